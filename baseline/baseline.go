@@ -0,0 +1,181 @@
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/senseyeio/diligent"
+)
+
+// Dependency is a dependency recorded within a baseline, along with the outcome of checking it against the policy
+type Dependency struct {
+	Name    string          `json:"name"`
+	Version string          `json:"version,omitempty"`
+	License string          `json:"license"`
+	Status  diligent.Status `json:"status"`
+}
+
+// Unresolved is a package whose license could not be determined when the baseline was recorded
+type Unresolved struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// Baseline records the state of a project's dependencies, so that later checks can fail only on regressions.
+// Violations are the dependencies whose status is denied, not allowed or review
+type Baseline struct {
+	Dependencies []Dependency `json:"dependencies"`
+	Unresolved   []Unresolved `json:"unresolved"`
+}
+
+// Change describes a dependency whose license differs from the one recorded within the baseline
+type Change struct {
+	Name string
+	From string
+	To   string
+}
+
+// Result is the outcome of comparing dependencies against a baseline
+type Result struct {
+	// NewViolations are the failing dependencies which were not failing with the same license in the baseline
+	NewViolations []diligent.Dep
+	// NewDependencies are the dependencies which were not recorded with the same license in the baseline, as they have
+	// been added or relicensed since
+	NewDependencies []diligent.Dep
+	// Relicensed are the dependencies whose license has changed since the baseline
+	Relicensed []Change
+	// NewUnresolved are the warnings for packages which were not unresolved in the baseline
+	NewUnresolved []diligent.Warning
+	// FixedViolations are the baseline violations which no longer fail or no longer exist
+	FixedViolations []Dependency
+	// FixedUnresolved are the baseline packages which are now resolved or no longer exist
+	FixedUnresolved []Unresolved
+}
+
+// Regressed returns true if anything has got worse since the baseline
+func (r Result) Regressed() bool {
+	return len(r.NewViolations) > 0 || len(r.Relicensed) > 0 || len(r.NewUnresolved) > 0
+}
+
+func isViolation(s diligent.Status) bool {
+	return s == diligent.Denied || s == diligent.NotAllowed || s == diligent.Review
+}
+
+// warningName returns the package a warning relates to, or the warning itself if the package is not known
+func warningName(w diligent.Warning) string {
+	if pkg := diligent.GetWarningDetails(w).Package; pkg != "" {
+		return pkg
+	}
+	return w.Warning()
+}
+
+// New returns a Baseline of the dependencies, which should have been checked against the policy, and warnings
+func New(deps []diligent.Dep, warnings []diligent.Warning) Baseline {
+	b := Baseline{
+		Dependencies: make([]Dependency, 0, len(deps)),
+		Unresolved:   make([]Unresolved, 0, len(warnings)),
+	}
+	for _, d := range deps {
+		b.Dependencies = append(b.Dependencies, Dependency{d.Name, d.Version, d.License.Identifier, d.Status})
+	}
+	for _, w := range warnings {
+		msg := w.Warning()
+		if details := diligent.GetWarningDetails(w); details.Package != "" {
+			msg = details.Err.Error()
+		}
+		b.Unresolved = append(b.Unresolved, Unresolved{warningName(w), msg})
+	}
+	sort.Slice(b.Dependencies, func(i, j int) bool {
+		if b.Dependencies[i].Name == b.Dependencies[j].Name {
+			return b.Dependencies[i].License < b.Dependencies[j].License
+		}
+		return b.Dependencies[i].Name < b.Dependencies[j].Name
+	})
+	sort.Slice(b.Unresolved, func(i, j int) bool { return b.Unresolved[i].Name < b.Unresolved[j].Name })
+	return b
+}
+
+// Read parses a baseline previously written using Write
+func Read(r io.Reader) (Baseline, error) {
+	var b Baseline
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return b, fmt.Errorf("invalid baseline: %v", err)
+	}
+	return b, nil
+}
+
+// Write outputs the baseline as JSON
+func (b Baseline) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(b)
+}
+
+// Compare returns the differences between the baseline and the current dependencies and warnings.
+// Dependencies are matched by name, so upgrading a dependency does not cause a regression unless its license changes
+func (b Baseline) Compare(deps []diligent.Dep, warnings []diligent.Warning) Result {
+	var res Result
+
+	baseLicenses := map[string]map[string]bool{}
+	baseViolations := map[string]bool{}
+	for _, d := range b.Dependencies {
+		if baseLicenses[d.Name] == nil {
+			baseLicenses[d.Name] = map[string]bool{}
+		}
+		baseLicenses[d.Name][d.License] = true
+		if isViolation(d.Status) {
+			baseViolations[d.Name+"-"+d.License] = true
+		}
+	}
+
+	violations := map[string]bool{}
+	relicensed := map[string]bool{}
+	for _, d := range deps {
+		key := d.Name + "-" + d.License.Identifier
+		if isViolation(d.Status) {
+			violations[key] = true
+		}
+		if !baseLicenses[d.Name][d.License.Identifier] {
+			res.NewDependencies = append(res.NewDependencies, d)
+		}
+		if licenses, ok := baseLicenses[d.Name]; ok && !licenses[d.License.Identifier] {
+			from := make([]string, 0, len(licenses))
+			for l := range licenses {
+				from = append(from, l)
+			}
+			sort.Strings(from)
+			res.Relicensed = append(res.Relicensed, Change{d.Name, from[0], d.License.Identifier})
+			relicensed[d.Name] = true
+			continue
+		}
+		if isViolation(d.Status) && !baseViolations[key] {
+			res.NewViolations = append(res.NewViolations, d)
+		}
+	}
+	for _, d := range b.Dependencies {
+		if isViolation(d.Status) && !violations[d.Name+"-"+d.License] && !relicensed[d.Name] {
+			res.FixedViolations = append(res.FixedViolations, d)
+		}
+	}
+
+	baseUnresolved := map[string]bool{}
+	for _, u := range b.Unresolved {
+		baseUnresolved[u.Name] = true
+	}
+	unresolved := map[string]bool{}
+	for _, w := range warnings {
+		name := warningName(w)
+		unresolved[name] = true
+		if !baseUnresolved[name] {
+			res.NewUnresolved = append(res.NewUnresolved, w)
+		}
+	}
+	for _, u := range b.Unresolved {
+		if !unresolved[u.Name] {
+			res.FixedUnresolved = append(res.FixedUnresolved, u)
+		}
+	}
+	return res
+}
@@ -0,0 +1,106 @@
+package baseline_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/baseline"
+	"github.com/senseyeio/diligent/warning"
+)
+
+func dep(name, license string, status diligent.Status) diligent.Dep {
+	l, _ := diligent.GetLicenseFromIdentifier(license)
+	return diligent.Dep{Name: name, License: l, Status: status}
+}
+
+func depNames(deps []diligent.Dep) []string {
+	names := make([]string, len(deps))
+	for i, d := range deps {
+		names[i] = d.Name
+	}
+	return names
+}
+
+func TestWriteRead(t *testing.T) {
+	b := baseline.New(
+		[]diligent.Dep{dep("b", "GPL-3.0", diligent.NotAllowed), dep("a", "MIT", diligent.Allowed)},
+		[]diligent.Warning{warning.New("c", "no license information in NPM")},
+	)
+	var buf bytes.Buffer
+	if err := b.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out, err := baseline.Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := baseline.Baseline{
+		Dependencies: []baseline.Dependency{
+			{Name: "a", License: "MIT", Status: diligent.Allowed},
+			{Name: "b", License: "GPL-3.0", Status: diligent.NotAllowed},
+		},
+		Unresolved: []baseline.Unresolved{{Name: "c", Message: "no license information in NPM"}},
+	}
+	if reflect.DeepEqual(out, expected) == false {
+		t.Errorf("got %+v, want %+v", out, expected)
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	if _, err := baseline.Read(bytes.NewBufferString("{{")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	base := baseline.New(
+		[]diligent.Dep{
+			dep("known", "GPL-3.0", diligent.NotAllowed),
+			dep("fixed", "GPL-3.0", diligent.NotAllowed),
+			dep("allowed", "MIT", diligent.Allowed),
+			dep("relicensed", "MIT", diligent.Allowed),
+		},
+		[]diligent.Warning{warning.New("unresolved", "eeek"), warning.New("resolved", "eeek")},
+	)
+	res := base.Compare(
+		[]diligent.Dep{
+			dep("known", "GPL-3.0", diligent.NotAllowed),
+			dep("fixed", "GPL-3.0", diligent.Allowed),
+			dep("allowed", "MIT", diligent.Allowed),
+			dep("relicensed", "GPL-3.0", diligent.NotAllowed),
+			dep("new", "GPL-3.0", diligent.Denied),
+		},
+		[]diligent.Warning{warning.New("unresolved", "eeek"), warning.New("new-unresolved", "eeek")},
+	)
+	if !res.Regressed() {
+		t.Error("expected regression")
+	}
+	if len(res.NewViolations) != 1 || res.NewViolations[0].Name != "new" {
+		t.Errorf("unexpected new violations %+v", res.NewViolations)
+	}
+	if got := depNames(res.NewDependencies); reflect.DeepEqual(got, []string{"relicensed", "new"}) == false {
+		t.Errorf("unexpected new dependencies %v", got)
+	}
+	if reflect.DeepEqual(res.Relicensed, []baseline.Change{{Name: "relicensed", From: "MIT", To: "GPL-3.0"}}) == false {
+		t.Errorf("unexpected relicensed %+v", res.Relicensed)
+	}
+	if len(res.NewUnresolved) != 1 || res.NewUnresolved[0].(*warning.Warn).Dep != "new-unresolved" {
+		t.Errorf("unexpected new unresolved %+v", res.NewUnresolved)
+	}
+	if len(res.FixedViolations) != 1 || res.FixedViolations[0].Name != "fixed" {
+		t.Errorf("unexpected fixed violations %+v", res.FixedViolations)
+	}
+	if len(res.FixedUnresolved) != 1 || res.FixedUnresolved[0].Name != "resolved" {
+		t.Errorf("unexpected fixed unresolved %+v", res.FixedUnresolved)
+	}
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	deps := []diligent.Dep{dep("known", "GPL-3.0", diligent.NotAllowed)}
+	warns := []diligent.Warning{warning.New("unresolved", "eeek")}
+	if res := baseline.New(deps, warns).Compare(deps, warns); res.Regressed() {
+		t.Errorf("unexpected regression %+v", res)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/senseyeio/diligent/baseline"
+	"github.com/spf13/cobra"
+)
+
+const defaultBaselineFilename = ".diligent-baseline.json"
+
+var (
+	baselineFilename    string
+	baselineOutFilename string
+)
+
+// baselineCmd represents the baseline command
+var baselineCmd = &cobra.Command{
+	Use:   "baseline [path]",
+	Short: "Records the current licenses, violations and warnings so that check only fails on regressions",
+	Long: `Calling baseline will record your dependencies, their licenses, any license policy violations and any packages
+whose license could not be determined. Passing the resulting file to check using --baseline causes check to fail only
+when a new dependency violates the policy, a license changes or a new package cannot be resolved.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		res := mustScan(args[0])
+		b := baseline.New(res.Deps, res.Warnings)
+		file, err := os.Create(baselineOutFilename)
+		if err != nil {
+			fatal(65, fmt.Sprintf("unable to open file '%s' for writing. %v", baselineOutFilename, err))
+		}
+		defer file.Close()
+		if err := b.Write(file); err != nil {
+			fatal(65, err.Error())
+		}
+	},
+}
+
+func mustReadBaseline(path string) baseline.Baseline {
+	file, err := os.Open(path)
+	if err != nil {
+		fatal(66, err.Error())
+	}
+	defer file.Close()
+	b, err := baseline.Read(file)
+	if err != nil {
+		fatal(66, fmt.Sprintf("unable to read baseline '%s'. %v", path, err))
+	}
+	return b
+}
+
+// reportFixed outputs the baseline entries which are no longer a problem, so the baseline can be updated
+func reportFixed(res baseline.Result) {
+	for _, d := range res.FixedViolations {
+		warning(fmt.Sprintf("fixed: dependency '%s' with license '%s' no longer violates your license policy", d.Name, d.License))
+	}
+	for _, u := range res.FixedUnresolved {
+		warning(fmt.Sprintf("fixed: the license for '%s' can now be determined", u.Name))
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(baselineCmd)
+	applyWhitelistFlag(baselineCmd)
+	applyScopeFlags(baselineCmd)
+	baselineCmd.Flags().StringSliceVarP(&pkgIgnore, "ignore", "i", nil, "Ignore certain packages. Ignored packages will not be recorded within the baseline. Regular expressions can be used.")
+	applyWalkFlags(baselineCmd)
+	baselineCmd.Flags().StringVarP(&baselineOutFilename, "file", "f", defaultBaselineFilename, "Filename to which the baseline should be written")
+	checkCmd.Flags().StringVarP(&baselineFilename, "baseline", "", "", "Baseline file created by the baseline command. When provided, only dependencies which regressed since the baseline cause a non zero exit code")
+}
@@ -21,5 +21,6 @@ var checkCmd = &cobra.Command{
 func init() {
 	RootCmd.AddCommand(checkCmd)
 	applyCommonFlags(checkCmd)
+	applyCompatibilityFlags(checkCmd)
 	applyWhitelistFlag(checkCmd)
 }
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/compat"
+	"github.com/spf13/cobra"
+)
+
+var (
+	distribution   string
+	projectLicense string
+)
+
+func applyCompatibilityFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&distribution, "distribution", "", "", "How the project reaches its users, from 'saas', 'binary' and 'internal'. When set, dependencies which are incompatible with the project license and distribution model cause exit code 73, and those triggering obligations are reported")
+	cmd.Flags().StringVarP(&projectLicense, "project-license", "", "", "License identifier of the project, used with --distribution. By default the license is detected from the license file within the scanned directory, and a project without one is treated as proprietary")
+}
+
+// buildChecker returns a compat.Checker for the scanned project, or nil if no distribution model was chosen
+func buildChecker(path string) *compat.Checker {
+	if distribution == "" {
+		return nil
+	}
+	if !compat.IsDistribution(distribution) {
+		fatal(70, fmt.Sprintf("'%s' is not a distribution model, expecting one of %v", distribution, compat.Distributions()))
+	}
+	var project diligent.License
+	if projectLicense != "" {
+		l, err := diligent.GetLicenseFromIdentifier(projectLicense)
+		if err != nil {
+			fatal(70, fmt.Sprintf("project license '%s' is not a known license identifier", projectLicense))
+		}
+		project = l
+	} else if distribution != string(compat.Internal) {
+		// internal projects are compatible with every license, so there is no need to detect one
+		dir := path
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			dir = filepath.Dir(path)
+		}
+		l, err := diligent.GetLicenseForDirectory(dir)
+		if err != nil {
+			warning(fmt.Sprintf("could not detect the project license, so the project is treated as proprietary: %v", err))
+		}
+		project = l
+	}
+	c, _ := compat.New(project, compat.Distribution(distribution))
+	return c
+}
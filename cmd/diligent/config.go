@@ -0,0 +1,371 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/httpclient"
+	"github.com/senseyeio/diligent/policy"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// configFilenames are the files searched for when --config is not provided
+var configFilenames = []string{".diligent.yml", ".diligent.yaml", ".diligent.json"}
+
+// config allows settings to be defined within a file. Keys match the long names of the equivalent flags, and flags
+// take precedence over values within the file
+type config struct {
+	// Registries replace the default package registries
+	NugetURL *string `json:"nuget-url" yaml:"nuget-url"`
+	PodsURL  *string `json:"pods-url" yaml:"pods-url"`
+	PubURL   *string `json:"pub-url" yaml:"pub-url"`
+	HexURL   *string `json:"hex-url" yaml:"hex-url"`
+
+	Whitelist         []string  `json:"whitelist" yaml:"whitelist"`
+	Review            []string  `json:"review" yaml:"review"`
+	Deny              []string  `json:"deny" yaml:"deny"`
+	Ignore            []string  `json:"ignore" yaml:"ignore"`
+	Exclude           []string  `json:"exclude" yaml:"exclude"`
+	MaxDepth          *int      `json:"max-depth" yaml:"max-depth"`
+	Gitignore         *bool     `json:"gitignore" yaml:"gitignore"`
+	NoDefaultExcludes *bool     `json:"no-default-excludes" yaml:"no-default-excludes"`
+	NpmDevDeps        *bool     `json:"npm-dev-deps" yaml:"npm-dev-deps"`
+	Scope             []string  `json:"scope" yaml:"scope"`
+	FailOnWarning     []string  `json:"fail-on-warning" yaml:"fail-on-warning"`
+	CSV               *bool     `json:"csv" yaml:"csv"`
+	JSON              *bool     `json:"json" yaml:"json"`
+	Trace             *bool     `json:"trace" yaml:"trace"`
+	License           *bool     `json:"license" yaml:"license"`
+	Out               *string   `json:"out" yaml:"out"`
+	Timeout           *duration `json:"timeout" yaml:"timeout"`
+	RequestTimeout    *duration `json:"request-timeout" yaml:"request-timeout"`
+	Offline           *bool     `json:"offline" yaml:"offline"`
+	Proxy             *string   `json:"proxy" yaml:"proxy"`
+	CAFile            *string   `json:"ca-file" yaml:"ca-file"`
+	Netrc             *string   `json:"netrc" yaml:"netrc"`
+	LicenseList       []string  `json:"license-list" yaml:"license-list"`
+	Distribution      *string   `json:"distribution" yaml:"distribution"`
+	ProjectLicense    *string   `json:"project-license" yaml:"project-license"`
+	// Exceptions have no equivalent flag
+	Exceptions  []exception  `json:"exceptions" yaml:"exceptions"`
+	Overrides   []override   `json:"overrides" yaml:"overrides"`
+	Credentials []credential `json:"credentials" yaml:"credentials"`
+	Licenses    []license    `json:"licenses" yaml:"licenses"`
+	// Categories are organisation defined categories, whilst LicenseCategories reassigns licenses to a category
+	Categories        []string          `json:"categories" yaml:"categories"`
+	LicenseCategories map[string]string `json:"license-categories" yaml:"license-categories"`
+}
+
+// license is the config representation of a custom diligent.License
+type license struct {
+	ID       string `json:"id" yaml:"id"`
+	Name     string `json:"name" yaml:"name"`
+	Category string `json:"category" yaml:"category"`
+	URL      string `json:"url" yaml:"url"`
+}
+
+// credential is the config representation of httpclient.Credentials. Environment variables such as $GITHUB_TOKEN
+// are expanded, so secrets need not be stored within the file
+type credential struct {
+	Host     string `json:"host" yaml:"host"`
+	Username string `json:"username" yaml:"username"`
+	Password string `json:"password" yaml:"password"`
+	Token    string `json:"token" yaml:"token"`
+}
+
+// duration is a time.Duration written as a string such as 90s or 5m
+type duration time.Duration
+
+func (d *duration) parse(s string) error {
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(parsed)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return d.parse(s)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (d *duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return d.parse(s)
+}
+
+// override is the config representation of diligent.Override
+type override struct {
+	Name      string `json:"name" yaml:"name"`
+	Versions  string `json:"versions" yaml:"versions"`
+	Ecosystem string `json:"ecosystem" yaml:"ecosystem"`
+	License   string `json:"license" yaml:"license"`
+}
+
+// exception is the config representation of policy.Exception, with the expiry date formatted as YYYY-MM-DD
+type exception struct {
+	Package  string `json:"package" yaml:"package"`
+	Version  string `json:"version" yaml:"version"`
+	License  string `json:"license" yaml:"license"`
+	Reason   string `json:"reason" yaml:"reason"`
+	Approver string `json:"approver" yaml:"approver"`
+	Expires  string `json:"expires" yaml:"expires"`
+}
+
+func (e exception) toPolicy() (policy.Exception, error) {
+	expires, err := time.Parse(policy.DateFormat, e.Expires)
+	if err != nil {
+		return policy.Exception{}, fmt.Errorf("exception for '%s' has an invalid expiry date '%s', expecting YYYY-MM-DD", e.Package, e.Expires)
+	}
+	return policy.Exception{
+		Package:  e.Package,
+		Version:  e.Version,
+		License:  e.License,
+		Reason:   e.Reason,
+		Approver: e.Approver,
+		Expires:  expires,
+	}, nil
+}
+
+// findConfig looks for a config file within the scanned directory, then the working directory
+func findConfig(args []string) string {
+	dirs := make([]string, 0, 2)
+	if len(args) > 0 {
+		if info, err := os.Stat(args[0]); err == nil && !info.IsDir() {
+			dirs = append(dirs, filepath.Dir(args[0]))
+		} else {
+			dirs = append(dirs, args[0])
+		}
+	}
+	dirs = append(dirs, ".")
+	for _, dir := range dirs {
+		for _, name := range configFilenames {
+			path := filepath.Join(dir, name)
+			if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+				return path
+			}
+		}
+	}
+	return ""
+}
+
+// parseConfig parses JSON or YAML config, rejecting unknown keys
+func parseConfig(path string, b []byte) (*config, error) {
+	var c config
+	if filepath.Ext(path) == ".json" {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&c); err != nil {
+			return nil, fmt.Errorf("invalid config file '%s': %v", path, err)
+		}
+		return &c, nil
+	}
+	if err := yaml.UnmarshalStrict(b, &c); err != nil {
+		return nil, fmt.Errorf("invalid config file '%s': %v", path, err)
+	}
+	return &c, nil
+}
+
+// overrides converts the license overrides defined within the config file
+func (c *config) overrides() (diligent.Overrides, error) {
+	out := make(diligent.Overrides, 0, len(c.Overrides))
+	for _, o := range c.Overrides {
+		do, err := diligent.NewOverride(o.Name, o.Versions, o.Ecosystem, o.License)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, do)
+	}
+	return out, nil
+}
+
+// credentials converts the host credentials defined within the config file
+func (c *config) credentials() (map[string]httpclient.Credentials, error) {
+	out := make(map[string]httpclient.Credentials, len(c.Credentials))
+	for _, cr := range c.Credentials {
+		if cr.Host == "" {
+			return nil, errors.New("credentials must have a host")
+		}
+		out[cr.Host] = httpclient.Credentials{
+			Username: os.ExpandEnv(cr.Username),
+			Password: os.ExpandEnv(cr.Password),
+			Token:    os.ExpandEnv(cr.Token),
+		}
+	}
+	return out, nil
+}
+
+// licenses converts the custom licenses defined within the config file
+func (c *config) licenses() []diligent.License {
+	out := make([]diligent.License, 0, len(c.Licenses))
+	for _, l := range c.Licenses {
+		out = append(out, diligent.License{
+			Identifier: l.ID,
+			Name:       l.Name,
+			Category:   diligent.Category(l.Category),
+			URL:        l.URL,
+		})
+	}
+	return out
+}
+
+// exceptions converts the exceptions defined within the config file
+func (c *config) exceptions() ([]policy.Exception, error) {
+	out := make([]policy.Exception, 0, len(c.Exceptions))
+	for _, e := range c.Exceptions {
+		pe, err := e.toPolicy()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pe)
+	}
+	return out, nil
+}
+
+// applyConfig copies values from the config file into the settings which were not set using flags
+func applyConfig(cmd *cobra.Command, c *config) {
+	flags := cmd.Flags()
+	if c.NugetURL != nil && !flags.Changed("nuget-url") {
+		nugetURL = *c.NugetURL
+	}
+	if c.PodsURL != nil && !flags.Changed("pods-url") {
+		podsURL = *c.PodsURL
+	}
+	if c.PubURL != nil && !flags.Changed("pub-url") {
+		pubURL = *c.PubURL
+	}
+	if c.HexURL != nil && !flags.Changed("hex-url") {
+		hexURL = *c.HexURL
+	}
+	if c.Whitelist != nil && !flags.Changed("whitelist") {
+		licenseWhitelist = c.Whitelist
+	}
+	if c.Review != nil && !flags.Changed("review") {
+		licenseReview = c.Review
+	}
+	if c.Deny != nil && !flags.Changed("deny") {
+		licenseDeny = c.Deny
+	}
+	if c.Ignore != nil && !flags.Changed("ignore") {
+		pkgIgnore = c.Ignore
+	}
+	if c.Exclude != nil && !flags.Changed("exclude") {
+		excludeGlobs = c.Exclude
+	}
+	if c.MaxDepth != nil && !flags.Changed("max-depth") {
+		maxDepth = *c.MaxDepth
+	}
+	if c.Gitignore != nil && !flags.Changed("gitignore") {
+		honourGitignore = *c.Gitignore
+	}
+	if c.NoDefaultExcludes != nil && !flags.Changed("no-default-excludes") {
+		noDefaultExcludes = *c.NoDefaultExcludes
+	}
+	if c.NpmDevDeps != nil && !flags.Changed("npm-dev-deps") {
+		npmDevDeps = *c.NpmDevDeps
+	}
+	if c.Scope != nil && !flags.Changed("scope") {
+		scopeNames = c.Scope
+	}
+	if c.FailOnWarning != nil && !flags.Changed("fail-on-warning") {
+		failOnWarning = c.FailOnWarning
+	}
+	if c.Trace != nil && !flags.Changed("trace") {
+		traceOutput = *c.Trace
+	}
+	if c.CSV != nil && !flags.Changed("csv") {
+		csvOutput = *c.CSV
+	}
+	if c.JSON != nil && !flags.Changed("json") {
+		jsonOutput = *c.JSON
+	}
+	if c.License != nil && !flags.Changed("license") {
+		sortByLicense = *c.License
+	}
+	if c.Out != nil && !flags.Changed("out") {
+		outputFilename = *c.Out
+	}
+	if c.Timeout != nil && !flags.Changed("timeout") {
+		scanTimeout = time.Duration(*c.Timeout)
+	}
+	if c.RequestTimeout != nil && !flags.Changed("request-timeout") {
+		requestTimeout = time.Duration(*c.RequestTimeout)
+	}
+	if c.Offline != nil && !flags.Changed("offline") {
+		offlineMode = *c.Offline
+	}
+	if c.Proxy != nil && !flags.Changed("proxy") {
+		proxyURL = *c.Proxy
+	}
+	if c.CAFile != nil && !flags.Changed("ca-file") {
+		caFilename = *c.CAFile
+	}
+	if c.Netrc != nil && !flags.Changed("netrc") {
+		netrcFilename = *c.Netrc
+	}
+	if c.Distribution != nil && !flags.Changed("distribution") {
+		distribution = *c.Distribution
+	}
+	if c.ProjectLicense != nil && !flags.Changed("project-license") {
+		projectLicense = *c.ProjectLicense
+	}
+	if c.LicenseList != nil && !flags.Changed("license-list") {
+		licenseListFiles = c.LicenseList
+	}
+}
+
+// loadConfig applies the config file provided with --config, or the discovered config file, if any
+func loadConfig(cmd *cobra.Command, args []string) {
+	path := configFilename
+	if path == "" {
+		path = findConfig(args)
+	}
+	if path == "" {
+		loadLicenses()
+		return
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		fatal(66, err.Error())
+	}
+	c, err := parseConfig(path, b)
+	if err != nil {
+		fatal(70, err.Error())
+	}
+	applyConfig(cmd, c)
+	customLicenses = c.licenses()
+	customCategories = c.Categories
+	licenseCategories = c.LicenseCategories
+	// overrides and exceptions may refer to custom licenses or those within --license-list
+	loadLicenses()
+	licenseExcepts, err = c.exceptions()
+	if err != nil {
+		fatal(70, fmt.Sprintf("invalid config file '%s': %v", path, err))
+	}
+	licenseOverrides, err = c.overrides()
+	if err != nil {
+		fatal(70, fmt.Sprintf("invalid config file '%s': %v", path, err))
+	}
+	hostCredentials, err = c.credentials()
+	if err != nil {
+		fatal(70, fmt.Sprintf("invalid config file '%s': %v", path, err))
+	}
+}
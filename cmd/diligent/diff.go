@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/diff"
+	"github.com/senseyeio/diligent/json"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffGit           bool
+	failOnRelicensing bool
+)
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff [from] [to] | diff --git [from-ref] [to-ref] [path]",
+	Short: "Details the dependencies which were added, removed or relicensed between two scans",
+	Long: `Calling diff will compare the licenses of two sets of dependencies. Each of from and to may be a JSON report
+written using --json, or a path to be scanned. Using --git, the manifests committed at two git refs of the repository
+containing path are compared instead. Files are read from the git objects, so the working tree is left untouched.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if diffGit {
+			return cobra.RangeArgs(2, 3)(cmd, args)
+		}
+		return cobra.ExactArgs(2)(cmd, args)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		var from, to []diligent.Dep
+		if diffGit {
+			path := "."
+			if len(args) == 3 {
+				path = args[2]
+			}
+			from, to = depsAtRef(path, args[0]), depsAtRef(path, args[1])
+		} else {
+			from, to = loadDeps(args[0]), loadDeps(args[1])
+		}
+		res := diff.Compare(from, to)
+
+		err := withOutputWriter(func(w io.Writer) error {
+			return writeDiff(w, res)
+		})
+		if err != nil {
+			fatal(65, err.Error())
+		}
+
+		if failOnRelicensing {
+			checkRelicensed(res, to)
+		}
+	},
+}
+
+// checkRelicensed exits if a dependency was relicensed to a license which is not allowed by the policy, unless it is
+// covered by an approved exception, exiting with the review code if the new licenses only require review
+func checkRelicensed(res diff.Result, to []diligent.Dep) {
+	checked := licensePolicy.Check(to)
+	errs, reviews := make([]string, 0), make([]string, 0)
+	for _, r := range res.Relicensed {
+		for _, d := range checked {
+			if d.Name != r.Name || d.License.Identifier != r.To.Identifier {
+				continue
+			}
+			changed := fmt.Sprintf("dependency '%s' has changed license from '%s' to '%s'", r.Name, r.From.Identifier, r.To.Identifier)
+			switch d.Status {
+			case diligent.Allowed, diligent.Excepted:
+			case diligent.Review:
+				reviews = append(reviews, changed+" which requires review under your license policy")
+			default:
+				errs = append(errs, fmt.Sprintf("%s which is %s by your license policy", changed, d.Status))
+			}
+			break
+		}
+	}
+	if len(errs) > 0 {
+		fatal(68, strings.Join(append(errs, reviews...), "\n"))
+	}
+	if len(reviews) > 0 {
+		fatal(72, strings.Join(reviews, "\n"))
+	}
+}
+
+// loadDeps reads a JSON report, or otherwise scans the path for dependencies
+func loadDeps(path string) []diligent.Dep {
+	if filepath.Ext(path) == ".json" {
+		if _, err := newScanner().Deper(path); err != nil {
+			file, err := os.Open(path)
+			if err != nil {
+				fatal(66, err.Error())
+			}
+			defer file.Close()
+			deps, err := json.Read(file)
+			if err != nil {
+				fatal(66, fmt.Sprintf("unable to read report '%s'. %v", path, err))
+			}
+			return deps
+		}
+	}
+	return scanDeps(path)
+}
+
+// depsAtRef scans the manifests committed at ref within path
+func depsAtRef(path, ref string) []diligent.Dep {
+	exported, tmpDir, err := exportRef(path, ref, isScannedFile)
+	if err != nil {
+		fatal(66, fmt.Sprintf("unable to read '%s' at '%s'. %v", path, ref, err))
+	}
+	defer os.RemoveAll(tmpDir)
+	return scanDeps(exported)
+}
+
+// scanDeps scans the path for dependencies. Unlike mustScan, finding no dependencies is not an error, as a side of the
+// diff without dependencies is compared as an empty set, for example when the first manifest is added
+func scanDeps(path string) []diligent.Dep {
+	res := resolve(path)
+	for _, w := range res.Warnings {
+		warning(w.Warning())
+	}
+	return res.Deps
+}
+
+func writeDiff(w io.Writer, res diff.Result) error {
+	writer := tabwriter.NewWriter(w, 5, 0, 2, ' ', 0)
+	if res.Empty() {
+		fmt.Fprintln(writer, "No license changes")
+	}
+	separator := ""
+	sections := []struct {
+		title string
+		deps  []diligent.Dep
+	}{{"Added:", res.Added}, {"Removed:", res.Removed}}
+	for _, s := range sections {
+		if len(s.deps) == 0 {
+			continue
+		}
+		fmt.Fprint(writer, separator, s.title, "\n")
+		for _, d := range s.deps {
+			fmt.Fprintf(writer, "%s\t%s\n", d.Name, d.License.Name)
+		}
+		separator = "\n"
+	}
+	if len(res.Relicensed) > 0 {
+		fmt.Fprint(writer, separator, "Relicensed:\n")
+		for _, r := range res.Relicensed {
+			fmt.Fprintf(writer, "%s\t%s\t->\t%s\n", r.Name, r.From.Name, r.To.Name)
+		}
+	}
+	return writer.Flush()
+}
+
+func init() {
+	RootCmd.AddCommand(diffCmd)
+	applyWhitelistFlag(diffCmd)
+	applyScopeFlags(diffCmd)
+	diffCmd.Flags().StringVarP(&outputFilename, "out", "o", "", "Filename to which output should be written. By default or when blank stdout is used")
+	applyWalkFlags(diffCmd)
+	diffCmd.Flags().BoolVarP(&diffGit, "git", "", false, "Compare the dependencies committed at two git refs rather than two reports or paths")
+	diffCmd.Flags().BoolVarP(&failOnRelicensing, "fail-on-relicense", "", false, "Return with a non zero exit code if a dependency is relicensed to a license which is not whitelisted, unless covered by an approved exception")
+}
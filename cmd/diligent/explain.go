@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/senseyeio/diligent"
+	"github.com/spf13/cobra"
+)
+
+// explainCmd represents the explain command
+var explainCmd = &cobra.Command{
+	Use:   "explain [package] [path]",
+	Short: "Explains how the license of a package was determined",
+	Long: `Calling explain will list each step taken to determine the license of a package, such as reading a registry's
+license field, querying GitHub or cloning the repository, along with the URL or path tried, the outcome and the step
+which determined the license. The path defaults to the working directory.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		name, path := args[0], "."
+		if len(args) == 2 {
+			path = args[1]
+		}
+		if !cmd.Flags().Changed("scope") && !cmd.Flags().Changed("npm-dev-deps") {
+			scopeNames = []string{allScopes}
+			buildDepers()
+		}
+		if isIgnored(name) {
+			warning(fmt.Sprintf("'%s' matches your ignore patterns, so is not reported by other commands", name))
+			ignoreRegex = nil
+		}
+
+		res := resolve(path)
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		found := false
+		for _, d := range res.Deps {
+			if d.Name == name {
+				found = true
+				writeExplainedDep(w, d)
+			}
+		}
+		for _, warn := range res.Warnings {
+			if details := diligent.GetWarningDetails(warn); details.Package == name {
+				found = true
+				writeExplainedWarning(w, details)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			fatal(65, err.Error())
+		}
+		if !found {
+			fatal(67, fmt.Sprintf("no dependency named '%s' was found within '%s'", name, path))
+		}
+	},
+}
+
+func writeExplainedDep(w io.Writer, d diligent.Dep) {
+	fmt.Fprintf(w, "%s %s\n", d.Name, d.Version)
+	fmt.Fprintf(w, "  License:      %s (%s)\n", d.License.Name, d.License.Identifier)
+	if len(licenseWhitelist) > 0 || len(licenseReview) > 0 || len(licenseDeny) > 0 {
+		fmt.Fprintf(w, "  Status:       %s\n", d.Status)
+	}
+	if winner, ok := d.Trace.Winner(); ok {
+		fmt.Fprintf(w, "  Resolved by:  %s %s\n", winner.Source, winner.Location)
+	}
+	writeSteps(w, d.Trace)
+}
+
+func writeExplainedWarning(w io.Writer, details diligent.WarningDetails) {
+	fmt.Fprintf(w, "%s %s\n", details.Package, details.Version)
+	fmt.Fprintf(w, "  License:      not determined, %s\n", details.Err)
+	if details.Kind != "" {
+		fmt.Fprintf(w, "  Warning:      %s\n", details.Kind)
+	}
+	writeSteps(w, details.Trace)
+}
+
+func writeSteps(w io.Writer, t diligent.Trace) {
+	if len(t) == 0 {
+		fmt.Fprintln(w, "  Steps:        none recorded")
+		return
+	}
+	winner, _ := t.Winner()
+	fmt.Fprintln(w, "  Steps:")
+	for i, s := range t {
+		outcome := "failed: " + s.Err
+		if s.Err == "" {
+			outcome = s.License
+			if s == winner {
+				outcome += " (used)"
+			}
+		}
+		fmt.Fprintf(w, "    %d. %s\t%s\t%s\n", i+1, s.Source, s.Location, outcome)
+	}
+	fmt.Fprintln(w)
+}
+
+func init() {
+	RootCmd.AddCommand(explainCmd)
+	applyWhitelistFlag(explainCmd)
+	applyScopeFlags(explainCmd)
+	applyWalkFlags(explainCmd)
+}
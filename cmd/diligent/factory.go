@@ -1,38 +1,65 @@
 package main
 
 import (
-	"fmt"
-
-	"path/filepath"
-
 	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/cocoapods"
+	"github.com/senseyeio/diligent/composer"
 	"github.com/senseyeio/diligent/dep"
 	"github.com/senseyeio/diligent/github"
 	_go "github.com/senseyeio/diligent/go"
 	"github.com/senseyeio/diligent/gomod"
+	"github.com/senseyeio/diligent/gomodvendor"
 	"github.com/senseyeio/diligent/govendor"
+	"github.com/senseyeio/diligent/mix"
 	"github.com/senseyeio/diligent/npm"
+	"github.com/senseyeio/diligent/nuget"
+	"github.com/senseyeio/diligent/pub"
+	"github.com/senseyeio/diligent/swiftpm"
+	"github.com/spf13/cobra"
 )
 
 var (
-	gh        = github.New("https://api.github.com")
-	goLG      = _go.NewLicenseGetter(gh)
+	githubURL = "https://api.github.com"
 	npmAPIURL = "https://registry.npmjs.org"
+	nugetURL  = "https://api.nuget.org/v3-flatcontainer"
+	podsURL   = "https://cdn.cocoapods.org"
+	pubURL    = "https://pub.dev"
+	hexURL    = "https://hex.pm"
 )
 
-var depers = []diligent.Deper{
-	npm.New(npmAPIURL, gh),
-	govendor.New(goLG),
-	dep.New(goLG),
-	gomod.New(goLG),
+var depers []diligent.Deper
+
+// applyRegistryFlags allows the package registries to be replaced, for example by an internal mirror
+func applyRegistryFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&nugetURL, "nuget-url", "", nugetURL, "NuGet v3 package content (flat container) endpoint from which nuspec files are fetched")
+	cmd.PersistentFlags().StringVarP(&podsURL, "pods-url", "", podsURL, "CocoaPods CDN from which podspecs are fetched")
+	cmd.PersistentFlags().StringVarP(&pubURL, "pub-url", "", pubURL, "pub registry from which Dart and Flutter package metadata is fetched")
+	cmd.PersistentFlags().StringVarP(&hexURL, "hex-url", "", hexURL, "Hex registry from which Elixir and Erlang package metadata is fetched")
 }
 
-func getDeper(path string) (diligent.Deper, error) {
-	filename := filepath.Base(path)
-	for _, deper := range depers {
-		if deper.IsCompatible(filename) {
-			return deper, nil
-		}
+// buildDepers creates the Depers once flags and the config file have been processed
+func buildDepers() {
+	client := buildHTTPClient()
+	gh := github.NewWithOptions(githubURL, github.Config{Client: client})
+	goLG := _go.NewLicenseGetter(gh)
+	devDeps := includesScope(diligent.Development)
+	depers = []diligent.Deper{
+		npm.NewWithOptions(npmAPIURL, gh, npm.Config{
+			DevDependencies:      devDeps,
+			OptionalDependencies: includesScope(diligent.Optional),
+			PeerDependencies:     includesScope(diligent.Peer),
+			Overrides:            licenseOverrides,
+			Client:               client,
+		}),
+		govendor.NewWithOptions(goLG, govendor.Config{Overrides: licenseOverrides}),
+		dep.NewWithOptions(goLG, dep.Config{Overrides: licenseOverrides}),
+		gomod.NewWithOptions(goLG, gomod.Config{Overrides: licenseOverrides}),
+		gomodvendor.NewWithOptions(gomodvendor.Config{Overrides: licenseOverrides}),
+		composer.NewWithOptions(gh, composer.Config{DevDependencies: devDeps, Overrides: licenseOverrides}),
+		nuget.NewWithOptions(nugetURL, gh, nuget.Config{Overrides: licenseOverrides, Client: client}),
+		swiftpm.NewWithOptions(gh, swiftpm.Config{Overrides: licenseOverrides}),
+		cocoapods.NewWithOptions(podsURL, gh, cocoapods.Config{Overrides: licenseOverrides, Client: client}),
+		pub.NewWithOptions(pubURL, gh, pub.Config{DevDependencies: devDeps, Overrides: licenseOverrides, Client: client}),
+		mix.NewWithOptions(hexURL, gh, mix.Config{Overrides: licenseOverrides, Client: client}),
 	}
-	return nil, fmt.Errorf("Diligent does not know how to process '%s' files", filename)
 }
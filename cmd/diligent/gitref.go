@@ -0,0 +1,111 @@
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// licenseFilePrefixes are the prefixes of the names of files which may hold the license of a local dependency
+var licenseFilePrefixes = []string{"license", "licence", "copying", "unlicense", "notice", "readme"}
+
+// isScannedFile returns true if the file at the slash separated path is a manifest handled by one of the Depers, or may
+// hold the license of a dependency read from disk, such as a vendored or path dependency
+func isScannedFile(name string) bool {
+	base := path.Base(name)
+	for _, prefix := range licenseFilePrefixes {
+		if strings.HasPrefix(strings.ToLower(base), prefix) {
+			return true
+		}
+	}
+	for _, d := range depers {
+		if d.IsCompatible(base) {
+			return true
+		}
+	}
+	return false
+}
+
+// exportRef writes the files committed at ref for which keep returns true to a temporary directory, reading them from
+// the git objects of the repository containing path. The returned path corresponds to path within the exported files,
+// and exists even if path was not committed at ref. The working tree is not modified, and the caller should remove
+// the temporary directory once finished
+func exportRef(path, ref string, keep func(name string) bool) (exported string, tmpDir string, err error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", "", err
+	}
+	repo, err := git.PlainOpenWithOptions(abs, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return "", "", err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", "", err
+	}
+	rel, err := filepath.Rel(wt.Filesystem.Root(), abs)
+	if err != nil {
+		return "", "", err
+	}
+	hash, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return "", "", err
+	}
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return "", "", err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return "", "", err
+	}
+
+	tmpDir, err = ioutil.TempDir("", "diligent-")
+	if err != nil {
+		return "", "", err
+	}
+	prefix := filepath.ToSlash(rel)
+	err = tree.Files().ForEach(func(f *object.File) error {
+		if prefix != "." && f.Name != prefix && !strings.HasPrefix(f.Name, prefix+"/") {
+			return nil
+		}
+		if !f.Mode.IsFile() || !keep(f.Name) {
+			return nil
+		}
+		return writeGitFile(filepath.Join(tmpDir, filepath.FromSlash(f.Name)), f)
+	})
+	if err == nil {
+		exported = filepath.Join(tmpDir, rel)
+		err = os.MkdirAll(exported, 0755)
+	}
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return "", "", err
+	}
+	return exported, tmpDir, nil
+}
+
+func writeGitFile(path string, f *object.File) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	r, err := f.Reader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, r)
+	return err
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestExportRef(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diligent-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"app/package.json", "app/src/index.js", "app/vendor/lib/LICENSE", "docs/guide.md"} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := wt.Add(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sig := &object.Signature{Name: "a", Email: "a@example.com", When: time.Now()}
+	if _, err := wt.Commit("initial", &git.CommitOptions{Author: sig}); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		d        string
+		path     string
+		keep     func(string) bool
+		expected []string
+	}{
+		{"all files", dir, func(string) bool { return true }, []string{"app/package.json", "app/src/index.js", "app/vendor/lib/LICENSE", "docs/guide.md"}},
+		{"files within path", filepath.Join(dir, "app"), func(string) bool { return true }, []string{"app/package.json", "app/src/index.js", "app/vendor/lib/LICENSE"}},
+		{"kept files", dir, func(name string) bool { return !strings.HasSuffix(name, ".js") }, []string{"app/package.json", "app/vendor/lib/LICENSE", "docs/guide.md"}},
+		{"path not committed", filepath.Join(dir, "missing"), func(string) bool { return true }, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			exported, tmpDir, err := exportRef(c.path, "HEAD", c.keep)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+			if _, err := os.Stat(exported); err != nil {
+				t.Errorf("expected the exported path to exist: %v", err)
+			}
+			var got []string
+			err = filepath.Walk(tmpDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				rel, err := filepath.Rel(tmpDir, path)
+				got = append(got, filepath.ToSlash(rel))
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsScannedFile(t *testing.T) {
+	buildDepers()
+	cases := []struct {
+		name     string
+		expected bool
+	}{
+		{"package.json", true},
+		{"app/package.json", true},
+		{"vendor/modules.txt", true},
+		{"src/App.csproj", true},
+		{"node_modules/lib/LICENSE.md", true},
+		{"vendor/lib/Copying", true},
+		{"vendor/lib/README", true},
+		{"src/index.js", false},
+		{"docs/guide.md", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isScannedFile(c.name); got != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
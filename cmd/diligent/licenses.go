@@ -0,0 +1,224 @@
+package main
+
+import (
+	encJSON "encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/senseyeio/diligent"
+	"github.com/spf13/cobra"
+)
+
+var (
+	licensesFormat   string
+	licensesCategory string
+	licensesType     string
+	licensesOwner    string
+)
+
+// licenseJSON is the JSON representation of a diligent.License written by the licenses command
+type licenseJSON struct {
+	Identifier          string                `json:"identifier"`
+	Name                string                `json:"name"`
+	ShortName           string                `json:"shortName"`
+	Category            diligent.Category     `json:"category"`
+	CategoryDescription string                `json:"categoryDescription,omitempty"`
+	Type                diligent.Type         `json:"type,omitempty"`
+	Owner               string                `json:"owner,omitempty"`
+	OwnerURL            string                `json:"ownerUrl,omitempty"`
+	OwnerType           diligent.OwnerType    `json:"ownerType,omitempty"`
+	URL                 string                `json:"url,omitempty"`
+	OSIApproved         bool                  `json:"osiApproved"`
+	FSFLibre            bool                  `json:"fsfLibre"`
+	Deprecated          bool                  `json:"deprecated"`
+	ReplacedBy          string                `json:"replacedBy,omitempty"`
+	Obligations         []diligent.Obligation `json:"obligations,omitempty"`
+}
+
+func toLicenseJSON(l diligent.License) licenseJSON {
+	obligations, _ := diligent.GetLicenseObligations(l)
+	return licenseJSON{
+		Identifier:          l.Identifier,
+		Name:                l.Name,
+		ShortName:           l.ShortName,
+		Category:            l.Category,
+		CategoryDescription: l.Category.Description(),
+		Type:                l.Type,
+		Owner:               l.Owner,
+		OwnerURL:            l.OwnerURL,
+		OwnerType:           l.OwnerType,
+		URL:                 l.URL,
+		OSIApproved:         l.OSIApproved,
+		FSFLibre:            l.FSFLibre,
+		Deprecated:          l.Deprecated,
+		ReplacedBy:          l.ReplacedBy,
+		Obligations:         obligations,
+	}
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := encJSON.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// checkLicensesFormat exits if --format is neither text nor json
+func checkLicensesFormat() {
+	if licensesFormat != "text" && licensesFormat != "json" {
+		fatal(70, fmt.Sprintf("unknown licenses format '%s', expected one of text or json", licensesFormat))
+	}
+}
+
+// matchesLicense returns true if the license matches the query and the filters provided by flags. The query and
+// owner are matched case insensitively against part of the text
+func matchesLicense(l diligent.License, query string) bool {
+	contains := func(s, substr string) bool {
+		return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+	}
+	if query != "" && !contains(l.Identifier, query) && !contains(l.Name, query) && !contains(l.ShortName, query) {
+		return false
+	}
+	if licensesCategory != "" && licensesCategory != string(diligent.All) && string(l.Category) != licensesCategory {
+		return false
+	}
+	if licensesType != "" && string(l.Type) != licensesType {
+		return false
+	}
+	return licensesOwner == "" || contains(l.Owner, licensesOwner)
+}
+
+// licensesCmd represents the licenses command
+var licensesCmd = &cobra.Command{
+	Use:   "licenses [query]",
+	Short: "Searches the licenses known to diligent",
+	Long: `Calling licenses will list the licenses known to diligent, including custom licenses and categories defined
+within the configuration file. The query is matched against the identifier, name and short name of each license, and the
+results can be narrowed by category, type and owner.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		checkLicensesFormat()
+		if licensesCategory != "" && !diligent.IsCategory(licensesCategory) {
+			fatal(70, fmt.Sprintf("'%s' is not a license category, expecting one of %v", licensesCategory, diligent.GetCategories()))
+		}
+		if licensesType != "" && licensesType != string(diligent.OpenSource) && licensesType != string(diligent.Proprietary) {
+			fatal(70, fmt.Sprintf("'%s' is not a license type, expecting '%s' or '%s'", licensesType, diligent.OpenSource, diligent.Proprietary))
+		}
+		query := ""
+		if len(args) > 0 {
+			query = args[0]
+		}
+		var matches []diligent.License
+		for _, l := range diligent.GetLicenses() {
+			if matchesLicense(l, query) {
+				matches = append(matches, l)
+			}
+		}
+
+		if licensesFormat == "json" {
+			out := make([]licenseJSON, 0, len(matches))
+			for _, l := range matches {
+				out = append(out, toLicenseJSON(l))
+			}
+			if err := writeJSON(os.Stdout, out); err != nil {
+				fatal(65, err.Error())
+			}
+			return
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 5, 0, 2, ' ', 0)
+		for _, l := range matches {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", l.Identifier, l.Name, l.Category)
+		}
+		if err := tw.Flush(); err != nil {
+			fatal(65, err.Error())
+		}
+	},
+}
+
+// licensesShowCmd represents the licenses show command
+var licensesShowCmd = &cobra.Command{
+	Use:   "show [identifier]",
+	Short: "Details a license known to diligent",
+	Long: `Calling licenses show will print every detail diligent holds about a license, including its URL, an
+explanation of its category and the obligations it triggers.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		checkLicensesFormat()
+		l, err := diligent.GetLicenseFromIdentifier(args[0])
+		if err != nil {
+			fatal(70, err.Error())
+		}
+		if licensesFormat == "json" {
+			if err := writeJSON(os.Stdout, toLicenseJSON(l)); err != nil {
+				fatal(65, err.Error())
+			}
+			return
+		}
+		if err := writeLicense(os.Stdout, l); err != nil {
+			fatal(65, err.Error())
+		}
+	},
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func writeLicense(w io.Writer, l diligent.License) error {
+	deprecated := yesNo(l.Deprecated)
+	if l.Deprecated && l.ReplacedBy != "" {
+		deprecated += ", replaced by " + l.ReplacedBy
+	}
+	obligations := "unknown"
+	if oo, ok := diligent.GetLicenseObligations(l); ok {
+		names := make([]string, len(oo))
+		for i, o := range oo {
+			names[i] = string(o)
+		}
+		obligations = strings.Join(names, ", ")
+		if obligations == "" {
+			obligations = "none"
+		}
+	}
+	tw := tabwriter.NewWriter(w, 5, 0, 2, ' ', 0)
+	for _, f := range [][2]string{
+		{"Identifier", l.Identifier},
+		{"Name", l.Name},
+		{"Short name", l.ShortName},
+		{"Category", string(l.Category)},
+		{"", l.Category.Description()},
+		{"Type", string(l.Type)},
+		{"Owner", l.Owner},
+		{"Owner URL", l.OwnerURL},
+		{"Owner type", string(l.OwnerType)},
+		{"URL", l.URL},
+		{"OSI approved", yesNo(l.OSIApproved)},
+		{"FSF libre", yesNo(l.FSFLibre)},
+		{"Deprecated", deprecated},
+		{"Obligations", obligations},
+	} {
+		label := f[0]
+		if label != "" {
+			label += ":"
+		}
+		if label == "" && f[1] == "" {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", label, f[1])
+	}
+	return tw.Flush()
+}
+
+func init() {
+	RootCmd.AddCommand(licensesCmd)
+	licensesCmd.AddCommand(licensesShowCmd)
+	licensesCmd.PersistentFlags().StringVarP(&licensesFormat, "format", "", "text", "Format of the output: text or json")
+	licensesCmd.Flags().StringVarP(&licensesCategory, "category", "", "", "Only list licenses within the category, for example 'permissive' or a category defined within the configuration file")
+	licensesCmd.Flags().StringVarP(&licensesType, "type", "", "", "Only list licenses of the type, either 'open source' or 'proprietary'")
+	licensesCmd.Flags().StringVarP(&licensesOwner, "owner", "", "", "Only list licenses whose owner contains the text, ignoring case")
+}
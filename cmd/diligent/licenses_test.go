@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestMatchesLicense(t *testing.T) {
+	mit, err := diligent.GetLicenseFromIdentifier("MIT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		d                    string
+		query                string
+		category, typ, owner string
+		expected             bool
+	}{
+		{"no query or filters", "", "", "", "", true},
+		{"identifier", "mit", "", "", "", true},
+		{"part of name", "mit lic", "", "", "", true},
+		{"query not matched", "apache", "", "", "", false},
+		{"category", "", "permissive", "", "", true},
+		{"all category", "", "all", "", "", true},
+		{"other category", "", "copyleft", "", "", false},
+		{"type", "", "", "open source", "", true},
+		{"other type", "", "", "proprietary", "", false},
+		{"part of owner", "", "", "", "mi", true},
+		{"other owner", "", "", "", "apache", false},
+		{"query and filters", "MIT", "permissive", "open source", "MIT", true},
+	}
+	defer func() { licensesCategory, licensesType, licensesOwner = "", "", "" }()
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			licensesCategory, licensesType, licensesOwner = c.category, c.typ, c.owner
+			if got := matchesLicense(mit, c.query); got != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestToLicenseJSON(t *testing.T) {
+	cases := []struct {
+		d        string
+		id       string
+		expected map[string]interface{}
+	}{
+		{"known license", "MIT", map[string]interface{}{
+			"identifier":          "MIT",
+			"name":                "MIT License",
+			"shortName":           "MIT License",
+			"category":            "permissive",
+			"categoryDescription": diligent.Permissive.Description(),
+			"type":                "open source",
+			"owner":               "MIT",
+			"ownerUrl":            "http://web.mit.edu/aboutmit/",
+			"ownerType":           "organization",
+			"url":                 "http://opensource.org/licenses/mit-license.php",
+			"osiApproved":         true,
+			"fsfLibre":            true,
+			"deprecated":          false,
+			"obligations":         []interface{}{"include-license", "retain-copyright"},
+		}},
+		{"uncategorised license omits empty fields", "CERN-OHL-1.1", map[string]interface{}{
+			"identifier":          "CERN-OHL-1.1",
+			"name":                "CERN Open Hardware Licence v1.1",
+			"shortName":           "CERN-OHL-1.1",
+			"category":            "uncategorised",
+			"categoryDescription": diligent.Uncategorised.Description(),
+			"url":                 "https://www.ohwr.org/project/licenses/wikis/cern-ohl-v1.1",
+			"osiApproved":         false,
+			"fsfLibre":            false,
+			"deprecated":          false,
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			l, err := diligent.GetLicenseFromIdentifier(c.id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			if err := writeJSON(&buf, toLicenseJSON(l)); err != nil {
+				t.Fatal(err)
+			}
+			var got map[string]interface{}
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
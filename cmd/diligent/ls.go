@@ -1,7 +1,6 @@
 package main
 
 import (
-	"github.com/senseyeio/diligent"
 	"github.com/spf13/cobra"
 )
 
@@ -12,7 +11,9 @@ var lsCmd = &cobra.Command{
 	Long:  `Calling ls will list the licenses associated with your dependencies.`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		licenseWhitelist = diligent.GetLicenseIdentifiers()
+		buildListingPolicy()
+		// the distribution model may be set within the config file, but listing must not fail on compatibility
+		distribution = ""
 		run(args)
 	},
 }
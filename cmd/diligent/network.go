@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"os"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/httpclient"
+	"github.com/spf13/cobra"
+)
+
+var (
+	proxyURL        string
+	caFilename      string
+	netrcFilename   string
+	hostCredentials map[string]httpclient.Credentials
+)
+
+func applyNetworkFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&proxyURL, "proxy", "", "", "URL of the proxy through which requests are sent. By default the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used")
+	cmd.PersistentFlags().StringVarP(&caFilename, "ca-file", "", "", "PEM encoded certificates to trust in addition to the system's certificates, for example those of a TLS inspecting proxy")
+	cmd.PersistentFlags().StringVarP(&netrcFilename, "netrc", "", "", "File from which host credentials are read. By default $NETRC or ~/.netrc is used if it exists")
+}
+
+// netrcCredentials reads the credentials within the .netrc file. A missing file is only an error if it was provided
+// using --netrc
+func netrcCredentials() map[string]httpclient.Credentials {
+	path := netrcFilename
+	if path == "" {
+		path = httpclient.NetrcPath()
+		if _, err := os.Stat(path); err != nil {
+			return nil
+		}
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		fatal(66, err.Error())
+	}
+	defer file.Close()
+	creds, err := httpclient.ParseNetrc(file)
+	if err != nil {
+		fatal(66, err.Error())
+	}
+	return creds
+}
+
+// buildHTTPClient creates the client shared by the Depers and license getters, which is also used to clone
+// repositories. Credentials within the config file take precedence over those within the .netrc file
+func buildHTTPClient() *http.Client {
+	creds := netrcCredentials()
+	if creds == nil {
+		creds = map[string]httpclient.Credentials{}
+	}
+	for host, c := range hostCredentials {
+		creds[host] = c
+	}
+	client, err := httpclient.New(httpclient.Config{
+		Proxy:       proxyURL,
+		CAFile:      caFilename,
+		Credentials: creds,
+	})
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		fatal(66, err.Error())
+	}
+	if err != nil {
+		fatal(70, err.Error())
+	}
+	diligent.SetGitHTTPClient(client)
+	return client
+}
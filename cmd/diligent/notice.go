@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/senseyeio/diligent/notice"
+	"github.com/spf13/cobra"
+)
+
+const defaultNoticeFilename = "THIRD_PARTY_NOTICES"
+
+var (
+	noticeFormat   string
+	noticeFilename string
+)
+
+// noticeExtensions are appended to the default notice filename
+var noticeExtensions = map[notice.Format]string{
+	notice.Text:     "",
+	notice.Markdown: ".md",
+	notice.HTML:     ".html",
+}
+
+// noticeCmd represents the notice command
+var noticeCmd = &cobra.Command{
+	Use:   "notice [path]",
+	Short: "Generates a third party notices file providing the attribution required by your dependencies' licenses",
+	Long: `Calling notice will write a THIRD_PARTY_NOTICES file listing your dependencies grouped by license, along with
+the copyright lines, LICENSE and NOTICE files found within each installed package. When a package's license text cannot
+be found, the canonical SPDX text of its license is included instead.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		format, err := notice.ParseFormat(noticeFormat)
+		if err != nil {
+			fatal(70, err.Error())
+		}
+		if !cmd.Flags().Changed("out") {
+			noticeFilename = defaultNoticeFilename + noticeExtensions[format]
+		}
+
+		buildListingPolicy()
+		res := mustScan(args[0])
+		n := notice.New(res.Deps, notice.NewDirLocator(manifestDirs(res.Manifests)))
+		for _, name := range n.MissingTexts() {
+			warning(fmt.Sprintf("no license text found for '%s'", name))
+		}
+
+		if err := writeNotice(n, format); err != nil {
+			fatal(65, err.Error())
+		}
+	},
+}
+
+// manifestDirs returns the directories containing the manifests, in which dependencies may be installed
+func manifestDirs(manifests []string) []string {
+	dirs := make([]string, 0)
+	found := map[string]bool{}
+	for _, f := range manifests {
+		dir := filepath.Dir(f)
+		if !found[dir] {
+			dirs = append(dirs, dir)
+			found[dir] = true
+		}
+	}
+	return dirs
+}
+
+func writeNotice(n notice.Notice, format notice.Format) error {
+	var w io.Writer = os.Stdout
+	if noticeFilename != "-" {
+		file, err := os.Create(noticeFilename)
+		if err != nil {
+			return fmt.Errorf("unable to open file '%s' for writing. %v", noticeFilename, err)
+		}
+		defer file.Close()
+		w = file
+	}
+	return n.Write(w, format)
+}
+
+func init() {
+	RootCmd.AddCommand(noticeCmd)
+	applyScopeFlags(noticeCmd)
+	noticeCmd.Flags().StringSliceVarP(&pkgIgnore, "ignore", "i", nil, "Ignore certain packages. Ignored packages will not be included within the notice. Regular expressions can be used.")
+	applyWalkFlags(noticeCmd)
+	noticeCmd.Flags().StringVarP(&noticeFormat, "format", "", string(notice.Text), "Format of the notice: text, markdown or html")
+	noticeCmd.Flags().StringVarP(&noticeFilename, "out", "o", defaultNoticeFilename, "Filename to which the notice should be written, or - for stdout. By default THIRD_PARTY_NOTICES with an extension matching the format")
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/senseyeio/diligent/compat"
+	"github.com/senseyeio/diligent/obligations"
+	"github.com/spf13/cobra"
+)
+
+var obligationsFormat string
+
+// obligationsCmd represents the obligations command
+var obligationsCmd = &cobra.Command{
+	Use:   "obligations [path]",
+	Short: "Summarises the obligations triggered by your dependencies' licenses as a checklist",
+	Long: `Calling obligations will list the obligations of your dependencies' licenses, such as including the license
+text, disclosing source code or stating changes, along with the licenses and dependencies triggering each. When
+--distribution is provided, only the obligations triggered by that distribution model are listed.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		format, err := obligations.ParseFormat(obligationsFormat)
+		if err != nil {
+			fatal(70, err.Error())
+		}
+		if distribution != "" && !compat.IsDistribution(distribution) {
+			fatal(70, fmt.Sprintf("'%s' is not a distribution model, expecting one of %v", distribution, compat.Distributions()))
+		}
+
+		buildListingPolicy()
+		res := mustScan(args[0])
+		s := obligations.New(res.Deps, compat.Distribution(distribution))
+		if err := withOutputWriter(func(w io.Writer) error {
+			return s.Write(w, format)
+		}); err != nil {
+			fatal(65, err.Error())
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(obligationsCmd)
+	applyScopeFlags(obligationsCmd)
+	obligationsCmd.Flags().StringSliceVarP(&pkgIgnore, "ignore", "i", nil, "Ignore certain packages. Ignored packages will not be included within the summary. Regular expressions can be used.")
+	applyWalkFlags(obligationsCmd)
+	obligationsCmd.Flags().StringVarP(&obligationsFormat, "format", "", string(obligations.Text), "Format of the summary: text, markdown or json")
+	obligationsCmd.Flags().StringVarP(&outputFilename, "out", "o", "", "Filename to which the summary should be written. By default or when blank stdout is used")
+	obligationsCmd.Flags().StringVarP(&distribution, "distribution", "", "", "How the project reaches its users, from 'saas', 'binary' and 'internal'. By default the project is assumed to be distributed")
+}
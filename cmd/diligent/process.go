@@ -1,25 +1,29 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"os"
-	"sort"
-
-	"path/filepath"
+	"os/signal"
 
 	"fmt"
 	"io"
 
 	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/compat"
 	"github.com/senseyeio/diligent/csv"
+	"github.com/senseyeio/diligent/json"
 	"github.com/senseyeio/diligent/pretty"
+	"github.com/senseyeio/diligent/scan"
 )
 
-type toSortInterfacer func(deps []diligent.Dep) sort.Interface
-
 func getReporter() diligent.Reporter {
 	if csvOutput {
 		return csv.NewReporter()
 	}
+	if jsonOutput {
+		return json.NewReporter()
+	}
 
 	return pretty.NewReporter()
 }
@@ -41,92 +45,198 @@ func withOutputWriter(todo func(w io.Writer) error) error {
 	return todo(w)
 }
 
-func getFiles(args []string) []string {
-	path := args[0]
-	files := make([]string, 0)
-	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if info.Mode().IsRegular() {
-			files = append(files, path)
-		}
-		return nil
+// newScanner creates a Scanner configured by the flags and config file, which writes to the outputs
+func newScanner(outputs ...scan.Output) *scan.Scanner {
+	return scan.NewWithOptions(depers, scan.Config{
+		Ignore:            ignoreRegex,
+		Scopes:            selectedScopes(),
+		Policy:            licensePolicy,
+		SortByLicense:     sortByLicense,
+		Reporters:         outputs,
+		Trace:             traceOutput,
+		Exclude:           excludeGlobs,
+		MaxDepth:          maxDepth + 1,
+		Gitignore:         honourGitignore,
+		NoDefaultExcludes: noDefaultExcludes,
 	})
-	if err != nil {
-		fatal(66, err.Error())
-	}
-	return files
 }
 
-func run(args []string) {
-	files := getFiles(args)
-
-	deps := make([]diligent.Dep, 0)
-	warnings := make([]diligent.Warning, 0)
-	for _, f := range files {
-		deper, err := getDeper(f)
-		if err != nil {
-			continue
+// scanContext returns a context which is done once --timeout has elapsed or the process is interrupted, and which
+// limits each network request to --request-timeout. No network requests are made using it with --offline
+func scanContext() (context.Context, context.CancelFunc) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if scanTimeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), scanTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			cancel()
+		case <-ctx.Done():
 		}
-		fileBytes := mustReadFile(f)
-		d, w, err := deper.Dependencies(fileBytes)
-		if err != nil {
-			fatal(67, err.Error())
+		signal.Stop(interrupt)
+	}()
+	ctx = diligent.WithRequestTimeout(ctx, requestTimeout)
+	if offlineMode {
+		ctx = diligent.WithOffline(ctx)
+	}
+	return ctx, cancel
+}
+
+// resolve scans the path, exiting if it cannot be walked, a report cannot be written or a manifest cannot be processed.
+// If the scan times out or is interrupted, the partial results are returned
+func resolve(path string, outputs ...scan.Output) scan.Result {
+	ctx, cancel := scanContext()
+	defer cancel()
+	res, err := newScanner(outputs...).ScanContext(ctx, path)
+	switch err {
+	case context.DeadlineExceeded:
+		warning(fmt.Sprintf("scan timed out after %v, the results are incomplete", scanTimeout))
+		err = nil
+	case context.Canceled:
+		warning("scan was interrupted, the results are incomplete")
+		err = nil
+	}
+	var reportErr *scan.ReportError
+	if errors.As(err, &reportErr) {
+		fatal(65, reportErr.Err.Error())
+	}
+	if err != nil {
+		fatal(66, err.Error())
+	}
+	for _, e := range res.Errors {
+		var pathErr *os.PathError
+		if errors.As(e, &pathErr) {
+			fatal(66, e.Error())
 		}
-		deps = append(deps, d...)
-		warnings = append(warnings, w...)
+		fatal(67, e.Error())
 	}
-	deps, warnings = ignorePackages(deps, warnings)
+	return res
+}
 
-	for _, w := range warnings {
+// mustScan determines the licenses of the dependencies found within the path, checking them against the policy and
+// exiting if none could be determined
+func mustScan(path string, outputs ...scan.Output) scan.Result {
+	res := resolve(path, outputs...)
+	for _, w := range res.Warnings {
 		warning(w.Warning())
 	}
-	if len(deps) == 0 {
+	if len(res.Deps) == 0 {
 		fatal(67, "did not successfully process any dependencies - see warnings above for details")
 	}
+	return res
+}
 
-	deps = diligent.Deps(deps).Dedupe()
+// failingKinds returns the kinds of warning chosen via --fail-on-warning, or nil if every warning should fail
+func failingKinds() map[diligent.WarningKind]bool {
+	if len(failOnWarning) == 0 {
+		return nil
+	}
+	kinds := map[diligent.WarningKind]bool{}
+	for _, k := range failOnWarning {
+		if !diligent.IsWarningKind(k) {
+			fatal(70, fmt.Sprintf("'%s' is not a kind of warning, expecting one of %v", k, diligent.WarningKinds()))
+		}
+		kinds[diligent.WarningKind(k)] = true
+	}
+	return kinds
+}
 
-	sorter := getSort(sortByLicense)
-	sort.Sort(sorter(deps))
-	reporter := getReporter()
+// failingWarnings returns the warnings of the kinds, or every warning if kinds is nil. Warnings which do not
+// describe their kind only fail when every warning does
+func failingWarnings(warnings []diligent.Warning, kinds map[diligent.WarningKind]bool) []diligent.Warning {
+	if kinds == nil {
+		return warnings
+	}
+	out := make([]diligent.Warning, 0, len(warnings))
+	for _, w := range warnings {
+		if kinds[diligent.GetWarningDetails(w).Kind] {
+			out = append(out, w)
+		}
+	}
+	return out
+}
 
+func run(args []string) {
+	kinds := failingKinds()
+	checker := buildChecker(args[0])
+	var res scan.Result
 	err := withOutputWriter(func(w io.Writer) error {
-		return reporter.Report(w, deps)
+		res = mustScan(args[0], scan.Output{Reporter: getReporter(), Writer: w})
+		return nil
 	})
 
 	if err != nil {
 		fatal(65, err.Error())
 	}
 
-	if errs := validateDependencies(deps); len(errs) > 0 {
+	staleExceptions := licensePolicy.StaleExceptions(res.Deps)
+	for _, s := range staleExceptions {
+		warning(s)
+	}
+
+	deps, warnings := res.Deps, res.Warnings
+	compatDeps := res.Deps
+	var relicensed []error
+	if baselineFilename != "" {
+		res := mustReadBaseline(baselineFilename).Compare(deps, warnings)
+		reportFixed(res)
+		deps, warnings = res.NewViolations, res.NewUnresolved
+		compatDeps = res.NewDependencies
+		for _, c := range res.Relicensed {
+			relicensed = append(relicensed, fmt.Errorf("dependency '%s' has changed license from '%s' to '%s' since the baseline", c.Name, c.From, c.To))
+		}
+	}
+
+	violations, reviews := scan.Violations(deps)
+	errs := make([]error, 0, len(violations)+len(relicensed))
+	for _, v := range violations {
+		errs = append(errs, v)
+	}
+	errs = append(errs, relicensed...)
+	for _, r := range reviews {
+		warning(r.Error())
+	}
+	if len(errs) > 0 {
 		if len(errs) == 1 {
 			fatal(68, errs[0].Error())
 		}
 		for _, e := range errs {
 			warning(e.Error())
 		}
-		fatal(68, "multiple dependencies are not compliant with your whitelist")
+		fatal(68, "multiple dependencies are not compliant with your license policy")
 	}
 
-	if len(warnings) > 0 {
-		os.Exit(64)
+	var incompatible []compat.Finding
+	if checker != nil {
+		for _, f := range checker.Check(compatDeps) {
+			if f.Level == compat.Incompatible {
+				incompatible = append(incompatible, f)
+				continue
+			}
+			warning(f.Error())
+		}
+	}
+	if len(incompatible) > 0 {
+		if len(incompatible) == 1 {
+			fatal(73, incompatible[0].Error())
+		}
+		for _, f := range incompatible {
+			warning(f.Error())
+		}
+		fatal(73, "multiple dependencies are incompatible with the project license and distribution model")
 	}
-}
-
-func toLicenseSorter(deps []diligent.Dep) sort.Interface {
-	return diligent.DepsByLicense(deps)
-}
-
-func toNameSorter(deps []diligent.Dep) sort.Interface {
-	return diligent.DepsByName(deps)
-}
 
-func getSort(useLicenseSorting bool) toSortInterfacer {
-	if useLicenseSorting {
-		return toLicenseSorter
+	if len(reviews) > 0 {
+		os.Exit(72)
 	}
 
-	return toNameSorter
+	if len(failingWarnings(warnings, kinds)) > 0 || len(staleExceptions) > 0 {
+		os.Exit(64)
+	}
 }
@@ -2,29 +2,62 @@ package main
 
 import (
 	"regexp"
+	"time"
 
 	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
 	"github.com/spf13/cobra"
 )
 
 var (
 	licenseWhitelist []string
+	licenseReview    []string
+	licenseDeny      []string
+	licenseExcepts   []policy.Exception
+	licenseOverrides diligent.Overrides
+	licensePolicy    *policy.Policy
 	pkgIgnore        []string
 	ignoreRegex      []*regexp.Regexp
 	npmDevDeps       bool
 	sortByLicense    bool
 	csvOutput        bool
+	jsonOutput       bool
+	traceOutput      bool
 	outputFilename   string
+	failOnWarning    []string
+	configFilename   string
 )
 
+var (
+	licenseListFiles  []string
+	customLicenses    []diligent.License
+	customCategories  []string
+	licenseCategories map[string]string
+)
+
+var (
+	scanTimeout    time.Duration
+	requestTimeout = defaultRequestTimeout
+	offlineMode    bool
+)
+
+var (
+	excludeGlobs      []string
+	maxDepth          = -1
+	honourGitignore   bool
+	noDefaultExcludes bool
+)
+
+// defaultRequestTimeout prevents a single unresponsive registry from stalling a scan indefinitely
+const defaultRequestTimeout = 2 * time.Minute
+
 var RootCmd = &cobra.Command{
 	Short: "Get the licenses associated with your software dependencies",
 	Long:  `Diligent is a CLI tool which determines the licenses associated with your software dependencies`,
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		licenseWhitelist = diligent.ReplaceCategoriesWithIdentifiers(licenseWhitelist)
-		if err := checkWhitelist(); err != nil {
-			fatal(70, err.Error())
-		}
+		loadConfig(cmd, args)
+		buildPolicy()
+		buildDepers()
 		ignoreRegex = make([]*regexp.Regexp, len(pkgIgnore))
 		for idx, i := range pkgIgnore {
 			r, err := regexp.Compile(i)
@@ -38,16 +71,36 @@ var RootCmd = &cobra.Command{
 
 func init() {
 	cobra.OnInitialize()
+	RootCmd.PersistentFlags().DurationVarP(&scanTimeout, "timeout", "", 0, "Maximum duration of the scan, for example 10m. Once reached, the licenses determined so far are reported and the remaining packages are reported as warnings. By default there is no limit")
+	RootCmd.PersistentFlags().DurationVarP(&requestTimeout, "request-timeout", "", defaultRequestTimeout, "Maximum duration of each network request, including git clones and go get. Zero disables the limit")
+	RootCmd.PersistentFlags().BoolVarP(&offlineMode, "offline", "", false, "Prevents all network requests, determining licenses from lockfiles, node_modules, vendor directories and the Go module cache only. Licenses which are not available locally are reported as warnings")
+	RootCmd.PersistentFlags().StringSliceVarP(&licenseListFiles, "license-list", "", nil, "SPDX licenses.json or exceptions.json files, as published at https://github.com/spdx/license-list-data, which are merged with the license list bundled within diligent. Useful for identifiers added to the SPDX list since diligent was released")
+	applyNetworkFlags(RootCmd)
+	applyRegistryFlags(RootCmd)
+	RootCmd.PersistentFlags().StringVarP(&configFilename, "config", "", "", "Config file from which settings should be read. By default .diligent.yml, .diligent.yaml or .diligent.json is used if found within the scanned directory or the working directory. Flags take precedence over the config file")
 }
 
 func applyCommonFlags(cmd *cobra.Command) {
-	cmd.Flags().BoolVarP(&npmDevDeps, "npm-dev-deps", "", false, "[NPM] Include developer dependencies")
+	applyScopeFlags(cmd)
 	cmd.Flags().BoolVarP(&csvOutput, "csv", "", false, "Writes the output as comma separated values")
+	cmd.Flags().BoolVarP(&jsonOutput, "json", "", false, "Writes the output as JSON, which can be compared using the diff command")
+	cmd.Flags().BoolVarP(&traceOutput, "trace", "", false, "Includes the steps taken to determine each license within JSON output. See also the explain command")
 	cmd.Flags().BoolVarP(&sortByLicense, "license", "l", false, "Sorts output by license")
 	cmd.Flags().StringVarP(&outputFilename, "out", "o", "", "Filename to which output should be written. By default or when blank stdout is used")
 	cmd.Flags().StringSliceVarP(&pkgIgnore, "ignore", "i", nil, "Ignore certain packages. Ignored packages will not be reported on or validated against your whitelist. Regular expressions can be used.")
+	cmd.Flags().StringSliceVarP(&failOnWarning, "fail-on-warning", "", nil, "Kinds of warning which cause exit code 64, from 'network-failure', 'not-found', 'no-license-metadata', 'unknown-identifier', 'low-confidence' and 'parse-error'. By default every warning does")
+	applyWalkFlags(cmd)
+}
+
+func applyWalkFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(&excludeGlobs, "exclude", "", nil, "Skip files and directories matching a glob, for example 'examples/*', 'examples/**/package.json' or '*.csproj'. Globs are matched against the path relative to the scanned directory and against the file or directory name, and ** matches any number of directories.")
+	cmd.Flags().IntVarP(&maxDepth, "max-depth", "", -1, "Maximum depth of directories to scan, where 0 only scans files within the scanned directory. By default there is no limit")
+	cmd.Flags().BoolVarP(&honourGitignore, "gitignore", "", false, "Skip files and directories ignored by .gitignore files within the scanned directory")
+	cmd.Flags().BoolVarP(&noDefaultExcludes, "no-default-excludes", "", false, "Scan node_modules, .git, testdata and fixtures directories, and the subdirectories of vendor directories, which are skipped by default")
 }
 
 func applyWhitelistFlag(cmd *cobra.Command) {
-	cmd.Flags().StringSliceVarP(&licenseWhitelist, "whitelist", "w", nil, "Specify licenses compatible with your software. If licenses are found which are not in your whitelist, the command will return with a non zero exit code. Whitelisting license identifiers or categories of licenses is possible, the following categories are supported: 'all', 'permissive', 'copyleft', 'copyleft-limited', 'free-restricted', 'proprietary-free', 'public-domain'. See the readme for more details.")
+	cmd.Flags().StringSliceVarP(&licenseDeny, "deny", "", nil, "Specify licenses which are incompatible with your software. Dependencies with denied licenses cause the command to return with a non zero exit code. Identifiers and categories are supported, as per the whitelist. Identifiers take precedence over categories, so it is possible to whitelist a category but deny one of its licenses.")
+	cmd.Flags().StringSliceVarP(&licenseReview, "review", "", nil, "Specify licenses which require review before use. Dependencies with these licenses are reported separately and cause the command to return with exit code 72, unless a license is denied or not whitelisted. Identifiers and categories are supported, as per the whitelist.")
+	cmd.Flags().StringSliceVarP(&licenseWhitelist, "whitelist", "w", nil, "Specify licenses compatible with your software. If licenses are found which are not in your whitelist, the command will return with a non zero exit code. Whitelisting license identifiers or categories of licenses is possible, the following categories are supported: 'all', 'permissive', 'copyleft', 'copyleft-limited', 'free-restricted', 'proprietary-free', 'public-domain', along with categories defined within the configuration file. See the readme for more details.")
 }
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/senseyeio/diligent"
+	"github.com/spf13/cobra"
+)
+
+// allScopes can be passed to --scope to select dependencies of every scope
+const allScopes = "all"
+
+var scopeNames []string
+
+func applyScopeFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&npmDevDeps, "npm-dev-deps", "", false, "[NPM] Include developer dependencies. Equivalent to adding dev to --scope")
+	cmd.Flags().StringSliceVarP(&scopeNames, "scope", "", []string{string(diligent.Production)}, "Scopes of dependencies to report on and validate, prod, dev, optional or peer. Use all to select every scope")
+}
+
+// selectedScopes returns the scopes chosen via --scope, or nil if every scope is selected
+func selectedScopes() diligent.Scopes {
+	scopes := make(diligent.Scopes, 0, len(scopeNames)+1)
+	all := false
+	for _, s := range scopeNames {
+		if s == allScopes {
+			all = true
+			continue
+		}
+		if !diligent.IsScope(s) {
+			fatal(70, fmt.Sprintf("'%s' is not a scope, expecting one of %v or %s", s, diligent.KnownScopes(), allScopes))
+		}
+		scopes = append(scopes, diligent.Scope(s))
+	}
+	if all {
+		return nil
+	}
+	if npmDevDeps {
+		scopes = append(scopes, diligent.Development)
+	}
+	return scopes
+}
+
+// includesScope returns true if dependencies of the scope should be gathered
+func includesScope(s diligent.Scope) bool {
+	scopes := selectedScopes()
+	return scopes == nil || scopes.Includes(s)
+}
@@ -1,67 +1,66 @@
 package main
 
 import (
-	"fmt"
+	"sort"
 
 	"github.com/senseyeio/diligent"
-	warnpkg "github.com/senseyeio/diligent/warning"
+	"github.com/senseyeio/diligent/policy"
 )
 
-func isInWhitelist(l diligent.License) bool {
-	for _, w := range licenseWhitelist {
-		if w == l.Identifier {
-			return true
+// loadLicenses merges the SPDX license lists provided with --license-list, then applies the custom categories, custom
+// licenses and category reassignments defined within the config file, which must happen before identifiers are resolved by overrides or the policy
+func loadLicenses() {
+	for _, path := range licenseListFiles {
+		if err := diligent.LoadSPDXList(path); err != nil {
+			fatal(66, err.Error())
 		}
 	}
-	return false
-}
-
-func checkWhitelist() error {
-	for _, w := range licenseWhitelist {
-		_, err := diligent.GetLicenseFromIdentifier(w)
-		if err != nil {
-			return fmt.Errorf("whitelisted license '%s' is not a known license identifier", w)
+	for _, c := range customCategories {
+		if err := diligent.RegisterCategory(diligent.Category(c)); err != nil {
+			fatal(70, err.Error())
 		}
 	}
-	return nil
-}
-
-func isIgnored(pkgName string) bool {
-	for _, i := range ignoreRegex {
-		if i.MatchString(pkgName) {
-			return true
+	for _, l := range customLicenses {
+		if err := diligent.RegisterLicense(l); err != nil {
+			fatal(70, err.Error())
+		}
+	}
+	ids := make([]string, 0, len(licenseCategories))
+	for id := range licenseCategories {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if err := diligent.SetLicenseCategory(id, diligent.Category(licenseCategories[id])); err != nil {
+			fatal(70, err.Error())
 		}
 	}
-	return false
 }
 
-func ignorePackages(dd []diligent.Dep, ww []diligent.Warning) ([]diligent.Dep, []diligent.Warning) {
-	ddOut := make([]diligent.Dep, 0, len(dd))
-	for _, d := range dd {
-		if !isIgnored(d.Name) {
-			ddOut = append(ddOut, d)
-		}
+func buildPolicy() {
+	p, err := policy.New(licenseWhitelist, licenseReview, licenseDeny)
+	if err != nil {
+		fatal(70, err.Error())
 	}
-	wwOut := make([]diligent.Warning, 0, len(ww))
-	for _, w := range ww {
-		warn, ok := w.(*warnpkg.Warn)
-		if !ok {
-			wwOut = append(wwOut, w)
-			continue
-		}
-		if !isIgnored(warn.Dep) {
-			wwOut = append(wwOut, w)
-		}
+	if err := p.AddExceptions(licenseExcepts); err != nil {
+		fatal(70, err.Error())
 	}
-	return ddOut, wwOut
+	licensePolicy = p
+}
+
+// buildListingPolicy allows every license, ignoring the review and deny tiers and the exceptions within the config
+// file, as commands which list dependencies must not fail because of the license policy
+func buildListingPolicy() {
+	licenseWhitelist = diligent.GetLicenseIdentifiers()
+	licenseReview, licenseDeny, licenseExcepts = nil, nil, nil
+	buildPolicy()
 }
 
-func validateDependencies(deps []diligent.Dep) []error {
-	ee := make([]error, 0, len(deps))
-	for _, d := range deps {
-		if isInWhitelist(d.License) == false {
-			ee = append(ee, fmt.Errorf("dependency '%s' has license '%s' which is not in your license whitelist", d.Name, d.License.Identifier))
+func isIgnored(pkgName string) bool {
+	for _, i := range ignoreRegex {
+		if i.MatchString(pkgName) {
+			return true
 		}
 	}
-	return ee
+	return false
 }
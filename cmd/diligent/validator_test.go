@@ -0,0 +1,34 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+	"github.com/senseyeio/diligent/scan"
+)
+
+func TestBuildListingPolicy(t *testing.T) {
+	licenseWhitelist = []string{"MIT"}
+	licenseReview = []string{"permissive"}
+	licenseDeny = []string{"GPL-3.0"}
+	licenseExcepts = []policy.Exception{{Package: "b/gpl", Reason: "r", Approver: "a", Expires: time.Now()}}
+	buildListingPolicy()
+
+	var deps []diligent.Dep
+	for name, id := range map[string]string{"a/mit": "MIT", "b/gpl": "GPL-3.0-only", "c/gpl": "GPL-2.0-only WITH Classpath-exception-2.0"} {
+		l, err := diligent.GetLicenseFromIdentifier(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		deps = append(deps, diligent.Dep{Name: name, License: l})
+	}
+	violations, reviews := scan.Violations(licensePolicy.Check(deps))
+	if len(violations) != 0 || len(reviews) != 0 {
+		t.Errorf("expected listing to ignore the policy, got %v and %v", violations, reviews)
+	}
+	if stale := licensePolicy.StaleExceptions(deps); len(stale) != 0 {
+		t.Errorf("expected no exceptions, got %v", stale)
+	}
+}
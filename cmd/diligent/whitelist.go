@@ -17,7 +17,7 @@ validate your are whitelisting just the licenses you are interested in`,
 	Run: func(cmd *cobra.Command, args []string) {
 		all := diligent.GetLicenses()
 		for _, l := range all {
-			if isInWhitelist(l) {
+			if licensePolicy.Status(l) == diligent.Allowed {
 				fmt.Println(l.Identifier)
 			}
 		}
@@ -0,0 +1,260 @@
+package cocoapods
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+	"gopkg.in/yaml.v2"
+)
+
+// WebLicenseGetter retrieves license information from an online source
+type WebLicenseGetter interface {
+	IsCompatibleURL(s string) bool
+	GetLicenseFromURL(s string) (diligent.License, error)
+}
+
+type podfileLock struct {
+	Pods            []interface{}                `yaml:"PODS"`
+	ExternalSources map[string]map[string]string `yaml:"EXTERNAL SOURCES"`
+	CheckoutOptions map[string]map[string]string `yaml:"CHECKOUT OPTIONS"`
+}
+
+type license string
+
+type podspec struct {
+	License *license `json:"license"`
+	Source  struct {
+		Git    string `json:"git"`
+		Tag    string `json:"tag"`
+		Commit string `json:"commit"`
+	} `json:"source"`
+}
+
+func (l *license) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch raw := raw.(type) {
+	case string:
+		*l = license(raw)
+	case map[string]interface{}:
+		if t, ok := raw["type"].(string); ok {
+			*l = license(t)
+		}
+	}
+	return nil
+}
+
+type cocoapods struct {
+	config   Config
+	specsURL string
+	webLG    WebLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
+	// Client sends requests to the registry, allowing proxies, certificate authorities and credentials to be
+	// configured. http.DefaultClient is used when nil
+	Client *http.Client
+}
+
+// New returns a Deper capable of handling CocoaPods Podfile.lock files.
+// Podspecs are retrieved from specsURL, which should be laid out like the CocoaPods Specs repo, for example
+// https://cdn.cocoapods.org or https://raw.githubusercontent.com/CocoaPods/Specs/master
+func New(specsURL string, webLG WebLicenseGetter) diligent.Deper {
+	return NewWithOptions(specsURL, webLG, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(specsURL string, webLG WebLicenseGetter, c Config) diligent.Deper {
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+	return &cocoapods{c, strings.TrimSuffix(specsURL, "/"), webLG}
+}
+
+// Name returns "cocoapods"
+func (c *cocoapods) Name() string {
+	return "cocoapods"
+}
+
+// Dependencies returns the licenses of the pods locked within Podfile.lock.
+// Pods installed from a local path are resolved relative to the working directory
+func (c *cocoapods) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return c.LocalDependencies(".", file)
+}
+
+// LocalDependencies returns the licenses of the pods locked within Podfile.lock, dir is used to resolve pods
+// installed from a local path
+func (c *cocoapods) LocalDependencies(dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return c.LocalDependenciesContext(context.Background(), dir, file)
+}
+
+// LocalDependenciesContext is identical to LocalDependencies but cancels the lookups once ctx is done
+func (c *cocoapods) LocalDependenciesContext(ctx context.Context, dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	var lock podfileLock
+	err := yaml.Unmarshal(file, &lock)
+	if err != nil {
+		return nil, nil, err
+	}
+	pods, err := lockedPods(lock.Pods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deps := make([]diligent.Dep, 0, len(pods))
+	warns := make([]diligent.Warning, 0, len(pods))
+	for pod, version := range pods {
+		if d, ok := c.config.Overrides.Dep(c.Name(), pod, version); ok {
+			deps = append(deps, d)
+			continue
+		}
+		var t diligent.Trace
+		l, err := c.getLicense(ctx, dir, lock, pod, version, &t)
+		if err != nil {
+			warns = append(warns, warning.FromError(c.Name(), pod, version, err, t))
+		} else {
+			deps = append(deps, diligent.Dep{
+				Name:    pod,
+				Version: version,
+				License: l,
+				Trace:   t,
+			})
+		}
+	}
+	return deps, warns, nil
+}
+
+// IsCompatible returns true if the filename is Podfile.lock
+func (c *cocoapods) IsCompatible(filename string) bool {
+	return filename == "Podfile.lock"
+}
+
+// lockedPods returns the version of each pod listed under PODS. Entries are either "Name (version)" or, when the pod
+// has dependencies, a map keyed by "Name (version)". Subspecs such as Firebase/Core are reported as their root pod
+func lockedPods(entries []interface{}) (map[string]string, error) {
+	pods := map[string]string{}
+	for _, entry := range entries {
+		var spec string
+		switch e := entry.(type) {
+		case string:
+			spec = e
+		case map[interface{}]interface{}:
+			for k := range e {
+				spec, _ = k.(string)
+			}
+		}
+		open := strings.Index(spec, " (")
+		if open < 0 || !strings.HasSuffix(spec, ")") {
+			return nil, fmt.Errorf("invalid pod '%v'", entry)
+		}
+		name := strings.SplitN(spec[:open], "/", 2)[0]
+		pods[name] = spec[open+2 : len(spec)-1]
+	}
+	return pods, nil
+}
+
+func (c *cocoapods) getLicense(ctx context.Context, dir string, lock podfileLock, pod, version string, t *diligent.Trace) (diligent.License, error) {
+	if checkout, ok := lock.CheckoutOptions[pod]; ok && checkout[":git"] != "" {
+		ref := checkout[":commit"]
+		if ref == "" {
+			ref = checkout[":tag"]
+		}
+		return c.getLicenseFromGit(ctx, checkout[":git"], ref, t)
+	}
+	if source, ok := lock.ExternalSources[pod]; ok && source[":path"] != "" {
+		path := source[":path"]
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		return diligent.TraceLicenseForDirectory(path, t)
+	}
+	return c.getLicenseFromSpecs(ctx, pod, version, t)
+}
+
+func (c *cocoapods) getLicenseFromGit(ctx context.Context, gitURL, ref string, t *diligent.Trace) (diligent.License, error) {
+	repoURL := strings.TrimSuffix(gitURL, ".git")
+	if c.webLG != nil && c.webLG.IsCompatibleURL(repoURL) {
+		if ref != "" {
+			l, err := diligent.GetLicenseFromURL(ctx, c.webLG, repoURL+"/tree/"+ref, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+		l, err := diligent.GetLicenseFromURL(ctx, c.webLG, repoURL, t)
+		if err == nil {
+			return l, nil
+		}
+	}
+	return diligent.TraceLicenseForGit(ctx, gitURL, t)
+}
+
+// podspecURL returns the location of a podspec within a Specs repo, which shards pods using their name's MD5 hash
+func (c *cocoapods) podspecURL(pod, version string) string {
+	hash := fmt.Sprintf("%x", md5.Sum([]byte(pod)))
+	return fmt.Sprintf("%s/Specs/%c/%c/%c/%s/%s/%s.podspec.json", c.specsURL, hash[0], hash[1], hash[2],
+		url.PathEscape(pod), url.PathEscape(version), url.PathEscape(pod))
+}
+
+func (c *cocoapods) getPodspec(ctx context.Context, specURL string) (podspec, error) {
+	var spec podspec
+	rctx, cancel, err := diligent.RequestContext(ctx)
+	if err != nil {
+		return spec, err
+	}
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, specURL, nil)
+	if err != nil {
+		return spec, err
+	}
+	resp, err := c.config.Client.Do(req.WithContext(rctx))
+	if err != nil {
+		return spec, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return spec, &diligent.StatusError{StatusCode: resp.StatusCode}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&spec); err != nil {
+		return spec, &diligent.MalformedError{Msg: "parsing podspec failed - invalid JSON"}
+	}
+	return spec, nil
+}
+
+func (c *cocoapods) getLicenseFromSpecs(ctx context.Context, pod, version string, t *diligent.Trace) (diligent.License, error) {
+	specURL := c.podspecURL(pod, version)
+	spec, err := c.getPodspec(ctx, specURL)
+	if err != nil {
+		t.Record("cocoapods specs", specURL, diligent.License{}, err)
+		return diligent.License{}, err
+	}
+
+	if spec.License != nil {
+		l, err := diligent.TraceLicenseFromIdentifier(string(*spec.License), "podspec license", specURL, t)
+		if err == nil {
+			return l, nil
+		}
+	}
+
+	if spec.Source.Git != "" {
+		ref := spec.Source.Commit
+		if ref == "" {
+			ref = spec.Source.Tag
+		}
+		return c.getLicenseFromGit(ctx, spec.Source.Git, ref, t)
+	}
+
+	return diligent.License{}, errors.New("no license information in podspec")
+}
@@ -0,0 +1,202 @@
+package cocoapods_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/cocoapods"
+)
+
+type mockWebLicenseGetter map[string]string
+
+func (m mockWebLicenseGetter) IsCompatibleURL(s string) bool {
+	return true
+}
+
+func (m mockWebLicenseGetter) GetLicenseFromURL(s string) (diligent.License, error) {
+	id, ok := m[s]
+	if !ok {
+		return diligent.License{}, errors.New("not mocked")
+	}
+	return diligent.GetLicenseFromIdentifier(id)
+}
+
+func TestName(t *testing.T) {
+	target := cocoapods.New("", nil)
+	if target.Name() != "cocoapods" {
+		t.Error("expected 'cocoapods'")
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out bool
+	}{
+		{"Podfile.lock", true},
+		{"Podfile", false},
+		{"Podfile.lock.old", false},
+		{"Package.resolved", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			target := cocoapods.New("", nil)
+			compatible := target.IsCompatible(tt.in)
+			if compatible != tt.out {
+				t.Errorf("got %v, want %v", compatible, tt.out)
+			}
+		})
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	specs := map[string]string{
+		"/Specs/d/a/2/Alamofire/5.4.0/Alamofire.podspec.json":   `{"name":"Alamofire","license":"MIT"}`,
+		"/Specs/0/3/5/Firebase/8.0.0/Firebase.podspec.json":     `{"name":"Firebase","license":{"type":"Apache 2.0","file":"LICENSE"}}`,
+		"/Specs/b/e/4/SwiftyJSON/5.0.0/SwiftyJSON.podspec.json": `{"name":"SwiftyJSON","license":{"type":"Custom"},"source":{"git":"https://github.com/SwiftyJSON/SwiftyJSON.git","tag":"5.0.0"}}`,
+	}
+	webLG := mockWebLicenseGetter{
+		"https://github.com/SwiftyJSON/SwiftyJSON/tree/5.0.0": "MIT",
+		"https://github.com/acme/MyPod/tree/abc123":           "BSD-3-Clause",
+	}
+	cases := []struct {
+		description string
+		in          []byte
+		depsOut     map[string]string
+		warnsOut    []diligent.WarningDetails
+		errOut      bool
+	}{{
+		"should resolve licenses from the specs repo",
+		[]byte(`PODS:
+  - Alamofire (5.4.0)
+  - Firebase/Core (8.0.0):
+    - Firebase/CoreOnly
+    - FirebaseAnalytics (= 8.0.0)
+  - Firebase/CoreOnly (8.0.0)
+  - SwiftyJSON (5.0.0)
+
+DEPENDENCIES:
+  - Alamofire
+  - Firebase/Core
+  - SwiftyJSON
+
+SPEC REPOS:
+  trunk:
+    - Alamofire
+    - Firebase
+    - SwiftyJSON
+
+COCOAPODS: 1.10.1
+`),
+		map[string]string{
+			"Alamofire@5.4.0":  "MIT",
+			"Firebase@8.0.0":   "Apache-2.0",
+			"SwiftyJSON@5.0.0": "MIT",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should resolve licenses of pods from git",
+		[]byte(`PODS:
+  - MyPod (1.0.0)
+
+EXTERNAL SOURCES:
+  MyPod:
+    :git: https://github.com/acme/MyPod.git
+
+CHECKOUT OPTIONS:
+  MyPod:
+    :commit: abc123
+    :git: https://github.com/acme/MyPod.git
+`),
+		map[string]string{
+			"MyPod@1.0.0": "BSD-3-Clause",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should warn if pods cannot be found",
+		[]byte(`PODS:
+  - Missing (1.0.0)
+`),
+		map[string]string{},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NotFound, Ecosystem: "cocoapods", Package: "Missing", Version: "1.0.0", Err: &diligent.StatusError{StatusCode: 404}},
+		},
+		false,
+	}, {
+		"should fail on invalid pods",
+		[]byte(`PODS:
+  - Missing
+`),
+		map[string]string{},
+		[]diligent.WarningDetails{},
+		true,
+	}, {
+		"parse failure",
+		[]byte(`PODS: [`),
+		map[string]string{},
+		[]diligent.WarningDetails{},
+		true,
+	}}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				spec, ok := specs[r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(spec))
+			}))
+			defer ts.Close()
+			target := cocoapods.New(ts.URL, webLG)
+			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
+			expectedDeps := make([]diligent.Dep, 0, len(tt.depsOut))
+			for depID, lID := range tt.depsOut {
+				l, _ := diligent.GetLicenseFromIdentifier(lID)
+				at := strings.LastIndex(depID, "@")
+				expectedDeps = append(expectedDeps, diligent.Dep{Name: depID[:at], Version: depID[at+1:], License: l})
+			}
+			if len(d) > 0 || len(expectedDeps) > 0 {
+				sort.Sort(diligent.DepsByName(d))
+				sort.Sort(diligent.DepsByName(expectedDeps))
+				if reflect.DeepEqual(d, expectedDeps) == false {
+					t.Errorf("deps: got %+v, want %+v", d, expectedDeps)
+				}
+			}
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
+				}
+			}
+			isErr := e != nil
+			if tt.errOut != isErr {
+				t.Errorf("error: got %v, want %v", isErr, tt.errOut)
+			}
+		})
+	}
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
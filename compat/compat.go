@@ -0,0 +1,197 @@
+// Package compat checks that the licenses of dependencies are compatible with the license of the project using them
+// and the way in which that project is distributed
+package compat
+
+import (
+	"fmt"
+
+	"github.com/senseyeio/diligent"
+)
+
+// Distribution describes how a project reaches its users, which determines the license obligations triggered
+type Distribution string
+
+const (
+	// SaaS projects are run as a network service for others, without the software itself being distributed
+	SaaS Distribution = "saas"
+	// Binary projects are distributed to others, for example as a binary, library or container image
+	Binary Distribution = "binary"
+	// Internal projects are only used within the organisation, and are neither distributed nor offered as a service
+	Internal Distribution = "internal"
+)
+
+var distributions = []Distribution{SaaS, Binary, Internal}
+
+// Distributions returns all the distribution models
+func Distributions() []Distribution {
+	return append([]Distribution(nil), distributions...)
+}
+
+// IsDistribution returns true if s is a distribution model
+func IsDistribution(s string) bool {
+	for _, d := range distributions {
+		if string(d) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Level describes whether a dependency may be used by the project
+type Level string
+
+const (
+	// Compatible dependencies may be used without further action
+	Compatible Level = "compatible"
+	// Obligation dependencies may be used, but trigger obligations which must be met by the project
+	Obligation Level = "obligation"
+	// Incompatible dependencies may not be used by the project as it is licensed and distributed
+	Incompatible Level = "incompatible"
+)
+
+// Finding explains why a dependency is incompatible with the project or triggers obligations
+type Finding struct {
+	Dep    diligent.Dep
+	Level  Level
+	Reason string
+}
+
+// Error implements error
+func (f Finding) Error() string {
+	return f.Reason
+}
+
+// networkCopyleft licenses extend their copyleft to software which users interact with over a network
+var networkCopyleft = map[string]bool{
+	"AGPL-1.0-only":     true,
+	"AGPL-1.0-or-later": true,
+	"AGPL-3.0-only":     true,
+	"AGPL-3.0-or-later": true,
+	"OSL-3.0":           true,
+	"RPL-1.1":           true,
+	"RPL-1.5":           true,
+}
+
+// compatibleProjects holds the project licenses, other than the license itself, under which a copyleft dependency
+// may be distributed
+var compatibleProjects = map[string][]string{
+	"GPL-2.0-only":      {"GPL-2.0-or-later"},
+	"GPL-2.0-or-later":  {"GPL-2.0-only", "GPL-3.0-only", "GPL-3.0-or-later", "AGPL-3.0-only", "AGPL-3.0-or-later"},
+	"GPL-3.0-only":      {"GPL-3.0-or-later", "AGPL-3.0-only", "AGPL-3.0-or-later"},
+	"GPL-3.0-or-later":  {"GPL-3.0-only", "AGPL-3.0-only", "AGPL-3.0-or-later"},
+	"AGPL-3.0-only":     {"AGPL-3.0-or-later", "GPL-3.0-only", "GPL-3.0-or-later"},
+	"AGPL-3.0-or-later": {"AGPL-3.0-only", "GPL-3.0-only", "GPL-3.0-or-later"},
+}
+
+var gpl3Incompatible = []string{"BSD-4-Clause", "CDDL-1.0", "CDDL-1.1", "CPL-1.0", "EPL-1.0", "MPL-1.1", "OpenSSL"}
+
+// incompatibleDeps holds the dependency licenses whose terms conflict with a project license, so they cannot be
+// distributed together regardless of the category of the dependency
+var incompatibleDeps = map[string][]string{
+	"GPL-2.0-only": append([]string{"Apache-2.0", "EUPL-1.1", "LGPL-3.0-only", "LGPL-3.0-or-later",
+		"GPL-3.0-only", "GPL-3.0-or-later", "AGPL-3.0-only", "AGPL-3.0-or-later"}, gpl3Incompatible...),
+	"GPL-3.0-only":      gpl3Incompatible,
+	"GPL-3.0-or-later":  gpl3Incompatible,
+	"AGPL-3.0-only":     gpl3Incompatible,
+	"AGPL-3.0-or-later": gpl3Incompatible,
+}
+
+func contains(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// current returns the current identifier of the license, ignoring any exception and deprecated identifier
+func current(l diligent.License) string {
+	id, _ := diligent.SplitException(l.Identifier)
+	if base, err := diligent.GetLicenseFromIdentifier(id); err == nil && base.Deprecated && base.ReplacedBy != "" {
+		return base.ReplacedBy
+	}
+	return id
+}
+
+// Checker assesses dependencies against the license and distribution model of a project
+type Checker struct {
+	project      diligent.License
+	distribution Distribution
+}
+
+// New returns a Checker for a project with the given license and distribution model. A project without a license,
+// identified by an empty License, is treated as proprietary
+func New(project diligent.License, distribution Distribution) (*Checker, error) {
+	if !IsDistribution(string(distribution)) {
+		return nil, fmt.Errorf("'%s' is not a distribution model, expecting one of %v", distribution, distributions)
+	}
+	return &Checker{project, distribution}, nil
+}
+
+func (c *Checker) describeProject() string {
+	if c.project.Identifier == "" {
+		return "the project has no open source license"
+	}
+	return fmt.Sprintf("the project is licensed under %s", c.project.Identifier)
+}
+
+func (c *Checker) compatibleCopyleft(dep string) bool {
+	return c.project.Identifier != "" && contains(compatibleProjects[dep], current(c.project))
+}
+
+// Assess returns whether a license may be used by the project, with an explanation if it may not or if it triggers
+// obligations
+func (c *Checker) Assess(l diligent.License) (Level, string) {
+	dep, project := current(l), current(c.project)
+	if c.distribution == Internal || (c.project.Identifier != "" && dep == project) {
+		return Compatible, ""
+	}
+	if c.distribution == SaaS {
+		if !networkCopyleft[dep] {
+			return Compatible, ""
+		}
+		if c.compatibleCopyleft(dep) {
+			return Obligation, fmt.Sprintf("%s requires the source of the service, including changes, to be offered to its users under %s", l.Identifier, l.Identifier)
+		}
+		return Incompatible, fmt.Sprintf("%s requires the source of a network service to be offered to its users under the same license, but %s", l.Identifier, c.describeProject())
+	}
+	if contains(incompatibleDeps[project], dep) {
+		return Incompatible, fmt.Sprintf("%s cannot be distributed as part of a project licensed under %s, as their terms conflict", l.Identifier, c.project.Identifier)
+	}
+	switch l.Category {
+	case diligent.CopyLeft:
+		if c.compatibleCopyleft(dep) {
+			return Obligation, fmt.Sprintf("%s requires the source of the distributed project to be made available under compatible terms", l.Identifier)
+		}
+		return Incompatible, fmt.Sprintf("%s requires the distributed project to be licensed under the same license, but %s", l.Identifier, c.describeProject())
+	case diligent.CopyLeftLimited:
+		return Obligation, fmt.Sprintf("%s requires the source of the dependency, including changes, to be made available under %s when distributed", l.Identifier, l.Identifier)
+	case diligent.FreeRestricted:
+		return Obligation, fmt.Sprintf("%s restricts the use or redistribution of the software, so its terms must permit how the project is distributed", l.Identifier)
+	case diligent.ProprietaryFree:
+		return Obligation, fmt.Sprintf("%s is a proprietary license whose terms and conditions apply when the software is redistributed", l.Identifier)
+	case diligent.Permissive, diligent.PublicDomain:
+		return Compatible, ""
+	case diligent.Uncategorised:
+		return Obligation, fmt.Sprintf("%s has not been categorised, so its compatibility with the distributed project must be reviewed", l.Identifier)
+	}
+	return Obligation, fmt.Sprintf("%s belongs to the '%s' category, so its compatibility with the distributed project must be reviewed", l.Identifier, l.Category)
+}
+
+// Check returns the dependencies which are incompatible with the project or trigger obligations. Dependencies covered
+// by an approved exception are not reported as incompatible
+func (c *Checker) Check(deps []diligent.Dep) []Finding {
+	var out []Finding
+	for _, d := range deps {
+		level, reason := c.Assess(d.License)
+		if level == Compatible {
+			continue
+		}
+		if level == Incompatible && d.Status == diligent.Excepted {
+			continue
+		}
+		out = append(out, Finding{d, level, fmt.Sprintf("dependency '%s': %s", d.Name, reason)})
+	}
+	return out
+}
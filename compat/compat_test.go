@@ -0,0 +1,97 @@
+package compat_test
+
+import (
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/compat"
+)
+
+func TestNew(t *testing.T) {
+	if _, err := compat.New(diligent.License{}, "woowoo"); err == nil {
+		t.Error("expected unknown distribution to fail")
+	}
+	if _, err := compat.New(diligent.License{}, compat.SaaS); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAssess(t *testing.T) {
+	cases := []struct {
+		d            string
+		project      string
+		distribution compat.Distribution
+		dep          string
+		level        compat.Level
+	}{
+		{"copyleft within internal tool", "", compat.Internal, "GPL-3.0", compat.Compatible},
+		{"network copyleft within internal tool", "", compat.Internal, "AGPL-3.0", compat.Compatible},
+		{"network copyleft within saas", "MIT", compat.SaaS, "AGPL-3.0-only", compat.Incompatible},
+		{"network copyleft within same licensed saas", "AGPL-3.0-only", compat.SaaS, "AGPL-3.0", compat.Compatible},
+		{"network copyleft within gpl saas", "GPL-3.0-only", compat.SaaS, "AGPL-3.0-only", compat.Obligation},
+		{"copyleft within saas", "", compat.SaaS, "GPL-3.0", compat.Compatible},
+		{"permissive within binary", "", compat.Binary, "MIT", compat.Compatible},
+		{"copyleft within proprietary binary", "", compat.Binary, "GPL-3.0", compat.Incompatible},
+		{"copyleft within permissive binary", "MIT", compat.Binary, "GPL-2.0-only", compat.Incompatible},
+		{"copyleft within same licensed binary", "GPL-3.0-only", compat.Binary, "GPL-3.0", compat.Compatible},
+		{"or later copyleft within later version binary", "GPL-3.0-only", compat.Binary, "GPL-2.0+", compat.Obligation},
+		{"only copyleft within later version binary", "GPL-3.0-only", compat.Binary, "GPL-2.0-only", compat.Incompatible},
+		{"apache within gpl 2 binary", "GPL-2.0-only", compat.Binary, "Apache-2.0", compat.Incompatible},
+		{"apache within gpl 3 binary", "GPL-3.0-only", compat.Binary, "Apache-2.0", compat.Compatible},
+		{"apache within deprecated gpl 2 binary", "GPL-2.0", compat.Binary, "Apache-2.0", compat.Incompatible},
+		{"limited copyleft within binary", "MIT", compat.Binary, "LGPL-2.1", compat.Obligation},
+		{"license with exception within binary", "", compat.Binary, "GPL-2.0-only WITH Classpath-exception-2.0", compat.Incompatible},
+		{"uncategorised within binary", "", compat.Binary, "CERN-OHL-1.1", compat.Obligation},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			var project diligent.License
+			if c.project != "" {
+				var err error
+				if project, err = diligent.GetLicenseFromIdentifier(c.project); err != nil {
+					t.Fatal(err)
+				}
+			}
+			l, err := diligent.GetLicenseFromIdentifier(c.dep)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checker, err := compat.New(project, c.distribution)
+			if err != nil {
+				t.Fatal(err)
+			}
+			level, reason := checker.Assess(l)
+			if level != c.level {
+				t.Errorf("expected %s, got %s: %s", c.level, level, reason)
+			}
+			if (level == compat.Compatible) != (reason == "") {
+				t.Errorf("expected an explanation for all but compatible licenses, got '%s'", reason)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	gpl, _ := diligent.GetLicenseFromIdentifier("GPL-3.0")
+	lgpl, _ := diligent.GetLicenseFromIdentifier("LGPL-2.1")
+	checker, err := compat.New(mit, compat.Binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	findings := checker.Check([]diligent.Dep{
+		{Name: "a", License: mit},
+		{Name: "b", License: gpl},
+		{Name: "c", License: lgpl},
+		{Name: "d", License: gpl, Status: diligent.Excepted},
+	})
+	if len(findings) != 2 {
+		t.Fatalf("expected two findings, got %v", findings)
+	}
+	if findings[0].Dep.Name != "b" || findings[0].Level != compat.Incompatible {
+		t.Errorf("expected b to be incompatible, got %+v", findings[0])
+	}
+	if findings[1].Dep.Name != "c" || findings[1].Level != compat.Obligation {
+		t.Errorf("expected c to trigger obligations, got %+v", findings[1])
+	}
+}
@@ -0,0 +1,158 @@
+package composer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+)
+
+type source struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+type lockedPackage struct {
+	Name     string   `json:"name"`
+	Version  string   `json:"version"`
+	Licenses []string `json:"license"`
+	Source   *source  `json:"source"`
+}
+
+type lock struct {
+	Packages    []lockedPackage `json:"packages"`
+	PackagesDev []lockedPackage `json:"packages-dev"`
+}
+
+// WebLicenseGetter retrieves license information from an online source
+type WebLicenseGetter interface {
+	IsCompatibleURL(s string) bool
+	GetLicenseFromURL(s string) (diligent.License, error)
+}
+
+type composer struct {
+	config Config
+	webLG  WebLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// DevDependencies can be set to true if you want to gather the licenses of your packages-dev as well as your packages
+	DevDependencies bool
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
+}
+
+// New returns a Deper capable of dealing with composer.lock manifest files
+func New(webLG WebLicenseGetter) diligent.Deper {
+	return NewWithOptions(webLG, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(webLG WebLicenseGetter, c Config) diligent.Deper {
+	return &composer{c, webLG}
+}
+
+// Name returns "composer"
+func (c *composer) Name() string {
+	return "composer"
+}
+
+// Dependencies returns the licenses associated with the packages locked by composer
+func (c *composer) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return c.DependenciesContext(context.Background(), file)
+}
+
+// DependenciesContext is identical to Dependencies but cancels the lookups once ctx is done
+func (c *composer) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	var l lock
+	err := json.Unmarshal(file, &l)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deps, warns := c.getDeps(ctx, l.Packages, "")
+	if c.config.DevDependencies {
+		devDeps, devWarns := c.getDeps(ctx, l.PackagesDev, diligent.Development)
+		deps = append(deps, devDeps...)
+		warns = append(warns, devWarns...)
+	}
+	return deps, warns, nil
+}
+
+func (c *composer) getDeps(ctx context.Context, pkgs []lockedPackage, scope diligent.Scope) ([]diligent.Dep, []diligent.Warning) {
+	deps := make([]diligent.Dep, 0, len(pkgs))
+	warns := make([]diligent.Warning, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		d, ok := c.config.Overrides.Dep(c.Name(), pkg.Name, pkg.Version)
+		if !ok {
+			var t diligent.Trace
+			l, err := c.getLicense(ctx, pkg, &t)
+			if err != nil {
+				warns = append(warns, warning.FromScopedError(c.Name(), pkg.Name, pkg.Version, scope, err, t))
+				continue
+			}
+			d = diligent.Dep{
+				Name:    pkg.Name,
+				Version: pkg.Version,
+				License: l,
+				Trace:   t,
+			}
+		}
+		d.Scope = scope
+		deps = append(deps, d)
+	}
+	return deps, warns
+}
+
+// IsCompatible returns true if the filename is composer.lock
+func (c *composer) IsCompatible(filename string) bool {
+	return filename == "composer.lock"
+}
+
+// licenseExpression combines the entries of a composer license array into a single SPDX license expression. Entries
+// in the array are OR alternatives, and a single entry may itself be an expression such as
+// "(LGPL-2.1-only or GPL-3.0-or-later)"
+func licenseExpression(licenses []string) string {
+	alts := make([]string, 0, len(licenses))
+	for _, l := range licenses {
+		if l = strings.TrimSpace(l); l != "" {
+			alts = append(alts, "("+l+")")
+		}
+	}
+	return strings.Join(alts, " OR ")
+}
+
+func (c *composer) getLicense(ctx context.Context, pkg lockedPackage, t *diligent.Trace) (diligent.License, error) {
+	if expression := licenseExpression(pkg.Licenses); expression != "" {
+		l, err := diligent.GetLicenseFromExpression(expression)
+		t.Record("composer license field", "composer.lock", l, err)
+		var unknownErr *diligent.UnknownIdentifierError
+		if errors.As(err, &unknownErr) {
+			return diligent.License{}, fmt.Errorf("none of the licenses '%s' are known to diligent: %w", strings.Join(pkg.Licenses, "', '"), err)
+		}
+		return l, err
+	}
+
+	if pkg.Source != nil && pkg.Source.URL != "" {
+		repoURL := strings.TrimSuffix(pkg.Source.URL, ".git")
+		if c.webLG != nil && c.webLG.IsCompatibleURL(repoURL) {
+			l, err := diligent.GetLicenseFromURL(ctx, c.webLG, repoURL, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+		if pkg.Source.Type == "git" {
+			l, err := diligent.TraceLicenseForGit(ctx, pkg.Source.URL, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+	}
+
+	return diligent.License{}, errors.New("no license information in composer.lock")
+}
@@ -0,0 +1,236 @@
+package composer_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/composer"
+)
+
+type webLicenseGetterResponse struct {
+	license diligent.License
+	err     error
+}
+
+type mockWebLicenseGetter struct {
+	responses map[string]webLicenseGetterResponse
+}
+
+func (m mockWebLicenseGetter) IsCompatibleURL(s string) bool {
+	_, ok := m.responses[s]
+	return ok
+}
+
+func (m mockWebLicenseGetter) GetLicenseFromURL(s string) (diligent.License, error) {
+	r, ok := m.responses[s]
+	if !ok {
+		return diligent.License{}, errors.New("not mocked")
+	}
+	return r.license, r.err
+}
+
+func TestName(t *testing.T) {
+	target := composer.New(nil)
+	if target.Name() != "composer" {
+		t.Error("expected 'composer'")
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out bool
+	}{
+		{"composer.lock", true},
+		{"composer.json", false},
+		{"composer.lock.old", false},
+		{"package.json", false},
+		{"random-composer.lock", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			target := composer.New(nil)
+			compatible := target.IsCompatible(tt.in)
+			if compatible != tt.out {
+				t.Errorf("got %v, want %v", compatible, tt.out)
+			}
+		})
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	isc, _ := diligent.GetLicenseFromIdentifier("ISC")
+	mock := mockWebLicenseGetter{map[string]webLicenseGetterResponse{
+		"https://github.com/acme/no-license": {
+			license: isc,
+		},
+		"https://github.com/acme/broken": {
+			err: errors.New("eeek"),
+		},
+	}}
+	cases := []struct {
+		description string
+		config      composer.Config
+		in          []byte
+		depsOut     map[string]string
+		warnsOut    []diligent.WarningDetails
+		errOut      bool
+	}{{
+		"should handle only packages by default",
+		composer.Config{},
+		[]byte(`
+			{
+				"packages": [
+					{"name": "monolog/monolog", "version": "2.0.0", "license": ["MIT"]}
+				],
+				"packages-dev": [
+					{"name": "phpunit/phpunit", "version": "9.0.0", "license": ["BSD-3-Clause"]}
+				]
+			}
+		`),
+		map[string]string{
+			"monolog/monolog@2.0.0": "MIT",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should be capable of including packages-dev",
+		composer.Config{DevDependencies: true},
+		[]byte(`
+			{
+				"packages": [
+					{"name": "monolog/monolog", "version": "2.0.0", "license": ["MIT"]}
+				],
+				"packages-dev": [
+					{"name": "phpunit/phpunit", "version": "9.0.0", "license": ["BSD-3-Clause"]},
+					{"name": "acme/tool", "version": "1.0.0"}
+				]
+			}
+		`),
+		map[string]string{
+			"monolog/monolog@2.0.0": "MIT",
+			"phpunit/phpunit@9.0.0": "BSD-3-Clause dev",
+		},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "composer", Package: "acme/tool", Version: "1.0.0", Scope: diligent.Development, Err: errors.New("no license information in composer.lock")},
+		},
+		false,
+	}, {
+		"should pick the first known license alternative and the most restrictive of licenses which all apply",
+		composer.Config{},
+		[]byte(`
+			{
+				"packages": [
+					{"name": "a/array", "license": ["woowoo", "LGPL-2.1", "GPL-3.0"]},
+					{"name": "b/expression", "license": ["(woowoo or GPL-3.0)"]},
+					{"name": "c/upper", "license": ["(woowoo OR Apache-2.0)"]},
+					{"name": "d/and", "license": ["MIT AND GPL-3.0-only"]}
+				]
+			}
+		`),
+		map[string]string{
+			"a/array@":      "LGPL-2.1",
+			"b/expression@": "GPL-3.0",
+			"c/upper@":      "Apache-2.0",
+			"d/and@":        "GPL-3.0-only",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should fall back to the source URL when no license is declared",
+		composer.Config{},
+		[]byte(`
+			{
+				"packages": [
+					{"name": "acme/no-license", "license": [], "source": {"type": "git", "url": "https://github.com/acme/no-license.git"}}
+				]
+			}
+		`),
+		map[string]string{
+			"acme/no-license@": "ISC",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should warn when licenses cannot be determined",
+		composer.Config{},
+		[]byte(`
+			{
+				"packages": [
+					{"name": "acme/unknown", "license": ["woowoo"]},
+					{"name": "acme/broken", "license": [], "source": {"type": "hg", "url": "https://github.com/acme/broken"}},
+					{"name": "acme/nothing"}
+				]
+			}
+		`),
+		map[string]string{},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "composer", Package: "acme/broken", Err: errors.New("no license information in composer.lock")},
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "composer", Package: "acme/nothing", Err: errors.New("no license information in composer.lock")},
+			{Kind: diligent.UnknownIdentifier, Ecosystem: "composer", Package: "acme/unknown", Err: fmt.Errorf("none of the licenses 'woowoo' are known to diligent: %w", &diligent.UnknownIdentifierError{Identifier: "woowoo"})},
+		},
+		false,
+	}, {
+		"composer.lock parse failure",
+		composer.Config{},
+		[]byte(`{{`),
+		map[string]string{},
+		[]diligent.WarningDetails{},
+		true,
+	}}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			target := composer.NewWithOptions(mock, tt.config)
+			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
+			expectedDeps := make([]diligent.Dep, 0, len(tt.depsOut))
+			for depID, lID := range tt.depsOut {
+				// expected licenses may be followed by the scope of the dependency, e.g. "MIT dev"
+				fields := strings.Fields(lID)
+				l, _ := diligent.GetLicenseFromIdentifier(fields[0])
+				at := strings.LastIndex(depID, "@")
+				dep := diligent.Dep{Name: depID[:at], Version: depID[at+1:], License: l}
+				if len(fields) > 1 {
+					dep.Scope = diligent.Scope(fields[1])
+				}
+				expectedDeps = append(expectedDeps, dep)
+			}
+			if len(d) > 0 || len(expectedDeps) > 0 {
+				sort.Sort(diligent.DepsByName(d))
+				sort.Sort(diligent.DepsByName(expectedDeps))
+				if reflect.DeepEqual(d, expectedDeps) == false {
+					t.Errorf("deps: got %+v, want %+v", d, expectedDeps)
+				}
+			}
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
+				}
+			}
+			isErr := e != nil
+			if tt.errOut != isErr {
+				t.Errorf("error: got %v, want %v", isErr, tt.errOut)
+			}
+		})
+	}
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
@@ -0,0 +1,89 @@
+package diligent
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type requestTimeoutKey struct{}
+
+type offlineKey struct{}
+
+// ErrOffline is returned in place of any network request made using a context returned by WithOffline
+var ErrOffline = errors.New("offline: not available locally")
+
+// WithOffline returns a copy of ctx which prevents network requests, such as registry lookups, git clones and go get,
+// from being made using it. Licenses are then determined using local sources only
+func WithOffline(ctx context.Context) context.Context {
+	return context.WithValue(ctx, offlineKey{}, true)
+}
+
+// IsOffline returns true if network requests must not be made using ctx
+func IsOffline(ctx context.Context) bool {
+	offline, _ := ctx.Value(offlineKey{}).(bool)
+	return offline
+}
+
+// WithRequestTimeout returns a copy of ctx which limits each network request, such as a registry lookup, git clone or
+// go get, made using it to the duration. A duration of zero or less does not limit requests
+func WithRequestTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, d)
+}
+
+// RequestContext returns the context which should be used for a single network request, applying the timeout set
+// by WithRequestTimeout if any. The CancelFunc should be called once the response has been read. ErrOffline is
+// returned if the request must not be made
+func RequestContext(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	if IsOffline(ctx) {
+		return nil, nil, ErrOffline
+	}
+	if d, ok := ctx.Value(requestTimeoutKey{}).(time.Duration); ok && d > 0 {
+		rctx, cancel := context.WithTimeout(ctx, d)
+		return rctx, cancel, nil
+	}
+	rctx, cancel := context.WithCancel(ctx)
+	return rctx, cancel, nil
+}
+
+// ContextDeper is an optional interface implemented by Depers whose lookups can be cancelled or time out. Once ctx is
+// done, the dependencies whose licenses have not yet been determined should be returned as warnings
+type ContextDeper interface {
+	Deper
+	// DependenciesContext is identical to Dependencies but stops making requests once ctx is done
+	DependenciesContext(ctx context.Context, file []byte) ([]Dep, []Warning, error)
+}
+
+// ContextLocalDeper is an optional interface implemented by LocalDepers whose lookups can be cancelled or time out
+type ContextLocalDeper interface {
+	LocalDeper
+	// LocalDependenciesContext is identical to LocalDependencies but stops making requests once ctx is done
+	LocalDependenciesContext(ctx context.Context, dir string, file []byte) ([]Dep, []Warning, error)
+}
+
+// GetDependencies uses the most capable method implemented by the Deper to process the manifest file, where dir is
+// the directory containing the manifest file
+func GetDependencies(ctx context.Context, d Deper, dir string, file []byte) ([]Dep, []Warning, error) {
+	if cd, ok := d.(ContextLocalDeper); ok {
+		return cd.LocalDependenciesContext(ctx, dir, file)
+	}
+	if ld, ok := d.(LocalDeper); ok {
+		return ld.LocalDependencies(dir, file)
+	}
+	if cd, ok := d.(ContextDeper); ok {
+		return cd.DependenciesContext(ctx, file)
+	}
+	return d.Dependencies(file)
+}
+
+// ContextURLLicenseGetter is implemented by URLLicenseGetters whose requests can be cancelled or time out, and which
+// record each of the steps they take
+type ContextURLLicenseGetter interface {
+	GetLicenseFromURLContext(ctx context.Context, s string, t *Trace) (License, error)
+}
+
+// ContextPackageLicenseGetter is implemented by PackageLicenseGetters whose requests can be cancelled or time out,
+// and which record each of the steps they take
+type ContextPackageLicenseGetter interface {
+	GetLicenseContext(ctx context.Context, packagePath string, t *Trace) (License, error)
+}
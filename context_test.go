@@ -0,0 +1,125 @@
+package diligent_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestRequestContext(t *testing.T) {
+	cases := []struct {
+		timeout     time.Duration
+		hasDeadline bool
+	}{
+		{0, false},
+		{-time.Second, false},
+		{time.Minute, true},
+	}
+	for _, tc := range cases {
+		ctx, cancel, err := diligent.RequestContext(diligent.WithRequestTimeout(context.Background(), tc.timeout))
+		if err != nil {
+			t.Fatalf("timeout %v: unexpected error %v", tc.timeout, err)
+		}
+		deadline, ok := ctx.Deadline()
+		if ok != tc.hasDeadline {
+			t.Errorf("timeout %v: expected deadline %v, got %v", tc.timeout, tc.hasDeadline, ok)
+		}
+		if ok && time.Until(deadline) > tc.timeout {
+			t.Errorf("timeout %v: deadline %v is too late", tc.timeout, deadline)
+		}
+		cancel()
+		if ctx.Err() != context.Canceled {
+			t.Errorf("timeout %v: expected the request context to be cancelled", tc.timeout)
+		}
+	}
+}
+
+type mockURLLicenseGetter struct {
+	called bool
+}
+
+func (m *mockURLLicenseGetter) GetLicenseFromURL(s string) (diligent.License, error) {
+	m.called = true
+	return diligent.GetLicenseFromIdentifier("MIT")
+}
+
+func TestOffline(t *testing.T) {
+	ctx := diligent.WithOffline(context.Background())
+	if !diligent.IsOffline(ctx) || diligent.IsOffline(context.Background()) {
+		t.Fatal("expected only the offline context to be offline")
+	}
+	if _, _, err := diligent.RequestContext(ctx); err != diligent.ErrOffline {
+		t.Errorf("expected ErrOffline, got %v", err)
+	}
+
+	var g mockURLLicenseGetter
+	var tr diligent.Trace
+	if _, err := diligent.GetLicenseFromURL(ctx, &g, "https://example.com", &tr); err != diligent.ErrOffline {
+		t.Errorf("expected ErrOffline, got %v", err)
+	}
+	if g.called {
+		t.Error("expected the getter not to be called when offline")
+	}
+	if !tr.Offline() {
+		t.Errorf("expected the trace to record the offline step, got %+v", tr)
+	}
+}
+
+type mockDeper struct {
+	called string
+}
+
+func (m *mockDeper) Name() string                  { return "mock" }
+func (m *mockDeper) IsCompatible(name string) bool { return true }
+func (m *mockDeper) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	m.called = "Dependencies"
+	return nil, nil, nil
+}
+
+type mockContextDeper struct{ mockDeper }
+
+func (m *mockContextDeper) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	m.called = "DependenciesContext"
+	return nil, nil, nil
+}
+
+type mockLocalDeper struct{ mockDeper }
+
+func (m *mockLocalDeper) LocalDependencies(dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	m.called = "LocalDependencies"
+	return nil, nil, nil
+}
+
+type mockContextLocalDeper struct{ mockLocalDeper }
+
+func (m *mockContextLocalDeper) LocalDependenciesContext(ctx context.Context, dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	m.called = "LocalDependenciesContext"
+	return nil, nil, nil
+}
+
+func TestGetDependencies(t *testing.T) {
+	plain := &mockDeper{}
+	withContext := &mockContextDeper{}
+	local := &mockLocalDeper{}
+	localWithContext := &mockContextLocalDeper{}
+	cases := []struct {
+		deper    diligent.Deper
+		called   *string
+		expected string
+	}{
+		{plain, &plain.called, "Dependencies"},
+		{withContext, &withContext.called, "DependenciesContext"},
+		{local, &local.called, "LocalDependencies"},
+		{localWithContext, &localWithContext.called, "LocalDependenciesContext"},
+	}
+	for _, tc := range cases {
+		if _, _, err := diligent.GetDependencies(context.Background(), tc.deper, ".", nil); err != nil {
+			t.Fatal(err)
+		}
+		if *tc.called != tc.expected {
+			t.Errorf("expected %s to be called, got %s", tc.expected, *tc.called)
+		}
+	}
+}
@@ -17,13 +17,29 @@ func NewReporter() diligent.Reporter {
 
 // Report outputs the dependencies and their licenses to a CSV file
 func (c *csv) Report(w io.Writer, deps []diligent.Dep) error {
+	return c.ReportWithWarnings(w, deps, nil)
+}
+
+// ReportWithWarnings is identical to Report but also outputs a row for each package whose license could not be
+// determined, with the status "unresolved", the ecosystem which found the package, the kind of warning and the reason
+// within the Warning column
+func (c *csv) ReportWithWarnings(w io.Writer, deps []diligent.Dep, warnings []diligent.WarningDetails) error {
 	writer := encCSV.NewWriter(w)
 
-	if err := writer.Write([]string{"Name", "License ID", "License Name", "License URL"}); err != nil {
+	if err := writer.Write([]string{"Name", "License ID", "License Name", "License URL", "License Category", "Version", "Scope", "Status", "Source", "Ecosystem", "Warning Kind", "Warning"}); err != nil {
 		return err
 	}
 	for _, d := range deps {
-		if err := writer.Write([]string{d.Name, d.License.Identifier, d.License.Name, d.License.URL}); err != nil {
+		source := "detected"
+		if d.Declared {
+			source = "declared"
+		}
+		if err := writer.Write([]string{d.Name, d.License.Identifier, d.License.Name, d.License.URL, string(d.License.Category), d.Version, string(d.EffectiveScope()), string(d.Status), source, "", "", ""}); err != nil {
+			return err
+		}
+	}
+	for _, wd := range warnings {
+		if err := writer.Write([]string{wd.Package, "", "", "", "", wd.Version, string(wd.EffectiveScope()), "unresolved", "", wd.Ecosystem, string(wd.Kind), wd.Err.Error()}); err != nil {
 			return err
 		}
 	}
@@ -31,3 +47,4 @@ func (c *csv) Report(w io.Writer, deps []diligent.Dep) error {
 
 	return nil
 }
+
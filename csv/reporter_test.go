@@ -0,0 +1,39 @@
+package csv_test
+
+import (
+	"bytes"
+	encCSV "encoding/csv"
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/csv"
+)
+
+func TestReportWithWarnings(t *testing.T) {
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	deps := []diligent.Dep{
+		{Name: "a", Version: "1.0.0", License: mit, Status: diligent.Allowed},
+		{Name: "b", Version: "2.0.0", License: mit, Scope: diligent.Development, Declared: true, Status: diligent.Excepted},
+	}
+	warnings := []diligent.WarningDetails{
+		{Kind: diligent.NotFound, Ecosystem: "npm", Package: "c", Version: "3.0.0", Scope: diligent.Development, Err: &diligent.StatusError{StatusCode: 404}},
+	}
+	var buf bytes.Buffer
+	if err := csv.NewReporter().(diligent.WarningReporter).ReportWithWarnings(&buf, deps, warnings); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := encCSV.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{
+		{"Name", "License ID", "License Name", "License URL", "License Category", "Version", "Scope", "Status", "Source", "Ecosystem", "Warning Kind", "Warning"},
+		{"a", "MIT", mit.Name, mit.URL, string(mit.Category), "1.0.0", "prod", "allowed", "detected", "", "", ""},
+		{"b", "MIT", mit.Name, mit.URL, string(mit.Category), "2.0.0", "dev", "approved exception", "declared", "", "", ""},
+		{"c", "", "", "", "", "3.0.0", "dev", "unresolved", "", "npm", "not-found", "requested failed with status 404"},
+	}
+	if reflect.DeepEqual(rows, expected) == false {
+		t.Errorf("got %v, want %v", rows, expected)
+	}
+}
@@ -2,10 +2,37 @@ package diligent
 
 import "fmt"
 
+// Status describes the outcome of checking a dependency's license against a license policy
+type Status string
+
+const (
+	// Allowed licenses are compatible with your software
+	Allowed Status = "allowed"
+	// Review licenses may be compatible with your software but must be reviewed
+	Review Status = "review"
+	// Denied licenses are explicitly incompatible with your software
+	Denied Status = "denied"
+	// NotAllowed licenses were not allowed, reviewed or denied by the policy
+	NotAllowed Status = "not allowed"
+	// Excepted dependencies would have failed the policy but are covered by an approved exception
+	Excepted Status = "approved exception"
+)
+
 // Dep contains a dependency identified by name along with its License information
 type Dep struct {
-	Name    string
+	Name string
+	// Version is the version of the dependency as defined by the manifest file, if known.
+	// Depending on the package manager this may be a version constraint or a revision
+	Version string
 	License License
+	// Scope describes how the dependency is used, see EffectiveScope
+	Scope Scope
+	// Trace records the steps taken to determine the license
+	Trace Trace
+	// Declared is true when the license was declared using an Override rather than determined by the Deper
+	Declared bool
+	// Status is set once the dependency has been checked against a license policy
+	Status Status
 }
 
 // Warning represents an error whilst processing a dependency
@@ -27,6 +54,14 @@ type Deper interface {
 	IsCompatible(filename string) bool
 }
 
+// LocalDeper is an optional interface implemented by Depers which need to read files alongside the manifest file,
+// for example vendored sources
+type LocalDeper interface {
+	Deper
+	// LocalDependencies behaves like Dependencies, dir is the directory containing the manifest file
+	LocalDependencies(dir string, file []byte) ([]Dep, []Warning, error)
+}
+
 type DepsByName []Dep
 
 func (d DepsByName) Len() int           { return len(d) }
@@ -53,15 +88,19 @@ func (d DepsByLicense) Less(i, j int) bool {
 
 type Deps []Dep
 
-// Dedupe removes duplicate dependencies in place
+// Dedupe removes duplicate dependencies in place.
+// A dependency found in several scopes is kept in its Production scope if it has one
 func (dd Deps) Dedupe() Deps {
 	out := make([]Dep, 0, len(dd))
-	found := map[string]bool{}
+	found := map[string]int{}
 	for _, d := range dd {
 		key := fmt.Sprintf("%s-%s", d.Name, d.License.Identifier)
-		if _, ok := found[key]; !ok {
+		idx, ok := found[key]
+		if !ok {
+			found[key] = len(out)
 			out = append(out, d)
-			found[key] = true
+		} else if d.EffectiveScope() == Production {
+			out[idx].Scope = d.Scope
 		}
 	}
 	return out
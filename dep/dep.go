@@ -1,13 +1,19 @@
 package dep
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+
 	"github.com/pelletier/go-toml"
 	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/warning"
 )
 
 type lockedProject struct {
-	Name string `toml:"name"`
+	Name     string `toml:"name"`
+	Version  string `toml:"version"`
+	Revision string `toml:"revision"`
 }
 
 type lock struct {
@@ -15,7 +21,14 @@ type lock struct {
 }
 
 type dep struct {
-	lg GoLicenseGetter
+	config Config
+	lg     GoLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
 }
 
 type GoLicenseGetter interface {
@@ -24,7 +37,12 @@ type GoLicenseGetter interface {
 
 // New returns a Deper capable of handling dep manifest files
 func New(lg GoLicenseGetter) diligent.Deper {
-	return &dep{lg}
+	return NewWithOptions(lg, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(lg GoLicenseGetter, c Config) diligent.Deper {
+	return &dep{c, lg}
 }
 
 // Name returns "dep"
@@ -32,8 +50,25 @@ func (d *dep) Name() string {
 	return "dep"
 }
 
-// Dependencies returns the licenses of the go packages defined within the dep manifest
+// Dependencies returns the licenses of the go packages defined within the dep manifest, assuming the manifest is
+// within the working directory
 func (d *dep) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return d.LocalDependencies(".", file)
+}
+
+// DependenciesContext is identical to Dependencies but cancels the lookups once ctx is done
+func (d *dep) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return d.LocalDependenciesContext(ctx, ".", file)
+}
+
+// LocalDependencies returns the licenses of the go packages defined within the dep manifest, dir is the directory
+// containing the manifest, whose vendor directory is searched for the packages' licenses when offline
+func (d *dep) LocalDependencies(dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return d.LocalDependenciesContext(context.Background(), dir, file)
+}
+
+// LocalDependenciesContext is identical to LocalDependencies but cancels the lookups once ctx is done
+func (d *dep) LocalDependenciesContext(ctx context.Context, dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
 	var l lock
 	err := toml.Unmarshal(file, &l)
 	if err != nil {
@@ -43,19 +78,42 @@ func (d *dep) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, err
 	deps := make([]diligent.Dep, 0, len(l.Projects))
 	warns := make([]diligent.Warning, 0, len(l.Projects))
 	for _, pkg := range l.Projects {
-		l, err := d.lg.GetLicense(pkg.Name)
+		version := pkg.Version
+		if version == "" {
+			version = pkg.Revision
+		}
+		if d, ok := d.config.Overrides.Dep(d.Name(), pkg.Name, version); ok {
+			deps = append(deps, d)
+			continue
+		}
+		var t diligent.Trace
+		l, err := d.getLicense(ctx, dir, pkg.Name, &t)
 		if err != nil {
-			warns = append(warns, warning.New(pkg.Name, err.Error()))
+			warns = append(warns, warning.FromError(d.Name(), pkg.Name, version, err, t))
 		} else {
 			deps = append(deps, diligent.Dep{
 				Name:    pkg.Name,
+				Version: version,
 				License: l,
+				Trace:   t,
 			})
 		}
 	}
 	return deps, warns, nil
 }
 
+func (d *dep) getLicense(ctx context.Context, dir, name string, t *diligent.Trace) (diligent.License, error) {
+	if diligent.IsOffline(ctx) {
+		pkgDir := filepath.Join(dir, "vendor", filepath.FromSlash(name))
+		if _, err := os.Stat(pkgDir); err == nil {
+			if l, err := diligent.TraceLicenseForDirectory(pkgDir, t); err == nil {
+				return l, nil
+			}
+		}
+	}
+	return diligent.GetPackageLicense(ctx, d.lg, name, t)
+}
+
 // IsCompatible returns true if the filename is Gopkg.lock
 func (d *dep) IsCompatible(filename string) bool {
 	return filename == "Gopkg.lock"
@@ -3,11 +3,11 @@ package dep_test
 import (
 	"errors"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/dep"
-	"github.com/senseyeio/diligent/warning"
 )
 
 type licenseGetterResponse struct {
@@ -73,7 +73,7 @@ var depTests = []struct {
 	in            []byte
 	getLicenseLUT map[string]licenseGetterResponse
 	depsOut       []diligent.Dep
-	warnsOut      []diligent.Warning
+	warnsOut      []diligent.WarningDetails
 	errOut        bool
 }{{
 	"single dependency",
@@ -92,9 +92,10 @@ var depTests = []struct {
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/inconshreveable/mousetrap",
+		Version: "v1.0",
 		License: diligent.License{Identifier: "MIT"},
 	}},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	false,
 }, {
 	"multiple dependencies",
@@ -123,12 +124,14 @@ var depTests = []struct {
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/inconshreveable/mousetrap",
+		Version: "v1.0",
 		License: diligent.License{Identifier: "MIT"},
 	}, {
 		Name:    "github.com/pelletier/go-toml",
+		Version: "v1.1.0",
 		License: diligent.License{Identifier: "DOC"},
 	}},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	false,
 }, {
 	"part failure dependencies",
@@ -157,10 +160,11 @@ var depTests = []struct {
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/inconshreveable/mousetrap",
+		Version: "v1.0",
 		License: diligent.License{Identifier: "MIT"},
 	}},
-	[]diligent.Warning{
-		warning.New("github.com/pelletier/go-toml", "error"),
+	[]diligent.WarningDetails{
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "dep", Package: "github.com/pelletier/go-toml", Version: "v1.1.0", Err: errors.New("error")},
 	},
 	false,
 }, {
@@ -189,9 +193,9 @@ var depTests = []struct {
 		},
 	},
 	[]diligent.Dep{},
-	[]diligent.Warning{
-		warning.New("github.com/inconshreveable/mousetrap", "eeek"),
-		warning.New("github.com/pelletier/go-toml", "error"),
+	[]diligent.WarningDetails{
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "dep", Package: "github.com/inconshreveable/mousetrap", Version: "v1.0", Err: errors.New("eeek")},
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "dep", Package: "github.com/pelletier/go-toml", Version: "v1.1.0", Err: errors.New("error")},
 	},
 	false,
 }, {
@@ -203,7 +207,7 @@ var depTests = []struct {
 `),
 	map[string]licenseGetterResponse{},
 	[]diligent.Dep{},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	true,
 }}
 
@@ -213,11 +217,16 @@ func TestDependencies(t *testing.T) {
 			mockLG := newMockLicenseGetter(t, tt.getLicenseLUT)
 			target := dep.New(mockLG)
 			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
 			if (len(d) > 0 || len(tt.depsOut) > 0) && reflect.DeepEqual(d, tt.depsOut) == false {
 				t.Errorf("deps: got %v, want %v", d, tt.depsOut)
 			}
-			if (len(w) > 0 || len(tt.warnsOut) > 0) && reflect.DeepEqual(w, tt.warnsOut) == false {
-				t.Errorf("warnings: got %v, want %v", w, tt.warnsOut)
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
+				}
 			}
 			isErr := e != nil
 			if tt.errOut != isErr {
@@ -226,3 +235,14 @@ func TestDependencies(t *testing.T) {
 		})
 	}
 }
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
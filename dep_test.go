@@ -0,0 +1,59 @@
+package diligent_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestDedupe(t *testing.T) {
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	cases := []struct {
+		description string
+		in          diligent.Deps
+		out         diligent.Deps
+	}{{
+		"should remove duplicates",
+		diligent.Deps{{Name: "a", License: mit}, {Name: "b", License: mit}, {Name: "a", License: mit}},
+		diligent.Deps{{Name: "a", License: mit}, {Name: "b", License: mit}},
+	}, {
+		"should keep a dependency in its production scope",
+		diligent.Deps{{Name: "a", License: mit, Scope: diligent.Development}, {Name: "a", License: mit}},
+		diligent.Deps{{Name: "a", License: mit}},
+	}, {
+		"should keep the first scope of non production dependencies",
+		diligent.Deps{{Name: "a", License: mit, Scope: diligent.Peer}, {Name: "a", License: mit, Scope: diligent.Development}},
+		diligent.Deps{{Name: "a", License: mit, Scope: diligent.Peer}},
+	}}
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			out := tt.in.Dedupe()
+			if reflect.DeepEqual(out, tt.out) == false {
+				t.Errorf("got %+v, want %+v", out, tt.out)
+			}
+		})
+	}
+}
+
+func TestEffectiveScope(t *testing.T) {
+	if s := (diligent.Dep{}).EffectiveScope(); s != diligent.Production {
+		t.Errorf("got %s, want %s", s, diligent.Production)
+	}
+	if s := (diligent.Dep{Scope: diligent.Development}).EffectiveScope(); s != diligent.Development {
+		t.Errorf("got %s, want %s", s, diligent.Development)
+	}
+}
+
+func TestIsScope(t *testing.T) {
+	for _, s := range []string{"prod", "dev", "optional", "peer"} {
+		if !diligent.IsScope(s) {
+			t.Errorf("expected %s to be a scope", s)
+		}
+	}
+	for _, s := range []string{"", "all", "test", "provided"} {
+		if diligent.IsScope(s) {
+			t.Errorf("expected %s not to be a scope", s)
+		}
+	}
+}
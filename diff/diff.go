@@ -0,0 +1,69 @@
+package diff
+
+import (
+	"sort"
+
+	"github.com/senseyeio/diligent"
+)
+
+// Relicense describes a dependency whose license differs between two sets of dependencies
+type Relicense struct {
+	Name string
+	From diligent.License
+	To   diligent.License
+}
+
+// Result contains the differences between two sets of dependencies
+type Result struct {
+	Added      []diligent.Dep
+	Removed    []diligent.Dep
+	Relicensed []Relicense
+}
+
+// Empty returns true if the sets of dependencies had the same names and licenses
+func (r Result) Empty() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Relicensed) == 0
+}
+
+func byName(deps []diligent.Dep) map[string][]diligent.Dep {
+	out := map[string][]diligent.Dep{}
+	for _, d := range deps {
+		out[d.Name] = append(out[d.Name], d)
+	}
+	return out
+}
+
+func hasLicense(deps []diligent.Dep, l diligent.License) bool {
+	for _, d := range deps {
+		if d.License.Identifier == l.Identifier {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare returns the dependencies added, removed and relicensed between from and to.
+// Dependencies are matched by name, so version changes alone are not reported
+func Compare(from, to []diligent.Dep) Result {
+	var res Result
+	fromByName, toByName := byName(from), byName(to)
+	for _, d := range to {
+		old, ok := fromByName[d.Name]
+		if !ok {
+			res.Added = append(res.Added, d)
+			continue
+		}
+		if !hasLicense(old, d.License) {
+			res.Relicensed = append(res.Relicensed, Relicense{d.Name, old[0].License, d.License})
+		}
+	}
+	for _, d := range from {
+		if _, ok := toByName[d.Name]; !ok {
+			res.Removed = append(res.Removed, d)
+		}
+	}
+	sort.Sort(diligent.DepsByName(res.Added))
+	sort.Sort(diligent.DepsByName(res.Removed))
+	sort.Slice(res.Relicensed, func(i, j int) bool { return res.Relicensed[i].Name < res.Relicensed[j].Name })
+	return res
+}
@@ -0,0 +1,57 @@
+package diff_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/diff"
+)
+
+func dep(name, version, license string) diligent.Dep {
+	l, _ := diligent.GetLicenseFromIdentifier(license)
+	return diligent.Dep{Name: name, Version: version, License: l}
+}
+
+func TestCompare(t *testing.T) {
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	gpl, _ := diligent.GetLicenseFromIdentifier("GPL-3.0")
+	cases := []struct {
+		description string
+		from, to    []diligent.Dep
+		expected    diff.Result
+	}{{
+		"no changes",
+		[]diligent.Dep{dep("a", "1.0.0", "MIT")},
+		[]diligent.Dep{dep("a", "1.0.0", "MIT")},
+		diff.Result{},
+	}, {
+		"version changes are not reported",
+		[]diligent.Dep{dep("a", "1.0.0", "MIT")},
+		[]diligent.Dep{dep("a", "2.0.0", "MIT")},
+		diff.Result{},
+	}, {
+		"added and removed",
+		[]diligent.Dep{dep("a", "", "MIT"), dep("b", "", "MIT")},
+		[]diligent.Dep{dep("a", "", "MIT"), dep("c", "", "GPL-3.0")},
+		diff.Result{
+			Added:   []diligent.Dep{dep("c", "", "GPL-3.0")},
+			Removed: []diligent.Dep{dep("b", "", "MIT")},
+		},
+	}, {
+		"relicensed",
+		[]diligent.Dep{dep("a", "1.0.0", "MIT")},
+		[]diligent.Dep{dep("a", "2.0.0", "GPL-3.0")},
+		diff.Result{
+			Relicensed: []diff.Relicense{{Name: "a", From: mit, To: gpl}},
+		},
+	}}
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			res := diff.Compare(c.from, c.to)
+			if res.Empty() != c.expected.Empty() || (!res.Empty() && reflect.DeepEqual(res, c.expected) == false) {
+				t.Errorf("got %+v, want %+v", res, c.expected)
+			}
+		})
+	}
+}
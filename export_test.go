@@ -0,0 +1,15 @@
+package diligent
+
+// SaveLicenses records the known licenses and categories, returning a function which restores them, so tests can
+// register categories and reassign licenses without affecting other tests
+func SaveLicenses() (restore func()) {
+	savedLookup := make(map[string]License, len(lookup))
+	for id, l := range lookup {
+		savedLookup[id] = l
+	}
+	savedCategories := append([]Category(nil), categories...)
+	return func() {
+		lookup = savedLookup
+		categories = savedCategories
+	}
+}
@@ -0,0 +1,148 @@
+package diligent
+
+import (
+	"fmt"
+	"strings"
+)
+
+// restrictiveness orders the categories from the least to the most restrictive. Licenses in categories which are not
+// listed, such as organisation defined categories, are treated as the most restrictive
+var restrictiveness = map[Category]int{
+	PublicDomain:    1,
+	Permissive:      2,
+	CopyLeftLimited: 3,
+	FreeRestricted:  4,
+	ProprietaryFree: 5,
+	CopyLeft:        6,
+}
+
+func restrictivenessOf(l License) int {
+	if r, ok := restrictiveness[l.Category]; ok {
+		return r
+	}
+	return len(restrictiveness) + 1
+}
+
+// expressionParser resolves an SPDX license expression, such as '(MIT OR Apache-2.0) AND BSD-3-Clause', to a single
+// license. Any of the options of an OR may be chosen, so the first known license is used, whereas every term of an AND
+// applies, so the most restrictive license is used
+type expressionParser struct {
+	expression string
+	tokens     []string
+	pos        int
+}
+
+func tokenizeExpression(expression string) []string {
+	expression = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expression)
+	return strings.Fields(expression)
+}
+
+// GetLicenseFromExpression returns the license which best describes the obligations of an SPDX license expression,
+// such as '(MIT OR Apache-2.0) AND BSD-3-Clause'. Operators are matched regardless of case. A MalformedError is
+// returned if the expression cannot be parsed, otherwise an UnknownIdentifierError if no license could be chosen
+func GetLicenseFromExpression(expression string) (License, error) {
+	p := &expressionParser{expression: expression, tokens: tokenizeExpression(expression)}
+	if len(p.tokens) == 0 {
+		return License{}, p.malformed()
+	}
+	l, err := p.parseOr()
+	if err != nil {
+		return License{}, err
+	}
+	if p.pos != len(p.tokens) {
+		return License{}, p.malformed()
+	}
+	return l, nil
+}
+
+func (p *expressionParser) malformed() error {
+	return &MalformedError{Msg: fmt.Sprintf("invalid license expression '%s'", p.expression)}
+}
+
+func isMalformed(err error) bool {
+	_, ok := err.(*MalformedError)
+	return ok
+}
+
+func (p *expressionParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *expressionParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+// parseOr parses options separated by OR, returning the first license which is known
+func (p *expressionParser) parseOr() (License, error) {
+	l, err := p.parseAnd()
+	if isMalformed(err) {
+		return License{}, err
+	}
+	for strings.EqualFold(p.peek(), "OR") {
+		p.next()
+		option, optionErr := p.parseAnd()
+		if isMalformed(optionErr) {
+			return License{}, optionErr
+		}
+		if err != nil {
+			l, err = option, optionErr
+		}
+	}
+	return l, err
+}
+
+// parseAnd parses terms separated by AND, returning the most restrictive license
+func (p *expressionParser) parseAnd() (License, error) {
+	l, err := p.parseTerm()
+	if isMalformed(err) {
+		return License{}, err
+	}
+	for strings.EqualFold(p.peek(), "AND") {
+		p.next()
+		term, termErr := p.parseTerm()
+		if isMalformed(termErr) {
+			return License{}, termErr
+		}
+		if err == nil && (termErr != nil || restrictivenessOf(term) > restrictivenessOf(l)) {
+			l, err = term, termErr
+		}
+	}
+	return l, err
+}
+
+// parseTerm parses a parenthesised expression or a license identifier along with an optional exception
+func (p *expressionParser) parseTerm() (License, error) {
+	token := p.next()
+	switch {
+	case token == "(":
+		l, err := p.parseOr()
+		if isMalformed(err) {
+			return License{}, err
+		}
+		if p.next() != ")" {
+			return License{}, p.malformed()
+		}
+		return l, err
+	case token == "", token == ")", strings.EqualFold(token, "AND"), strings.EqualFold(token, "OR"), strings.EqualFold(token, "WITH"):
+		return License{}, p.malformed()
+	}
+	if !strings.EqualFold(p.peek(), "WITH") {
+		return GetLicenseFromIdentifier(token)
+	}
+	p.next()
+	exception := p.next()
+	if exception == "" || exception == "(" || exception == ")" {
+		return License{}, p.malformed()
+	}
+	l, err := GetLicenseFromIdentifier(token + " WITH " + exception)
+	if err != nil {
+		// an unknown exception only grants further permissions, so the license alone describes the obligations
+		return GetLicenseFromIdentifier(token)
+	}
+	return l, nil
+}
@@ -0,0 +1,52 @@
+package diligent_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestGetLicenseFromExpression(t *testing.T) {
+	cases := []struct {
+		expression string
+		expID      string
+		expErr     error
+	}{
+		{"MIT", "MIT", nil},
+		{"woowoo OR BSD-3-Clause", "BSD-3-Clause", nil},
+		{"MIT or Apache-2.0", "MIT", nil},
+		{"MIT AND GPL-3.0-only AND Apache-2.0", "GPL-3.0-only", nil},
+		{"(woowoo OR MPL-2.0) AND (MIT OR GPL-2.0-only)", "MPL-2.0", nil},
+		{"(LGPL-2.1-only or GPL-3.0-or-later)", "LGPL-2.1-only", nil},
+		{"GPL-2.0-only WITH Classpath-exception-2.0", "GPL-2.0-only WITH Classpath-exception-2.0", nil},
+		{"GPL-2.0-only WITH Unknown-exception", "GPL-2.0-only", nil},
+		{"MIT AND woowoo", "", &diligent.UnknownIdentifierError{}},
+		{"woowoo", "", &diligent.UnknownIdentifierError{}},
+		{"(MIT OR woowoo", "", &diligent.MalformedError{}},
+		{"MIT OR", "", &diligent.MalformedError{}},
+		{"MIT Apache-2.0", "", &diligent.MalformedError{}},
+		{"", "", &diligent.MalformedError{}},
+	}
+	for _, c := range cases {
+		t.Run(c.expression, func(t *testing.T) {
+			l, err := diligent.GetLicenseFromExpression(c.expression)
+			switch c.expErr.(type) {
+			case nil:
+				if err != nil || l.Identifier != c.expID {
+					t.Errorf("expected %s, got %+v %v", c.expID, l, err)
+				}
+			case *diligent.UnknownIdentifierError:
+				var unknownErr *diligent.UnknownIdentifierError
+				if !errors.As(err, &unknownErr) {
+					t.Errorf("expected unknown identifier error, got %+v %v", l, err)
+				}
+			case *diligent.MalformedError:
+				var malformedErr *diligent.MalformedError
+				if !errors.As(err, &malformedErr) {
+					t.Errorf("expected malformed error, got %+v %v", l, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package diligent
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-enry/go-license-detector/v4/licensedb/filer"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// SetGitHTTPClient sets the client used to clone repositories over HTTP and HTTPS, allowing proxies, certificate
+// authorities and credentials to be configured. The git library only supports a single client per process, so this
+// affects every clone made by the process
+func SetGitHTTPClient(c *http.Client) {
+	client.InstallProtocol("https", githttp.NewClient(c))
+	client.InstallProtocol("http", githttp.NewClient(c))
+}
+
+// GetLicenseForGitContext is identical to GetLicenseForGit but stops cloning once ctx is done
+func GetLicenseForGitContext(ctx context.Context, url string) (License, error) {
+	if strings.HasPrefix(url, "git+") {
+		url = strings.Replace(url, "git+", "", 1)
+	}
+	rctx, cancel, err := RequestContext(ctx)
+	if err != nil {
+		return License{}, err
+	}
+	defer cancel()
+	repo, err := git.CloneContext(rctx, memory.NewStorage(), nil, &git.CloneOptions{URL: url})
+	if err != nil {
+		return License{}, &CloneError{url, err}
+	}
+	files, err := filer.FromGit(repo, "")
+	if err != nil {
+		return License{}, err
+	}
+	return getLicenseForFiles(files)
+}
@@ -1,6 +1,7 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,12 +17,28 @@ import (
 
 // Github houses a variety of methods associated with retrieving license information from github
 type Github struct {
-	url string
+	url    string
+	config Config
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Client sends requests to the API, allowing proxies, certificate authorities and credentials to be configured.
+	// http.DefaultClient is used when nil
+	Client *http.Client
 }
 
 // New returns an instance of Github pointing at the provided API URL
 func New(apiURL string) *Github {
-	return &Github{apiURL}
+	return NewWithOptions(apiURL, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(apiURL string, c Config) *Github {
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+	return &Github{apiURL, c}
 }
 
 var pathComponentsRegex = regexp.MustCompile(`\/([^/]*)`)
@@ -59,24 +76,77 @@ func (g *Github) IsCompatibleURL(s string) bool {
 	return err == nil
 }
 
-// GetLicenseFromURL will attempt to get the license associated with a github repo
+// getRefFromURL returns the branch, tag or commit identified by a github tree URL,
+// for example https://github.com/senseyeio/spaniel/tree/v1.0.0
+func getRefFromURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	pathComponents := pathComponentsRegex.FindAllStringSubmatch(u.Path, 4)
+	if len(pathComponents) != 4 || pathComponents[2][1] != "tree" {
+		return ""
+	}
+	return pathComponents[3][1]
+}
+
+// GetLicenseFromURL will attempt to get the license associated with a github repo.
+// If the URL points at a tree, the license at that branch, tag or commit is returned
 func (g *Github) GetLicenseFromURL(s string) (diligent.License, error) {
+	return g.GetLicenseFromURLTraced(s, nil)
+}
+
+// GetLicenseFromURLTraced is identical to GetLicenseFromURL but records each step taken within the trace
+func (g *Github) GetLicenseFromURLTraced(s string, t *diligent.Trace) (diligent.License, error) {
+	return g.GetLicenseFromURLContext(context.Background(), s, t)
+}
+
+// GetLicenseFromURLContext is identical to GetLicenseFromURLTraced but cancels its requests once ctx is done
+func (g *Github) GetLicenseFromURLContext(ctx context.Context, s string, t *diligent.Trace) (diligent.License, error) {
 	owner, repo, err := getOwnerAndRepoFromURL(s)
 	if err != nil {
+		t.Record("github", s, diligent.License{}, err)
 		return diligent.License{}, err
 	}
-	return g.GetLicense(owner, repo)
+	return g.getLicenseAtRef(ctx, owner, repo, getRefFromURL(s), t)
 }
 
-func (g *Github) assessLicenseFile(license licenseResponse) (diligent.License, error) {
-	if license.Name == nil || license.DownloadURL == nil {
-		return diligent.License{}, errors.New("no license information available")
+// get returns the body of the response to a request for the URL, applying any request timeout held by ctx. A
+// diligent.StatusError is returned if the response was unsuccessful, for example when rate limited
+func (g *Github) get(ctx context.Context, url string) ([]byte, error) {
+	rctx, cancel, err := diligent.RequestContext(ctx)
+	if err != nil {
+		return nil, err
 	}
-	resp, err := http.Get(*license.DownloadURL)
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
+		return nil, err
+	}
+	resp, err := g.config.Client.Do(req.WithContext(rctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &diligent.StatusError{StatusCode: resp.StatusCode}
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (g *Github) assessLicenseFile(ctx context.Context, license licenseResponse, t *diligent.Trace) (diligent.License, error) {
+	if license.Name == nil || license.DownloadURL == nil {
+		err := errors.New("no license information available")
+		t.Record("github license file", "", diligent.License{}, err)
 		return diligent.License{}, err
 	}
-	text, err := ioutil.ReadAll(resp.Body)
+	l, err := g.downloadLicenseFile(ctx, license)
+	t.Record("github license file", *license.DownloadURL, l, err)
+	return l, err
+}
+
+func (g *Github) downloadLicenseFile(ctx context.Context, license licenseResponse) (diligent.License, error) {
+	text, err := g.get(ctx, *license.DownloadURL)
 	if err != nil {
 		return diligent.License{}, err
 	}
@@ -95,23 +165,44 @@ func (g *Github) assessLicenseFile(license licenseResponse) (diligent.License, e
 
 // GetLicense will attempt to get the license associated with a repository identified by its owner and name
 func (g *Github) GetLicense(owner, repo string) (diligent.License, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s/license", g.url, url.PathEscape(owner), url.PathEscape(repo))
-	resp, err := http.Get(url)
+	return g.GetLicenseAtRef(owner, repo, "")
+}
+
+// GetLicenseAtRef is identical to GetLicense but returns the license found at a given branch, tag or commit.
+// The default branch is used when ref is empty
+func (g *Github) GetLicenseAtRef(owner, repo, ref string) (diligent.License, error) {
+	return g.GetLicenseAtRefContext(context.Background(), owner, repo, ref)
+}
+
+// GetLicenseAtRefContext is identical to GetLicenseAtRef but cancels its requests once ctx is done
+func (g *Github) GetLicenseAtRefContext(ctx context.Context, owner, repo, ref string) (diligent.License, error) {
+	return g.getLicenseAtRef(ctx, owner, repo, ref, nil)
+}
+
+func (g *Github) getLicenseAtRef(ctx context.Context, owner, repo, ref string, t *diligent.Trace) (diligent.License, error) {
+	licenseURL := fmt.Sprintf("%s/repos/%s/%s/license", g.url, url.PathEscape(owner), url.PathEscape(repo))
+	if ref != "" {
+		licenseURL += "?ref=" + url.QueryEscape(ref)
+	}
+	body, err := g.get(ctx, licenseURL)
 	if err != nil {
+		t.Record("github spdx_id", licenseURL, diligent.License{}, err)
 		return diligent.License{}, err
 	}
-	defer resp.Body.Close()
 
 	var data licenseResponse
-	err = json.NewDecoder(resp.Body).Decode(&data)
+	err = json.Unmarshal(body, &data)
 	if err != nil {
+		t.Record("github spdx_id", licenseURL, diligent.License{}, err)
 		return diligent.License{}, err
 	}
 	if data.License.SPDX != nil {
-		license, err := diligent.GetLicenseFromIdentifier(*data.License.SPDX)
+		license, err := diligent.TraceLicenseFromIdentifier(*data.License.SPDX, "github spdx_id", licenseURL, t)
 		if err == nil {
 			return license, nil
 		}
+	} else {
+		t.Record("github spdx_id", licenseURL, diligent.License{}, errors.New("no spdx_id in response"))
 	}
-	return g.assessLicenseFile(data)
+	return g.assessLicenseFile(ctx, data, t)
 }
@@ -1,6 +1,7 @@
 package github_test
 
 import (
+	"errors"
 	"testing"
 
 	"net/http"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/github"
+	"github.com/senseyeio/diligent/spdx"
 )
 
 func TestIsCompatibleURL(t *testing.T) {
@@ -60,6 +62,21 @@ func TestGetLicenseFromURL(t *testing.T) {
 		}),
 		"MIT",
 		false,
+	}, {
+		"should lookup license at a given ref",
+		"https://github.com/senseyeio/spaniel/tree/v1.0.0",
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/repos/senseyeio/spaniel/license" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			if r.URL.Query().Get("ref") != "v1.0.0" {
+				t.Errorf("unexpected ref %s", r.URL.Query().Get("ref"))
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("{\"license\":{\"spdx_id\":\"Apache-2.0\"}}"))
+		}),
+		"Apache-2.0",
+		false,
 	}, {
 		"should fail if not github URL",
 		"https://senseye.io/senseyeio/spaniel",
@@ -123,3 +140,65 @@ func TestGetLicenseFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLicenseFromURLStatus(t *testing.T) {
+	cases := []struct {
+		status int
+		kind   diligent.WarningKind
+	}{
+		{http.StatusNotFound, diligent.NotFound},
+		{http.StatusForbidden, diligent.NetworkFailure},
+		{http.StatusTooManyRequests, diligent.NetworkFailure},
+	}
+	for _, c := range cases {
+		t.Run(http.StatusText(c.status), func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(c.status)
+				w.Write([]byte(`{"message":"failed"}`))
+			}))
+			defer ts.Close()
+			_, err := github.New(ts.URL).GetLicenseFromURL("https://github.com/senseyeio/spaniel")
+			var statusErr *diligent.StatusError
+			if !errors.As(err, &statusErr) || statusErr.StatusCode != c.status {
+				t.Fatalf("expected status %d error, got %v", c.status, err)
+			}
+			if kind := diligent.WarningKindOf(err); kind != c.kind {
+				t.Errorf("expected %s, got %s", c.kind, kind)
+			}
+		})
+	}
+}
+
+func TestGetLicenseFromURLTraced(t *testing.T) {
+	mit, _ := spdx.Text("MIT")
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/senseyeio/spaniel/license":
+			w.Write([]byte(`{"name":"LICENSE","download_url":"` + ts.URL + `/LICENSE","license":{"spdx_id":"NOASSERTION"}}`))
+		case "/LICENSE":
+			w.Write([]byte(mit))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	var trace diligent.Trace
+	l, err := github.New(ts.URL).GetLicenseFromURLTraced("https://github.com/senseyeio/spaniel", &trace)
+	if err != nil || l.Identifier != "MIT" {
+		t.Fatalf("expected MIT, got %+v %v", l, err)
+	}
+	if len(trace) != 2 {
+		t.Fatalf("expected 2 steps, got %+v", trace)
+	}
+	if trace[0].Source != "github spdx_id" || trace[0].Err == "" || trace[0].Location != ts.URL+"/repos/senseyeio/spaniel/license" {
+		t.Errorf("unexpected first step %+v", trace[0])
+	}
+	if trace[1].Source != "github license file" || trace[1].License != "MIT" || trace[1].Location != ts.URL+"/LICENSE" {
+		t.Errorf("unexpected second step %+v", trace[1])
+	}
+	if winner, ok := trace.Winner(); !ok || winner != trace[1] {
+		t.Errorf("unexpected winner %+v", winner)
+	}
+}
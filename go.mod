@@ -5,6 +5,7 @@ go 1.13
 require (
 	github.com/BurntSushi/toml v0.3.1 // indirect
 	github.com/go-enry/go-license-detector/v4 v4.0.0
+	github.com/go-git/go-git/v5 v5.1.0
 	github.com/google/go-cmp v0.3.1 // indirect
 	github.com/inconshreveable/mousetrap v1.0.0 // indirect
 	github.com/montanaflynn/stats v0.0.0-20171201202039-1bf9dbcd8cbe // indirect
@@ -13,4 +14,5 @@ require (
 	github.com/sirkon/goproxy v1.4.8
 	github.com/spf13/cobra v0.0.1
 	gonum.org/v1/netlib v0.0.0-20190926062253-2d6e29b73a19 // indirect
+	gopkg.in/yaml.v2 v2.4.0
 )
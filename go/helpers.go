@@ -1,6 +1,7 @@
 package _go
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"go/build"
@@ -37,44 +38,71 @@ func goPath() string {
 
 // GetLicense will return the license associated with a given go package
 func (lg *LicenseGetter) GetLicense(packagePath string) (diligent.License, error) {
+	return lg.GetLicenseTraced(packagePath, nil)
+}
+
+// GetLicenseTraced is identical to GetLicense but records each step taken within the trace
+func (lg *LicenseGetter) GetLicenseTraced(packagePath string, t *diligent.Trace) (diligent.License, error) {
+	return lg.GetLicenseContext(context.Background(), packagePath, t)
+}
+
+// GetLicenseContext is identical to GetLicenseTraced but cancels its requests, including go get, once ctx is done
+func (lg *LicenseGetter) GetLicenseContext(ctx context.Context, packagePath string, t *diligent.Trace) (diligent.License, error) {
 	components := strings.Split(packagePath, "/")
 	// in some go vendoring solutions full paths to packages are defined as dependencies
 	// need to look for the base package identifier so github.com/aws/aws-sdk-go/aws becomes github.com/aws/aws-sdk-go
 	if len(components) < 2 {
-		return diligent.License{}, errors.New("invalid go package path")
+		err := errors.New("invalid go package path")
+		t.Record("go package", packagePath, diligent.License{}, err)
+		return diligent.License{}, err
 	}
 	// try a three component base package, if possible, as it is most common
 	if len(components) >= 3 {
-		l, err := lg.getLicenseForBasePackage(strings.Join(components[:3], "/"))
+		l, err := lg.getLicenseForBasePackage(ctx, strings.Join(components[:3], "/"), t)
 		if err == nil {
 			return l, nil
 		}
 	}
 	// can have libraries with just two components, for example gopkg.in/mgo.v2
-	return lg.getLicenseForBasePackage(strings.Join(components[:2], "/"))
+	return lg.getLicenseForBasePackage(ctx, strings.Join(components[:2], "/"), t)
 }
 
-func (lg *LicenseGetter) getLicenseForBasePackage(pkg string) (diligent.License, error) {
+func (lg *LicenseGetter) getLicenseForBasePackage(ctx context.Context, pkg string, t *diligent.Trace) (diligent.License, error) {
 	if lg.webLG.IsCompatibleURL(fmt.Sprintf("https://%s", pkg)) {
-		l, err := lg.webLG.GetLicenseFromURL(fmt.Sprintf("https://%s", pkg))
+		l, err := diligent.GetLicenseFromURL(ctx, lg.webLG, fmt.Sprintf("https://%s", pkg), t)
 		if err == nil {
 			return l, nil
 		}
 	}
-	l, err := getLicenseFromLicenseFile(pkg)
+	l, err := getLicenseFromLicenseFile(ctx, pkg, t)
 	if err == nil {
 		return l, nil
 	}
 	return diligent.License{}, err
 }
 
-func getLicenseFromLicenseFile(pkg string) (diligent.License, error) {
-	cmd := exec.Command("go", "get", "-d", fmt.Sprintf("%s/...", pkg))
+func getLicenseFromLicenseFile(ctx context.Context, pkg string, t *diligent.Trace) (diligent.License, error) {
+	dir := fmt.Sprintf("%s/src/%s", goPath(), pkg)
+	rctx, cancel, err := diligent.RequestContext(ctx)
+	if err != nil {
+		t.Record("go get", pkg, diligent.License{}, err)
+		if _, statErr := os.Stat(dir); errors.Is(err, diligent.ErrOffline) && statErr == nil {
+			// the package may have been fetched into the GOPATH previously
+			return diligent.TraceLicenseForDirectory(dir, t)
+		}
+		return diligent.License{}, err
+	}
+	defer cancel()
+	cmd := exec.CommandContext(rctx, "go", "get", "-d", fmt.Sprintf("%s/...", pkg))
 	cmd.Env = append(os.Environ(), "GO111MODULE=off")
-	err := cmd.Run()
+	err = cmd.Run()
+	if rctx.Err() != nil {
+		// report the deadline or cancellation rather than the killed process
+		err = rctx.Err()
+	}
 	if err != nil {
+		t.Record("go get", pkg, diligent.License{}, err)
 		return diligent.License{}, err
 	}
-	dir := fmt.Sprintf("%s/src/%s", goPath(), pkg)
-	return diligent.GetLicenseForDirectory(dir)
+	return diligent.TraceLicenseForDirectory(dir, t)
 }
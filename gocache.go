@@ -0,0 +1,40 @@
+package diligent
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// GoModCacheDir returns the directory in which the Go module cache holds the version of the module, or an empty
+// string if the version is unknown. The directory may not exist
+func GoModCacheDir(path, version string) string {
+	if version == "" {
+		return ""
+	}
+	cache := os.Getenv("GOMODCACHE")
+	if cache == "" {
+		gopath := os.Getenv("GOPATH")
+		if gopath == "" {
+			gopath = build.Default.GOPATH
+		}
+		cache = filepath.Join(filepath.SplitList(gopath)[0], "pkg", "mod")
+	}
+	return filepath.Join(cache, filepath.FromSlash(escapeModulePath(path))+"@"+version)
+}
+
+// escapeModulePath escapes upper case letters as the Go module cache does, for example Azure becomes !azure
+func escapeModulePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if unicode.IsUpper(r) {
+			b.WriteRune('!')
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
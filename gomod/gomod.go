@@ -1,13 +1,27 @@
 package gomod
 
 import (
+	"context"
+
 	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/warning"
 	module "github.com/sirkon/goproxy/gomod"
 )
 
+type requirement struct {
+	path    string
+	version string
+}
+
 type vgo struct {
-	lg GoLicenseGetter
+	config Config
+	lg     GoLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
 }
 
 type GoLicenseGetter interface {
@@ -16,7 +30,12 @@ type GoLicenseGetter interface {
 
 // New returns a Deper capable of handling dep manifest files
 func New(lg GoLicenseGetter) diligent.Deper {
-	return &vgo{lg}
+	return NewWithOptions(lg, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(lg GoLicenseGetter, c Config) diligent.Deper {
+	return &vgo{c, lg}
 }
 
 // Name returns "gomod"
@@ -26,24 +45,29 @@ func (v *vgo) Name() string {
 
 // Dependencies returns the licenses of the go packages defined within the dep manifest
 func (v *vgo) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return v.DependenciesContext(context.Background(), file)
+}
+
+// DependenciesContext is identical to Dependencies but cancels the lookups once ctx is done
+func (v *vgo) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
 	mod, err := module.Parse("go.mod", file)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	pkgs := make([]string, 0, len(mod.Require))
-	for pkg := range mod.Require {
-		pkgs = append(pkgs, pkg)
+	reqs := make([]requirement, 0, len(mod.Require))
+	for pkg, version := range mod.Require {
+		reqs = append(reqs, requirement{pkg, version})
 	}
 
 	for old, new := range mod.Replace {
-		for i := range pkgs {
-			if old == pkgs[i] {
+		for i := range reqs {
+			if old == reqs[i].path {
 				switch cast := new.(type) {
 				case module.Dependency:
-					pkgs[i] = cast.Path
+					reqs[i] = requirement{cast.Path, cast.Version}
 				case module.RelativePath:
-					pkgs[i] = string(cast)
+					reqs[i] = requirement{string(cast), ""}
 				}
 			}
 		}
@@ -51,20 +75,39 @@ func (v *vgo) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, err
 
 	deps := make([]diligent.Dep, 0, len(mod.Require))
 	warns := make([]diligent.Warning, 0, len(mod.Require))
-	for _, pkg := range pkgs {
-		l, err := v.lg.GetLicense(pkg)
+	for _, req := range reqs {
+		if d, ok := v.config.Overrides.Dep(v.Name(), req.path, req.version); ok {
+			deps = append(deps, d)
+			continue
+		}
+		var t diligent.Trace
+		l, err := v.getLicense(ctx, req, &t)
 		if err != nil {
-			warns = append(warns, warning.New(pkg, err.Error()))
+			warns = append(warns, warning.FromError(v.Name(), req.path, req.version, err, t))
 		} else {
 			deps = append(deps, diligent.Dep{
-				Name:    pkg,
+				Name:    req.path,
+				Version: req.version,
 				License: l,
+				Trace:   t,
 			})
 		}
 	}
 	return deps, warns, nil
 }
 
+func (v *vgo) getLicense(ctx context.Context, req requirement, t *diligent.Trace) (diligent.License, error) {
+	if diligent.IsOffline(ctx) {
+		// the module cache holds the source of every module which has been built or downloaded
+		if dir := diligent.GoModCacheDir(req.path, req.version); dir != "" {
+			if l, err := diligent.TraceLicenseForDirectory(dir, t); err == nil {
+				return l, nil
+			}
+		}
+	}
+	return diligent.GetPackageLicense(ctx, v.lg, req.path, t)
+}
+
 // IsCompatible returns true if the filename is Gopkg.lock
 func (v *vgo) IsCompatible(filename string) bool {
 	return filename == "go.mod"
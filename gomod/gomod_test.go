@@ -1,7 +1,11 @@
 package gomod_test
 
 import (
+	"context"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
@@ -9,7 +13,6 @@ import (
 	"github.com/senseyeio/diligent/gomod"
 
 	"github.com/senseyeio/diligent"
-	"github.com/senseyeio/diligent/warning"
 )
 
 type licenseGetterResponse struct {
@@ -75,7 +78,7 @@ var depTests = []struct {
 	in            []byte
 	getLicenseLUT map[string]licenseGetterResponse
 	depsOut       []diligent.Dep
-	warnsOut      []diligent.Warning
+	warnsOut      []diligent.WarningDetails
 	errOut        bool
 }{{
 	"single dependency",
@@ -91,9 +94,10 @@ require github.com/inconshreveable/mousetrap v1.0.0
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/inconshreveable/mousetrap",
+		Version: "v1.0.0",
 		License: diligent.License{Identifier: "MIT"},
 	}},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	false,
 }, {
 	"multiple dependencies",
@@ -116,12 +120,14 @@ require (
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/inconshreveable/mousetrap",
+		Version: "v1.0.0",
 		License: diligent.License{Identifier: "MIT"},
 	}, {
 		Name:    "github.com/pelletier/go-toml",
+		Version: "v1.1.0",
 		License: diligent.License{Identifier: "DOC"},
 	}},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	false,
 }, {
 	"part failure dependencies",
@@ -144,10 +150,11 @@ require (
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/inconshreveable/mousetrap",
+		Version: "v1.0.0",
 		License: diligent.License{Identifier: "MIT"},
 	}},
-	[]diligent.Warning{
-		warning.New("github.com/pelletier/go-toml", "error"),
+	[]diligent.WarningDetails{
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "gomod", Package: "github.com/pelletier/go-toml", Version: "v1.1.0", Err: errors.New("error")},
 	},
 	false,
 }, {
@@ -170,9 +177,9 @@ require (
 		},
 	},
 	[]diligent.Dep{},
-	[]diligent.Warning{
-		warning.New("github.com/inconshreveable/mousetrap", "eeek"),
-		warning.New("github.com/pelletier/go-toml", "error"),
+	[]diligent.WarningDetails{
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "gomod", Package: "github.com/inconshreveable/mousetrap", Version: "v1.0.0", Err: errors.New("eeek")},
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "gomod", Package: "github.com/pelletier/go-toml", Version: "v1.1.0", Err: errors.New("error")},
 	},
 	false,
 }, {
@@ -184,7 +191,7 @@ require (
 `),
 	map[string]licenseGetterResponse{},
 	[]diligent.Dep{},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	true,
 }, {
 	"replacements",
@@ -208,12 +215,14 @@ replace github.com/pelletier/go-toml v1.1.0 => github.com/russross/blackfriday/v
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/inconshreveable/mousetrap",
+		Version: "v1.0.0",
 		License: diligent.License{Identifier: "MIT"},
 	}, {
 		Name:    "github.com/russross/blackfriday/v2",
+		Version: "v2.0.1",
 		License: diligent.License{Identifier: "REP"},
 	}},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	false,
 }}
 
@@ -223,6 +232,9 @@ func TestDependencies(t *testing.T) {
 			mockLG := newMockLicenseGetter(t, tt.getLicenseLUT)
 			target := gomod.New(mockLG)
 			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
 			if len(d) > 0 || len(tt.depsOut) > 0 {
 				actual := diligent.DepsByName(d)
 				sort.Sort(actual)
@@ -232,13 +244,9 @@ func TestDependencies(t *testing.T) {
 					t.Errorf("deps: got %v, want %v", actual, expected)
 				}
 			}
-			if len(w) > 0 || len(tt.warnsOut) > 0 {
-				actual := diligent.Warnings(w)
-				sort.Sort(actual)
-				expected := diligent.Warnings(tt.warnsOut)
-				sort.Sort(expected)
-				if reflect.DeepEqual(actual, expected) == false {
-					t.Errorf("warnings: got %v, want %v", actual, expected)
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
 				}
 			}
 			isErr := e != nil
@@ -248,3 +256,68 @@ func TestDependencies(t *testing.T) {
 		})
 	}
 }
+
+const mitLicense = `MIT License
+
+Copyright (c) 2018 Senseye Ltd
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+`
+
+func TestOffline(t *testing.T) {
+	cache, err := ioutil.TempDir("", "gomodcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(cache)
+	defer os.Setenv("GOMODCACHE", os.Getenv("GOMODCACHE"))
+	os.Setenv("GOMODCACHE", cache)
+	modDir := filepath.Join(cache, "github.com", "!azure", "cached@v1.0.0")
+	if err := os.MkdirAll(modDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(modDir, "LICENSE"), []byte(mitLicense), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the mock fails the test if it is called
+	target := gomod.New(newMockLicenseGetter(t, nil)).(diligent.ContextDeper)
+	file := []byte("module github.com/senseyeio/diligent\n\nrequire (\n\tgithub.com/Azure/cached v1.0.0\n\tgithub.com/not/cached v1.0.0\n)\n")
+	d, w, err := target.DependenciesContext(diligent.WithOffline(context.Background()), file)
+	if err != nil || len(d) != 1 || len(w) != 1 {
+		t.Fatalf("unexpected result %+v %+v %v", d, w, err)
+	}
+	if d[0].Name != "github.com/Azure/cached" || d[0].License.Identifier != "MIT" {
+		t.Errorf("expected the cached module to be MIT licensed, got %+v", d[0])
+	}
+	if expected := "Failed to determine license for github.com/not/cached: " + diligent.ErrOffline.Error(); w[0].Warning() != expected {
+		t.Errorf("expected warning %q, got %q", expected, w[0].Warning())
+	}
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
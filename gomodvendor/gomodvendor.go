@@ -0,0 +1,194 @@
+package gomodvendor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+)
+
+// module is a module listed within vendor/modules.txt
+type module struct {
+	// Path is the module path as required by go.mod
+	Path string
+	// Version is the required version, it is empty for modules replaced by a local directory
+	Version string
+	// Replacement is the module path or local directory replacing Path, if any
+	Replacement string
+	// ReplacementVersion is the version of the replacing module, if any
+	ReplacementVersion string
+	// Explicit is true if the module is required explicitly by go.mod
+	Explicit bool
+	// Packages are the vendored packages provided by the module
+	Packages []string
+}
+
+// Name returns the name under which the module is reported, replacements take precedence
+func (m module) Name() string {
+	if m.Replacement != "" {
+		return m.Replacement
+	}
+	return m.Path
+}
+
+// version returns the version of the module in use, replacements take precedence
+func (m module) version() string {
+	if m.Replacement != "" {
+		return m.ReplacementVersion
+	}
+	return m.Version
+}
+
+type gomodvendor struct {
+	config Config
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
+}
+
+// New returns a Deper capable of handling the vendor/modules.txt files created by 'go mod vendor'.
+// Licenses are determined from the vendored sources, so no network access is required
+func New() diligent.Deper {
+	return NewWithOptions(Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(c Config) diligent.Deper {
+	return &gomodvendor{c}
+}
+
+// Name returns "gomodvendor"
+func (g *gomodvendor) Name() string {
+	return "gomodvendor"
+}
+
+// Dependencies returns the licenses of the vendored modules, assuming the vendor directory is ./vendor
+func (g *gomodvendor) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return g.LocalDependencies("vendor", file)
+}
+
+// LocalDependencies returns the licenses of the modules vendored within dir
+func (g *gomodvendor) LocalDependencies(dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	mods, err := parse(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deps := make([]diligent.Dep, 0, len(mods))
+	warns := make([]diligent.Warning, 0, len(mods))
+	for _, mod := range mods {
+		// modules which provide no packages are not vendored, so are not part of the build
+		if len(mod.Packages) == 0 {
+			continue
+		}
+		if d, ok := g.config.Overrides.Dep(g.Name(), mod.Name(), mod.version()); ok {
+			deps = append(deps, d)
+			continue
+		}
+		var t diligent.Trace
+		l, err := getLicense(dir, mod, &t)
+		if err != nil {
+			warns = append(warns, warning.FromError(g.Name(), mod.Name(), mod.version(), err, t))
+		} else {
+			deps = append(deps, diligent.Dep{
+				Name:    mod.Name(),
+				Version: mod.version(),
+				License: l,
+				Trace:   t,
+			})
+		}
+	}
+	return deps, warns, nil
+}
+
+// IsCompatible returns true if the filename is modules.txt
+func (g *gomodvendor) IsCompatible(filename string) bool {
+	return filename == "modules.txt"
+}
+
+// getLicense looks for a license at the root of the vendored module, then within each of its vendored packages.
+// Vendored sources are always stored under the original module path, even when replaced.
+func getLicense(dir string, mod module, t *diligent.Trace) (diligent.License, error) {
+	l, err := diligent.TraceLicenseForDirectory(filepath.Join(dir, filepath.FromSlash(mod.Path)), t)
+	if err == nil {
+		return l, nil
+	}
+	for _, pkg := range mod.Packages {
+		if pkg == mod.Path {
+			continue
+		}
+		if l, pkgErr := diligent.TraceLicenseForDirectory(filepath.Join(dir, filepath.FromSlash(pkg)), t); pkgErr == nil {
+			return l, nil
+		}
+	}
+	return diligent.License{}, err
+}
+
+// parse returns the modules listed within a vendor/modules.txt file
+func parse(file []byte) ([]module, error) {
+	mods := make([]module, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(file))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "## "):
+			if len(mods) == 0 {
+				return nil, fmt.Errorf("modules.txt:%d: annotation found before any module", lineNo)
+			}
+			for _, annotation := range strings.Split(strings.TrimPrefix(line, "## "), ";") {
+				if strings.TrimSpace(annotation) == "explicit" {
+					mods[len(mods)-1].Explicit = true
+				}
+			}
+		case strings.HasPrefix(line, "# "):
+			mod, err := parseModuleLine(strings.TrimPrefix(line, "# "))
+			if err != nil {
+				return nil, fmt.Errorf("modules.txt:%d: %v", lineNo, err)
+			}
+			mods = append(mods, mod)
+		case strings.HasPrefix(line, "#"):
+		default:
+			if len(mods) == 0 {
+				return nil, fmt.Errorf("modules.txt:%d: package found before any module", lineNo)
+			}
+			mods[len(mods)-1].Packages = append(mods[len(mods)-1].Packages, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return mods, nil
+}
+
+// parseModuleLine parses "path version", "path [version] => replacement [version]" module lines
+func parseModuleLine(line string) (module, error) {
+	var mod module
+	parts := strings.SplitN(line, "=>", 2)
+	original := strings.Fields(parts[0])
+	if len(original) < 1 || len(original) > 2 {
+		return mod, fmt.Errorf("invalid module line '%s'", line)
+	}
+	mod.Path = original[0]
+	if len(original) == 2 {
+		mod.Version = original[1]
+	}
+	if len(parts) == 2 {
+		replacement := strings.Fields(parts[1])
+		if len(replacement) < 1 || len(replacement) > 2 {
+			return mod, fmt.Errorf("invalid module replacement '%s'", line)
+		}
+		mod.Replacement = replacement[0]
+		if len(replacement) == 2 {
+			mod.ReplacementVersion = replacement[1]
+		}
+	}
+	return mod, nil
+}
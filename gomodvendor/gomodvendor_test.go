@@ -0,0 +1,190 @@
+package gomodvendor_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-enry/go-license-detector/v4/licensedb"
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/gomodvendor"
+)
+
+const mitLicense = `MIT License
+
+Copyright (c) 2018 Senseye Ltd
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+`
+
+func TestName(t *testing.T) {
+	target := gomodvendor.New()
+	if target.Name() != "gomodvendor" {
+		t.Error("expected 'gomodvendor'")
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out bool
+	}{
+		{"modules.txt", true},
+		{"modules.txt.old", false},
+		{"vendor.json", false},
+		{"go.mod", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			target := gomodvendor.New()
+			compatible := target.IsCompatible(tt.in)
+			if compatible != tt.out {
+				t.Errorf("got %v, want %v", compatible, tt.out)
+			}
+		})
+	}
+}
+
+func writeLicense(t *testing.T, dir string) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "LICENSE"), []byte(mitLicense), 0666); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLocalDependencies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diligent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeLicense(t, filepath.Join(dir, "github.com", "root", "license"))
+	writeLicense(t, filepath.Join(dir, "github.com", "nested", "license", "pkg"))
+	writeLicense(t, filepath.Join(dir, "github.com", "replaced", "mod"))
+	if err := os.MkdirAll(filepath.Join(dir, "github.com", "no", "license"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	cases := []struct {
+		description string
+		in          []byte
+		depsOut     []diligent.Dep
+		warnsOut    []diligent.WarningDetails
+		errOut      bool
+	}{{
+		"should read licenses from the vendored sources",
+		[]byte(`# github.com/root/license v1.0.0
+## explicit
+github.com/root/license
+# github.com/nested/license v0.0.0-20170608043002-7fe510aff544
+github.com/nested/license/pkg
+# github.com/replaced/mod v1.0.0 => github.com/fork/mod v1.0.1
+## explicit; go 1.13
+github.com/replaced/mod
+# github.com/not/vendored v1.0.0
+## explicit
+# github.com/local/replacement => ../replacement
+`),
+		[]diligent.Dep{
+			{Name: "github.com/fork/mod", Version: "v1.0.1", License: mit},
+			{Name: "github.com/nested/license", Version: "v0.0.0-20170608043002-7fe510aff544", License: mit},
+			{Name: "github.com/root/license", Version: "v1.0.0", License: mit},
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should warn when no license is found",
+		[]byte(`# github.com/no/license v1.0.0
+github.com/no/license
+# github.com/missing/dir v1.0.0
+github.com/missing/dir
+`),
+		[]diligent.Dep{},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NotFound, Ecosystem: "gomodvendor", Package: "github.com/missing/dir", Version: "v1.0.0", Err: os.ErrNotExist},
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "gomodvendor", Package: "github.com/no/license", Version: "v1.0.0", Err: licensedb.ErrNoLicenseFound},
+		},
+		false,
+	}, {
+		"should fail on packages outside of a module",
+		[]byte(`github.com/no/license
+`),
+		[]diligent.Dep{},
+		[]diligent.WarningDetails{},
+		true,
+	}, {
+		"should fail on invalid module lines",
+		[]byte(`# github.com/no/license v1.0.0 extra
+github.com/no/license
+`),
+		[]diligent.Dep{},
+		[]diligent.WarningDetails{},
+		true,
+	}}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			target := gomodvendor.New().(diligent.LocalDeper)
+			d, w, e := target.LocalDependencies(dir, tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
+			if len(d) > 0 || len(tt.depsOut) > 0 {
+				sort.Sort(diligent.DepsByName(d))
+				if reflect.DeepEqual(d, tt.depsOut) == false {
+					t.Errorf("deps: got %+v, want %+v", d, tt.depsOut)
+				}
+			}
+			// errors are compared using errors.Is, as those from the file system cannot be constructed
+			got := warningDetails(w)
+			if len(got) != len(tt.warnsOut) {
+				t.Fatalf("warnings: got %+v, want %+v", got, tt.warnsOut)
+			}
+			for i, g := range got {
+				want := tt.warnsOut[i]
+				if g.Kind != want.Kind || g.Ecosystem != want.Ecosystem || g.Package != want.Package || g.Version != want.Version || !errors.Is(g.Err, want.Err) {
+					t.Errorf("warning: got %+v, want %+v", g, want)
+				}
+			}
+			isErr := e != nil
+			if tt.errOut != isErr {
+				t.Errorf("error: got %v, want %v", isErr, tt.errOut)
+			}
+		})
+	}
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
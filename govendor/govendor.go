@@ -1,7 +1,11 @@
 package govendor
 
 import (
+	"context"
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/warning"
@@ -17,7 +21,14 @@ type vendor struct {
 }
 
 type govendor struct {
-	lg GoLicenseGetter
+	config Config
+	lg     GoLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
 }
 
 type GoLicenseGetter interface {
@@ -26,7 +37,12 @@ type GoLicenseGetter interface {
 
 // New returns a Deper capable of handling govendor manifest files
 func New(lg GoLicenseGetter) diligent.Deper {
-	return &govendor{lg}
+	return NewWithOptions(lg, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(lg GoLicenseGetter, c Config) diligent.Deper {
+	return &govendor{c, lg}
 }
 
 // Name returns "govendor"
@@ -34,8 +50,25 @@ func (g *govendor) Name() string {
 	return "govendor"
 }
 
-// Dependencies returns the licenses of the go packages defined within the govendor manifest
+// Dependencies returns the licenses of the go packages defined within the govendor manifest, assuming the manifest is
+// within the working directory
 func (g *govendor) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return g.LocalDependencies(".", file)
+}
+
+// DependenciesContext is identical to Dependencies but cancels the lookups once ctx is done
+func (g *govendor) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return g.LocalDependenciesContext(ctx, ".", file)
+}
+
+// LocalDependencies returns the licenses of the go packages defined within the govendor manifest, dir is the vendor
+// directory containing the manifest, which is searched for the packages' licenses when offline
+func (g *govendor) LocalDependencies(dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return g.LocalDependenciesContext(context.Background(), dir, file)
+}
+
+// LocalDependenciesContext is identical to LocalDependencies but cancels the lookups once ctx is done
+func (g *govendor) LocalDependenciesContext(ctx context.Context, dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
 	var vendorFile vendor
 	err := json.Unmarshal(file, &vendorFile)
 	if err != nil {
@@ -46,19 +79,44 @@ func (g *govendor) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning
 	warns := make([]diligent.Warning, 0, len(vendorFile.Packages))
 	for _, pkg := range vendorFile.Packages {
 		pkgPath := pkg.Path
-		l, err := g.lg.GetLicense(pkgPath)
+		if d, ok := g.config.Overrides.Dep(g.Name(), pkgPath, pkg.Revision); ok {
+			deps = append(deps, d)
+			continue
+		}
+		var t diligent.Trace
+		l, err := g.getLicense(ctx, dir, pkgPath, &t)
 		if err != nil {
-			warns = append(warns, warning.New(pkgPath, err.Error()))
+			warns = append(warns, warning.FromError(g.Name(), pkgPath, pkg.Revision, err, t))
 		} else {
 			deps = append(deps, diligent.Dep{
 				Name:    pkgPath,
+				Version: pkg.Revision,
 				License: l,
+				Trace:   t,
 			})
 		}
 	}
 	return deps, warns, nil
 }
 
+func (g *govendor) getLicense(ctx context.Context, dir, pkgPath string, t *diligent.Trace) (diligent.License, error) {
+	if diligent.IsOffline(ctx) {
+		// vendored packages are often sub packages, so look for the license within each parent down to the two
+		// component base package, for example gopkg.in/mgo.v2
+		components := strings.Split(pkgPath, "/")
+		for i := len(components); i >= 2; i-- {
+			pkgDir := filepath.Join(dir, filepath.FromSlash(strings.Join(components[:i], "/")))
+			if _, err := os.Stat(pkgDir); err != nil {
+				continue
+			}
+			if l, err := diligent.TraceLicenseForDirectory(pkgDir, t); err == nil {
+				return l, nil
+			}
+		}
+	}
+	return diligent.GetPackageLicense(ctx, g.lg, pkgPath, t)
+}
+
 // IsCompatible returns true if the filename is vendor.json
 func (g *govendor) IsCompatible(filename string) bool {
 	return filename == "vendor.json"
@@ -3,11 +3,11 @@ package govendor_test
 import (
 	"errors"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/senseyeio/diligent"
 	"github.com/senseyeio/diligent/govendor"
-	"github.com/senseyeio/diligent/warning"
 )
 
 type licenseGetterResponse struct {
@@ -73,7 +73,7 @@ var depTests = []struct {
 	in            []byte
 	getLicenseLUT map[string]licenseGetterResponse
 	depsOut       []diligent.Dep
-	warnsOut      []diligent.Warning
+	warnsOut      []diligent.WarningDetails
 	errOut        bool
 }{{
 	"single dependency",
@@ -97,9 +97,10 @@ var depTests = []struct {
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/go-logfmt/logfmt",
+		Version: "390ab7935ee28ec6b286364bba9b4dd6410cb3d5",
 		License: diligent.License{Identifier: "MIT"},
 	}},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	false,
 }, {
 	"multiple dependencies",
@@ -133,12 +134,14 @@ var depTests = []struct {
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/go-logfmt/logfmt",
+		Version: "390ab7935ee28ec6b286364bba9b4dd6410cb3d5",
 		License: diligent.License{Identifier: "MIT"},
 	}, {
 		Name:    "github.com/go-stack/stack",
+		Version: "817915b46b97fd7bb80e8ab6b69f01a53ac3eebf",
 		License: diligent.License{Identifier: "DOC"},
 	}},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	false,
 }, {
 	"part failure dependencies",
@@ -172,10 +175,11 @@ var depTests = []struct {
 	},
 	[]diligent.Dep{{
 		Name:    "github.com/go-logfmt/logfmt",
+		Version: "390ab7935ee28ec6b286364bba9b4dd6410cb3d5",
 		License: diligent.License{Identifier: "MIT"},
 	}},
-	[]diligent.Warning{
-		warning.New("github.com/go-stack/stack", "error"),
+	[]diligent.WarningDetails{
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "govendor", Package: "github.com/go-stack/stack", Version: "817915b46b97fd7bb80e8ab6b69f01a53ac3eebf", Err: errors.New("error")},
 	},
 	false,
 }, {
@@ -209,9 +213,9 @@ var depTests = []struct {
 		},
 	},
 	[]diligent.Dep{},
-	[]diligent.Warning{
-		warning.New("github.com/go-logfmt/logfmt", "eeek"),
-		warning.New("github.com/go-stack/stack", "error"),
+	[]diligent.WarningDetails{
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "govendor", Package: "github.com/go-logfmt/logfmt", Version: "390ab7935ee28ec6b286364bba9b4dd6410cb3d5", Err: errors.New("eeek")},
+		{Kind: diligent.NoLicenseMetadata, Ecosystem: "govendor", Package: "github.com/go-stack/stack", Version: "817915b46b97fd7bb80e8ab6b69f01a53ac3eebf", Err: errors.New("error")},
 	},
 	false,
 }, {
@@ -223,7 +227,7 @@ var depTests = []struct {
 `),
 	map[string]licenseGetterResponse{},
 	[]diligent.Dep{},
-	[]diligent.Warning{},
+	[]diligent.WarningDetails{},
 	true,
 }}
 
@@ -233,11 +237,16 @@ func TestDependencies(t *testing.T) {
 			mockLG := newMockLicenseGetter(t, tt.getLicenseLUT)
 			target := govendor.New(mockLG)
 			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
 			if (len(d) > 0 || len(tt.depsOut) > 0) && reflect.DeepEqual(d, tt.depsOut) == false {
 				t.Errorf("deps: got %v, want %v", d, tt.depsOut)
 			}
-			if (len(w) > 0 || len(tt.warnsOut) > 0) && reflect.DeepEqual(w, tt.warnsOut) == false {
-				t.Errorf("warnings: got %v, want %v", w, tt.warnsOut)
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
+				}
 			}
 			isErr := e != nil
 			if tt.errOut != isErr {
@@ -246,3 +255,14 @@ func TestDependencies(t *testing.T) {
 		})
 	}
 }
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
@@ -0,0 +1,100 @@
+// Package httpclient builds the HTTP client shared by diligent's Depers and license getters, allowing requests to be
+// sent through a proxy, trust a custom certificate authority and carry credentials for specific hosts
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// Credentials authenticate requests sent to a host
+type Credentials struct {
+	Username string
+	Password string
+	// Token is sent as a bearer token, taking precedence over Username and Password
+	Token string
+}
+
+// Config contains the options of the client
+type Config struct {
+	// Proxy is the URL of the proxy requests are sent through. When empty, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY
+	// environment variables are used
+	Proxy string
+	// CAFile is a PEM encoded bundle of certificates which are trusted in addition to the system's certificates
+	CAFile string
+	// Credentials are keyed by the host they are sent to, such as api.github.com. A port may be included to restrict
+	// the credentials to that port
+	Credentials map[string]Credentials
+}
+
+// New returns a client configured with the provided options
+func New(c Config) (*http.Client, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if c.Proxy != "" {
+		u, err := url.Parse(c.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy '%s'. %v", c.Proxy, err)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+	if c.CAFile != "" {
+		pool, err := certPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		t.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+	var rt http.RoundTripper = t
+	if len(c.Credentials) > 0 {
+		rt = &authTransport{t, c.Credentials}
+	}
+	return &http.Client{Transport: rt}, nil
+}
+
+func certPool(caFile string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no certificates found within '" + caFile + "'")
+	}
+	return pool, nil
+}
+
+// authTransport adds the credentials of the request's host, unless the request is already authenticated
+type authTransport struct {
+	base        http.RoundTripper
+	credentials map[string]Credentials
+}
+
+// RoundTrip implements http.RoundTripper
+func (a *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") != "" {
+		return a.base.RoundTrip(req)
+	}
+	c, ok := a.credentials[req.URL.Host]
+	if !ok {
+		c, ok = a.credentials[req.URL.Hostname()]
+	}
+	if !ok {
+		return a.base.RoundTrip(req)
+	}
+	// requests must not be modified by a RoundTripper
+	req = req.Clone(req.Context())
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	} else {
+		req.SetBasicAuth(c.Username, c.Password)
+	}
+	return a.base.RoundTrip(req)
+}
@@ -0,0 +1,144 @@
+package httpclient_test
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent/httpclient"
+)
+
+func TestCredentials(t *testing.T) {
+	var got string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+	}))
+	defer ts.Close()
+	u, _ := url.Parse(ts.URL)
+
+	cases := []struct {
+		name        string
+		credentials map[string]httpclient.Credentials
+		header      string
+		expected    string
+	}{
+		{"none", nil, "", ""},
+		{"other host", map[string]httpclient.Credentials{"example.com": {Token: "abc"}}, "", ""},
+		{"token", map[string]httpclient.Credentials{u.Hostname(): {Token: "abc", Username: "user"}}, "", "Bearer abc"},
+		{"basic", map[string]httpclient.Credentials{u.Host: {Username: "user", Password: "pass"}}, "", "Basic dXNlcjpwYXNz"},
+		{"other port", map[string]httpclient.Credentials{u.Hostname() + ":1": {Token: "abc"}}, "", ""},
+		{"already authenticated", map[string]httpclient.Credentials{u.Hostname(): {Token: "abc"}}, "Bearer xyz", "Bearer xyz"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := httpclient.New(httpclient.Config{Credentials: tc.credentials})
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			resp, err := c.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if got != tc.expected {
+				t.Errorf("expected Authorization '%s', got '%s'", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestProxy(t *testing.T) {
+	var requested string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	c, err := httpclient.New(httpclient.Config{Proxy: proxy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Get("http://registry.example.com/pkg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if requested != "http://registry.example.com/pkg" {
+		t.Errorf("expected the request to be sent through the proxy, got '%s'", requested)
+	}
+
+	if _, err := httpclient.New(httpclient.Config{Proxy: "://bad"}); err == nil {
+		t.Error("expected an invalid proxy to be rejected")
+	}
+}
+
+func TestCAFile(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	c, err := httpclient.New(httpclient.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(ts.URL); err == nil {
+		t.Fatal("expected the test server's certificate to be untrusted")
+	}
+
+	f, err := ioutil.TempFile("", "ca*.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	pem.Encode(f, &pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	f.Close()
+
+	c, err = httpclient.New(httpclient.Config{CAFile: f.Name()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if _, err := httpclient.New(httpclient.Config{CAFile: os.Args[0]}); err == nil {
+		t.Error("expected a file without certificates to be rejected")
+	}
+}
+
+func TestParseNetrc(t *testing.T) {
+	netrc := `machine api.github.com
+  login octocat
+  password ghp_token
+
+machine registry.example.com login user password pass account acct
+macdef init
+machine not.a.machine login x
+
+default login anonymous password guest
+machine after.default login a password b
+`
+	creds, err := httpclient.ParseNetrc(strings.NewReader(netrc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]httpclient.Credentials{
+		"api.github.com":       {Username: "octocat", Password: "ghp_token"},
+		"registry.example.com": {Username: "user", Password: "pass"},
+		"after.default":        {Username: "a", Password: "b"},
+	}
+	if !reflect.DeepEqual(creds, expected) {
+		t.Errorf("expected %+v, got %+v", expected, creds)
+	}
+}
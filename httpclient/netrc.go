@@ -0,0 +1,72 @@
+package httpclient
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// NetrcPath returns the location of the user's .netrc file, which can be set using the NETRC environment variable
+func NetrcPath() string {
+	if p := os.Getenv("NETRC"); p != "" {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	if runtime.GOOS == "windows" {
+		return filepath.Join(home, "_netrc")
+	}
+	return filepath.Join(home, ".netrc")
+}
+
+// ParseNetrc returns the credentials of each machine within a .netrc file. The default entry is ignored so that
+// credentials are only sent to the hosts they were defined for
+func ParseNetrc(r io.Reader) (map[string]Credentials, error) {
+	creds := map[string]Credentials{}
+	machine, key := "", ""
+	inMacro := false
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if inMacro {
+			// macro definitions end with an empty line
+			inMacro = strings.TrimSpace(s.Text()) != ""
+			continue
+		}
+		for _, token := range strings.Fields(s.Text()) {
+			if key == "" {
+				switch token {
+				case "default":
+					machine = ""
+				case "macdef":
+					inMacro = true
+				default:
+					key = token
+				}
+				if inMacro {
+					break
+				}
+				continue
+			}
+			c := creds[machine]
+			switch key {
+			case "machine":
+				machine = token
+				c = Credentials{}
+			case "login":
+				c.Username = token
+			case "password":
+				c.Password = token
+			}
+			if machine != "" {
+				creds[machine] = c
+			}
+			key = ""
+		}
+	}
+	return creds, s.Err()
+}
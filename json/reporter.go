@@ -0,0 +1,146 @@
+package json
+
+import (
+	encJSON "encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/senseyeio/diligent"
+)
+
+type license struct {
+	Identifier string            `json:"identifier"`
+	Name       string            `json:"name"`
+	URL        string            `json:"url"`
+	Category   diligent.Category `json:"category"`
+}
+
+type step struct {
+	Source   string `json:"source"`
+	Location string `json:"location,omitempty"`
+	License  string `json:"license,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Used     bool   `json:"used,omitempty"`
+}
+
+type dependency struct {
+	Name     string          `json:"name"`
+	Version  string          `json:"version,omitempty"`
+	License  license         `json:"license"`
+	Scope    diligent.Scope  `json:"scope,omitempty"`
+	Status   diligent.Status `json:"status,omitempty"`
+	Declared bool            `json:"declared,omitempty"`
+	Trace    []step          `json:"trace,omitempty"`
+}
+
+type warning struct {
+	Ecosystem string               `json:"ecosystem,omitempty"`
+	Name      string               `json:"name,omitempty"`
+	Version   string               `json:"version,omitempty"`
+	Scope     diligent.Scope       `json:"scope,omitempty"`
+	Kind      diligent.WarningKind `json:"kind,omitempty"`
+	Error     string               `json:"error"`
+	Trace     []step               `json:"trace,omitempty"`
+}
+
+type report struct {
+	Dependencies []dependency `json:"dependencies"`
+	Warnings     []warning    `json:"warnings,omitempty"`
+}
+
+type jsonReporter struct{}
+
+// NewReporter returns a Reporter which outputs the discovered licenses as JSON
+func NewReporter() diligent.Reporter {
+	return &jsonReporter{}
+}
+
+// Report outputs the dependencies and their licenses as a JSON document
+func (j *jsonReporter) Report(w io.Writer, deps []diligent.Dep) error {
+	return j.ReportWithWarnings(w, deps, nil)
+}
+
+// ReportWithWarnings is identical to Report but also lists the packages whose licenses could not be determined
+func (j *jsonReporter) ReportWithWarnings(w io.Writer, deps []diligent.Dep, warnings []diligent.WarningDetails) error {
+	r := report{Dependencies: make([]dependency, 0, len(deps))}
+	for _, d := range deps {
+		r.Dependencies = append(r.Dependencies, dependency{
+			Name:     d.Name,
+			Version:  d.Version,
+			License:  license{d.License.Identifier, d.License.Name, d.License.URL, d.License.Category},
+			Scope:    d.EffectiveScope(),
+			Status:   d.Status,
+			Declared: d.Declared,
+			Trace:    toSteps(d.Trace),
+		})
+	}
+	for _, wd := range warnings {
+		r.Warnings = append(r.Warnings, warning{
+			Ecosystem: wd.Ecosystem,
+			Name:      wd.Package,
+			Version:   wd.Version,
+			Scope:     wd.EffectiveScope(),
+			Kind:      wd.Kind,
+			Error:     wd.Err.Error(),
+			Trace:     toSteps(wd.Trace),
+		})
+	}
+	enc := encJSON.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// Read parses a report written by the JSON Reporter. Licenses known to diligent are fully populated, whilst
+// unknown licenses retain the details within the report
+func Read(r io.Reader) ([]diligent.Dep, error) {
+	var rep report
+	if err := encJSON.NewDecoder(r).Decode(&rep); err != nil {
+		return nil, fmt.Errorf("invalid JSON report: %v", err)
+	}
+	deps := make([]diligent.Dep, 0, len(rep.Dependencies))
+	for _, d := range rep.Dependencies {
+		l, err := diligent.GetLicenseFromIdentifier(d.License.Identifier)
+		if err != nil {
+			l = diligent.License{Identifier: d.License.Identifier, Name: d.License.Name, URL: d.License.URL, Category: d.License.Category}
+		}
+		// production dependencies are left without a scope, as they are by Depers
+		scope := d.Scope
+		if scope == diligent.Production {
+			scope = ""
+		}
+		deps = append(deps, diligent.Dep{
+			Name:     d.Name,
+			Version:  d.Version,
+			License:  l,
+			Scope:    scope,
+			Status:   d.Status,
+			Declared: d.Declared,
+			Trace:    fromSteps(d.Trace),
+		})
+	}
+	return deps, nil
+}
+
+// toSteps converts a trace, marking the step which determined the license as used
+func toSteps(t diligent.Trace) []step {
+	if len(t) == 0 {
+		return nil
+	}
+	winner, _ := t.Winner()
+	out := make([]step, 0, len(t))
+	for _, s := range t {
+		out = append(out, step{s.Source, s.Location, s.License, s.Err, s.Err == "" && s == winner})
+	}
+	return out
+}
+
+func fromSteps(ss []step) diligent.Trace {
+	if len(ss) == 0 {
+		return nil
+	}
+	out := make(diligent.Trace, 0, len(ss))
+	for _, s := range ss {
+		out = append(out, diligent.Step{Source: s.Source, Location: s.Location, License: s.License, Err: s.Error})
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+package json_test
+
+import (
+	"bytes"
+	encJSON "encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/json"
+)
+
+func TestReportRead(t *testing.T) {
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	deps := []diligent.Dep{
+		{Name: "a", Version: "1.0.0", License: mit, Status: diligent.Allowed, Trace: diligent.Trace{
+			{Source: "npm license field", Location: "https://registry.npmjs.org/a", Err: "unknown"},
+			{Source: "github spdx_id", Location: "https://api.github.com/repos/a/a/license", License: "MIT"},
+		}},
+		{Name: "b", License: mit, Scope: diligent.Development, Declared: true, Status: diligent.Excepted},
+	}
+	var buf bytes.Buffer
+	if err := json.NewReporter().Report(&buf, deps); err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(out, deps) == false {
+		t.Errorf("got %+v, want %+v", out, deps)
+	}
+}
+
+func TestReportWarnings(t *testing.T) {
+	warnings := []diligent.WarningDetails{
+		{Kind: diligent.NotFound, Ecosystem: "npm", Package: "a", Version: "1.0.0", Err: &diligent.StatusError{StatusCode: 404}},
+	}
+	var buf bytes.Buffer
+	if err := json.NewReporter().(diligent.WarningReporter).ReportWithWarnings(&buf, nil, warnings); err != nil {
+		t.Fatal(err)
+	}
+	var out struct {
+		Warnings []map[string]string `json:"warnings"`
+	}
+	if err := encJSON.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"ecosystem": "npm", "name": "a", "version": "1.0.0", "scope": "prod", "kind": "not-found", "error": "requested failed with status 404"}
+	if len(out.Warnings) != 1 || reflect.DeepEqual(out.Warnings[0], expected) == false {
+		t.Errorf("got %+v, want %+v", out.Warnings, expected)
+	}
+}
+
+func TestReadUnknownLicense(t *testing.T) {
+	out, err := json.Read(bytes.NewBufferString(`{"dependencies": [{"name": "a", "license": {"identifier": "Custom", "name": "Custom License"}}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 1 || out[0].License.Identifier != "Custom" || out[0].License.Name != "Custom License" {
+		t.Errorf("unexpected deps %+v", out)
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	if _, err := json.Read(bytes.NewBufferString("{{")); err == nil {
+		t.Error("expected error")
+	}
+}
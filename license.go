@@ -1,6 +1,7 @@
 package diligent
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
@@ -41,11 +42,37 @@ const (
 	// must be kept with the code per organization policy.  The match may be to software, code examples on a website,
 	// published public domain specifications or another type of publication
 	PublicDomain Category = "public-domain"
+	// Uncategorised licenses are on the SPDX license list but have not been categorised by Diligent, so their terms
+	// must be reviewed before they are relied upon
+	Uncategorised Category = "uncategorised"
 	// All includes all the licenses known by Diligent
 	All Category = "all"
 )
 
-var categories = []Category{Permissive, CopyLeft, CopyLeftLimited, FreeRestricted, ProprietaryFree, PublicDomain, All}
+var categories = []Category{Permissive, CopyLeft, CopyLeftLimited, FreeRestricted, ProprietaryFree, PublicDomain, Uncategorised, All}
+
+var categoryDescriptions = map[Category]string{
+	Permissive:      "Open source licenses without copyleft, which generally require attribution of the included software and may include other obligations",
+	CopyLeft:        "Open source licenses requiring redistributions, including those of modified works and code interacting with the software, to be made available under the same license terms",
+	CopyLeftLimited: "Open source licenses requiring the source code of the software, including changes, to be redistributed under the same license, with the obligations for code linked with it limited according to license-specific rules",
+	FreeRestricted:  "Attribution licenses which restrict the usage or redistribution of the software, such as prohibiting commercial redistribution without permission",
+	ProprietaryFree: "Proprietary licenses which may not require a commercial license but have specific terms and conditions which must be followed",
+	PublicDomain:    "Software made available without explicit obligations, but with a license notice which must be kept with the code per organization policy",
+	Uncategorised:   "Licenses on the SPDX license list which Diligent has not categorised, whose terms must be reviewed",
+	All:             "All the licenses known by Diligent",
+}
+
+// Description explains what the licenses within the category allow. Categories registered using RegisterCategory
+// are described as organisation defined
+func (c Category) Description() string {
+	if d, ok := categoryDescriptions[c]; ok {
+		return d
+	}
+	if IsCategory(string(c)) {
+		return "Organisation defined category"
+	}
+	return ""
+}
 
 // Type is either open source of proprietary
 type Type string
@@ -74,6 +101,14 @@ type License struct {
 	OwnerURL   string
 	OwnerType  OwnerType
 	URL        string
+	// OSIApproved and FSFLibre are true if the license is approved by the Open Source Initiative or considered
+	// libre by the Free Software Foundation, according to the SPDX license list
+	OSIApproved bool
+	FSFLibre    bool
+	// Deprecated is true if SPDX has deprecated the identifier, in which case ReplacedBy holds the current
+	// identifier of the same license, if there is one
+	Deprecated bool
+	ReplacedBy string
 }
 
 var lookup = map[string]License{
@@ -421,6 +456,10 @@ func handleNonSPDXIdentifiers(identifier string) (License, bool) {
 		spdx = "BSD-3-Clause"
 	case "FreeBSD":
 		spdx = "BSD-2-Clause"
+	case "Apache 2.0", "Apache License 2.0", "Apache License, Version 2.0":
+		spdx = "Apache-2.0"
+	case "MIT License":
+		spdx = "MIT"
 	}
 	if spdx == "" {
 		return License{}, false
@@ -431,6 +470,9 @@ func handleNonSPDXIdentifiers(identifier string) (License, bool) {
 
 func getLicenseForFiles(f filer.Filer) (License, error) {
 	licenses, err := licensedb.Detect(f)
+	if err == licensedb.ErrNoLicenseFound && hasLicenseFile(f) {
+		return License{}, ErrLowConfidence
+	}
 	if err != nil {
 		return License{}, err
 	}
@@ -446,6 +488,23 @@ func getLicenseForFiles(f filer.Filer) (License, error) {
 	return GetLicenseFromIdentifier(maxKey)
 }
 
+// hasLicenseFile returns true if the root of f contains a file whose name suggests it holds a license
+func hasLicenseFile(f filer.Filer) bool {
+	files, err := f.ReadDir("")
+	if err != nil {
+		return false
+	}
+	for _, file := range files {
+		name := strings.ToLower(file.Name)
+		for _, prefix := range []string{"license", "licence", "copying", "unlicense"} {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func GetLicenseForDirectory(directory string) (License, error) {
 	files, err := filer.FromDirectory(directory)
 	if err != nil {
@@ -455,14 +514,7 @@ func GetLicenseForDirectory(directory string) (License, error) {
 }
 
 func GetLicenseForGit(url string) (License, error) {
-	if strings.HasPrefix(url, "git+") {
-		url = strings.Replace(url, "git+", "", 1)
-	}
-	files, err := filer.FromGitURL(url)
-	if err != nil {
-		return License{}, err
-	}
-	return getLicenseForFiles(files)
+	return GetLicenseForGitContext(context.Background(), url)
 }
 
 // GetLicenseFromIdentifier returns a License given an identifier. Ideally this identifier would be a SPDX identifier.
@@ -475,7 +527,11 @@ func GetLicenseFromIdentifier(identifier string) (License, error) {
 	if ok {
 		return l, nil
 	}
-	return License{}, fmt.Errorf("license identifier %s is not known to diligent", identifier)
+	l, ok = licenseWithException(identifier)
+	if ok {
+		return l, nil
+	}
+	return License{}, &UnknownIdentifierError{identifier}
 }
 
 func getLicenses(predicate func(license License) bool) []License {
@@ -514,6 +570,52 @@ func getCategoryFromString(catStr string) *Category {
 	return nil
 }
 
+// IsCategory returns true if the provided string is the name of a license category
+func IsCategory(s string) bool {
+	return getCategoryFromString(s) != nil
+}
+
+// GetCategories returns the license categories, including those registered using RegisterCategory
+func GetCategories() []Category {
+	return append([]Category(nil), categories...)
+}
+
+// RegisterCategory adds an organisation defined category, such as "internal-approved", to which licenses can be
+// assigned using SetLicenseCategory or RegisterLicense. The name must not clash with a category or license identifier
+func RegisterCategory(c Category) error {
+	if strings.TrimSpace(string(c)) == "" {
+		return errors.New("category names must not be blank")
+	}
+	if IsCategory(string(c)) {
+		return fmt.Errorf("category '%s' already exists", c)
+	}
+	if _, ok := lookup[string(c)]; ok {
+		return fmt.Errorf("category '%s' clashes with a license identifier", c)
+	}
+	// all is kept last, as it includes every other category
+	registered := make([]Category, 0, len(categories)+1)
+	registered = append(registered, categories[:len(categories)-1]...)
+	categories = append(registered, c, All)
+	return nil
+}
+
+// SetLicenseCategory assigns a license to a category, replacing the category assigned by diligent. The deprecated or
+// current identifiers of the same license are also reassigned
+func SetLicenseCategory(identifier string, c Category) error {
+	if _, ok := lookup[identifier]; !ok {
+		return &UnknownIdentifierError{identifier}
+	}
+	if cat := getCategoryFromString(string(c)); cat == nil || *cat == All {
+		return fmt.Errorf("license '%s' cannot be assigned to unknown category '%s'", identifier, c)
+	}
+	for _, id := range EquivalentIdentifiers(identifier) {
+		l := lookup[id]
+		l.Category = c
+		lookup[id] = l
+	}
+	return nil
+}
+
 // GetLicenseIdentifiers returns identifiers for all of the licenses known by Diligent
 func GetLicenseIdentifiers() []string {
 	ll := GetLicenses()
@@ -72,6 +72,8 @@ func TestGetLicenseFromIdentifier(t *testing.T) {
 		{"empty identifier", "", "", true},
 		{"handle non standard 'NewBSD'", "NewBSD", "BSD-3-Clause", false},
 		{"handle non standard 'FreeBSD'", "FreeBSD", "BSD-2-Clause", false},
+		{"handle non standard 'Apache 2.0'", "Apache 2.0", "Apache-2.0", false},
+		{"handle non standard 'MIT License'", "MIT License", "MIT", false},
 	}
 
 	for _, c := range cases {
@@ -86,3 +88,67 @@ func TestGetLicenseFromIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterCategory(t *testing.T) {
+	defer diligent.SaveLicenses()()
+	cases := []struct {
+		d          string
+		in         diligent.Category
+		expFailure bool
+	}{
+		{"custom category", "internal-approved", false},
+		{"blank category", " ", true},
+		{"existing category", diligent.Permissive, true},
+		{"license identifier", "MIT", true},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			err := diligent.RegisterCategory(c.in)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+		})
+	}
+	if !diligent.IsCategory("internal-approved") {
+		t.Error("expected internal-approved to be a category")
+	}
+	if cats := diligent.GetCategories(); cats[len(cats)-1] != diligent.All {
+		t.Errorf("expected all to remain the last category, got %v", cats)
+	}
+}
+
+func TestSetLicenseCategory(t *testing.T) {
+	defer diligent.SaveLicenses()()
+	if err := diligent.RegisterCategory("internal-review"); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		d          string
+		license    string
+		category   diligent.Category
+		expFailure bool
+	}{
+		{"custom category", "MPL-2.0", "internal-review", false},
+		{"built in category", "GPL-2.0", diligent.Permissive, false},
+		{"unknown license", "woowoo", diligent.Permissive, true},
+		{"unknown category", "MIT", "woowoo", true},
+		{"all category", "MIT", diligent.All, true},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			err := diligent.SetLicenseCategory(c.license, c.category)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+		})
+	}
+	out := diligent.ReplaceCategoriesWithIdentifiers([]string{"internal-review"})
+	if len(out) != 1 || out[0] != "MPL-2.0" {
+		t.Errorf("expected MPL-2.0, got %v", out)
+	}
+	for _, id := range []string{"GPL-2.0", "GPL-2.0-only"} {
+		if l, _ := diligent.GetLicenseFromIdentifier(id); l.Category != diligent.Permissive {
+			t.Errorf("expected %s to be reassigned, got %+v", id, l)
+		}
+	}
+}
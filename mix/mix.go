@@ -0,0 +1,237 @@
+package mix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+)
+
+// WebLicenseGetter retrieves license information from an online source
+type WebLicenseGetter interface {
+	IsCompatibleURL(s string) bool
+	GetLicenseFromURL(s string) (diligent.License, error)
+}
+
+var (
+	// "jason": {:hex, :jason, "1.2.2", ...}
+	hexRegex = regexp.MustCompile(`"([^"]+)":\s*\{:hex,\s*:"?([^,"\s]+)"?,\s*"([^"]+)"`)
+	// "plug": {:git, "https://github.com/elixir-plug/plug.git", "6a2d...", [branch: "master"]}
+	gitRegex = regexp.MustCompile(`"([^"]+)":\s*\{:git,\s*"([^"]+)",\s*"([^"]*)"`)
+)
+
+type lockedPackage struct {
+	name    string
+	hexName string
+	version string
+	gitURL  string
+	gitRef  string
+}
+
+// hexPackage holds the metadata of a package or of one of its releases
+type hexPackage struct {
+	Meta struct {
+		Licenses []string          `json:"licenses"`
+		Links    map[string]string `json:"links"`
+	} `json:"meta"`
+}
+
+type mix struct {
+	config Config
+	url    string
+	webLG  WebLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
+	// Client sends requests to the registry, allowing proxies, certificate authorities and credentials to be
+	// configured. http.DefaultClient is used when nil
+	Client *http.Client
+}
+
+// New returns a Deper capable of dealing with Elixir mix.lock files.
+// The url should point at the hex.pm API, for example https://hex.pm
+func New(url string, webLG WebLicenseGetter) diligent.Deper {
+	return NewWithOptions(url, webLG, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(url string, webLG WebLicenseGetter, c Config) diligent.Deper {
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+	return &mix{c, strings.TrimSuffix(url, "/"), webLG}
+}
+
+// Name returns "mix"
+func (m *mix) Name() string {
+	return "mix"
+}
+
+// Dependencies returns the licenses of the hex and git packages locked within mix.lock
+func (m *mix) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return m.DependenciesContext(context.Background(), file)
+}
+
+// DependenciesContext is identical to Dependencies but cancels the lookups once ctx is done
+func (m *mix) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	pkgs, err := parse(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deps := make([]diligent.Dep, 0, len(pkgs))
+	warns := make([]diligent.Warning, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		version := pkg.version
+		if version == "" {
+			version = pkg.gitRef
+		}
+		if d, ok := m.config.Overrides.Dep(m.Name(), pkg.name, version); ok {
+			deps = append(deps, d)
+			continue
+		}
+		var t diligent.Trace
+		l, err := m.getLicense(ctx, pkg, &t)
+		if err != nil {
+			warns = append(warns, warning.FromError(m.Name(), pkg.name, version, err, t))
+		} else {
+			deps = append(deps, diligent.Dep{
+				Name:    pkg.name,
+				Version: version,
+				License: l,
+				Trace:   t,
+			})
+		}
+	}
+	return deps, warns, nil
+}
+
+// IsCompatible returns true if the filename is mix.lock
+func (m *mix) IsCompatible(filename string) bool {
+	return filename == "mix.lock"
+}
+
+// parse extracts the locked packages from mix.lock, which is an Elixir map literal with one package per line
+func parse(file []byte) ([]lockedPackage, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(file), []byte("%{")) {
+		return nil, errors.New("mix.lock should contain an Elixir map")
+	}
+	pkgs := make([]lockedPackage, 0)
+	for _, match := range hexRegex.FindAllSubmatch(file, -1) {
+		pkgs = append(pkgs, lockedPackage{
+			name:    string(match[1]),
+			hexName: string(match[2]),
+			version: string(match[3]),
+		})
+	}
+	for _, match := range gitRegex.FindAllSubmatch(file, -1) {
+		pkgs = append(pkgs, lockedPackage{
+			name:   string(match[1]),
+			gitURL: string(match[2]),
+			gitRef: string(match[3]),
+		})
+	}
+	return pkgs, nil
+}
+
+func (m *mix) getLicense(ctx context.Context, pkg lockedPackage, t *diligent.Trace) (diligent.License, error) {
+	if pkg.gitURL != "" {
+		return m.getLicenseFromGit(ctx, pkg.gitURL, pkg.gitRef, t)
+	}
+	return m.getHexLicense(ctx, pkg.hexName, pkg.version, t)
+}
+
+func (m *mix) getLicenseFromGit(ctx context.Context, gitURL, ref string, t *diligent.Trace) (diligent.License, error) {
+	repoURL := strings.TrimSuffix(gitURL, ".git")
+	if m.webLG != nil && m.webLG.IsCompatibleURL(repoURL) {
+		if ref != "" {
+			l, err := diligent.GetLicenseFromURL(ctx, m.webLG, repoURL+"/tree/"+ref, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+		l, err := diligent.GetLicenseFromURL(ctx, m.webLG, repoURL, t)
+		if err == nil {
+			return l, nil
+		}
+	}
+	return diligent.TraceLicenseForGit(ctx, gitURL, t)
+}
+
+func (m *mix) getHexPackage(ctx context.Context, hexURL string) (hexPackage, error) {
+	var pkg hexPackage
+	rctx, cancel, err := diligent.RequestContext(ctx)
+	if err != nil {
+		return pkg, err
+	}
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, hexURL, nil)
+	if err != nil {
+		return pkg, err
+	}
+	resp, err := m.config.Client.Do(req.WithContext(rctx))
+	if err != nil {
+		return pkg, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return pkg, &diligent.StatusError{StatusCode: resp.StatusCode}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&pkg); err != nil {
+		return pkg, &diligent.MalformedError{Msg: "parsing hex response failed - invalid JSON"}
+	}
+	return pkg, nil
+}
+
+// getHexLicense returns the license of the locked release of a package, falling back to the metadata of the package
+// when the release does not declare a license
+func (m *mix) getHexLicense(ctx context.Context, name, version string, t *diligent.Trace) (diligent.License, error) {
+	releaseURL := fmt.Sprintf("%s/api/packages/%s/releases/%s", m.url, url.PathEscape(name), url.PathEscape(version))
+	release, err := m.getHexPackage(ctx, releaseURL)
+	if err != nil {
+		t.Record("hex registry", releaseURL, diligent.License{}, err)
+	}
+	for _, id := range release.Meta.Licenses {
+		l, err := diligent.TraceLicenseFromIdentifier(id, "hex release licenses", releaseURL, t)
+		if err == nil {
+			return l, nil
+		}
+	}
+
+	hexURL := fmt.Sprintf("%s/api/packages/%s", m.url, url.PathEscape(name))
+	pkg, err := m.getHexPackage(ctx, hexURL)
+	if err != nil {
+		t.Record("hex registry", hexURL, diligent.License{}, err)
+		return diligent.License{}, err
+	}
+
+	for _, id := range pkg.Meta.Licenses {
+		l, err := diligent.TraceLicenseFromIdentifier(id, "hex licenses", hexURL, t)
+		if err == nil {
+			return l, nil
+		}
+	}
+
+	for _, link := range pkg.Meta.Links {
+		link = strings.TrimSuffix(link, ".git")
+		if m.webLG != nil && m.webLG.IsCompatibleURL(link) {
+			l, err := diligent.GetLicenseFromURL(ctx, m.webLG, link, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+	}
+
+	return diligent.License{}, errors.New("no license information in hex")
+}
@@ -0,0 +1,165 @@
+package mix_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/mix"
+)
+
+type mockWebLicenseGetter map[string]string
+
+func (m mockWebLicenseGetter) IsCompatibleURL(s string) bool {
+	return true
+}
+
+func (m mockWebLicenseGetter) GetLicenseFromURL(s string) (diligent.License, error) {
+	id, ok := m[s]
+	if !ok {
+		return diligent.License{}, errors.New("not mocked")
+	}
+	return diligent.GetLicenseFromIdentifier(id)
+}
+
+func TestName(t *testing.T) {
+	target := mix.New("", nil)
+	if target.Name() != "mix" {
+		t.Error("expected 'mix'")
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out bool
+	}{
+		{"mix.lock", true},
+		{"mix.exs", false},
+		{"pubspec.lock", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			target := mix.New("", nil)
+			compatible := target.IsCompatible(tt.in)
+			if compatible != tt.out {
+				t.Errorf("got %v, want %v", compatible, tt.out)
+			}
+		})
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	webLG := mockWebLicenseGetter{
+		"https://github.com/elixir-plug/plug/tree/6a2d1a3": "Apache-2.0",
+		"https://github.com/acme/linked":                   "ISC",
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/packages/jason/releases/1.2.2":
+			w.Write([]byte(`{"version":"1.2.2","meta":{"licenses":["MIT"]}}`))
+		case "/api/packages/decimal/releases/2.0.0":
+			w.Write([]byte(`{"version":"2.0.0","meta":{"app":"decimal"}}`))
+		case "/api/packages/jason":
+			w.Write([]byte(`{"name":"jason","meta":{"licenses":["Apache-2.0"]}}`))
+		case "/api/packages/decimal":
+			w.Write([]byte(`{"name":"decimal","meta":{"licenses":["woowoo","MIT"]}}`))
+		case "/api/packages/linked":
+			w.Write([]byte(`{"name":"linked","meta":{"licenses":[],"links":{"GitHub":"https://github.com/acme/linked"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	cases := []struct {
+		description string
+		in          []byte
+		depsOut     map[string]string
+		warnsOut    []diligent.WarningDetails
+		errOut      bool
+	}{{
+		"should handle hex and git packages",
+		[]byte(`%{
+  "decimal": {:hex, :decimal, "2.0.0", "a78296e617b0f5dd4c6caf57c714431347912ffb1d0842e998e9792b5642d697", [:mix], [], "hexpm", "34666e9c55dea81013e77d9d87370fe6cb6291d1ef32f46a1600230b1d44f577"},
+  "jason": {:hex, :jason, "1.2.2", "ba43e3f2709fd1aa1dce90aaabfd039d000469c05c56f0b8e31978e03fa39052", [:mix], [{:decimal, "~> 1.0 or ~> 2.0", [hex: :decimal, repo: "hexpm", optional: true]}], "hexpm", "e6e7a6ab0a67b3a1b4c0bf7be4cd11b6e4e9e2a2bd3e4d6c7f7f0a0f4cdbe3c0"},
+  "linked": {:hex, :linked, "0.1.0", "abc", [:mix], [], "hexpm", "def"},
+  "plug": {:git, "https://github.com/elixir-plug/plug.git", "6a2d1a3", [branch: "master"]},
+}
+`),
+		map[string]string{
+			"decimal@2.0.0": "MIT",
+			"jason@1.2.2":   "MIT",
+			"linked@0.1.0":  "ISC",
+			"plug@6a2d1a3":  "Apache-2.0",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should warn if packages cannot be found",
+		[]byte(`%{
+  "missing": {:hex, :missing, "1.0.0", "abc", [:mix], [], "hexpm", "def"},
+}
+`),
+		map[string]string{},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NotFound, Ecosystem: "mix", Package: "missing", Version: "1.0.0", Err: &diligent.StatusError{StatusCode: 404}},
+		},
+		false,
+	}, {
+		"parse failure",
+		[]byte(`{"not": "elixir"}`),
+		map[string]string{},
+		[]diligent.WarningDetails{},
+		true,
+	}}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+			target := mix.New(ts.URL, webLG)
+			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
+			expectedDeps := make([]diligent.Dep, 0, len(tt.depsOut))
+			for depID, lID := range tt.depsOut {
+				l, _ := diligent.GetLicenseFromIdentifier(lID)
+				at := strings.LastIndex(depID, "@")
+				expectedDeps = append(expectedDeps, diligent.Dep{Name: depID[:at], Version: depID[at+1:], License: l})
+			}
+			if len(d) > 0 || len(expectedDeps) > 0 {
+				sort.Sort(diligent.DepsByName(d))
+				sort.Sort(diligent.DepsByName(expectedDeps))
+				if reflect.DeepEqual(d, expectedDeps) == false {
+					t.Errorf("deps: got %+v, want %+v", d, expectedDeps)
+				}
+			}
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
+				}
+			}
+			isErr := e != nil
+			if tt.errOut != isErr {
+				t.Errorf("error: got %v, want %v", isErr, tt.errOut)
+			}
+		})
+	}
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
@@ -0,0 +1,78 @@
+package notice
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-enry/go-license-detector/v4/licensedb/filer"
+)
+
+var (
+	licenseFileRE = regexp.MustCompile(`(?i)^(licen[cs]e|copying|copyright|unlicense)([-_.].*)?$`)
+	noticeFileRE  = regexp.MustCompile(`(?i)^notice([-_.].*)?$`)
+	// copyrightRE matches lines such as "Copyright (c) 2020 Jane Doe" or "© 2020 Jane Doe"
+	copyrightRE = regexp.MustCompile(`(?i)^(copyright\b|\(c\)|©).*\d{4}`)
+	// placeholderRE matches the placeholders within license templates, such as "Copyright (c) <year> <owner>"
+	placeholderRE = regexp.MustCompile(`(?i)[<\[{](yyyy|year)[>\]}]`)
+)
+
+// packageTexts are the license and notice texts found within a package
+type packageTexts struct {
+	license    string
+	notice     string
+	copyrights []string
+}
+
+// readTexts reads the LICENSE and NOTICE files at the root of the package.
+// Multiple files, such as LICENSE-MIT and LICENSE-APACHE, are joined
+func readTexts(f filer.Filer) packageTexts {
+	files, err := f.ReadDir("")
+	if err != nil {
+		return packageTexts{}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	var licenses, notices []string
+	for _, file := range files {
+		if file.IsDir {
+			continue
+		}
+		var into *[]string
+		switch {
+		case licenseFileRE.MatchString(file.Name):
+			into = &licenses
+		case noticeFileRE.MatchString(file.Name):
+			into = &notices
+		default:
+			continue
+		}
+		b, err := f.ReadFile(file.Name)
+		if err != nil {
+			continue
+		}
+		if text := strings.TrimSpace(string(b)); text != "" {
+			*into = append(*into, text)
+		}
+	}
+	t := packageTexts{
+		license: strings.Join(licenses, "\n\n"),
+		notice:  strings.Join(notices, "\n\n"),
+	}
+	t.copyrights = copyrights(t.license + "\n" + t.notice)
+	return t
+}
+
+// copyrights returns the distinct copyright lines within the text, ignoring license template placeholders
+func copyrights(text string) []string {
+	var out []string
+	found := map[string]bool{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if !copyrightRE.MatchString(line) || placeholderRE.MatchString(line) || found[line] {
+			continue
+		}
+		found[line] = true
+		out = append(out, line)
+	}
+	return out
+}
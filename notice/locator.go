@@ -0,0 +1,149 @@
+package notice
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-enry/go-license-detector/v4/licensedb/filer"
+	"github.com/senseyeio/diligent"
+	"gopkg.in/yaml.v2"
+)
+
+// Locator finds the files of a dependency, such as its LICENSE and NOTICE files
+type Locator interface {
+	Locate(d diligent.Dep) (filer.Filer, error)
+}
+
+// installDirs are the directories, relative to a manifest, in which package managers install dependencies
+var installDirs = []string{"node_modules", "vendor", "deps", "Pods", filepath.Join(".build", "checkouts")}
+
+// pubHosts are the directories within the pub cache holding packages from pub.dev, which older versions of pub name
+// after its previous host
+var pubHosts = []string{"pub.dev", "pub.dartlang.org"}
+
+type dirLocator struct {
+	roots  []string
+	caches []func(d diligent.Dep) []string
+	// pubHosted maps the hosted packages within pubspec.lock files to the URL of the repository they were fetched from
+	pubHosted map[string]string
+}
+
+// NewDirLocator returns a Locator which looks for dependencies installed alongside the manifests within the roots,
+// for example within node_modules, vendor or deps directories, followed by the Go module, NuGet and pub caches
+func NewDirLocator(roots []string) Locator {
+	l := &dirLocator{roots: roots, pubHosted: readPubHosted(roots)}
+	l.caches = []func(d diligent.Dep) []string{goModCacheDir, nugetCacheDir, l.pubCacheDirs}
+	return l
+}
+
+// Locate implements Locator
+func (l *dirLocator) Locate(d diligent.Dep) (filer.Filer, error) {
+	if d.Name == "" || strings.Contains(d.Name, "..") {
+		return nil, fmt.Errorf("invalid package name '%s'", d.Name)
+	}
+	name := filepath.FromSlash(d.Name)
+	candidates := make([]string, 0, len(l.roots)*len(installDirs)+len(l.caches))
+	for _, root := range l.roots {
+		for _, dir := range installDirs {
+			candidates = append(candidates, filepath.Join(root, dir, name))
+		}
+	}
+	for _, cache := range l.caches {
+		candidates = append(candidates, cache(d)...)
+	}
+	for _, c := range candidates {
+		if info, err := os.Stat(c); err == nil && info.IsDir() {
+			return filer.FromDirectory(c)
+		}
+	}
+	return nil, errors.New("package files not found locally")
+}
+
+func goModCacheDir(d diligent.Dep) []string {
+	if dir := diligent.GoModCacheDir(d.Name, d.Version); dir != "" {
+		return []string{dir}
+	}
+	return nil
+}
+
+func nugetCacheDir(d diligent.Dep) []string {
+	home, err := os.UserHomeDir()
+	if err != nil || d.Version == "" {
+		return nil
+	}
+	return []string{filepath.Join(home, ".nuget", "packages", strings.ToLower(d.Name), d.Version)}
+}
+
+// readPubHosted returns the repository URLs of the hosted packages within the pubspec.lock files of the roots
+func readPubHosted(roots []string) map[string]string {
+	hosted := map[string]string{}
+	for _, root := range roots {
+		b, err := ioutil.ReadFile(filepath.Join(root, "pubspec.lock"))
+		if err != nil {
+			continue
+		}
+		var lock struct {
+			Packages map[string]struct {
+				Source      string      `yaml:"source"`
+				Description interface{} `yaml:"description"`
+			} `yaml:"packages"`
+		}
+		if err := yaml.Unmarshal(b, &lock); err != nil {
+			continue
+		}
+		for name, pkg := range lock.Packages {
+			description, ok := pkg.Description.(map[interface{}]interface{})
+			if pkg.Source != "hosted" || !ok {
+				continue
+			}
+			if url, ok := description["url"].(string); ok && url != "" {
+				hosted[name] = url
+			}
+		}
+	}
+	return hosted
+}
+
+// pubHostDir returns the directory within the pub cache holding the packages of a repository, which pub names after
+// the URL of the repository without its https scheme and with reserved characters escaped
+func pubHostDir(url string) string {
+	url = strings.TrimSuffix(strings.TrimPrefix(url, "https://"), "/")
+	var b strings.Builder
+	for _, r := range url {
+		if strings.ContainsRune(`<>:"\/|?*%`, r) {
+			fmt.Fprintf(&b, "%%%d", r)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// pubCacheDirs returns the directories of a package within the pub cache. The repository recorded within pubspec.lock
+// is preferred, falling back to the directories of pub.dev
+func (l *dirLocator) pubCacheDirs(d diligent.Dep) []string {
+	cache := os.Getenv("PUB_CACHE")
+	if cache == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		cache = filepath.Join(home, ".pub-cache")
+	}
+	if d.Version == "" {
+		return nil
+	}
+	hosts := pubHosts
+	if url, ok := l.pubHosted[d.Name]; ok {
+		hosts = append([]string{pubHostDir(url)}, pubHosts...)
+	}
+	dirs := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		dirs = append(dirs, filepath.Join(cache, "hosted", host, d.Name+"-"+d.Version))
+	}
+	return dirs
+}
@@ -0,0 +1,82 @@
+// Package notice generates third party notices, which provide the attribution required by the licenses of
+// dependencies
+package notice
+
+import (
+	"sort"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/spdx"
+)
+
+// Entry is a dependency within a notice along with the texts found within its package
+type Entry struct {
+	Dep diligent.Dep
+	// Copyrights are the copyright lines found within the package's license and notice files
+	Copyrights []string
+	// LicenseText is the content of the package's LICENSE files, if found
+	LicenseText string
+	// NoticeText is the content of the package's NOTICE files, if found, as required by licenses such as Apache-2.0
+	NoticeText string
+}
+
+// Group is the dependencies which share a license
+type Group struct {
+	License diligent.License
+	Entries []Entry
+	// Text is the canonical text of the license. It is only set if an entry's package does not contain its license
+	// and the text is bundled with diligent
+	Text string
+}
+
+// Notice lists the dependencies of a project grouped by license
+type Notice struct {
+	Groups []Group
+}
+
+// New creates a Notice for the dependencies. Texts are read from the packages found by the Locator
+func New(deps []diligent.Dep, l Locator) Notice {
+	byLicense := map[string]*Group{}
+	for _, d := range deps {
+		g, ok := byLicense[d.License.Identifier]
+		if !ok {
+			g = &Group{License: d.License}
+			byLicense[d.License.Identifier] = g
+		}
+		e := Entry{Dep: d}
+		if f, err := l.Locate(d); err == nil {
+			t := readTexts(f)
+			f.Close()
+			e.Copyrights, e.LicenseText, e.NoticeText = t.copyrights, t.license, t.notice
+		}
+		if e.LicenseText == "" && g.Text == "" {
+			g.Text, _ = spdx.Text(d.License.Identifier)
+		}
+		g.Entries = append(g.Entries, e)
+	}
+
+	n := Notice{Groups: make([]Group, 0, len(byLicense))}
+	for _, g := range byLicense {
+		sort.Slice(g.Entries, func(i, j int) bool { return g.Entries[i].Dep.Name < g.Entries[j].Dep.Name })
+		n.Groups = append(n.Groups, *g)
+	}
+	sort.Slice(n.Groups, func(i, j int) bool { return n.Groups[i].License.Name < n.Groups[j].License.Name })
+	return n
+}
+
+// MissingTexts returns the names of the dependencies for which neither the package's license text nor the
+// canonical text of its license is available
+func (n Notice) MissingTexts() []string {
+	var out []string
+	for _, g := range n.Groups {
+		if g.Text != "" {
+			continue
+		}
+		for _, e := range g.Entries {
+			if e.LicenseText == "" {
+				out = append(out, e.Dep.Name)
+			}
+		}
+	}
+	return out
+}
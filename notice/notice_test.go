@@ -0,0 +1,213 @@
+package notice_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-enry/go-license-detector/v4/licensedb/filer"
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/notice"
+)
+
+type mockLocator map[string]string
+
+func (m mockLocator) Locate(d diligent.Dep) (filer.Filer, error) {
+	dir, ok := m[d.Name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return filer.FromDirectory(dir)
+}
+
+func writePackage(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "notice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func mustGetLicense(t *testing.T, id string) diligent.License {
+	l, err := diligent.GetLicenseFromIdentifier(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestNew(t *testing.T) {
+	apache := writePackage(t, map[string]string{
+		"LICENSE":   "Apache License\nVersion 2.0, January 2004",
+		"NOTICE":    "Apache Foo\nCopyright 2019 The Foo Authors",
+		"README.md": "Copyright 2000 not a license file",
+	})
+	defer os.RemoveAll(apache)
+	mit := writePackage(t, map[string]string{
+		"LICENSE.md": "MIT License\n\nCopyright (c) 2020 Jane Doe\nCopyright (c) <year> <copyright holders>\n\nPermission is hereby granted",
+	})
+	defer os.RemoveAll(mit)
+
+	deps := []diligent.Dep{
+		{Name: "mit-b", Version: "2.0.0", License: mustGetLicense(t, "MIT")},
+		{Name: "apache", Version: "1.0.0", License: mustGetLicense(t, "Apache-2.0")},
+		{Name: "mit-a", Version: "1.0.0", License: mustGetLicense(t, "MIT")},
+		{Name: "custom", License: diligent.License{Identifier: "LicenseRef-Custom", Name: "Custom"}},
+	}
+	n := notice.New(deps, mockLocator{"apache": apache, "mit-a": mit})
+
+	if len(n.Groups) != 3 {
+		t.Fatalf("expected 3 groups, got %d", len(n.Groups))
+	}
+	a := n.Groups[0]
+	if a.License.Identifier != "Apache-2.0" || a.Text != "" || len(a.Entries) != 1 {
+		t.Fatalf("unexpected apache group %+v", a)
+	}
+	if reflect.DeepEqual(a.Entries[0].Copyrights, []string{"Copyright 2019 The Foo Authors"}) == false {
+		t.Errorf("unexpected copyrights %v", a.Entries[0].Copyrights)
+	}
+	if !strings.Contains(a.Entries[0].NoticeText, "Apache Foo") {
+		t.Errorf("expected NOTICE text, got %q", a.Entries[0].NoticeText)
+	}
+
+	c := n.Groups[1]
+	if c.License.Identifier != "LicenseRef-Custom" || c.Text != "" {
+		t.Errorf("unexpected custom group %+v", c)
+	}
+
+	m := n.Groups[2]
+	if m.License.Identifier != "MIT" || len(m.Entries) != 2 || m.Entries[0].Dep.Name != "mit-a" {
+		t.Fatalf("unexpected MIT group %+v", m)
+	}
+	if reflect.DeepEqual(m.Entries[0].Copyrights, []string{"Copyright (c) 2020 Jane Doe"}) == false {
+		t.Errorf("unexpected copyrights %v", m.Entries[0].Copyrights)
+	}
+	if !strings.Contains(m.Text, "Permission is hereby granted") {
+		t.Error("expected the bundled MIT text as mit-b does not contain a license")
+	}
+
+	if missing := n.MissingTexts(); reflect.DeepEqual(missing, []string{"custom"}) == false {
+		t.Errorf("unexpected missing texts %v", missing)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	n := notice.Notice{Groups: []notice.Group{{
+		License: mustGetLicense(t, "MIT"),
+		Entries: []notice.Entry{{
+			Dep:         diligent.Dep{Name: "a", Version: "1.0.0"},
+			Copyrights:  []string{"Copyright (c) 2020 <Jane Doe>"},
+			LicenseText: "a ```quoted``` license",
+		}},
+	}}}
+	cases := []struct {
+		format   notice.Format
+		contains []string
+	}{
+		{notice.Text, []string{"THIRD PARTY NOTICES", "MIT License (MIT)", "  a 1.0.0", "Copyright (c) 2020 <Jane Doe>", "a ```quoted``` license"}},
+		{notice.Markdown, []string{"## MIT License (MIT)", "- a 1.0.0", "### a 1.0.0", "````\na ```quoted``` license\n````"}},
+		{notice.HTML, []string{"<h2>MIT License (MIT)</h2>", "<li>a 1.0.0</li>", "Copyright (c) 2020 &lt;Jane Doe&gt;"}},
+	}
+	for _, tt := range cases {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := n.Write(&buf, tt.format); err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range tt.contains {
+				if !strings.Contains(buf.String(), c) {
+					t.Errorf("expected output to contain %q, got:\n%s", c, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	if f, err := notice.ParseFormat("markdown"); err != nil || f != notice.Markdown {
+		t.Errorf("got %s %v", f, err)
+	}
+	if _, err := notice.ParseFormat("pdf"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestDirLocator(t *testing.T) {
+	root := writePackage(t, map[string]string{})
+	defer os.RemoveAll(root)
+	pkg := filepath.Join(root, "node_modules", "@scope", "a")
+	if err := os.MkdirAll(pkg, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(pkg, "LICENSE"), []byte("Copyright 2020 A"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	l := notice.NewDirLocator([]string{root})
+
+	f, err := l.Locate(diligent.Dep{Name: "@scope/a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if b, err := f.ReadFile("LICENSE"); err != nil || string(b) != "Copyright 2020 A" {
+		t.Errorf("unexpected LICENSE %q %v", b, err)
+	}
+	if _, err := l.Locate(diligent.Dep{Name: "missing"}); err == nil {
+		t.Error("expected error for missing package")
+	}
+	if _, err := l.Locate(diligent.Dep{Name: "../a"}); err == nil {
+		t.Error("expected error for invalid package name")
+	}
+}
+
+func TestDirLocatorPubCache(t *testing.T) {
+	cache := writePackage(t, map[string]string{})
+	defer os.RemoveAll(cache)
+	for _, dir := range []string{filepath.Join("pub.dev", "a-1.0.0"), filepath.Join("pub.dartlang.org", "b-1.0.0"), filepath.Join("pub.acme.com%47dart", "c-1.0.0")} {
+		pkg := filepath.Join(cache, "hosted", dir)
+		if err := os.MkdirAll(pkg, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(pkg, "LICENSE"), []byte(dir), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer os.Setenv("PUB_CACHE", os.Getenv("PUB_CACHE"))
+	os.Setenv("PUB_CACHE", cache)
+	root := writePackage(t, map[string]string{"pubspec.lock": `packages:
+  c:
+    dependency: "direct main"
+    description:
+      name: c
+      url: "https://pub.acme.com/dart"
+    source: hosted
+    version: "1.0.0"
+`})
+	defer os.RemoveAll(root)
+	l := notice.NewDirLocator([]string{root})
+
+	for _, d := range []diligent.Dep{{Name: "a", Version: "1.0.0"}, {Name: "b", Version: "1.0.0"}, {Name: "c", Version: "1.0.0"}} {
+		f, err := l.Locate(d)
+		if err != nil {
+			t.Errorf("%s: %v", d.Name, err)
+			continue
+		}
+		if _, err := f.ReadFile("LICENSE"); err != nil {
+			t.Errorf("%s: %v", d.Name, err)
+		}
+		f.Close()
+	}
+	if _, err := l.Locate(diligent.Dep{Name: "a", Version: "2.0.0"}); err == nil {
+		t.Error("expected error for missing version")
+	}
+}
@@ -0,0 +1,153 @@
+package notice
+
+import (
+	"fmt"
+	htmlTmpl "html/template"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// Format is the format in which a Notice is written
+type Format string
+
+const (
+	Text     Format = "text"
+	Markdown Format = "markdown"
+	HTML     Format = "html"
+)
+
+// Formats are the supported formats
+var Formats = []Format{Text, Markdown, HTML}
+
+// ParseFormat returns the Format with the given name
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown notice format '%s', expected one of text, markdown or html", s)
+}
+
+var funcs = template.FuncMap{
+	"rule": func(char string) string { return strings.Repeat(char, 80) },
+	// fence returns a markdown code fence longer than any run of backticks within the text
+	"fence": func(text string) string {
+		fence := "```"
+		for strings.Contains(text, fence) {
+			fence += "`"
+		}
+		return fence
+	},
+}
+
+const textLayout = `THIRD PARTY NOTICES
+
+This software includes the following third party packages, grouped by license.
+{{range .Groups}}
+{{rule "="}}
+{{.License.Name}}{{if .License.Identifier}} ({{.License.Identifier}}){{end}}
+{{rule "="}}
+{{range .Entries}}
+  {{.Dep.Name}}{{if .Dep.Version}} {{.Dep.Version}}{{end}}
+{{- end}}
+{{range .Entries}}{{if or .Copyrights .LicenseText .NoticeText}}
+{{rule "-"}}
+{{.Dep.Name}}{{if .Dep.Version}} {{.Dep.Version}}{{end}}
+{{rule "-"}}
+{{range .Copyrights}}
+{{.}}
+{{- end}}
+{{if .LicenseText}}
+{{.LicenseText}}
+{{end}}{{if .NoticeText}}
+{{.NoticeText}}
+{{end}}{{end}}{{end}}{{if .Text}}
+{{rule "-"}}
+{{.License.Name}}
+{{rule "-"}}
+
+{{.Text}}
+{{end}}{{end}}`
+
+const markdownLayout = `# Third party notices
+
+This software includes the following third party packages, grouped by license.
+{{range .Groups}}
+## {{.License.Name}}{{if .License.Identifier}} ({{.License.Identifier}}){{end}}
+{{range .Entries}}
+- {{.Dep.Name}}{{if .Dep.Version}} {{.Dep.Version}}{{end}}
+{{- end}}
+{{range .Entries}}{{if or .Copyrights .LicenseText .NoticeText}}
+### {{.Dep.Name}}{{if .Dep.Version}} {{.Dep.Version}}{{end}}
+{{range .Copyrights}}
+{{.}}
+{{- end}}
+{{if .LicenseText}}
+{{fence .LicenseText}}
+{{.LicenseText}}
+{{fence .LicenseText}}
+{{end}}{{if .NoticeText}}
+{{fence .NoticeText}}
+{{.NoticeText}}
+{{fence .NoticeText}}
+{{end}}{{end}}{{end}}{{if .Text}}
+### {{.License.Name}}
+
+{{fence .Text}}
+{{.Text}}
+{{fence .Text}}
+{{end}}{{end}}`
+
+const htmlLayout = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Third party notices</title>
+</head>
+<body>
+<h1>Third party notices</h1>
+<p>This software includes the following third party packages, grouped by license.</p>
+{{range .Groups}}
+<h2>{{.License.Name}}{{if .License.Identifier}} ({{.License.Identifier}}){{end}}</h2>
+<ul>
+{{- range .Entries}}
+<li>{{.Dep.Name}}{{if .Dep.Version}} {{.Dep.Version}}{{end}}</li>
+{{- end}}
+</ul>
+{{range .Entries}}{{if or .Copyrights .LicenseText .NoticeText}}
+<h3>{{.Dep.Name}}{{if .Dep.Version}} {{.Dep.Version}}{{end}}</h3>
+{{- range .Copyrights}}
+<p>{{.}}</p>
+{{- end}}
+{{- if .LicenseText}}
+<pre>{{.LicenseText}}</pre>
+{{- end}}
+{{- if .NoticeText}}
+<pre>{{.NoticeText}}</pre>
+{{- end}}
+{{end}}{{end}}{{if .Text}}
+<h3>{{.License.Name}}</h3>
+<pre>{{.Text}}</pre>
+{{end}}{{end}}
+</body>
+</html>
+`
+
+var templates = map[Format]interface {
+	Execute(w io.Writer, data interface{}) error
+}{
+	Text:     template.Must(template.New("text").Funcs(funcs).Parse(textLayout)),
+	Markdown: template.Must(template.New("markdown").Funcs(funcs).Parse(markdownLayout)),
+	HTML:     htmlTmpl.Must(htmlTmpl.New("html").Parse(htmlLayout)),
+}
+
+// Write outputs the notice in the given format
+func (n Notice) Write(w io.Writer, f Format) error {
+	t, ok := templates[f]
+	if !ok {
+		return fmt.Errorf("unknown notice format '%s'", f)
+	}
+	return t.Execute(w, n)
+}
@@ -1,11 +1,13 @@
 package npm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"strings"
 
 	"errors"
@@ -15,8 +17,15 @@ import (
 )
 
 type packageJSON struct {
-	Deps    map[string]string `json:"dependencies"`
-	DevDeps map[string]string `json:"devDependencies"`
+	Deps         map[string]string `json:"dependencies"`
+	DevDeps      map[string]string `json:"devDependencies"`
+	OptionalDeps map[string]string `json:"optionalDependencies"`
+	PeerDeps     map[string]string `json:"peerDependencies"`
+}
+
+type scopedVersion struct {
+	version string
+	scope   diligent.Scope
 }
 
 type license string
@@ -68,6 +77,15 @@ type npmDeper struct {
 type Config struct {
 	// DevDependencies can be set to true if you want to gather the licenses of your devDependencies as well as your dependencies
 	DevDependencies bool
+	// OptionalDependencies can be set to true if you want to gather the licenses of your optionalDependencies
+	OptionalDependencies bool
+	// PeerDependencies can be set to true if you want to gather the licenses of your peerDependencies
+	PeerDependencies bool
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
+	// Client sends requests to the registry, allowing proxies, certificate authorities and credentials to be
+	// configured. http.DefaultClient is used when nil
+	Client *http.Client
 }
 
 // New returns a Deper capable of dealing with package.json manifest files
@@ -77,6 +95,9 @@ func New(url string, webLG WebLicenseGetter) diligent.Deper {
 
 // NewWithOptions is identical to New but allows the default options to be overridden
 func NewWithOptions(url string, webLG WebLicenseGetter, c Config) diligent.Deper {
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
 	return &npmDeper{c, url, webLG}
 }
 
@@ -85,35 +106,71 @@ func (n *npmDeper) Name() string {
 	return "npm"
 }
 
-func mergeMaps(to map[string]string, from map[string]string) {
+func mergeMaps(to map[string]scopedVersion, from map[string]string, scope diligent.Scope) {
 	for pkg, version := range from {
-		to[pkg] = version
+		to[pkg] = scopedVersion{version, scope}
 	}
 }
 
-// Dependencies returns the licenses associated with the NPM dependencies
+// Dependencies returns the licenses associated with the NPM dependencies, assuming package.json is within the working
+// directory
 func (n *npmDeper) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return n.LocalDependencies(".", file)
+}
+
+// DependenciesContext is identical to Dependencies but cancels the registry requests once ctx is done
+func (n *npmDeper) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return n.LocalDependenciesContext(ctx, ".", file)
+}
+
+// LocalDependencies returns the licenses associated with the NPM dependencies, dir is the directory containing
+// package.json, whose node_modules directory is searched for the packages' licenses when offline
+func (n *npmDeper) LocalDependencies(dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return n.LocalDependenciesContext(context.Background(), dir, file)
+}
+
+// LocalDependenciesContext is identical to LocalDependencies but cancels the registry requests once ctx is done
+func (n *npmDeper) LocalDependenciesContext(ctx context.Context, dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
 	var pkg packageJSON
 	err := json.Unmarshal(file, &pkg)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	licensesToGet := map[string]string{}
-	mergeMaps(licensesToGet, pkg.Deps)
+	// merged in order of precedence, so a package listed as a dependency and a devDependency is reported once as a
+	// production dependency
+	licensesToGet := map[string]scopedVersion{}
+	if n.config.PeerDependencies {
+		mergeMaps(licensesToGet, pkg.PeerDeps, diligent.Peer)
+	}
+	if n.config.OptionalDependencies {
+		mergeMaps(licensesToGet, pkg.OptionalDeps, diligent.Optional)
+	}
 	if n.config.DevDependencies {
-		mergeMaps(licensesToGet, pkg.DevDeps)
+		mergeMaps(licensesToGet, pkg.DevDeps, diligent.Development)
 	}
+	mergeMaps(licensesToGet, pkg.Deps, diligent.Production)
 
 	deps := make([]diligent.Dep, 0, len(licensesToGet))
 	warns := make([]diligent.Warning, 0, len(licensesToGet))
-	for pkg, version := range licensesToGet {
-		l, err := n.getNPMLicense(pkg, version)
-		if err != nil {
-			warns = append(warns, warning.New(pkg, err.Error()))
-		} else {
-			deps = append(deps, l)
+	for pkg, sv := range licensesToGet {
+		var scope diligent.Scope
+		if sv.scope != diligent.Production {
+			scope = sv.scope
 		}
+		d, ok := n.config.Overrides.Dep(n.Name(), pkg, sv.version)
+		if !ok {
+			var err error
+			var t diligent.Trace
+			d, err = n.getNPMLicense(ctx, dir, pkg, sv.version, &t)
+			if err != nil {
+				warns = append(warns, warning.FromScopedError(n.Name(), pkg, sv.version, scope, err, t))
+				continue
+			}
+			d.Trace = t
+		}
+		d.Scope = scope
+		deps = append(deps, d)
 	}
 	return deps, warns, nil
 }
@@ -123,42 +180,65 @@ func (n *npmDeper) IsCompatible(filename string) bool {
 	return filename == "package.json"
 }
 
-func (n *npmDeper) getNPMLicenseFromURL(pkgName, url string) (diligent.Dep, error) {
-	resp, err := http.Get(url)
+func (n *npmDeper) getNPMPackage(ctx context.Context, url string) (npmPackage, error) {
+	var packageInfo npmPackage
+	rctx, cancel, err := diligent.RequestContext(ctx)
 	if err != nil {
-		return diligent.Dep{}, err
+		return packageInfo, err
+	}
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return packageInfo, err
+	}
+	resp, err := n.config.Client.Do(req.WithContext(rctx))
+	if err != nil {
+		return packageInfo, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return diligent.Dep{}, fmt.Errorf("requested failed with status %v", resp.StatusCode)
+		return packageInfo, &diligent.StatusError{StatusCode: resp.StatusCode}
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return diligent.Dep{}, err
+		return packageInfo, err
 	}
 
-	var packageInfo npmPackage
 	err = json.Unmarshal(body, &packageInfo)
 	if err != nil {
-		return diligent.Dep{}, errors.New("parsing NPM response failed - invalid JSON")
+		return packageInfo, &diligent.MalformedError{Msg: "parsing NPM response failed - invalid JSON"}
 	}
+	return packageInfo, nil
+}
 
+func (n *npmDeper) getNPMLicenseFromURL(ctx context.Context, pkgName, url string, t *diligent.Trace) (diligent.Dep, error) {
+	packageInfo, err := n.getNPMPackage(ctx, url)
+	if err != nil {
+		t.Record("npm registry", url, diligent.License{}, err)
+		return diligent.Dep{}, err
+	}
+
+	// an unknown identifier within the license field is reported in preference to a lack of license information
+	noLicenseErr := errors.New("no license information in NPM")
 	if packageInfo.License != nil {
-		l, err := diligent.GetLicenseFromIdentifier(string(*packageInfo.License))
+		l, err := diligent.TraceLicenseFromIdentifier(string(*packageInfo.License), "npm license field", url, t)
 		if err == nil {
 			return diligent.Dep{
 				Name:    pkgName,
 				License: l,
 			}, nil
 		}
+		noLicenseErr = err
+	} else {
+		t.Record("npm license field", url, diligent.License{}, errors.New("no license field"))
 	}
 
 	if packageInfo.Repository != nil {
 		if n.webLG != nil && n.webLG.IsCompatibleURL(string(*packageInfo.Repository)) {
 			gitUrl := string(*packageInfo.Repository)
 			repoURL := strings.Replace(gitUrl, ".git", "", 1)
-			l, err := n.webLG.GetLicenseFromURL(repoURL)
+			l, err := diligent.GetLicenseFromURL(ctx, n.webLG, repoURL, t)
 			if err == nil {
 				return diligent.Dep{
 					Name:    pkgName,
@@ -167,7 +247,7 @@ func (n *npmDeper) getNPMLicenseFromURL(pkgName, url string) (diligent.Dep, erro
 			}
 		}
 		if strings.HasPrefix(string(*packageInfo.Repository), "git") {
-			l, err := diligent.GetLicenseForGit(string(*packageInfo.Repository))
+			l, err := diligent.TraceLicenseForGit(ctx, string(*packageInfo.Repository), t)
 			if err == nil {
 				return diligent.Dep{
 					Name:    pkgName,
@@ -177,12 +257,43 @@ func (n *npmDeper) getNPMLicenseFromURL(pkgName, url string) (diligent.Dep, erro
 		}
 	}
 
-	return diligent.Dep{}, errors.New("no license information in NPM")
+	return diligent.Dep{}, noLicenseErr
+}
+
+// getInstalledLicense looks for the license of a package installed within node_modules, first within its package.json
+// and then within its license file
+func getInstalledLicense(dir, pkgName string, t *diligent.Trace) (diligent.License, error) {
+	pkgDir := filepath.Join(dir, "node_modules", filepath.FromSlash(pkgName))
+	manifest := filepath.Join(pkgDir, "package.json")
+	b, err := ioutil.ReadFile(manifest)
+	if err != nil {
+		t.Record("node_modules", pkgDir, diligent.License{}, errors.New("package not installed"))
+		return diligent.License{}, err
+	}
+	var packageInfo npmPackage
+	if err := json.Unmarshal(b, &packageInfo); err != nil {
+		t.Record("node_modules license field", manifest, diligent.License{}, err)
+	} else if packageInfo.License != nil {
+		l, err := diligent.TraceLicenseFromIdentifier(string(*packageInfo.License), "node_modules license field", manifest, t)
+		if err == nil {
+			return l, nil
+		}
+	} else {
+		t.Record("node_modules license field", manifest, diligent.License{}, errors.New("no license field"))
+	}
+	return diligent.TraceLicenseForDirectory(pkgDir, t)
 }
 
-func (n *npmDeper) getNPMLicense(pkgName, version string) (diligent.Dep, error) {
+func (n *npmDeper) getNPMLicense(ctx context.Context, dir, pkgName, version string, t *diligent.Trace) (diligent.Dep, error) {
+	if diligent.IsOffline(ctx) {
+		if l, err := getInstalledLicense(dir, pkgName, t); err == nil {
+			return diligent.Dep{Name: pkgName, Version: version, License: l}, nil
+		}
+	}
 	npmURL := fmt.Sprintf("%s/%s?version=%s", n.url, strings.Replace(url.QueryEscape(pkgName), "%40", "@", 1), url.QueryEscape(version))
-	return n.getNPMLicenseFromURL(pkgName, npmURL)
+	d, err := n.getNPMLicenseFromURL(ctx, pkgName, npmURL, t)
+	d.Version = version
+	return d, err
 }
 
 func (l *license) UnmarshalJSON(data []byte) error {
@@ -1,7 +1,14 @@
 package npm_test
 
 import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"net/http"
 	"reflect"
@@ -58,7 +65,7 @@ func TestDependencies(t *testing.T) {
 		in          []byte
 		handler     http.HandlerFunc
 		depsOut     map[string]string
-		warnsOut    []diligent.Warning
+		warnsOut    []diligent.WarningDetails
 		errOut      bool
 	}{{
 		"should handle only dependencies by default",
@@ -84,9 +91,9 @@ func TestDependencies(t *testing.T) {
 			w.Write([]byte("{\"license\":\"MIT\"}"))
 		}),
 		map[string]string{
-			"d3": "MIT",
+			"d3@5.0.0": "MIT",
 		},
-		[]diligent.Warning{},
+		[]diligent.WarningDetails{},
 		false,
 	}, {
 		"should be able to handle multiple dependencies",
@@ -112,10 +119,10 @@ func TestDependencies(t *testing.T) {
 			}
 		}),
 		map[string]string{
-			"d3":      "GPL-3.0",
-			"cypress": "MIT",
+			"d3@^5.0.0":     "GPL-3.0",
+			"cypress@2.1.0": "MIT",
 		},
-		[]diligent.Warning{},
+		[]diligent.WarningDetails{},
 		false,
 	}, {
 		"should support part failures",
@@ -141,10 +148,10 @@ func TestDependencies(t *testing.T) {
 			}
 		}),
 		map[string]string{
-			"d3": "GPL-3.0",
+			"d3@~5.0.0": "GPL-3.0",
 		},
-		[]diligent.Warning{
-			warning.New("cypress", "requested failed with status 500"),
+		[]diligent.WarningDetails{
+			{Kind: diligent.NetworkFailure, Ecosystem: "npm", Package: "cypress", Version: "2.1.0", Err: &diligent.StatusError{StatusCode: 500}},
 		},
 		false,
 	}, {
@@ -171,9 +178,9 @@ func TestDependencies(t *testing.T) {
 			}
 		}),
 		map[string]string{},
-		[]diligent.Warning{
-			warning.New("d3", "requested failed with status 500"),
-			warning.New("cypress", "requested failed with status 500")},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NetworkFailure, Ecosystem: "npm", Package: "cypress", Version: "2.1.0", Err: &diligent.StatusError{StatusCode: 500}},
+			{Kind: diligent.NetworkFailure, Ecosystem: "npm", Package: "d3", Version: "5.0.0", Err: &diligent.StatusError{StatusCode: 500}}},
 		false,
 	}, {
 		"should be capable of including devDependencies",
@@ -199,10 +206,41 @@ func TestDependencies(t *testing.T) {
 			w.Write([]byte("{\"license\":\"MIT\"}"))
 		}),
 		map[string]string{
-			"d3":      "MIT",
-			"cypress": "MIT",
+			"d3@5.0.0":      "MIT",
+			"cypress@2.1.0": "MIT dev",
 		},
-		[]diligent.Warning{},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should be capable of including optional and peer dependencies, reporting each package in its most important scope",
+		npm.Config{DevDependencies: true, OptionalDependencies: true, PeerDependencies: true},
+		[]byte(`
+			{
+				"dependencies": {
+					"d3": "5.0.0"
+				},
+				"devDependencies": {
+					"d3": "5.0.0",
+					"react": "16.0.0"
+				},
+				"optionalDependencies": {
+					"fsevents": "2.0.0"
+				},
+				"peerDependencies": {
+					"react": "16.0.0"
+				}
+			}
+		`),
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("{\"license\":\"MIT\"}"))
+		}),
+		map[string]string{
+			"d3@5.0.0":       "MIT",
+			"react@16.0.0":   "MIT dev",
+			"fsevents@2.0.0": "MIT optional",
+		},
+		[]diligent.WarningDetails{},
 		false,
 	}, {
 		"package.json parse failure",
@@ -210,7 +248,7 @@ func TestDependencies(t *testing.T) {
 		[]byte(`{{`),
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
 		map[string]string{},
-		[]diligent.Warning{},
+		[]diligent.WarningDetails{},
 		true,
 	}, {
 		"should support old license objects",
@@ -227,9 +265,9 @@ func TestDependencies(t *testing.T) {
 			w.Write([]byte("{\"license\":{\"type\":\"MIT\"}}"))
 		}),
 		map[string]string{
-			"d3": "MIT",
+			"d3@5.0.0": "MIT",
 		},
-		[]diligent.Warning{},
+		[]diligent.WarningDetails{},
 		false,
 	}, {
 		"should fail if response is not valid JSON",
@@ -246,8 +284,8 @@ func TestDependencies(t *testing.T) {
 			w.Write([]byte("{{"))
 		}),
 		map[string]string{},
-		[]diligent.Warning{
-			warning.New("d3", "parsing NPM response failed - invalid JSON"),
+		[]diligent.WarningDetails{
+			{Kind: diligent.ParseError, Ecosystem: "npm", Package: "d3", Version: "5.0.0", Err: &diligent.MalformedError{Msg: "parsing NPM response failed - invalid JSON"}},
 		},
 		false,
 	}, {
@@ -265,8 +303,8 @@ func TestDependencies(t *testing.T) {
 			w.Write([]byte("{}"))
 		}),
 		map[string]string{},
-		[]diligent.Warning{
-			warning.New("d3", "no license information in NPM"),
+		[]diligent.WarningDetails{
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "npm", Package: "d3", Version: "5.0.0", Err: errors.New("no license information in NPM")},
 		},
 		false,
 	}}
@@ -277,10 +315,20 @@ func TestDependencies(t *testing.T) {
 			defer ts.Close()
 			target := npm.NewWithOptions(ts.URL, nil, tt.config)
 			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
 			expectedDeps := make([]diligent.Dep, 0, len(tt.depsOut))
 			for depID, lID := range tt.depsOut {
-				l, _ := diligent.GetLicenseFromIdentifier(lID)
-				expectedDeps = append(expectedDeps, diligent.Dep{depID, l})
+				// expected licenses may be followed by the scope of the dependency, e.g. "MIT dev"
+				fields := strings.Fields(lID)
+				l, _ := diligent.GetLicenseFromIdentifier(fields[0])
+				at := strings.LastIndex(depID, "@")
+				dep := diligent.Dep{Name: depID[:at], Version: depID[at+1:], License: l}
+				if len(fields) > 1 {
+					dep.Scope = diligent.Scope(fields[1])
+				}
+				expectedDeps = append(expectedDeps, dep)
 			}
 			if len(d) > 0 || len(expectedDeps) > 0 {
 				sort.Sort(diligent.DepsByName(d))
@@ -289,11 +337,9 @@ func TestDependencies(t *testing.T) {
 					t.Errorf("deps: got %+v, want %+v", d, expectedDeps)
 				}
 			}
-			if len(w) > 0 || len(tt.warnsOut) > 0 {
-				sort.Sort(diligent.Warnings(w))
-				sort.Sort(diligent.Warnings(tt.warnsOut))
-				if reflect.DeepEqual(w, tt.warnsOut) == false {
-					t.Errorf("warnings: got %+v, want %+v", w, tt.warnsOut)
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
 				}
 			}
 			isErr := e != nil
@@ -303,3 +349,184 @@ func TestDependencies(t *testing.T) {
 		})
 	}
 }
+
+func TestOverrides(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	}))
+	defer ts.Close()
+	o, _ := diligent.NewOverride("d3", ">=5.0.0 <6", "npm", "ISC")
+	target := npm.NewWithOptions(ts.URL, nil, npm.Config{Overrides: diligent.Overrides{o}})
+	d, w, err := target.Dependencies([]byte(`{"dependencies": {"d3": "^5.0.0"}}`))
+	if err != nil || len(w) > 0 {
+		t.Fatalf("unexpected error %v or warnings %v", err, w)
+	}
+	expected := []diligent.Dep{{Name: "d3", Version: "^5.0.0", License: o.License, Declared: true,
+		Trace: diligent.Trace{{Source: "config override", Location: "overrides", License: "ISC"}}}}
+	if reflect.DeepEqual(d, expected) == false {
+		t.Errorf("deps: got %+v, want %+v", d, expected)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/d3" {
+			w.Write([]byte(`{"license":"MIT"}`))
+		} else {
+			w.Write([]byte(`{"license":"not-a-license"}`))
+		}
+	}))
+	defer ts.Close()
+	target := npm.New(ts.URL, nil)
+	d, w, err := target.Dependencies([]byte(`{"dependencies": {"d3": "5.0.0", "other": "1.0.0"}}`))
+	if err != nil || len(d) != 1 || len(w) != 1 {
+		t.Fatalf("unexpected result %+v %+v %v", d, w, err)
+	}
+	expected := diligent.Trace{{Source: "npm license field", Location: ts.URL + "/d3?version=5.0.0", License: "MIT"}}
+	if reflect.DeepEqual(d[0].Trace, expected) == false {
+		t.Errorf("dep trace: got %+v, want %+v", d[0].Trace, expected)
+	}
+	trace := w[0].(*warning.Warn).Trace
+	if len(trace) != 1 || trace[0].Source != "npm license field" || trace[0].Err == "" {
+		t.Errorf("unexpected warning trace %+v", trace)
+	}
+}
+
+func TestDependenciesContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Write([]byte(`{"license":"MIT"}`))
+	}))
+	defer ts.Close()
+	target := npm.New(ts.URL, nil).(diligent.ContextDeper)
+	file := []byte(`{"dependencies": {"d3": "5.0.0", "slow": "1.0.0"}}`)
+
+	ctx := diligent.WithRequestTimeout(context.Background(), 50*time.Millisecond)
+	d, w, err := target.DependenciesContext(ctx, file)
+	if err != nil || len(d) != 1 || d[0].Name != "d3" || len(w) != 1 {
+		t.Fatalf("unexpected result %+v %+v %v", d, w, err)
+	}
+	if !strings.Contains(w[0].Warning(), "deadline exceeded") {
+		t.Errorf("expected the slow request to time out, got %s", w[0].Warning())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	d, w, err = target.DependenciesContext(ctx, file)
+	if err != nil || len(d) != 0 || len(w) != 2 {
+		t.Fatalf("expected every lookup to be cancelled, got %+v %+v %v", d, w, err)
+	}
+}
+
+type headerTransport struct {
+	header string
+}
+
+func (h headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", h.header)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"license":"MIT"}`))
+	}))
+	defer ts.Close()
+	client := &http.Client{Transport: headerTransport{"Bearer token"}}
+	target := npm.NewWithOptions(ts.URL, nil, npm.Config{Client: client})
+	d, w, err := target.Dependencies([]byte(`{"dependencies": {"d3": "5.0.0"}}`))
+	if err != nil || len(d) != 1 || len(w) != 0 {
+		t.Fatalf("expected the provided client to be used, got %+v %+v %v", d, w, err)
+	}
+}
+
+func TestOffline(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	defer ts.Close()
+	dir, err := ioutil.TempDir("", "npm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pkgDir := filepath.Join(dir, "node_modules", "@types", "node")
+	if err := os.MkdirAll(pkgDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(pkgDir, "package.json"), []byte(`{"license":"MIT"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	target := npm.New(ts.URL, nil).(diligent.ContextLocalDeper)
+	file := []byte(`{"dependencies": {"@types/node": "1.0.0", "d3": "5.0.0"}}`)
+	d, w, err := target.LocalDependenciesContext(diligent.WithOffline(context.Background()), dir, file)
+	if err != nil || len(d) != 1 || len(w) != 1 {
+		t.Fatalf("unexpected result %+v %+v %v", d, w, err)
+	}
+	if d[0].Name != "@types/node" || d[0].License.Identifier != "MIT" {
+		t.Errorf("expected @types/node to be MIT licensed, got %+v", d[0])
+	}
+	if expected := "Failed to determine license for d3: " + diligent.ErrOffline.Error(); w[0].Warning() != expected {
+		t.Errorf("expected warning %q, got %q", expected, w[0].Warning())
+	}
+}
+
+func TestWarningDetails(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/malformed":
+			w.Write([]byte("{"))
+		case "/unknown":
+			w.Write([]byte(`{"license":"Unknown-1.0"}`))
+		}
+	}))
+	defer ts.Close()
+	target := npm.New(ts.URL, nil)
+	_, w, err := target.Dependencies([]byte(`{"dependencies": {"missing": "1.0.0", "malformed": "2.0.0", "unknown": "3.0.0"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]diligent.WarningDetails{
+		"missing":   {Kind: diligent.NotFound, Ecosystem: "npm", Package: "missing", Version: "1.0.0"},
+		"malformed": {Kind: diligent.ParseError, Ecosystem: "npm", Package: "malformed", Version: "2.0.0"},
+		"unknown":   {Kind: diligent.UnknownIdentifier, Ecosystem: "npm", Package: "unknown", Version: "3.0.0"},
+	}
+	if len(w) != len(expected) {
+		t.Fatalf("expected %d warnings, got %v", len(expected), w)
+	}
+	for _, warn := range w {
+		details := diligent.GetWarningDetails(warn)
+		if details.Err == nil {
+			t.Errorf("%s: expected the underlying error", details.Package)
+		}
+		details.Err, details.Trace = nil, nil
+		if reflect.DeepEqual(details, expected[details.Package]) == false {
+			t.Errorf("got %+v, want %+v", details, expected[details.Package])
+		}
+	}
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
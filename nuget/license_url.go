@@ -0,0 +1,81 @@
+package nuget
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+)
+
+const licensesNugetHost = "licenses.nuget.org"
+
+// knownLicenseURLs maps well known license pages, with their scheme, "www." prefix, file extension and trailing slash
+// removed, to license identifiers. It is used to handle packages which still use the deprecated licenseUrl element.
+var knownLicenseURLs = map[string]string{
+	"opensource.org/licenses/mit":                         "MIT",
+	"opensource.org/licenses/mit-license":                 "MIT",
+	"opensource.org/licenses/apache-2.0":                  "Apache-2.0",
+	"opensource.org/licenses/bsd-2-clause":                "BSD-2-Clause",
+	"opensource.org/licenses/bsd-3-clause":                "BSD-3-Clause",
+	"opensource.org/licenses/bsd-license":                 "BSD-3-Clause",
+	"opensource.org/licenses/ms-pl":                       "MS-PL",
+	"opensource.org/licenses/ms-rl":                       "MS-RL",
+	"opensource.org/licenses/mpl-2.0":                     "MPL-2.0",
+	"opensource.org/licenses/isc-license":                 "ISC",
+	"opensource.org/licenses/isc":                         "ISC",
+	"opensource.org/licenses/lgpl-2.1":                    "LGPL-2.1",
+	"opensource.org/licenses/lgpl-3.0":                    "LGPL-3.0",
+	"opensource.org/licenses/gpl-2.0":                     "GPL-2.0",
+	"opensource.org/licenses/gpl-3.0":                     "GPL-3.0",
+	"opensource.org/licenses/eclipse-1.0":                 "EPL-1.0",
+	"opensource.org/licenses/zlib":                        "Zlib",
+	"apache.org/licenses/license-2.0":                     "Apache-2.0",
+	"apache.org/licenses/license-1.1":                     "Apache-1.1",
+	"gnu.org/licenses/gpl":                                "GPL-3.0",
+	"gnu.org/licenses/gpl-3.0":                            "GPL-3.0",
+	"gnu.org/licenses/lgpl":                               "LGPL-3.0",
+	"gnu.org/licenses/lgpl-3.0":                           "LGPL-3.0",
+	"gnu.org/licenses/old-licenses/gpl-2.0":               "GPL-2.0",
+	"gnu.org/licenses/old-licenses/lgpl-2.1":              "LGPL-2.1",
+	"mozilla.org/mpl/2.0":                                 "MPL-2.0",
+	"mozilla.org/en-us/mpl/2.0":                           "MPL-2.0",
+	"unlicense.org":                                       "Unlicense",
+	"creativecommons.org/publicdomain/zero/1.0":           "CC0-1.0",
+	"creativecommons.org/publicdomain/zero/1.0/legalcode": "CC0-1.0",
+	"github.com/dotnet/corefx/blob/master/license":        "MIT",
+	"github.com/dotnet/standard/blob/master/license":      "MIT",
+	"dot.net/license":                                     "MIT",
+}
+
+func normaliseLicenseURL(u *url.URL) string {
+	host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+	p := strings.TrimSuffix(strings.ToLower(u.Path), "/")
+	switch path.Ext(p) {
+	case ".html", ".htm", ".php", ".txt", ".md":
+		p = strings.TrimSuffix(p, path.Ext(p))
+	}
+	return host + p
+}
+
+// getLicenseFromURL maps a nuspec licenseUrl to a license. Links to licenses.nuget.org embed the escaped license
+// expression within the path, such as https://licenses.nuget.org/(MIT%20OR%20Apache-2.0), other URLs must be one of the
+// known license pages.
+func getLicenseFromURL(licenseURL string) (diligent.License, error) {
+	u, err := url.Parse(strings.TrimSpace(licenseURL))
+	if err != nil {
+		return diligent.License{}, err
+	}
+	if strings.EqualFold(u.Host, licensesNugetHost) {
+		expression, err := url.PathUnescape(strings.Trim(u.EscapedPath(), "/"))
+		if err != nil {
+			return diligent.License{}, err
+		}
+		return diligent.GetLicenseFromExpression(expression)
+	}
+	if id, ok := knownLicenseURLs[normaliseLicenseURL(u)]; ok {
+		return diligent.GetLicenseFromIdentifier(id)
+	}
+	return diligent.License{}, errors.New("license URL " + licenseURL + " is not known to diligent")
+}
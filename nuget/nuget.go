@@ -0,0 +1,294 @@
+package nuget
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// WebLicenseGetter retrieves license information from an online source
+type WebLicenseGetter interface {
+	IsCompatibleURL(s string) bool
+	GetLicenseFromURL(s string) (diligent.License, error)
+}
+
+// pkg is a version of a package referenced by a project
+type pkg struct {
+	name    string
+	version string
+}
+
+type lockedPackage struct {
+	Type     string `json:"type"`
+	Resolved string `json:"resolved"`
+}
+
+type packagesLock struct {
+	Dependencies map[string]map[string]lockedPackage `json:"dependencies"`
+}
+
+type packageReference struct {
+	Include        string `xml:"Include,attr"`
+	VersionAttr    string `xml:"Version,attr"`
+	VersionElement string `xml:"Version"`
+}
+
+type itemGroup struct {
+	PackageReferences []packageReference `xml:"PackageReference"`
+	PackageVersions   []packageReference `xml:"PackageVersion"`
+}
+
+type project struct {
+	ItemGroups []itemGroup `xml:"ItemGroup"`
+}
+
+type nuspec struct {
+	Metadata struct {
+		License struct {
+			Type  string `xml:"type,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"license"`
+		LicenseURL string `xml:"licenseUrl"`
+		Repository struct {
+			Type string `xml:"type,attr"`
+			URL  string `xml:"url,attr"`
+		} `xml:"repository"`
+		ProjectURL string `xml:"projectUrl"`
+	} `xml:"metadata"`
+}
+
+type nuget struct {
+	config Config
+	url    string
+	webLG  WebLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
+	// Client sends requests to the registry, allowing proxies, certificate authorities and credentials to be
+	// configured. http.DefaultClient is used when nil
+	Client *http.Client
+}
+
+// New returns a Deper capable of dealing with NuGet packages.lock.json files, along with the PackageReference and
+// PackageVersion items found in .csproj and Directory.Packages.props files.
+// The url should point at a NuGet v3 package content (flat container) endpoint, for example
+// https://api.nuget.org/v3-flatcontainer
+func New(url string, webLG WebLicenseGetter) diligent.Deper {
+	return NewWithOptions(url, webLG, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(url string, webLG WebLicenseGetter, c Config) diligent.Deper {
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+	return &nuget{c, strings.TrimSuffix(url, "/"), webLG}
+}
+
+// Name returns "nuget"
+func (n *nuget) Name() string {
+	return "nuget"
+}
+
+// Dependencies returns the licenses associated with the NuGet packages
+func (n *nuget) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return n.DependenciesContext(context.Background(), file)
+}
+
+// DependenciesContext is identical to Dependencies but cancels the lookups once ctx is done
+func (n *nuget) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	pkgs, err := parse(bytes.TrimPrefix(file, utf8BOM))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deps := make([]diligent.Dep, 0, len(pkgs))
+	warns := make([]diligent.Warning, 0, len(pkgs))
+	for _, p := range pkgs {
+		if d, ok := n.config.Overrides.Dep(n.Name(), p.name, p.version); ok {
+			deps = append(deps, d)
+			continue
+		}
+		var t diligent.Trace
+		l, err := n.getLicense(ctx, p.name, p.version, &t)
+		if err != nil {
+			warns = append(warns, warning.FromError(n.Name(), p.name, p.version, err, t))
+		} else {
+			deps = append(deps, diligent.Dep{
+				Name:    p.name,
+				Version: p.version,
+				License: l,
+				Trace:   t,
+			})
+		}
+	}
+	return deps, warns, nil
+}
+
+// IsCompatible returns true if the filename is packages.lock.json, Directory.Packages.props or a .csproj file
+func (n *nuget) IsCompatible(filename string) bool {
+	return filename == "packages.lock.json" || filename == "Directory.Packages.props" || strings.HasSuffix(filename, ".csproj")
+}
+
+func parse(file []byte) ([]pkg, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(file), []byte("{")) {
+		return parseLock(file)
+	}
+	return parseProject(file)
+}
+
+// parseLock returns every package resolved within packages.lock.json, regardless of target framework. A package
+// resolved to different versions for different target frameworks is returned once for each version.
+// Project references are local to the solution and are skipped.
+func parseLock(file []byte) ([]pkg, error) {
+	var lock packagesLock
+	if err := json.Unmarshal(file, &lock); err != nil {
+		return nil, err
+	}
+	resolved := map[pkg]bool{}
+	for _, framework := range lock.Dependencies {
+		for name, locked := range framework {
+			if locked.Type == "Project" || locked.Resolved == "" {
+				continue
+			}
+			resolved[pkg{name, locked.Resolved}] = true
+		}
+	}
+	pkgs := make([]pkg, 0, len(resolved))
+	for p := range resolved {
+		pkgs = append(pkgs, p)
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].name != pkgs[j].name {
+			return pkgs[i].name < pkgs[j].name
+		}
+		return pkgs[i].version < pkgs[j].version
+	})
+	return pkgs, nil
+}
+
+// parseProject returns the packages referenced by an MSBuild project file.
+// References without a version rely on central package management, in which case the version is defined
+// (and reported) by Directory.Packages.props. Items with an Update attribute rather than Include alter packages
+// referenced elsewhere, so do not add dependencies
+func parseProject(file []byte) ([]pkg, error) {
+	var p project
+	if err := xml.Unmarshal(file, &p); err != nil {
+		return nil, err
+	}
+	pkgs := []pkg{}
+	seen := map[pkg]bool{}
+	for _, group := range p.ItemGroups {
+		refs := append(group.PackageReferences, group.PackageVersions...)
+		for _, ref := range refs {
+			name := ref.Include
+			version := ref.VersionAttr
+			if version == "" {
+				version = strings.TrimSpace(ref.VersionElement)
+			}
+			if name == "" || version == "" {
+				continue
+			}
+			if ref := (pkg{name, version}); !seen[ref] {
+				seen[ref] = true
+				pkgs = append(pkgs, ref)
+			}
+		}
+	}
+	return pkgs, nil
+}
+
+// exactVersion converts a NuGet version range into the lowest version it permits, e.g. "[1.2.3, 2.0)" is 1.2.3
+func exactVersion(version string) (string, error) {
+	v := strings.TrimLeft(strings.TrimSpace(version), "[(")
+	if idx := strings.IndexAny(v, ",)]"); idx >= 0 {
+		v = v[:idx]
+	}
+	v = strings.TrimSpace(v)
+	if v == "" || strings.Contains(v, "*") {
+		return "", fmt.Errorf("cannot determine an exact version from '%s'", version)
+	}
+	return v, nil
+}
+
+func (n *nuget) getNuspec(ctx context.Context, nuspecURL string) (nuspec, error) {
+	var spec nuspec
+	rctx, cancel, err := diligent.RequestContext(ctx)
+	if err != nil {
+		return spec, err
+	}
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, nuspecURL, nil)
+	if err != nil {
+		return spec, err
+	}
+	resp, err := n.config.Client.Do(req.WithContext(rctx))
+	if err != nil {
+		return spec, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return spec, &diligent.StatusError{StatusCode: resp.StatusCode}
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&spec); err != nil {
+		return spec, &diligent.MalformedError{Msg: "parsing nuspec failed - invalid XML"}
+	}
+	return spec, nil
+}
+
+func (n *nuget) getLicense(ctx context.Context, pkg, version string, t *diligent.Trace) (diligent.License, error) {
+	v, err := exactVersion(version)
+	if err != nil {
+		return diligent.License{}, err
+	}
+	id := strings.ToLower(pkg)
+	nuspecURL := fmt.Sprintf("%s/%s/%s/%s.nuspec", n.url, url.PathEscape(id), url.PathEscape(strings.ToLower(v)), url.PathEscape(id))
+	spec, err := n.getNuspec(ctx, nuspecURL)
+	if err != nil {
+		t.Record("nuget registry", nuspecURL, diligent.License{}, err)
+		return diligent.License{}, err
+	}
+
+	if spec.Metadata.License.Type == "expression" {
+		l, err := diligent.GetLicenseFromExpression(spec.Metadata.License.Value)
+		t.Record("nuspec license expression", nuspecURL, l, err)
+		if err == nil {
+			return l, nil
+		}
+	}
+
+	if spec.Metadata.LicenseURL != "" {
+		l, err := getLicenseFromURL(spec.Metadata.LicenseURL)
+		t.Record("nuspec licenseUrl", spec.Metadata.LicenseURL, l, err)
+		if err == nil {
+			return l, nil
+		}
+	}
+
+	for _, repoURL := range []string{spec.Metadata.Repository.URL, spec.Metadata.ProjectURL} {
+		repoURL = strings.TrimSuffix(repoURL, ".git")
+		if repoURL != "" && n.webLG != nil && n.webLG.IsCompatibleURL(repoURL) {
+			l, err := diligent.GetLicenseFromURL(ctx, n.webLG, repoURL, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+	}
+
+	return diligent.License{}, errors.New("no license information in nuspec")
+}
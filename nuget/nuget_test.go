@@ -0,0 +1,268 @@
+package nuget_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/nuget"
+)
+
+func TestName(t *testing.T) {
+	target := nuget.New("", nil)
+	if target.Name() != "nuget" {
+		t.Error("expected 'nuget'")
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out bool
+	}{
+		{"packages.lock.json", true},
+		{"Directory.Packages.props", true},
+		{"MyApp.csproj", true},
+		{"MyApp.csproj.user", false},
+		{"packages.config", false},
+		{"package.json", false},
+		{"Directory.Build.props", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			target := nuget.New("", nil)
+			compatible := target.IsCompatible(tt.in)
+			if compatible != tt.out {
+				t.Errorf("got %v, want %v", compatible, tt.out)
+			}
+		})
+	}
+}
+
+func nuspecs(t *testing.T, specs map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET got %s", r.Method)
+		}
+		metadata, ok := specs[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?>
+<package xmlns="http://schemas.microsoft.com/packaging/2013/05/nuspec.xsd">
+  <metadata>` + metadata + `</metadata>
+</package>`))
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	specs := map[string]string{
+		"/newtonsoft.json/13.0.1/newtonsoft.json.nuspec":          `<license type="expression">MIT</license><licenseUrl>https://licenses.nuget.org/MIT</licenseUrl>`,
+		"/serilog/2.8.0/serilog.nuspec":                           `<license type="expression">Apache-2.0</license>`,
+		"/serilog/2.10.0/serilog.nuspec":                          `<licenseUrl>http://www.apache.org/licenses/LICENSE-2.0</licenseUrl>`,
+		"/dual/1.0.0/dual.nuspec":                                 `<license type="expression">woowoo OR BSD-3-Clause</license>`,
+		"/nuget.licensed/1.0.0/nuget.licensed.nuspec":             `<licenseUrl>https://licenses.nuget.org/Apache-2.0</licenseUrl>`,
+		"/compound.or/1.0.0/compound.or.nuspec":                   `<licenseUrl>https://licenses.nuget.org/(MIT%20OR%20Apache-2.0)</licenseUrl>`,
+		"/compound.and/1.0.0/compound.and.nuspec":                 `<licenseUrl>https://licenses.nuget.org/MIT%20AND%20GPL-3.0-only</licenseUrl>`,
+		"/mystery/1.0.0/mystery.nuspec":                           `<licenseUrl>https://example.com/eula</licenseUrl>`,
+		"/system.text.json/6.0.0/system.text.json.nuspec":         `<licenseUrl>https://github.com/dotnet/corefx/blob/master/LICENSE.TXT</licenseUrl>`,
+		"/microsoft.extensions/6.0.0/microsoft.extensions.nuspec": `<license type="expression">MIT</license>`,
+		"/and/1.0.0/and.nuspec":                                   `<license type="expression">MIT AND GPL-3.0-only AND Apache-2.0</license>`,
+		"/nested/1.0.0/nested.nuspec":                             `<license type="expression">(woowoo OR MPL-2.0) AND (MIT OR GPL-2.0-only)</license>`,
+		"/with/1.0.0/with.nuspec":                                 `<license type="expression">(GPL-2.0-only WITH Classpath-exception-2.0)</license>`,
+		"/unknown.and/1.0.0/unknown.and.nuspec":                   `<license type="expression">MIT AND woowoo</license>`,
+		"/malformed/1.0.0/malformed.nuspec":                       `<license type="expression">(MIT OR woowoo</license>`,
+	}
+	cases := []struct {
+		description string
+		in          []byte
+		depsOut     map[string]string
+		warnsOut    []diligent.WarningDetails
+		errOut      bool
+	}{{
+		"should handle packages.lock.json",
+		[]byte(`
+			{
+				"version": 1,
+				"dependencies": {
+					"net6.0": {
+						"Newtonsoft.Json": {"type": "Direct", "requested": "[13.0.1, )", "resolved": "13.0.1"},
+						"Serilog": {"type": "Transitive", "resolved": "2.10.0"},
+						"MyLib": {"type": "Project"}
+					},
+					"net48": {
+						"Newtonsoft.Json": {"type": "Direct", "requested": "[13.0.1, )", "resolved": "13.0.1"},
+						"Serilog": {"type": "Transitive", "resolved": "2.8.0"}
+					}
+				}
+			}
+		`),
+		map[string]string{
+			"Newtonsoft.Json@13.0.1": "MIT",
+			"Serilog@2.10.0":         "Apache-2.0",
+			"Serilog@2.8.0":          "Apache-2.0",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should handle csproj PackageReference items",
+		[]byte("\xEF\xBB\xBF" + `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <TargetFramework>net6.0</TargetFramework>
+  </PropertyGroup>
+  <ItemGroup>
+    <PackageReference Include="Newtonsoft.Json" Version="13.0.1" />
+    <PackageReference Include="Dual">
+      <Version>[1.0.0, 2.0.0)</Version>
+    </PackageReference>
+    <PackageReference Include="Central.Managed" />
+    <ProjectReference Include="..\MyLib\MyLib.csproj" />
+  </ItemGroup>
+</Project>`),
+		map[string]string{
+			"Newtonsoft.Json@13.0.1": "MIT",
+			"Dual@[1.0.0, 2.0.0)":    "BSD-3-Clause",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should handle Directory.Packages.props PackageVersion items",
+		[]byte(`<Project>
+  <ItemGroup>
+    <PackageVersion Include="NuGet.Licensed" Version="1.0.0" />
+    <PackageVersion Include="System.Text.Json" Version="6.0.0" />
+    <PackageVersion Include="Microsoft.Extensions" Version="6.0.0" />
+    <PackageVersion Include="Compound.Or" Version="1.0.0" />
+    <PackageVersion Include="Compound.And" Version="1.0.0" />
+  </ItemGroup>
+</Project>`),
+		map[string]string{
+			"NuGet.Licensed@1.0.0":       "Apache-2.0",
+			"System.Text.Json@6.0.0":     "MIT",
+			"Microsoft.Extensions@6.0.0": "MIT",
+			"Compound.Or@1.0.0":          "MIT",
+			"Compound.And@1.0.0":         "GPL-3.0-only",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should resolve license expressions",
+		[]byte(`<Project>
+  <ItemGroup>
+    <PackageReference Include="And" Version="1.0.0" />
+    <PackageReference Include="Nested" Version="1.0.0" />
+    <PackageReference Include="With" Version="1.0.0" />
+    <PackageReference Include="Unknown.And" Version="1.0.0" />
+    <PackageReference Include="Malformed" Version="1.0.0" />
+  </ItemGroup>
+</Project>`),
+		map[string]string{
+			"And@1.0.0":    "GPL-3.0-only",
+			"Nested@1.0.0": "MPL-2.0",
+			"With@1.0.0":   "GPL-2.0-only WITH Classpath-exception-2.0",
+		},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "nuget", Package: "Malformed", Version: "1.0.0", Err: errors.New("no license information in nuspec")},
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "nuget", Package: "Unknown.And", Version: "1.0.0", Err: errors.New("no license information in nuspec")},
+		},
+		false,
+	}, {
+		"should ignore PackageReference items updating packages referenced elsewhere",
+		[]byte(`<Project>
+  <ItemGroup>
+    <PackageReference Update="Newtonsoft.Json" Version="13.0.1" />
+    <PackageReference Include="Serilog" Version="2.10.0" />
+  </ItemGroup>
+</Project>`),
+		map[string]string{
+			"Serilog@2.10.0": "Apache-2.0",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should warn when licenses cannot be determined",
+		[]byte(`<Project>
+  <ItemGroup>
+    <PackageReference Include="Mystery" Version="1.0.0" />
+    <PackageReference Include="Missing" Version="1.0.0" />
+    <PackageReference Include="Floating" Version="1.*" />
+  </ItemGroup>
+</Project>`),
+		map[string]string{},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "nuget", Package: "Floating", Version: "1.*", Err: errors.New("cannot determine an exact version from '1.*'")},
+			{Kind: diligent.NotFound, Ecosystem: "nuget", Package: "Missing", Version: "1.0.0", Err: &diligent.StatusError{StatusCode: 404}},
+			{Kind: diligent.NoLicenseMetadata, Ecosystem: "nuget", Package: "Mystery", Version: "1.0.0", Err: errors.New("no license information in nuspec")},
+		},
+		false,
+	}, {
+		"parse failure",
+		[]byte(`<<`),
+		map[string]string{},
+		[]diligent.WarningDetails{},
+		true,
+	}}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			ts := httptest.NewServer(nuspecs(t, specs))
+			defer ts.Close()
+			target := nuget.New(ts.URL, nil)
+			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
+			expectedDeps := make([]diligent.Dep, 0, len(tt.depsOut))
+			for depID, lID := range tt.depsOut {
+				l, _ := diligent.GetLicenseFromIdentifier(lID)
+				at := strings.LastIndex(depID, "@")
+				expectedDeps = append(expectedDeps, diligent.Dep{Name: depID[:at], Version: depID[at+1:], License: l})
+			}
+			if len(d) > 0 || len(expectedDeps) > 0 {
+				sortDeps(d)
+				sortDeps(expectedDeps)
+				if reflect.DeepEqual(d, expectedDeps) == false {
+					t.Errorf("deps: got %+v, want %+v", d, expectedDeps)
+				}
+			}
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
+				}
+			}
+			isErr := e != nil
+			if tt.errOut != isErr {
+				t.Errorf("error: got %v, want %v", isErr, tt.errOut)
+			}
+		})
+	}
+}
+
+// sortDeps sorts the dependencies by name and version, as a package may be resolved to several versions
+func sortDeps(dd []diligent.Dep) {
+	sort.Slice(dd, func(i, j int) bool {
+		if dd[i].Name != dd[j].Name {
+			return dd[i].Name < dd[j].Name
+		}
+		return dd[i].Version < dd[j].Version
+	})
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
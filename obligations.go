@@ -0,0 +1,132 @@
+package diligent
+
+// Obligation is a condition which applies when using software under a license
+type Obligation string
+
+const (
+	// IncludeLicense requires the text of the license to be provided along with the software
+	IncludeLicense Obligation = "include-license"
+	// RetainCopyright requires copyright, patent, trademark and attribution notices to be kept
+	RetainCopyright Obligation = "retain-copyright"
+	// StateChanges requires modified files to carry a notice stating that they were changed
+	StateChanges Obligation = "state-changes"
+	// DiscloseSource requires the source code to be made available when the software is distributed
+	DiscloseSource Obligation = "disclose-source"
+	// SameLicense requires modifications, and for some licenses the larger work, to be released under the same license
+	SameLicense Obligation = "same-license"
+	// NetworkUse treats users interacting with the software over a network as receiving a distribution of it
+	NetworkUse Obligation = "network-use"
+	// PatentGrant grants a license to the patents of the contributors which cover their contributions
+	PatentGrant Obligation = "patent-grant"
+	// PatentRetaliation terminates the license of anyone bringing patent litigation concerning the software
+	PatentRetaliation Obligation = "patent-retaliation"
+)
+
+var obligationKinds = []Obligation{IncludeLicense, RetainCopyright, StateChanges, DiscloseSource, SameLicense, NetworkUse, PatentGrant, PatentRetaliation}
+
+var obligationDescriptions = map[Obligation]string{
+	IncludeLicense:    "Include the full text of the license with the software",
+	RetainCopyright:   "Retain the copyright and attribution notices of the software",
+	StateChanges:      "Mark files which have been modified with a notice stating what was changed",
+	DiscloseSource:    "Make the source code available when distributing the software",
+	SameLicense:       "Release modifications under the same license as the software",
+	NetworkUse:        "Offer the source code to users interacting with the software over a network",
+	PatentGrant:       "The contributors grant a license to their patents covering the software",
+	PatentRetaliation: "The license terminates for anyone bringing patent litigation concerning the software",
+}
+
+// Obligations returns all the obligations, in the order in which they are reported
+func Obligations() []Obligation {
+	return append([]Obligation(nil), obligationKinds...)
+}
+
+// Description explains what the obligation requires of users of the software
+func (o Obligation) Description() string {
+	return obligationDescriptions[o]
+}
+
+// with returns a copy of the obligations along with further obligations
+func with(base []Obligation, oo ...Obligation) []Obligation {
+	return append(append([]Obligation(nil), base...), oo...)
+}
+
+var (
+	attribution    = []Obligation{IncludeLicense, RetainCopyright}
+	weakCopyleft   = []Obligation{IncludeLicense, RetainCopyright, DiscloseSource, SameLicense}
+	strongCopyleft = []Obligation{IncludeLicense, RetainCopyright, StateChanges, DiscloseSource, SameLicense}
+	gpl3           = with(strongCopyleft, PatentGrant, PatentRetaliation)
+	patents        = []Obligation{PatentGrant, PatentRetaliation}
+)
+
+// categoryObligations are the obligations of the licenses within a category, unless listed in licenseObligations
+var categoryObligations = map[Category][]Obligation{
+	Permissive:      attribution,
+	CopyLeft:        strongCopyleft,
+	CopyLeftLimited: weakCopyleft,
+	FreeRestricted:  attribution,
+	ProprietaryFree: attribution,
+	PublicDomain:    {},
+}
+
+// licenseObligations are the obligations of licenses which differ from those of their category
+var licenseObligations = map[string][]Obligation{
+	"0BSD":              {},
+	"Apache-2.0":        with([]Obligation{IncludeLicense, RetainCopyright, StateChanges}, patents...),
+	"MS-PL":             with(attribution, patents...),
+	"MS-RL":             with(weakCopyleft, patents...),
+	"GPL-2.0-only":      strongCopyleft,
+	"GPL-2.0-or-later":  strongCopyleft,
+	"GPL-3.0-only":      gpl3,
+	"GPL-3.0-or-later":  gpl3,
+	"AGPL-1.0-only":     with(strongCopyleft, NetworkUse),
+	"AGPL-1.0-or-later": with(strongCopyleft, NetworkUse),
+	"AGPL-3.0-only":     with(gpl3, NetworkUse),
+	"AGPL-3.0-or-later": with(gpl3, NetworkUse),
+	"LGPL-2.0-only":     with(weakCopyleft, StateChanges),
+	"LGPL-2.0-or-later": with(weakCopyleft, StateChanges),
+	"LGPL-2.1-only":     with(weakCopyleft, StateChanges),
+	"LGPL-2.1-or-later": with(weakCopyleft, StateChanges),
+	"LGPL-3.0-only":     with(weakCopyleft, StateChanges, PatentGrant, PatentRetaliation),
+	"LGPL-3.0-or-later": with(weakCopyleft, StateChanges, PatentGrant, PatentRetaliation),
+	"MPL-1.1":           with(weakCopyleft, StateChanges, PatentGrant, PatentRetaliation),
+	"MPL-2.0":           with(weakCopyleft, patents...),
+	"EPL-1.0":           with(weakCopyleft, patents...),
+	"EPL-2.0":           with(weakCopyleft, patents...),
+	"CDDL-1.0":          with(weakCopyleft, patents...),
+	"CDDL-1.1":          with(weakCopyleft, patents...),
+	"EUPL-1.1":          with(weakCopyleft, StateChanges, NetworkUse, PatentGrant),
+	"EUPL-1.2":          with(weakCopyleft, StateChanges, NetworkUse, PatentGrant),
+	"OSL-3.0":           with(strongCopyleft, NetworkUse, PatentGrant, PatentRetaliation),
+	"RPL-1.5":           with(strongCopyleft, NetworkUse, PatentGrant, PatentRetaliation),
+	"CPAL-1.0":          with(strongCopyleft, NetworkUse, PatentGrant, PatentRetaliation),
+}
+
+// GetLicenseObligations returns the obligations of a license, ordered as per Obligations. The obligations of licenses
+// with an exception are those of the license alone. False is returned if the obligations of the license are not
+// known, as the license is uncategorised or belongs to an organisation defined category
+func GetLicenseObligations(l License) ([]Obligation, bool) {
+	id, _ := SplitException(l.Identifier)
+	if base, ok := lookup[id]; ok {
+		l = base
+	}
+	if l.Deprecated && l.ReplacedBy != "" {
+		id = l.ReplacedBy
+	}
+	oo, ok := licenseObligations[id]
+	if !ok {
+		oo, ok = categoryObligations[l.Category]
+	}
+	if !ok {
+		return nil, false
+	}
+	out := make([]Obligation, 0, len(oo))
+	for _, kind := range obligationKinds {
+		for _, o := range oo {
+			if o == kind {
+				out = append(out, o)
+				break
+			}
+		}
+	}
+	return out, true
+}
@@ -0,0 +1,93 @@
+// Package obligations summarises the obligations triggered by the licenses of dependencies, producing a checklist
+// for those releasing the software
+package obligations
+
+import (
+	"sort"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/compat"
+)
+
+// Item is an obligation along with the licenses and dependencies triggering it
+type Item struct {
+	Obligation diligent.Obligation
+	Licenses   []string
+	Deps       []diligent.Dep
+}
+
+// Summary lists the obligations triggered by a set of dependencies
+type Summary struct {
+	Items []Item
+	// Unknown are the dependencies whose license obligations are not known to diligent, so must be reviewed by hand
+	Unknown []diligent.Dep
+}
+
+// patentTerms apply to the use of the software, so are triggered whether or not it is distributed
+var patentTerms = map[diligent.Obligation]bool{
+	diligent.PatentGrant:       true,
+	diligent.PatentRetaliation: true,
+}
+
+// triggered returns true if the obligation of a license applies given the distribution model. Software which is not
+// distributed only triggers patent terms, unless offered as a service under a license with a network use clause
+func triggered(o diligent.Obligation, all []diligent.Obligation, distribution compat.Distribution) bool {
+	switch distribution {
+	case compat.Internal:
+		return patentTerms[o]
+	case compat.SaaS:
+		for _, other := range all {
+			if other == diligent.NetworkUse {
+				return true
+			}
+		}
+		return patentTerms[o]
+	}
+	return true
+}
+
+// New summarises the obligations of the dependencies given the distribution model of the project. When no
+// distribution model is provided the project is assumed to be distributed
+func New(deps []diligent.Dep, distribution compat.Distribution) Summary {
+	type item struct {
+		licenses map[string]bool
+		deps     []diligent.Dep
+	}
+	byObligation := map[diligent.Obligation]*item{}
+	var s Summary
+	for _, d := range deps {
+		oo, ok := diligent.GetLicenseObligations(d.License)
+		if !ok {
+			s.Unknown = append(s.Unknown, d)
+			continue
+		}
+		for _, o := range oo {
+			if !triggered(o, oo, distribution) {
+				continue
+			}
+			i, ok := byObligation[o]
+			if !ok {
+				i = &item{licenses: map[string]bool{}}
+				byObligation[o] = i
+			}
+			i.licenses[d.License.Identifier] = true
+			i.deps = append(i.deps, d)
+		}
+	}
+
+	for _, o := range diligent.Obligations() {
+		i, ok := byObligation[o]
+		if !ok {
+			continue
+		}
+		licenses := make([]string, 0, len(i.licenses))
+		for l := range i.licenses {
+			licenses = append(licenses, l)
+		}
+		sort.Strings(licenses)
+		sort.SliceStable(i.deps, func(a, b int) bool { return i.deps[a].Name < i.deps[b].Name })
+		s.Items = append(s.Items, Item{Obligation: o, Licenses: licenses, Deps: i.deps})
+	}
+	sort.SliceStable(s.Unknown, func(a, b int) bool { return s.Unknown[a].Name < s.Unknown[b].Name })
+	return s
+}
@@ -0,0 +1,95 @@
+package obligations_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/compat"
+	"github.com/senseyeio/diligent/obligations"
+)
+
+func deps(t *testing.T) []diligent.Dep {
+	var out []diligent.Dep
+	for name, id := range map[string]string{"a": "MIT", "b": "AGPL-3.0-only", "c": "Apache-2.0", "d": "CERN-OHL-1.1"} {
+		l, err := diligent.GetLicenseFromIdentifier(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, diligent.Dep{Name: name, License: l})
+	}
+	return out
+}
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		d            string
+		distribution compat.Distribution
+		exp          map[diligent.Obligation][]string
+	}{
+		{"distributed", compat.Binary, map[diligent.Obligation][]string{
+			diligent.IncludeLicense:    {"a", "b", "c"},
+			diligent.RetainCopyright:   {"a", "b", "c"},
+			diligent.StateChanges:      {"b", "c"},
+			diligent.DiscloseSource:    {"b"},
+			diligent.SameLicense:       {"b"},
+			diligent.NetworkUse:        {"b"},
+			diligent.PatentGrant:       {"b", "c"},
+			diligent.PatentRetaliation: {"b", "c"},
+		}},
+		{"saas", compat.SaaS, map[diligent.Obligation][]string{
+			diligent.IncludeLicense:    {"b"},
+			diligent.RetainCopyright:   {"b"},
+			diligent.StateChanges:      {"b"},
+			diligent.DiscloseSource:    {"b"},
+			diligent.SameLicense:       {"b"},
+			diligent.NetworkUse:        {"b"},
+			diligent.PatentGrant:       {"b", "c"},
+			diligent.PatentRetaliation: {"b", "c"},
+		}},
+		{"internal", compat.Internal, map[diligent.Obligation][]string{
+			diligent.PatentGrant:       {"b", "c"},
+			diligent.PatentRetaliation: {"b", "c"},
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			s := obligations.New(deps(t), c.distribution)
+			out := map[diligent.Obligation][]string{}
+			for _, i := range s.Items {
+				for _, d := range i.Deps {
+					out[i.Obligation] = append(out[i.Obligation], d.Name)
+				}
+			}
+			if !reflect.DeepEqual(out, c.exp) {
+				t.Errorf("expected %v, got %v", c.exp, out)
+			}
+			if len(s.Unknown) != 1 || s.Unknown[0].Name != "d" {
+				t.Errorf("expected d to have unknown obligations, got %v", s.Unknown)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	s := obligations.New(deps(t), compat.Binary)
+	for _, f := range obligations.Formats {
+		t.Run(string(f), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := s.Write(&buf, f); err != nil {
+				t.Fatal(err)
+			}
+			out := buf.String()
+			for _, exp := range []string{string(diligent.NetworkUse), diligent.NetworkUse.Description(), "AGPL-3.0-only", "CERN-OHL-1.1"} {
+				if !strings.Contains(out, exp) {
+					t.Errorf("expected output to contain '%s', got %s", exp, out)
+				}
+			}
+		})
+	}
+	if _, err := obligations.ParseFormat("woowoo"); err == nil {
+		t.Error("expected unknown format to fail")
+	}
+}
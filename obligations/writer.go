@@ -0,0 +1,135 @@
+package obligations
+
+import (
+	encJSON "encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/senseyeio/diligent"
+)
+
+// Format is the format in which a Summary is written
+type Format string
+
+const (
+	Text     Format = "text"
+	Markdown Format = "markdown"
+	JSON     Format = "json"
+)
+
+// Formats are the supported formats
+var Formats = []Format{Text, Markdown, JSON}
+
+// ParseFormat returns the Format with the given name
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown obligations format '%s', expected one of text, markdown or json", s)
+}
+
+// depName returns the name of a dependency along with its version, if known
+func depName(d diligent.Dep) string {
+	if d.Version == "" {
+		return d.Name
+	}
+	return d.Name + " " + d.Version
+}
+
+var funcs = template.FuncMap{
+	"dep":  depName,
+	"join": strings.Join,
+}
+
+const textLayout = `LICENSE OBLIGATIONS
+{{if not .Items}}
+No obligations are triggered by the dependencies.
+{{end}}
+{{- range .Items}}
+[ ] {{.Obligation.Description}} ({{.Obligation}})
+    Licenses: {{join .Licenses ", "}}
+{{- range .Deps}}
+    - {{dep .}}
+{{- end}}
+{{end}}
+{{- if .Unknown}}
+[ ] Review the obligations of the following dependencies, which are not known to diligent
+{{- range .Unknown}}
+    - {{dep .}} ({{.License.Identifier}})
+{{- end}}
+{{end}}`
+
+const markdownLayout = `# License obligations
+{{if not .Items}}
+No obligations are triggered by the dependencies.
+{{end}}
+{{- range .Items}}
+- [ ] {{.Obligation.Description}} (` + "`{{.Obligation}}`" + `)
+  - Licenses: {{join .Licenses ", "}}
+{{- range .Deps}}
+  - {{dep .}}
+{{- end}}
+{{- end}}
+{{- if .Unknown}}
+- [ ] Review the obligations of the following dependencies, which are not known to diligent
+{{- range .Unknown}}
+  - {{dep .}} ({{.License.Identifier}})
+{{- end}}
+{{- end}}
+`
+
+type jsonDep struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	License string `json:"license"`
+}
+
+type jsonItem struct {
+	Obligation  diligent.Obligation `json:"obligation"`
+	Description string              `json:"description"`
+	Licenses    []string            `json:"licenses"`
+	Deps        []jsonDep           `json:"dependencies"`
+}
+
+type jsonSummary struct {
+	Obligations []jsonItem `json:"obligations"`
+	Unknown     []jsonDep  `json:"unknown,omitempty"`
+}
+
+func toJSONDeps(deps []diligent.Dep) []jsonDep {
+	out := make([]jsonDep, 0, len(deps))
+	for _, d := range deps {
+		out = append(out, jsonDep{d.Name, d.Version, d.License.Identifier})
+	}
+	return out
+}
+
+func (s Summary) writeJSON(w io.Writer) error {
+	out := jsonSummary{Obligations: make([]jsonItem, 0, len(s.Items))}
+	for _, i := range s.Items {
+		out.Obligations = append(out.Obligations, jsonItem{i.Obligation, i.Obligation.Description(), i.Licenses, toJSONDeps(i.Deps)})
+	}
+	if len(s.Unknown) > 0 {
+		out.Unknown = toJSONDeps(s.Unknown)
+	}
+	enc := encJSON.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// Write outputs the summary as a checklist in the given format
+func (s Summary) Write(w io.Writer, format Format) error {
+	switch format {
+	case Text:
+		return template.Must(template.New("text").Funcs(funcs).Parse(textLayout)).Execute(w, s)
+	case Markdown:
+		return template.Must(template.New("markdown").Funcs(funcs).Parse(markdownLayout)).Execute(w, s)
+	case JSON:
+		return s.writeJSON(w)
+	}
+	return fmt.Errorf("unknown obligations format '%s'", format)
+}
@@ -0,0 +1,45 @@
+package diligent_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestGetLicenseObligations(t *testing.T) {
+	cases := []struct {
+		d     string
+		in    string
+		exp   []diligent.Obligation
+		known bool
+	}{
+		{"category obligations", "MIT", []diligent.Obligation{diligent.IncludeLicense, diligent.RetainCopyright}, true},
+		{"public domain", "CC0-1.0", []diligent.Obligation{}, true},
+		{"license obligations", "Apache-2.0", []diligent.Obligation{diligent.IncludeLicense, diligent.RetainCopyright, diligent.StateChanges, diligent.PatentGrant, diligent.PatentRetaliation}, true},
+		{"network use", "AGPL-3.0-only", []diligent.Obligation{diligent.IncludeLicense, diligent.RetainCopyright, diligent.StateChanges, diligent.DiscloseSource, diligent.SameLicense, diligent.NetworkUse, diligent.PatentGrant, diligent.PatentRetaliation}, true},
+		{"deprecated identifier", "GPL-2.0", []diligent.Obligation{diligent.IncludeLicense, diligent.RetainCopyright, diligent.StateChanges, diligent.DiscloseSource, diligent.SameLicense}, true},
+		{"license with exception", "GPL-2.0-only WITH Classpath-exception-2.0", []diligent.Obligation{diligent.IncludeLicense, diligent.RetainCopyright, diligent.StateChanges, diligent.DiscloseSource, diligent.SameLicense}, true},
+		{"uncategorised", "CERN-OHL-1.1", nil, false},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			l, err := diligent.GetLicenseFromIdentifier(c.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, known := diligent.GetLicenseObligations(l)
+			if known != c.known || !reflect.DeepEqual(out, c.exp) {
+				t.Errorf("expected %v (%t), got %v (%t)", c.exp, c.known, out, known)
+			}
+		})
+	}
+}
+
+func TestObligationDescriptions(t *testing.T) {
+	for _, o := range diligent.Obligations() {
+		if o.Description() == "" {
+			t.Errorf("expected %s to have a description", o)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package diligent
+
+import "fmt"
+
+// Override declares the license of a package, for use when its license cannot be determined automatically.
+// Versions optionally restricts the override to a version range, see VersionInRange. Ecosystem optionally restricts
+// the override to the Deper with the given name, such as "npm"
+type Override struct {
+	Name      string
+	Versions  string
+	Ecosystem string
+	License   License
+}
+
+// NewOverride returns an Override, validating the license identifier and version range
+func NewOverride(name, versions, ecosystem, licenseIdentifier string) (Override, error) {
+	if name == "" {
+		return Override{}, fmt.Errorf("overrides must define a package name")
+	}
+	l, err := GetLicenseFromIdentifier(licenseIdentifier)
+	if err != nil {
+		return Override{}, fmt.Errorf("override for '%s' has license '%s' which is not a known license identifier", name, licenseIdentifier)
+	}
+	if versions != "" {
+		if err := ValidateVersionRange(versions); err != nil {
+			return Override{}, fmt.Errorf("override for '%s' is invalid: %v", name, err)
+		}
+	}
+	return Override{name, versions, ecosystem, l}, nil
+}
+
+// Overrides is a set of declared licenses, which Depers use in preference to determining licenses themselves
+type Overrides []Override
+
+// Dep returns a dependency with the declared license if an override applies to the package.
+// When true is returned, Depers should use the dependency rather than looking up the license
+func (oo Overrides) Dep(ecosystem, name, version string) (Dep, bool) {
+	for _, o := range oo {
+		if o.Name != name || (o.Ecosystem != "" && o.Ecosystem != ecosystem) {
+			continue
+		}
+		if o.Versions != "" && !VersionInRange(version, o.Versions) {
+			continue
+		}
+		var t Trace
+		t.Record("config override", "overrides", o.License, nil)
+		return Dep{Name: name, Version: version, License: o.License, Trace: t, Declared: true}, true
+	}
+	return Dep{}, false
+}
@@ -0,0 +1,86 @@
+package diligent_test
+
+import (
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestVersionInRange(t *testing.T) {
+	cases := []struct {
+		version, r string
+		expected   bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"v1.2.3", "1.2.3", true},
+		{"1.2.4", "1.2.3", false},
+		{"1.5.0", ">=1.0.0 <2.0.0", true},
+		{"1.5.0", ">=1.0.0, <2.0.0", true},
+		{"2.0.0", ">=1.0.0 <2.0.0", false},
+		{"2.0.0-beta", "<2.0.0", true},
+		{"1.10.0", ">1.9", true},
+		{"^1.2.0", ">=1.2.0 <2", true},
+		{"[1.0.0, 2.0.0)", "=1.0.0", true},
+		{"master", ">=1.0.0", false},
+		{"master", "*", true},
+	}
+	for _, c := range cases {
+		t.Run(c.version+" "+c.r, func(t *testing.T) {
+			if got := diligent.VersionInRange(c.version, c.r); got != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewOverride(t *testing.T) {
+	cases := []struct {
+		d                                  string
+		name, versions, ecosystem, license string
+		expFailure                         bool
+	}{
+		{"complete override", "a", ">=1.0.0", "npm", "MIT", false},
+		{"name and license only", "a", "", "", "MIT", false},
+		{"missing name", "", "", "", "MIT", true},
+		{"unknown license", "a", "", "", "woowoo", true},
+		{"invalid range", "a", ">=one", "", "MIT", true},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			_, err := diligent.NewOverride(c.name, c.versions, c.ecosystem, c.license)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+		})
+	}
+}
+
+func TestOverridesDep(t *testing.T) {
+	byName, _ := diligent.NewOverride("a", "", "", "MIT")
+	npmOnly, _ := diligent.NewOverride("b", "", "npm", "MIT")
+	ranged, _ := diligent.NewOverride("c", "<2.0.0", "", "MIT")
+	oo := diligent.Overrides{byName, npmOnly, ranged}
+	cases := []struct {
+		d                        string
+		ecosystem, name, version string
+		expected                 bool
+	}{
+		{"name match", "gomod", "a", "v1.0.0", true},
+		{"no match", "gomod", "z", "v1.0.0", false},
+		{"ecosystem match", "npm", "b", "1.0.0", true},
+		{"ecosystem mismatch", "composer", "b", "1.0.0", false},
+		{"version in range", "npm", "c", "1.0.0", true},
+		{"version out of range", "npm", "c", "2.0.0", false},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			d, ok := oo.Dep(c.ecosystem, c.name, c.version)
+			if ok != c.expected {
+				t.Fatalf("expected %t, got %t", c.expected, ok)
+			}
+			if ok && (!d.Declared || d.Name != c.name || d.Version != c.version || d.License.Identifier != "MIT") {
+				t.Errorf("unexpected dependency %+v", d)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+// Exception permits a dependency which would otherwise be denied, not allowed or require review.
+// Version and License are optional and narrow the exception when set
+type Exception struct {
+	Package  string
+	Version  string
+	License  string
+	Reason   string
+	Approver string
+	// Expires is the last day on which the exception applies
+	Expires time.Time
+}
+
+func (e Exception) String() string {
+	s := e.Package
+	if e.Version != "" {
+		s += "@" + e.Version
+	}
+	if e.License != "" {
+		s += " (" + e.License + ")"
+	}
+	return s
+}
+
+func (e Exception) matches(d diligent.Dep) bool {
+	return e.Package == d.Name &&
+		(e.Version == "" || e.Version == d.Version) &&
+		(e.License == "" || e.License == d.License.Identifier)
+}
+
+func (e Exception) expired(now time.Time) bool {
+	return now.After(e.Expires.AddDate(0, 0, 1))
+}
+
+// AddExceptions validates and adds exceptions to the policy. Each exception requires a package, reason, approver
+// and expiry date, and any license must be a known license identifier
+func (p *Policy) AddExceptions(ee []Exception) error {
+	for _, e := range ee {
+		if e.Package == "" {
+			return errors.New("exceptions must define a package")
+		}
+		if e.Reason == "" || e.Approver == "" || e.Expires.IsZero() {
+			return fmt.Errorf("exception for '%s' must define a reason, approver and expiry date", e.Package)
+		}
+		if e.License != "" {
+			l, err := diligent.GetLicenseFromIdentifier(e.License)
+			if err != nil {
+				return fmt.Errorf("exception license '%s' is not a known license identifier", e.License)
+			}
+			e.License = l.Identifier
+		}
+		p.exceptions = append(p.exceptions, e)
+	}
+	return nil
+}
+
+// isExcepted returns true if an unexpired exception covers the dependency
+func (p *Policy) isExcepted(d diligent.Dep) bool {
+	for _, e := range p.exceptions {
+		if e.matches(d) && !e.expired(p.now()) {
+			return true
+		}
+	}
+	return false
+}
+
+// StaleExceptions returns messages describing the exceptions which have expired or which did not match any of the
+// dependencies. Dependencies should have been checked against the policy
+func (p *Policy) StaleExceptions(deps []diligent.Dep) []string {
+	msgs := make([]string, 0)
+	for _, e := range p.exceptions {
+		if e.expired(p.now()) {
+			msgs = append(msgs, fmt.Sprintf("exception for '%s' approved by %s expired on %s", e, e.Approver, e.Expires.Format(DateFormat)))
+			continue
+		}
+		used := false
+		for _, d := range deps {
+			if d.Status == diligent.Excepted && e.matches(d) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			msgs = append(msgs, fmt.Sprintf("exception for '%s' did not apply to any dependency and can be removed", e))
+		}
+	}
+	return msgs
+}
@@ -0,0 +1,82 @@
+package policy_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+)
+
+var (
+	past   = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	future = time.Date(2999, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+func TestAddExceptions(t *testing.T) {
+	cases := []struct {
+		d          string
+		exception  policy.Exception
+		expFailure bool
+	}{
+		{"complete exception", policy.Exception{Package: "a", License: "GPL-3.0", Reason: "r", Approver: "legal", Expires: future}, false},
+		{"missing package", policy.Exception{Reason: "r", Approver: "legal", Expires: future}, true},
+		{"missing reason", policy.Exception{Package: "a", Approver: "legal", Expires: future}, true},
+		{"missing approver", policy.Exception{Package: "a", Reason: "r", Expires: future}, true},
+		{"missing expiry", policy.Exception{Package: "a", Reason: "r", Approver: "legal"}, true},
+		{"unknown license", policy.Exception{Package: "a", License: "woowoo", Reason: "r", Approver: "legal", Expires: future}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			p, _ := policy.New(nil, nil, nil)
+			err := p.AddExceptions([]policy.Exception{c.exception})
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+		})
+	}
+}
+
+func TestExceptions(t *testing.T) {
+	gpl, _ := diligent.GetLicenseFromIdentifier("GPL-3.0")
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	cases := []struct {
+		d         string
+		exception policy.Exception
+		dep       diligent.Dep
+		status    diligent.Status
+		stale     int
+	}{
+		{"package match", policy.Exception{Package: "a"}, diligent.Dep{Name: "a", License: gpl}, diligent.Excepted, 0},
+		{"version match", policy.Exception{Package: "a", Version: "1.0.0"}, diligent.Dep{Name: "a", Version: "1.0.0", License: gpl}, diligent.Excepted, 0},
+		{"license match", policy.Exception{Package: "a", License: "GPL-3.0"}, diligent.Dep{Name: "a", License: gpl}, diligent.Excepted, 0},
+		{"different package", policy.Exception{Package: "b"}, diligent.Dep{Name: "a", License: gpl}, diligent.Denied, 1},
+		{"different version", policy.Exception{Package: "a", Version: "1.0.0"}, diligent.Dep{Name: "a", Version: "2.0.0", License: gpl}, diligent.Denied, 1},
+		{"different license", policy.Exception{Package: "a", License: "AGPL-3.0"}, diligent.Dep{Name: "a", License: gpl}, diligent.Denied, 1},
+		{"allowed dependency", policy.Exception{Package: "a"}, diligent.Dep{Name: "a", License: mit}, diligent.Allowed, 1},
+		{"expired", policy.Exception{Package: "a", Expires: past}, diligent.Dep{Name: "a", License: gpl}, diligent.Denied, 1},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			p, err := policy.New([]string{"MIT"}, nil, []string{"GPL-3.0"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := c.exception
+			e.Reason, e.Approver = "r", "legal"
+			if e.Expires.IsZero() {
+				e.Expires = future
+			}
+			if err := p.AddExceptions([]policy.Exception{e}); err != nil {
+				t.Fatal(err)
+			}
+			out := p.Check([]diligent.Dep{c.dep})
+			if out[0].Status != c.status {
+				t.Errorf("expected %s, got %s", c.status, out[0].Status)
+			}
+			if stale := p.StaleExceptions(out); len(stale) != c.stale {
+				t.Errorf("expected %d stale exceptions, got %v", c.stale, stale)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package policy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/senseyeio/diligent"
+)
+
+// tier holds the licenses assigned to a status, separating those listed explicitly from those included by category
+type tier struct {
+	status     diligent.Status
+	explicit   map[string]bool
+	byCategory map[string]bool
+}
+
+func newTier(status diligent.Status, label string, entries []string) (tier, error) {
+	t := tier{status, map[string]bool{}, map[string]bool{}}
+	for _, e := range entries {
+		if diligent.IsCategory(e) {
+			for _, id := range diligent.ReplaceCategoriesWithIdentifiers([]string{e}) {
+				for _, eq := range diligent.EquivalentIdentifiers(id) {
+					t.byCategory[eq] = true
+				}
+			}
+			continue
+		}
+		l, err := diligent.GetLicenseFromIdentifier(e)
+		if err != nil {
+			return t, fmt.Errorf("%s license '%s' is not a known license identifier", label, e)
+		}
+		for _, id := range diligent.EquivalentIdentifiers(l.Identifier) {
+			t.explicit[id] = true
+		}
+	}
+	return t, nil
+}
+
+// Policy assigns licenses to the allowed, review or denied tiers.
+// Licenses listed explicitly take precedence over those included by category, so it is possible to allow a category
+// whilst denying one of its licenses. Where a license appears in multiple tiers at the same level, denied takes
+// precedence over review, which takes precedence over allowed
+type Policy struct {
+	tiers      []tier
+	exceptions []Exception
+	now        func() time.Time
+}
+
+// DateFormat is the layout of the dates used by the policy, such as exception expiry dates
+const DateFormat = "2006-01-02"
+
+// New returns a Policy. Each tier accepts license identifiers and category names.
+// An error is returned if an entry is neither a known license identifier nor a category
+func New(allow, review, deny []string) (*Policy, error) {
+	p := &Policy{now: time.Now}
+	for _, t := range []struct {
+		status  diligent.Status
+		label   string
+		entries []string
+	}{{diligent.Denied, "denied", deny}, {diligent.Review, "review", review}, {diligent.Allowed, "whitelisted", allow}} {
+		nt, err := newTier(t.status, t.label, t.entries)
+		if err != nil {
+			return nil, err
+		}
+		p.tiers = append(p.tiers, nt)
+	}
+	return p, nil
+}
+
+// Status returns the tier a license belongs to, or NotAllowed if it is not covered by the policy.
+// Deprecated and current identifiers of the same license are treated alike. A license with an exception, such as
+// 'GPL-2.0-only WITH Classpath-exception-2.0', is treated as the license alone unless the expression is listed
+func (p *Policy) Status(l diligent.License) diligent.Status {
+	ids := []string{l.Identifier}
+	if license, exception := diligent.SplitException(l.Identifier); exception != "" {
+		ids = append(ids, license)
+	}
+	for _, id := range ids {
+		for _, t := range p.tiers {
+			if t.explicit[id] {
+				return t.status
+			}
+		}
+	}
+	for _, id := range ids {
+		for _, t := range p.tiers {
+			if t.byCategory[id] {
+				return t.status
+			}
+		}
+	}
+	return diligent.NotAllowed
+}
+
+// Check returns the dependencies with their Status set according to the policy.
+// Dependencies which are not allowed but are covered by an unexpired exception are marked as Excepted
+func (p *Policy) Check(deps []diligent.Dep) []diligent.Dep {
+	out := make([]diligent.Dep, len(deps))
+	for i, d := range deps {
+		d.Status = p.Status(d.License)
+		if d.Status != diligent.Allowed && p.isExcepted(d) {
+			d.Status = diligent.Excepted
+		}
+		out[i] = d
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		d                   string
+		allow, review, deny []string
+		expFailure          bool
+	}{
+		{"identifiers and categories", []string{"MIT", "permissive"}, []string{"copyleft-limited"}, []string{"GPL-3.0"}, false},
+		{"empty policy", nil, nil, nil, false},
+		{"unknown allowed identifier", []string{"woowoo"}, nil, nil, true},
+		{"unknown review identifier", nil, []string{"woowoo"}, nil, true},
+		{"unknown denied identifier", nil, nil, []string{"woowoo"}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			_, err := policy.New(c.allow, c.review, c.deny)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	cases := []struct {
+		d                   string
+		allow, review, deny []string
+		license             string
+		status              diligent.Status
+	}{
+		{"allowed identifier", []string{"MIT"}, nil, nil, "MIT", diligent.Allowed},
+		{"allowed category", []string{"permissive"}, nil, nil, "MIT", diligent.Allowed},
+		{"not covered", []string{"MIT"}, nil, nil, "GPL-3.0", diligent.NotAllowed},
+		{"review category", []string{"permissive"}, []string{"copyleft-limited"}, nil, "MPL-2.0", diligent.Review},
+		{"explicit deny wins over category allow", []string{"copyleft-limited"}, nil, []string{"LGPL-2.0"}, "LGPL-2.0", diligent.Denied},
+		{"category allow applies to other licenses", []string{"copyleft-limited"}, nil, []string{"LGPL-2.0"}, "LGPL-2.1", diligent.Allowed},
+		{"explicit allow wins over category deny", []string{"LGPL-2.1"}, nil, []string{"copyleft-limited"}, "LGPL-2.1", diligent.Allowed},
+		{"deny wins over allow at the same level", []string{"MIT"}, nil, []string{"MIT"}, "MIT", diligent.Denied},
+		{"deny wins over review at the same level", nil, []string{"copyleft"}, []string{"copyleft"}, "GPL-3.0", diligent.Denied},
+		{"review wins over allow at the same level", []string{"all"}, []string{"copyleft"}, nil, "GPL-3.0", diligent.Review},
+		{"deprecated identifier covers current identifier", []string{"GPL-2.0"}, nil, nil, "GPL-2.0-only", diligent.Allowed},
+		{"current identifier covers deprecated identifier", nil, nil, []string{"LGPL-2.1-or-later"}, "LGPL-2.1+", diligent.Denied},
+		{"license with exception treated as license", []string{"copyleft"}, nil, nil, "GPL-2.0-only WITH Classpath-exception-2.0", diligent.Allowed},
+		{"explicit exception expression wins", []string{"GPL-2.0-only WITH Classpath-exception-2.0"}, nil, []string{"GPL-2.0-only"}, "GPL-2.0-only WITH Classpath-exception-2.0", diligent.Allowed},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			p, err := policy.New(c.allow, c.review, c.deny)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l, _ := diligent.GetLicenseFromIdentifier(c.license)
+			if status := p.Status(l); status != c.status {
+				t.Errorf("expected %s, got %s", c.status, status)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	p, err := policy.New([]string{"MIT"}, nil, []string{"GPL-3.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	gpl, _ := diligent.GetLicenseFromIdentifier("GPL-3.0")
+	out := p.Check([]diligent.Dep{{Name: "a", License: mit}, {Name: "b", License: gpl}})
+	if out[0].Status != diligent.Allowed || out[1].Status != diligent.Denied {
+		t.Errorf("unexpected statuses %+v", out)
+	}
+}
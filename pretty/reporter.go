@@ -35,17 +35,107 @@ func writeStrings(w io.Writer, strings ...string) error {
 	return nil
 }
 
-// Report outputs the dependencies and their licenses in tabulated form to stdout
+// writeDep outputs the dependency, noting where its license was declared rather than determined and any scope other
+// than production
+func writeDep(w io.Writer, d diligent.Dep) error {
+	strs := []string{d.Name, tab, d.License.Name}
+	if d.Declared {
+		strs = append(strs, " (declared)")
+	}
+	if scope := d.EffectiveScope(); scope != diligent.Production {
+		strs = append(strs, " (", string(scope), ")")
+	}
+	return writeStrings(w, append(strs, newline)...)
+}
+
+func writeSection(w io.Writer, title string, deps []diligent.Dep) error {
+	if len(deps) == 0 {
+		return nil
+	}
+	if err := writeStrings(w, newline, title, newline); err != nil {
+		return err
+	}
+	for _, d := range deps {
+		err := writeDep(w, d)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeWarnings lists the packages whose licenses could not be determined along with the ecosystem which found them,
+// any scope other than production, the kind of warning and the reason
+func writeWarnings(w io.Writer, warnings []diligent.WarningDetails) error {
+	if len(warnings) == 0 {
+		return nil
+	}
+	if err := writeStrings(w, newline, "Unresolved:", newline); err != nil {
+		return err
+	}
+	for _, wd := range warnings {
+		name := wd.Package
+		if name == "" {
+			name = "unknown package"
+		}
+		if wd.Version != "" {
+			name += " " + wd.Version
+		}
+		if wd.Ecosystem != "" {
+			name += " (" + wd.Ecosystem + ")"
+		}
+		if scope := wd.EffectiveScope(); scope != diligent.Production {
+			name += " (" + string(scope) + ")"
+		}
+		reason := wd.Err.Error()
+		if wd.Kind != "" {
+			reason = string(wd.Kind) + ": " + reason
+		}
+		if err := writeStrings(w, name, tab, reason, newline); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Report outputs the dependencies and their licenses in tabulated form to stdout.
+// Dependencies which require review or are covered by an approved exception are listed within separate sections
 func (c *pretty) Report(w io.Writer, deps []diligent.Dep) error {
+	return c.ReportWithWarnings(w, deps, nil)
+}
+
+// ReportWithWarnings is identical to Report but also lists the packages whose licenses could not be determined within
+// a separate section
+func (c *pretty) ReportWithWarnings(w io.Writer, deps []diligent.Dep, warnings []diligent.WarningDetails) error {
 	writer := tabwriter.NewWriter(w, minColWidth, tabWidth, padding, padChar, flags)
 
+	reviews := make([]diligent.Dep, 0)
+	exceptions := make([]diligent.Dep, 0)
 	for _, d := range deps {
-		err := writeStrings(writer, d.Name, tab, d.License.Name, newline)
+		switch d.Status {
+		case diligent.Review:
+			reviews = append(reviews, d)
+			continue
+		case diligent.Excepted:
+			exceptions = append(exceptions, d)
+			continue
+		}
+		err := writeDep(writer, d)
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := writeSection(writer, "Requires review:", reviews); err != nil {
+		return err
+	}
+	if err := writeSection(writer, "Approved exceptions:", exceptions); err != nil {
+		return err
+	}
+	if err := writeWarnings(writer, warnings); err != nil {
+		return err
+	}
+
 	writer.Flush()
 	return nil
 }
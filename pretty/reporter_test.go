@@ -0,0 +1,50 @@
+package pretty_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/pretty"
+)
+
+func TestReportWithWarnings(t *testing.T) {
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	deps := []diligent.Dep{
+		{Name: "a", License: mit, Status: diligent.Allowed},
+		{Name: "b", License: mit, Scope: diligent.Development, Declared: true, Status: diligent.Review},
+		{Name: "c", License: mit, Status: diligent.Excepted},
+	}
+	warnings := []diligent.WarningDetails{
+		{Kind: diligent.NotFound, Ecosystem: "npm", Package: "d", Version: "1.0.0", Scope: diligent.Optional, Err: &diligent.StatusError{StatusCode: 404}},
+		{Err: errors.New("something failed")},
+	}
+	var buf bytes.Buffer
+	if err := pretty.NewReporter().(diligent.WarningReporter).ReportWithWarnings(&buf, deps, warnings); err != nil {
+		t.Fatal(err)
+	}
+	// columns are padded with spaces, so each line is compared with its cells separated by a single space
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = strings.Join(strings.Fields(l), " ")
+	}
+	expected := []string{
+		"a MIT License",
+		"",
+		"Requires review:",
+		"b MIT License (declared) (dev)",
+		"",
+		"Approved exceptions:",
+		"c MIT License",
+		"",
+		"Unresolved:",
+		"d 1.0.0 (npm) (optional) not-found: requested failed with status 404",
+		"unknown package something failed",
+	}
+	if reflect.DeepEqual(lines, expected) == false {
+		t.Errorf("got %q, want %q", lines, expected)
+	}
+}
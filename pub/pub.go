@@ -0,0 +1,270 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+	"gopkg.in/yaml.v2"
+)
+
+// WebLicenseGetter retrieves license information from an online source
+type WebLicenseGetter interface {
+	IsCompatibleURL(s string) bool
+	GetLicenseFromURL(s string) (diligent.License, error)
+}
+
+type description struct {
+	Name        string `yaml:"name"`
+	URL         string `yaml:"url"`
+	Path        string `yaml:"path"`
+	ResolvedRef string `yaml:"resolved-ref"`
+	Relative    bool   `yaml:"relative"`
+}
+
+// UnmarshalYAML handles descriptions which are plain strings, as used by sdk packages
+func (d *description) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err == nil {
+		d.Name = name
+		return nil
+	}
+	type raw description
+	return unmarshal((*raw)(d))
+}
+
+type lockedPackage struct {
+	Dependency  string      `yaml:"dependency"`
+	Description description `yaml:"description"`
+	Source      string      `yaml:"source"`
+	Version     string      `yaml:"version"`
+}
+
+type lock struct {
+	Packages map[string]lockedPackage `yaml:"packages"`
+}
+
+type score struct {
+	Tags []string `json:"tags"`
+}
+
+type versionInfo struct {
+	Pubspec struct {
+		Repository string `json:"repository"`
+		Homepage   string `json:"homepage"`
+	} `json:"pubspec"`
+}
+
+type pub struct {
+	config Config
+	url    string
+	webLG  WebLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// DevDependencies can be set to true if you want to gather the licenses of your direct dev dependencies as well
+	// as your other dependencies. pubspec.lock does not record which package depends on which, so the transitive
+	// dependencies of dev dependencies are always gathered and reported as prod dependencies
+	DevDependencies bool
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
+	// Client sends requests to the registry, allowing proxies, certificate authorities and credentials to be
+	// configured. http.DefaultClient is used when nil
+	Client *http.Client
+}
+
+// New returns a Deper capable of dealing with Dart and Flutter pubspec.lock files.
+// The url should point at the pub.dev API, for example https://pub.dev
+func New(url string, webLG WebLicenseGetter) diligent.Deper {
+	return NewWithOptions(url, webLG, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(url string, webLG WebLicenseGetter, c Config) diligent.Deper {
+	if c.Client == nil {
+		c.Client = http.DefaultClient
+	}
+	return &pub{c, strings.TrimSuffix(url, "/"), webLG}
+}
+
+// Name returns "pub"
+func (p *pub) Name() string {
+	return "pub"
+}
+
+// Dependencies returns the licenses of the packages locked within pubspec.lock.
+// Path dependencies are resolved relative to the working directory
+func (p *pub) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return p.LocalDependencies(".", file)
+}
+
+// LocalDependencies returns the licenses of the packages locked within pubspec.lock, dir is used to resolve
+// relative path dependencies
+func (p *pub) LocalDependencies(dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return p.LocalDependenciesContext(context.Background(), dir, file)
+}
+
+// LocalDependenciesContext is identical to LocalDependencies but cancels the lookups once ctx is done
+func (p *pub) LocalDependenciesContext(ctx context.Context, dir string, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	var l lock
+	err := yaml.Unmarshal(file, &l)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deps := make([]diligent.Dep, 0, len(l.Packages))
+	warns := make([]diligent.Warning, 0, len(l.Packages))
+	for name, pkg := range l.Packages {
+		// sdk packages, such as flutter itself, are not dependencies
+		if pkg.Source == "sdk" {
+			continue
+		}
+		var scope diligent.Scope
+		if pkg.Dependency == "direct dev" {
+			if !p.config.DevDependencies {
+				continue
+			}
+			scope = diligent.Development
+		}
+		d, ok := p.config.Overrides.Dep(p.Name(), name, pkg.Version)
+		if !ok {
+			var t diligent.Trace
+			l, err := p.getLicense(ctx, dir, name, pkg, &t)
+			if err != nil {
+				warns = append(warns, warning.FromScopedError(p.Name(), name, pkg.Version, scope, err, t))
+				continue
+			}
+			d = diligent.Dep{
+				Name:    name,
+				Version: pkg.Version,
+				License: l,
+				Trace:   t,
+			}
+		}
+		d.Scope = scope
+		deps = append(deps, d)
+	}
+	return deps, warns, nil
+}
+
+// IsCompatible returns true if the filename is pubspec.lock
+func (p *pub) IsCompatible(filename string) bool {
+	return filename == "pubspec.lock"
+}
+
+func (p *pub) getLicense(ctx context.Context, dir, name string, pkg lockedPackage, t *diligent.Trace) (diligent.License, error) {
+	switch pkg.Source {
+	case "hosted":
+		return p.getHostedLicense(ctx, name, pkg.Version, t)
+	case "git":
+		return p.getLicenseFromGit(ctx, pkg.Description.URL, pkg.Description.ResolvedRef, t)
+	case "path":
+		path := pkg.Description.Path
+		if pkg.Description.Relative || !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		return diligent.TraceLicenseForDirectory(path, t)
+	}
+	return diligent.License{}, fmt.Errorf("unsupported source '%s'", pkg.Source)
+}
+
+func (p *pub) getLicenseFromGit(ctx context.Context, gitURL, ref string, t *diligent.Trace) (diligent.License, error) {
+	repoURL := strings.TrimSuffix(gitURL, ".git")
+	if p.webLG != nil && p.webLG.IsCompatibleURL(repoURL) {
+		if ref != "" {
+			l, err := diligent.GetLicenseFromURL(ctx, p.webLG, repoURL+"/tree/"+ref, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+		l, err := diligent.GetLicenseFromURL(ctx, p.webLG, repoURL, t)
+		if err == nil {
+			return l, nil
+		}
+	}
+	return diligent.TraceLicenseForGit(ctx, gitURL, t)
+}
+
+func (p *pub) getJSON(ctx context.Context, u string, v interface{}) error {
+	rctx, cancel, err := diligent.RequestContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.config.Client.Do(req.WithContext(rctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return &diligent.StatusError{StatusCode: resp.StatusCode}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return &diligent.MalformedError{Msg: "parsing pub response failed - invalid JSON"}
+	}
+	return nil
+}
+
+// getLicenseFromTags maps pub.dev's "license:<identifier>" tags, which use lower case SPDX identifiers, to a license
+func getLicenseFromTags(tags []string) (diligent.License, bool) {
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, "license:") {
+			continue
+		}
+		id := strings.TrimPrefix(tag, "license:")
+		for _, known := range diligent.GetLicenseIdentifiers() {
+			if strings.EqualFold(id, known) {
+				l, err := diligent.GetLicenseFromIdentifier(known)
+				return l, err == nil
+			}
+		}
+	}
+	return diligent.License{}, false
+}
+
+// getHostedLicense uses the license pub.dev detected within the locked version, falling back to the repository or
+// homepage declared by that version
+func (p *pub) getHostedLicense(ctx context.Context, name, version string, t *diligent.Trace) (diligent.License, error) {
+	versionURL := fmt.Sprintf("%s/api/packages/%s/versions/%s", p.url, url.PathEscape(name), url.PathEscape(version))
+	var s score
+	scoreURL := versionURL + "/score"
+	err := p.getJSON(ctx, scoreURL, &s)
+	if err == nil {
+		l, ok := getLicenseFromTags(s.Tags)
+		if ok {
+			t.Record("pub.dev license tag", scoreURL, l, nil)
+			return l, nil
+		}
+		err = errors.New("no known license tag")
+	}
+	t.Record("pub.dev license tag", scoreURL, diligent.License{}, err)
+
+	var info versionInfo
+	err = p.getJSON(ctx, versionURL, &info)
+	if err != nil {
+		t.Record("pub.dev version pubspec", versionURL, diligent.License{}, err)
+		return diligent.License{}, err
+	}
+	for _, repoURL := range []string{info.Pubspec.Repository, info.Pubspec.Homepage} {
+		repoURL = strings.TrimSuffix(repoURL, ".git")
+		if repoURL != "" && p.webLG != nil && p.webLG.IsCompatibleURL(repoURL) {
+			l, err := diligent.GetLicenseFromURL(ctx, p.webLG, repoURL, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+	}
+	return diligent.License{}, errors.New("no license information in pub")
+}
@@ -0,0 +1,281 @@
+package pub_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/pub"
+)
+
+type mockWebLicenseGetter map[string]string
+
+func (m mockWebLicenseGetter) IsCompatibleURL(s string) bool {
+	return true
+}
+
+func (m mockWebLicenseGetter) GetLicenseFromURL(s string) (diligent.License, error) {
+	id, ok := m[s]
+	if !ok {
+		return diligent.License{}, errors.New("not mocked")
+	}
+	return diligent.GetLicenseFromIdentifier(id)
+}
+
+func TestName(t *testing.T) {
+	target := pub.New("", nil)
+	if target.Name() != "pub" {
+		t.Error("expected 'pub'")
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out bool
+	}{
+		{"pubspec.lock", true},
+		{"pubspec.yaml", false},
+		{"mix.lock", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			target := pub.New("", nil)
+			compatible := target.IsCompatible(tt.in)
+			if compatible != tt.out {
+				t.Errorf("got %v, want %v", compatible, tt.out)
+			}
+		})
+	}
+}
+
+const lockFile = `
+packages:
+  async:
+    dependency: transitive
+    description:
+      name: async
+      url: "https://pub.dartlang.org"
+    source: hosted
+    version: "2.8.2"
+  http:
+    dependency: "direct main"
+    description:
+      name: http
+      url: "https://pub.dartlang.org"
+    source: hosted
+    version: "0.13.4"
+  my_git:
+    dependency: "direct main"
+    description:
+      path: "."
+      ref: main
+      resolved-ref: abc123
+      url: "https://github.com/acme/my_git.git"
+    source: git
+    version: "1.0.0"
+  flutter:
+    dependency: "direct main"
+    description: flutter
+    source: sdk
+    version: "0.0.0"
+  lints:
+    dependency: "direct dev"
+    description:
+      name: lints
+      url: "https://pub.dartlang.org"
+    source: hosted
+    version: "1.0.1"
+sdks:
+  dart: ">=2.12.0 <3.0.0"
+`
+
+func TestDependencies(t *testing.T) {
+	webLG := mockWebLicenseGetter{
+		"https://github.com/acme/my_git/tree/abc123": "MIT",
+		"https://github.com/dart-lang/http":          "BSD-3-Clause",
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/packages/async/versions/2.8.2/score":
+			w.Write([]byte(`{"tags":["sdk:dart","license:bsd-3-clause","license:osi-approved"]}`))
+		case "/api/packages/lints/versions/1.0.1/score", "/api/packages/meta/versions/1.7.0/score":
+			w.Write([]byte(`{"tags":["license:bsd-3-clause"]}`))
+		case "/api/packages/http/versions/0.13.4/score":
+			w.Write([]byte(`{"tags":["license:unknown"]}`))
+		case "/api/packages/http/versions/0.13.4":
+			w.Write([]byte(`{"pubspec":{"repository":"https://github.com/dart-lang/http"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	cases := []struct {
+		description string
+		config      pub.Config
+		in          []byte
+		depsOut     map[string]string
+		warnsOut    []diligent.WarningDetails
+		errOut      bool
+	}{{
+		"should handle hosted and git packages, excluding dev dependencies by default",
+		pub.Config{},
+		[]byte(lockFile),
+		map[string]string{
+			"async@2.8.2":  "BSD-3-Clause",
+			"http@0.13.4":  "BSD-3-Clause",
+			"my_git@1.0.0": "MIT",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should be capable of including dev dependencies",
+		pub.Config{DevDependencies: true},
+		[]byte(lockFile),
+		map[string]string{
+			"async@2.8.2":  "BSD-3-Clause",
+			"http@0.13.4":  "BSD-3-Clause",
+			"my_git@1.0.0": "MIT",
+			"lints@1.0.1":  "BSD-3-Clause dev",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should report the transitive dependencies of dev dependencies as prod, as pubspec.lock has no dependency graph",
+		pub.Config{},
+		[]byte(`
+packages:
+  lints:
+    dependency: "direct dev"
+    description:
+      name: lints
+      url: "https://pub.dartlang.org"
+    source: hosted
+    version: "1.0.1"
+  meta:
+    dependency: transitive
+    description:
+      name: meta
+      url: "https://pub.dartlang.org"
+    source: hosted
+    version: "1.7.0"
+`),
+		map[string]string{
+			"meta@1.7.0": "BSD-3-Clause",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should warn if packages cannot be found",
+		pub.Config{},
+		[]byte(`
+packages:
+  missing:
+    dependency: transitive
+    description:
+      name: missing
+      url: "https://pub.dartlang.org"
+    source: hosted
+    version: "1.0.0"
+`),
+		map[string]string{},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NotFound, Ecosystem: "pub", Package: "missing", Version: "1.0.0", Err: &diligent.StatusError{StatusCode: 404}},
+		},
+		false,
+	}, {
+		"parse failure",
+		pub.Config{},
+		[]byte(`packages: [`),
+		map[string]string{},
+		[]diligent.WarningDetails{},
+		true,
+	}}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+			target := pub.NewWithOptions(ts.URL, webLG, tt.config)
+			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
+			expectedDeps := make([]diligent.Dep, 0, len(tt.depsOut))
+			for depID, lID := range tt.depsOut {
+				// expected licenses may be followed by the scope of the dependency, e.g. "MIT dev"
+				fields := strings.Fields(lID)
+				l, _ := diligent.GetLicenseFromIdentifier(fields[0])
+				at := strings.LastIndex(depID, "@")
+				dep := diligent.Dep{Name: depID[:at], Version: depID[at+1:], License: l}
+				if len(fields) > 1 {
+					dep.Scope = diligent.Scope(fields[1])
+				}
+				expectedDeps = append(expectedDeps, dep)
+			}
+			if len(d) > 0 || len(expectedDeps) > 0 {
+				sort.Sort(diligent.DepsByName(d))
+				sort.Sort(diligent.DepsByName(expectedDeps))
+				if reflect.DeepEqual(d, expectedDeps) == false {
+					t.Errorf("deps: got %+v, want %+v", d, expectedDeps)
+				}
+			}
+			if got := warningDetails(w); len(got) > 0 || len(tt.warnsOut) > 0 {
+				if reflect.DeepEqual(got, tt.warnsOut) == false {
+					t.Errorf("warnings: got %+v, want %+v", got, tt.warnsOut)
+				}
+			}
+			isErr := e != nil
+			if tt.errOut != isErr {
+				t.Errorf("error: got %v, want %v", isErr, tt.errOut)
+			}
+		})
+	}
+}
+
+func TestDependenciesTrace(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+	target := pub.New(ts.URL, nil)
+	_, w, err := target.Dependencies([]byte(`
+packages:
+  missing:
+    dependency: transitive
+    description:
+      name: missing
+      url: "https://pub.dartlang.org"
+    source: hosted
+    version: "1.0.0"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(w) != 1 {
+		t.Fatalf("expected a single warning, got %v", w)
+	}
+	versionURL := ts.URL + "/api/packages/missing/versions/1.0.0"
+	notFound := (&diligent.StatusError{StatusCode: http.StatusNotFound}).Error()
+	expected := diligent.Trace{
+		{Source: "pub.dev license tag", Location: versionURL + "/score", Err: notFound},
+		{Source: "pub.dev version pubspec", Location: versionURL, Err: notFound},
+	}
+	if got := diligent.GetWarningDetails(w[0]).Trace; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
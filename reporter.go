@@ -6,3 +6,10 @@ import "io"
 type Reporter interface {
 	Report(w io.Writer, deps []Dep) error
 }
+
+// WarningReporter is an optional interface implemented by Reporters which also output the packages whose licenses
+// could not be determined
+type WarningReporter interface {
+	Reporter
+	ReportWithWarnings(w io.Writer, deps []Dep, warnings []WarningDetails) error
+}
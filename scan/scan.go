@@ -0,0 +1,290 @@
+// Package scan finds the manifests within a directory, determines the licenses of the dependencies they declare and
+// checks them against a license policy. It allows diligent to be embedded within other tools
+package scan
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+)
+
+// Output is a Reporter along with the Writer to which it reports
+type Output struct {
+	Reporter diligent.Reporter
+	Writer   io.Writer
+}
+
+// Config contains the options of a Scanner
+type Config struct {
+	// Ignore excludes packages whose names match any of the expressions
+	Ignore []*regexp.Regexp
+	// Scopes selects the scopes of dependencies which are included. All scopes are included when nil
+	Scopes diligent.Scopes
+	// Policy is used to check the dependencies. Dependencies are not checked when nil
+	Policy *policy.Policy
+	// SortByLicense sorts dependencies by license rather than by name
+	SortByLicense bool
+	// Reporters are written to once the dependencies have been checked
+	Reporters []Output
+	// Trace includes the steps taken to determine each license within reports
+	Trace bool
+	// Exclude skips files and directories matching any of the globs, which are matched against both the path
+	// relative to the scanned directory and the file or directory name. A ** element matches any number of directories
+	Exclude []string
+	// MaxDepth limits how many levels of directories are scanned, where 1 only scans the files within the scanned
+	// directory. There is no limit when 0
+	MaxDepth int
+	// Gitignore skips files and directories ignored by .gitignore files within the scanned directory
+	Gitignore bool
+	// NoDefaultExcludes scans directories such as node_modules and .git which are skipped by default
+	NoDefaultExcludes bool
+}
+
+// Violation is a dependency whose license is not allowed by the policy, or which requires review
+type Violation struct {
+	Dep    diligent.Dep
+	Reason string
+}
+
+// Error implements error
+func (v Violation) Error() string {
+	return v.Reason
+}
+
+// ReportError is returned by Scan when a report cannot be written
+type ReportError struct {
+	Err error
+}
+
+// Error implements error
+func (e *ReportError) Error() string {
+	return fmt.Sprintf("unable to write report. %v", e.Err)
+}
+
+// Unwrap returns the error which prevented the report from being written
+func (e *ReportError) Unwrap() error {
+	return e.Err
+}
+
+// Result is the outcome of a scan
+type Result struct {
+	// Manifests are the files which were processed by a Deper
+	Manifests []string
+	// Deps are the deduplicated dependencies whose license was determined
+	Deps []diligent.Dep
+	// Warnings describe the packages whose license could not be determined
+	Warnings []diligent.Warning
+	// Violations are the dependencies which are denied or not allowed by the policy
+	Violations []Violation
+	// Reviews are the dependencies which require review
+	Reviews []Violation
+	// Errors describe the manifests which could not be read or processed
+	Errors []error
+}
+
+// Scanner determines the licenses of the dependencies declared within a directory
+type Scanner struct {
+	depers []diligent.Deper
+	config Config
+}
+
+// New returns a Scanner which processes files using the first compatible Deper
+func New(depers []diligent.Deper) *Scanner {
+	return NewWithOptions(depers, Config{})
+}
+
+// NewWithOptions returns a Scanner configured with the provided options
+func NewWithOptions(depers []diligent.Deper, c Config) *Scanner {
+	return &Scanner{depers, c}
+}
+
+// Deper returns the Deper able to process the file, or an error if no Deper is compatible
+func (s *Scanner) Deper(path string) (diligent.Deper, error) {
+	filename := filepath.Base(path)
+	for _, deper := range s.depers {
+		if deper.IsCompatible(filename) {
+			return deper, nil
+		}
+	}
+	return nil, fmt.Errorf("Diligent does not know how to process '%s' files", filename)
+}
+
+// Files returns the files within the path which are not excluded
+func (s *Scanner) Files(path string) ([]string, error) {
+	files := make([]string, 0)
+	w := newWalker(path, s.config)
+	err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		include, err := w.visit(path, info)
+		if include {
+			files = append(files, path)
+		}
+		return err
+	})
+	return files, err
+}
+
+// Scan determines the licenses of the dependencies declared by the manifests within the path, checks them against
+// the policy and writes them to the reporters. An error is returned if the path cannot be walked, or a ReportError if
+// a report cannot be written. Manifests which cannot be processed are recorded within the Result's Errors
+func (s *Scanner) Scan(path string) (Result, error) {
+	return s.ScanContext(context.Background(), path)
+}
+
+// ScanContext is identical to Scan but stops making requests once ctx is done. The dependencies determined so far are
+// still checked and reported, before ctx's error is returned. Depers implementing diligent.ContextDeper return the
+// dependencies they could not determine as warnings
+func (s *Scanner) ScanContext(ctx context.Context, path string) (Result, error) {
+	res := Result{}
+	files, err := s.Files(path)
+	if err != nil {
+		return res, err
+	}
+
+	for _, f := range files {
+		deper, err := s.Deper(f)
+		if err != nil {
+			continue
+		}
+		res.Manifests = append(res.Manifests, f)
+		d, w, err := s.process(ctx, deper, f)
+		if err != nil {
+			res.Errors = append(res.Errors, err)
+			continue
+		}
+		res.Deps = append(res.Deps, d...)
+		res.Warnings = append(res.Warnings, w...)
+	}
+	res.Deps, res.Warnings = s.filter(res.Deps, res.Warnings)
+	res.Deps = diligent.Deps(res.Deps).Dedupe()
+
+	if s.config.Policy != nil {
+		res.Deps = s.config.Policy.Check(res.Deps)
+		res.Violations, res.Reviews = Violations(res.Deps)
+	}
+	if s.config.SortByLicense {
+		sort.Sort(diligent.DepsByLicense(res.Deps))
+	} else {
+		sort.Sort(diligent.DepsByName(res.Deps))
+	}
+
+	reported := res.Deps
+	if !s.config.Trace {
+		reported = withoutTraces(res.Deps)
+	}
+	warnings := warningDetails(res.Warnings, s.config.Trace)
+	for _, o := range s.config.Reporters {
+		var err error
+		if wr, ok := o.Reporter.(diligent.WarningReporter); ok {
+			err = wr.ReportWithWarnings(o.Writer, reported, warnings)
+		} else {
+			err = o.Reporter.Report(o.Writer, reported)
+		}
+		if err != nil {
+			return res, &ReportError{err}
+		}
+	}
+	return res, ctx.Err()
+}
+
+func (s *Scanner) process(ctx context.Context, deper diligent.Deper, path string) ([]diligent.Dep, []diligent.Warning, error) {
+	file, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return diligent.GetDependencies(ctx, deper, filepath.Dir(path), file)
+}
+
+func (s *Scanner) isIgnored(name string) bool {
+	for _, i := range s.config.Ignore {
+		if i.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter removes ignored packages, along with dependencies and warnings whose scope has not been selected
+func (s *Scanner) filter(dd []diligent.Dep, ww []diligent.Warning) ([]diligent.Dep, []diligent.Warning) {
+	ddOut := make([]diligent.Dep, 0, len(dd))
+	for _, d := range dd {
+		if s.isIgnored(d.Name) {
+			continue
+		}
+		if s.config.Scopes != nil && !s.config.Scopes.Includes(d.EffectiveScope()) {
+			continue
+		}
+		ddOut = append(ddOut, d)
+	}
+	wwOut := make([]diligent.Warning, 0, len(ww))
+	for _, w := range ww {
+		details := diligent.GetWarningDetails(w)
+		if details.Package != "" && s.isIgnored(details.Package) {
+			continue
+		}
+		if s.config.Scopes != nil && !s.config.Scopes.Includes(details.EffectiveScope()) {
+			continue
+		}
+		wwOut = append(wwOut, w)
+	}
+	return ddOut, wwOut
+}
+
+// Violations returns the dependencies which are denied or not allowed by the license policy, along with those which
+// require review. Dependencies should have been checked against the policy
+func Violations(deps []diligent.Dep) (violations []Violation, reviews []Violation) {
+	for _, d := range deps {
+		switch d.Status {
+		case diligent.Denied:
+			violations = append(violations, Violation{d, fmt.Sprintf("dependency '%s' has license '%s' which is denied by your license policy", d.Name, d.License.Identifier)})
+		case diligent.NotAllowed:
+			violations = append(violations, Violation{d, fmt.Sprintf("dependency '%s' has license '%s' which is not in your license whitelist", d.Name, d.License.Identifier)})
+		case diligent.Review:
+			reviews = append(reviews, Violation{d, fmt.Sprintf("dependency '%s' has license '%s' which requires review", d.Name, d.License.Identifier)})
+		}
+	}
+	return violations, reviews
+}
+
+// withoutTraces returns a copy of the dependencies without the steps taken to determine their licenses
+func withoutTraces(deps []diligent.Dep) []diligent.Dep {
+	out := make([]diligent.Dep, len(deps))
+	for i, d := range deps {
+		d.Trace = nil
+		out[i] = d
+	}
+	return out
+}
+
+// warningDetails returns the details of the warnings ordered by ecosystem and package, including the steps taken to
+// determine their licenses if trace is true
+func warningDetails(ww []diligent.Warning, trace bool) []diligent.WarningDetails {
+	out := make([]diligent.WarningDetails, 0, len(ww))
+	for _, w := range ww {
+		details := diligent.GetWarningDetails(w)
+		if !trace {
+			details.Trace = nil
+		}
+		out = append(out, details)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Ecosystem == out[j].Ecosystem {
+			if out[i].Package == out[j].Package {
+				return out[i].Err.Error() < out[j].Err.Error()
+			}
+			return out[i].Package < out[j].Package
+		}
+		return out[i].Ecosystem < out[j].Ecosystem
+	})
+	return out
+}
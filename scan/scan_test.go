@@ -0,0 +1,329 @@
+package scan_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/policy"
+	"github.com/senseyeio/diligent/scan"
+	"github.com/senseyeio/diligent/warning"
+)
+
+// mockDeper reads deps.txt files, where each line holds a package name, license identifier and optional scope. A
+// license of ? results in a warning and a file containing only "error" cannot be processed
+type mockDeper struct{}
+
+func (m mockDeper) Name() string {
+	return "mock"
+}
+
+func (m mockDeper) IsCompatible(filename string) bool {
+	return filename == "deps.txt"
+}
+
+func (m mockDeper) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	content := strings.TrimSpace(string(file))
+	if content == "error" {
+		return nil, nil, errors.New("cannot process file")
+	}
+	var deps []diligent.Dep
+	var warns []diligent.Warning
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		var scope diligent.Scope
+		if len(fields) > 2 {
+			scope = diligent.Scope(fields[2])
+		}
+		if fields[1] == "?" {
+			warns = append(warns, warning.FromScopedError(m.Name(), fields[0], "", scope, errors.New("no license"), nil))
+			continue
+		}
+		l, err := diligent.GetLicenseFromIdentifier(fields[1])
+		if err != nil {
+			return nil, nil, err
+		}
+		d := diligent.Dep{Name: fields[0], License: l, Scope: scope}
+		d.Trace.Record("mock", "deps.txt", l, nil)
+		deps = append(deps, d)
+	}
+	return deps, warns, nil
+}
+
+type mockReporter struct {
+	deps []diligent.Dep
+	err  error
+}
+
+func (m *mockReporter) Report(w io.Writer, deps []diligent.Dep) error {
+	m.deps = deps
+	return m.err
+}
+
+type mockWarningReporter struct {
+	mockReporter
+	warnings []diligent.WarningDetails
+}
+
+func (m *mockWarningReporter) ReportWithWarnings(w io.Writer, deps []diligent.Dep, warnings []diligent.WarningDetails) error {
+	m.deps = deps
+	m.warnings = warnings
+	return m.err
+}
+
+func writeTree(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "scan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func mustPolicy(t *testing.T, allow, review, deny []string) *policy.Policy {
+	p, err := policy.New(allow, review, deny)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func depNames(deps []diligent.Dep) []string {
+	names := make([]string, len(deps))
+	for i, d := range deps {
+		names[i] = d.Name
+	}
+	return names
+}
+
+func violationNames(vv []scan.Violation) []string {
+	names := make([]string, len(vv))
+	for i, v := range vv {
+		names[i] = v.Dep.Name
+	}
+	return names
+}
+
+func TestScan(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"deps.txt":              "b MIT\nd GPL-3.0\na Apache-2.0 dev\nmissing ?\nmissing-dev ? dev",
+		"sub/deps.txt":          "c BSD-3-Clause\na Apache-2.0",
+		"sub/other.txt":         "not a manifest",
+		"node_modules/deps.txt": "nested MIT",
+	})
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name       string
+		config     scan.Config
+		deps       []string
+		warnings   int
+		violations []string
+		reviews    []string
+	}{
+		{"defaults", scan.Config{}, []string{"a", "b", "c", "d"}, 2, nil, nil},
+		{"ignore", scan.Config{Ignore: []*regexp.Regexp{regexp.MustCompile("^[ab]$"), regexp.MustCompile("missing")}}, []string{"c", "d"}, 0, nil, nil},
+		{"scopes", scan.Config{Scopes: diligent.Scopes{diligent.Development}}, []string{"a"}, 1, nil, nil},
+		{"sort by license", scan.Config{SortByLicense: true}, []string{"a", "c", "d", "b"}, 2, nil, nil},
+		{"exclude", scan.Config{Exclude: []string{"sub"}}, []string{"a", "b", "d"}, 2, nil, nil},
+		{"max depth", scan.Config{MaxDepth: 1}, []string{"a", "b", "d"}, 2, nil, nil},
+		{"no default excludes", scan.Config{NoDefaultExcludes: true}, []string{"a", "b", "c", "d", "nested"}, 2, nil, nil},
+		{"policy", scan.Config{Policy: mustPolicy(t, []string{"permissive"}, []string{"BSD-3-Clause"}, []string{"GPL-3.0"})}, []string{"a", "b", "c", "d"}, 2, []string{"d"}, []string{"c"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := scan.NewWithOptions([]diligent.Deper{mockDeper{}}, tc.config).Scan(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Errors) != 0 {
+				t.Errorf("unexpected errors %v", res.Errors)
+			}
+			if got := depNames(res.Deps); !reflect.DeepEqual(got, tc.deps) {
+				t.Errorf("expected deps %v, got %v", tc.deps, got)
+			}
+			if len(res.Warnings) != tc.warnings {
+				t.Errorf("expected %d warnings, got %d", tc.warnings, len(res.Warnings))
+			}
+			if got := violationNames(res.Violations); len(got) != len(tc.violations) || (len(got) > 0 && !reflect.DeepEqual(got, tc.violations)) {
+				t.Errorf("expected violations %v, got %v", tc.violations, got)
+			}
+			if got := violationNames(res.Reviews); len(got) != len(tc.reviews) || (len(got) > 0 && !reflect.DeepEqual(got, tc.reviews)) {
+				t.Errorf("expected reviews %v, got %v", tc.reviews, got)
+			}
+		})
+	}
+}
+
+func TestScanWalk(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"deps.txt":                "a MIT",
+		".gitignore":              "ignored\n# comment",
+		"ignored/deps.txt":        "b MIT",
+		"sub/.gitignore":          "deep",
+		"sub/deep/deps.txt":       "c MIT",
+		"vendor/deps.txt":         "d MIT",
+		"vendor/pkg/deps.txt":     "e MIT",
+		"examples/x/y/deps.txt":   "f MIT",
+		"examples/x/y/z/deps.txt": "g MIT",
+	})
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name   string
+		config scan.Config
+		deps   []string
+	}{
+		{"vendor files are read but its subdirectories are skipped", scan.Config{}, []string{"a", "b", "c", "d", "f", "g"}},
+		{"gitignore", scan.Config{Gitignore: true}, []string{"a", "d", "f", "g"}},
+		{"exclude trailing **", scan.Config{Exclude: []string{"examples/**"}}, []string{"a", "b", "c", "d"}},
+		{"exclude leading **", scan.Config{Exclude: []string{"**/z"}}, []string{"a", "b", "c", "d", "f"}},
+		{"exclude inner **", scan.Config{Exclude: []string{"examples/**/deps.txt"}}, []string{"a", "b", "c", "d"}},
+		{"exclude ** file glob", scan.Config{Exclude: []string{"**/deep/*.txt"}}, []string{"a", "b", "d", "f", "g"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := scan.NewWithOptions([]diligent.Deper{mockDeper{}}, tc.config).Scan(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := depNames(res.Deps); !reflect.DeepEqual(got, tc.deps) {
+				t.Errorf("expected deps %v, got %v", tc.deps, got)
+			}
+		})
+	}
+}
+
+func TestScanErrors(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"deps.txt":     "a MIT",
+		"bad/deps.txt": "error",
+	})
+	defer os.RemoveAll(dir)
+
+	res, err := scan.New([]diligent.Deper{mockDeper{}}).Scan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Manifests) != 2 {
+		t.Errorf("expected 2 manifests, got %v", res.Manifests)
+	}
+	if len(res.Errors) != 1 || res.Errors[0].Error() != "cannot process file" {
+		t.Errorf("expected a single error, got %v", res.Errors)
+	}
+	if got := depNames(res.Deps); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("expected the other manifest's deps, got %v", got)
+	}
+
+	if _, err := scan.New(nil).Scan(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected an error when the path does not exist")
+	}
+}
+
+func TestScanReporters(t *testing.T) {
+	dir := writeTree(t, map[string]string{"deps.txt": "a MIT"})
+	defer os.RemoveAll(dir)
+
+	for _, trace := range []bool{false, true} {
+		r := &mockReporter{}
+		_, err := scan.NewWithOptions([]diligent.Deper{mockDeper{}}, scan.Config{
+			Trace:     trace,
+			Reporters: []scan.Output{{Reporter: r, Writer: ioutil.Discard}},
+		}).Scan(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(r.deps) != 1 {
+			t.Fatalf("expected the reporter to receive 1 dep, got %v", r.deps)
+		}
+		if hasTrace := len(r.deps[0].Trace) > 0; hasTrace != trace {
+			t.Errorf("expected trace to be reported %v, got %v", trace, hasTrace)
+		}
+	}
+
+	reportErr := errors.New("disk full")
+	res, err := scan.NewWithOptions([]diligent.Deper{mockDeper{}}, scan.Config{
+		Reporters: []scan.Output{{Reporter: &mockReporter{err: reportErr}, Writer: ioutil.Discard}},
+	}).Scan(dir)
+	var re *scan.ReportError
+	if !errors.As(err, &re) || re.Err != reportErr {
+		t.Errorf("expected a ReportError, got %v", err)
+	}
+	if len(res.Deps) != 1 {
+		t.Errorf("expected the result to be populated, got %v", res.Deps)
+	}
+}
+
+func TestScanWarningReporters(t *testing.T) {
+	dir := writeTree(t, map[string]string{"deps.txt": "b ?\na MIT\nc ?"})
+	defer os.RemoveAll(dir)
+
+	r := &mockWarningReporter{}
+	_, err := scan.NewWithOptions([]diligent.Deper{mockDeper{}}, scan.Config{
+		Reporters: []scan.Output{{Reporter: r, Writer: ioutil.Discard}},
+	}).Scan(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.deps) != 1 || len(r.warnings) != 2 {
+		t.Fatalf("expected the reporter to receive 1 dep and 2 warnings, got %v and %v", r.deps, r.warnings)
+	}
+	if r.warnings[0].Package != "b" || r.warnings[1].Package != "c" || r.warnings[0].Err.Error() != "no license" {
+		t.Errorf("expected the warnings to be ordered by package, got %+v", r.warnings)
+	}
+}
+
+func TestScanContext(t *testing.T) {
+	dir := writeTree(t, map[string]string{"deps.txt": "a MIT"})
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := &mockReporter{}
+	res, err := scan.NewWithOptions([]diligent.Deper{mockDeper{}}, scan.Config{
+		Reporters: []scan.Output{{Reporter: r, Writer: ioutil.Discard}},
+	}).ScanContext(ctx, dir)
+	if err != context.Canceled {
+		t.Errorf("expected the context's error, got %v", err)
+	}
+	if len(res.Deps) != 1 || len(r.deps) != 1 {
+		t.Errorf("expected the partial results to be returned and reported, got %v and %v", res.Deps, r.deps)
+	}
+}
+
+func TestViolations(t *testing.T) {
+	mit, _ := diligent.GetLicenseFromIdentifier("MIT")
+	deps := []diligent.Dep{
+		{Name: "a", License: mit, Status: diligent.Allowed},
+		{Name: "b", License: mit, Status: diligent.Denied},
+		{Name: "c", License: mit, Status: diligent.NotAllowed},
+		{Name: "d", License: mit, Status: diligent.Review},
+	}
+	violations, reviews := scan.Violations(deps)
+	expected := []string{
+		"dependency 'b' has license 'MIT' which is denied by your license policy",
+		"dependency 'c' has license 'MIT' which is not in your license whitelist",
+	}
+	if len(violations) != 2 || violations[0].Error() != expected[0] || violations[1].Error() != expected[1] {
+		t.Errorf("unexpected violations %v", violations)
+	}
+	if len(reviews) != 1 || reviews[0].Error() != "dependency 'd' has license 'MIT' which requires review" {
+		t.Errorf("unexpected reviews %v", reviews)
+	}
+}
@@ -0,0 +1,152 @@
+package scan
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// defaultExcludes are directories which contain the dependencies of dependencies, version control data or test
+// fixtures rather than manifests describing the project
+var defaultExcludes = []string{"node_modules", "vendor", ".git", "testdata", "fixtures", "__fixtures__"}
+
+// shallowExcludes are default excludes whose files are read but whose subdirectories are skipped, as they hold the
+// manifests of vendoring tools such as vendor/modules.txt and vendor/vendor.json
+var shallowExcludes = map[string]bool{"vendor": true}
+
+// walker decides which files within the scanned directory are processed
+type walker struct {
+	root     string
+	config   Config
+	patterns []gitignore.Pattern
+	matcher  gitignore.Matcher
+	shallow  map[string]bool
+}
+
+func newWalker(root string, c Config) *walker {
+	return &walker{root: root, config: c, shallow: map[string]bool{}}
+}
+
+func splitPath(rel string) []string {
+	if rel == "." {
+		return []string{}
+	}
+	return strings.Split(filepath.ToSlash(rel), "/")
+}
+
+func isDefaultExcluded(name string) bool {
+	for _, e := range defaultExcludes {
+		if name == e {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob matches a slash separated path against a glob, where a ** element matches any number of directories
+func matchGlob(glob []string, path []string) bool {
+	if len(glob) == 0 {
+		return len(path) == 0
+	}
+	if glob[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchGlob(glob[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 {
+		return false
+	}
+	if ok, _ := filepath.Match(glob[0], path[0]); !ok {
+		return false
+	}
+	return matchGlob(glob[1:], path[1:])
+}
+
+func (w *walker) matchesExclude(rel string) bool {
+	parts := splitPath(rel)
+	for _, g := range w.config.Exclude {
+		if matchGlob(strings.Split(filepath.ToSlash(g), "/"), parts) {
+			return true
+		}
+		if ok, _ := filepath.Match(g, filepath.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// loadGitignore adds the patterns within dir's .gitignore file, scoped to dir, rebuilding the matcher if any are found
+func (w *walker) loadGitignore(dir string, parts []string) error {
+	file, err := os.Open(filepath.Join(dir, ".gitignore"))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	loaded := len(w.patterns)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		w.patterns = append(w.patterns, gitignore.ParsePattern(line, parts))
+	}
+	if len(w.patterns) > loaded {
+		w.matcher = gitignore.NewMatcher(w.patterns)
+	}
+	return scanner.Err()
+}
+
+// visit returns whether a file should be processed, or filepath.SkipDir if a directory should not be descended into
+func (w *walker) visit(path string, info os.FileInfo) (bool, error) {
+	rel, err := filepath.Rel(w.root, path)
+	if err != nil {
+		return false, err
+	}
+	parts := splitPath(rel)
+	if len(parts) > 0 && w.shallow[filepath.Dir(path)] && info.IsDir() {
+		return false, filepath.SkipDir
+	}
+	if len(parts) > 0 {
+		if w.matchesExclude(rel) {
+			return false, skip(info)
+		}
+		if w.matcher != nil && w.matcher.Match(parts, info.IsDir()) {
+			return false, skip(info)
+		}
+	}
+	if !info.IsDir() {
+		return info.Mode().IsRegular(), nil
+	}
+	if len(parts) > 0 {
+		if w.config.MaxDepth > 0 && len(parts) >= w.config.MaxDepth {
+			return false, filepath.SkipDir
+		}
+		if !w.config.NoDefaultExcludes && isDefaultExcluded(info.Name()) {
+			if !shallowExcludes[info.Name()] {
+				return false, filepath.SkipDir
+			}
+			w.shallow[path] = true
+		}
+	}
+	if w.config.Gitignore {
+		return false, w.loadGitignore(path, parts)
+	}
+	return false, nil
+}
+
+func skip(info os.FileInfo) error {
+	if info.IsDir() {
+		return filepath.SkipDir
+	}
+	return nil
+}
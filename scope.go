@@ -0,0 +1,49 @@
+package diligent
+
+// Scope describes how a dependency is used by a project, such as at runtime or only during development
+type Scope string
+
+const (
+	// Production dependencies are shipped with, or required at runtime by, the project
+	Production Scope = "prod"
+	// Development dependencies are only used whilst developing the project, such as npm devDependencies
+	Development Scope = "dev"
+	// Optional dependencies are used if available, such as npm optionalDependencies
+	Optional Scope = "optional"
+	// Peer dependencies are expected to be provided by the consumer of the project, such as npm peerDependencies
+	Peer Scope = "peer"
+)
+
+var knownScopes = Scopes{Production, Development, Optional, Peer}
+
+// KnownScopes returns all the scopes which dependencies may have
+func KnownScopes() Scopes {
+	return append(Scopes(nil), knownScopes...)
+}
+
+// IsScope returns true if s is a scope which dependencies may have
+func IsScope(s string) bool {
+	return knownScopes.Includes(Scope(s))
+}
+
+// EffectiveScope returns the scope of the dependency. Depers which cannot distinguish scopes leave Scope empty, in
+// which case the dependency is assumed to be a Production dependency
+func (d Dep) EffectiveScope() Scope {
+	if d.Scope == "" {
+		return Production
+	}
+	return d.Scope
+}
+
+// Scopes is a selection of scopes
+type Scopes []Scope
+
+// Includes returns true if the scope is selected
+func (ss Scopes) Includes(s Scope) bool {
+	for _, selected := range ss {
+		if selected == s {
+			return true
+		}
+	}
+	return false
+}
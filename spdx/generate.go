@@ -0,0 +1,93 @@
+//go:build ignore
+// +build ignore
+
+// generate bundles the texts of the licenses known to diligent from a checkout of
+// https://github.com/spdx/license-list-data, writing texts.go
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+)
+
+// lineLength is the length of each line of the base64 encoded texts
+const lineLength = 120
+
+func main() {
+	dir := flag.String("dir", "", "text directory of the SPDX license-list-data repository")
+	flag.Parse()
+
+	files, err := ioutil.ReadDir(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// deprecated identifiers are published with a deprecated_ prefix, and casing differs for some identifiers
+	byID := map[string]string{}
+	for _, f := range files {
+		id := strings.ToLower(strings.TrimSuffix(f.Name(), ".txt"))
+		if strings.HasPrefix(id, "deprecated_") {
+			id = strings.TrimPrefix(id, "deprecated_")
+			if _, ok := byID[id]; ok {
+				continue
+			}
+		}
+		byID[id] = filepath.Join(*dir, f.Name())
+	}
+
+	var buf bytes.Buffer
+	gz, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	tw := tar.NewWriter(gz)
+	for _, id := range diligent.GetLicenseIdentifiers() {
+		path, ok := byID[strings.ToLower(id)]
+		if !ok {
+			log.Printf("no text for %s", id)
+			continue
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		hdr := &tar.Header{Name: id + ".txt", Mode: 0644, Size: int64(len(b))}
+		if err := tw.WriteHeader(hdr); err != nil {
+			log.Fatal(err)
+		}
+		if _, err := tw.Write(b); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		log.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	out, err := os.Create("texts.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+	fmt.Fprintln(out, "// Code generated by generate.go. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "package spdx")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// bundledTexts is a base64 encoded, gzipped tar of license texts")
+	fmt.Fprint(out, "const bundledTexts = `")
+	encoded := base64.StdEncoding.EncodeToString(buf.Bytes())
+	for len(encoded) > lineLength {
+		fmt.Fprintln(out, encoded[:lineLength])
+		encoded = encoded[lineLength:]
+	}
+	fmt.Fprintln(out, encoded+"`")
+}
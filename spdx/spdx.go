@@ -0,0 +1,81 @@
+// Package spdx provides the canonical texts of the licenses known to diligent, as published by the SPDX project.
+// The texts are bundled within the binary so that they are available offline
+package spdx
+
+//go:generate go run generate.go -dir ../../license-list-data/text
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	loadTexts sync.Once
+	texts     map[string]string
+)
+
+// decode unpacks the bundled gzipped tar of license texts, which are named after their identifier
+func decode() map[string]string {
+	out := map[string]string{}
+	data, err := base64.StdEncoding.DecodeString(bundledTexts)
+	if err != nil {
+		panic(err)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return out
+		}
+		if err != nil {
+			panic(err)
+		}
+		b, err := ioutil.ReadAll(tr)
+		if err != nil {
+			panic(err)
+		}
+		out[strings.TrimSuffix(hdr.Name, ".txt")] = trimLines(string(b))
+	}
+}
+
+// trimLines removes trailing whitespace from each line, as the published texts contain indented blank lines
+func trimLines(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t\r")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// Text returns the canonical text of the license with the given identifier, if it is bundled
+func Text(identifier string) (string, bool) {
+	loadTexts.Do(func() {
+		texts = decode()
+	})
+	t, ok := texts[identifier]
+	return t, ok
+}
+
+// Identifiers returns the sorted identifiers of the licenses whose text is bundled
+func Identifiers() []string {
+	loadTexts.Do(func() {
+		texts = decode()
+	})
+	out := make([]string, 0, len(texts))
+	for id := range texts {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,43 @@
+package spdx_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/spdx"
+)
+
+func TestText(t *testing.T) {
+	cases := []struct {
+		identifier string
+		contains   string
+	}{
+		{"MIT", "Permission is hereby granted"},
+		{"Apache-2.0", "Apache License"},
+		{"GPL-3.0", "GNU GENERAL PUBLIC LICENSE"},
+		{"WXwindows", "wxWindows"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.identifier, func(t *testing.T) {
+			text, ok := spdx.Text(tt.identifier)
+			if !ok {
+				t.Fatalf("expected text for %s", tt.identifier)
+			}
+			if !strings.Contains(text, tt.contains) {
+				t.Errorf("expected text for %s to contain %q", tt.identifier, tt.contains)
+			}
+		})
+	}
+	if _, ok := spdx.Text("not-a-license"); ok {
+		t.Error("expected no text for unknown identifier")
+	}
+}
+
+func TestIdentifiersAreKnown(t *testing.T) {
+	for _, id := range spdx.Identifiers() {
+		if _, err := diligent.GetLicenseFromIdentifier(id); err != nil {
+			t.Errorf("bundled text for unknown license %s", id)
+		}
+	}
+}
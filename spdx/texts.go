@@ -0,0 +1,6420 @@
+// Code generated by generate.go. DO NOT EDIT.
+
+package spdx
+
+// bundledTexts is a base64 encoded, gzipped tar of license texts
+const bundledTexts = `H4sIAAAAAAAC/+z963LbyJYuivbvfIoMxd6rpA6YU5Jvs8odfTYtQTZ7SqSapMrlvWLFOikyKWEWiGQDoFXs5zkRJ9Zr7PViO8YlbwBISXZVzZqz6R9VtgQk
+8jpyXL7xjeP3k/Ne/Uv9T7/hn+Pj4+M3r17h/4+Pj5v/Pzk9db+jn58cv3r5+p/k8T/9Dn/WVa3Kfzo+/jUGGQ7u7+TPmVltyuzuvpaHZ0fy9Pj4jbzdyLG5
+lZeqmOd6I/+lNLf/V07/6BW6/lchrnW5zKoqM4WsjVxXOpEzs9okcmnm2WKTSFXM/2RKOc+qusxu17WW9X1Wycos6gdVarkwpVTFRq7W5cpUWjxk9b00pYT/
+m3UtF1rLrJL3utS3G3lXqqLW854Q04+pnIwupp/641QOJvJ6PPpxcJ6ey4P+RA4mB7I/PJfwUP9m+nE0lueDydllf3A1kf3LS/mpPx73h9NBOpGfBtOPYpx+
+6I/P5XQkpx8Hk6Dh4dnlzflg+AHfGlxdXw7S8/Dt0YW8SsdnH/vDaf/94HIw/SzgwxeD6TCdTHpyMJTDkUx/TIdTOfkIjQR9ep/Ky0H//WUqL0Zj2R9+lpPr
+9GzQv0zk+WCcnk0TMRjy3+RoLM9Gw0n67zfpcDroX8rz/lX/A3SBXrX//PSxP52M0h/TsRynk5vLKfT+Yjy6EpejCXb4ZpIm8rw/7cOr1+PRxWA6SeSnj+n0
+YzqGHveHsn82HYyG8PTZaDgd96EHw/TD5eBDOjxLxWgsR/j0dDSeDkY3E34hkf3xYAJfHN1M4e0RNng2Gg5TahGmG+fgZpLK0Vhcp+OL0fiqPzxL4flo+nvi
+n/Z/frc//f7lby3+H5P/r96+asn/l69f7eX/7/GnX5OEzkwh+1W1LlUx0/Iym+mi0jK4HWZ4O5zC7cCCDIRIOpkMRsP+pRycg4C6GJz14cCLf5Y340t5cD0e
+XY2m9MTkcvShPyShhw18N4mbuAaBMzhLD4To57kcw3crOdaVLr/ouexPp+PB+xtoTfYnk5sxSo/LwVk6nKTyUM3VqtZzuSjNUtb3Wpoyu8sKlYv3k3OZ04CO
+hBhrdyvBmFUxhwtMZoWszLqcafzJbVaocgPX1LJKZPN2wlsumyloIBGq1HIFF2INn1+V5ks213NZ36sa+zEzxTyDRyt5q3PzIOGFpa57cnqvq/D3otT/sc5K
+LRV8Qle1VMHq1Eb+C03cv8pDaPigv67vTXlwlMiHeyPvzUpX9NmsrsSqNEsDL6pcflH5Wsul2sh7na/kX9dVnS022DsYUaWKeSXNQs5Nnquygskw9b0uH7JK
+y9ssz9VtrkWdLWGevugKBpoV8qHM6qy4o7sdpg1fkotS63wj1ReV4YuJNCtdvODptTpAT4iTnoxXAzvBz83MXCf4lXuTw2rKlSpr/Myu9RA0NWdmrmFiluuq
+hjVZZoUu6nwDKskqVxvq84frDy+q7K6A5dK/1PC1L7rMFhmOF1a/J8RpZy/hK/AReCfYLfTBWy3VbGaWK1Vkei5v6XOi43OgBM3NbL3URa3shkykVrN7ifMN
+3yl1tc5xpvUverausXer0tyVailNKZW0/5jrlS7musCdV2pTyKySuVoXs3s9T6TyU+Em4lD37nqJgH9U97KalVoX0OitKgpdYkeP7IBpw31XRfsyK2DgdBjk
+w302uxdZMcvXc139IITEP4fqSA7VUsvDA9rCB0eJ+93tkbwuzUKjQqlymcEA3ILKw4MdggbWWBVz19bsCAXP4cHN+PLgqCfEy54c6gz3JYyggE4UrH3WpZrr
+pSp/jseHB+VWg1iYy9pIXcxNWWkBWxAPlYb/z9ezupJzXWZfvNQJdVy7O6uVnsFo5KrMYNOWWV3rQqycDt0T4lVP3lS6rFA2wOhLOEGlrlamqDI8Q7XBLupf
+Zvka3mr0Gc6FKjaCjuBKl5UpqgQVbdiIeYZyHU/O4cmRLPXdOle0mytdwxY1DwX0ANqaL7MCNryqTVkJs5B6ucrNRs8lCKgVbNZEHp4esVhFGaDLJR4MmFjq
+hJ0JXCF5+PJI5Gam8ujbpb5T5RwaQCuCNg7JFFPp4NfZcmXKOpH6F/y/sHIbOlfMys0K2guFC+qUF+N0i8Hw/nOok4fmA6jV6U/X43SCWjar/8Kr/4k3EBL5
+/mYqh6OpvBxcDaYpGBMJNvxsq4EuRnndH08HZzeX/bG8vhlfjyaphK5bQyY932JdCB4J2wWov8Nt2WlwWOvC2xmD4RkerP5lIpw5kv6UXl1f9sefd9ghh7vn
+QqQXF+nZlCyQIfVx8H+n59DgVf9ycIZmxDCdfhqN/yL7Z2fpZPIOVunsZpxewQhHF3Jy834yHUxvpqn4MBqd47JM0vGPg7N08k62DJwksHDewd/f30wGOMWD
+4TQdj2+uQXIciY+jT2gwnfVvJuk5rj2oFkPcGaPxZ2j0csBLFZlK3jqaTMeDs6kIHhuReRRMTGBCSWtCfRpM0iNnNg3os5/6nwVbUM5WahhHCa67HFzI/vmP
+A+g2P3w9mkwG1AeasrOPgtZotz3VP59c/o39P8dvXx+3/D9vX+71/9/jzzS6s2ao0VRyqebaK3BOPNor7UGVpSrqTDuJ/3NWzHvis1nj5UnOINYwrFcIb4FS
+Rz4h3fg039S3G5HVlc4XeBtVcC2YcmVKRYpnbeTGrEt85V1D496YtZwbWZhalnppvmiBfqbSrMpM16CmFabOZrrqyc/QBt8hdec0UHslXI2yzKqf8daBLzyo
+7IvGgc9ylS2lulNZUdWyv1T/aQp5nt1ltcrlRJdf4FuJHBSzHgwmqyupFossz1StK/J7wTWvZ7W0w9rapZ61wt5s/ZDo/tB2GdC/uHxx0jv5TUXA7vN/cnL8
+5k3z/L95fbI//7/Hn/4MdOBsJi9K7ex+IX7UJSqZJ70TMFNl52NSrVZ5pitZG1L72OKWD4Z0aoW6aXWfrdgsG9knPpny54MjsO0qTZon27TUNFi18l5VcpWr
+GR5tLRcmz81DVtwJOsEyWy71HLZ3vvG/ZJPbui340YUh9T/6/g9C8Of0XK6LOZsI3UP94iekdyDEB5B/MET+fU/ajkdOazc1pJBLc1urrIBuKuykNAvR6pc8
+PPhs1gdHUsFE5vMXDxmYwqXZqLzevADzOpGFKV6wLfBFJ9D+StdrlScCflOtb9njgfI7t8sKqn+3x36pyztoB16s7rFBMOuS0IfPXv1K57mYmRWL/3b/QUqi
+YaRqEJOwGyo2SBdsroHxQFO+UrUuapKjFW6FuUSjpahLk+fWfNZ+glHOo6G0vDXzTM9FVnT1opKLdVlk1X27DTSnlupnndBcwJCwY2ax0CVul0rl+tljq9a3
+fyVRLqItGzh5ekKgZwumLnC+bXUyxJ9H90Kpyf7UQuV5a7Oj++a503Fr6vvuaeSLGMa9zV8Bm4JMvqWqdZmpvBLuVsYrDpoNR9c9uKwIHByS3S+pNXgrMrLt
+gcQT2DbuK38oYVyFCX4lWFnBvQU9MWVl7eqoJ4lzEVA/s9L7CtBCrui+FfiDZIvDQD7NYWC/LHAOrIqlf1mVuqpip4H0TgM7g3aoPWEt1M9oyTiDcTzpsZN0
+NLbW6EROP/anaDecja4/jwcfPk7JCOFoGMR6Bh8G4G9Bw2wwkaNPQ282uwZHY99U65XzwYSM0PRcvP/sX7oZnqdg6/7Yvxycy7Ob8RgMPevJhahVo2vw7XFP
+pj+dpddT0Z9Y+/zys5xM+2BqDoZsdV+l54P+NL38LK/H6VmaogE2SYdTML+Sdj9F6BSgjqHBZXuDFqFTgN/3JwOImg2mH8FQszOeyHQAVp1o+w0iZ4F9Dw3k
+PoXOoEdu5UYXcjgavhgML8aD4Qc0gNFHEHgRtk+3dy5cwm/BsdByKgh2KvSwCbDlx6kcDyZ/kf0JL73895u+NSM7vwOjkJ9HNz3ysfidBm+4oYC7gIz2Ccxg
+Opmw5wA64+xanuaeGI7s36EbrW9+gEbTc/kxHae0SrQZwiXzHekJ4ac4MuN7/MJwJM8G47Obq8kUwggT3PruV5fph/4lewESYQ3/7Wb9URK4BEITPwnCv3b7
+C++PmY7Q7r9Ox5PRcId3xnlkAjcNhFG3hIYvyAX0sQ8dSsfOzdCfyD6Hh5sLIEbj2OfQWvfdu9h++2I0dhFn8NV8GlxeJtTCZDq6vu5/SGGurq5voGMX/cHl
+zThlZ9DFzZDDyTwR/eG5gNnD6YS3YIc3guDwsXTi3SLg+LATSnP/sf9jKt+n6VAMhhB03ukzsU337Ox07iJBLYOvq399ffkZFtL9EtfxPO1PP6IfCrsCfuvh
+v92MPzei8/hNASfi83eTYEPZo5j+NE2H9JHBGW6Zy/4nkFcfB+8H0wl12XeyJyajq1T+2814MDkf4GRO5PmIOnp5OfrEjZ5d3kxwTOPGCP3+Ets8fomcMF7C
+twOHJ2joqv85mhuBwkLYm7s2ckIxnjM0asG+qHW5lAfBjw/kUqsCVSu5KvVCl6WeU4ilU2deoN/+Z9C1wnAQ3u8ZhY1AWfI+jUiLEXNdzcrsFl6/Nw/wkprN
+4P6FF9l/0fpoW+lXd6XWlaiN9UpIJZdqdp8V+kWp1Ry/zIo/theMuFMnEio3xR0pURgUCl+OniS12HXIq+2V76YoKZhK00pKY21kpeqsWnBMzNzm2R1OCmiJ
+YH+RudIchtg2DNQSw6iQLPXKVFltyo0stapMoW7zjZypfAZxAD3H+cIIqswK/ctKFxW5V+ZyZoovush0UdsFud2A4wbXW1USZ0dVfoSg22XFmozShq+poeNh
+oHdjTR5rO6r5HBUv3GWq3jYQGKV8uiFqbVZsMjCb23tKiKs1GHJyCjNS0IdhtNdkKPVnZC6g484eqeoeNnfNb2gwvA30eabyfINeK+uaKwzOmS5B1S5nWaUD
+PZe2RGUBV9BBeI/jl/ZrIlvQEmS5lkrm6qFaZy6aOTPrsoazpu9oFmDAxUaOJgN5pss6W2R6jhFhjvQK5+Oi+a5kHhvl8Db/CFV3tqCxSwftyRKtyToA65KC
+/IsyK+50RTZ5ZHl6q7KqdFFnKpe1EfAW/iaMLN37PkJ3WxC6U3mpHkpdzLRMe3JsKl30JEAaeHr5FM57EZKuhXeTtaHjjjZnA0pnv98VA+/FXaRlx6g0PaXn
+7jUKKZKp0WlkgCPWb2Ny1ewRWv84f8j/e/o39f++Pj1t+X9fvd37f/8Q/t9Tumn+QR3AX+H/Pf079f7K5zt/RbfzV3618xdVkf/Svt8Qb0kIxx7pZuTYhQ2S
+WAduwziAnXTuPi8+8edVjQ3MSq1qncjIJZzYKTaBB9P5iS1UUjxmhSQwoQ84T6Q62RbMIoAAwRzCL8mWWuEcEc6NAV10jkudFdSOPAhnY4ht9g7cdIiZIvUr
+ngmbO+CnQnZOBapPqiw3UgkPe2t/sdsmgbdJ9ZelnmWrTON5vlc1Rrnv1ZdAm+rS4vd+6yf7rQlNQHrueVahONAlPGl/FQhYxh5UIcDWGlkZ4Xm7pkZmVsA0
+RYqVKuErwr7iBSC+6F5iywSQtdlcztZlCZvLSlp7sIKukeIs019melXD9ueJyjeyqhnXgK+El9qqNDOtEf8GNglYFInoHJoLt9h7LDAOMUgTu7BxmtiPJ7wX
+m/ekXUNTygygg3oeIPMSt9R5tsxqxn1CpzwqBDZs04+dNMFv0Pxj2Lee+BXc1KTAeO+wCN3UM1NUdVaDnwRmyRt/iDY2i2gqe3Jo3CLXpr1drOUGUo5WQtOC
+B8sydzscfWJ2Dun8q9ssz2C/3+ALhZGzrJytl1Wtipkmd9Ta/irXd2Cl3mtTbhLxcK9x9WAfmbKWh27JZKHv8uwOts9RQlJEzepEmtLDvBPrQ7jXwu3yWy1z
+xEI3FBkrgudZqaGhrLB/Q7CryuFHMxT7oJPQlV7p/1jz3M7VUt158NDsXkGH4ECVGV0llVQMe26ugWDJ6FAy7Sjizp1qv70wpcgNOnrknTHzhyzPE1Jcq9qs
+VuoO1aTlag0dW6gsX5coGpcqX6yLGbXGE4FXX57TdMJbS13OopGWEj6mK96NebTuuV13WgTQb1G13sjaBL+EaZ9rReB3WgqVy6z467rc8GzB5AXXDYC1hV99
+DyJGpQA+kc1IR1QPIEPus9usrmS1nt0HXezJiVlq+dd1mVXzbEa5CgyyUqDlNJHJZWN8fjPIbXshEZWhdfbtwGYPGrJ+DDczn836786t/FxfsvS+ZPGNvmTp
+fcni233J1otViW/3JUfW0Lf5kqXzJYtv9SVL50sW3+xLlk1fsvh6X7Ls8CWLvS/579KXPLbn5ga0Czux1uxpXmwoax7UpkI56O5uOIp1mc0glGJKb2/qeXO6
+MbFnA9NMW9ZtdTSQKk0No+lT63KhS5+afavDfBQyk+GaWFfaHqS9b/zvzzcO/t/T3vHf0P97evLybQf+983e//t7/NkK7hXiS0+e9o53uX9Dj60+OLKXUdMd
+LNruYPl17mDR5Q6WX+UOFr+KO/i0dyzEyZF0HmEv8B7zDYOw3+HjFVt9vHKLf7c2cm7iqXEJmApdvw442n27kFv3nRC3R6R26pUqddvleXjQ9L0dHMlbhfO2
+MkXbk/JOiNlRIyD/mA+57d6jsaGKNXtnBzY/Yo0Pdfh2U/R4vnkXpolq2xlOBd72EmT/BmvLisDvvbDJdm+5eKa3/HFosHiit1w+xVsumosICbnBhIaqvpvV
+p9ttgu02+e12m4hBzd9gt4mW3fYNGCDRsNu+HgMkWnbbHgP0B8YAvTqSQQDhYh9A2B5AkENT48plhajbhuwtXGB6SR1ECY3jY3OjNsEAE79WVSJI3FYJPSAr
+PSt1XVmPn2Ffa63zXM/QboQkQ13Wm3Ah2P8ruhz+FAqD/lvRHhhOgZ3TkL4g80n41obEMUl3kF7Opdq4K3inKJL+1iYW/Ou5XmQFXQ4TjVauPMVukXSIewMN
+hHvCj1XoL7qQ2YLsQvq1KjU7ZLdcPuHyxZ4Bt3xoi65Kbb077Kb0c4xbysch6bacZzBHMAlITSD8vnPghWIT96UhPr19/WDW+ZxifoomRdQuENBDreL1ESnK
+FU8gdLqokdAi30hD7DA9Id4cyX0I+L9ICPjtkQtgovpYmi+6QB6O50c6RWzH7Ip04i/utV33hh+OZl+EwUw4pFtjo/BLr5c6P54zkIRj/ogOl0v7akvu4BY6
+rJhbxlTB6Cr0Mkbd//rIqXCRU/lrRE5FEDmVv0LkVMSRU/mtkdNfOcEnjpzKr4+ciu7IqfzqyOmfj+Q+eLoPnu6Dp98UPP3+SPZncM7ctRREP3pyQNGZp7lt
+YHOp5p2ceNUG9Fip/F2rpV4sTOmPuI7Pq6gNQyYjV7xCQQM9CG9lVky77iKnYgqdV1reruvo1/IQ9yHtPmtvoBEPGrW81fWD1kVwW1IE7EgE7p5VRIjLykdL
+O9niInOTV5unBNICLf2Eb/PE0n9JVdd6uUL1dG5kZZpSdMsU7Z4DsXUOZBZex/YMkCJx05v0Ao0FY04gIIFC7IvK0d5RD9gVe/LXRV1munJmOWr+tBdBVsO0
+btBBVOqFKXUCWgzPGGmfjwQfO6eOfjHPZqrWAjlhlD8RzXmyjiM4l3XFk8lOCg/yPDk++gOHXJU7vnP4GDyCQpckgFQsnf0NqOSsNFX1Ai9fEkJrWBn8d4Ix
+UlbWbFAUT85hduSIcbxS22C8UfZVL09h87o4rinlYRY0BKMF4VdvntpUB8L6Fv0Mdngzs7zlldrij4YviZjUDjp2r8o5/B14Bk9OjuS/BTI+kT/qYk0b5oP5
+okuMM1+qh57swzmd1SzsMd5d6lzh9YPg4WBnsIPltjRrDDEW+caquxgcJzUgul3kAxtYkTZS6iqb012KnJrZ7D4COpCTBvb0qsyWqtzI23WVFbri04gCBJVV
+e2jR1oo+7KeUAqPFIs9m9QuzeME35JcM/Vl4hAWvUkgHcVOg8BjyWpyh448eKOQZq16VA/MPQvEgJirH8/rBmHkVCybqmJ7T3G9Vfsy6hknCUVYzs2off1NK
+tah16Q6/PeHOWeFR4Dj/zOhKvhm0anSh8jq4QlFM+qhRHxTBk7f447Oe/H/+f/Lk+ETqWlb6P3pkLTxVgG6Tnt5LwT6Wal1+QUv9XkeDaunw4uQU3RemLPSm
+khcaVLIBYTV4R6MXcGHKmd5x25hSVFqjd97qeP4EwO6tTWJd/eChh5+DVbHx84wyIEchJ0o9M194UWam4pkmJ7KuAknWrdR6hUQoO7bv5EJrK9mhxaxAkO+c
+4mRFwdcItEcaX0tsoiNErVZa4XURPBQvgnzSIohgEV4eyausmuk8V4U266pxm5Qa9j07KzTRf+paBzrNzBQzkki013nPLlVda4pjmAUqf8ydRue2tYyIysjx
+nrnVYl3wwhPnL47Xv+sWDq9ucMeiLIt16kKDe1+VG8FuT5nVMmgVBv/qSJ6DvzKzs4PpMrAs00jnox/X9yg5TUPnSyQbfCIr5Hq10iUZGQ9wihT8nsJNGMSY
+Z1+y+ZrUb8V2I19BgfLBnguUkwlvnOUq32Q2dMNqQ3gqkoaeemHKsH04ycIOjxh1w/sPBTBH/2CXV3EoEO2rrGLVD254Uwh+gnq0MWv6Jpdh8Ad17mY4kQf8
+jg3BwbUOom0Fs5WwGUv3ijVk0e1mHXf0Q77tlqpQrB7YM0Gj8Sb47cZZ2Q0j2+oCxFR7n62gjUW2qNG2nqHO8fr4/zwS8CFTOikPcr1WBeHo71WpK9tWdiRv
+daEXGdqbUbtB32DbvT6SvwpWS3islvxGrJZoYrXkr4zVernHav3D/yH818nfFv/1+u0e//VHxH+d9k5+BfgX+gXFrwH/4mxg8SvAv9owi6+Df53s4V97+Nce
+/rWHf+3hX3v41x7+tYd/7eFfe/jXHv61h3/t4V97+Nce/rWHf+3hX3v41x7+tYd/7eFfe/jXPyT8qwXxMhHNhm7wd3RdOsykISyCi+cnhEE0kQRemNGFyv12
+HxNdiLTbzRPBXo0KpiIAe+2xXnus12+E9fr/77Fee6zXHuu1x3rtsV57rNfvjfV6cXp8/GoP+PqviP96+bfl/3p1/PZVG//1eo//+j3+bEF2HfQvLg+O5Jee
+fPksCjDx9IoQj2PAxJMrQgQxKI6yq/lfFd4SrJz/dhUhXj6HAqyNEXoCRMjFX2JYkB3BfF1GllUQ+6uN6MCEPQMN5kIbAELRXI7deviVmBkMf1tsmMOO1aUq
+qpyikXO1qiEoWYMGgb+gwsK2+AS0WZaquNNt7EtCJgKoCaUmm+Fr4GgiGl0XHM2Q1rQu0A/0DHSa6ECnJR1ko2bBNevvjQOBzA0TbpIOBKa18Cpj7FYKHTLe
+P+1uZHtDK6yxD4Ew0CdtTL1zA/+xMHS/5sF4YnER8Svg5ThkKjzyZtup9HiSFqQjaaDpEkHBZQUTgNb9cmXK+olIyX9okN2+wOLfDbpuD2NzMLZd8LUOYIOI
+EGIwmk6IlJWZ4TVTm/B28KNORIBtk1+NbYNYmngOWq0l2mQg2kwpWLJ9LYRNxhA20YKwdXdyO4hNMohN/CogNg5JfzWIjRQBD2WDh8RvBGJ7DWcVnci5PNer
+3GyWHImxV0XHr8MrA9c8LPKfiEir2lr235TtWCkTwD+oDXozxJbQUder4WmGMgrFBjVXt4EgyOd8gow9ejB2ocOCP1H37c6Bxwk/UKHvCQ6Qu+IY1RaGO2Dl
+izkVmaBwBLivlSx0/YB7pw+vCCswnMbnFAk+5Q5opz1IJoiuo8sfBis6lsnK9G3T3wrb4zhEuJi8I60f++RwdvS3hDMmezzjHs/4XwnPqGd/p3BGMNnCIDxW
+m5zdq6K20Cy4/bIaws90EtC/kMGuLQVHK/7gcMbOpbA7tOnqbwEbxd83sNEUXoLtBjO2AGziW8CMMgIzim8BM8oWmFF8PZhRdoEZxVeBGWPIng+eiiejD58A
+8UskKQ6yzpaaNAovkghtR6H3OKhKzhL8tcNPVezjyrUikFhWlvqLoY56WJX4GlhVBxgRrdGnOe2egE0Uz8AmykewieLJ2MQomu+HSxPJ10dtqB81RMmhLQUu
+V4wZyzyrmqjA0FXom/HIRPEsZCJ5oOYGWquMO0n3pjAlgwqqbpcl30cBJjCCA7ZxK8LiVraZU1nl1enaSLVY6FkdtEqiFb4tYvxrFQq+g4XKUBU/kKbkf821
+AuTJwVEXNE94aF54KX8tME90A/No/nHBrJCpDc2z1TPcxLrVFqyI7yGHe8jhHnK4hxxGkEMvGD1DI0wVr7BagVuvBGkWTTQrys+CrG3DDX63Bw7+YYCDz8P4
+yRjjJ74J4yf3GL89xm+P8fttMX4nb47kVRCIhVFPtxsbEQrw//lfAAJ87fB/gsF/Mcyvge8L3P06aUIoIgWwE+y3Vc2nUCWBAcKwcKxREtJoi9OQ0qlaoXH5
+0TzoL85xrjbcOMMufqk7M36aY20OFXV26zoVFhdE4KUr/rEMaLKKOeuRGa43KX4RUqqKoVIivvYD3JM3Cn7Ag+y1dm+Vh8EU9L1if5sdg/XFHneCRchUAlAY
+dn9Dn8EdSwfGVDaAy9oF/MOhXpofe0cHP4gTIKoLl4HxWehAWgQgjEVWVjXc8OquVKt7qW7Nl8Axy69ZKNqcJLf8l6yodFlTN6zLDvsGKsS/4rhInNsWbJ/N
+Q2GrCAsG6SxAZ70DT222zHJV2vXgNxv7GPEyLJWCdSHaDWqaPhSvPLqvfUljZxHIdZHrqoon1N74iIy71bogre4LiZ8RNMORhgHIfzoLh6PJ4MiZr3St4SQg
+ZjZ3qQVfMk1W+oxqLc+cXQTX+h5M+4/wp//h+vLFyW8LAN6N/3355u3JcRP/+/btqz3+9/f407+4SMcj+SEdpuP+pby+eX85OJOXg7N0OEmF+JEvs5NEXqly
+do8lvIVoKg6nsg8YBSMHxQywBCeIGS7nclKXWtfyhZyss1rL09PXiZyoQl6Uqphl1cwk8qwvv391cvw2kTeTfjtTQfmgpL1ZWQ38MLyRH3ShS5XLawzpBY5U
+7p+A9IaT7//8fSJPvv/+hO60iZWoF2ZdzNnAg55TNN9eKVkI3OkJByw5nB8F4nY+twoRWJds/DuZjT9UPnxgo/5CpF90ibGTrKLP1DsyJ77o8lbV2TLycft5
+csi6BNPbIbp2R34SaN1RDVDiRqnVEsxYMfVAGnJ6LE3li9MTGkJX2V1B/ULvswIlgnyUpdZzs4TfoA6OTkP8spZZ3ZPvrdpf1WSm8w7ZsmKBa1fURt6tFeqa
+uvtjMvgY/M71+sULa2lW65JdLfwr+AQ+C0MFZT6rK1iv0oZiGj0KkOwwMRC0oCF8VwWTRFFrD5taleauVEuGsbMKh9oiKXuoQQiYoEMkhqC3eG7CruJ+8jFs
+2OyX2W0JxnQ8hU4FyIqq1mreI21jpopIy8R55N4hCtX0hPh0rwv5gMqWQnh+NJkJ/Aq6Q2BQciDatUBYmlyVgHDA+RPbVzbcRm5xnAZE0ANU8ahpsY0qNOqd
+PORNUN5ZNH9WWWifzFCLEw9ZdX+U+E+VeqZBBWLdaob4kBIn607XeGDwRfmgCvinf1XAM7zlom3FsJ5Vphk/Ao0UstAP1F875+8YyUAKuPy5MA/4JWiXQPqo
+SGfFHUB4pwgSq/WsDkNBuCKFDmbSmp8EvIW+Lkx5m81tZBYyAHSBZ5Y/QS2xhaSqn+lXBtak1C7cZXEKU3on+Ipw4H54a6ZLDIM5+zTLKTAFKwIt83x2rWd0
+PMm9QQ+zxZfVPSEukEVPQV5rsqsx9p3Z6fYIr7tS1RmMFwvVKLnQOpEba3ncWXdaELHjkLedqWifoqNAeEITv5Pre73Bc5W4XRbsrPo+2nTgKkYzipqq7okI
+ZinDcBqyuugNbRW6kHhZhPiku/YH310PRla1XoFNeHLkL0MZzzdaJ6dHghCatEHCBD104sP8oM3BjqwG1UnDMoZL4E+mDJHc9ns9Ifp5ZQggj0hrEo7fVXYg
+lrbGrEva6HgG7Ua3Gw0PkLaXJxp56Ayq3CqQ/CyMBaJsvHSIBAh4RFs3hFM2bjeyMksNH0HeGMLwVBV6On33sroKtg5ILl4tVcsHuy1IwvFVDF+0Jl9Ca8wB
++FVpbnO9xJuQUnMwRwGvCFhajkGVepHDupsiasteNt/JUq/WHPeEA5QhCWGCnwglEln8GBIG2YwIGFXU+YbGznPCWOGe/KRRmqLU+GKyOcfzMDSIQyi12wim
+5MhR9D0cgfe/5hvBMfQYUYzOeAjAU576z5ZJ1TZDUQtdqxIoDgz6/3VRY2QLF8ZBjGVWMxjBzzF9Ck/dbQAkXhD2F3fVd0AXqRlZUuKcu+cU6lI90p5WsPLb
+ot8L9BKtNhhSiKCU8GDoCRPkz4FG0/HVRPaH5/JsNDwfTAejIXG8nY2uPw+GHxKAL03Hg/c38Ct88Gp0PrgYnPXhB0IcN5x8jXQ8O4XWD0tpeXTSQVuDsKrz
+hAjOtGMNxIuRe5MjFlRtWMnkMGKInW0wDjnIx04tEK8beXBNvTxI5K3OzUNC+ocdhItE2JE8OJSCkgfwD8Yz8PmwrbG7O0g44N8Iy8gWZ5Y5R3uAWfjBeoQo
+qwMOFT3Lk2dZFq5975RcmdKRedUOXOdUeuuAinNoSJD6uxahEsaRNOWquFurO92Thx8xHItxw8Ql3rHD1sHW2QErArhRVrjlkQfhxw96RzJViCPicHpWWU5k
+OAGVPNiY9QEIdI9DCQD6T9v0qFiSXw0VXdFwl78jyalK8vdneJorCpzSPsGAwkKW66KwIsKuKIta75tPHIzUrOvVunZIYftKqHJTGBxWq8IACyoFHiBo17xr
+lwHOZK5XoEdRSORefYHOga0oUCTdbrp6fNSTnxwinXdYudaS2qqkKdxt4gY5NxrZpnzs4smmo2vkuyrUSoSiq97qLxnyE+LhANjLepl4gKnTimBiVtlsbdYV
+g2KCwHK+EUyuDUPwCVaqCJ9qV2jgQQSIYnuZvxM/a72C0wDrb1U1eq2yFxHTI7c8+fgzLdVtpRmHRo5xbhqfuaPUKGvQBXphPHVo1q82zdhrSNcePN0Twq0S
+mSyoiTqwwep+UwH6xu5qSpRlq0uxoqWszqg20nmPVyxcYMx2JKFKlSFygm1lqwFDWmavEQSh9mhMZfd2sUCbWKSJ+n6N992SOrv1dCQunNLcpZRuE0pAYcpQ
+DEc4Ac/ihkGArl2p8srIpda4Rej20RHCjvKgXQTCxzedBrjIcl15zL1H3NvNVtUWjcA2Hc0y56bj21bqIO7Jo1yLO1iB29b3cTe6LJ5NE+5oierZKkWkjck1
+JlIVhFR2tzgFixv5au2FVCXrzBi9RnIfr+tUUvEXVI2wY9q5teFzl5VzbAE2yhaKwQCSMDuSg0U8xfYWL0y5BIVQllrNyV2CKj2GQhHtCIC7h3tdgPBMxCae
+NpBT+MuqViXclVbCukhp0BCqdXz5kWOnnGcF+FUeFBpwclVmRJht05tBWhWFWRczCnUHQLVIjolOOeYiS9sNlENTopWRWKXKbSmf5mofPnJ+BPJe4QkOdG8d
+KcsOWBVtfr4OdZ7bewiasnm4FPeJpJvH9xymFmT5g8xi4ZDVlc4X1pVnpxs8ki7T3S00zjGpco3ZTUgObb9i3eWO2Ke5WzHRaKh3JMTc7Tn7dnzFzdlHGsJK
+bedJhtMcM+5EtF25OJPZwuU4WSd1+BGyvXCK3cwUd6J5R8gHVeH1Qy2ZFYjZdYHYEIPj1ZjppYoqsMFxq2Dbravd0YEFd3zg+6BZXJovvDUXaobgcyLLj6La
+Td/7dax+b5f25N+g20kVIZ9dODzrkqXJDubIAVtKp/bwUnR41cNZckDdeL5uN/LjdHrNwxBdE+QCwO2xW6upYSmQNVhpuyUZjhfi9l1bDza7jgQrorJsNhmi
+yxjw5TGQ9AYry9vkOd+sqiCsiE3/AvUVVGfCpHnFFB6uNMTSa5v8SNt3Wen8C1jg9b2Oo9kJOvgdPD+RlsTYD9NUWrruozxu3GDwAakqEX+6J9+v623Py0ot
+g1ZD9hGaQUHWK0aKdmzF+l7H9ghvZGzDugOEKbYDEhP26XsPGNn4bJDRQ7oi7NvcVp6GlS35M9YIWaNWQT6wYk5uBxpWqe9UOc+p+Ay8JB9KU2uBntDp/bpK
+WF5ZRxLKLY9a4XmC5gll4J29aMhUpDQKX/6EHABMoEWdJQfQxqzfyVLB4JLwU2T7Wiy4iHgOLBqta7KDM4O9iYhjOAVBxKvWE2KAETZGri11qaW6u4NZss06
+1mJDaaDdVwaCGyIzzcM+tm6aI/i3kl9MviYoi5JVbUp1h+DsaHxkHflr7ra092rQO68hRejfQEN6uduUaw6g2XdQg+gjNjvg9EhmhTQEHrLBDv2Lnq1rxUiy
+5Q4FuwINu5jLU9KzxRY1e24kplgvupiG+jMQspgcVnuryAnemSkpdIDqVIvKI7y4+KTzWd3lbdo+BFpSWi4xW1e1WaoyyzeULo7qonMGY8IB6urvpCkT1Nfb
+o1Hu9OAll8gvKs+oKVXLXKuqRi+rlhutSuJ/gTsekb9ehd6QY1xZHbswBHlEjwobZniROksx31jOHswLpYmK8u+l8vO8kyQlWgQRzjljgb92roWda/ncuZ5t
+2zkh70mkyCmXauXKI/lui3ifRZGYQ/SYIkFVQQIqq2zAnHxBC/QCF2E6X9O1ZX1GUZdCRXzXOSSSnQAm7jZTVkg1m5mSPHhysr61Iv6WJhmU3GkcW5ILLxs6
+GH1kyOjzEBP5iA4iH4IUqyLoL7tcO7Un+iJmTXV1K89BF1qDbZx5azWRq3xNYGhVVWaWWY+nLheAC/T4ZbKr+XmSpWW2okj+PLyDoHNZrtwCZRDdyOkH7A8I
+RhQgQ1E54/RVn1GWNCN44ZkQGJItjLUM0ZCv710CUlZ5wyd4DZyF1h3MLZtS3JIxCqt05Lf9Uv2VUKcrU6B6eUgDhB7/rMtC56RdVEaa4sjqt2alS/JRVJuq
+1kvyI2YzajNY0HINi8AIQwai86fYrBOKj2Omq8bbcE8vWhd+0Lopo72fVdK6QnGboyFN5EO1QRua9FvFwAHcDBiMYL3UvRWYE64Bkmww+mjzWYUZ9UlsjJLK
+UKfs0gyjq0eTUYaGR+z38G7s5UrneYDhCRrxPkE8FcFkAEtSL4LtkqvPsuN5roJERqxTkTbQVWZuBxUAJ4lRsYiAI6Y28deF//qOj2eVhHgg7T+M8UXZhD7L
+UISR6q5euTNoJxc0YIzmOZHKtITCeEiEtVY7mvRBU4sxyUqPeXIdm4vKOKYrCi9xB0q9VBn6UBfrnIRKnqlihow5tG52A4T+CMp1bpgPVVbMtAe8wDsEi0E4
+kBu7ZXvCqDOTv2xaRUZohdqrIgLLPLOEVQFdokV0KGtQlbqRpIsZYoSesSZeezRhGQ54iboD3Q571AzLHLLXcbvKTd4Jh1zf7K7H4eqcJC6FfFv++LZwLPXY
+dV90ODeqUMMG0psehcfqbKn5vt+lpO8er4iwJ42Tw7u+kspuWi/HXNg/t8xsHXgMGWMxbL/idIItAeyeiJIJlivDDurFuuTISAA94nG5kVTf+XxhRvp0Mrc0
+z1Cc5UK5YpToYE8eBwoDEdyI9ABVDRMbVFJ5xokZG3GY2Sr/up7fLbkwImbwOpOyI40XxM0iokcjH4s8JHDAMrPEXvxuVa11dZQE04ljpFlEOxc2zmGUIwW9
+MiWlmzn6/jBj6ihIEUO2UPZhuU+0PBYYQuVTrH+ZrSvasG4TRe+K+IAPFhaeBm+HsRrDuralNayy5TqvKc0wpzCS8CyHXaLeFSxi0KQuawqtBK/xXa8qEa/h
+JtiVW84dgzRkEzummtx1RIoGLTE9Ykg3Gp7rtusTtiFFQZBA7N7Y02ojZ5Qnl2/CVDq4Ddl9uTFr52UDx1NOCTo45dgrO7u3pr63fJrx3YaP3Wpyey5KlXkP
+Drlwd3SfdLZGLC/yIdls0Kwgi9aUcUqoJ7kVnrAnosnA8clblXvZrcPmIy4HdCOCwzh8KIhAdT6fFbyDws9CUHwQeck4H7LLTZYVSIHMGEh75gOoEAYiHbaX
+KvySw8tSE5bW7NBVTdZ/RgxZ0AhqE/TkOxF9/F6xAWPyqIc2dMt3Jwz6ruQWLUhWOMs5WmJS8hPpXKJA24RyRPs61zO3+1foZMcJu8IBa7PKQ/jUnS50adaV
+57iyZiHajtlcSyJuDiDvIjRw7GZHuUW9kwgWJVEuDeZJV1kV1tTzu1HVgl56x27P9cp+nMBuf5oTPYaRcz2D7mQLiXejrO5xy4D+x8QHkQTj2bP986KIO0lx
+OweAYSFI4pvICInI2ZZW2LKrl8TYYvAr+YbBaA9sE97qPNNftE24VqJxVdGFWnXQT4k/92xcq+mJ+JMpQwqCQEkPMlWzwuIphSskZC3RW7fzOWPVBTVDk7xK
+Yi3EPSQYHQYSEc2sKupHx12AaIb5nFwMsAOyWt5pg1l+oAjFzpYAweQJISzjmquJZGGV0atZJXymheW6MKVUS1Pc+bdZbKyrgNhhUPBJVnStBniPrJiZcmVK
+VfNQgx6qCnakdRByhPDWzDviquL7XphEg7NioTOQiocBeVreQj/YmJQDaMS4OtHA4NOFny3xroD/9+QEhhO19JAxxwQnN2aFrFZZmdU2lsGlhuwblGwC/SSG
+UnhhrmuV5SiguYg+fMIBW4k/eKZLRKaiFs2NycyGPhUuWlbcrbkkv32iWC9vddlrBnRtoijoCfGjbRY/lIsBJpKv1QMkj1G1Lm0LB4QxcJkJDmXTqO7faWrw
+QbIBPG6SCrfL1qfsKpOIpnXkdKTGSG14QdCQN/KxASdOWZrdG6u+21fA2hRbv+3hSuji5/dR0wgEaTNE6lXQGH8Qfpc37LZMrO+qXYlddi5fSlPK++wOo1OB
+8R5OeJQndn3pORe6Fg1cHQh/ztDyChxLLrTZSLpC1iWnJltodCAN0D5pIagQxkl3TZQeIii6GAmshvXgiIATFCk6vgttOsfClCLIW0Ml2F14TjMIhXpj6YPW
+19U78aARCA9So6ILzdNsOWR9T47WJV7CFTGekCi5W6MHikny6wcj74zKK1JzkCrdHiSci6pW9Rp/C5q1d13gj2wemNc0YAXNgkm6bUOQH8bcrbLUzXy8O9pW
+WJdASjkcOQZI4FjqyffpWf9mkiKT5PV49GHcv5KDic2HPJcX4zQF3sizj/3xhzSB58YpPBG0BIBtETSQWKbK9KdpOpzK63R8NZhO03P5/rPsX19fDs767y9T
+edn/1JPpT2fp9VR++pgO5Qha/zSYpGIy7cPzg6H8NB5MB8MP2B6AwseDDx+n8uPo8jwdI3L8T6MxvUhVwdMJdOPHwXk0JmFZQJvcnzC2/vCz/MtgeJ7IdIAN
+pT9dj9MJDH80loOr68tBep7IwfDs8uYcQOni/c1UDkdTeTm4GkA/pyOcGfusbR06M7polTMHFDvXMxfNeubyGfXMoZ3rdHwxGl/1h2epGF2EQ46oQOXk4+jm
+8jz6PUxTKs/Ti/RsOvgxTeBB2Z9Mbq5SQbM9meL0XF7KYXqWTib98Wc5Scc/Ds5gFuQ4ve4PxhLR+uMxtDIaIh9SDxZuOJLpj7D8N8NLqNw+Tv/9ZjDu2gTQ
+Qv/DOMWJDNZcfBpcXuLqNBc+wVeGn4OF/yw/fRzJq/5nShD4bLfGOHUZBGm4SWE+/cbsvx/BDLxP5eUAuzUd4XTA8pz3r/of0kmwAeDTgjOIEzm5Ts8G8JfB
+8Gxwng6n/Uuak+Ek/fcbWML+pW1E9seDCbQAe5DX62aSCthnQ7s/piPZPJKH/tvtvScvRxPcaOf9aV9gj6d9+T6Fp8fp8Dwd41Hqn53djPtTYIzFN9KJnNxM
+pv3BkBYFxjsay+nHwfjcniWB2/OiP7i8GaeyscGmIzm6TrFJ3GjBgtATk6ME94AcXMjJzdlHQasnoxP7WX7sT+T7NB3K/vmPAzx1/J3RZDLgORlRC3Yee+Kb
+8v9f/g3z/0+OT09OX7fz/4/3+f+/xx9Qjp7IAfAScujl0HzRqGueHh+/DZkAuJDg28dy7P/lvq5X1Q9/+tOiWvRMefenf/2jJMPjXDyWpU45bQn2LNcL70sM
+AQ2kmqCS93OG+RsLCiUk1lwhB3+YGK0LzCWdGQ7aMnEcM6Uj21Dt2RkVKTYW+YhVZ8ow2fKx7H7XQfYCRfwzzbx/sT3vP0zFr+89pC9M/4f3ui1TW13Du0ye
+nvkvQEkMDQ+X/BuzAMis5jheIwE0ZAIQxATw66XEj9blloz49hT/ljnxeulS2n0uvPi2XHjpc+HFV+XCJx7DHubCi+5c+HP9RedwKioHew+3VGt6dyVGiyAx
+WlWVLkP+YA5itVKkZSNF2tGafUWKtNydIi0rDVYfMqTqQi+ymsCTC03IJYeS/s4djYynJVsi8xOhj5dqrkVWOFiTbnmMXKI8ofhdtBH9utWq1GpONV1u9cws
+tfBFThYBENqvTGT79uQVpVS63ze3qlCllvdalZR9DDOtCwiCqTufc0rM7xT/c2IxiJUzDa2Vhp4qi0OtsXismJ+RWkXnxoK9VTBCqW7Nuu65q2DLHWDZ6Tgz
+uYOfBsaS67q28WR2uzNCJasJhEh98hWj4B+lzrWqLMVteDBrEzTVe8Z95eRMdPH4+wa2DlYKdP5Ndu26YYXdoL1QBRVv2AfhbqieGDgsRMW+M1g49n41b6yg
+klsTYMVh49b0BumTpfs+ngnncqPGQ5CC4EVgh0B71RJaFVu3kFcLI9HUWsIHHbkyoxWtTUffZVff4YQXkhzkDtYDK8Jgwd2riRQEgcNOWK/Ng4o5VgiqBc85
+Zy76XTg5HLUYX1XKdgRZ7lTTe2a7dH1J+hT/+15VvFv1XKrQkWzXzb/IXid7cErdqnMVu9V+t9R69AEd9wIC2or8QgdhEv1BkH/uir6GzsXdOe1CHDgl+cDm
+JaoicMG9yLOfmfgad69LqejUIDlpqpKVXmZMrY0AzgprPx5Mw5z3KHPef9GBOD2xQQceSzwlC9wxLuqKuCEPPAbkwDIDeNIHCqeWd6rI/tNRVEwNZ6BvDhrA
+VbYAMH5hSiomi3cgMlrbyiw8lqwQSi5UdY9SAkUQXR086uBmTpoV5Viao4Ne6l/UrEYgIoqQ4Bqi71T2zCp50DziB7ZLWpV5xth/4RG5BxYHdNB6Cu//A5uF
+Dj/pIC1YF+6LEctAC2MkfKQf5heC1+pO1bo9xXPj8aqoJ2U1XQvucgomThDsAFVWStfxMAeru2T5xtaIgaMaEeLTPvMFTEREsJDY8jiEHmW7DSWzy/YK8Vqo
+84OXmADtPXHNw6TMZ3b4d8uKQ0uv28VOe5QIe8E3rzpb5JdQxegcD4KHtK+CCh2qEg+aKEMMrG7xRTe3OZxNOOXszXYDQGmgkXXcNsyYJ2GtBlM2cCc9eaUt
+ol4F/OMKr0jZzp4TfCPTasvCuNxzC5cCBTZzlYq/aJhLuskaabWljw/b3NlKHvSDRP9L1JCpTFl1wDMqmC4ch5vVft1UVJ60mPsc7Hwjv2SME9OqtoqMALXe
+ffkRkgGv3tc6zyufBmsLlG0jEmJxc8hbNeY8p5w9XwJLlT5vhq2vIFmMUn1gTn27bUlMPb03D+KR7FwbMfTLwDFbghASlADHaFOsURyvGHBlbxYll7pYJ1L5
+CZcZYzcowl3Vcqk1kmOBNCyzWpek30gpT3pheTu+0A8C9ejgV09ROBh5SPVBcKqg+hh/2LapAlk7qRXIq7kc2Ak7CJni+Wd8DkkGZxWnZxDHeMUtCC5BiiUn
+Zaln5q7I/lPP3QMVxv0TaZzZ4pw47kOVZz0WzVJo1l5DjgVL/wJKq3Y5BmC42fQawjR4+wsLWjmybVW7JuzyTAj4QhyDGRxOexdGWR+tDIfwJhVuvTxwi7f8
+oTqKCGl4Cigtwq3NSs1+VlhLRckrzHg4s2kIpH26+CV6WpwCoOr243i4b48EF2S25QlInIYQmQdXgqW7IQqtOiiVULK9bXCDUudU4Z91RDxbLhG0u+IUmWVP
+9uVBoxMHiSOXRqDZL7WraMCZIa5gnfCJG4c2MeQhK+bmwcHDWnkizjaUzYQRcZghZOFoa+YIzh6ljxhLdZrlRIVqa93O18ztTfcMPBdnQ/nyuP69cs14a9ye
+Z1H+FomXSIw08qtwR7lcpMA+E/jrQmvrb0StvdaJzRDinbPoTHo6wm75HA5qjallApcODdTzRNgspShJ1lRhKa+e8G6NgDHBnjfb6neVbB5VnFEaR/6CkYSi
+Niavgl/km2ATRs44u2+t3yTQMLMizDFs9jg4kRbA3dTJGxjcaBVtkVZ33QfKQyPfK8wKozQ5lun4S0A8+Em3lCuNc0Xu47nM7azhg/NNoZbsE8mz4mc9ByCI
+mxlXPsrq/Vu99+zx8Hfo7UZkRZ0tVa3lXNWqWQm5dBthkZsHeavrB005RpXGWzTsR+CtDzElnnxAdp0P+UhaGvlMZqqQpbbHQMQZCGR4tb/c9Tm6/Ld2Jj6r
+TannvJiYn8UDkxLKeL9XVTYLKlFUSCWZN/MJulDmpXa/tqpbremq8YDFLo4ieDMsXhhkPmtbp7WBzlnqmisn+UqfhGaEo7dYZOWSyfvXhc0YiF3FVrT4A+hs
+ODltUJdZJ5iSob3YIg72vRE2Q9WToCWMuONyWlRXOSqEGjUeUwsKNQO3fa7ndzoqV4ieXq6w5ykzPKAK0zLcfIb4UpGrhwB4RqXkYVJY9Sf7NepTwM7KaQ6k
+TTvTNUyPCHBiUTkInwBnw0QZVtCb6TAfH5oVjW+zi8bXDGuCuZn+DcMwMJ4GxxUCTCtiN2LCXBTmPD0ctqnvLTWLJdb1LlBXvTwRXdRsrrTDjkJbWeHNOkrQ
+VbUuQZXwSk08wZxgy8sG+8JgvGbtvOJRH92SCZo2Hill8mP+lgwnwlZBvNX3Kl9YSgP8kas9JNgjCD1Bzy0NDUfuiuvjrONp4V6xD4wjZ47ujHFwwo7bEQ4y
+566mrGGsJOQKLUHo206ay4ToykaIY9qwQfINvxGn4AiWK0h6CQDdW+8njSPX73wd9mPcVhhVWBeu3hbJzpc9eU2hMGjihqJGZIFTpXZ5AZPTL+rsxRl22dbD
+u8SDODSxcHE1v+ZaL/XcXfbKsrVSffTZfWFyc4ch5aVWSMTuZ6hRunaxzheM0W+nDKHxk2t5cmLvnU+D61HgMaKCoVLNzYow3fL0WJ7rGSEUTr7//g0eJ+sE
+Rxer3R6ggZggi624k7NoEiynNI/BBYfpZMHaNwRkwtFSSyDI4UCsQlYbS8wdfQQz0btmrGq4FbAncf+yiqedggGt2mXd+bD1PQx5FVQTioegirlgGh6ikKG6
+zjV/tDaEb5cepWDKSKqFyolgZ4KisKNL54MMNyrhGai1qI4ktiZOnOP3naDJ9MQ1XbMpt84mHYhXPekP7Y8WRnJGfjN/8fDiPoc5s0ErJn4N5kwb6xHPYc58
+J5v0mA22QsE6bUcyHjhLrEbyxYVe5myuhzwULH7E25jlCmZiewfMIiTflE3yTeHJN/M8omPv9HU9nWcTeY+zGXM5898dCzmn6vslQe0hZNqk4H+1Rr4y9Bd3
+sGyGzJpSytfhTnPVr1gTZkBVx5bbSWHFKkbL/2UN7KzuoAWzxbPYwRJu2Q4iF7uurx6l1JRbKTWn1lxigsbHuTM95z/anOS7EXfZF1JwS53rL6qo5VzVTJv5
+Fd8g+JcLWVpexI5jEOr1c0+gYK/ct6wHB2RvtvMVZ3P7X2SFe++VrI082HI4Dogf09GBWtU0pBAj8Rw41jrkuyvJD+kEkmL1WQGbBIvO28AuHalYoSfQfm0j
+5nSyEzpmRFXYuL7dhDiKLpVbcjibIggdDS8EYpGzifpoUiYN1jMuhUDUz+QQ1PNGVzESL+AwRwZUOGvW0PRaGdJ7gssidq9gxqaqNfOK+wazhUdiWcY6n5Q/
+J+eUJ5jED96rakdQpEpI5pDiy+SiQXhEROGRd/LeOYSiq6f1Ff8BznozojCdX4gDMCHVpvMVcM6iXpJqjo7ykEyHt0+gF4blq3meODjmGQfZRom9RYTyyUpZ
+UAgHdZ0IpNTSTby/iYvyQW1t6xXKaud+IQ1hKZXM4RYoA7gTETlG1G7CUbvJbmq3JAz6FvLAstDpA7s2wRQ5psQQvmSvaTt26+YkbYqq+iOaxNWM5Qq0ptW+
+1ZXkrd4YnBL2UvkwO8032x2QvojmFembjdXDM+Jo9bQ/GpYSuSFe+MIXnt0u8gy5hugifBNehENTvOA78MKUy84LsNm3llN3+7VViVe4RV5vvb2CUFuLEK3T
+N7mF1dgH5rIi4L4DIao2fA+e+W/Frm68yfXy1szJxYpxNkv/TZd5LQ/JZUehEPfbjp15lEjPzOSAc90euOwX4p5Qcr4uya9lW34uTRvdw3+zMW5n3RNdrHu4
+LdwjUlWhI8gqeCu6cErmy8SZ0KWwih+5eFTtur80c507Pj++dynvmS5bXTVnRnAQEdGnEQXfdpepi164RSAIGvfCBv+2uP0S+dT1FjvX29ITkvbcZif09dGR
+qFBsIyp0QSwWCU4npRLOp0ddRF7dk0MUYQ08YUhUTjoV79FANsb2XLhxGSkRLgzvM+FIBUO4YqcbXD7GJyjMbKYq1JfY7ovIBXO2P7ZSCoYb6gmkgZUnDXxz
+SyrLlnPbQci2hYhNHlJZLUeMw1N+xF3HyepkZ9s2bYItFfL/q03AHRGRttECWw4iXmhUnK0GI1gBD23IYDd1fr1pTQbRPQeqoO+HLTVI7ZqbMWl/zt4rjIsz
+RYTEpNfkIflW6DCjgCqlCrlCj5gbgyRTFU40Q50Ch3FwGVI2eFbUKiuYi8J5VCtR6F+cTygcGVPSP1i47SIr5u2Rud0/dtq8QA5jB8eV96aqq61vJrzRoYPW
+FxhmxojAjIux6IE493FdS59Tt5i5e0LorUcA6zPKldbli9q8gP9HBOKNySQyUsFqkMYyXTRNjWbhUHTuuxCDoEotbjVJxYUufW1zDuE6IHkg46xxGpzxOecR
+gCaOMj8k+Im6ZSmtQ69A5mkv8PCL7UemkiqORW8Sb0bc6gjs4SS+aMlch8YBD7W8pnvtADsS3pYAnavWS1Ll8RFLdOPhPrUq7jIAFa50WaE96jh7AuQIM3G6
++84+nMiFWmY5gnTkvVlX+t7kc8HhnMrfUDaK6gLAeGnmc0Y72iwIwiDWRio5f6BaCz1gtJ5rYh7kU4VjVHZUJEiaY03k3Kxv68U6J4g++eLFrZalrkz+hSZ5
+ob4QzB6VASwPddEBI8LPuBvldhM9gIlQiTyIpikCEst6s0Idgsvgm8KjaRTSIlWc4U9dF7F9X9+Hif7wr6B38HFpXCkDuAeyAHgimo+qWb22vaQF0r+s9Ky2
+9U/oX3SS1syIZjvWE/3dk96iY9cOhWufuEfCSMccIfwtniC1VFVTQKlE56r7APWW6Vbx9oafyFJbihXHCQYbDN195GXSDOALknhoKOJgEBDcyoFnSbYomfB0
+BUdmqet7M6dbYqbn6xLYNYlRghHa8me9obklORcyMIekl4yfQVlHsBmfACHiPL2mkYcbJ+og6x0d9SewSP02xSvmh6bammvA6ulGyoulzquzYg1iYF2g9GSV
+NMrdaNzyWYHSVyGBFlYeBKPKenloWARRwUjfrSY7OgqRuPLRSzVn+twwqlS0BGTo66tcYafEopfxLyE2BUO360qz6RVOrUfIBFr4bLYuG9UV8NojBLEIzyCz
+n5mFNXNs3kWwLaOlzCpfzUq7wghOOWOQ0UrXa2Q5swolWqsE1zjs9BCKoIfwEbgQ1azWZfafDLXdcnHRuCPIiC274djlO2zqbQeM6lfUDZ+wcH4UpqpbyILv
+M1jqgtkkA61OlppKN1GUBEuxBOdKdG5IU7Qn/HARYq4i3yRuOm5Qz2Fdx6OrI8YQBb0PDZ1t425j1FQ0AYx1gwMWNmftaa7UnLhwCm7i9WquakY5KF8LS/gT
+42ah9APx+YK8qRK7j9q7sbAVQ2q5u9EeMNCz1eJVe1vrUsPWEA9M4Bgfby6EZPEENrg3ByGmCQ2Ed5TPjgtIye2HTCm/ZCZ3tGLl2hYUW5WmNjOT20SoEFem
+ZqWpKhE0hEiFHaeA5MHWRbb6bmBW2sJFgSyyxwaekfSy80y4lDqbmA/M58QVXd+LNlx2C1a2CzXG5iZ+nbeeKIxjb1+pqnowJS7rz5ogNusCYgoYgy61mjNY
+hc0m8lm+7cm+j2hMNXkqD4KfBTVgDqQqdQg/gf2d8yHa5jcE8lDGpIRkSgi2K5i1tyRsOGGzLCev7O4E2Uaq1GF8Jq5A4+jSLIijLjUaWMiaZS3tjXig7JEQ
+ph0xyraTHVywhhxsrQQfQHWBDa06u87i0cKzQwBpULxHEbRU8OlD1T2IyVhB3qjPQ+GejklgnJe4Az2kaHvNXEoQXTx21N0j2IILIRdSF0Kko3wjl+FSxUZs
+mSYO7SpLEG9K+pGr4oebpScP/Q4R4fs8c9Zt6iGrHIIxD9wLlQf0kGxtgJgTQWV2Bz49cgA59s50d94UThgmFFQV7NqoTWuKYtQZht8sLQH6WbvQD8FQEbhU
+wwJi6oXFfTkK1y9ZFdLdup1NKSbUSfyyms9FR/fsCiIynvXk7VWSXU6yQ5cdPUlCsCsKfkXhhHPG46DRyDcmxoqyJWKZMOGOdAbnULIA3vArvsDYyWsUnidv
+mt+GEizo3h+7HEpma3MRQJenEjp+KWzl4B4lT49QdUAea7V9N9V45VKq8bY4pY1k0rah0JavuCyzmno8O5LXHtwll1nlDKoImUSkpXZhgp1pSp82SiK1k+CQ
+UGG2/7daLlX5MwnLYDYeMAWt8qsh2tzt3Cp1f34kL+1ici4auSfw+oOFtZ4I6EShlvQXy/8ezLgLGtpO0gf0EWDjcpq02hA0ugEWK9Vcw3AInOe5ZDHLEX9L
+X06Ee5RsQ1bncC4q+t4i3EFZMdfLIkKJ+Z6jekRdby0LYNuC2ApJ2CoaHhLtdy5VVnMNFSKjQW+BUFW1Xq4cZbo/OE3TJHGk78EzfOVVemubLiWWSfMNw/q7
+B8xodpJPDQhWE1iB12xQ0++gi4v/APUzW+FUK2vUesxo7/GimJylQ9zetnR5q0R8F8Rm250qAhe7wuE4DVF11RTwkjasUQnv9ZC63KE9rFoZdq+jPdQFOjgI
+hCkDS7gr+dLd2fN5+77ym9AOXPBVEMh1Vbd624xRwxsdvfZmZLUuv8CeQOkT9F+G/fe+BOws9qDd5UaAKNTkg/KgVHHHxmUcCitMvUmkwi1Al5lFADQ3rSc1
+EI5MlV83pd9LXPWjO9YQ6rWskPdbqKPg5JjmWUqsBsQo6wAYR6CIQJ20SlJYeYdfU1Wgsb9jUx12ZxhhEDhY675+UIzH/nNPTrnkDOkpYYENn9/gyYEay0Yf
+lp1VfsSzqvx0fSyrXSUf8VglH7m7kk8U2XdM+p6OrJmyo70bRCHtdySuTsA/8TGAQqGirVVFSFEykjtVuprV8jIqnyIif3hTYSOUIDoByFA98kojxWbZbasK
+mPQ6yzv1vij5p5jDRo6nMM5BoSk/vD3CPawoxT3x2KJG4wuV5Xiw4egsOEhIz7rpADGEt3agk5Dbd1VmlNP65ljOUUtZ1LwOmD/Au/PKlNrgnLemUD5nCoMR
+iW0DwnFkuuocidw2koQAnBlpA4usrGpflSeuG8Uyxiy27xdxuAjq9Rw1LK6wuw4fLGdrDvb5VnF2QdC9jGaXTgx0Z+UkJXUKvG9eLLjMlPbpsifCXQn+PNYB
+2x9iLx4poCVd/axd5bN6fJm4Z7Ep9Ji5T88ZwFCH6xwsf8K5QwjX+4+1ytF+9NVSCv0Q8xS6eL+7WGP87ckxSdPvyT/HlaGGpmZlk4N6HynzKgL3P7FUF0EE
+57oM+0kZTWzZi0YxFdkvZlmeq3LjRGs3VBId7SWVoMTwQKu8DcZ3dsagw15Rd2Se/axBtnttgYcYFM/qKDEWceCE8FqsOibaVcc6L6liE42xWWHMsr8EaTvZ
+Ikwoe255MTfDcLt0GOdPrR4mbfUwZq047sm+ve74Gdacz81DUdWlVks5dpgSW8DACZwtOUFPKfAlnlLgi2DV5bpI/NSHeZGuWjjW7fMFvsJp/fZiW15j9ARX
+/UIe6KJG88jHZA5ItQ9+4uNA9BVKJKQMspATCsv7xYT8GFisKk1AU1OwUra+RVQXOXniNnCL6vKOVPyQcAqsn93HlIC7Fv1UyPbgEoFIbgrccBX+aKhBRaiq
+CSmDTyAe1a0/wGTgbHpRWBv/rA2Sb76Dy1vPMc2P/CYYddRVLe/VnCyBdY65EyF2FeKUmVlXXsGyBVd97e44QWArcCkcgtuqW/rk9Jfw9/eqElndIBrltDR3
+u+vFwpR11VCRn1iDLqg75+OVlbBKpyk5G3tLacyIsGDT8XnhjqrWia0YFsTHA/rVsCtd6eqizfITfbHI6kzVWuZZzamRgWotlcRgzwv0C5IZu4apx39TKAYy
+HtfgBMESd85SD5RyftjL6bkPTiR0FSWyIvRK4uGBMLpK5XQMYWbKZrVHaajuRwA/ymoWtSc9eY2fryznGdeXMuWBxWI0NEQ4T87digD4jtVr3MsBM1pEXxIU
+RcJUq55PKMIkZl7gufCgf46X215+V0WdduRvnC0RPeeoWaIp58CRyhs/luaBEUUsH3PvUQgaTjxyKC+1mm+kmrFSA4dMl5rUTvvTxF4PIB5utV9tp1wvVVFg
+7VWXq9yGEi8aGwPlNO0NxyLQmBKKwfBVbwPFPFTfJXmrhe8SBoO6ijfaY9+Vbxp826JN6DCHXlMcj2cXSXgVTX4QlIexMkPWhqSGXSFfDrei40VzFtYwcwjS
+0B/QUeo/pdxDP11BpVeFrguXEp/AzsznD9lcJ3GBQuqWk0dRUnmwA7dswMRSTycEjYKFTBjn7I83nW3PQEI8CTuUEG25JNAQ2bIrwE9lHTC+nhKNia8odC4d
+xK4DEg7FxjpAfKFMwZHwrCbnGqdOybkuDBsqCdlMhhUdjUYstn7oiM8K27JoKr5UKs+/g9/7ogtFCYfwUCXX7LFvVxA96iH9H67yAQO2G34RctSRRuG4GZka
+fHZPSTqdo906rjDJGtttQ40ayipQemTFHaqmOSncRaujDkEkHoW0Wt6CGK9L3nxHjo0VDc3CJ+zOH8+78TyGnrHXfkQ0cgLczYwB/5jbl/wIzlNEnk+POxU2
+CWoLlIgq91XGf9yjREuqjVgbOddAUkn1rxEZwlMUMHzTEkXbwdZWCl0tlkBLHL50X0i+Sha5qRS1oXD+POiEEHloJDnzx0Pqe/LgL83NYsnznPuFgyOOKIZp
+ReFGsKZ+c2sxK4f1xzeTAJHTnOk2SemyjhXqF+XGdaUPyvhNugntEY1gF9lcF3W2IAJTWwvRxi25gQZTP+muArcDFnX0gAg8cwncQtVaEbKK1OSZKQodMXTC
+nZrryIwwpV1mlGsiTFIPbGHM3ViVZra2ptUXvWGjN2mdcsy0LxVy2bVlEGoDITRXV2yodC2IU8oY2710UFvXtySoVu7kjqOcCy2jqJvCVsdt9hHmALuPt0Fk
+UNNGZs9ORCMgOj5BCaUdJb77zU9mlTyYZ9WszPAyMeUGEz+7iNqC4Fs1Myvtb0HCZCeOgaRqmisJo5Yd4MezAJBGQE8KsoBDmJC3c6SDAkVcZdtZsuI631vy
+MDmmVGp3Q8EEF+HeZDhXhGi0AT5b+RK0RgZxRiXYfcUF6h+q6gQ4gftvpTZLV4pPR1+IqBIYlWQ9qMxUB7tLxBLFH4Pge622SSVLLHs2TbMIXauku1pnXGt7
+WddqQkUtg93TVN2QHrMtEuJMtahtB2IlIA3W1ufHsXYvG+dUFxV+fEQ3E4QYyoxTPOk2nsef5pwyez4ZYl4x3N8mIVe+GLnKilb/bJgEF1EXNf4iK6ynJJRp
+0iVWNopzK2ctJ96rfvpneaXK2T3WGiKsz73jNfW72uPc4EKsy7WL3bHxHEJnYHUBhJjpuVuRoMbFwnlkQo3OohQ2gV58qwMtpDaBWz2IX9phqge2hk97cmjk
+ZF2WGp81CzmCJqvvsJbS3HC17u5i8vIri8mLjmLy8iuLyYvd7zaLyXcLGawrL3bXlZfPqSsvdteVl5115YUIepjVW2vJw+M4syiVfJybv4TtEQQ6z/Wslsqa
+bnjoXP6iE0vBea0NCJdlqKcHfO7Yf/lYwXhuyVVPiOvW7KgcTx2JxaXn1oHt+hJy/Jam1nLIevbAk42/A7S11zy311H5WpgeT30ARLTzgup1O2OEsAwBcThZ
+uK6QjmfS8PT3osQBBuX6A/55a10cZnzP2C+5fEy01zI/KUdCFdLgL6mKVxDB2WpamEbrtzbYj3GPR/Npn5Af7awogSqfZar22e+q3HjyLs4oVY7PwqVvc/Ui
+zHvuNglJGIdUttvz3Tn+6jgDbzfdVTe6t1WgcrhiRHOnfNvkE+90cO6Gr92PzgSIHAYCyGT5xrzNCt2KklmVv6sIR3O4YsdwOb+QLQdPh+KpcEPWCxFXsiC/
+5zb8aZ5H+RsR0xeCsV1KZfvyt7hrP1R+mLkKbDezPGcwdoTFfsaCs1B6BUKJqqT/2KxFfRlVltlWnvCZFdfF49WXnlx1XXRVXZffUHVdNKquy9+w6jrpcw4w
+Ts9HGXtPK5QjDzhgEBdij8u1J/LpZdnF7rLs7Y4+Vp/duuG37aCtBdtlq2C7CAu2P2FydlVyF+jojCq57+wl6pKda8m6OeycXzYYn5vrWTa3ZcMX63pdRoXk
+xNNWFlrirI3Ecaj8gtFMetBhCBGKF0XslV+HAF8SRII4i4DnJaq4b31MXn+5xJXN42rylBwB58AiJy2u0JQBO0OY8uGwFKIw0QuBetrQ0VVhu20TjqL4FgJB
+SDx7cloYk60xiRscQ3rB5mTp97rn8gNoU33iDAGQeVtKlMvnlygX20uUy+eVKBePliiXzylRLh4rUS6fXqJc7C5RLp9TolzsLlEun1eiXOwoUS6fV6JcRCXK
+YQu96VH2gYupXVrIOwiMX6N+uYT65eJ59cuhdvkgtbsGSrf9mH6eRJPyjYXLZatwufj6wuWyUbhcfH3hctlduFx8XeFy2VG4XHx14XLZKFwuvqFwOW6+tz05
+sCVA3AactBKV/NU1j6Sdy4eCx/JoF/vEDQe3J7w2uSJA8yIFKDczlXMKE9EqM0ae5S9lyTHQHNRD/UCG0BotPqTjIBWZW1IP7CEyVS1nuaHMX0hx+gWrQlB9
+qtvK5OtaE2s0DkaB3p19yXLh+97hGowSJC0kOcoM8ykpIpoIn9reBCZxe/C5UtfrMqS1lekQFnRLHcWP5kHWBjK48g3j/6Y2+eAzXGVD/WCbr1wQkssTcf3I
+VViAIag7zEE27uNdqWHyagQsIdfLumqVZqPgWlUTkZSRanaPQRkHF+bAalY3itKSoqNtOXKqjREX77XFrl2IsvIpCVNGFCZS1bVil7JXTW1qnO2tRY8O0Cqq
+1AK6XBt+2UVTmDGF8nAQdhbkYOBYYIs5CvZ8Q/oUu8QD5kfHtYzjwJawieoeHUIUsvP0eVoeODXlQOZkxs6lEiuDzg1yVFm6o8Xa0dnCaBagcPaE+BdTaHrX
+EuUFQ/+uwmwyalbelpleyGyulbTMURxg6f1rs9L8v2y0Kv9V/gu+bmwm5r8KqsCx8gCfaH1/cNWro1XN6kZV5qx2+KzIPn6Ksquqbl1cdJbEZ6OkZfBeBqkn
+h3Gy8FHbSuq1x+2H53Jd7s1Ku0wtNL1JKV+sc7IqWdXCO9qqW+/CCsXUDrvKA2GzaGlMprQKk9iuME20fuKkLjjWJcjUtcjAcOM6hHss056wYJ7huA4mEa25
+wtSJrLSW/3Jf16vqhz/96eHhoXdXrHumvPuTBQP96V8xia9CUyBioDEFsZUQFYaiouSwK3SuZ3VpimxGABq10qVcqix3EI2Ah3GmfKlFkv7kuXyCl5IixjxP
+xOAdF5vPansz0v2xsVmfxPZEAE3Ldd8THW7wMtx2Sj7oWxvtoC2e1WFpJ/JVc0KvUPLA1vJCjxl2KddqXvkuYJhxdo8g/IXjmWeIOJXegURFzLf13nAm9WRq
+OZjjgGcP7lznfYFTcKvrmmFP7jHBw6re4Q5w+QcvfZqCjZE16OU+N6YcJhEnSi9XudkAtMSW1nfF8vhjS10eIbIZbL88EVR+DWOQQAVFPGhWEHot6MD1KSzr
+7uuBQPhCELgh3p2w4115Se/mgx+wxekOEZaAftJZ+Kf9n7+XP/2r8+vL636v/qX+zb5xfHx8/ObVK/z/8fFx4/8np2/fnNqf0c9PTo5fvvonefx7TMAaNKt/
+Oj7+NQYZDu7v5E+gVc2OIKb+JsHIuuzPv4ALbi6vsllp5Ln+Qozxg2IW118jZggNut5Yt4qxEwcZYfZdUdes8sldNmLr+Yx1qYHlzddODJiPWfE1pbzNClVS
+o1US0rdCY1iySc9dsjVnlewq+CwPD6KOHRwlceWmKF1bxIp9uxTcD83ZqJrlN9jX7EbHJEYOTcFmql0fwaq1rZ8QR23yQPkMGHRmVH+32ZOsCKdvW09oRfTX
+dyYrti+GVbgpUG8nHS7PYVDsnEguChMQU+Bndm1OJi4IOuuYWMoQiuwocSwTvy7mpiTkB4SLTa099mWuAcA5txQe4Qa2mykAzGSmdDnkTWIanLZbeOGmN+nJ
+Dxj1gmlxuSx6zkfrBzmNvpT5unm0lQ/G6WQ6HpyB+wj9aZPeAcTfEzlfOwWMKxqABwKUPrZIGh8XWSWjpDG25zyiKsq0qTQCOOt7WOOL/vj1ae/09C1uh/OL
+/nhySj8QupaV/o+eJZOAwDinAZkIElPqu3Vus7NuKt0SE6K723G1QleQMGQjIEoZXcNu9xUVdm4gXwimyeXjqs7xFgJJOPws34/T/tlH65gGDwm5vgYTeTk4
+S4fgHv8ITtlxOrm5nMrBkH3PV+n5ANxu4/TH0VkffCmC3be0nHI6kuP0fACr/P5mmiayfwZuXTkaS3ItDibyqj9Nx4P+Jfr6g3/LgXOsk5f2/EdwOp/Lq8HZ
+eCTP0x8HZ+wO7aFDJ3LNisBhD64e6sFoPHGO+cFQDqYTeXYzHoNf2LmD8A3rte8PP4txCs55cKjCryeJ/HDTB9syTdHx2+XYJ0eqJEP0sxynlxBdAHf65Ob6
+ejSeQrfP06vhYPo5kel4PBrLi3GKPtGb4WA4Tcfjm2s4F+TZHIyGCTlF+1M5mMrBRODjF+PRFTvUcVJ/HIxvJumkh2swen85+EB9lv1xKiGQ8v6zPB9Mzi77
+g6v0XL6AKEgYgEh88GE0xpgIzNln+eKxWASMlF8VPhaB22gwvUyTpoGdPBqPwL1yM+6ffU7E2ejq+jKdpkPsIk6JbcaGJkYXHCigRRmOhi8Gw4vxYPghvUqH
+057EGICgGADt5p0bajSO95MLAI3G0XYS3ld/we+cDyA0gSvs/mY98t5NL69vhgMKg6Q/pVfXl/3x50RsddgfPrIA1+PR2c0Yx0pO6veT6WB6M03Fh9HoHLvN
+8zN55xz1NzDNY5iTmzRBdz1O3vV4dDGYTt7B39/fTAa4Tm5TDkbDI/Fx9Cn9MR3Ls/4NR63e9/EvtOmnH1PYNqMLnBsOQnHIoftcREffu+uf7IoPw1cCpcGH
+D+P0A0gn34XRxa8mRWgLwSpAPBEWIR3K89HlZX88kYc3E/l/nBz3jo+PoNHPo2EqAjmIgZcxy0L+x82EYo6hAIQHrqcT+mn/8jKQsRSgw2GO05QF7WXan6S/
+2ghRtsCz+M7lpZvHAbQWCJdEojyZ4O49T6/6w3PYLjgxtM+2zg2s2cVonH4YYThrnEqIguLOZ0998yLqQ9R0QJKWNgXcI/govA6HZzq+gVDaRN4M0+HFaHyW
+wvFMBISPsF/2E17UwP5Nz0ZXqfxxNMDtfJ5Ox4MrPLMwufGkiq+TFU5CjNP+BCT69GM6FF95XfIOhKEPhv1pcB1ffu6Jq9E4Hf0Iocl4iumlyc34x8GPKfYl
+/el6QDcMXjDcHP6Tpl7YqefO444jOTNudJDPs2yeZ1AdR9PBGcbx+RqyV3uC++v9Z/nkAyL8FMD59y33z/4yHH26TM8/QLN4V8ZTdtX/DLHVyc37f0vPphDg
+tBooXpI3EKPECbvsf6ITMU4/3FzyHWrjpEMUuggYmPgY4tnoBlYaNsWnj4Ozj3xjpiitYWs2JTbqgulPoA3QLhld4ncFjqo/YUAD/r5/fjUYwuzQyoS9gl7S
+gsGEpGc3Y5B03OAEwsvn6QXGVqGbKY4xgeY/pXAHTmgg8jyFK/iKNSF8N5gP6Ku4wgsLvnk5mEx7HXMLQ3yPkeTzxMojWIlxfzi5SMdj+MfgCkaUnieCxpae
+2+j4OH0h3c/4lrjqD4cUyP04eI9TR53qD8OwPQA7osD4trmFmeuP8RG8hy8GZ/3Ly8/yPJ0MPgzxLrpOxxMSanZBoynGbeB/5bdSexGEncie/BWOo2D1MjyN
+X3USp6GNi/6HkGLWo+2JO9ZC0qx1tVToaWYvBxgU1Xqly0qjAz6nihWmUrnles7YfltbvlzIWSoxcaGSt7p+0LqIE1OiGr/wodr0ZNRrz03OkW5Auj4UuoQa
+14kgOymRdVbbDH9LM8Gk81QTG4ZmkthSw1wGRzYa+QMYBhaSbWPYmymoCyNvSwxKNh0JM1VAoJ6SDuYu3D03BeZeYmIfT4nkihy3TBliCyTz5PRkipFVXS5b
+X0GGJccBx8m0YJ4Se3GxkV2vCUwor5EOSc9trLqUt5qqb66LoCX4RZYjyCAhlDg2aXNVBFLeLH3hl2VWZMv10prhzp/vGYpckmiQwMeA2syW5fZ8fwiqY4x7
+xaukKr8itxtLLxiw2yOsO2+m1YRwXTaLOc2m42swFiy+zZDBCcanzUJO9S+q6mBbJrJzg7CHRZ7NKG8VMBXWWQUtCFPKv67LrJpnLlsSvQRFBms+ZCAelvop
+fMRDE9iE+LZULicqx5y6D8bMgWI7xyJ0K3IqlFRSG7rX3DBuLmLPiYi7tMAvLvQcY4944GviLkbkSFbI/rqq4VzhZCTOqWYTj4QqNcqL2w2fFwvnJZoornQT
+fRXa+KKLtQ7r9K6RUqXp/7/8TX3/j/v/j1+9evOm4f8/fvn2ZO///139/z/IdigAzjZAerQ847AyecgSPCNj8v+Pvf+fVJP+cMqeS3rX++8ror7OSMBxJLzr
+A+LwAH98YLMikfA25sfzF20rqYNRWowjwpJGia3rwEHpmEGkDPEgYZpHYwSBu1E0icU8Dshmu1l+M+iok4P2KWBgQg5LpsYL+xgmTEdAlY4+EZPGE6ZI3WZz
+5/7tnCsWY5wk4ztKH4wISnzJrIiqxKfxE3wG3/yu8p7UyuVpOnqzxhQfIu6IfmgRMwdHyFKAU+TiEhEBSWOWtIwbSGQj9CPC9a+SILTEcYkwvPSuQdXrajL6
+b4r2N6HNrK5YL2RwYlfkqQo4dyn+Q2pTEGNpLtkvNSMabPwf5xUuDb6+o3iPTX2P+9eTzUhLIkNO74jQG9NlzZ3BtrpO7DcEU1oT9+SICmXY4Ns9kXaw44Zc
+v25GYc9aQhDak8bzriX2bUG8ViCsEgzq2Qx6J7LgSoZr20cqb9fExYIQVJoHotBG7S3Mz+fZiliY8Mji3FDVUCImMJjQyxXM4SdxMabG1Ll2ffIbpz01Hgxi
+SX5EqDg4//6tqjJQh66vL1N51f9LCikEgXM6kez07sbcuxAA2uxkkW1H3Df9zclXYfAT4e1T+Bb1fTK6mH4CDwL0czpB+w5ac6EB2b8cDVOOOZyNrt5bk9Gi
+8dGAPr85m04aYHh2hONn2o5s56ru8mQTCv/3dVU/x0Pd4W6Owe6YTkDTguEVAuuf9W0MCFwS3iNFBnh7URLZcIVj9IiiKt6Bws5wdAj0z6aJmIJnwk+UHKYf
+Lgcf0uFZepRIckmFfuuxz1QJfOPQEfF81Po/EsCpf3Xdn/zGFsBj+v/p8UlT/39zvMf//L76v1f6+zNQAgjVa1CfvM5mmPnXL2vyP01mmS5mupKHB/3eVe+6
+1+9NegdHPXFNZI8B55ueu/sLfQ2WnXdOhnYbRNQnursXbb67prLpuEu9HpYwpRiphomYYbZAcKuyqsvadqD0NQBFWWVNbktBEpLqdpAK55bq4ppqndtfeuYY
+qRaGMKLI7CPUrPbTUYVtkhPpFmDiWxR2Gee2/iDEP8tH8EaP4IucclSTu6eqqZCsBzaxHro10fCf5SM4o8dxRbYT1m/4zE5YoHuENBIh0si5KQP1x3mows7j
+eJrcMi3HzxzMq7mvpQOLbdU8E2rTnvwaDD+iFGN1W1Z6VmrSQj3jQFbUGolD1mERY1C+3XkTDujkASmJ1NvVYKuworO1ofuLqGmEXNnNa4lK3EccrbSnbQq0
+f/E07V96KFW1TfEXWxX/uLcxsCuBwQdVwpou+04XZuChlJGH8gwY601ZZAonTjRQUl2+Pvbs1TTfPSG6d+sPFFlw2mkTruPH1wwtC6ui22h0WxGXoZ6+XZ8U
+2/Ng5ejiK0AnCB1oKvMYvvOwGcaSxCq0G23CseBguKEiCWHgr4aM/G2BIt+AD0FzZSs0JHEK82DodWRWg0XwGETEtirOkZZ81AE3Ec1k14t4Bz8HdSL+8VTp
+v8s//eH0cvzi+vy3NAEe0f9P3r582cT/n7x8udf/f7f1l6eOGkx80lYZl4XhfOhApQFPEm6ZF0TlAyhhp3cxm8fcQPyxJ/oFqrdfsvmauDQo73hD7DVGuqIH
+yCz3kFX3tnK611qDNEbCPFOHTenw7fIO0/qsaw5+Hfrk6vvQHcYl5PkpU6KH1qzr1bpOiEuJKvgDa2boYv+kpS7gXld32mem2fzlSIGhLgTVVh5AIZWq4krW
+M/DO1p55Bx3blHlGzUGvuY33G3lAzx9gO0DGFfqgMffZzYgfpmZAv59HHBuShsY5e5U8pBFDwWSxsiXiYQsgy+KqNKDuJDF3oGe/9aBrjk4s1KxGClnMDBZd
+GnnQfKlXpqwTqetZr+cCJ1DOhoelCk7v5MkBu0kWVM/f5F53t2vIQRW2snw/3euY21v5OYboiSVwwTm2C4XN3ms150xLRZUtFMXTKT/TzW9P9iuBOSiq4uDJ
+3Tqb6zwrNOWE/KxXNbavf1mx/ujYtXRxr4oZ7dasktWmqvUSsSH+YUzb5II9xuRVwKmtKl+DGUu3aHT7Pu38X/cvfusQ8GPy/+R1y//zdu//+Vv4f06+//Pr
+BP77Fv/7Pfz3+2P87wn+9xT/+xL/Czli5lbLCW7XSgzCoMOWCDEaYsg5YC35k1fy2lT1ZFZmq/pwfCT7F1f4RCWzOuKqCGzMhDhtuVJhcEc4CkEu+hAF3Szr
+4a40sETEsT5k42i4J+hAkytFz995aeG7zjWWRNg1+7nazsE7/4mwC96+hodsHVaz6PhESGtZmCDV3yF64NnD6ugdz7eizwtfaZEZ+u0LvXhR5b2C3/taUUQh
+YkIiVqRsJepLV7e42du9nv8H/dO/vnxx0jv+2+X/nrw8PX39uuX/f/NmL/9/jz/98/41JNHI65v3l4Mzi44Vglk05UnvGDnk7G/O5afR+C+Rs8rDnOOUg21t
+Hx7w3w4ou6MdTJSN0KF1JMR9wFwFdL1MxDg9G1wP0uH0uwmnfXiCtTj7AUPA2FHvNsGEpyk5JlxLkGVwPukYFzfWkwdRf8gdd+DePxDo9mLw+Pv0cvQpxEjB
+F1MGSl0GZQbUXK0gUnHgyqyQhZPNQqZKVVDmrPsRFtHr87tNihMmowBBf4ulnOFqWK+oto1Dr4qw1Cd8ezfZNlUTZKe+mkeMGGJbT5xCbvmPnQbPT8zlJ+Ku
+rSylqPClz7Fyk55Lvm/Nuq50bd23A6yWlgPc07qCe0JMtLZMYvK0dypvdW4eEpn+giUYZJ/JliwIGXSHyXq1glsLhKLTQDZU36JRPtJOXTR4aZdQLrSCwFkV
+la82cS2omFncKgmOm56LVOs7g3W3oFDAgqpuEbB5dm+ymRaMjoUhRC7pSmt5rcqamIXcqN9hIQDHY2Ib85WxoMEp1kK4xloIrp1XjXawbvGs0amIyR8nDpfw
+kNhMjmTVtSjYFH7jTfQN5ojr0VGiDAr6Gf74pCcPnLd4MBpyEZofhIC54ipTM+WVko59kkS/gA0YKZXtWn079hxOiRCHt62PI7Z9sr4lyvm63YHgd9gHxshh
+pVMuRRlruxav3d1oT4iT3mlPHvjsDPkpfS8ng2l6YEtMAUmzvpVVVmswtO2Wuxlfujo4qFYKXJmTaGUSBiLBw6ydEznafMf8yNuNWBnkGrZw7BmWPqjluQb0
+PrpyPulbOckYzFfoB/wGKveWn6zKfqk34vDN8RGWWcbBvoTB+kiBHSQc6mtELJL88VNYtUVPs24hE1IQLxt/2xQ6PB347Vc9eZBepmfT8Wg4OIuvr6sUwiiD
+yVXYo6WGycqqJXuwcq5MtQJfl60gYz1L7L/gpPflknjk7QEO2KRsiVVYqLmqFfbtNfTtp/TsZgpRk3Dt46FHTB2+ODDTs58Rm8RJ701PHnyAdJwhBAj+7WY8
+mJxTDljYNEYdE4qzFrOgogY5FUMxJaKt1iWw8Ltve/IA0r6vgQxzOAXE0Y0fji/hD9bMOtchO73FdcKUCMsRj+jcJuQO3rK1VOa+xmlzj8hgjwjeIz0ZOZOU
+7YdLt7H1r6nU3KCY65UuYOjyip68XdciK6oa7lNKLQrpPBqLtbCm3jrXP+DNkFVSL2/1fE5HtvUOif2sslT8eE5LjXxXM22Nx8ZQUc/AfQCpHnKxLkhwQ2Eh
+nAg5y1VV+XbewU/hTkDd4Fb7r3GgFu3mhICMhrNiChxpYssnKTJ+51mpZ1gwx1b7tf+m0mL5xjaIMhINYypRKder9mBwF/0Zd9EAg35BmDHcuiwsTGkr/wb7
+U1WhZBOhZMuKoKy7rzJ+u5EdwhP78n3QF9IhfSeo+msenr5EGoo+o0/XXadw4cTsKgvnIGc6OEfR1j5op7FMF4oLWGz9Lt5C0eeyqn1fRlrObh3tpAfFtw8u
+++MP6TiaB2WrsqraVg3oFtn2KFb2LNoCSnNcj0asPyoKcNI7OWnr8n4dIrWAxQipidFtDfEDKgqN972PQNie2Zsk1F1O/Yc5D/cAXS54sLWCXS1VxTltdsFe
+t/cRFGU5CAGYBzAn0U8m4d1D1zQBlGlALLVshYz2sYESCweU3OlbagR4QvlOJycMxSgZhGESzFfklEP/7q2Z03UKnOaBLdW+yaMC2/D+LYiBjt0h2qaLPLyl
+6jlm8XTVG7xw3YoWfP7c7n9THtEA4JacjG7GZ5BVfx7dumFkZkEitHFQg3kTnW+Q0xDT5AgLtlyZwhd41B0tQslvVG1UVZlZxtB4S8zodX9y2XFOCPplK4fr
+t0Xbg0pg6IMNs2vYGNW/6Nm6xgRKnA+4vQEtQZCLLtHb1NMg5hHTNunKblCW8gxSamrOztsZq3qqDp4U+ORjat3Jn+Nub5NOcGqJvp9rjJdZpbmCPJ22SrSP
+WyVr84OVB1LiJd6UOe/C398eBaCsxqjh3hUCLl6uWEcSM6qS3BRmlpbpfkuTQhDDLz7C42gPwhoqrurQNoOk31opZOA8cA0c7G7BKj3NVYRacWH5BC4g/4iB
+JA99NVoqACVMseuF7yp5q+9VvuDsXUzXpN2pZiwIaB5J+jlNUxd3is0iUwbVbUmfCdan+7tHRLxK3NeldYyAdFWtCfWwPyp2xwKCUG8Qk5ipFaJ5WdPK6spf
+NcWmQyulc/A9ngOAzCP06WIQat6srMXBjo6LmixMwS8gefNBFbhbDoLL8RR0As9i//kJN+OrDsfBac857Gzbp+A08Gwj/GsibLHaGPjyuqUVOB/gnE5iJODW
+QsXdVnCILq5IbxjbYqVSPRkD7WNTNh8dBcphdhRmyq1KsIx0R3YRVqblAspMyhv8ZEVY5kQ0KNB9IfS2tGL3R9boweMfiWDc8Vfw1mp13um/PDw8Xm4S3wkw
+MAIlFttM3aUk6aOMg6WYIP71Ks7Nw6QosS58kryqsooo7bx5puQlBBdLqy8d3j51g+zwCvEmoSL0lfz2TRJUdf7dN0nLubXYJWnfif02CuTVaU9e97E0z1cK
+qyjM0KpZbMoAwN4sw3qv6sogTsuV+ux0oW7zbLK5FCjITOCRCPbjU420pnaCAfCyqqN7xZRU3tajXzqcs4d8T6AhRo7Jox2SGAxe57kOKgV2+KLDctR0nslx
+QcH0Clyi7xN5lshz2iY0ZV1ObaoVRuUoYCmQzMRVvO5U08gT0zxHWdU5I+Hl60yb9vIKyremzTV5kf6EnEvn0kfoSJY5NNu2WYRprlzX6IRaaYC//jnoouha
+IVtfzBU9/gEq8HdcMLbAfrFx2oVo+ex3OKC5FGHB2lHzA+Iw9u5Zu/0oNP7D003zvyMiIJpro/zKxH1p1WOPl4cpsc6FWx74LG1edDUEReafuHvFY7u3qyFw
+/Oti23hFaHTx2od4tc7Fx7sg3gHtk90j84bpePxV2OUHCCukx3W0resu9ORynU9e8U5/qz9W1ziF1YsB+zVFqO1RHr4Lfbl7LhxUV6lq3gNByZEniJbOVbbG
+r/AT5LR/LFHNYUgs90tR4GBQKVkN87D2hnBWIo0iQLdCu+47bGPzcnQvgWjJt+cugSvwISIZ1z7VW4dE04G40sI0a7HH/FkmWj1XZKS9GZofQbcIb3ouvBRG
+73iMQeOhqyxSHoSVL/iwX9S4b4lPbGpMUziRYvtVAIdrfiQ/UVmN4DvrSlfEwxK4E0rvj7Vcyc01owN26J2zXpI2Kt52dtWGI1VrikV4r239vHzq58UTP2+P
+e2zchbNyCEpr59WBa5P4cBJpNqj4NFZ1jSwUKDODe8OUfuvoquPDXRqPp6YSiP7ON7JV0Kgx2i7xFh8x0S7m/A3iSvQDLfdlL6SgpMyx4bnPHBsjl72bKFg7
+LNZMHg5ku7E1THPY5Xf3nfe/LX61zZvL5haMjDcKV5+t/GMxDbqwqZUIleSKvYx1t1WSEnm3VvA3rW11thKzfWMqDkE+mOfp16Fu7dCoDd3I6/zEORIqA85t
+1Z12amlSFsLLaXJw9Z7XVTvzYYeFZbEhQeLr1NUmOBXg8+anbrHWUU3Ec3F3xK3iaqt2lJYKfvfAgtyGhKrf5WprNAQ4UahyHqclQ0/1L7qcEVUcvBCAqOwW
+EpZABvYK7t2kadGzTwgL9+lKVibXTeQtyHw9W5daPJYrzF0otJ4DuImM3G60kegOeYT8QtSArNE7vkJIUENTzSoX8URHNxYPii6rBkVTA/BFOTHuhe+qxtCx
+0Rl+gMtE8YdvNfTYt27H0QxhWSkDpcJTSNHsc/nTZpr35R8gzbsjvPxkCbA9BVy4lGQp5cteA2PpGandbL3snTSe4nKpl5/ZsdDf0iuePbT7GoGXhke94cs/
+rI4CWyEq7Ci/ZEqqQqYe7nIe6lNXDlGDOqtc6TIzc/SruKqGDzr/ouXhyemRXJqivq+oCD5yM1Qqm7ef4GaE3Q53WeFRCqVWlSmoq3CZq0XNeiFST3R69oWz
+6gvDdfwQXoGIJukQTdxWwwfiWoxjWdumNPRHQkpGsE8qwmfsOpUdXJ5imwZB0h2btLpBqFeF7hkf2oxirjRdTTtGRxBMBGfmJNYvslwfYafoOt+uBPoxzuHG
+QgGWzda5KpO4a4xYEWFixRPCGh6wAqAYPZcHVqeuf6l7B3GOWfC65IIw3ZH9Nn6GCw/hHixs+DOPICJh8BaK1NpTpDBt0SK8fGxWEA1tQ2lwHav8Dt+4m5cP
+paprS6u6YKIzkF7rarcEfgneTI9Gi8TLpBPpjawwoYQLCuE/eq90O3MQfdTw4oL6Lg+29OzARVWDOzDpKhPqgK1tX3+QW6TDr4dSTGxz6KGO6/KhiHi384AR
+I7z9lnCyDzjOzKJBJxOQq1A67yKDHfyDdRtv7afbnY0+oawL96Mp20C0Bkhryyc8Aj5oUNjvRm5hhktCQHGp53BNVLtaxigsIpOfcp1wYP32+fNBBqpjMMGi
+lIn4ovJsHqNZYVPVG7gC5OHLN/ZuSlzR3MbG8/4HsSWsxLASTHmThSEnDF4zZDsStc7qflNBrrOPn8CcMNhkHi+Fyy2VS6inXOgXAPvHeQgl76+29sSC5E8B
+otxClFlFIevH18/GgWb3wddEe/E4y9d/shH5QSHRlgIC1BIcymMdsYADMBJzNaNrbMtbwr9Flpq9slqSMJDQoO2jeF6XaJYgLALc8sRaeyQQpg9qziM7rye2
+IQ4cbdMLyjgMa59y9VCeotgAbfthohPcnlbZltAJoFHgbVrMSClyYjM3BVlxoYOED2IQXROm7MA6VEx3jynsjvvItRNcYi97MkB3xbcYMAagJ7ixVj93oRe3
+XlFBvKQ2ITiqY1ui/WQl0JZ4UFbFvslu7ayldDgFa9sxT0SV1WuEk1XGqxJdPrUMmeGqVTZbm3W1Q6UQVselIfdktBtzVfqMXeq+vXVf9l7iLszIaQNl+du6
+8xbce3NSe7KldgiWqFgU3xfdZTEq1YzA2gzCLy3sETjpyEHN76MxIW6fK5plh2gO9iSatv9+Mxin5wwnxUJCwT7tCZHuABzg4uvCl4cO8MyVrpEG3ymrIlJW
+Q1R5VgTV5bvvhSbuQTQzXqyvVNWh7NtmAmfsSqe+Cgvuz+GK2rQ7Vq25dxiHzVw2wMpUVcYa82pd43O22CUq+WFXGkq3nK+19RNWdblGzsTEx3S7rbXYYgrG
+QN/LjS1PyoAztDlz/UUVtUfHx5zA2JkMOmFKPT/iGv1sADxgJuCtRpINktW5MT/jkuBoFX8/2Fave3I4it0AvM3AR0tsYEhfNcRiMMhYFUGPyckCggT9tWjH
+aa4l+bJ3mtCpLeawhZPAabRUCCDGYg1wEMjsFjDD5qGQEatLKU15p4rsP+nf66rbyxQRw0SRQPh+w3BmOwqdGFFIBqfLdgy/xV0L/VePdfFwZtD3k33R+SaR
+B3YKYQLj+YMwPKiezYCejWD5RM0mHWZWWGhKqEKbsnWzeiDowA7rpmqO2gaiXD9snhJQ41CSGzYRzoyjJKRt6LMiMOfP+tx4OwjcbOCo2NGLDrWBbgkMS+Ng
+TSnw/Idw3iBAFrYQxsxhsVsWjdgK3mIQR7guZJVW8TK4uRU7RsUjiTrsZEdz4W+1YKek2ulsf8qiBpMh2p1oeUHVrjRFL7TFrm5Rm41gGO+cijhQqX4OylvR
+eWcTQnxdcY0jcpY11pYOb6gMkUff6pgZBTa7ytU0zXkQVCeyNiCiSIoAtC4Qkm96sp2bNrGcJ7FPGfemVZU7lFALa+8Krmyd2FBTYY3SbZvAAgo+J+znOHnT
+hUW3oKyigL5LtnPgOlttogpm5W1PBmk9MB39BhbMlLHAl8zZG8H+8XSE2jucS9LLLW65c5MEeLwtqUC80qJlQNPe2bS8S0EfVIwysRSnPeHQEaxYUpheQbNb
+h96JioH9LfD6iVWyyOhqdF2qUntHjtNRY2usCbPoQB2+hEy583RyNh5cWxIGX1uxfcODv2iXbnnYefc2kFkaR6lF129VXhlOoYSDi7rQFrw8CYaZWldaNAG2
+OH7aZPN4l1H5rs2ueIXgHBgV5ChyKpwdGGdJJNskS7h2sO18biBpiZF/O6HO7EpiwJPK2Z4+6BCMi9WC5qhwseEGK0zzq0L/klUUqypM1GHcXE1LYFfnNWfH
+4ovNxmBvdo+KblCeWZ9Aw/t/QyoZ5Xtw6K6puvW8x24bXlFRKA8DN1lRMy91fLYwzQVW3ZTcDalVmWe6jB88tKYFHXOE7aX98eUgHYeHSISK8wHRJoQbiEe6
+e2iUtfsFw81BXn7bRo+X44n4SOJZbIIxO4SrNfa5B7DW9mxUmLMf7XRCMg7TT5FMeWQ6wrQjBv/1O5B/8Gi4mCEkSBVukAHarAWjbQOR4ohVuLgYJuBNYAMV
+AvJJDo5CHI195FxTFl1mCjkO94wPYyQR6gy2mzdT3Zfs5J+hO4G4KldsFW37oBg3djM8N9QPWzvV6wCAsVIrOj65qymWXb4dyPUi6KgIdLYocM8rl3kdaNcc
+WMykam11B96q2C8EG6qG+9DtVlX73RpDCnfOD6HkdjhHs6Jm0MlO4SgznFAO00gV8EG4jITbDZv124QkRXD5wAgrPBpisgvE8pjs9F23jDhh1yM4qU0NzQq4
+fYj1vdSW4l9UM7NyTiCL2bBYmKyQ/bLOZrluh8vYEeuR75CwG35krvnvDcRNSFtkFh2KMIfyVS0Lwxg8JLxlDQwzM0hvQnYf1YSU9OTFuoQuJSI6JlTf1Fm4
+eFzKkgqszu3tkdVVI3t+VZqZnq/L9gl75Ha9XeNHeR1WulB5VrHHVszVUoHUlLuXMqsIGHibkV/GFE1UEsO+GtYX7SkuTMqfqDSx6bZV1IZc9zvVyG1w4A4M
+gwbpb9FLOCBSRRY7PxamrUZKkQfWbks2rVqqzts4Qt90CAh+KMO4C/cTphe+zT7GYKbdJFeuqO1hXIXPKRsBtNJ+1Lu8/wxpEoZV/5aO6ayGLj3IAaYfU+Y8
++qMTTb+FNakxPc60+L5nKeQDvh457F+lXK8Mc0NxiZvhrlDsRBjA8EFfhEIERSi6mSO2VKZo+Q4tWm5bVYoQNXXcc0UDxunZ6APxVsF4O6hHLJSqIsoYXdVS
+1Q3dfUvSFfvf7kHG4jpndSVouFTc9ovK12RP3ut8Jf+6rupssXFpV4lcmkLT/qSkaZkVX3RFwlnYqsrNhd6dB8R7KmIYEV1bSoUblfqPwoeVuACm4ghM3L3l
+ywc+QXpQjM014h0uFGsrEpFVMlfrYnZPRmG5xpC3wwHZhMMdJGLfVeGqiaxwNZTpmjaz2bq09S2n3pU+GF6MxlfE2nFEaLS+b0cOfDuiAxAkW4AgsncbwYne
+Fo1F7FpHIrRz9O7N1XNyBcPBItyy4bKS/AtCcrvXtZ1luHNdbc3FHYtLvnNWy7JKBlrXwpRh+Lx9+BJqZGF2+CWsUOJBR+kwxmeqBgZKgg4j4WJ+Ho6zZeWJ
+3km1qIgbLCFWaG6lpkP+CPlgStzkptBydV8qsHBgAOCo19jYyTE+BMTBYHPBg/PsLqtVLjNQKzhjdHWfzexcBuC4mL0CGQLn8F3gcGsEPVqnAaXmxOUddron
+JLknEvZPbJmz4PoWoSTa7qmQuz0Vfl2FX1dc02092L4UbMymjZRuz6ZfeXLo7VVZKSFR4AO4+JXn17IY1Z3jboEbO+A6Vqfw+eQhUHnL+FDNhoNByiVf126H
+xKWctt5ulitDzLJytl5SaKDyYH1qp2Z/Rq4ebD51AHz2ClxEuynES+R92sargZni3gSEZoNFxj1fuUp3OOHCgYKbk9yah9o42UrHFn0tPBPBZ0Xjs2VkXbcd
+xj30jrjOyUZvhBfbwRSuy5UpSVZx2Tz5RZWbRBbrPOcq1GqpOZqE0icRQcQRRWiY8xLsQcKbt2JIX0w2ty5JQTZEGBi6xbkoDJw6Ig7Ui4We1aRgvQLujiso
+TTXgWCmy+g7P06vhYPrZaWGveifRk7YqE6JJA7/7QfCMJ5w6aoBMCbqHnayNRXEowSiQMM2HudBBN5vrZcF86T6vJTRPY2koDmwfCW0aBhdwwcKrJQb9hymZ
+GGk8c1U9GuGVuZEVJ4NZnqWuZzl+zXQ3gtDqtTT2R3F5uG1RDZqwrvZJl0H/RFbLWa4Vo+Cdz+fJMxpsRkgeyKo/KTxNC81kDp1zgTixnux7JwDAezofXVfk
+UpyQ8KVrXRcbTqIESdhmHWuAtNohd8qhrox3GFicrxOszL3YHXve6oIKTMNXvROf+TXPllmRVfei+YVt1CQ+YNOB08e33cTbGG33VFexGcfp4y3HH7UhWtis
+3fOYuPIoLVCgCFlRnGltDSpXPzTCdJHkaWOyxFZM1vbuhX2JAnyCA3xHgbQ67UVCLJjJeXDOI1LTpbKUsXYHxElTma7oVg/SmTWVPy0r0J2rrNBVJS0Vn8Nb
+AzgIPMF0X0QM5QIV6TndYgs1g+8otq19KaGwCkTDjOb6nT7ccG8CAoRtkVy8yoL22WqnY46hAuQ4FyTe8OmlKgpt6R7cKeDw8sog0lDlgWReuDzISLKKqTUB
+OAsu+G2ccP6kLgvXZfgc/L2KpAsJ8Ogj0R0VEhwfCZszyMmvIJgWdFfPrbhcbCRcDFtk9RZYWhTywIgHb86LQWoJuY6kulNZUZFhk5sKU5fZ8SjI3VbVjVtO
+HkBRRLziVJlV1uLj7M4Eq3CuM+vKRQFIhJKKcRhh/meEF7adIXWShp5pi2OhQ8/aBoWGXRQMS/HCdLBSWjkkU3AK41WXM1MUDFpCtRb9ug2k6XN3c7Q1om/4
+VHe7TchTECoTFkvFIQ84ChHQBN6mWYbmL3G5ONW1uLMPEK+uJIpJfccUkTZHUTjVP0ywxZwqU6K7z0boUYfuWAa49tGahaaWK65cYzXh7dNtPVF2YXAgTD9/
+eyQo3XRHE567MmFH8AsYiaqDyLx/VcRfT1gxXxC+wqrCOifQs65rVvcLfWfqjNajx7FnNwOCZ0BtGEaareDzEUYERiXJjYf6FxQAc57T1z35YzqedORJuWvk
+NSi9EGS1D7I7dZvfAhMrq3sKJ+u5pbiB6C2XlmgGf9nvhpRNpDj1yIzl54nR8VZzeTuFhyIr7tZZhVm29rFivbzVZdBxyAq7uEjPsMZoYwijYqYfpeF2eQX3
+qpK3Whd2dHTQuxibbdQ3gPUGFTWi7MYGSAkNAxFWccNCfqjNenKQQLFStW06UNXxOvE6cYAe7MSvdrVMe8d5xfgbDcxcPBO7qiAU3v09pxLIgvN1ULZQuYmk
+AUoktE0jNByP3XdR+I0SE60gJpACa47zjXxwxMzmfCqewk08EErXVp50Tlr3o1VUjj2JRXREDwd+r9tNY2QdQrid2SiHbKt5yvxtvkJLYel0TzK5gwlrlgpp
+ILfwvLzpBaQYcFy4bLM3ft+AHOBc7ZhAI/0JquPI/sRWjb78LCfpFEDc049yMIxq3CRyZ+kfYUv/+DeoTLG0Farf9ycDKLQzmH6E6sFQ7QcK/aQTKM0MDr/E
+9T0RH2768Lc0TWR/MrkZ97kq8dloeO7iJ9DEXwbD80SmAyzew8NIz4Py14kIqjjbj6NrMf4o1rkOPkBydTC9TJNWFetENMphw5uPFcTu4QRCielxKseDyV9g
+4qcjrKj07zd9bAbcF9fpGKMArmhRx6zDOLyHoicnH0c3l+cCJiR+FpYHKw+lZ1h/iUs4j1MohD1NfBvyEMoewcc4KiWCqJTk2trdxIJHuERXKRVIOhtNpnZx
+hulZOpn0x58FeTNwDcbpdX8wprkeQ4VuoF6gjXN2CTWuw2pOsIFgRakwN0yqaNVeGo5sALE9fqg9dTP9OBoP/u/0XH5MxyntU9r6XZvW7ZaOzUJPRmfInrFT
+pJK4Hg3D8tZudic22SYQJ8TqkW+8nUZWrCslZKNtQQF7NNHMQtiEx/kjXBf4iCURAaFaZhU4ix3rt1dYmbYETTVGULTDtqEjwIZb0BFAgHESZ9XPlfQav1m4
+cIwuSwPGJl4PmYdCBnIOVP6EAQuSoHZgTNgSJoltC/VWcJZgHRTS49ZFxLFl0zLK9cq6JUjNQ40MV+5tD+t5DYaW/+MtyMoOlLa72ddFneUODKLn25Cd7HVm
+h43j2niL+6RDfUABb1ttYHjXtYEgAjkbgtARtvcy7u8TPDW8uC0OGljASP0JXHeoyPn+xV2yCVdBKghaIEtV6xJuvdsSzcfYCup0NOE6LlSWo+KD5DbYNLfA
+bvUmO4ZZUI4AnokH5f3n9FpPftpJbkMu/mZv2yRr3H/ouIhcnrbzdF7IydB4N/MT3qCMaXm53I7jTF2pWn1rYU3EW0j4u0ECP14lp7jGpaq42GmkbHUuuli4
+BGWFCTUkPrr3Dp8/57OMpqU2ooH+oyG6/dTgEPQsvVUnYE3QDD9oBNrrLxnlj5I9mm9c79or2IXsFDuQnaNwHAfsSIHiCaXKk8avq4MjBpNV6/KLTdTHDuxY
+kt3nVeyAs9FYsP24H1JVcahLZ6VdbtCTXRYLJNsl8lUiXyfyTSLf4if+nMjlGr5e4f+LeVYRg0BlZFDVOmzQr5cG8HNP91ohiiNfNrsl/UNhIOJs4WDrts5W
+9FVHxugOlujQzfvFprkWXfPnIk8hA3BzGp1f/W17wnry2gcFscsJ21pZKSjCl0Q0A1nBYS15qzeGHa+7DjJ2UfBGw+P/mo+/KjY733xcBIhA7ru98qp3koBj
+OgFzAv5zmsi3kKH5tvc6kX+Gn+HugZ/X5k47Cmxwx4Qx0mZ/Cj3TVaVKX8EML+1VqfnKgmZ1geE/DFzCoinATdAyBeFdPnbNI0dX/Z97oR43upCXA9bde0L8
+Ga79wRA0yfRH0G4nH/uXl6hOdsO0EiwdCurw4HzQHw/SSYI68sXF4HLQn6aTRIzG7v0pWhbTj+lgbDVv0MVHFxeDs3Q8SeT5APTgEfw1vbq+HH1OocH+8PxP
+0MoHUCDFYZ/16z6khPY/y/fpUSI/9n9MWe3l4ZD9MfzMjcrz/lX/AzQ3GNJPhPvJ9Q2MDOwD/8zZAHLW+pf+Z9DfQfiD9CfoJGj17kegsZNhEDxprYYR2mb2
+h58+9qeTUfpjOpaHTtcWHbo2+IZh+rAk7Hl/2of2rseji8F0ksRt46P98WACTUEzowuJicj4zKc+GDyXWPRwOsJJxDYHQztl0xH95GYYGAvwExEYYwnVhh2+
+eNRA476BtQK/HafYHTT00Fye8BYEo7E/uLwZp9ADtqQlVNYYjacuSJ3Q5ulDFdrJzeUU+d5FOh7jfiHTDh66GkzQRwY/u+x/xnT/0XU67tsyutNxfzjhp+IJ
+FLYbo4vQ/IT9NfqES3XWx1RusE9HNKvTj+lo/Dk6Sa6KrhgM6bT0wcaEGMXZNHwMOgND9BtADtMPl4MPKZv52KtPg0l6BE3h4qH5NcJu00KHy8trHnsfxnap
+mzWExbZVgx+mP0FwxduTWBhkIj+gOyIwIRMUFWJwIfvnPw7Q50CtXo8moQ0IlYTt5u/ZHnYKIkFSB+zw/vX1JW7L+Fifp/3pRzwGWHyrfykHw3+7GX/mnQGT
+ApsDvykwMPPdJJxZ3v3pT1g/AD4yOEN6q8v+J9h/HwfvSa6dfQw62RNPNs7lE41z8QTjXD7TOOfS1Pafkw5X1oS8DXBfodQ/7dlvPdUHlvbPAseLdCsmdghi
+Jy8TdjB1iMpHReHUir5nH0rBh1L+iody1xHsPHLbBKV4/pGTO4+ceNaRk7uOnHjukZNbj5x49pETUsrve9LXVoXnYaUv0w9Q5uOMH/t+i8fiVkfZzZzwUNXl
+eqvTQoDzxZrOH/BlMEv+LSwi3aqz9LJZDYBN7VLfqXJuSVBmpljkGTHiQHFqrxCix3zlomG3zJMTxnm7qvZ5UIYptNxoVYZ8myqAHCjueGnAUEYnHH3uQWGd
+AOgeWw0u2Qo14r+uy42s0ezmuFye1RbpxvFq0VULfUj5BaqW2c65tMw0PKVi25Q66oct7bgFh2cmtaqxBjhkrn2mbJnvQdBNmZujfmyJAz6RsDAv4qsFY4MZ
+/Rn/vjZSFzWDT9A36WqiQ4sQkUHGcvgVh/gdiRdamLZoehCoAfv4r1xm1uEJGizNt6a+l6oWtup5VqBnELGbtExoC1MxX17KB7WJ4t2RTS522eQJoWJgf+In
+kMEP/4ZQZA0nKrBZHDCtidkKdmLj+5b8OStL/cXMEI6u6tqUBRq2TP6oV0xMHi6Cr/j4hIVemJK8a4j7rDCt9ntwKKZdrMGW4FzqvNKUnW+pzjkpQH8JvGR8
+dCmphrHkTb7uGKuhClzTrrIYIJzA06OyHJpZxWwkLp+zNrLUMxisJBdaxX4TDp1XDYz8QlPuEkJMcIL1Bp7hin4zi2kNRuB+uTRrgrNB33C6CYI1/+t6fqcD
+MmCuWX/ck1eDyVl6edkfpqMbCAicHPdOOqAbS1iLVnVaonJgE/lWF3qR1c0qJk1m+w7eD70DZe6Z83fniQW1bxp+HpwC3vgBoQZfPq5EZNPnGyCHXFM0P6eN
+u82hi13dA6KhdIQj8J0Zb3aL8VuqutalcFR6GOld6bLStiLiDERNThES61GUpoQbqJi79C4utm7reIkY6lwlvheV94dalqtK3ur6QbMkt4wzzVRv+F3cbekq
+BZ8ck7Nf23hJeOBviqzWcznkTXRmii+WkLegxUNgE28gSz7EiWETRTmUH4yZVzKr2oUj5/T9V/T9XBV3awjQZFThBkZTdfuo5K17CtyqlVdCRJUhSIAUERbD
+cBIXKittXcdEWrRxVZfZDCBCC+a08ZvGAhzspMKM1YbcfXAnmFKW+m7NFWnJhciZKj5B2g0KWvOFPP0oXMcjcNm8JLWjg8ynkWNwcgw+Oq5X5HH5zVnD0Ane
+KZTy4K6RsJrXEzQ1ZIEMekXuRXjeXljQftclpdkrCd/aFauhtbEXpEVrsNy/3XQsCcMZ/eDd5CKmkon14b1lVmTL9ZKTioV3Ekb4Xt8QTVh07eY86W9oz7qk
+WXmvFR3ITrIc3Cy2Cj4Lri+6yODfwuXvwqOcauaK8jdnBrPuY3I2dvKuV+za5z3lLpqG65OvUvxEKAbesvYQrc6Bu9sOEvsPjlnYf1UHDLdGwCPyxISdZn3U
+Bck8gxr+HApJut85EWDJCMVBW1WiyuONOLGsDf4ck8pgCFW2zHJV4sVX1gDaFz5DyK9FzccaZ+DPblGD7RXeZjT7OO9emPl0fDr0/nxaMYOQfq8Xcru39pzP
+raqTFneIlbPT0JN/+tM///M/g6VnXRUQWJ7IdEj2qzwkT+X0Y38IzgZwMYDFPBjK9Ce0AWX/qPfP//zPf/qTEO4n8pDPQ3+uVhh1uCYoE3f7CHULdLyc/NDl
+p6aiOelk8GGI7s9P6Xs5GUxTfG8bGDCrqA71/3zmH3zpv6cFlipY57nLQez4yP/Ah/E//fm8JFhD14Pf0JXf7yUetOKRYBJsMEKY6HMNVh5CBj7pWznJav2r
+TDQkUoLQ6mp/99QPR9P0hx2bwNHKaItIIFKABPNl7jwtRSUokghn6DX+j9CMDtWR0GPyFT7ypud37anfteAQigGqxM5lliuqn95VyR6SfLZTGYkYbvfFR/5t
+ji0aDofV0Q/PXQPG0hIuMmRcjToPtciJ0XAXBfMPonuFcYZe/hD4gP7tZjyYnA/Q90Os3FQRhcy37orT250Pz912/7Mn/nvXVqmNpL245VMgRf+HH9GrH6Qv
+5T1IJ08ZyEFAQ+mLf8/1IivQqAjqbwVkohrrHPib35V23VERFCGv7pVKtIqXPXQWZs2qZm1an1bZoJ3c/vGenBruJIFImxUafL+S7Sno66qWSCmPEIaf6BL+
+5cBOCbUPc3ZrfqGjXGVcbBI+GoS42zNHR3eSXqa8B6WU70c/wVL2P4z71x/xJ1bugZSS/0P2m8XbuVR8zPHe86/CS++f9JIllLVYleaM/SAoWf7h3gBSyTwU
+GmG+VTbPVOme30b6Usj/WOsKJusdpRawX5JKjLmKrGPKEkWtwn+p2tG0iJo2JabWP9rNLb+GxmCYxunr16pkxPLh7VFjYs9+zYn1RPREQJ0z7M7/64FYOeVS
+/dWUmU8U/2KYcarWpa4ce/CWlTAl8RVExPde6G6Z5O19qsIeNbrSmK/zr5qvxqyIuAecfpIHZVI6R0CZnU2xKLzcS+QBt3Vgq2xpTlRcmQdKwqGvSlOyXxx+
+ST8jVCRdE0tVKK6RB3JuZfJsxtQ+qOHzgKxNUN+jKw9fhf1YVvfZCuzHxmRWiHyx1nQSCcbGRKe/4USjKyGadc8ogH4dTlUvTe7DIc9ZE/nYmoinr4l8ZE3E
+c9ZEPromorEmU7pZ1Tqvg1FBO/HyZNsv4agAKFyh2QKQExgVvBqNUzKDuAhRd6VQU8rUpbGh4vlKqlKLJ9zhgX75+gcufiDEzuwFF2u2dhsHFvvn/WtEyFzf
+vL8cnNkGxOGBL7vdn8iLwXgCkPir68sUzKz3n7cplPK/D4pKl1xNQS212EGcgYpTD0OiCE/hTpwPJteX/c/u3yFGRUBKxej85syCPhpB2IRANClc2I7JNx0P
+oALijykxM0OmRnouACTSnrQw/O/i4N9NZP8M4ugeExME7fvDcwExTpxZhxEhNM2UIp+uJTlO++eT9kI4PMFBBCE4gMblgXv9QPbHmO4wGKbnFL0P3u1LYOZs
+NCkHE3E5OutP/QvT9KepvBhcUrbG9DI9d5/tTX+Cj5zBWveHn0Epp6SHIZZld8kJ8HYPI9Wd3zwfpROKOtuW/GsJv9NAqSDmq385Gcn3qRy9n/ZxiH3K2bgY
+XV6OPiEugi38H56v3v/P/8mbU3RTWnWZmKzbT2waehfrW1TzpVGqSxUuPwi5FhMHf7C5QHGiD1cwYJ8OXuyAJtXznpzoKE3OVZxyYUXns7pzdkpUnNW6rap2
+zwOR8uYHSTX4eWFwC8BWfYods6sqsll4YSeaddxLJg/MCltGgjntkT7COyG7CzKKbLuQQVOher6t8DmdSFVUfKHBT6xCK7MlFl6rNfLc5OaBpm9Q0QC3l9GO
+KjC44nm908Zw/j9NKwP68t/l/2j+eDiin77f2AsNbyJ4mn2dT7hNuMwVjlRklRyOgDWGtID2YutwiaPr600jqs8SBbAgB4JUncBF4ZICEcyeOL+4+iXwi7sa
+Nb7aBZeFxJxc7lDGY8KWJNZ2sXoNhl/IJ0pJEOjbznN+VXTA/TGN5X1vq9+IY9f4CgXUAqIa5BvL5y8esrlOZMjIkQhwL3tkQW5PTETUERYE66qWa1EFdH65
+KjH+EBxFtHvQCbN9uZlrq9TkowYeiVvtUtzdRqFE2IQqzyWwj0jHdFn7VF0NloZXFbwAiSAnNz6qf3F/tUWiuLb7ylQqd5p3F/cdvmmYO9ksEHMS1dRpJLgU
+pqNGs11WW7iSdipb/O93zNEP8vDkyGngWE2hGTCOXG9znetaV7KbpvGwO3U7cuztcJpI4uCsTZvz5Z08PPX9jEqdEN9rbZ7RnYhglFRvrAZ5+PLIV8DYPkRu
+r+ocny2zEZT536nfnvVkirEkOCsQTorGjV3dZkrXJq6FY1OZusv77Kh/kDRLEYhfQw7ISA6Ib5IDMpQDYosc2FVPoSULZCgLxLfJAullgXiCLHDZRE9aKBFX
+yzCFtrCNrmqIWd0qFB3tWWdxBm8e2VhsQqWdYNg85njAOhquHWswUMfugt5SGjPcXlB49uTo+Ruz+7gh+6QQIBE4knAb5Mg8/yOY3PLYxMmuiXtn9RMpG3w4
+Fr3WKB7RnXZtSTGQIPOb5b+M5b84e+RsbLsDdrwiWvdA93PyR2aFeM7NsKWpb78cvraPdF2I5nUhu6+Lx78innSByNYFssWLJcT57juERU6cmwqDanLpKBFX
+VmsyVv3daIStnneJf/FrqYJfK/63lrO3QxARvd7XCWlWThPxqJDu6lSH+I0Fndgi6EICeC/dHlFuxbOFmzzvWm6UaGKLRAuea6uz4S/DE/uY7LI7KHx/h8AS
+WwWWDAXWlt502Q1bldotUqqjafFk3bYtmoLmgB8D0XEh3w4nwFd+eT3+vgEaDouniYObSTo+OOrMcsWGYmId1YIVoJXMLi0RFCtTclaaqnqBAgQempl1UeuS
+/k1VWomzjsFxEYIqJNsMJRFV0iL3P9exlNWmqvUykUtd35t5QhDkCjCmK1yUNbBk6C9IwMT0SIlUXD/FLORSFeuFmhGZcIjVRX+RWUQdOELu94LBZvhzUwYE
+481SLVYzuqm036puBbrSNaIudOZ3tygBplzQ3ippHu7tLq0iKzSEYb+3RBCExWQ+dPiCf5+4ymyPA1Q0F9uIuZ5g58xL9UA/b20/siXkrSODl7qY05BavZIr
+XWZmjrH9sPe+zKrwHbYzHQ48qgqsAndmRtWhg81MHa0Eq/oqKgHm1hOwdSimFzwfbi2J569yvG2RqeNnB5WFy3ZXe10/dGVl3MhF81xyt62F4jpKeGUH2F35
+tbW0bgKZ5QLv7Nsf5KQPYZh2WWLwkz+1huHu0tjPKV/Y6RB5YuVCwgIjaPLQoigthvEICecwePjNVQ1Fwz/wtKqGO1V9eyFRNzoLDjqGWSaXo2sX2+4JgXhK
+D4RMh+cTBFIyRvKf9n9+7T/968nli5Peca/+pf6tvnF8fHz85tUr/P/x8XHj/69ev311an9GPz85OT55/U/y+PeYgHVVq/Kfjo9/jUGGg/u7WX8QmxxTnoxu
+xmcu9iiEVSRPesfyhbxS5exenrxJ5Mn3338vr3OsvVZqNY8v9ZkqNSCBN/aqnJuHIjeKM7CyymlZPfl+E/6WEmgqRzZpn0vkxqxRX8JkG/y9AQ/9rVkXcR3f
+zhLd8DaT4ZrSVkzDpiwZ05ZchxWNkd+1PYVGLH7DDUUIIFzUhS5V/k6eO3xE1UhmigrhbELdk/SkB8911Ie6DfKM1dREDopZTx4e4I8PjpjgEh1IhVkXMyYB
+igzlSlAjjCBnpvIQ/06fCgoh8mWLEUKcW2ohm+sCaHphEW0ehu35AxdDPhhRJfAcudApDaGqLaxe1TIjBjzXxY7S6rv6+yXYj4dRTMv+ytk4WB0jq21la0tv
+2+Ku9QM8PODPgPHZ53QEG8xzoF1x0juRB32vfXFUk4BLVKbH1sSHrUMqAU8hKZaoUJtSOMq9RB5mR04fMmXDYLFGFrslCoPJTb5+pgvmCcIIEtmPy9muDX8e
+qZKz7AgQhXBMyQqhzMlG+pmvaWIhteHCUtElVO/iMoBRxg66HiyNq3VEi0Y17ahdl1YUcks7iuf2tIrPLBWCWZVbZhUa2TWtYvu0ym3Tip/vmlTROalZAW+U
+sRGfyFr9rAv21rc89aQ1R7OEkuZUHpzBN/ScjxrFkjuWqvm12AsOmzB6waWQNt4L0j9DrHDDBYB9eykPzvUqNxvXK94MnryL/Vx2J4RDCUh00S2DU5ZR5mIO
+39Z4DVGm+RedG+SClIfj/3Z+hN30jPwdadwijHe7VteNHrEnNOoWsxBid2zdBATRlXeqyP4Tmw9hiuP/dk6jVpV80HkO//cAQItWjNmHH+vD7Qb3HF8dsR8U
+f0R2fsmVD3A1XsmDoLi7XRJFMtsKf9gPumqsQ9vDQ7txdyH9DprCk95reRDtJ+oGdlnNKUNY1iYhJxv8A+S023RkHsADglNlqRCU/T1l03EFpXAMWMu4oA5n
+mOIF1/kc7S9R6ZKhrmDvwBaP9jzk6UgLdw46Ka0v0HaTc8GL2tbiZwOKZSjcfWGf3tleW2hzoR/oBasCNFKMocmWqwpuVU46bhQytqmDfiqEkxpvWje0FxtR
+EZNFUE4v0kxg6gw3kW9E28NHV81uDtmuzxUbsV7NVU08D+vVXanmpCWFykZltY1K7vy06ErMVLUnhvfpgazXZKGuo9jMpRtH3GsFw4FFgviBdX8/EM/GSe+t
+PAiGE07q/XqpClSRfdWf7ZNLCfioIGWuvA1HGuQy2JsVZU2Hc4qIYzNf57qSWS3sfgAVdk27wtP/kuBbqFmE4+VTQLXHKlunTFiANGzBLPfsfFlR1SrPg5zV
+sKrjoaGrD47eEU7Sn+XBZ7MmrBkIUY/0RmH4JZvbshNxugUzFHvcXif7CuCygtcyGEnze1GNGWpdeO23NHmF2bBtwHhWbceMJyCRE9ZHPAScxgbiwyHAkw7x
+n0jnz2kA9MsQB253HHXa474CQHeDHYXlSwTSX2SLGh2TM7wwXx//n0e+uhhXg1rXLt5S3auSTiMRT2BFjajBoE89IU578tpV47updPVOnnn6h/8mx5qy+ckm
+msSlmOjqiCkjRMsco9MZhxZx+r13mOmO0buE+hlypogW3bCz5TpUpq8AqsR6sFlQV78jcya2FHiUztuJuqIlJ2n0pDZybpo5OOK0d4JjA9sGVYwZFsPnSgIR
+P3irRbzBTekYmxpqHpOTP13xSlz5T5Je2C/yXZOLEuVEMQPTCY5DqR1RkLcNLFeEqysddTr2Y8OrHF8ngnRdq3Lj0rh59KiHa6qW5WQ6Zoer1UqrUjbsGsEr
+X8zlz1qvYNxwqJhIAVvusoToVsF09Sb5wzuqX+pd+J0BEFwOKs5kf9+4GcNLnHduWDse5tuvNo3A7g30dyw4rEwMM3jRsp7GpDbh97jkaE2vlHxiW1Edoouw
+4fzvLGLUEUMnVhFWVVChE3/lAL5vUBGiKqg24djyLMxm65KAxHawuBJX8RUIfQXPjgtki6ZvGTdgCz4FaiZj2vHpRsGXaEvgB+AqTHwp6HwTrCqpeqosN0Q+
+gzveF4B1aUVtc0rO12SHUi9cPd8AAG7PEH7EZi3icgmnOOV5e4SVS7ICGLXdDmSURcqoP73ySae3UnVWgYzJcz6VIb+Px26ftKKwDZ3PlwsMNGM6Mcj0AR9A
+AUSd1vPG4nvfl9MA4zqBYDsu5ZdMSQR7l6bIZv6gYHRc9+56/h5AVV7JB30rq6zWRwHvynbS+kZBwShkWsWZUTw54iWB4xNnaFvbQuV8C9r1atQebZ6fnnCr
+FZYgwunr0LB3zaZPU15Q+VzL+Q27n95prRbKgQedf9Hy8OT0SC5NUd97eISwZ9AC0akZujJQ6wKpJzP6li7fkSTA4XA9MO+1si4Ba+/yLXFvHnB2bvFKiQYY
+nzdQlBYZInSkWdd26UAuOei9ZQkJT+8C3MtC1fK+rlc//OlPDw8PPfAe6d7MLP9Ee7DCaf5TpJ737utlbuXb/Ehmi3Amo1nMChmoykmoQ5O1UECVbJY3IixI
+TwLD8bCRkVtXOl+ELoescGXKoosjEZFjtrFZWoudVR1kBpbpq/NSC+fRFLhWDI4odbBqzSsI6zLTWn7AU4S15WamqLK5dhWg+PRUUnkRhA6E4BQ6NJBgV10X
+BwNKNfhlqFTGLlOSdeHWU7EWGKuIXiek7+FQtmiBtK+7MWxs7JDv0ZSxWUJab8vJmrgCzoECCB+inSfa7ke72JVaalnNzEozdV7NdVutHusmlexrX06gd4Jv
+nPZO7Ya/fWxGs7ryieydE4r6N6rfIla/V7pcaZwobMhxJQZIwZ3TLp427fIJ0y6CaadTqxO2knXiq/4zraVdGkmkJJr+mUGQH1LW3OQgWLsxP0eR67Sh33e4
+lEXgDUxcgvByndcZfKXOWDEOr8OeEK96MvAUVj1/EVE4X4W/JtsT/IZNBxdhpC3o5zmuwubQ4EH6JFY+o/MOnoBcW/gTSgev5ll1JfGaDBOHlToU8+g5E51U
+YOscbgo9d7SLzUuvA9/XE+I1wGB8LqEpeMcF1J9d/Jq1LSfkdCdENrJ9Qy3YcEY7/THBLgcALW8gZ0WvAW/mzRUuMa4uT0moh8TASr7UqW28unm7wtv4cRgA
+blpDNY9npeY99aYn+77dKax6b5uC0yquLtvF1cVziqvziGkynbVdVEjYWgurm7PcY0nYhL3RZELYtTGOsES7QCdKGRRnbzqiXa0bO/i4DDueYl9vXfD1hj/G
+WY3KOm88Q2JUjx2Xpef3Pt2xIjLXvqsC3kyy9yzIuDlC2Sij/tmsBcapvJkZVh+WtfEVh5OwDvG9yed2b6pyidC7BUNl/dJlxWxdli7SZlGSVaXLOiBgpHZu
+bYki6z3uHAEWSbGg1WZ+bE/6bfyEMqgtcfFtdVBFow6qxBKnsXeho47p9oKlbXeZO2fPr1Aq7Lsqr0xwUOF1qp4TdfTRsqQiMrKDSYzqkvL+bVfTtHun8gmq
+T6idGUluC43r8B5DUQBIG/bJ52Nbj4NSXuPB2inlGj7Az2Mjt7AqYlXqFxxqAm2k1lWNUWQiVCRkDP2QQhmPfENQAT0b51nnc/YYL1SWr9lFG5fLgzPHwIes
+cGxuphS+2+RjmWWa5bG/k0I+RUcOTF/3BOui4VbsvhfRn67qQIxBg7qos5JKBmJ1TDEaDz4MhshheJ5GBB2WJQD4HpoUAYm8uf4w7p+nk2C9RMAbgG8Rvsn9
+7TtLUDEaH06O5KGFiHK1TmSe4HoRE/FnfO/75JFGgHzibHR5SQV+LqFIwEU6HlPdmf5EHuBLB0fC15qwxSQl1CEISpFy8R9fjjSxBSrC2qa+IEYi3t9MkVIC
+qwjgJ4m/g5/d2TgMtlHSFAoYTfrTweSiD6WJPkc1Sh+rdYoPDUfDsGgqc2NYAhmu8tDjKXWMGNxNqniD3BY3Q1oFTH5D2gvBRB3xdvk0uLyUV2k6lZ9HN+MI
+AOxr6OBrvirOhdjSzvtU3gwHw2k6Ht9cT6miLNbceXExTlPfHtffkYPhjpa40Gl6jsJlBIV4R2P5aTyYTqGexRDpWyxjC9S2OEvFhwHUunj/mecHfjG0288X
+TQkr6P6YcjmLs3Han6ay7w6IiIshDYbwBHCUfkzl5Gx07Tlb7Bs9DpmEhKqqbp93S6SQFbUu5qwr482CR99X3JRmIYv1LNeqlAs1y3IKzQmVlbNSLWpZqC+s
+riUx0zbnQlQkWrJS1qVaLLKZC74t1ew+K3QlHCcYAoPqeycYmw5dvgdQguaIWjRyrhXE8FaYA6NyAXUHSor6VaCvabm631TZjEKTuviSlaZAJ0rOdQlc0Y5L
+q8hQfQ45iAvkR2Uw4y5hGUvmurQeRbNQpAEJ9KH5pkATxWiXnsfNHBwlXqFJbA35hkpO9i+WRmV0Q13r5YoA+aWZOTuJtOW4ThtjT6w6ISxSU8vuHiF9XnhJ
+dz8mKyMCdGBh2CVoh50Vd44tz1cjxTvFV9S0IVT7ieYF5SOEIU8J5jRYF8fKoH5GAXp2xzP+QNDrNrrIphC7iqUN22PIfMHmmV6GAI6GvvF973RrYTpmpRqO
+5NlgfHZzNUF+pYmtToey4H2KL1ymwpYb8jXcXO02X/xtewUiW9JndIOSejTmslvDD1TDJq6shRKW6/w066hFolmAIHTVxuBipjrKWBXNk0HRSPtBASNfqshX
+Bd9en+gokcx7NfETGBUtCooHwcSJj/2JfJ+mw2eU7+JbbQr6B6zFOdM/heXTgnpYfBXaokbpVXr+Gb0UheFaGZTTZHddbWqVC28FsbMSHfF57oqftNVWjLFp
+CnhxYQob5Bdzk+eqrOTh//H6uHd8fET82XJaqrleKtY5g6bmRpNEJ3M+LlZrj1bt3hampH8hmVklGZKcyIMYsgw/uVIzOZrIn8K/y4kuv+jyQLINyJq+a182
+2oeICcoB70eMU+jpu+IKXj44YuOYn8QfWnWYUS1SF3NTUjATPOqmdh6lSs51CVVFhUuAjESJEIFdEsU3b10qP4dNg5qniiKYYCVy/QdefxGi+NwMyHWFlFXr
+bK5zuOX4ilNwIRk0HbZFJRAF8yf/5sKUL8s5u64xMAGb4aQnRxbKYe1gCs1XQcnfhKiSeVjEIyg5es/A+SeARx2xgik73BUW0B5HbA6ObMkOh4OP20TjujC1
+vG0WpuYbVDTq6/QevxyTwNC1biCU/l0d6IK40QDnGbpMipqNUt5H0HADVx1H1QOXGAws6ER9r5e8hXpOcIQgDBHFAWgsyyjkHeJMGyXkI6CpYBeun91GQkFM
+jnYCtVqiqqsnEBEIf9RIf+AQ/daa0nHRcY7QxCtscb18RwfeyWxBwlNluSMyp+cd8IERfJ31x235cdlRfvzlcavsuHBlx4PnLe4j0DKalZLgH7OsnK2X5K2G
+taxmZXYbuYIr+X3vxHptT1723hzeHiH1EAZKnzcjSVhBXs7XJStuOCPttBfQxLkUhi2ItPBpCZE2GnnlaPlPmWEC2o02QrNSOXmovO8wmLKqNiu8GmyFkUc2
+eOeWDrz3wrkptuigYXJBnEPciLt/3ztJBAvAua7q0mwa4KWuoMGWrx6yd5Y/K8TO7x75JJCsgIhyiVxwuiLPmgtW9WQf60S3CqrHYRq8UTAWRT7GZiV1S0rQ
+kZAdFjvfUXe6oyS2sCWxpS2JXd2jRfaVRbHj6ushIrZdgcSfrJdY/vzPCTh3To4TeXKSgCg7xYU9edmTQ6ac5KKB7MnNM+tahF651HqUM7qoeFO7yATi+W3x
+OvaImrK2IDsMZ5W6Wud1tOWKu2iEcd1G4aib4HlGEu2YH9d3KyJWpf7rep7NtOA0giA8QqCvpZ6joRpOAUv7lz15lVUzneeq0GYN/vWTl70TmSJxiLxUD7Jf
+VesSpVovAqJxjoVnEyGyEfrkC/pHGwIZAMnUur43ZfafpGlx6SssfFhJWwSQSjo9uKLyUZW8iDw8/sqDqjhYoueorHv3OlVA3FYRsO215VuTBqTn0fgg2quO
+RFbUBvPXYAWkK8iFT1aIR5fApgGLc9Ob9KRe3qryzug5MU+UGwvvxbqAG/SVk/jCx6elVtW6RMCXKuslBX0sccBkpWcZXhoBs+uQ+0CavW3Iv08KwnKpyxmo
+HlM8BmYhz3WRqVyOyrkuK8zmdD6BpgEOe8ElNhAUhIJ6Hnlm6yvlZsZhUY7mOxA6uJRNuX3SfCwrmClT+B/DLNCmPeXCFTi8tEDIcsm++Bb0Bci9cfQw2qzW
+ywNM59ELq8td9McAhTiGHFDfrE0O5UM6uy/QkzRXtbIKEN87kfZl0YWUYYeKIz89W1dw7ZdZvgmQe4YpSUHeN/oVS2PUxGwbGxmMKfeyptFP1MJ5HCKr/Gez
+QohmPVmYBpCjJyfycOraOVe1IqMMf3cqD62BKC2F8JHAAjJU0ybcded6YTliZ/eq0lUiz3GyX5/2Tk/fvnh7fPK6+S3x4oU882MbgB+Rvn96+rb39vT49MVL
+eTh2CxA82+oYHIjWD+V5CKWCdFFbzg7AWnAf4fmJN5jADWbTCtuQiY7l9gBCR8J68rL3Uo415V1Yqvdrtu7ktCX5WRwFdewqCoWh/lpIdaeL2QY5imu2BxP5
+V5NhSm2xnUmGS/VyTgkWf+NKhzYrEjVCHyl2vfFCgDcuHm5V+rwG4YJRyFmPYAdrxxB8G3dcg8H+5GXvlRwUc73SBYqDc28gIZvUPZfybF+P2XKlstKZ5C6+
+yGuVeHARG13MnoWfd5YYuf7qRIIFr+uujFfYpiY3dxt2PJDDAQUg44Q8GsuUrkjagmvhVkJVcTUFUj1qzbkoDZRxiPVI4q+L+OsOlWDH5xFlNJgoYZNm+7X8
+pLIvusQ8D1fRricv2P3u8Ci1sQX6ussgiq4dO9egiEhFFZsxr3exxv3IbS2dNaXqaI8K94GvrQYpo2qQ4onVIF3vo2KQYR7ky94bOYGwgo0UoAt+sHD8Xgxp
+UFTK12b+aTS7Ql1GLLJiXu0uKpkEGZw+IEuF7aIKkwl53xsN6ZYGya/Mt9FSgxJKzNSiMlKh1aF9BUo9M4VZZjNbx7ZybiVvFbObKvFuAluUUnTqtQ7ZkBVU
+fM7usjl/uYd6UoNKTjR4MqOyZbZSOWLwqzANgpDZaFtTEJ8PuOVmQqWKXA62iLS3OE75afmSjj46Ayjk0y7HyZq3WfB1YFtJOmWXt5p9dISlriAwf/AIeLvs
+pMH28SH9+EaiNJ+meSuwbvJTzVDc8W/lOZUAl2NdmXxd+3Ppid2sSLPlwmXpnrUVdEV0r2wt420pxdTPTGFhNayhQUdCwfxzsxrGcqbybGHKIlP+ovLfYHAT
+nPrgurKxwvhAWkzyF03bkcwDxPfhzxd6rkuV2xrd7FTCibQd6qgLHCtv31ADWFg041fVAH7Z+7NMCbXRt9ixd0Hht0v10NA9Zsbz+NEeh7eFR549VhdZ7qyL
+LKfhteHkcxNlGhbMjQ1GK1/wn2AthjuBbU5cnFtDxrD/PbQaFpyemWKRo3gAtUhB2YNP0E1n0nhzhkYLcrYg5rd/X+tbPUvkmSrUXCWNwm+zXK0rLZgx5odW
+8VPam4usXLorjKufAqaTEEb8i8BhYjHtzbSAqlHxlKud9uSlrtxnTVFL/Ut2h3UfBOJwSZejy7KWGlZuXclcV0HLM1MU+hddycpk8HSp5xl4RXQhVXGXq6zq
++fqnPSEOri3zwJnL+wOvJxIPddLi9PNcskY/1hWEl+a8S8Jk/KpNdgEyuQWJbzwVmVQumVyVupkAtIu8JiRTghpy4jv+zXdHbU8Jrhj2nDdjVoThJAdddcoF
+szFxVkXMAWh78JREFhieKLWay6y2/E0BIxN0iatFdUR+8tybvLtrwjSeEGijy+8Q7vUdF4WRu4vCpAOMIXfgo4QHfkHE+b3HW00QcIXRXQ+MCvBU7pM+OJ8I
+AtDYpztAU8njyCgsezR8ESKj3JpV7RI28utK2Ih2CZsD8ST+t5O/If/bmzdv3rb43073/G9/JP63E/lC9ldllsuT739F/jeq0vOVBHCyQQAnvoEALk5IEXsC
+uD86AdzJ35gArhuCx7wfgSfPCfGwOEScYs5J97m+0/N848KrBE6zfD6b76pHEgVLPB/eMxSjCSXDCWUDTmjZxFqpcqxNfTuvHedxOPbpJ1HZUQKeeJTKjkHx
+uyjsuNvi16KwC/QW8c0UdtZIEDso7L5qAsWTSOu2T183WZ3YSVYnt5DVvfwDk9W92pPV/YHI6l7/Ecjq3uzJ6v4IZHVvt5DVWVKtJxPWCU9YJ7+VsE4EhHXy
+GwnrxPZPP5OwTsSEdXIHYZ2/i+DBgHrCpq4z7rVFDQSvfdVEWva3PUXeYxR53+8p8vYUef8YFHmio4TX0znyrCc3ZsoTlilP7pny9kx5e6a8PVPenilvz5T3
+X5Upj1NdOrZqaMx1J2KhQkCHTzCGrUWnR+7LZ9DoCSo732DPk1/JnpeIbOFnO+bS+7zn0ttz6XVrizuo3cTTqd0eZ9QTvP9+BUY9EUmrvzdGPfG0yX8Cr57o
+mPxv4NUTwKsn97x6e169Pa/enldvz6u359Xb8+rtefX2vHp7Xr09r94fiFdP7on19sR6vwKxnpWQnCFuMyYVaUCWj40Dlu1576bg2waaaxDtCUwU+0pSPW/E
+gxoY0OvJb6fXE0yvJ59Kr1etq5WmrjTI9SK6e+sT2W49b+813NxZLpRc4I/mukVewVVUZeYdli4hsmSLl3eKmi+zAr6qILIpKJl5e9pkkNuVZ4vaLjeMGZUo
+FXiiBTqfIQo7uw+fceA8nxf5KLWg2EEtKJ9FLSgeoRYkjh1st2t+bxE6DbOK/lUH30RcAOgokA7qoGiUm7Wx8XWyG01pXXToLit1tV7qX4F/ahd5I08KzDes
+W3PZ/JLUJmqncRBuN47j8etXQTyB4BGEEjXBB6pqnqhtIy22pKl3pCDXxocd0X9mFUKSRM2oS5zhmpCVVWpAeTfFjGhDWtGd6AUGOaAIpWHz7fONLPSdqTNy
+hS9M6SWd9b16aFGAB9zzYe75MPd8mH+vfJjyK+gwxVY6TPlcOkyxp8Pc02Hu6TD/8ekwgXzuNVBgij0F5tdsarGN5ePpPJY27Ug8zj4pn84+KXazT8rnsE+K
+R9gn5TPZJ8Uj7JPyGeyT4lH2SflE9knxJPZJ+WT2SfFk9kn5CPukeDL7pHyEfVI8g31yT+T3jUR+8lt5/ITj8ZO/Do+fgG/Jf2wev9M9j98WHr+Xex6/b+Px
+s6Dm59D5vdrT+T2bzu/1ns5vT+f3X4rO782ezu8fgM7v7Z7Ob0/n98eg83txenx8/HROPzF1zHjfyOkn2LqS387ph5xAwOknQwqhr+T0E9598Stx+gnKIf3b
+cPpZqNk/LKefiEj95DeT+okGqZ98Pqnfs/jfkP/v9G/J/3d6/KbF/3fycs//93v8eUS69eRJ7/T3Z7aD5JLO1NU2YZ14CmGdfCJhnXiUsE4+i7BOeMK60y2E
+ddaWO2ryz8md/HN7urY9XdtvRNd2CnRtBc2ZCUhoNmwrEWATmWeoQ5gBUDlTEee6snEK/C0bOM3M+z01XDc1nO3ltbVNb5gUSuyii5NfQRcnttLFycfp4prd
+FDfPo5B7tF97CrnHKeQ6jgpdvvpLBpZbUyLE50ZuPTdbGggOkNh1gHaQ2ck2mZ34ajI72SazE19NZidbZHbim8js5J7M7h+UzC6UzHYt2ffaJMGM2dsC2ijw
+eRnUBpwTEgI12RfrZATMbRDaXa3LlakgPNgPm/wOvyyaX84K+ACrG3KmVmoGNzYcQNjYi2yG7ENLuOA0BIY0pEsmIaJX2JAJXgUYYrRZEaZcmZKvna03xqHv
+PAw0Hs6RB1n+xxq8cdi3cF4tJ96eOPAx4sCT4z1z4J450DEH/j0TBzrKEvGH4g0Mkp+2MpBsoRMUnXSC8mvoBEVoKXTaCDFJ2RZuMsdj8M0Ug8LTvnw7xaBo
+qbFfTTHYDLc1iHSYuO0b+AZb1+3X8w0Kzzcov5VvUDT4BuVX8Q2ivDn9ugPQRZ0nnrcrv4k6TzzCvvWusQsctWBTzmS1V1BUXmo1h+syq+okICEUTyQhlCEJ
+YYejBrenv6oshxG2VNE1VpabFmFZFfsqYVBkOxJwld5uUj3CPqOnmEkupvTZ1uFH6AXFVkqzNpAltkXaVICiSQUoHRXg17DuiS7WPflM1j0M5wlGC69QyKjb
+fBOc8GdSCQrPtog2yCMki78mA55dcOFF4H8BKrzT3sunM3KoHG7pu/vAgY1HO3CMCt6wEU0eyZCs7HQuds2AzzcmaChoQVK5GvpSldpf6s4wZQ9m0BuPeos8
+aE6EuaoyhNAgVap8EmXbIshJY4V0x7S4C1/GLETkyxYLz0B0i/xpNdnI8QcgWqzn0hRxpoVZRF0Vra6GSFLZb1IahuZOkHQDB6vJcxjemCEfUwXZzl3MUdB8
+pWfrUgeztXNeC63nek5wtWJDVpb+RS0RbQs/JIenIIdnTB1GPjWKHcCXFaiu1lfaEEnxVUzxLMpGbg3AYppxL9lP2SJetlWbN9Pwce2ZJltMk4dBaiSt39Ez
+2SfFNl2ryUcpH+OjFE/mo5T/lfgody2ReNIS7Rkp94yUe0bKPSPl34CRskMNDKjOHY/315NUipikUn4jSSWOlVwqQZcj6kr5HOpKEVNXIrymoYw26Szlns5y
+T2f5W9FZRg39NmyW2z7xxySzjEyPb+OyPBv9mAL14+9PZbmDglIwBaXcRXcpme7yiD5xeUmkPoP3N9PReCIdeaX4w5JXJjBlXeyVCTz8GE4aH+qfnd2M+2fU
+1L/fDNKpTIf/NvoMmOmki99S7uS3hBfS/tlHEUxlm/Sy/6E/GE6mEoknL9IxUCThHiHiJtcB+la8x5KdlJmSKTPDNxqMmSJmzOyky9zSRpMtUzi2zG1kmdsb
+anNlise4MmXMlZk8gSlTMLNVuBxbyDO3U2E2cue/jQlTWiZM8e1MmNIyYYptTJjxFbKLCFMyEaZ4FhHm9z06y130aMhLlsr0pylsZdj/1+MR5t6k57CIl/1P
+IBxg+YH7aypC4rSOdXNcavJJXGriKVxq8mlcamIXl1p8Oh+hUhNPolKTT6JSE0+iUqMb5uxjNJdNejXx9fRqsoteTWyhV5uMrlL5bzfjweR8wLLrfAStwt0y
++oTtbdlQ0EW/4FuZ8hI5cWvo2rnqf6aPXF9fwtqJz6ObR7ne5KNcb+SkEZZizFV0Z//n7aaR73v0JHY4j9r4O2GHS+TBv6+z2c/TbKkPEuH/ISd1qRVQMAVE
+cvJZRHJiJ5GctERypnRgXNtm3GTcYtM4BZX960joBJPQyf8yJHSTzoJpPkDRhbRshaiezWG3la1ONE3rPU3e3xNN3p6dbs9Ot2en27PT/SrsdGYbMV1A2vOr
+ENPJmJhOfD0xXXNqxFcT07k8jz0r3Z6Vbs9K93uz0sHjS1Pc4Z25LQq2i61ObGOrk3u2ur8RW91vQ1PXhZFzzHViJ9fa85nrRBdznfwa5jqxnblOPpO5TuyZ
+6/bMdXvmuj1z3Z65bs9c9w/KXPe//5enrvvf/+truOv+9/+aQzNfz1538gz2uj8qfd0p0dd9x7/5bk9fx/R13yG85Lt/PPq6hGAYIkBBEJ/dt9HZiSadnfxd
+6ez2f76G/++0d/w35P97e/z2ZM//9zdbf5BJ1zfvLwdncjK6GZ+lFpUhBN8P8rR3LF/I/voOlPg3iTw9Pn5pJQMK5kjxnalSk11iU4c4qdG5cK1E7sn3m/C3
+EjE+TrLbxxLM80RHJ6ht+HsjbrW8NeviETYmMGrh7bkhEHUpZ6qAv7moho2ydCSqrmiI/K7tqGQkEso/OxLB4b6hqfUPclDIf1PFWpUbmKu31lPFybnop7YU
+Khw3R2uOY5qxInEAnaTf8L/Jz4pteexvqRc5Fb3EVNmYA0FstCor5viZJ+z/Z1OHWqpIYcO2MPRq9YfrySVsgN5vxgL59dyProyn+BW4H2XA/Sh+Be5HB7iG
+07PndtxzO+65Hf9Q3I7pL8Swkm9kRPNIBwypz269B95ttMgRLkBYNfxVVl5ZSqJ8E5IS5VQ2WRWbOHcBvEoRQR3teRlD6N1qq8pxzUXJeonMCvRtP6gNlx8H
+1zKR7n3hxP7tUWA513mGCTro8UWXaCJsXqFnLGgAaXGzKTnL0aL2g4J9uydl3JMy7kkZ96SMvyEpI3My7nkCH+MJ/H5PE7inCXQ0gXLPE/hr8ATeFI6uC2+W
+Z/CmWZK/fBPiNUI27pBPlYR2S20FO/NW1dkS0khtV0QMlGwMhsF5FpbU4mZ1VLOeUFDsCQX/WISC0pTtvYBjFF0sg/IbWAZFE3P+DSyDoskyeNV9eDhnuzUl
+2w6V6DhU8usPlWhb3PhgbI49/Rw9QoHI7AqVkUutaWPSya1C+sM9UeLfiiiRT/sMMVLwdlsKd3V2K7OiCEfYaqtFssgwHK8M4wpQzol4uDdL/BL6b7sa6+qa
+KTtcrD3RxV/4eP9wjLfaW5R01L1S4aGmDZ/n42SQ9J54Ihlke12a+1o4Xkj5JF5I22I3QaSICSLlVxFE+k6LbRvgWVyRTHZ43iitkXrTwFKdYHKmteYTm5HT
+loaRGD58HLBwtIOJUnQwUcqvY6IUu5go5XOYKMUuJkr5bCZK8QgT5SsImZRzhFZvWBvtPZ+dUuygYXwuO6XYyU4pn8FOKZ7ATimfzk4pHmGnlM9hpxQ72Snl
+c9gpxSPslPI57JRiFzulfBY7pdjJTimfx04pHmGnlE9mpxRPYKeUT2anFI+wU8q/GTtl0kmN2BlhKfVMZ18g3BgVQmqy7LW/sZvLMhGOQzF5PoFii+NSfDWB
+YgfHpXgGgWKyLQITa+ziMepL+WTqS7Gd+lKqW/NF77kT99yJe+7EPXfinjtxz524507ccyfuuRP33Il77sQ9d+KeO3HPnbjnTtxzJ+65E3837sQrNfMkiQ1u
+RNnFjSge4UZMLERW8j9zc2d+C8bE+EP0HbGFRVF2sSh6Ui5mfFtzYJ8p8eDTGICJcML1PTduFp4EZDetojDlI7yM8sm8jCJy6TyJoVFuZWgUz2VolHuGxj1D
+456hcc/Q+EdnaHzXQArhD+U8Iz/mIiur+hltOy8fLKOl+bDu/78rMsjn8jbKiLdRfBNvY8OV9c28jbLB2yi+gbdRxryN4ht5G2WLt1F8O29jNDjxTbyNssHb
+KPa8jXvexj1v498Pb6PcEzfuiRv3xI174sY9ceOeuHFP3LgnbtwTN+6JG/9GxI1vQwKivyO6RiQU+gPQNRrwEmAXA0+6WlX5n/aUjXvKxv2fv4c//duq3uS6
++tvxPx6fvHz5qsn/+Prl6z3/4+/xBy+4rJL963Hav3p/2ZumPyUOX3vSOznWiXwos7rWBShFH1VRvfioy6UqCvkeYsTi8OPH96Pz9P86H01uhoOT3nsQY9Oj
+xEmc99ntVP8k/79qrlaYAvidXKhllm/iD/WEmLDMwnvLdel8dIZtKTnXtcryZqqfvactoUtWRfeGlcaBBP7clSfQEyLQE85QTwAf+vffn3YN+5qtVeRGcpG3
+2lAuRSNJw3I0RAWsMq/eNKI+ESeCDUmR2pBVzk42hfsV5uGx2oLXoDO4ejt6Cp9o9tSlVH+JIN1hX/11EGScwwKFo9ygYdwelkWnYqAATGmLIEAupcbSdYGe
+O4ZPhEXg6LU2jyl0b39FPeFPf25uNSjDb367G+AR+f/y5Zvjpvx/+ertXv7/busvJ4TmBEvI0tLOD8ujKGva6pHOfvdWFRhVsIXE9tbIwGL9svQGVotFePcn
+W9zC1qoAjyG+OKOEz+09cZnRhmiFZZAtiZxDRDkUJ1iKwryghKsmGVGYYOlFdkhKFKQ6rkoIY2mSeQrzr4m6DZw/jo/BJ0Tan7jMyFbuX1aFA/fYmtYXskLy
+g6aMWNmgYRdORZKy2tMGhJmAbLEhhuxg+/weUAoVO+G31/YV2zFk4Yh8gb7MlE4T8XdAT4gYl+ATqTB7ymVI4dRRatX2zhM1HWVlkH8ek6pMVSUc+04cCWyJ
+3qF1VlcRCVxdm7LQm+8qsdDaEcRmNHaOj+NX0O+5pp/PG1wNjVmAqQe8KBN070zkOECUPlioF/2byylgI0MNaJqefRwOzvqXgnM6LByYTeD0PDCCu+3bRDaS
+JMSOJIm2oYu93GbrOlRuf5w6gxsQ+P3LCX1kjEOPcokuWlZwIqeD6SVi6xsZE70Qz81A+6v+2Xh0lZ4P+jj26QTzXS4H6XjiId3CQroJu+1R3Ek3yDv9Kb26
+vuyPPyctILCweOXD7XOKiSfX49HZzdgldUxu3k+mg+nNNJUfRqNzcFeISTqGxIPJO3k5muB034Cn4Lw/7eOHr8eji8F08g7+/v5mMsBZdwkSg9HwSH4cfUp/
+TMfirH8Dyw/LMxrSZnEIcIdxdjBxmEePC59Mx4OzafAYTP12iHiEBj9ycPfBkLX0zxb5DrklNwTSbp6AAEROCHH5KEJ8rxN2638f8s3q/jdTAB/T/47fvm7o
+f6fHr97s9b/f40/LM/42Ofn++z8np8fHpwn5yLdflg3LMiS90IDbKTXGKKzi1ODBuLV8wORrFk07UzlKVEcAD88lNqE15ISIuCJsEn+lWxWjGxRBDHgmNLrF
+Z3iqRwBWNKjbfhDihRyaBpxRz7Oa61dgI8ShFyPY7KdhppARRc/fCeQVe0GROSCYeJbV76JWjAxDaJfaPebe77Zo+CqTYhA6EjL3W3qpVfoEc0a35+t5i+9i
+6ppZ8ZpffNqqx54L4RoKHBUROokwVLfaT400Lm+8NSZnoNASsq4c4zEJq+ny+jn/GMP+a6tHL6D/VGzb09lmxQwdImDQoLMf/oIhaMwTx3+6jWoxQwzsTITj
+tUUiN1PI2pS1PPQKrlXIfYBAFvouz+40o6w4Fu1Sk44S0WLIZaSYKSUYGERKdleadTGvENgtswULHpegr+bIGyCQ9WBugS61kT9j6QWGf5jKUQZZIDkPjeLf
+1OhS1brMVF6JiMGK4kdWmcb4UY/fiCAalkQKD7L1JCaiqlW9rk25SQKvomXlgAMZQw5anQnMCNGB9HWpBjZuDPDEe1XUjtWjlIusLhwdRMBgIJiJ0nJUNomr
+YIuFOTXOUL9TpSPnw+4K1929MvUP8Ke/WK5UWem/Zfzn9duW/+/18d7/97v8oTjHn/8McY4/f48gzGf78DxIgtNLQYdAJNsqI6IXWerQre9JO38gLP4RYX84
+NsDqjUWZVQw5tFqOhcGgkiHEKeIf7Q/8feECGRnV+HjABDHbuL0cQj1OEPN8tcpma7PGSgjFPEPoDahNpqonSMyfICAtVxsZ/kw5x5LC+MZdVtWIhvMppvBV
+EqKd0wtOnjTiFXifQsbxYCIvbsbDweQjWOdwOSXws8nN+39Lz6ZyOpLgXvmQOj/PcDQdnKWEYJh8HN1cYlY0URoMJ9PxDbXTl2ejq6vBFN0LQFlwPnqfysnn
+yTS9Ah/B2Wh8PRr3gQBBbP+d7E8mN1dIHyHH6eR6NPTG99g7BGxGvEA2hgmnqhO1BbqXrvp/SVt+HUc2csjuqcT5mMD3Me1PIVf88xEOHT/PM4LOgmAqiSjD
+EYaEWI4heqREgznkMYBGG54x6eLiEN1cHH+Yq7Ofq/k8K35L8f+I/D998+q0Vf/x5V7+/67rLy9K7WBnrqzKoS0A+edEDs0XDSxa8gTviu+/P5KtaPkr/M3r
+hPwIEh0J+Kywn0mLWperMkNOdHGli9xAnsrPSQSkhTyZfvdtA4Is/SGGimaVwMRamwqhCNfOJsGH4Y19soq5sOC3OG6XwXMBFgjbyQOblGZhelWt0HrKN0Eq
+cJAE3PE9W34SzQzEPGSqdNi76FFXbdKHwvKocsAvdakw/tUTXdPZKMnha4wx4tia/PALyi4IIZ21CWz0Bywk5i/pMG7PL4QGYe2cF0y2LrzPQpXqrlSr+yOq
+6oL6AA6nMLbuZOI4FSlZxVaTqhi4iNYtPms/Rlmwru2Q7P6YQkCWTNoV8aGM4FWYXQqjhKcplSMkNcycIb8iEw7Gw281SVwp8wEiTVUDtZEV7jMJ5bFlMZiy
++RlYBC6JZpnUGIi5LupyE5K1teDImG2bq5kNhu6Yojc2gRu+izU2FMLlcUPPXN1PRj6j5yN8kHtDSZTHngXhCuHVrAx21AKlyWnU7QLTHXlJDuyO/nBvqprW
+qneA8caDa3r4ILG879jXViEssu+vPQZI+cXQc8Gl5siEdmsExyYnYryOI4UgW67caUoo3UnhahA3zL3U0W9oteAEDIZrNwYL5z544UAUjJQK/EVhg56ZvFFx
+wudQU756WWcL/YvLn0RksxB9eYCj9y4dP1G2TFLQ32tfFwvEaMN/Zo8PP5UEmaUy69iZwS3Rpyzzk+/fvklw6QRIalmXqqhyl8ahIp28J8T7z/JqdD64+IyE
+SGNQ3YgkyLL7XI9HH8b9K3nIAbpPo/Ff5Ps+RoSG4RNHCbDqYODuDJi1kMqsf3aWXk/7GJS6iKmVpiOg/oG4o6Wfg9jgNB2j2nguAua3C4zxXX/GUF7Uw9E4
+6H7YXeASG43/MqGuitFQDqZ4v33E1zDGNv3YH8Z9+gAK6gS6Lq/T8dVgMkEOpxF/BNRi9/m0+T3o/nk6HvyIzGP0eeQIhIArkx1B4NWzYAliwepBmA2+aamQ
+cNL4TTchEc8eP/lYtzgbu+fvTCHsiWvcaQDXiPMbLJ1JDDOD5yiLithrfTkVl4mF98vWqhQo8G2biWU1+H/Z+9fltpEtTRie33kV+SnijRInYJYlH2pXeWJi
+aIkuc7csaUhpu/12dPQkyaSIbRDJAUDJ7B9f9D30HfaVvLEOeQJAinK5Dntv+EeVTRKJPOfKtdbzPIlwpUdk1IHs0h7ln2DNhDouatuahZelFYJ9jtO+7icu
+sa5Xc+1zgd/VkkCoq4heXBPcCtnszaayZ1I2T+zFeKXn6WbVD/RevP5OtNLZmU9++7bNpNaKQIQKN+rDaiGlPO3HSmX44U1oEjV1mYNJETzKXX3ekl5R2+Z2
+7pDIUBRsuiKUD3LkToHvlDJREf2n5zK1zANbG/xB2hxEY+Q5S83hDCFndVBTj3B2SrLHpHhhSyM3r590WnCle7HmbNtzpVlpx0RloTzcmXbIhSmaz3DeDrzB
+Ps68IlbbL6yRLUsc2cABRZaO3rC+EZxTCHJneYHS0zajnuFSbUo8fXS+WXFQTZhF2McKyLeQu7BHVoCfDCtdLc0c52DYtTLN702G3Pu2fT4UwxAdqv+qBK0Y
+hDYpeZ+aLI7qBZMN5lraQ5+QtVTdHMulckwMs5kuy0g+pKxMoZgNttBVkep7lQnbudDxTGQlF5rVFXBpE7MjP0FwdV/oMc4nvAsJkz/L0twNd68tMZeLhg7g
+UNwzD47nfQhNSw1LdwNWk/Z3rlpF3Of3JtusWFDH1435xaH7zUIWKp+bFfJK9AJwFPWTRfFxZ3iKqsjUswYSspXYSkfaIM1ZCxhSO+nwt9F0Fo6pbgHzIqCF
+KtmQhvfmsta10uqHEskmXjQFbh9rqItrXVrSpI80nYiDg4XtmI4Yn+vLY07UF3vXRb8HczANN7zmTCz0ytxzviLZ48+cOirCfOuqf14iKxDxjbZ9dHhSD2tL
+QEzwZnd0Cas3x6+gMc6yuo6O/w0RFbpV6+zfWGPNRrZKGe8vgcRtUGQdUzbdBmtcHDNUDrfVGfc9NDaKbvfkAZNMuEk25G3Nu5yxJvaSenb+bHz1AYgT7nJd
+pTNZqTVX3qwpqd1uD/ignwoxxyv5yOOZkaVl1e8F01zgNI82Quyhg+Y6VzXONpBTYz4Ty9md+vc017X67Z+q054cuL07vOA6AEFUV+iU8HJQP2JIh7+KTYMm
+W9qWzpxNVaYkKUXaBn35jokzVCY24OMoNjnM9V7tPT74T3s/SkwwQ57ZVOtNxRNBz63Ng9foACkflFSvrDVxcHtIF1g/p6ftUdFC7TFZjmszdKlwV6a4CLP2
+ceMiq6FPzGheFiRWIwyRxR75UQddW2uq1Vy2unPl0hoNDXp3D7WFz1x6sHDpwTXLDZ9hegp5fAS/QJX5Hh/I5IIjWLMz1CPDdmvb65UFP7J3qKYnKFr1BPcW
+/13Zri8ovL5goM9CZvwCk55aiw5q6/xcoi6atq9alJvj3XeYhpxjzgv8VehnK5VmUs3niI86JgO2l8T1h3+4nALm4COtRD6BPu3u06lztbHeEs0AXEKVzJ20
+SWUESVta2zrV5WPabPT61MUE8Z05HDDgt4VzrkRbXuXzkrgvlWVufVjqHFaF72FWmkwrJP/DASIxCluwZeIrPac2pj2tizS3JDgYKAT+0zw3m5xYZ4Lur/nh
+WvO/VDSsONUF7kFO8kUem0LqrNSJLNU2mph8lkvlftwDr91syYUSwweKUBDZhFUiKKzyTU1sCFuOu1MbEEy0sUFWNhkLikWpvMrI+1Q/OJ9pfRz9KeNuz/De
+vjwmhSC83FmParCbBkPKbHk89DgkpfBjwoL20P/hyJBVVjkdJF3SGY9VT6Q1qlMYUHk0mJpNJafmy1Hi+6Ymf9aYDF4WNehG4UzmiMvyTaONpIDY0lR7iIu4
+za3NjGtLh1m9xrU52+/h2roPlrazkXxpbrScKsbMFCTLg9OdeeGdxRk6LhI511lKFDJ4YyKfQERvh3gSuoqyjxO7gfdRdBgHJfIFGQ9K8nOSQxYcyIWeO94y
+Fw/wYv+iReyfZPBilX3y77vWhi+nN6osE1VbtbIMNuYNHA2pP/QgDrShAJblUdPM0rtQM008EqnLsrS/xzegt7h0mBvLxQ+VS/neSoxJZaWyTIXRkKBFCDix
+p2ZNQtkPNJ5eZrU2udVDoi2Jp15oZB+nufUvewjSNM1VgfmNq54veqX+agpfbimOqfKw9D7rItcZ8wcbCYY4V5433/yOZQmkY+iKmyaKDXSwO0Bx06vtbuEw
+hbcwamfqbQmRVrQ5F9rm83ISaWlgpdz3WvoQbSPGMGEKZ4qpozanzjJeR12dELOz+0109nO/Kldq22ZKKg2BFWN/zPkt7h4QbgozJnOabpv7uX/eEbkRQRA2
+iDldv/dbnr0wtnGKsOkaEcDSwsdeTHsRtUkgY27JZiOfrmmqdrGuprZTvYUrGUkUR4sGDCtYFTXUXBrQX0Ms2LYQdtF7lUEnB8z82G6W6Z3rMr3LcVUjvRRu
+xbkvxZcguQRhS2hsIw4wB+cGlkbxuRKjMk2nZ7zbYqItKcnWjCx7kYKJr1Hnr1kFgVVQGCN28zXoIvLavugznY67sLHarRCXxg5FqM9Xc+7oNj3ABgFmOEMo
+TbxNQjIiJWx/i/BvcR6yFjkrZB9TVaVX6ypgV6xM/Hbh377n5Wkp703Kd0hkZIuZqR0nm0BTnfusrVZOPs/xYS2NpXNihyG55NGxa4MnFozYUqTnU8RSKEXA
+MzDbis1FaVyyAMVkuQKeARnJ9TwBEc2Ol315hWu45u5n471++f+edWyal2h/FYd3zXQBZynHqlNNe5OnR0ykizDBrFHzOR3qQI2WVvJOG4yaE9ttWAef8y6I
+3YyWPQWV+V2JLA17VcJH0zIQPaC7vWdFFe5pSZksm9LTp8kRe8VmqHdWt5Jt/qDVenY1VKVMF8FWjd9bNrIasaSUMtbm5GREGzsMwaY3VxgJpDT55KhBsNRv
+RWkKp/NFRdaplyLcaSL3AyPFtwGb2sw98QQRLXgzoDjHQzkeTf5JDiZWRYiFvbCw6+EYUw9dVDnqSquj1bfZmeH30M9D1qca/WVIIWvKsWQtnwlWDsLRl8Oz
+4WQyGH+SBAPF/hoPrwejMYFOUcBqdHUJayqAvd5eXkB/sMzWudWrGp2hdtHF4CM8Pfh5PKThHl2i6hVRW11cCKvx5qSEKFhNHfXx/RWK2XDgl0XSxsP28K8Y
+TCiOfYOppG+v/jJMAhklnmswcE48x00NfPXPw8vhOATeigMUeOr6SlChFv0kcTuJKhtiWZuz0gFwAXuLiQrw/7dD+PV4CJpKw3Nhc15v8GXwxBAyeSesjfb2
+E7b3ahzMvRFJ8A1CFSOAxdpK3VyxMBoLtAUDQr+Y9DxUFsGw768uQOHJYnAFDVxdcOlxOO2Edo7XffmzziFe3a/l/6TlXkZFy53YkvyXSLfBitnScMo2ECcW
+cGOiM8J5/xrOg1IuIJXPZY47jkVvqmTqgaIQAosIwHSYAKT5LEkr1IJXc43+UGUZG1kfNy5PUOTSKRMXVV0R1b9HIb0spn3DrTJOmglIwtETk9QPPGed4PvH
+Q8JfD8+F3af47oG3E7nJU2I4Rl7vdkLL4JXwy0IL4rOuB3vsUYpHUlxwnX4SEw1/csd5rRYpnVjtzO7HR+dX56AWU0svEDAeMdgQmjjLVFmSjxMSrIIUKZdz
+Zh0GR1BM+BPR+En8giPr3CY3IabyrTdFuWFtd2Se5+w6YbnkqeJBn6aW5L0RPMvnstko8C/VaPR3+jz7cmcHB+pf51fnyQEv3tub4vHelE/qTdHozaAzWRjg
+4K4Uu7pSHt6V/2iYtsFazZb62Un/+e/G//Tyh+c/1PP/X7540eX//xZ/Gvj/V88gYZ+wsjg35M+F2ax3Yb/GuhGhZaVRdjXAJ4Gvr0xoBZrCxesjKD0Bct1F
+qSVNpS0ECQ+tNIDzT/oyrhLF3UOv7KasWBMtSF2vx10SwcHYkkn0/bvyea0iFg6si74Qp80KpHnYA7YCHCL+5nWwN71oExSRyr+D8NbMiPqduy/ECxp4Nb/X
+RZWW5MK3T6+IJxw+XGiUPsKYrbuip6U/BDCeFkZAfN29oC0U+JM8smyOmEvh0sr8eUKqE3ou2KYMJ2pd7Ja/e39zc405OpSlgV6q40gKEH7WN8Xd9z3RPxLi
+JWWSMdsVFWIVJPHIC1+6l/NKHKCbGPbU44xX71o/dwgSDCXMKklt+l++abIvxKu+vD6gEtabNtUSvFDaNfhI5uQ2Ee4DElnhDk9tMPmxZlgrlEoRNHh9IV63
+rmCV8+p5WKqqNODmaqzknTNKiIOnlHx8SomvmFISphQTGL27+TgYx/xdb5FqSQ6uB2fvIcX76vZa/p//QzzW31HyecjQJZ7M0LVHxlx8pdOkwdA1uhQ1Xq1G
+mzgFPRJxb2omM62W+HqCLdlCsCW+jmBLthNsia8g2JK7CLbE1xBsyb0EW+IJBFsyJtii+elcBuIJ7Fp0+3eriq/JuIAhETSvSF8sD9a2jaKsYIWn+Ty9T+cb
+YOaIJEUbSxJ95QqOG+KPB0ZImwnFibZzg75nWxsX9GJD4tJKWJxpuPHjIp9s1rqgCwxsIwMvllEmcF1GfA4prIyyLM1NCp8XU5WrZ2dLBbpQdzlFiVcGY9NR
+bmNbK8T+Q8pt6qXW8n+07y7/8xfcWpz9f/I72v+np037/6Sz/3+7+5/zTp30T2piGafPnz8PbwNtWFkwEUV3N/gHvBvcIDJ4/gzzuupuF6brcI8XOka3UGZh
+QlcD/vmvZci1TFux11gbZCRzpLMtx9ziulDkMLR8fSUoR4sbOAdKrQINVkZBpcX8GUGF4iKD9BkquN96DYnvHy0Na72NiCeouD96GxHBbUT+vreRf9Ff+vLo
+z+qzKiqVHNlbSYI6//wP1PKEM/zoX+3tpVaAFccv/9WPqfj620w7o8CeC8B33+009nfQ8YqvMvZ3REjFVxj7cqexL1wT313dXp4Tlc2TTH/PrSu+helvO0h8
+E9OfuHXFtzH95dU70Zn+B5r+rbbH48a2aHkusqvbz4G+dPphdW8WWiJ039isd944wsvJ/suHeMLlQ+6/fIivsv9Pfz///+nLk5ct/v+Xnf3/29v/QljGn9P+
+80T+WeUbWLanz5+/FKJ9ldhssO9baQBwY8dNczy8Hl+d355ZNbTzgCXh6tKSAJy77GZGfUsphWAKDn3E0XMIwwcxPDCHako0SBhr7WjP9RslmQbEFdOt11k9
+sZgt+WMbb2utTqZoVMqb6uYh14XnipFqUy1Nkf67t07dbwX91mJDMRvShjejNC1+OdKnDrHYRgU2eWCQqBmWY2vAAntk6+OHqS4FZzljungief+Gf2RY1YQz
+AjhDcIbWlP2Jte9VxS8h/zRpzzM00/WjG95EHvHzloEEoFr4kHnQRcKQdxEx2yIey6KJ6EMmDVmpXHk2U7SzqTIOQy+mW9mgoYWsTWwcQpbMA2udQxmLdFEh
+7fEM8c+vnv8/PcknjU0kNpvKCgLDEBS6tGWlPdYoxoh4VG5Qt3BAP5nNEYJ44G/FUS8cU5UHpyO8IRx9m94MlfDmaClsPm0tfTt8J0mtHNHNszaHahgJj4uI
+ruSPUda6kzBGeUT3RPoqsat4kd5tGOSP+IawwleYXdyssAsOOFUpukSsNOBCMI0TSWacfQDTKqKdWUhllWdIaMU1S7Y0izEJc053FtSsO8yBqurqZK5lTlCr
+sglChASutuu4mYherA8IwlPMgvcQUuTnqS3T3FbeFJI6iZoh0MTyGPOGkGNCWCZmubRUFzUImgV3hAScsCFUFZwCLmUba3isPKY+TFNy0ThApaZfBFI59cJW
+n3ueIeiA8qg+woS0ebzNdg91/uBjU0SXy16IYpkH/ArwsZ6nlUGcRaFJzbxMEAdV2RmvMzU1hfKMZTyY4brwxOBJAHFMkIuBjcKWESVjtnXndOPlu0lgN0W8
+FTg+WDALz3D+dQk5VgSgL8wKK73SCNHP0vwzdJCYpjmOP1x3e3ZIHeyIFIqibquPl4XghmN6Ftj7reNZn9Exq5zrKl46duN1dYBi4n43haeTo1aoin5vimbf
+eVF7njMkJo+mOQANNlP2NVbGp4wT2zoqQ1GilqtS/VD3BgAsrL27uDcNRGX4xbJxD6qV3JeHnLTiyLXDnrVuwzQLqTM9qwqTpzPmGSJ6eI8nWq02OfevCGXM
+XYdAf1TBlMceLvfvoSIqF1rq6yEBgAyPgXcT8+m9h9YaHQT0Iq4YkZblRsOGPvvsQWCl11gm68BZMmG3JlFTxCLuT6aNmW3KkkFNMl2R/Padx2dh8zweIO4x
+O7NiYmGgA9bzyA4JgUFpjv2PXdduKEJe3qWppJLhGgPHZXPx1WxUN252Be2Zlq6rYOk9LBHAFL7Q0y07hMl0G78BmDNJeQAxD4F8iJOwZ2wvOsh/BrsFc4Ld
+XHdpjpOY8qnV+ucFINyGibDtoC+aRJmffm0ZuB0qcI0d9FAVuJrcRUi5FW2PaGs23rLr5CSPvuv/awVb4Vd1vjutxG/f+cceMVZWdjGFXCY9ucaWiWBwQLI1
+Ya4EBW/G+yNC8uBr0BJJ+L8AQCyY7tutXUHmJdtWH62hgryxs6WbBpYOE+E/TtGBqsOyclwrbMqUk6zD/nN7Wq5nuiwJFG3VHOy1Mi2iRXpc9gTg9DSfRDOz
+gphTgAtsPlBD+zqeVKiQaPwWYV98xmA2MoxqaqlKbAuztErv6LWsroccGHw1DRhllZwVpiyfYZdIBARvEOWN/05zqaz0Xg9usxqYYUUMYIXNJ2pTtPXI2tYT
+8KqUwc1z5jt+axsRKmcwdFPl7luHjQvgjJ/MRrRA6xZ2NLECtD87RB1MXyvfA//kaWT7TzDh/pwiRHFQse8Asz6QGO8SNRZB7jCxy6KLKfr2B2ntibzLOq9F
+bbGmWu8O34ZciZuykndQO0+45HC+9cY0t72aYJHlC8HqeupDz1jibUu4NsBVtIUPRrbxwSAclRgJPOVKad8U8utQqDmxt6PgBmpz3lrGhLvND2ciWPSJTp2E
+J2PilQeYnygQ9rT1dhBiercIGRFoH6sDHe2TTBwF/4btm2LmXrrI2ur1BthumMcULQF54BHJFxzJSn+psO9gNVicD+b3h2Fk4dbgIX1VY86RjvnMTw7d2lHM
+z+D3DlySVFXm1Kn1lDiwp+SOnsJpoSoJcYpK4PZdZ2FCyHf5k62SsvXxPVeT3dg/MsGuGE3Fou7RSBeeIq6GAG+WaorEV9BmAHtnyXRbe07Y1i8oy2tn9Nz2
+bz1qjhFzS5llWxuMFJ6fdItzHiGVBXeonLnreMw8F6pw1pDdYtV8Dn8n4vS2ecMtr3eLaFvHhKZHVjJTEHUjvEDn883K2l3RCFvtNFqp8daK3WXvwkgt0TKp
+VW7D6gapHGA8S25w0/3c2mhv7aK9ZSXb8Pv4bg5dCo9zPUVQOVMEnPrOMDvcuy9aNHSbNcDbX4Rfa54SpXDOGtefn8ym7aWNkEJ8BjnjkFDvPBfquvk1I9V3
+9is0uYP0gtCaKfvyNkdZYxgTi1zPtlRc6NxW+VbU7KB2F0fdrSFDtwa8pTLBEjCFRzLWKbkeuRTEYtN+DtDDZHbxs315aSr4ufOzu4wouB3AyrpjEghB1Skh
+iFpqWkF+4Xq0Fw49usMqz/ivnMA4iDXAHMW7ANF/8Jbvb7VeFS02zzlq9bovb5zkTz+WqnBEi2gf1nQZbGQDj21KR0mEVw9KHMco/9N4wkn8cQw1hW3X34Us
+vZMgolZVmhyPPRwr5lfa2vQ8UkuYxm44WXO+CbsW7M8iAcdow+0L8UNfnvs0NLOQH5mMzE1kW0P0lVHsGyoIkF+ENBaaHEfeNxLQOPBSLX0Nj6Fljeun+11a
+ldGyKHs19b+3gwnoLNXIDRiLHQQ4SaRIgEhRYtl4mEQkUAEMnGFJi4JiwgpVVilQRKsAugvlrJMWneuGSJEpwtR90ZbNQ8cXxWUyjbx45drkZWopVueabiJu
+xXmGulyXeKaS8D5OpnmdbNb7Z8tysyJTukjLz2VIaYVr6hMyDjJBD7QzCGG1UZwI8aeQpgKeuLA6k0iUkRuktSFqJiogN6w2WS01ijPaiGDaFLf0Spa9xIUL
+k1q8cNOYsKI2YR338lxn6RQNHazQHVxrs617TSXVrCp7uyc4bWrQf+EsjrRCYShxzFjBM4jIwXNeFdT+zWmChkKhNOG8RKgtjrdMAY4XNat0gVr2KVG9KKtn
+X9visb82bidgjfs0D/iMIk7I473RNlsTaKSVQb0zZg7cNAnpepSVWa9JnN+ijxcqzTYFHQMqWzCza2JJQ+NAeIBwdu2ml+mylzhF0oY3pi5O2iJDKuoypEL8
+2JeDGWzM0GC7D8ILB/4wDGb1x2Wa6V3LTLRddV1Yw0kMLI0hTxf6tDgkSSybRCq+MEUCiHO9Rt5BilSvydVleSNxEulVjmF1r+RqayrNNGP/RGlzijkpvMmX
+0EpbREht7hjstKDIxJufM6ZpNLk3Q9mjDw2zH8Pm5rc2rCNaDdLkwsdVvDMhGNmECyIKXtwtYX2SuhD2AZBLzfVC53NifViarOW8WapilTlB1nwb9FaazzZF
+4aMS7P9TZakLvK2Riyxpzrqp5TGC6iMnlO81Z9naQRNBRExl/v19ObykxL6WtB0hBtfXw8vz0T//BKMTika5ZLwL7z7FOjyQC//mwJ8mHD+u3WinJs10sc5U
+Ze/PiTebF6nO5qXU+SwzJfacnELQR1elPPqXfz1iESR7uGzt3MBdjm40Irjz9eXxucm/8+4AeIct8P/Xw2sk3rPKpeW7d6/msF9M3boiIYFtXqkv0oaS8MZJ
+7+zLj5pIBwtNv2Z5aUW/ollQlpTujbcLr1uFpyOHpUI1TJN7CrgjJhQUsBkeWemuRkAfqqZVmerCdo2NwzkPgLtlC1XMlhDP6wvhQzL/st1ut/8q/wUriviD
+KDD5r1bDJaBPq8+GRAa5ZpHUkj4CqtFtwOlGp4VVGWWrFlldLJ+YnyTN6yqJltdkKS6s6V89mtnG0juQJCnE06xVGR3mNntUhF6ZRk6GTMvIbdNmlwpxoGEq
+HzFM+0JMtI5ebyeulbyWmcrvNupOM5dPLdOI/Hneli2bLeqUov+wfwZFlZZVOvs1GUD25/+S2Gec/3v6/PR1l//7W/xBZB/PAZ8FfF1otQLCXCRUToN7fZAM
+K1PS4GPS8MirRVuAVa25VrPP6k4LlocmHcjEOnQ5bOcPl/cmmyMSLWUdAJYqWk0ztk2FslW2+SCwM2ExDABbBZ6INb0eLn5wfNylLn0DubVE/DPPEWt9MrXg
+GXzPLaJY5MoU+pkpnsHBILwzZaHKJSMNNmVcKsSbQy9M5KXsCxGkQQPmjd92FCgfUn9nmU2BXXCIqilu0uhYsle9rF+oNtMs0EpR2qRoMIggU6Qm0nc0AQ+d
+Kub2VD+qyzQq32kLmVZ4dyLncyDWDZawaJfwbqFwekjLpXd71dvZxxyY+LMjmSIrKgYUUjK0XHJiYMQU/h9ou4tggvRtum6KOmsJk0J/V6CNkmMKkkJeeFYx
+ibhfaeqlpbz2pY39TLCPLLTmuqoKK4uidiqXf92UYMpas2+qyhR7AHNJxczAjWG+cdARvuWVCSkXoIfFYHImCmDpecCn3ZfHYDJZzlcx1RErvH1z6nJAGjML
+r+8+u8EqDCGJH2VFVUiun9HNs5SKMqGIQJxic1OtCitIBSIxR+9ITX5gs1iPHJU7qUWwcBU1dO7Ml7TSK4fEJJZjgRdjp1Kvdem6Qaa5XKp8ntmtAR7vyxGT
+hAdv9CFnu3HgmxpSCWkVGEJE7O/3R1ShcSlTKQALTnxQCbcH9BdhtPtBbZ2MUi10X8YRYyZVxIXooBxmEU04534MeLPbFBTtJEJ2c9tUl5FZv1OU1t9n3Xse
+dFxSYpe7vePtcLq5k4v0C/LXm6Kyl2L8yOfW1AJITSUYwl2dE+7KFD6c3dgM5MB1QLCtCN6aoIs5gFClFNVAn8WcFPyDbrX7G2VL2Ub5LD6OOeDdM+JiD48M
+dPZu6zpbW0qa0UUVkrVzB8Nz6F6wiQV4FbJpB0vzYIOlOd6cfPqBqjg8HYpaBsNsXIxZXl0OGzHmn4RQPeYW3zZjemnePhBBh8BkrpZ6JWkhC7eQvZrGdCvX
+LJtXqnQuG9oLt6XONXEb5iHHuk1JMYUM5I9rz5OWBCoL8GVWlqlNqlGlXFTr/mbTz3WFcUS40XHj2w2TQGJ6d4+0L0MdbPsS/0x7zhfq5iX/hnZSvpLzhKIM
+Jka9hEqUfSFmPVlodh1sMXmutJPWE9mXRE/N8SUb4o7pUe1jIngsYWvOCydMdaCRGkwuqXyAbQUIskyCO0KQ12G23FUztkEyrYps66zMEud2WnF8mDJWxI4V
+SV0679GkM6ydGXJQgFOMkreCcGyL5fDSr+2a6cdyzmXbwg4VAkwRtE20JT8dvvyCKqhGu2VDwoN20CydFmiHkkxIZe4o7IHNDpUbUKCjWkajhdV366xHihq6
+0LIy8o7ztepTvC/EtFcThYl6P5D5iFVg7DkWrxHhvXh1A3kWvmfvbLfvTIuaEk1jgUaTPbgk7Cw6+oLDtehYr01hW5DNcJih3RH2dimP4/7eO1z7xuJXWQyv
++kEkgYMGRavN6vzczbSI0OStlwZwBK3JxqVQBZTDAYjG46EgiKhHMWprEu2/IMSwbVvU/gHUNLy7gwi/VbyhjjumkE62DWJFPb8XBAlqiqzbQrQ/4plKwh5q
+3RhQP5GJF7WI2oWms1ObXDhXN6cfBGJAjZ0AuzVjbt80X2/QnHe5BPQ5yRh6Q2vnpmerGSlWRCqSEXYhfpruCwAFy+8oxdisYJiC7DAwHBKXOTTFiPU86NBs
+m4jgWzd28yDQ5I/cH/ryDJuqC0gVmRYGLidhn0zRdONeyz+T9JKJ24yGoggSp9hMjKjI/Uv/FFC47LiqhgQnNfbIgK9FPIWvxXlvd9NIQiASiR+uB2f/NPg5
+ZiSx/mYIDFlfc8BLcgAriX0KSTeQBOQQVpLRE1hJqGOH+bzzL38L/++za11kv4IT+BH/74tXr1/V/b8/vOj8v7+Z//eo7gA+6jzAnQf478ADLFUp+ByEzgDI
+eecU7pzCnVO4cwp3TuHOKbzXKbzZ5Lrq3MKdW7hzC/9NuYXxdHyKy1a2uWzFV/trZaMfReev7fy1u/y1rm/0aorF+Ye+KwMtwsKypcTq81xUKY+nxOWU5ne9
+N1SMw+c5bCcb17rwOG/beS32XqTiv2t3CWuY4iGCDZnr+d+8K/pX9ETLEYNCbNeYIu4ZJJjdV4S8TxUb+88sJfYGcMJrIr7e5DBL4FZULc0cbyUeracs53ww
+k9IV9gKC6eubAdlEOf2GgZY5pxQ359e+rUTmDjiaVqI2OLGQOzDQFAqFmEv5Ajv+ZbJz4QnHPuVqW28c1a59IjejBGF04Biv/iviUNt6+r/wN2lu8TCcLV32
+3PQWh0QVoICC7Ui92mRE++FfAA/fqwJxX+6WaV8WMpk2YxVyf6xCRGtAFbqmZC/NglcnLlM/LvJ1NCC61CKssTUi8dYRV9ffd2ggHxBgQcQb5EoA/JagWXFX
+aMbI6pI8L1FhFGIZ+I2s9XIrVbBWG9LcKntQ20ChWzS3wlDVzI1Z6ba7N/TLFC1ijUBE8EhWwsrEp0x4bZ1/bVs9Ue3dp4TEZHeJJt2MQjgSuNZmAFx8tsRq
+NgZ9x+oUzbn/4x82WHXyvItW/crxn1n2p18DA/BI/Of0+elJPf7z6nnH//2bxX+6/P8u+tPl/3ehni7U04V6ulBPF+rp8v+7QE8X6OkCPV3+f5f/38WTuvz/
+Lv//F+b/d77pzjfd+aa7P3/AP87/f/p78f+cnjx/cdLk/znt/P+/l/9fItGZ31pBsuD0+fPnz06fP3+d4E4BSKFY23p4r4utyUn1zR5VJJa33tZd6K3eLJSz
+pwCEtZzJQEBfBhiYKZ5UaG/A9QhzTMJQhS9B6hKMdPIPe/7nOCwB3rVcLgodSLfvCFV4/7RvBd16v48pcEH/JIiZpKU4OMBxUExDWF+9sx+W5AcCr9Vnrdf2
+smG/9+J/YNattRVchLqLqO3MmAe9UDc5nGEQXxmWBqw9kMFEyvr6ICLfLHsw2nvALNwwhKkxEWE3lOm0eryKwipl0ZBNlrEHhS1nYGQ0XtendqlM0D3GjI5W
+m761cugX1/qzVO7mZrnp0cXvuyKO2ODNqzX2YL3IOpDcOi57dVcOfBQGJkQrgSOZX1Va1HxKdeUvr/hGqgnYTxBiceo2eu48Fux1VZUGCUTBVp7tul1xmHpL
+v0Phx5mebwqrbGljJtCpR3BbiSqmixIvtYYTQbL0s7Z7RxIpJDhWd9FwpoWBMt/Tv2aQjJc/foOXVigdPM3RlMa9hBlw4becONV2a09It+iDdQRGl3nQyHQV
+t02kuwCLJgX0ls6F6HRb0wrGTuOVVAvSH4H9WmesgWujYIouE0pa/0pteiZOwLS0a1/YK+iOR5oNAJZyrSM9Qh2sYb7Vwzw/3xU5E47PneiCyVnQcAFgS2le
+4wLAoI7j4Yf3+0UtICbkKrsvpBkFgffHM+EbEX3KxMK5xF02UG+AcJsu90xLV7f6FAmnvF+urmL4eo4JJF4bjj4pxYMuUNjn6+pyZYNCTqbb1iUtm8aFKv2Q
++mv6ow5z3HzSqpTImpxXVhOUlVLSyroAXCdPt6LFVLHTfLGpNoXfOSItZN+VX69b7Pbo5uZM2YONd7m0wum20vTGwJ+ccM6mYzDHKS2eJHssm7LHNeknMKjc
+xRabcMsJEB8CD6z8yHfj8/DCLo5Pek5YIDIdbICjvqCo7zDBAP8Kv7MT204DT51tyY8PjV+SqXAeayO2bK2+wfSuWE2tHla1DwpxfNpznsjzR2zbhrbXQZHa
+SHetvd18mNzB1CslK5gqWdtnH43vyp3xXXFAfFcOKtr+4cNCU81wg6l1Bh6FxntpvA6XXRVRB/mlc/yiVwsgq3xnEJl3tWRXHLmuir0naHyz1MHq8j47GygW
+jweKE+45pmXn6HIZq2naLVR49urz2iSsz9yyftSr0q5kcfyydWriGNXL8c/J4/pswtSXltmkct64AxXAHWNYf11PNKdiPWDSFvJr71sRyKo0pSOSKATTCBMt
+tIIzoEyi0I8gE3OT2Rn0VUE7EC20ke+WLndzb0cmza4tIgnc6vVjN6HI7u7b5tauN/F42DrYH0EbUeflpmD7DGJRKvNHSPuschbWuiBBFrjIgKvYPm/lYza5
+k5lpvFyOcidekERd6fZMnzOkyDNsBZf9nc1Nn9Bz3NbQWY8cC9Y0hgsJJ+mUNfu60V7vOm/VUtw/BdiaxrG1gTsp5XHaax1p2OfFcZr2pPJiadBsOnXJnOAv
+sPwFpVDRdYozVGBqh46L1uORZX5cCpO//tL9HN/Keg/GBXu8z8NVwuY6JfxeTCsr/XRtOR7rQ02PsmIHadxH7vsU3EUk4Gsb7DsZp7fyor+Y/IWGSmGW6RQ3
+jOmmqm91JbtDrLepvim7Le+dce6KNrBUc4GUwm6cwYwRx69aN+5oZ939mqBEMWHztLl72UPXYbvqweUlKX7c6bCkneuGQjtRGbwm5b3K0rnkEYb8Q3dPjIND
+5PIp45ok8CAMNTzIbq4tW5eowgr3NWgtqesEBcI0mIFHLc2xBhz1hUpxb8hcP9SbLUBmLUdN9plWMEU2RSNmjnVlJxMWRE2MiyoEFdDIfWBljWor53DxU4uK
+Ezu5vupB2U2WnES+VASEufYgSiswchemWOiUust2MSevBiJambMtjl+3TrOm5wHWTXysN2dt0naiw9xiz9+EvSUv6Z+QghFYPtHsEi3muQue0nlxwRm2YZ6I
+OP7Bt8eH74OfONPmseVpN5teGPznxF+ygc/jdCBvHQZQypbEPg+GonQFv5P6xAVv8bbuQ8JdqhIOUAdF0N3FyVmtTY4+Wj7Ww7pFJfVZ5SZwtkZy5W5ft4nw
+tR0wzsRq2+cozYDFaprVgdn4px33RojA+wLh7fWhK8NhwjMhEZVxYNpaoj4lZuDvwqQJvFJXRk43aTan5LdnqJcuGCZpCncnt24H7C0WfrEpvSpWEnKOC/C0
+t86aR6+y7ofOmtJf4A4sFOu6+dB8zSnVF2JU6VUpb6xg/KWp5Jm/qly7dJX6iAlx/GOPlcSOH7Gv2VAmjxJlnPQ4l1sXkOypv1SaNlW0jlyiQtA9tDISdjOt
+Sp3dg+lNYMBwmVeYc9jiHo3NRLpnYfUVu7VqSRoucztOE+KbGj9Tu6G1LpG+ED9jqkomr2HU6GAXxyfPe3KQb0kzNrSzd2vG7l1DIi1ZfCeAeNY9a335dkv2
+WhIo+NawByLqejxPUTssbpY8N1Z3yZaVRCXhwIjQ0RjmLLWUCJ1y0uPTs9lC76SMTLrmWKN5B3haCJmhJjZv6yonMIY1EXJIJKqWOlZrqkx0lYG6NM4c2dTr
+5edXXmi+1rTTnozCjzWdV6hUC6xoGyq0s7iroH/hF3BfSUiJ8M7sgbkcn7yovd/JqdsERtQmL1PQzX0wRTZ/SMGlCGbyM7N4xqcS6cY7G5mvNAmJ4ULHJzQj
+UEQQvi51liX833SF/nfnc0HpY/I/6uhwa5gBFJuCV7MIHjcDDfe681nYJGy7r+UaghqqSNGlvihSzHufbsNXOrMNTKq0gvnr2lqxwGCw10klZ4Upy2dYHUID
+bWCjxX/3rDRfEFQDBP4dLS9VhW+mFCd8ZWn3bFP42JspXNtt5SnwDSaeaF3nLKPHmVQk1Iqiz3S2zsn0sQrgvoGC9dPm5Io+PnnZ26HJ+5MQkF7Ulsz09hNm
+Hp1dXX8aj35+fyPfX12cD8dWP/BmPHp7e3M1noRZT+LgrKf+AWlNVuj20ZymBN5RF8yVg/FQno8mZxeD0Yfhuby5gleK4T/fwJfXw/GH0c0NtfPT1e1YXlyd
+DS7kxeBjX95eXsDrxsP/fTsa008uBh9Bk7fRHeJqHPaG/Di6uJBvh/JiNHh7MaQaX36S56Px8OwmkaNL/7ez0fnw8mZwkbCc22T4v2+HlzejwYU4H3wY/Dyc
+yMF4NBld/ixHl1jKx8EnCb0LWo7vh/J2MrR/5QFM5PAvw0s5eicH538ZTYbnwn5/NZnYLLGrd3Jye/Ze0ks6xbZ/kD9vJ+fPTp+dZWBs/T75X89PX548r+V/
+nfxw2uG/f5M/cZbX/9hqVfxP+T9QxPN/9uUgy6wfodBgoODhUQuQ2chdGqYWWYYZdFgljWBBZBOL+OLXTG/28rABhBIeWunqJ0ROxlVCGy0I3ZLvp9AoAwoF
+qqm5b+qiJoLtOsrTCN+Vz2sV8eEvQjfWK5DmYQ/YCnCK/Tevg71XRxFqq8Ec5/SUvn+deRt5uODsH03k5OrdzcfB+KDTf7Lz+BfwxePJzjJIdn57eyMvrzjZ
+GU9oTHYWB1kFWJHHDAMR2wB9OEgvr/CQvJGT94OLi9ZWyvhQn8i3Q/GkA31yPTwbwV+G/zz8cH0xGH/ac8gfP9Il1+Ors9sx2TR4dr+d3Ixubm+G8uerq3Po
+aDEZjv8yOhtO3siLqwn21i1YReeDmwG++Hp89W50M3kDf397Oxlhp40ub4bj8e01ZJn35Purj8O/DMfibHA7GZ5j716R1XHzfng1RrPhYsSdn8iP74c374dj
+6FDsqQF0weRmPDq7CX4G1tHN1fgmaKO8HP58Mfp5eHk2hNpcQSkfR5Nhb7+xI5yxE8zYhrkjO3PnoPP/GQB0307Ov7Ud8Nj5/8OrOv//i5NXz7vz/7f4A95f
+HvbAs+D/dvLjj6fPTp+fnMrwl9eFAd9YaCCIzkDoDARrILwbD4c4T8ZXfx6e3cj/83/QHPjuO1mzB8RX2wMtj4mvtAdk0x4QLfZAvVFNc2DH/V58vTkgW8wB
+8XXmgGw3B8RXmANylzkgvsYckHvNAfEEc0DuNQfEE8wBzBy9T/VDafM70X0KywwxA8i1yGvKYUfgl9ECw1AbpYaz81ZtqqUpqFDGIDBa0ROpYrKYo7NEH/5i
+kQKmUqwNZOdixlIk6K/ngaR/Yl9W363FY+f/pa5+heP/0fP/1av6/f/F8+ed/s9v8qeB8voTHvQ0E4K06USO8tkuhwCGHvAcTWs5OW5thGCTyrS/A5z2nfHw
+D288XA5vcF5c3V6eM9h5dHnWb/gYxE6r4uleBrHbqniql0HstiravAy+mZ1/ofMv/C73/xe/s///hxb//4vu/v/f/lvn/+9O6N/hhH7Rl5dBumSYNe+ru6Q0
+jNz4H2G3I0TPZzeUFkm/m41FPoGNRRzCxtLFL7r4RWdfPP38fzao/DbwDW2BR87/FycvTmvn/8vTFyfd+f9b/OnO8u4s/yOf5S9b54PKeSyAHr0kNvf6vPB9
+q2afc/OQ6fkd9OdP4jv0V3FNfaqsqx/T7fgs0qPbPMW8/7mayznkdma6WJlEDIo7dNGqI3m8rKr1T99///Dw0F/T930933zf63/XWSSdRdJZJN2fP+KfyP47
+Axz+t/cCPWr//VDP/zj94XXH//eb/IFADA67BJ+7RRjEXqF/+fLly5d/fUb/k/9yBc4heRXQO/2rEL+Lp+i4htFCZFrMZxDYVaj/36tBMw8wSf/7N7JILZPd
+Uy3S//53ZZD+91Z7tG1S/SHt0curNsPrYnQ2vJwMJ/LmCs9UsJA+fQd0wAgrQZtnggGhn8HSQruPjEJ+si+/gYkoQqbhv9Fw2GMmongqiqYzETsTsftzmP13
+8fby4lcIAj5i/718ddqw/16+ftHZf7/Fn0b+zwsieB7rOw921tI6QCrkFzlTWbowRZ6qxOkCXqgHlDYRb3XxWWd6Ky/xGAfWIjU1hUKoa2ixISXQurL+HIfR
+VmswIlRWevmJ2/6kL8/1uurDr4e5Lu62vfbwpGy3OcVX25yy3aN5sPkIrIi/r0vz+LRZg79lExLIAHc5NXdPVDtBZWOCCj9Bk9ap9rglyuSj4ttYok5zQnRR
+zsMNxquPl60uRbYXRWcvPmovCnFoWvajBqM4NC37AINRuLRsSxNErFa5kWaaWf6GIBZQGS9GV+MJVdVsySyPm/VdoZAkg9wHng9ysclntDfgRoKCQciim3si
+tGADF8dHw3wJXyJDyFFPOnrtN8CmRtJT6SIUuLJ8gUiHEj4tPGkd73FrFFfMLAULs+pXZs+WFpy5iSOaA4YOoq73An12a3GcT3eFxnqwAtdsGVUuEY56bKkL
+Pd0ywUm88XNhP0lV5x4pzFZl1fbZotBIQrXWKCYcMI4wO2QSc8+sC72m0IwlZGe2JwjfsCginPGWS5GFZ3Xh9taYRp4YsKf2vY12wvSovwsaWWizSILDE8sJ
+TnLiUP4bs/9f/r75fy9OTn9o5v91+P//9t+6/L8uZ+D3yRmAgbfSg/Aq/zQUn5qcWAfpvISBDRXonDkLrRbztFxnarszJq8pKC+klE+Ny4cSE5KSBf5+Excb
+ntHHvb3iF0MqW4x38ZWQyrrx7tojuD3fwLMrDsFPPs1SF4/gJ59iqYvH8JNPsNT/2Ib6f+v+/O3Zf89uz34VE/Ax++/Fqxd1/+/pyy7+/5uPvzze5T17NuHT
+qxfqAv7LvSpSsyklWI3lvz7Fb4wWhuhMy860/PVNy4PNSjHd7p20ifezQI/VDcb9BmhQ6EHeZPEHw8yMhz8PL29afMjffVdD4Ypf7EVusV/lN+L2EO1eZNu6
+gzk9vE0qvgWnh+0J8U04PcgmFd+G00NevRN/F5wenbG34/y/LkxFGg2/jgdwv/138vLkZUP/+dXzLv7/W42/9ONvE0CFeKenxQZsjNPnz0+lF1l+xn+IIced
+hxN75kTyEMdHbyfnRz3n3l8qMhF0LkEtWurFAt57r4WTOkZTUipWeEZTwBRSyYd0rmW5LrRCCSCUcODUA7Op5NRUSzkzq5UukCgHzmcIPfiP3InZB60e+Ndd
+oeBL1DEu4URNV9BgUm6G6qelrIxIV1hlrN0dk/3/3w1HhhaBxJCPNJBOtSruNviFNTYLLVC+Wk51VekilG4iGwsGgz9MWNTSKjpxLYiBvjIu1vwAxXLnbUld
+bqmzdeLM4XSuVWbuUA3SFHKlUXtrRrzkhUpRvRgtpBRtEeAzT2ebTBWgbbNhXQnUXVwaaa1MFELF0BOQDmlnGKKyAgsws5SGKublT/ImUJa9Obv+fnTtVN/S
+LNtAVSqrB0XiTTiGG7KaVBaLOcXvlJlW8xJ7sFDrdM4CBDZY52ohnj2TZlHpXGqYglMN0wt7Mc3LimeWknMt/7optHtOHuu7RF5NRjLXFU68lZnrrOz1hfjo
+FALwxevCZOlCF04bzdliPKzzQL/t58tb6dQjMBYlnJKbCxqS7zKv0nyjUbR1pksvQVAY6I1SzoB/HppRGYmqrWjU/YwqxIGcEHNYUTMX+kFmaU4W6NHP1xfP
+4A5TgTApNhILs5opYmrmW7fwcJqxMkmaO/USXVO2ePYM7eQ7Xw2WVBK+FFB6zrYsvbJZM4f9kavK2hSsBsUyxtVSr1DbDSZpJTCPaAXrE/YMVnUlDvxnz2jR
+SZJO0vcpKvzJWVrMNqsS50dJC8jycgmUn8YAnynmLpBcadIITwt5ZxSKZXrhYSvdAzsUb1CbEuWJF/wMtBYWZLmE8u42MKeshABGHn3vH4FuSyhmYnJYN7lM
+V+ouzbWVoAaVZVSoQCl7s4Yph1oJtEBJ7nAuQJkB/n68Nqj7vJWzDFbmM7rB9ugKi4129Qvay1ostrKoHVKkVh4aKu2mNVRJV2llir78iFpdNNH1l7Ssgpgw
+qQJTP5P4cm4qWehMK6s0lxZULSrAQf3MguTr3QaZLrz8ncD3uOVF3YJb4gzfFESJscRoO52qGU93fLswD7k89idHz1+2MPiNxa7wYj3VcqEKuTIFiYJiC3zu
+Aahn4imEnV6/C78zRXBbL7QqcZ4/aLneYPgdt5a0lEfth/TRT3Lg2j/XZXqX03UxBEEWWs/NiqL0PnASHDRWjYT/ORcU605ze+iCk0fTWVfqCsayTOi8rR2w
+RJRn/5mQZJvg6cRjS/sH3lWX6h6XD63Y6PprNxR/HMzNikn51oWewRQkPR56p3NkLFBaY71FDZxYEg5+GbqGBDkM+kIwp+PN8AwuRDYjX94Mxx/cdfd8BN/R
+NRMCF3hnOx9N6KaIHFUgdPHh6nz0bnRGdE7PfuEfDIk9h2S0RZpTA/tCqJ48uibj5SghTEsS6JKjJAl9jU4x6O5Q7t4fP9iBoilmM+3JgTzCB6eqRLFy/P2e
+lwYuj2v/bpVvRS2Hgl/vPVOZeuhbwctZTx6FKtNtb8L1MiP/VaFJFK72jtIVOO/Jo09m01pjNVvaNrM090lfTmZmra05xN+y7lLpoogHzC0ncUWd0RdX2D8K
+bN20SllVkrXz8ZclvLmuLPRG3vjmsk6qCDs5LXn3tOdv4lx5ZlPhLmIzaINHZtAgPRckQu+9T7gXerkaqWjI2iYB9dhpX/4l1nfmDC2InEJXxWJXO6Wxbd1U
+iYlFLBUq0iqJFbDbxCyhvut0tjGbMqP3YfrwukhVBYYF5k6VS2FyGnOuVvirhqP0jfys9VqmeQV9jyI7S83flZT3izOJJmRaemn7fO4m6bTUbH9CAx5YWeeN
+s4hweZD/FHa0dRq6zV2PBJqz4Xsyk995H2s0KC/6sVR7TeYVPuJFSIeSX/h25FgfFhPT6PVF+4Al9fGN9BBFM1erOXwrrXf74X/C3e5m2Rwh+2KnXRTNTqc/
+6rziKB4o6iqEu8X9E/mIg1vg+bhSeU4ecjI40JhzeumoI1es8O4SbMG0xUKr6pujrba7GM11XrH2IcmHh5YpdGFaCSulPsUzdEGXQjsznJw7lN8XYkYvtltb
+zVhqVCgtw4pT/ZhxNtsKq5zb8PPDNHHiqa2b88tDJ2lo8XVT9Ledop+saK6bj/kcBkxz8ALtNmylqtxGx9r7pMJMe6Gw+xP202KTZXKuK5Vmpcy1JgLkUMRt
+qht6ofDm+3S+UZlYWbW+RYoK7fGA4R4f/QRPPG4ASOv5xYQOhki5VxXBq03Od8OqXmueYpF+us0WWphNPqeV5vtPWbk/dlvxzAqmpSnsSZXQ9zhgJoNvYCDB
+K2OZpzEoljYiP3Xbi55xOavkM3L3NVVKxW9QMGWtMG1l+MBLC9LKhDVVMxrteWTdFbSmX/XliAioA/9LsGZJh5PWam1B1ipeX5VSf4HyhCr97uPHvUXgGHVO
+9f/d6LyCrGkoE+e9mrEUc5ZBeK1Sn3UOPZE26m3HeJfqp5TydR8CSRyh+rQ3hPbVuFzxizK1wgCZ+AWZWm1gEowuQVswjkOAZ/nx/ZX8MPgk8RqEr//+aizH
+Q3dRGlKQZnz183jwQQ4mXnBPDN5e/WWY/LpJYDbQVI8uQTTL9tnNFX4W1FP8cpSHj9OJX4ryCOJ04pejgm2cLhE2yQtDZh57HQ7v+wEERIeX+xPBRBB0m3RR
+tz98/Ofi2Un/+a+V+n9A/O/56csf6vx/z1+//qGL//0m429MWfnwnb1hPnNiyCf95/KZHGzuwJI5+aFaJogSFuLapY/ItIzQQOCHAKcdemnQrEica0oXJQnu
+h7nbwkzBsGHlXXfjrUlrqBn5vVHWOrKFrUtDTrciPKjlMQYTbOsIl4W4Imd2J5KTiGJ8kP6iZ/AXtBFRxHjFF52Ji/LhV2YnNMm2wT0g7AO6oMLSYv7MmliV
+AWtsFT0B/brYFHlaLsmxOzeyNAkYaKLGfOPuJj+Rl7R+G3F8OJOwR7GvdF6lFO2qWHrY6SAL75523jAYYKKyCVXr96aQJZStVbPrwcyM72y+awPrV7L1S/2t
+sizoaNHe0XKTo+o32nz2Fc2rKJr7pck0Xm0E1Z4CDSs1W6a5fkbTAKNGhvobIxMUBmZ/urKXtEzldxsI/KwLA7cZU/RJR3mfTZhIK45sLUk4VeFU/6fR5XkS
+mIoWWhvYhy3WCB7I+4WTHzUJE3kzurkYovlY10/eYR+KJtiYzLery2HgJ7/8WUYd0mLtkd3gMoQeNWkwyyjMJ0qcmfdufPUhsentV/a5S/bzQ7/HtbkahyYh
+vf58OLgYXf4Mxlg0ln8vhs1btdyul7/q8f+4/s+Ll3X838nrV935/1v88ev28upmdDbE46NEefwKwx52Y+c9D3b2cinLWZGuSX8/RhD+/OEvoBl40ijnQUcI
+rgXu7PCVyvChDfvPoUh0HhHq2e7MS7P2iTSc6DLVuV6kFXnASsgshtORYvAcbwxr4NOFsMQJhNDL5TO7bcsb/c8Yv9zkabWVKTob9IwFi2xIF2LFz/jFmB4E
+dU9z647FWD0k4GAIASrhvodmobdJKzxjSno/NBxzh9/7lA9KxqG8CegoNKagc0Ihfed5kLcWDB9lNgioyp83WWrkROWzpf73BCqTyD+b8jstB335Qf3/c1Va
+r4vixP3Ca4/hYelwz3CAL3U2h3N/bXLIJ8ATE5/WRWEKtjLCHOTcFO43c7VS4BtkxxXRu0A3okmGOTlpTllC8HMrmYb2x1KVcqo1pguQc4+/psyGFNKxIJiJ
+Zz6/qC9HC+/8KdNqY1XZ0hJchxRsitsCxlxa9MVtW9J5CssBpkWWztKq6S4qdeAaZtcXjMKm1LWSbJesNwWkSvUl/zQ3lZzrTFf8gLPjBNlx2CRiEQA/a1wq
+tQhdjmxoUTwehhUqTSa2eFgaCM5rMtoellt6O1Trc5rPM6Li0SXP/FLn88i1WxlZ6S//625134eIX71C8VaB+0RrxeYaRjvlFBhYPwJHnQKXgdc2U1Odkcuy
+Zng5Ry+9BkLl5TrFvtMig6wOs3ChN8ysoLwTSDqCZiy1KmB+bSobN59meoWVXaT5vC+vIS1Fi0JDGpL9vt4DcniJrpDGVvq3cf4Xxa97+j96/p+cnL5o3P87
+/v/f5o/N/FRyrWaf1R1d2uEUpN1fzlNFASs1K0yJiXoVBrPMGn76z9tn63QGF+8P6WypdCZhRsljvVJp9pOcqqL4X/Cfsm+Ku15fjmi7h1ducp+4AF9IG0Kh
+9M8gHgU3OtpfwLNginrmEUb/3L82JW2T9gmRVkG4DUA38DqXDIlhsuBxt0fbDoHeyUrjYWSJmG4qOeLNBDIJ9AwTCShzgdwY0AryZUCFV9b1oTKpv6hVmrvA
+p8D63rhMxZxSBFVeaZsQi7t8UJ07Y+b2CKmWaX7XlyPIp9Kiom07rSgGfKHAqDnVif1b//mP8E55DYcBWDxgNVVLsIvkSAJuCgY1N5yOJrCgLMPgDSdtVks5
++DC50f+c8EvxS/fizuP7N/XnbVrdmKLQefXruYH37/+vXr98Ued/OX3xqrv//cbjL6/WOpcT8mU5HEjgBua8uP1PsMuV/3XUg+0xSz3Hln9azLIUCqHcjwyt
+Or/32oxbBTdHQIyWaKJt1nPECcCWiiniOZjfgvKHrSdRVb6g46Ogvtdc6lGPfgU/CI4ZOMX8r1nx9pjbYoojYJzMt7JZoM/uX2+KcoOEWEZEKVt4xF7Yn/Fz
+/cYnCdnE1isLVmta2hxarCERFMYZlIRwda+yaUNlEMIOUn3EliPyeO2CkpJaKgKn1zSqK4QHAuEr7T+heTgac3ZUa7DIp+TJxYuHHOZ3Nr0hSh7BzEdRy3Dr
+y/g2HL4nNxVaK0XViMvDKSoyfacyhhY1MucwbWIN9cwriQmQDnMQhPr9/CccURXXwmaI2kIhLIFz3OVdhQvDp+9ie2uJG+iK+LOaTnXRupwg/EJL6s9Xk4uj
+XkJj6O/DxJZKkzcsYATvRBOuLyeaE1xegosGavHyeNE7TtO057NHoHw6z6FcMS/Meu3VnW192A9ChgkB5D+F0wkQB9IUnOL4wODs5jSHFEaca7CwsQdNDpsC
+phFJdXdX6DtVBRGgKDmMM2HA0uJk55IaUsKkURkCRDTuMuScL/vy0lj2OemyphYap4SlniW8/VtTLW0vnpk5TdMgEnBPW2J9rkRr2AZQwiw3TopCkIa+R+KE
+syDrnYhmPcgJ7DtMcEP78BimNk3Ro8YLj+LE6RI+CIs+wiYcBW06QmKEOUxNn2VlF18PcfrWJ4BAPD13hIVxkyoTPhoOcD6H6qMXClO1YSW4AMxHDMCwA4ha
+hwtQHtV/dEQrBisqmhV92Zdvt+yJsRiDemWC/CWcvsHo+Zmd4LaI9Id8OOTbWltp3/ysdzXa+krz4O6CXUgp5B5+sbsWcDEJIHhUKby80IsxzOVnplnQFInr
+6eeQpUMEZ9SraKW2N6DmlkokXHT8LynvLDeV8PAn7LUgwzmg4UzQ+5eWcQl+rOCxLFXsvOOxBTdg5UFrjSrOjS7z7/g2AjncGnxEpqAsOxwk9COl+V83BX5h
+vYFCvHbus4D7sfUtpmjMVnZiqS3HtMVCa0KcuKYjY+YafEVI1bmIWpvmc73KmU/UNTsgMC1FZWg4Z5uyMgBNjfyC9FqHNwvmQV+IH1zTVFnqgh1aqoLOxDCs
+Q3HFo4GWF8OWwp4QbiHDEFLJ0wLTK6HktsMyocozqcuO8sJ0Pf5Oqk1lVqoCiGpGRcP1OMwddA7UCw9aCtYYvtc85C1jZhNfBbqV9y8+uuqCwbqVdijqJcq/
+QihblRSGmBvbTpdp3WI0DTKMc0AhkI5op1G9AY1XeQAcnVZXk9Eze9xHZ72DxJnCPiQJ3qArVWzt9267mC1N4EsWNjvV/YzRPCqPd0iPSnW7koWmyspAjuwC
+MispxRpP1JUHNfhy5LE7H+sDEs5Gzoe1aQjBdO/xGkDUNuNDXdsavciIybxMy0o8fmTj95hbHFoZ0QYLMjD6oWYHBO1jPxY3wGXopis6OdKV9lsxsQHDyg+7
+0m7Qbo/C5WaDK/zi2vKTtk88bX7uaxlY1Gj2RpvAUjF6Gm8UlQmc+eIiqoKd+G6RtPVm3WYsNFrHJnfgvbmHtOFYznRBkEV4CZjxxr4xslMeHPwSPl2kRVlB
+VwWwp7Bg+E2h1xpvlgDFpLlv8nudp4RdzwHGmhnIPN9KPnF0Pq/1bF+4XrjBOoHV+zNs+fBD+9U76HXbpf2gc4PMKLIhEFiVzZ8BvDIRISVyEvMl+4UdpfvY
+1GziKsgyPUMSZToMq62g/T7hjKF6epDqy9taCpRlWXapUEx3nYTnZA1IAfCl2OwgKw+NHAzpBBYo5rfblRrRKHtbW5BFQzeCPDS41xbLwThDvEnJTe7CP1NV
+piU/Ch0Dy7y+DwCmwYZK+UA0Cz4hS4mOTxortag0niZ6To7lvCoMwuun27BNiWCLOPE3n0QauHfgnC5VphO6UIHdH+M1HnAVpCVYWdIsaj3Z2ltginG2Eq87
+/aVytpINc9Lgo5nGkAr8uc6xJ2H2HVpr8Wit5aO1ju0o8TGk0abLVmMZVaZWLK6roN/7tcvOEcLISzT6LG4ezQ9M+co0/dvtieVmWnLMtpCE/dgU2JrjtCcV
+bv0i2PmbR4Up5DHcnOGFsMPCE+6UqRCg4eAXZiGaTp/3utBpTvMsrZ+xlbsJ1R88EuUS8t9sCBX+7m6StVuZhZgwlpKaD7UKO1K8pY28hloNzuM0t74D+fJY
+9SyOFs1An8qp8woM+IelcXuAXTjsWOMJi9/Sm+rALNjDo0aIYxVdYo96j+2kcs9OKr5qJ5XdTtrtpH8TO+mLvhx+4YhmGZoimnZYcH9hpLC+4UjaU6ZazrVe
+6TkMBS4w7IfgLleouQb8XJl453OZ2GFP6Aey1LNCVzaniPHN7WvL7fk0qWubE2O+ZIj5EpijPMe5lebQJH69s5PTkiqv517hwu38jZ1cEF3EXAeQPMq92f2M
+vdfPzGrKQVyY/qLtx0xNin3g/Jhobhdz+Du2ADsyqLjgKek7/NGewsQpTn2iJ0IgY81pFpxDj04JGLhiXWifA4eAX+Fqg/1EnQ8F0d3T+V75zuCZKT1Ofuv7
+3ZfmVwZF1nHFK38rieB3L/EOVcgr7z8B8llV4CXuPKZaAb7Z+KC7qXkTPjXcaH05sOleMP18ZpcIPbO7ncCBRso+r6Jwc4+9pKYgMEA6W9LH7hxNOGHLfhMJ
+mhRa1N2MMXjRNtbddD1HmNfNTex5L04p2LXDrni0rvYSHJzwRDixA0sa3mIdjVN00Y0vlFqBU4kK4yrLH/oec+uMpHYGBVyZGkno+HtcwU1/r68/FU5DiSEh
+w5u9KUhZR3vPmDDEWxH5aouWIMKu6QO/bpkmKqvohQFDjrY2XKPYuFfJLWOZJr6z5MswRbEbAw+B9VC5TvSMVCpz0BtqvoOJ+B9wyTYIOI/NyHmPDIgBealT
+S6QX+jP9OKLb2zm0k5bpI5r2c81FvtNXv2cxhmvLG0tQUqlWGglJlCUsiW25YJSFDzQxsGTnmXIPxcmVBs9LWq4s02BmYxt+H6/zFuNQ+Lxl6x7WcOAWJk9n
+hF/CmbqQc1UpcaxyeTT0Pwh3S/nB1gHi3Te13rQBAp5nwiwazWlM3VbAO7NWQxDfDy/urKqSsLorWT0A+5g8PjntyZXJq2UpyaKE5iHnQArrPK1S7KepnsHI
++LkiwsLK9Is8fl0rSOFtkIDi4dIpVTpvtEs02uVisBjXcy/2obN6crHOyw0vGFU1ZqqtAPVKGMaxJzw8sWPYhBs2mZaUG6HS3CbKBjcdT7cEsabAMLu2htkH
+TJgHMz+FCQBPXsOT8gyNfRdowOPZUW3z8RbSikXv/a4U7XagM4CcAGhlYA0VRPNl3ZJlwGFWW/Lb5qZf6S8VXcxdTD7s6jCYLKq0gjvJ0cXw58HFEbXD7l1M
++wQNdz5GbE5AR8hfp7koN0BLCPsrc0XYTnH7LoUYoNMY7kd+XzWrXLcSFlKgNed7lyasi0A2g5N+tqh46xV+6532qLPIwAMq8XWVOTYV3JugD6jjYoiefXOg
+Tlf5Ox50DRUKnArW5K30ugTF19kS6oSJ+4jdDLiXBKSJpkgPWNK1IdcP5V1hNuuyx0mICnkLVQYRUbZE0xw3VM9Lyn6OebsdjhYotACHA3w2vmPdIqZ+xySA
+NO1HFvbgeuSmfX0TCM/IwMS0/LKw2tGMXqgZZCMNrkdHPRscry8hd40O2RI5T8n3hYjuo44LlX46uB4FCwLzVm0NcdFQz1EdGVrjxxybnoLigAeAoCX8CV1J
+/CG+KAkuaIj0NPX0J8g1QZca4DeoTlOdpfp+b3y9FjmHDhcutmb9RxaVpCrfi8Ha87dWL05Inwn0/m+tiRzE3YVA6WDegS4JNBvYIfMNja2uG9PYjlgcAerk
+5Cdjqq6aZdLeB864Fl9xEXCEwPgF7wNthl8Ya2veDVsbbknQrKOTjJLhF7wVygEnKlHnHfWgc9BTiNuJWezqADTAIQ4ZjP0OU8nDX9hDFvsMneUq1HxOixUl
+GDDHKFzQfMe+9JgaomzOTcWAIkqeWDNWjH6Iox0SJEeWETRyTuG7tCq9azmRVjJTRIcU7othwZmhVvgtU+FN5x7mMMl+mmKLL+oJpq1F9Bhfl6fasulWRmbG
+fPYSnkpa+FAcd4R1ilY8zSjOMFCYF7UwRRKAZ1xiw2F5DJzdhcyoMb9diz+8fu9AnDvGKeEi5aL7U71UGXuOYaSM/SieLq1ekr6IrxQp01rFPr/D2yt8e4k4
+e8EEBNgIWPU68dt85AYwtkg6c73/A35Od9JwrlqT23d1ms82ReHv+bYAYYc7fJynEXI1m8UTGkj3K6g+Nq4vhO7HNwWzkEN/o+VM4dLPsWA9N4zpXa5pl7/Q
+nrNAw4UTmLYgvm97n1PT2+SYouA08ueFqtISfd71fAW41AQZk6rnNO7h8soXLFXVOECPUy499Dw4bkqRu4VO/ua6IyBxOdDRWULL0mTwVaEyT/OGvlAyQPdc
+JXZdftPAXhSPeGTC3AjFJ8rajv/SPPBZpAstXOdyKgh3brA1SN+3bI/C05SJ6ozbtBK1tUkV414nD3vTqYhRvJZlKJAecbt3d8A5u3eZ+lX2Ncs0fHd9iYra
+EvUNDhfforn4GoEZv7vvSLg81v27fiJqzmtZmhU53rJs55QJfNkzzMGJo1h4TDbep0qfyV/PZrILHC8k6K0gh6TwMUjjZ/fehCJVhFPOJQeJMDmotX5hbtA+
+V1wzRAdN0JWhvMpRbse8MvIMssG38pxMgUmlKs6qH+s7uMFgMo6zONKVszc4RYXuhFgG9nkAhW5dn/QrcDVwWJNHU+wbTTZUSqpdIv+6macMpZtjjlAhC1fd
+QPQbD1DYR8Ma7q4a21kr9SVdbVYcZhO2xbxpzhixa2sTv5zGyIYeaE1QNHu+ZO+jibMQ/Y7izF/KpbJObk9qwEEIvZUK8QJ9MUH8eLDJWeRgnZIyuCnvdHQS
+5U+jBBrWLJMNYbl6Xqcc0iUL0SQuTGk7gyPEbb2WMBC+pSH+ogQgS3RT8KIJ/RQYcZyTzFz5GfMiKy+qHtxuiVhS4klSGVrlqJyBWEDxem/kpYZcCbBCNDL2
++hNaOOGmC5d3VVVqtuSjxtvT7lZiERNpGaoAzAVFEd1F0CmakIPsh74zaBqVxngSZAB6k8dWEHdfTvxrpv1JyNYo9dyGgnP9IHagCchHPnQolvh1V/msZVE7
+V4bPPMThENGlpd028Ma3yh7UtmykI6acuitqq11VPsXwkyuiNP6GgY/jdGxYgS2mB5nibV7ZoLZBC4PDtS8u+eLhE1h35zgGXi96eRBGqUwjbcddOZsJzED3
+2m8eLs3U/kUtdgYdE7AYBE712ADzN32+HRF1eyBSQvmYKe//HCDA6KpFTGWFVvNtCwitpUGBB4kA0+SHsSe2PZf9nZmYp9P8LtvKMl2lMM9q5OjMkm8vYCK+
+gEH5ziJwaVQHmH1w+IrSwD2f0QiFzKJWMPWsTXJ2cfVHwup9If6EJpcjcF7Ij3x56luRCBSOOL89u4movW4vgTwT6WD5d8TUKZHyS74dTEaTR3m/Rkg/1WSK
+TYSj//JlIP/XgHQoAvKvm/eDG6S0aqvuu/FwKK7eyfPhu+HZzSQJeMIuhkgStltU8WocEoONLn/uIzkW0K2Oh3I8mvwTtJXZyP737cCx0V4Px++uxh8GKEz9
+rrVuYjTBdslPV7d9OXl/dXtx3mwAdPaQ6z76y9BqB46HQAh7k8DD8hg4UoEezb/maiwj3tpA7LEnB5PJ7Ych091ObuyAXA7PhpPJYPxJEIsr9vx4eD0YjUnb
+cTwmSrE+jbkj0x1DCW54gZKWaGFBVUQMJxNmp4X+dQqH3FDkFmblw7bBG9zevL8aj/7f4bl8PxwPccaJ4T+fDa9vwunnq0Kn2o99zGBm258TIyz6IvxK3q5N
+Lt8i4IMP6TB7rRmVES6OTCEOh+KooTsYSrLA4IhpNyPt0Qxvcj/cFHyIMAoFHqHElaLayuMXz3tyDueWWUAk0OBuoGAbtDn/U24M6M/6ZLydCDbveXcgI9tU
+inSUm+KedRxkFfRcA5R67fMpyXNObpu0YBhsIsJgaJpb7OpUbw33dVv5bl+NqkN2Q2MkBwgK4sevKcYwyhdgP+Odyh1OFE+ttMzSii/tIItLoCLsUxuiSIPH
+OQR2rL9YT8FczzJVKPRY/nUzv8NfEf922RMWihTtv5Fldxx+FX5hH8XwGThV0QQnL2fOb5DWnuNoop1PpRxjRHau86oHFri+cy6UBlCJPK6ELUtz9y/b7DJA
+WLGLFz6wJJUcb/AxS6qifz8jjGSUwlLKE3jdaX2QaYyFX1TrwuB9DyFFhGEu0y+wEF7bhcAeJzwxg9eGPnrgcE3N/KhnGTPdolKVNaXpJ1hVzoMg/0iao5Aw
+qoulPVEZuVbb8EVKrjbVhlIY4Ak0rIKIngXjumyqtSoraQrByT+cXNVYlxZEW+tPn5cNjZgX6sEaLXYe8yStX5cbL4ik2fwLkOmKOwgTBOIOUr5t2rUNZsNa
+bWnyF4Xi5SKI56Wq9RAL4AU9a/GiTBLLqR31JlkbzzY8T9rD5n657Zp7Kp+L0114PAeQ+bJOA4XDRleQOTz2vfEX1JFsXP1qeMXd+4rbLsLZ1bp45aGLV/jF
+yyoPONou5bfQpcnuwWdsYzHTAB9XyFJXFYVde4JUtfkY4f2TO6etSX70EqcnF8wdcW97KwCARaOIPR50RdsoylPeMpzMAck5zmZmgxWSc00Da5MY1Aq/MVb2
+k69idvYaZ/5TqPTSyLGuCoOaVjq4qIb2hRyFmF5okv+Sb5Ou2j+Co90U8sfjqYsZw0aq8zkFuZz9AUuF/IDBeWM7SoTIUFOUTC8Cydm6KJn3w7EcQFvTebYN
+uxedrqxe5gvyeXLSj3fQmtgmqCIj6+Q5OAis94k2NXYY9tlau7ySZ6Px2e2HyQ3YxqQ7574iXxPR/CfC0uDu1tfuJQFBbsyN6xUdrEGcCDByA0M4kU55YWI/
+a7ND3e8mt9dwNYEfYVlWeBsvDiBo4Wl+b67wievheHJ16Uh/vbSDk3MINB526zmwgX72fgBNRWbgvVcjfg6Jj60OA0g0fBxdXCTy49X4n+Tk5ur6evDzEHrw
+w/UtFPpuMLq4JXrgD4OLd7eXZ1QaVV6gqMfFhbtQfIB7VFRLehl0RCSyQKIKNCDvB38ZCtRWGF3CBekQRfNJ314ZbAsjCQhBJcP9Z3B9fYHKFu5L7Prz4eDm
+PapP4HAMLuTo8s+3409CjIeT24sby6EcVHcSzDJ7vxv+883wkl4yOsNRvhh8hEnyfvR2dDMhPQhfyb6cXH0Yyj/fjkeT8xH25USeX1FFLy6uPnKhZxe3E2zT
+OHgYLqx+auyU1k/k5Io6x5cD4+QLAp0SEfUNXDeFODnp895qWUXRQLGpbwPwsFUPWgeeJZXPa0QecNEQjCsqG1mAFo6fzy01gVRFWrKuYNu5lQizwf0TyVKr
+NGPefPiIT/kWr1SQr1wZz94mdla8hqrHk6+I+4IAQxCLoQRXxAt5JAEb2iEbkCnw5lmDmXDim5MaROyelRMwCx9FgyE57RPp0c+Y347H0TCfA/aqKClFtTXA
+GZL6pZVeJQTbV4RLCGhFZJrLl3+SZ/13/XFfnvZPnp/I46tZ1Qcm41e9xEaIoIVmERQrnEM8EioI3tv8QV3Ei7Wo2AVJdm+tRien/dOTU3k80WtbJ5I4Sksy
+YNJq6X8u+OdI8+NbdfpD/4fT56fPTlw02330Uh7/eZNr21oYqFp/C9ffUs0wELcrQEjYclM687PUVsuWYTtCnIBGYVrOdJapXJtNWQsDuEwzK7oJKW5VcPLD
+gMx04fQwLaZvhZmrklFRlv3X4Rnb2KCQ0Jj11HOdL0wx05RpjtPfP+smcKEhg07Pua0RgMxl5llEm0wrGZRaa6grM0RunKksXZgiT5XM1IOvQSmOtY0Ghe8M
+3NaZeiBZ5HybeKYmB6vpJdIZTIJVPxdZOquemcWz+F20eXi1rRqCJbiB2DQyYf29Wb1xNamKv26KtJyzbASbue/0HNMbzsymqErrsbk0qCGYc+Cb7MugeywR
+ymat0STDp22RZ2aTEyX0ROWVgh28UEn4+LEqw1TUHoWf5b3ON1pmW0ZGBU8LKjMsJPGuq8zAFs7ZwY0935RVGUBvRBv0Zob1p5+S2equUKqqTJHrbSmRdYYY
+qdZo8fZR8KMFx3ubY4jwUpGBfYbEB/SDnPZ9NatKBxgY5cxSYnKViYminL2fjZmXTIbOc4HmkJ7zAZPP/VHof/SgrMapz8ZUEil5qgJ35Lw+k/yibo3BR4pz
+iE2CKdseK/YUZTQ0luLdLCgtB5su3AzFk6jY6HnEkTMvKLmZfoZBFM96EUV/Tl6GKs3wlk/oSqtdeD+Zjd18zaZqxHg0SuOnudys14TyAeKtAgnREwK5S5UH
+WojwEyWZ7w4x2DZvHvqQPVBoGCT2XFrTvHYR6FpiQfIoFks099By46JiARLrnSnCqqWWcJ2j4Og+E1xrB54vTFYmpFEcYIUTFjy04tGrlcntL6gpW7Ppo546
+E1f54JsnBEncI9SVkMVABPIP0EFka4V2F8KSSbsRp0NacOswzJsrvs9b0nlqixtI2MrtTJOmEG4X9o4q85DrolymSKS8SBcVkgTP0Ev36vn/03MJlDxKZlNh
+ZB1223KpCl3astIeiyFQDkdYblA3mKmv+o7whFzh1gaKKWYuHDmWqiKrBOtTLVUuTK7deLcl5DjqFTiOSck5Wy/VVKNjySaaQNILHfKeloWbW2iFsLSb5e4X
+OV1TjAlwW4S17zxDDPyrXJqHnOWEdI50/QByCSzgn+SwjdlAHs5sIA5gNuiDJeebdNoToh47/kneODtVla7zm1SWNHa0MMBmEzVi0OhNJ8fTXs9x2fwkD6Bz
+5RKoI9eqUHeFWi8Dv6wvz23WPz2FJbW2ld7sZr8Ido7dxBdtPBeizobxaKNwBMPxkdKdcT/JJkXso10UVZL656upSkREVSJ/IVWJCKhKWlKzGzNVSnb2/oTr
+so4HiDJvao+/PNYw/YL8Jipj7UI1COpBunQCV60OopoUQX5qlsFDm4C9ixKDCp3miVxnGwrbqLI0SNk+lx6c448KwdrClDzF668y7gCBgzTNfIJumpeVyjJn
+fUWZzcKRbtBxzamGLmPZXhNVkZbQzNAEidESoh0SAZ2MA+PXuerRUH0yG17mLVde9/OXzVSV4T+j80YOSE6OhpzoXxhZtF5rVfwKEA+bisH5lQ0HRROzixlH
+8znHVGGPb6rfVcbSIm+j3+U+/86zfDkNvbyK0k2xkapo3Gge4dAOlRSPgyb3fMJUqI3suA7hdXhuctyNmGzFrAkmJ4YZvh2mORMS487hrieRFQrvZJxhLLjo
+NsBKLqtq/dP33z88PPSnaVVRA/szs/qe9/Hv+7CY6/S8QYaUjs6q4Ae4bDgbBj1Izl0r2rNhuAf4esGI+yyFO8hERxSe7joD8T2YJMIZ/3TJ9qYxLt0wK7RR
+738ALYOI///k9+D/f/36+atXTf7/Fx3//x+L//+k4//v+P87/v9/GP5/ZMJ6Iv9/x/zfMf93zP9/KOZ/FCITTomd0JocfFB2XcTyAMRkSD0r5yb3rISeoc0S
+i8L61XmJP8GJG1x8w+WiWiaKrcZaES1I+yqlLhd7UvUTD/Glwx9dni3LljcPIoLGcug1QVnflQ5jW6eDe8c3C3Vv0jmrfoq52UyrpBaSw/Lbyb5Uu6IBiipw
+9eTXVC8CO4hfNIK17mvQ8He6EX+ruhHJIUtb7JwYnepEpzrRqU78dqoTQnxj2QnyDeY5U3zidSWvFalaNzW3O7QwyLjhEF+rXGE3l/qbKXLzoEo30bOtv2u7
+jNxgitRUL1wniXixYf44dknpV17zuWaNPPVomE7vaQn5a/t4UGTT3rtiHQ5xiA6HfIIOh+h0OLwOxyT21XP35XPP59tCInqoeMffJeW86vjmO775vwPlDvXL
+tDtE62H4B1LvOGxnq1WwVfJDfKXkx57EGPEVkh9yl+SH+Jr9d7d4kugkP7otuJP86CQ/OsmPvxXJj19FiwM+xftgbiqfkU/OgdQUiMKuNF6ZSx3aOqZImnwy
+8SoRe1eJfNoqEY+sEllbJW2eaomeahG6/zH3xTkfTKHvDILQHu8taL0whT3znkSs4zmTke8iVyudiJjedq2qZSLV/F4XVVqiPyeRWZp/9pEBR9dlsexc6krl
+uUaeMPuBnqeqk4zpJGM6yZh/UMkYO2UpDpK009j9AgkZ8ajayVMkZEQsISO/gYSMsBIy8hdLyAiWkJG/WEJGBPPuF0vIiDh5QpUSafc5aeypvSlq6apECvG4
+rEsrF7P4RbIuMpR1EZ2sSyfr0sm6dLIunaxLJ+vSybp0si6drEsn69LJunSyLp2sSyfr0sm6fFtZl7u+PKsnwB6wTbYdhWXSDFE2B/nSVNBPluFjP3WVC6/D
+fFPFFkml/c6V31FwEv/igjVBRBpeECX98r03uG7m23Y3U9iUlpYIF3Pd1tmB7EKcBffffF6PMTgEggj5Vn0ofUHuXq2/JsFdLFp9ql8DURB1iEJjJ2pk7z8C
+VPCACVLY+QVoAOESXQoddIn7dXP6tbLnOIRMfKg/dg6hN6TO9YeNUaK1wjix8ClfV/+82lnvRKQLSUd5+3HoshwsTRCZnKaoe7GcT1Q4WJkpgo3RxfJ3vYk2
+X/smJfPNakr+0kPeKsO30vuYoprTt1U+F8CMi1Nm51NSzWuJOL7rkNY3kYtNPiOnO5q7MIERjFnPnfd2/O5oYg33Ir4O97JrpotDZjpTQ/lpAwW2ThhMoEjc
+7RUz8xWlW+vVOgsCqLFwCO5FsnUvEr9oL2oH2wxcUASGvRlus4vOxWvtval+eIidh4dsHB7bfWgR29hvvQ034SSdlFonpdZJqXVSav/wUmp1Q9E5suzui2sA
+LkeZRHJZur1SE/lqBb1EK3hJYRpqUKHvzWc9j/doh36b98VTRdzkoyJudmDk8XRT0QGdbznHir/qdQJvncBbJ/DWCbx1Am+dwFss8KZzeXwSqbvBPoBpB6me
+x+pu9dDJDreGuxnhOWsw0DTXWQreX3GfKqlXXCtOU8Nsziwtgxyq7z6+vxpNvsNsqGkA+Sz0XVpWhULXMSZzPehpSXY5hRvaArxEaFV81tVOx5tg8kPFuG7N
+tbTVC4rVZXqHvwikpGo+qoThSIEIQxBqp961dLWPKOaJRxXz5JMU82C3t+lbeKEI7SeaT/qR21IouifaRffk14juiU50rxPd60T3OtG9TnSvE93rRPc60b1O
+dK8T3fs2onuyRXNPfK3mnqxr7olfoLknI8098cs096KuEZ3m3q+kufcUvT3R6e11enud3t4fVW+vIZQmYvbEwHPXkKELOvEfQY/uUFE4sVsUTj5VFE50onC/
+kyjcPkU4EttZLNIMVX4EfYYozm8hEydCmTj5S2TiRCwTJ3+BTJxoysTJr5KJgzkp2oCuan5P0wjtB9e7/Nze1BrK33DBbD6sDF7s7tNM3+mYFx++stA92KWB
+T77O5dgixWNB7pQGsKkyfN6fhJ38XSd/18nf/X3L3wnxNXR/rRg20enf/W3o33Xad3907buTTvvub0z7rqGF9fevhveP9+etKWZ68+XXEf47SP/v+cnp6Yua
+/t9Jp//3G/05c8fFyY8/vpDvCpXPwCymaeG1MBaFxqSHMmC85dTr4FzD89ACVMCGcGb7Ws0+qzvd4meiGw5/b12/Lofbke9SeT8JMR4Ozj8MBfqCVcVO8u+v
+svl5CrbnquWbD+pLuufbdFaYfV/n+x7eZFW6zvSgKMxDKcScfvm9e8J+ENXBfRi92n+at/y09iIIzz6726RzXdqX/dsHM9f/Nv+34WqdmbT9J2Ot5v/2QQtx
+tUD/HiKmLGkAcpJuFIZl4eJmVXzUplpagJ6qAC6F578qYahwSOW6MNNMQ7jmbEPmQJSd9pMQC55ac1PJKU0vqSq5mWVmc6/SnL7Q3Snzm/45G9xcTS5+Pe3X
+x/f/l69Ofjip7/+nL593+/9vs/9zwGxgr4SlvCk2mOn6uCDs9cVwMBlK2JI5H/nq7PYDRKbPBuPhu9uLC8oKH11KiEpT+vjNpz6GmwdnEO0fXH4aXf4srsdX
+P48HH1rT7IfyZjj+MHEp1C5JYDCZXJ2NBpBwfTO+ndxAAP96eCkmV7fjM5d4DhKu9DdUcL38BHnXmOBNKRWYV/Dh6nz0bnTGAe+xT8MYXV0KmxXAdQwTveHz
+8fBsdD0aXt58N4FGDa9vfPp7kPHt7ljyrZp9vivMJp8L0TYAUejDelnOBj3LtIXHqoHRoXtRX37U9jvhuBWCH0REj8jTRhDYnIjdAua2JraYoGjrQs/0XGO+
+TgaF4Qn9fzcII07kXWEekLMXvQG5ubdHu5FzvcJIiqq0YOGXKs03cKSD4z6tVpyYGdQ3kQ/Wi5ppVdp0qWuqkjxWpbCXebyX99pBOzc1h2EoUbvWxSqt5Cy8
+YlOQK2Q1Ct4aqhtdW5eGd70gORR1NuG32WIKCZdXBEC1Ht+ZLkKiDmIIDQbNpleBp7paUu44ZgJqZnHFRmPwRrgaRQkGRCagSlnrLUznrAx5/d2jlbF0mlCL
+pMXfWqZ3edR9jhM/DSHcD3kLnB76o+HA5hRYKMZDzZAnCszCTZlQ1BeKFXGr2P9gp4SFZLbfxduTxMnV4YOw5BkkizOIo+8U19ihqRzWi1AZJ30AsowuR5h4
+A7JFJ9EY2VCT6ll0wYwRBWcDSiu9slx5XO4bguZOe6L2AFrgUU63n6Jng17CklLUlsgJWy21n0VheqZ/4HtTNJ5xI8B0fkylj+Gepr42B2M9hLJG37bJg7CS
+p0pIIVQxiPvMvc+6vaOyUkSFP6gSKqzntcqKNpmNtCp1tmAfnMk15rXnd9Lkjd+WzFDWj5eamBuZm4CwdFdf4Zz5SR5/IYIOJ1xgfbdmIco27xLpt/2VKRy8
+g+s6YtnyTj6LqHCZHAzp3tJ7oa5zH3t58KjGcAKf9E+jfqLJejaw/Me8czXCRjGbli7DGYbFvoiKtYYFFa/I4Rmzp4QQ2cbuvFSVj5HAN9F4RVMnhKHGuwps
+jKI2WJ4QFWv9Ul6o4k4XGKqytcW+s5EFJAIpa4ujIe9B9yb0aEJdDmJixwq8CvzGrMACtXByLDTo6BxtqHm5gKbNjp4Lfh+rd5ecZ5FWtT5zst0o/hazndTn
+y+vGfsX9FGlW1gbSTXgHVY+CvWkAT8eJVzpOd8ziGYSHcXQQG3JYwz9wV6plhFH+juNPPun/0FLpRnsaxyw2/E9y7GD/PDNoK4EQKsOASScC/4/Cs5EHpWwe
+HiC1BW5hsCfHo5/f30xAfuukXdiugSxunAexop2rrlC5TAsAh88oHSvSUkpIdKmpuRTyQntNJLEuYEfTbZtLQtDuWbYNFJPsJ046KeJDn9dld+NzYNHYnn1S
+Fj08WwY1EQ9OozWYJvjLYKZgXOER7pul0/jzcH3r73Ixw/6JzxXyB0PYbyHgT9gVjUlJMVr5tH/6xxv0GAjIld+RpLdHPaky+E+6P6xgqxRPG+j9oyloNG8C
+YVILPmgOGKM4Ym3NgFvP5tnE22M+D5euTCERwhMxNB9I2TKx+2/UKmyntR5k2/tYtXpX90+DHd7htoI2NOnmYg0YkZs2haXglhX0109ouVqmRBuje3BUfHWz
+qNZce/Jjcm5aSf0ltRmHqU/+ULOZKXAJihr2k47TN2ANYyVC4E9JHQV98BOncnkBzii83hhVX7E3Lo+gNhPQQm/aD9MtrcCGhdkmDnXsCbdtGkKtGtbl0vOM
+ZHN9n850SRWb9fbA63hKNI57N0/p+qCmpc09ajbI2e31/ehFcNx5thrLbm7vrI3diLehEGolmF45fnk9lzlkJw93XD8XX+LMVWW5KWCqlBaOTD+dbhtiRM5b
+YjvEsZLZLiPiSuxHPwB7NA8cAUq7WdwXw3p/zJmDoqwxjVYm6N8AxTDFbNEKFBN2vweCyxRuDtcD1K217sJn5AVazwNK6+cbb6DgYHeMKJrMQ2k3i0CfGaeA
+b4slgizLzUqXKMxXBz3ggTCDfJ/H5LK4CrnWcz23BwJOz5ey0dVBqjs6ZlRh5yLRnSALkkUSJxI1JAQ5tSpd6LJyF3Qn9QWfNKauJ94JKWmEJ64PEtW434LZ
+Xst1IzvwRT9ySsrx8H/fjsZD8LZOQA3wRI4W8dIu63fHun1jIdTQmas0t8j/mRa7qLrwUb4oq1I+4Jld2hz1Vq7jpMlpHJ7UNmchqHUg5mJzwcLtEDe7UPDA
+5DFhsawMZ3jU39aXg6ioWMfc91Z9i6x3HB+EMOw7rtkRf9NP9nIhpfzvMq04SUWXdRqD/eqt6PSpFVU2IZo/CfHfmc6AkOp+f4mo1WGeR3dk+IBXRKrrlUmE
+zUBhGy1Lcbm5O1fwpCmCB7EVsI7wQbAwww0pEUFxe8tY6QJcUZXdHeG5RVrlUCW0PUTgVuIE76SdNo+9l1bvOgJ0vKn3HsMLDuk8v3VDhRhoFubn2YxxnzuO
+qTrg5E/zGWZpqcx2PLNnpSrzRVlgdGZKxDQs0qr82kbCsnRSJCFux3KJsKRkDfhUz3QWATt33Upgr4Fl9ufzlw8ssMF4SseVedr20OTdtjusTTQM+dxpL4c1
+7FaOLnH78JtGSqCbEB9OsoLOSfCifyo/Whh5UDW0lnz9apcSeGtjK7A7be3RZjzDddUOfbp2bkTiOnW/D30LL/ov5L4IyULNYDJbjZAAZTePebBF2AtNGytQ
+gohWDCbigXefKUedfVAKJU2unxGD3Wa9KExeWfEKBllEFYgaFr1EuAzD+jv4wJptigJDUSwO5+hNNSEw4Fcmx0uRCMkzqmVUmBO2YtfZToRozdMe15Yvnghk
+23VOttxPBLLkhBI7bhrB0saV4G/KGoJ2/lpbOmdCuIYqI9fLbclsFDh/ahLW8ARc8fpy5Fji4DOcQVUbX7nl2qy5JHERY/djlg8sR3uVLHUB9xxC0mDBeP+j
+HSsqhA5yHGJ0G7uhSWTAJoRbBb6Bvd9C5WFCyywtZpvVvc6r+Dpcg5Xkvro0JVJdikCZ1M4p15Zol0DjCZffSzloNoK4A1XkZJ5u+dZZD4T6C6WPFzzNo5y0
+jKsI3w0GsUTmP21J/5Figk4ixqHVJ6ZnASw3zFP4dXT/wX41qN3cwKUa+fsDey3CIq9tcjVrT0YJ8/7WHXV5goNhc51pzJIobtCgqMZjOJC0tKiujcqCQHeZ
+hGqqtkDMhkMhaCcfTxnMsTVWN578dUa5qQnjEu2ANlJ1aXZOtgCPoepWTIN0ngCeiMrVtKtE5X7lkDR8Jcdn/ufB571IzoPg83qh8zmf7CztIUjJo1F5eTzi
+n6R6HpeLLno4hOtx5uh4a4QztWyvqMMx4J3elCWYb457AC28EthUQAXG2ZoX+Lue5SbgvRFt9wTstnKT0s2VxQcIS8kMX4FfIOaZjyAVO9ovYp+t85rhh4jL
+NYU0zBhQRuIx+XbHtBfRtPfu29buotBmroPIJrQ1knSsn/Q3NRUae28uuRT2OzpHLlSVehO2a+rsSDAN20JLlnRT9B1DZJp+h3Ah4nb+Ci7gdUrlnUKaVhAp
+GKcyepOoeziepKXZNItrjEWnfWI9658mza2bMhF2q25a8yWyUMKhkqy+2e9/P7r+t9FkcgsEIZyzwJqREEJOc2Fpt/DmcFAuVCKvct32Y3GdqX9XiRyVmcrn
+qUqQ6/kTHGMnJz+8/DGRg6rKf4oS234uzGYtTQF9ximrFWbhUNP+10wB7iMRU1NZEvBprBD6qICobAiIWhPGu8QwnjjYrSNqXbx3qpi7zB3iX0COtHAI6RrD
+5k5da7QtEBHfPZLwVLOxkKw0rcqibnTb1EVjV8tnHd7d/rAyoz7sbxVaHlcWfdF/HYylj/cVeoWcWmZazpB00hS28/CSNJBtJ2yAYjWFdT1To3UFcTSL7CIg
+GnRAmsd5aQFxQO0iEcXDZSjHH/js0MJz8Se+soQ7beSrdADHlsgjbicOfkaGlFBRJgD8rCXjIIrtKRb2p1HxIwvKDOahDMjGw1tZDflWe2F9JdDt/mXf03KR
+mxV8rmecufWyfyKHrW30DABuAvk8htg6tlwUQsUrMdTJoeVkB8XtKJyL1bC3OW2FDTroyvY0GbdZzVXlEYpYar/RMNEUmw1dxeiy8RK1bRsLI/oTsYNjyTt4
+d9hXionfQjs7MN/hSaQv51Y2fkwvoGGIT0S0MjCPlKoo09wpCbWcbv7w4vXilf9itKvr92KH9J/D7NcHMRanprmK18qQp6GRHvIKmaQtdBA0VE4k80QPJpZe
+/OKTnAyR7vvmPSRjh3nJSZTbHORfCwIpBvnbUBYkd09uBjdD/OLs6vJ8RNTYQS0ouRp/SyRwwv0uoazum8E/AdPZ1RhTu5M2GnR8C9DgfYrZ9IQnHg9KZQ7y
+RJ7fjpluLQnJyJOQzxyDJiEfOv0eqM3ejW4uoSL7mNGZtrvWbT+PgGfuasws4+fi7SdZI/mTlrYO6dk8j3u9vyaJb9ok6rEJMt9ROvxgPERu8LefiHENUua5
+YyDzfji5sTRx18Pxh9ENpMe//QREcSi1c0rr3R9bKUXism2D96NBW+mP4FyXGDrflFaKKFSha2w9NuRHMbYStQQdFB2Z0tKaN22nJy28g9Tu0fbE4je4y5ZZ
+OD1SXRRIG1eHC8d+8jJh17sglgK4x0Epc1WpJFLfAsN87TMsNzlbOkzhRsHLotis7UUGbHtlM8de9V/URyNQySubUerUxqjZXbtg8DRrf7vCobL5hnQY2J+L
+mWcqLWaFWlQyV/eOGBWua5vcsjaV27LSK5KSU2khqwJSc2eeHkfNlpjn6DY0zEGG1wOn+6bQ9bNnBnrGItNqTtd2VS0TjlurTKb5XzegRWUKWcLtXTsPJHyk
+8/u0MEiO5uIhtAG+7tcI+T0Zo3j99M0Q11dEsuhWj6DVkyBxaLCqPZskLviY75E4PYlo01FuioBp09NvDv95+OH6YjD+lMid7JsB9aiFjQcEixeganA9vno3
+upn0Evn+6uPwL8CPObgFClFo2hXJKBCtadRbiWSOUzG6DJhMyfoJf3a1jwU12qt7YjAeTeAHrN7wcfBJAj8o7563tJ9GYe3axno15qEAfs8J6llAQZQISYmR
+oUSCZ/scnP9lNNnL6Ckso6dVKboZD86HHwbjf6JD7+14cAmtE+KH/kkcKXFJIhTQB3vAr1x2XkcOGEd5FKJInFIJ7iD4L7RmoOgHVppjqxZyIw2CsmJ2Arqd
+fj9TVTFfFZ/7y2pFC9aF2fxLEudP539m5s40Xg0IkZxIno04G9QcVGcD+QEexlwkdpi4N8QvoPJd8QKKj0pvxomC3Y+8e9DF3GEq928XKYkpxilT1hB00rQR
+n1RrFi/5vXQ+NwWlP4N1ayr9hDL6MD2ixEzPd3aShLeRmnZ2S96+HyqBY0NnCdelZXziKFRdM4ru4XSRxCg7TQhVeQPYXiS9DELDvvxTX14PcB+8GN2MfsZ9
+BpRzMN3Evzu13KKlvbAGhOmhK7SepySOQx3qWWHK8hk5TjADYJNXunCOFGWdoHUhgaDagtNTAp7qujByewjF5+Sh766Yw997wvr5SstN7waUGnpc9jiPpv59
+zSkXcW8HeaYB17urHV7PWPGrCgTp4VY4d1IvVx8vh+PJ+9G1ED82M8IbNOYt0cg0jz2CyEXfP7Vc4LqZP8WsSU5tIQmSO8IkKSipvsajjMK6g7IPy3tn+aKl
+fNvQ1uT8nS/GK1WYygGb3FkMLip7Qep4/Tty0uWmEtO6+E1EjhNyCp0NYPljSjABBTP0l84KTSlSPgnJaXC1vrrNDKb2OD4lprblDcuUNXaqOpdjkALFu22Q
+ab8StJEwjO15H68eo0veBU6e90+QRc8sWuZ9s66NCU8oLTAX8WDcodXpxFypZc3sovAVMO+8sqwTFbImaS2xA7aj1MyxlHRlfaRTPTOoNqYeKMGFyshN7q8L
+fTk6sNGuuYloHHDQaK1KzUfcfF9mA0awjcmlKn0LQOZVzSq6sgSZFkHNQr9dS/XsYmnkb05DowbDwPFOEJLQ+ko6WSGU7GH0RhtBP0yok778eXg5HA8ukAX8
+5CAK4TRHAQFpChlRCHPj/A1OED9v6JAlbVKsMBbClzNXCl/XLAMjnIpzlIYTj0TQLcR3sSnolKVdvqYlQsReHO3cx3VsM7rSPMzoaiApiMvaF0QdA/UJSZCx
+b095D3KSmrl+kIHguDuEUVgT98BG5yOwM1ThZJ9hUFSdTMtKgd+l9zqXCud3mt9t0nIJKTX2KVLLpoieQ3n7OsV7c6itGUN+RY2dbEelAuCuRYnaEEDk+U4E
+Byj2tTAtvVhl0gix64yqI3ZYfscxgrzWUI9gDirQpoJ5NtijfxnjBwF3uUMzOVjvjysni0OUk0M+YdvkXYLJolUwmUwrXzHwSRPgZK9ustirmyxtqx2Oeq/A
+sWgVOJYHCxzHQTLRKnIM8eOnSxy3BWIDqWP5RKljcZDUsaxJHY/bpI4FSR17SWPZIml8AnDaeg5iPW8JTH7Hr1hx3MIGdlkUhysgaLsp6DIRZke0nXyOzBdX
+01arwsdIBcVzzMI+rwpT2v3ON/hBpUjH4Q2AykglwZMlK7RZ0lxQpmG5ySqKVVqDngnL3b8DfE9aQmybFJEYs0wSGKIlaNzgg6+ZW3BQrDNF0QrXiyLoRTrD
+GgVzqg3mIuduPjmP3V83RVrO01nE/Huv8w28KHE65GRiLPRcF5gfsimqWPSMJvasijYR0bChfxGtvGillZeH0MrjNH0F+b6Fjt3lmZmpQBISz2FGUf3vjZ7q
+WSLPVK7mKom5zMQsw9nFIt1MAxvYCNTpi7RYuevtlgSUIANEl5W95Md82FYGrxZ3olMSieuxrsP8Do6rvrzQpXutzdtReaVLwW+Hdv7fzXeQk1JKkyM2707L
+/7vREtMBMfeMH62krmRlNqWEH7t3C3LwI8wH+63Q8xRu0zqXKr/LVFpSD7+ODv+0DC9T0Cr9BSnKPEKUHfRRbiZs1n4/c9sG+hGKrXdV+2Hkgz8GXvvvxe6o
+SJ05H62uclM4p4R/CVxIRG4qbgRU+pn9qylse2oZc/ep8ZS65AtoNFhE+zcRvVtTlEiPrcXovAE4S5DQjRVtuP9/iDdirxZDXQNrqgg1QXhDiuxbeLUIrIDd
+YiEdy9sf48/Z2bO3n56d9J//igyg+/nfTl8/f/mqzv/2/OXrjv/tt/hzhsz995TFavJSDgL38En/uTwbDwcowA0iayAbdnY1vr4aU7xpNCE9LwiLiXej8QcM
+nJxfDelzziRgKT0S1wYFtWbAB6jjxoN3N05P3ZWB7x+iuPrNzdX4cvjp2dkFkK3J8fCCouXvR9f9Zj355ROBpZPCG9WatNqPBpNno8kRqbW3PP9h8E9YhSBO
+L8bDnwdjjHhBTCks0yZNUADRBiQndfE3juI5rTeBWm+QW3EbMsQJVFtHbbzjwYTIoyB6P7y4+tg7jCOv1hxxffv2YnQWcOKdnV1fHEF8LWTHc6+ld9wMzzhr
+4Ozq+hMG3aB931+NBSnvxQJ0jl3PRt2wKPrlzXvSxw+Ezb2OubDVotci//rwHCioP9nQH3R6EPm7ufIvcJ0BYUBShicaPsq3+Hk8BN088XYo317dXmJzmh3m
+dNkjLXkMME6wSPicXg7ZLDeD0SW/UVK8dDI6H46dYN6nq9txjQwQkz7opVHqzMSThDkG/hKV24/OXOwNUQlHEd2QpYmAf5MbE4PlqL0Dtni1NJm520pTCJ3P
+trPMrDUmxUYZSFCuhTDTGY+5zXKTM1/NnKHCCuJOBL5V7K/B+x5eWGYx+NkZD0jGY9mtbIb+WqOGKhGxsPG8KnV2j5kJhUZ12tU0o9Rrg9AB1w8PS5Mhjjdk
+XHKmCqbURX3mvPVW0ymdI1pRyZrqSAtPnrs0NPSCvD9l2pdHtZLiYWIgPqoMuw43Re0Dn9O/RtuQ5e+Yq8YPdFWovLSukdWG8iMCleNEzgsFAQmy7RKxIEtR
+Wf3CRK4M/F+u01mo4ZTI0myI/xCv8HcwFJUj9aHC1LRISVYcx3iu85ILjWLOMGFaZhn4w9DJOVNllQhsCvk76fm5WqNejJVdoxzp1nGW4TiLp41zbVBbxnTW
+twIspjgKWKBila2Q5gyBLKVvqnPciV08XhL/zPvyyIWvBmiVP/rCUI7HvrAvhO7Lo3D2RSwD6IDBnjQLtv45o5CwuzWqRNGs76Ivjz6ZzdEuzbFdWmMtjhcB
+LVhSUrdTssm2fOHR891otbqbUdj208WHi7W+XHuZJyQ0Ild8HD+U5eZKa7GzynKuy3VaUQIsVdjfz5BsQr5TaQGajXKMhXiBTht0y9phtoVGzizyOSZ0oWN/
+SKCUFSGCFvAq5FAi2R0ibbPOo8B5KXiLcZxOAUVJND8oydTpqQUiSq5cUUuNw7R31z8/FypHl2gLP9V+VoPEDYWIaapQSnUXB1WDq2qti7VGhM6xFdWab4rI
+UxQ0wDWxF5JZtUFq0txNMgzAVaqy58NPeEiHzGfSz8cKk6ZNsTaFqvwXdKIxlR46IGv7GCd9tJZKVBuuUOd4qh155Ru7vUyxeg7mNw/KrGtuvcFtL2bBYBiH
+KeR6aXJDRwORuDF3m6Nuc7hF/wnG/uqfglOXdhDYiOQ8vUuR8mAzTw0dbnalBj1mNTRbmt/S9Pkfqhkt/YyOTEiwsHOMD0Y7/eYWRrMCe428p0h2UlpcByaI
+QX4oJoh7JA3wQpUQxGq8IuTe8hEiG0KsUWEpAkhXerbMObMhCj22rxPefhqV7ouBy+aQ7ARjH2sQavYKyYW2PtBCQ0IZumBfokS083vt5HJz4RRufuTSRZhE
+6Fa0Ijg+2OD1JkI3G610q4Xhp9ZhZIam8B/yTIG4PX0d2IR5tuUd+JFgM1YEgTU0ql7ICF/GXnEsfaw5MjQiIExK5lnScqwiZFZQQdFSsfsn1hLefVgHiP0d
+YPsnVoJCg8T6RDntj02SYEuwBGP0U3de7uw22yue3Ps7ESaZt0AoHXtVXL8aEyXZXm48Pmu9likB9jjlgCS4CLXETIeN9As+LS1Pjy7A/vLY77gGT5x9Ym/n
++y51YV5c9XBzxKvFSqtyU3AThM39VrMZa1FtfAfam6TDarVxx+wwMOXA+pXDvYvjI7Z/dhyBzTufVS5BtRFOBWLobNt5KRWmkVJ2g2sIZRFS/BI2jrryWbth
+D6F1GC06c9uqhvc+mhher9HtfnYqJY3orcvySQTBCkN2rFp7MOCI6WAzz/Pu3sGLwd47BN07Esor4vBSrSHNa1R7Q8STGiLrDam9QzytIbK1IXhFHy2QY/7X
+2rnp8ls/6qUp6kNTJjLaLESwWTSV++xN1XoIMIumrckwSvO0EkFim8170PN0g7m0ZK7A21HQtkqz9N8RO7Ml9dltCKgTx9D0Um/mJt+uEInpjOeeXfD1SqQL
+FBLKUj1/gz+gzM1oewh/UqOub5liihvmYJxWgb5l22mZP/JY9+/6iTx6BxNoGTpP+Glh+VXqc+hImkIeTWaF1jlaiI5O0fFX1x/ldXTU48wQrjobd0Bxhhsc
+m3c4s91o0Y75xqVwJ2JpM/msCPzermqZagmR/3AKmSiDKpGQE6lYEmOe992QFCdWKvAT8IP0TMnJuH6nV5XMtCotMScDRC3jzp6CBRXsoIzjmNAkkR9jBpRz
+f0SCRfZ26yhE/FxYuFVrGcJb02bdLuI5IUWDE9LtXlNdBhKxpviubCDrg+FMczhstj8Jkfb9i5aqZFLLeZDgbMcW6x6Z2Z4t0hIM8hPONrHWA6txcLbMYpPJ
+Q60bJ8gGG8NS3VtGD580iuUrxrBYuiMCzm2y0vjQslhoTeFpdAqVsU9wrbaYD/BGiDSlQx5b3OBbjR0TSQgvoVFNHSVGIlj6HfwUnsDUvxQ/szDnEJBjisCb
+CF5ftWJ/KWSX2jQ/0CZXs613rVSmqND3Q2A1h4DxJdNx04o5A9DseR1wFuGlKFaBv/k4HmF86GpsiT4xbhHHW5IoXsMlnvv4lnTxrYbI6Gg4iWVGhyOoRQsQ
+VzqYlytEeshZIgjX5QqNQ2QQKQGCUihvcHZ2Ox6cfQqDQ33A6MkL57GSJpcXln/I9WOMx9vTH4iO5YfCeJuFoWG0zYfkbq7kAEZjfI5I308+Loe/FG/Hw8HZ
+e1df38jRpZwMkRNAvgJYH4QJAXh2Iz+OLi58+OjtEN92MRQ3VxhEYvgdBUQZhGdRgg4HGIIDIxRgIq9vQUTmL0OYFw4u2Gimg9jF88xB7nz/E15OjN75Or8f
+TOTb4fDyAASdbCDofIY37s83jZvOnq2IjqUADRNCKwRam7DYpgWiUqZb3LJqSal1Y9y5qEvno051yQ7oe+1dxYfYbmSnwlv9eSLceeIObBeIwDeQGIvLvnHN
+m7uzng5J700vRVDVAJq12GRZi7yxKUOlKIfmOUnkaSJeJfJ1In8gt8GfqGo2Hd9erQMy82Zsq+bQpXtZm1s3CQ+qaHBlWorYOysP9c6GZ2OvwVkvXI2i0xx9
+RrVcaGsI1AI0HjSTBWCNksGNZWXWTTg7Ps6UqVW60oHRZueAcJyqOLSYA06sVjwzsIrwCmjOvFAPUc/L4/AQ81WDQi37DOWNxYxQU500sF470qIZ31RzAgXA
+DTfhEEaAPyZSXCvZ5Sey8G5xHAyHG/yQljOdZSrXZkMBbcyehU6ruZHkgU4K6Je6wzyJwzk2Emdih08sueELpPlVwpWnbVZLVbbO68rU9oF41XxlO5s3IPH0
+dsX3k1+jgbP+3ztOh510IaH3L4XsiMjf/jhkZw7bHbZEmiL4fb2jXUXmUNM5ZaTPmS6SD0vBGdxUS17AWA/8dWHNYU9ehQAE6ajZ8rkk6T8RdNSWnWPEu0pd
+u6NUCk//OvmlOM0zK3gBBw68CS+1hYY+8KCDNL8rE/+KUoS3P2XBnTGQxb0C920S1nfvcasTe01w9sEUEymYCDmgRqwrDSKMiW7h7BGIGDiENTdqPWcdwVMt
+XYqOndY4Azd40MLgVTr3zRURcMkUNpTQF6KRBcj8IsoPdHMpEaNbbuyNGTITVFUaPAFb6Bydv1w2XhfmbmSp9V+hfVegE5CqwRYg4TqqpYbLp+UKttSavg4R
+OT31j/CxcXzoTue6UFk7DbudvnUWdhuLr7XR9lFmjc+9CjuyejDy+LQncV3mM+SJXzR7ZqnCAFZqgzjzQIQhGlMvuiHchop2aOBwIHEkDwoN3Bp9IRg8ZM00
+Gx8LsmXAVJ1F4E92tzgoPvnkSuGWpTdHIO0wkblOPQk8DX+DFUMe1XvjyFJbWISD/60pmG5i0Ta7/OIghjBeG3ZfbHuKwDmeQ38TgBnTvG6HN1bQd/C2/NmM
+xdB9RTelutMSVdmzNMeMNxcAdjhCYSgh70FPy7TSkSpLgykeLkbs7mZPeojQbFnc/DZmlow4RWb82xl3ginuvu9y9f/u8/9Pf8f8/xfPT3940cz/f9Xl///u
++f+nv2X+v3VUwdPim2T+Y7nim2T+e7em+EaZ/+Rh7DL/A/+kf9PF4GMHBeigAB0UoIMC/L5QgH36v6w15687UrlhgOuBKVOL6sf+FEF/wlPlNp8tC5PzaDTy
+GdKVnj8jbL+9Qiu5Aoj5nUjhJiqPj7CMNL876rnLyRMbLDrsQ4d96LAPHfahwz502IdfGfvgtn7dl4edrA/7TlZKr7oO9LLGNj9J3uJqfpup/LOu7HIrA489
+M/dEO4Rw8WK2Mp2QRrDhk4jBfarI1nbv5jWDZCdAawqph3IwORtcJ/Lth1EiJ8PJ4KyX+CSq4DSGno1K8zls3L8i/JYKf9BTMJ96oeXQp/SqD3q2VIjd4C0Z
+V8AESZ9MsfUd9a17BIfJbdx3cNuQpG2Q3nG0+E7nFbfgvSqKrXxnvsgB/rTRO+j79xM3zEiO07WPj2bmXhfWhD3q1XTtknr6dkv+mjUsplt58oO8nZw5NMnJ
+yStHNzSRZ+6MGMwqDNbDFxCIv1cZkeGyAR8yJJU9siQ+0qjBgfbY0OxZI6LN+myY7XvH1stSP322P4tnOw/nBN4//EJ6Ir1E7Jzpds84bE67sRM7x07uG7uX
+bWMndo6d3DF2HWyrg211sK0OttXBtjrYVgfb6mBb/6iwrXhiBCqL0RwJTjPRcpp5dT/cZH0iFWdYlbyE6/JQ3iYM09hux6Nwy3Abtm6MjjQ2GZsyeMjYQe9Y
+MGpvOEDR4dM6fNpX49NuLy8QSePQPR9ub24HFxefLMzn5srGH1FnbjgJgD+JDzxevXs3HE98XBij1xg3dIHqmnwcBFPbcT4Qajwbji9tIJsxIAT6SR7V3nsM
+CxS/9GZ0czH0MnsjKyD1mMYeavVFUn2C0SuDt5Mhh1AvSJTGVg8i5cOzm0niYEaJhbxQ19BTXADISA3H46vxxKlewa8h6wAC+Fd/GY4hst2Xk6sPQ/nn2/Fo
+cs4aoPL8Cn83uLi4+gjlCxTfm3Cwl3swEvebXFHA1//ww+ATFXJ9fYEwpE9Xt98aCnUAKkk+AZUkDkAlyUNRSeIQVJJ8IipJdKikDpXUoZI6VFKHSupQSR0q
+qUMldaikDpXUoZI6VFKHSupQSd2f/fifV78j/uf1ycsG/ufVSYf/+QPgf151+J8O/9Phfzr8T4f/6fA/Hf6nw/90+J8O/9Phfzr8T4f/6fA/Hf6nw/90+J8O
+/9Phfzr8T4f/6fA/Hf6nw/90+J9fhv+xiexBDh0gPTIFI/byeAopcqX4pXgff+38FnifoOJif8X/TvE9nGqZyF1AHrEbyPOTPE57DsCzC6MT4XowJyME9gTw
+HDxpvocn0hS/aCuNf+ImgbPOpcpNkAFhCpfjRNaZkuXawCMytf7XxOYHwOFLjrBE/NVsilxlPbLhg4himocKOvWuTfzqBAsinWnouKlTc/G9i52ZRN1GGWy1
+eneApg7Q1AGaOkBTB2jqAE0doKkDNHWApg7Q1AGaOkBTB2jqAE0doKkDNHWApg7Q1AGaOkDTPyygifA/L35H/Z+XL09etuB/nnf4n98d//Oi/1ze5mtTwOx9
+BAgkHBBIfhsgkHBP/2IgkMByvwkQSMT65t8ACCRQlLwDAv2hgUCRkzL8Dj2fb4cO9TM8B6n3t0M5gI9uxoOzm+QAHJF8BEckvhmOaDBXazLNHsemJK3gFPaX
+PAGcotw7Ezn3V29CLgWIFTCdKYHQnsSULeINKCWzFD4o8J6qigreP+OSbPrLXaEw4hpml1EABdNuSjlLc71SFfxwvUxnQf3KJnpFPIZekTvQK4FdTPcOwBCt
+4ClzB6APiDBib+i5D61bt8JB0JcgTyF0e9VAILn0Y74L8SKcL/drES80BEGXi3A4fjPEy5MaS5At34V+RczcZ/DQzjnnp3yEqyPb38LuUrA+4+lYJr5rcMpN
+C6PmMJtKzPyh2fdgPeQ2hWWlqkrbb6ulyvknWVrW8uhOjhc9StSHTNF0tkzk1MY/bbeXzmGKtfXLkH+QEpZP51UI49MizSuNvQPXX7Jk0THdXCepS2Cc78cV
+0spAWCENewgSaIUVYnCiFVsoathC2Yot5JqaO0rMfARqKPZDDeWT16EIEYbozu3JAxCGAM46d25OO12ty8tfVur3Rb+vsF9yneoySogwRVA7vCyp9bow6yJV
+FSY/FGZzt6yhbWjjo7w3BNFAhJmcao9gyJLg72VCYZhtGDsKoGXHZU80wWVyF7hM74SVJc2o/p7TpFpq4Sv5eIXboGmS6HVzI1oBYzOV4z3XuRsSP2zlEtIT
+bNyfvVuQKyQajQjPOfhGzSpTwNaU5nca/oJ7dIo9gFt6QUAbizLVRYn396WBR+k5OKizFN07c40ZAInEtIw0h66odLEudBXdmF0C1u7tEttObhZ7oi9M9jkz
+hX7DGUvYOBE0zu6T1DWE4CnkVFtfD1UfSiaHVYAURISYWKRf2PmJ+fFls9PchKYfYLcnUB9XIextmObBRs3r6k6584wcM4SAqeiV7vcMI6yjFF1fcU5W1F+H
+QhSbHjhZ88B55K5tkH1xIspZSvNvxgcB12BuVgpGG1x94OizZg88vTJz7DYcb7Ogrd0NrPD1sZn+lLjn7cOpMWAuqNV6menKm5XWGf5GKolnTKEToeZzKDmR
+pS5WNNb+hHTHmVppmSt4Ah5m7DOakvbv5hlbK4g3h1/NlqbQzg584CWrYX5XKs1XjAiYb1ZTWS7Nw5t2JBJh4U3huv/BFDiPGqYmvqIyfP7wsZOuUnK0wbel
+4I6kDEoFY4dpuypX4Hvb4IYflbt9Yy1EsNUK9YALeK3SHLoyEaqYLdOKe1OWs022pr/q/K5QYF7h8oG6+/LWS9OotthTbXlItX2h2zfC15kyhnHuweKTaZZt
+yqqwKHW1xs0nT2T5WVezJeWFF1o/m6crnZfo98cxJZdlSlHfOx5ZyMkza/f3sDegJJz/M/0m3hSgem7xvgk3ojdoGqzWqU9sm6tK1dII00qmmJsFb6LorWrB
+JL+hYCv81b7dduC9KhBbYwo5S4vZpnQ/KETbu3JT+c04hoHv3JH7Qtx14OY94OZlXx5d21AyQw0DuyvGRkIF00oFUN+A0qEyQdypaaKZUreUktj4Fr3RFHZZ
+JcEeOwXXPs1l+D+GHf3vdyKuyjfWchS7LMeP1nSmjVs+KMb8r/RqqgvXSP75Sm0FYwyqpS6jfB4FK5gv4qpy/4yQZjPoBRslXr0RQQe7rowruKN3XBJUHOmL
+n7V1D3okRp1FPbwbjYnd6LYKhhr7f7uaR3V1ZQuI+JaJs00KiTddwMqmfXk0tqDlurFvgb2Lx17QFpSbbul9hO0rYaU71GAZZ5ChjZZ+Ue6iaGHUUHf7xc6O
+KytTwL2dTC67GTaQrs5rk+aRxeBPeryrFiZPZ5xSHxAPnGuVeThlk3zg4qvIBzalhqmrGfcQ0AUUrcQEEfVAwe4bH4VWhfapaAtTUHSrGbr17+Hugom7j5tA
+dtwEu7gJxt+CmwB2YcFwr/GTaQlMDrD8BheBL2gQ+B7d/IgT2/xPwl0fvg7z6zm3gVZHIitMGwgy3stKr8l0zLQqsq3I1FRncL1bqQKPpCj8aQEAVBeC9Jby
+QRccGa2lQQlKOXiHM19B6n0Se4HlzGyyOaGyis96Lo9ugsfJAHpQpXsEnEKwrIb5HVyG4X2TtcrTcklQARWBX7l0DpnrlsKFTelz2Rz9I8f74H0qODr1A7+F
+j6EN0BaOOV4hA6abA14SzITDiBKI/+DS5M8gEwgH+cwjo+0OMJkt9UqXkKXLZkaEbHZuOxFlcrLvK4DjWxcQTkuHVjdFE42Npxq+Vc5Uzp4wSpiqZRFGWaRN
+YLqtBWEpmswAbl+Is5Rrmc9No+8NkSR8/Aa9Bi8U36rXJPWaYL9Qa5ftRfK7CsQdJn5xh/F0TqHb/mKyTV6pts662VHT3R2ERAZtnAPOWafvdV75nBdXPEUf
+tCWf8+5amH1MS4BPqfkqzdOy0kUp713d651eJshygE/w4wlhS39Jx/0j0gYEtoYNR/zpeNFLwtyog/gExB+bT+A8yjfefWbsIAiIidca5ADySeQA4vh2POrt
+pQiwxcWcAO2NENdPAvnLBsg/xPaLvdj+RpKxzbgOaxpe5VvWXWXsW+3DrWECXetttRW7uAHkV3ADiL3cADLiBnhsXMT+ydWXH5c63zOA4Szk9P1wJIMBJLhB
+es8bQJNBIBhb0RjbcPjUjsFzcJ5vPoqQryvsUn95rHoHUg9E8TrPOiD2sg6YPCAckI8TDognEA7UKlSH7Isncw3IXVwDJhe7aQZ8Vzbg+vX65tGt5JeTI3CF
+hauwL15+VYUtv0C8RpJHtmoykwZxPkh0E/SN4QR/ZV8q/Q0DpsF6U5QblUfnIMzRJNxSxLdiGJCmEL8xw8A3JhdIHmMXEMwuIHeyCxwfhdmL11T6UW8X64DY
+xTogv4Z1QISsAxjLdNQDcif1AMUYdxEQiCYBwXj0t0A0cJze9yhnJKSRcUYZrtZaYDXeUVqJCOQeIoIwteIxDgJ5KAeBeJyDQHoOAjBFudLhHoXbPjddvJTH
+095XMhTIiKGgmQbguyDetGJeArmDl4CWvP0CVxbjFB0sk1kEbC/ueh8XWCYw5XKpSlwW/FRp+6iUB3MaYEIAP2TnWj0pyFdvfwob2FbQ/WSUs8n3yKi15ZEF
+244dCW5GqStpNpXlQsrnGMMJwmefzKbYHUNLRM1Qy9JZWmVbTp2w/1JlqYuKjfFsy7CmyJ2cCN5Oie24kDqfm6Jk2Ne2XYM7RC3BNh5sqII31GZ2kFkwiIja
+VluiSYQMsWlaiXBBvBArEob0zOKr60i5UoyxUWXg10Sc1jyE3XFXhPLkHisSZbcwRmW6rfnccUuGHnBu3eQRu9z7rhmhM91aS9IU4ZJ5xByh+bwpK8cxZooK
+En4qDM0ndNXHyQMOYV42c12YO4WeKOWSQmzepQhtZ/JxPVjX7brQf93M01mqMntM1Ebnu1IiA50kzN+m4kCqHzPo/zIgWWn61nADl39Wa5X3grTC0A8jQgdM
+68Uf9tn6lfO46Q8J+rYnXDsZ5MkHqe1XJtqvUke6H3qgTdHoXcG9+4Ru4wjbxp6D4cwUuF2jWw3eBiNO20Bbvp7fvpzZDUBzXVZsZ8QTuhaHIa4CldHsrozU
+dCjxNc6bKH5E/LbGu9r+odgzDHAfi5IZOraajq2mY6vp2Gp+a7aa3QdvG0eNt+A6jpqOo6bjqHkKR83BsZQidpWKb0NKw2nVvwIpzSMNq3k9ai0Rv4CGpnVl
+io6GpqOh+fVpaHZS0IgnUdDIfRQ04kkUNPIRChrxNAoauZ+CRjyRgsZZEy0EPl9LQ2NXRwsNzQK7rTV5IXEGWQ1ziK5hnc/gaKvlVQpMCpsXaoHF2LCDO3HS
+nMUHaf2+1UUO8aj8XufUeLYCrn2+I1KleGCMHNicdUooBvCcWlEip4E7/7qiPJDTPyXy5McffuyRCTI2Ky2CN5mFPPnx9Ql9+XF0fRVIedwUWtEmc/Ljj6+D
+nwT6MnRCXHvkZvyQ67vbHFZEqbKg/KAaxxiowtwNYXL55022lacvseYn5EcudUgiUxsKdOTwCcxGUaEzfa/ySoSOlBiQeRFZNapANBSbRFNtt6O5VDPOA/Zi
+GwUsEoOLKiRX4vF0XuzAhDPwIuob/4D1k+5wM+AxA9/jAlYFtDut0JPYNlNFq5GYqQcP8/YLMkw7aSbreHJJSnNvPKYKt+FSb4Wo1qBz34j6qQgvjHOOg9i5
+PYXZzRe5hOPzso1e5hKjMh2pVEcq9RuTSjXa6eKHFIFJ/127SN10yw5uwbNg8Sj5lHyMfEo8iXxK7iOfEk8hn5L7yafEE8mn5B7yKfE08qm9QlE4sX3dgnw2
+P3CEYXJRusj+74T6/57/EP/Xy9+T/+v561evm/xfrzr+r9+d/+tl/7kc5ZUunJ3W+PkZ59Vh4kVzM+95awRMs0WKPo+533nYYcZ2AeffoNuTPlFz+KDv/Shs
+YzYqwseY867aNwiXYZeph60uns0yylAsXH4PhfOQu0K27HZgKaWVMw7oEmBPpzAPxu/X8I9cHqnyWVoeyakq07Kl6Lv0XpfCG2Hk471TBXEGBa9MCNqjKl2k
+KpPNozktWl1YSf0wDarbUiGbrVpKlWUis9EPtCesAVXocpNVDvVG7954h1QcZJRrU5Yp+ThuMfuh8VLyidkDpxTi0aG1c0YFVwVNR1ebz4sATFCoKcqAaYAt
+qqXJ5rqgY43bUS5VUYMPYcZ2oM/hi3GjEmSU+wsJ1oXIBKLXCu9g4DtE3EpCstFF3SejW1A3G39QSwDVBaOqsoA/Js+2CftGYECWalNWCMqjJdg8+s3G+Zgh
+heIsft3CZYCZovxJXm2KxtDAy9x9Z8FCY9OtoCuhsw/xLgTTvyZ/6vRvDT7ouhZusmobpHEIa8awzNSj/d3H6sb1LAp9b2bkgbNGdCnLJWYBFFqRX807lR6B
+TroLHfhdEMxscBzh3oD3uS0t675svExlpZGlnm2KyNj37sR6KSZsTGm89c59OVO5LLTNb3L9qJBcAjG4LbVgYCAC9OINh9Pkw/CPvSoJ9Fa5Ozf1+dnZM7dH
+2UJwL7L/gOG125fKPVgWN35/zwuXUl9+gC6Y7ZmT8if5kH5O+21maDyX/21hin9zz7XOdN+bP8m3sDVgv+ea10l96ifON28KwUjZvZM76MR50C/YJW5zaCWT
+d56SzCe1BuXziesmj71mC8ra+K//+M9FiNKc6pnaeC9E4FZxoyJ3j8p//cd/Vkud0ya78U6PQt9tMhUkl7irdbQOsaNwowpaUDbwzrtWtWXHy9wVWpV2l0HX
+B41EH7XpzWJHn8POX1ap9fC7jWUR2AjQczHpgu02+EFJ9/QIIx7V06UI0iv7ciBcneH1FCsgR4a96IV8iuVn8kIo1qRjOK5D0wrSWJ4V6RSW9iCD2/DdMgQz
+4EiE+0aC2nuq0FLnM7Mp1B3uy574HfdsWxkm//MJqrvXo5/24msWpNal3GeWiqZZGtsQQryNEi4DD93cai4fW/I1PGd7BI9RM8oXzOfkR4feCL38j0Lnf1G1
+5fFR/AEmFUep4fgS+pEIAwRT7Tm59Fwy10xeFcjnZREBLtrX7A/2h/kB4ZzOgvvE+hQog7c96M8npDtKQ74AnMh+LTReNdW5XljOLFuA4EQMzrZYqc+N0fzg
+TjbvK7E2cVhREeyewkGGTuR//cd/hhSpfQxND5i/0xVOwIo2Q+8s2qImyIhU2D7F5ZqWMcmnKepMqy2NyeeR8140f5KGSPmEaFLxL0TjOG+ykgbOJRtHCrOv
+aZdAF78/SgLXX1tDBS+mpc7m9TRacko1uAzjGR5Qira3cQ+/KDaqoXjOYyeiQjI0Hjk1d+7fKVvfiQyjbJenKz0X7YykxNNCMX96K5zDblUGDG/0Ca5CsN/Y
+PV7s79M0px9FvJfwaGN2pjkHbcJUoB0pDe3DQFH+fXOZ2xOdyJB6XfKveGnPMlPqbCuc7za8CrmzM2lhqasNraPPSURjiLF2m1T+rHNdpKU8V5WCJcVV9aXT
+XRoqsTQPHqhP1j+yX+g5RgpUpe/oXnLgrA3Kiu5xdmM5PZ72jk96zyBIYO9f+7qXMgCGDop5E0ExP1gopp1UhiaYV3h2aBo1LTVy7y5guq914a2hJAwlI6HY
+6l4HkbBMPZRwe1+kGbLpBFEGtscgCjvLtDw5cen9rTFUNTc4Q00uz/WMI7PPEwqsitrMSaMT0UfsKRQ+9Cw60Gs+PdN2xkKR+yGhv82JCshh1Hzq1B5jdu/E
+J5vLw0phUYoA4dDYQijA3vg42BEcAxvWhMPctL/NeS77HVu4Y8exBsZhfabOa1/Wd/2G5ePr0cTf0lHdThUkHt1GkoDV0IaeKoMGa9TFYlcX+61xXwezoaGq
+4NhoMf39DRVI3NzP2thoj8ueZ7o7TnXZo05pJbsTjU5O+3KCHiNPC8ceqnDsDmAtg0YJNtfL9nO4Npr+imBpuXgzJRuxpqEd77HzwKWK/yq1TQBN4vwUmI0C
+MOOFg+cRxB2vLaumDRY3N+JBh6NY7OeQk55DzhfeTiIHB7TYwyHXF+Kv/X2nBQ8Cj3LArO3PLefxJHDyeVrwLv3j6+9//H54Zhsw3MCOqxB4mqUKdjGsKH99
+Zjb5LM3gnycn4oMqZkvcEW3SHvm7PemX5ZWEyjLmilNe7A66mc20Ru1iVcoHnWUO6SR1WVLgO9tKmE/3KoPquCDylmwgPjWQy6svhOU9aFki7UyQbTcrys5o
+245oaS/5dhJmlcAb0/wuMM1P0TSfzMyak0iV28lobfaFOHkar1nLIf4LKM4cawSBc2PWMxH4FncSmdU7Lt1xHRCV+cnyQUkpB3231mkh0N7T+iijpwyRdqc5
+OnoRohsU+LYvHWqt8AA2X3Td4iTqvd1H8yEiAv5pUXu6eRIkbeMXZB3A77G+CJ1kv3aurf2J+Eg0i/GC2eZQs73xgtAK5HavNHEbt7083WH4vT5WPaKp+VAn
+xnnjGXZiAh44IM2D5fHxB7t1lpeiRp6xa/Z8FYkQU5bHW/qumka8QHMjStPO+WStL8L6wXBUxkJWXaqTNIF1KioDNZ6mmKQYX/gPqUzYN6JxSPoT6KDCvHUs
+HrPJD7wwlIjSFdyi2vD7qEgdeHx6rHrHL3syR/5tXppl45Lr5u+rvjw3D3lZFVqtfNo4TPBBX14hf0+TKBd22qYZK4fITtPgdLG/FN4eCzE50jltVC7Njjc+
+MmANyMKht9i3mEY9D7sgJIt6jMwoyOA1ASLEI0HCOhDugjYreHjHTBHu9laZZJfTATZHmBilzxAsfQ/VO547ia3InQMU7tevsWMCAHiTJbW2w4XyFqYIkn7K
+qtgQ0iOOadQR6WjjseuRKaxU5fb03bMpLRObmsepzvg0Y9lhp+JmkFvL3l7MYkEAW2TZK8V0mzRg3eyrd4wknJKJ8zUiElEBF2eaC49kVXCtPx70jtMeLbpp
+X16FYUa0Sj6YwiWPegs9otRN80rf0bXcOggaUf3GXQPMDNIqIWMZH18X6b2abd2VFxvprtekjqAgjM8eizcBj0RMx8Oh+nbCwWhLF80tPfTwtvkDfdyQLoZt
+iAk8COUBpx1xNtUxwqd9ea0qa3YHKW/e67Ojn5s7yYu66/2x3tnuITl0ErMuPLJrbXsfFp/ac7xtEP0Dc0mKkOrQchW6WOo24Djk+jH9ayv5pKjzICLjpcoy
+nkczVepamqpPaXUAO9d8sZODMrDtX4QnjjwLzTDcHh7pnCaZX91n4RImRN3GA+++X+e0Wpkkao8ZLY+jK6zzn4OJxaGj1aYEPlY0zjFMGdVDpgsm6bfUP43l
+4fy2jR2RaF5t+vAsQkpyWgs6MPa5S5wfrBSP7n7JTjIaT6/F1ffel+OYLN0SSeHR5l7YexNcO6SUadoPRRGYaKjxI/wVfRfQ7jncVm3x1h+/3/f0boa+N0Lg
+k0CQZAq53K51kaW5I6Bv9vMuFqeQ5+wN2iiOpphpQ9yE2j2APKtYnYH57I3F5bFwQWRX8jXvLHjdzpDH41si3a9CXtFKf6kidspmRyU7NtdTb4qVqkrLBa0F
+v1hjJg88dHdOSk/S5NnQEkvVxYYj1tVF1B5Z7X0RsVinldf7C7nXdtR8ypAXXApKtE0fJQvL4Mk0KJw8Q1AejtaHqXp4Ho0WjfXntg+/CVnmP1zxjNDxJbni
+xXRb7+HjQe+gOUz322jXbMSk4CvnSXBkUVGEzMXDPH8NTGR6r724RFxT4QuYk3/F+VC8e7Z5Zx33HnmUdrsC+0J8bEYGxzGj7p7nYz+2eNSPTWyyiz2ukuga
+eNILvVMRpF1/oUi/wJSvHV4cPNMLqeCwLCtFsJg10dq444T1/ix40PoficKedyvbFYcUaAvwJae5UP7jaE1iDs3eyF61ZJioLUA8qQB5bM3GtCpD56atXU+k
+ZWOu8UY6C0hhQ6fS/p3Ldlo4BuLQMYia2hdin1st8hu8JDuDsa9RwnWh0YVO0zNEEu3c+tvi5fGqYCG5RyKctnKvKOsiOndrPD4hDcsioGHBFXNLiGZn+TtK
+MUuzDADJvLlD7rjttFMItB/EkM+NFVTlMxftCBB1IjcNmDEa4a51fGR/Tulgmuki35nU4rnR2dxNiA8Obr7OnPeRQgYO29OkNcou4qogOyUclsVsqfKKuzmR
+i7TKoY8xc5Aw2bNNppyji/zdab4oUtYMTQSHnqHYjG5hASMWuEbgOJ7NNgVeVm2aWKH5qUL6AoQuClQwtO1ntit0X0p0zZQzc68LPI4k7drekCtjS07Yix/7
+WR2fhXeJ8/LxRTg3UeiMplyPXdfCNJe5YbZ6Iq0J51UdHCna8JDHj6RI5PouS++gk3pxRo8DUuJtMYJE2o88ODKJoZEQHMxTygvXX/RqnaEqoBu8zJQYgJoZ
+TEmE/GHKHjRFHVUpocLtzjlh9h+GCfacTMOvTeE4ThAHUqKbB9crdbvjhcCr5gEVtXNFBd0KVrSHOqTlYVPFPx9PFeGmyoxc47vuF551XnmZYVsL53xCFhUR
+sDT4JL8weYtyQHa5c1amrFxqDtLEfUlXqsI6wKoz2aaKKgpVs6wOCzy0smALdrEO3MchXoIPBDRPfc/zVDtKbCKFtXp8jEXvz6VzNxSiY3hvXVh8vC5UmtWj
+PbuCfrnYwcBZq+sO0inaBWge+YLCrO7mwQHT2BU3j/nxxGskhU4rWeg0LysYmJ/QMRG9VqrQIqAXOaE6mZZytkHnqPdbVu5TlKdMc/niuZwjjIJzO+026m4K
+rkCQJbT36NM+5SryIeSrGRKJBiDk2f74X2SovAZuQB/JI1KDwJsUlIzrjHi0jCy1/iwLDbc9nk3YIFf/eowgkPyeP1a92isRm0HRgvbwLI2mNULagwXFDgap
+ZmkRnZTz1LrwgEPb+9nZfscm8aCQA6xGAGa5v9p4v3Zeon7ANU8e7xsXmolceKoWEjyEMEW2hlvCjKNAOnFuad6Y2sb7AonblVMy4QVe07xMGuww1puNXwfA
+u/awBDSAqa1g6ac5cWjYUWfW+1h5POShOzgT02mmYFeP7I4fbKyPrq4dkXIaeTceonagVCapaeK5YFQSBMrCNuRWKU/s9D6yVjrgizL1AIfp1iklWBunnukk
+2ntmn/G1i6GqGbcnIg8RkVQFfFRT3YhnPpHyKa0icicL1vF186JYtuz4/SWseat/1noh436j/dLO2X10Wcjn0p75MIuop9xXu3rQ8iPEzFNw/m4KLfwR3EZC
+VV+p8FXz/Jh/ZWyygYAIbDw4wBIbD0GzJuEJk96nmb5jeyzFTcbrzzvrP6yioNMmzCpwEW6XxAWTwl/3IlqeMO2hfxB/S1o1QLANihLPyyFCogYkHIQyqCmM
+X2spkHI/rEu4cjwVtHE5pmY8+lEq1U6EQGgYesAJ//eP5I13E8N3jyN7cXnOeZePkwhJjdxnBoizs+d2XpzTd+faesYd9fdBTCicjhhCAJbKy66rx8DmTNm9
+gyocTdtGASZLZ6nmkpAOxIKUazAp+8tEPIV35QCeFRGmRzdZVuQ+lhWcPyHLiuWceyzJv4VaCE+Q3DVhLuLsmMpQLdsnbdSGvce9iI/7wPfC/dXABR5AqwKH
+P8qK76RS0Y2FeyCjSstc6Itfi//j8uzZya9HAbKf/+P0h+evfqjzf7x49bzj//i9+T+eXZocPtYFJtac9J/Ls/FwgFTTZ1cfPgAd99nV+PpqjPzjYjQhVm0g
+wJbvRuMPyJJ+fjWkz6/HV38ZnQ+Z53oyHP9ldDac9IHy+2Y8ent7wxzeyFB9Ph68u5GWp9qVge8fysGlHNzcXI0vh5+enV2MiIv7AmsxeT+67jfqKfjlEyxd
+ji7fXY0/DOiNwL4tj5DW/Ui+HUxGk+bznuvdM4uL8fDnwRjY2JEKOyyT33aeUA+MJmcXg9GHCfJ+I1k28ntbhu7xcHJ7Aazz4t346oMc3UyAkbsvhMOzOvL5
+48EEqNZHl0A6Pry4+tiTo4l7nby9PB8SpffNcPxhYnuzpTtu316Mzlz/Hh8BtdeRvBrLI/4Mwaf2tfSOm+HZDZGdn11dfxqPfn5/A+37/mosiAQ+5kDvI4F5
+jVuc6eJv3sMQTuTg9ub91Xj0/wZ1D+jJ6bXvR29HN8NzSO/7BPzm4zMiNIfSsRITS8uOL3Cd8X44HiZIsD44Q/J2GAvk/pc3V+LtUL69ur08txoAcYdxDagL
+HAv6zzDyEywSPqeXi7Ory5vB6JLfKEeXyM8+Oh+OB3ZKf7q6HXMtBkyFj1Tp9NIBCQOcj3D+0t39pB/iUvHad2R5j++JpM0qwivGDjmwuFzrIjVzzFNNy3KD
+EYpqySychdD5bDvLzFrPU5XELJFWUimtSuZprbYSza4o6QUApya/E3SAy3xjVVjrsjwIDHbmOvow7I25dlkWxLJCmTOrUmf3iHooUMhKr6YZujwxej7z/YC5
+7X05wC6wd01PN6tkrc8itsLAclXyXEMenf8hkHTGGHFRkwEq22nSj2olxcNUB/9aVuv4Awg4UleuC/1Mf0lL7DvsonCgA22rxCFlAzMokfNCwVX23+k3YkF3
+EJXZT+TKVChRks6qTaHlvS5K/LwJuyyqGGQk1LRI53f0Hrg36rx0cKHALEOLqDnLXFIFwTzrcGVFUdiEWSj4PtY+zjIcZ/G0cW7RdmoSYLtLzdFj4BisWhBE
+DBjmd0qh9gOd8KO6sNhjL3xYWmH5uXshYSePwtkXKbphHAp7Eu6nntQI691kjBfN+i768uiT2bi5nT8KFdopeMW6C6XLAEnNBlwE5MvV891SDnWS6EDiDm4m
+XKxTc3B6U97btDczXeysspzrcp1WWipXYe96pvymdyotkHWEw98Nl0YWUqeHBLGhA06YwvngQo5YG2/D+gcQ2LQoK1mqzLNJBrevUvAWU9MNL33mgaMvech1
+IessLJl6cOWKWAGrpBQl2z8/E+vKU9BZLkLz9ZAsj7+SsciEOFRkorcTrOW4K0SoK8sOYTwfKJ2nMgE6y8/HyoQatOFRWxlyyhRyZQpd38dKh8tpltombBtK
+vfIOV7qEyClWzym4zoMya7siPDPDX8+9fsHMrFO6cq9RqwqPhhJdaYwz5bsuoLEYaeo/QTdS/VPwjzAKdoE6W3dphYCSeWrocLMrNegxdjCIlua3NH3+h2pG
+Sz//w+nDD54oAy//2DLw80Bc2E2IGvCa6K3IcjZF+DOcKaD0eqBqfAuXd105XjxJOX6vZryggqKl0pCQP6wDxP4OsP1zoOK8aCjOIwOODM/Lnd1me8WnmX4n
+6piDXXpUcf3apOPFV0jHy73S8SJKHIlr8MTZJ/Z2vu9S55ndIQoPTRB4zTOZheubok2c1+WopHldD3ingSkH1lEb7l2Habs373xe4F3uFXi/t2U6lXfxuMq7
+fILKe1vVfrlyutgl9e7ag1nzLN8BBeEeH+LiQkFKYVVW98q/1w+y9oaIX6ZZX3uHeFpDZGtD8IoeLiC38IOE+XZilOZ5IhiTEGRhyRQjIytVpNk2pkI1Fs2F
+RT6oYo5ZDeRdFWoOAh7qDncnxPRVWq5MrhHJBUFVe72mNaG/EAlgtN4cY6FwJi1gKNGzEtoo1kJZpJl+BsEuTN4rwpzaMEsgDPEZG2o9rF0ybJfY264gd6li
+sTiZG7lWW9azob7mR0X4qNwhWRF2UqNHnLbV9uBD7OmnODlC6mZfqCZoP0tkdHCI4OCoI6TKSHIdDw1Hplub/qxtLWLsikfKSEeJY1kCvYAPEuvm99ppr+dq
+pQUo0kX4Ln+R6u2QbI41750svtgpi9/QNm9sN2368GKPPnzDvfeYSLw4VCRePi4SL7xI/CRQX/86GXgRycA/2lUtUy0WhBc7BOE57d/78WDBKUy5Dn1G/CA9
+45IFHhd1x7fuKZgV379K+vftlizHSKdx4Zask4Bs0e4M1SP5hdQoNsDKONd2qssq1GD5rsSLWKbnd1rSLSwYyzQHq2P7U0xaRbzP85D4OdC6jO9aeCRFXNMO
+cs0GqjUhKQeBfop5V/JQE1fYwD1DakgTbhuARbB8xfQDFuCbBKhiV7uFhi8KXXq9VT+heGcv38gb21hnoFlsQeyYSrzISuIx8A5pT7FyaC9/FL/Pge/ho2qZ
+FnOrSlME3mTw+quVy1e4VzafjIH23rVGmuAbGK6/bhjBbkum5B2nGzyYWJXni0+o7jw8h0BRGGuKpJ4Fa1R7RWr4eo/ecBSr4xLPKbYpjgYT6WKbXjm6XaI6
+kcMR1MLWF95rVZW9/LRokZ/GIoJC4/AoRMmGED27GjuNaA4MCqj3t9ZfhqAaPxTGWq0aMkZafTj25koOYDTG5yiI/cnHZPGX4u14ODh77wKZvpGjSzkZojQ1
+5NceIPosniD6LA8QfRaHij7LQ0SfxRNFn+Xfn+jzIbZam/yzV5Hr5J87+edO/vkp8s/zSCX5EAdVJATNwZI64c/XqkG3Zkp/EzXog9vZvPGIp7drpzb0N2tg
+pw3daUM/URu6qXAcJnI8SR9a7NOHlk/ShxaP6EPLp+lDi0fgTk/Uh3b8Ud9IGzpG60Ta0J2abKcm+5uqyeakD8vjisNvcbSHoBd2qsTKJ6nEin0qsfIpKrFi
+v0qsfKJKrNijEiufphLbSbl2f3bjP05/L/zHi9PT188b+I+XJx3+4w+G/zj9LfEfHfLjHxr5cTUO3gTTqYOCdFCQDgrSQUF+VyjI47IWwZUn0K6DK4IpU4v+
+x/4UQX/CU6g6V5icR6ORw5Cu9PxZuxKdQCU6eXyEZaT53VGvDZ1/SINFh33psC8d9qXDvnTYlw770mFf/nDYl8CTLSyXcSC1GNSi1Oi5rZZB3nIpXx7Pe1ij
+l8e61wKk+VocjU2HFx2OpsPRdDiaDkfT4Wg6HE2Ho+lwNB2O5vfE0Rwi55KElo1osWwsuyoDTnymC6fAlDyZVVmaWaqsal/gkQjzjEAQJzg+3OGtG6ND8uis
+TiZCEZtw1N5Ix0/ZAYY6wFAVOhS/gb+WxNeuvW66HFvAi7zFW8XbTOWftV0XYFe2ZyA7D42oCwV6nYtIydwU8j5VFMNxb7e3O1L9Ezgr5WByNrhO5NsPo0RO
+hpPBWS/xuJzAy1vXgPe7r917RfgtFf6gp+CW78naLoOTKfx564ktnnayPXJiC5GmIK0MpxGO1NjnMU2cwKEboW8/FDhDnCcSpDjwSy9xJ+DDirvuvSqKrXxn
+vsgB/rQxLJjQFlwJA9MwtpuPj5Co38ZkjugSHx62NTu6BYtlPeXTrTz5Qd5OzpwZdnLyyo7u7STQYRjMKsxAhy8CFfk054hUSGtc9qwYQ+HrxbsjbRhhA6LJ
+Ir7SDHpssnykmQun7mPz5NGdQsQ7RSMk9shEa4iDJmL/RAsW1rN4zfPcmkANhmyX9RKxc71bG/WwlZ2Enpz2iST3TaSXbRNJ7JxIcv9E2t0E8ZX3g9YJJHbt
+Nk+Hnt5eXiBozgL55Ifbm9vBxcUnyYi+myubZgBAM4aQMcYv8fkFV+/eDccTn/6BSSqYHuDyUcZDAOgBOgyTBSBnoh3SBxkFZ8PxJeSZBHAvwvclFt2XIC7x
+9gaAaCEUMQlgf7IF9ifil96Mbi6GifwwHJ+9h08p6yWR70Y3l9AziHGjtp/dXgzG8vp2fH0Fr7m8uhxdvhuPLn8efhhe3iSCgWqDt5MhZ0pcDBD3Z6sHCTHD
+s5tJ4hCFiUW3UdfQU1yAuHonh+Px1RgwkO+HWMDVGJOLIE/n6i/DMSSw9OXk6sNQ/vl2PJqcj86ob8+v8HeDi4urj1C+GP7z2cXthHM6uAeD7k/k5IryOvwP
+Pww+USHX1xeIOPx0dfutUY8HABDlEwCI4gAAojwUgCgOASDKJwIQRQdA7ACIHQCxAyB2AMQOgNgBEDsAYgdA7ACIHQCxAyB2AMQOgNj9eQr+79Xvhf979bqp
+//S603/64+H/XnX4vw7/1+H/Ovxfh//r8H8d/q/D/30D/N9Xof5EC+pv/uui/nSH+utQfx3qr0P9dai/DvXXof461F+H+vsK1F8DNtSG/JMHIf/EE5B/8jDk
+n/jqGdjIDxCHIP/kIcg/cQjyTx6C/BOHIf/kIcg/cRjyTz6O/BNPQP7J/cg/8XXIP7kb+Scc8s/CFoI8F8D1ZApG7OXxrPcI0k8chPT7hZDF2jsOr7gIKv5L
+UX3CnRcdqq9D9f0hUH3cdYncBd8Tu+F7P8njtOdge9BXdVRehOTD2HkI5QsAeWhxfA9PpCl+0Ybx45+4S6HDlkiVmyBSbQqXi0Lp/EqWawOPyNS6wxIbx4UZ
+TB6KRPzVbIpcZT3sJuXDPTLNQx2jercmfocGqxS2W1PAuqFKhQg06Mgk6jLKNKrVu4MwdhDGDsLYQRg7CGMHYewgjO2TRfflt4Exirbd4utgjOKr1n0rjFHU
+YIzyF8AYRRuMUX4ljFE8aTLthzGKPU34DS5EHYyxgzF2MMYOxtjBGDsYYwdj7GCMHYyxgzF2MMYOxtjBGDsYYwdj7GCMHYzxHxjG6PB/L34v/b+Xr5+fvGrq
+/73q8H9/MPzfi/5zeZuvTQETvAMCdkDAf1ggYOTHDL9D5+jboUP9Dc8FOMqHcgAf3YwHZzfJAThC+QiOUHwzHOEAoFVotDyOTUtawWnsUnkCOE25dyZy7m/n
+hFwMEGtgXVOw0h7WlKbqbSwlsxQ+KPAqq4oK3j/jkmze7V2hML0njLpQxB7zfUs5S3O9UhX8cL1MZ0H9yiZ6TTyGXpM70GuB6UxXEwgrr+ApcwegL0hpwd7Q
+c7Y5As/DQdC3AOIYesZqILBc+jHfhXgTzt37tekHNARBl4twOH4zxNuTGkuQTd+FfkXM3Gfw0M4556d8hKul64GF3aZgoMbTsUx81+CUmxZGzWE2lYiHotn3
+YJ3oNrK5UlWl7bfVUuX8kywtawn8J8eLHkF2IAyczpaJnNqEG9vtpfOpYm39MuQfpITl1XkVwng1Br6xd+CGTMYu+q6b6yR1KIz5flwxrQyEFdOwh3ChVlgx
+xi9ascWihi2Wrdhirqm5o1j2I1BjsR9qLJ+8DkWIMEaPb08egDAGcOa584Ta6Wq9Yv4+U79S+n2FXZdriOCEy88UQe3wPqXW68Ksi1RVmG1XmM3dsoa7o42P
+ZHYQTgcpTQzM3I8hTYK/lwlFarZheCmAlh6XPdEEl8pd4pK7AaZJM41sz2lSLbXwlXy8wm3SlJIUHHIjWqGjM5XjVdh5JBI/bOUS8uFsohk7wCAxVTQaEZ5z
+8I2aVaaArSnN7zT8BffoFHsAt/SCIHcWZa6LEq/4SwOP0nNwUGcpeoDmGpMEEol5gGkOXVHpYl3oKrpUu2zf3dsltp08MfZEX5jsc2YK/YZTZLFxImic3Sep
+awjLV8iptu4gqj6UTD6tQCkUsaJikX5h/ygmv5TNTnMTmn6A3Z5AfVyFsLdhmgcbNa+rO+XOM/LdEBauole637OMaB2v7PqKk4Cj/joUrNx00smak84j922D
+7IsTUc5Smn8zPgi4BnOzUjDa4A0EX6A1e+DplZljt+F4mwVt7W5gha+Py6zHLHFvH06NAXNBrdbLTFferLT+8jdSSTxjCp0INZ9DyYksdbGisfYnpDvO1ErL
+XMET8DBzH6Apaf9unrG1gnwT8KvZ0hTa2YEPvGQ1zO9KpfmKk33mm9VUlkvz8KY94ZKwP6Zw3f9gCpxHDVMTX1EZPn/42ElXKfni4NtScEdSopKCsUOskMoV
+uOc2uOFH5W7fWAsRbLVCPeACXqs0h65MhCpmy7Ti3pTlbJOt6a86vyvUPYMdMqi7L2+9NI1qiz3VlodU2xe6fSN8nQmmhHMPFp9Ms2xTVoVlqVBr3HzyRJaf
+dTVbEiCt0PrZPF3pvMTQAI4peTVTCgzf8chCErhZu7+HvQEl4fyf6TfxpgDVc4v3TbgRvUHTYLVOfSb1XFWqlreeMvTFVHrGAV7Vwk7whuKx8Ff7dtuB96rA
+tDlTyFlazDal+0Eh2t6Vm8pvxpHlsXtH7gtx19Ec7KE5WPbl0bWNNnNGdWB3xShpqGBaqQD0H1C6VCYITTVNNFPqllISGwKjN5rCLqsk2GOn4P2nuQz/x8ik
+//3OTMTyjbUcxS7L8aM1nWnjlg+KOT9WGkh/XCP55yu1FQxsrJa6jFJ+FKxgvoiryv0zSiKdQS/YQPLqjQg62HVlXMEdvePypOJgYPysrXvQI7EuRtTDu1PO
+sRvdVoGvLrT/t6t5VFdXtoCgcJk426SQeNMFNFbal0djS19QN/YtxH/x2Ava4nbTLb2P0nZLWOkuJbiMk8zQRku/KHdRtIQKUHf7xc6OKytTqDtrytnNsJHO
+77w2aW57mE0Le9LjXbUweTpj/FZAQXKuVeZzpZs0JBdfRUOyKTVMXc1gy4A4pGilKIlISAp23/hAtSq0z1ZbmIICYM3orn8PdxdM3H0sJbJjKdnFUjL+Fiwl
+sAsLxpiPn0xQYnLgv2iwkviCBoHvMcB7hrlv/ifhrg9fh4Aui73F1ZHICjMLAohVWek1mY6ZVkW2FZma6gyudytV4JEURUgt4ozqQtn6EEwtOHhay5QSlJXw
+Dme+AqxXEnuB5cxssjlBwYvPei6PboLHyQB6UKV7BJxCsKyG+R1chuF9k7XK03JJ2DQVMXhw6RxV1y2FC5v15xI++keOAcb7VHB06gd+CzNLy7iLcMzxChmg
+ug54STAT+v+AlCnBFmW9mH86XvSSMOviIC4VsZtLRT6dS0UAlUoLg4r8WgoVEeBUvpJC5TxKhtw9W3dwosSUbw0+FPkkPhRxfDse9fayotjiYhqU9kaI6yfx
+msgGr0lIZyL20pk0MiBtOmhY0/AS0U6FwG+1D7c6KHWtt9VW/DIqlFgEXTyBCuWxcRH7J1dfflzqfM8AhrOQc4vDkQwGkHKh03veQ5qEKcHYisbYhsOndgye
+wxp881GEZEJhl/rLY9WTT2ZaMbknWRF75dVN/pvwq0CF6gwl4ttRq5hc7CYn8V35KK1KHtlDv5xShSssXIV98fKrKtwJpXeUKnVKlXi/TB45tikFYRBnJUT3
+ET+xORNd2QkovZ0LW8J6U5QblUdmFexXSXi8iG9FqgIDcDCpShsVytOJVb4xp0ryGKmKYFIVuZNU5fgoyKiT11T6UW8X2YrYRbYiv4ZsRYRkKxhRc4wrcifj
+CkW6dvGuiCbvynj0N8CvAhG8+56sM6i5DfZ42kvq4b34dGnlX5F7+FfCAP9j1CvyUOoV8Tj1ivTUK7Bxc6XD8wpNAH+lmfW+kpdFRrwszVi074F4z4rZWOQO
+NhZa8fYLXFiMp3PwQeZOsZ24631cYJnAjMuRl0UV1josbReV8mAmF4xK80N2qtUzU3z19udRgZkN3U/3M7b+Hxm0tmSmYNexI8HNKHUlzabiOyguhmkUw0HK
+i51RkUSE9gtMkXSWVkTzoL+4f6my1EXF97Jsy/Cb6IxMBO+mRL5dSJ3PTVEyPGnbtncnEboGdvFgPxW8nzZTVMyCwS7UttoKTSIEg80VSoSLJIWYhjCuZBZf
+XcfDWH2IrOfS5M8A8oWr7sxTV/CAyMlsqVe6BDg2B4si6gmXfCUi3w1nMAUcGzaRB52LjlDEFE26DIxN4FvlTOWcz0TIuBpc9EABdKJganLJOPM4hqPXLOTm
+JH1DxDofv0Gv4er+Vr0mqdcEZ/f8ki6TcZeJX9pljuzG3Yat8cCPpjwsfpehlUS9lJaB+Y75h7nJwwtGGYJ98O3e5pz2fJYHFoAdU8OY26nyy7reel9TmB9/
+Mdkmx5tAY1bc7ByS3RXayX/jbAl9r/PK47jcCyhdFgOnaGrYsrHeTJGDT6k5HJNlpYtS3rva1xtZJvI+VeQY4ccTfwX+2hki7A3z66eBDKeBqE+DWY9y9Bgi
+p8KyEGY5D1GzfEK4PoRKOVd4lHlGr4MH4niY5eTxIZfkEc+VjysxwG66tb4WU4SWxCOXNDrmN2XlSIdNUUEyXoVpMwn503EuQ7CGmiPnujB3Cie5cglbNida
+hN4lGowHG1ZZF/qvm3mKI1CZtkPru1IibZckyO6m4iQHf5RB/5cB415zx0SzVv5ZrVXeC1J+wyknwrnW6hqHvaDulD1uBh2Cvu0J107GaPP1wvYri+BUqRPE
+CaNDpmj0ruDefUK3cfR7Y28H4cwUaMXilgZvgxEn66gtl9Zbdc4xBTwRuqz49hVP6FqMlKhGVEazuzJSk63Ojk5/cfMj4q292rbcPhR7hsEGUNza68imOrKp
+jmyqI5v6rcmmdh+8bRRT/mLbUUx1FFMdxdRTKKYOzjYo4mCi+DacUgx5+BU4pR5pWM0XXGuJ+AUsUq0rU3QsUh2LVMci9Q/OIrXALmv10yTOIKvhgTFgpvMZ
+pbrVEBnQ/fNCLbAYG4x1J06aszAwVeitLnItz0wOLqww6HDtc5GR6ciD1uTA4kko2R+ArWpFoXsDd/51hS4vefqnRJ78+MOPPTJBxmalRfAms5AnP74+oS8/
+jq6vAhbmm0Ir2mROfvzxdfCTgOKeTohrj6qOH3J9d5vDiihVFpQfVOMYUzkwQVKYXP55k23l6Uus+QlF10odckDVhgIdOXwCs1FU6Ezfq7wSoSMlBktfRFaN
+KhCpyCbRVNvtaC7VjHP0hYuOFrBADC6ocFbzeLrgXmDCGXgR9Y1/wIaPdrgZ8JjBmQeLVxXQ7rTCGEbbTBWtRmKmHjwFQ7AYg9zOpl/Sc8MSBKXxmCrchmsT
+RzziPOjcN6J+KsILYzxAkF1mT2F280WRsvi8bGOHuiRxqo4TruOE+2054RrtdFkVFJhO/127/IXplh3cgmfB4lHuOPkYd5x4Enec3McdJ57CHSf3c8eJJ3LH
+yT3cceJp3HF7FRpwYvu6BRnffuAIX+iTFwLz/++Ila7785vz/7383fj/Tk9etfD//dDx//3B+P9e9p/LUV7pwtmC9YfFGWe3U+Jj48DoeYsHzL9Fin6Vud/d
+2ClHtofgzMfSs3moOXzQ974atmMbreCj0rpYfUa9y3PP1MNWF89mGeEECpdZSSFD5K6RLVvqZ12KtHIGSMlxwIxT+n0Goj8T4B+5PFLls7Q8klNVpqUvWtii
+79L7yNAjP/KdKogzLHhlwjnglS5gXPhjG1wn33W7t7d2YAfVbbZVWMxIiVZNZiMsaLNYI63Q5SarHOqV3r3xTi8OZAqbaW/KMiU/yi0mLzQ6mPxuTolMiMeG
+1s0ZFVxHNB2PbX41AjBCoaYoA6YRttqWJpvrgs5Obke5VEUNPoi4qUAQzhfjRiXAdflLD9aFyESi1wrvwOB7StxKQrKSM8BDwmyWPBuYUEsA1QajqjJrUZaY
+Xpiw7wUiy0u1KSsE5dISbJoXZuP82Jy9JsRZ/M6FS8A1RfmTvNoUjfGBN0bp+2RtCrp7OkMUL12wBmpCb04O3+CDrn/hyqy2Qb6IsPYSC9w+2ul9rG5cz6LQ
+92ZGrj5rrZeyXGK6QaEVOfC85+oR/LS7OYL7ExkNDA4mXFDw4riltd2XjZeprDSy1LNNEd0qvN+yXooJG1Maf03gvpypXBba5pe6flTIMINgipZaMDoYUbrx
+rsOItTDOZO9kAt1i7nJPfX529szdU2whuCHZf8Dwzu2dOveIeYFTzF0ow/XUlx+gC2Z75qT8ST6kn9N+m8Ebz+V/W5ji39xzu6e779Kf5FvYJLDzc80rpj7/
+ExcJMIVgzPzeGR705DzoHOwXt020Kk84v0zmgQVB+Xz2uhlkL/WCckT+6z/+cxHitad6pjbe5xE4cdzQyN1D81//8Z/VUue03W68i6XQd5tMBaks7iIfLUbs
+KNyyghaUDeaDXUvb8mRm7sKuSrvVoKOFRqIvb31ecLPP4Qwoq9TGE9zusgisBei5mH7Fdhv8oCSvQMQWEdXT5WnTK/tyIFyd4fUUmSC3ib1Whsyq5WfyeSiW
+QmZgvsPVC1J2nBXpFNb3IIO7990yBBfiSISbR4KSz6rQUuczsynUHW7O3r+PG7etDNOAepTA7kXpp734mlWpdSkPN1dF01yNbQsh3kY58MFlem6FFo8tKSOe
+vz0Cr6oZ5Srmc/LhQ9+E0YVHKTW+YSPk8VH8AYI+IugOvpJ+JMJQxVR75j49l8xIlVcFsv5ZxJaLOzZ7hz1zfrA46b7gHrLeDYJYtBukfIS6szZkFYkykVte
+NdW5XlhmPVuA4JQQzvtYqc+Nsf3gjj7vtbGWc1hREeysfBxI6TlV5X/9x3+GhMp9DJYPmO3XvYQAcG1m4Vm0jU2QP62wfWsTbyNKYFPUeZlbGpXPo3CCaP4k
+DXk1EiJVxr8Q6eu8yWEcuLtsZCuEydBOgkEHf9wEzsi2hgpeYkudzeuJveQmazCfxjM9ICBub+MeNmJsVEP8lMdORIVkaGVysvDcv1O2vhP5iNmKT1d6Ltr5
+i4nVibIQ6K1wVrvVGfBB0ie4GsHQY4d9sb9P05x+FLHkwqON2ZnmHEYKk5N2JFm0DwPlHeyby9ye6NQGjEzJv+IlPstMqbOtcN7k8OLkztekhdOyNrSObCsR
+jSHG2m1S+bPOdZGW8lxVCpYUV9WXTjdvqMTSPHhACF0TkCtHzzF2oSp9RxeYA2dtUFZ067Mby+nxtHd80nsGYQt7W9vXvZSTMHT0CTcRfcIHS59gJ5WhCUYf
+wi7jkApqWmpk6l7AdF/rwltMSRjcRvrB1b0OYnPANAV3/UWaIfdWEPdgmw3iwrNMy5MTh8NqjeqqucEZanJ5rmccK36eUKhX1GZOGp2MPn8gxBTQ1TifBwmj
+tjMWipwVCf1tTsRhDkvsk7n2GLx7Jz7ZZZ4KAsWAAyhaYwuhkH/j42BHcHyNWBMOvNP+Nue57Hds4Y4dxzEaJxow0Wb7sr7rN+whX492xoJyB7GYeHQbSQIO
+1ICOB4zaqIvFri72W+O+DmaDQ1XBsdFyPfBXWaB8dD9r464+LnueF/M41WWPOqWVGlM0OpnAfoGXld5BztADWR3KnbQOO+gcDt6n6pwPzf70d6G9Jo5oWR3T
+7U5aCLvAqTPSMu4j8RhtQ2uzd1A2uDb2hfhrX07Q2ecZPelNMlxIBxBOIhqa71dlu1FUW1r+TmcZFflkI8N9npYA/k4Ct4E/8EKtevxXqW1+cBKnLsHWINLV
+2hQO004cQXjPXDUN47i5kYQF2EViP/2n9PSfvvB2/k+wlsQe+s++EJ/7+45uHgRecoEogjcinLOaMrjO04KPzB9ff//j98Mz24DhBo4/hWwNWapwVkFF+esz
+s8lnaQb/PDkRH1QxW+LxZHM6KVTh+RotJTBUlpHKnBFlj7PNbKY15CILBVHmLHNAOKnLkvIism0oOe9yDLZkkPIRjjSMfSEyppxp2a/aSXzbLr+UvNN2NtA+
+u+QrY5h0BG9M87uW+9Ip3pcmM7PmXGMppXInDO2ZfSFOnsZO2bJp/QKiSsfAReQWMXelCJzDO+ko632Y7rimicr8JMSg71Y7LQXafVqfYHidIcWFNEcvfYL7
+b21zDB3+iH0V4m1fOrhj4ZGP/pWNi0GjXHdgCCiX+FZ3W1iHKMf4p0Xt6eaBnrQNd5DOAr/H9iBVAQczcm2vEchHgDs++gvafKd2TkopXxAShsItlSZO+7b3
+pztM+NfHikkCP9SZDd94isSYQRFMHfNgiRi9iWbjI6WoUZftmm9fxQLJUhXxebCrphGx49yI0tQqzVhua0cTjhRGpDKWJcKl0UkT3DNEZaDG0xQTYGMXziGV
+CftGNE5Yf3wdVJi/54jHblcHmlQlEmMIblFt+H0grM71cXqsescvezJH3QVevWXDXRFO4Vd9eW4e8rIqtFp5VAJM80FfXiGBYpMjHXbo5p1EDpEesEGqZ38p
+vHEdQr6k88SpXJodb3xkzBqImENdEm8xS38edkHI1vkYm6RPLBUmABx5oFFYB4L10JYFD++YLMJdxSuT7PIgwRZJDACuxqXvoXrHe0Na5XsGKLxevsaOCWhX
+mgTZtU0uBASYIkgqK6tiQ0CiOIhV54FBG5H9ycwhqiq3s++eTWmZWIOdM+nxaWaQgc2Km0E+SnsVNYsF4beRH6IU023SYA3g4IyjAeOMX5yvEXuXCmiY01x4
+oLQCH83xoHec9ty6m/blVRhfRmvmgylcerI38iNC9TSv9B25WazDp5HS2bg7gnlCSlVkb+PjSHc32zoXBuvY8G2KtHEUJHGwB+pNQOAUUyJyokat69o2dtHc
+2EPPfZt/1weM6aLfhsnB41AecOYlsg2FftqX16qylnuQVOm9eDv6ubmZvKiHVB7rne0ezh2HHnEhsV3L2/sk+eymaz/xLjERimjlDbFB9G1AGML1Y/LvVuYU
+UScVQaYcsCdoHs1UqWuJ0D5p2kE4XfPFTuaaKGd8qfPd/nTMCcC3C7zM7TZLW64cL8IDTZ6Ftl5Ee7Oj45tkzXX/lkvFES2GJASD/E5CmwFzP+4x8+VxdMl2
+4Raw4zj+CCQi9vKAke+oKjJdsAKMZfRrrD7n5m/sucQ+ZfPfZxHUl3Om0N+1z7vm3KaleHR/TXaSzHkGVa6+d9Ydx0oclh8SD0/3wt6bwBqiP2naD0V3mEKw
+7Xf4Q/o64Fd28MPaDtFSwv2+AnazMb8RAp8EAkRTyOV2rYsszZ3MSbPDd7E0hpy2b9AccmT4TIDjZtbukeTpxRpADH4zFmHK8jiRFcvXzbPgdTuX9uNbL13o
+Qhr6Sn+pIibyZkclOzbxU2/1lapKywUtCr9wY04aPN93zk5PwujZThNLxck2KtbVRWIfWfZ9EWklpJVXlQ25VXfUfMrgLVwTSrRNHyULy9bOhD6cnUWgNM4E
+CRNC8dwbLRoL0e0jfjeyLM8Bo3FQkiteTLf1Hj4e9A6aw3SbjrbPhssCvnKuDccGGUVWXRzVMzHBRKb32jtSRCYZvoCVX1accMfbaJtXv34SvSTn127vZV+I
+j83I8jgWYtjzfBwHEY/GQUhBYLHHRxNdPk96oRctImnQXyhjRGBu4Q73EtoQhVRwgJaVIqDXmoia3PnC6rIWLG5dpnvOfHJxYRSddzXbVYe80L3AvTnNhfIf
+R2sX87j2Ro4ra8jYAsSTCpDH1oxNqzL019ra9URaNuYkb7izQCgg9Hbt3+Fsp4VjJA4do6ipfSH2+fsib8ZLMkwoUhun/xcaowI0fUPs3M4joi0fI141LGv6
+SAS9vmBfUXZPdE7XGKxCAqJFQECEK+uWsPwBvTlzjFoJDoAG580ddcctrJ08o/3gBpQBGWLlMxfICbCkIjcNcD1eDlzr+Ij/nNJBNtNFvjOJKhH2dsKmckIE
+sXApd9cMH5FmyLw9fVqzOURcFWSrhsO1gDhdxd2cyEVa5dDHTE2oiiqdbfCaS7sD+e/TfAFBRSKLEJziAMVmdDsMuODAawPH92y2KfASbVMWC81PFdIXIHRR
+oK6ubT/zvKFzVaLXqJyZe13g8SVpd/eGXxlbfsJeSNkL7JhcQl8/zn9fhPNghd5yyinadV1Nc5kbpqQkuqZwXtVhwaINCXz8SCpOru+y9A46qRdnjjkIMd5i
+IzCw/cjDgpMYFAxxzzwltIL+olfrDLVq3eBlpsTY2sxgeiwktFMmqynqeGIJFW73Gwqz/9BMsOdkGn5tCsfug+ikEj1QuF6p2x0jCl6BD6ionSsq6Fawuj0A
+Jy0Pmyr++XiqCDdVZuS433Uf8YpEyovf21o4vxgpUwT8JD6pNEwSpFyjXW6mlSkrlwKGBIlfUggZQx1g1ZlsU0UVhapZPpNFjFBq2c9f434OUR18MCA663um
+s9rRYhN3rJXkI0F6f+5mjfTkvXWx8XG7UGlWD0vtCmbmYgc1d62uO2jXaDeg+eQLCpEGzQMEprMrrkbcK16jWERayUKnOezvGsSoTvrxazF87SwEepGTUZVp
+KWcb9N9612rlPkXx5DSXL57LOeJ7OKfYbqfuhuEKBNHc4Ap+2qf0WD6PfE1DkvEAiX+w4fIa2DF9yJFoPQJnX+ijgPVGTHJGllp/loWGWyLPJmyQq//+zMr9
+1dMyeiWChiig0R52ptG0xkh7PKPYwaHWLC0iVHOeZBfBcHwTfna2N9PxoodEeDUWPEuA10Z+9+j96wdc/uScv3GBpP+PvX9bbiM52obR87qKCkasMBnRgkXt
+xjM6gkBoBC+S0AJIz6fv5I0iUCDaanbz62pQw/fI9+Ab+GOd/P97G8t34itZkZm1yeoNNpRmY7vnwKYAdFV1bbMyn+fJyCOoajHMoHm1RTmoNTjEwW4sx+/S
+aR5anafgWiShY4sGhgoIh40GctKQSXKOd/yaMUTbgyjwAlbnDXaBNCdBGTcBrEI05krU9zpfsvDRYSDgepf/Cbt84g4DttfuXHAdUX6aCVGeIH7WVEVSS+Lq
+Q2gJC+/xd8ldalfR6dEkuihy4TL1Bc7ZR59gy5k/dXyXaO+hbXZZl2xbE3BA6jYiUm5jIm03uhGFPVAHLa0ixTPHKQttC/r/ruy4fgPbgEvY2Xpns/1GW6ib
+u9s05FDkqB21sYj02PxXXT3oRENiOTY4kjelFuFUblNmq69Y+Kp5niyfGFFtkHGY+QcHWuJCOGjxJHbCpA9ppm+tqZbiZmOF8jkuljdR0AHEYzA+Lu+hazAp
+wk0w0qrieI3BXqJGadVgbTd0e5ImIR76BVU4oQx6FUuzbCmQQCvOu1x58RbawLx8OZoCmNvbTQSeamyt5ZEfySN5FTzO8N1uKjouz6Xd7WPo5LKAeRzwDGI0
+eu7mxRl9d6adk93r4e8lD2RBmJyFslZl4NLuUkewOvYd+vlo7TYKKLJ0kWpbEmrkOFZ9jc3nfpmIQ8SI9hAfEhyh35Qektukh3D+cOkhJ8S4i2fSAiHGEyT3
+r7AUMaynKqiV7ZM2eoetx76Ij33mlrH91aCv7qE1BEbAbanu11v0heoLd0+doZa50OsL/Z71fy7Pnp3+QhJA2/V/Xrx+8eZVXf/nzavXvf7P70D/50yX6YOp
+CQGdDp7L0Ww8RCX70fTiAtT+R9PZx+kM0xuIyZxE+0FfX76fzC4wCcPZdEyff5xN/zI5G1sZ/fl49pfJaDwfQEaBq9nk3fWVTRGAAvhns+H7K+lk8H0ZWP9Y
+Di/l8OpqOrscf3o2Op+Q1P85tmL+YfJx0GinsJXPsXQ5uXw/nV0MqUYQ95dHmDXiSL4bzifz5vMhlURIXCBm4x+HM0j2gEr7vExb21lCPTCZj86Hk4s5phVA
+LX5MH+ASAMzG8+tzSGoh3s+mF3JyNQfB/4EQnrLuc1scD+eQyWFyCTkNxufTn07kZO6rk9eXZ2PKGHA1nl3MXW+2dMf1u/PJyPfv8REoBx5BuoEj+xkyyl21
+VMfVeHRFuRRG04+fZpMfP1zB+/1xOhOUYyJOsTDA/Ai11AU2G8XVBxjCuRxeX32Yzib/m7WdZT+gaj9M3k2uxmcA7/wE6RNmI8qXAKVjI+Yu6wNW4Dvjw3g2
+TjB/w3CEuSFgLDC1iLyaindj+W56fXnmUozEHWZbQF3gkyz8CCM/xyLhc6pcjKaXV8PJpa0RcpRA+ofJ2Xg2dFP60/R6ZlsxtJk2MBMDVTqkvCNnE5y/dKk+
+HXCSOV6gj5ys+gNpQB5ZooeyRECvDiHvdZkWS4Qqp8ZsMAxUra3Ibyl0vnhcZMW9XqYqiUVoXR7LtDJWArp6lGjARpAkYI8X+a2w7OZ8g4TNYtVIhohsf3/x
+QQeR80HU3A+CBJYI13RndPaArJkSs4fqu5sM/cpVwVJmPWhiRgzkELvA3dr9NUsoWeuzSAyV3QGUxC1PhR+CBnAsAyFqyRdNexaGo1pJ8TDVmfxOND/+AKK7
+1JX3pX6mf04N9h12ER9ollA08bR3ZlAmclkqcAr8N/1GrOg2pzL3ibwrKsyAlC6qTanlgy4Nft7kUJdVTFIT6qZMl7dUD9zAQ4LlyMBF27I5yzzShTjbde0B
+RSHvxMrO2Jtt+zhLPs7isHFuyajZ1Nf318OjXeQqbBqL1LIEFnD9a894wlyhR/Vsrrsq/LIuHI3DV0hE6CM++6I0uhjsw56Em37QM8N2NxNSiGZ7VwN59KnY
++Lmd76SadaYZtWldjIflpMUGnC3kKNfL7kwxdXF4llcY7ni2WJ8sxmf5DH67rcwE0dlkudTmPq20VL7Bwa9PoLP3Ki1RZshiDRrOoYxnZuD609yVSYr4VpGa
+SVC7oCa2n/HZ09JU0qgsiNWye6wRdouppZo0Aebh9Yq+5LqUddmlTH3x5Yo4wZ4h3Jjrnx9JZukQSp8Pfz2dxxdIezLOYSP2zWFz0snw84I0ImzSPpUKng+E
+raoKxu0L87EqZJovrEym5kdtVZB7q5R3Ranr+5jx1KxmqdAAVqjXEKwdeYaQUlURQjv4xik5Iu4xrR1u8wYdjJZzbD0AwK+zrOPwCTrX6p9Gif+V53irzTIt
+6KByq469vXW7iJZXaXmNK5/VyA6HPUPcSC2fTIRbktr/QDaq4DjUOCUeHsOR2wdeA7GejmeWPXYT1UIFjklfb/RADLNIGT94oh3r5eaRcQZLLe2asaj8JeEn
+ZxEJ6qolvQxHiNnXb6DgBUPBe4BAcBYGJDrnXNGicAjcMAGTMG1qHHeSfiMjsyj5z3A+QSb6Rs6h3MGr5I50Mx4t51BiBAyPsMV5iqXPHG524mTh0ZJJWk4g
+CHw8CiooWlBRkneoe78OENs7wPXPbj6bPb3ZirOScRX9NEp20H7E2l4JMNk/iDp/oiszXNw+T7LWzEzx4/FZ63uZoiNR2kAfSgAHFH09TYLbF2NgvYiALHEL
+Dpx9Ymvnhy717uAq4ohGYjsCb0RF5pQRikZq8ggzA1thblJTEWArUmeqD9DQeYf53sXEZ7acFs3rEZGqsHyGzkQYd9vRIhW6jMEKCceizZ1AeTja6DNdWf8I
+JkO2bFvT8IpEEyOkOPa7n5tKDaxRQJMnwgLWvdnXeB9E/dtEOlAQ7vGcQshTwwqXBl6ZAJenSyCfd369MJx8u45OcxsWlorAwFQyNftrxHRKxCBlsNJdUjFX
+ZCZGKjD+ukQGoDea9JIuppEB0CXxwqCxPKLPw3GFC4vu916Sv5fY+l4MetQhIYN9bR8Ve8jIRJ3U6BGfnO1x773/8MOPrtp1aymRnXurb6Zwu6y7AzvfQxBk
+rt1GYXUu06pGT4GLLZFhpFdLckKSIdsUijPnD/rRRf5zdUfpEyMuVzDLTxw8o96IdOVJbm/xB4gVjnfT8BO4hkDONWq5i7q7nEjWZmxj+7Qki0xoN7eG9kJZ
+ndzmXoW+Coe5wE3E1o9+CZtlDEHsIjhKYL4pBA7zS7l9kJ4xTujRnxWqkplWppLKiPuyuEtzZL/ahHK7C35S6mY1AA1kNDmiVJsrP2l9Fs+W9Ks8Aaitk+L1
+9uQ2MWj0RpuKp9H5g0ELPtPLWy3JfGdjl+ZwXD0SpTEC65Kq9pLLarOUpbGhjhtzpOTtec3WunH2B0XN6aeIFJL72kfChZotT4RS+z0yBgSWryzNP7BoA3XX
+t26l4YtSm5A2N8wru7/BDSpNaW/HN/ZHvEPLx16AJITXk8A2d5x2QSFeeGn7UVypp7nDR9U6LZcuz1TJXHdyqVfqzjon0/xBORiUpbQHPwbld9/AmP11Q4NU
+K9lm96Q80MO5y9p9/gmzdY/PwDPPnftR6m6XczxkGIec2lvyR0fBEVviWQgmSR9M8pnARXvK8USOJ9AK115olsuSvSOdOBTBCo3jURCWGEO4YjrzOb95JOZb
+59OGKIZ9iAe3XHZrDG2F+NfVVA5hNGZnmOD8UwiC4S/Fu9l4OPrg2xtecnIp52NMNQ5Q0T2SeIsDknjLPZJ4i32TeMt9kniLA5N4y3+/JN5102Vr6m4Zpe4W
+X5O6WzZTd4unpe6WLam7RZ+6u0/d/btI3b2MMlzv49KIknhbT3RdTeepmbxbAb1PzOTdp73+pdNeL/7N0l4v+7TXv9+0132i3D5R7q+aKDen1Ld2XHH4HUFy
+Hwx6ZwJceVACXLEtAa48JAGu2J4AVx6YAFdsSYArD0uA26ep/f3iv1/8Jvjvl89fn5428N8ve/z37wH/zWDfHg0uX/ya+O8e+f0fjfyezlhNMJ16KHgPBe+h
+4D0U/DeFgu9ObsCuHSwRHZjphUkdjxr7U7D+hKcwhVxZ5HY06tggTCv3rD2tnMC0cvL4CMtI89ujkzae8z4vLHrse49977HvPfa9x7732PffEvueCECiBnfT
+o9NSzQv3sGtL7VQDn1kEmxcHw+a5z9bJs1oPpHARLyreaPRRVmuG3TTy1fHyBF/m1bE+acHgPxWC75C0oofg9xD8HoLfQ/B7CH4Pwe8h+L8xBH8fqL1sg9qL
+J0HtZQvUXnwt1F4Wq7Z1dSDU/i0/ERqJHQTfB3Yd9E4ckRD3DOJgsQ9G0CRVxhSLVLlUYWxQOMAEUmOw3dSfZbpBhqAE2y4fEk9nwUeOOAWyk1MgDuYUyC2c
+AnEop0Du4BSI/TkFcjenQHRwCsip8w18ZoTl/+hzH6/kzIHh5TXanO8ylX/WbpqA1dEOVPS3ZFHP1BUE3aNsxEUpH1JFfnRbe7D9Ke2WONaD24EczkfDj4l8
+dzFJ5Hw8H45OkoDZZ562eh7nsMm4LUbwb6nwL/oGXKMn9YWHM4f/vPVgEodt4DsOJiIRXGjYdHGkZgHPMfcZxvwIffuhwBnivUGgOY9fhiRQAj6sbNd9UGX5
+KN8XP8sh/rQxLAjsYReGYAHVrKrjI1Sidn7xI7ri8TOlZmW18DSct/LmUZ5+J6/nI29tnJ6+dqN7PWdC48NFhTs3fMEyQae5jQpwkU5z4tTGyyg7uNehj14g
+miziiaf9zskCSRBo9sKGumuubNMubNstGqGJHZOtkaLugHX/LF73NL/EHFowtibItjXvzLHu1S3Y6t5jMsltk+mVOGgyya2TSWx5hV/BFH4KQ+368hy5NJ7f
+c3F9dT08P//kiD421gvkE0srsbyfJAR5p+/fj2fzEINHpADGaD0oYDYG0g4wRjBiC4HrdpoPhHVH49klBPsZBYQ4P4lj/CTIVbq+AnIKpyclu6hAcaVXk6vz
+cSIvxrPRB/iUoAeJfD+5uoRuQd4Lvfvo+nw4kx+vZx+nUM3l9HJy+X42ufxxfDG+vEqEJa8M383HNlx9PkQukGseoBLGo6t54llGiWO8UNfQU7YAMX0vx7PZ
+dAa8qA9jLGA6Q4QHgCWmfxnPAEUwkPPpxVj++Xo2mZ9NRtS3Z1P83fD8fPoTlC/G/2t0fj23gXXbg6z7EzmfUnA9/PBi+IkK+fjxHFlIn6bX35oJtQcpSR5A
+ShJ7kJLkvqQksQ8pSR5IShI9KaknJfWkpJ6U1JOSelJST0rqSUk9KaknJfWkpJ6U1JOS/iX4P69/C/7P6fPXL5r5H172/J/fL//ndc//6fk/Pf+n5//0/J+e
+/9Pzf3r+T8//6fk/Pf+n5//0/J+e/9Pzf3r+T8//6fk/Pf+n5/98Jf/HwdMZxAEoDZmCEXt1vAAEgxE936fn+/zr8X1sdyT7EXviHBo/yOP0BH4qkNDTkR4j
+4vkkdaIPo+vggfzHohTHaYpftJVGPwmL3MPspcoLFqgsSgdFsIQIJc19gY+kzjuTuDAezEy6Jifyr8WmzFV2QuHqEIaA3ZGlfKh3bRI2MTDaLHXnxqcfCL2L
+nZkIz4NyYPh6u/clOIkugpM8lOAkthGc5IEEJ7GD4CQPIjiJ7QSng5KmiF0EpwOSpoi9CE77Jk0RexGc5K9NcNI9waknOPUEp57g1BOceoJTT3DqCU49wakn
+OPUEp57g1BOceoJTT3DqCU49waknOPUEp57g1BOceoJTT3Dq//sq/tfL3yT/06sXr5+38L9Oe/7X75X/9XLwXF7n90VZ6aXnFYkWIph8GhFMtBHB5FOJYKJB
+5PpKIphoIYLJryCCiVYimOyJYL9/IljkKOXfoff13dizvsZnkH3+3VgO4aOr2XB0lezBI5M7eGTim/HIhkCtQVNwNzcpaSUnWT/OAeQk5etM5DJgd4m5xhhL
+cGehUKgH9CNsMliuSmYpfFCig0CVFdS/sCU5HOhtqe4QSMhiOoQHQPypkYs013eqgh/er9MFa59pspfELvaS7GAvsQsJXfggaH0HTxW3QPoB/Aj2hl4GcFxh
+wTh7UZ8YYI+742okoFyGMe9iPAnvT34quIGGgHW54MPxqzGeDnpZouyFLgwrYuE/g4c651yY8hGvki5djnaZgtkfT0eThK7BKXdTFmoJs8kgBJZmH9YA37q4
+6Z2qKu2+rdYqtz/JUlMDlJ8er06IqAFB5nSxTuSNw+64bjfekYutDcvQ/iAlLqfOK07j1BhWx94BvwNdIdBh3lwnqScULLfzSmllIK2Uhp2TRFpppRggaeWW
+ihq3VLZyS21Li1uKlO+gmortVFN58DoUnGGKbuYTuQfDFMh5Z97N7Kar8zWGW2L9oh72FesQvocQEV9+1bosNrfrGp2KdjbL7gWWFCCiyL23gySYsL9NQvGf
+xygSFLiDx+ZENNmDsos9qDt5g0kTcrbluKjWWoRG7m5wG/dQkmJ6XohWRuBC5ehB8I6cJIyLWQN2zuHUrN8Q4J6i8RL8IINv1KIqSth70vxWwx+4CafYA7hn
+l8SkcjRiXRr0jKwLeJSeg5M4S9FxttSIMUgkMqHSHLqi0uV9qavIF+Fxsd37Ib47ObDckb0qss9ZUeq3kkCn+HKCvZzbCKlriPZVyhvtvGjUfCiZXIGMCooU
+QLFKf7ZuZcTOmGan+QlNP8BuT6A9vkHY27Ai2E5sF86t8gcWubyI3VVRlf73lidap6H6vrKw2qi/9uWgNn2bsubbDNRs90Ku4kSYRUrzb2F3etuCZQGRlASd
+qOBCdXYNPH1XLLHbcLyLFe3dfmBFaI/HoCOeOhiAN0UB9oC6u19nugp2owszvIXFqZF3ngi1XELJiTS6vKOxDkegP6/UnZa5gifgYUtuR1vR/V08s+YICgrA
+rxbrotTe0Ptil6yG+V2pNL+zWKHl5u5GmnXx5W07XpPEDorSd/+XosR51LAlsYqqsAeMPVfSu5RcmPCtcfQ0wjkpGDskqKhcgVdzgzt6VO7jW2cCgjFWqi+4
+gO9VmkNXJkKVi3Va2d6UZrHJ7ulPnd+W6sHSAjJoeyjvfl00mi22NFvu0+xQ6ONbEdpM3Bice2+RP51lG1OVToZA3ePmkyfSfNbVYk0MqFLrZ8v0TucGIyo4
+puQMTincfGtHFiDVxb3/m/cGlITzf6HfxpsCNM8v3rd8I3qLZ//dfZp5M3WpKlXjuKSWJFJUemHDxqqFdP6Worzwp6vddeCDKhF1V5RykZaLjfE/KEVbXcg4
+847hiOffuSMPhLjt2etb2OvrgTz66GL1FpDNDKuY9wsNTCvFuNxMs6MqWESvaYMVRreUkrjIIdVYlG5ZJWyPvYGgCc1l+H8M6IbfdwIZzVtnGoou0/AnZxvT
+xi2/KCvqcKdB1cW/pP35nXoUlk1XrbWJgEQKVrC9aavK/zPCoC6gF1z8/e6tYB0cLNGogR2945FWcQw1fta1nfWIjPCoUQ93I9axG/1WgVWXOvzbtzxqqy9b
+QCzdJN42KSVeZYG3lA7k0cwx3evWvCOtr3ZV0BbuvHmk+gj1a2Cle0SxiWFqaKOlPyt/E3Tce2i7+6Kz40xVlOrWmXJuM2ywAbxbJs1dD1vTwp30eBktizxd
+WFYUU5Y40yoLUOumusT5k9QlNkbD1NWWmsj0IMpW5YlIW6K0/pkQ31elDhi4VVF2kdlCPba7YOJuE5+QvfhEl/jE7FuIT8AuLCyxeXaw7kSR2+uE050I93P4
+WNbPlhY9iJYqBCt+0EtBdEtBBJeKiRbBn45XAArMMhFkIeTXykIIJgshd8tCoCpEixiEfKoahGAEiieqQZxFIMXuydkh7xCrRTWkHeRB0g7i+Ho2Odkq8OCK
+ixUd2l9CfDxIokE2JBq4MoPYqszQgCQ6WCZvKTdP2+notlb3cKvrS9d6Wz2KLmUH+QRlB7FV2UFGyg67xkVsn1wD+dNa51sGkM9CwXjXdiTZABJ2N32wO05T
+/4GNrWiMLR8+1TF4Hhv/zUcR0H0i7BDqZE/hiMjJHDQjxFbNiCJnchFyt1yEOEAuotagulKEOFgpQnYpRRS56BaJCF1JKhF9VtheJWKLSkS8/SQ7TsEyshLD
+LBYWVq3cbPNMBlpO95vSbFSOJzVf60m3gIRoE5CQTxOQEE0BCflkAQnRIiDxZO0IUddgIMJo8iTxCMHFI46PGKJJfqTSj9C/v1tUQuwrKiF3i0qQuMYeyhIU
+f9hLWwKPnuvZZGeS3D01JMS+SXL30ZAQwQKBzcqnMQ57NJ4ifKPuFpgQ3yKDLoJOKrFTW4K6030BC09Z0pDnSFklCJgg0aEKj9N0yKUyOPh2pI3rggNUKKp1
+eMit/3rcOzSmBaUhAkrDHXhkwhvNCu8elFrwGYqLlVnwW/saRley2FRO9wpiaTeRAxnp+nSwijZyYc2sy9JFWhFFXf/s/6WM0WVlTfcMXV+itu8nbtMgQddS
+6nxZlMZSSh7btijm9bCbVcu2gXaEur8vi/sSVlECQ0sEBXq3mpBYIjjq3CERkuDG5jh07tRu348TwVkbHW3cT5GEhEYui/wZ0HRwVY0C7d4OiJwv1vpOG2CT
+Wk91RJv30A4ROQMsPoLpAzgUAXIFvRhCUTap/ugYxVrlQuUWLUFsphoDbpvqAWsF7WhNHQxv8sVs2prV15ykb0kU5Kdv0Gt4n/lWvSap14SFFnxNl8m4y8TX
+dpkX6vAXJndG2kdTOywBkEUriXopNcwkRXRTXuTcaDacoIG1hyPl5iSEmLEA7JgabdZNla/reufuS2F+/KXINjlat41ZcdU5JN0N6tTu8NAW/aDzKnBvfAUE
+xsOoDW7ermxst5X3wKfUEg5FU+nSyAff+vpLmkQ+pIruzvbxJAgAPnWGCHdrevo0kHwaiOY0IICQpTUpXhZS45ac6WhPCN+H0CjvW41gL1QdPBA7452eSHAc
+JzucG8GpbUlRN4/uOl6U3JKAfm69fHhdzaKsEnEHpj4eNuiRxclbqc+2/XKpy+JW4axWHh7iIZbc40Ab/Jdik4GXXd6X+q+bZYpdXhVtp9QfjESNIUm8yk3V
+S5v00ia9tEkvbfLbSZsU+SGqJujx6lVNelWTXtWkW9Vk74BhGftfxLeRMbHuuV7GpJcx6WVMehkTLmNCXdZ66Uy8uVKjTqGTW+cLgnbUsK3Q/ctSrbAYF0Dx
+23Oa2xx61KB3uszBK5TDfZx7UD8GVBdKbQT4vxw6ZC7BJoEDpO4otlZAvPG+wvu7fPGnRJ5+/933J3Rez4o7LVhNxUqefv/mlL78afJxyuQwr0qtaJM5/f77
+N+wnTGuYttOPgYAWP+T77jqHFWFUxspnzTjGWCvCh0SRyz9vskf54hW2/PQEx8ZoLkJSGwq8pNrjyloQpc40BBAF96PFvLLzyARQJXI+rP1wo912tJRqYdGO
+wkc0SlggBS4oPqvtePpIBLN3CqiI+iY84Og5zBQi20xl4BHAYwa+x8WrSnjvtEKHbNtMFa0WVaa+BLYqW4wMy9R0sgh/ZhCYt/GYKv2G6yK7gZzHOvetqJ+K
+UGGMrGRoCncKW59FhMSOz8s2eZJLjC/1okS9KNGvLErUeE8fCaUoW/rfdBPZkJtTpzx8vdopXiR3iReJg8SL5DbxInGIeJHcLl4kDhQvklvEi8Rh4kVbpbJx
+Yoe2MYRjGDhiavhIbGT//6frInH9n1e/jf7P6ZvXrxv6P69f9Po/v0/9H/y5ka8Gz+Ukr3TprJzmUhpZnCLBcBpb4Uk4y8GwWaXlHeUZKLQRxCRD34w9VS0O
+xwTGr1rCB4Pgi7AWWr0mJ+ruHXl+Z/CIxUx9edTls0VGiM/S43woIoL8dl+uCHsF2BlpZULRxJTJLDgz4GHCbgf/yOWRMs9ScyRulElNi91xmz5EJgy5E28V
+Gq9RlYmFH1a6hHBo82BLyw6nX+0oYs1teVeH/jV4XmfOs46nsTM/Sm02WeWZMVT3xntxBDiltKk8yLMwJiUPwTXGGBu9QH4lt12blu26PrRuzihmaGva+Fv6
+gJx9OAsKmx3GDjzZI+siW+qSDgX7HmatSqabYOntK8FSw4Ri3KjwFAfBnMe2EOE4qlaEq7m1wOO3JLYLXXMDuN8BNK3pBK0EEBYbVZU5W8kgCiixXgUYkLXa
+mAqJO7QEmwdnsfHuTACZjOLqVh4JVpTmBzndlI2hgcoi0CiZUIIuVN66wpsETP9aGhmfOLXAB33Xwj1QPbKIrnBGgM0Wt7O/B9jcuJ1lqR+KBfmvnAlqpFlj
+HLTUirxSwR2zg17lr0Pg00PCY4HjCFY33oYeaVkPZKMylZlCGr3YlJGpHJxx9VIK/jKmCLav7cuFymWpHQLM96NCAjpCeFtasci0KpFvYlPz+ecs7YBHGtxF
+Q6Cvx99Yqc9Ho2d+j3KF4F7k/gHD67YvlQdCnSh4IudoKQ3kBXTBYsuclD/IL+nndNBmxMVz+b9WRflf/rnWmR568wf5DrYG7Pdc23VSn/qJd2gXpbBsuq2T
+m3XikvULdonfHFqlvL2fIQvgVla+PXH95HGXVAuv/Off/r5CtJ0Ct0cib/RCbcIdnjkl/KjI7lH559/+Xq11TpvsJrgMSn27yRRjKPmLabQOsaNwo2JvYBqc
+yK5V7SSyMn8BVcbtMug4oJEYYILyYtXR57Dzmyp1/nG/sayYjQA9FxOzXbfBDwzdciMeadROD6KkKgdyKHyboXrytJMbwF2TuKia+Ux3eGWR44h2N0ThKD+D
+Fw4B14syvYGlPczgLnm75uQQHAm+byRIrlOlljpfFJtS3eK+HPzVuGe7xlgFsADR7V6PYdqLpyxI3WoEPN1mjQ0MId5FeFXm/Fq6hE7HTp4JD+ETwt+oBeGK
+8iW5qKGruPN8J/f2qa8kGq9Us5nk8VH8AWo5RthybAD9SHC//I0Ogj96Ka2QRV6VKBbkclL6IFuzr6wbKoykhcuWtr/cVZ7A0e02qj1a/RnMycgRhrClqhud
+65UT5HEFCIsOsIiAO/W5MdIX/kgMLgpnTPOGCrbtYtwU/8drrcl//u3vXGhxgGHUoVUB9JUQQ6PNUhxFe9wcZVdK17cOMhdJBRZlXa+x5aXyZeQ7F82fpMYL
+NSK3I6t0iX+QGNyyqW3IfDsujMMB7rTNoIc9nEXM89b2osIuuLXOlnVIHvmEGopo8UxnwoTt77hFpRBfqpFyzY6diArJ0Pq0ML9lqFO21ok6hdawT+/0UrTr
+GpIYBMWnt00DO3ui0xCA4cb+yq6ORVYYnT0K73Xk1xB/biUtKlK1XvHyFolo9A62bpPKH3Wuy9TIM1UpmI22qaF0usdCI9bFl4CCJss7Uzc60xhpX6hK39Kd
+YM8BZ2VFdyi3Jl8c35wcn548A/e2u/ts616KXY89rfQqopVeOFqpk/UqjI5zjXt4rrox2tIxgD2gy2CJJDwIioI/dw+axXAy9cUAnGOVZqh2wfzj1haC+OEi
+0/L01JMPWqN/alng1lPk8kwvbEzxeUIhQVGbOWl0qIQ4M4W/x0HlAnotwO5cZ6wUXf0T+mtJUh2eJxYQMlsMya0Tn+ydQJHF7H2Mf9FYfRQabnwsgyabV0jC
+ltgALW0NSzuXw2Yn/I7tVb3igLSVtmqZpyQJVzcsQjvaqaemQ8pDtJgTzY3QyXcxmQKVZXEXi64ufnQdLLd1sD2rVcV23BazO9wOQYLK/6xNLfLYnAQlquNU
+mxPqlFYxKtHo5PVARqaTrYNci3vSc00nP7eDl7v3PlUn7zb7M9wxtloHomV13Dx28nvdAqfOSE3cR2IX/7b1tTu4t/4dSdRojq6zoKFFNUm+kPaQeIIZJuy9
+xbTbE7WlFe5KTsPInmxk89bywMcHHk8ui/8y2oEukxjiAluDSO/ui9KdnFY7Ae9vd02bMn7dSBUaTAqxXXBLBsGtUHi74hYYGmKL4NZAiL8Oth3ddhDskmM6
+w8GI8K5fQvqcpaU9Mr9/88fv/zgeuRcYb+D4U0hXy1KFswoaar8eFZt8kWbwz9NTcaHKxRqPJ4f9I8d/UEhyInzQWEvPs8gZd5xtFgutAeAplJFfdJZ59ofU
+xlD8PHvkOWJ9LPqRbDl7hKPw0UAIJ6jRsl+1y+a13SIJ5NF2NtA+u7a3LQ5OgRrT/LblqvECrxrzRXFvAZxSSuVPGNozB0KcHqYH1bJpfYU0lFcmIU2HWC1K
+MH9rpwBUvQ/TjhuOqIofhBgO/GqnpUC7T+sTVqS6II3jNEefd4L7b21z5O5zJHwJ8W4geTVlYPzcbIiUgzUnzZvfjvJJ6azb0tpHlD08LWpPNw/2pG3YGfwB
+fk/6wTDcNkSQa3edQDIu7vx45W7zTbq5KaV8SbwCCmJUmtRk2+pPO0z5N8fKiihd1HWi3gbBqViPSioIljhZq2CqudCDETVpl6559yRNLSsSHZ8LXS2NZLKW
+hTBFrdGWyOjsabDPaZyqwlGkPexKFuy+IaoCWnyTUgL4yAuyT2N434jGSRuOsb0KC/cdseuWtadpZZAVLuwb1YY/xJjqRPcXx+rk+NWJzFHx2C5h07jx8yn8
+eiDPii+5qUqt7gJ4Hab5cCCnKDDVVCeFnbp5N5FjlE9qiA65X4pgZHMCjfTOLJXLoqPGHWPWoBvsdRwMcOO7hLnJuoCrme1S2wpARFEwNkdgcfA2EGeCtix4
+uGOyCH8lr4qkywkDWyTRX32LTeihescHg1rlWwaIXzPfYMcwzYGmNGVtk+MA8qJkGCRTlRtiacRBoroIAtqK1iVrNdZU5Xf27tmUmsQZ7hZ5jU9b+QTYrOxr
+kJvPXUmL1YoooEiONuLmMWlQZm3ww0u9WIQoztdIREKZQKdKc6+B9RJW5OnJ8fDkOD3x6+5mIKc8dItWzUVRejhrMPYjKdM0r/QtuVuc46eBlGjcIcFMIQFA
+srvxcdQvWjx6Vwa+p79VkSq9AmiE9US9ZeokscaVhT/Uuq5tYxfNjZ07v9tcpCEWSxf+Ng4HHodyjzMv2DLe0UvWyUdVOQuegfCCN6+jn5ubyct6VGJX7zxu
+EZzwbAMfcupa3sE3ac9uuv6T6IhVARCtpHkXn35kbHnbPrRiO2QDRJ1RjzIRKsvsPFooo2vA2QCy9fw4//qiU7Yhwhivdd7tksZwO9Yu8FLXMEv9odty9XjJ
+DzQ54rZepPnQ0fFNMcu6n8sDXESLIQnxlLCT8MP51Kt6bbX7HXneXRgwihxVK9OV1Vl3Ck2NleYx5I39lWRWHDZ6EXEmLeoIfVzbPGreVWrEzr00ceSbhlpS
+kL+zzQ8OuuNY79rpfeFB6Ss8ecs6l/5L0wGXtreSUG2/wx/S10xr0lPTartBSwkP2wroVqZ8KwQ+CYJWRSnXj/e6zNLci4k3O3wf1a23aPpYqLlTNwn0pe6R
+tNPLKu1bYlTh2IdWhD6yWO0Vc8Sq61zGu7dZurxxAd9K/1xFqqzNjko6Nuxtd092P0TsQ4uTrlEk7GS0VOv3ZDppnDlpVJWaFa1AZiFyYUYyHDqXgqAQqfUo
+kVRe4nTcrPGLHeOjpDu2kYF4z0EzaRUywXFhvo6W31gWES5AJdrmqpKlk8m1AmcWUUXsKAvh4PhNPFAnq8aq95tW2PqcvCbTvmQl+eLFzWO9h4+H+8nUtRwb
+r8hj1e1yHAjxUzOSOos1qLc8HwcvxM7gBckhr7ZM6uimeHrCXV8RXV3/TAgJgRi7hDuEghsKD/xSKjjtTKWIxXNflNEBYbOsOSaw83NuOaAJLcXEIZd8LWLV
+or683mKQ2e5irmf3aZ9vj29omgsVPo6WD+4DW6PDlTNSXAHioALksTNR08pwn6xr3YlITWNvsRvsgokkc0/W9k3GdRofUrHvkEavumM/jXUSyRChaKwHzFs0
+F3r+abZzHlXnrtsGV4gXmU0WtiNKXl/frwn8Ep3LNaEfLtWyYlItuBCvidfNtGiteJ6THweaaN7c1DpuWO2qA+0HNeDysYHKPPPBGsYrFHnRIFqj4e/fzh7p
+n1M6Sxa6zDsxRolwNw9rBiekfAgXbn+FCFFnS592B0ArYkPETUG1UTjfysVa5ZXt5kSu0iqHPraaW6qs0sUGr7C0mZCPPs1XEDgk4QBhYQxQbEY3P9cwudTg
+kYETdLHYlHhBdnC/UtunShkKELosMVude/+ixDmMjlOJHiGzKB50iSeIpMMgGHomtvSEu2xaD6+XwOD+fJz/oQjvneKecILcdF1F01zmhdVaI2EbPq/qFFHR
+xgo93gG3yfVtlt5CJ53EwCpPJ8UbakQMdR8FimgSE0QhtpmnhO/XP+u7+wwzwPnBywqD8bNFgdBSwIETCrQo69xSCQ1u9wmKYvsZm2DPyZR/XZReFgX5PAa9
+S7heqdu9OgZeb/doqJsrinUrWNmBspKa/aZKeD6eKsJPlQU55bvuHyEbgwo5Y10r/AlNMuJMqyJgLjmGjvBEXS6ku8JUHuaFEqU/p3eqwjbAqiuyTRU1FJrm
+tC1WMaenZT9/g/s5RGzwQSYJNQiaULWjxYFznFEVojx6O7SxJoDxwbnP7HG7UmlWDzl1BSxzwTRntxyDHQJVtBvQfAoFcZR+8wCB6eyLqylSijco9p1WstRp
+bioYoB/QhRlVKxW3EKgin5xMpkYuNuibDW7Tyn+KkrNpLl8+l0ukxVjIrdtOvZHvC4RUdOzK/WJA6FF7HoWWcvVcxsre23B5A2qcIZxIEg/Mkcd9ErDeSIKr
+kEbrz7LUcFGzswlfyLd/S4hisT1Gyt8Eq0SuDQUr2kPLNJrOGGmPVZQd4lPN0iIlKu8l9tEJrz0QZmf7a3rBX64gVpMPc8phbaphbQVGy/87XP7keL/yQaLI
+26dq8cmgf7RFRaY18MMBbSxz3tKpw1nNn+A2JAVPWqtAeWc5q03SkMxxTnX8mnEq2wMk8AJWIAt2gTQncRE3Aaz0aZxZmsvXiX2jafUu/xN2+cQdBmyv3bng
+OiL4NBOipA78rKmKpJYazYfHEha64++Su4RpotODaXNib7KlzNQXOGcffXIRZ/7U3UOivYe22WVdEl5NMAEpnYhIxYsJdt3oRoT1QE2stIrUrxwfK7QtCFu7
+suP6DWwDkPycIrktdzbbb7SFurm7TU8MBW/aERmxNpf/qqsHnYBELM0FR/Km1CKcym0qXfUVC181z5PlE6OlDa4KM//gQEtceAYtnsROmPQhzfStNdVS3GxC
+GnJ/MeBNFHQA8fiKj7l7eBpMinATjHSLOBZjsJfATVo1eM4NDZekjZ3+SPKFUAa9iqUothRIgBTnTa68kIexefMtuh1NgXyhjZ8IPC/MWof874MjeRU8zPDd
+bvI2Ls+l3e1jeCQlpQ5YBTEaPXfz4oy+O9POqe6FnveSirFAS07SWKuQfVvJHe22As0dwtBo7TYKKLJ0kWrD9FIcD73GhHO/TMQhwjR7CNEIjsJvytDIbTI0
+OH+4DI0T5dvFJWmBCeMJkvtXWIoYslMVzjHdNmmjd9h67Iv42GduGdtfDernHrozYARgdulOrRndWLh7Ss60zIWB+CX1X+bDZ6e/if7Ly9MXL5839F9evu71
+X35n+i/o5h5m6WctTwfP5Wg2HqJq9Wh6cTG9nIvRdPZxOkMpczmZk0A3aGnL95PZBQqun03H9PnH2fQvk7Oxlcyej2d/mYzG8wGoh1/NJu+uoQxhxa7l2Wz4
+/ko6yWtfBtY/lsNLOby6ms4ux5+ejc4nJOt9TkLuHyYfB76dwrbTVT6n0ieX76ezC2o1CnnLI1SIP5LvhvPJfNB4T5KNF5dTrhE/G/84nIGwO6pq8zJtbWcJ
+9cBkPjofTi7mKCFOutsgFe7Evmfj+fU5CNjL97PphZxczUHceyCEZyh7Hfvj4RxU2yeXoF8+Pp/+dAK97qqT15dnY1IHvxrPLuZOOrw5bB+v351PRr5/j49A
+B+0IpMWP7GdIGXbVUh1X49EV6aaPph8/zSY/friC9/vjdCZITz6WUx+gFnpNptwqz199gCGcy+H11YfpbPK/WduZ0jlV+2HybnI1PgPw4SeQSp+NSBsdSsdG
+zJ3CO1bgO+PDeDZOUKt9OEIdeBgLzCEgr6bi3Vi+m15f+nQCcYfZFlAXeEH1H2Hk51gkfE6Vi9H08mo4ubQ1Qj4CkHqfnI3tspi+hydmthVDq6qPqutU6ZBy
+DJxNcP7StfB0wFnEeAU8corKD6Ro55P4W7qa1waQ97pMiyUCaVNjNhjIqNZWsrQUOl88LrLiXi9TlcSSmjYZMZ67JGhbPUo0wTzUAQ67RKqsyG8FHeYy3yCt
+sFg18lQhndub7ujicLfo2gVafHHp56u1vjM6e0BuR6mlMkbf3WToGa0Kls3kQRN+fyCH2AXu3ukvCkLJWp9F0o7MilUy8Ovph8fKyJj1L2p5sUy7APtRraR4
+mOpUbaeXHX8A8UnqyvtSP9M/pwb7DruID7QjbKOd5XjNzCRK5LJUcK39b/qNWNF9RGXuE3lXwP/L+3RRbUotH3Rp8PMm07esYiqVUDdluryleuAOGfI5RiYa
+WkfNWebhEsQsrpPLFQVtEys6Yu9m7eMs+TiLw8a5NqgtY7oYhAvO0S4KEDaNxRqZdn1nJmHuzDuqJVbZWeGXdeFIBr5CYr0e8dkXJfLDcBX2ZLGSTMMK293U
+ohfN9q4G8uhTsfFzO99JiGp6473WMqZwcBRNQkRlj9bVq5fdWSHqUtciZDaEW4ot1ieG8AnYgudpK25edDZZLrW5TystlW9w8EwTcum9SksUmbHR8oZ7I+M6
+81xNlzvjSN/b6usyQV0XlsP2M9Z1WppKGpUF6U12EzPCbjG1LGAmABW8Ws2XXJeyLrqTqS++XBHnPjIEPnL98yOJ7BxCPPMBnKezzQK1TMbpK8S+6StOOnlo
+XnFE8LTM1kmM5wOBiaqCMdDCfKwKnsKZH7VVQQ6aUt4Vpa7vY8YTh5qltuWF5pmS7Q5n3jIYfVV4+UNOW6vvivDMAn+9DCkfFsV9Stfve8z1hEeDQbeaZdPa
+ey8wxiyfNnyCLqX6p1FuYuXZy2qzTAs63NxKZT1mnQ2i5fVbXn35u3qNln6+8lla7ByzB6Obfssnc8+WJMg+kI0qOBzURzdDcofIGwP9rEpGWsseu7lhoQJH
+Yq83eiCGWSReHhzEjmhyw3KFQ812I7BA+CURAGcR7+iqJQNIM213E3guGPDcx+2DDy8AwjnNiVa6w6YuWQJpPyFq9HJSMyPLuSj5z3CmZI/ivpFDJXeoJ7kj
+I4gHsTnwlnIZ+QPEN0+x9JlDlE6ccjeaZ0nLsYqp/QUVFC2Veqb/PTtAbO8A1z+7KWTWJGFbglVBq+inkR59a7e5XgnsvT+IOmWhK7VV3D7Pb9bM9vLjUU/v
+7ZJ6BzB7XcnebfYxvl1E+JK4BQfOPrG180OXei9tFdEyI50bgde8InOiBEUjFW4EZYG9OjepqQhHVa3DANUNTDl0Tlu+dzHdly1HYPPORzwmLJ+BJhHg3HZe
+SoWeXDCtwllv5e0pVUIbY6UrbRmhV+jMbWsa3vtoYoSUmn738znm6xAgng7cQrm9Ldt4H8TD21wnUBDu8Zy1xxM6CpcaWZkAJK+9SPMa1f4i4qAXkfUXqdUh
+DnsR2foieEX/ZbbuRqc8YQ/3RI1vsYcL3MNlxx6OuffqLe7az8WhvbJlPxct+7kjZtTto517u9i9t8t993Yy7r7N9i264IFP2b73PDvberBjJxfRTi6/cifv
+zlm2107eWOdNw/4rNnVR29Rbe2m//V20Oof4ePqpxog17cpeTetUWO4Sg37K1OyvWtUpWoXk5Up3iVddkUsg0qXyrjG67PsLsl5a8XN+4+kSnWJAfo4/4uCB
+woE49nsvyd9LbH0vBpTsELXCvraPij2EraJOavQIgVgmK9TQ/aXuBORWbWySRdm4+SbxPibYPlYnbwawrvM9ByX22mEqF6VeppWIOW6BUSe9pptTig2501CV
+PX/Qjw67lKs7LSBzZsQ+DW4ZT5GtNyJdeVruW/wBsh1EtF3xn1i/yEJZUeum8aLsiznW7qNLVtqyDbZtH8d6cDtI5NF7ME3W3C1vn6ZN6OaxYZ1gbqCj+aLU
+OofZIT1P0WcbqD9qLTQI0M3BALJNd4gol7vMbpxtZEiWAVUEnQIiGW7vqpaplpDemIXICcOahE54myCQOEchKgALTiHPg3ug7YP0jIch+ZNHVTLTylRWPuIu
+zWEtK5sLckvBggp+UkJyNQC5dzRdopSyK79qfbbatjTDLNGtrZPey5oEJsb432hT8QxYfzDo2cn08lZLcuuw4UxzOPEeiXEecSsod8CSJw9gqXljBw6eTFG+
+AvuEt4ycYUMgJ/opAjv3tq2EQwZZWh9l5XxkhDUsX1nFlSBoEFQUfOtWGr4otQnZl8O8shs8eNbSlA43fGNvJThyU+zyTgIaKgnCH/Z1EkGIHHhp+1FcqVcc
+gY+qdVouXYq4ksWp5FKv1J2NxKX5g3KoVasuEpz2FTIINzBmf93QINVKpouMTXA+nLt09OefMA39+AzC0DySHeWkd5n0Q+p8SBa/JTF6hASwJZ4F5IT0yAmf
+4l6059JP5HgCrXDthWa59O878uRDEZ3gC4jBjyE2P535ZPYcdvCtE8VDyN4+xJEcLm074jg82APKHcJozM4wc/+nGuJDvJuNh6MPvr3hJSeXcj7GHPqA7N8j
+O704IDu93CM7vdg3O73cJzu9ODA7vfz3y06/j+3WlrZedKetl09NWy+aaevl09LWi5a09bJPW9+nrf9dpK1fRmnr93F/RwnsrQuhLnb21Cz2rZyMJ2axf+J7
+Nm9A4vD3iu8nv8QLLvo0/b98mv7lv1maft2n6f/9punvE3v3ib1/1cTeOaXqtuOKw+9I/PvwpDoTdsuDEnaLbQm75SEJu8X2hN3ywITdYkvCbnlYwu4+q/a/
+zn+c//Xit+F/vX758rTB/3r1qud//X75Xy9+Tf5Xz/z6j2Z+TWesJphOPRWsp4L1VLCeCvabUsF2p95hlxKWaRSM+MKkTgkE+1Ow/oSnMEdoWeR2NBqog/RO
+L5+15w0VmDdUHh9hGWl+e3TSptSxzwuLnvvWc9++ivt262eMlmMrWMsHIlAq1unt+lmmH3TmvcJO1R7WsREGAxp1Qki+dI6BQHlCAFC9i37gZm0iLos8AMkS
+GQzbnrDXE/Z6wl5P2OsJe78QYY85yIXTa2cpiVkrjEaHcLVm8GgjXx3rE2zRq+PVSc/+69l/PfuvZ//17L+e/fdvwf7DpAql87aI1nueg9bI+s2K4rK8vIKL
+V3pZ0NT9EYG8MDKX5mb/8gUyHfaPF/xZ3av8ZNA4paQSX3dKsesSNSzKF2wzUNDt1EW+2cm2jRMp9uVE7jlXxFNPuSYnUuzHifyWJ17NyOg41MShh9oWTqT4
+JuvSfS3250TKHZxI8cTzbQsnUuzLiZR7cSLFU4+61g33iadez4nsOZE9J/L3zoncJ4Vfws9l0XIuOw1+Sx4MKEV7Fhs7mZUxxSJVLts081VzjCgkQWT7lj9L
+dGN0ZOHOfYLHhcSFfNTeSq9i3pM/e/Jn5UNNens6jT0jeUS6/Ej7ERYxc6xFeY1W/7tM5Z+1Wxdg5rSzR7wbXNSzW4dEaSHERGmqH1JF0X1fu3OhUapqZ6jP
+R8OPiXx3MUnkfDwfjk6SQK5k8T/YZ6PS/O7r9l7Bv6XCv+gbCNieyNoug5OJ/7z1xBaHnWw7Tmxie15oOI1wpGYBgzr3Wbn9CH37ocAZ4sM9kMsNvwzJlAV8
+WNmu+6DK8lG+L36WQ/xpY1gQjMwuJ+z6Hfsmjo8ww5O7Px6Rp5QftjWrrYVQ62KoN4/y9Dt5PR95M+z09LUb3es5S+A1XFTIHoIvwMJ8UBllArBYBZ78wpy4
+LF5laJfdHWnD4C8QTRbxRDNo52RZDeRPNHvh5N01V7blBGjbLRqAiR2TrZHW/YB1/yxe9zS/xBxaMLa22bY17+zU7tXNbYg9JpPcNpleiYMmk9w6mcSWV/gV
+7ghPkRK4vjxH0rMnYl9cX10Pz88/OUb21dSB0IAobCnAlqOdBPTZ9P378WwewIEIYUTwGKIVgSY8GwPBGti9CCUDRF07JRvwZqPx7BJY4IyuS/zsxLGzE+SV
+X18BkZhTyZNdtO240qvJ1fk4kRfj2egDfEqYyES+n1xdQs8gR5nefXR9PpzJj9ezj1Oo5nJ6Obl8P5tc/ji+GF9eJcISjYfv5mOLozsfIm/bNQ/gkuPR1Tzx
+jPDEsZOpa+gpWwAgT8ez2XQGHPYPYyxgOkPoKaA4p38ZzwDeOJDz6cVY/vl6NpmfTUbUt2dT/N3w/Hz6E5Qvxv9rdH49t4g/24Os+xM5nxLqL/zwYviJCvn4
+8RwZ45+m19+atb4HgVweQCAXexDI5b4EcrEPgVweSCAXPYG8J5D3BPKeQN4TyHsCeU8g7wnkPYG8J5D3BPKeQN4TyHsCec//Jv7369+E//3m9YsG//v1dz3/
++/fM/37d8797/nfP/+753z3/u+d/9/zvnv/d8797/nfP/+753z3/u+d/9/zvnv/d8797/nfP/+753z3/+3fF/3bECobCAeZRpmDEXh0vAWRjxNfyvYMh+C34
+3qzhYnvDe373b8Xvft3zu3t+d8/v7vndPb+753f/q/O7bdclsovILbqJ3D/I4/TEE7i7ONoRrxtRVJzYzejZuCn/EZ5IU/yirTT7E290eaahVHnBMEtF6VGJ
+dLgrae4LeESmLmySOEQPzGLyXyfir8WmzFV2gl2lgkUg05ynJ613bRL2Dbj+pwsNHXfjU2WG3sXOTKJuI8xprd09ob0ntPeE9p7Q3hPae0J7T2jvCe09ob0n
+tPeE9p7Q3hPae0J7T2jvCe09ob0ntPeE9p7Q3hPae0J7T2jvCe09ob0ntPeE9j353y9/k/zfr09fvnjZ5//+l+J/vxw8l9f5fVHCxG3QrL+eCC44EVx+LRFc
+NIjcX0kEF94T2RPB/8OJ4JF/kn+HTs93Y8/6Hp8JcICP5RA+upoNR1fJHjxyuYNHLr4Zj3wI1Fo0RnZzk5NWcrJ1lRxATla+zkQuw62bmOuMsQxWMwUh3SFM
+oMhgOymZpfBBiVdUVVZQ/8KW5NCft6VC6A6PplAkHpGqRi7SXN+pCn54v04XrH2myV4Wu9jLsoO9zExiunJAuPgOnipugfQLUBXsDb20tgTzKOxFfWYAc+7x
+qpGAcxnGvIvxLLwb96mwAhoC1uWCD8evxng+6GWJsh+6MKyIhf8MHuqcc2HKR7oKZPY72YUUDM94OpokdA1OuZuyUEuYTQYpGzT7vjjnuItY3qmq0u7baq1y
++5MsNTUC1Onx7QlxHiG8my7WibxxQBrX7cb7SrG1YRnaH6Sk5aDziss4aAxoY+/AzZeMWPRJN9dJ6mlsy+26ErQyUFaChp3zLVtlJTAu0aotIWraErJVW8K2
+tLilGPUOqQmxXWpCHrwOBVeYQE/uidxDYQLI+Wfew+mmq/N2hXtK/aoY9hXrkryHyAxffkXJWof3JHV/Xxb3ZaoqRNGVxeZ2XSMu08ZHhCXkIwNUifxp/3qM
+cL1T+CBhf5uEwkaPPNbF9BCOzYloKiJ0AqxXnVoISRPTtuUIrNZahEbubnCbnoKk5EJ5IVpVDhYqx3u5d48kYa6ZNYDzHOrNeuMAKSsaL8EPZ/hGLaqihP00
+zW81/IEHS4o9gOdQSURrJ42iS4P+hnUBj9JzYF1kKbqjlhoRC4lEUGKaQ1dUurwvdRXd8D38uHuPx3cnt5AzQ1ZF9jkrSv3W4nXx5QR7Obe5U9cQg7uUN9r5
+pqj5UDI52Ji8BSoEiFX6s3XWIhLHNDvNr0L6AXZ7Au3xDcLehrXJThe7GdwqfwiTI4kY0BVV6X9v1R3q0hq+rywiOeqvfXU1mh5DWfMYBrkZ90Ku4kSYRUrz
+b2FPL9uCZXGnYLTBNQmOSWerwdN3xRK7Dce7WNF55AdWhPZ4qD/C1oNRe1MUYOOou/t1pqtgCzvn/VupJB6MpU6EWi6h5EQaXd7RWIdj3Z/B6k7LXMET8LAV
+7EH71/1dPLMmFookwa8W66LU3nj9YpeshvldqTS/s8ij5ebuRpp18eVtO/qTBJyK0nf/l6LEedSwj7GKqrCHpj0r07uUHIPwrRG2I2mnVjB2SLJRuQJf4QZP
+qajcx7fOrAUDs1RfcAHfqzSHrkyEKhfrtLK9Kc1ik93Tnzq/LdWDZV9k0PZQ3v26aDRbbGm23KfZodDHtyK0mfg9OPdg8ck0yzamKp20krrHzSdPpPmsq8Wa
+OFul1s+W6Z3ODcYpcEzJxZpSlPrWjiwg0ot7/zfvDSgJ5/9Cv403BWieX7xv+Ub0Fu2Zu/s0wLqXqlI1EH1quThFpRc22qxahHTeUnAY/nS1uw58UCVi+IpS
+LtJysTH+B6VoqysvqrAZR+ZS9448EGLdK/JsUeRJB/Loowt9W3g3MxZjbQxoYFopJvXCdMiqgsXJmnZlYXRLKYmLx1GNRemWVcL22BsIRdBchv/HMGn4fScs
+0rx15q7oMnd/cvY+bdzyi7JCVXcalOr8S9qf36lHYRmB1VqbCH+kYAVb74Gq/D8jROsCesFFte/eCtbBvivjBnb0jgdtxZHJ+FnXdtYjMkK3Rj3cjX/HbvRb
+BVZd6vBv3/Korb5sARFqk3jbpJR4PQd62F8H8mjmRGvqNxQn7LLaVUFbEPHmkeojDLGBle7xySZGvKGNlv6s/O3WyehA290XnR1nqqJUt86Uc5thg1vgXU1p
+7nrYmhbupMe7S1nk6cISypjw1JlWWQBuN8Wnzp8kPrUxGqautix7JhdVtgpTRdJTpfU5hai5KnWAzq2KkqJxzVBzqMd2F0zcbdpUstem6tKmmn0LbSrYhYXl
+ac8OlqUqclA9amhRhYKGzGHKCKgciBd+wnd9+JqzyxwZGFdHIiuEOTC+l6n0PZmOmVZl9igydaMzuN7dqRKPpChc6+hv1BaiDkBkt7SR3BpsSxBE4j3OfAXE
+syR2XctFscmWxJYuP+ulPLpij5MB9EUZ/wh4smBZjfNbuAxDffN7ladmTUQ5Fek22dKdJ6OlcOEgiB59Mjjyul/BEYSjUz/wW/S4WsZd8DHHKySTZ9yjEjYT
+Bv+BQllsi3Ku1z8dr04SDgHZS0FLdCtoye0KWkttFmV6E/l/BQho/c50s84iZGb3bO0QWYl1SlvkRw4RwRLH17PJyVYpLFdcrH3V/hLi45NkQIKYFVf8EFsV
+PxpwTIdN5S3ll4h2bQZbq3u41UGpa72tHkWXBpZ8ggaWOEBOZNe4iO2TayB/Wut8ywDyWWiBznwk2QASMDt9sHtIU2mEja1ojC0fPtUxeJ748M1HEZCNwi31
+V8fqZE+JrSi8EdS1xFZJkiKvq5FsF9YSBwhr1RpUl6YSB2tqyS5NrSIX3XJaoSsbslT19uaRPfT1ImC2wcI3OBQvn9TgSEdrxyKJ3obt114To30Ls87ymjyW
+3CqPJXbJV721Hm/VhCJyzqXnCR9bMCSWUZS+NfZ716qTfeW0RK09Tkphf3zV/EQeHw0DQN8WdHSy3yE3myR4MwslBMdWc9PEsCkbvN1bIrZC7H2msbKbux+L
+A+iWBu97xoU6RIfJdvAe2ez/J5xpbe8kvvZ0UyGG7oJoB45fy9knuiwwHgM+7AQU9RNw60zYeg4K9mjbaXjASIstIx0fjGxzvGkcjGzG7X08yh3Ho6gdj+yl
+D9Hpap27vWLXv7Fi17MnSnbFW0Cy4xZGMLhhjIyL3EvBTrEsJ+Wh8cFtAZP3flOajcqjWzKYnwnfWcW3Eu2CEfiVRbu+sV5XskuwS1jBLtkp2HV8xAwQ+ZFK
+PzrpEvISXUJe8ilCXoILeaHNV8PntKl5kRnXpeklmppeYPj87rW7AJDxcCLrSpJ+hz2+OUnqaI34stCq7SW3aHtxkNkuWS+5r6yX2C3rJYOsF+zcttH8+lGx
+Y1bAHeSJml8y0vxqQov45aSMzmau9CU7lL5oxbsvcGFZrranpltdrqY9GtdnCzQJzLgcNb9U6Ywc47rIyL1VwhBkZB9yU62OjgzN247ldVY9Xd+sUbVj0NoA
+tWzXcSNhX8PoShabyqmhwmK4iULyKKfUGeROBDdgYIqki7QiCSH9s/+XMkaXlb2SZI+W2hmFqBJhd1NK+1NKnS+L0ljq62Pb3p1EYEPYxdl+Kux+2oRJFitL
+pKR3q63QJGLHObxqIjwwgPPlOEygWD25jfspxpEQ3GWRPwM6Ma66UZBFsgMi54u1vtMGpD5s7D+SNfIAYBG54i2Kluk3OTApxoq8WFVRNqWYMNSMtcqFyi2m
+lljXNSmCbapUrBUk79fUKfP2cSx1UjORm5P0LYm2/fQNeg1X97fqNUm9JixY82u6TMZdJr62y7yQmitAOOPBPpraYQm7DK0k6qXUMPsdMfA5B/XC/YwRSbH2
+YHMuTgJoDwvAjqnpl7ip8nVd74JpKcyPvxTZJserQGNWXHUOSXeDOrXVvC2hH3ReBY6wr4AoG4iDQVPDlY3ttvJr+JRawjFpKl0a+eBbX39Jk8iHVJGf2z6e
+BI/mU2eIcFfMp08DyaeBaE4Dglxb+rXiZSGFf8kVGewJ4fsQGuUjmxGQmKqDB2J4g9N7CxH0ZIfXJsAErL/y5tF5BYqSWxI7Lml0zG9M5ZOHFGUF2OoKUZAJ
+hUdxLkPsnV5HLnVZ3Cqc5Mrjbx0vR/BgAQ3GFxclvy/1XzfLFEegKtoOrT8YiZKQkuQgNpXFrIWjDPrfMDXX5o6JZq1NRMBoJ3zKCT7XWiOd4OWpu56PmzFk
+1rcnwr+n1f+w1wvXrzYRZ5X6pJw82F+Ujd4VtncP6DYLZtq42wGfmQKtWNzSoDYYcbKO2vgcwarzcQbQINKmsreveELXIC8kY6Uymt1VITXZ6tZTFy5uYUSC
+tVfbltuHYsswuHi4X3u/vpAh0i0tK/T99fn5eH4lLDv043h2Mbm6apWvq8sfyi75Q/FE+UPZlD8UXyl/yCoVTfnD4X+w/CHygHv5w17+8DD5w+7juk30MFyH
+e9HDXvSwFz08RPRwb8hZGcfwxLdRObTx7l9A5fAQgEZSfxPxFbqGrStT9LqGva5hr2v4H65ruMIua/XuJN4gqylZYJhN5wvCO9doedD9y1KtsBgXwvUnTorI
+E79+3+ky13JU5OD44qGKj4GQgtp7gbksh45USIwvkGRQdxTxL3I51/cVOsrkiz8l8vT7774/IRNkVtxpwWoqVvL0+zen9OVPk49TlhfgqtSKNpnT779/w37C
+kq7QCfEx6IHED/m+u85hRRiVsfJZM44Rz4coeVHk8s+b7FG+eIUtP6WYnNFclbA2FOj+sSewNYpKnekHlVciQtJFMh/nkVWjSqSrW5PoRrvtaCnVwhK1hI+p
+lrBAClxQfFbb8fQhQWbCFVAR9U14wAWdOpwTeMzgzIPFq0p477TCyEfbTBWtRmKmvgTxILYYGcC/6c0MauXEQ2w8pkq/4Tq8SdBKYZ37VtRPRagwJoUxaJU7
+ha1zMIqvxedlm17hJUa4e5XSXqX0V1Ypbbynx2JQODv9b+1RDzeP1i0u7CxY7VQzlbvUTMVBaqZym5qpOETNVG5XMxUHqpnKLWqm4jA10605g3Bih7Yx2k8Y
+OCKZe8hDZP//XoVS/03/4/qvr34T/ddXr169+a6h//rmea//+vvVf301eC4neaVLb1E10pCPLBKaQIeNbfck2A1gRK1S9E4swx5hXVuCTnCLOjRBGEkt4YNB
+8HhYa7DxQvbAcY7KQE7ylKFMfXnU5bNFRlDz0qMaKVyH2mWyZV/6rI1IK3+MGxuDyyz8O6D/ws4K/8jlkTLPUnMkb5RJzaDZd7fpQ2QukTf2VpWkGcmqTCwA
+u9JlqrxUmgtskwe43WdaO/ZYc5vvKhxBwaBtkLk4BZ78ztQptdlklRcQoLo3wXVkg4jCkZYKY1LyRlwjcKDRweS98hkmhdg1tG7OSMWMek2HTJt3ipg8UGhR
+GibaZG2fdZHB3R4PIPseBpZAzMRGCipL9BmK8aPCMPrh6oBtIV2mqNqW7PbRW5IoAF2pA7vWQdStmQatXBUlh6CqjOkH5tljYj0YENVdq42pUN+AlmDzkC42
+3hsMyLFRXN3K416L0vwgp5uyMTRQWQSbJ3NN0OXNW3J4a4HpX8vd6QBvVYEP+q6FO6d6ZDAN4QwOik7v7u8BNjduZ1nqh2JBvjJn7hpp1hjlL7UiD1hw/exQ
+ofBXL/Afoi5MgeMIFj7evB5pWQ9kozKVmUIavdiUkVkeHH/1Ugr+MqYIdrbty4XKZakdrNP3o0KdLiQxtLTCaiyg1kG84VjeLw/UuEuNQL+Svx1Tn49Gz7yh
+7wrBvcj9A4Z36S6ledAdETjF/I2ML6WBvIAuWGyZk/IH+SX9nA7aDMZ4Lv/Xqij/yz/XOtNDb/4g38HWgP2ea7tO6lM/8V70ohRWdGTr5GaduGT9gl3iN4fW
+PELep5EFKD8r3564fvK4C7EgVMY///b3FRe8uNELtQn+AuYA8aMiu0fln3/7e7XWOW2ym+CeKPXtJlMMPOIvwdE6xI7CjYq9gWlIx3StaqeOnPnLrjJul0En
+BY3EQF4HJG6zz2HnN1XqfPF+Y1kxGwF6Ltavct0GPzB0o47kdqJ2emQ0VTmQQ+HbDNWTV59cDu5KxvW0zWfyFyib3N4qm3hhElGUQQhiIIcZ3Ftv15x+hiPB
+940Ek/irUkudL4pNqW5xXw6+cdyzXWOs+HPA5XevxzDtxVMWpG41Ap5osJJ1EUT130UIdOZlW7oUusdOlhdP4BPiQaoFIQXzJfnCoZ+4l36nPtGT3kd0vU8I
+5B7FHyD7IuLQYO1xL3jahVNE1Utplf7yqkQ1VUed8qG8ZkdZZ1cYQ4t+L21nOYcBcR3arVN7qPrTl6s1RZDglqpudK5XTrHUFSAsysJCKe7U58YwX/jDMDhC
+nBnNGyrYhiu8bMKp/Off/s5V9QcYdx5ayXdfODHQ2mzDUbSrzVGPsnR96pCvkS58UdbF+VteJl9GnnnR/EnKdYoSUtbHP0j5e9kUsmeeIxck4jwV2ljQfx9O
+H+bXa3tRYVfZWmfLOrKWPE4N+et4hjMV+vZ33CJJjy/VyGxtx05EhWRob1q07jLUKVvrRFF6a8qnd3op2kXsSSWPAvpUKxzdflUyfV36BFchmHzW911u79M0
+px9FUunwaGN2prmNyHCcTwdeoX0YKITvfErIe1BVysn89DoBWmBF6t1drHYaLHwB/ngSiFh9oIOrsX8qI7Ux5IfPHuuJt+NGM170crB1AdpWR5YHMGuM/ZXd
+jxZZYXT2KLw3mV/5vI2QtAgb1+ajV1xMRGNeYus2qfxR57pMjTxTlYJ9wDY1lE4+A2jEuvgSaCR0y0HBNL3E2IWq9C3dv/Zcaqys6L7qdsMXxzcnx6cnzyBs
+4e6Z27qXYvhjryBwFWnoXDgFAbcSCppG9CFsjZ7foG6MxhwTK1ij97oMVl/Cg9uoQXv3oFlsDuQGwUuxSjMUYGRxD2t3Qlx4kWl5eurZW61RXbUscFkVuTzT
+Cxsrfp5QqFfUZk4aHeMBP8CZCHSpz5cMMOo6Y6XIzZLQX0tSj/QM5ADm2mK0b534ZFsG2QNMT88IbI19j7TBG/oszV2MCfqHRBWcOayarrHaZsNYMqyq9lmL
+E7Gu+j9iqv/48lz5fz1ovh17D689jB1q8QN0tiztkgynpfBHvtfLjvESVjS6fUtNBw1zNLSjXa/BdIhkip1beML0vJm0nMqyeKaIrpkSjqVt88QaeeSfEOdd
+N7XgUAAJWf+ztjwMx+YkaDwfp9qcUKe0yjyLRid/HshoRtg6yBu9p6aF6RS16BCz2Hu7rSteNPszXEu3mpeiZZHfPHaKYrh9ijojNXEfiV2iFa2v3SEX699x
+IEQ2oLXI1KmpJskX0h7iyUgFt1dd026Q1pZWuF47dWB7QJPNsEwNMN8T5sEJ5/aShSPwX0Y7mHMSI7BgaxDp3X1RekI/KQLhlf+ueRmJXzfKIQU2qdguZS2D
+lHUovF3LGixVsUXKeiDE3WCbBWIHwS45lpUo2EI+WkBAtLO0tCf/92/++P0fxyP3AuMNnOIKpSqyVOGsgobar0fFJl+kGfzz9FRcqHKxxlPWQVMpVhS0h528
+PTTW0rQtsMudypvFQmuAVAsFwfIs8yzAyKxkJqWHSjzSZcAeYigpPBAit4I7LftVuyB9y4z0mkXNs4H22bW9pnPsFNSY5rfsjvoC76jzRXFvodIqnNPkDxPi
+9DB55Zad6iuUlr2EJMl5xOLLgvnlO/WU6x2XdtyLRVX8IMRw4Je4Dsd/+xOWUFhQnqM0x9gISazVdkQeZkG2rxDvBtITPMvA9QxVNm5ijXL9KSGgXBIM77YO
+98nXFp4Wtaebp3jSNtwMigO/x/dBcQYbQsq1uwKhAgNu8+iYafNdOz2Cl0ThIdOu0pSRpa3ytOPu8eZYWYnbi7ou79sg8Bvr/0oFkTQnIxyMMheXMqJNaqxl
+sj1Jw9gmWopPgK6WRrLEy0KYotZoS113FwCizcJwVIUTxfD4P1mwC5KoCmjxTYrI3dhRtk9jeN+IxpkaDqy9CgsXNLHrWrinEWVQB0TYN6oNfwhA1qVNXhyr
+k+NXJzLHrEF26ZqGc8jP39cDeVZ8yU1VanUXuBQwwYcDOUWdxGZ6D9iYmzcpOUbtv4bQofulCLY0J6pJ7+xUuSw6atwxYA0ez77en3fgwvKQ1mLL+9b3vMbr
+wi2+uTE6KtzBryz2PCwaVYauCB0guMZP5K7zzjkIlCPRYsnnA1fd3qUKHbDBomCcscAV4wNCzCysGR/uWDbiwjtOiqTLcwknBUk/+Bab0Hf1WRguESpnw7fN
+Q/AGO4bp7TQTXdS2e87pKEoGCzRVuSEuWBxLrQsAoX1s4xdWJlVV/oDrXlqpSdxlxZIh8GkrHQTbtn0N8o27a3ixWhFxH4VBjLh5TBpyETZG6PXfLGgbZ3Ik
+26ZYOoU0F4Ehr8DNdjw8OU5PaAe6GcgphzegRXdRlB5eHm43UVaUNK/0LbnJnMOuAcltXJrBRKMcmXTRwMdR5XDx6F1QNhmdvUZSgjsF8CHrQXzLZLtiXWML
+Ear1W9v5JprnGw8TtQUVAl6BPBxtnCq0CuQeR38i27QHXgzkR1W5KwsDxQYvbEc/N7fVl/X43a7eedyitOTZPz4s27W2g0/ZmjDk7yC1LSt/I1rVYhyG45HJ
+xNj22QwerXo5oi4lg/pIKsvsPFooo2tA9gB69xRc//qiU68owvyvdd4dxEFICtYu8BbbbZqzu9ZLfqTLEbdzI5Gjjg5vZlqoO/Q8+EvUjWgIO4a9g3YAK/O5
+5X4jjyOXgg/sgQ1rg92gF+NuTZVK87gdMl3Z3G1OvLGx5HxAqbHLktCYIy0sIn62heihd2+bL9H7uo3YuaMmnXqCQfvcNj+4Jo/jHFpOChSPS1/hyVtnCdJ/
+aTrgufKsVGTjR/gr+o7pR3u2aG1DqD/+sO3pboHpWjFCYDkgeFmUcv14r8sszX2Wsmavd6lyckn6t2gS+lw2VvDIT6/u4bRzzKbws7TFwnGDbXa7yIy3l+0R
+q65zUe/edOk6y7PIVPrnqqaxXu+opGP7fhGMPaOq1Kwea/ZkrEGEx3rnFA2im0HdNnHSq9ZOx7b6wP+OtT8QUaqjtAqZ7LmWbkfLbyztDheGEm3TR8nSJVux
+Ak4WFkh0QotD4iBkPPEmq8Zq9JtJ2JJckgYmYc1K8sWLm8d6Dx8PT/aaw1aRIsSGJiyLcVXUO6Q2lF7lCwsQjesFfOVdQlUd1+sKRSiou1ec0p1/y+WDeuZG
+syCaD5FnLKOC6MxgYKeyT8dQUkYEumW0RfhFc7rzHAUHrCD2ZqJ5rWpZSakJXfWLLJvGXRXn5/73N7nr/ib2vb/J6P7WmEw23GF5n3Uro0Nib9c11ivkkQO5
+2/M/EOKnJiJmFifk2vJ8HEMUO2OIlElqtcXVGblxTk+4MzrSadE/E8JNIDq6w0uLZmgpFdhiplLE9bwnhTdvrcAkoig0ri0XbthiNpKnGPcYu1O4rtqnQl+B
+rznNhQofR7MZ4ahbwSOVs4VdAeKgAuSxuwmlleGxDte6E5GaxrTlxviq5szjcnD2hF+w7YU7l7fvw9E2bLtW7DuWUZcMhNjmXo/8h6/IHHZZajjHqdQYeaNp
+zmm2nTZJG94sXl02Df4OsI1r3GtCLUbmYU0aj2uUrZhGGa68a5L7YIkTrHixS/1Tqc8+cMiO7o6Lfru+TruFCBQqbKAyz3yQlNHNRV409Dfw/unfztqSn1Pa
++he6zDtBoYlwF2B7K0tIeRqcPv4mG9AeVlXDmTmtgC8RNwVl8OE4KhdrlVe2mxO5Sqsc+thqnqqyShebTPk1QmGyNF9BwJ70ZIRFQUGxGTkgmMgknCpw4C0W
+mxL9NA6ZXWr7VClDAUKXZVEyB4AVkMQwBp1qZlE86JKg37T7h/uGiS8cwvk8bLzFiz3xkBrO+1CEP2F5UIossi6PSJrLvLBat6ToxudVXTlAtIkFHO9A6+X6
+NktvoZNOYkSsVxlAR0mkF+A+CsoBSawbAJiCPCUqlv5Z391nqnxMhCtfZoXBuPWiQBYAUHYIsF+UdckBCQ1ud9KLYvuhmmDPyZR/XZReAAyplwY9nLheqdu9
+aBJ6WfZoqJsrinUrXO8CuzA1+02V8Hw8VYSfKgsyl7uuwSFzpd/nQiu835Vy3jAJowCS5+BngiN2eTLvClN5lCjiWH9O71SFbYBVV2SbKmooNM1JHq1i+iXb
+x9/gPg5xU3yAaSAOgghiHQ5nMX3OegqxVr0di17TQ/rgvLf2eF2pNKtHfbuwArno0PqvtbVDkZF2AZpHoSBOpGoeHDCNfXE1JXDxhq5rlSx1msO+riFZ6ekg
+rlYqbhFQRT7NvkyNXGwwLhBc9pX/FJOvpbl8+VwukblouRFuG/VXWF/gW1mUzk/zYkBYf3sIhWbylAVMoWNvK+UNaO2GcD5ePbgTmbvBYJGRwmQhjdafZanh
+MmWnEr6Nb/x2mPj25mkZVYkXYLpltUM6aCidBdIeJCs7tBWbpUVCiz5C4cNiXocmTM3216SMyVwdsyaN6VQx2xQxG6W58foOFzxFeq58fDZyM6saLiAI4G2R
+EWu9pnLIaMCwkUuGhPzQCgz+atJKt3wGqICYJNatUNdMc1Ec/JoR3dvDcfACVvQR1n2ak7qUG3UrMo/Zs/W9zpcsEHkYjcHnbcaunrjtnu2qO1dXB1yGRj7K
+McZPE7jXx+n8fRA2YQ4G/g65S/IvOj3kNm/lJlvKTH2Bk/TRZ5FzBk4dHSnae2ab5dWl3dgE75DElYjkG5lS441uRPgPFENMq0j20JFjQ9tC6hBXdly/gTXv
+Ure33sZsv9F+6ebsNiFJVDprhz8tIlFG/1VXDzrloFiTEQ7fTalFOH/b5BnrKxW+ah4eyyfG5Bv0QWbgwemVuDgg2jSJnTDpQ5rpW2uMpbjJ2BwbHFXOmyjo
+tIl8Bw7z4YGfMCnCXS8SrOPYp8FeymZp1RCdaIh3JU3SAvQLSvFCGfQqli/eUiABwFygovIKTrRx+cwHeO7nC238ROBpCtdaHvmRPJJXwfUK3+1W0sDlubS7
+fAw8XhYwj4PrUIxGz928OKPvzrSL1/hUGntphFkIM+fPrVUZ9AB2ibvYFBgdqTfQrm0UUGTpItW2JBTK6iCiuV8m4hBFsj0UyASn6TT1x+Q2/TGcP1x/zKmx
+7iKbtQDw8QTJ/SssRQyRqwoXYGmbtNE7bD3uRXzcM8eL7a8GD38PwTE4/G9Ldb/uFBnTjYW7p9ZYy1wYiH83/a+zZ6e/kPjXTv2vF69evHxR1/96+eq01//6
+Heh/nekyfTDydPBcjmbjIaZ9GE0vLiA1xmg6+zidYS4QOYHEJFdyCMko5PvJ7AIzlpxNx3PMfPFxNv3L5Gxsc07Mx7O/TEbj+QDSb1zNJu+ur2w+DUyccTYb
+vr/yOSN8GVj/WA4v5fDqajq7HH96NjqfUF6Mc2zF/MPk46DRTmErn1Ppk8v309kFtRozYcgjTLFyJN8N55N583nKuwLZNVi+k9n4x+HszOVV4WXa2s4S6oHJ
+fHQ+nFzMMQcHJq7AXBsuW8ZsPL8+h7wx4v1seiEnV3PIjjEQQZfCJ4I5Hs4h7cnkEhKAjM+nP53IydxXJ68vz8aUXuNqPLuY+95sdsf1u/PJSLr+PT4Cmc0j
+yM1xZD9DrQhXLdVxNR7ZxDWj6cdPs8mPH67g/f44nQlKyBLnIxlgMpFang+buuXqAwzhXA6vrz5MZ5P/zdrOUoVQtR8m7yZX4zNAFX+CXCOzESUXgdKxEXOX
+IgUr8J3xYTwbJ5jsZDjCRCowFpi9R15NxbuxfDe9vjxzWXziDrMtoC7wGUl+hJGfY5HwOVUuRtPLq+Hk0tYIaYAgV8rkbGyXxfQ9PDGzrRjatDSYtoQqHVKS
+nrMJzl/yFpwOuIwEXjSPXA6CBxJMPfIkeqKbejkYea/LtFhinDg1ZoMBkWptFbFLofPF4yIr7vUyVUms2OxSxaaVsXrp1aNEQy+CgiVSZUV+K8hkkPkG2c3F
+qpFvFHU8/AUBvSbujl67ngvSUSM82Z3R2QNys0pM0KvvbjL0sFYFy0r3oImKM5BD7AJ3u/XXEaFkrc8i5WBmKyuJm5wKPwTB7FjrRdTym5r2lCVHtZLiYapr
+dbgME/EHEN+krrwv9TP9c2qw77CL+ECznL2JF7Zghlcil6WCy/N/02/Eim49KnOfyLuiwiRj6aLalNoBPZKmEIYqq5gKKdRNmS5vqR64qYYk5pEhiDZYc5Z5
+cBEJHNTVRRQFhxOrM2VvgO3jLPk4i8PGuSVpbTMZhb9GHe2i8GHTWMySZXuBa1J7eiDn2F0O5FE9YfKuCr+sC0cd8hWSkMIRn31RpmoMe2FPFivJZAux3c3s
+LaLZ3tVAHn0qNn5u5zsJjZ2ZfG0OJEexJkhf9mi9x3rZnVapnkmBpe6Gu5At1mdW8ol0g39rKyFGdDZZLrW5TystlW9wcHYT8Om9SkvUFbPR9oYTJeNpTLhY
+O3f5UfoIC+Nheu0uvIftZ+IPaWkqaVQWlJ3Zfc8Iu8XUsrmaAHTwAmVfcl3Kus5apr74ckWcw9IQFMr1z4+kq3YIh9QHhJ5OHA0sURknfBL7Jnw66aSUeqkp
+ETZpn3cIzwdCIVUFI5OG+VgVMs0XVg1X86O2KsgNVMq7otT1fcx4OmCzVGgAK9QLZ9SOPEOYoqoI8Q5845Qu7PeYORK3eYOOOMtstzdlIHRabnv4BJ1Q9U/B
+u2J59yupvJKA2izTgg4qt+rY21v3hGh5lZbXuPIpwOxw2DPEjdTyyeTLJaXGGMhGFRy4GGedxGM4co/Aa6iSsTazx25yZKjA6TXUGz0QwyxKIxE8tg67d/MY
+ti6o2a4ZS4FYEgN2FtHNrlpyMXGMlH39BvVAMOqBD5UHp1qAq3J2Gy0Kh5AMEzAJ06ampEBaj2RkFiX/Gc6n7FHcNxJ05Q5oJHfkZqrDUQVh8iMwap5i6TMH
+VZ64HApoySQtJxAECB4FFRQtKJ7eHeverwPE9g5w/bMbeWpPb7birEZkRT+NMoO0H7G2VwKD9Q9iB5zUZ5SI2+dZ/ZqZKX48Pmt9L1N0uEkbEEOl70BjqOcU
+cftizGwQEaQjbsGBs09s7fzQpd5tWkUA3UiZSuCNqMic/kbRyP4foUdgK8xNaiqCLkX6a/UBGjovKt+7mFLTltOieT0iBhuWz/CJiJxvO1qkQtcqWCHhWLSJ
+RihpTRtnqStFJgFHyJZtaxpekWhihCzifvdzU6mBugkA/kRYjoA3+xrvg4hxm3UKCsI9npM1efZlQSZ6QiIxlqFAl8DJCuVTf6kNj65X9RMykZ3ryVs4wq0s
+d+9x980guF27gcCILNOqxgKBywyB56XXYXKyoCEdF4pv5w/60UVFUdTruChj3lQwxU5c6LreiHTlCWVv8QeIlIxXUPgJmJ6QlI5a7iKSLmmUtRPa2AEt2TQT
+WsHWuFoo41TJ6vMT76cuHo0Tx9aPd1Gbhg2huyJcjlHZEGGT/CJmH6RnjJPv9PuDqmSmlamkMuK+LO7SXOcV2qNQ6+6Cn5QRWw1A6BqPmSgX6cpPWp/mtCU/
+Lc+QauukWKbdrU0MmbvRpuJ5hv5g0GrL9PJWSzLZ2NilOWxRjz8wiTQLVSTV9CWXTWc5XWPjDA+wSKndE4ftiebOHIoo0k8RRSH3PROFC8NZFD3lPnxkuG8s
+X1kSfaCpBm6sb91KwxelNiGvcJhXVnwLrOY0paMB39hv6w4rHN/8khB6TAKd25HGBYW/4KXtR3GlnkcOH1XrtFy6RFwlc9fIpV6pO+uQSvMH5SAiljMe7q6U
+Nn8DY/bXDQ1SrWSb/pQSZQ/nLq35+SdMZz4+A28sd+hGuc1dKveQuB2Sjm9JsB05xG2JZyGAIH0AwadKF+052RM5nkArXHuhWS6N+PZ86wKKYIXGMQhwRY/B
+RT2d+aTo3Pv+rROOg+faPsQDGi79N4YzQszjaiqHMBqzM8wA/ykEPvCX4t1sPBx98O0NLzm5lPMx5mIH2NweWc7FAVnO5R5ZzsW+Wc7lPlnOxYFZzuW/X5bz
+uumyNbe5jHKbi6/JbS6buc3F03Kby5bc5qLPbd7nNv9d5DZfRinA97nGRlnOrfexrlXz1FTnrWDHJ6Y67/OC/9J5wRf/ZnnBl31e8N9vXvA+k3CfSfhXzSSc
+U25gO644/I4mtg8+tzNDsDwoQ7DYliFYHpIhWGzPECwPzBAstmQIlodlCO7T+P7L4X9f/Gb43zevX75p4H9fv+zxv78f/O+LXxP/a/0o4quRv67aueiRv/86
+yN/pjNUE06mHAvdQ4B4K3EOBf1Mo8O5sCuwKwlINgslemNTxTbE/BetPeAqTBJZFbkejjg3BxIHP2hMHCkwcKI+PsIw0vz06aeOD7vPCosc+99jnHvvcY597
+7PPvCfvsd8nFPil9bOKp7kOIgDIfQ8oyOXNIE3mNE/9dpvLPunIz0wwa2vLRYhJ1lfmgFBelECtK+ZAqMkt93XZ6Wcl4cawHtwM5nI+GHxP57mKSyPl4Phyd
+JAEOU8sjHpUW0Eh2FAT/lgr/om/A0jjhh+yAgDIXerFWlJdmFnyWcy9T7zvqW/cIDlOtL2RRMk1xoW51XtlX+KDK8lG+L36Ww1sw4hvdg77rML8ZtrKG1zw+
+QskpZ+4dneAbc+RiDb/ZAkVyh/DNozz9Tl7PRx5Ofnr62vXy9Zwpig0XFcZia5l509wau1yjw5xQFOcnGjbY/HeNzTatgDZLrWHibh1cH/wWh0/3Z7UhpuGc
+Q/1jm/jwJBGdU91tLftNaj92onPs5Laxe9U2dqJz7GTH2KGq/H8YeSMRAKUOvvNHJ5ubF+5h15aaWQ4BgIj3IXreR8/76HkfPe+j5338x/M+nsL5cKtKfD3n
+wyZMEL9Dzgepwu+TyCPZteEnMl0B+IOoH2EeOBCOsbNcGbDilMsIx8wujnS6nk0EW1V+T9ONEaIc8i7zFU9fwkduIOaMXPKV5BZhM7F8C3KLtay/EblFULq7
+X4fccn15jjB8Tw24uL66Hp6ff3Icgaupiw4BdN2C0i1rIAlhoen79+PZPETtMLaIUR0fRpyNAfIPeHOM8UCoq50kAIGg0Xh2CeFBKPBieDWeTYbn88TRBhJH
+GkiQ7nB9Bfh2znBIWtgEgrEJajVfTa7Ox4m8GM9GH+BTilgm8v3k6hK6B6Hz1AGj6/PhTHy8nn2cQjWX08vJ5fvZ5PLH8cX48ipx+Pfhu/nYRrnOh0gncM2D
+YOZ4dDVPPFEhEfYh6h96ihUwns2mM6BWfBhjAdMZBoYhxjr9y3gGwceBnE8vxvLP17PJ/GyCjIG5OJvi74bn59OfLLFhdH49t/E424OsJxI5n1JMLvzwYvgJ
+ChEQ50Qiw6fp9bcmU+zBa5AH8BrEHrwGuS+vQezDa5AH8hpEz2voeQ09r6HnNfyCvIakJhb8VApD6wQWPYWhpzD0FIaewtBTGHoKQ09h+I+mMJh/YQ4Dw/+/
+/o3w/989/+60x///zvH/r3v8f4//7/H/Pf6/x//3+P8e/9/j/3v8f4//7/H/Pf6/x//3+P8e/9/j/3v8f4//bxm7HvrfQ/976H8P/e+h/z30//cI/XeIZIaN
+AhR7pmDEXh3fAPTJiH9tqL9FnCWdmP4teRx+EMfpScDyd8D1I4h/UsP4c6Q+3qH+CE+kKX7RVpr9iR9sbwdLlRch/I1L1gJcyA5S0twX+EjqvIKJCw7DWUXu
+mUT+tdiUucpOyFoOAS+R5jztQL1rkzCZ4cC1qP0bL4Efehc7MxG82wi+5CTxbbt7bkPPbei5DT23oec29NyGntvQcxt6bkPPbei5DT23oec29NyGntvQcxt6
+bkPPbejTM/T//Qr8j5e/Vf6HV8/fvGrJ//Ci53/8fvgfLwfP5XV+X5SwM2wjgojJXH4rIoh3znw1EUTYaueyJ4L0RJCvIoJEbk/+HfpS340962N8Bplo343l
+ED66mg1HV8kePBK5g0civhmPZAjQerT2dnMTklZygvXKHEBOUL7ORC4D/oWYK4yxANcSQsV5QC/CGIJxqmSWwgcl+gBUWUH9C1uSw2Xclgr1yjhiiuIyiAcx
+cpHm+k5V8MP7dbpg7TNN9oLYxV6QHewFduegOx2EaO/gqeIWQP8Qx8Pe0MsQrC5sUHQv6gMLoHPnWo0EkMsw5l2MB+G9w09lPNAQsC4XfDh+NcbDQS9LlJ3Q
+hWFFLPxn8FDnnAtTPuJV0b3K0a4wm3s8HU0Sugan3E1ZqCXMJoOQFJp9WAN867AVd6qqtPu2Wqvc/iRLTQ3gdXq8OiGgNqAf08U6kTcuhuq63Xi3LLY2LEP7
+g5S4XDqvOI1LizSvNPYOuBboloDu7+Y6ST0ob7mdV0YrA2llNOwcJN5KK8NwRyu3TNS4ZbKVW2ZbWtwS2HAH1Uxsp5rJg9eh4AwzdBqfyD0YZkDOOfNOYzdd
+nTsxXATrd/Gwr1if7z0EfPjyq9Zlsbld1+gUtLNZdh+wJCAyTR68HSShhP1tEormPEZxncAdOjYnoskekl3sId3JG0qaof8tx0W11iI0cneD27hHkkQr80K0
+MoIWKkcngffVJGFczBowDA4vYF2D8jg9EY2X4AcZfKMWVVHC3pPmtxr+wE04xR7APbskJoWjEerSoPNjXcCj9BycxFmKvrGlRuRAIpEJkebQFZUu70tdRe4G
+j1nq3g/x3clH5Y7sVZF9zopSv7XgH3w5wV7ObYTUNUT7KOWNdo4yaj6UTN4+RgVDCpBYpT9bzzGCuk2z0/yEph9gtyfQHt8g7G1YEWwntgvnVvkDi7xaxO6o
+qEr/e8sTq9PQfF9ZeFPUX/ty0JruS1lzXwZqpnshV3EizCKl+bewO71twbKAYEmCflLwkjq7Bp6+K5bYbTjexYr2bj+wIrTHwdMJ6xYMwJuiAHtA3d2vM10F
+u9FFEt7C4tTIO02EWi6h5EQaXd7RWIcj0J9X6k7LXMET8LAlt6Kt6P4unllzBAnF8KvFuii1N/S+2CWrYX5XKs3vLIx9ubm7kWZdfHnbzp8hsnNR+u7/UpQ4
+jxq2JFZRFfaAsedKepeSlxK+NQ7iTaBDBWOHgFGVK3BcbnBHj8p9fOtMQDDGSvUFF/C9SnPoykSocrFOK9ub0iw22T39qfPbUoH9hMsH2h7Ku18XjWaLLc2W
++zQ7FPr4VoQ2E1YV595b5E9m2cZUpaMhq3vcfPJEms+6WqwJkVxq/WyZ3uncYNAEx5T8vSkFj2/tyD4msiru/d+8N6AknP8L/TbeFKB5fvG+5RvRWzz77+7T
+zJupS1WpGuY0rWSKmC6oiYLAqoV0+hY3SfzT1e468EGVjvOzSMvFxvgflKKtLkSAe99vxPPt3JEHQtz27NUt7NX1QB59dJF3S5BjhlXM+4MGppViXE7G2a8K
+FrRr2mCF0S2lJC44SDUWpVtWCdtjbyAuQnMZ/h9jtuH3nTQh89aZhqLLNPzJ2ca0ccsvypK67zSoOviXtD+/U4/CoturtTYRLEjBCrY3bVX5f0b0qAX0ggux
+370VrIODJRo1sKN3PG4qDpPGz7q2sx6JqVJRD3dzCLEb/VaBVZc6/Nu3PGqrL1tAuNwk3jYpJV5lgeGZDuTRzDFd69a8I62udlXQFtG8eaT6iJBmYKV7qpuJ
+QWdoo6U/K38TdNxbaLv7orPjTFWUcDEnk8tthg1+pnfLpLnrYWtauJMeL6NlkacLi05nzPIzrbLAAWyyy8+fxC7fGA1TV1uqAOODl63M84hbXlr/TAjhq1IH
+RNuqKGVH3DvUY7sLJu428rnsyedd5PPZtyCfwy4sLNFodjDvvMjtdcLxzsP9HD6W9bOlhQ/eUoVgxe9HBSeG92WRPwPIDfb1KFA/3WyZL9b6ThsAgdojKaJu
+eh+OiMCD1hHC+MbOXYAQP0/HLcom3RR3QKwVbsPWLULIpBjNFgMXm8xb1wqBR1WT+uznUAyCrQFrmwbCW6KB//QNeg1vtt+q1yT1mrA+hNYu20pV9g2IO0x8
+dYfZ6Z5Ct/2lyDZ5pdo666qjpd0dhEztNlK1d+zoB4RQOHCJL55c0dopUQXfHcw+y7vGp9TyLs1TU+nSyAff9nqnmwRp3PiEfTwhBtzXdFzPi97Ciw6+UROd
+Zs6j/afj1UkiVMSVlv/qXOmzCADcfVR0kJ9j7aYG8VkeRHwWx9ezyclW+rMrLuY7t7+E+HgQgVk2CMyctyy28pYbGGCHg+Yt5ZfFltVaFa5W93CrI1rXels9
+ii7es3wC71ls5T3LiPe8a1zE9sk1kD+tdb5lAPksFGRa8ZFkA0i4+PTBbhtNdjQbW9EYWz58qmPwPO/km48iwGmFW+qvjtXJnrTqKOQTGNViK6O6yBmZWu4m
+U4sDyNS1BtV51OJgHrXs4lEXueimUIeuJA51S7q0eMolO3a+MrLTQ8uFxa4rV4NnhlAX3m9Ks1F5dIbA+CbdVGrhFuY3oFKLQKWWX02lFpxK7QIJT2VRizob
+mVjUyZNo1ILRqOXxEUORyY9U+hFGWHbTq8W+9Gq5m14tMNK0B8eaIkB7saxxu7meTWhsvp5NLRybWn4DNrUIpw5YMz6XX1iXuHPwxdlNtRZ7Ua3ldqo1wn4q
+sZNlTd3pvsCriSVhec6Z5US7cHW0mUIRNCVyqQxOADvaxnVDGydbICdb1jnZ1To85PaAOvogNAhv46ILK+POSjLdjGaFdw6MaAGs8AVme9u+htGVLDaVUwOB
+iOYNd+OD8ErZ7ctvHOdZukir7NGGcN2/lDG6rASZbNmj5aZEbq3EbRwkq1lKnS+L0ljuzmPbNpUITj2BDatl68DzQ93fl8V9CSspgf4gJojEd9s4BVO3tDi8
+3+FBkhBM4IB/HlooVu3ZPvdoI4EyLFFCGXbXQbLNknOnbFfwJKMB8B9F2S3R4Oax5vvD1QI94P1UyQ7rzXeOtDSLm0dnbxQlXzIwrq0nrZfVKcoqEXdwrmGv
+4r0RZ0ulPtv2y6Uui1uFbg3lo9Ee0cVNKnIzfSk2GTj15H2p/7pZpotUZe5ntSnzByNRdEkSU2tTuQjOv6c+giWif4U0gmTSCOLrpBFkpzSCeIo0guyWRhBP
+l0aQdWkE8XRpBNlLI/TSCPtIIxT5IaoIKPDXqyL0qgi9KkK3KsLeTtEyvm+I2Lj6Om0EEU9j2Wsj9NoIvTbCf7g2AnVZa6Av8eZKjayBTh2dL+AcqOFVxBfo
+/mWpVliMcxj67TnNbdYeatA7Xebg/sghBsrpLR8DjgT5+wFwLIcOC0hALWAdqDsCyBQQ4rqvKGb64k+JPP3+u+9P6LyeFXdasJqKlTz9/s0pffnT5OOU6Xpf
+lVrRJnP6/fdv2E+Y2jxtpx8D5SV+yPfddQ4rwqiMlc+acYz+ZIxziiKXf95kj/LFK2z56QmOjdFc2aA2FHhPtceVtSBKnekHlVeCB/RjJst5ZAKoElHm1n64
+0W47Wkq1sPiqoLxdwgIpcEHxWW3H03vemL1TQEXUN+EBRwhgphDZZioDpwAeM/A9Ll5VwnunFTqP2maqaLWoMvUl8OPYYmTB1mZgW/gzg+CDjcdU6Tdc6i1O
+B2Kd+1bUT0WoMMZysYiRO4Wt2yJyccXnZZvmwSX6U3ulk17p5FdWOmm8p/f8k0c5/W+6iWwIWqJTHq5Z7VREkbsUUcRBiihymyKKOEQRRW5XRBEHKqLILYoo
+4jBFlK1ZI3Bih7YxFEcYOMKG+6hDZP//nsVWvP7Hq99O/+Plm9dN/Y/TXv/j96L/gT8w8tXguZzklS69zVF/WIwsMoKCwI2N6SScrGBmrNLyjjLOuFVkPSV0
+xgkbBTaB8aeW8MEgeAasvdR4C7slO79XQGN4jESmvjzq8tkiI4xJ6aPMFKJAfqtsWbmftRFp5Q86Y5HymYWDhGhs2HvgH7k8UuZZao7kjTKpCUULV/Rt+hAZ
+FOTcu1VoSkpeZULgf1XpMlWZ+3gp/DGTlh0uuNrBwJrbfFfh8EYGT8/M+bnxbHTGQKnNJqs8Mp7q3gSfCriItKmEvd3fF8akdF+/xvB1o4PJy+M2TyPErqH1
+c0Yxs1fTNtzSB+R6w1lQWJVuO/BkHayLbKlL2qLte5i1Khlv2tJbV4JJdIdi/KgwTGAwrrEtRDiMqhXhomzt4fgtCe1Ol84AJ3TEL2vIQCsBAsBGVWXOcjEY
+f07sHR8Ak2u1MRUC92kJNo+xYuOdixDeHMXVrTwOoSjND3K6KRtDA5V5231l02DcPAq63nhbB+16mP61xF4+cVqBD/quhVuZemQhVuGOZJu9YWd/D7C5cTvL
+Uj8UC/ImOYPQSLPGwGSpFfmIgnNkB73CX07Aw4aEpwLHEWxgvJs80rIeyEZlKjOFNHqxKSPDNbjG6qUU/GVMESxR25cLlctSO+yB70eFBFTk6bS0YpFpVSLC
+1abK8M9ZoCP3+zuzX6Dnxd8fqc9Ho2feFHaF4F7k/gHDu3TXtjwQakTBEzlGS2kgL6ALFlvmpPxBfkk/p4M2kyqey/+1Ksr/8s+1zvTQmz/Id7A1YL/n2q6T
++tRPvHu5KIVl02yd3KwTl6xfsEv85iDappu/9WcBWsXKtyeunzzuymjBPf/8299XiPNQ4IRI5I1eqE24UTMXgR8V2T0q//zb36u1zmmT3YQLfKlvN5limGh/
+TYzWIXYUblTsDUyDE9W1qp1ETuavg8q4XQav8TQSA0xQWqw6+hx2flOlzlvtN5YVsxGg52Jipus2+IGhO2fEI4va6eE7VOVADoVvM1RPfm+6lLtLCxdVMp/p
+Rq0sbhFTxxkCjZafwSeGcL9Fmd7A0h5mcLO7XXM4Ko4E3zcSxOSrUkudL4pNqW5xXw7eY9yzXWOsAlAAiHWvxzDtxVMWpG41Ag6xUmOTQoh3EcWZOZ+WLrfg
+cZzymyAwakHQnnxJLmLoHO683sm22/8lxK6XkMdH8Qeo1xahF7FK+pHgnvAbHUQ99FJasnpelSgI4vL/+LBWs3es4yeMlgVjlbaH3OWZoHftdqg9Pv05ywmH
+OMvDQmlUdaNzvXKiG64AYePxNgZ/pz43xvbCH3vBKeAMZt5QwbZW4RHhp/Kff/s7F1EbYMByaBW+fOGE/W2zAkfR/jVHSYXS9Smu5dTEMmBFWddia3mZfBl5
+qUXzJ6nxImyIGs4qXeIfJPS0bOqWMS+KC5hw2CRtIejLDucM83G1vaiwS2uts2Ud/0bel4baUTzDmehY+ztuUSBLfOp1nufTjp2ICsnQsrSYumWoU7bWiRpk
+1mhP7/RStGuWEdGbIsHbpoGdPdFJB3BDY39lV8UiK4zOHoX37/Erhj+TkhaFmFqveOp6Ihq9g63bpPJHnesyNfJMVQpmo21qKJ3uqNCIdfElEB/Jqs7Ujc40
+xrQXqtK3ZO/vOeCsrOh+5Nbki+Obk+PTk2fgSHb3mm3dayGanqRyFZFULhxJxUn2FEbHef08+VDdGI3CdiuYKfe6DFZGwsONKOZx96BZtAR423ArXqUZMtmZ
+J9raORCpW2Ranp56uHprnE0tC9x6ilye6YWN3j1PKPgmajMnjQ6TENGlQPM4MNih1wLAzXUGy7UOfy2Jhu8ZCAGLssVI3DrxyZYJhBs4TwRLmthYfRSEbXws
+g96SVz/BlthQKG0NSzuXw2Yn/I7tFXvi0K+VrWmZpyT3VDchQjuazCQ65dpp+qLFcGhuhE6ax4UnqgINwaiLRVcXP7oOlts62J7RqmI7botJHW5+IC/jf9am
+BHdsToLKzHGqzQl1SqvQjGh08nog5+iJCZIs1vPDx24PxRB4KWHNYNN+hNVGM5jeThLDbqZkXtVSPsZ77JK5KvFfRjtEXRLjF2A2CmDTlW6ztuQ/vA7cNc2X
++HUjkVE4xcR2/RYZ9FtC4e0CLnC2iS36LaRgsuW0sINgR5nJVoZzy3sSCcZxlpZ2l/7+zR+//+N45F5gvIEdVyHuPksV7GLYUE8K2eSLNIN/np6KC1Uu1rgj
+OmAX+ZGD4IbTdILGWp6BhUW4HXSzWGi9xNSVRn7RWebR/VIbQ8HR7JFnw/aBxkcyH+ypgToaAyH+ahmhLUukXYWp7YpCEfy27YiW9toa9hx5ADWm+S2zal+g
+VTtfFPcWlaf8TkZrcyDE6WGaIi2H+FfIi3g+LTERY8URwXx2nSIi9Y5LOyxpURU/OH0NKeVw4Nc6LQTae1oftYqnBQlmpjk6UClvZyjw3UDy4srA2wDKaF5U
+VEPSuFKQ/E33Eb2PUm94WtSebp4ISds4sgg1/J5EJWH8rN84184ORW4QWr94R2tzWLleeUm4b3JrV5r0BdsqTzsMwDfH6oSI/Bd1wYG3QbkgFjaAg7L44vQR
+wgHvnNFG1OjFXbPoSeIMVjY03tq7WhrpLSwLYYp2LQ1nhYFVR4NUFZauFWAxsmBWqqgKaPFNSknSozvzPo3hfSMah2U4ifYqLFjJYpdtvufFwSBDTdg3qg1/
+iDrU2ZAvjtXJ8asTmaMGpl2fpnFP9PP39UCeFV9yU5Va3bGk6QMhhgM5RYWDplgd7LhNc1aOkb/fYL27X4pgl3F2g/R+D5XLoqPGHQPWwILvtbOD/j5Cbpe8
+C7icxi65h4ASEwWD2geIPW8DAdpps4KHO2aK8Le4qki67u2wOcLEMAFNZmQ9z73veNtJ1prsHCC+X7/BjmHkx6ZSWW2H4+jeomQAEVOVG4LQxzEDu7w9GxNt
+Peu9syIfqvJ7evdsSk3iYFwWFotPWx4n7FT2Ncgz5G4xxWpFFD1ULzLi5jFpUBqtL9zzzi18D+drxGZVJnBd0twbJy9hOZ6eHA9PjtMTWnQ3AznlYTy0Ti6K
+0gMNg6UeydqleaVv6XruHAUNcFbjzgHmBmnHkNGMj9+Dk3bx6K+++JL+mk0KxQrC5NZz8ZbxpGOFBRsKbxdyirZ00dzSuZO0zaUW4nJ0QWxD1+NBKPc47YKJ
+4h2DZJR8VJUzvxk8Knh/Ovq5uZO8rHuvd/XO4xbxKI8D9+GHrrUdfFn21F7irYOoz1ajS3AJKacB5WOVj0w7yrYPrdEOUS9R15dCJTGVZY5droyuQRoD/NEz
+l/zri05tL2bjv+QnjhxxMwy3hx2d05Q7qvsuPCBB1G08cJCHde7PzVOv/rHVqHa84x/wOC01xvuiCmW6soq4TsmhsQ489rax9ZFOnsOULiKumcWHoMdim3/E
+O76M2LnNJZ0J7IM0im1+cLccx8qkThcEzzBf4clbds+QUqbpgCsQW92Ixo/wV/QdUyDyZJ7aKq0//rDt6W6xordC4JOgd1GUcv14r8sszb3aa7Of9xHleIvG
+iEXmaseN98GL7gG0s8pKIVseSeHIWlYlODIg7b1uxKrrDA/s3vvoIsWF2Sr9cxUJdTU7KunYRV9svwqy6xrGp1s8X82QYVXYRdpyLX0ZbDyjqtSsaO0xs42r
+p9Fp3rkIBIW6rJuGxHQSp/RiLVLsGx/t2rGBDMR7DmxIq5Cth0v3dLT8xvIucOkp0TZdlSydeJqVQLGoF+KT2DA7x9jhVXWyaqx3v12FTc+JLuEOY2kioSRf
+vLh5rPfw8XA/IRt2RLwiN1C3/24gxE/NSNgslhXc8nzsfBY7nc8kjrfaMpmjO9vpCXcpRcRe/TNFtgXinxLudwleHTyAS6ngZDOVIr7DfVFGR4LNgOM4kyGG
+4G1XtsDa18xbjADa3cl12z6Vu8pCK9JcqPBxtCZwcW8N3VVryxN0BYiDCpDHzh5MK8O9l651JyI1zZenjXPB9PC4t2j7zuE6jY+X2He8olcdCLFtk4zlkciu
+oFBZjFQuNfrIaSpzOknnVt8WS45XkM3SsiOE6Rr3mhAJ0TlbkzrhShUrplSBq+uaaK3epPc6OU5hElhyeXOH6rjGtJOu2w9eAEJjA5V55sMZjFYl8qLBM0Xr
+2r+dPaI/p3QwLHSZdwI+gpistWMTEjmCK62300Mo0LJH3W7eGkYXcVNQXAwOq3KxVnlluzmRq7TKoY8Rckek3MUmU96DRQ7tNF+Vqc24lQgbW4ZiM7peuYbJ
+pQafBxyHi8WmxFuow1eV2j5VylCA0GWJ6YHc+xclzl30S0r0uZhF8aBLPA4k7fDBcDOx5Sbcjc46UL0CQPB52+UTivD+H+5lJhxE130vzWVeWHlf0vXg86rO
+kBNtpLjjHRiIXN9m6S100kmMdvFsOrwGRrw491FgyCUxPw6if3lKgGr9s767zzDljh+8rDAYYVoUiOUD4C3B7oqyTq2T0OB2r5soth+cCfacTPnXRelVIZBA
+YdB/g+uVut2LA+Adco+GurmiWLeC1Rw4AqnZb6qE5+OpIvxUWZDPu+s+EQR3VUjS51rhT2bUnRCMqh8AcBzYRCCPLj/NXWEqj71BNbKf0ztVYRtg1RXZpooa
+Ck1z1P5VTKJg+/gb3MchEIIPMCWcQZDCqR0lDinhLKQQPNHbcWY13v8H55uyx+tKpVk9jNMV1cu7ZOVqbe3Q5aFdgOZRKIjDoZsHB0xjX5y7Vdl+FG9Q0zOt
+ZKnT3FQwMD+gfzCqVipuEVBFPguMTI1cbNDrGRySlf8Ucz+luXz5XC6Rf2Bxj24b9Za6LxBy/rh784sB4fjsIRSaydXxGBN1sf02Fxkqb0DHMYToiNnO3ESs
+ZFxnpDxUSKP1Z1lquG3Z2YQv5Ntfd/6zhJnLXc2rVYmkBgoDtMdfaTSdEdIeBSg7NHeapUUCPN4F6/3+nnIdZmf7nVpKqWPVpJpkklNLalNKapTmxus7XPPk
+yr7yMZfIN6dqsb6g9bJFMaM1jsIhRSwv0dIpYVl9k+DkI8FCWqBA72UZQU3SkAdxbmr8mjHW2uMN8AJWDAiWfpqTkIIbdcKf1PJ2cqkusW9wysvFY1dP3I7P
+Ntadq6sjBE4j78dD1A6UqkhqCWd8lClhETD+DrlLQyM6vY020+gmW8pMfYHD9NGLRDsbp+7QEe09s8346pIpagbkSc1BREpFTJToRjcClQfq/qRVpPDjWC6h
+bSGLiCs7rt/AmoeUshQQbbmQ2X6j/dLN2W2aSSjq0Q5piPWH/FddPehI8rH8EJy/m1KLcAS3KRHVVyp81Tw/lk8MOjbYAczGgwMscYEONGsSO2HShzTTt9Ye
+S3GTCcldvfXPmyjotOFwAR+69igtmBThuhdps3A8w2AvEY+0arBHGzoVSZOYC/2CEm1QBr2KJX61FEigDucCrrxYgbHZiC2uGI/+fKFNW+5q6AGfVXdwJK+C
+Wxi+202JxeW5tLt8jBKkVJ8h5C9Go+duXpzRd2faecK9nu1echgWb8jh8WsVcpqqXSxtq0PboX+Lpm2jgCJLF6k2TBPCsXtr/CL3y0QcIr6xh9iGiPLEN6Q2
+5DapDZw/XGrDCY/tQvG36MvgCZL7V1iKGPZSFc6V3DZpo3fYetyL+LhnvhfbXw1C3R7aGnD4Y87OTj0N3Vi4e8pqtMyFgfhlJRX+pf4j/Y/58Nnpb6X/8fL5
+6XdvGvofr77r9T9+c/0P9LYPs/SzlqeD53I0Gw9RQ3g0vbgAneXRdPZxOkNhaTmZC5RLBmVj+X4yu0D567Mpql9fyY+z6V8mZ2MrYDwfz/4yGY3nA9ByvppN
+3l1fWXFmkB6WZ7Ph+ysvQOzLwPrHcngph1dX09nl+NOz0fmERJbPSVb7w+TjoNFOYSufU+mTy/fT2QW1GmWV5RHqdR/Jd8P5ZN58Poh4B8loMRv/OJyBzDZq
+HPMybW1nCfXAZD46H04u5ijojCrIKNzspJdn4/n1OciJi/ez6YWcXM1BankghOerelXx4+EcNLQnl6AmPT6f/nQiJ3Nfnby+PBuTVvPVeHYx973Z7I7rd+eT
+kXT9e3wEqlRHIPR8ZD9DOqmrluq4Go+uSMV6NP34aTb58cMVvN8fpzNB6t6xuPUAlalrotFWB/zqAwzhXA6vrz5MZ5P/zdrOdKep2g+Td5Or8RmgDT+BcPVs
+RErVUDo2Yu70trEC3xkfxrNxgsrZwxGqcsNYoKi7vJqKd2P5bnp9eebE3eMOsy2gLvDy1j/CyM+xSPicKhej6eXVcHJpawR1eBDenpyN7bKYvocnZrYVQ6tx
+jhrYVOmQFN/PJjh/yeNwOuBMU7ysHjl92wfSF/NJnC2lKeRbv9dlWiwRNpsas8G4SrW2ApKl0PnicZEV93qZqiQWOLTJKCnFe16lpa4eJRqLHklByd1VVuS3
+wlIc841LtlfPkIFUX3/JQM+Lu+fXrviY0dth8++Mzh6QjEHJx/XdTYaO2qpg+fweNEHuB3Joc5bTDdlfaYSStT6LhPairNmBe00/BH3JmANez8hh2uWwj2ol
+xcNUp/M69eL4AwiTUlfel/qZ/jk12HfYRXygHamXMqdb7isz3hKXDf+/6TdiRTcnlblP5F0B/y/vU0qR/qBLg5832aBlFXOfhLop0+Ut1QO3XZ0bz2JixiTa
+cc1Z5qEYxD6tE5AVxY4TKzphb5Ht4yz5OIvDxrklzUptTBeDcBU72sXZwaax0CdTEu/MXedRccuBPKpluthZ4Zd14SgFvkJiRh7x2RelEfIJ6aElTMMI291U
+BhfN9q76PPJb8si7XNXXAT3TcMRkT8pT3ZaLmjFz09JU0qgsCCHGmarztvSwJuAlvFrJl1yXclsiatEnou5KRF1+i0TUdh8zniZUHpyN2u5wxsM2KSe1k7/j
+DLT6rgjPLPDXyyDA75LQ2xTudDQYdABa+qu9oQM5zKXy95+g86v+KXh1LDl3JZXPBq82y7Sgw82t1GambNHy+i2vvvxdvUZLP/+HpQEeiOG/V97eJUtf6SdE
+jQ9OalZkORcl/xnOlOxR3O+Z5rdFhrqe6lcclOp3a5JfQQVFS6WR83e/DhDbO8D1z54pgkUjRTBq2kh+XnZ2m+uVQNf7g6hTILoSDcXta8v1K56Q61duzfUr
+IrhL3IIDZ5/Y2vmhS70/uSOLL7yCwGtekTkVgRiX5G6SHlkDe3VuUlMRrKtahwGqG5hy6NzLfO/aLxlv884XMvLKrRl5H1yZPi2v2J2WVx6QlretafXcvPLg
+3LyiKzevfx/E2tvME1AQ7vGcptea8DDK19vIL1w7yNpfRHxdkuFaHeKwF5GtL4JX9F9m6250yhP2cPGkdO0de7jgCcEbezhmQqu3uGs/F4f2ypb9XGxJ+V5r
+j9m5t4vde7vcd28n4+7bbN+iC634lO17z7OzrQc7dnIR7eTyK3fy7gxSe+3kjXW+LcP6wZu6qG3qrb203/4uWp1DkxXqZv5SdiC50hoLgyVDd58l8dwVbXnN
+ZWdec6++XNtAbaJisXe6c5a9CJWY8wf96JA1ubrTIspvXktv3pUTPUrV7bN5i85s3o1k1I0DyyeatvzPR5cusGXqt00Zmyn96D0cR2vuirVP08S7eWycSJid
+42i+KLXO8b7peW9eYbz+qD2VISgzZ8mzO/N2y215u8WOvN2NY6xsrj5VSZ/PW3Tk87Z0l+AJBkCTQqoB9zraB+kZD5JhGbple4ZurHVLwYIKflJWYDUAiWc8
+rqKkjiu/an2+yLZEnyzVpK2T3sseAyaGmd9oU/EcNH8weJvP9PJWS7rKs+FMc9jlHom7HMH7SS98yQXDWXLM+NKOh1+kUW6f8KehO8wIgkM/Rdjh3uepcLgV
+yyijvHiPjCuF5Ssrq+GI6wljy/vWrTR8UWoT8p+GeXWvHmH2gzclTemgwTf2J4Pj18RuziRgdZIg8GBfJxGEF4GXth/FlXplCfioWqfl0iVpKllsQi71St3Z
+6EuaPyiHqbQqEsFRWyF5bQNj9tcNDVKtZDJebYrh4dwlhD7/hImgx2cQeuTRyygrtEtnHZJXQ7rmLamJo+ivLfEsRMulj5b7JNOiPZt1IscTaIVrLzTLJWDe
+kakaimCFxgF3iLuOIR47nfl00jzU/K1TNUOY1j7Eo/cucTLG7kOA/2oqhzAaszPMnf0pRPnxl+LdbDwcffDtDS85uZTzMWaxBpz5HvmhxQH5oeUe+aHFvvmh
+5T75ocWB+aHlv19+6H1st7bE0aI7cbR8auJo0UwcLZ+WOFq0JI6WfeLoPnH07yJx9DJKHL2PyzNKIW2vjXVFq6fmkW5lDDwxj/QT37N5AxKHv1d8P/klXnDR
+J8r+5RNlL//NEmXrPlH27zdRdp9at0+t+6um1s0pWa4dVxx+xyffh8XTmTJXHpQyV2xLmSsPSZkrtqfMlQemzBVbUubKw1Lm/r7z2vb/Hcj/efGb8X9evvju
+u2b+39c9/+f3xP958Wvyf6xrSXw188dVOxc98+dfh/kznbGaYDr1VKCeCtRTgXoq0G9KBdqdZYVdUFg2QjDoC5M6zQrsT8H6E57CPIJlkdvRaCAQ0ju9fNae
+W1BgbkF5fIRlpPnt0UmbpsQ+Lyx67lPPffoq7tOtnzFajq1uKh+IAKlfp7frZ5l+0Jn3EDutdFjHRhgMbtQJAfnSOQkC5QXBQPUu+oEbsokIlmzP0OoZWj1D
+q2do7cXQ8geU3ifLmk3y133+E2zrY0gPKWcO9ySvcTW/y1T+WVduuZlBI/dJtEOIehaUoPUbpWssSvmQKroR+LrtmrEpTQRCGuVwPhp+TOS7i0ki5+P5cHSS
+BHBWLZ97VFrAxtn+FfxbKvyLvgEj74TbNwOCbV3oxVpRqrBZcCbPfRoV31HfukdwmGp9IYuSpdcQ6lbnlX2FD6osH+X74mc5BAXhx0b3YFAhzFxOnIhZJcdH
+KBrqLO2jE3xjDiSuoZBbgHHO/rl5lKffyev5yJPeTk9fu16+njNN2OGiQhQAfMFSVqa5vWdwiTVzQgbPTzRscKLtGpttUk9tRnLjdrF1cD3sQhw+3Z/VhpiG
+cw71j3+GuXerTxLROdXdprHfpPZjJzrHTm4bu1dtYyc6x052jF3PLu3ZpT27tGeX9uzSnl3as0v/E9mlmEPE25ii1Y/kYHyy7rkhDAgvr+Ayrl4gN3V/RIBS
+RAGkudm/fHcF6Y5A/lndq/xk0DiXmoxZcdi5xPwx9AHPNy1sihXydzlcDTvLDmHZik/fmi6457m2lWUrvu6M46wgsZVlKw9k2Yq9jrE9WbbiyX3dwbIVe59o
+u1m2Ys8TbS+WrdjOspWHsWzFgYfbFpatOPSc61m2v1eW7T5JBhN+RxAte7HLOWDpqAH3avdfYw0jZeDirlySahbx4KhjSNPI5qo3g3VjdGTh9noCXIbUinzU
+3kqv2t7TiXs68dPoxNeX50h8rJMxr6YOcwIcQcv+s/TMJIBNpu/fj2cgXTwWiGtBxBJiRTw4aTYGbiUQ+xA5AgCadjYmwEtG49klgI6g4ovh1Xg2GZ7PE8fP
+TBw7M0Fe6fUVEAk5lTRpoW0KRtus1Xw1uTofJ/JiPBt9gE8JB5XI95OrS+gV5ChSB4yuz4cz8fF69nEK1VxOLyeX72eTyx/HF+PLq8QRDYfv5mOLnTkfIm/T
+NQ8gUuPR1TzxjNBE2Ieof+gpVsB4NpvOgMP6YYwFTGcINwPk1vQv4xlAmgZyPr0Yyz9fzybzswlSM+fibIq/G56fT3+yDNLR+fXconxsD7KeSOR8Skif8MOL
+4ScoRAB6Chmjn6bX35q1ugeBVB5AIBV7EEjlvgRSsQ+BVB5IIBU9gbQnkPYE0p5A2hNIewJpTyDtCaQ9gbQnkPYE0p5A2hNIewJp/99W/ufr34r/+ebF8yb/
+80XP//x98T9f9/zPnv/Z8z97/mfP/+z5nz3/s+d/9vzPnv/Z8z97/mfP/+z5nz3/s+d/9vzPnv/Z8z97/mfP/+z5nz3/s+d/9vzP/wj+p4PcM8QfcFIyBSP2
+6ngBgD4jvpbvGe6d34LvyRoutje853f+evzO1z2/s+d39vzOnt/5r8rvtHD7RHYROUU3kfMHeZyeeAJnF0cz4nUiLo8TOxk9ExfiH+GJNMUv2kqzP/FHq/d5
+SJUXDAVXlB7nSlu4kua+gEdk6oJvicOIwZWGoiCJ+GuxKXOVnZBnJOz7Ms15wst61yZhrsC9LF1o6Lgbn3wx9C52ZhJ1G6GYa+3uCa09obUntPaE1p7Q2hNa
+e0JrT2jtCa09obUntPaE1p7Q2hNae0JrT2jtCa09obUntPaE1v84Qqvnf778rfJ/vj598eplg//56rue//l74n++HDyX1/l9UcIk3kYEFZO5/FZEUO9R+Woi
+qLDVzmVPBO2JoF9FBI18lfw7dIC+G3vW5/hMgEd8LIfw0dVsOLpK9uCRyh08UvHNeKTDpbonC203NzFpJSdat8kB5ETl60zkMtzAibnKGItgQRM02x3IBMML
+dpSSWQoflHhdVWUF9S9sSQ6rcVsqDLpy2C7FUBBXYuQizfWdquCH9+t0wdpnmuxFsYu9KDvYi8w8pusHcEjv4KniFkh/EGTE3tDLEGB33oW9qI8MAMa9XzUS
+YC7DmHcxHoV36T6V8UhDwLpc8OH41RiPB70sUXZDF4YVsfCfwUOdcy5M+YhXTVcAR7tOwQiNp6NJQtfglLspC7WE2WQQUkmz74tzlDtMxZ2qKu2+rdYqtz/J
+UlMDKJ8er06IAgUQ/HSxTuSNC4G6bjfeb4qtDcvQ/iAlLrfOK07j1iLNK429A7dgMmjRP91cJ6lHhi+388ppZSCtnIad069aaeUYo2jllosat1y2csttS4tb
+QrzvoJqL7VRzefA6FE2GudyDYQ7k3IbxNoJQb5XCXcX+MszjyLed+pmiKrHlQrLwBYawCveNg1OlLB4IOtkADuKPYEC0MXSxzx45c6Hh6/DbZBxDT8SNXqiN
+a3zmKJ6AmAkwRPRE2tY9aO+LdZ2YYE8gMy142KM7PUMu1vobYRMJYWn0z+BMTavMXVkNIeK0R1/A09E5srITr36dtDf18EqihXIbYTL9SHOuh3s6hnuKXXBM
+8nwenXmXuZsrzn0aWlr3PYTDyfq471NtImBNUbIpjhdvnCn3ZaoqBNGUxeZ2XSPD0ulJqHTkuJp1em853b9/WvFqJ10+YX+bhAKPjzxaylj0x+ZENHn0nSC6
+204GfdLEsGwxnKq1FqGRuxvcxsKXlEwgL0QrN36hcvTseAdbEiaXWQMYx6FcrD8X1rlovAQ36eAbtaiKEk7hNL/V8AeaIyn2AFovJbF1naCGLg16rNYFPErP
+gU2apejQXGrEuyQSQUhpDl1R6fK+1FXkI/Jww27LAN+dHItuP1gV2eesKPVbi8/DlxPs5ZxJQF1DNODS7qb4ETYfSiYXLRNFQJq5WKU/W3c/cuxMs9P8sqMf
+hD3ONwh7GxYjs0ns6r9V3nQjVyTRaCuq0v9+IMS6RZDB95VFIEb9ta8aQ9PnLGs+5yBS4l7IVZwIs0hp/i2szWNbsCzuFIw2OLfBte0sfHj6rlhit+F4Fyuy
+YvzAitAexxYkmGq4Ct0UBVjG6u5+nekq3KBc+OetVBLNqVInQi2XUHIijS7vaKyDMegtNzjhcgVPwMNW5gVvTe7v4pk1zFFaB361WBel9leeL3bJapjflUrz
+O8sqXG7ubqRZF1/ettOZSfanKH33fylKnEeNWxVWURXW1LIWVnqXkmsZvjXCdiRtzQrGDoHUKlfgbd7gsRSV+/jWXYbgWlKqL7iA71WaQ1cmQpWLdVrZ3pRm
+scnu6U+d35bqAQ/qEmbEmpV3vy4azRZbmi33aXYo9PGtCG0mDDfOPVh8Ms2yjalKJ8ij7nHzyRNpPutqsSZcfqn1s2V6p3ODkS4cU3LSp4RzuLUjCwjU4t7/
+zXsDSsL5v9Bv400BmucX71u+Eb1FK/juPg0wzqWqVA00m6JpeV8WlV5YvIJqkV95S1YN/Olqdx34oEpHwV6k5WJj/A9K0VZXXlRhM46M7O4deYA8/F7HpVPH
+5a8DefTRgSesXgGzDmOBBWhgymzdSL2qKliktWlIFka3lJK4iC7VWJRuWSVsj72BYBbNZfh/DLSH33eyts1bZ9+KLvv2J3dLpI1bflFW3uhOg76Zf0n78zv1
+KCzro1prEyHYFKxg63NSlf9nxFZfQC84XMTdW8E62Hdl3MCO3vGwvzi2HT/r2s56JGauRz3cLemA3ei3Cqy61OHfvuVRW33ZwqS3MM7ONiklOnVAcOPzQB7N
+nPJJ/Uri1EFWuypoC0PfPFJ9pA9gYKV75QETYybRRkt/Vt4n4rRYoO3ui86OM1VRqltnyrnNsCGX4R2UaR5ZDOGkx8tKWeTpwhJImHrRmVZZkGRoKhidP0nB
+aGM0TF1tuZNMc6hsVTeK9ItK66kMuAtV6gC+XBUlxXObYIVQj+0umLjbBI5kL3DUJXA0+xYCR7ALC8vFmx2sbVTkoO3TEDQKBQ2Ze8TPjxjKGX7Cd334mrNJ
+LJqHVkciKwTKMH6HqfQ9mY6ZVmX2KDJ1ozO43t2pEo+kKODv6C7UFpIFMfKLLi0WoAb8EwSyeY8zXwHRJIkDHnJRbLIlMeLKz3opj67Y42QAfVHGPwL+T1hW
+4/wWLsNQ3/xe5alZEzFGRQIatnTnumgpXHhHnfOvDo68eFTw/ODo1A/8FlGnNlI8H3O8QjJRvz0qYTNhP7UlElG6LPJngH3DQR4FdRW3A8wXa32nDeDSrZkR
+qaN4D7WIsMvWzcskfZyjCqelV7wpyqaiC55qWKtcqNw6fQkiWMPNRrjppriNawUxh5rqQn5fiHH5Nax/0+h7S0pLP32DXoMKxbfqNUm9JqxfqLXLtqoB+QbE
+HSa+usPsdE6h2/5SZJu8Um2dddXR0u4OQjGkNt0i76zTD+ggdygvXzwF2rTT2Q2RCZh9VtoIn1LLuzRPTaVLIx982+udbhJUSsIn7OMJ6VN8Tcf9J0oPMVvD
+Rd7+dLw6STgacC9NIvH71iQ6ixD23WdGh4BFrDG7h9DDNoEhcXw9m5xslRlyxcW6Qu0vIT4+SXAhCAVxbQWxVVuhAat3HAPeUn6Vb1l3VeFqdQ+3hgl0rbfV
+o+jSF5JP0BcSBwg37BoXsX1yDeRPa51vGUA+Cy1hhY8kG0AK/6UPdgNoajqwsRWNseXDpzoGzxPYvvkoAkJduKX+6lid7ClfFIWm9xV/KPK67sN20SJxgGhR
+rUF12R9xsF6R7NIrKnLRLVUUurIh+VNvbx7dSr5eYMk2WPgGh+LlkxocaRTtWCTR27Tu16JYOVkKbhVhyKomPST3kh4SXbHotzbutG+k+5hA7XSaFKVvjfve
+turkAKkiEfMCScBgGxp2fnICzX5oa7VoIjG8ygnfdtkAUN/jXXzFeWJGQAvTIqdFDBUm8tFOLgLHP7ZKyARYgatabK06ehxmX60dkrdDHKcnFhBBIiPpiTyG
+Xx8Ng1/DYVB2NthBB5rPOt5I9ugddMFKCSyfH+Tx5ASPkF3WxGzi7IXW6hoChVblifXW7vPnrTyeTE78OswLgDPusCJYBc3zhh8EoqXVe1oVvI5vdCo1G/NW
+HE/82x9iS7QVJr7WqlABd+YgBAcP5V9O4E5S/7XoMn/ZEXGg+SHq5sfWSbHVCBHs0TZTRO4/6GKPQbe8uXAy3TSsktAgsbdtInfYJqJmm7CXPkRWkb+RqJNv
+41FPdtx6yOMwjFHEkVM12AWWHarc+R1Qddje+01pNiqPrpRg7iV8RYlvJU0li1L8ytJU31iVKtklSyWsLJXslKU6PmIHvvxIpR+ddMlViS65KvkUuSrB5arw
+ZK3B0No0q+jk7VKuEk3lqtnkX0Ghiqycukae928c35wkdYxSbJy3KljJLQpWHJC7S7xK7iteJXaLV8kgXgVeHdtobu5XbGcVYPM/UdlKRspWTUAdvwyU0XbM
+sbiyQ8+KVrz7AheW1bjwkh5WfarFGonqswWaBGZcLpXBVWGfMq6LjNxbCwuhdfYhN9XqSPLQvO28BzQx1aO9LtlzdMegtZEP2K7jRsK+htGVLDaVUybOl4iG
+YECUT8Wm7EajJKJmcziwMoEQ3b+UMbqsrEGaPVpKfBSYTYTdTSlFUil1vixKYyUDHtv27iTC1MIuzvZTYffTJhq4WFkCOr1bbYUmEavYYfsT4eEwnGfMwTHF
+6sltJGy05WcrwyKEyPFfcskG2xW+VCjY+7sjnKjlN9881qLXuCNDD/gAabLDLA1RYMvuvnl0Fk9R8iWzwxoJVyYnpVqUFUBnKwS5JeQ0x8kDoVW7bJa6LG4V
+xnSUh1c6so7gXiiKFn1xQdD7Uv91s0wXqcrcKVEbnT8YiRq7kvQiNpWFJIUxg/43TJyvGaXC/dtqCTMuCo9oCB7KaHWhgwVb92kcNyMLrG9PhH9PKxBiz1HX
+rzY7X5X6TH08lluUjd4VtncP6DaLVdm4Y5DPTIHbNQaooDYYcdoG2vD5YfvyDiwQKdKmsmZGPKFriAbSuVIZze6qkJoOJXsLCRZKGJGwrdldbftQbBkGuD1E
+sMBvo3J4cX11PTw///QkucNA4f2WcodWV+4rlA4lUzoUX6d0KDuVDsVTlA5lt9KheLrSoawrHYqnKx3KXumwVzrcpnTYffC26RsGC67XN+z1DXt9w0P0DfdG
+JZSxp1F8G0FDG8X4BQQND4nhJfU3EV8hYdi6MkUvYdhLGPYShv/hEoYr7LJWGGDiDbKaUAV6hnW+gKOtxlAQCK9elmqFxbiogz9xUoyP+fX7Tpc5xIDyBwpH
+e+/ax8AcQJm9QDGVQ8f+ImrOsTJS3RElooA7/31FiMoXf0rk6ffffX9CJsisuNOC1VSs5On3b07py58mH6csseZVqRVtMqfff/+G/YSle6UT4mOQ+4gf8n13
+ncOKMCpj5bNmQPNLQkGKIpd/3mSP8sUrbPkpuZGN5gKEtaFAR449ga1RVOpMP6i8EhHYIlLxOI+sGlUir9iaRDfabUdLqRaWURNSX5awQApcUHxW2/H0Xmxm
+whVQEfVNeMD5STvcDHjMwPe4eFUJ751W6Elsm6mi1UjM1JegDcQWIwNwNmGvQZicCGONx1TpN1zqLS6Fwjr3raifilBhzN5hAWB3Cls3X+QSjs/LNmnCSwzK
+9IKkvSDpryxI2nhPHz6kCEz639oH6m4erYNb2Fmw2ilcKncJl4qDhEvlNuFScYhwqdwuXCoOFC6VW4RLxWHCpVvTNuPEDm1jyPAwcMQGDlE6Zv7/60mi/kf9
+5/VfX/1W+q+vXr747nVD//X1m17/9fek//pq8FxO8kqX3uRqgbwSoIuANI19+SQYFmBlrVJ0XyzDJmJ9X3TEC4ukMUHiRi3hg0FwiVhzsfEK9kTy+Flfg4ed
+Z+rLoy6fLTJCzJUeqUOROZSdki0712dtRFr5c97YcFtmUWwB0RK2XvhHLo+UeZaaI3mjTGoGTaW02/QhsqfIXXurStKMZFUmxHdVlS5T5VWull45TKdlh1O1
+di6y5jbfVTiwpUHjIXOBDDQNnC1UarPJKk8Fp7o3wbdk44XCAd8LY1JyV1wjkKHRweTecoeHEWLX0Po5o5jVr+kUanNfERocCi1Kw+R3rHG0LrKlLumIsu9h
+YAnEnFqkMbEUbaEYPyoMahjuFtgWUtiJqhU8Fy03qexbEr2b7twB++yUTqwdB60EpjkbVZUx/cA8e0ysiwMCuGu1MRUy1WkJNk/xYuPdxYCGGMXVrTyWqyjN
+D3K6KRtDA5X5q8vK5kO9eRR0u/OmHl5rYPrzN2cgjqrAB33XwqVUPTJEhnAWic3fvLO/B9jcuJ1lqR+KBTnTnD1spFljQL/UilxkwTe0Q0/A383AwYgKHwWO
+I1wB8Gr2SMt6IBuVqcwU0ujFpozs9uAZrJdS8JcxRTDEbV8uVC5L7aBKvh8VKi6hMEVLKyxbHlnr8YZjuWM8kuNuPQIdT/76TH0+Gj3zNwFXCO5F7h8wvEt3
+a82DgoTAKeavbHwpDeQFdMFiy5yUP8gv6ed00GZSxnP5v1ZF+V/+udaZHnrzB/kOtgbsd2JqFM2pn3g3e1EKKx+xdXKzTlyyfsEu8ZtDa04h7/TIAjyVlW9P
+XD953I1ZEADjn3/7+4pLFziVTetQYB4SPyqye1T++be/V2ud0ya7Cf6LUt9u6Mi5eWRt1bV1iB2FGxV7A9MQAela1U4dOfO3YWXcLoNeDBqJgbw2dYYN63PY
++U2VOme931hWzEaAnouViFy3wQ8MXbkj4ZSonR7tR1UO5FD4NkP15PYnn4S7s3E9bfOZHArKpiW2GhVeYgLWzVKbRZneICFsmMHN9nbNYfQ4FHzjIIKOKrXU
++aLYlOrW+5vItQ2btkvib6z6cwCbdi9INu+fsiJ1qxWwt40aGxRCvIuAlOyiurQyOvI4VuIlBodaEA4wX5J/HGYT99zvFJfZ8w3ErjeQx0fxBwgbjsDfWB/9
+SPAYwI0OApZ6Ka0wW16VKH7pMP8+oNfsGuvyCuNkYZul7R7nNiCQbrsJak9Of8RycR2c4GGNNKq60bleOYFJV4CwWAsLqLhTnxsDe+FPvIbwbtRQwXZV4fm1
+p/Kff/s7l84fYPR5aHXdfeFEnWgzAEfR1jVH+cDS9akTY47E34uyrsDf8jL5MvLPi+ZPUi4rk5B8Pv5B8t7Lplo98x+5UBEHWNPmgV78cMQw717biwq7rtY6
+W9aRsuR3amhcxzOcSc23v+MW3Xl8KZL88kJfiRs7ERWSoVFp0bfLUKdsrROV5629nt7ppWhXqidRMwrrU61wPvtVyeRQ6RNchWDXWQ94ub1P05x+FOmhw6ON
+2ZnmNi7D0T4dqIX2YaBAPrqKWlTGGyLjXlxc7qcqnog2IXHJhMS5hDhud0FGPG6xiOStt60+2+rItgA8uLG/spvRIiuMzh6FdyjzSx1TLG+K0NYmo1fHS0Rj
+UmLrNqn8Uee6TI08U5WCTcA2NZROXgFoxLr4EnR46B6D4lZ6ieELVelbumHtuc5YWdGN1G2FL45vTo5PT55B5MLdJLd1L4Xxx57qeBUpLVw4qqNbBgVNI/oQ
+9kXP8FE3RmMWiRUs0HtdBrsu4fFt1Au9e9AsPJepLwb8EKs0Q7E8FvqwliWEhheZlqennnPQGthVywLXVJHLM72w4eLnCUV7RW3mpNEZHiAEFDwfB5E86LWA
+GXWdsVLkSEnoryUp/XneXMBzbTHLt058sh4DPxO2EcFoF41NjxTGG6z55hbG1NZDKgrOd1NN51dtp2F6Si2K/PGPcSJy/ga+LFdlXw+ab8Pa7XVhsQMtZIAO
+kqVdguFoFP5891rGMUTCCvq275/poGFthnY0qbhkE7ULGIqd+3XCtJZdGK8q8MYQzQzRNTPCGbRtXliLjjwO4rzr7hVcBCAt63/WppF/bE6C/u5xqs0JdUqr
+BK9odPLnAQ0/E6u1LkI+dntoqcJLCXtdMu0GT200wx3NiYXaM4COpWVqgBKYMDdAOBqWzKeN/zLagWmTGOcDs1EAlbz0TEeiyOO98a5p7MavGyUiAptHbFe2
+lUHZNhTeLm0LlpDYomw7ECIbbDvk7CDYUWapbcJx613OBHc6S0t7uHz/5o/f/3E8ci8w3sBBoZDDm6UKthBsqP16VGzyRZrBP09PxYUqF2vcyB0AkgIOQYrU
+qV1DYy1/zcKH3Ma/WSy0BuCuUEZ+0VnmWWNdyU98QP6RjE27T6LC6EAIp0LVskTa9anbbrOOv9/cjmhpr+01kCN0bJYUdgd6gXeg+aK4t4BcFY4CcqoIcXqY
+2mqL7fEVwqtey4p4zrEWq2DO3U551XrHpR33LlEVPziVSinlcODXOi0E2ntaH7VMtIISnqQ5etqR7cwKfDeQngFYBjJgKLpu2pMgcLdFsU8Wr/C0qD3dPAmS
+1lM4IDjg99hepKHawEKundkctF/wJt/m0XS98ZKYH2QOVJoyLrRVnnbYq2+O1QmJ513U5freBt2/WBYQDsjii1MXDAe7i1YY0aaj0TJ7niRtaBOpxFt6V0sj
+tcJlIUzRrkTpjEbiTcJwVIWj/3rYmCyYUS2qAlp8kyLgM/as7NMY3jeicUiGE2ivwoJRL3ZdJfa85xhkPAv7RrXhD2GpOon7xbE6OX51InPMCmKXpml4E/z8
+fT2QZ8WX3FSlVncBgg8TfDiQU9QDasr3w07btL7lGNVtGoI+7pci2GOc3yS9d0zlsuiocceANegf+7oL3oHPwyMhiy3v29jT6q8LN7/6jwKD6uBXFnvu/o0q
+Q1ewmDJXM4j8O96bA+FTxOcv+Xzgep679CYDpFQUjGoUKEZ8QIjQgzXjwx3LRvgbeFUkXa4uOClglZgAPTWh7+qz0HafNak7Zys/vN5gxzBlgaaQfW2751SA
+omRwMlOVG6IQxRG2utQBGrzW4W11wVTlD7jupZWaxGE+LYYen7YiCbBt29cgZ6q7yhWrFTG3UQjZiJvHpKEXYCNHXunGxl5wJkcCNYrJpae5CBRpBa6Z4+HJ
+cXpCO9DNQE550BtNtIui9KjkcF2Jsh6keaVvybXinDwNJGfj4gU2F2VOpJsDPn5fpg9q8ejdFviS3kVCCawUgEqs1+ktEyiJFRMtcKRdEzo630TzfONxhTYv
+dIhi0y25jYqDVoHc4+gn6ao6+fzFQH5UlbuDMCxl8Nx19HNzW31ZD/js6p3HLTrUnjTiY3Vdazv4Ia0Js8SrF+mKWLlvwdWonZy0j+w/Mhlq2z6r0N+qDy7q
+UtUoSq6yzM6jhTK6hn8OWGnP3PSvLzplwtlF5yU/fuWI26S4PezonKbect2B4+E7om7wQkwprHNarVZ8bMudQh5H93kftQF708Yu7zYGJPPxpoJB86gdMl3Z
+PEpOUqqxPHy0oLEjkhK/w6UvIgquBVmhN2eb78j7Mo3YufslnSpHQQHVNj+4oo7jfDZOoAyPNl/hyVt2B5NSpumA562yAlaNH+Gv6DumZugJgbXFW3/8YdvT
+3XKHb4XAJ0F4qyjl+vFel1ma+xxBzX7uUgfjUrRv0WDzmSSsHo2fUN0DaGeVTaBluWiFI3za3FKRkW3vvCNWXWegbfeWSJdNLv1e6Z+ruuRnraOSjs31RTDF
+jKpSs3qsWXuxRAweup2TMoh/BZW9xEnAWSsa2+rjuDtW+0BEiUbSKmQf55p+HS2/sVwqXApKtE0fJUsnsm71dSyUizhiFjrCgaN4Hk1WjfXnt4+wCTlxZqYs
+y0ryxYubx3oPHw9P9prDVmYgeP8nLIdoVdQ7pDaUXoQJCxAN4x++8g6Zqo7FdIUifM9Z/ad0I99yNaCeudFtwsc8q6/oVC62U9nLMJekhEx3gM6ALZvrXC/3
+gOXDXks0bzwtyyg1oZ9+kTXT4hp7ecjVSu66Wol9r1Yyulo1ZpINLVgmX92o6MiuseuG6RVCyVnb7WUfCPFTE90wi1NobHk+DhGJnSEiSh+x2uKFjDwspyfc
+8Rspb+ifCa0kEM7a4SBFC7GUCkwvUyli792T+pY3Tmwue6cA4Fz7lLPKbgOuK/Yp0BUQSk5zocLH0WxFfODWWH+1tkx2V4A4qAB57C4haWV43MC17kSkpjEt
+uWduVfOjcSkue3wv2PZR1/Tu3mSjPdZ2rdh3rKIuGQixzbMdue5ekXXrpN456aTUGMWiacyJkZ0GRxs2KF49NsP0DmyEa9xrQphF1l5NloyrSq2YqhSurGsS
+aPD3Ta+Q6PT8ge+dN8/ljjt2uyJKu/kHnBZsoDLPfMCREYRFXjQUE/Dq59/OGoqfU9raF7rMOwF8IWmSvWQlJG8J/hZ/iQzBequD4GyYVnyOiJuCWrtw3JSL
+tcor282JXKVVDn28wn0A5SUWm0z5NUIhpzRflWl+SwogwoJWoNiM7v5M4A9ODTjQFotNiS4Sh5QttX2qlKEAocsSU5u797fifRhBoFPLLIoHXRIUl3b3cH0w
+8f1BOHeDDXV4eZ4QlbLLJxThT1AeDyJzq8sZkeYyL2waK9Lg4vOqzvUWbfTu4x3gqlzfZuktdNJJjF70vHD0UUQMb/dR4HonMdMb4vN5StwY/bO+u88wXbgf
+vKwwGANeFAjLBg4FAaiLsk4Sl9Dgdv+4KLYfmgn2nEz510XpJZuQC2fQuYjrlbrdy9ygg2OPhrq5oli3wt0t0L1Ss99UCc/HU0X4qbIgW7jrVhvSUfl9LrTC
+uzxRFEow0ZkAaOZAVUKPdTkR7wpTeVAfwg5/Tu9UhW2AVVdkmypqKDTNidSsYj4c28ff4D4OIUt8gKnWDYJsXR29ZCFYzjoKYU69HTdcU7D54Byn9nhdqTSr
+B1y74u656BAUrrW1Q0OPdgGaR6EgzmxpHhwwjaugXhfJfYo3dBerZKnTHPZ1DRnITgdxtVJxi4Aq8hmsJdw1NuiSD97yyn+KeevTXL58LpdIJbM4dreN+vup
+LxDylTvvzYsB4bLtIRSayXWRmabCYnsIPjJU3oDUaQim4+2C+zBZybjOSBawkEZrYObDfcnOJnwh336zFSO7vXm1KvGCSxepdoQEjaYzQtpDVGWHIF6ztEgd
+z8cHfFDKi4eE2dnu2aGsolzSsKZn6KQM22QMG6W58foO1zzFWa58dDRyHKtaVD6olm3Rfmq9iXLQH8upvnSqlVapK3igSaraws+hAgL+W7dBXejKxVDwa0Y+
+bg+GwQtYpT5Y+mlOkkBu1G06LMxNq+91vmRhwMNQ5z6ZInb1xO34bGPdubo6wCo08n48RO1Agat7nCzbh0AT5kPg75C7FNqi0+dt81EBxzJTX+AwffQJSpyN
+Uwcbivae2WZ8dQnuNaEzpEskIs09Jq93oxvx9QMV7NIq0qpzhMXQtpAt15Ud129gzbvEyK0XMttvtF+6ObtN/Q/lqdrBR4tISc9/1dWDTu4lFtKD83dTahGO
+4DZNvfpKha+a58fyiRHxBtuL2XhwgCUuCodmTWInTPqQZvrW2mMpbjI2oy/HBfMmCjptIveBQ1x4HCVMinDdi1TGOPJosJccVVo1hAAaiktJE2YO/YL6qVAG
+vYrl8LYUSPArF4iovOwObVxeeB6P/nyhjZ8IjrdmgyZHfiSP5FXwrsJ3u9UNcHku7S4f43iXBczj4B0Uo9FzNy/O6Lsz7eIxPpPBXsJOFhHM6U5rVQaO9i7B
+DZuBoCPzAZq2jQKKLF2k2paE6kYd1CH3y0QcIiO1h2yU4MSKpmiU3CYahfOHi0Y5Cc1d9KAWpTQ8QXL/CksRA9SqwgVQ2iZt9A5bj3sRH/fM92L7q8GN3kMl
+Cg7/21Ldr7coQ9UX7p4KUS1zoVeG+ib6T8+fnf5y0k976D+dvn71/FVN/+n5m9OXvf7Tb6L/dI5H9qhYgjTk6Lk8HTzneqSz8RBTBYymFxeQTmE0nX2czjB/
+hJzMKSsCJDCQ7yezC8xycTYdzwV8/nE2/cvkbGzzFMzHs79MRuP5AFI2XM0m766vbA4GyDAgz6aja8gdgY9jsVj1WAwv5fDqajq7HH96NjqfUBqFc2zA/MPk
+46DZRFvvnAqeXL6fzi7w9wITJ8gjzMhxJN8N55N5y/M+TQdLjzEb/zicQSINl/9ANBpucyOw+uAjyI/gm3QmP4xn4+vLs/Esob6azEfnw8kFZFgYUloEzOTg
+cjHMxvPrc8gvIt/Pphdx7oXtdYstdcMtyzswipX8SFYBJfFHBmWxIq9ZnGfHwr6cbCJSNGq3hUWRr9Bhb9kYIvZqzSyltl18IfDfLZrIqdlab60ovuQaIUbH
+mL0BvlVZlqDgFn53dEImZyzghJ9F+k1/xCiUD9dhcYlU8gjELI8AUTlykiNQLJz8Zo3kMl3eqVznFd6MsjT/Pxv8Bo1DJ0xSSGVzgtMBKpjZFWUgw1/aEw6/
+tIszkYtNVm1KC7kxi9RCrgTpUR0fBZG1dvkfdLY/xprmWpUuOlFKEvsSaAiGMIa82aQZ6RC4VFAJT90pvQgLNiSxQkMQICz1MkjXKyNXpdbktnPXVAcCQBMh
+SLc6wgR+54KQXG6laUhhn+kSUbbuCaeDbAcMbArf19ppE7tNj2iCd4V1JKZLjZB1qbDZtvdDshEnBx9YfmKf0XKdRJjTW5XmLFUTQYY1DIbgbEA3adLSpytE
+c8ouPm/i2rQKRUmhUhwVE4KkRjugbEFNt92Ez90VVfqgmEaHv1Fjyqx7vQixAealqkl0EHpT54alniKUr08liapvo+dYvrCLwmaNXq3S8g5WbN1xjxN6jbcK
+s0aXhbLLEG1yn4uzZT9hKT8Sjz5NM3sNNOEeCG1i6u1Y1r3LtcDmcS3nh+cwkXWPAblML29xZVNmctGMBHc30k8wy8GTdNckDTVBl2kr3A2vPnpOQJmw1xDm
+aFt1AzmkquoKsfhCUKTTmSXyIwGyYvklJ4RQlDLX6e36pigZOxc2o+76YTPd0R0YryUgdUBGOwZzDXpFENRafpaACgGIAyPXai/IkEhPx2WuVOTxB9EUu217
+3GaaDuQdQ9Bb7GO41/pThk4XOJnsorPV4mn1VgiEjnqovE3Qi2h5V/Q9nTf+VKCF9AdDkiLEVv+sc20AN3tPIlVpHloM6FJXFI0kzifYdEwlNzkKC+B6rdKQ
+wQ98vuzAShx+WXCMvPLIjnDfo7zEeGwTPrVZt13SpVq0kpzdHkYniOXbCv5KD+kgHNFusgUOA6wCF3il2dzFDhaHsIPlbnawqLGD8yUDvXsViJrOPezCGvxu
+4WADYTaflsByio0pygDus6HdpXsxB8Z5gPkU8SuSSCClrHF8vWRa04ALCLw4RyUUQpL8iQdud7ybcS9HKMOf0MfondV0yPk0gpJ7hwJ9gjwjValC7oVE1LNm
+ukNDWsYwBO8q4J+iXz3h1lnCNB5xwYlN7p27aKoSYSKR6kblS38SGhTnRlVQRCutfKV/MNsPH/iIpVB2aqrgb1Yb5wy1a4HxQoVHdWxy+pMh2PXPqaGFHEjm
+qw3aJm3l2/yRIVcyHbjpiWOoVros06ooMVtKYFhj8mnnK7xTP4ODX/gkWz6k2shjGuYIb7RtICqO45gbTNOYCMpd7ZKZYEfjXLUP2Lzk3BzMN6i7gnbzfeoW
+GySLFjWLcXsugHbbEQ4MmEGk8E9aEHcFTZBgMJG9QpMa5Nf8JKScCNjN+KU3961yGTQb7ykktlBziKlKZqq81Y77C18tdVWm7lYW5p1Y67R0qT3sHmHspLfm
+gpcIxP3CticKPbKdndYFzcRSmwXFnhK5UDkm3aJvWGA0iR2NtB8WpYAtp8LJoHxehGVqys09bTD/Z5PqSur8r8XjHQsIupTYoTeEQpdkhfAb6zhm686F3ttu
+rYT6rUVI3qssu1ELFAchlVWbV8I3IYxYwCXLGy3+ulne6iW9Ivauz1KV5tqjf+s5N2h78jBOKj3gVu4tPykE0WiNNbfEikjKaV4VqOC1ySs+EbZ2hGTw96ZR
+7d85NdIU7j3rO6pTLy1o5lKETS+tOSJVU4xBkKgcgdHwE9mUZwgOAb4va3t0sn1ZtAk2tO3Auyzr4/REHL7pyR2bnjh405Mdm554yqYnt2x64imbnuzY9MQx
+i2Vpl6u+K6lYWBc1kM5o9Fx8oVTDjmrmJxy7fnWuUldhrGILNX/NMoUtEtOLkFIezyDHHkYAaNhB7e8c9KiRVC7KlpPmNmu+MrqxxBT+29TvuRBPRDYrHNd+
+6tvYD90tJenR2oD21rUg+FpAVlx6wkmy+xkqnRnV0MCwmcgXDgdfWgWHbRu3iDZuKeWrQaw/EiVltniayyKOz90TvrbG8fX9rEoXnvfGHfzpLTsQ7fHRLo4j
+Ddud04JYFovNHWWou2EnP0NJ0zXeA6N9nqStMGixBQZN3bsF8Rw1eceyPwjuLLbCnSM/oQcwd6OexS7UcxWDnmUH6JljnROB+/mWe4X1NLYtfMJ6+DEMCQ/o
+nuTxtNALKIfOLk7OwWbPQSeipR5jiIRbbRYV1Hbnaw4S5bxy1/19D7iBfE9OyaTllUTLKxU3zr8A9QXJFRu5NglX4Q6j6D0flgy4Yq/HzDnCrfiWhOCzvRct
+vKvMbnw7YB+iKuIViMWsFfxILje0Wwf6RktextSQw6wUjab+R8d/z87Of+kA8I78P89PX35Xj/9+9/p1H//9Nf6jIKc8G/9lfD79iMFDG4gMMVmX7f0YJsuJ
+EH+x7qjTwXM8s08HNYHs08HpQB6NXLilKI+sjh1eIlqF7EJmFu3QwvSw9lmM8VvrBrvgoBis8UVco7SNPGIKeovi7iaC9mo5dR7bebGqvqhSJ4KAPFEFlKIC
+bDXJqzgmmOVJSHoaPUVJ2G7su7FjlJWBTX+JTUeQo2+Hazdw5tobimbczUlcJ+J6jhcnbe+7SjNN12q78TfKpG2z/jsR14CmnnLWbDjJLG+PO/9OB68G8mj8
+s15s0C3AB6P+wlE0kGk/zpGPLgCQgCW+HsijCUw1lckz/aCz4l6XR7t0EnEIVmlpKmuSuXcXvv6mPHyUxvp08GYgj87BRUOROFenopgy0TOpy7Vpvl5R+h4S
+toeouxfFkoIct/AIiya05qX28mKng+8G4Ubm3z82Uk8Hf/I/4gOwVg/OvvRxE7zqJ10eCU8PdOaYjTLjBdfT+WlYsCTyYLtQPfhcF2QyENIUPLDWocpIz4si
+f9CPHlOOb/D9QB5FM5APNs0OhKtAkTLMNTuRVkxvQPCw0ZAw8TDZnfqG2WSVcdB1rhyQyKXONP7DCfRzVJ5ooaoqKnnraivKoJARvSGJcQzRLvvCmmhLM42L
+8dayo/VLTJZRW+lE0Le7bu0m1BothC7tmqSng9PnA3nUaNiBg4d6QBX4ztyL4XJx7XUBN0JfaFVTComaA0eSVWEaoVXsFzB2Jn6B5vIxuGny4gsGmZcyUmj0
+k3jrPQvuVNW6IPrRAu9t8H7qHtkRG0P1GKdnI2ztYZ1K53qzJ8QpnG6su+qnA2FGGr0m415Lc0G7VE3zsHTjC26lmxPuDHB7ibIxQp+2WDhlIHJsuN35FM6y
+T8XmSB4XJf4FGBzXz7W9WVnPcVPL1iZGYynFCUbvuluyrcPNwCQec0SrsvIRM0xNCwme80dXudu+86osMpP4xVBkmY1MFegqdemIoL+F/QU1CVOvtwpQLr1x
+lMgj+0xtBMV98QVelSJ7WJenqlYFOWKsKlCpPW7+TuXq1vt9cSTodcI2ffPos7zEjgJnPhA+Zp3eE8gLme0ql6t0VeE1dKHzShy/fv7/8kJOxabyeY0RGY4G
+GwU5EIITFclaRWfXiyAe/CN6lweBWWcFUxrnO/2S0tDRruyyo9gEGHGiXa84MDilmHSUBp2ug+XSphRO80qjLtiGgrr3Gj51K7RqbU5DoVhYheJnXRLF3uHt
+kM8/CAFjT/OpvQ0uxs2soXvvVPEesBO2bwBWo9HaxJIxI8EMB+YKquQOGOG99RqjmjX0S/OkOWaWjbP9Tiwgpih99u2aEelU+4w/x5RkxpWNax/fuB6Kdm7v
+e/K2Eom5Ji41WymNtgkfVk1TL3HaugkRKGHzS6iHrJqQTrCAxKICVjY0ZJSVNwC8YDgcoQ+L4FTYs4sGQoChfhWSJwTRl6CaYWBVwETxm3OpmYO98DohWjSn
+KRm8YQRN/VB3ActGk0WkH69owVgGTl0Qtnn4Hy9PGhnQvcjL4BRfA5jcsDTclM4CJc32wg/y+JTiuuHMR1FpnWlHMdxyNXpB4RHupjQCN1KYNT+gXx2nDpuY
+3ddC7zSvXaxE1xN2ARAmg1llS41pbdmm92IQ3Sp/s60OL3W8JZ067LTLid9yl+PtfOL2hltMbXuLr/1Wjxs2GTzGost/67YnbBCEsv5uci+gh4l/Ez4p6hti
+884ofslNMqntkltcF/y17fuprMg9ojTN+YoI8u7sOe8zirrNmQfs6RO2OeOg0j5c24MF27c5rLW2HeMOUhvTrjcTrQP6lvbdF60Ole6CBe+ytv6Qe/fH/sfE
+C3dMwJ8dJ4WgOCxryN5nRPy2/nwQ9fNh5/7/4uD9H0Pl/gyItihl7HmwDAdCSz+/hTEUjfNANs4D/xq1S1Kx4sW66dx5LGyfHlFRbefEMSV24iu/48VOuKwQ
+nixv0QX4co89oc0FFwfxxH7vQIfZy1pq9mlQ02JJHU4Hckhzx/Oy2cUWxPHzlpaFwz/s1415GiYlvEnwJgi8F6NSGapX3DT8GWnOG0H3aJ/ZiH3DSrrRrC1L
+ylu7yzJqCC4KEpmt/5C2DI1SJOF73WyjnxfEkPfdtX9PRW3CMkOmAheWtmdfc7LUHTbKsLx0j0g9oTCj6Cyi8UppLlWLwC9deIPQtMXJLDamKu4I1I8rGY8I
+V77+mbLjsun3YtCIHVw1Njc/28gAkGRjBAG+4CajpKnWcSu2Om7xSQ8DCHXc6CzVD1Y8Jz6FldPU8cByFwNh0HKvCGg2qDTPoBDOq8tT+3kX681j3L7QRy8H
+cubiu5eoT2wVsKO5G8SLXUCgWLW8BL2oE4nW9rZsGvuZncr82Vi808rHFiVlMrWHgo2OJ6LFZrTt87EMq9pvd7n6bHSgRuGe8iRoe6WECimx8n1FSjE/V5C5
+Hr7mGv2Wos33eAt0aNzQWKe/Gsgh4RPc+cGyZ6C2jBuDLWKmdubltgFblxvtZTaPdKVLPPhDkgc8CAJggkHQo52K3kyETeMPAYdTatwQwzDaPPiVFRDyeW4W
+a0K+ypXGZiUOqPKYoHJjUaJi21Lf5RaYFbTBuGZjVZCiQ4mOLNYoOr7j7mCqWY+2fctCmoK2cnffKr7k8kavVbYicgj2vPvITdrGuLrpws/IsNHCDizSymuN
+ZY8EMLGD4XbcvftAhD5Apzj0bZAFQlOduhr+6VBnNo+1K5L0olt8CPAcnUSR1WhfMIxDmiOY0Quwt/ZJ4xalDIlOb7KK6cG1vrKIhp1mOrwQvi5bSK9rZkix
+4ueUNZv4YqpdAVuiEK3xytphL1pWRos9EFLkut1ysS7Q4WRd8+pR2O3KPhSEoIIAjkcXelgqT2piL2TWTyDidL+NFcwzOPrGORxsrTNE0NioAJxdUW7Hu7QK
++3KFcEi78v5gamnvGxuQt9rt74xG0Yhq7dVu/AFq8yHUxqth/LQYJk65JPRl5nowWpUyWpWitiqp/2icqKTmkGD/t65AgcD7x+4No7FZdC5V0V7GE5aq6GzN
+rqXqtym/EkV9Jb4ZcG8FX3LWroq8GRSqgBsUgVlry41dk+AqKPaOmMsWLzavVhmhJJzxmXaEXoTO48spRDnUZ4nZlJpr4kcZagWfCTb1sDVM2/YRD3t1O1MN
+7Oz78xVcnS71F76FzTe5vEgXZWEeTaXBiTfJFwOZmige7xa1qfQXVS4tMPXRie1IiBODuJs1KHP9RT5EjWGdSRM+vcMJCf8/kGOw/ewDTgZJ3KYPOscVh4Ql
+YMXDsLqfEabd6xLFaY2CbvPgJSwbOtSDe5BvVO6d1lEeo6qwHsB2E/cVXAPGnkhb61Q3SW1OeNiN03yjvZtx68WqbYhFS+Ccz9WadeWH3v463DtCuFvY9CjL
+9jnlRNaaKzvEPq117JDhTQf2fVms05sUhk+m9vS50fCv6OLLukC0wgRop3BIkI53ZUsslC4chLr1LeUB3Sr261a5o1unAWcdZokpmGH7S8yQvTowSGe5a2ht
+ibDZ/9JdgvWST/yf1jr3NoTKO04Y+MEXvFQWYR+HPYPZDiQp2xJFqR8A+ZKIvyHTTO2i4XdTUrb9AQPmpcZs4vwliTrsE4xgKh2NwmwW0ARPuGBNffNw7raq
+kDlpQNTMITrtTTjuXbOcg/jmpD7MacWNel6YR/GQ3bjdnqBRez3w0jDjGci9WCmaTxAnmv5lPBufyfn0/dVPw9lYTpjGC+q7kDiMQ3F60RvpRW8S+dPk6sP0
++kq4cqGO4eUn+f+eXJ4lcjy5+jCeyfH/+jgbz+fjM9CXmVx8PJ+MzxI5uRydX4MUji9Fnk8uJlcoO5MIJppz9WF4hbo0bU1+PxujkM3Z+P14dDVP5MV4Nvow
+vLwavjsfJ/L95EqAEs5QfhzOriaj6/PhTH68nn2cwivN5OX08tnk8v1scvnj5PLHAVYzvryazMZyNpn/v+VwLq+m+On/53oIujoCYK4fxzNUyLkcWRGd9rbB
+e8lP0+uBnH+YXp+fYdfUfyig08e2/aAfNLnE383G84/j0VUCBchjUDIiaZ7J1WR47oC3MKoz/PkUulqMppeEv53OTuRwPr++GNvWza/c2FyOR+P5fDj7ZLWU
+cAhm44/DCRY2ms5m0JLp5UCQOlDrDJKj6eX8anJ1fTWew8SAAb7EpkFHe2khO3sG4nLqJIfaOgF6a3h99WE6m/xvLjEkx/9rNP54xedjaA7N8TcDeTWeXUwu
+h9Rm8YbgII2rSiNFivc0WKH5dqFqsU0bG7daJ2SbL8OPNqUmS/CmRB8XOZAEk46+0YviDn1AFOKlLYZ+PpAfncypvTgk1lZNS5mragOAY5ccSaW5THMn8HGj
+Hwv7um2SxDH3zooXY6e98Bclx+9yIZZI2QejvrjzWRjbUi8yVSqkFAGdDn5lCeTmxCtHtLs64shoq+Uh4huOL/DLurjjraUAGDYuNbSPl0SUVQYAfGWVLtJ7
+lVdHJwAg1bee6gzVsu8Z/MLJqXTEUCijh6g/n5rai1kLviXU7zOCsOeFtcIbPXES5U50OK/s0Y8PhyRaiXYLmUXcW232dxRGwvs+dM0alnRc+o7TTqPR5o2O
+X/DEg3hZJ5lYzR3hK85T/WLwon3+JghwEG/sorJuZTwL+YCEhX1fFkjtSR+0VbWKl7qdDfrn+7T06wb7gepwVdzrMgWYphXkTVd2ffPfCvoR2T1ptV6W6ov1
+3OAsbVKNOqahnfGiPh4upr7JU9TiUhkN5v2mNBtraCmnLBpEO7FiwcrB1f8S76748g+OYs+2j9rgvBmcQk1vBi9cQBaGU4M6htElE8NFbqp60JR9Aqmv2aOf
+gtbP4bNumAhCgnVaWkVVRM1h208juB0mcK3QExFterxAOkm+GzALBE4qL6Y3EIJOoMupHE1mo+uL+RUc/nMkvfivSKHw6sN4OvuUyJ8+jNH8uZrOruSxN3fE
+5fjH88mP48vR+CSReGAP4ZifzugM/2kyHydy/mF4fg5Hf9J+7IPu3yeBD0h26CfOHPBEnCme2vXz1v9ufv0R7LGZsAfz9L2cX48+kLU0nify3Ri74XwMdhD8
+4uN4Np9eorwg/HNyeTaZoZ0C5spkeJ6IyeVocja+vBqeJ2RQXM7H/59rax04SUJnCHwYwuuPZ7vsQfcc1HsO1szH2fT9BAy+8+kci/txOj37aXJ+nqBgoZxf
+TT9+HP44hj6++HgNVbwfTs6vZ2PQNLwYnr+/vkQzx/cFjCX0uuvVCzAlozZj1XPolvFfxpdy8l74zvpkh+zD8C9j+W48vrQqiuMzsoXG8uN0Pp/QfPLdbEse
+oGkjOmafLRk1Mj9+PP8EI1HTeRwPrz5A82hwhudicvnn69mnuvBjaO0f5jLMQ2fijv/XFRK5YEqMcMzPhz+Bffph8m5yNafHQyMHcj69GMs/X88m87MJ9iVo
+SVJDz8+nP9lCR+fXc5KxrL1hmCid8ySR8yl2DisHxikUJC6Gn+K+AYsbF/SfBvJ6MB/IH2H2XyJJbQzrdT6ezW0AtxnsMlLJo6BzINNK3yVHpBWl6DCRDpxN
+bqdXfxKjwfvBbACH1vNTeTwFV+Dp99+/PqEkQVZ8p1hFBTfQ9kfyWBnRWouq5Ks/SVvL/+//li9evxi8ePHds++en76i7Hd4qraWHzgQESofX4l5YuEHG+Pe
+yNV1+mLw4vSFPJ7r+2og8J0Qdgjv5A6T5s+hKeHDFy++G3z34vmLZ6defdB/9Eoc/3mTa9dbsD/TkMG45NBYOc6X8tro0jgWRZeTN4e8QVxBM8QELC+IrgX1
+CiwrepEhWj7FmEOWanBMOzGne10avSQNDSdf8344S+QZ/q/H0tgySA6DDHgCdqnlsiQte1ZxCHE05kI7HUVK+f1AXkzmo/H5+fByPL2meRx+FJACnrt4j7DX
+cP4zoQCH97xTVWXh6UDAm3SlgWD5H1AuoUJQTC0HBM4p/2xQr/EZIGCgaroygUfelf7hijt1fJl1Tz7qqpA5GiULwExe6CdK8+DCbI3vt8Cwg5uHNbkmByKI
+1OlDa0zI/iSRwV7B3uFt+wO6ildZuqieFatnmfoifOcZ4ltlaeXitKihaNX9Wi50N/XU4SLqBk8425SVkVlBGQLsi0e/hGn/oPONDl0nWNfJ/bouCbkdswLx
+EMTK97oCFGhzucZ2qN5js+m31m/n0D5CVVVR5vrR/EGutFUndZnLiBuiYpAEZqjNURzyUpFROypyKxonilyOLPslJNnCHDJeum6uMry0/1gUy1oGdxpsvcTB
+c1I/pb7d2CxT5Lnz8yQ4+lR+u1GkAqoC/caNLExXU5UbvXRXAnxqWRLFLGjMMC2JOo7IxgRhR/IRRtz5myMS48VZf6EIC75vUVZSLe/SPDUV3ZdEeE3gKFlH
+hP2poz2hNDQNoPuxJ1zaQN0mr8pUmxPxxfmWdzvIgy+8DcVCuyeQC8GxNr2cT5j9ROLZACI08kZXX7Tu8mLXXAEO/m5x8ob3I1EQgipOLctf0nEDT1z6v7Qy
+clOlWfrfnjHQTLbGXVxsgAtLLoaha7pUbLIp/xJWb811LW5RNekP3BJkEGpDXPpAuAwz1gWGZ2cVlChrAk9VwXLO2NxVViokSh/YZ2j4V9H/OP0N9T9Ov3vR
+1P9486rX//j963+c9vofvf5Hr//R63/0+h+9/kev/9Hrf/T6H73+R6//0et/9Pofvf5Hr//R63/0+h+9/kev/9Hrf/T6H73+R6//0et/9Pofvf5Hr//R63/0
++h+9/kev/9Hrf/T6H73+R6//8WT9j2mpFpl+ot6HbNH7EE/U+5Dteh/icL0PuVXvQ/R6H73eR6/30et99Hofvd5Hr/fR6330eh+93kev99Hrffwn633svfrd
+GuSNcWtKbF9TB0xhQcujvrBNkYGhfYyfKwMzNQuuMqOrKsO2nsggFbKG6wrMTjembe8WWLW0eOzN3EeQHlS20e5O0IihtyyYtiltp7PncFbqs85lmsNYLZDn
+KNNcLjXNWbf5qDv8pih9I2w/Pd7ZYEXmzb43g1e9dkuv3fIvpN1ymFqLrKm1iKertci6Wov4CrWWeL6Jr1FrkXW1FvFktRbZrdYiDlVrkV1qLeLpai2yrtYi
+erWWXq2lV2vp1Vp6tZZeraVXa+nVWnq1ln85tRZxOb2ajMbgZ59fDy+vwEKdk09Zfh8c5nsrRginGHG1thTUBktXS/jJ1qPFh/dwCUCTRypLV0WZp4rfQmv7
+eTgMzUnnhi723NBl24b+Xi91qcAdBhu7CzZdgumjy9yikCjkzFrs5rOBt3GHAn9DcFWE39vNnE4FYsCmuZyrvFJylKlSQfUImQrPHKC7MxqPJuegAPP8l1OA
+2a7/8vrFixevavovpy+ev+j1X34d/RfwfVzJszEt2tEY/5z+OBlNxufyfPJuNpYjDZNEDh90WaXGoEErxEjb07KS2lRyk1vv2kLLpZZZcZsuUp3JLL3BC57Z
+aLn8A/yKTGHLKdd5VWphtJFqU+lNaaRapbn8PzDbtfezLqHMzc/oYFoaeV+m+SK91/DXP/7HpEst//F/SaNk+Y//WVIM4wch/vm3/6+tEQ6KBL6HPaIo79Lq
+H/8j1UYuywKRDir/x/+tUpPICl2iGzgjVbmUS/eTpRaZcrsz7Zf/+B+5SB/STGNb2S9lppDdVqb/+B/4VaC9wf9J7Uq7L4vKAl/+z+YPaSaL1arUUm1+9l2h
+KwnbdqbSUhu51IYqMfJeVWV6V+Sp2vwszKaUm9z3+MC9udpUpXv3qC95By4p2r3BGwd85YoxNHLmB6EWi3/8PzA+tIcbhPAlrin/+B84ejZkT//jf2A88B3w
+EDUKX2QgxDkbYeojN1lodp1KU+TVD8B7vIPTSZWpqmBUsz+Mc13eploOq+Iuhc7+59/+LkfjYQJ1q5vMTUmCRCyg+IUq1aL6x/8DUwsh8ekKn6z0Yp3jX7qS
+KTS7KlOdJXJZ5BV0kkn/8f/capzQJq02//gf+fL02cuXstxoO2zv//E/y3/8T2mt4+9ef/f6BTh3JnO50Ev9szx9DUF6nNbWyFWZfeOZXqx1uYCNnjcKX+dy
+Nu94H/GP/4u9j4zeR1f0SgWM2v/Z6K0vgi9xkS7WOns2zG+1+O71d9+/As93alzj3wyEmFhjIWp+aDtaabCKVOXaoHM5tAEZ90KTy9lkmIj2EfqKNxLsjf7/
+7P3bchtJmi4K7mt/Cjcu2yZyLIgUqZSUqWxbtpEkpEQVRXABZGZp1rR1OxEOMioDEajwCFKoi7F5h3mBtrmY6Zxttq7mZtu6a9m8yDzJ2H/wU0QApA6ZVdkF
+XWRKQMDDj7//h+///tNyqbICxc2PZd7MtWoSOS3ngJvBizWR31/Io6fPE/nym6Pnz+SZlie32hRqTeMdCHExHQ3ffn91NvpIgUa9elKilpAt8BeptocA1L3W
+GaAtf60rkAlpK+8YhYKsdBoil9PGvVRUH365yeAsy7mu66BjqjAwOXOVViRfoeEP/57r+GT/x/9ernTBZ/c//iccyCdEuEGjm2vjemdwMMqYsqoz+rIiBEuq
+78qsMngslYXtp9qIaKAowFNNsllXdxo6jfpOU5MUISFgWgM2ssnrD79UmW4qTDk5e6Lm4KHIrMCNRZDUsC+cTMTZX2U66Zld0Z3dvzSZ1IVMP/wyL5sc4y2w
+QSnJGk4vCM4MJ6d4AqI5qzpyTfylwfvlRlWqgIfRcP7wC0t5WWi5UBkIYW10JUFQ509ICoarC+JZOEG/Sc7b4cL1CddcUaMnESdoYSR3pXVHCczo0FlRY24d
+7B754Re83PInqq7J9Mb9/SQYGe2Aus4qGAz2G5YrM39ptGGCmA+/GKEa3gVLjF1/+LegGZSPtFNaq/FV2eDt++EXtI1W+GtYJXra5nG3DwFu/7ibIF6KGg5j
+8eEXaZQ0qw+/gONkjoPvHNsEl32lm5puxAJODXnr3qMOsVRFtmpyXUld47PwGG9jkZYFyi5cINd5vqjpWi0XGi2rAi5QRQoTsmfRiuFT87IoFFxxxVwbkQWy
+1IAXoSoXZZFm4EKBSzM6Wqak0c5lVtxleFY//BsvAXRZ1NrUGndZ+uGXvzTKzuGZnQaYYmzqWpsSz7QqjO0WJRwZudLVUtcwtSJ9ooxpKmhTSfPhl3lTZSzB
+qB0oPfjh35caJ6Fs/Be0KPh5Ild5Y+TNh18KuDop0JuIYLvQhOdP9PtVXmYwBJZsRi4//L+XOuxdqoOODKQX2wIX9sP/u6603URZjWuDcvDDL7QJ8OUgQ4xb
+Wuo1tIP/rKQuRP4Edhc8CT1Rfy6bWhYZZqZUtMjwK/I9m0Hn+jCNAf8l+nagx0/QF/aXRlewBjW05vamveKcDBCbZACIqyylFssGFqnSftpoufE5TIKnCROk
+XkLnMuKFG1Z1Ns+1PNKVPAQIG8Y2J+czIU5hsHM3lASXoIb9ANImuwOxk8i8rAy2aqTRFfT+wy/zCnqr7vScrk7sm5irVVYTb0qDD8I2ym4KLw+4VY26H770
+FQwTHsJFoWukqN3spu76S6TRoIGCBxG1a+MrV65K4y8ToWupikK/p3uF5z1+j1sN3BlGYeoIzjRmp06u/6xrFl72MyYnRMEs5wq6Mr/98Isqavh9FPhJ7IZm
+qYU34bKE70n6wlW3omdF2ri5IKHHsF8QukH/rZMpHsfZ9nHM3N3Jnwg7ss8fhG4ALKCXGep1XvvAngQbKW3Y01uHoyGM8odfNgxnyV/jjOB7s8LgVnNeNYIR
+BMMMm3qiC6OXdByNzNCry1gKFt85PGhIdSlvKrVcahHKTg3j/Uujc1ouMIrgpBcffsE4SlahcnyHpgJ3V1fhGGzncMajYRq5yOa3mQbBnBVk74HNbI0luKOy
+3IlzXDcaIoSinOoQT12KthlpG5vkiVVHTBP11ObvwfrYnbdvDuL2ywZ7HtxQkJcczphao0GNWxvErxZ+3d2q6aZqLbl9o7S60pPt620VorgZPy1lgwIXAWCm
+LAotV7drg/ZGCQ3DleR8sXDrdY5RrmVPz2HzbN5iy5jhZV5WzN0GjoZKsYZjEqFSxSffsIApyuaO5NmiLPCxQuV032dF/eGXmwpvVqv9nwUakoCRxrObSJUV
+JmOnCpJNNvB/cDei2jEQgj4LBtMUMhiN356kexuJ2VqrKmNxEMkJ8ZcmS3CpFmVmePN++IWmksSRfg9XeE3BS9I34DXVh18UqQNpz+BF2QgBNzQmaMNNnH/4
+Bd+KBwZUIfASLcqmKjLjRKc/fTRKebouFLoSgvHijUzfJ3JeffiFJU2uZTyXWZF++GWlixTVI7/ZE1nrHOd4rrkhFqrBVirqcBKsWyuYDCPC9g3qoOYJP4By
+VuEpkNqs1BxMPoUxbnWjo54loFoVQq1WOldoqpI7qCOw7cvpLHG/7c7on58t04MjppPrZclKkYMq04U03DIq39gx3TDDV4n3kB8CjoAvR+g7qHqif2JZLGc5
+CmToQEKTXembqmxWaNQW0Amjmzycb3ABoKEXHuR5iQ677I4RhqFIojd7mYOan1eRnmgKxZAfEUJOTZ4xZSTO/ipvKtqMVgs7lody8v0fRpfgJbNiRiqyRWlS
+8AYo5qx2+nnnPuBsux6Sz5P+rUVRFtLxcqHgKQy4KGDwMJhlWaQZyj+/YhI9luTY/PDLIisyLefZoVpVH/7dkNquuPPPqZskXnMl0wZNxlSH/s3w9ikrNNng
+vplrEZxNOx3P5KEcnkD6ANWFEkgacxYqSB2lSKZ+CbxygZuh+vDLqkGbHIEsBq/0hcpqeBCVFStsVFZ7HZf9x8AKlGu2sOjYNJG4pXOxLNe6kEVZg85QkNJW
+f/gl//CL/yXMG/oUKlwhiZYGSM7M4IyUDf4seFX7B5QT7+6u72wXqY92LM6t0zNJdKTgrMzLApfXrwz7FUDMhgcbbzwkTrmCjaSXK/ZSuGlOmPkYBuGnoCms
+/cu+P4q5LdAxFpnpRkZS1DoBKHrGZpbzsOiaH/AYBZjYppANykDoeto2m/X7FZgC0A14nZLkmV9meGcEthL2xM6XWOFtBAfFLocpCxnuw55zEp0OoOWbZ9Ah
+0xi2E/x6VBpdAR/+Ry01zFaZVRJv1MhDEByNr+UhZDlB6tToXP44fnM1uprK0aU8vZqORpxJfDToPhNJFvJNg6aa3TSoyuOEpqpmuzIeY2cb4aBFNGiNDIQ2
+l/l4YDsUvJXM8UqhyQZJLyAMWNC3pUcOLqq8JUU22sShSImv+6ETUodydDk6P72C8NZMnk4ngKw8mZyfjE5HM9tPK03xJPx7qiOxSq4jmhidhI5HKzK4A96g
+8ZIRPSx1Vjglj74L5KUXWs7x4V0ewglf1OlVlueg/9HdJeGfsM0TmT88DFjsOqsrLW8qNc9A+/BDEWnsSUgb8ugYeV3pO43qCLgSoBOZ5imvy8YKL/KXGnBO
+kWPRCJEtrZLWq7LahMejgV2V0ydXl+Oz8Yyl/1l0+ZoaUz+qzNBQnPcoaJI9NqEE+UtDt+R7kVLMwMiUHDJz66gkT6KfaDj8c3wNqXRshJUVeiqOBh1PJSix
+qtBFjUYFoQ9KlpTehCxa8yVad4hmBZGOBKqJAyGOB/EtBNqdArqnW3UDm/GJV+ISMsukqcv5z+omfr1pyD6wdwmKdgxYloGbPX2iC1lekyssoX9++KVu0gz+
+WTb0iXU1aq+m0z1n/f9oflbLrNDkPs5SdgcG4UdUb8vCCvVrZdjjntFUC9Tr8Ca1Suvcr/R30NhczzPrkWqZj5Rb12iezXJlA3cijWczDWczjWYz1aQzMWSG
+DkQ4u/680+GAPaoLGw5+YvtQocTVVd3E7g/c/ce8++Xpk+HFxWQKuHkQVC6tgJxzZ9o1q3D9WHWJDFK3TWXuHtdkcGYVDg/tTV0l4NytKlXc6IpW1TVK80UW
+Q+S8b7lD7BsovsIiPnbb6AK9rE1ek7K8/Ty3ehAOLDQpYu8tvH1JAqfADavlQn34f6HiC0c8A4FtykIW5RJPoCrAue8iMZqDauHbhK7DSxEsHmWVkTlcgIfz
+zK7eMy+7RjEQanRJH71+jSD6cAUD/kAfAfQuM7eIXqNqLWdhyP0rNEcel8umIAdzbBrZqKsT1laR1HVLgxWhn8C/jN5j79elqua3tFp0kZTg1IeLpGzgUqmb
+rAbjX/DV4XVG0688r6qSFM8Hd4cP4fHGx4BfS390fsKyAeMnsRGbGt0XPber3Hi7Sil5gY8AwRgt7emVh8nMhucz+XZyOn49PnnUtbVpIIxPaQ1JxC6D0I0b
+OKPRqYijdUPjq6uu29FPwWAQ8geFwYIeTQS3TU0uGLqfMvTplcuVuoEQzys7U1QMCo2VfksBb7L0ySbb4GHdP+1GOKl5q/R4jRwucPDFIqCGFEEjv4FGvk2E
+wPCeToIAupHlh/+D/AN5OKWxzDN1MJWgFYLC5xaaA2f00kVTN5Xx2wB1jjl0VFdyoeYZ2xWqiaIJnDzQVhuyIs1IjcnJt2gdgin7op0OQx6IBsYnYGTlh/9Z
+u3I2hc7xF2AKZ6E7mV8vEU9xVym5UnB2ubuIv4B+CWrOiqCFrmoyIjnaF52a462nhg4MWAl9dzffBB2Hb3gNJO2TnGrRBVF0AgykcShQGTCKlhlyHuZP2LnK
+ex2QDRvOxseJqlx3O5HEjkDxBU61hXhp8Z/iVM9/s1PtNkbneItoT/5ax9u//xPOueCj8yXOuXDnXH7MOX/WPecjvBCvzsDafnc+fDv+b1ej2aaj7tEUH36R
+TSHbbvrOMXd7TxRhaB09bxwH6DQSYD8IIuCXmY5iwxV9ohAzqmSg3xR1NOSvB5jhOrykjIaRHP44OpFnQwejfXNxxtH0YB9GVupbr6hIVFRg97nwjiSvlsU7
+LTND9mt0qiMg5ZuLs0TmHyGVXLiKFIy4qcHW3vvOQ4f//abSPZ2NUQif2dmed2/otvX6vJCHkEZ7MR2PLiF9+3J0djY6ubyC/zEFzdFAzq6m8iyAPHOaN8p3
+H0GEzj0inNpyQUkXRb1EcyaARkXnnx/Dt/CFRChkj9gNnEFWhf0LQx6iUKdCQlGTeWsC8xsL+f/9vzd3FUrbosnZtCueKJErEFaIvGuFrVs3a4QTDq1wGcSK
+w65ALORG3TCuClNUMvJ5+YaCSbD0hRYsIHoPPFn8Sdt/dgebpuV8BUckr/OxXecekzoEEG7BbVsQXGxrkyZR12p+y3i4EMe3CcIDUSYbP1Po7cwMB/d9gqPt
++zPf916JGklSjLc/JE1hm/XCDVMtHpiBHrkqdY13u7bI2Aa34HtwjVh5ivBVYyymxK6/HeLXyHd2MZkRCglzjK7OYXQkcOEJiAGFQ3W7i1MAyUFErrkP/yYR
+9U8x4ipbkoPD7e0UdYkcOr9UBaFVOHCLahDevNaDuX1bgJpq0K/VOvvfoVb04d9CvwjpmMiFzrdamtXBu7ZvQWG3YNfqZf6Q401z5CeE3SuqrhEUavPhuIpc
+41LiGKKHG/YxxyNtQmnJKCkTxYYxAAIxLcJdJh28EQNIGZ/KoQgL2citJ50WxyCsK4DeGBIJDq0YJzykOIJHDEQ8ZiDBJfNSHjIfGzCRzGaTkzHs25e8X63U
+Kp4gSbeWupCqmcNdqUzLX89qMiEgnLMJNjeDQwiMieHCeZjLgF20Iha/i7fGiXYR9eiF0amlSSkNobXr9Qo/s2ALwqJShBWWMZTNGG6u6TT0dY8nMXgs6Kpg
+/CBZY85ljAovIx3UvEbE50pVhPc0+JFpDxrfI3q7UPBBwFfBB7lCm6Bjd8jImyAIAWzs1AxgYcFXqwmVmuCeDH7A8IPWhCYOitf7TgTCGtjZ3pQIUeWkuLoh
+KBpAw05vVgJb/p3YT4vz32tv0GXa5xQjZ7Ypm1uVAXj8BA2f0Gpr2SeLDJYBhalxccQg/3uhK58zAcDi9tJyYDLeo62YaXDyvpGHNquYWFlGQnyDGl0L2ds2
+hXWgI3wzOE6kyVovLRzgRoDM7IlwhWUHnP/AuJyIOIAeK7pCyUDn6gEirypAf1X0VFPreE9aJgn4Kc5yTh7uSpDwwBqzrFuBsfznJsUcD5TqdlWbawZGw4pk
+uXO7K0pe0w2SC36DF4rafkZ4y3PeA+Yp4D4lUmwMZ+toI4hYmSu0NKqpVGbNMvw5tKULWamM/PbsA38FeAwyQ5eY1y3SxrluwqgNuja0X7Q8p7wQ01q7dnA7
+EftZ6xU8dSa8HY3NG1EWvhuq9nxlcM05gvnHUbjrrPtqD6oGi/YGozxNEecUWAuiBVFkD/ciK1o5CDnF2/azzqD6RiIeM5LobsFbgfFTWFom00Whi1pEShkK
+LHb1uw3J4oI0s4BAZx/mhfxMGl5cY3jHuh0S+kimWl5jNsEimwefRuHr4Nl5nkEA+t9xSwiOlqgCuWWWCpEwH/5N3qgbjB/VVdlc5zrslYfMHHg0qWJcQlZl
+uGEpbyheVMa+YfAhcYn4aC3zWrilkLoWxZMSzbSVYnUF+hWe6g72K5CI38pD+WZI9LtCfNtRmQO8CV4hTxjDjapPNzUlSszDW99dqKaFI1QoBKUu5Dyr5k3e
+NXELbVGeK2VEGEDWNcWT7wCig+p5oOgFm9HIInNh5Tlluej3makR5pY+cWkAuKVBchqEjfkkpybyOZH2FyU5zbVclRnhGkRvxpN8MOOpN91J9KU7yf50pyCc
+zLFxvhw426ebb0dOV8jczGWl8w//zgktvZI7goPhjv3w/8lh13M8zlAo0CSik0RkM2pYXeEMIgqtXpdFCwfAjl1jE4g4DaXQVHWPPY+ptueAXEZW3TeEtn8v
+rzONbOXfsnUT3DwguXJFutZ1WRDc2N0iPn7JjuTY5RWYvc5+j0LM+4hvbsPaAtwP+hxM5HMQzw+wq88eOHk6jigRYFn+x/+uC3sq/+N/do+6oEwkdN145ZBF
+LaHcFQW7GbKSe3952Ev57eBY6HBU3PA8SmaEE2EUMEYSRNcKQw6mhznWlI5lQCfJiqK8s3hGXdVZQa7TUUFX8RzxsG2skn8vuXD7ZgkvBRQWVQVIbN5QftdV
+igaSFbWuqmZFB5Kewg2HwQ1KycI8JZjrvzQZnUgRHQ5dW76A7KZh2WszhHIti4zbreCiyiolc38iEBQSaKzfDr5ub93WeCln1boiNq1omJ9RaDLl4dfOmmef
+cYjvtGdgm+kOQHGKjodQuqZg1FcSsgtAnxyQgLZi3zsGYpgVJmsvMwKRLd7PbTSFomVBkFy/BO46NZaXGi8Y/JTxHejRL5uqUhkiNNmvD5Y2483II1fpjpaW
+sEM6FNYJy6yOPRUrPefgrViy7MtkqkVNqpbtJkptxOFiH3r0gvbE5E3mlXCBplOWxkY0ctCk+A8YMjrSPvyyIJopss62/EY4DzaZ1zjrcyowiv/vM76cqdE2
+w9BiFHHe+aoq63Je4oaCOGKV9shr9EbR8rcjfhT0al+ovBzph1+K0nHPtmEkkYUohyjHhNtexRMYO80uDDx4kdMfAmetKmzeoPPpFeWynQiG34S/R/fGUlV/
+aXSYYfmUrNTx2ZiBIUdPEaFLDiA69X9ptEONt9Kf3j9gaYoeSzOYcTobqEBkOXs+V7nO7OXofynrShe1FvvPnh7IP2OGPmNiirL22gwlm/D2iaRlpQ3OLkpg
+xPcOcLBtn6TNdW1h9KF/H37htcJs4V6cZyKcEzcoBdbKspPO4ZWEuiRHiaxrA4xO4UDvOlx4h/fO3dwFqXfU7wq1VxgMUiprhBIY4Qz5vzRPWCiQaELJy2BA
+fB3JMk9HgxdSCLp3e+hIHsb+8dPxj6PpjP3jR0ewoU6GVzPikp2OR1fTkRB0DGQaWGiFpnvQHT2W/rLStapS6dx0qEBneU7OxAAPGRrvqJ9qtNzTD//Txitp
+VyOHpFyqP8NsJvaLRVnVTYaqAZxQ1AOlfu+mPfGB/sRrJwmhzpo7lZm2jskpiOGNj7Y2LoNqADmq53UVuEk5LYPQcnOvtIOFla9NpmXwc9iTmvILNKqJ0E6Y
+sZ8QaARWvHCpLrRz0Sl2g64c6qzKNWjV2si5qpWpq3J1C4uCdSFgqzCq/MMvNx/+HzUl5WvVvKeP6wr1VnKrwDh0VWloTi50Q4+g8WsowTBHOm4wBUxAHLHI
+EbBezjNqly3AVMubBtr7//3f/p9CHB3xoV2orE4s60KBE2jTyIJdBYjSkiGCmGOFD8IRRhkqyvlcGZe4zxqpyktOa4mf3oRaSdCARGkmIhc6gBUI9Fjpoizm
+WWAmK+4iBdWt4ALxCfam74cIeEeWpK0eHbEO79KxioLzzlDjU3N7jc0dgWUoQBJOiEd/A+aXJu6G9efRIiqR4aC0adjeSZDzRQo/zXRFLYh2C3Nd04/t1edA
+ID7oNffUAJ00KVE8AVnIWOW6FYTx74IwLqm3T0jRgv1Bvk4aLN6LGNq09xKsaKVtBj8mSaQf/iee3Ft78RuabogBohv6ye16Vda3H/7daEQddLfIFnCTeaLu
+QIcGqcTRRw68QTN5mUFT+C+s3xvWuAVLilKx0NVH8J7E8u2UmSgbOdf8M9o8TVrhEeEDZidqoVkfhQ/VUhcpH9swXCVIidP2EiBeCPc27qd94UBe+osMj2A8
+B8JfRz6xJwxU5EoWTU7xBtIfbQSUfaxB/DMRfAtsmejAbavhkw//o9BV+BZSuL253cK8Hx1Bpdaz4fmbqzhPiPWBFFxqUhfEz6o9SZslnOLPVXGTw6eBSiXS
+7E5XN6yWoXSG5bJOQYrB5MqVHguahh0tF2UW3r6Qnnk+ufoRYCMzCRcv3cFXXDjgUoij48ERr5BPMY/wLLgFMW5LDq9+GLOW8zBDHni9B3KI6RRWYjE/CWaj
+3CIuaU6RVNqXreRH0MnhOvkFfYs6RD85mDQaFKqyO6vL0JZYsImRxrPhkAva236AMdeVxES7Ek0O9g4GaivolXwN+wx3V3sylPZk2BkD+Uu4q+e3CkVbg8Uk
+P/xSlVxrcl5TSNA2E5FI2ehiQMbC8V+i/tEEtK913KVVVV5Dxoll5YFZRk/ZL9omR3aJ8mjBntE+8LaC455hjJkvU8g+7dapYpUdeaMEB8usVuZwM8EYcf0s
+EIpuEvcC33DZiPDVITlKNwK1LWBG2LfgeEC67tlkHNYvGF0iNm50SWUORtPp+HIyHQ8BdnX0rBUZ5/PO7Gaw68osOJIbQwxkdheUAoMt5CRC8ydqmRFDuDYe
+vpeiVEcOWM47gpZS6zkAl6ZwQA3oimno+qY2rRYTY4CPEOM8tA5nZ/lK2wUbw04//JKrzCX87x8fwF1KUEXcgDAKkTMTx52LaIOQXSDfnSOu1ehdY2JLSp1o
+yAavbtxxFRvGzeeBTGHTUpNyRZaXdaAgQpRiTUZegrwqQIGADhMUDvcG8gfCXMgT/Yp4GU9eMaHh+BUTAcqzs1f+TJzBgXHluI5gTo+Pvnr64qvjp0+/Fv/L
+7s9v9sfx/x797fh/nx6/6PL/Ptvx//4Wf7AMp6vmSLzd4/M3cvhmOhohuTeT/55T8QYqezF0ZS0yI5VcVFr7Ghq2qhcy7HNRPF83PM3MvDF04VvSd/S6NfVt
+WRmZFbKsUl3JupS6wErbfaz49X2J2B+bSgoi76bJUipebIifPytuXglxKBdZZZDjnWkOyOfCtSBfV7qY32I1CXld1libjOh/TVTcoqxqgxzu3nuucnR566pe
+0+8tt3dAfUGzUFNNeAOj4pEK+/BtmafwVbmQel4W5TKbW8r58i4oTjKAoRh4JIWx9M2KnwxXPyHyYpeLeK2Q21cb7BZzas5LDIrr90wo3xhdHVJ3uHjPoszz
+8h4m+rpMM001bOBVdglt3RK7EXgH7Z9oae8H+UrwBSGDG0K2r4gDykF5FCnwITWtXLajDV7PE3aJz1UOKyQs7y8BrpGe9pb8TLfqzm4gnkpYYjT2y4W8bkxW
+wGypmliBBbECy0exAifydQWrNbBD+hiK4EO+T3tGh0PylLnzzxqVrBotAopg2UcR3B7JZ/MFH1q24A3Dkx89PNE7vM/gCxaWLxhHLKUk3uCzEZ2JVVOtSqPd
+1n9dae1L2VBxAehkJDjrkgrm4RlDYSPa5exh8EEkis6Zk7RxsYTgxHHVWnh4UamlxrIUWOxD7gU8wHuxdFiWKcJFL7F4iK7mWDGDxqR9facsgAmqHEt5Wobg
+KFixKCtBIzOlVCYQ6Fx7JTNECoxNVpor24DgCqqft3CNIG2pfaoboqhsia4gtmyLNXihRtPlBBvNJw2jLsHsXnO+8Fp0pjqokBhUjkx4tbhi/l2W2tpKKIWZ
+l0an4h5T6+u1L/jAy/bEXnZJIP55lts3QEKn+1Y7pt872xOUtPBerO3Ib42qeWAxSa6Oouc1XH8w09dYDMxNFgznCYWQC39jwTdVZn620BeFQ4IR5qWiYkEN
+1VOh6YONwmVfU6p4Ap+UlUO32JKYdhZs1Xp4vbzJ7lgNIGc4zEZ0hBLqFZSSX2rlb1Uml0NooU5FXVoCX1Vrex2rmor217cai2FpbMKEbVQaTV/afNx/zVc0
+FDSqMl3MdRpg61RunOApDlO9qm99ObGfi/I+1+mNHtgSalRumF9PwkbdUG0WmE/qqebaQjO/UQxWfsEFDfQSQQWlK42F2ci1mBUheFdDgSY35Rq5cte8x7IK
+OXs1Fcj5qqxEqmrFVcXohGKHYGlByOiCatQm8EjDVW/oHGqcv4LeopY6TNkpF+7FXAHOCz9Yx2uNuki+dluEXktXgLnVaSLcAaNlKkpYylpXOg0Wtyjl0CaC
+qkrb8roq5Xo4lV6WdzoVOP9VuRwI0dMdV74JCyJVWBPPCVrYFlQ/qnNiRVtnS/WcCkyVIMaWGa2pk1u0DeG3JV9ZruoY3ivOr9Im5n3NCh1fNV7NcuNI5L0t
+f+t1NMaSWGKQJYLlWFQxN6/MdV1rEkdrroCDQiVuiatqu7RgIdybX7kj5UrpRLcdrVYws1xoyct5Yd13A9+8PQe+9eDuy0heTKhKzwkLd5A/nN+KH4HagXuZ
+ixKH3n74eYvddk/BXbQnWQDW2VL7OaVxaS2IA4r644YY9Ntm8/X1f9bqf9hZ7n8wJOH6Lz+v/xmWJ/eXW+rsA7SN6Elfk4lrUxapjA/0phFTPoFOtw95aZ+C
+usC1zLUytSy7fK5+/d3c2PZg77REZETki/KDuHtlnhV0DPHkWksnM1Zupl4vYW0rbDnoR7Ag4bius0JVa7nIcm1kSUX4wOSkQua2DGSWh9XvwjEFb/gBJUrY
++FatgOzCsHZ7T6/p/3bTIncvNA8MvdGqwAUMH/JtRptbpxvXmv4fFDJza+NeZttaqlQ/aqXPWKMJZ4DmJLGVqa3gQNmeFWl2l6VggJeVzPWNyqUu6qxeJ1TJ
+1e/01h70e7xvbC1iX+hM+PIHCH0LzuEErc0z+go+1oW+l4uGwAsqz9CdnRW1vqm4HGRdxl29XofvDiY8ca4DpGmdWz7f+EAim69dGaPR+WK5e2nPusKPrBVE
+wgTv1kSWBVMWZDl2EgwDZk5F4ZqQqmHZeHFC8VS0RlpWLpVMkKZh1Sxs1QWscRLIklroymvfPRucElDnsjXWaLmYzFerQJEPZxJHCaIxK1LEDNm667eh7onK
+ozVZqFWn1dunUPUAm+u+xJiDrnRRt2eLlU54tGoKGLjRiOzHkD3c0xJZfQ1VfRMlgqVzp8nR7rc30to2FMrMcEd80pxYsV/oOavUnV1JJL5zmWfFz1Yx/5lO
+WkaMtfOaHEm4wys3tQPSvDZNoZXVTrPnTiQ40Hm5vLbFnxUy6+Za+AnuiChH64vHGF174TXuXm+FDzVwiaZuqDWxsopVmrGVrGBeX0WTBYy+KscFjnU4iBxf
+XE0vJrM+N4HueAPoKEWd8k6CWAVB3TmRRVkcdsh9dcX1OFN5X1Z5ep+l7qfu3reTLlRYsTkrpOfMpPKSVOfU/uz+tsxJP4BBBH7OciHIZgwclyprVwLt4fg9
+Pxkxxe9luDrtKpbK9N5QfZqK6GjGdpuX83lTYcjcLgGpP+WipevqO00cwMj/SxbvNumM54wga9dgKV2vZVreF/aXoBEIhZZITTm1fLNdr2X4jFRE7jtX0GSa
+NcvvBCWktVS1aCOzr0bzQV/IYCGsEwPWEu8+S+Y7KbBc0rqzFxNbZRjn2lYejisgu/rEc+EN9lhm8nPWL2G9IWy3BV/ybVOCrwjmI7S3ydVyq4y81oh/YHOw
+LuMZWFUZHRWMJOD+wNziTXsbaHl5d1+XdzppyQKt8VtY4rmz5blGPIkmdc9ObyrdHW5t5Oh9/RrKpv44kqdDoIY5P4Uo+FsrmYijN3qG5IPfsHb7z8ulZtRo
+dqcl2Y/ESsiKvczskJ04F17GbZ6Age/N8YC719eHSmOcJSsY05k2lT0MJAxI/eqKBPGQ8hqIhpCod3YyuRjZarPT8ZsfLmfyDSS1jU7l4eP+0EicDOXFxLNg
+2puHXUvWqIMyvEksDQR3vBUYmoXegUAWYM1k2FBWZtqpgZUJjhmvJdb05a/7Da92QX2UyAMhJrYScxJfGf3jpMhPubApymGmpB0hd2mlKLjPdgh+TmtdVpjE
+Y7vreX4Rh4kDo5LlvTobsvzyik5eyyt7LbouZtY1mv2VTnljdEuuNIXlEdMYBou9YnKRaezwQkbsvujwyGp5rX3dZC7g7WrUexUPhR0qqkQn4ph9JayoXq7K
+SlXeb+VKaed5e4Ja14UIrwtJwcRw67AKcTywtwIW317lao1/r5qiwL+UlTR1WXUupTI2V/AGQYFP1hEtkKxLS+6bSFM36ZqGZTZ5qrz73gZecKqzVCtrcbuw
+I04iDCPY263z4eCtRS01dQmv7FhdIJchiSDyzXUcMXKuqgqNi6YOXiC2z11M5ksTqW7Ccbe2VMsHTRKX5xM3KXRjjZ0Ib1m75Y+Rjn18eUbh9MuJfDv846hN
+Q3Rpr2yJPuyfdYtniGw1tmbdg9bo1GxxJpL5fBPYadjMBvNVtvR6fwW6xu321sJH8GHHBcd6++Ftvz8cUfv93tVrJ9lS9+ZrT49DEYIl3ms+4izt5glf4Gzk
+lEpq09/J5qRTEF7ejsY35LCDa/vi6vsz5nRlyYVxFZvJqKokmDSCN/gxOm82z3l3EYVdxGVWs9i3eQm6YxvVt84bz83GB17Qgael7NVPO2u8VNXPuk6sz5yC
+CCZL+SjAsVRyoTWKnPgCSaRiBbKy+NUeoYcvBuMg6MyqKufamJbCD4KiwdLprW0UBeXoTVa1cB7FspJNsWx7IWnSsiql9cIEvXlZpYEeixeueOSF6+gw+xmB
+J689kuan8eUPk6vLFinw9vMSDbSuGr1pXrMi9CPK2GfMbpn4POHxaKU0UuBMG44GsGfVxyO6VipeWMaShBbkyg1pQokpVG0wLPBi22hRBMZAuSBDO9RqnhgZ
+RlSFC3Ru0nGBFRQ6DLSgNlaU2GAV2nf4EcVQWz7lcDqzqEwtRKViLwIoiYayBfzHRjbFbVakGmNpUTg6eM2iyfNoJa/XvG58sy8DwFOfZog3K7cOW7UuE+G0
+HLyITF2WqQ/pWj+bgmuYDF+kCA1UCdcbQZdvUWLarmaD2/7MuhrcLyGI2D4jx31nhGl/T91hEeKnztVODiW19c5INnsArOrd2vK8JTvhCtYzaujGtYawFK5/
+XaJYbR0Ld298zGnueyvuRppw8SUOMvkMdwe5M9G/8onueZ+Aoy2/zNEW9mjLL3G0aad9/tF+1ne0iZfyxPJU9h3sW2WsGgYTKWMv/pYjzcZIWmK3XbgeD0S3
+EeVABsFC07wJ5b3uPbHq9kBbRL/v8GpH38SbizNGzI4YbBPtx2hrbFBQMqfZs1t7DoLXImcDOdR/orEHFpdUbxRHok8ckUHv42FBW4MHh+MHYFVa6rrsdl18
+VtfbklT0d2PTMAK/3At5GHAAD8+QGng0vXznSUaPBnLy42iKi8s0wMEldemCkrK8L8x231YnMCuHXk9vfAig/Vxr2Xuhz32ngybAY1S4o3DadI5BFGgu2AJZ
+bSTCAqGhopRlwVJBXOvIuoXvszqEhZgy122HQhBBd/0TLXHWB0fG49sTxQ4mG4dWU4CJQN8INexrrW9Ghd8bW8AMie+hZXuJ7iLL7wPO0oHfMcfBjumx6Puh
+4u29Elv61zov/WVKOIBNrlSUpZBxa2N53lOca8cpHPT3WdDfjrC+jOU0Bls2i2oL7Y+RCuGIRe+IewS21UfQwIRdf1uW7PhTdoFIKNIuJNwrAWCCHTAI6YLl
+Hybj80s45D+OZ7QiTqwHf2Vm4cs4noLBv3wdbD1yBBYle0aWGDUoK973ScuPt1RUiKjetAcE6U5GQtmp9z6iYTctkQeHThiaN/4Zv08SlfBc+TfywYgMR9Fx
+xOIcMGFwNHQ/YDtWoqbLcWiWqC5fy6xYgHqAiyS2b/NFKJAYDBHEm42LCKmfdR9O6y4zhx/+7fAuI/+PqdVikbScG8gG7IE9Dmps69wQqhhm8PP6Gl8pL5Hb
+5WwIkQnLAczUv1codIqS+PCWREhrvJ0RKONMDNyO+rLeL4LcBc9si949z3zrSiK3o7sDwBbd6zu7Gd0LWi5MRFuQ8xuZf8uFbZM2SK+bBB5q4L7p6WFiZw+f
+CPqa8C3jbK1IynrNjGBdqJDJidXsXfexvyCfYE1FOBX82t5Zc9NEy5AV86byiGkHELYsv7NsmeWqytfRbnN9cBHqnomkSKSzHRK+hODD8r6w/CtUcA+aD+Kj
+gZxDi+YakWZoNgDUXmS10fnC31f9Jq6HpfB89Bqg9m7sM0CsH5a6QqhW7IGkHgzkDM6T67hzuDKxp8r722XoZxSecVPJqo+oy/69wIAauOw4dyzaFu0wbnxc
+gTD4bExcwe8sV/A2FXsYkQTflk0eI0bkQmW5rEuw9hZZnrcjQLjcQUaECw+0NN7OToLxz3OVLVEB1AVTYXklqoI+56UBZZGBUspIFeTZIXdwXgsX0vNBKdv9
+JNQ0vXGqQewwRCKrLQ3wZWzC+1ODaScU5OdJBB92VhMsHSGAVgPLjOhLhYFV8wbptaaD2R4T3m3e7/4KoRnlgmYhbWJMxBNDDMB2NWA3wmI15BS269VaIEv+
+S9OLfu2C8cnQgH9Tx5OHGn0V8uQGRlyMZPNxZxfQylVda5TJKkoxoBQLEQXGcBNEDzlAOMwjMf1u7Ld4sN+00jbf3qtCqIhxqByFITqaVnreFBkEQ8vKp1rh
+C/ezgR7QX8FsV7VK3L+I7c8kNuhW3Aj4yj8wb0xdLnVloF3MB8WHV2VVNwXsunlpahLLIP2a6hr6jjkM3AmkDLxD8OFBC0NKgAWMQmjY8YQhzmqUK+pGwb9i
+uBWC3nwkYW0llg1l1rpvyuN9be/zluEUBUbDs25BxDE8LaT6/Wk4BVjEO6b6jRS5ELji7Xc8VkUd8AkEmAafcydczh3eoNDS4W153w+LdxvzXsUI85T0VcGo
+NtwuWGU2RzCd4YyTWpuaMz/uNBIAp4ksSg85gx3I8iiMIqF4s42kukYjdyA7SU8tseDynISDFaWVunfO5XbSk3xU0pMIkp4okSzOenKT5PGfYcpRgHmilLm2
+d9ndEE5zIEQpTRlFuHsjfqCeU1fqVjoR/DqrTZRFxEkFjbZA+pWuHJQXXiIsrgXVaxpdqCYUZU3svjJVS6J6tLFF4sXBA23Vbsvq2wfWqTSte03Gzk1ZpiDB
+69tNYXhBMEmrX8eIFjf/+x70HF0n4W+MrsUGOGS56MKXHOPvIw5g7OYBFUinLmsjAm+VFe68GNrAAGCWyvAh0sMG2iM9UN9yjpwPq+Ny++F8OzimvJIsDmb3
+vNM2LggEktWGw8ZAfNhwIgqmeunU0v8SWK3ydCUEExwIMWPgIGeUhSuflmx28hv7pw2dBASo9H3UVVVWfmew+sw5avOyqLOiKRuTr9vPXOuAFFg4H1sgMEBf
+hyOy8pETGK/tUcQQTIILJ969Yal13W4zPHOWH/iydyp0Fq54vnZrnq83T5NwP3dmejBXwT0WW8O4/aNwjhIEBUv8eMk47WzJ2JIeyLdlpcuO2RmEFMFt1I4M
+VMscM7X5Cm5DBviypp1M4/KeIfb2Bzd55uphWsUXkXkxH16RdjKMYwNqIM/BfK5vNfTNmQFo1oa9it/NQxBtoKFum498PiUcYtx8IVMBayreghVWaKeaeX9n
+zfyhH4VQ6jnKArQjSww3KKMPr9eH8H9Ga4WGlTu+Gy0s0D5Nowo22ZZ6WVaqSBsEPHKYSqH12t7hmUELJ8pOcFaFaGEtg7MTOM8DRbZQS1iq0scW7ai9CGy1
+6bl7ywUjiNueWWi1/TK8Arc1pbDWugYZ2UrpBC5gANqOzyMu4CjWgg1cV1rNb9sWw3aTUkS7C1NamWIBZa0k4mfCFUXYeJAH9Voi8W+q1uCTrHXFHkoPvjas
+sHZyKG5xMpaKczOcC9rR/0b34j1m98SZD7ZnXl2W4APXFWzaOIzljzBumlZMyx9a5/WKdhf2wTgBDZOEEQ2Lknc4ctulwKOyAReN5L8ijtRllXWf48zZ1rPi
+8fGcPmQ58gC/Hc9ORmdnw/PR5GrW494mNuDRn06uZsTF9uPoHEBr53yTXKDcdyIhz2xyUjhTqc7VmjBf3mhmI1G04iah9FU1rQa2ideE39UtRmCFxZ9A2r4p
+MTyqClk2tclSrg+RkIxVYVpFqnlvRUopuaZDAmBFe5CVb00UwHNMga9kDf8O6X+NLHQNsTCTyOu8nP/sWS8EfxOkhih5l1UNF4342fv4HQMw/ZKDFFwBGLcw
+kuVSwoTKYesqU6Nhe69qXbHGnEitqvr2Lw044RO5yCqdRMS+sOF/Jmtf5Oq6rBgSDc1UidT1fGApfJGtR81ZQ4g2ACwYrC3C0MtCU4LeHWILHWFvIutSZMVd
++bPuPLOQvbAYaxk3vf5vcc1ztap0TRKZAA3gAM1A6rLIw4fIMKT+sugLjGeQBtw3dJC4rkAyq08sz9eOzTfObphDj3JjzbVczbWJVQ59l5Ww0FF+PcyhuK+y
+utYFOSdUnkSXpvWdkGy8s7vQwF3CvprEHndWGAhoDIOrtONp8C92zeNAAy7Eua4K6wW3bQ/EeRkkY7ogVQv42+ubtYBve3X6cGLrwrN9aArU2MgYQ19fVkiY
+HWsIQ3ASfV/MWZE2+ToM7zszD00GTwU87oH3PGoDyntN4orYrue1Iztg30dZWWiPDzKBKOJMYgqa3hGfakIz2/1WwLc8TxC3gt0y16m2kY9okugxREPDx4VG
+DuBqTdTAhH93GPtr3XdPbOgGdnIgh2zzoiougimJbip/MYeRjTqSEcpPqM3o67B2FE1OiuZdmaWJuzoqrQzmiajalHT3tnwCrebQucv5hGWn4UFwoVmi4OGb
+Tp5UxlRvdL1el57QDRoaFTeQrtCnYrntEezseem5BGhmuC1Lm9pJSmzqW4zAti5qpAwe/eTJgjmbybHqWcrgYSBv0D/SRb+kDdPb4Ig6UGhaplChc8TBsxbt
+0ryEI4JbFAFAjITuZktmxqZx6RTVXVh5G4wKWRw6rG86xPRQkQS4GGPA+0ojQzCppcz2IptVqmpKFC/0vWfz7QI1UDUmqKMPERXN8lpXA07f9eLfN4QaMSdZ
+wxsyYxptiIwHb5sU3LwR65Bj9A2WyX7XxZVJxTxG/MpO10U4jeEN1dOWuzE2NYYZHqpnpMl22FibxzfctpCV+wbwIedAAXk2/AlTIP5wNR3PTscnbLK4VN1o
+v4RUZJ5RMQ4mcAihlLpItborK9gMRtc15zyDRmTbNKzdr5jCgXVMVWUmSkEMIy7tKZJlUx+Wi0OkdnNkvSwK1LVxBlsRPcldwhaISA3ZJomyt6hvjVRGWPd8
+VgVJxST6+U7US+IgX0duAlUFFwC5ZzcPO/D6LnRV+Qgb8fGdQG9dWjRU+jFpRsLLsmtRyjCpUAyx4CJFAyEc/+7gCKbh6OlXxzsG3n9M/t/jwdO/Gf/v0dHz
+513+3+c7/t/f4g9zs/bRAAfqylb23+jKdBSUKvAv9fAAi4AHWH4eD7Bo8QDLDTzA/yfPA/wAdy5dZg793oLXwHgDbrJeBt1EXlelStssDOTAqkwCvfFUvs5J
+wW9QQQ+QVzjkKMYOBzQpggmNsdrVQwTGcjuBsXgUgTG9hNcJfhEwGIcoVbGBxfiyqzXSNvyP/8NvHyAn/nW5fytttKrmt59GlytULY+fy6rR8kxf56qYJzJb
+LjUWDj7T8qIsVFHLUyADfnr0nK7s3yUHcLIjAY5IgIORX1RaQXUb+TAHcFcudhiARZya+zkMwMIqrj0MwAEB8G/N/7vy9qAn/11Yts1NpL+G8lGizGQcy1aa
+3yCg/w9I9ftloCxb+HtlyN+L/+6jFRYObpMEJL/xyaBtkPCdhhS/YKZkdUjtywRBib+vWZXg64nigpupfGWLyld8BpWv7KPyFb1UviHgplzEExhf0y0+X/EZ
+fL6yy+crPo3PV27g8xWfxucru3y+opfPN3BZbGH0lX9TRl+mpvkSXL5/t1S+nUsrcQAYB+Xr8bVRiLwo9Hv998Hn26WOCTh8xRfm8LVTsw4GJOLey4/vPZ0Y
+tGBEv7twR9r790HaC/HHL8bb27smvxfK3oe4eiH6E1L1ik+k6u3nOf0yVL2fStJrEqGMvNd5joEu/8y4qHVVqPzXY+21lLyin5I3IuP9VPLd0ftoFD0rwMND
+9IZMdZWhUmZPmU9Bish1325ghv2V2HLHxeNGkXgu2nbyhyUHFvgKdI1wjlKkSEUdDnrw5vwKUtbDU4QfkZImL8gS5lPqgkHH9oh1CfSREc+pXHYFY437ddkU
+Ke2XcTH/3RPn3rIBF+PlGMgVdJzYrB5PmZtvoMyVyohPpsxtedfY8rTxmk+nz/012XPRxN/x5wb8uZ9Lnzu/VZWa17rKTJ3Njfgk+lwP2/D2r/DsmPDY55Dp
+iphMV34uma7opkRkNaNH2W4OeG2y+lNodf+uWHU/glTXygqCgH0RSt1g7iJW3ZhR9+OYckFfY8upxZHLPRGdnnwMO66M2HHF57Hjfq9NhqUMs0Urd/yeVCDY
+BDjG8t5BINFDYulv7bwXN6KH2jVQp+LDK4mRkmpNLldlQUlMYR9El2dBF7QTemQPbT/jO2YTJLyjUAQ0VeTWY3pf9uJ5J1+0S+R40W660sJeieiWiaYupmJx
+jgX7A62ppM+8yq5dtmnoEo5ODV4yoM/dVGp1G5IE0x79ZIrgMIcpICNTWHkCluVLkARjUYwqM5Ya5B+GIxgm0HPlOms81YSZ15u5ga/1bRaXgeqjApYdKmDx
+yVTAvVMkPokKWPZSAYuPowL+e2QCNp9BBWy+BBWweZgL2I1ZFY4X2KpW7pk+SuDITcCHKYWBa+OvfD6H80pHvNchM7CXSCHl3UdQAfevGttpbifW4nM5gGXA
+ASy+CAcwSi2xiQM4vDc3swBDL/D1PRuln/S3D+fapvwVn0H52zZ6Hkv5+0UJfx9N9/tJLKGbOUL7WE3/wThCwxnszPcX5gcNuEBF7wuv11YsoeJR3sMvuY2s
+3sz7KT6W0ncz76f4CErfvyWhbx/Xkb+PusSQbOsFdJSb+HFbEPrHn9yN1L7ktxVzPrRBXazdof2kQxvO4CYqZ9E5vfKTT69wpzcEl2x687ZjLLYd48fR9wZd
+EF+Evrd9jEd/uhxNz4dnH8HeW8hWHCD5GLdiL5VvT4O9XL7C5ly4mEAnWP0RTL7khW+d+rlyettmetseWYI6ESVPtdNY+EV2kz9AD5y0Un4ER3fqrOK92I7+
+lgsfcmhljvCrB1sG+YgueZZiVzrxEXMht8zFrzTIj2T+3RH/doh/+yh95SMpfcXjKX2tAGz/Qt5n3lFaNdApG5cW1+uWLHmIvtd5kQfHIXvvVvLekLLACr2O
+BsNWZ3lffAwHLnY+agfl6iJjKGeQmQpZVAF/r+9xV1xv6XRHUH9Ox9tNbes7ga57qX0t0WgrU+DRFL8bGX6/FJnvgyy+lo5XIBfA7W9F4xvawNBUW6R/Eonv
+A4v/IIlvm2jrk0l94YePZfW1rsLfhNT3Pw+nr/m1SH2/DJ9v2LL4Yny+bTrfB6aNIgW5Y/CVjsFXmDLXn0PhKwMKX/G5FL4yoPAVGyh8A/lLWNY2d6/scPeK
+T+buhYd61118We7ej6Du/WSyXeIX9WS7IWeQ43XtYJpEPxWv7KHi7RBs9XPyCsfJ633T30CA+cuQ8n4sFS9sg4iLVxLMwxjxhYh4gcf2QGwl4n2IXzdk6m2M
+FjHNbbdgsWfPbcPkYRdEHUCyWswkbxFIPoI2V0a0ueIzaXOlp80VX4Q2Vwa0ueIzaXOlp80Vn0ub22b++VK0uY8h7ezLQ8OryNSq1sAbUN/qQ1XV4tGkuPJR
+pLjiUaS4vgGix+rjxBWP48SVj+PEFY9KJJKP5MQVZLxuI8WVX54Utyczh7pXb2HGRRZaMJ4JVpESwVwqvhQjbqaJDvT4V6XE7UB0HkGKuwHwyNy3vy717Udy
+3jq3uvgEzlvvwXZkt3Bz6gqVoj7CW6MW+jenvW3x3aKrxKZw2eGElHitRz+F9fYhxluZAdXWowlvfwO+241Et7GFGMY5hGIkVMx467dhm+f20qYCthfV0puK
+0KYNbJPyvkNuKlXFGbcN6Y29/LeizX8rP53/VnT5b+Wn8d+KXv5b+en8t6KXyvZT+G9Fh//2k6lvRS/1rfxU6tsNe+NR3Ld91LRI/eckWGY45fbTeG9FD6zx
+C9Le/idnvZXDvy3lbcR1y3ar+CJct2GNni/CdSsfx3UbEtz+p+K37We17ZLa8tp8DJst/w1RtvkaRJwDbIs2j23EYQsY8H7+WtnlrxWfyl8ru/y1wvPXYuDM
+rs/1OuKvDWlr0VyPOGul56wV7oFPIqyVMWGt+GjCWoey20RVK3qoaj+bqdb8g1PV9o3xV6Kq3cxUu3Xb/fY0tbBLkPD5E+hppSm5+gcK9fVjSWmdzhwR0oqt
+hLRZgdcM2+UxIW1PzVbxOO5Z+QD3LL81Yp39PNJZ/Dt+54APaKp8EeZYlI2fzhgrexlj/8aEsRCx/k/BF4uL8xk8sW72xBfgiZUtmljxOJrY4Regif2iLLH6
+Z1SpliwJTZk7CCm7sx/FHSv6uWP7eDKALla+VllOF0TPuzexw8p+dljxCeywmwcmPp0d1p5EvKHSDELVRS1QbwloYY8HT2WKJsrx06cvDp9+e/j0+UAeMVef
+ROOWotMnel8dyJP9ojIHcrxfVJk6kGf75U02z3R+IM72s+tKH+xoZf+O+V+P/mb8r8fPv36643/9u+Z/DYXCkRMKR88On744PD7ascPu2GH/jthhP/zC9LDK
+0sPqWqrmvbRfGKnyWlcF2XFt8lixkTxWfiJ5bD8P6Y489suSxxZVpnbksX+X5LGZ9YN9BHUsj2pHHbujjt1Rx+6oY/+hqGNfV87jo8zPOpV/abShqZyrAqer
+bIrUAkrLxSKbZyqX9/raZLWO1SbhQoELtczytdy/revVq6++ur+/H8z1PMvzQVYsyq+yItXvB7oY3NbL/MCFzLw/eJ6ryjnndwy3O4bbHcPtjuF2x3C7Y7jd
+MdzuGG5/Jwy38OohrH3Z1yn7zfa+PeO+ib7ii5/Rt9FV3KNRU5UrrQp5VcDDG3qD1fs+bq5cw+w8i+XejgT4M0mA/+m/mHm50v/1o9mARcuZsGMD3rEB/x7Z
+gP/pv9CDWXHzX3upgUWLGlj+I1ADx9OyIwreEQXviIJ3RME7ouAdUfCOKHhHFLwjCt4RBe+Igv8zEgUHmSmbaIF7m8dEX8Xod/jSOa3r20prudaq8lBjuS2J
+NxGP5R5FzlGT8VYwge7h+vkA+ygJS+V0vR2B8I5A+Lc5zJ/NHdxzoMUzHg+BTaySHMcft57wkLd344s//ahvbDORn3DmRXiMg57LzzvzO7ZhyzY8ufxhNLUA
+69lvRjicyDjwYZEHEHLoISMWjyAjlo/k6RVbeHq3devvkKjYd1a05/DT5gYjZ+KT5mbHb7zjN+7lN5b/9F9gOIGHeUd0vCM63hEdP5LouMXf9yB5sI3BCehH
+Ir8I07HwWU2fzXQsdkzHO6bjHdPxjul4x3S8YzreMR3vmI53TMc7puMd0/FHMx17VOfvjvP4n/4LTOAhTuB/fTQDsuhjQJY7BuQdA/KOAfm3ZkDGC6LOzMcQ
+IPvfOIv1s/mPo9GIz+Y/tl4Q8aX4j+V5Kb4U/7GNqOz4j3f8xzv+4x3/8Y7/eMd/vOM/3vEf7/iPd/zHO/7jfyj+Y/lP/8U6C9BCsdiKHSPyPwIj8qdQHsvf
+C+Ux8/9+/+ux/z7I/3v0/OnXbf7fpy92/L+/If/v4fcPMQDvOH53HL9/Rxy/I6b4HVqK3xaJr9xI4is+kcS3y3crdiS+X5zE93w6Hv5eSXw7cjFmyzVt+ZgV
+tS6YcgMUYoGCAc1wGLEqagx5peXy0Yy+3HQqwqTiDYy8tu0+Tl4lTV2VxU3gdAaJfZPhFM+hAzXpQfpOV+vrMl2TlPAdM1L5fmXFvKxWJSM5/BeCcM+sfFnT
+304QpYwzjCLOAr7WLLbyNYNKwcJC4RVEYtMd+++O/XfH/rtj//1dsf/uaHV3tLo7Wt0dre6OVndHq7uj1d3R6vbT6u5YWj+TpfVjyVmtrSd25Kw7ctbfMTlr
+Hx+rbPGxin8EPtYdA+uOgXXHwLpjYN0xsO4YWHcMrDsG1h0D646BdcfAumNgDaSbqqWSy6zIls1SBjhJ1v09X2ObvunTCVgtpyKSMgYqwWPpV61CaH+Zqlp9
+GgXreNFLwFqsH0HB2mVUDUidOuyKDhfchY5Fec6hp0M8QCa3Bc67Y6ckdsrp6HQMqWPDYh0l6+EbfPNS9RCWsvXZov8AtZ10bHt324ONUkWnfU5nUgiuldFp
+m37L1i4NnDpJxLCgH+Qss8ar8EcRxB0DCrEDNkWI+9xrKDqwDhrF/RRi/ELon51/121JjaBmgWaCVibL1yyQLJ/DUtXCebrf9lBa1rpaKABOgxGwtACJspDK
+xtCDBm052FSjmWclQqfdRIR0swDGKJu6C3xE67+2WWMblk60YaAPLF00ts4acjpqIsTXAw590itD4VGXUgWKxNqfkt49LAICRjI+beZXafEjJsYEQdoQxVrd
+99fa1HCtlFVtArY2f6OH/aGj0c4e6jG4RWxwW6E1cLI4MJwpvY2J8ZyqlbQnB18VyCYOFcGeoHQvLrXOumTBvm7Xw1isx05rwaCGPlnlrtiy0jdlFkHJTFsY
+Pe+lykW2SgLeg/uQ/np4EpDn/kTB8J6Two7dDotlK+eDm7ewrbai1R85AOnpvfblii7ugRDDno5svOz86w9PbAeQaAmyYgWl58vP68+OInZHEdtLEbtjht0x
+w+6YYT+WGfYhJti28urif0AMK74IMaxPhRGfTQwrd8SwO2LYHTHsjhh2Rwy7I4bdEcPuiGF3xLA7YtgdMexHE8P+7uhgH0sAK/sIYMWOAHZHALsjgP2tCWAD
+i+/xDLD+RyIAmX8mBWwcYP9sCtgNe+PTKWCBUu9LUcCKHrjojgJ2RwG7o4DdUcDuKGB3FLA7CtgdBeyOAnZHAbujgP1dUsDuSFz/cUhchfiRd8fR4KlM0ew4
+fvr0xeHTbw+fPv8kjlfxe+F4fQT/68nfkP/1+Ouj5x3+15dPd/yvvyH/68mO/3XH/7rjf93xv+74Xz+Z/7XLyveQXMzwMFGEEqWCiLM9Q8rXQ2vB2WMUaZ0h
+6d5myldquY/wNU5G8GnANvVBBBAaglH4BE/rc0OHQg+naou+Urg8dMfqqm2cUd+xDrojbt0Rt+6IW3fErTvi1h1x64649e+EuHXs+CzFjsP1d8Ph+onsrX6x
+/16JXDf08HM4XUXA6So/gtPVSwMHthRtWteg51NOhv982lZB7BkW79YXOgx6l1iCV7mB4FV8AYLXU11lDANsixlCkS+vW1ALD0BvZXWRNwLdIfGU7XhQdzyo
+Ox7UHQ/qjgd1x4O640Hd8aDueFB3PKg7HtRfhwfVbewuXaLjz4zsjr9L1lM59tSkneynqCnkFtVS9RkyH02e2m+QfXnu1Og9O+7UHXfqjjt1x5264079m3Gn
+dtlCLXvqJsdqP49qL6fJJtaxfuLVQEKhERkSp1rnfPuAtJDRjz/e/fwsmS2CK+Z8skvqwnx3sj/1ZIczWC76Z150jrj86CMeLdyG98iPOeuib9v2bZrHHXrR
+f+hDbMijD73YdOh7GIJPR9Pxj0N022w79qRNGtmjTCZ00Hq+wUzFz6dITsI8xT7AYwA/oPwsVN78pg9ylgIahK6/JGC/8zsa+OkG7dSgQP0sFzjdfcN34b5I
+rXXXQhDPiHzlLUpFMnIfEoqWDKCDpmpJAgrc9Ypu40mOH3yPmOdaxVWrKUWFozpwDYNhG20i/C0e1o1ntQdw1SG8TR5/TvvOqF+poEUiSkORDX9rI8IwmkKG
+bkouP6JE6+RitC/97+THXvqrpprfKmOP8ZYJ8Yvy+ZLh64eoaxlJzbJhO1ftZuUg5q0VMW9tCNYouW6h6j1Y/lU2oGb5gLcS44Yv2EJJi5NEVklW62XIQQZc
+ldCBHTvtjp3209hpYX+8mSIl5eOJajcfqL8daa0l1nwMZ20r7P05vW619BsS1goP4wg2Z4/CQJpWSG97PrkcnwQhoH9UetvvSCeIeeYDWRVqzWb7SBzmOAzF
+tpMYO4AF604kzz6mN+NvYZt0KhkkNvhA6aoOXOXKF1hmn0d4WHdEvjsi3x2R747Id0fkuyPy3RH57oh8d0S+OyLfHZHvjsh3R+S7I/LdEfnuiHx3RL47It8d
+ke+OyHdH5Lsj8t0R+e6IfHdEvjsi3x2R747Id0fkuyPy3RH57oh8/2GIfD/xz8n5dHz4h3V9Wxa/GgXwdv7fpy+fH71s8/++fPH1jv/3t/iDOClMVu1S/ra8
+SCdlBRnCLqDsaD6nTKPKmELUKB0fpwIxvsjmWqpaHn3z7XN5UWlTl4X86TartTytkNNkip8l8sehPH569O2R2N+Drbl34HW8cUQjNKluVJH9lbqzv2cdFHsH
+FojEmjAGdMQfLnCLu5vlaHA0eO/zUqBBSw7FjF0EDvBMijHtjjI+YYUy6eT+nr0m9w4sJerxoB3Z30zZBUtAgwgvfJgES0bBFAfeddMmhoF/huQwiazKtcrr
+9eGiQi6EssrTw/ss1cIie0PkGky1ytd/1QnG7BJ7AbkYGCUkc0Im6LerSq9IEXRALrbW/e1OC4ja831mdCf5XaqcjQwC5YWN8XL57KBE3nq4kKpxep70TBy+
+k760bjAIKZerNWpiiYTIdCL3Tuwncn9+II++/fbF4dG33367ca8Lu9dlsNe/Q/tgSn7HKYf99ogis6xvZaVr61prJba1hi67Q7dTjCpJACcZ5rWuCnyUDA2Z
+Z7qBQW6akiTMRVujFkVWsIhZMdDM2i+XWV1bReQvTVlrc7B5GRhl9R7xgLxN0C1XLrOC1L2s2LrqPUMXfUN/JffsWd7/MTzMB84UdqS1AfxEbDx7WbFxxlgw
+tkkvRV4SFJIxMOMClkLXJGpaHCNNAdTGeJQMOphrn/lRiX0ILhZkwd2qIs31wSsUkoPj46+Onj59EXrbad1UbtDIK695UxGJEKAc36+ZZ8j27Cd93dupq+nZ
+K3lb16tXX311m+YDevWg0PVX0cv3EHYaOQUCyh1cHOh56/Q7NMu1MjRLuMpzPlJtkjf2ZiKSqLZ+LGS1soQuzpxvv6hDT0ySSXalM+qqRZveh3R5Qy4tArgK
+3o64o+ElhOCNtjKY8LC97hXrwBQZjVO32YEpLCsyb7LAvPp6EJ/IoiRMLzzp4w8UJYZ/YSQjCfjg7EEoKwG/4Z66X0pjJbwu0rIymoPzy7J2+ABs3UEPy4UP
+cFnATBB4bPXXCnJ8l+0LpdUXeu4MkCDm0yPa2f5BWdm/XWKRKa8bSoIUmSVLrZZOJhxSG//S/ZPsQbMQub1TOdlPzwc01Sg3fu6QjngxUpd+4CVsD7k3nMnx
+bI9CbQOcffl2+MfRTJ5P5HR0MR3NRueXGCGaycnUwnTGo1kiR3+Cr/Hj8duLs/HodCC/fyd/Gr6Tk9di9Kfh24uzUSK/v7oE+Ls8G78dU0uJjN8DRu3peHZy
+Nhy/ncnh+bvWm4V/8TtMUx5NT34Ynl8y8BA68Hp8eQ59eT2ZYgMXw+nl+OTqbDi1XHbw1OUPw0sBjpur2cj6cAJCgLMz7Oj4/PV0fP5mhA1d/jCenmJz7xi6
+PxDiBU/47Ich/+b7EQIhz0bycmIVUHzl5PKH0RTeN5113vh6MhXwjvH5yfgUBnuWyNnF6ARygiZTyA6Zjf7b1egck4ROh2+Hb0Y422eT2UwOZ3Iop6PZ1RmT
+9YzP3yQCM7jfgbdgMg0yPc/fRG9OJE9TkPsJ/RxNXicYu5Hj12J4+uN4Njq1vb6YzGY2NYyfHcjZ5O1Izi6Hl6OZPJ3gTAzPziY/4S/8gsMCjv50cnY145xT
+BxqVswk+O/x+8uPIbYLRVL4dvqPmLi7O3oWTirhhVvQ7yhJHhIJA3vWalgqwkNlyqdNM1TpfE+SX3fBWscILCJ/mOP9S1RrrK/QHQR29lRObRJtFD2eGtQmO
+9c1VwfBNEF2rGtmmoUM6/U6WFYIrpcneQ/zzxdMDgfFPf9V1+hgd5oVUvrfULJ546ksiMi8QMUgKPbFvp25yr62nBZUh6Mxhqtbs7EN866apd06S0PVkS3Rw
+pAblep5bBISxzIu2rAdIrZoZXn7MqpusyBTEqew9Yd3y8D38JiStPi/rW8ZKtfygbT7GgBoHvdTMmXqbrSghCJxFCWIjC13BxxiT/HOZoSOywKW25iSuBIwy
+0Gi/Hcjv13KeZ3NCpJF43yPOyD2Q+zW6xXWlfTqKZVTMClOrPEeYHGj5YPOVVWR1tAV8oA97NQDQ7WVTOP/uprSCfnNtIH6f/p+Lv6n/58XXLzr1n16+fLbz
+//xW6y8nF6NzOZtcTU96Kj8JMX57MZleDs8vX8mLs9FwBjmMw1O8gF5P4N6Ci9I9L0+G09Hrq7Ozd8Bo+E6enI1P/ohX67k/zT/BVSjH56dAITQ6ld+PziY/
+4f0Kv5hcwF2cyPH57HJ4dkb3skCd4KfxbES3trx4d/nD5FweDV5gIFjJo+Cmfje5kqAsnEKnTuEm/GH4I4/qVFzS9QlAnhnqUSeT89PxpQ9SjWfdiRj8p/eV
+OXEpSFyyTh/OcMje/1jfmWix3CPaKNeoqjP68f7+frAiKVRWN96kNjDsspDD5qYxtfw6EcdPnz6V+3u+a9dH6G/7jV1tmxxrstexJj7RsSY7jjWx3bEWTssj
+XCyJ2OZY65klIo3y7qzwfV/CndXnzPoYXxYirx/jy3ol97q7O+n6kIR5xKbKik0dbbuQBKvav5kL6eio14vV60USSvZ5kXo6Bw6kh/xHR0d7g1/JfxTt8r9z
+/9FDDiJXNiscFHmHWt6J+GzvvBMbvBNeLbg++hjvxPPHeyegjS0eCmE73OOhSD7KRSGci0JudlGEA97mohDsopCPcFGgs2aDyUpsRBuQyeggUG0XgGAEYZ/0
+3OqZ+Hszj7f08EEzWXgzWX6qmRzNU5Dr6pJGKEsKmJ2ZI5jpprc6lWVWiF/VcYwekE+37tmiTwIzv8+6j5SRX8O6F9Td/0w4kcD+P3xzcXZ44lKpvpw74CH8
+x4uvn7Xs/6+/fvFyZ///Tex/3ATMaHb2+3QHDB7tCJDkCBA7R8BjHQGDT3MBxPCZ38Zc/z1Z64PPt9YfgMHIPhiM6MBg/uMXQME8Pzx++vTo4c0boWDERhRM
+v8dg65jFVoeB3OYv6AW/iC0OA/lRDgNBXd+GNpGPR5uIzWiTurzBtBeKokfz1utFEJ/uRZB9XgQR2PLPHu9FkL1ehO2dexQg5ejZb+BQGHw5hwKedfNpzgQi
+4mVAnPclQF5pphfSNMslSFtb0uFWFTe6z5UweMiVMNi5Eh7jShj8hq6E8K1f1pXgfAhJ5EFIHOJigzrV41UQbeDD796rgBJJp7pSeT/DgggcCldFVuuU/Aom
+xGRZwoqAHz5s2F290FhCDB58X+j3KJTxsF8NZgP3G3its++xmJBLkKAeCVI0l8uyuNcqr283eTrsZ0/MQ04PGIbN+LcwK31TohEO33HqPLGwxUqPl7LRfUky
+2AtdDKhQChOIHre0+Ny9Mi4TNE4VCshE35xfyTdcI+aCHLd2qfffXJwdJG0vUGuKhJ2OmL50g58HM8CYSIWypyzDBfZIMNGLo6kAMWwLhRn5dSKf0yXycouD
+QbK7SXwJd9OXQGVsOEDis91NIU5RfJa7SYbuJvG3cTcNfhV3k/wtvE0nF8Ozw6PB018N/PGg/+fFy6cvv275f56+fLnz//wmf0gmWvk5rD13jt2QYbrdPmyX
+A0xuORrIvVO9yArax3vgEwEqlL0TRzElr4ze46KAMS1PeJgC7O0JSGCsLp+28Leq5U4+ojc53lv7GgTjUW1xukls/Q3iXaTHtauaYItRgCbxNozK4SuOo1fY
+idgLyp33FLOeUFn5nAtUIDOJiNrm6tJrqULmXu+yedsND17zaDzTV1jWHTv7TO7x9OGbw15GXZJl1XpDWbXHIvrGgv17G1dSzwqaceQd8krQqqwsaaulQwF2
+gb0R0n2URTaXp+F+eKvBgsnM0vZayaX9yJNXuuK2FvrtHFFMzkcEE8QfUq99gVr/VpscZekofYr84LncGyGnK2w5249wRq2PAl1BAU1bUI8Ex/lC7lmm/FPq
+l67C1Yir4fNeDauBmJBtX7g2fAaNe58vTsSs0tdrOXp/m11ntRxiZ17KvTNV3ehK/lRWP/vpRWuSuApp3XVrsGXVWUVidZjzezu6M2nhressmOBvpPU3+tnI
+jHMMYneBCpefCpchoPNwHCSoeTjleaneY00TUqKFZZF01CEWZ4SVtB1xPq0StoT6RZieTuWEoBxLSGwVV9qel8WdXju7HofwrdyLDsleUPs+LKdfVjLVuY6J
+PqEHlkBbmLpq5hY3wIQxvccZvRWsLbfEmISqIyLexiH6ykglja6YSWGR5WDNqKgRmQGb/pDKZDw4AhCytiSqwgbD4uK2667C34ZuC/E9va/Q99QICXNfKWUd
+UQ93pmRju0eDo6dyL3rerlCrmB2wgzS1rkKywFTzockgl4WKBqcfezBh1uNbAnVCbDhpb9SsdssVsBy7yzljqzCvtErX8QXaqWjkzyTMAhy1CwWLJU+QwS3c
+qUQcSFTS+5D5V5T3WHYilczhSVWG/SnZZgIncqnr2zJNiEzAcHlOtUICi8bQe2yxhLXgt3tBIG2Bb77sjo7kXjDdoXhdObYBEtSLULNwpSRZ6xCdOrNZHVrz
+XMK1ybWRmd9/iVzlDR9pj3L05Q1SpxfZIwVbZVXz1U9MOXVVEt9vlodlW9HicLewKqS/lahAclDXKM2QLpr4hqEpVWXIwmopC73UED1Sgy6xe53nEt2/SaBz
+xZeBnUSncjwxYn5bZnNNCYrh1p+rgkhopMI+VdoY2jaqmt9C+lWr4h+6L9QK3CwVzKRMtf0dK4upPqTfhiV9ZWYkxHDyte828kkWJZdtpa1yLPfelc2e3C8r
+/Fu1d+A2eusyVsy3yJeyfq+rOdn4TBxLPH3sagLyILvfw8vBXoRJfFSxfaZBshmubeMPPQuWDmXGSXTgxJWvyyrsHLKX0bhsyV28p7jn9mbHTWYQ1sj/yFEe
+4E7KDL9oTjYAP4HjEe/Khl7K9Fb+ZvebO5F7/Bt7AIFmAw9heQ/zRJznIijUgRe2JzGiD3mVl6pQzAJqCzfQcDwBGBrnRV0xUZMzInA8+9cHKKIq6/1A+hDU
+0RbZosZ0nDm0vv/86f96YHXcsqmdo8vcYgChrOS1LvQiQyMmajLoFak2x4No87fVnmNmmelohPJNpVxtze63HBLguOa7spEqCFu2A5oRNtmm9idxQOoh+lgS
+wa+EgDWkfbG1Esl+oAKzvC4r79Q5CKQ3LFt3iJYN0sddXXUejrC6yCsOhYclWjxQ3et/v0d3PaBTGrAxx5aMLc2hjNMrlAw05++QMVXsX9u5oZvT8NXpOGyd
+Nkz3C/OJk/MKHUnQcqQFJTaglFApBrD3EpqZFezzDCYGfswBZJD4eIMZlbsiJsKb0zB3padWfcTUDIQA8oP6VnumzYD6Eg+9lUXHgyPYH1hf4PpAqkoHFQk5
+0pequm9LL7LK1MHKtZSggAPKClDR0lr20wPZ9k2HHYMOXZdEhGH3ZO4VJR7TK3l0gPcEXqGoV8L5QlVWe0U26tx38vjA8211nhH0TFnJZweyTWlsSN5h8VmZ
+HXQQwO2Vcrpxxg8/5GTgrY3HMSR6TjXVx/Gy6Di8wp0Emn28mGAisrC1GInxURLLLtKvJXsiL8snih08/72Vjq3ySJ4mFAB4S4ST03vuBGtnFGkNirxjsDUJ
+l7XldlEtY52FwEcKr8fJrqQlvN522Q2sdyocMY+NAB7UP+bRdXsZx5fVJvydI/eKZsxevMGvDwK5ietJIrIlHkUgUrkTfZISRMI2t1vYwd6lxBkGGdFzXje3
+G/rwemdDPno2HpDhLCUNyAArv+GvW0V42BES3lTDPHbw9llYD8rq44+V1VS2xsrrSO4ow7I7qF7UM4cgwkVXhPc++bAUF6GkjI3IctHX6GaBLh638Xql/D6U
+rV3VVm0RXUPNvv/A6cv2XsD76usHRAKJz9gJCm9Q165iy+O6T7cQ1NEOHb8Tz4PurikgZhz6olxBsI+15fiFZOrAfcNxUCICDz62ndC4161XRGxzWz4Q0Q82
+Mtq/IjQBAvLKaOYYsxXSXmJMuaX3dGzCHrux3V3v0IssR4GBSTjrMA/LxtRBiW9f9D5oB/eYvtPVOvjemvekmEBTfgwDaprZa0j6lpXMlihZMS5L80u+82Ce
+HMAPd4rKa670VGloGyv/3EZ8wZvG7urOzbNVBif1iVUTHLN+wCdvu+smoghrSVtntHA1/FrFprnlyPdnd8OzwfNgEx/LIXklXFmeOFAA3s1wJ3/URsa1vKab
+TXinTRZFI8gB1lWr4+nzSghWElJLLZEGA33DoffJL0Al7zJFpPAUkJEbAjvCBXaYBrUsNI2pXMo1rkSqN7zFjYq0l2zRhltCHx4MKCU0VUzSHPuJbLFiWd/r
+/E7L/aOAI3VR86zhVZjVNlqANMBzmCXXWCLCxkz2Xu6/aDWkelh5aSWioF60IYSr29AaeF3S/e93PJ1CVXWrR9lCTiKoM9MVVTQ9JniHvtOFpWl5eHERWJgV
+DAL1ldJcuNYeimfyVJMrtE85GgjhBBX5iFSex0J004FglyouMvpcXc65PcQWI/nObjuPnOG7Klao3eILZrWhBgZ9stSRg1EVNV/Ipm0lID2errI7nSYbCtkm
+pJvEFp4DkPKl1Q0OkvPY3lntoipdazwrnLMukO6Js+w52MlxjKzYcEqpPlQPQWYWCjQrLvF1JY4Mfhl51np0SLtrvpbj0Bq8sNbgW1XDrUFm4yVuOKpXQFrM
+QI4XHVXRVfPie8cpnYTiijbuE7PdDM1a9XzJXazDSmNWB+8zCqO72qCrsKxAp0iix8K9Jrj0MuxwX4gkPNIRzoKLp+2djd4Mz/Z4QexiEA0f2vNuv7M+6xEZ
+9HVWYGXKDGsHylTXKsvt/DkpRAWzYH5JvtsjOa87C0F4cSZ68ivihK5oxR/bElBtvIFbc0cU/Y44KiiYj1NC88jpo0woH0092jv+vbULfAlf2BGqQlu9vNYr
+I/dtLRQ4PFxEMIhuLJmxO88MwtpEoe/NTVU2K3PA5P/qOl/LucrhUuCyp1mBt3mN5XeIXX6uQY50gTO4MIW+D2bWXSUWqT8gd0A42OHFuHNknrQCqJHa5HV0
+mOGbSi2XlCprw2CYfLLx/JWLlt3Huryge8zNg6/hANOwtPU1cJTDi3HPYcG0BHtiUNmh2ePtVLQ2ANvO06AkBobOw3Z9uUJ8cSK94YUVsEqjU1dDS9RlsCW/
+3lfO1g2bvNZ5pu8sAfyWSccJib8v7e1gAUz75qCnGjjOeHByWShZ9EQvlKEbLLZi+LmcWml3TrXkwwvbF2qAVv294cPeFiyEh65ctEXXQIwXXNEEa6XaQqB1
+KVcN46J9sypUmyKlFy9/KpdL1dgZRcFZF53ru9MwZtWg1wW+sgWQuYIaXdNltT5g9KjCep7yHivJYZGmnyEkWZcyL8ufyRUFb1D8Dhym1/LTqMJKuO6wpNZa
+UWkK+nJFlzr2KPRJMQiGBxGJRg8H8iPH8xGul19uS18RXeZB1XY/zzT66HbvmmAiuuXps7BEYXzju+HOb8uSEMFoh1Fd0tqGdaWSC43qbRIUzoTSO2WF0ftU
+LwuuKOUL0QUl16CpcNZ9v9tGe4/hmJbSlGS5lwVsfxyhvNa3Kuc8ITSF7UebVTB2a0VOErdGcPXIrJbqGqs5wJaa51qx09szjGwZv9gwfpkZmlc67fBKdNN6
+V0GYkMTkJtDkYi36RwK/I69B5DPkAfo+uALc15tasqclbKdVrbs7ZLFhycnghQHhcANR9iI2F8tFqNuyx8xJNxUmIHS8YcEPCXACOyOzFdF9pVAwI/ylcHQI
+8hS902TiUUXarilCi5KRyPBaIB91U/NJ2mbd9UFgskCtEQ+4CCJAjExjA+62vKfcONgzOF84KK6FzupJcPZkNA3kPrOjsV6NeVmYVTZvMPmDgUUkzR5hkiT9
+BoksKzEvc/iGEnz6zJOuAIuEVVvTGsie/YEWc4+htOjgK51gFPwuW1HHmiQlrnolCWmTcI9RQLKtS+vlYEDE4hSvnTMdXaSTU7c3lzRsrb8g64B3mO2cvRN6
+xuqTlepaL1c1XobZMkMkCHr6ZOSxe2KcRVVs3MXOY99XwznSWCRfsA8virX5/ATa0T1KDteBg5NUV2rI9tX3Cee8X+bCPMVWvReHiUdtbb02tgvtfo9BInva
+/lhBLjb2XPb3fJtwt44WfGaz7H4ZhjZDIW3zoMLvKZ0Hwi1whqMTGMRTLKZZPArTLHsQKOErYUgCQqe5S1/CnGVWA+cKjmW8wbBCWfvGsO8V4SbygtUewK7T
+5Gt4nbsEQcnCOnenpBRDzmJD7uWpvmlyy8jgtO9s6XRveAf0tFUsD1apBXaLJUY7E86US20rPPZdR6yvG+paIv/cpAy+wrr0Ai0w29dYj3+Ffqywd5sXjutA
+xGBxZ2l855xfkb/Kx32M85PAhoGm1lJh6JRrN4d3o73Mwuur5Xiwr8GEhsBgxNd0fu9ghqGLx/QaUiMyTuPE0lVVoinA3ii/C/zMchnXYBjCdsNbkfmaPUC8
+BwMXEHQG6+qBtW5+zvKc0+BsSryvJC2zmjbr80E72tcyP6MURHQ6aK7eGPqD+9XJW8U1YW9JExF9dikuaunUhu55ejFwOmGr0J8TS5Dheq7vQ91xVsIOppKW
+42I+wAJB9iPAoCpfALDSdxni8cmEbxUBDCt1892SLXHs8P+BHKm5K8zoauJzuWzcLVlx02QGM0vtY1wz0Pf/WI5wK8MIWyOZFPPWeXX+JB5AUA4wMMtbCqhV
+SZzAVvm9WhMyNStIAjQGb9tejVS50IlTvASast5cbIy2V0zQ257W6J5ph2RE3NNgdNdr6RcP8nTJgAyzgOy3ODmw11yqSuB2pA4EUc2WDey8Al2sf7BWEEZx
+Sdf2GozcCrKscDKUr1i5KX66TwdozYuCtTXSUqKLPiWcJiady6x2x84mQfQlH8go+UC0/EkHwdUHgrvS6NJAPdcqX6b06ubqtlJGGxmenUTsQR7innTAkGKB
+WKV8LQ0VX+XfybS0WfNaVTCmdfgihk+AZCpKGwiKniCFznQ1Oo+BjDIZtcjqUEHEcbXOgW3bZbMEGqQMNEhK678Yng3k/uuMAFh8iWyN7fTpXkkX/x9qYbbZ
+bQ4kX4WWj8nSQNjUxMno17oLh4l2MWWPvhyEVSsmrx1/x0CIEyjdOQIqrtORHM/kxXTy4/h0dCqvzk9H05iTa3Iuh+eOpeT74Ww8S+RP48sfJleXrk2gvgD2
+ij+Oz08TORoj3Qazk4xOA34SoBg7Obs6RU4M20rIS+LpTQRwhSDRRbu7r6cjJA85Hb0enVzOkoCOBOhOXo8viYUk4CARAQfJ+eT8kBlGxudvBvgKYPeYjuR0
+PPsjUHowe/l/uxoiuwbQo1yMpq8n07fD85ORmLzu7ReMB9jQBnL2w+Tq7BSnJHoIJnrE/Qbi0PG5ILIVIBy5JCq1fWAzgebH52OiHBn9ODqbXIymQBIyfjM+
+Dz+LGE7EyeScOEcm0wM5nM2u3o64pzMsxgJPno9ORrPZcPpOzkbTH8cnuBTT0cVwPCW6k+l0hCVcBwI3Qv8uQlqUy/HlFVRYGZ5LWGhiSIFJbzO7DcT5xFK+
+dCZlPJPDq8sfJtPx/3l0KoHIhLbh6E+QnB/uSd8VEtVQ9GM0fTs+H1J/xTeYDNAyJAIfvA0WOtwKXeaeBiWiRxHsFFpgMC5W0EmicGFCeIt7qKl0T+ES8eyp
+xMIp5UJe63mJgRxFQGKSMvQ4VcL2qFjTcosQsockGfyYIqb52g2NZIhpqju4uqz5HKGbwxvvwlfb5vQ4MsyyiimVY3yHq8Mtr/W65MkNXtDBVkXdwRU6tt4D
+wnvUWuZZbR2n15jvpSsqs27DVyFMkAOm+45zRaR6nisIGIBRc4PPKIr4Hrg0rUeJcHSexKjijzK9g18K+2IM1OImynLeF9Q5im37sr3KQLJgVWfzbKUKUF9V
+nusbiyzhtBFsIHjsST+atR/44OaRMplocjloE6bgtg7L9brzWugwDIrcO1GAnRVsgML37oWEqIZe8HEIqwcFLxD+SK6qEq1crsNoC/Mv+GC5lii2jUHbFerY
+1DTqwgTBeoXFlsh9E1Tzr0u5UutoeEoum7qhlHh4HBVJFzHVgqH21nqv5EoZogJkGGFjtiA327OZCFtlCYaUVureamvuXNCmbxv9G5CobvO1X4SOu9a0uYlK
+pAqGaLMJ0DW5Ums6VlWl7DFUFSWpxxOV0uoGs8t+nhXV9hbsUukMjTVdOwHE4NazE6M9EmxCuWkTbiK8Yu1Xv19lVUTJQBNjNyYVmJIw5UweAHFmDu6jjeOK
+Hd2qKqW/udSQJDRgtp9di8tPHjy8svfwxlNl56bv7Hbna/+6A1iv9F35s049cF0oZ5ojYI9kGmHWOZ8qTaQp8zQsTUuMP7cq5ae25DaE+xTuiWf2nqALYett
+YDd8dIZD8fkrCE6Oz2LDbg9X2pT5nU49ROV67aMNlTS6rglecSCQuMQeY74M+Y7uG6k/Mrzq5M10MulO5Y1uWUDbhfhGeJQvLA/Qm0JmRV1KNZ+XDXZKpprO
+kYPvLvGbspKuEzRPa4L6OmsPF/brmF4SehxoKK1OfUOdArWBER7QM41lp3XliiwHBfK9ZuD3YVmRZ7HSRue5rswBG4MuPgjTl6WBHsUxBDaAg5YC5dGvYDAA
+QkRZBSz4hnTWbweBvROVHBwIQbru+USejKcnV29nl2BuEG2y+4rcm5c/jCbTdwnwOqOxdTmZXsp9Z1yJ89Gbs/Gb0fnJ6CCRaBYMTy5jGsKE2RPfTa6ShwwO
+EXzmzA0ZmBuewNDSHk7QXgg1ffuMmF1dgDU4tebA5LWcXZ38QPYaEFtGZI7wxMVoOpucS8/VeDoGKyURjqkxpG/czNrI5scPQ5iO0TSwRkWfNWp/99rSPU5e
+yzeTySkwVSbyp8n0j3J2Obm4GL4ZwSy/vbi6HE3F6+H47GqKtubb4dnrq/MTao07D6sJ827n8C2Yrz3ckqOZLyjhpucdLxpSbH8/gm/PwS7tp4W0E8sts0W3
+Yf8FZJpBcUv7JUzB6Wh4+QN0j5ZjeCbG53+4mr5jAkwgtXw9nbwNevtkJv1OtGb16E+Xo3N6yfgEV/ls+JO4mE5+GH8/vpzRz30nuZrnH66m49np+ISYUDtF
+PYMynlMRj9BvjY07I6F6n+NZ0A6sk29IdAp/gqVPRF1PB8Qn+QY2+zmSlI/OT4lvlFNqOtgAyOx3WV5ZrZfJHhETqRqFhrREAOQk+vobeTJ4PZgOxPHg6OmR
+3J9ATASIpA8SDOeTE1qWi6jhDsXKHsrB4BHReSQO61O3goihIvU27pU8Oh4cHx2L/Zle2X4hvs8yIaPq2n4c++I/PD5+OXh5/PT4ELgsq7K5uRXuo6/l/h+a
+QtsRwyVAUw7zWkBv5ahIIV2uMpYzpWUzQw8QO0IQT1aYfJTbUQzBkh4N5Nvx7GR0djY8H02uZu0wSQRX1OQa0HVwEcGizHWFd6SlCVginFpayq4xX5PWBJdd
+sgp5q3Pr9WsKXSzKaq6JqQSXxP3WX9mVBpSMzX2KI1QR0tMG3oNWiY5SxMZ7i8X1ROXZoqyKTLVoTV2uXPROEbjikZE1w1E72ITx7t2DkEk1qz2F6mG5OMzV
+vfDvGsifWrYQ5JpizrsDnriEkbKwuGs8ePOszv6qQd9nakvL6TG/VVWNG4Zidpmhf0HrpbwGBziankWXoBaMh+FSV9lcJRzJdwZODHDp+Eau27zm4s9NlZk0
+m4f56K+ZqvakbKraaeLnsHF1VTDYimJLfoES2vR3umi0JCoViIOqolbyJFeVguYQ6BX8xod48xKpFXDqRDvvZV6a+gFeXhBMTVXTo6x/OXVV1XVZFXptnsiF
+1vS1fr9CrZUwS6odtHRzfs4G9UlZgAqJxlshT5i3xLjAPTGiW0r9mSJU7JuyTDG1QL+HM2zyNW87nSIlFrEQR/Fw2lBux3qojipuGkVQa+WJU+zCChDMddXo
+1Fko8Ku0Il+Fc/dz+Ke1P1gSHQ/QPzw5n42Dq5iouiHLzVfDeJyjyrpEA/OH2QaEOySdxaakVfhpqpbqxnPzbkyyEbAXmF6rqbPc1sQoFzICY3VwH2jyWYgN
+sOjRJn784MKBsWSgf2vBWV08OIZtFigC/tJkBF0iInZLFGxdvBllqhQpSQdS8mOS4LKwFQ+Iic4S9pYLj+vhNX02kG9BYbo4Gx2yi5xUZIgytgdK+Ddtshvy
+hwVZ6h2YhzLybZPX2SrXhzyp6UB0PnJsXryPu+9EwuHaYNCyLnkFtdj6clrQAFDnnroYnlkSTMV1JQIeHeMvBe9n2YR7suK3i+8IeRlhkr8eyOEplZoBg2k0
+fftKDi+Zk31yTg7VoaEIrja1VAE3K99hJZVzIT7L+jYmwuylFdnf625LKBfD3b4tV9pWXjfMwUziiUxnWOtbna/EnxtTu5h2tkQSjUKTk1YvQGORWXGnDfMP
+OGdmhyBVRB/Fuyoog2Bn73u5vxeS1I59YsfegVAhforGgUEO6GELLQodjQj/qhg4JjIjc9UUiBxB2WHTP6umsDFz7qDhbzksYIi4DPRAnxkobNWY1rL4IT8x
+csPI5L7y2va1zsv7A8LllPN548p0ItN4Nqd0BJ+Eo5ervFz7LWudE5KcJtoY0UVM7HuoqV0E1/8ChrjKlbmVZl5pXRzYAzJAW8Jkf3VHy/YpA6ksza1Nkpi3
+VO+69TtUuoTOQyCc3jQ96DeG72k4PQMVLfxne/EdYNVBCDdM5qKsBL+lJ/DFhG8B0r51h7qexoeSp5btmE2boJ30At94R15WCKbpX9J1Hy1RYh3GqpZ5eZMV
+CRb3UNdlU+953hv0DDMZugjljaq0kmXRng5q3BwkDsOT2tgC9gt7s8AEfVVvHphBHfieDsdSaxYVPr0SjzNR+1EKDfq3w/bCBC+LYmmRHeC9HkQTqJIK4UQS
+Ee9UjDVBVZpt4gafykxbP445tJdUBys+vsSsV99qox2/XhJmEeO2gVvQiQ3KI/I5mA2XZapLRHqFsRFeat6guGR0gASxDNdbhJB0WO845WDT4omycpCbcA0W
+jm2EQysB7Adz0rW/3oOmxbjVxoZeOtukKCUG3PzcuWCG2LTftnTZyR7OXMd1ukGhUIm5rmqVoUGy5dLyYDTSFtbx9YXr77Px0WscXe3hlsddHEAeN11hJEYI
+RQ1np5/EzHqLwyhHIq+bmgQU334Cbj8ycVClrGWG72bzAqcpyM7sKicJpckuykpvutznqoBX8lADDjYriCy0njULbEkVraJR4eKpjZcn4YMDEeAxoJuy5bs9
+/o7Y7kDhR20AbXaUHGIfRgKuBY1tHT3Fh8zBdxKSSuHBWLS0U/l7XoZRTSBQYhE0L/Pch5ipkEVHyOxZDqjxIthu7mbzibvrTTPlAzeVFhRAjfHoARrciqUt
+zVmh0x0g00NBU2reTsjP86BuSGJrfkj8p42c+lIiRjCKjgHSrMdu6lJIvuMUj7jqAAPxPTmdy/qn8WQ2cYdQ2vauuWa3I7SK2z6oidL6Id6zzLyaVfNmSZTf
+gauJflzXBJ9kg1+FLgGja1k2dSf3hWyL5z22xfnoEiMCV4B3QkUN/bh7o/do8cDygKIIKx3SKSO3Wog4T6iUApLsz7dUC+iUGeD0rXtlMzysDIx+Jzq/Wyo/
+u9dryyITRK7flY3neecEecDE4h4OVzzqOJnF9PhKV4YrIXSZBlqp7s7OXjDEFnaPVLLQ9T1KziH+hJfKV3px9wszJJYLFx50IbvgkqwrzUKwZ4Fc+G8Dc2Q8
+gTCGaAl58xmfjch7+eeY6slPQ0xIjHl7oz9hPAQSix+sISKCGiIDIfYuW0TxuIXRZFZVp47iR7VOmChXaODgO4drLspaEu2wfRf7g6NUOOGkgj1RLsePqBMi
+5iz3lFS1/JfwjyRbKHCVu6pYxAnz1yzPVbtslR/IEd7LNs4sjign5ChMGVVpal07sAOt3u0IQGlbuvgJ709CzAR3OTpEdRrpIJs1L0xksnxYifDgYE9WZPHm
+ddln6bmrCTMkuK/hIfVg/WDywgd6ChP68GhYBtBDfpnjyp5JIijLM/CozrSOXrZgOjF29cy9E5WiDJ4MHFckTA7q9JzlbCwXMxPvlHDLiMveSbfwo83J11Gj
+EsxMketFLa9zVfy8UV3NTH+TG1mpewV9a0CSMKLWFWcvB0obqLK61oijDH9B/BbWQgMdJmypVVEWAOIBNGbzXA69K8+qTf0yJ7xgehNPtKBGXdoAfP/f/+Vf
+/uWfg6wGF9jBWjhUicDFnsoFPg8/ibL5guhTVphaq5TeWBrtoFzjBcqw+8zcog0A6q897etOeoEd1GZqQeq677ujLHCNc+FSzqfpe4fAd/jmwYWauEJq9JNU
+z3H0sNpUJ6S4ac8LjlFQ7GWVqzlO3NKrZtZrYSsnh78Na2u4BRFhEjBMGyeAYAd07+uTKIMNNoMdteidWRp1UI8l9B9Hu2KwJ8R/P59cjl5J0rfe164pLzkp
+fxyzPkwOez1fewPO/8bmrvUmSUPHTO/xpPuLPW90/2mxoSeV8kqVe/kCK9hlRbfpSFdwWNOY0e2fhbCKAlR02eBEiEx1LwmIZebVhjP+L/HPLijhZ5M59shW
+sBDxxiffsCU3JidRUNg3K3q8gdYrKk6973eLE+y/2x39VWAcp/8MbYcZzwG8PkBguI2oPra8k2ASY/no8k70poH4X3Z/fvd/Ti5+9fKPD9R/PH7x9Yvjdv3H
+Fy+Pd/Uff/v6jz01HwUWxB6eAH5weP4OS0NPJ2+mw7e9uXkjdDfMXIoVAAgn5/Li6vuz8YmwaXv7e8M309EIsGh7BwPU1K8A8gl1vSenVyeEjZsGVaoJK4cI
+Qnq7CFO8pqOT8cV4dH75ZMZVUwGa6jrhXjaQUA/9dPQawaST85kQvsSjr+34Sgh1YKUq6nOtknFxRYHgi7llD43ZMPsMnLCaPjFcCSnldee9GMEMcpWDd7+y
+KcHZgeM3Zav5ghj5fMPwVHbgbLf2g98JESLGuTFbFWXDjywPnSVUh4GrSkfD7ZaujKlLhjJcAPnENWmeUBZsnPOULWRG9aGd/Rt2qI9nM6uNzheyrAT7jtS8
+5qLA7WefGGbtGkS9MlaNdF5UOyGi9X68czmTBybT8s3bImKhhR7OUlaAI/PPTTH3pRHCdp2qTV5E2UGWM3NrRi8WpPO2CnOXi7DNgRB9FVQR84f+sKA8Ja6g
+769pt+OQG7YsDrUWlZEz3Glbzy1eV6fUCIsCzILMBqeFNJyowGCpqF4CTBzVm8D2dCFY90k78wl95r+Gzs7WmkfrgxkGVRryJYUnGFqcWisimMuyiOg6Te+q
+xqLABSYE0R+7STIDeTwQ4g14OEC2TcdvfricoayaxT6zmP4kaHtD2RZ2Sgo3AqniEi0J1XLplnIJQqu5Y1kJiquIVaVXpKt2dmNCBAtzqvDOpVfsJ64Gi18G
+Il31JVnaS+bizpF4tuAd+vH8NuiJwJ7AjEdl9eDJkuZzbnnUB0Jc/y2nuVUig3aOPXbCHrvNJVHqkv+ZLUHx9yY1xhtcXVywDh8/pY+aNwQmt7pP8dsAV9hb
+5Sg+2UUqwrOTLeJamZ0fZPaKuF7HX5ZVQpAXK8V734cE5KZTacW5Ne90QGTYln8UP+0ddASoBGFrKajcK6yFZy+bdteQfsSm80kMh6MXLred8HUPhEBiW7vb
+PP2OcWUXbgHJ3t2wtFOjbC3BjKqxlGwj1LGgizKmqSioxWngLubZ4i7zoXC7sEHIkoS/rP1cOlhBLzG3JdLjDC96kqv1EUtOOEQk7mXkaEhuVpd+ykSAL71G
+0H9tBxG27r37UVJcuYj6KdoE4mE5IgocBfEizyge1T5GpUx0MuiCGBKupV90Fj2wJEvY0GWuWyBTgcIBqQH8wLYynxdapzp1Hg6ESej3CrIMEgEfRpXEuuV9
+Qtp08jn6/saY2EiXJX6yacgbGA0Em5sHoAL7ymuCI7h27Yx6dSA96G6QFumzZRT2EeusbjMse5SBZzRsnxkvPyMi5u5F6o6WyIrWFTOQzwZCTEf/7Wo8Rctm
+JsSwxV0eYlFadHVOiBYdgc1Z89DpDYpmxChJJghYSzWH0bRp07BRudTC2hCd+/K7yPqpTfelr4TIDny2LykMfCwjpt22xoQfMGNsRgaN8F1JXEgIusgxoShr
+wP8Sg8fRGLI6J5UErvDw3Cdhc1vbWOoKTK2aRQ+2tshqTOQgbrXAbGKk2HdCZK3J4ASBx8yFF3PQPsPlw+rNtk6rr9iKoTCV47wggkzldknZJs1U7puyiMS8
+NEg6t8hqg33ODqg8hvHcmasWv4fsEGi6TRJSaIq++liu1Jyl1PSyDMUQ77LsLu5GqMDYuKc7HiH5P/apowvhkhESye1bbZAWty5tzDirCb0ZgPSWqiio7A6+
+k/LJFJbeaZZy3pi6XJINhHEp6JkzHPV7rkUixE821TrocgvC0FLSoKfhoQ1LCXUi/lvOaauClHtoA3EhXkr+F6HojfaoDdVXelneMWdkrS3PV4ziNLIN/hFx
+yx2Jjn3DPYzAPHIMEF2b82WUVZ9lmQgrtHEleflwBwVoOLzLTOitmXpu8bp0rxbdF3a1vK+RC8vn3wa+MJg0l0UZ5ZGHDgaYSyrRJBm7GN+XVlSLIFHNoqZN
+4lPK4Pg4xBNphD/rgfzplgrze9VTZsbjY+pSLtQc3mOLAgSZn433qbnbvaWkCzCbHcK+dWupsDEmWZWuaBeHu4iqPSuEWy8WMi2496qsWYjF0pGER2SAi0uL
+baRbvMWi9gmdTUS3WuhesLqhi+YgIvml9CG9gCUjKWRZ2onCt9N9ub835mcynbZatilgqAuXBhHJLNMFSXtTmxiLKPfO8MG9A5tvRULS1ojN1b1pMkZKYG8E
+lTBnAqRIo440xjAfbUOXRZw76qoz4oeYZwcryBA8E9ixfTMrEARUFHoeVyZNWyzxj1hYVzqObMCQ+txqcRZ4lZZda9AqNZWkuRVhfiY8oEgjJzJdfaPTDZp6
+qJAgagfZHGHW/tKoHOvdqmLT5DKzrjqQrnYOFbNxiCwatIi3v8vzsbONoyF16PqANf24hfaSckH5hDUMGIyqdQjW7PulzJiSB48DU5bDbFlSV89sIgt9UzKZ
+CdvpGydBrVn/ysCEsbUx/MgkJ0qBoszZoQMhQmOoJSGWeHuFhv1jd1MiL+gT+SfodEv3ISRPgW/rmx9EBLHG1Pc9/ZrKHbHjDR2d9iTjFYVEmF6bFXZu69L3
+LfGIylYjVCVAeU280u0TKWJPfMu2Q/1uIK+8YsLnKNm2paguC6LmvLS0PQqkTFfWy2B8OCjx4KBcsQbFucVs55pAF23t9xWDqF3iqudD3zYu0mTgx9Q1/vlA
+QgnK84mnvhTMUzicWcbLM6BWRA7Kyx/k+LwVIUvCGFsU4ZsAG+QDVJtj4gc5mZwT+ngmt1BvCk+8+RDvJjSx5RXicnwJ3JoBdyYPxnNvMuXIFAg4z0ezWYeE
+UzIJ54DURu+QyMhpkq87iccdpiFf5gsVp3IhqP5/xBjfcj7gl9Y7QwR3BqDuxpTzTDmkelYb4SrM9aYp94UQXHqHhXlykhK/w93r5UJyDS+pqwpzrtv1IWKm
+BKcdwCYuK1QZoJVU1SqxbeE9Bkdg5c3iplBRXVTOJ6ualcWSk8wn8fwCwrURxafjfPmErX3OG9CFi+U5c/4EXEGzkMMGNl7MMkMsQkjv44l+Qn4fT/oz+tPo
+7cXZEIiQNnL+eFKkno0vzoAZ9WI6eT2+nB0k8ofJT6MfgZVneAUp2UABg0ww75hwKZofz740PvccSwKMiJPL8LFJh58pZMUJOZkO5HA6nsEDY3yt+Gn4TkKX
+OTR/RRS2W6L2+DLkwwGrxlOucigLQ1shzWoiLcfQ8PTH8exxPELyJUTJRuej6fBMbOYx8SYrps8jxRalZgScI3zA/OYXRBNSh+584hyFM42N8L52rbgKwPAE
+UZWmiKYVW4NIeLcwbcWiQSoN1pxbtIVoFdQlWxJ+oF3SFdaZQWQFBRC6vCvQkOczoVFhf0I2FpzaQExagoEg2uNYRpx10S1pzsYnyBFHohcTpPty52EhonlV
+GnNIehg61pqi1hX+W1AdNzI/DgIawnbBwYBOreNP4jK7ohU+ae8dmuSAuTEmIsQl8RMhGEWaxkB6mX38TBZrwcGHx06LjKbFURDi0OPYGjlGAq66MDzVNoR6
+CtOLsnIRqoOAGxJnI/Tf0/j2jV2kzgOtOqpxIgryQX7qAgBCPO951+OWmXAoC5XlRtal2FAdZalqXWUqt4e82uoHR/ead044qmZ4S1Ppjg+R2T/LBUVAibYl
+pm8W1hIrysJf6IiOVh8xejfuJIxdOyfEXCtD2IxY0ekazcpIU1K+pnebiVUFcg3Pe1BxL+xcaEb39pANPtF3tH2X29W8bLdodV0hCoIKWFLoEXhTygIDVy4x
+D4cN7sxoxNpWke0R59dNLaKiCndlhjWbbYbMnIR6dC05lycVB/EVGhzPvof/+sxY8naRceubm9X6XlVEM1lZKAo2joYIlyKJao8EkgVLlBBtdXfjuqIkwhUl
+6ZbIqHt7c6uCjkTVMjIjgnjX+Pu3NmHFgu06jdFTMEfKmOyGXtkX66zutHX7dnuEF5GxhDsOwhXFkQt9L1plHXxD20uwyFYJFlglYTdiMOFRmP0gLJhyHWPH
+WvwDG7vFlPKELSAMnS0i3LqNSJIoEeyFbnOZsXsGYmZtv4nOPfNZTyw3HGgHU3AQZJYEXRjIkS26K4JM2FrVURVoI4/3FTMGY9Y2JXmEQoChWEUZlCltM9r3
+e9UYWdAt49067cLmo7qOInU9xgRt5r02dbla6bwNA/Ac53Sw3eag1AY78PhK7AmWVT5j6rKj77bh/aAVuFpSMKW2DtA7LGlWpJ1J8WiG8LcR75z0vHMoEMi5
+a4nEXH+ocvh1RQpM6CLuHxwWbUWRUhZarrWqgtLh6AjGdaIWVFUaWyOJ3n+vcPVh2/E844n/cwMKMN7W5OgTnlDB6w//2bIeTi4mmABw/CtmADyI/z8+auH/
+j56+eLHD//8Wf4j9NUXBjLfIZKULXxsKtscBpAEcC3FRabUECzjmGiVJYij7K4jrWR6RmDE0DA9iPECkNr3UZRy2s9tUkYa8J68x2c2hUBQFKK05Da/lAxxx
+NPJHjOW8ROU8K+SqzIq6zeETJzsFCZsUOD98uHdzVbicUkowt07coE/mu49srtLBve+j8XED/GyYh81xzjYEdyDEIYhlNhYXrPSgnpTIm0bhDaMTSxxjKy/L
++1tVmxK0dJn5lWD6L2usB9/IPWUOM7OHL0SltKqzeY6MXeVypYq19Yni6lkMwHWHvqHSTuUQ1jWCWklT32LMADEpRd3aopmRuiC2VMtGiaQRdKWl2V2WNsrf
+wxbUV2mFMOzwfgb/CHS2tdE9+HLVVKbhIiRt9kjxwAtJN+NIi2t6Q3lml0i8v0fDRwYY/pCydpDwY384k5jxMjqV34/OJj8dPDJxhypC/WF0cikmF6Nz6ZN3
++G+QuuNeQ21ejk7Af/c9FFG6eIdePXBTfmW9iCKm1XapP9ath01NiDP+h+G5HEbVl7oFwMRkGrzpbPgT9wNTTkenAyG+f2edjeC39L5GS/eNb3SzAY7H8TmV
+uqJEIiJEBx+yuJwA9fv3k6tzHGF3zrhTNCvUb4kuzRk2CJ/Sy9HfPByfuzeyj3Y2Ph1NHTP4u8nVtJXOhH5Oein0y0dBBmKMP3DU49BjGGMwikd1HscuTobn
+0Mrb4R9HfUvEPDMDeaoXaI1RoUg1kHt8tA3nQEAcIWQvQtw3PxLyAdAWtgANJs404pYDxg9KSAvdcgIkpIyBvwEY+hr6R2clyPqID2SY8bLxJIre+rIAtx7I
+vVZtRvsqzsolpDDWoXHtllXrA5AVNuFdH+r3ZEA6qZ0O5F57BvaoXpOnt7ZfA5KHKrJilniCvNXZDRPIBZFyhuSmqlb0aKd2LV7pQuiB3LtgORzNJMt7h2e6
+19cmq3Uil+pG/TUrdCJPTg+nk7eJPP3xVJYVD5JRb+hFKCtYMl/Ule5zw1A6V/piIMRiIPeCfcETYHxCAe07TmJo5SnEk0DDbUx0XbZII3GWB0LcwGtrVaSq
+Sm2iZPhuLvNM/V5YjDDdZwE/Ctyqosua0ptopO295qxw3MSJzU7A78S1DipELhqqFDyvdOiHox8OhLgdyD3andtmjealk86npVv+EDraOqhJZ/Z4J2Kk3G+z
+pEXpD+kPtV6pVQg5s2JlIEQ2kHvvymZPZkgAjDiW3jN8zw6XQK20p8tC67VAroqNnM8DIY4H8rXKKiDv/8r+hUlJPA9zi4errelWmpKhMAqbkCbDlOhBdaQI
+RLWAV2EaD/4t1SpHGAhVNTIq104viVhoWIx4/jKfExGjzJGMjIYs/KdMNkbttkK+AyGeDdwQ31RYs6s3JWoD4pt/mhBPJkn8KDOKOOE2JUEl7WSpla5WGn0R
++1b+O2JMHm8wBDfIgzBVzW6EIMNCZKEoNtbFhNSNr/CWs5xQdlsZKw9a3rIWw3D7PMCL0Gwq7yOSMUN3lX0LAcSumxu5yN4jU2hZ1Q6oCB8FDCmt6qssNYVj
+FOFM79MSzZ+y8lwjLBvkkC/RQCSxTEPuNiogVLOPE73XKRUi6JsICmL2FBJoX5t2sIGmTY5gnKAIa4zdC4JToXw4EAHPnJflql0xuZXGgK/PClvKy7E2+3rh
+BGBG3HXK5RJriiXclvfIP8eDhQ2NzdmHsX34Bd3cdqCtlIxNW6ij6bRpP8uqPTa8RG5uKn3joHO0N/a51P06MEgPHFhDeEsaw4TVzcafdHOYqYKUHoTmHd4c
+88aYyLpzFlxokjvgJgkFKO39oAUot1mA1BFqTlSaCi17McdgSiJctldL51j3zb0Iz/RGY++6qSPmuUqvSlNHA3DqD252P0xiIfw5BOOHGzyJhk01vliPFr1a
+AgQ6caiAtq2zgvJQbEK6aVaUpkJqFZOaFT/j8rBMD0YseqRYfC49LCKWDnZnuSeFEw38sNmWXOtlxdcDzrJ0n33aHYQ1JXovIa5OqPz9EuXlti6hojyc38Jh
+SUR8WWVVpe9Kunts8Rl/m7TAwActbIJwqbyIXXRVDG0Cb7Ilg9fq6c8Hcspqhge75tZtx+EDHziRz5iOOCo+Am8WwdI41juvDPrAYxW8zt+UVC+DKcY4sSMI
+kpeWVModz8QXkLRUsJRoE5IU2hwQhs9ZsJxNDCPDTdX2BuX676B5PHAr+8s36rhK73RVZ0RAEFwyGUb+OY3VnoLM0DXCGF53y9Ndd9kiHB46qWfPgjVFdJGW
+Fb2SqjFo26ohd154teObN0oHuUk6pD2DpVzxXCujWV0tOGPLHePIw2tRC8LptSD9sGexfWDt3b62ZIbSwl0+oveacfUpdL6AeSazx5R5ShOLLV3b7B4EJDc3
+t4lg0ulrzWVAcXCpHRyGyfTG23nLLcz7KOLb6/cPJK6CDOUhRfT0kgs+cRANL8WpJrNVjClvKNNI+59ErXJ+FaZ8hAlWXTFdRXoDE27bURK6yzOzM3cFBUtx
+huGu7Giw7XmwoBCb1d07ET7lodWocJP7VVmF7fqFKUr2yzCNZ2l8HXFn/eD9YjPc6VG3MTf3imffkQGaJ95M5EndVKk97l+Lo4LOiV138bPWK5kVtZoT3bUV
+ZJxM5pw47ULxdLl5+QYRD489j3vw0PaNZ5eywGgW57dFmZc32VxhBQLYENw1Ts2wZTbKTrQnTI0TAbClbicEdy/1RY8Mimz2Bax2jrFhuAKXZUWmfrakMLSv
+o4BmcSFXCKfnBEE00bf92gK1AhGSmeja83akfg8XMFnEcT2oCAWW54w9U/eGKS5txa74ZvIcGPheCmXjhRGUCw+u9RdwrXNmuuI85p+iFGd56jbJgJy7gYue
+HN2M40/E3k/ggYa/o48YPh/+OByfgYt+z6PxwQnMKGsZ4PYjUL4IQflT+eZqCN+NAJHrqrhC1clEDmezq7cjrDM6Hc/+CN5vKK2JHGC+Sqx37Meg/oshVuiM
+P5xdjS8dpHl0eTKwDniHDXdA8hm+mD9P3FgmUzm7HF4CBnxztkHYv75MhW2JEK1khDA74Wwk/tvV8LEpCq5Ya0h3jDkQ8EUnC0Ig6Hp46eMd+/z7i8kUegdf
+TEeT1xgZOplMCdN+NRu9vjpL5PdXbw5fQzRhMhX0/9fyx/H0asYLK99eAUp9OJvRXgvx+ucAQj9H0DoGJywyfOTjU1i5dTr+cXg5/pE+gsJxLwc2Latet04Z
+JfEkPt8Rt/xtiXk+1RLrgXgFR1gPonNZBlxlQc6jTXXCjMWknQKZuNRQcF4KLi8YVgWMsXIOo4kyBxWeuKLggS/XIazfu53q1xavzvP9zYCKPrCnC+L1toKb
+5KSIuJgtE0Vg5CeOwCVifA51mwFmfymhcnAYvGK0PrL3zSAoF5U+hhXl/AMs+yw4C8GmSfRWP47SIBJ5cQVMfD/ixnX5EhbJL2y6gcsx8HEq27dO8DCqIW2z
+By5/GIlNY/phOKNaxR+RYmCLZV9GBbRVC+9gt94mrYHM3wC63NTlUtVw9+ZrgSEh2AfXFTqfPOm/1Xc6F6kcOz+4cY7wTBvMpUZD0iL+2j4jQ6cCXtD1gifg
+4yJQLvQYXTPYGhHSeeicHUnqvWt4B2b93eJkCJEVFK9opxxRLqFtfuARfkeJPE7ki0S+TOQ3ifw2AaJfcM4cHdGc2gLndqYiZqlI87ge2Er+FoAWmKHe/AwI
+iEKOnHatfxGJlAAlWN61nidfN/5IUrJ+qq1BjQ5Kikw4XnrHPjGPcxTs81IXqYl3EBmbLecImfh9LpKkE8Jw/g+5b3nxH+NfD8OFBxgjgdVEByebgYJBmYHx
+20Ulk7cAzbR2CJZoQiJ3iQXY16U0dbnqptvhz4PCQt+5Peq2N2VCOMegzl0+OHupsIvwChhQWqn7eMr2o93hunaralcfAW28FgPStU46aM4NqvJBwvdZZP+F
+2HV7ljBPx9ZjhPVvCroZ3RkV3hllueahVri7Jy2Jmc8xul5T3LRaegPPPe49fwlHlfAMhBVuEnkd4LE5iRK66H5KYiXm48oKR1DBm65VsbojBS+jflFac1E6
+/JII8UvdxP8oThi5M/OM3LHkH6TF5rK0LKrpuq9vdVmteXQ+t9e/dntlYpy4G13oCsyVPvodSiTpsu+4EGI0LGHNybx/gvqPHiV1MNzJGdWBU5pmIThRbihk
+0fpS5ZmZ6zxXhS4b070le+t4tzHJeRnXWr7VKoXk+KCOKwsSIokNPshMHCFOosZtw9bCX1UwzyuVSypIUC5gfmDi229yl8eDZdL/XpILIz/U41ILeesI99PP
+zTEUYY6hfDjHcA4XCGs71ZZpdh3hmseI8qYGi5JVKBsgoV6yQMR+4NOVO1eFr5Id0lqgQMhuCstApT2i/VpLigLQxG5olbyt0e73hZnp/MGBrsJK/RaxGK5K
+m7WnC0MkMkY86RXVGinKgP8xK25M4l9iREh3xz6K+1YNe/cSvAldKeTgTdbkioTmNRaQYD4um2Oicr+UvX560p/CKmNWP23NX+A0dxHr0Ae+bFCBsU5wN2QR
+Sw32zP7DFTU4uRoeTn7lGgDb8f/Pnz8/etnG/z87ernD//9G6y8ndI1uqwHQh/aELXM0kCFZ1ZXRFg8YZ4H2IKi7pRdD1SLig8KX0as6fOVoGoegTUJ82GRb
+z1jO+LZKkzgpF61SNfCO4/gdHt7nMY49PMVxRXOKuomo8QCKGhEpWwdBu+Jhqnt480N6HOztM+ytr+oSdvOBYoxl1R5MfxVH6GD0y8RjYZQJi6i62vtcTgZ7
++DUAVcGKqsoCQEfhnnir57eqyMzSA2SX9iOr/eaWwI5q6NdhfkFY655vCuZtg8e0f6sLjTM9ycDWQzgaPI+AtLYj4ZxGIJUgmTWMTcFIXwzkXqdg2oMo48zG
+9VJORxWdNvrKPTEqKayA5ao4YW9eDuReUDOoBUF+ZGEgu5Li0YWB2oVIcY6/Gfh6jG4+MuNAn9jhbwZH7rFwKW7VXcR+7ChzE6dnqveBnikYrqR9RdCQJLxV
+xgNbkmUVEDbijqNpTdAOQz9xnss4ADgvizu99toPjOHbgdyLzkpYUsEqPpLocqggWll49B10Aa1kLKldV828bpDxNCw11lvPeVXpu6xsTEueSeAGFfFeNtah
+khLRlNEVZ6szEldFjcgMUBzDgRw+ZgRhdT2C9obht06R0g3dFuJ7el+h76kRF3xEDFcbONCZko3tHg3AvbEX/cAuURTKX/iqmU7aUP1CPDiZETZBIf3Y0wnT
+Hl8XBIOChpP2Vs1qt149TllbFVLllVbpOr5L40ManUucBjhtjKI6wcBHVP4jqNqB1BxFeY9wYbRgYcdjjFK4g7LVm5HIpa5vyxQRl2AE0rWnVphX3xgbebHA
+zVUI7rLFQvCk8rV3dNQG/ocZCHqhq4oYc5eeVzU4BAvC10HgJcbK1qXMIu4sLMLPOLnMb8FErvKGT7XDHSHvabVQc67KxjV/6VTBZlnVQYIBR9Jhm2UUEmYG
+T1OrPHf3cVTvHT2HYRqDc4JS4aHlSlUZljG2PmgvOESP4KDL7F7nOTKaF0mggMVXwqJNzfrEiPltmc3Zk9STfWjpDBFFRvtGVfPb7E6hZ3Lp4beiRZwmU21/
+x5pjqg/pt4Tz8emFAMfL177bSNZflGyI0145tskC4JuFvwHXqd3qrUvZ5p7z5Ryw7zMunAn2yW0OoX6748Mbwt6HSQdcouSiQaHOEF/RdRWZpkNy8wIO6+uy
+CjuHGRQ0Lks6i5dVlEfIuwxUNmP/kaNIsE5oetGcSoDxE+RbQDv7delhFe6C97s7kXv8G3sCgXcBT2F5D/NEBOJwn3kycdj9yiI76ENe5qUqlK+bQA54HI67
+ysU1x1TUvI5MChwPcD1QgfXbbAVNeJqARbaosaLMHFrff/70fz2w6m7Z1C4wYW5VRYbDtS70IkOTJmoy6BVpOMeDaPeHWSNHlly0rdBx4kT/l510CMHpEIcP
+5kPI3PvoQqC9taJEP6UFid9XQsDq0Y7YWvthP9CCfVDMRcoOAskNC9YdIkF7kqBIEEP9k6AMkK3+46FUosW0073893uU1wM6n2XlbqbYnBG2xFmIfQ9UZ86y
+3r+2c2NL7dC12a1QRVcLYHVhS5UVQinxr4tYB0rkRogv8yG5Ej4wcsK8Lbj4VcIYuRhBDie1Xy/qnZqBEFBsOazxEsKC8bg7qq3BkWVVgblQlfYVCBh1J1JV
+921pylgKq4fFfeuGvERLZdlPDzpRxLBjnuWlKHuqjfCYXsmjA6rnUqbaZ31waWCnxkad+04eH3gKoM4zgp4pK/nsANcmjvA6UupXMqN5DjWOjeX7M374IVdD
+H/MaXZh32RxxzMfg0QgdGn1JW05AyG0CIhHbi1qFqVsPyyrL0/VrSZ24QOOnCRw8+S2BE3tRyNWU9lYd7D1xglUy1PdkUziPNZWxDxe05XVRLTudj/9Hiq3H
+Sa2kJbairojIOxWOmMfm6nB8VXJ41e9iR6we/E5YR2M0Y45B3P/6INlS38wJRhEIU+5En4wEYbDN7RZ2sHcpvyOGqd6Turld0aG/bM2GfPRsPCC9PRvWwPNh
+DY63C++wIyS2KXgeu3j7zKoHpfTxx0ppigBZSR3JHWVYaqdeJPfMIQhv0RXevU8+LL9FKClbWZaL3iXcKMrF4zZer3wPMomgK6Jrndn3H/hEXr4R8Kb6+gGR
+QOIz9oAScMjYGPjjuk8a8rNB7PadeCZHzCc+GshhQNNTLmIYR/wqMmwQOIWilwE/+LHAj+3rKeb5KF9lj/tCePdFuIW75m6QrBnNGTNQBMmLIs4Q2ZyC0LYS
+O89YF57o2IkPJZhsyhsR/vt4eHGOyCOTMARjYfrmyedkmDApw7SxZJss5J4UDVYQglQMxyBqu8sTIVQRBqCtA9rXjcFO+Ae45cjZx7Mtng2e4/Y9HshhxGe+
+iNNWwJMZ7uENW1gEH/stvKE2UxaFH4KqbZtTj6RXPGCQRi01sm4o9AOHbia/7SpxB98XLgQjHwzlMHK5LAgkd1suOQuWJr/nLW5U39naCa3BQh82vFe49yY0
+VQR/CX6M90iNqV61rO91fqfl/tHxgVyWRX1rAtpAvP6y2oYGIN9ez2GWXGOJDBsz2Xu5/6LVkAoCCTEPZ1wAO9oQngNEtYOgeOf7vT5AEaeqLoYOE7cslXPn
+FNue0PQENc2gUFBh0VIPLy7WFssKz8PSDtI+g5DkqSZ3Z58u5LOg2AtENeoCwblJmrPXFJeXwgL2+NqDa2uWv+MN57P/beWTWH32FNGMqKYGBn3y0xMCIGAS
+XuunuhUxsXmZ1v+Vc5ED+6+ENJHY6gsrpNa9jiHyD4eplGEGKT8vorChdcdFnCzWgmdvuyc46D2fwqYl6FZJe/iFl1lWRAb5vDDo0HcmejRGiX+eQZh4HBp/
+F9b4e4sAT0NW4iXutQvUv0hpQfRdWzP05TnpsolrJotozz4x263OFlC3jysk4Jnu2IDRBQ2a+JEsK1Akkp6qdPaOkrV+T3wRHo4anuY4Fzarc+DXw/yLvQ59
+Fp4Lgpjzhmf11UMwHDN8UMU01bXKcjt/TgARTyvML4l2eybndWchiHeEwXV+Rby8bR+alvBT/deufNaeO6IjcVWqHHm0ppQUmseskMTvjdzc0dSjeRMxLbjo
+lkP/1gBXZDW81isj922NSyqKBVMZRjCWKkPTOc+wtEoFPMrmpiqblTnwuUFrOVc53AcMR6QikpzzEJSMT7tIGVyYQt8HM+tuEcv4MiDrPxzs8GLcOTJPWnFS
+L/QiRhrBJCFLAhfbUBfM0ebzVy46BRdIttMV5uYhqj8B6fSBgB1ejHsOi8qNqw1IzkKaPSea4g3ApnIra3KwrdgvlIa1dham3JYhuaOoy2BLfr2vnGkbNnmt
+80zf2fTZLZOOExJ/70kRGLC0bw6sP6M948HJZaEUFRZuQxa6IWFQZuXUCrpzykcOLsK0oY1Ags9fGT6szaAgJFLrMSRw11H15qKspQVpSOSdr22FAttsWPU2
+Imuge7/RthKzg0lw9YjOzR03LJSHkbsjrNCeuoPJohu6rNYHzOJDxIGSaohdU91LzNYVeVlSTjI1w+9wqUTWMVgWROdSVrGaAIvJxolQaSox1Qfvc+zRSTfF
+hwcRCUWP+XEjF3gywvUKw4t05cf3uAVMRengMHoRXew9Fld0wfNnYQpjfNnzcIOa1Gh2JTa3nYK2UsmF1kRyYNM1EiSpQc6TzKaEEmO7M7q8TwEbDmbd97tt
+o3s70fWNCoaioV4GzFxUUjlxtYWjKsu92ppg/1VUgs/tTrh0ZFaDR6XMmxq21DzXir3bLv3IjV90xy83jF9mxhZJdow+4I91ngERVhCla8dm0W7WO4lcInIO
+lpTeE2bpzJuq2qbB2tMSthOUuwNbf9uSx0MmK5fyIRdI7vkMMHinrdojgUbLXjHTy/ERbgyRRZowAUlgT2Q2yQIF5dJuKn8RHB0+GzynaB5ZdLoWdL5a9ocr
+FEi0Y9bM4ENuicVaxpxoe5w60JaoXvUDrqaQuFHJNDbabsv7FpkZDmrR5IsMMQR1XNpURNPAfjIejXVizMvCrLJ5UzYmX0fUopEd0u8nSDZYIRjNA8SMroi3
+v2uTiB7R1a4DE8uFBzlgxCa3X59IpHXwZohlAyIETcKeHxSNbODSevkcR18N3a5dD3mdqjRVsW2n0G7kYClSv8Ns5/g2EN0V8FWTVV3r5aqWdUkoL1+kO3LN
++eJNPRg526hzytOj0uga+lDfthk17dUqNi6Ka9NOeCdJNJbAYoMErr0nc1NR+oARPixJH0hbLkG/URD2Xg4kmsXni+Y4gVJ8tmi2HhJ4RvRJ3peDMPgYiFhH
+vxh8La/XHA+hMjeB5A0CHh+HN5ZdcIgIX4mDgdhm7gi0sOYNq29z5LmKL2imQorlfcchrapQLFplquvnQBeHu7xAOUKqmFNSZme1qpn6b+pYYqjAHmnN2dLp
+zPAO6Gmbb8YTqvXOkGinc5lyyfX1897LhPVsQ11L5J+blBFRFaDgyDNk+xrr36/Q9RT2bvPC1aXowridhfCd81dFLqaQ8NZ6NmDDQFNrTpkElMP8NrrZ7FXU
+5rUOXAX2NZhvEJh4+JrO7x32L3TKmD4DSHDtorhq+aoqUYVn/5HfBX5mOeMyGIaw3fB2X75mnw3vwcBpA50pq5QYwM3PSDiB6Yp4kdVhUqDMatqszzsxuZbB
+eNkm8+JU0Xnowt0ga8BiVXWt5rekR4g+e5KNAnvpd8/Ti4FT6Fp1OpAh6WiAxYu8zhfmOXGlEyzvzVycWN1Mp9bCDougibh5X+tMulpnWFyIn3+g+pdoVf+C
+zh4P5Ai3rK255Ls9KeatY+kcPZ4HlS88EUQYWlqi0xscpTBVEnOsAUy3lXGVsHbusKp9ES7fhCkDaw5+zndI0Nue1ugisWGSDT0NRne9DhZP8OL11ZfrWeLN
+xeVsnCyuNBr13RrxvazgLyjUEXOotOx/S5GmfGbqhpiu2KcTYylj0dxISxnVC0SmsKx258xmI3x8FsBBcNeBpK40+h5QLbW6kimddihWt5Uy2sg9P8V7Cf6L
+/3dxtueqxMzLYoEwonwtTbbMYDtSAzItBfbVZfmuwzdaZENdwojYyxk9QYoYUdVEGEEPTIzSDHFDB4odDrC14bht4RJMNmt+Wm7MlhzI/dcZAaayQmyIzngh
+mHTTVqJy/kXg8xH9Pp+AIoDesjQQ3jRBCjzJ+Qiq0tZfBgcoTV8OZFxl2xKLDYQ4mfw4AuooLAjTVzkm5GQCNqgHasS/E1tqwhMNGzOyJQ+Vhfdka8Lxm7W7
+axnLTkevRyeXs5h0LQGqtQ7NmmCatRaZ2vj8DVV2Ae6q6YiY64aukIzlbwMWvYvR9PVk+haqtojJ695+wXiAQWsgZz9Mrs5OcUqih2CiR9xvYMgaQwH+d3I6
+Al6tS6pRsw9VWqD58fmY6oqPfhydTS5GU0sRR8V0gvrmB8y+J6hXs0tbg/t8dDKazYB+azaa/jg+wWmfji6G4ylx2SEv3HhyPmjzvIlgx8CrZpfjy6vLEdSo
+kbCoVPIcJrhbKud8Yim8ggkQdpaC4j+uJrilOAv2n+8KaQffDLC4zfgcNwoyph19UW4udt0sMEwWK+IkPygvEN/iHnIFfZnQCx7PCvHsqUzhMi4XreK9VnzQ
+41QZ0sNTTZ/zgr0J8GOKZQaFIklgPJoZ68LzM3B6GhlgWSULRU73EHSRFZZo6FqvS57c4AWin7DDl9n9ZnDs7k9CYdQ6LHx9jelWuiImDhtZ6lJwBZUBRKrn
+uarLag3Wyw0+QzQn5sBlSfXb5zGWtz8ztyW1bYMYG8XdkeW84PRSCidTfhqWOzeQhFfV2TxbqaLea5Xk5pQMbCB47Ek/XrQfbODmh/KDHK22LYhus1tbp+B6
+3Xmt5fshjS6KabPSfDw47mdlSai60Qve56zv46UavED4s7aqSrPyNaOYqCVb8IlxLVE4OWBJ5RsT+kmAp1dyPztgZ3dA5FKXcqXW0fAUM4Xk7BtHldATOVpu
+d2t+V3KlDJWdZrgeszX2YyPbs4nm8z6gQx2TGGtfbr/TZu4jYelZfsc7134RHqnWtLmJSuJ64jxEjHyv1JqOS1Upe7yIRaZuTRQXswpmN6YQEuwT6QyNNVc7
+AUXSJ4+v1/EeCTah3LQJI1EdivCabe9VVkWcBzQxdmNyWXXPcGPZ0RDBXKwdFjdxNe4Tn3aRhCbJ9rNrke/Jg4dX9h7eeKrs3PSd3e587V93IOGVviuh7oOD
+hotWKX2SaYQK51ylNGE29QDpipNxq1J+akv2QLhP4QJ45i4AkvRbxbzd8dEhZvlJiK8vLzk5IIINu01caVPmdzr1MeXrtff2V9LouiZIw4EgRn5XYhpvOb59
++0bqzwwvO/kjnVC6U3mjW5bMdim+EZIkHOEVwF0Kqv6h5vOywU7JVNNBcjjZJX5TVtJ1guZpTexKzmrDlUX3J/4OIIdksgTKR6tX31Cvvhkc09mjsoG6SAXG
+4+3cevqq4M73O7Hk8mGVhkQRXZkDtuogliXQh4KkY4GKZClWmbfPtxTohX4JA+Um1q3qiBWWmGK93QK6LpDoIrPsQAjSY88n8mQ8Pbl6O8Nij1Tf0X1FHkri
+102AU5lKZE6ml3LfE1Kfj96cjd+Mzk9GBwmp/EMwFGJK3NkPwH7tCLk7hkMCirjomA2Oc9pxOE/QUgxNFvfM7ApIhtGURJvF0ueijTWaJTGPMDxxMZrOJueO
+Onh8fjomFuo+EmGq+hnwCFteXmdG/DCEoY+mD1mQ/DsB7z2bzLCBN5PJKRAhJ0QoPLucXFwM34xgRt9eXEGjr4fjs6sp2odvh2evr85PqDXqvMCKoWdnzvR6
+CyZn1Et62WjmCYrd9LzjBfph+ONIIC3x+BxsycfxElvjqnevCWoZy4NeXJy9g7l3X+LUn46Glz9A92g5hmdyfP6HqykanVdnl0DF/Ho6eYvvFNjbJzPpd12L
+dTpmmnZlWWfUZd/JgZhN3o7kH66m49npGOdy5uqYnp1NfuJGT86uZjimaWuEfmts3BmJnE1ocnw7sE5BQ2+H76K5EWCdE/XV04G8GswG8g1s9nMgVZcjOJ6z
+0XTG1aQ7gXjIjndZU1mtl8kekfyoGgWEtMn05Nj5+ht5Mng9mA4gdfzpkdyfgJfz6Ntvnx8kkkspcD5e2HCHqWQPZV7wiOg80qq3iN3Ci4JdopUvXO16JY+O
+B8dHx2J/ple2Xwigi0o8tB/HvvgPj49fDl4ePz0+PJL1bQVlWYT76Gu5/4em0HbEIE5pytGHCb2VoyKF9DMQ68Q80hc8LLAYT2l6QsqOq8fyko5nJ6Ozs+H5
+aHI1a0c1IjygJgtf18GlA4sy11VBtVEiQlrLgPUYbtBbnVtPXcQN2mbaFF2mTRprFFCKoJTWAxq0Sk4QsZV69UTl2aKsikxhNciV9wMEHlr/ThFXiUxkhqN2
+GAXjfbIHifT3dEaYxEWezevDcnGYq3vh3zWQP7UsH8jdxOxxh/JwyRhlYYHNePDmWZ39VYN2z05py4sxv1UVlQGnEFtm6F/QeimvwWuNhmZhq/DUOsXYrEbH
+6XCpq2yuEg6vO3MmRpP0cZLGpc3En5sqM2k2DzO7X+sUMSwnZVP5cp3nJZKzFgxsohCRX6CENv2dLhotiY4EwpaqqBVA5CtwYzSIpwp+4yOyeYkkBTh1op1T
+wjUOtlP7zKG39CjrWk43bRU8iIolcHW+dozRzfk5m88ngFktyFQr5Alzf/hqz+Oi1hUpWiqXM0Xg0zdlmZq4ihltO51iQpbgIqdB+Jo2lNuxHhejiptGEZZZ
+efIRu7BIKFtXjfa1JOBXaUWeiYDF15d5aZOkAUUN+HSh+ndwFVOlEijeZxwFbD8EpOUSMFTazh+IYGGJ/oASPuGnLdrozSkrsO7MSNXUWZ791dnPG0vnulxE
+h2e5t/XJugMRLSS54RNP/9Y2E4oHwqhHzFkBcAbhfzCHfiBsSV72wIaVeMvKH0kfI/EMvMzUtsyMTT50IBpeq2cD+RYUoYuz0SG7sEn1BbBGZ3UQRKaBMRi9
+WkE2dwdtAb7At01eZ6tcH/IMpnsD0fOho7viPdp97wrsjxqrI2NoFldMi60doAUMkGlOBF2c2bRLlfNx8wQOxst77zDZiDOyxak7SIsAyjz6E2qJcigPt8Tb
+hNi7bHHO4ebDplXVFrnyQZ5ToDNFR69nKzz4LqrhSaxF9h18FfZD7uwFG5S1vebctTDV1p2UWt7W9erVV1/NG0Wk5gNi+lqUwGRV6PorIWadanhRvYBApwgf
+oFNiQ3LEMyHaITkZheQ4V5SlJ6f45hlIz5nW0ctsWQhe+7kXmKRRePIsXzjSUqK2e86KdBwZzYz8l0f9kfzz7qbrpU7Z0q4cyAs+KSJg+NjwMKVu2MoX+ycH
+/OCm5gXFcaiAuJwS9T5k6wTSb98cvPqIYQ/9sQRZXW88F5yVjsp9LzxYC2rUheTxPMj//i//8i//LN2xSLwKNrfFOQItsVzgD+A3IoTJBXoi3JRapfRSTB5H
+F6scL/DEQeF2WZdgBJT37FgX607w3g5rc2a9pp78c3QnFWUtXOOomxoLYNn8Dt88wh0SvB3nquYC8qme4+hhoxAzZnHTnhccI2tJVFsAovZeG+PghS/lHfw2
+JJO0w/rnCBsL85YicoI6oAm50Xp9EkHDbK3jh4ZNAkG40dv7IO7FnhD//XxyOXqFyh1mMdq2nHhnXDWiKkwOZyBfe3iF/41FhZk+gs2FrzrcOtYkb80tJuyQ
+vNZiQ08q5f307uULJKvPim7TEQjfBYHi7OZ//v2xx5+oHOyOX437/WH+96dfv3x53OV/f7bjf/8N1z+2pBI5LuaDFr8XZ2ZV2mcLUCVeS0AZRO+Qnomxdh1k
+dmYQHJWGZKahnUkZjxi9x0R6eU3gVVf42F7KnjvZN0SWZfABqih0WW0bKw/S6iqVdkWu6zIuci2uzsd/kpOVrrCXcrY2tV6yPl7ix5ZUSR69OPw+q+XF6cXh
+0ZE8ubgiK1hV+bqDL312jM/2ts42u9OymEeNpDX+gp4T4/EYXxF8Jn/ET0yDZVvLSpaucUNdfyXEs+NDkIrPjn/Enwpx9EJe2944oC2VXXuWyK8T+Zzqrwmx
+bVZd6aWbRsEEa8vLv1xm9dIl23JUFeXrvJPIhMu85S2k97n1CeqQcUA3ACjX7c2BVwmIc8pMDsskBaRUqJ5xq6Di9faGhnz89OnR4fHTp8ek57mEI6fnTXWU
+wa8KuqmywnZMFW7Lg6PP0cJ5CssQ0pdg8jBZfFHhPWcfhjPjyuHDj5a6ftXuD3GNBTOExzfKuWLUD6UquYpybtaFxRbwXJqaGLX9q4u01S9f03jQ7U9WhLNh
+307CQPd1wIIbxKd0wN790ZBFSCTnagUbP9dOhwu7jpp57urGw3v8T5fk1oIPFxrhVHFF5cx4dz0MWTBTYavjau7S7aHFV+ilYE82y0snoDulE3RqE9as37fn
+iAk6YucBC72Ftm47+UXpn8U9ZbN6vItHhObI42rdBzlYbjCWsssJx7jqPZ4M9OdAqNUXC51NXl/+NJyOPJ4VvG49mNbv38mT4dnpaDqU4/PL0ZQwhRCFHJ9D
+leHz0zBMOpP/+q9oaz95gl89WDc5BLmK768uMQSFUanRqbyccJD2bbfccqeeML6PSweLTaWDJQzZwSVPBzIs/0rxwW2j9XHb1y4cTHFaG7GNir264K0r7Zpw
+neie2K0PZCeybyIuppOTqymWNKa45/eMMxUQscXpJeDqaPadC+ZiSenT4eUwwbDmdPJ6fDn7Dv7+/dVsDFNF45xeXcBAD8QPk5+wXPHJ8Ap8ezCnXOOWq9uG
+EVUfih+fBwF3CJCfXIrgsUknWB+GTcMA/YG0RW/H51zj+Z1w9W+DerfjmdvDPoz8cAlbQdNtC12d5EMQTnU2//VMgO36/9HXz45etPT/46dHX+/0/9/iD4au
+c1WR49buBZeXIi4qrZYQOUAlCz3p3pi2l6TMjE0V1Nb9ZC9bBlNTwE5eqPnP6kZb0Y+MPCmHOp3C4t1pP5RwwzjWMUNJi0Yvr3NFXJRC2S5b/vryjq+psECQ
+Le5IrwdG+SzHFC3rp2mMroyIH4tTh5reLFM7IuItWZaVPiyrQ8Crinlj6nKJiosytxguw8IRUasYow0wmMtW0ZKg5NYrIfb4bXuEI/ZVrcrc1qq1tVwiJ/D1
+undiqfhI6rKYfLZXX4PW7OLQPTpMGpVHXQa//KxWRaqq1FfP8p3lfFs3aZjfektMSsyZwKlSiSwr4bnx+FOc5vm8rNI4xR08hjoIbLQ2kDIiiE7ovLyHjrYf
+28OiErdcGNZaqawQevWyrPw/jPOA856BdqkuA4ZfMDqyLpsnmEucFUi7pa7LpsY2MqKIb9Uqzoy88K1FlBYLrfdkZo1x8Pkxb8rCFsdMfRFrd07tqUN2RvYt
+MmXf3utK63xtSSxzHYWXihLfkxl+jc9wjo4FfIAIF1Hf0tZAmCQfcoz+ZsUdgSSzQt6qIs3twYPfDeSYOZ38u0XM3GIfxUYrHRxCm7PoiS0D6VPf6rVwRFoZ
+FZtyQRk8fHAEbjAj4l6hVX6t6mxpycL41WwRhUVl7DZ3/NWt2XbWmiU5JQkQmWfotHVkPcBkxo17+qeWVUPWi687E5guRPjuBkch5+vmRi6y9xCnW5VVbTPQ
+8SPpXc2R2LHatnAOFg6ZnZYgiRNy/5aGiUnbOwi/D9iWrV1Ih2wgh26GwlPNkgHWgMGUNSfSIvgpJaBGz7wjAYEbdZD/R+4n9G33nQbLPHKv1lE1Gl6WrLAI
+ZMv9GDBUUQE85ItMOc2DOVsC5pRC8PnEp7BheJS4X3r2QekBLZPzkd1mztZ7JYQ64KLEOKhli7etu1KduosccqAVE8GKBcjlVUlAM6OyVHYqJF0ZXejawmr+
+0kBFH13UYqnTrFni0kMX7emOf1/iHan+jBnWNcIBqLKPliajA0Autqawh0anIO+1sVcdveJ67Z7tF/i1p4fbPFfti8pOuRe+Ev9cHzgp53YsP0OxJ86v4K1W
+rcrKsdOU1Y0qGC4B1Yxdsi2R3hSHxnZCOyYTQ8m31njmqI7FSrEr0J6C4GcJq1meIu9aB0Xug20nlS9qsVQFUl/CBQHSHff2hp6xcpCTB9OqfwZ3fVa383O7
+M+ymND2g7cj05JFDzGecGH/Bt1d4IIQ+YLcXV42elw0yiBH/cMCgaFph9/YiCu8jcCpZz65pyzly2S2D4Pe9giv1640MQkyiaPpEUUlwhTmnA/s5F3G9rI8V
+GEEX1MYdH+49itNfV6i4Uv2yuryhAky4HFlBvHsECeSzFOwi7L6TDAcS4VW60li7UdebhDhkocyxjFmx7vjS/EZYqvltVujDSqsUV4Gv5vjsUk+7i0hHEK/7
+jYcv+gKPIJ2d9rYX1EkiGeJQZTANRu7HE+HnUXbmUWybpF/r9BCD++MnNZEbZjUB9iNgPSW2goEQz/0ZcMR+aUuRdUxFaYu0LdaAObZNnWVaOFZD/WOJXLde
+V6wDFbksHVZF1baRmM7eddNRYWm9Sdv17xVZbXS+2HDiW8rGzU2lb1Qd1e3ZZ6abtfCIbZLU/Dlsx0Wl9YGXHlHRl1xVN2E70rcj+tsxHRwRaqa4xWkSgimg
+4Aj2FflFvIm4oZleOQUTbP3gNDHCTgwyM1lXdbmg3VXeFwhqiA1sdmNj3b5aV6tK1yF7o15e6zSa9ScmfNJe06oIbzR+pZH712uRk4V28B0148CCAcyT6hRX
+3hawyxqNXjhnhgOOb5K9YQ8zvPpxICkGi15wPJMLSHZkM+7lnOsHZ8Wqqbk8K80ofV429aqpvTa/8RqyKxXlTy5gDryF5eM8rV8n8rqp0bgmJLQ1pKmUMzkO
+QH2DMbBlCKmDwX7N14kIvnXnxcVXArEAuwOVVjs1ZRXPDIbFtjWBlQXItjuEkQKNdlOkzXK1B43tNQXskj2uXoq+IZpUMrA5JhXspGyJs4B5em2RRvp1Qc8w
+lL/gkEp3f20TiBhZoQayWrQWJzA1cXUvVKVuKrW6NfIZ5WJsPqDCZTy43rYHR73r38iR0+LlQJ4AArMqwafBVyHe7ZViGbVCsqrwmaxgmWgxhObAbW+4YKCr
+dACKn9FmrMvovRFbjl4is3b0AvjxnaoyvOatpW1f5nJxrtdxqx7EHduivgixl2Z0BhQTx/m7H56j04nH1K+LfBEtiDZahD22qj/akHF3vfVKC0kkxVRVgUiC
+gHeCQBn6xpZTrbUJ4PO2MUzRlEMvyNo+jg0WEh0qFRzhaOuKzFiiqW3x6TDm6ZbSOCn4HT4psDwaHK2iRBev56G07PFWde+7ARZqro0EKByznuH6UOjdIUQ3
+DIOJ5BrTsxfw0IoHD+1AiG+ZFDWgBerxc69t45uDouIjgqLyMUFRyG7DcNLF8OSPwzcxyY8FDkMczKKGH45pigfSLh+OaY59TFNwTFNuimnSzI6K9PcHv/ub
+/zkpYYMdHg2O/mbxv2cvXj5v4/+eP93h/37D9W/lRAjn0z6ComKTeV1e60o+e5oAvOlZILj+4xdI2HwKgKcXktu61GqZyBPOOhWzeUbm8Sl4nBD1lUCeF+Lf
+qGLWT5nBi7U4fKvSzIAi7f7y0zgEzAsHmMfq1EjxHpZ+4Hokr+K+DEHowfev5EVVLhAGJ95mVVnIs+wOcldONRHI2F5L2+tEHh0fPZU/DeSpWtdlIWf1IOqd
+fP7s5dMXh0cvvnmeiP0XT785kMcvjg+ffvP8hYQewlv+t7kZ3GdmPtBpY/NMnah2FeuJEYizudKy+o//4a7dF4P3/gf7ezYTZO9A3CtjA51hnI8WlVgQk/6F
+kX0LIx5cGK9sqqa+LSt+Ej74vlQVYvqn+saT5epNi23xib6yEVzRZd4UNWjWDirkeUM8/00wPLnfCeQZ2Ukns5gv35e9g4Ho3UNl0fMWiN1qZbQ0Wttknfv7
++8Ecn1rRQ4OyuvkKM8F8Hf8krj1LH7mys1E9YEoNiDQoq0fMHOXMVizgNhRhjBOkynSuoFFvIp/DQTpobsA+ZjXyzLRTrfrwhq8w7tYDNfyo0cXYP8L39Qow
+mRWv5P6RLX9lW0eSyGMi8UHFL0b5OYSfpa20cD1PtdlF/NnWBwy9O6aZ7TbfZg1uLXVcuKS+1cLPIwWeXmHO21ZgX1jjlCSIOyRb9+zBXieLKGM3D4bENYWv
+q/SQsko97JAcjQUcHmSEQmpPhl7GTzn2ZLeAqU/u4P6zNw0jejCLaq5Svczm8BusBoG0sHY7uPVyj3Glnoa484i83AhbEinwAcy8opznQUd1/PUThLJjuKkI
+SzyJOaexkbvfKfZu2jMiT/cZ5rZQtdwuE62cHSDcxv/qUZZBQEzlPEC1cTB6mCoNOwQPQsRTpeIMI2NLnsKD8hkBbBOZ1SLoh1pAEDl1rLqZbkuv7gF355py
+k0KTBGf3DvCjtFyu+KNeOpJFVFUhP7d9oz4fyMuIB4ErLtaOLspKM2z2ev2YNUCBaQkVsoARyt2zDU2/UWHRI7sEa67VaOm5VFwBPaFS6XGldEqvWOlqpRFa
+E5WuY0IIHJSgQQW5/B6OYtN+N1TKrkv+Z7aEM+XzzTBIXFeqMDYs4M9l0cGEh/EqdIVitXRPiHxPhMgksOJiz2RFBynxfaXuRbRn2lWQMyjHg9uIqbij3hqp
+Ui6mCBgcQf4sLuzaXinymZhOgW0m5ZhzkjSTaYloU20bX5T2DzeORUC7NxjOSwmEvr9O5Hnp6OVgR0hKSOB3BESlAwqQ2JJDAbW2aa4P+Xkt95X813+dWkzN
+3oHMbNZ70H9LaeHoBKFFJs/YD+vFzKvSmEOcBMJDNUWtK1dLEJlDTJPVByKitGzvKktvh3M/DSqHUI/2zQH7U3Hqp53SIvZwyf1+4oKDQF2NF0mgTOOlCigC
+Y8Y77JefFslpkWmHi9W6lPymdUxjzNbeZmPtoxGj7ogtFK0IyohHglc1bLbgENvxpMJWrHPcEyzbHYt+wOIrIlBx5Ab6/h2TGF+8m47f/HApf5gASHzWhb/D
+B5c/jCBL6sfRdAY+nNCH9FF4eAsDF5+Eh0/gs9nwcjx7PTy5BOg2UzQnIkDKb/QqQfeuegDz0TyIh+ZBBvPwdvjHEYDtpyMY/+icvGHSUlcLD+d+Ozkdvx6f
+4PdAEnb+A3DCAfIdZ+10NB3/OERSaKAom8Gvp6PJa7BHieBLMGf1iMjVhsDHlfhuJ/JyOjwdvR1O/8h/lbPRyXR06aDosPQX0/Hocjh9J/A3A8sUvoldTHzE
+DvHM1JPXr8cnyDr3Bvs4mUpIFZi8Gznq7GAKPTcasuLZdxOTGeZrjIIhIIkZrrBopyn0E8vF3GGttAV5cQVceT+OxKeyzX1EBkPSzWAIEhiSjQkMyaMSGHyq
+gmWASLbkJxwk4mI6Ob06uQxmPCYV7OYsyP6cBfEpOQuO3g7jFCe6wtwzvLXRrmedxXSu9rJlgyWWJjar9RKZab1GUTjklgOVoWW5pTHCoqLeLFp1hom8bPQe
+0QonDE2HS3FgbdSIYgwhg1T8zggukp9vGeFDfeNxInUpjlVuHiu35YLdPZQmMBjRMxicTz+gRYNsVUFJGcYRQyiqqbSnSid8Dl6vmpqdB83yxIL1hr2psiWC
+YYPyf6V7qwjmGyfYxVoCt9dji704i4V+K1puhf1//deQ4mjv4OOqwYh2NRjZqgZD9V7cqn5CrZewSNz61y/24rr6kYVefDfjSi9+bwtYtr4yL3VrZbfUeKG+
+dGpe9LqOXwkRtCpEn89YBj5jIV4+e/Gy600VouswFqLfYSxExz0sBDtqEEI2CL756v+KD3+F/x3c1sv8S4S7TkCRvy+r9FdkgHiA/+Hpy6+ftuM/L5/t8r9+
+kz8xdRGQb0Is51v5RlepPNfNjb5Wja6EmN+vmr/+NdeDtH7f5phiN95tubKcELUTfI3RiyYnYATrRIJUBK4wA0ecQglRKkxZIfB7VZs27xuZ02Xh2afAb4NS
+Zk4ZG1h+SFJlSsrtJ9hlVkuMHlk+dSdCV021Ko0WZUV+C0kXsCs9gJklawRneZ77gRADIUZQgLEsQrYAGeOJATEVsWFE6SvhvCYiQEdkpstkgM5fjoBhUzCO
+GKVM9ztxE6UD8fD5X0OsYlar+c+6+nWEwAPn/+jlcSf/8+XR8e78/xZ/eP0lbwD0cFRakwsKnVuUkoU4WlXIlTLG5kapCtmSFpRN4rbuOqhp3EoTLWRWh8Xt
+0XFoMydi7oQLH25DErITiuzCwb4ye85F3U1FJbhwoR25I/xMNMbBjSnbEnIrXeoU8Bvcn1RExCBPkbKxl7wEAFJV6iyE1uQZFwv6SbtqGW1eVcu5iW5HVHoy
+LOpgE+cCNR3eXguITZAgUZSDilQ417leGsf8hY6muiobeIvzCft4AE+jeOw0MqlK6PQNo1/KHGZkmax96XR8LUj+W1Ubwn7CtxQ3FV3YYbRHMIkK9weEIF1A
+ATeIq28Kmnq8S7Yum2r6l60xrnB6mN0nLHbvniu51aW81fnKv99OO40aBXPVUKmEgYw3rPjUDXuj8ZzUZcMVomDrUsYodCEzptHGr3k8MhrI4CMUw5PmWv+q
+5F8Py//jp/47lv9PXxzv9L/f5A+sv7xRSy11cZMVOiblOn4qUz0n0E+lcYMPWmyXlu/omfipREPoThVyUlY1ZKQaXXTwLqEceYKC5Eni4oIYb+jwjlrXyHog
+xoUsSi4iQhXaHBIFbGWiU88zW7kfG2yxHLsouehj2xkIceHVt0Cp82pnmAfiQ+/8MlYlA0KzKLPDU16z8wSaxeLpWd1VDrOab9fEgixEB2QRYr4JZnHpEoj7
+uYRsBHeZGeeCApztumz893gz+nTY+6rkJDZ3ebsZs5dQd1IwFMSBaMD2tyEBbDjwEy0XFiGar5G/q9Kc7gwyOjOCLlaKWFPaM8IXPKmcc+hY1MEqV1mRQ5pS
+9TPeYOjASHxdZ5oUEU+KRA+MFa/doaNP/BlrSqykBzHySi/LO6JZUtw/3Hye76zNGGWjlJD6neN97yC8dSnhtL5CyMGsPUz/0r1K544renMxfMubtMQTl2fz
+DMJnfQjh/2X35z/tn9PJya99/T+E/336/GXb//P02fMXu/v/t/jjL3PvyQdxNw4AkXC1DU9G+5dvDxJ5OZzQX07G9m+nw4K/ZRr/2dvxCfxbiAd/9l/p7/Hv
+5P6tLpD8vL51+FhbMfN0cuKL3VDlOucnIXvqtGxucmXkyUDO5rfLLKXB3VItBA2UA+KmKpuVVLX8SaEnvi6LAA/UBiiH1TPG1V1WMJjwRwXi9TrLaxH+ONCQ
+5uhUe4ZOtSQstekYIeUsK+ZahsMSmZHlSheHdEckrAQEnJimAzNacJGFgHo1IdeTiFmLDg8dvignf1RWVfquBGbu9eFhfRv3JVQIRRv444B8LSrWBFbqXgPK
+h7xYbeYka+CJTuDFaxW2RHkib8t7MCeT2GR0q07EU5iSrTD70Bls2MnrJstr9glGI3PKjVNtz0vfqnCtom1mGA/kdFe22/4MuoO5zVbdjEBjk0uFe6UF5Q0E
+pq3OFYYx425R/C3IBYYgFTduPGocu4xGuHABGFmUsrzOLVylLmN1EqaEuxQuqwN64kyJ7kzBxNQ6WAc3c1btWGYmgEdK3kR+3GROWJYRN8fspoUOAfBT2uok
+8Dkr9TCz5UJ0tiW1iAqmz4EDg2BVkXUQPY37ExU5ETqu+VzRiFURHrqIPmxZpjqnHQl7oXhSc+hIWJOAJwR4nnp2mnVx+WPL/p57zcQVkBbKuL1cw26VoChL
+U8Ij6HhjjCe2w9hdqz/H55WIfoWpyyrThj05w5MRCmESwCx8Q7kr7/W1NFmtmRuWKdd8WA7W842VmlTew5ZNWYSh6HFR6xtK+LXUyPvj2Xh24IvV6KLWFZKN
+nAaLMSHBQhE1LnW2QTrP6oE8K5vMpTKKFtlbuJBuZoK8eehG7zNw8poCC77MmmtWgY0tqE0nnyfEwPd1rSv5r/+qjMzMkyckeYrSGqyZNoE7LBEBhK5Y24fw
+TMJxv1WFJWhKZFEWUSlQko9VytWasqLWSNGGdBMILoNflZVcZDVWsXIGaSfMMZCBFc3JyPGArnXHhkZEXeKwdVzuBfC6+r1ernJVrROxgqlDcGtZ+agM1uNg
+I/yhglJ5aWoYzyKrTSKgh1hfJqWIPa8ccRo4Fx6KFW4f4BU/a0DBJZ0rNXBfIqy9u1Yctf45K9Kwo/BO/xTEk6mqT9JdNiwIHa5Az/x3FherQUfeCYWVtcg9
+kWqVY3GmxjC7CuLC5aoC9+JcDzacEnF14pQVr6iET3A5d1i+cq0tz4mpmxRBKbQzsFpqUQbgi76a1O296hnyEu6u0fNK10a4bUkFSq7X0TrJYNcSYr1cDOTb
+stIlawB+56K436S/+bGX1WOHD31zo++eAdybeLs0FnYP29TnIbgjYU8JKky95wDCD9EN0c4tKUpHdqIK+sw6yIIbnnKVhBVrD0/Gp8wEKt4mM7Vz0MMenpdV
+pTmfKU7TKSupi1tVzDWFEoYMb6F9AQQ/QfQIzxYg4e34Q+UnpHsJ9QmnigxkexbfHf/x0MKXatRDUSVkkAhqSVgoT0xm4BOqKQTV+t0mKSJR7eSHfLWnQt/L
+q5lM1TonfVQBr6iRVZNrjJgxwXZZYCI+mjGUsqyrm7W8KPNsvpbDOXJqHD99+jzZYwCxNrWy8Bh4S+cVdbYEeoXT2eUBvs7fiFH5QlJQ9PsV7KXT2SUDnd+C
+LQRvfNlnhnAmhhHwkq8QEI37WeW6SFVF1QYDMxEEV6e4ADGrkmpWlLWgzBE9D2hJYWDQJ+p/vGJr2axuUH7QFu20L4gONCtu8jXrOkSb9jiLNbA6k0fI0djo
+C5/62OR9OGC6ukNKx75EflRL7Km3LvGud45YXT5dCLLr0qLiuQxGUbYxDAo164/ou3jk/JdVtAQFIhm1kVlNnvdorAGp/GeMWdaloEQ1VqCzivYMpG4G8VQC
+sN3caGP99eycNQkJqQJut7KSf2ncI5wlmutaLDXq8CDpOw6Jvxuf6uns9OJvHP/7+tlxJ/63y///jf54vP755NLlHGBkz391/PTp1yEKHxDkP4xPhm8mvtQE
+MErZ/EttfOrsqiprPScSIfJReL8NYn2jyq+CrquBvOhAqChSY7GdYZQJvshq08rmzUxUwEinSYd7xsds0DXI9i5pnZSODf/Lc8GAl7LNJoihtpar8Dabq5vS
+wxBcNIl+I5QvRIOVS0mnxpfB3RYiE7bEF4EyObJp9tCo2fuuc2f0hVFlHOKkeRJBpDPKWdcwc3pFlUPiBD8Xw4z8THUpqK5eIk0Z3OaZ2UxsHC4BJf8Gub6C
+wODOPLJuXFpI5AborZzCleDmWa178C3hk/fKsMcJ9ZJXQsxqtD7/MJDf68KUhXyr6luNvGiWXyCG/srTjMt8D6ubsig0lzFWuThT12Wl6rJau+/GZ/LF06+f
+fSvEu6xYN/KdDngYSDcrFNtT9gXw1hGG6TVqX7Nagf6VBltQ2M8SeTJEUPxQCJhNd0fZAkzErF3EkCOmXiPrRXNhchj9/7acm4Eq8sFNeQfGwRo6fbjW/5vh
+9xF4uT1yGYwc1eWFmsMGz3xlP0MKarmQY4DdlAQsEuNU3ZaJzIwvUHPZLsodFIdP/AkKNMtgXoKjSWIoqsoe1HZ26Kl4OUhXh8u8nTB3MZ28mQ7fyp+G8PfR
+xXAKaTeQ5ySHJyeTK0r2gUQtiVWeJ1NKqIPvIb8G5KngPCdIcptdDi9HM/lm8uNoeg65QgN5PhpjRtO2p+Q5VWQR3KjLCTufuB92BXhif8bpN2OffjUTE5ee
+NUswe20mQ+hu0pPJh5lJw9ns6i0/fDZ6MzwTUd5QT7Vr6N/w5ORqOjx5B1lYby/ORpcjyG5KOA/v9dUZ/FNwztX4/PVk+pbzqoYXF8Pp8PJqlkhOVLLVXk6g
+d7hikxl3zmXfzQRm3o0vZ9C+/GlydXYKt6B0uXMXmGY3OnsnJz+dj04l3oYzKNf9ejTFyi0wx+NzeTlBTDOmlL0en8AAIBkRqtu4DnFvkiDLC9MqMfvufPh2
+FCblibfD8ytIW7yaQm5cnGx1OhnNsKPnI2hxOB2fvcPMNUoms6vxDoc2Oj+dTGeYc5aI6Qh7dn7KEzeZytfDHycwWpviuXF78Tah3SV4d1E65I/j0U+UaHYx
+PoeURcyMu7r8AdL9eI+MTu1knU7anRf4Nlqc12dQROjyh4kv1fS4LtkNv8MrfMyf07I2+i/F37L+59Ovn3b4v5692OG/f5M/3fwPiqAXKodyQEYXWjWsLIU1
+pDlyhomJzOzIlY05JEwZH1kd+kW4FoLgrApnymfGfkWJZvtcXD0KM/IbAy7MlVrp6tBAafty5ZAK/xer481zZcyB1fQsOIvhYLle1DJDhO4/tMAYnZwdHg2e
+/k3r/z5/9qyD/32+O/+/yR90Q6fN3OrrJzb+6bkA8fhsfYbrxPus2KDiKgYs2OgTYDOiuu1j+/RTV2r7p7L6ee9A3mN5GdD8q6jxsto7kLfKCKyD0i7jaS3J
+5VKnmapBXPgv4yJO/KjNIIve/0qIGMHzT2utqv8q/8n//JZ45v6rsBXuw4L62+fqzlErPuW5jV4exh2D/I0Qle3qbgQUWJXOke8Dk73RbCLYajto3R7CPpIs
+R/xtNoFXPDiWgfx+zUECDlBSaBsRK7a+FfIjt4aIoKUbRCxZEc/ZDCpNgvTxhGNfec5545QREa869ZcjbbbwE3uVtnb/VQT1tl6PMF0PA7xA68xJykmAYUq4
+rLLlgCHOs2ig/Z6ph1juFpy376LFYfxvoTGcwIRRnj6pu6odv04f9XdIPeRntCwk0GzEJbDsyASOrKywnpQtsgVh2nDntur+VGGROQ8fEuAke8X1mxsk4nlf
+P2rpmGSopCfkXabvFTM7U7yJGwndUmncD3RgDcg5sqr0oX5PqfmyF1oR1tlKbC0uPH9u+wm//aBgLj5iEQWktXQ9iHYBN4oAxLa4sorcyj1VV0s1d78124tu
+k07vaaXtctWtpSoKXbFrUt2VGWWWzsti0Zio/Ercarno3X4IOgpr7PYwSnuGFOtOsHQqfxyfn4auBeFcC56cpFsfFi3FB/iAHi6Oew4egHPrACDnS7dCLnor
+yLwFH0mX86ZbJhddRomrdWsN+qCUrAhKyaLryDLseLIWpGjpJ155PZ28TZhzRUxsPdrzEbUCUx1XPbY+oVnAl3M6Gp6Nz98AoUz0cBBWtWwkREtoIqRHIHtA
+X4flsbHQrHDlPyD0DsID5Sac6BXRuXDCXbTHIOrvz+tPmJndZjZPhAuKIqNWkLggL7M61zZ+QH3MivgVTkyrYi3CsnpxKgqyRBIpC0XcK40F1ty56DkCvxv9
+//hvqv8fP3/x4mVH/3+xw///Jn8e0P0tAfXx4Gkih6sqyxEtEjIglwY4OIFF1pLhfUXy4nFq8PHgqdzfG52c7R1QdQ3jC34OV2p+qwU84sjuXGpwXfJ9iI+a
+ebnSvsxvrQuLH8gKl3v7TBCGO0ypqm8147v5x9p222FBM21YtQ1pkYmi1cWzqK8y6CvCdsFVgjgnVb8KZ03h4/G0MYMZnEchLkfTt4487XSMTHBUoh7E/nTE
+fmXyf5+fgpObKNbGk3PMSjsayKB4sKsdJoSz0xjgt9TMqtOrSC/Kqo/BOsLzI2OkCSuozGjGjTxy5YK/7Usyb/WprDqd8oKVrMGysoSUzD/+1x7jRtCzFteO
+G8HagNZ2CV+ub1QuR9hspwNNEZCGckK37QGsa56zCYYfZpr5u5jPKuEEFfxHjl1FrdFD9mGXlYXjv+IbRdX8EiIpx11N9oBPlUjd8iZyj39vy/fuZ0R+vSrv
+dZVwQE8E5JiJZxSgOnYM5kMV1YceLbyWOpNYKhVg/3YRs7DKKA5uP8sOaLkYvi8X2YKu+jk0uv/86f96IJmEyZW8bWq0+dDwvVUVq9f7GTR2rQu9yBATGLUb
+9C1cUKzADDWH4G/gMgjWVBU4B3dZ2pCKEa6+ZURH09lpEsaFxm1xoJ5NRBmZe0RZ0NpDK0fyj98ucI5/7hhKYdIweE+LksHRJO76UnKSlqbi8m/oFC+ym4aK
+kQqsihV2mPD23Q5j6Tz4rBV0X2qQt1AVjPnoLPJPECS5MLnjKVU2QZWKV7phyZ5huWJQlFkkaFi+bFiXwQ9G5hKH6tLWMAanj6zXq3iY6FFqL0jXEZV4lqDC
+dr6sbFICDoOKEPgkLO/xcZRrynjyHdgsquty4mpGng6e6vSquoYbIbV3EvZwH4twKaggFyGEWYsdrla6SLP3AkuaH4SjbuvNe+0VvkdD8+Exu9wghQD8Qu5b
+K5pBfyRnnB5NfEvZ/JYv06wuEQeEpHtULFIVRVnbHa9zQgjQv5w/KzZpHYYmoRJk97clFVIW7v7trijxvPVKTrdefprQrWEC7mZeH2yY8bOs9FPpXESGI5s6
+nGZdgbcRSpPBBInrrMD1B5vpwGPkXSUq8JZE09ZeL4d9D9b0JKiI0bue7R0dZzC4qeKjYwVvaAJ1HTcO80ijUDU9X1bduXN+ILtnsoI59DDV0KaXpJ69kfm5
+sJ8ERiqE61LHY+kUAOLj3yLFvWog6pJfLEvQxm5Vvui6LbDlgXzMTSv23DjsXesEZrmQGhxHVVlk84Rqyee4P6yNyiolo5pgR9vN4SYE5qM2EWzsTpvtMlRE
+7cJIfT+wMCv8LAfVOnIlO6WDsdQoXAVSzIBAn/8c4rhxUQkjBNqB02TCaU2ioYhFPJ+YzJKZeWOsn1hmS5RpvEPJ+XWN4DU72HjG7M4CS2GVzZuyMZ7TIdRD
+OENHsZ+LKds2KYoAyD8va6lkeMYGe32Hr6WjunWzJ2jLtnRTBUfv/hbzfMIXesJPrlyNMiR6gyfUzNcBj6tOBVc3tfNIguN4IN+gFYS1jex299rLrM/13+dK
+D4UmVggP5iPyM6OcgERJ5esYRLUOWlUQivKQqs76KggQ6U2Ez0oOT6q1reqyD57YkaKwJcnVlK+Fq7pjP4EekoLi3fhJO1U5EpGob3YE36bb09GDuDW4IMv0
+d7cANiKvDOH1WAXxfPIH7SIIrgAF5o+B5sS1KFpVKMJaFDT3G4pR/GQVlkoL5uWnN9lgI5bix3ksjd5a/MM08fwJJ9u2lfvIquiwgp9T5WWh+UYKCjvwzdrz
+A+cvxB1Vl6wqxR2yzyrj70ysg/0OIzY267ZTtUF+RNUGsbVqg+xWbbC3fktibRNBKKVtjQkyMDkv1E68TQOM0gfxEimETxK0Kx1xMcFcBLWq+Gxw9pPVUqie
+wqeUd+i83L5b0FnrJEFG5cq6Ggqn1qXuG5oYW3aqulFF9lfXi8A6ivexLcWkw7btpQixIsukGqh2WIXVWBX0The1f6DvlGgKuwr/rqh7ZRX2vku/jPMkwxPK
+DN3MfQVnkJ4hwBAjuRcNmf4YFsboNpE/k2vFYdmFxbK7PRHU2CF5+3W70BiTLRCpON8ZbSkfxzq7Km6slQuuvgsmZ7PcHtBljPxmCyuOymJPta63lVJTnhwD
+icWD8jKVrZHy8FjINm2zY3+Hay+ug1d43knPy4jFx9GDVFVIjLjMCl1YI5duCSs/cPYtwAuHBb/mF82DFxH+P/FlwZwLwcZRexaE5ywIzEPNGa8yJLzJEx+3
+ohVRtRdjttuOx4TeLey7/QXkNVO6Z+wvsSNcr5lDWhb+AjBya2y1B8CzkLpy93RkXa17uQd5OSejPYqNw8yFPAYILgjr74P5VDx6quKicwrxF07hsl3umyek
+VXU11WwgmbqKnexMlHjkRMkNE4W7QtUS8utqgdfuonVGEBNkXtkuKdsfP3Nh9JtncfPCBLdZtBM7ZSCyha/7HxDQ9LdaVonvIGukgbPret36nbCjXxAOBATQ
+nS0GFtbks/PbqsVHOUqwXmFJzmClpM0uCnJ5VW5tNlarUFrSmnl6feFgQK5MQJrC3yswqHv3DY+8PS2i7xijdnVjspRIAYxUBbxAQ41+qy9HK2xpHemgxmI1
+KgGm8v5NjY6pTmlvGnA3fNA76B5SItZW2j4tTGdTa9vPkPmvrHr4+j4iOiPKqlOOvNuDxNKjU57r/LazLFIZ4Zxtbj6xpUeEhOILyCr1gvLneS+wNe7G0zIu
+/v/s/etyG0maNgjOb78KH5qNiZwNokUpD5WZbW0DUZCELopkk1Sq9I2NTToBBxmtQDg6PCAmem339/7eu5jbmEvZK1l7T36ICJCU8lhVkH1fdZKM8PDj6+/p
+ed7Mzf71SEdKFjJoE5VrpNQ7SiuGdUkYDbHJNEABw+3osINuKtV1TenUNfWByHoy100ooRI9w7hq6iGDLmV3SPYBvswqc8gsOnUtPB5iJSiiqUBj7VqsKgIy
+ouRqYtqvV7bxlk7RUG0MXH50abY2GlWihoX8a0zQI3IrFvtx4IGFWPUsGR8qjV2FtBHJyebxE+C7dn3Ed6Ra5WKYhNNOrnJfKIaia/oxKX1JD8s2lM4WOtqy
+oejlwjXMC45pZLhea9+6pWmwlqsuaz23ftaU17k7VXedqHIm+DHFklf3BW8oqBV5yInDQ7huZUOnpTkTJboydygYYaXQVIh+riIuDR9bH/2q+9DNngtBnkOV
+IlvAA41g2FDG7cX4cnpZqE761pSympJgdZbOZcuOIi+g1MSxWaghRqCEKam0ndMA83U1vTqZAIzt9DDN2ypUL/3LNQ8WfaOrjGJsFXj0E/Z2lAdzS9ZkOHmR
+9Qw5f0L1CdxQURzWN9k2gVSj9ZKU6qb0KGpD7hGeLZSyaX3mJBw5YPjSZvoLEJfI1MFLJ0Lag1WpI3UybIHAGFehj7K9ta7ZZCGh1jVt6kGo7U1V3th6Zg8K
+JeHfohP/XT+4afeFB25uq/IaFR88cTfgnqg24TOtNrPWH2zf5FxINJXnEHsJxD3sJkhY95Pd9hCtVVnPyrmtW1MVahubVZLeCVNhI4uToQLAWMKqI+6hNbfm
+X4cismWd1LYSmUfndavnX7Uu9AQGWTnagTfOzSFlraCokG/dCsoJIWsFQZalIBcGoqvFuo6KA95BWWJDQsgTxk0fs1CgU0i6el614M3+DPou3MbfjfQYa7/A
+mN8HlrRGj+PdmO7s97dlZbcet3vs+CJorlTbCiYU/ZMcZka/qDaYdb2Aq4NK0mBhNOz5ityWAqzHjWSXNdLGyLFImLMic5OXNHmmA+XUJxtphdKz/SYSYHIX
+qBr77DZtMo4GszJAb3d1VE059MC0BvhrEHKd8jqoReSxsuhdSFaXfET4kZI8k5zQ72QOIH1+bhdW0vghMXLAdW2aJQgMFVi2wmyV9WzdNDHSxF4q471t0IIj
+d2fR33nXUuUj0F3GWQva7l2ysRKlK3x/BNhZrEE5kIql1Pj8fHL6cvq37/Ubd4cWLBX7fTDzrXXUJTibSl192YsFJwyoDhji2pWVbYDYSlwTSY3oRWmrude2
+RpIOmFelriHOZ1uv9/73/2MPtBcC1uA7G9k6KAjZCErMxJFS+y8dsWFGHIG0+D8foOmJthkTMVzb+HHSp5VKblDhJdB+U7fmZ6GyIjOVvjrS7y25MxtLT88V
+u+/pMdon3sd0ZdLbVnIlSjDy2kqv4TzQPHp4Z2/VlFTGydzYPWEOG4IOaWt8CdVjeH4k/ho8B0nF/GZ2C3HcUYot+t83m83m/9D/O3Y1S6XGsOT/ofvgIqXu
+3SOF/jHNq8xhWT+ILq+QPz/Q9eO8sVI8UPwxtXpFwiguqs9OxFzHttq0SiXJjgMpopx3/Et0XBXpZVO/Ti8rp1utLNNmlSJ1Vv8SdVapqM+O9KW12edlF4es
+08rUN2tzY/UN0leA2aRS7Q42bdSAfX9Ev2Ju+eTVH47/PPrmaAD/ebTL//49/k3Az1RB5st6GYVIwCh2i6HkjEqDmDlRbLIQOhbJn30EJTSPd3yPCt9hT7xS
+XCdwMq1rwY6rQ1bcu46m5BPBhgnxCtdAu0V0rMPzmBK5ZYR4xSTDTEbDVNzMeeU5hDu3kIunXN3pSne0h7okWZk3w+97lE6x392iqaFZRM+tfRtzGZgRHNt+
+6H1hrhkf/3X8ehCehepOF6E12lK+Xd1fvl1/Ufn2xxVp71ZnHwRrKeEiibisqzOujH7BDDTwU79IeFobXAqGq7wqeFYyPAC8Hl8QmxfhD8ILTV790fifp0ff
+PH/Wl/87/r8/gfx/hvWkfq0rQG0Vilrrz7oFil/1GogRlp9bKib1W14JIdL7JReASi8AnJxQPgD79Fm3gTQ22l0H/7TXweT8j9b/n33ztF//CSChO/n/O/yb
+zKpy5a0+R8ERbNZD/YkJSoiY7+zt+fj0A+xgYTtMhcS705dMTkiuMt7LenJ8Mj2/nOjzdy9OpseK8Y16f2/8+mKCUZq9AxIiPVAjHKUU0ihHhT+vIt3dpb6Y
+HE/Pp5PTqyeX0NfJ+dUYuPmkF+Fjo4CLnLyanoofT2vdQVZgVj+kWR1I0HdmfMg3QSAA+l4SX2j6B8ysp4SJlI92yEdRej2WQCs5VPkqvO59Gh2nMfs7/Xy4
+QLXW5UFgieDo9DndL1nz8GB50MV2hGd/UJz8h35SaY8v9W0vCb6ktVLjcA4XVDbuay7Uk9TESMYx0uM8MP8kNOmfYKPKpM/rcoH1ho2HTtl5t0MDmb66bLnQ
+kFTuYUSnq3vPPvHsAx9lvfKSCBPSpmRCVOf7qAV8j1BMjChKjH3p5muuDjXowSprCK79JwdlohNO2g3+oJK8s72YPa41oTBhBaCzXeJe2c3c5qhzDlzDx4Dy
+smzjKfOTs4ophyt02Xea2gt+S8p399TYvTnZ+cri1FHn78nIltiZN5WVbLhsA4X87DvIKKYMbXFud7rM/ynDhr92Vj1bIU1lB1IvaXqYocULScVMphK6c3fr
+BN/hB9c1lwoREgoRuWSSfIR4gNoE8o4ISlFyXQ6TOvWa34IlIEXXqzAIbbrAgQRSkAIHHgJrqG1c0hlYQ98D1ogroXIKpt6qhfBiJqwxlW5TREBH7Im6C7mG
+KU6qW/eOwR3Xf+Q0d9LTafPIwVN88CIMA400Alt0EBiEvbgHeqEeP6WPmjeijM+7z6F8ioQJqVUAVIi0yg93PVfp8SkXhZQma8vlwGYo5Z643uR/dE2hsgTB
+we9hGrTnOzHpG3FKzMCXj22rxFMfJCClYQ4OunZtHHiMtSaf8IrkYRply4Bq+tRBIHVONQZtoynqUUknYLPhBhkpNTvQcbdFqjUB+FVcma63YQVcdBuSxPDC
+66ULaW8xs7295Ty0QtdYQGjdmFpM+pSWrpu/ESAnsrAhPUzkfwpdCFH2QdouxeH1LIrNfAF60h2icHz5TiC6dXHKME7NT10js0Urg0hbj3jpbmWstJ9K+ilp
+AGkqzUiPKUmfs52gGwk9QQBdEPlYDyMT1pyFT1x08WdgCpIfSgJQKBxm6yaFFgxOsHShtnaOyVw40QSlZeR6oeCXafW67jkok/gfnAEIZif9zXwtKtNpyxZe
+Do8+8Z2BYHMzbJr2t3zy2i5cqpzKjEaNYH7Q3yABlMunhfe/1DS30J9bhG0tFuUMOx+hpjxTXEMrPTNRfgb8TB5yDgJDjpYq684VE7CGF5P/eDe9QIMHshSy
+/sdMl3xSs+NW1j2ZTXJCQb+3KJzDHj2woFrdz/i9F+YYhvRD1yJqff/T3ytVHmiLJaXKT7baJKc4z2LpaE/4iySxMGfwK0KqIsOUO7mK96YktmXLSaRwl+fY
+tqS5e9voVBZUjyks+INSZWcyCIVhHzMXUd6lCXNJzcpHJcvprSXv5I71WZFH7HN5IGUg6BKqN7E8Q/CmYkZ8Umcq7BO8UVCpsXMlJma67ckMoPXoSGuUR7zR
+yk95N1JNRoL44ZD4hAgE+9RTinDJKFsm7Fvr9S1lC3H+RNkS8jImHzMZoyaDi9mTlGGsWUhLLrFuLacuBzPS/kzWOkmD97e27va6w2HSUdigs53TK8yRW8hP
+Bg7s9UGWFpI+EVoLqTcoE/CCim+kYjjbpsIl3til+0SwkAqzLutNIi23gZRU3nJPumPfJNVJPAWc/iOeCIJO9gS4ShRgodMUzhBe2A3daz514lxEtF7rwqdV
+/4M9jU+QjkmliZwB7DhJ2ix9nMLU6UCpgpj3M2MkVnZ9isxWCc7V1nOiWAWiBl+iPIJDVNvGhwq/VfkRypJiWmbKmaZLj5Vra/bWcDWYwG8a+7yODrdw2Xd0
+dgWGdBLJyW4wkzYmeACUEcQvheloc6c9NKHCkrGoEYVz1ljo28q1LMpyGdlLivRICtegbkGXevrXL+tsoXpOrP29ZHVTn81B0O3gwOGmogxMhqByXibU1W0G
+cjr1/t6Un4HrKW85BZdT7m8hkp3xLb71en/mqircPnsn+ODeQciLRlFJ13MhcHMfbU9FueiG2eNSBTtTIKUzVId6sMvijbM/k9PJrH00/QyXMXQh6SqatUMz
+qzAvrq5t4pLrYi87+3XbwipZWDIJ05TgDqeCOBoz41BUm0bT3Cpk/mb2VHjAkIKOsrGyN3a+RXFP1RKsCO0a0PFap/9rbSrM3jX1tslFWfk93BAA/V21FeEP
+F2JYy6BVvv0DD4vMNo6GlKLrA1b88xa6Sxp59XDPccWnqFoOv6nBDIU/43HwpJzBbDFvuva2bSup9n/j2jKt8LZ1EoyU1y7BohFUeByZNq0Wpdn+vGKgRmob
+dSTEkklqo53/2N1U6HP6jf4bdLqjARE7QI1fG5ofCEor1puG/k5vU0079sOh61NOMt5SCyz0Gutky9y2LvatEHqOXiMEb030cYk/DO+mvqmHWt5Iv4uKiZf6
+xPdsqTu8CRDcFqWl9CiRMn1Zr5Px4aDUg4Ni9X/B9b1bMXv9cI69Qv4HUldZ1KbAkvvGRcoMvExdy/AVX48gKi5hfKUmf4OgmR6HYk0nH/Tl5AoC4VdviA06
+jaMVaSQuCwSenarxaQco9pmZtVzpjDuiYlZBmkkgTWImgRQBO/1w3yfUNpxYN9EA3nwQJkbKY3RRlH4AMKYfBxhToWyC3uaOwD+KvwZstWHMWNl6lULG2OPw
+QFxhiJMRvL78jXC1u0XIVbFN4xpf9FLF88ztoCDAPk7gSXPTmiImy7hGwylYRft4XbOdQQfbke+padYrYdchsZ/BS2NdPthQgVf9C3a3VNsLceVQWg+p2EHF
+huwRyid5M/5xQoXu5IuPyx9Rkj+iH5M/sh82/8DeVydnl1dwCF9Nry4PCv3m7P3kx8mFPh6/g7JrWJiN8k6u3kzOLj5k81PohHc+UM0rMCWOr9LHzi6Ifz7p
+yunk9cn0NRbCS1npDzrpLmoo3eXe8L7Q00/+BrYNZcdA/zm+hfEuLiiHuQcFpvjo6Ss9fvnjFAYt7Z1dhjqDZ6/05bvjNzKnAX77enI6uRifYO3nzPMwYL6W
+Xpf1J1OVmEu2ri2Y9zObWMTxCKjK3KGHMnHzo2cGTzY2wrs7tJLUfrXMNjlHVLC6N7iUFQhZN3iNkAot+i5qKZZS2VrHJkUcaACuo+myjKF0EFzL9VKUaAnG
+biSuhDe0ig3R1EB/kpmhstqJsIysUJ/NYaUSDiv9uRxWQZdC017tZyyDIdjS1eNRstHdHCvjNyHechA0aU/TmnqjaXz7AJNEDar3AItocdrTHmL2bPVs/1qo
+iz+fw0qpcVUNfWvoOuh9hVIrACbqmV9Squ+QqkI9CFWeB5gKBk4PeIeibb0WBU/AqF1H2Mo2pSPUaLm02ixa2+hrO3NL3Aow+UoMidrV8TLCFEvzGaMP4y7S
+SGywoWeYSkkkSPN7bT7jtXewi33i+FGrBk4jCoWRDljOtHOpFTjYQylR0QvzXG9iQzq1BdNu0erOXN2W9dpy4Lv5VH6CszkBZ4CriWHYNkR+kVXd3sKTlQuh
+63WrSrYiYxmZsg7I1hmJokyYBqcd1w8Eiwowpeo6SVvlTKgIciRnDdlmsbnL1t6ZhijFGsmtwMaVZMb6W13bOx24oxPJEviJD/qDY6dzuURNBv4vcZPVYha0
+t8wr3e9Njx8tKWGuwuM0FknGe+XW9Twc5zS3rNd+9qJKXkTfnvflDXWsHxbDSRL3Zq9dGKjhCuVVkruUxU6TuQwlG0JDWDPl2iJBWa2Q6wi25rr0yFEi79Xr
+5bWspWzXZFk6TBQAPTTVndn4bjGjThGzrd2i04V+PsXJY0K2il4QSQEoWN6Y+0dZetlZkJXedQ7Yiru0JdSWDLRPu6Ei7i/pwkhPAn0Jh6iE5IZI5qTywrN9
+c4BnCm4RxQwxqajgDKTaJcHnIF4Syup+UJ2j6eloh20MScAPHS1AXmH4i6udKYvsBLbqhr6rSrpV5jGM2rXJwLOLUw3EhVgazIVzJls6AmBGtyDoDrLGl8Qb
+tNCn9k5/EHqX7qTECH76bl6l3C30eGmbcmZQbJAHEyc47Y+iA4P+aaNTP+jwjbB0FNOuUQ5trGl4xyLrjGEHOrdgGuctn1r6/p3B1Ydtx/OMJ/4/16Dc4Z1O
+3qyk9H7UMnY1lv9R/kH+/x+L/3r+/PnzZ7v8/z9f/v+zXf7/F+b/E+PXy6idqH+mhP+XX57wvxea9Ht/3oR/nh9139xERnHX6rdiySD50Jfk2r/858q1f/mH
+5NrzJgEV2tVz8HKF+tbGJ469g9R53knI//LCO8duboMS/HnFd9IowZb6OyrW39G/sP6OSurv6Pvq7yi1l+/8wbm4dwokhwZUUK9YzIRTp1tX6LmtLP4Qi/Ek
+fY+1XogAl39WMYM0sA/i1KWlX2aVaajM2wYtMOTJ6fQWOhkLvpmST3Hnc2Hzd0RBv9yQSsoNcYvE5DW30J1YJ0xKCRVU8aog1iP4D98261m7biDjzjVM1Z33
+Q4JVZc2pV4YoLoPzBgsZweymhYywOb++xi91boLuwGL5or14mNMDTxkYC0ghyKJeroG7kKujZXlqYIykmVUWvYReyEEJruAWnHs2CiXZcKHSTwdC8dD/rD7b
+cGk2dW+87DNLs6msNJuW0mx7Pckj3Wa+Iej969N3+jXyRVcdrS0jfwpcfpVpbRNdXm6RJUJ3CRGJBqpb/Ans3JSgyEuuWhnvhwG96O8coHRvNZn7AEovf22A
+UpcOfIc9GsYebb1HwL+IhAe/AugovT9+GeiIome/Cugo5WPfgY52oKMd6OiXg45e7kBHD4OO7IEeYqQPl9GgQYWnMw+WfLQ+SEJT6VjKDBqIy7KfBdxc2j3d
+696B6tAw98PvdmbLVdtVjzv8/dotlBkyC8tFEjHtvyTBGP38YAs8S+O/56MjjFhnU7LF5GdNmAxE+ENNnqr0Ecz9Q7qiPm7D+PSWLAbMaxXKpY+eFSHo0cst
+hLeWK+hKL1nCxOIXUbB2rKbtaJrtvjIB0ySwiS9C02ijPgtNE3EtvZkwm2ShBvaQCccoVvSAzau64xsoV8JvfhbCbSBNNce5dWpR/BKcm0Kcm/6T49y2zEi4
+aNUWevgvRLup0NR9aLegXZi2tctVSzY3VqkVTBNF7WWjP+lEQ7k+FZ4m2mnJqU3wbFmCceJNvi+JhTWLEKe8DhXcosGEm9S0pV+UkmlBX1pGt0eCZHg+irLu
+2SAu7WVemimRFuyJ/ywkGvqBykUn2H6gS9/xTLK3S/KYUHqEZAchlF6gC2Xos0PXQsK+ssUu7hX7TlcTfmaKaa44Qk7Qyc+35XXZ6vGQidd2ZlSBYNo+VckV
+hbu0NwZfBJH360H5no+e68+H8w3Vb1MD5ZuKIBYtwdwiKb9rMj5lt0hO//4ev7930K9qlm4fdj5uhgbH+STtrU2vg65Q743dzOcJjU+SGS7F0nYwvx3Mbwfz
+28H8djC/HcxvB/Pbwfx+Z5gfIIeuzhiJcwVAqPPJxdvp1dXkpXrxQY/Pz0+mx8ifezJ+fx8qUD8GFagejQrUj0YFqgdQgfozUIHq4eJxj0UFqkeXkXsIFage
+hQrUj0UFqodQgfqzUIHqPlSg/jxUoLofFah/a1TgZx6GrSBC/SgQofo8Eur7QITqUSBC/RgQofosEKF+DIhQPRJEqB8GEarPARHqh0GEagci3IEIdyDCHYhw
+ByLcgQh3IMJ/UBDhy78bEOHLXw9EqNSXoAj1VhSh+nwUod6GIlSfjyLUwyhC9UUoQn0vivCUa+z3kg1IrYtO72ubqi8SVEuS+lXJIcBsClwTpxcFRFpF9NrW
+dlHOSlCeeFzQO/JfD6NMSEONwaND/YrTX/tBH32KYQel9hAqmUbsURIMZxTEHRaFa2hbhbbvJESV3O4YbccwaWrZdTI6omTqprt+GmGZU3QhcSxy/r3+f2I5
+Vd6DqDXxrsf/RrUyJLmqvPZvmuR6axv7/xrtKXVZotqS6I9bImJp9Bj/EmedllolKTqtky72siOC/tZZHlKBKfuodi3X0aYQ5dz6spGi56t1m4YRUSVKouig
+2gG2wNahYHTqikzfqhydsFiz3WiBcUk6vAEPoP1kMKrc2Fnrms2BJjyRiSFsfSclgCFsRJ7uyrmPySbgT9OWla5BkCxZIjObrZtY3dgP1M0l8MEO2/mYf5N3
+L01rbppy/ttBQB+o//fNs6dHHfzn0bdH3+zwn7/P+mvYAK+bcq4vxTBlgaNjper92YF+9vTpkU6ez0D6XZh9MHJDMPSTq9Z1C/JslmXu4m3CikLW+ivXKAS5
+JxXHtau7DxZ6RaXuvLU6KTpt14dz2dpQehqkZ+1bU1WsM2BWuqT9069CTn8GIYIro7GpxaZCfgsPk4usuya4UNIW0roY0BhX/oNR+UJx+jSZTCM9rjcPuGtD
+OukGqwC6RmOhdr++PuQ/WQ/KRUebjbdzvIG/xwKPF9ngvP7c0WEQQmaSkmVRU+wXTZfMAfqzDCRkC2rj9Z2tKm1CaUbfkoSPCWusFMTOMTqx6eBtJPZHEWfx
+MviYlBGCidIUFX+8GmwuxRmk+S6mszV4NvihTnV+moXvRcHirRfPSCwHZT/Zyq1iJDs9p/v37PID0FjGVWub2lB0vqA8KAIS0q2cFaafSSIxZW7VcNSqCquw
+W9MUpI3nT4XUn7Dm8+6qsHsMs35wRkEp83pv8vL1XoH/R185V30sW/wxjm4P1y39DejssI/3lOQLXVtK1qQ8FdcQMhVC1K5NN4ZrZIp9QE22mLgBUEFFaiAt
+46opYXs3cAjrRA+E+b+1q8MV9eEQJvrQzm/kpP1vM9vUo9ntSKmvqDo+DJ4Oa+0SlwzqZbTzAjhVHGHX6xu9KH+GwOHKtLNbQO6tVzeNmVu/BZJZaFvfmnom
++XZNUsQo+PYW1jAOcMHFxIyETtIYZve4027ZsP7FWzH6nFS5wD+KMyQv9Iqi6NFdZbijiBWETQIkyNTWrX214cgpnB3MRqIlbwkY4NJcPV46NVCbAjVdqZc6
+h9/i1sFgsFh+Ib5fB1tRpXvQpFioDkoqhUYVMLMri5ZuRJepkm6ezqVjuzfOpkilifjYgljHyVWPnlzNxawSrDHfPK7h60gF1v8isePkc4mlFl0DfAV15XII
+OceIG5eVvTx7dfUeCrOmMeEXHyg2806/HF+NX19MKcSUxcaS2rNquNKs/rJKs+reSrMF/O5yfDW9fDU+voJQ13+8G9MfejVoVT8KDN17N1CK9qHhqrfjv04g
+YA80GJNLCPBhXO7qzfgKX5Z5LPTbs5fTV9Nj/PtloSenb4DwAiEFGBObXEx/HF9Nf5yo92cXf72Ety8mZ68gQn5yghMjsW6uenuFEc7js/MPGBwr9NXF+OVE
+X06OLyZXISynzi/Ozi+mk6vxBUfRQpg1iSPmYdYHFzkJgJ6exTch1MqG5iTpwOTi8uyU5r4fGA3B0DRCmoVC0zgppBucvwMakB8ngWeVQ4THb8YQwZxcpJtq
+iHT4/OLs+B2hORSGBl8wK4l+fXb2ElfjcnLx4/QYNujJ2SV+AclOYEbgzxxxxdDoi3eXU9hbanp6Nbm4eHdOH3kMn2sMukq+R4GRVjVI13pQaKZaSWY8jb4W
+A9FXNVyxONmXjydhVSF+ujPB/2D777cvAP0A/9PRs2+/7tr/T492/E+/y7/JunEra2r9ri5dnREKzKz+cXQ0eqphj6AHAA0ReQES0tY1qJPPnj79lvDV97e2
+D+/vQWt7B5TiEEME75E+pAk+CAWUK3O7wGz8a1u5uwPO2i4TC24Az4DqCX8RjWmV5Ji/R+6VJNxmPBOWlD53/0uf6WvoxrVztZ8nG6PXck1Y0gSKbThOF6hH
+xAi+dRVlYsDvEcl/gP4Sq8+ERgV++TlDjG51/IV3zdDM3RqvV5WZkf3TM0h1uQQ8nGnBLRv/CJ3sWfAC3ct6/L2K2PPYYdw3uIWUwlyHEGuKGGw2bWDPwKgg
+3lfWN5gDl3jI8zYjjxeMkmsvw6+mdTY3RWewNIGUA5r9AT5f1jeBVuv/9//5/6LNyu18n28qpeTv+arxxMgO/j63h1OuUibWmtEJmmHshu18WcWBvVhsRXGS
+q6PyDn4/+dnO1hhwJTwRB2eR4oVQQTyCLr0FdZhL8TYda3AmznuONemsv9YWirDn6xV76Hpzk5ktgR8G5UbYzJLXoWj/pmfNLbIWyNJZ2XqOLw5+VODdWUYB
+ktdA7Bwtuo8U0uhMxQ807/zl0ociDrRO8UxU5i7NbhQGN7eu22ajwSQrXU1m07hpy1ll9dHXo+42wxM00H/eJmXre2uVbMIUKYeN3K6Xpj5srJkbTjddpvIv
+ilH4zdJ5NLs/2ToQDaysW1EgybfrOWVvkHmafDZuM/40QaPmltuH035DNDUVbDpbY15oWdH+l+C5x8PXcsAUEzpXjW1FkMIra4ygwzKtApQsO6HeNTTyGtwd
+BIoggAmTqfXKlfcPoFdhfqKwyU98EqXd9wc04G1fvLvlHA1BR8aWVdJykcWro2M83ot43DhpoLdPexNhLTS498Gt9x7RP0zzh6fW3txYSRAMUYDBmydOCm7f
+l2kaE8/qcZjV0tXfKwarwOveonQvIJ0D/29la6LeauCowH+kuc5FskDwo0JimGXZ0h+zqesSRhXa1YdVSYx0brHA/y5i5lFcbk7rA0eM8zBVKWvHlvkLxEaX
+M7cK2kUnDS+VkBBUT/drTsFDfkPyMB326Xa6DicVXUKUVE1fQGBDx9VfhPt6LmRTabxUfbK+jc7b7p0exdSaw9TvmbAND3vZzNZL36IPUajnTFXRdirS14PD
+K2phtHVDHlPn4wUncn0cYGHqXDRJa8l5lvND5Ex5ZD7No4IvxGvVNeKQU6lSyruG76y8aewoe1MDFVSR3rqMxS3S3sap6TCDD38ye6MSoB+TCTzqpdSLmGfE
+DL+MeqmLD89MjRktHJiaa0ebAPVGwLqvVq5hiB9F66LjUdXuDulK8NYmPrISbpzWznGmFqaRCcuxAhwX89o7DN8l12tpJZqxdHBCuZ/w/qbIVSmmuoYrXRY+
+lkmC3yYt6FzDR6ganWXoTqpI4NYJFAQq+E1nQR7YmavdspxJ37C3EvmCczfqCIVIepKqVn1ZoIMsYNFNH1AMDVwx/9atrTp6GlLVZgPsJ2OTuaQGBFpy4AbU
+08Bwksn/wKYetZTOoBFdx4GR0Dzz3TFtTZdwEWV/X9XFjN3QRGpEZc8y8Um2RfhJjyhh4eMyemlmt2Vtgy6VQr/TTqXqlalcfUN46h4WHh8ImeTh66lq4hpk
+nECdhMvJkuWlMsNsILTa4RRgMQqy1/mydc0mRP+sSjtfem2NLytS9GCTVYQX97GKGdJY1DdySkPPJRsY914euOgoWKFPAdN+kkLy6+RS6uXYJcZ4mmE3tyuI
+6eWHhWeaMuUiZ2jCbJjTAcTzIEcqsnclZ4ASi1S5xbi5jDHHBTd3a9Y+noBElKJCoki/SMVdMh0tQSxCTOUsyexO1TBv+TThEe10OZofJHPyjADvlpjW2DYl
+p3qaeq4ymPJy5Xw89vJBMNlgNOmjZCYmesf3So0Tigbs0ff5QlE6+UdrV7CoZobCtk/7oF2jAvGDD1lfZPFW4WfmiWVywUTvwif5V5EgQkV+iNIy/Df0LA3g
+J8l+DBFIvo9/UrlezCwYiLzvHf1b+y/+1j5kioxUvztU0AH2cUcfQpvWNA0KUTBR61gA27eoOEcOJuqE8WSPhdx4ycqYc8WL1NDGEtyrTWUXSCa99vZ7EbOh
+hw+Mp6N1Z4cHj8NDKl5HNcTMkActj5A7Pne1fdBTmE/5fgCIiKQ70DNT1475GlBK4/HAJUnSE7F11cGw5IpWbwGZApGalSMZ+9rZYLggy5VpJQ1/26q87K/K
+cboqXVLRAU03WYdr1972F4POQU3CDPwpqsr9gEZLZyub+uVSxp9tK6hY6bx3AfvNEw8gPOlBn+EHFU1Toff6b+yR5AiaF+txntU00ZQhI6aelz+r/KpNdpG+
+JMaRdB22AHNs0nPF78OmWVSw+ChEbkv/LzCvW3A9mZczeUa5Rad9eZRl7qqxYPGMFLCYRpRi5khCiqYMeNw72GV9I/slsaeLfBPiIQx5L6JQHd6jUSlhbxU1
+aFiHifnpcs57SoyKht1WHcbaqMPoh3QYlegwJ+gOOG9cS1CJ74c9mXwzJwlFrQvWNN5rCjOjEnYjXyCsppxZzT+6htOn3KKjzNuA4gjOzoVrImwMRxFY7pDj
+Tpe1EEuJLkkk7rkWW8+VWO1R5azFFTukgYZkgONbQ62NAxc8aSmBLD4xzRpheDVd/slBXYttERWIRnVJyhlaOrflkk5ME2loWxd+TfecaeUeZliVWrk7/isH
+gwjcFEkxo+jtsXM+YgS555u/jYWevErDX6axv2QouDs/dygIivzg1gXn9os7X5A9Oj/X/RWEjTJcpYQNR3ZuJU4qorzKs4Dp14q+9OBlzaDrl0Gfg0feM98X
+7TWxAU0oMoDeY+t9wR7q0svRp1Szcglyima/Xi9t49ZezZLxjPQ0YC+MXpQ1AraofcEdtsILFdj75xZcBKgT7F2vb/yeLutbJoLk6wMY/DPGLs65Y/yOXGek
+xtPZLoYN3Z7vmvw0es94Xfo9CNKUPoN6p9yM5Pn8WBKn4cw2gZ+C1iVhhaS3VWJHdYgciwdZHAttrr34S5JZEhoJQnrMNkWPXbLLaNwjBqaUX4zwxtNo0iw2
+Ccd8M7C5UJDPs70lXHK4o2ptvWemMBhWxzMebANWAJW4YIORBrMci67lhvFfutv6JFDVCWYvKRRERHdltVhXell6+CaSigVGS4bkVBthxmqdOLUV+bJ911sG
+12lZ69qBFVO3hNiJt2i94Tbljob/LkJ+NYX6GlMF2rB0XxWBIcyte/PmQmi8F7Lv7bzEXI4fWiR0IjfOzWEoBaaYasQHgls6hpQEMQ4dNpVkyBYZIYl2wkYY
+yInoYwVOjlBMRre+2FZm/oncpPQAQbUCMwOaktTQSAUsd38Z4tTTwYb9u0Y1SFLHI1MdUBYodqZi+/CLnNw+7rLvRnqcYKokV9YrRWx+PcqZnseja7MVAbA1
+u3WOsl5nTozoWpuBz0G/0J5imq9b09xYbfTC4l4rmLUQmWTcQpzMRcLsyFx37SaGg5IpyVwUpM2xZoWCnRDlbVJTKOjxYU2IHtmucCJwWpNGccyK6BVbKtPi
+asqAdneBhxaGJr8eoD0v8D4JtLWivfdvWt6KPfqogr8AV9iCbluYYEo7ZirAgimv6NlbV80HqkKYZlkFgZ1x0Zf1bN00GP3GiRbWK+9tgwkNzLfRozBk7/MC
+5gd6hskXNKF2rgKLWhCvORwzdIAzPp6O9DjsiI70oFs/kIj0E2XYmJSPQ9dmVTn7yMXATK3LGZQgm9L07SlOmIlX6rVrW8eVY+7Keu7uJFoUWc9/bnsfdg0k
+DZjFomyWjL7xxGCSrKcvl2VlQJ3cFMMlr6xu1pVF6y4Pkoz0cTIOQnrASIIbmbberLKGNMOyaewnh+8r05nPpN/iYuNiX6SK5ezRI0THQsch6AIp9rHtwDn6
+uY3CbCUlB5LLUgIRrcPNdL0J1/izbu+ltoaPtcsWHV02hNavN9jcUIRdknpSX4Ua9DbFRu4NqsE2PgKOgAhpy8KJGOWSEoDo7nvQz5V+8nrDBYPcgngDGleX
+MzUb6ur+IiUovN5E3lQMJN/VlTPz2DQ50lVjEWQjwOADcazGToIWWBMUH6ODnQ8ZfWevfdnaA0U88q2urPGtKLH5fOB/pgBAsHMpaM2yJedK0o29MU0I9EqZ
+ogTJZ+bzhinWOoGxRJiIR/TObHTZct5UEhQptNxt80L71jVWQrIBEJXXZGGj5QjwZcwTk6xdP0cgwcp1Nn+0fFFFSDh91mDkt+UMMm4UOu0QXNSgpO/ka8mX
+B1M7lLokPHabdBW/VruE5yb3lfF+TRJMbhNaDJXvo6wrPaMlIU4mjils0zOZlSprvVhjrEC4eqKMzEzEo+cj/bb0M1tVBChS6j2rj6vG/ud6XpLYEynynRBc
+JC3l5TcsfdS2VkUt5tq2d1b4t5kjy+SZrYNFcYapwmwe7vosnrBWcig/nygs7LpQ32+kL3OCL1FJ4QJrm3VMZAyEX5SBGGLKSmLKZbuV1Ouqm1PMbjIMC69b
+wnnhC1QXqsr1ueuSao7U9k7lNc6yu8ChWoy/TOu+0JEVX9kIS31LK/24Do0+MLUI/nNdl/+1toNcNAnlSrc5jDBYHwYAMhJ1t0j+BD/SY8RTxZemIsko0RnY
+51+N9L+vm9LPKZan1LjepHxesYZ3OH0h1S4RRDJl3gY7TaWbe2CdMr+R6KKwq6MniSdOdeDI/5l0OJa+WpM5/e8QO406Xi/pvARQo/G6MuUc7yrUmUQZeP4X
+efGqsabdaOsh8s+kQYMNbka9SeMZCMebj3bRpWsamhVFO+uzZ102WZwrlQenh2YNRJIl9mucvxDmD8sCXSnnNgSmEBFbtl6vmhL9wsLxzvvp65EeRx3zxNyx
+UySPI1zboQL6vTUjLIAkxWLn1FDnNNvPt3hCb0rfYkK9A8oUFuhXAf5+Xyde2OqmNDV2plx8T7lhpru01oeV/Yy9rdK9/QM1nasRj9gdOMza8cDZ9RGHrGjI
+uqw9RU2GpxJ2rESk1EBUy++xFSGs4Xw8vqbEfEhRx0mFIQwXlNT7r89PDpBmJzx5trJ1nyli/+ySHxwdIS/P89HT8AqMvIMG8RYeOkoe2lKIvPPUsZ3BCcEP
+Pd1hx341/NfRH4n/+ubrr3r4r2dHO/zXnwH/pY9GR48CgPENsUOA/dMiwLTW+D/3IcGO+LG/KzjY4b1gsMO/cyjY4Q4I9kcDwQ5/AQzs8I8AgR3+CSBgh78r
+AOzwzwn/OvyDwF8oNh7EfunHYL/Uo7Bf+nOwX+p+7FembtA1i++jd1fK64YQf8adBA38fQLGfhW8mCa8mLoXL3b4S9Bih8NYscNfESl2+HeHEzv8TJTY4edj
+xA53CLEdQmyHEPtShNjhDiK2g4jtIGI7iNgOIvaPChFTeCi1YfUkK+YRn9LrGnPo2iGXXig4oVIXEGbuJbdl1nI0ufQwQk19EUJNDyHU1Jci1PQOofYbI9SK
+HURtB1HbQdR2ELV/YohazCe2HcEyBErTW0Bp6rNBaXobKE3tQGk7UNoOlLYDpe1AaTtQ2g6UtgOl7UBpO1DaDpS2A6U9AEobhJzpByFn6pGQM/33CDnTfcSZ
++mWIs3yTqF+CONN9xJnaIc7+MRFnXNOMlTYsNd6Ws1jtnTtQ95Bin483K4Ld0FjyYeEu9Y9OEcoBa92T/ZmANYXVsoeG3BueWUWnxFYEFGpVhL+dmQpWY21H
+YbfOTK1bWCgz/2TqllLQ+Hh3e8DaZ9no2a0LXrwd6G0HetuB3raD3g5/K8jb4d874O1Qvz59tx3yBn/LYG+Hnwt6O3wY8nb4CMDb4e8Ad5vULdycvyX866H6
+3189f/68i/96/vXzHf7r9/jH69/dhT/SzYt7sV8G/Lnm1wp9cnKc1gFXSR3wvKo03pEc29tWCvv+MtN5oeyosIeo1lCBaw0vLW27tdA19wVhC1zHGiMk24tY
+c0nkfgnUYD7FjkSHPdWW7nagrNMZ6BTS/tX7IE76rLC1eA4YenVPmey067Gws63nhwPVt/Mq2fB6t0621NOGQSt+vNP1vOz09rrZDuQzr+RwDW144nIyPsmK
+Z8MvvTVVv3K2pRThgcrXRipjb6l4zeOaK7zzP1n2e39GyW0qJZ3UzJa+S2lsOnt7tFserIeteiWwWTFLKz7fBSN1uAw2VePv/z4U38fA4qzVdlX9b5bvlJkD
+nM7XI33+iB4ktb3Bm2DnybgLmCJ4QqVzka5CSekHxYMDgdMid9599Yl/+gmLDz95guVWk+rDk5db6g+rx9cf1vfWH4YvqqzKsB6oMtwtLzw9hfq5UIH1imvq
+Tk6vJpeX40KdnBwX+uwcaumOT7D3V5d5Ed4XE6wDezKh751+GKiumxTVVaHSbiynC+1mtXZDTd39+ydGJTV09QM1dH/o19AtkiK6P6ikiK5Oi+gePKqIbqiF
+W+j3byZYcXh6mpTWvby6mKYlc/HbUGVXD1bZzSrqHqh+RV39hRV11UBFXZ5uiRSHc8UhJbzrPrlqXbcG007TPIelmVv00oKmX9bz8lM5X5vKqxAAgreDCMVI
+vfEhGgTgPsNpwOl3Q4bj9UalCkOhExmcCgsQMnrpmty5yhme8vlCscjx1up/3SbN/21XWfhR/yZN9ZsTQDzA//D82VGP/+HZs692+v/v8W9ycTI+fa3P3704
+mR5LxXVR/7UA9nNw/Qh06aOR/umnJKr65AkHedD1b+sW07oQMY5AcUk57iBcVYpwfZtmoeFXnnW+Ij0LX2NX0HUnuhEyAwjJg9qAyponjQkRxr2wNbSQPywS
+EgcUs4ZU8ir29zn1l4gg4NtZR7Neadd0PuKa7nDU0HCwi9mbGJzFeccgXcxLWbkmR9lDH7+CPk5CHC4P5r21s1tTl34ZOm70Un6XoLlDuLyrBSdZYpKX2m4C
+qjSG/wjQu7CNZLVg377GvgUgVuhEOqUSoyZMe3Q+JcnB2NY30NYU9qyp9Evqlm2yBYl3HYUpcNMKZyShwmCP9hoR3T/5pGCsgq//eqMnPyNtiB5jf76F/pyY
+5sY2GKJJZvguQvJp9W1nyK4Ja6l4LcmwmvGne/7AAQyDt4Fx4Gj0F+wN/TqZk9IHOw47/R08lm222O0EV6BbR0QMlcUfg+8XsjA5V6bREMOZtWtismMA3+C5
+oCCV/VS6te9IBUz7Vvl+8LqxqBPMyX3pbcOpg4uyAue1yRrRpQfwEXLDPDwCTin2FIqHBiWLEk6YdD2khG/ptlIv6Hu1vaNGSDZSSzSZadpeb0q2tns0ghSU
+n37K3giL1IEghjyzRE+b28jEwFnXEVDw2B0OE58LXRUoHgqBzGMmLydZ8Ir10ALEpYPBxKqxZr6Rg0AznG/zbGcfjY7wUkq6nB32FSIooOMpG0a2kxZoZkLW
+iuqwlDhdtmnGn6kqvXRzSH7RZVxHsIfXfDS8d7PSkIxsbbMwM0usPLTfeGvCfK9aHwx4aKlxFTNlRNrasvatqapwLZg6AawS3DW4g1Kf1ryEBApODIVGTVNi
+aJvTo9T955BxMxZweLW7q1PmmVxCEb4i1Qi8xOu6FCWS9lTWzO/BJEDwxWZ2W34C1gVE8SZOPkthx1UDc6rnVt4LHDCH9C6sTvTJQHB63gda1I4T+2jfoJrx
+wa0T2da5GwzTOfAdkaQCcYQW9zFteuhZtZG8UMksSoVyke9dtHuS9jHQJx0KTi7YVPx5uSpwq/hCssYbh44TzocruVOYaONqeYI69cGt6bOc9xyvirhFC/3T
+T/ySTIzaNwc6JPEXQ0m3uImN4Efol7xGS1ObkCyt0C9GAwpEAJgAB5801Gog+cAR7V8fMMr2tlyReF+0mAAys3Wr9r9++r8caDEhecrduoULCA+tvzUNKaKE
+/Z3BmcgaTPoUKTuSjRulzbPREe3rvnLwujF1u+2PPR4P9WgeD8npKHQWVS6bOV4dGzWcfk55kd8rWjuE9hTRyVwws0YhaYOFsFTgZ/iTKIIi8Ej1pcV+oqKI
+unnQc+h31FbXKOPDvWd0oh39AJ9UCpacrgjhLKjdnWY+FLNobcN4FNfkR6A/9YUkhxSU8Qk6faHXPDgPIm7/p5/etWVV/rd98uRA3z9I0cMOCn29bjGJttp0
+uBMIOCkJpTQCUlc4B2STp67YGiUUJMK1TnNX9GMnG4ZRO4yfwzdv0O5qsr5wVlnG6SCfWawbaCpfIwqeB4PE485/NkrlvOz3Hu7osyhr1Gdtdf37bvVsSlLm
+s15u8fDK0B2r8CrX6zqgqhH5ImGv/uRjNL5rB/CBUb/6gcnM4NapdPsNmODDQ91+GNTnHAZ932FQn9GbeCb0ljOhPvtM6P6ZIF6TzJJOCBkwWHYU0kvEURGu
+/6veFqMLHoYeXSbaNFH/VfeZefdDU/Ql38h4kK86VB8J+jzbeTxLW4HrWbHStCuwAjCQLZQJ6cNdYgQ1wKMCTcVOjALAYxvynSeoRpE4NNAIbvcpur1HNhQn
+RmV9Zs9GY2flqoQD9yRl86HMywjdkO7yRKgchCKmd5L1fGvTB7jlzEzj5VTPR1/jRns20mNSdkO6ZeoaQSs0M4cHNxtGZa/plkx0/rLuce3cT5zgbSL9CPK+
+tJyDbXxuxsQ5bvQn+HsdkRFbHFYqOKz4iLvawn/d3bol5v5T/we/EkZForNcdAcLfXjQUVZI1B4zhcPLCLALaeTtna0+Wb1/9OxAL13d3npNsjhwapStLklj
+Qa7EGcxSymiXNObLn/X+N52GTAp4zSgkMo9ltvIq8nzkA28dCem4qemgmSYBCrEpZWu/bjIKkaFTRtPjk2+kULWHF7f0GhpoTVmL2zZRB3DfgwC2ZE4P+pOj
+FGKmlKrKRVzr2BGyyY8CW+W4vOS7kXMaYOe3pr6xnk83NUVaAs1K9pkulQpC+bCB0ZCgDJwtCoGiy6DLdRUTXYYAexERlolpBjkN6NbKnVbBxuYrpW+zkP8h
+JcarzTJYWPy8yryjYicme4GuAtBP2IEr1MP18PlUKI0rVLW6uSWJzBJZmNEENJlZp7qOnuAHfQ7e8GmqS56LLvnWtHAnkC55hXvtHFXPY1QykUosoMckoYPd
+eoa1VIGk1Zm+qnJkMMLA4wFN6SY3pGqwxl+2Xq9RMaFpED7RKGoPCO/U2UEIAMN9G3Jxcg9RcuLasgV18KefTiavxydPnvBEyyRzwAWGFfYxDZVJOMOfVVlr
+v4a8TpAfem5bU1YyO0GuUO4vzB5JbDlqsxbnFwbjruHkkbcgTjMJPTpvH1EryEVbTpCc3pdK1J/no2d0HwvliFuu4MQkTJM4CTR1sEuJfKoMB/2jTdxKbVSw
+0Z7ERjHFncF0rV15vY/jMMRStUAnUeLWUktTQv47evJQHantnb9p3HrlD1JFeWaq2ZqORus4Ws4UYne3Lq+6lMs4WADwQcepDJcAzTTm7sEpTbXs8fk07Pim
+J3VMLYoS/oY5epfEz8auTyV6IJM8ijdKrBTaZ3TBDNsDJcBglsGSGJ9Pk92OlNcxiaz0PClBYOTLiRfG15CPx77sU+LDSiTwfE0jIsESZVV0eXPQTUGDA5R/
+EktMPdsk+HJptnBN8qqiVymdPZNvAxomikfOds7kGy+VElPV1TY4xtLV2/cHUSc18zmhMlG6YyQl2QPCA8IzkamgMdAFHy4DIQUhzEmhWK3blB9N4W2ayL1M
+rcRLdm2FjDcEjhAoVfeEnEoaxkkOUMB44AzMlgWwCV+Ortnghw54so1ee9uoOyE4r8qPbMRWzn3EZaK2+EOjPrg8BY4D1LyHHY8g8YAbD2hx4lEZgom3TqUL
+GDdC10obMDTmTnsXYOBwfFWEgUf7OMv0GdYCOC6XhbzDaRHQlbn2rlpjGQHC9lKRhS6memj8asv4delpXklFgU+aytWJacmOJkGY64AwV9v1GbIyk8HcgzDX
+19takj2RtsN7DWAwKUtHHLLasuRkPRFgd4EZw88hiJ2ZHW6Rakrs/PBxK0bTONsYqsw0LAqAMTifrFeUg0vZVPGOPDp8PvqalByyFGzL3CEdvZaWg8H+Ufng
+Q8k8g7pjJKiuz6F3YZWpzfCApVmoJECn57kxcOvumHVeJCsOarGuFiW6wAY4yhJVgTNyaTS45RmXuCpnwtUjKc9d/VYN6rfFFu0WL0eI9CE8ZVDXVQ/cBT19
+d2h/tBmFv9rm+BGWPHGPOlYCKLYnvEUoBslIUrQ2IfCYZPvKOnWS98W0LOtuTUe1da0jvVTABPtYm2TAmgh0Z6Zt7XLVomAvl2WLgq0SYzzM7BPfocFOCYpl
+skKWAD/qbQt9aG9zYlofbuRHLIBMeJxAGd2jpC36WHkNWHnHhvrLoKmm4JBkxUzszZbkl4GL4H4xvMVM/AIxvKWlR4jhcAUNSdlvR6knPRGnpD/ljnaKUYL7
+F05bJmUTR/7nJeZ0gg5UOTb5JA4Gws2VFfQD2K2ikEDaV9HZHn7d2J5sV73vNqkIlOPTsZXRRg63lEPaxmqjX5KCdon8P6ieXNibNaUoJIpguQxq4MI1iv35
+FCTnCHm9GSAH6PqFwfGUMhND8DCG1tMui+roY8+a0DPSIGWuvkdPBXUmYvqHe8GCdWl+LpfrpcQQZHA/BPdGkNIkmSJns1jMM7LE7IYB84x7Ty8suWHkTlE9
+E2bQCQwODfxM9/2YipABkAY87CPNjF55KDPWwMK419DMFqSGDw0jegKqDfsCeLulzgC30IjehGio/4hUE1gTRnySKBkxmq/LNjBfd6Iqnaygq2y3x5Jjs9Tj
+t0XjBcs4EqneWjVgBYqyHy7zvp8J+CbPTk8nx4BE0Fdn+u3Z/5ienIw7Wbfc10lTmfomTYYyeh4JYDBVkFftrfvvsqq64L1C1EJ1NHqK9IAhy2z7rfC4xgLH
+oQBPLgB+wGiWD8D7e/bj5GLyUh+fvczxNO9OX04uCN3AwyX4RQK0eTG+nF4W+v306s3ZuyslrcIXAC/x1+npy0JPpgCoGATjFDqAMEIrBDQZw8QXKkHdXL0Z
+XyHsotvhVxcTxGG8nLyaHF9dFgk452RS6FfTK4DIqEFIztmFPj07PZyevrqYnr6enr4e4ScADnMx0RfTy7/q8SWsP/z2P96NAb6hAIhyPrl4dXbxdoyYkVeD
+/YLx6A9n70b68s3ZuxOCJGUPwVRPFPV7+uNEYCYXEwDrXBXwst4H7A00Pz2dEkZn8uPk5Owc1pFwP4hXScFBB2p8efnu7YR7dXkl63E6OQZ40cUHRufgtF9M
+zsfTC8ICXVzQjh/Rssc9o5I9g5ghgvtcwnaARSX4EExwQMTwnhkBxomRMskEKJml8burN2cX0/8xeanfTC4mtOkmfzuenF+lOzB2JdAbXk0u3k5PcaP0hMYv
+IsVhg2iB3s/82qPTCO2UlOMXHoKrG2UpM+nA42Wtnj/Vc7PBi0nov2Nl6PbW8uMEyI0ZDX7IJFDR4Ubu6GoThka+S79uPoHEkShqlmKfytjzyG/G+Z7XmwDK
+I69NGiIray6Koq/txvHkJh/o6ShZdwJVYC6DAiaLGC1SN3nXoKHmatcmaWrblNws1YAuoy7tJNM87tecSMkpcXUSeDmIVHFvp5fHk5OT8enk7N1lZ6Nt4f1J
+qAk7BKiSG7PEUIWSSno9lp+SK73QADJan4KzMcKzODsK3Y1MsYN+godqwpRtxrKj04GpQFoReXyuNxxVGiSV46xxvPCQMRJs8Ts7t/UIlxfyd9atLdiLDJbT
+JlAAdtk8JYwUbOPUYeCa5OAU+T5vhAyJoCxUMCkycOTULmo7XQn0vPS3RFQi6T6odbVu9vHWVUt9DJ8gIhhmSV6UDfo3KXseuJD/9mb6YnqFYIKffrrqZKbj
+qFAtNE23a6JSbL/Zjwouzcp/2zv4gYPnlFhBx0S+YAPj65C1HgxeCq2gNxU9LYGYy2SU6oOdU7FETo4Txt1du7bArGtKI25s25QWWoaAPdeurOaY+Qu8bsq0
+GeIQP4goQaAKHKrK2eEHIzUsT31BAmNUXfY4jUuJzjGsubB44BoQnKVSlXY+0pc2q1CDZhL8DPYOuOo1dHhtbiT9J0k7hkOUmhi9njPh1YCAGwIaoP5Zzrx3
+tX7XfrKzjxCO8nr8YqTPJfExyX8LD8MMRp6Io++++67Y3hJcTxfYf3UhfBFPnuwAm3/AP2Da8ZvlH8n/8uzrp1938Z9fHe34X36Xfzm3C5zbw2dPnz7Tb2zd
+lB/B500BaRUKgPCtAdxIXax5Svr+xPjD0j8pupzum5x5O4pDFcThNOH5Jt361nKtACkBk5XThvA1KTikm6mubiYKQbB1A/VqerModR5pIsqM2DXmlYWbMAxa
+PhYI5EMMQkWC7jQ+7oPLgLzOZYs/J/QoqE5RgZCQAC3h10iOklbmInqbq06hjLSbKVnIsvRB17TzH2I4Ff5O2RvoSIbf3zWutTn5c3YXwzP9SaHgLjkqC0xy
+zGlU2JvFT3QCICECC5kRljBURJ+D9CzI+ojuqsBQKG6mVWVK0FahKAmBAUG7LaJHjCZA5ROgMQEirzSSDhOpbj6kcySFtvgOzSqf3LtRORep9w3aRfNPtmlZ
+d22Ya7YkgIvgDdP6L8SRSqdLVIUicp40JTQSKVzIj0srRvlmnUXr7UKIznvNdVod+XMxtMXorE3mcO7mFcl88Jp1C2bJZ2MKTfDooVSRUFvkhWnsElknJV4j
+RDLo2qcPdyiKMuq80rP6lPBosm3x2jbAfYGx1hWi06OWb9u2onzrS2Oa62ZtZx9tnWjsf9dME68uX43Pf9Pb/8H7/+nzr5527v+n3zx9trv/f6/7n0tnZYcn
+sykfIGbTGTGbKmPd3/UyvMFgnxzR2a9QKhlT9DNRvgVOOX0lPUpzQlDRiHoGfFt0jR0JzCPO/7uTP/r8P/2md/53/C+/3/ln/f8Y9f9nh0ffffdNAf/5l8Nn
+T4+e6VeNtZH89JVb13M+99N6JpcslAUsb9BvjcE/DQcVXpRr/gc88Nva0jflJ+vVukZfhp13qtGBnCg6he8kT7dsd6f8F57/k4s/0v5/+vzrZ137/+k3O/v/
+dz7/cOzB9v/mcQdebuHPPuR66JArPuRCr5+ZwverH4SSjWUzSx/C9D7RHXYyYvDfq6vf+vZ/6PwfPf/6qHv+nz7/9uvd+f89/l3xeb3arCxgnzBsFBJSQBwc
+/rupD599q/uS4lkBZNDfgDH90nwq5/pq3dS2KfSFu7ZNq9+X1a2tlkQi/97C3/SJhWITN1BRqCXnD5ZuGOxGJ+ZS1trbT5gUS0wv4KCYfQQP3w+cEYYeh6VK
+klGpblXC8dSmX1q4utW2vilrW+hPpkGCpda5KpR/SlKlKGDeWMykL0JY0erWFbodGEDmgMizj8C5gARAegHiEcdGqHgaUKEDe5KeO127Vi3gnRDeKamam/15
+hT4i+Qb5AMMHMcwOQnHtsx6qbOAtxt99K8AdX3QcclRH9KMVNi2u8GKbDSFSuwO9M16XkBmdJG2+uHxZYNkb34KXCpqc/vtrvT+t5xY4/W3d6n8/n7xWrwHt
+dCBthcKoMHpbQwgV4mDoY/Uprbhb5FeRLuvM/8oll+844YH4iA1gPEZ67Kk0JsFnIam5bAlvqleuhLRJ0xB06nulnH6PBb+ftATN9FbSkDNCZcojDXCMu1gE
+GiFR1iemMhY3haSO9Q3WkW5aP9L7P8Es+ieZWX4A30ektqm3+KLvbk0L3yTvrakRYhFdeVjHCN13wXd4az6xa3FlNnqN3065OZ7otTc3NnxbXHKrxrZWsrBT
+X3E3Xis+YrjKXYMJBYo7tMBfirsYdl8K6ppFlBoccErdL7lsYc5iHjqBsWZi00rP5AxTG/d/mjV2Xrb+yYFS72OEFVHX5MLgikGy09rbdLd1nZekmqjtqgkF
+yWUXysYb4R5iXnbc2rHirZqBkxIWZFAoQu+M9+ul1bVLkqQl/bB12XsqyqJXxNVbEM3vyroVgiPBUb4OBXFzjjT6JU3Zv0QeecWBkH4GgG4TOYCjRNkTp3ND
+2b+SdxOiGQpAqkDwvrcXAs292UZHU/QZ/d//l/7XjTXNvw3OlNqHYD/sX6hkDOH+g7RSgY5epb29PaXOiY6usVhhURoOqRpYvigGkMLHxKu9wfrU7ZoKoXkb
+yq9fISuG0lo/zUhUQWQ2bn2DmyadtSLJQP6J74Mnhf6pO74nJETD7xVfik80rqHcdd62gQgxZWxOb9brTRa52MfbXN1zm+v8Nj+QgvVDnaQ86A0GSYifsVrd
+Gvg9VbJdlEhsQXSAkNsCtGc0hpC4VoW6ShTS8A6kn0RsVvQtAU3+hH95okuvDDGWljOczwTDTux6nQae+Cx+YTzx3oF1UdZICli20CM8Gkb/xALrCXTqJxtJ
+S9lPyX8mihs+Va2D5n4ySv4YRxGmjq7lJ4/SHjr6URZb6q5Fho6qqnSsBVWhKC3pPZlkhcrnWIE1cLFxxWpJSRd4dNnGDVakLEc0X/d2VjZvuC7gpK9rxDAI
+NZxruMh5CpZnK3TGCX4I1EvPUrhLpEABKnhwbAmXUvrRdu1z1nWOodr79EGFV/WPyKDokUwq4+UigiHYyt2NhHb51KUl5N9MMEH56sM55vv+++T4Kq9aQLnU
++v5MJMqhVpxDvS2DeqiGwb11CzCV+aG6BXmhgvfTkxNKO6asZ8rfvVSYP3z+4WL6+s2VfnN28nIyXKSAKwwwp/+LD5FJ/4LTnKVvV5g3XMiHurMYyP7yAi2d
+I8hMZsxiNsTXt7LNysIt0K1pS0uvKF0rwEmQn4wkh03IyEg62YSnjBygjEaKaAAsI06Ekh3naMJnNmSa6P2y1teuvU1q8ShHSYMzIfbxRCnXAR8Edq1u5sMP
+Ia0B75Hyv61CkYEHjgkzrUB3UlbMgcTqsg6JD5JfOVTdB9XxfMnur+mTLCaKjv2fwAFy9berJwdqXXNU+YeM2tgXgRSYgvrM9dK6TIKxQBbaJITjVZtQ7zik
+ui5XpsaIWyZkyW7rhsMCPCd0rRj6HmY+BJmSE1WE2UCWAj8amLChGkRS9tak1YNQxUbZ7/G/VV7+xnMVCLY2ZHVTwErYhlcWFH0pR5SeuM60vLdE7dDTIFcY
+6tPvLk56Vv2dvdZQVl8NWgqgX4HeJdU5wZBbGnBROiwxd3VrqZ5NqKgk1eopzyDwnCelbFwTK2C0A9o3ASr/E2aWljPejbgqqZEEHb7DetBDdpBap1Zl7fTC
+Wl1bvDf0ypRzEiuBkm4cc0qUOk24fsNsidaXOTzgF7UjG5JSIURjZ+YfXHs83igGoo1TpGksRaxDRPxlgW22m7eitlYeIjttfXNjfUvsguQQEVxjEe1PnL6E
+KIF3XTQyVreNYTxEoiR3Epf6W0Z3knNCkazv1ZDGG383IVUu/VVVXjem2TzBqYm/TkH+oPqNfTRmYbC+vKntXPeVG9PQA4GVu3VMmqbLNm4VKTp+r5pj52Fo
+Baf9Z9+LjNDIRyFEvWmRL95NhdyVeFpFxocrL15WpCvFUB7IQUQeUPXvNe2i8LzsKXqhqzrH47Zx6yB6k/0R8YP4/2maDGcYZqBPVaWM+V8R4amZtZ5Lclq2
+Rts7p3PSnsQQl35R2UmttdNij/5vtatv6jWYpUq9LP1s7WFjcoGFwEqbpg1C16TFIrVOFmvk1MeiY3SFhrsrGlC463ppHpm+DTQnMA5iOmGuCt+aptXx8vSt
+Lhdhb4LwJA+mqTftLbuRbm214iXo15sb9SfjEItGbJmS6/WNz4ZL9hG50WpT4f0Mx0OX3q+RUj2kzYsPUcFYcO/YdkYdOFs3+tYtLd4UgiTgobT4RAIZSL0I
++vDwUFty2LHyAL/6O40vvTJl81sHgB6M/37zVS//4/ku/+t3W/+AO/nXGOOZRvasf1PqnU8KdJMRUHq6pAdrcSL9lm+b9TJw/BK1WITYYytYKT3wAqdFg9be
++vgc80mRyiQSOn5hpFREA36PBt77s4u/XmKRvK4tvIsEp/9eNWZp71zz8yHgu38bQXD/+X/27Juvu/iPZ8+efbM7/7/HP3KGjN9O3p9d/A2LRAZ8PpahzgCq
+YwGiFuxx571D9bHlqaPR0yKSCtqaMrXLunWhrn7+9jEZxaicbNy6yFy+EWddMGwYMcleCQ8RaXEYgQhWZmx5bvUL47mYiX7p7kBoWLPMeWb3EWVtuPiFOOEA
+tjt+fTGh0pQvxsd/fX1x9u70pTgMu/1HVXrJIpEVr2tblZZZeIeq1CpiqKFKwLZtbaP/a43Un0UgK2/t7LaGGBXOUCx+W7tPKb2hQtRDqymAAY8aMoPsEqt1
+2Hn6PsziPKfikUK+oWzXSIGWRG4heL4kV07N1DIcBx6Y7lhGLXHFICynXMJdwURjhACWYYQqO4wTx3AuPILzxvMZ+3bllLCzt2iy01RTq6HPHkOvGb4zWg1p
+vxX1O09XFod4WacDofBpr0dg3Pq1qRmqM3Rusg0FlAOX2/ZSoLYznxgAjrETMmX990NV+a4ydBCTC8DrPdjQitdzaQ2ASqRgwtC5ieWThusqD/aebLqcUnqg
+zlOYFuIb3XI4k2pj/WozEjcqcieSa2ATuTZ0IDChh1Hslwe0hYeZkmNFJ2iMUhQoSFriYW1tPbfzQNYLE0uupXvEz0ZxgQL40VZC5UzOVCQPKsuDSPjGblGO
+lnaqUmC2yNCXkmWCPm9ngmZXlQCugwqXkAk9C8EIAAfuJSRBez1/2NY6W7GmVh4bp1Ni2iSWnsSnsgJaXQfeUD0t8m9qqb8kpbNck1XOknJb7MvYJFUAaBnQ
+rxkur6HaVOCExa8I+BxJDcoWwloFx/NMnbH6wuNJ6cRwJqTYFReUCOWsUOC7yt2AP3J/fqB/hOjz4XgO63Npm0/gG07ORZJh4Bq9sPaQvJEys4fsk1Ce3r2/
+PsT32m98a9GrmdL6ppUdXaNg2OsKpOkPyQ3lmniPsA8Bftc2hF/7ITunROlua7i8CyUp5GHheG3wNddgJSfcgfNC3zrf0qLUtgUTgUec9FcGSyVrgtDhYjWX
+OUMCSUt2gYrvRBgfMhmnREIPVbcZrGFD1AqRoTvGNdRjK9rQjuPCZQtr07KX8NUlxlCZbraxC2gmsHE7rv68ut14XKO8DH/rWPyvQ6xo4D4vayEwyCoKwtGe
+3ZY1bDEzF/Dt8geS6AgL7ObqBtcUxZYkshJuU9gQuGcC6a/artfBxiDpxLGxikjzOC7W9INXOY/9QJNKolpbZbVU2pkfDFw/PCkf0+oNMnVRBhCbDApQcYDj
+XjjEvVCk4NKFa+yNIy7t7uQncRtCqcouPw67mCJLuEulRXmIrmQR9kdhv8Po0AkegnZwP8XwNab91DW/F9N+BswEyHlTrM91SEm6qkp++DKPH79BnudVZBui
+DvP2PRa6nE59EpzLbbrF8OWopNzvB7fO63QW+jHaAFP3kz6gBs/Tg5ssIZrB3hAcXGU1Ojgu08SQrKsHZ1PXTqijGTP+iT3vtdOY3LEwn1yTERtBw3o/HJdi
+4KYohJjxxjRzuM/Tqc80L/hUGimH1g9IfhFr/VCvM51kq0INggY4gQbvyOD6D6WBuM2tepEqa6YLonzh+3ZPWKEARawTX5jLElBpgNifDsk9PgQ2FeUS0+Ew
+ZaPZnsPUMyXFCYeGiZQJIliL7Rea1PpRgQwVW9OdSYP+eRcGFSdtrjMmp9JjXS/YA0VS8wAyTDeR35sSKOFJmh6KAPbINdMl1fgPpDokzWyZfcZow+lHrZkJ
+p6tKDhUNn4+Vv885ILEz2MLX67Ka98rYyOxv2wllDVuFhx7FgJLbMWaHsXJFEiAWdqLPsnYq+qChExl8BZF2i1I8QnWNwE0v8VW4gMs4LbFAeLd+8RYh1J0s
+lYiuXHhztWyMxVX2xtbz7xOYrsjNMIaOgj1oJN7vWxrpd11zCEsZsRnVZ79KeTISguaW8kxGOFOdHSQUvp10jELCm7flirNifeT0T1IlvNpP9QzT6iHz6ht2
+MxU8cd28ezhUtWvZXGLLhjYzz2tMpBva1iahY2ldoGjGScYN8ulAGqK6hMKqkNJz3bZLyjXhBM6FfOj/DI6P/7OsP/GNMILHVUY6e08HSRrG5AaPlUnoRktE
+KRKiEZI8q+rhtrcc+gbjfMwwY40cGmZ7a//POFQJBrc/t583uFi8l76QfhIGoraKQVEyP5UHsQRUtZGUMLmaPpX2ji42vK/dYpvoUniOtsivkIoTfYdLU9e2
+0TXMNgg1zKgXOaO4F74rDVgCKHWZuMt49oRWDTNm7vGgys7mPDBFsm52m7ngekVrRMd+SIQUKmYx5Il99zi07pc5pb9X5ORajI1lSHBQlRDe1fNoA7HcCZcW
+PURchaGm4DjsptxATUebUTLF+mUP7PGk4JedK14H3hUbyse6TsX2grwsK5ZrOd1e+MJo5pZEQnORTo+r9X1b03Nh2S1/TlV16uL1JhTT32xz7tGmSWj0OMdw
++Bxjmj59INfJk1U3+V7CTSZHeSt5pgQ/8WaOFmR0lpo2GbdKxx3QRJ2dh9UVm878NvYw3bcoWdZ0QZjGghU5JwdmfkdEGnxv2tIvsnQgnLzILC9l5K83bI7R
+30pC5ZqohT6kU8t6YiDINYqXc8D1m06YBIO2tbrvGnW/vXVQPLAW8eCqbV+hbYVJk9jD4ABKS18OWjqYneUIUjN8HxzcIzfZC3J9ALvhMK4P7NV9qfCDZh55
+jKrqIMxYwiOZFj8Jm0CLmzaZk9R/uN83Cw+o5dicap3slbQZz4S015h1c70J85XtX0N3gl2uyId5zdtQtKivtLl2n+xoWyQl99O1jtKdojejdcmsYXppo7oF
+XKETGectKfpMY3y/haM6HY3FT8CNyYmcYqduW4/YlUimu64J32mDzKNbUjK/y/qmYxgOB2uYgq/acJaG78wO1rCDXyUubW1oTImaWwHcSdHvD7S5gZaSes8D
+BziZdpsEFsvW4/yqPrZsaJ79vabk11vKO4YqB8brOAHhqpQ7L21XhXZDtrVnzjS8+jzHo+v58ET7JPS2pVQ/TXpyvIJiNjO0owZbLlTZ+lRtwOmhAttbqgMN
+3HQjpb4ZBbSJfhmsGaKxvzx7dfUeEmo4PyClWM8wKARCAUJXwGj0USYJiuRBwAl8mF9T94JPigeRJ4UGfEpC0I9R2ELBkGAkLz5ESvGXOUzl8s345AT7QmM/
+uwjE9DIrhX4xUV14yvQCmfenp/G/jqcvJ6dX45NCAy3/FP5j8rfJ2/OT8cWHgnjzTy8n//GOSPCVIFzCNOn9/jQdwOQfv7vAAUHPLt+9YE59/frs7CVMviKS
+/snlD/rk7BJnEPEwL8dXY/zu+cXZq+nV5Q/w3y/eXU5xLqenV5OLi3fnQIt/oN+cvZ/8OLlQDLeB6TyjIgNXbyZnFx8yHvZCv38zwWIC01OqJzCGGbi8upge
+X8XHAOpzdXZxpffjEE8nr0+mrydYDeGCKhK8n15ODvT4YnoJD3Bpg/fjDxoyuwjTo7guQGdZYAX19JUev/xxivUi8GF9fnZ5OaUu0Iwdv2E40Uipb6Mj/cfS
+VQlCI6tqzhCWVGy3SbZKYFKfg9SY53UBYr0A8hp+ciUG+JkcdZu2u0WfRaXJNXphZmVVtsLOHNzXn3AUVuKz4kDfbn1uv6SGn1f9kgGh2JLwqZe1dg1rFKA0
+zgl1apmkQOzUT+mMD30rKuGJ4opWEtxA0Ahc1K4hl4nxkBscLCnEDlq6T1s3UopkdldVj0EXE17Fes1s8sX0JpikekbQw81wPlI0+Hpc4zAvib4NMMMsI0sd
+n709h73+7OnT5+o3yf+zdgosub9hEvD9+X9fPXt21ON//vrbXf7f7/IvrH+HgF8fBqJnzAPspxsB4/DRSO8lFTL2OCcBbag0m5eCsz7U/YXHI4QO/8qFP/KS
+7fCNZ/k3pFt7ScYJVWNLqpN0aO0LIsNVWePk0QLNOS0bFzPX8ofFLO2U01dZNVR1BEXo99JqI2k3sz5p13Q+4ZruYNTQYLCDb3ssEzjnqCVGlTwEQtjGxR5+
+NdJ7W+rt61BvX7pt9FJ+lWawoIiLCPBuahjXKpG8OKkqZ+NX28bUfkEOy7lpTQj6HEFV5r1YFVE6ktcEJdoUqmIaMyHSImYw0m9Geq9X9yBdELg+PpXzteSt
+4IblgEZJCXfDFRD7FSGFwDTAsK43sUYY9ubbkd5LSvrFGUaAJKV80drbznBd01tJyqP/vBqDyRz/ZRRLfYT5KH0IP2CHvxvpvWyf7SUpRymlkmtCHkdkyOAS
+LjOr0lQO6FMCQuydCKpbYz8hEVMuC/T7W1urbm1YTsCYc4VE2zDoVaiKZMydkuXfKzUe6fFjBpJWWTG9+JmMQIWVGu69Ui/oe7W9o0ayvILgshyUX/e2ezSC
+ukJ72QuyUp04ABjU69Y28cDiDqK9V3qVVRT8nA0Os59LXBX4qwJfVFsSRVfZhmUbygrl0uGmaqyZb+Qk0Pzm+zzb2kejI7iNshzFrTmJQzUjY56iylKWJCs5
+J89Yuvm6sl6XaW7Iqlr7TuqiGspVlN0p4V68iVoXauKnCYzkio8ZjAothKRuLEWSCZnXwb6HCrKlqTigXHoYEntn1P1HkUQrJuFAiK4uEpd/LqAIDpzchU88
+1wcmsyndR5w3WdbahKTHNL/SVMwunzFFp76nuU2TJfHQHtK7sDopIP2unOdJM5xCR5lytGtAv/jg1lG4da4Fw3YFXw+cE5XEDsjck/jsqtpIWmXKcSASOXcp
+UfuM34wE+apzIPw6BM9C7Bh0r1euSTtXwp6isUjcUSW4KrlicI+B0uDlhwpPFG6kkseD17er5QkcD9hK9NGe6y3u7ULv8TsyoxANwDPo7sCKJKNVJQYsptLH
+Wmz0S17apanNTchJQJuShlMIL4y63gQkMrwRaGpwPOAUd3e1pZg9XgwLqqgwgzb3v376vxwIXlzULbduESSMu+nWNKS4XtvaLkpKsk0bTPoUWETS/d69fZ+N
+uFJHX62g3NRtBZuGEk6RiORwiIkkS0VVAcKdhYsel3zKAR3BbjeWECS2YBR2QlMSGEwS+pFO4npf1uwP6DcHPcrXjsbrGmV8uDGz+swSOb8+4K1MnlCva3cH
+LcIXzKK1yKBIkic7B/2pLxSXuSsIUAHGQBGy8j0IyP29d1hy0O4d6PuHqGLA6Xrdau8q26uvFyCRTArZBhhlyBoL4QiFnnOUblxamXuiHzvVEiFqHYoLgR2l
+fYEg4LXNYyDyGUHhZCukKIVJLBmfbP9no/SmCJseo7zpH/5x9ns2Mzopn9YrWj68QHRNK8f5K4E8hOrO6aTq+NseMXLXkuBTo371U5MZ0a1T6S4csN+Hh7r9
+TKjPORP6vjOhPqM38WjoLUdDffbR0INH4/koN8XPYvAvnJ3no6Neueu8zF/+IbrxYQZoz3HaefJrmQmCyIl6re4zI+8Hj0TsEJzzq1ur0rswqjj5vuQ5zCss
+VhveiaErPX/1sPqUPxMsxEyBUt+Mjmh5Q2GnkACYZGl1a9BbJL2NfxfVlswTaCqOYZSRpYYEZCyHH6vqeqoluUl1BhVRSaalWke4ayQu7kUlho0Am3zb2LmO
+ZKiw7p/obmg7YROS7oaJqNMMVfENKByJACiSB7jlLWXpv052MVTy6uDyct8NGMqZxf45O1koseCWTlT/DnoC7cDO/urNH9tHjvC1mL6xtPPSoLchNcLiCjRY
+hNTUwUmmtzjbVHC25RXm7m7dkouvzu2Wr4RRkdQuF91EWujDg06+vCxzeJnK57VE7azbO1t9snr/6NmBXrq6vfWaroEQEilbXZLGVMEpnsEshcYKlTbmy5/1
+/jedhgz6ipB6uc13cuZqzTbE1gxizOaY3SZbno4h5dz7lat9KYYg5hsg96xAmYdkFU2PT75hP9lal1y99sHFLYlJmrMU0d+caCLJqYAbwJJLgGeg6+oJooqM
+JVNVuRgNJ2KTnwh2LZiQABhY1gJIjEntPsi+a52cNJyc7DPiIYfVVxywpAZGQ9I0ZK8Sd9wyKJVd1UiVXmjVtsNW0S+Xa7XBUcDXFuvv6mUGb8+BX0xjtqX4
+d1kHqzWR73RfgIbEDmipM1FvFwbCBpWTVZepRBOBmdAJakdqVjAyu96qZNt8tSXDRb81LVwc8qTWOKYr3HvnqAUfo747Umq6iGDzSLlNtw9rzKQbk3tka+qK
+LmuVsrPmeKiGvFL7DJtKa7T3/YAHhdr091JM0461wjOHVyLn2rKt7FzvnUxej0/2mL5fJpsDRxXyb/N+poEyVjn+uaxTqMXctqasWBuNYobyMWHuSIDLkZu1
+gWKLswNRPMVJRhlIJxvyrDAcnV19Ue6YrZfrs4KrbUPGAZy3FWYFoLlCkD+Yg8DUm/BDhg/Hz7RB1VcR1INJYGRj+NauvN7HcRjm5aEacomPLudEc42q7Z2/
+adx65Q8y8JOpZms6Iq3j7H5Ohby7dbFmeHIASNfCBajtXTKVvZzwUbr3rw8yM2R8Po07v+nGBxjBHRRtpmteEgxTPLqiPrq7mhHR6CsTy4l2XAftldkJUPI2
+EYjj8ylS1ync9ZhnLVufOZUi+KGsOwubiISvIcWa3fSnBF1Jb4/5mgZGEicKsejOz9BCfUwBOTtzJyVJxEzMhcBf+iqTdKeCTw3op2m+Wib4NMsqsaJTvsd0
+Eff9QaFEozXzOaXpoNjHUFG6F1qXzkR2xnhOIFo0pQKouOP1ynlSJJiKFE9Dch+kikumduLtu8b3ytbHyBgiKWrdk3m9hivHexJPoMLBhAwTuidds8EPHfBk
+GwKHhLq6UPWC7Gug/IuoDsMfipbL7NY5oipGrT9ULmaouUEw+sI1EQVVROrAsp7bZc2iP1YqSNNaW5ctYNwIXRNxpHpmytxp78hOpMx1vDP1tb011SKa7k5+
+1bnuE/WAA49pLD8qMigdy1aba++qdQsTh+S+nEvNDgnVH79+cPxpFUtO1zKVq/l8SS7+9Ybyk0hAYpOLzRbFBd4jGzUZTKiHHftQ1rN10/RUJp3NSc87Zbwy
+mgiLYDIfP2Syrj4IDjcRVd903B5ukSpS7J0J0sukdQt6OQHJixTlg61RSg5WgCqgGyHenUeHz0dfk+pDBgUhBPt6L22pksQPH89wZFC3DWi/bbbEUNyxTEwL
+9YBBmkUh9Ty3Fm7dHVcNso1VQZ9brKtFiU46aDY9fNk0kLdGRiM29MzVflXO1m7tq02Evj1O/y2GtV+6Kiv4S2Mq3deFdXk96LPo5jCn+sBID2wQNNAG1PKO
+94kmPSB4ArMYRTClAFFSVIoXJ4RXJVkgXSgxSkhTFBN0sGLKlsVmvZS3k3RO7tSBcc2d5dh5C3CJFgV8uSzbUDFbZ96gJ15FRodtWzbkQfCjEUDbgaqMxIp4
+eAFkwuME8uiC1FX3Sd02cZ6xVo8N9ZcB5lwNSlicp/uE39CFoH4VcQzTp34dcaxFHIsZHpJuh6UtJDZHl38qVtnYz0IC+nrDjmo4dNmRSUIOkoSkHpWE1AmP
+cBX2+EkYk4LoehXKdCGIBQdmMMGt6NzNzEaXy3j5rkp2QiIK5RT1bWo0p8O95YhWZKNfksp22ZqWOV4u7M26EkbjoBuWy6AZwjc4+kB5ATRrCbXL4AxRahdD
+ReDlgYoJ2VqwNulj15rQNYVKpczX9+gBSDuzfZ1Yxi7Nz+VyvZQgjAzuh+AKybwXMQ7gg1U9I2vNbrgcHXAvAYI4ubzktknvl47VusXw/Qo+o3rvh+yLeUYY
+0bdkAgyIhqtomLDzUO3nKN3QzBYMaxoYRvQWVBtF/gLecqnDwC20a+ZE4OM/llXFaDhxYyZk5SWTYnzdi/50kqCyhPmkYNAs8w4OS5hbOHpta2a3pCqoAcMw
+6P9ysffPzzejoLV1+IaCHMLclVN7l6p3ryrXlF5/MrWe21q/sM0NyqUVBFY9nIdPpbdzqQ8Lhv+n4c8ougXKJe3hcmkZQczPB+QsVKMlsKYHrWJdeuQvl8fq
+9fI6EZ7fgIY0wR0MH+x0/6yedU5lcEbwCEIAKjMNO4phwKgHw7W6MxvK0ynrtdSM0WU7HLowwYEe9CGFboRox8HrfJP0VKCsNbpOuo55lfc0Gd31Rg8solKn
+bOLFPN2Bx3C6sjoBifOKuhTDXarjEwi+gL4XMVk+dK8HMjC5/9g3F6NKMD9GhxD7tsjafnS0wzphScO5yzAuVDekbMMBlDzLofxGneU3qk5+40FSDITA1ejI
+QC1VVCcBk6Mnk+tc7IX0/r1C/RR+6OT67xV679X0/GQv0FLNXL1AJsNqo325LGGrUptSjdSsVtbgw3lRxjQLAnOvQtEw0egSNQ7eUJ0jIA2EzNjtep7V3RHJ
+bT/S+6/KqmLStAf9/UU/+zE1n6WJ7e4hPWY3KUV64fGlh7iZ1xH/hfzHOR1eT4Qf4H79dhShiAg1jNzl6vjsx8nF5KU+Pns5yXCXhMRE9JswOCM6T+8hHnNP
+vxhfTi+LHh+6GqgNJqjNycsEt5mBNaUVRCGOAR+YVgdTV2/GV4hk6nYXCm/BiF5OXk2Ory6LBMd5MkEQZw/AqRjACV1J4JvT09cETAWo5MVEX0wv/6rHl/rq
+DH/7H+/GCO4DlOL55OLV2cXb8enxRJ29GuwXjEd/OHs30pdvzt6dvMQpyR6CiZ5wv6c/Qm0xxLdeTADIeVXAy3ofgJlUeGwK+E39cvLj5OTsHFaRMKGIZiQ8
+5PTFu6uziwM9vrx893aiqFeXV1Kr7XRyPLm8HF980ATdxGm/mJyPpxeEE70ATOn07HREix53jEp2DOJJCQt6CZsBFhWhpTjBAS/JO2YEmFfGUSYToGSWqD7b
+9H9k4N/J344n51fp/otdIfn7lxGSHU9PcaP09JR6HkV/XgaMBDpe2AEvmeMoFftjFqasupo2UyAjlBu/Eh5aN3wJXjfo575DKh/1/Kmew3XrFvrazhx6+w3m
+ErPkoMepll9M+fIdhwTlcETvP8XIqk0YWh8smeIke2rdeWQ85Ix6srDKRteGfMdpIL+shZjg2m4cT27ygV4WTdYdWrHvRsnJznC9I6VopU/P9PH04vjd28sr
+OFiXeNLCn0h1J1xwhAJ3kL4qIn0PigQknKJ+iwR93TtVRTxTKjlThQ4I7Ev5HQwiPc/hmct35yDeLmTPn71SiAZGAQQQ9Vh38OoMT3l2lM8nF5dnpwHzHbHe
+gu9WKei7C/AOJQzlwL0ZwxQgavo+WRuA4fBdAXQD1hsqKhZY/UJfXp2dn49fT2Bm356/g0Zfjacn7y5Qkr4dn7x6d3pMrXHnYQVhrkVIvQXhnPWSPja5jLjq
+MFcfaKHUm/GPE/1iAn89Ban7GMz15UjEULLnVNhz1DKi3sfn5ycfYCHiH2EKXk7GV2/0mSzH+ERPT//93QWK53cnV7DXXl2cvU16++QyxZnzpTH529XklD4y
+PcYlPxm/B8n/ZvpienVJr8dOjtTl2duJ/vd3F9PLl1Ocy0v98ow6enJy9p4bPT55d4ljuuicqrg11LadUehL6Nv4KmkH1ilp6O34QzY3Cu4xgmwCW97ocqRf
+w84/RX6ACRzTy8nFJacM9pzRei+hrSyBq3CPkHCm5TK6sU5CWeuv/qKPR69GFyP1bHT09Ejvn4GNf/Tdd18fFEITiSDtRdZwD4u0hyI6eUT1HunQ42G3Eo8X
+PLH2nV7po2ejZ0fP1P6lXUm/MAGZ6Svpqug+jn2Jv3z27NvRt8+ePjs8EjooFX71ld7/93VtZcQgSmnKUZeH3upJPdfviNVuRiw4+U0BPeBya84PeFoDUyEs
+6dFIv51eHk9OTsank7N3l93rtLHITlK3ARtLtLgR4z5z9cw26NST9Ogl5opogYlOF4zE44unZ/6UcK9Wc3ZZrGtbL1wzs4RHogRdeTcS1mBdt6Xkdm4jxWpd
+MBWSVokMQOVX1nWOCHu5bme3ujJ38eNe79vUwSOfS4zJytwVusQBB699Uuz4AAq0SsyFaFbqRVXO2kO3OMy/NVLvO647SBRHpHMIcoRUOFdLwgmeuVnZlv9t
+a0QIoRGmGLUzuzVNKzysjb0pPf0ErTt9DVaa9fABouKDE32KuJjK1HOPHj/VK+DMBSWgV01JhdHYW0bdzSMtiW+SZ1OYQ/mF7Pn7mG0OwsKpJDGfKoAjdxsT
+OpjmumyblGMYTUOHsCuatZXZMBZPBYbz5LUf0PqEPia/7I+rQyoBBhru67LW42ppK1d0Z5Q9n7MDjqi25c1w22qAsKJD1vaf66b083KWAtdnbk2YluT7Kvn+
+luno5jbinAykaquUvZm+hY8ykRGnqVht2tY1td14vbBMc2R/XqG2SzjWytypzCHKGzycoBi5MvXNmuuHmQjVyqiE22Zt50pIo+CteUPZodGuDnVY+w4eAPGB
+IXZ2ejlNtAI0QkBAspO3rDGA4CUtghGOGejEeDQT7LxrTUji+PPRV8XWlNK5AW+E16YppXLlluob6zbEGdNEOLfIYIWdoGJWZJYclITrXZVULDi7zGMScWSi
+pzjJJ1uvLRXnDBlW6FShrkBOLGXRMh8VLD/NVcg0WZhZ6xrJrpWAGGHZYboCNxkzrigShxL3CRPHORG1NrWG+A1FChHZMtKTv6HihQj6vasOGLsNLMMDpL9b
+eS3YaauORk+pMEMAwh/8ENx5tWu5HIJ8wsYd1I/fRreT+G0DE2AKFZAuRH7MW7e0o7uf/aiu/uX/vag+zaFU/L8sGmtL6PthyjurLnvsMYnL06ZXc/oAzaz4
+gAgxpO6vD89Z70yLxpiFqrTzkb60NvvYghEGseSmHHa6mBOYLJU+j/GhXs93hfn+gH+vX708OQQil9+wBugD/D/Pn37V5f95+u03X+/4f36X9T99h7JSv8xS
+dETfU5EE6KjQb00zuwUqqqdKxVKhQFoFv6N2gpR65db1nBWNaT0b6a+P9KvG1B8riOC2hX5VLtpbCAa5plAvnG/hybdj/fTZ0dHTw6PnT4/0u8uxUhNIoHC1
+zUuO4u282nTzCT7Z5tq05TKpf5/WsA7ABwIaImqBFPsYl6ncnaQOPx3p84vJ+O2LkwkZy9uqF5Q+2C1GL029NlWByerXzn0sAixd6puHbug9EPZ7EqXw2Jhb
+YLWPuVt+D60ajxkOVqYhkqYB0pWfzOajscmMlG3RA1PH8tllK/I+KVZB6krt6vRXIPxnrp6bpgQFJht+5MPkxApFRPnM00khwkYbfUfM5Te2Zf58eaGUovd3
+t2VFpDbXFjqIHoQ55nl1la3lIEMTTrTvWsVoZH0sqWzzHsxUZRftXkjuYqYQ0/YrBjG7RViyJZfxTyI8sAhhDc2SF3Kkpy3b30uxxxUcuNdc5TtXTYoYIDRa
+eigbl2tccx4BfE+JV4Qq5GOuX3iom5sdgpIcPV4QtcLaVD40GAuxqWtLCKPs17q2du7pd5kj5ntt6LecJa/9LWYaz9ySnRvyKTIOBF6BM8X7N7EXEm8PGBsv
+1m2eycMHFXUJEgThDf7ODzBE5hkJvPXkZv8ZzFJFW43q1pBpvOi6QlwjBBMsHGKk23hENZU10hE793Gk31MhuOXSdhPj4blZucJDBd2gPXWHjh6RJqFc8ixW
+L8TEuJnYN6Pgk5yGqNbLySt0iJ+dXm7P/IBR06wkQgiU9A79kEQ3sQBAAADHChi3roId5A1LDZneYV00L6UftGOQoHty0ewVVP6ioMGG3qLhEbsMnSWLc2nB
+3pEEhBWdHTwsfEYkQ9drM58zrYzxem/j1nsAKhU6KzuPJHLc2stwuAPDFc5SwvSUPgZLZCKZ+CIRo3TxzMNFVIjc7YgrTmRBIrRKmIJcrEzAejT1WyTvRqzQ
+PRo48fhDLL6elz8zHLpxdXvIu9jb1LmgwjBZ0sGZDKQJaU6YVEBK8WdBjiOehyuh9CawddnPT7wGQ4ACTHTA9l2TphBRT/0BE+jwhoRpCOnUMxQnC3JRsT0d
+qm+YtvuFkdp/5cB+MSB6C0kBD13E44Z2KSPK4AzDQJYGxLppyxnYvOG+2wQKb7EN7c+rynASdPLS6AC3eDZ5MwFdGB4pbODb0reuwWJ8/Upq0ZaVndOZKtIn
+FkT9UyT3dqFXt2XlvFvdQtuFtu2tVGVcuarEmKleOV+yxwrkH+/tJYcD9qb1J9OUpm5lr/k9NLBntoGl0b29KNIM8Xbkhad7CPpcKMyDoo+w5tT/QqC5ZimE
+q+rNJrkVwtFLSd8GE35wFOgI0Vf257bTfX/rmlavjPfoqHELwhSGwlxV6Vss8en1KzxJ2JLClrRr9Asz+3iYtP4ZXU/56tRg18d67woEwso0ICEz70FXRPUq
+L+LTRfT/o0jRRhNmjBdJDPUIdEuh0/AdpnlkAVvQeyo4XljuY90Zoi62c7yHw8mE3/m2MXBvLFxzB3csixZsu5wpnHJ4DyVIo/epNDSuCChMzlOd/1X5s638
+gbynV6asW1EzUAzhm/PGQPUZf0C5ssT6lYyM/85fLGJiPVZuIZcTVaeuV2sSYdBBmjghX4C/h9SDILelooWGLW2Ry0DRe7FpfV/ToY4L6q8oU6KjXiW7gfxQ
+2WJCgbj1KqYaRvUPlvLOUO3VeenB0WturEry+dLbCNRm2EUwUtaukg+HHsqU1a5V+T4tYV9gYvXe2cr819ruodMV5S8rV3EqcG5gStLBsbkmqDcSr+PL4+k0
+eK5ptAUcu7JeOJ5RarDQJ+bK/q3zu8vXb0+0a/Tf3p5IYV7e1hkp3MurlwXvWgOSbX4IMR7XYP6HR4ymfnP19iTXwW/XS1Nn0zhSNPgwSBnNufPtJaYJF/r8
+5SsMM61gu0jxTtOyuAhFhc1cJWcLA2bXm+y9O9dghSwmu85Hi5qmJPuql1cvRd/gFyhO4SoS10gh2q9PHLlwRdTQQyBZcD6YGImSpPHk5X3Cbrh1C2sSKePq
+aiNS+gquDH0O+Ykk1gp8JWjXmsxnYo+ER1fmxuqy9bZaMN0igu9iAaoVud95XNZy7TNQX7HMWnlNxiuMqbVNaao8wMUYAs8JnNYEstfYAeLAk3KyYQFpvik/
+Es0xBHnE1yRaXQyMO9Ygqyk70uql823kTuCEy5RWHDrwxNMHYEvZmQ2m1bW9Keuaw+74CzeXmwSlb6Cq+3Fy8WJ8NX2rj8/OP0CeRISEDLhX8uuMFSA7L9fL
+RP+NPgPVdyNkvI4dPvmiY3TEsnv1XKXJofQHsUbaLbZP1lmK1siOVRkKPrkRsDjMfK5rF+AtoXzm3a1pvQM/DLVO6kxG1phz/mAIIZTGXloDjhzsmrsmcy+h
+0cKlgYOPq9YE7iiYjmQhqcccqfhoWcAHyiFBySoJZRANDd6qtvYBO25/JsYODhZCm4GoILanDRVUVrbGGljdkFPMSsYsczqG3M0wbWUdzJDnCeII36hsPee2
+isR+RM9A0oSnkgZcPwcWC1Hygg+YJXXxeCzCqcW7GkpG/Mc7SE69+hCSvgVbIMImdRkmO4cGTfXrqYBlrY+ePo27MrF1Ohs0SJNMYQyTZutZ5Zj1kj6uMMD0
+yTbhPmiaTUE506xaBSEG+7e9td6mrX8/pLWSAEPDkFqnOeyqsvLgtZl9pOdG+oVrb7lHCSvBQH9CIVA6Qj43GXlSvQ3rc5X3iCaENVfq7rqqSLhFOA/cL2GB
+6G/2v9YoaYKkxE5BRgZmjaQMAHSgg9zn0fJ0l5HsaoTO7cDiKtw4icMpvoeGQuWoUASinMQXGvuoujsKdY1QUs36dK8XogO0DGowPnNqQ79gl0g4lkoPEZIh
+AcHN8TahGzgIZphn01jdOqc/uWq9LGu39pSC0YaNhaKDXXirNS9G2VDOimcDSe8br5fEdYxvR4qLA5lZgzkgKtlxEcfCRRK1Q6fH/D/NDHFXcHuPegc0k3Ga
+laxHnVYVT2s8dTQdkVSmZ0h1FNMNYkZvyJWJ+cKxC8Sai/KJ/Kx68KlE8Tw0WJ8EtmfIcDusbYv+pkDukJbojTlcvY51Rl9gOAB+a7Cks+z1IiqDwb7jTx6S
+Yky9IxAY9g/j9e6urpyZa1O7erNEELg2bWRQ1unLh6I+S9Nw07du5io/khUVjt+Knawrig+FtUGambiV9KpZz4k5yq6A6fLW1rg9Ub/J6X7corMzylr/19ow
+WXAsLxa1jmQ2Fc4mJppzFnV7u/Y6LpWQmPNNFNZpXbdllWV1qY01TcKRVsHvEY7WvVvrbIfsp6kikmVIFWVuKOWhoQJtFXjLAt7LRvr66BaFI8Tuhv9aW3Jo
+QLyrdm0QELHonDAV0aEd9usppAG/tgui6Ygzz5lgqCb09AOcd8DZaazga1CWzhDAxVogfp9xsXq9muPsdiBBL2NVAgLovj17OX01PR6z7/s+hdXors+322qi
+diQFGd1ClBavKRv0ebd8X5w/YbOPhGbxc+zoAfW89Mi6kim8wefX6+aCAUzwx8ZVgWU9nHXaorHaY7fEZ27iu0X2mRDYJcZBVGoB3Gvh/yV5K2XbqSEYjurc
+0d2l0FEbOAS646BKC+98iI9F24dqU2bXsGRiHmjD9zuhM2fEsUBkCl2hh4NlTBi7GEO1hAASZcAe3WvwGeJh1nfwP5j7eS3OP+npG/TSbjpO9PDdg4ixFLFG
+BT6x3xwkyrshrmgncLOOgmTaAIeDU8PxbuS5pwoSJyUKmc5EohLCB7fICHVWtvFMOSsU8WnOmmKnltQ05xHmkYotCwtn+4YCZEgOH2TgAs47txSiX9vkit5n
+WHvZ+vi0CoMp4S94LcX6pfCBA4DCjRCPb/MJQVO7C/nrKqL90aiuyhqgoy9H+lx0Ota5+zbqgKyajPR4PmcmsUCM1n0TNTQU8/msB52odXjst5SkB+g0nFAp
+879EflLky4hX0JBF3TVVbrB6gop3SLL3JHA8KKceiPoF/zhS8vhbdxe4y8bzua3n6yXFAUdKvU5mWmI7nW4Gy4CJ5YaiCWiYBT04NXAI8l3W2VL1zLaw8m/C
+zG4rHJ9576dJ9ymbA/tDJw+doyxR9jhQ2QbvDfxo5sSB12pTq7K1y8ApFI5WMCkKDVpGgTj4cFbyZIttAt/INPTECDoASCqil/eeAajOHBYBQV2nVEid3g73
+tG8eJT2EOUoFXXtr8TbSpkZsisBx+Xuqtz1N8B5wl4NMxhgJhdj/vbNyXXU8AgQGdxCeYTo1ilRGigk8qK5zUuRHi1n5Q1/26p4v9i65stV3xoc0YYy4e6HX
+VhTbl6bCWsoyJ9mry7LVptcX9s3C7+gihm8laRHhAgBpBivuRWHMui7uW9fwdai2XYeRMahskyyBgavxr6Sl1JuBPTueBYpI+L7fgy/vvbTzUNeqyIx2xU08
+yU7nKhNM8XDLjRDqXeHTLV7CC4V2YPDcJdQ9nT6Je34ee8VbrQ3gn5OBe2hA+PX2WJRb1PWyofibqeNvaKR+JM2wJh+K0oEw/WQqcaTWrk0Ts9LCVTIv3KBS
+b4F3gRBIg8szqeeu8bw0RA6jTXhOXKkJu4vaopCMlDod6ZeOrRxWw2oo2cMQtLBmvvNZTSkJAu3RZa1Sz1O96U909Lh0+yEd9VTrayAdA6eVszZmDJVQ4McC
+95nxQyH2JDlC1y46sTjbJDAiRE9AcABzYXGytWNofoBXyNvYQ+N1KWMeqStH+n7pC7qnkk0jpqdUoBrYk2U9eBf1b14WWNywEOpkNgAuBzPRUvsq7LXU22ce
+2muJJZdU8ZLMEwX2sk2eD6V+e+t9vcHoL0hiBjscHi6SBBQVeK+xkZVFxx1E0LXwDIbwT4jlos74SWq7a9fcmJpBIqKq0nWKCfWfsvJiCLQRb0xvWjj3AZ5a
+r8j5R7mOc09WS+JBRr2J1RN5UYUXn32dvtZxJxeyLywlXaZbJD7lt55mfUbMnjbtcLdrmixI/Fv8Pvq9+dZjT9j1Ru9Hv4o2TQNOXVoTzhwlRBc0xtWc9DS3
+uANnSzjghp2qEtNPgxfo9JQrGuLN0g84mK6B/0p6kRQY5RaoE/i0aajgfn0T2UwVIAVTvAopRXgwfkBnj/yxsXjxY8uuwukCIxGTmMpZ2apE0Q9yRDqeXMm4
+UWkUSVMcyqW9uO8Pct1uXzxVySxyhLRDcYPXnRq0OUri7fWJmiVlRUluG+8t5TqUiNdKjq0Q4w1cFkSodXz29sX0FEJEL8+O3wFCOvMoYT3OvP8J952kwd6b
+klT0WD0T9LTIqK/Iu5TkNUc/mO+ySW4iAamI1rQEbhToW/QDekBlnogwlCKpMCQKKic844vGD7SqRDjyjM0pfbIkBb5nXl3d2s6TtZU0B7nlXG0HDS3q03Jd
+teWqsorCTjNTDY2VRQCfAOGF08wvCK1Hm0dhAjE3O9RYTM+D4wk7Eo9ZJLGUHKmC2CtjjMotKCCAqa2y4uu6BN/vNYpltvIScUklJxVZD3Ap+qLn1QiLR6dP
+uyaevOAglkuyXEgVR9doW3mrjfSBqc70W+k2jtDM/3Pt2zSpM79vZes9fO93bPigD9MGwGxsUKd6OznxNvLDqQ7pB+xS/qKS+3hoe0tmD5qqWy3cH4Jdph74
+ds++4Pty6GmV2R0Jy/ScVeSqGvpEqreM9oRn7/js5GTCrBNnr4bkFwNSZq6qeKA5IUMvKrlNrA2kK9Io08slqZvZQd8kSIR0deJ3+gczZJt1SSHjYIbEYpKC
+0KyrgS6AgO3GU8MB7UA9vBRL6AVbZYLtzwRmDl2fBwMdblJhLU263IlPlK2K05ZUvBqiEma5j3fdcJEqyiFnngPoWMK9TJ8O85OvSn+QKmYHD00XTvg8C8x8
+O9Lj168vJq+JlQQZtaanLyfnk1PgOEFSmkvIcU3L23Y3IdcGiSAcn9633q5MY1rLtuvcggkFt3xcMcnMKjgc62ptKNhtRS92DWqTTR4x4TQmFVmUsTj3rcMN
+ua75Fw+Fk5JNWbtsqNELW3oqLZIQ0SYPBhs4+Z2KGZaA5jU3N7A8rd2T1KVkOeMAImA+1O6w1eKQb1iWvJ7iSPQxuSPzETmsZoVzQKMtm5DTnbxaKPLnbIKP
+IEFJDcCqug7zaUJuS9p9wuirY0ROP4cZTMqftS5LLhmKlMWKTWk2dowpuNpCtLhpo+/J1m0JaoHMddH1GqeeZdY0WM9weRaPXzeNW9dzxSQvHWWSBVv40Eif
+BaYOnE5KvKFsxNC0Mtgmvkq7NDYQWNcuxqeXJ3geFeUSVyHxO/pvEmRcGoMotHfBiEhzAGM7Xm0PpoZoQFBxoSoJTBBsnAEVkjZe0nhMn8KsdVPpAUulbHoo
+JV+o1P4JJVTSpt0i838M6y4S9VRyhkSb6FDP+iF9cdSvp5d2ocfC1g8rdwrAJN+f1DeYGJORsqZZiFNiuiCJNy99JP+5tu2dJb9i2h1J9VXbvtFXw+/rEaZS
+KDAiTVkFSrmEBDBeoeRLhEQaKa8amfWKTupPttWYksF4xUwF1SZOIsjVAYgFAslIFCYk+dnnVfz8fV8vvf7kSraQYLQ5L2HkK6Qcjq2kHkn9RXYewWkmOGfg
+5uBsCuWkIa6Dhik1vSapO5KFzIckchVKx+bKu5C6xkVVG44QC5Eghtoi40VkFXv17gqI5C4mP04vRQFNmSTJxtuGCM+onWt7VwR+587J0q9P36nt+HQ9wPWM
+l2fGDy2Ez8xkq8pa+1XZlMGukbTDULcCxAdJPbDtdFlz2S/dOsEWwicg6fC6sksu6YqsWp5IMpjg4+7ubnRTr0euufkXgfT+ixQ7HiZ/1qV/JDW1nnZQfQzu
+EfKdjGma3rmH0XhPqhKY1gZi6r00nxpM0o2UFcHTH2qPxAT8WKyVXdaSwyu1NMUs5b4mHWqEdjrrAj6uBui094OShlxBB+I927bp+vMVNCXqzEabzhT3hd4m
+K4yEEyavUA7Nl3dPjV++nJy+fPf2exAJ0f/VMV5QnAR9V6mrgedK3DwyzLBmzD5QDJa6tSq30uL75NHjRNCw0PHWJR9RauJ79Z+osoQMhIiC+D6jjpgd6A+T
+8QXQal7o0/HbyUifxzu+jGywQUpnCXQQM1OBKbyMFuVwVgKKk3voLoqU7kK4sPPNmPGd37ecP6igSA8oF6Q9n0wvkS54eqGvplcnQPYYXuqnccd35NLhR3t5
+3PHJgN3iJa6ijOna1X1XyP2TtRfzdHFz1W4Q2Al7zuo97OzgI+Cz8a01c8y5I0QH5Wq5muGkMRClO1/sxgPkg2pv6I/hU0gC0ftzMm+p+2cxgPoMY49HMY0g
+1W1TfipNJSEgivswO8KMyuAljAGK3CwsPr2NrxFOGQy/irf0JlYmEqKQyH8gapOS4oyy57cxTrSOKVVYS1jT8c9a3fEy/ePzPz37A/mfvv0q/i3wP32743/6
+k/E/PSv0qfuEHBxA9/RsiAKqePb06RH8z7PPJINSKRmU/ichg4rlkVFXXnu7WFf6F1JDqUANpX85NZQawHR+KTVUqFz3y6mhVJcaSu+ooXbUUDtqqF+HGqro
+ArszqijVoYrSX04VpbZWnwshEP4UWoGeck+r+eFdCRp04zamajeHuOODw3Bdy+qXtZ6vhUQ6WNOmxUEOon44U1hxyuUfyldFvLoE3k7rf5ZcsCpxWQ64KSX7
+gsQq+dERqReNa1qCuGyVuduRZP1TkWRd3a79r8COJdOtfg12LJ2xY6lfzI6VqC6F2rFjgf8kJsYG/+eCHACcgxYzSblSNo+Ioqhd5ZhrQMRBZRliJERD99Ii
+yv9tGzcYN5v2Ega5m4FiwNQb1X+TOkgAFopA13/XfGB63M93laTWlvhhOAFXMnS3pcDy05Kv+ydlGuOMH5XxZmFgSK6WoGjfzy+mU34x9Uv4xXTOL6a+nF+s
+TwKmvpBfTA/xi6kv4hfTW/jFVMovhgLVXHvL3EPy25iqTmnMvz7tWE1rJvuq9KrzjC4XuRbPKJy2NJU2y5CxAoRHHRYzbOqfkcWsSOjIoNnzl6+Ccai2EJvp
+dBbadP6T9Un4zk5fF+pvx6+wO/9+/nqktxCjPYYHDRpRvwoPGkyR+g140IZm9Euo0dSOGu1PQo02DirS3kTCYX/78D/2wo1INoVfX6/rskdIkGp84kErfUIB
+8bcP/0OIv1CPh59J55Zc7zSgLuqICmZQeKNnCOn9N7ax+GcUA543jVzGMi4FA9DQ2dJh9SyybCVsNJTT3EFMdrBUcMuG3GnQ9J3eyyC1uK57JEUzhN/dra0p
+rB4qPquOBkIiWwpcem22LY+ZzRzr7FyEKeqyfLBi00lm1nu8wtqNfll6ysxsvK5x4l2qzNE+Ri+BVz36um3cc4JqG/yKaaxKHJmkTIfY7PUm+nvotGXlgNYt
+iUTj2Vzxas5tYxiVPlha/32CmoOrQG5gHsO23qG+DudV0p1QEt4ar2snRUYdyQBYqXCouvWZdjyDO57BHc9gh2dwP8wIdB12txFPznKJxxpPHr9FNGp9OF1x
+Lz/hFqIL9aX8hHqQn1B9IT+h7vMTqi/nJ9Q9fkL15fyEusNPqH4hP2GqrvxifkLN/ITq1+En7ObL7/gJ/xn5CbtchCrSfjRu+fkEgjohEFSP5Al8BNehioJv
+mOswXC+P4RpU93MN6sdzDapHcw3qh7gG1aO5BvUjuQbVI7gG9aO5BtWOa3DHNbjjGtxxDf7Dcg0u7J1tErJBTCsgrKDdhCOIeGPaCqVPsYo7bsIdN+HfCTfh
+ZICbMI5TyPdSgkJVtoF87xcSFKrBRfsCgsJJoFfoEEH8AoJC1TuXv5SgcOtwdwSF/wwEha8kRtk7eo9hKJTlV/FI5Ix7X0BTqJimUP9imkL1D0pTOPkjaQop
+FrClCzhkpxLKQv0IykK6O87SJao3g67/Hb/h3w2/4WTHb7jjN9zxG+74DXf8hn8GfsNOlHfVUYbKRm25b3esiDtWxF+BFXHyq7EiTj6bFXHyWayIky9hRdym
+ku9YEXesiDtWxN+KFXEb/6BEGaIbt7Eerov6Jipb6dA48xqztltHSQv4EOJ1hCfLLbovPvHwDnpsNq6eY5KU6pwvHCLDwkf6PaNBOtCmeG5MHSnzCvVoDsWQ
+3ZqR9nGA3DSYXPN3xnwICndOe5jPzJfRHl43ZvbRdgBKQ4SHRfDfgEBoXF3OUleOW0iznYEoXND0LbjFHqJPjGlYva6qHZniPwSZIusaVTWgaPl+aEAUE9HL
+VaKXF7+EmLGbthlS8rYM3iW95Csx2Ad6Wqsv43Tsfa3XLVi4oIc2yWeL4fcxeSWyO2agwrIvdO/VCnM/d5ZHXqQZYxTBDfv/QLduIFIVnzg6UNjNdrNiSkZu
+KBJ9xCQwMkl2RJU7osodUeWOqHJHVLkjqvy7Iap89usQVW6hbxzmWISJrN0AK2LGNql/BbZJtYVtcqivvY4OoggK8c6gso/ElKPRiPq/p6uytuLTLP336s/B
+4vnQ2AN7VzZO17BaLngp8SCKodHeNtYWemmbG8vaZ3vnNMbha/YztA5hz7RoZbs2sfjdjv7yH4b/8fkfx//49VfPv32+43/80/M/Pi/084wB8i96gABSIwMk
+/O8z/N9vC3oUvqG200H+K6uhC79AFfTfdkyPO6bHHdPjjulxx/S4Y3rcMT3umB53TI87pscd0+OO6XHH9LhjetwxPe6YHndMjzumxx3T447p8ZcyPeIKBFV+
+LzF4iM4gsBmwOInWl88ZflRX5Q/sKDsyyR2Z5I5MckcmuSOT3JFJ7sgkd2SSOzLJHZnkjkxyRya5I5PckUnuyCR3ZJI7MskdmeSOTHJHJvkHkEnmLJJqxyK5
+Y5HcsUjuWCR3LJI7Fskdi+SORXLHIrljkdyxSO5YJHcskjsWyR2L5I5FcsciuWOR3LFI7lgkdyySOxbJHYvkjkVyxyK5Y5HcsUj+GVgktzFICndkxIHdwyLZ
+/Xz5GeSRaNeq7eSRSgX2SMG1Y9gbTten0qUaSye1GvOmAi0kZVNkhIFdWUPpQ2XN8bB9c0Dz5JGApdqE+DvELigNapDWQDw/nIq6KOt8yD7rWIGpmPvXBygR
+TW3rFj5VLoYbX5iyQp9w7UIeqQSDZDqUIIpCXpllDMOqKcmn881TPTcbn4bArffC0fXWNZY8bL0p1J8zhcmI1LYB4Tgku7o7Er1tJKwwlT5JvAwpZzm1aDRw
+t+4XtS/oQFCRDuRCMm2vuyEfW8/WHLiLreLswgXzPJtdnEPszioEgEJRxiveFdyrLVSqPTh2PD/JFYNX+EMcqzpQrN7HsDoKPG38LDaFrvPw6TnT+bXpOifL
+X8igFd4ZYhv1AyPk2ghBlzBIzKPDG6/ecD9UYKHZMbXumFp3TK2/FVNrl2Chv5yN+3mjZwa246yciwG6WLcgF5Ojk6t6Ca8Sp+NiQ0+85FSFYB4uAMJ5BLZp
+QqpgKm84TPfffIO0jueDWpc3JHqUZ9YeHY30xYTEBKLB9t4a70HqvAUnAjWtj11VmWtHPEH6sgS/A0S79t6+PaYfDxL453vXVHP9vpxb9d5ea1QQObojC+Lj
+tYI3GtnlZDP4kCjsE7TGwszKipIZ+aZCSGrrMBuaVXdsBkDsPJV35ceSl4ufn5kacdJoxtcSQc3gkA0CWsb64ZnYUzIJewcSIEh4WsvWJrPiLRvS/XFDUq+K
+e5XTrmBuoY2RUnvHx4cvPhxejlO473FjyalxjDglr8dtcBsdXt6axuoxOIv189HTADjKuG17LRy7ZuWEDQoNEogzH64at8AIdvgrp38muZ7kb3ULfb32ZQ0i
+uaz1pan1q8bUs9LPXKGPIQHBNXVpMHsV08uN5xOjRCJ3bp0kv69DzGtaujbT0DVM1rSWnkZ4tEtBIo2VH0xihJY1ex5cI+Q+mPEpWSsC8k0O0LjGVSq93rNV
+eVNGPo6Qor3H6ayRUXE4hIeuw4Wglz6ylMFMZdLt4uD7r6NGcYd5edhHgrPgE2/fHjPjQeCXqNCIlymaDw1ci8dW4fv7Rwf61sx17ZJ4tKfnxVPgAxEPavPP
+DqjzsLezz6mggQdGTGK9/AtH4NzKNqaV+0eLiJFQs6wc/WX41OEMKTk0cqBws+DfSSCQqsIJaOP1zdq33JXvMqcDTgIs4LY1HunH03qrHa33b03r/XxH672j
+9d7Reu9ovX/bf69Pnp1f/pbk3w/yfz89+ubpsy7/99fffrXj//7d1l88LCFX81mRMX0/7zN9Py/08W1T+tat4DDa9X+bsrZKnXdT0wq+N3ucHKWPJxz+iKHp
+7I4QZ54w5HYJrBcW76Bb29jrjdzUfaqOxFkpVAWR1CjyacR8q2vX3nbdhvKiMPvGsFe/Tb9eIRdqfZMPaNSdHtYcOlPTS2wLLoMwX6VXw8P9nuzhgwE0XCJJ
+513WCtJRjM8/84NSzw4iaUfC6gfvuXUzsyj2xceSfy/EueLQAst4qssSV4XxnWc5md3O1mRkRi511yyDP0K6irfiJ2hHL+3s1tSlX6YEX+iASNQUfk3P7Sdb
+uRWGgeHaWteSM9kJJGNAbEHum7lpjVCLJ0sSV2LPeF36vbBROWAjqZewtsyms/mjr6HX51D+4elvegPcL/+fffvN0ddd+f/Nt9/u5P/vsv6gRk1OJxfjE33+
+7sXJ9Di624MxUuhX9rpZm2ajj777y3eZBXV8gL/baodwgYevj9CH8rECh0pb6Fflor3VryrnmkK9cL6FJ9+O9dNnR0dPD4+ePz3S7y7Hf1wViPPGmiUAjdGa
+ryTwLsFyT5kZvo0CALKMTA2fbRt06X20dsXZRnQPqaVtZpsYow9vjPQLys1tjG8L7dbNFtWWTK7W1piX5/TN2uA1QOHXtAyEv5V7VSiTUuX38FDKVvh1QxZT
+Ksjw2QXHdsqWc6aIxmZLx9inL2kZ23bDk86lz8zqJNnFlEDGu8CoDWKZ4irE+1G2lMYxM3VayAAngFtA+gYHJRFuba3vkKHWfOwZFmiuEMXWwjYNM73xJBYU
+EWsQb3MpDLcQPlbt9mkofVJ+IZnieBuGSAd/RwnRhLkzG7TtbJXmeHZ6HNrhcGB6DyvX4Kzc2Bb3NNmWd6ZuMc4SXoVneFdkK+/IUlqVdkYxSGikZnxWrOzg
+f8hT0yEXI7Qr5AqayBVG6B9aNa61szYNROLc1zaZpsbCOZ4lysPCNdcl5tS42urWqbmtKVJOn6CWArrhI/0JDNumsUHBoacEM5V+JXIgwltCEx1YBlJPPbRc
+9ojG8nS5TBfhh1nDwzDnqwTsdF9j2ogbnye8CEUobhrTlshzvCBye2uTPG7hK4G9Ua5KglpWVTIL+TbEc0RcVJ2N2t5apJ5xRdhnyd7qqH+QacLcZtBUa+mT
+kgrG84/1SQY2AntK7hxR5XyPbtqod+cTy05Z5TCGSjshyneOXRFuFv5IyaA5WqbrxROa2EgYmbgI1LjyjuhYMYeZZNITLwOR9Ci3bmhH42GTHS07CqONoXIP
+qt7MpymTHaDoohayCiqyOPRIwFaptA72AidZwEcIg1DPERWG0ZjYvbL1yQ4B+YMnmFz1svqBqJHTUEP2FqYgSjRKh2h1WbfCUhjK78Tyro1dVAm9Ywdb4Z+g
+S5yMJIGwMO+MmCZ54Jf5/yBhurifPoZZcYFzYaumg4lal1hO5fjs9CVVU9Gvzi6EiK/QL6eXVxfTF+/gTwoeTJl8lHoK4iXxf49DUl2nCotccVkZFt63v6Du
+CifQPqbuyvDFxRVQzql7AwVQVCiAkgyBasgQ7HEPfgiQfOywtMbhK7b9QBE6j21sqy2SPrKltIioe2qwpMhIY0YEywa7pfiKUkcxK/TROmXo3hOfSkLFVqzI
+TMIZZXyj/TTQmav9qpytEf9IkxlZVKqNCiG/mmQQd/J+rpU87RM6LZLlB4V6aUnEWXI90GsskHDRhfR2q8KHwH+uJREY43E15UvYC9Jtgo6XXE35TKoO6mLb
+Z5F+MHjX+eVkCW+R+hOvxmDHr243HmFsMGThNReVj747UupZbIUvA8bqrTY6smvmvZYdnG9QzlPpbyaqIJTu0qFA0blpzE1jVrf6SG2l7sIUg7nLg2LfK2UO
+NJXtih4gO0fGfxRCyLEZMxLCwkcyE9mWqCASgy29LXnAc9OGtaancKWVuk4/zcHYRTzgAw6hBFUnRSE4jKuSMG4IQQxNPDyAN6hbBMHQcRSqbMYLZkQIvjDT
+YkgYNw78jc5E2cxDDl5YI/UYWar3OVU3DDhm90D76LlT4aZPVInWZVl1WSeKDkXBwUip2YFUUAkLLdK5ds2S05XNnIwo1DjKurWNwaqBUErj1ta6WdfMg4Bg
+QENGFXMW+tY0rZ3DQyia4UjhLk4akvgKxZIwxEPEpGggrz2CasyG4jJNWUvRCKS+NbU2de3W9cwyPzNEFPFgZjJODco4kxVfGdSk9hmSWUTmZdMqwtTiodIm
+PHwQLRsy3Clc30nJljkOV2yH0xTbsJEED5rStxRSRz6DYVGnOleyUvODjlzTny3XcuLhfAtpUqOHdmKXbBm/CxnFNgH1UBMhpSTBjLFa05HSuBQ5Au1AfSaG
+LCbYXTcyv1GTSjDkfuZWNoJB8OCOlHp+/23f7W2iejRJt8HkK1shf47y+tmBLmvtqGoVucab1ImNvuu+xFehBa+PsEvPGOi+Xe4zncOA+DczcixtkCdJ1iBQ
+oc5cQyYuHrIeHWyizhSK62ImBCOZernl2uoM4gcNPj51PdQzIzkhtBcL/clUJRd5iWxgjSVy0kjLSVUlgnzckJkkgnfBSJQlGhl8dOTQzBxBqtPNdaASrtjB
+GkgRS5jOXjpZUh0rTpL6/EmabVu+sqYKG6WrUxUTNVlkwrSN7XdQJR0UI8FdU67RSO+jzZKkBaCOzLW+kC9g0aXCVz1jC58rF3mnUil534HAwkio09nRgVKX
+SW8jCVeSLbgiT52dSxQolkCkYqYfcU+n171qHboMiTcrO4xlZYu47NlU4RcD21X8ixKv6NLNEQKdcOIgnwgxWgZ0HG1IolZryd9VO7nkbLY8zGmBrQYUaOCn
+0VV53ZgmZu6GXdKGbDNc8I1v7VK7mtsgvHI2ZrjLKY1k4Zr4gVusz8S6nmuSYheef9n7sGnjlzV9eaTUV3k9ge1gp1wPDBG21CgIoCd9P+hpm1WbYKDUdgzU
+5/bq81BRwfcWuSXPOxpECtsKOKmHECCFdp22lfxhCBEyPEXRRyOO6bKJOJQEI+JdYH4nNwD3jlmny5rYKvE4lYb4Br/mwEbPUUPFImIB6t61KzaD+BNU8sQB
+Y/UR78dTnGeYZ07BFq9L72i1TFWpsvUskHO30kipb9hrEDBIPeXv3m6mTxxQoCAYvJ09wouZFImNSPfgH6O/EBipl36YOy2lX1IzM2C6h0eantASy+ThaKR+
+R+aM53FF0/0JiJQG3XPs++ZtKNmSgcbvW3IsPQq0I3gddshmeBv+zJY9/GeE7HQAOZ+LwInrKYgNNYwksR3vdwauwWXf60NwyN57HOhG3w+64SbVNqjNoxNW
+ZeTBOfhIKE3MD+za2qbeqC1Imof6otRfAvX/HWzO1qX53yj9er6gsm6lAE8WL+Mai5nqlEwofDoQHRWoEIfCmQzY4PjWwjUJ9xfpNCESEOrGB0P54XEWKvva
+1lxijKG6pYWz5Sm4ETQbH2IVI3W2bhBHFOHd11bfrMt57Ep75/SNMxUnjyLyWvYbxTpa064x/IgFjhOyErfQEuXOo8jYklu6QMfrbw3ZwPVcNxZuRreIr4S8
+IEIP4f+cnun344uL8enVB/0dUJZPjsfvIDTwZqLPL85eX4zf6ghFfKlfXUwmAFA8fjO+eD0p4LmLCTyRNgQhhKs3E8UNFPrqDBuc/O0KSFvOAR9+dTV5qV98
+CKXdTyb6ZPx+pCd/O56cX+n3byan+gxafz+9nKjLqzE8Pz3V7y+mV8DHBu1BmOJi+vrNlX5zdvJycoGxjH85u6AX9fn44mo6uYRx/Dh9mY1J7Y0v9fRyDxll
+zt5dxb6fvdLj0w/6r9PTl4WeTLGhyd/OLyaXMPyzCz19e34ynbws9PT0+OTdSwiTqBfvrvTp2ZU+mb6dQj+vznBm5FlpHTpz9kq/nVwcvxmfXqX17F9Nr04n
+l5cKpm5MPT9+dzK+0OfvLs7PLicjmsDTqylARaeXf9XjS5nW/3g3Du2cTy5enV28HZ8eT9TZq3TIsEgwWsj5H+nLN2fvTl5mf4dpmkBhfeB/+nFSwIN6fHn5
+7u1E0WxfXuH0nJzo08nx5PJyfPFBX04ufpwewyzoi8n5eHqhMX50cUEsUhBoeDqChTs905MfYfnfnZ5MLi/1xeQ/3k0vhjYBtDB+fTHBiUzWXL2fnpzg6nQX
+vsBXTj8kC/9Bv39zpt+OP1DI6oNsjYtJiGlNVDr88WWyMccvzmAGXkz0yRS7dXWG0wHL83L8dvx6cplsAPi04hhboS/PJ8dT+I/p6fEUWIrGJzQnp5eT/3gH
+Szg+kUb0+GIK0D0Ne5DX693lRME+O5X9cXWmu0dyP367v/f0ydklsXeNr8YKe3w11i8m8PQFECdd4FEaHx+/uxhfTaBz8MbkUl++u7waT09pUWC8eJCnFy/l
+LCncnq/G0xOALHc22NWZPjufYJO40ZIFoScuDwrcA3r6Sl++O36jaPV0dmI/6DfjS/1iMjnV45c/TvHU8XfOLi+nPCdn1ILM40hNTum5gZCmUmMujR8QPmMk
+JqLUiCu87VsH1lujT+2dXGw+4BQ4XVIbVK1CeoJg6Tm8zJ4Qvh1vGmta61us0oG1G9aklWP1Wapxg8U6fQteYviLmd2W9pPVgRuAA+plmwt/9k+F0PrM1LnC
+nuQ/BV9M4gy8iqZB2xq2lqMKFIJiLnVojDRVqPFmAV1uHb+sKOfBSbUdwtsRNyGZ+Wh8w1icb7ngIbnhZ67+ZDdsq8+qtWcwRx5Jw6awDa5XhfpbRpq/F+5+
+hrzAe0atHHrrc0cRmms0RBjOAm77kVL/ChOJ7yYVcGTsTzxxJGKz+rop7UKXc2swwMh1NUFtG/1bzBhXlCK42eh/FX5F0mr+jVNog6Ook+zwQ0gqyta0bMVC
+CMk1w8Hu+zAiOqN0H9KSVKr+xICzJERCwfBOQKav/I76I0x9qBwyuXUrG6qSitK09naxrsgG4btZgRyR+/mHmFz8iTl7uqnFMAvdK9Y1j7hhL619aPoWUqeF
+LCKh7Us3ZvRQZg7U+9YkxHZVm0zbD7pc6Np1VeP7E04L/c23X2sAP6sxBFCPzfK6Kec3VrJMn39XQIYp5/hoM59nPlZXS8RGakxh9a5NmhNO+TUrLLMnDEaZ
+5zMLtxVBhnExaAPT1YRwFqKccdwQeFNlS0LEM8dc0hJsfMAxvq7dknltwtb85rtCd7Nzf/5Z5ydPp2/O3NIy09f4xeXZyburycmHVI39AVebF1q3m5XVP0F5
+E333hNNeuieXAuDCyXxnK/iGbl3vIJNQljypaBP9kHxGzZ6kHeD0oNvNyrW3lthpQ3RT+oVLQz/MnsiuxB/RqEqiipkht82LeLbQM7duYq1X/pwUhuPCE0xt
+iIYSmvaOE15MPdyzHyjVaobdw3N8DYu79vZwVpWzj5jpt7T1GmuA+MNDkLFoyfp12fos5zU/gRglurEsoOxyVbmNbfS+5ISC04oLPeHbS9scaMmi9WA/V4WS
+YiCt05DSqk1yvyTZJXsxHikKARxYi0xEzWak3yADsPaY+vgDBRwC3y6AMT+4jZtvaitHlxE98gkvKB3+NB4F69tY6gQ/q39KtvUTtR8GBwOgGnVMBckFNvA8
+YoKc16ZFfuzlNRWpk6jU9Ub/O3RSvQFmPyiV9K9UGGDd4Hm62uhj5+p/K/SRHq+asqJkeP411dtAFmV4+Mdyhvnkpn1CQwrxanTW/M/qj8N//D9+SwDIw/iP
+b7r4j2+/fr7Df+zwHzv8xw7/scN/7PAfO/zHDv+xw3/s8B87/McO/7HDf+zwHzv8xw7/scN/7PAfO/zHDv+xw3/s8B87/McO/7HDf+zwHzv8xw7/scN/7PAf
+O/zHDv+xw3/s8B87/McO/7HDf+zwHzv8xw7/scN//J74j36O4A4AsgOA7AAgOwDIDgCyA4DsACB/FgAI4D+e/aH1P7569vzZt/36H1/v8B9/FvzHs0L/+7q2
++ui7746GsB8F/uWB+1grlWNAGms7OBA9iAMp/oRAEM/6T4r/MKgMxdz/1nzkpP5BbIZKbJOy7SBAHtCTEhSIbp36dVAg8FH1OBTIPel5wfuslhxn/x3gIPuX
+sR7itk9FoxFdpJ9sE/V+mOoT673djrupfWvNfHQQoCcw0o2invym2JOzdaOGe+V7e+6ReJNB1EHWPb3PmyZmTpQey0qjuYhKhALH+MH9kBT9OEiKehQkRT8G
+kqJ2kJTfAJJyPyBFu0b9eQEpovYvs4AoohjsRrAPO6TKDqnyBUiVV/DHCrMsN5lEgg61t+j6rDFjo4aJbKsNjZ3nZAV/Bknw3ioJMxpILeFE6/qGzMc2NZxd
+wyix7Hs4grKel5/K+Rr6pCjRi78RNBdEJJDzUfKlUhOKDGPbot2GchLM0hb9MbgwONtLM0fzfVZZ0yRzTJ+SfMGQYY27knfVE6+w3yDWXYNznmZim6r6rQBA
+n4fy0Q+jfL4A2xMSltUvxvbECL36hdge3cX2qF+A7ekk6KskaRZbob7H8Vbm7nt2fmJfPCSKmyFIkPoMSJAehASJ3zhcsnOFMXvJYK5MfbM2N3ak999gBgsW
+kC/C85zIEYqeiwe2Kpcl16wt67Auei/9+N7o4JGApDH4+OieT/xHj9vtqBFS1h1quOp6k1XmZ4cTPOXWrS/xGHtKlqZ9wqnkku/fSXsjEUn6iuUEOHiEPZoh
+g+k8SkHuSUgULVuvYGFRG0C5WLYYq9meSqX309xytwAhBJ27trZWKIuuN0M9Phjp96yohB3WrK2mtjxlSZo2fUVh+GKHCnsIFZbAwHQOA1O/CAbWwUaoB3Bf
+Sv3JABK/IoisvV3jRbekzm49HYV+PNxMJPtQ/v4lD+1I3w86W1qLW0QFcEW8mAmB8CEHE305Ek0lSDT9GCTaCHEGne9/GQJNO8wRzBForiGKhwYDWz1xNwhK
+w2i4ikqO14a/8BnIsxzFqyJG6E+IAQPPj3LNvKxNs9khv+5BfoVl3J9IOt33uuxkFbbeVotOLBH9liFBMiw0zrEiYz2f3YLk0PYrNlzu/7UuG/KBUGudhgCn
+IX4LfHRJZj0mkrQu34kxzIQbHlMqMRpSLkrEf3grOcacTkpvsBKz7ZyxxDMYLmus8a4219UG1ApQaRoMrUeFAR72dmUa05IS6nmnLr2tPoFJ1N7aOlsRCmCG
+RPFCzx32KBmm81aH7uM56UgW+IA2XuWfHukX63bb81QRP7RqPIkSt5AZVCHL894LAX7I9ERBykAbYp8pV/clS5gAcrsmLgkyulhRpocswDJaG5HgsLINf0aU
+wzVKe3JK1HOyA2lYjb0xzbzCyviQP+L0XeNaq9A1dXW79kXikScQVMsKILsjA2wWVZrE+4YKpqfLXEWMBFlkHNqjzpJFvnHrH3RjYHBF+imySSTbPaa6S15A
+46rByU6hgtCbqqJkH95+uGoqXzVIH6i1mZMwKfTyHqgljgNBkENHmRMocqyCwO22whX0NiSm+hIkph5EYmY312dDMfO+R/wlKy7qC9GX8n4Hkae+BHs5/oOw
+l8NDoCWl5VKztW/d0jRltdFr8cpE71zd2kbQ/ILXHP9meE3yVBrRfWpHyURo6bLCTNEj0eDhcrMNa8OCm0t3ZaG/CMKp7odwftZcK5lr/blzPdu2cx6AfcIj
+uBA6i+qorSjVPw/oE4comgpvprJGeGFDnhV9ub4WEX9Nk8zKh/a/ClA054XQW4Gi5Aq7Hyiq2qFuDQNFC72q1h4tBeO9m5WGkgFb2yzMzCbIS7J3+HmSpU25
+olDsPL2DKK3FhAUqa9+ain7BdloyogRkuA2t2h1OCmvuwlhhJJTvJF6WoJAmr4ETR9x03LJr1DUZCbBKB3HbL81/4rW5XLka1ct9ydsp9Efb1LYi7cI7DXmX
+NEDVxaJuRcESAHZdo+qBfQ6fYnVbCcyVALfZ5GGaX/fCT1p3Tbb3S6/FRYXbHPpnZjP8tEPbhvRbw5Fc3AzoHWa9NLwF+vYnU0EvQwMk2Sh0n2w+UZhRn8TG
+9MytUVH3g5phdvVwRqlb39x27NHoXlyuLCa7JV2QRrI0TptOxuegg1OtI9cGaJuqB0HBQyhgvR0FrOLX7/n4o7G/Kg0dDvXq8UBf5WKMegja+xgsb+zYXH0h
+lvcD53N27UQC33bMB1/WMxszEOAdylMA9T6OvXYkOTAMiPBRcpbmoVBaof6qpCGBnFhEjC0yVc0sQu1Wjbstr0tGx1XmTtIZxMTrjwabaezCNbaAlyJwOe1R
+113+CITw45DM3AvVQzLrbUjmbfGxDu5adXxXiXFCGvYOEf0rI6I7Z4jTOsg20RaUvRksUjx5HMBJRHDHA08g6+mCr/KZq739r3XgY8O85Vb/53p+g0420koS
+k5JjtmW9gDsGvTmBXzRx1xvvar1P0dplyal38q73a+sPii6olWYR7VzYOPuSNHK94V65Zo46SGBLFAcDyOcDuZnrtjHzckYUyskneh4LDG3xKbY/z9aeNmzY
+RNm7HdYFBjjNTA1vpz50x7q2N23pFxvty+W6ak1tKTSDHgZ3XZU3HUK3TpAkTubKNi25vJPX+K43XuVruEl25ZZzx1Fz3U3mMSoP/es7zAyHliiBUjduY6p2
+c4gB+eRcJ3qBCLPrDXun4VzARcWnVSIalF5doYwu6/BTe9ugFrFx6+BlswrNB86M5OR3md1r194KcD2/2/Cxa0YRLBpTRg+OwiW+p/uks3ViLJkPqfT61lZz
+XdZk0bpGr2s6kBa1OlpWiGjjQZytK9PoWdnM1kuP4pphbKaKstumzScZm+xGRArF5KEkMjD4fFnzDko/C8HKaeYlW60blF4DbrKyhsQXSUqTM5/kbmCAKKRf
+wk7dsMOLE/Eps03cazhXJSGNQhIBPfmDyj5+a9iAcVXWQwmp8d0Jg75puEXJWoxZqdkSk5JfROA7Us+BHKGrfUVZELL7V+g6xwl7iwO2blWl+SwIC3drTyKH
+vxLM7LtybnWD8b4ES65SA0c2O8ot6p0ua91YEuWaYP++9G1ghp2ZuBtNy+w9P7Dbc73KaQD+Ze5qmv+5nUF3yoXGu1H7W9wyoP/hTDqVSTCePelfFEXcSYqn
+hMQEFoIkvhVJ4VtXohp41Tk06S7FVDLoKHyl2nB20B3bhNe2Ku0nevLaaqM6VxVdqL7tB0eYi6HnZ+VsjrW3PSYGn2Qp6LKWBDeFRlBT2pCxch12vnZ8M0X2
+hGCS+yLXQsJDitN1QCKimeX7/D7ZaFCYm/mcXAywA8pW31iHnGCgCOXOlphZQsjQOR0Uqh7AQ4l5btmrpVcxT54cNjVe/0tX38S3WWysPX/Azkd6WvNJNnSt
+JnH4hATDM/lG6KHxsCPFQcjBwGs3H4h3qe92xDC/MTFMenf+czLDpLphTg2D7ugvpoY5ehr0xR03zD82N0yCglXq6Gikfy1emJRyY8cL88/NC/Nsxwuz44X5
+Q3lh/gRsMGS5gva244P5++SDqR9JBfOverPZbP7tH4cN5pl84CSJXW0nh1E7cpg/nhwmwaKrz8Si/+MzxmysaXaMMTvGmD+YMab0+vcnjeG0O/PR+oQaJtDG
+9Jlh9K/FDKOvthcDiS4PDt1EhwPsmnRVydWQ4DgV/8GL16JJxY2B+Gjj1m1ZWyaZ3yTuEs6oRiCia1BbW6zR0cUdqcoaQaTJB1PXto9JPdw4e3NKr1BumTYq
+ixjgLgJL+qNpGVTPv/g/7f59Fv/PH1j/+aujb46+7tV//urbHf/PPxT/z47+Z0f/s6P/2dH/7Oh/dvQ/O/qfHf3Pjv5nR/+zo//Z0f/s6H929D87+p8d/c+O
+/mdH/7Oj/9nR/+zof3b0Pzv6nx39z47+Z0f/s6P/2dH/7Oh/dvQ/O/qfHf3Pjv5nR/+zo//Z0f/s6H929D87+p8d/c+O/mdH/7Oj/9nR/+zof3b0Pzv6nx39
+z47+Z0f/s6P/2dH/7Oh/dvQ/O/qfHf3Pjv5nR/+zo//5M9L/7Nh/duw/O/afHfvPjv1nx/7zj8T+o4fof/S/6UcTAKk/jv/lEI7z4evj48NgvP96jDD38788
+PXr6/KjD//LVs6Md/8vv8q+svW1a/fr8RH96FoIlrf25xROl1OvjY33CHE8BpZDlKgeFOMkVL+sHLvQi3NNqyP8bofHrWiLOeU4I8E6F7NoSxGhwOLLzD3Vj
+dLzN3BISzRI+qoxiRJAbLg+xgqOw96ZbE9Y5yQeA5hEXI4HmdRJ9g05g1qXdwuKRZxbMe8G+xoKXs/V0qUqAV/E98Mmy5puFksi2KPKU6hLT4WzNmOv6I0U4
+HEacYZR5ltv/tPv3z/Avk/9m3bqZqxe/8iXwgPx/fvT8WUf+f/3VV1/v5P+fQv6PeU+kwn98T9bw1pAeifQhcT6YzBWg7cKQUC/Km3UTc6CJdoK9F2whuUXo
+L+VahVxlcuPoz/AKKBSWxDLVzfPD8Ch3pNBpviwnmIT2YSbhRgrTaFYraxpBoFEYdHsn9P7r85MDinjdgLSvFei1dDUkke+laW0DqyFJUwyrpyBt+HhU/Y/Z
+hOr2NzzKTircCV2eqWvO+8GkbhWsMYP8IgS8w1lj30O9WrcHdBNna6XjWr23esZp9IwY3pub1uyF/tFExQSBLT3lBAsMDCOCoW49pc/Hu41ypClijokUngNk
+3DB8OM5L67jDikH7FK6FwYZuBxw0fxDdc73vWb1Xu/oQx6XiuMI4ajTUOKEKvsT9kZdin3Aqy9Zzz0JeRvdEimYGpzsJ/ybR8/j1xoLfUCgj7ovGvhfwIron
+OrAtE9GfiT4mX4mBWAIO6vbBfof0p9ZRSnW3fW08eAyqQv+vlNL/v+rh5yQHaeVaW7cAbGG5051PNMjTA8zYVOMH10OSEOQ7AS8mwGm4W0d6f1pHgNw8AhCw
+s+yVXrpPFm143gX48aBX9jcCz1P2y9FBoHnNiHBuQ7q3jD+h6ppbxLbArmPvkltsXxwliyPZ6P3Z3imQf4f633XpXf3rewAesv+ffdWz/7/96ulO//s9/r2A
+JX+MbhcyaDCCp42uALrZJMwagSWDQOsNaSokRekzK9N422j/EaSNq7t3R2QA4OicKGqYZE05iJjtJuiV+ELp6ycC3tJGPkTZHC0mCYb8D/44SNn+ZSW0Hezr
+zPs70uOImwTWN0aORHhKnzGiM2LqYZzNbpxINRbugC2iV5JdMRAUyVWy+eQ8ynUFiqqieRd6JuFeScnZIsL1Hi2UPR9quFvb9Q+4Ms18/nC2kS5DREI9G2FI
+Dbu+u0Z+T/k/q4z3K9Pe/rp3wEPy/9ujb7r2/zc7/++fxP4/hj2hz017m94S4hFmVlMk9ifqJgJzuUbPN7VZ8o+JzzUAor3AsIP/cd7DjsXGR5oYQbZmnzII
++B4hRt7SyIOS+HZH9/s0uvSMKaM4DR0zI3LgoopO6uRBnImUnUbmg2o2ID4jg58XCU+KQLnjMkVPhrdDzQbP9gDFhsquiuSTQ9evlut3mBZDLTGGHvhng3+5
+26OHV1AGJ3nE9B6geAeaIyoc/u+Q5pqQBxE2yDXbdtU0Y0VO/9a1Venyy2xUnBjRHMLa8NuQnbFJ4H6M9iLOdswzCl9n0JpkE3MSEttknc/CISO0XDC+1C+w
+uTL5v3B1+xsEAB+S/0fffNvV/795+nwn//8U8v+Vq9vH2AecKBDMA6mxwKdyjRh0jIi5ukWxpOzy2s7jLwl0h5F+O+84JuWR4ESMJRzgjwr/GBmJNmIKRCU5
+CrrQM/FkpsUA7rlAOHclHsXwvVuCswt60JAnRaXgWfAxbrKe6yXeKL0eDOjhqotTTcUVzeeQX22rrFKBvUhqYGwTVPoBQaU+S1DtnEN/un8g/5+Pnv6W5X/u
+l/9HT58/+6qr/z/95tuvdvL/d1n/R9T/eV7oZ99RCaBnT59+myb0/t//F/7qgYRL/a+3bbvy3//Lvyz8YuSam3/5tz9LRZ/7y+wQyX6BXarsImLpM0IvuMtI
+1n8skVd6wfQjDxcNquchFQXhyaaiVwfLCant5YTS1Pb2NnJN3lNVSN1bVUh/RlUhCNylMaWQJNipMFS2TDHTyf4cqDKk3tvigUzetErcvZnIHgOwMIiYTF62
+ISCGmY9Z5SGYvBAP4wxbs2GtQioRjfJSQPqfqBSQXYZKPrEEkPplJYB0LAGkvqgEUBEZfNMSQOqLSwBx6QspEUIcE7becL3FrOIPOpP9x/Cneyr+CI9QWJFt
+VX7UfQV5Hqzu832/vdZJMlm6C6A1GuJvXxAIMmp1DO8mLPCBrIx75XtlpeZM0fOrVAeKXNefXx3oJcGeYE8IX3SUQ+cnjy4dZLy3TZuwW7u6s6xcREh3igiJ
+dLspP8mGw/pB6v76QT2gDK83fHMexkT55qHcTaS8L0JEHnkdqg3yF6Awx+P/WcWB4LtI9bL2vW8qbz7a+DVmGvPBGZTFazwmwJvmI3FiMld8oERQtHYBiB8Y
+0q6tNm0A29imcULo0zrpCaOQP5Vu7cP3Rkphtbm5/YRgsK7cBQlBo0pI+piOUbtGN+s6DCK7NDOhVyKRNe7LQpsqcPHBWOv1wszadWMbKW/m3UgFWAVckKAJ
+od+1rJHGsEWOqUCvaMplh3uFlyG5GAaqsaH4Ys4Q05YzvTJta5s6yoNrOAtuNls3kgGsTEOYMPJttqQExTpFnir52YTGhQv/EDU9aQ1YO8q3al0zPRqSWCbC
+VAoTxZWA6eh45jibRAjgFMEAiBWGdypSXnNP0eiVsji0fUxTehA811jMqeQpJvGp5m5J7J53iPdDeLmZb4hAOhrl6D/1IcnFYW1ffJOI92Blexvj9fkJEnzB
+3SS3E0mZjiRn7SkWpmK+7S+qSnXZmtZ6xQiRoEXLA4TPoeRlkSBLIVzn3LTQqKsFW3/I9D+Qn7Vat7bxpLSXNU8HZWY5qgPWq4olrqekOpaSDpFKh/PTKY9F
+iBnBU6Vgy07FKyXXPlW8CtlTHq4bFkLyOSbKIUTenKYjXPn8ZQVJO9CV37OsFXIXPB3pl4FJ1hOfwV5awGovKQElR+X5w3mRI6X2jiOulGuCmDphlzhEaFpl
+7njCAm3+kJVUcMECr71dljAb61mLJL0eTai9q7TeVF61KnwxEPX2A9sp78xjKjDpPX7c+j2iSYl6yp6U40okGBKcNDemLv871IW7clz9abPXISdm81aiEmZu
+Vnis4Ach+xcDTpe1Mnph/C0sDV2ERA/Io06iTUWKHaOLAtVRpF4BnW5GqkbIo2RnKH3Ha4aiGe53csXuSZfg1i/ZPFKRdXlPIit7vacgrKb3xL0JvxkoGLau
+wxezCl89HkkV2dxgfuHgGvBT9qd47iInMQXcKIWvCBCOZOLUXZQNpO9GKjtvYT8SkXdVCot8xpbI+4zpvMCvkBU3K9gjyuE1dkrAidYi/zJOTlSqy09Sv2mk
+znmYlCSBFE1+i5DYx7G6JowylRgHheItYT6ZssKxdHD3xBxN2Y+RIIr2lYlVyYxXkG/Jy0Bg9c4awNmEU843fxgASgML1TpCw8xrqcRmdU2HW3Ck31phTSci
+V+Gih8suspqF+VS1bYmzG5+rXaj7JJSYoBizX4i6X9Y3sFfrbkmbJnKASd0ar/fGSZGtE9C89SlRA+zxjCq88NsA3A7rZuh7dSnXZKh/VG00qAW4wyyBCPFq
+A0shfPmBAl/RYmhtVflYguYhBZ3FzT5vVd4W6SD4BeaADrUR2PZPCoJQOYfAX5DVgkgJmaCngNV9oDKOsF7FZWA2LqKJpWxwHKNgbFEcr5hUU24Wg6DYQps4
+4YiQFZwatrS0tuUY1awpW9tQYoDW+mikL8mCPCYWa6yomBiVe786Df3eWaTN3ktOFagT/GFp0ySy9hIUT9PM9VQmLL6cTCKdQ5LBpWcK/hL1Ks8tEA09RaUM
+HEl3U5f/befhAY/cboV2xNwPI5sZ0vrCh3ygKZuzQyBhzxQkMcgkKb1YaFfbwCN/V86tlFAg3rpoqCoYNLzKdWtDE7I8l2io6BOMx5dwOOUuzJj9eyz26U2q
+wnpFck7e8vvmICsGyVNA1PdhbVZm9tHcUJbLW2S1PxaqedJ6s5yFqACYtv84Hu7rA+Vtg5RXNelUJE5TMAh2mK29oYaINSzQZSqj+9sGNyh1ztTx2VAEc8sl
+gux5eRmE5UiP9V6nE3u8Z0qi27Q/t4XsU2b/B62MsvQjOf++kP/flfXc3QUK0F4tANl2M90tCqD2CaR+sLU6AM4elQjAHSvg76Dkc5pO2Bv0XF7xAk/AqrFt
+8h6Y/lzbyOq946xGB4mXTIx0amjgjgr1JhKvlsI/R8uQk15tIW4H3jmLwcIWB9ityNNPrXHSUIK/ooHGGm0BhZUWQnLeJorCSAWa9zIJzst5k1afeN09qjij
+XXOxda7yyR+qTbIJM1ew7NuGDbNEwyzrtI5Mt8fJiZRgeFcn7/AsZ6uo+JII132iPHRqeqSVP/CkJpxAaAcmu0uyejvniuIwc044KrkuYpp1R0lYUNcgzIz4
+S4PeLwcFX0gdWex3i3fo9UaVdVsuTcuAE7hx13UAHjdhIyzASXBt2ztrxaZP+xDDZRmXBl9icDiGzoZ+oOwI+dyIIEiOgMoZ5sno6n956HN08W/tTH5OuxKP
+/CymJZc2D0xr/WykXxhfzvR5hMojn0zV5YsfYhFvbPizqG1YZ9gtEkLaodqg8GbZNPaTQwMlTeEjTkU775JOLm3LPkX5vLDVwrFbLMpmycilbfh8ESvx8AX7
+Dac1LRkslSWNTm3FtHpwp46xkgpEEU9YMDCvbPlKgZsuBULmjee1vJWZgau/svMb6zPPPYTDTNlIvXLaPbESC3rlwnym/MGqMndJfdolOrVhUljtJ9s161MS
+9OBEG9Kkg9ma0t8nMAjsb0ibDAVOJNZa1hp5zNN6a9Cs6nzbhVCXawbJurnsMkZLYDyd2rLMVYZetQVRw6Ag5+nhgGF7qxdmxjEpfC5Wbnbeik9oqCTyciWp
+xFvrFuqyjiYdFWBiZKyKCk0+wVxAiZcN9gU5Idc++FDSPoYlUzRtPFKG0TlYl3QiHBc0u7a3BoAfdLDxV+RoAGceuwGhJ6AA89DI18jeapp1PC1ixpP/iwNy
+ocww07sqGXco9M1hLEtVofxtuaK53Lg14IHDpAWm+yG2+TxZAzZIteE38hILiuUKFpsH2mWurQNfyFMyfgh84kdPmXkHiZ4iHxDKzucjfU4+b2jiHQUsyPq+
+oKP6CiZnXLfl4TF2Gdy40OwJHsRTlwsXf2uITW1u7dLOY6XNOrqHdWtnt7Wr3A3cIKB9gRdYxRmKXh+sJLNYVwvmYO+XhEDDp7L66EjunffT87PEW9Q21rQb
+beZuRZzd+tlT/dLOLFIaH3333Td4nJinmtyrsj1A+3BJlRLwEmaTIDEaHoOXtAQ6WbD2HQFZcJxeCndjiFGv3B0RBy5cc13O849gWuHQjPmOSwF7kvev9Dzt
+KEYVl1OBcWwtoUTbN4S0XXo4o+qkOIZNJUJhJFTbM9DILMtWrARSY4b4VKA5xY4EQ3GZUK4FKpiAoG1TlRbVEa6665q8hssTRZMZC5MOzabeOpt0IL4a6Xho
+f5T8qGPymcWLhxf3cyrW67xivfo1KtYLwaL6nIr1P+huWfpOlXDF+uxAsRVwlIhG8inEW+Zsqqd1Bln8qG/zKsYwE9s74BZp0XvdLXqvYtF7UO5iosOwn+vx
+9e3rDWUDadfo2vF/B9gFl2KLS4LaQ1rhnnIJ/Hq1ck2LvuKB6vZpRXut9dfpTnsrphRrwj/GwHhny91bophVjJ7vS4zrsh0o+8w+FnGupFt2oFCnrOtXD5ay
+11tL2V+JqcSF0R+uWR/TcNDe5FR3ztUwmFb2ydQtFq+ncvVf8A2KiYcUNWE8HTgGqV4/jwXy5Mr9lvXgpJi3dN4zPij+oazDe1/p1um9LYdjj+rSh3SdgCpI
+SkSTeE6cagPynYJ8rgYqFke0kxoBACvnvfUS26cjlSv0lOnRSoYAneyCjhlRV3au7zAhgdbMVFL8W0rAQEfTC4FYKyVxEU3KolPVmrOLNlR6Dp2Bdt7pKogJ
+r+AwdwjO4qyJoZnkKBqGGOWuFcEfUEw1aZCTuTBLQiqSx6Jrc3JMzQ/E344fvDX+noCIL0jmkOLLRf2T0IjKQiM/CEii6Ba4734lfkAyAVTtBr+QB1/SEvfB
+V8A1aexS8mTUOCuWytsn0QsTqKLMEwfGIvaMbZTcU0TojbLRzAGJuk6WxdHTTaKvSXFVTYZBcsxSXC+kISwj6H6VSETXdEp3q1C6Ww+X7i7SgG+t96TKuN2T
+tUmmKFTCjzHjeE3L2MXFSdoUtMDJT67Rqa6jXK990ZX0td04nBL2UMUQO8032x1QnibhxO6sHp6RUDbdxqMhQKCOeBFmmVi9PPMMhYboIvwmvQhPXX3Id+Ar
+RwTivQuw27eeQ3f7teXVV7hFvt56eyVhtl7B60G/5MDH8qBcWSe1zUGImg3fg8fxW7mbG29yu7x2c3KvYoyNi3ZLKpXeJ5cdhUHCXwd25kGhY+XdAIga9sCV
+P1NtQaPn64b8WtLy55bhpnv4Dxvj9qrqaqiqOm6L8Ig2PnUEiYK3ogunocJWNBO2UaL4kYvHtKH7SzeHwIqQvNO9S3Wt6LK1vjszigOImMya8Xhvd5mGyEVY
+BPhZSS8k8LfF7Vfox663une9pfw8ac/96vME2WPuLoIPDBaiDwEsFglBJyUO+GcHQ4WahyeHSkBz5oLGEF+jTQudI9WfdCreo4lszO25dONylkS6MLzPVCga
+L765rW5w/VC9eOVmM+NRX2K7LyseX7H9sbVkfLqhHlEU3sei8N9ck8qy5dwOFNzeUmhb71Paeih8ylN+wF3HyRqsvr1t2hRbKuT/N5ukNmBWlJsWWGrM8kJn
+xIWKFfAsWT7upsGvd63JJLIXEiro+2lLnaLl3c1Y9D8n9wrnxNHZlGpV/JreJ98KHWYUUI02iT9kc8C1D0ky+XSiOc0pcRgnlyEhXsuauAcraxKPqlc10ra5
+3si8wRa57oXTi7Ke90cWdv9Fxr2AugmP69b51m99s+CNDh3MAbW2Rh9iYsYlUcxcnMeYrpRHZcxJtMlA67BbjwCxHq2sbQ5bdwj/l1KhQs5gNplUzUBCc9Y2
+PikPkjYLh2Jw36X5B6DUXluSigvLpcLhrxy+lfqcqYwT4zQ543NU2UkTR5mfFnDNugUp5BhqTLwCZSxriIdfbT8yXps8Dr0pohlxbbNEjyDxVU/mhkwc8FDr
+c7rX9rAj6W0JaXN+vSRVHh+RDPiY6tOa+gZL5Kxs49EeDTVZk6yRZomCN9x38nChF2ZZVpigo2/d2ttbV80Vh3N8vKEkihqCv3hpVnPOdJTCdpR/2Dpt9BzS
+/zBlblrruaXK8nyqcIxGRkWCpDvWQs/d+rpdrCviDCVfvLq2urHeVZ9okhfmE5UORGUAEnv0q4EUIvxMuFGuN9kDoAY0hd7LpilLItbtZoU6hKMUMlfHTBqD
+ZW+9T/ALhcrte4mgrsNPSe/g49o14VgYuLhj0onqPmpm7Vp6SQtkf0bub/jP0vNPdh4wE5xnDh0bqfH9k95xTMhSJU1oYogM4AYVb/ECSwf7lgJKDTpXwweo
+t8xmibc3/EY3VgpnhprPsMHQ3UdeJsvJe2HqZChqb0qpK7TtprHMimTIpKcrOTJL2966Od0SMztfN9YXDNjk7Gz90W5obknOpSVcWK7OU8hOpHK1w7idvpGH
+GyfrIOsdPbI5t1Bl67cqXjbrHcHV1pCnZ+NFEjI6IDbclvUaxMC6RunJKmn0s9p5JqxKD92tHZ5CwTuCUSVeHhoWpadgpO/akh2dhUhg21xbW2MZ5VEoDyZG
+cN0TkKmvTwQ9W1nwMfyPNC8FQ7drb9n0Sqc2ZsckWjgBkIyPMT269ih7WKVnkKtbu4WYOQK2SLZltpSlD9nEEAPuIhk5wWhl2zVWsRaFEq1VStfYH/QQqqSH
+8BG4EM2stU3535xmu+XionFnKSNKJhW3zLUdsqm3HbCRfrHmiEqiZqjgR2Gw80LXfJ/BUteOwqGJVqcb2zI40GqDGNRNcq7U4IZ0dX/C99OyB7lvEjcdN2jn
+sK4XZ28POIco6X1q6Gwbdz8/zWQTwHlucMDS5sSexlKloPZJOAU38Xo1Ny1nOZjIlKniiQmz0MSByBKFTVXIPurvxpqdkGWr7290pMfBaomqPWvucwtbgwjP
+u1EZYdKRfAIJ7s1BiFnKBsI7iuoFcxxVHH3yIdfoT6WrQtnoBjnPOBWmdTNXCfopzSkzs8Z5r5KGMFPhnlNA8mDrIou+m5iVis2kRBbJsYFnNL0cPBOktVab
+QOMDJOjoyi3bW9VPld2SJzuUNcbmJn6dt56qXQC8rYz3d67BZf1oKcVmXUNMAWPQjTVzTlZhs4l8lt+O9DhGNK641OFe8rukbsseEerH9BPY3xUfom1+Q2AM
+55yUtPIvJtvVVks1OITu+yQgNdLDnVCB2T+Jz7QuDR+FItiSxIG5E4TiimDZjboj5Eiaop16kwaADiFYQw62HrgHsrrAhjaDXWfxKKnZafJoCGIyhLBpFZ8+
+VN2TmIwIcgSjxrYp3DMwCZznpag+QN9rFuBAdPHIqIdHsCUvhFxIQxkiMIicxFYzh62pN2rLNHFo17QMxnEN/QrD2zxpZTvS+3GHqPR9njlxm8Z0VQ7BuDvu
+hamS8v9sbWC1vIxFi+22g5Agx96Z4c67OgjDgoKqil0bretNUZ51huE34SZAP+tQ9kMyVExcamEBEXYheV+hfBFDiXuefIKXUCfxy2Y+VwPdCzzEpZT6K/9b
+dtBW3kvThuyyg0dJCHZFwZ8onPCS83HQaAwlKRuKH8Evq1J0huBQkgTe9Cui6Xh99DUKz6Nvut/+QbsG3fsXAT/JpcVDBDBgVFLHL4WtQrpHw9OjhDYglrky
+bZxqJ5U9tscpJZJJ24ZCW0ydTehA6vHsQJ/H5C69LH0wqLLMJNeUN2Ud2TLDznRNhIyqLeQJIStM+h/ZFMo6nY07hJ/5uBoqrAV1wFTSKnV/fqBPZDGlJha6
+J/D6g4UVTwR0Aov6wX9Q0Ns1yYyHoKF0kj5gDyA3rqJJax2lRneSxRoztzAcSs5zTUSlLy39lb5cqPAo2YaszuFcePreIt1BZT23yzrLEos9T8gsessCuW1J
+bIUkrM+Gp/ddM7xUZXtAJ4k4ndBboIz36+UqUCXGg9M1TTjoUW/SZ/jK83ZrmwEOWy4pD1hS+gcHzNnsJJ86KVjdxAq8ZrFgyxwl0p74wdOKaHuon/GZAkNf
+4iwhZzS4tOU+zLPXKAeHRq7i3VJEunrDp3woxWbbnaoSF7vB4QQN0eiBcURJGwjeYeZtsxyBvWxCtoeolWn3BtpDXQDnFiPzIbeWaofkqbz5zRDu7Pm8f1/F
+TSgDl3IwiVw3ba+33Rg1FZTu9TqakX7dfII9gdIn6b9O+x99CdhZqUg+EPJPAkSpJp9SD8GNXkhcJmRhpbCbQpuEtFIyALqbNhIaqEDgwa+7Ju6lsp6jcBiM
+NaR6LSvk417WUXJyXPcsFaIBcZZ1khhHSRGJOilKUpWUp+bXTMLX6X9gU919yiMMCgcr7us7w/nYfxmhMREJtEV3IsIywTdEwqHOstGHqQtwr/lqEzaRGuBu
+0ON6o03b2uWqTUAOrRv8GJ3eT66cU5IWpoPlaKCWe28zvEf/y1lkH/VTtBYCq18XsmOjG8TcNGZ1m4mrI/BPvElSoVDRtsZTpigZyYMqXctqeYR6YEQj84d3
+FTbKEkQnABmqB1FppNgsu21NDZPeltWg3peBf+o5bOR8CnMMCk35/vUB7mFD8PYi5hZ1Gl9QmWiHR2fBQUJ6NkyHQoKapU11EnL7rpqS8KzfPNVz1FIWUioY
+8QO8O9+6xjqc894U6s+ZwmREatuAcByl9YMj0dtGUlACZ0nawKJsfKvbcmmj4RButFiiaet+Ufty2xPKM7e40u6G/GA9W3OwL7aKswuC7nk2u3RioDurNpYp
+g06B9y2KhYBM6Z8uORHhSojnMaG4R+WP0+ZRY8onQpIfwgeEaniQ/WWadwabQo9Z+PScExjadJ2T5S8YO4Tpev+1NhXaj046hKyEGd1niPeHizXPvz16StL0
+O/LPrRBlA5YCK5sc1HtDyKssuV9y79KoBdFj9RBRrpnbJu0nE5GRZa9yDwVI2llZVabZBNE6nCqJjnbUhzk8YLyiwJP9r7WkxT8Qg057Rd3BuvYg26O2wEPU
+JkzRSAcZWrte7fRs/CifvcqLM2y7NyhlOocJWsYIk8FHzC8JbKfMqLEHViBjKrve8KdY2QkzzIUwusY56xF8ScUJ6JnYgaubGCueUmFBvO74GdacX7q72reN
+NUt9EXJKRoq4kYLA2YIJyoMd+YXKywh7IBOvmZEYrIaCQaJFnPoUFxlKOwE9FIUIWpeNeKTzU8Bsl5xGEdA5hBssYc5Il02AOInGGMmtxrXes3WL5lGMyeyR
+ap/8JsaB6CsEJCQEWcoHVSjXdDjjMLDovaVEU1ezUra+xqwucvLkbeAWtc0NqfjxD8RSd/8xpcRdyX6qdX9whcJMbgrctI51+Ph3lLyyvN2UMvgE5qOG9Yc0
+GTibURS2Lj4rQfLNE7i87RxhfuQ3CUX6b82cLIF1hdiJNHc1UDIGBaugCp+Gg3k9gMDWxKV0CGGrbulT0F/Sv98ar8q2Q3HLsLRwu9vFwjWt76jIbE6DwBmy
+eyUWxkC40FfOoxal0zWMxh4mPMvJCjYDnw/lVpCptXEbU3HwyiU5aVx7PHZlCK6u+gw/2RfrskVvWFW2DI1MVGttNAZ7DtEvSGbsGqYef6ZQDCAe1+AEMVVl
+b4Klnijl/HCU0/MYnCjoKiq0p+yVIqYHwui8qegYwsw0wW8VKclI+Y/pR2XLovZopM+ZMpH5zmpyEbpmT3IxOhoinKfgbsUE+IHV69zLCStaRl1yHrkdEWo1
+ioAiBDHzAs9VTPrneLn08onPOh2I3xgtkT0XaFmyKefAkak6v9bujjOKWD5W0aOQNBz5g01FpJlmxkoNHDLbWFI75beFXA8gHq5tXO2gXC9NXYNyELHK/VTi
+RWdjoJymvRFYBDpTQjEYvuolUMxDjV3S11bFLmEwyHjd04zk2A/hTZNvh+o+eJhTrymOJzKLFLyKrtqTvvkoM3TrSGrICnmBZFtPx4vmTEoo25olWQcFFr6s
+4pU5IexhnC78ENk/Bl0XARJfwM6s5nflPIqbQ2JvwW4FeZSBypMduGUDFkJ6XlBqFCxkwXnO8XjT2Y60+8STcI8SYoVLAg2RLbsC/FTigIHMaJIdNCa+otC5
+tJe7Dkg41BtxgGhz01jKNuBIeNmSc03qy8xt7dhQKchmcqzoWDRisfX9QHpWS8uqq/gy6W14B7/3ydaGAIdEYM4Oe3oipTw8GCHzHy7yXqACz8ZGfjpSKAIt
+I9N2z24JozM42K3DSjHW2G4/06ijqwKjR1nfoGZabbggebejIYFIPZjRKrQFebouOfMD3zZsZdgqAa87fxh2EykMQ3ZB+IjqQALCxYzxfngS0zrK6EYIjiJy
+fMa0UxWLIQ2OlcoheRc/HpNEm4booJ2eW+CntFiLGxNDeIqubW0XZfAGdLZDIAJPPC3CnaX2n4cvFF8kisJUqpRs2Q7syXk0fmJC/Ujv/bW7V/YUbdvgfKHQ
+iA40MUwoCveBGPrdncWcHGmucM9zzUSbpHKJW4X6Rci4IfCgyt+kS0dOaJZ0Uc5t3ZYLoi6l/sWoJTeg8goRpLnSbqhK+8nGdAg8cgXcQX5tKK+KlOSZq2ub
+cXPCjVrZzIhwjawySjWVQtQTSxiRG6vGzdZiWH2yGzZ5i94hR5x9Y5DFrr/caSF3sfnITOmQubAZwyoZr9YyJNqGvoWLIsQptIlkc6ldlHVTtZJa1ekjzAF2
+H++CzJymfcx+nYxEQA18guCkGEwOgHvUUMfdT5Ze781LP2tKvEpcs0HY5xBFWxJ68zO3svEOpIzsIvCP+K6xUnDOckj3iRwApA/Qk4rs3zRJKFo5OiQCZSxl
+2zmyRpmFtQWFuQjFzky0eE2d7k1O5sryGSW8x/NxDTojp3BGkF99E/BmgmhHRZ3STeD6W5mNpCRmoYJ2kxMlcE6S+E+Zow52l4rxvZwjLvler21SyIpQ52YR
+E9DZsUpCRFxxve0ljlU4gfnu6SpuSIzZFwk5Ti1rO6SwUhqN2neNPL408+BFcl4ogQ/oYoIAQ1MywJMu43n+aUaUyfkMRQhck0KQfVa/vH96OUiCi2hrKk/I
+hQhNm+4bprUwbffM3ZlgKxfRp/7sL/qtaWa3WEKLMn1uA6Np3NUxyw3uw7ZZh8gdm85p4gysLqQglnYeViToDHO7CP6YVKGTHIVNohVf20QJocpGvWy4MExz
+x7bws5E+dfoyFL9xC30GTfonWMlp7paktnXo5sgbMWe2LL0v5iASqq2bljzYRVQaw5igrwcqrF9j5iVXRehWlu3E0jZixtmfZ2uWxAEitP3dUAqRyf+HhYx3
+2iCltQC3fLlcV62RIiN4pvsUUYMMHjhM0OBbIgRJXmNdr2dcbvpSsGy1QZKO0WCRH5xYFEoxyM0fspLaNgPzfdZqI3Yb5uUH8GKQSslxbR3IlmWqpXdyIS2B
+SLmwGddpkFnDEjHcUqiXkCeWsb1NYYRFY8paUXqkpIvl0jIS68BufT6CdOqoW0LRgzGYjG577YMvSscLylVmiCksD+0aIX7oRR9EmRoqbNCt2KAe7LzAt1g1
+y4puJ3WiYbo6paFDJGRrel9VZenxKZES3jIRsdaXrpLWKonOfQ0/XZktFdCKGFJ7jrk9M9tQPl3CGx8NLbaqFPa8Q/sUqoJrQ3gm3i1fARz0UwlL8GNWnyUL
+H1zdU7SOckuJCEs33BaXZartne6UfbmvmDkdsnJJh7ZcWuDYm93mzeDorq1QxiE586psyqB+CEIrc29BJynPT5eI7zNlhYtKtTLgEyqUxHGNTHaIIXFjmD6F
+lJyoMcBGWpceLzh5ol4DtV0vgUvyMSUTPOTv0vMZgOqhEoB7nPlVmTYemr1Q/K91mAuWlcfjLGa3yNw5iQhKaTjZvJbEqH4PG+EGy3oguyC6Q7ftmd7sxBwq
+nKaNNtKooq8/PCsxFDC7dRKFkJ6Bp0mF7umHuofX+eDqsXoEcvHnDdWqsrNyLnXaqdRRuufVA2sJTXC+fBHYK37GOBI9GLK3MAkqi5WaOPNJZD/xwcslRxOC
+rcu0in0fL48TXEvRr8IQYEZhy0vOmmR0uSbBxafJ9iGKrWqXvZCoBh39yNTSbYF6ZJEFDMGT5E5L9zsvdSVxS2MwJdmOLOG+HoXMbNpG7zk3G+Tam8nFRE8v
+9emZfj++uBifXn3Qr84u9NWbiT6/OHt9MX5b6Ksz/Hnyt6vJ6ZU+n1y8nV5dTV7qFx/0+Pz8ZHo8fnEyUSfj9yM9+dvx5PxKv38zOdVn0Pr76eVEX16N4fnp
+qX5/Mb2anr7G9o7Pzj9cTF+/udJvzk5eTi6w4NG/nF0ofFGfjy+uppNL6MaP05eTtEt6b3ypp5d7+v306s3Zu6vY97NXenz6Qf91evqy0JMpNKQmfzu/mFxe
+Tl7qsws9fXt+Mp28LPT09Pjk3cvp6etCv3h3pU/PrvTJ9O0U+nl1VuDX+FlpfTq5VGev9NvJxfGb8enV+MX0ZHr1ATqtX02vTieXlzh1Y+r58buT8YU+f3dx
+fnY5GdEEnv7/2fvX5TaOdG0UXL/zKnIQMSNypgSLlGy37Q7vDZGQhG6eFgBarbVjx+wiKkHWcqESX2VBNNavuZb5913D/JiI71LmBuYWJt5THqoKpOzV7RX9
+jRXRbYkEqvKc7+F5n2c5m0/1fLb4q54sFA/rv95O/HNupvN31/PLydXZFLoSd3m2wN7qT9e3Y734cH17cR7/XsEwTfX59N30bDn7aZrBB/Vksbi9nPJoL5Y4
+PBcX+mp6Nl0sJvNPejGd/zQ7w1GYT28ms7m+nquz6/kcnnJ9xUvomzHhvn0240LAxnBSXMHqmf4Ea+P26gKGYT7919vZvLtC9MXkI0zB5P18iqMcL4iPs4sL
+BVPXXRUZfuXqkw6r4pP++OFaX16fz97NprJqQCnrp+mnRTIok0W0XCdvr2Fc3k71xQzbs7zGQYJJO59cTt5PF9GywHeyiHKmFzfTs9nkIlOzq7PZ+fRqObnQ
+9M7F9F9vYWInF/IQPZnPFvAEWJk8i7eLKa6+K1k1y2sFP4tn+Ci8u78i9cX1YgFPO58sJxpbvJzot1P49Hx6dT6dT8/V7GpydnY7nyzxZfCN6UIvbhfLyeyK
+ZgP6i9t7Nj/3OwwX7bvJ7OJ2LstOSaOW1/r6ZoqPxOUXzQR9YnGc4eTr2Tu9uD37wNMGD8XPKZqxD5OFfjudXunJ+U8z3Iu8vK8XixmPyTU/gceRF9+3Yz0T
+4QW/ABe9EpFwZxXJaecrUeBjVbKKA2TeA50JKUtuICQhydap7CqvuHiECG0ZncznL9UnMcQXTEDzSFHOXdMKEQLZo/yk/FGUL12rV5WlmksoLvkFufhJFejO
+2WrXGuLrxc7kYJKXn8tKhbYPhGUSd0zAoElNTigGUMlAhKLiLiTEZ6Rr3Zh218SEonp6BRN6QLbug33UrYXamWrPyKulwL4/wVV2ZR7l8c7nf1gUBs36x8CN
+JwAE1hrm/Aa38R4LBl2LUBFk2di5niAW5TVcSxQ+VuerBwyIe6Cm9ZKCicApF+UaUTgnVYJUilUUNn12yAUw+JKxXJnO2zbncF4wRqUoSVoruL1ZC41y+Rqa
+3Fr+so9kM1cFVUAg4CdCv2NfrEu1EdGe4nBkxLnnWW6xH/gkfAQLRVK6JBCXGT3yZspIV+ToFjpXW4vOGUUJhGhmvfNEotCbNViaY6X+DOOI3xWKsqjrLxzW
+8dBj9V1TmrUuCxIefeRijsIaN/4x1q4/OjvWf96bvPlR/xm/bqUG7kdF2geRbGYyv997xepkVsu2p7HrkTGJ6/yklZs7/bT1rWIVdPY/evqNFxHa/yitzzwO
+7ogK9l+3w6FfvrzgwW6NL44R33LnzHpXkefINhZezmJn/eDLT81nzoFwfDI6ZdY9U8k2Yimpw5bSwnyhBHxj2I8VFFa8VD2aOD3FnpoiX/yj2mjY0FWrbZtp
+Z4z+80Pbbt33X331+Pg4vq93Y9vcfyWIi69+xEoph1Z/QvNha6KEIL6BnISkYR2YyqzaxtblilAK+dY0epOXVbjOtrGLyKDmKo6AZP68YvmNHEaiEZZhhN5S
+l5ALoGzpwHDMqxnTtG5sYb5X6s/8zh/1b9lYxOmLF9Hk7eL64nY5vfgUexg/4ATy3Ol2vzX6/0DJ7scXsma7mzMc/Hlj9KOpVnbD8cF0r9LJy7GFyKf/IX7P
+6kXcAo7vPOy3tn0wmKnzwnO+Yfh+/21eaaIzHlf8qoRr9EDEUV+v0TrwCeVw5vk3b7Bq+M4EL/MHvnLf384CoS5T+mN7duj161F+B1vzzv4ySncGIjrvDR8c
+ZrOt7B5gBKLf7zXRuDkb0xxr22gHzmaVKVLZwoSTK+9rorySBRLMrlFInsu1jdwiIv0AmCdFmex0j5A8L6sIhpAj/IBd3EiT/Us35PLpbe8jL4Qqi8mx6vtk
+chTRVAdpYfmFk/xBE5+1OYCxGrtrS7zkmH2MYzRKSiR12dJRRkcudJobAnFkKmwOL+QsTcuBQDL1FD+cg0q0ex4l/f/IOf3CZomq/YVxzjSdUVEhquxakxf9
+RAkQyWAZC+BzTe4MYlaGp0FyVU5/9fiwf1nb9mV1v63GD+2m+nGs/uWPP/+gP+9vLl6+Hr/6v43bX9p/1DtevXr16ps3b/C/r169Sv978ur16Ztv5Gf085NX
+37759l/0q99jAHauzZt/efXq79HJuHP/LPN/dSuhC31z+/ZidqYvZmfTq8VUqZ/EqMz06Xf6L7vaYKY6tqH/x3/HHx0MD2d6Vq/GYc+v3Rr3+49KTcU7SiSF
+hJEUtezCdT0oX1J6cLMKhchgh6JrxVLLqdjQWKmbxuQbcPieO+6plrsxJsMmVWad4tnE4IgE7VJlc75RkoIw9LHib/rkIWIyPdl5jiZrkONr85+NyjlN2njJ
+/9aSDGDsUQoqxI312z1nxeEQ7hi1qt/dsg6Qo/tdjoAF8/wLIWaRUuLx3fbyZUB9NmhziSZZYrF5auiyZTL4sfrIZF+HF1Z8Qz1p/BOBM3RCXvgDNEVSQGjp
+CC6GapypaobFNcgchnLrPTRQCdMBlh+tSMGm2nOAIb7ZEW5jhVDnEROc+c+YV0rt1UeC2JFYsMTpabwzLqeGykp9vWsOZD/76yWMeoruQxebnq1aG2+xsLPS
+yTnimZYyEAwniBlYugfWgFYR50JCbZUWx5TrYGqUbRZaB5+h9aQShnBm7diWhmV34CE1RnoS0U8B8vLjAKcJf1Hw3MJKgAXSjxxhYb2buCQUp6I2IvOKfIhe
+FK+xG12Yes+2Hj9RdA0anbuf/a+sdh4CE/BsSSGgnxFJbvoKNqY6VjxUg1MUDxFB7dY6ojQq2+/7z0MXiMWywiqAp1EXx2oQGjL4fgF9i9EugB0i0ta2UT7k
+F5Mw5I4KmzrUJC7UyXKrIilEcc8Z3AfP6Szu9sGgp2GzuMBVFl1HOhXKW0kUDB8lftkmDsFphw3c0ypi4TyaQqXOvQS1Fx6NfY2hdcX296PVrjVb9z2RADtn
+mjYqJLV1Z1pFzZ2qGGjNpboxsuAw3txB+RMePQ06JrEpnm94Z5DVfhGzubyIRKH4/ri5IK5tBKhuK6Z4fFpevuuhUyULgnt2rvdO5bCKQ97GaBCnD1AYBcai
+3PEBUmQ0gdSqstEerYBBKyZ0FiInU2jTNFagWa2NyXt8seHnIHO1sBscMYzBds9dOCGoVxFNvJDJctn1II9sRxZBJBshjl0FSju9yevdOl+1u8bQEueiXx8K
+gQsS6QcruJRqjNG3WB7g4/15SdrkQU6RpyG6GCJbIkwbWDMko41lxdu8bU0TtAXzO9gLTL/Kyg55Q2FYD70kVmmRL3AeYu0BQls4FjDLQUFhsuHwGt/VnNS/
+q9Kq6kdDZ2mYCRiOLkrk5oLLvhGyrCjqwKUwtFKRco9bij4y9ssvn7wpScs4quUta0boFRZNG2wM/L7QVMoWlySULkKJefoY/iY8ikARvYWBMYyEEV5Omc5J
+ztaTelcyvwfmIOJYQ/uAmY+aNH+xI9We6uZ48XGZwFgv2rw1TnFEyFvR8gGKqVHJqpwgsPJi/lz/UFv3BL1hae5alElEISshlmLVJxxIMDBwS362ZZGgxApY
+n4S2VdIgMumY1yi2DhhXKMHPOMcRxUzGemmVXPsUYJJ1kzvWsQzgXicQUKFwxOGIypTxzQrQ7tAUdgZ4dUd4xghMtA7knVkqHQMfjOsEFQW5xupAIk1r/Wqs
+z31tIGtGjmLhsZgHvZc/OGxVA3voWcjnsJBeXrsARnkJcWQSKA10SV5guOMlZUoK1pzZlDAau1UL91Tu0IUaLUNKMW4y69/SG4NyfwcmmaLzEJ/GnyAHjzBt
+dHuM9nY3GusRf9xAVWtd6FGwU0bCuxSdYMo2Oi3JR/NyRPfsSOCbvgoO2szsqI3Oi3zLEoRNT2tel7XK9Tp3D4GdPNXfCjd+lvJfGK9dTIq2v+QrMjXoEE+h
+nbEcGLc7umJH0iS49Ut2j1QoRh5J3cKo9ymphw5ozxGPA6etUoHwgUrnuCZCBfwVjK+vyekPcWGDrIFoqVG5luSiooFTj+FsIHuXCe9so52B9UgFBlXpi/4S
+7HyPIjZMT5U/ZkJ0RSTyHJSwVL9F51+gHymxDh7q64QPdKxuuJtEks5VvcOHxBH21Ta+l/GJcZwpXhIHKIkzKZ/AoimqDUNrH2eKC1DgB7lTIADB00CA4c4c
+wN6EXc43v+8AngYGaRFcpLfG3glOgW28EU/uxlhfGpFvypMaM7jsIkyvjKeUTNJs69p6po6gFplJXCgmm5vUT8gdCm2n06OD1J4jgTzHjMJlG+Ytp/fVpVyT
+Xuez2mswC3CFGRIQxKsNPAX/5mfkcoPH0JqqcsK72TxroPNxc8RLdYAWmb9QksXr1dnZ9/dHqovl7/xzh2gIOXMD0w4YmAO6uB78GqaBIctO57oqXStGj0+G
+KSt5bpd5AcVcb0yNtUt+wHXZmo1ktvFJG2PQEy2dXjVla5oAjBxLjeyZLeQmH0VO5SimeOdjiC570gnwkrV+TIigF/aj6mnglu1Yj64DL/4o2lVgTjgvqLah
+FR3O2kWbw3lV6JkMWPhyNIi0D+kMLh2rbZVoVzl+gkJiAZFUaczK3tfIDSwfcPrOFli66YV5VzlZff5FLnDrckAgFW/BzCHy/eb1/Q4rCm1tfK3dY1kY0bbJ
+N7a+FzPTNA7l9321VN76R8j0JGI+JWxOuQvhIA4Gg+f75xsjvkmVn69YqhZfh2VurkMubliQx88NSb4SudNl/u+20Wd2s7W1D1VHSj5tZADkbf/jTKqnsBLc
+eZJxOk7jUuFQOjL8IOLL80T1Ktf9ZRMRV/dJ7RPRquQSUQO89mM90aNOI0aZr3JDgoVf2kzWqd7gRz3Pg1rJl/TRz6apTQUHe13YR/ZFaWSc1QBtEU9aSv5Y
+kaC+5w+rI8pMH6ckKt1F0exqIfSkQkHTeCNfJKpD9aVtujInpcAQo+81Oy7JxeU5VIKfHCNDcqE8RF5FEb6Eum6RZ0huVmsyCTvwyllTKzs9PcZmdVTipBio
+Q5Ce6UDX41ZNuRUtOqK+Iu8tGArjiOdyoLg4EsvvblUc0a672FpbuegXMUtCGgoOsro09pGFSfIsLN+oui2OdqTwinVt8g6l0iCtlL/uI+PBe2JEFqtz5+yq
+zNsgsBOIaGGuVVrb2wliwicoD1MwXqBkOY1iX+cbLpQGWIApgD/Nj4xn5xW7P6msjgNZHHcLd+jdXpV1i5hTXeRt3tHqCBw/eg1BgjvTPhojPn3chpAuS/Av
+fIktD9FTeDG27oHtw7CNY0yebAGVVtST09V/89Dr6OJfPilm6vdp98TTpchn5BvfMa316Vi/zV250jeJ4AIwZg8RanXqQRvjfy1mGxJP23WwBFVXZx8jq43R
+ZdOYz5ardgNXs2cQ7hTkbkzLMUV5fUwBi4xjzPm6qxEpzdSoEakjHyth83n/DYeVAWg4J82urpkDLCnVPygEq0ROtPVPyhiALRw9eNNhhKtsdy3b3imxY/S8
+fOWJPVwSuYd0WF4yc7zUUQeJSozK+fG8iyISioqihZCAqIlgUFKKobhNUdKDa5OFHoMdurjwzNlY9jcUI0W8RiaEFS0saZ3qU6vOu61PdfHqchYWCx2/MBAP
+udBmbLA/iX/plOd44mp8ZAuwjQwPJwzbh0jOEz8ns08nBceE+prXyOO4D1Hs4VLUWJ0RL0ohXlWpEkcYYDyy/LTBuqAg5M75GErcRj9lioaNe8oy+FY7q+OB
+sMzZfGce8motpBD4I69WqjgMCC0BAzguwpZoNY067hZuFce/OCHHXDPcC1N4hQ7YHpJMKBvdGOY8eCi3NJZ7u0NVpZQ0PmTDV2Wz2m0cVtK5FKzBtK8JVRev
+T8Xnyp2BMKVeCNkYvCGFZPwQkeHisnIEzgwgQDw7X4/1DcW84RG3lLAg73tOW/UdDM6kbsuXZ9hkCOPCYy9wI17ZTom+kCoUxmxM4S/6vA7hYd2a1UNtK3vP
+MtM5areGEQpRH5KY2FXrkgjk+sX96PhURp+cyL3zcXZzHUWL2sbk7V7nhd22FPQ6fQUaFwaLOk++++4b3E5czEvh1W2QCFE2YpCs7/UqGQSv9EF9OKTz06Wf
+xXoS7CqVtGztIwH217a5K4v0JchLMzRirhNSwJak7SsdDzseo4r5YaAfh4nZmWiTU9p2oLQ851SvAE6xJ4hyafmawssr6Jb5WFyHjw8epziQkFNexjPMZgk3
+GZu0aI4wYtc2ERdLadwLRYPJPTswmvrgaNKGeDPWYdP+JPiohCI6nPXDACpvJ7xwiQ2TKmiosiVarnrPSutduQdeRG5brnaURUWzI4SqKl/2rmxNFSOM8noy
+oPWD/tmYrS4JB59XQY+HpToU27MDtBkd8RHOtxTsqieS4nwKf9slAiieaACPX37nhM4RXuorY/BIJ4WnhLrpQJyrq9gdIt1+GoVPbs+q9bbRteW/wx0UBjWe
+ErQeGA+PemOEJRDZQtv4Jkep/qhcC1fa1/FKuxRXii3hn0JivLPkBkL4wS5lE6MX+xLnumwDI6//UiRzEbT2Weq6T7In8/pmaLly2srQrNJsuvgCI6EqT6WK
+1/sqb5p9FELsrMbw9OBvUtxGMVYjD8ojRd7C8N79pndQTtxD1Pr0u34bxHZ9Uchn/ZX7LdvBsVomN96pDt0eejr8vTe6tXp0YHNAKcGKxdSgS56QLWjX8fEc
+BdUGzndK8tka6uks14qUdY/embZUatAT0qMVhADt7Iy2GZFCda5vPyA9xRcvE4gNjS8E0rkQ4CK6lJkeVHclSUEKBpqi09R7JM2ubceBikfN0wUGjGK+57R5
+ElpBmj7wfAm4EB7IYC5ESUTiMJFI0hhFu2brVGP1cELEZXTmkOFLKYpY9UwlqZEfhMTU82jzru69JbxAkACotjDwhjT5wpctttzHCtBNQdNZyPEnMfPXAFs6
+5dBwjGWcODFWmK2pC6SHIx8ljRRRqV7Z6JrSN2jrJCiOnm0SYk2KnBFh5OGcpYReyELY6FxXcAs0UbEMMYzUOtefbbXDWiuVa9faJr/HSyLJEMr1HSV8oQbo
+/r4xlEn1kiZ+iHCNk8qU5IzDNS19lxAnWVPwBAY/2UbHto6yveeLraTvzN7ikHCEKqTYabzZ74AS76gWdUAMqta+Q2FrCPdp53jhCx+PuoHIkH8QXYTfxBfh
+la1f8h34zlLdbu8C7LatF9A9fG059QaXyNcHb68ozbbJVw9lbV42Ji/wOBuMSz5LRFvWRMYotyGo/tE9eBbelYa58SY3mztbUHgVc2wPe4cGqwjLxwpK4bcD
+K/M4i8rKffXrcAQOFLUVrvxi11BcS55MD9OrnWst0wPiCk1Q+3jOEMyN7uH/sj7mXqMLrbOMtWfXqDbpy6gbY/Te5Ky35z+icxcHgsTA29KF0zDZIY6EaZQY
+fmtPVMjN39gCEitSXB1pFPJla1x3ZFSgFu6Uzh4OmfrMhZ8EogjjVkji70DYL9NfOt/qyflmKUS2nmtLZXUInWEKXi/esOLyAXlXtY8SCD6BxUeCt0kDcbLH
+gLJpfoBnmnWoOmTPeQuNI9OfbCpeo9HZmPpzidQ+oSQSsQ5aZ8yLHumYHwyDk6mSV61p6pzuZyeRG6Lus6tVLnpiLJ9UQ2rANKuSMIh5TR8VI8QbHd0FhS0W
+gnDeC11nbXcnpto3d2SyHNi3d/sg8MDTwKNMaQ6S80Kh3yOCrSsv109DzoLcNFgh5htN6qFhE51Biv/ne49S8T+kF9MEewpFmmg0nMWCUWyAJ2D5sJoG3971
+JqPMngdU0PvjJyVnnustxuywjApj4mhvep4r+po+otiKZ3elpGrETXtM8BQ+mVw80AxzigLG0WVI5bElcbYiJj1EVJ2qzS8+JhT3zOX7YU3G4dU/99a8Ei4J
+7teDda07+M2MFzo00BNpFpSuxxhi5MZ1COXDcR5yukKIyTUnwScDq8Mc3ALPaG91BpMqrCU1Z0zjIlqO+LGwKZ5lywej9s7Qqbg2rE8Gv+X0rXC2xWecOKfR
+Hi/QZCdLHM/8IMDSaRZAyDHVGEUFSqHJNUUgdx3eMk7naR56nwU34i5VkU853ZMz1yNxIEKtb+heQ6WH5LYE2JzbbciUx48IAj5Afdq8vkdmmq1pHKmQN7CZ
+2n2MGmk2ePD6+04+nOl1vikr4lZ/sDtnHmxVKNEPCTeUZFF98hcvzapgpKMvcbe1EIgWj6SSMgbZi8IQ0QTvKuxjLr0S+Zu0r5ku7O6uhRp2UmQn2qM7oxvj
+bPWZBnmdfybyRGEbp9R7F0KEr/E3yt0++QCYAU2mR8kwJSBi3e63aEOwCoWtA5ImR3ER56L6hUyl/r1kUHcuoZmn1sHLmQASt0UOF3cAnajuR0ligFpJE2R+
+gdg2mnCl43+ZwtdMMM4cGjZWE/nr8KB3AhMyVdEj0BOPihtUuMXhGi52rhWNcwiu+hdQa5k7Cm9v+In2cuXYV7z/YYFhuI+iTKwPGBj6pStqNCPoCi27WaB+
+EIRMvLuiLbMx7YMt6JZYmWLXgLa3kDzSw342exfpW8W0ErGSopTs5HXBkBkzXLfTd/Jw4SQNZLuji6wGX71s3UHDKyW9oHK1HeD0TLhIPKJDCf9uode7Gk9P
+NklDnNUUyWFFbPa1xV0o9Y7gVEmUh7pF8BTM9N0Z8qOTFAksG5TR3OQIjuyostS9A3JIBpS9LHgZ/iXGpSiRbifXKx7agI6JrHAqQMpdyOnFOhQq3oOsjWTX
+4uZIsUW0LJOpLF3Q/TOF6lYyMsBoa9pdSfRpHEsHb5XgGkeDEUIVtRBeAhdivmoNkJMSzPbAxeXJ88OgKhlUXDJ3ZsinPrTBiLaj7cSEgxQnFzuvdc33GUx1
+zVKzkVWnG9NycaDRkSQ97ys1uCBt3R/wo3WMt0pik7jo+IGmgHmdX18eM4Yoan3s6Bzqdx+flicDwDg3IbiWx4k/DUYhIrAlnYKLeLct8pZRDrlIsuWtCjvG
+j0ITOiJTFCn38zrqr8aag5Blq59+KOnLOr70xLRny70wsDTUo4iUJNu7bFHmR/AEktwr4BAzhAbCO4qokzmPKoE+eZFtWFRYJMl2FQPWto1t7cpWUv0UY8py
+lARU0YMQqfDELqDz4OAki70buZWqpxrvtw18RtOXfWTCKzQJ4wTKatcFS4b0oLIHcLJDqDF2N/HtvPRUbX3B2zZ37tE2OK0/G4LY7GrIKWAOGvWgREB/yxUN
+WkOGJ9KWXzLD4Cj6WURITDJ+EfwE1nfFm+hQ3DBILEZq8gy2S6VdGJtVcEmaHm6E8rIvsU6+jdNHnmtaQByInaAqrlAsu1ePVDkSQ7QTGYh+oYNP1lCArVfc
+A6gu8KHzwabz8SjQ7Bg8Gmke5AQrVbz70HSPcjJykGMxanh2IMrvDALjvNQ92CF1P2rmy4Ho4pFeD/fgAC6EQkhDCBHoRMovCA2yFENUB4YpVSOn862xG5by
+pEEr27E+CitExd/nkZOwaYCrcgrGPnIr8gr9NaZYIG8DjjkVkSV48OmxB8hxdGa48bb2h2FGSVXFoY3W9oYoRZ1h+k24CTDOOoR+iLr6WwUnqLyEGils5Gqg
+eTKDiIoXMnR/A3XZxZ34RR5ddvxFJwSHouBXlE4QlnF0GiOuWS9jE2hmfUBJALzxW5Trkft23/2Dtg2G9+e+fhL9kuazzwD6GpU48EtpKw/3aHh4lNAG4FvF
+2vdDjVcuNvFgnlIymbRsKLXF0kNUHUgtXh3rmwDu0pvSeYcqQSaRsrZMTLQybRNKRtUB8gSPCpP2BzaFso5H4xHLz1yYDdWX9uanUvOLY+IBF9Dkjul46PqD
+iY2VTOt8Q3/x8uBhxH3SUBpJLzDHgI2raNBayypqKVisyQsD3SFwHjsRdk0VjvhbenOm/EfJN2RzDsfC0fvW8Qoq68Js6gQlFloekVn0pgWwbVFuhU5Yl3RP
+H9lmeKrK9li0tOq2oWiBCvTMLiWq7rommehVxp/hK8+Zg8/05bCsGG0F0j/YYUaz0/nUgWB1gRV4zQq5oSn0aEgHe6QiETpw9CXP4jGjPYGMFL1GGBzquQp3
+SyZ7zumcd/kQxObQnaqiEHuO3fEWYj6k5x1OWr4caeRNsxmDvxwU88WsjJs38Dy0BXBsMTPvsbXKxkpMQ4WX/s4uiv59FRahdFzkSaJzPW97re3mqInIudfq
+4Ea6XfMZ1gSePlH7ddz+EEvAxgoR+EDKP0oQxZZ8TD0EN3omeRmPworLbjKdBwURjwDoLtpAaKA8gQd/3TZhLZV1gYfDYK4htmvZIJ/0UEfRzrHdvZSJBcQo
+6wgYR6CIyJwUI6mKGKH5a7mLLPYf2FW3n9MMA9PRs7v/mDMe+09jdCZYKqkjbh/qGwLhUGfa6MXUBLjXXBW49dWQlP2k3uu8bc1m20ZFDq0dfBnt3s+2LAik
+RTpPSTUQ8yO3Jqn36L85yewHqXnP6tct2YmEF0n7OTmuTiA+8SGCQqGhbXJHSFFykgdNOpH1DaUemNFI4uFdg41QghgEIEf1OBiNlJsVyv8aBr0tq0G7Lyn+
+qQtYyOkQpjUoNOQozxi0drKALeo8fE3czha3zpqThPRZPxxKhN0jm4TCvqimCN/+5pUu0EpBqXh8k3FOVuelbYzFMe8Nof41Qxj1SB3qEPajNG6wJ/pQTzLl
+ZQUeDBHnksBXpMzLNxqfMXZ9eL2oI7ntqcoz9bji5ga+7NWOk33hqV6r8nUyuiJUbMqtPympURB9C8eCr0zp7y7ZEf5KCPuxjUgzEXsRyZSmAyHgB/8CkSkd
+ZH+ZpY3BR2HEzL+6YABDG89zNP0Z1w4hXO+/7fIK/UcrDUJWwoTu0+f7/cWa4m9PXtFp+h3F51iv6sq2bGxyUu8DVV4l4H7B3sVZC6LH6lVEoXpm3E4mIiPP
+XqURCjhpV2VVMWu15+7o5zow0N6Q6C2mB1B8KhaixPzOkznouFXUHCShh7M9WAvcxUjSKyhnwQcwf5nw38Tw2iaHWwwmriuDP3BJdRQYx6xCkq/E4SPml6hs
+R2gS8fwamoGEqexuz69iY8ePMNwuA8452xFGZIBjTbMumlEgrlqjWTyR644/w5bzeZBAnwcJdNbu8wfOgZqgNNmRXqg8jbAGkuM1cRK915BxkWgWhj6uiyTW
+EXgn1C//u6/wiYc13QXMdskwCl+dQ3WDJYwZ2bJRIU5kMQZyq0mtR6Zu0T0KOZkRmfbRT0IeiN5ChYRUQRbzQWXKNh3OOEwsOmcIaGprNsp2d4jqoiBP+gxc
+oqa5JxM//IJY6p7epgTcFfRTrfudyxQiub0aNtnw4fd48sr0diFl8ArEo/r5B5gM7M1wFLY2fFaS5Kjb15gCy/woboJZR+Na/ZAX5AnsKqydiLGrnpLRG1jA
+L7+DdtGG7BUIHAQuxV3wS/VAm7z9Ev/+IXeqbDsUt1yW5m93s17bpnUdE5ndaThwhvzeoAPuJdUjJlklRqdtuBp7mPAsJSvYD7zei0QjUysL+0b58Yj4N27K
+ULm66jP8JG+syxajYVXZcmlkZFrrXGOy5yXGBcmN3cHQ478pFQMVjzsIguRVZe69px4Z5fzhcE4XITmR0VWUaUfolSzAA6F3Lq9oG8LING1HMFjbhoz/AD8q
+Wz5qT8b6hikTme+sphChbUaCxehYiLCfIu3JnTMDs9e5lyNWtIS65CZwO2Kp1TgUFGERM09woQLon/Pl0soXLmm0J37jaonkc56WJRlyThzlVefH2j4yoojP
+xypEFKIHB/7gvCLSzHzFRg1sMtMYMjvlp5lcD3A83Jkw29643uR1DcZBqFXuQ4nXnYWB5zStDc8i0BkSysHwVS+JYu5qaJK+Myo0CZNBPYluu/bbfqjeNHq3
+12jCzRxHTbE/gVkk41m01Uja5sKZoVtLp4bMkJOSbEPyPjxm2MrS4Uc8grQvyVw6Fa7MKdUehuHCF5H/k2PowpfEZ7Ayq+KxLMJx85LYW7BZ/jxKisqjFXhg
+AWZCep4RNAomMmOcc9jetLcD7T7xJDxhhBjhkkBH5MCqgDhV3dFoI7y+v6IwuDRKQwd0ONR7CYAEdXvFmfCypeAal07pwtSWHZWMfCbLho5BJxaffuRJz2p5
+suoavkx667+D7/ts6pwKDonAnAP29ImY8vB4jMx/OMkjTwWe9I3idGRQeFpGpu1ePVCNzmBnD3YrrrHG5/aRRh1bFRg9yvoeLdOK7O2611APIFLPIlqFtiCF
+61Iw3/Ntw1KGpeLrdYvny24ChaFHF/iXqE5JgL+YMd9f7RmMXoYwgg8UUeAzwE6V1EAdQBKRPqKz4eUBJNo0RAdtdWGAn9KAP9UgMISH6M7UZl36aEBnOXgi
+8CjSItxZ6ui1f0P2m44iP5QqJls2A2uyCM5PANSP9eiv3bUyUrRsffCFUiPa08QwoSjcB17CqrOymJMjxgr3ItdMtEkml4RVqF1UGTdUPKjSb9KlIzs0AV2U
+hanbck3UpdS+kLXkB6hUIYIsV1oNFcpdejgEbrkM7iC3Y60o2wypiuYabtTKJE6EbWSW8VRTcYl65Alj5ca2saudOFafzZ5d3qy3ybHOvsmRxa4/3WgLxMBc
+49hN6ZC5sBvDJhnP1sYDbX3b/EXh8xQ6l/zxKvGLkmaqVqBVnTbCGGDz8S5I3GlaxxzXSUgE1MArqJwUk8m+4B4t1En3laXTo6J0q6bEq8Q2eyz7HKJoi1Jv
+bmW3JtyBhMjOPP+I6zorGWOWPdwncACQPUCfVOT/xiCh4OVoDwRKWMoOc2SNEw/rQBXm2msD5sHjzet4bTKYK8EzSnqPx+MObEaGcIYiv/re15tJRTsa6gQ3
+getvm+8FkpikCtp9SpTAmCSJnzJHHawuFfJ7KUdc9L7es8kgy7zOzToA0DmwSoeIhOJ6y0sCq7AD09XTNdyQGLN/JKR1asmzPYSVYDTqyDby8U1e+CiSdUIJ
+fEwXEyQYmpILPOkyLtJXc0WZ7E8vQmCbuATZyXFItUS93ctJEpxEg+GAgkoheH2GM037ssp0zz3m3lfOQkz99E/6Mm9WDyihRUifB89oGlZ1QLnBfdg2O5+5
+Y9c5Bs7A7LK+qp8RbzMUZu3jMbFBJxiFfWQV35nICCFlox4aznczf2Rf+HSsr6xeePEbu9bX8Ej3ApWcCrshs61DN0fRiILZsvSRuINIqLZrWopgZ8Fo9H2C
+th4rP39NXpSsihC9YiiXthc3zvyy2vFJ7EuEDn9X9BuF/H/4kHFW50hpLYVbrtzsqjYXkRHc032KqEEGD+wmWPAtEYJEX2Nbr+dc7vunYNnqHEk6xoMiPziw
+eCiFJDe/yAi0bQXu+6rVufhtiMv3xYv+VIq2Kyo7c0YmKlePsJCGikhZ2Ix1GmTUUCKGn+T1ElJgGfvblEZYN3lZK4JHClwsPS0DsQ6s1tdjgFMH2xJEDybg
+MtrD2ge/CY7njavEEVNA0Mln0R3JYu8HyCjyQWGDrmKDerbxUr7Fpllgm/A3SCuIJKZ+iUUCKKx0CN5XVQk8PiZSwlsmVKz1T1eBtQrQuW/hxzNzQAEtCym1
+14jtWZmG8HQRb3xwtNirUtjyDu0TDAuDt6meiVfLGygH/VzCFPyU6LMk6YPlE6J1hC0lIizd8LNYlqk2j7oj+6KekrbDTVaSsjH8Fzj2Vg/pY0SjmynjkJx5
+WzZlG6TjqUIrCW9BIwnnp8ualY9xUkkrA16hvCSObWSwfQ6JH4bwKaTkRIsBFtKudHjBySfqHVDb9QBcgscUJLjH79LnkwKq5yQAR4z8QrnzIGkh4n+tRSxY
+Io/HKGa7TsI50REU03Cyey3AqH4LG+EGS1oQJN7VkMR7tGZ6oxMwVDhMe53LQxW9/flRCamA1YOVLIS0DCJN6mkF+rh5SnXa52ePzSM4F3/Zk1aVWZWF4bOA
+pI7iNa+emUt4BOPlM89e8QvmkeiDHr2FIKgkV5qHkY8y+1EMXi45GhB8ugyr+Pfh8rjAuRT7yncBRhSWvGDWBNFlm6guPgbb+yy2qm3yhcg06NhHeS3NllKP
+JLOAKXg6uQMtKPRJdCVxSWMyJVqOfMJ9PfbIbFpGHxmbDefah+l8qmeLWJ1dgzT/8sNU38yv388nl5leXuO/p39bTq+W+mY6v5wtl9Nz/faTntzcXMzOJm8v
+pupi8nGsp387m94s9ccP0yt9DU//OFtM9WI5gc/PrvTH+Ww5u3qPzzu7vvk0n73/sNQfri/Op3MUPPrqeq7wi/pmMl/Opgtoxk+z82ncJD2aLPRsMdIfZ8sP
+17fL0Pbrd3py9Un/dXZ1nunpDB6kpn+7mU8Xi+m5vp7r2eXNxWx6nunZ1dnF7fns6n2m394u9dX1Ul/MLmfQzuV1hm/jz8rTZ9OFun6nL6fzsw+Tq+Xk7exi
+tvwEjdbvZsur6WKBQzehlp/dXkzm+uZ2fnO9mI5pAK+Ws/lUz2eLv+rJQvGw/uvtxD/nZjp/dz2/nFydTaErcZdnC+yt/nR9O9aLD9e3F+fx7xUM01SfT99N
+z5azn6YZfFBPFovbyymP9mKJw3Nxoa+mZ9PFYjL/pBfT+U+zMxyF+fRmMpvr67k6u57P4SnXV7yEvhkT7ttnMy4EbAwnxRWsnulPsDZury5gGObTf72dzbsr
+RF9MPsIUTN7PpzjK8YL4OLu4UDB13VWR4VeuPumwKj7pjx+u9eX1+ezdbCqrBpSyfpp+WiSDMllEy3Xy9hrG5e1UX8ywPctrHCSYtPPJ5eT9dBEtC3wniyhn
+enEzPZtNLjI1uzqbnU+vlpMLTe9cTP/1FiZ2ciEP0ZP5bAFPgJXJs3i7mOLqu5JVs7xW8LN4ho/Cu/srUl9cLxbwtPPJcqKxxcuJfjuFT8+nV+fT+fRcza4m
+Z2e388kSXwbfmC704naxnMyuaDagv7i9Z/Nzv8Nw0b6bzC5u57LslDRqea2vb6b4SFx+0UzQJxbHGU6+nr3Ti9uzDzxt8FD8nKIZ+zBZ6LfT6ZWenP80w73I
+y/t6sZjxmFzzE3gcefF9O9YzEV7wC3DRKxEJd1aRnHa+EgU+ViWrOEDmPdCZkLLkBkISkmydyq7yiotHiNCW0cl8/lJ9EkN8wQQ0jxTl3DWtECGQPcpPyh9F
++dK1elVZqrmE4pJfkIufVIHunK12rSG+XuxMDiZ5+bmsVGj7QFgmcccEDJrU5IRiAJUMRCgq7kJCfEa61o1pd01MKKqnVzChB2TrPthH3Vqonan2jLxaCuz7
+E1xlV+ZRHu98/odFYdCsfwzceAJAYK1hzm9wG++xYNC1CBVBlo2d6wliUV7DtUThY3W+esCAuAdqWi8pmAicclGuEYVzUiVIpVhFYdNnh1wAgy8Zy5XpvG1z
+DucFY1SKkqS1gtubtdAol6+hya3lL/tINnNVUAUEAn4i9Dv2xbpUGxHtKQ5HRpx7nuUW+4FPwkewUCSlSwJxmdEjb6aMdEWObqFztbXonFGUQIhm1jtPJAq9
+WYOlOVbqzzCO+F2hKIu6/sJhHQ89Vt81pVnrsiDh0Ucu5iisceMfY+36o7Nj/ee9yZsf9Z/x61Zq4H5UpH0QyWYm8/u9V6xOZrVsexq7HhmTuM5PWrm5009b
+3ypWQWf/o6ffeBGh/Y/S+szj4I6oYP91Oxz65csLHuzW+OIY8S13zqx3FXmObGPh5Sx21g++/NR85hwIxyejU2bdM5VsI5aSOmwpLcwXSsA3hv1YQWHFS9Wj
+idNT7Kkp8sU/qo2GDV212raZdsboPz+07dZ9/9VXj4+P4/t6N7bN/VeCuPjqR6yUcmj1JzQftiZKCOIbyElIGtaBqcyqbWxdrgilkG9Nozd5WYXrbBu7iAxq
+ruIISObPK5bfyGEkGmEZRugtdQm5AMqWDgzHvJoxTevGFuZ7pf7M7/xR/5aNRZy+eBFN3i6uL26X04tPsYfxA04gz51u91uj/w+U7H58IWu2uznDwZ83Rj+a
+amU3HB9M9yqdvBxbiHz6H+L3rF7ELeD4zsN+a9sHg5k6LzznG4bv99/mlSY643HFr0q4Rg9EHPX1Gq0Dn1AOZ55/8warhu9M8DJ/4Cv3/e0sEOoypT+2Z4de
+vx7ld7A17+wvo3RnIKLz3vDBYTbbyu4BRiD6/V4TjZuzMc2xto124GxWmSKVLUw4ufK+JsorWSDB7BqF5Llc28gtItIPgHlSlMlO9wjJ87KKYAg5wg/YxY00
+2b90Qy6f3vY+8kKospgcq75PJkcRTXWQFpZfOMkfNPFZmwMYq7G7tsRLjtnHOEajpERSly0dZXTkQqe5IRBHpsLm8ELO0rQcCCRTT/HDOahEu+dR0v+PnNMv
+bJao2l8Y50zTGRUVosquNXnRT5QAkQyWsQA+1+TOIGZleBokV+X0V48P+5e1bV9W99tq/NBuqh/H6l/++PMP+vP+5uLl6/Grl7Z5iRbBuP2l/Xu/49WrV6++
+efMG//vq1av0vyevXp+++UZ+Rj8/OX1z8u2/6Fe/xwDsXJs3//Lq1d+jk3Hn/lnm/+pWQhf65vbtxexMX8zOpleLqVI/iVGZ6dPv9F92tcFMdWxD/4//jj86
+GB7O9KxejcOeX7s17vcflZqKd5RICgkjKWrZhet6UL6k9OBmFQqRwQ5F14qlllOxobFSN43JN+DwPXfcUy13Y0yGTarMOsWzicERCdqlyuZ8oyQFYehjxd/0
+yUPEZHqy8xxN1iDH1+Y/G5VzmrTxkv+tJRnA2KMUVIgb67d7zorDIdwxalW/u2UdIEf3uxwBC+b5F0LMIqXE47vt5cuA+mzQ5hJNssRi89TQZctk8GP1kcm+
+Di+s+IZ60vgnAmfohLzwB2iKpIDQ0hFcDNU4U9UMi2uQOQzl1ntooBKmAyw/WpGCTbXnAEN8syPcxgqhziMmOPOfMa+U2quPBLEjsWCJ09N4Z1xODZWV+nrX
+HMh+9tdLGPUU3YcuNj1btTbeYmFnpZNzxDMtZSAYThAzsHQPrAGtIs6FhNoqLY4p18HUKNsstA4+Q+tJJQzhzNqxLQ3L7sBDaoz0JKKfAuTlxwFOE/6i4LmF
+lQALpB85wsJ6N3FJKE5FbUTmFfkQvSheYze6MPWebT1+ougaNDp3P/tfWe08BCbg2ZJCQD8jktz0FWxMdax4qAanKB4igtqtdURpVLbf95+HLhCLZYVVAE+j
+Lo7VIDRk8P0C+hajXQA7RKStbaN8yC8mYcgdFTZ1qElcqJPlVkVSiOKeM7gPntNZ3O2DQU/DZnGBqyy6jnQqlLeSKBg+SvyyTRyC0w4buKdVxMJ5NIVKnXsJ
+ai88GvsaQ+uK7e9Hq11rtu57IgF2zjRtVEhq6860ipo7VTHQmkt1Y2TBYby5g/InPHoadExiUzzf8M4gq/0iZnN5EYlC8f1xc0Fc2whQ3VZM8fi0vHzXQ6dK
+FgT37FzvncphFYe8jdEgTh+gMAqMRbnjA6TIaAKpVWWjPVoBg1ZM6CxETqbQpmmsQLNaG5P3+GLDz0HmamE3OGIYg+2eu3BCUK8imnghk+Wy60Ee2Y4sgkg2
+Qhy7CpR2epPXu3W+aneNoSXORb8+FAIXJNIPVnAp1Rijb7E8wMf785K0yYOcIk9DdDFEtkSYNrBmSEYby4q3eduaJmgL5newF5h+lZUd8obCsB56SazSIl/g
+PMTaA4S2cCxgloOCwmTD4TW+qzmpf1elVdWPhs7SMBMwHF2UyM0Fl30jZFlR1IFLYWilIuUetxR9ZOyXXz55U5KWcVTLW9aM0CssmjbYGPh9oamULS5JKF2E
+EvP0MfxNeBSBInoLA2MYCSO8nDKdk5ytJ/WuZH4PzEHEsYb2ATMfNWn+YkeqPdXN8eLjMoGxXrR5a5ziiJC3ouUDFFOjklU5QWDlxfy5/qG27gl6w9LctSiT
+iEJWQizFqk84kGBg4Jb8bMsiQYkVsD4JbaukQWTSMa9RbB0wrlCCn3GOI4qZjPXSKrn2KcAk6yZ3rGMZwL1OIKBC4YjDEZUp45sVoN2hKewM8OqO8IwRmGgd
+yDuzVDoGPhjXCSoKco3VgUSa1vrVWJ/72kDWjBzFwmMxD3ovf3DYqgb20LOQz2Ehvbx2AYzyEuLIJFAa6JK8wHDHS8qUFKw5sylhNHarFu6p3KELNVqGlGLc
+ZNa/pTcG5f4OTDJF5yE+jT9BDh5h2uj2GO3tbjTWI/64garWutCjYKeMhHcpOsGUbXRako/m5Yju2ZHAN30VHLSZ2VEbnRf5liUIm57WvC5rlet17h4CO3mq
+vxVu/CzlvzBeu5gUbX/JV2Rq0CGeQjtjOTBud3TFjqRJcOuX7B6pUIw8krqFUe9TUg8d0J4jHgdOW6UC4QOVznFNhAr4KxhfX5PTH+LCBlkD0VKjci3JRUUD
+px7D2UD2LhPe2UY7A+uRCgyq0hf9Jdj5HkVsmJ4qf8yE6IpI5DkoYal+i86/QD9SYh081NcJH+hY3XA3iSSdq3qHD4kj7KttfC/jE+M4U7wkDlASZ1I+gUVT
+VBuG1j7OFBegwA9yp0AAgqeBAMOdOYC9Cbucb37fATwNDNIiuEhvjb0TnALbeCOe3I2xvjQi35QnNWZw2UWYXhlPKZmk2da19UwdQS0yk7hQTDY3qZ+QOxTa
+TqdHB6k9RwJ5jhmFyzbMW07vq0u5Jr3OZ7XXYBbgCjMkIIhXG3gK/s3PyOUGj6E1VeWEd7N51kDn4+aIl+oALTJ/oSSL16uzs+/vj1QXy9/55w7REHLmBqYd
+MDAHdHE9+DVMA0OWnc51VbpWjB6fDFNW8twu8wKKud6YGmuX/IDrsjUbyWzjkzbGoCdaOr1qytY0ARg5lhrZM1vITT6KnMpRTPHOxxBd9qQT4CVr/ZgQQS/s
+R9XTwC3bsR5dB178UbSrwJxwXlBtQys6nLWLNofzqtAzGbDw5WgQaR/SGVw6Vtsq0a5y/ASFxAIiqdKYlb2vkRtYPuD0nS2wdNML865ysvr8i1zg1uWAQCre
+gplD5PvN6/sdVhTa2vhau8eyMKJtk29sfS9mpmkcyu/7aqm89Y+Q6UnEfErYnHIXwkEcDAbP9883RnyTKj9fsVQtvg7L3FyHXNywII+fG5J8JXKny/zfbaPP
+7GZrax+qjpR82sgAyNv+x5lUT2EluPMk43ScxqXCoXRk+EHEl+eJ6lWu+8smIq7uk9onolXJJaIGeO3HeqJHnUaMMl/lhgQLv7SZrFO9wY96nge1ki/po59N
+U5sKDva6sI/si9LIOKsB2iKetJT8sSJBfc8fVkeUmT5OSVS6i6LZ1ULoSYWCpvFGvkhUh+pL23RlTkqBIUbfa3ZckovLc6gEPzlGhuRCeYi8iiJ8CXXdIs+Q
+3KzWZBJ24JWzplZ2enqMzeqoxEkxUIcgPdOBrsetmnIrWnREfUXeWzAUxhHP5UBxcSSW392qOKJdd7G1tnLRL2KWhDQUHGR1aewjC5PkWVi+UXVbHO1I4RXr
+2uQdSqVBWil/3UfGg/fEiCxW587ZVZm3QWAnENHCXKu0trcTxKT800PemILxAiXLaRT7Ot9woTTAAkwB/Gl+ZDw7r9j9SWV1HMjiuFu4Q+/2qqxbxJzqIm/z
+jlZH4PjR68o+6jvTPhojPn3chpAuS/AvfIktD9FTeDG27oHtw7CNY0yebAGVVtST09V/89Dr6OJfPilm6vdp98TTpchn5BvfMa316Vi/zV250jeJ4AIwZg8R
+anXqQRvjfy1mGxJP23WwBFVXZx8jq43RZdOYz5ardgNXs2cQ7hTkbkzLMUV5fUwBi4xjzPm6qxEpzdSoEakjHyth83n/DYeVAWg4J82urpkDLCnVPygEq0RO
+tPVPyhiALRw9eNNhhKtsdy3b3imxY/S8fOWJPVwSuYd0WF4yc7zUUQeJSozK+fG8iyISioqihZCAqIlgUFKKobhNUdKDa5OFHoMdurjwzNlY9jcUI0W8RiaE
+FS0saZ3qU6vOu61PdfHqchYWCx2/MBAPudBmbLA/iX/plOd44mp8ZAuwjQwPJwzbh0jOEz8ns08nBceE+prXyOO4D1Hs4VLUWJ0RL0ohXlWpEkcYYDyy/LTB
+uqAg5M75GErcRj9lioaNe8oy+BbmJR4Iy5zNd+Yhr9ZCCoE/8mqlisOA0BIwgOMibIlW06jjbuFWcfyLE3LMNcO9MIVX6IDtIcmEstGNYc6Dh3JLY7m3O1RV
+SknjQzZ8VTar3cZhJZ1LwRpM+5pQdfH6VHyu3BkIU+qFkI3BG1JIxg8RGS4uK0fgzAACxLPz9VjfUMwbHnFLCQvyvue0Vd/B4Ezqtnx5hk2GMC489gI34pXt
+lOgLqUJhzMYU/qLP6xAe1q1ZPdS2svcsM52jdmsYoRD1IYmJXbUuiUCuX9yPjk9l9MmJ3DsfZzfXUbSobUze7nVe2G1LQa/TV6BxYbCo8+S7777B7cTFvBRe
+3QaJEGUjBsn6Xq+SQfBKH9SHQzo/XfpZrCfBrlJJy9Y+EmB/bZu7skhfgrw0QyPmOiEFbEnavtLxsOMxqpgfBvpxmJidiTY5pW0HSstzTvUK4BR7giiXlq8p
+vLyCbpmPxXX4+OBxigMJOeVlPMNslnCTsUmL5ggjdm0TcbGUxr1QNJjcswOjqQ+OJm2IN2MdNu1Pgo9KKKLDWT8MoPJ2wguX2DCpgoYqW6LlqvestN6Ve+BF
+5LblakdZVDQ7Qqiq8mXvytZUMcIorycDWj/on43Z6pJw8HkV9HhYqkOxPTtAm9ERH+F8S8GueiIpzqfwt10igOKJBvD45XdO6Bzhpb4yBo90UnhKqJsOxLm6
+it0h0u2nUfjk9qxabxtdW/473EFhUOMpQeuB8fCoN0ZYApEttI1vcpTqj8q1cKV9Ha+0S3Gl2BL+KSTGO0tuIIQf7FI2MXqxL3GuyzYw8vovRTIXQWufluwA
+yZ7M65uh5cppK0OzSrPp4guMhKo8lSpe76u8afZRCLGzGsPTg79JcRvFWI08KI8UeQvDe/eb3kE5cQ9R69Pv+m0Q2/VFIZ/1V+63bAfHapnceKc6dHvo6fD3
+3ujW6tGBzQGlBCsWU4MueUK2oF3Hx3MUVBs43ynJZ2uop7NcK1LWPXpn2lKpQU9Ij1YQArSzM9pmRArVub79gPQUX7xMIDY0vhBI50KAi+hSZnpQ3ZUkBSkY
+aIpOU++RNLu2HQcqHjVPFxgwivme0+ZJaAVp+sDzJeBCeCCDuRAlEYnDRCJJYxTtmq1TjdXDCRGX0ZlDhi+lKGLVM5WkRn4QElPPo827uveW8AJBAqDawsAb
+0uQLX7bYch8rQDcFTWchx5/EzF8DbOmUQ8MxlnHixFhhtqYukB6OfJQ0UkSlemWja0rfoK2ToDh6tkmINSlyRoSRh3OWEnohC2Gjc13BLdBExTLEMFLrXH+2
+1Q5rrVSuXWub/B4viSRDKNd3lPCFGqD7+8ZQJtVLmvghwjVOKlOSMw7XtPRdQpxkTcETGPxkGx3bOsr2ni+2kr4ze4tDwhGqkGKn8Wa/A0q8o1rUATGoWvsO
+ha0h3Ked44UvfDzqBiJD/kF0EX4TX4RXtn7Jd+A7S3W7vQuw27ZeQPfwteXUG1wiXx+8vaI02yZfPZS1edmYvMDjbDAu+SwRbVkTGaPchqD6R/fgWXhXGubG
+m9xs7mxB4VXMsT3sHRqsIiwfKyiF3w6szOMsKiv31a/DEThQ1Fa48otdQ3EteTI9TK92rrVMD4grNEHt4zlDMDe6h//L+ph7jS60zjLWnl2j2qQvo26M0XuT
+s96e/4jOXRwIEgNvSxdOw2SHOBKmUWL4rT1RITd/YwtIrEhxdaRRyJetcd2RUYFauFM6ezhk6jMXfhKIIoxbIYm/A2G/TH/pfKsn55ulENl6ri2V1SF0hil4
+vXjDissH5F3VPkog+AQWHwneJg3EyR4Dyqb5AZ5p1qHqkD3nLTSOTH+yqXiNRmdj6s8lUvuEkognhtcZ86JHOuYHw+BkquRVa5o6p/vZSeSGqPvsapWLnhjL
+J9WQGjDNqiQMYl7TR8UI8UZHd0GRO8YE4bwXus7a7k5MtW/uyGQ5sG/v9kHggaeBR5nSHCTnhUK/RwRbV16un4acBblpsELMN5rUQ8MmOoMU/8/3HqXif0gv
+pgn2FIo00Wg4iwWj2ABPwPJhNQ2+vetNRpk9D6ig98dPSs4811uM2WEZFcbE0d70PFf0NX1EsRXP7kpJ1Yib9pjgKXwyuXigGeYUBYyjy5DKY0vibEVMeoio
+OlWbX3xMKO6Zy/fDmozDq3/urXklXBLcrwfrWnfwmxkvdGigJ9IsKF2PMcTIjesQyofjPOR0hRCTa04igsQxKuEe2ALPaG91BpMqrCU1Z0zjIlqO+LGwKZ5l
+ywej9s7Qqbg2rE8Gv+X0rXC2xWecOKfRHi/QZCdLHM/8IMDSaRZAyDHVGEUFSqHJNUUgdx3eMk7naR56nwU34i5VkU853ZMz1yNxIEKtb+heQ6WH5LYE2Jzb
+bciUx48IAj5Afdq8vkdmmq1pHKmQN7CZ2n2MGmk2ePD6+04+nOl1vikr4lZ/sDtnHmxVKNEPCTeUZFF98hcvzapgpKMvcbe1EIgWj6SSMgbZi8IQ0QTvKuxj
+Lr0S+Zu0r5ku7O6uXe8qVmQn2qM7xKXa6jMN8jr/TOSJwjZOqfcuhAhf42+Uu33yATADmkyPkmFKQMS63W/RhmAVClsHJE2O4iLORfULmUr9e8mg7lxCM0+t
+g5czASRuixwu7gA6Ud2PksQAtZImyPwCsW3HXOr0L1P4mgnGmUPDxmoifx0e9E5gQqYqegR64lFxgwq3OFzDxc61onEOwVX/Amotc0fh7Q0/0V6uHPuK9z8s
+MAz3UZSJ9QEDQ790RY1mBF2hZTcL1A+CkIl3V7RlNqZ9sAXdEitT7BrQ9haSR3rYz2bvIn2rmFYiVlKUkp28LhgyY4brdvpOHi6cpIFsd3SR1eCrl607aHil
+pBdUrrYDnJ4JF4lHdCjh3y30elfj6ckmaYizmiI5rIjNvra4C6XeEZwqifJQtwiegpm+O0N+dJIigWWDMpqbHMGRHVWWundADsmAspcFL8O/xLgUJdLt5HrF
+QxvQMZEVTgVIuQs5vViHQsV7kLWR7FrcHCm2iJZlMpWlC7p/plDdSkYGGG1NuyuJPo1j6eCtElzjaDBCqKIWwkvgQsxXrQFyUoLZHri4PHl+GFQlg4pL5s4M
++dSHNhjRdrSdmHCQ4uRi57Wu+T6Dqa5Zajay6nRjWi4ONDqSpOd9pQYXpK37A360jvFWSWwSFx0/0BQwr/Pry2PGEEWtjx2dQ/3u49PyZAAY5yYE1/I48afB
+KEQEtqRTcBHvtkXeMsohF0m2vFVhx/hRaEJHZIoi5X5eR/3VWHMQsmz10w8lfVnHl56Y9my5FwaWhnoUkZJke5ctyvwInkCSewUcYobQQHhHEXUy51El0Ccv
+sg2LCosk2a5iwNq2sa1d2Uqqn2JMWY6SgCp6ECIVntgFdB4cnGSxdyO3UvVU4/22gc9o+rKPTHiFJmGcQFntumDJkB5U9gBOdgg1xu4mvp2XnqqtL3iD4ulH
+2+C0/mwIYrOrIaeAOWjUgxIB/S1XNGgNGZ5IW37JDIOj6GcRITHJ+EXwE1jfFW+iQ3HDILEYqckz2C6VdmFsVsElaXq4EcrLvsQ6+TZOH3muaQFxIHaCqrhC
+sexePVLlSAzRTmQg+oUOPllDAbZecQ+gusCHzgebzsejQLNj8GikeZATrFTx7kPTPcrJyEGOxajh2YEovzMIjPNS92CH1P2omS8HootHej3cgwO4EAohDSFE
+oBMpvyA0yFIMUR0YplSNnM63xm5YypMGrWzH+iisEBV/n0dOwqYBrsopGPvIrcgr9NeYYoG8DTjmVESW4MGnxx4gx9GZ4cbb2h+GGSVVFYc2WtsbohR1huk3
+4SbAOOsQ+iHq6m8VnKDyEmqksJGrgebJDCIqXsjQ/Q3UZRd34hd5dNnxF50QHIqCX1E6QVjG0WmMuGa9jE2gmfUBJQHwxm9Rrkfu2333D9o2GN6f+/pJ9Eua
+zz4D6GtU4sAvpa083KPh4VFCG4BvFWvfDzVeudjEg3lKyWTSsqHUFksPUXUgtXh1rG8CuEtvSucdqgSZRMraMjHRyrRNKBlVB8gTPCpM2h/YFMo6Ho1HLD9z
+YTZUX9qbn0rNL46JB1xAkzum46HrDyY2VjKt8w39xcuDhxH3SUNpJL3AHAM2rqJBay2rqKVgsSYvDHSHwHnsRNg1VTjib+nNmfIfJd+QzTkcC0fvW8crqKwL
+s6kTlFhoeURm0ZsWwLZFuRU6YV3SPX1km+GpKttj0dKq24aiBSrQM7uUqLrrmmSiVxl/hq88Zw4+05fDsmK0FUj/YIcZzU7nUweC1QVW4DUr5Iam0KMhHeyR
+ikTowNGXPIvHjPYEMlL0GmFwqOcq3C2Z7DmMdOPOHILYHLpTVRRiz7E73kLMh/S8w0nLlyONvGk2Y/CXg2K+mJVx8waeh7YAji1m5j22VtlYiWmo8NLf2UXR
+v6/CIpSOizxJdK7nba+13Rw1ETn3Wh3cSLdrPsOawNMnar+O2x9iCdhYIQIfSPlHCaLYko+ph+BGzyQv41FYcdlNpvOgIOIRAN1FGwgNlCfw4K/bJqylsi7w
+cBjMNcR2LRvkkx7qKNo5truXMrGAGGUdAeMIFBGZk2IkVREjNH8td5HF/gO76vZzmmFgOnp29x9zxmP/aYzOBEsldcTtQ31DIBzqTBu9mJqAIsVV4NZXQ1L2
+k3qv87Y1m20bFTm0dvBltHs/27IgkBbpPCXVQMyP3Jqk3qP/5iSzH6TmPatft2QnEl4k7efkuDqB+MSHCAqFhrbJHSFFyUkeNOlE1jeUemBGI4mHdw02Qgli
+EIAc1eNgNFJuVij/axj0tqwG7b6k+KcuYCGnQ5jWoNCQozxj0NrJArao8/A1cTtb3DprThLSZ/1wKBF2j2wSCvuimiJ8+5tXukArBaXi8U3GOVmdl7YxFse8
+N4T61wxh1CN1qEPYj9K4wZ7oQz3JlJcVeDBEnEsCX5EyL99ofMbY9eH1oo7ktqcqz9Tjipsb+LJXO072had6rcrXyeiKULEpt/6kpEZB9C0cC74ypb+7ZEf4
+KyHsxzYizUTsRSRTmg6EgB/8C0SmdJD9ZZY2Bh+FETP/6oIBDG08z9H0Z1w7hHC9/7bLK/QfrTRI1+Yxpfv0+X5/sab425NXdJp+R/E51qu6si0bm5zU+0CV
+Vwm4X7B3cdaC6LF6FVGonhm3k4nIyLNXaYQCTtpVWVXMWu25O/q5Dgy0NyR6i+kBFJ+KhSgxv/NkDjpuFTUHSejhbA/WAncxkvQKylnwAcxfJvw3MbwWpW/R
+4e/K4A9cUh0FxjGrkOQrcfiI+SUq2xGaRDy/hmYgYSq72/Or2NjxIwy3y4BzznaEERngWNOsi2YUiKvWaBZP5Lrjz7DlfB4k0OdBAp21+/yBc6AmKE12pBcq
+TyOsgeR4TZxE7zVkXCSahaGP6yKJdQTeCfXL/+4rfOJhTXcBs10yjMJX51DdYAljRrZsVIgTWYyB3GpS65GpW3SPQk5mRKZ99JOQB6K3UCEhVZDFfFCZsk2H
+Mw4Ti84ZApramo2y3R2iuijIkz4Dl6hp7snEj8mmwPt5epsScFfQT7Xudy5TiOT2athkw4ff48kr09uFlMErEI/q5//xIW9hb4ajsLXhs5IkR92+xhRY5kdx
+E8w6Gtfqh7wgT2BXYe1EjF31lIzewAJ++R20izZkr0DgIHAp7oJfqgfa5O2X+PcPuVNl26G45bI0f7ub9do2reuYyOxOw4Ez5PcGHXAvqR4xySoxOm3D1djD
+hGcpWcF+4PVeJBqZWlnYN8qPR8S/cVOGytVVn+EneWNdthgNq8qWSyMj01rnGpM9LzEuSG7sDoYe/02pGKh43EEQJK8qc+899cgo5w+Hc7oIyYmMrqJMO0Kv
+ZAEeCL1zeUXbEEamaTuCwdo2ZPwH+FHZ8lF7MtY3TJnIfGc1hQhtMxIsRsdChP0UaU/unBmYvc69HLGiJdQlN4HbEUutxqGgCIuYeYILFUD/nC+XVr5wSaM9
+8RtXSySf87QsyZBz4iivOj/W9pERRXw+ViGiED048AfnFZFm5is2amCTmcaQ2Sk/zeR6gOPhzoTZ9sb1Jq9rMA5CrXIfSrzuLAw8p2lteBaBzpBQDoavekkU
+c1dDk/SdUaFJmAzqSXTbtd/2Q/Wm0bu9RhNu5jhqiv0JzCIZz6KtRoGCxJ8ZurV0asgMOSnJNiTvw2OGrSwdfsQjSPuSzKVT4cqcUu1hGC58Efk/OYYufEl8
+BiuzKh7LIhw3L4m9BZvlz6OkqDxagQcWYCak5xlBo2AiM8Y5h+1NezvQ7hNPwhNGiBEuCXREDqwKiFPVHY02wuv7KwqDS6M0dECHQ72XAEhQt1ecCS9bCq5x
+6ZQuTG3ZUcnIZ7Js6Bh0YvHpR570rJYnq67hy6S3/jv4vs+mzqngkAjMOWBPn4gpD4/HyPyHkzzyVOBJ3yhORwaFp2Vk2u7VA9XoDHb2YLfiGmt8bh9p1LFV
+gdGjrO/RMq3I3q57DfUAIvUsolVoC1K4LgXzPd82LGVYKr5et3i+7CZQGHp0gX+J6pQE+IsZ8/3VnsHoZQgj+EARBT4D7FRJDdQBJBHpIzobXh5Aok1DdNBW
+Fwb4KQ34Uw0CQ3iI7kxt1qWPBnSWgycCjyItwp2ljl77N2S/6SjyQ6lismUzsCaL4PwEQP1Yj/7aXSsjRcvWB18oNaI9TQwTisJ94CWsOiuLOTlirHAvcs1E
+m2RySViF2kWVcUPFgyr9Jl06skMT0EVZmLot10RdKrzFkrXkB6hUIYIsV1oNFcpdejgEbrkM7iC3Y60o2wypiuYabtTKJE6EbWSW8VRTcYl65Alj5ca2saud
+OFafzZ5d3qy3ybHOvsmRxa4/3WgLxMBc49hN6ZC5sBvDJhnP1sYDbX3b/EXh8xQ6l/zxKvGLkmaqVqBVnTbCGGDz8S5I3GlaxxzXSUgE1MArqJwUk8m+4B4t
+1En3laXTo6J0q6bEq8Q2eyz7HKJoi1JvbmW3JtyBhMjOPP+I6zorGWOWPdwncACQPUCfVOT/xiCh4OVoDwRKWMoOc2SNEw/rQBXm2msD5sHjzet4bTKYK8Ez
+SnqPx+MObEaGcIYiv/re15tJRTsa6gQ3getvm+8FkpikCtp9SpTAmCSJnzJHHawuFfJ7KUdc9L7es8kgy7zOzToA0DmwSoeIhOJ6y0sCq7AD09XTNdyQGLN/
+JKR1asmzPYSVYDTqyDby8U1e+CiSdUIJfEwXEyQYmpILPOkyLtJXc0WZ7E8vQmCbuATZyXFItUS93ctJEpxEg+GAgkoheH2GM037ssp0zz3m3lfOQkz99E/6
+Mm9WDyihRUifB89oGlZ1QLnBfdg2O5+5Y9c5Bs7A7LK+qp8RbzMUZu3jMbFBJxiFfWQV35nICCFlox4aznczf2Rf+HSsr6xeePEbu9bX8Ej3ApWcCrshs61D
+N0fRiILZsvSRuINIqLZrWopgZ8Fo9H2Cth4rP39NXpSsihC9YiiXthc3zvyy2vFJ7EuEDn9X9BuF/H/4kHFW50hpLYVbrtzsqjYXkRHc032KqEEGD+wmWPAt
+EYJEX2Nbr+dc7vunYNnqHEk6xoMiPziweCiFJDe/yAi0bQXu+6rVufhtiMv3xYv+VIq2Kyo7c0YmKlePsJCGikhZ2Ix1GmTUUCKGn+T1ElJgGfvblEZYN3lZ
+K4JHClwsPS0DsQ6s1tdjgFMH2xJEDybgMtrD2ge/CY7njavEEVNA0Mln0R3JYu8HyCjyQWGDrmKDerbxUr7Fpllgm/A3SCuIJKZ+iUUCKKx0CN5XVQk8PiZS
+wlsmVKz1T1eBtQrQuW/hxzNzQAEtCym114jtWZmG8HQRb3xwtNirUtjyDu0TDAuDt6meiVfLGygH/VzCFPyU6LMk6YPlE6J1hC0lIizd8LNYlqk2j7oj+6Ke
+krbDTVaSsjH8Fzj2Vg/pY0SjmynjkJx5WzZlG6TjqUIrCW9BIwnnp8ualY9xUkkrA16hvCSObWSwfQ6JH4bwKaTkRIsBFtKudHjBySfqHVDb9QBcgscUJLjH
+79LnkwKq5yQAR4z8QnHTIGkh4n+tRSxYIo/HKGa7TsI50REU03Cyey3AqH4LG+EGS1oQJN7VkMR7tGZ6oxMwVDhMe53LQxW9/flRCamA1YOVLIS0DCJN6mkF
++rh5SnXa52ePzSM4F3/Zk1aVWZWF4bOApI7iNa+emUt4BOPlM89e8QvmkeiDHr2FIKgkV5qHkY8y+1EMXi45GhB8ugyr+Pfh8rjAuRT7yncBRhSWvGDWBNFl
+m6guPgbb+yy2qm3yhcg06NhHeS3NllKPJLOAKXg6uQMtKPRJdCVxSWMyJVqOfMJ9PfbIbFpGHxmbDefah+l8qmeLWJ1dgzT/8sNU38yv388nl5leXuO/p39b
+Tq+W+mY6v5wtl9Nz/faTntzcXMzOJm8vpupi8nGsp387m94s9ccP0yt9DU//OFtM9WI5gc/PrvTH+Ww5u3qPzzu7vvk0n73/sNQfri/Op3MUPPrqeq7wi/pm
+Ml/Opgtoxk+z82ncJD2aLPRsMdIfZ8sP17fL0Pbrd3py9Un/dXZ1nunpDB6kpn+7mU8Xi+m5vp7r2eXNxWx6nunZ1dnF7fns6n2m394u9dX1Ul/MLmfQzuV1
+hm/jz8rTZ9OFun6nL6fzsw+Tq+Xk7exitvwEjdbvZsur6WKBQzehlp/dXkzm+uZ2fnO9mI5pAK+Ws/lUz2eLv+rJQvGw/uvtxD/nZjp/dz2/nFydTaErcZdn
+C+yt/nR9O9aLD9e3F+fx7xUM01SfT99Nz5azn6YZfFBPFovbyymP9mKJw3Nxoa+mZ9PFYjL/pBfT+U+zMxyF+fRmMpvr67k6u57P4SnXV7yEvhkT7ttnMy4E
+bAwnxRWsnulPsDZury5gGObTf72dzbsrRF9MPsIUTN7PpzjK8YL4OLu4UDB13VWR4VeuPumwKj7pjx+u9eX1+ezdbCqrBpSyfpp+WiSDMllEy3Xy9hrG5e1U
+X8ywPctrHCSYtPPJ5eT9dBEtC3wniyhnenEzPZtNLjI1uzqbnU+vlpMLTe9cTP/1FiZ2ciEP0ZP5bAFPgJXJs3i7mOLqu5JVs7xW8LN4ho/Cu/srUl9cLxbw
+tPPJcqKxxcuJfjuFT8+nV+fT+fRcza4mZ2e388kSXwbfmC704naxnMyuaDagv7i9Z/Nzv8Nw0b6bzC5u57LslDRqea2vb6b4SFx+0UzQJxbHGU6+nr3Ti9uz
+Dzxt8FD8nKIZ+zBZ6LfT6ZWenP80w73Iy/t6sZjxmFzzE3gcefF9O9YzEV7wC3DRKxEJd1aRnHa+EgU+ViWrOEDmPdCZkLLkBkISkmydyq7yiotHiNCW0cl8
+/lJ9EkN8wQQ0jxTl3DWtECGQPcpPyh9F+dK1elVZqrmE4pJfkIufVIHunK12rSG+XuxMDiZ5+bmsVGj7QFgmcccEDJrU5IRiAJUMRCgq7kJCfEa61o1pd01M
+KKqnVzChB2TrPthH3Vqonan2jLxaCuz7k901+so8yuOdz/+wKAya9Y+BG08ACKw1zPkNbuM9Fgy6FqEiyLKxcz1BLMpruJYofKzOVw8YEPdATeslBROBUy7K
+NaJwTqoEqRSrKGz67JALYPAlY7kynbdtzuG8YIxKUZK0VnB7MwxQunwNTW4tf9lHspmrgiogEPATod+xL7DEYm1EtKc4HBlx7nmWW+wHPgkfwUKRlC4JxGVG
+j7yZMtIVObqFztXWonNGUQIhmlnvPJEo9GYNluZYqT/DOOJ3haIs6voLh3U89Fh915RmrcuChEcfuZgDzObxj7F2/dHZsf7z3uTNj/rP+HUrNXA/KtI+iGQz
+k/n93itWJ7Natj2NXY+MSVznJ63c3OmnrW8Vq6Cz/9HTb7yI0P5HaX3mcXBHVLD/uh0O/fLlBQ92a3xxjPiWO2fWu4o8R7ax8HIWO+sHX35qPnMOhOOT0Smz
+7plKthFLSR22lBbmCyXgG8N+rKCw4qXq0cTpKfbUFPniH9VGw4auWm1biEUY/eeHtt2677/66vHxcXxf78a2uf9KEBdf/YiVUg6t/oTmw9ZECUF8AzkJScM6
+MJVZtY2tyxWhFPKtafQmL6twnW1jF5FBzVUcAcn8ecXyGzmMRCMswwi9pS4hF0DZ0oHhmFczpmnd2MJ8r9Sf+Z0/6t+ysYjTFy+iydvF9cXtcnrxKfYwfsAJ
+5LnT7X5r9P+Bkt2PL2TNdjdnOPjzxuhHU63shuOD6V6lk5djC5FP/0P8ntWLuAUc33nYb237YDBT54XnfMPw/f7bvNJEZzyu+FUJ1+iBiKO+XqN14BPK4czz
+b95g1fCdCV7mD3zlvr+dBUJdpvTH9uzQ69ej/A625p39ZZTuDER03hs+OMxmW9k9wAhEv99ronFzNqY51rbRDpzNKlOksoUJJ1fe10R5JQskmF2jkDyXaxu5
+RUT6ATBPijLZ6R4heV5WEQwhR/gBu7iRJvuXbsjl09veR14IVRaTY9X3yeQooqkO0sLyCyf5gyY+a3MAYzV215Z4yTH7GMdolJRI6rKlo4yOXOg0NwTiyFTY
+HF7IWZqWA4Fk6il+OAeVaPc8Svr/kXP6hc0SVfsL45xpOqOiQlTZtSYv+okSIJLBMhbA52KFUwOfG5wGyVU5/dXjw/5lbduX1f22Gj+0m+rHsfqXP/78g/68
+v7l4+Xr86iWskJfvz85e+oLAcftL+/d5x6tXr1598+YN/vfVq1fd/37zdfgd/fzkzenrb/5Fv/o9BmAHN9y/vHr19+hk3Ll/kj//3//X/7usnWlaOCf159ca
+tBgRcqvfn53p+e3VcnYJYZC3cwgCUdBudn2l1E9ico5PMv36JKSyv1M+RxMd2t9r2PeHTt/71SpaebDru5Y6PPhgFDrTs3o1pqPl+6++Wrs1Pv5HpabigyXC
+RcJ7iop5wSgYFEkpPYRahXJnsHbRgWNB51TSSEwTHMFdjWkaJpXUU+mlPhr5v4+OWYZ1kHJEdTQbviTAHxyCo9H7m4vPr0fH4CCqKPGRc8gCfbcj9NM6jR0R
+N6u+I9ppsRKxljrCcXdC0Nw6fGxSUu9/2imsx/aphLS2dGGgYkoauJZgVFvLAA4TTJAMf0P865RPZbQ+TqTYefCZB5NDQ1mJsS50w/0OWoo+OMKvKYKRjzL+
+LEpp153GclgAF0JXaQCAT7DLoqqH6MY+VkGlkWFnAgRBsQf0wJ5vuKiT3e07LaMY2auxPveIcSpYkCkBc3AWqRxc2mJXGQGHsaPJyVnK43aWi+IqkRXRnTNm
+X59Fo3DTWDDtqJ4Jtb4Y4pjIE/s68Lv90Hto+/GWixArMX6q8xWgvHp/dubFkIcyiGci9HpGQAc8V56SVHdZwrkUbTm0vQOtTJJrOT68fb1NdfT+5uI4rMGQ
+kaRqyrzeE0ku4D3akH+K4wYYc3i3eIc9v7l4icG6FnHCcoAiAF3cJmbPTZEvUWFZlnRdCUcieRNsh8a5c2w8bLYeP4MJWoVr+AS0cJk396ZFee2YnjUWiSRw
+BE8ReTeNoeTa57JBcG6LT1FbWmTwkWb1ULZm1e4a2k6R+i2pCDXa7Ur/A13W8D4GBDpnNncVZOcqmyP+CBVVG4nyhKW+fcjB4u1iQwgiHPUNXQgVQyyLvM1F
+2aVDNUey1y6WIMYtsjFFmbdGp3xAWL4SWIAR6Saams9/O6gS9/bqiAsdbbNxRFbnATb+GRQjgvMtfocSSW4XyIWhcQ+7TV6/FF4K4m7mIS1r/Zf8c65/4hm9
+JGELdbdvDX8SXatoTOFA3rlMJzyRnugnUOyxKKxtnKIqD+PXiUuYniNKmpjAbegYU66sV+bQr33CGq5v11qL04kRlTLhZ8dVLqje0E5CRUfNxON66EWA6Z1W
+JdJFy4FdOl3YWkjA35+dkfyRkdOMib47Z0OIotim8yA5AsMZFPS9lK1xK6cFh/RJvvaGTiDc49u23JT/QSCE92dn6oll6vi8icvvqdJFeh8Pj+Lh8SL+75uc
+MLUR28pNzLX2aRCnFUHTpQBvnexq2Bsi/wonoKD9u7Yfznj/inUZxUZLZuOKTl3qrwqXHLNopuUkaEB1OXjyqkoaiRhUWlt0sw4Nmawo44JaLGIMGb3HxT7R
+QS9cLdIWQRwgG+pQAUcVF6MTFa0fEMUD4uWSr6z+aPKfjdA+wYUCfkFl1qKkzihVSkr17bEAat9QgGgfGOgb41NbIiTYFC8JMR10Q5ze1XnQpvRGL7RhCKym
+utfbP43/DwUIK1uv/85BgGf8/9dfh7+z///1mzdf/+H//x5/Djj/Sk1ul9dn11fvID/7bvYe8v2Ls/nsZjkcA3iV6ZM/6cnufudaDgIE//1//Pcvct9/7Ljv
+f/4vdt8Tp+6Qc66fd87VFzrn+pBzHpxw1XHC9W92wtWQE66fcsIjv1c/7fcmqldw3fK58sKJ3IJiy75zeST0Ii8c3yOHHOYDHu3ojOHF5E0w5phNQdukjOPr
+1BeUluJYqbKrGj9Yc6RGVyIvgQQ98mLyUYYezk4oVstzBoh0AMh1p+ua63gCDhHNSVz36A9RIVYBozM2Y6oH9r8gPwbm+ZhyEfBDUOmgdsJOsbASwTYrzN3u
+/p5sOlRveglEkPBvmiQa5tGsNsFg4IGNR9r7LdCQoRH5O5phvHqiMT1sQikyGb/EhIpYhcp1StUj76GqxXzHoNnOoHi2L56xVO3K6juDyUgZHRWNjredutSf
+UUEv8Nl19x3nz1QszxjzF6U10jhHwx8NZInKc1SmO1wcecTUenvOGYgZpHR3CzkNlR/aQxad3xT/TGadNPqfPzP1vqTx+Ptle361/Xf69UnX/jv5+uTNH/bf
+7/FnAlQ6jdVvKANwz8tBbKZjpeQTWM6xbl/iatGzFh3KFfPuo+5SKD/YGrutqDaVCxpX1mtDED2krw7h2sJ1Y4wQpcB7gv+JoXA6ibMExMHS2rTtq71+yFc/
+IwgLMQ0cVJPKMoC2BGgI1/5RzQcDynZOeTEsD10cYOuEJ2nB/aAMh4BNTA1IDZB4Zhat+me9c9pTEKmcn4o9pM8YLQPM2hetSFmvpLbtjkhc6YYGK/SlrRWL
+SYvXj78UZMDd7l43BrIfGb9ZkzJFYz+buPxYsAaKhMMe8q3T/076E5Cxl8oXsp2Cey/QBcclsCIZB5OuwEqpTN7UnlJr42+hePZipd2STRnOCsASyDcAyST7
+PEPAmQxHjdBAeiB0horLZRSh5J/QhFLKCy9tXabA6N8fIE4siFturD8a3TY7R59kLpL8DsOJu4auGOsIzf52rxcP+WOtP+QNwEk/QyDn5E/6etVa2Acn3333
+p7FS57PF2cVkdjmdf48w5sX1u+XHCdVScMHCuVQpZE+XKUh9wnB1whAUHF4YahJ0vyYhU88VJGR6OVteTBGre3V99XJ29W4+u3o/vZxeLcc6gvSrxQeoGBiu
+1yBk/vXVVJ/PFsv57O2tL+7wA+Lh9gT5u/rkYfKCEMffU7uhcAR/NLsCF3U5n5wtof5kvvSfhoKSTAnE/t38+jIToP21fO+Kqhdw3NPWcGkLw/Hxgep8OrmY
+Xb1fwJfjD//z2gHvq7L4h17+z97/p9+efvO6e/+/evXH/f+7/Hl9/u5v+v0FVE0xDRA6I8OwAnHgbubTySXsU4qTRMwiieKylOhBUNrpXL8+1+ghlCsXw9Ni
+0BwngI8mN7Pj8TJKFSLzRiBEYQFj9N64utXzvWD6LW+KA5XCUTIK6+Fcaxptt6b2X3RMpyF8jKZQwrLkpRWizNJYLwLNjBdGMC7SpIE0oFTpk8sr2Z7IqYiF
+sTO0XQrTlJ9JdZmoXY7Yx86w0qFTboDRCiHUEDmRhHx1GzMJJlSGxyJ6pVJ6K/Gp0AYLOkJBhyBi3aV4x6asbeO5URXSSlLZPgefOrJX5K/3eorNESZgkiUp
+osYRCgOp9eLWlF626uAz74xmQlkR70N+5uG1IjW/Lh1HViLDxf+6WP/yxRuIAbpeAClarH7ViEGEIGShaorZecKy8lJQna6q1sqQRXRlh3jI+M2o4le6NAan
+YiOJTDP/vKHJPNymsZrnsFayZNnj6zclB3VxPtuHLm1VKvkbnqu8op5GIsQGwyx+aD1hguwThu5A5xJGiYB5UOsgn5alYUz4YMLUSDBjH9c4n76bXUkd0+n4
+hGx4mfc4slt7NDACRWgHjaAvo2MO+wXxD/Vl4V6WtS5bL+kXlW/4iVfpxP+qpTuGXp16Egg94jKsEewOxB0IWmniSVoCkAOumReOX0aLRnkt6a62NIzQeVg9
+H2m3nY5f61HnxyPhvPMo8jg+bWLCl7yVhaS4ID+sHj/cHbrVp5Zizjvt0OrzRXWhQoeSE5x+T5FEjH8gFdCKNT1wsdDyECRFdJZuTUOYjPhandzMggijG6vZ
+AKtXxFgS4eNyOdVOx2/06DJpXFrXN4oEdylwyPcPPd/pPJo99dGziyScpI8PtjJef4pfrfHP6fhrPboQjkx6129cr9/geh1Fnx7FYpzybDwUSNGVYUExMTRU
+IJATnU4ZRtVZlDpBFtGgIO+4adMzEt+oIPI/dHrCzzeUAddlWJZkDOTO2VUpS4MMJRXYX72GDXMfAkXXtuWt2VpPUB5jEmlWglamGCWdvkQz860ewSzItMDf
+9SxUBVEiDz/+eqwvZmfTKyjgnUCd+Qyr4tXr8Qm6h+CSvfQxni9O5/lV+MKpeBGkok8UM6hZE7ood5tMJzTyJmHr8YK5r8evhQKWi3wrjnF8rxQQm3Eo3W3L
+1Y6onODjUU1PFQhebE21kdy3+FORfACf70fDQwlBhO9AGFQ+xwF8oQKEfaQe4W2egTQawVCJGB9sEKDBmxZrTHWHXl8lBN75wRJpp51B6ssWdr7Pf379g1JA
+zPazMVtdUgGbLHdft0p9WJtGXpqQXvEE5XfOAGm/nNZx0alSR6tjCeKRWVLvY7ZMOiWCAJyYrjTzJZzBDD+LYNZ2jQs64zcUx6zRmJAvdpZhUjQYdyROpBoV
+XSolcrgFlneuX4JwWxBROPDYMeyfU+YzOJtg5CIlf/jqfDqf/TQBXgf98Xr+10W0yXgtMC0pCH80w3uL5dJSFyFjW6m/UeEIV+nZeGizuVgMqbfbhIhU4bbb
+GENrR3LZvgA6GGz/2W1p1/3bqrNXdXevPr0bNe9G9cxu7Fgy/zPsSn20enLLdLscy88c3Dq9bz23hdSzWwh29ifhRw6kyD7xijfpoUbDOZs3VNG3QUY+JSOY
+4CxwSWKJf+ExF87fzgVmsWkM6VPQLtNr14ApHB6fri1Z3+j7Ke/85C0q/BMdNVPJpiIoz/BTQ8PW1LC/x4Ebc5HzgQtvuD8Wnqtud2uBEkD1YFT0GhUjg8rd
+g6mRyV3t0xGEgwt/ibhbgyUTtRd92K0e4gdRnAOhBiUxk7NYK54JdW139Ypcm9zxeQRH0CjEuzGeNrtaTucTJteZXZ3RNZ4hoQ5+DHhvgE8HgvQfZgsfR1az
+hX43n1KwvZMcyL6Mw0h7DiMVsgRIKuTpm6ily2sttDI0ecv55Hx6OZn/1b/nZj67niPvznJ6pZAeZ7HgO+dARxdTemQUV2RmHH1z+/ZidiZWIZf1v7uFlMH0
+b57+JqQHrq98zsF3GMcEmrDQR0AdNF0s0ZpUEN3Xs6t31/8rvHl8dn15PB4pdfRwjC7AIGt4uIdOZWknVID+QEBPD+N35LZAJsnW4YNdZ5WZOOCB8GsMXLY9
+hTY4SeAMF7ZjVskwrk1kylrLXJBssHj0PbHl/qAbiquo6FUExvJ0zLEIhHcEmP8mAWp1bkLnLX+4quOZoWt3UlWHDYjOoDj2EvMmDU4g4ERc07IOqDWqdXKd
+q18cYe8dwPZL9hAs8sXt279Mz3CNh615M79eTs/8uppBOuwdkCHp66uLT5DuWtxOrpZCtvXsCv6STaWe2VT6izbVhNY47pDZQt6vLyefIGd2/ZZJm3APJHvo
+GsmV3n7CDBk88uo9vkNF74BXAMJubf9XPIxXdnM8phexTTkeSlVKf9SXHUY6PowOHxIqHWJ94JA4cCaMFTBlZfr8FonEyDyea8zAXi8Awvr2E83N9U/T+RVk
+MDurZT6FkTujMN5koRfTJbRh+QEyfovbt+czehWUpB6dHB/NZsfSJj7YITM4PftwNTtDmq/lBBabOru+vLldTudhlZ5dTGClTJb6/N1kvtCnX5+OT0+/ffnt
+KyAwZaIyeOnscgbZ2Os5MF4BGRvQruGXfRry3WSe6fNrHOSryQIYuuDTNzcXnKW9vcJxXXyYnncvIHULjGCdrO3F5OPC85FdYTIZifkW/kDQWv/2S29E1qj4
+JZEd03VCetZAWaf4zUZ1S7oGzBlPAQbBBzDB4MQ/4HUUlgjpOz4HOBonxyDSiKRXey/0JTWiGOpZJboaGypeegmGS07FNT7ek3HSRnISg/Hap3sAuuSZUken
+Q83K/X2BqbKMBBwoyhSomRpjNBC1IDM8Ca3BQyLmeFYNFwOytkRBgQkfdiQdXm3bh71jgn6OTALghXsc3zOZzsOA9YYolVCIRlPFg0dG7m8ZNA21ixwT0qud
+ay3zx/vSuYAQRPkxNM5lqF8fWgExO0kC+sw9TRenLG3UapWulySneIT5g7xqTVPT+i+dANTJ+FiTLDQYxHDP55XqJSzwc+U6bVLMFNTbTlHsT+F2wi6KaA+v
+pU5J5wJKUb1Lf3R3DEsS6fHGxxSpWXaCnfHE+dK7KD5NIKwAKGa4bPy1o7KW0HqAdkNRUkOFlFH57Cb/dyb8tzUagEdSEpmpn01Tm4rcagdb35fnchgd5mXv
+WrNJVdfCMIFD4bxGAOcT+FW6bFEjJ+bK63x7ZQvxSWfrnkHW+WAXvl6yQsLd3ovZcara10iwwjHlvEnCGKXQCVUs39JgH3/OK3Rt5AEYwugHqn3qEvKm+DBS
+ksE8abcD4duKdi6zgCEPd+qHCi4LhsqQTh33IWoCBgeUn9poMNiBTmVME8DZQISrc710BE7VsMCp7gicYugWF9BuxYXHXubUx2XVs3FZeELPaO4E0vBsU2nS
+spPsCsDJL5CZVLZOu+xeHJCc9LIrD6YxZX1QfVUdUl/VPfXVrqBrCDRI6/9BkYZUJQbCdAIvDaJ4GbE2P7F4VG+yntK0189o2qtE0/5Qq55tVM+F8zr4+j+p
+gz8OQNjnBLmzoMidDUlyq0Q4IABxkRs1iMYGVW5nNex7IeSPtJw2wOlR1kQbGRYb2alvxvpytjibXlxMrqbXtwul3oxPYqHtbugRx6ra1S1yZL3di2RoItkc
+y/2oLzlTfo2Os2oD9ydEeqtKo7rSAFhBHwArUCt9k7tN7ItEaRGeMZXXy9Z9vWx6onpyBfa2BKYKLFk3fquG7quBGSjrrqy90bfjxZjTlWwQYdVQCM2DNuwY
+5vdUB27fxBj6ivgRviRSgZL/HvtV1l6AgBTTYOWx6XG396pqtqECcG5RnKZ1WYIfUoN6sHiTphCE/ibEYysvChZ3rMpV2ap7YxkAkFdptyMS4yAXJEpK3JXM
+w7jjr6qk4JLsSJJw29j6Pnybg1w70SMyBXAw0y5d5QS9iDZ8oMzjrkYtzJ0u195tEZ0tWwyF8N+MX6cAG4p4pRpJHd5lXCMy/YuWIvDqDFgEbFOXuS8OgEcw
+SH5rGocr7d93TekKgZnVyTN0eIbiX5nPZP2h+SpshrGuZT8r1umh1vrrcUSFicnzrwFX9CUU++DBZ/opzn7lgzsQIekmDZ8i3deDpPsq5ieH+BritzvP/SIy
+fvVE7Oifj4z/wFAISb86QNIvVPbdrz3B3a9+HXe/Trj7vx6fxlj+L6DnBxr+A5GfTCVE/F/O0z/5RLntT7LU5lMfy0xX2NCi/dUU/uoZCn/9Kyj81dMU/rpL
+4T/UgUDrr34brb8eovVXv4nWf7ihio+H/yzVP026+k1U///yx5+/Z/3HrvrlH1wA8kz956tvT77p1n+cnpz+Uf/xe/zpRuYExMvlhSThKtYqBPRfnr46eQWW
+7qQuGlBjqGz7cylV+4ewfKyQUAF3355heYQhFceY6N3Et8kg3SouH8WCGRwsJB65AP7RiSegRQh9YCg/MjZVqDFEXC3R4T/62pNue6lkI47TyNcBG7Zzpv8F
+M1C5H3WColHoRXj4UFmrpJ7SNp3ucY6Aihyd3uyDzgF6RfMLJBavOdr6G47GDzdX5//Y3f98/ffp6ded/f8KKIH+2P+/w58PpWttU64SCg59ResTI5IBafbn
+LhLuR6VukhABwmfi2FV/m8SUk/DLsnXpoldrQR8ylp/iVhQ5WBs8kiAGebePJXXjXB7h1OznAZhtvt2aHGkGc49diDD1LDiat50qC/q28vKjUWCk92C32yJu
+sr7vbOa0DkqJMsKfu9GAH/WfbaMbQ2UAsPch1vWj0BNKrUVefDZNW0KASgn8a4UKRRSsYMd3OCAvnJ88rKKQnCkPi8VIatTP8VBDicK1tj2aPizBx8gT0Wum
+NB7+/Z2pFuCMn85R7nTpRtJOxWFVxAB2REQG2ye11wv0h4I/SK7XfPp+Mucy6QhAkih7XVwMuJMDddQqdieTsmhNZdEDrQu+idQ7R9ph5zNwzg67HZ0K6Y8f
+JsvF9fSnKfhMi9uLpZQ8K3EbCBnBvsPN/PrdbLlIqqgnVwjUJyRKKKm+mr6/mL2fXp1Nlbfeocx6dn274C9kXR2zw+XV5BqpnsMcjf8fFv7v+OdD7n42VTVH
+toh/kCHwzP1/cvL6tHP/n359+of9/7v8IVbibvGaXFx6RAtDWLU5MA3QDn3BSDyleA1p8AxGWVc8KgAzIhQfhTm/T0j+V8f4BL0oN7YGOYHKPjIXFIoHSQU4
+3SHcMAz6VjYEebn6VNrkSVWIdQaSEJzi6GQ3Dnkt6Q0VTA0ldbmYLCAmGLqYwaKhVgB8JlDXYbPJshrry46v4HwmhDvmvRiMaSNNWNq64rm2Dbkk6M9VJm8w
+MynczZxWiwyUqN5YEUgVBxqBBL7ijK2JePr9qvjjEP/n+DN7e/lyW965/0L+r5OvT7rn/8nrV9/+cf7/Hn+WZI+HANBD7vSdMXWXPYBpuu72evb2koSo9WTx
+craAVHLpxmpSI1ErQQH8ecePDjQDciHAU7xJLJIXeJ7WiiqL9hpzptph0b/ZOL0u2/hA5dw3lniEDD38i/Bd4BlJ3VoEBKIPSQb3SYEHpCvkhqvQ7ijlS39N
+1bZ8LlJ8lG7VvlTnAJ87gVuavm/EQmxMHu9PZhw3FJ2V4163ZvVQI6sq+55cDt91tT3a4s7EiB1/j2DeEyQlVsZFfJLeKthFxK/yYO4C5Ukxjy3UH0633bXF
+7+kwQxAGlqbVoM+YrA1fdcdxSaaZDJXOQOhg6IZ9tOEDTnKx0qAmpoJQKmSlZvqtvoR02M31nEDjp69enSrl65cvJ8vpHJyvlz4rcjOHBAilJ/D7/6T33ezs
+9h8d/nuW//vVt7343+tvX/9x/v9O8+8xFC81/Otk/CeGS5NEbLRRONUtBStX18vZ2VSnJvzJd999DTmCN3qWQHXeApQKELIs6QHkwSyeKGBlOI8dEk4KCg0s
+1OYzMnJHgcaBACAqgNo1w9Qzz7NCx5K9k4BYp+Q0OR4jgoU0cEelm6SQ5bVzjvElhckrOWcW3ahaBLSMry35dQR9CTBLNRRFzfTGYLe4xjMuK/bSKc50q7HU
+IpFEDXqRNCxUWvbAh/siIsZd75qadIQ8Gk0dCLH2zukjd6yfjZOqJADrMTnxbMTx2Cfe9Z+LyQKJ03+OklEHSkb196JkfFb5WOoiYypGDqNxUv8Tl9xwJFLF
+kcghbA9E7OhvC249ggkoMMf7vE/PiLHVTKfBS/1s7DLrBC/VweCl/vXBS3UgeKl/c/BSdYKX+ungpZp6FDATuETcSMGWMdpLIw/QOT3kZKqp4YC/Ft4owQtD
+MbrlNKTLvQISfwqPqbK+Z5OodP2zKiXFoIBL+R8JBrPbyj9c/P8J/sz+8v6/2P47eXX66k3P/vv6D/7P3+VPrEb1l5vpe/2+sbutNwkvpu/hRF8sbgF+OqsB
+pVzWelrfgx3yvVInSJlc2PpFS4wYzoiFkIgLYjW5PgJNaK5HW5dM9Xa3u3ciEa0q0+qd0z/X9vH/dAwUXgjpWOU1K1J3s4cgXg1FCV7AesxMFfULqSoAn3Cv
+dg7Jc5Laj21jOKAsCtiN7SapPXe1D09EiqOBbCJfQZMrU9yblIIaAR6pPSmve/GZD3ew1WZ/eS9lTLNaV+Y+rwxGyOMAONbDxGBeTBQnMoAS1+inSjMqmwLP
+P2KWiShHy9aRqhrErfPVatfkK7I9AV8jWVyAxah12YIxz8WhWI6x2lV5EzK5y2SqkqSuGFaMt85USTxlDQBm3G6TUFs2pfuZqyij9uF3pYlCxRm/bZWIB598
+993JS+DjzvTywW5yp9+PIVQNJThVpefkbczZ21ChjidoeN4ZYnt8yg/Rw8b7UxgIBRSQnocPZs7Y9XE/tB8BILpUZolqJ9cpz4haJtYv7FN1doYsNLGg6kAC
+Tc2nk/PLKTpBvlqZgzVFFkkFhREnGZmXnpWI0RO7GqtZTfGDZ3kURSWX6cJUhv9qGwZ0hdpZYV5FV0xJMySTIcU1hVSmYoHukLUPlamzNdUzdGsrh4YA/u+J
+J1JLXJszZEyPeqNKhTZlzXNRB2LBAfm7cAKPxlRuHC23dVzA0nmLLEAp+OVwnVQaOYX1UT7ohxvZLzIIMbqy4ZEIMcAfdBudPfQkcCY8CTs+ocg3KPHJbEY/
+lzUJaKXrMjCrwId805PMI1TQxBq+ciRmqJiKugS8Hna1T2yxhEFyTMORGyRtoNQUvxmd0XQdpHsZ/DQZR+ET9erEe2wNjcULx7Y7FRVDKSD+u2+jB1AOwnl8
+LUo8e16ttfUsoop1nLqnKHO7eq5KoczNnR51llJ0mb9wSp4Aq+qj8XAfqdaHmABsSa9fcTDcK3BMjPlDGCVUn/teDAlR+rMAa2P5JiH6Gyz8VXTw+1A5P0t/
+NnVhm7FSee3K05/rZryiEjE6f7xflaCc7vY6Lf28GOsb0xqo9Nq1bvWQKWcr47XEbCMKXF0XJ9OTCs4osHha02ybkhmaL01dWX2TNz9n6mwy1mnzYCWJtNLd
+fjCAkZbysaJ17VqTe9Xrndt167kSobRDano/IHvVqtzC9GbKGzdxmD3F/A6Ic8DiO1oYE/h4QhcRoYcHSmHavKzc+DgIZ5EibjoesH1rYwpKNculBPtGkK9e
+mDRkG/zu5xkWGlsMm1GHPBMFGFBr25h7rDQMKQBdWDqL9G1d/gIDvi7vdxxzJIZUPZKfmhFK2vhUPilYX91Gak8ETwuzeLd/ciZEp5y1bfzwIoO5WkoNPaw1
+MqVgDUaxKuFwPaImju93qJ3O/3K7u0xXLf0rU1ULtbBj93AMdcXk9/Oz+EGZkL2+hBBityOX49l4OYYmo+5d5eyBditSKjR546JgYFO2DxvTliuYNDz+tnHw
+AI8jOHfovbI3lE+lPbIO4eztZaYny//Lkuymy7J1u7vSPZRj/cHUq6E3UTpMoc1c7T1GUkymEP1l9WNcN1JmTAS+uMQakzuwoHnUCIjae5tPkJJKWrRiYbi4
+o955OFrAflD9x3hdBDrB9SZvyMS5BwcLBohvOhohU+gPu/V6k9fAtInGOkmYAUd+tfdCgSA1VO/R5DebSDYZUn+yGsp2fKwXVq/zRuVOP8KYGp2TE4AmKBf2
+S3YzZgzQnjFgw6PKXsuS92xyYKEyMplofGKvUCrQwmATogViTka/n70j426sl1blqHQHza/vqR+BzeO2Lt3e6Yt/+8jNy/DL8DzcM9xJmSYl05RXrb03REEv
+qUP4Ir6/8Z/XDt0ltC1aK6eBHu2AaAVdKlOo97N3bsS3M2Vf/9vOhCNKGCWhjXl1b3Hyf+Bhk7Sq77GCwa4gb8FCCnjw05UAv/LcOFA5AdPIkgH4hMLAgKI0
++EfDHw+M/cEyBZY06LAQhc8CuQ1sgE3eQvKG6f9DRgeuSNMQdBdkoncL8JDULFRWFGNox5Hb4LdzDR8oVwbwWz8f+LpOvj76I4z4X/hndjP5L47/nZ58/frb
+Xvzvmz/wP7/X/Ot3tm59xC+oN3w+Gb+iY51+Z5twaUV8M4WnKzjKHcEDyTCfQFCoMvqEYifH6jkBkty/+mjkmzE6JpoUjKsI0JLI6pvB4oZuszKBncS8Nj2W
+ExX6DcesngshznO9IsKBst0Jo8E86BerAZ4P/x5AzvhnHUUSxscYVR2dl/dlm1c0O156gTJDUDzHNGo7uLzEjA7CP9hrMIMU3rXYz4jOdm3r1qFEgh51B6zz
+kqF2BDAXW8CyQlQY0aSnr8es3fHrXiPyksi9SlcnMk8nKK3Mh5FCFAmXChBq4IzyAiCbOC/yLUdvWqvJ98vZKWlivvH+QkpULHLkt9AY6FUH2l+bx2ofi2Q2
+pm1K85n4h+s24Y0jpi71BS2BX083d6YAgwpeyIJCoRXnHKVS78rKPIlxk3dwy0zRaxjSbYT1eGbrFvZlPH0+gBE8f6tNXWAMynlaV9tsYPoKflKRt3kMjIBv
+Ej8tWnUbG1O3uBZM2G25aneNcZle/iTOowt5zrvG5sUqdyxujA8SinlqIOzWkn7PcJF81ZpGgfJ9Fj1++2BbYVXRJUa4MpFZ0W6/ubOViLYwj8nPBmkU9Kg7
+BxrmoLPab87J+AtN7wyvihbN57wp7S4KwviOl1Ii+uQC9uoDJD/ttJG1Y5tujpqdfYl/yXnh6TDrVh2N0rUHJ3T6E7RDdy4ijuk8y4+7B+lFKYTB8cO1W0YM
+fHt8ifSENBtoiJkYsqzvdwRiYQfNOiOaIEObVWRsKMimPB/kYLO13bWuFNHz5xpPdC7fjPXojE9sWQ6e81Bj6qHpiyiNlfp2jHUY/ubDFX2Nq2b6y7ayjCJK
+llh8U2YeApmplIkTc24MK1rRpzhslulOVumXh/KulOPWn6ASyLeSc/KtGSv1J2w334bpBiCs8OODDaR6gwfd0AUdHxm25il4+jF8OvrGRHfvqT7yevQXXvQ4
+sXqYEYtjz/4hEQ0go5eH3q14yQmbV2BOGtBTR2EK3msRO5DXdFD95REib/FbYvum2rOi8eCZ4PfMg6l61zlyOdVWO8+xlLzcSzLCTSprDNuB7+8EvQfmZeid
+/ewbxan9IDADobaHXgU/9fyAmcK/YsQejSLHDir8sjenY7S9lvGP8EDgwNmB25hmOMhfyy4npkZZGU5yBAMi+kOTPXgWnA617tDay568+WExoc6BKfQmb01T
+5hXli+vubYQGWC05ZbYa/Gmo4fJ0Kr0JXw81FCLeu1Wrv+A0C9sAW6hCC6kqPW2f3JYHh1cg4jceP85afz59QWy6L6OfcPLVsd2HkyzyYBtTlHk4iFBc2ken
+yf6JjZFMCtFWAe8q13pjhKcMH0rmFidww/CZX1oYbfeFhh9dmq1lO0KYtOO1gRxtnZ+G42CQeFScmDxakV8PNNZr0yUTbZsD8+ybr9Lm+/u+0+dDZnFiduD8
+kucjQqjdVcM2BQ3E8ACicgjoG4XO0RAhtqS2nlXV3lXlPUdbh5wgkpSvvOOBL81XrNOnvhnaLr6u8EB3sdRkcGtjgFMOZHyVcB3QXV/t5bZvUyxhlzBX9d7a
+Wp3HDOH6i/eROhrNo8cDhPpXHodyab9Wfh/rU7KQBg4bXPlZGjkwYrTHLQm7Q3UNDyMiwy05opjGE+u4Ozbfe/+HFCdVai/QwqEq2C/rZjiu1Amp2MzTOiav
+PhIaEdk2r/XRPITu2awRm0VS3WXdOSLl24eOAl/Gq+K8AGHBZuv+SaNLFzfbFLAg3C4nhsUwkW/wVPxW27UabFB2UAXM41I2ptWMgFkmn5UPYForbYxkBTqK
+U9FJCTdbX07X1lVJ+aQ7YTOCeyHHujO9MauHvC4dOWnmFwq1KybTt67lk83nDMDqYXL21rbIberoqNta16L0p2stJueJvR73+ENeF/i+tTEwBV6cAeRZ8oRN
+v9Mr0dG7O06QOIyxiWMW4vjpwnw2ld1y9kr441uEBu8ah2Su+L0DK5PFVvPap+gokUwHaHJ+Dbc50yXeNALk4eLrkO/oTS9PDEYjcNZ6qmSq10grsaPBPvhV
+0ncyysa1qjHoVA3xPXMJybWAmSQQdjzWi93qgdvKII/WSsmFzlk2GtOf5J5LyrH7rKwnj+ZMC/Oh4IrIYYM82OKL+9h9PIOSOmctSXVTF4ce1z1LO5rLQ5HR
+ozfH+oqUOMTAtQ2bWyZBzx+6GT1IU5HljyuYvtTQIMZP6J+hR18fY9xZ7E7d2l99CIjQBN73eVs6ZnwfVKPyqAVZAeEuEa0jIE9Tguvbo9KgsPxY7Sy5B0x/
+HOYn3g8H/OPBA1l9E2zwwaO4e7MdFGxUCT6RD6iT44E7O5C5PTvJXorm6PTQkxBvmNo3Ee/+wAMPLO68bfPVQ3KeJqbdAfeWvaBIsunc14/GlUYsSkSfuZ57
+hSWhjh1SdAsQ4MlCqop6L4k5ZMOPYw5dNcShm1YnLWfLi2mGkktxvVHWK1uC1j1HqAvFNlNPyzQ95/ok3alPUn4s+hxJVFyU+TKjmKU1om5FfuTzKdAJ/216
+eXMxmX/KDrMpHT1JK/wDDN3Z7Rw7rpCQ9O1iOVvewq/fX1+fIx0TkexOf+gyw1Il0+LTYjm9FJLVH7CySTEL7+2N0C5N/zZbYPVTVOsEc4afX0rl03GmP1x/
+xHqpMxCCOkeNKdQ1w6V0PcciNY/TTCqlQnEUSV2Fj+lrKo5SYTSGCqg+zhbTY9+5Gb314+STjhhwZ1eL5eTiAqupMxLlgh/Ppzfz6/PbM1Hmuhai54vr2TKR
+sz2wllVnLROVLbBhT+dns8VUavNYcOo9rOPpuQYebNS5Cqy0zDirvoRxFhzyJCJYio9X28jxo2sV5Xx8GEpK8/V/2xnH912jyxouAFFK8Pe8D6QidNfDdWq9
+snXNCjuR5FAQ7s4U35B0LH9xcGUgsdS1hzwoPfIr3uijJYsz8MP8WXjsA2hw1w+cleJV3Jd1MGNQr8WuKYq7Fch63/dkazQKwdYFP3Fl67asd0Z3iEP9ZxWx
+b5JuPbrEvcdzjOcx39NVemVbGG4E3IhN6322bqBuOMqky1oFQnge9rtGAr045etfEQJU3SHIhgf4kMaHl2AA0DJzEc3YiM9dUORvw/x2L3u5XXnJcUxP/eeW
+Xd9t/L5TctxtViiS1Pl6bVZtiAZ7MDrtpngJBHKS4WYM2a+4TPH14UmQuqBpNMXhxP7X+ug9QPlqIsx6pN0BmAsYRFHsbczn0plCIhO1efSsUD1hAC+ukNcK
+19TQ7DhTwXhwJVBnfXDY3O3uCOjfysvE6UpCViyAwpMsI/cFc63sGg+3gYDFF03/mJ+5yVuMpMM8h2ocisMcilAE/NpF/ghD+Jd8m0ckA0rINVERIkd6mPtd
+lXs5Jfw8GZinDPPrn2CR1ETwcERjgp/wT4D/uXh5Mn71D8WAPYP/+ubrkx7+69tXJ3/gv36PP0C1c0PpX96W+ic6DjTBvz5M9eQM5EsnV5/A3htyYIKE6HI6
+v1z4ovjZ20vVVXQ9Gk3ez6doSSO06+oTFfd3TcNUbbijSQBGPNnfIFFxNruZTa+WL6Ci/2x6s0wK8/3baDufjEE8Y3Y1I4lX+NEI0gGSEx+RF/+9UvlYl+nF
+OExoogcJTY5Gs7eXSBLyYFQ/SoOxN3Jf73rvQePANwqVJ31crxx3a+PSZ5blOFTVdT7yA4GVOB/AT+FL59B3FJfetRx0wgRzR8L5bt+DQUStR/niaITVC/9I
+94IfGn9el2uU1swdNMoUnQbB27AD0VcUyyzS1WZrg4kVhAf0PvvC6TvzkFfrcdIqxwrgXor60IBQ3BaCzOUxjoQz27yB8dnYYldReVCob4uGiez4f9/VwZCP
+JlbrQeknUxJPfQ8jifOtj0puBTQ9eViHZKur0D3uLH3b8MqHTas7sI66Ldt9RyMqlaoaKxXhCLmmY8TgMPynFHtRRzyePZl5rxCuRDOpRPjQuinr+xCMZsPT
+5ZWRwq14KlEjEj8BGRO1sps7xDd5zylqcy8Wy4OQFJ2yfdRjz47rQlXHysqd9pFgqWoJsJVEyzYW9IQ9nFSYRiFvmK+BZna7gJOXLG3oaITDoa8eguAMaI8N
+IW8U2EDR3DlvLaHPDccv+eB4kC4Gksl93zDrnXxJfbVTMfQGcowoOebKz1AfbZuqeCxhPBu7z6t2/xLLcSKFuIDV8clAoDUyW1+Imm6XKFfKGLDoJ5zhjpmY
+iGA2SDWSJVonAOXuaSTTm4l+6EPUEoUtgUGPFwx+Ml4z0I6xUnf/lcPMe1y6TosnYI34QGgtMhhwShYIq7Igi0z/LDcY8/CmOhHcSCYbLtMvH9IvGrclxd6T
+5rMLLRXLLPF9F8U7enMLGzfePuU603kbghu9L5Ryvd3t01/CfY99khto8H2rfOeMk9R+aJuI4PkCu9ThoomgAM1gp9Gdjku16QqIXuFYuTxOxsRNQ87Mh7wp
+CKpqGu1S/k9YbLhAfAR/NY4c2EB46aR+mOWCe8tWgHkPRp4UxENt715wPQhZpmuLlBNNXq9IgVge5GMPLFcTv9YXPcpk+ySuXFVxk3iYPdC3rFtTgX++y6tQ
+IDUUt6B714/StNv9gqU5KKQVyqpbGw0nojPwU97gbKgi/m7fe1ckC8o92XDYKm51NEZp46NEy1hPECIviR9oFBdgcBhAnsJfRsJBmb1YaxjXA59ZoVt8YlHZ
+uO8alnV3WA7waFntmji++tQcyLOoYNnfv1gman7JobCSfpiAYDqbqfSPSdPTgPwInUjE4FNjvmyjZ0QlJQO9y1f4BlqW0oA7s7ZhNfdCMN0cWjHuLy9PLMP7
+kLdU4LkpscrSj/1uvS5X2K9w6fLkMit6vBvDMd1amu94UfSv7adhkVrr12NRKwRvb6HUJOkNIoIerCULYHjYu3L4cEPQeQQexiE7PAlT+vwmuI8tKxIbF4zP
+QSXf3h39Q9dDbAfKpL5Xqhxrg8HP8jNXavOJYGt2c+DZXVstpmQoyQdUMRmBcAdBK4U8KCawDN/k+G/UjbKtyBICyyE+RbL4cU8+o0M4hE97jnDoB3J948Fg
+QdovGYtwdkaMJpkKXS7KxqzQ/pW/YSwSynTLelUWpm7zSmaV46plXoVH0Y3udGUdVtWuy9Zhm8sxYcvksqv3cSaAQUII/5B0SepnNIZMKMO8EXmbLHvyhWg+
+Okc+Hly80MrPaTP6ZEEmhnMFAESIjScGDE51TRhQXrfG6Qf7qFvLBfmeTIvK7vFpm7yuTaMJK9o+4EWlBEWhVzvX2g05hR4+5L0xwVjRafBRyHuiVnewGx3z
+EBrb2b0eVpZ+ccg14nG8Gx9GCXTJkyLIf/hGfDT3TuSEwSMFw5VUD1i7bbna2R0sNIYaU3BJ+ayKOutSY32Twf9/96viW4Gwd6wGKLSIxSzU5ZnhrsC+ATgG
+x2/yxPEOvCxq+OLAPvOS4SIQXkl7umpdnOXwlyhy7PpX91/YM2hFS/7s+hIwD4gbSKKTZwHr6i8W26SRILh/mEJJ1MSTq1wuCRXxs/kKOiAD4NmAXVubxnkO
+A8Tb648PBFcOhjbYD1KfAQ9b5yt4T85XXoTP7auiZ12XBPNdvvSxc2XmAyxAdChhrIN47BGhhOT+MmUdACQD1be25bMzPZTpzEojDssH06CdwwZZ9Nvf1lg+
+p+PnHI2i2Y3DZcfeBoUd7ogNem3qgssFCrOpQS/fICtHr/kQIebPwH2YPjm/z8va0W1QWeeMy+QqUXTJOKKFqSp/3Y0u8IPw7YaMbDybyR7IMDG1K9nQpiwY
+EqYIBj1xCxLTVhojDFMDTVYivvMLhfryXVQbjFULMINcWuYir31oZNUA4AEaPlTs/czEKplY8nhjjL5YkY5fU9i+7yu2VKNpbFW3yignD4LKLs09a/73PYvY
+DsIUrm3AqGwt8RkSSeChwcWz8nudH8PzNtu2Ika9tcQNpNMqXf5IbMJ1rhQmgN6QFXZ3TAdk5wndKV3BP22VsWEDnREMwRPf1CVDBnA7OOOLBCXr6kzbMqtL
+be5tW4rYxvLwCqOEOZp9JfhaUlYXeqbzVouVbn7Zsr5G4rWlJ8QG78D4Ov3S1ZTpG/qJ/hs0umNyEYlKjW8bGh+AaCo21IZ+T98maUUOM2J1hOxkvKVQYCMY
+0T6j3drQtoyLXvsPIeKdPDgAkhUaXk19txPNyrG+DZYQ76PsqSX1iDeBsLLyaSktik6Z/lmvo/5hp9SznWJ/g/i1d00rnriLTODOet/mZB/zURvTDjzVLzJm
+4MvUNP462Q1fj2PCVqWmf4MUJaA2GdZ5AfL8S4A3Lj94ynWftcySvGecdL2+UpMrjxV9O1nMFj2uetZ2P7u+OqeEZ4e7fib4u/mU0IiCLo3gkPJIhENOmDH9
+6tNTr1BfihnVXwAZHZMdHKImsNhsBVdeV8SmMIQPkihHviWKv7w1aDvZtUI8S8rI2omJiBYExJW4ltb97GJ1CLmR1JOMIoNpEz1QJwjWOb+jLqKyD26OaRrb
+uIxDCaFOiiWHoO9wt4uBwKQWYDXAU4hjIWZKgF2wDQ75rmbHhja2peBY0+y2UohBxz4nkqiO3QN45wnO9Des7itegz6Lr68Y6Yk4VTCxr+cLRgZ/mPwE2gef
+whu/DBWsIlTwr8ECD6x99SwOV/9aHK7q4nAzAeLqXw/EVR0gLksWxP6K7oApnoTQquchtPqLILRa62/H+v30ajqfXCg1W6ehjgF/uXS6rD/nVVlo2+hdbeq1
+bVYmcsHDFlBV/ohB0x4oEHY2PoRXt39KJEksbHYFlm+rJ3NniRo1ly6RCe25S/MGL9UH05jWsksROurhY+i6bPwZoOHg2uw2YkRLCnwvaTOqQw0PoqEh3lY/
+MmMc2uiwDOREPkTdCl7ZuxlpFj5iCYfTJO+InLEanPdsj8LplutVY517SQYZBvZ2SDQH/0aTTfyQY+ZXxkWQxM5D9H8onsWVs6qTLxoGwQbYa+7xDwX8E6ck
+DIQqHZYEFWOdRC3KXz+S9V5xKuVLh0Unw0KOBF1JXEDqrVIKkhxRXJMqIENWrusR4SwyCyjPlLKNT8wde5/EdaCtL6R/R04mqfcBzpTIRNEULNiTOoWawt86
+AST/1H/Xl00zQYfWeVk5rH2HK3PP12VAWoeSLtrkzZOheMZkSJRiJ6YyvGVHHP9JDHNrmtIW+JxyY3S+bk1DzDu4FGgi2CWrbR2udaqe/RW99/3O4pS9j0as
+TO4ILJOaO33vOXfaWVvr3EUhNLVt4FzD/R6xjMSNe7bmnT0/NbS1Q5O7NCEpF0EA9iNCovlcfsZT7u1lAqJOUNPR3kNwNfzweGBqIUKicJrg1eXGcBosepju
+oaKRS/XO6PvyM3p5EdkQAGPke0QHQk6tZ8gL7Uoy08cs9/uY753wFzFsTHWwzQebRRPAuXnCzzGwp3OsKVqS+dO9LJ0MLSR/up44Acxbqw4k0qKO9tLwxxFc
+OmrCWHHBp2XUel4jFu2Bo8K4E/AulBK+lAcnyC5x+qraK67aL2s5nJw+xcrouqBjCnHkWbIWGQtV2yitLetX4lzDUR5O3sdjNewOPD5gxbnyDYXCTkqNCaWd
+ca3dbk3VTaoHbp8yyW8oqiHn5+n0ZB7IGUXKesue2XWPNQshgiegdvj7AoYU/nFlHvUn2/zsA9EJRMAPSvzd2xp9ngUlmqBsaGOacpUjZISCjUKP79ujaLs1
+dI/GIcvhzgVScFsbvTd5w0cwuFkYmMR5oifkjXWy57mmNsfZh0UblGlz/e87sMPw0qDAU6SPGq6xf0JFrNni7L9a//PkzTd9/tdv/9B/+r3m3wP/u1r8r968
+RE13wK9iatC0erF3rdngie5s05a7TaZn9WoM6YzF2ehYqUE50Fev06eI7xAek6hxHNbR+SpmfR0ShEJVAcIE9Niv1sYMiYc+K22pRFDyS7Qmg67lF6hLYsAA
+5kAiKgsNgY4otobig/Pp+8n8XC2vU7HBEKbDb3VKw4fVJfGNHHMbq371NTSmX3TtAyj9OEs3jqIkjpKKPOrfKPKohkQe9W8WeVQHRB71rxN5/Jf/Wf7MIGZ4
+md+Xq5//YffA0+f/61en4Wd8/p+++vYP/u/f5c9bzJ/rR6PvTQQc/8XXKrPpm+nKtI5EmMClMo8ovRd/RDnwXkpWUOAQ2vckF0JojL3d6dZ+r9T/1UuL2Me6
+sqwEAbZZtB5jZTqUlq+YBAZNtQxPe1IyzqtMcSkGRa/hJzqlJRMSsh8wFPl/Pfgyev7qZ9K0SinGXVAKJLSCPKwq65+jaBprUkmU0tP3+BhX8j1EHx386MHH
+xlgPeR7KRNMDyxoNV1Qae7YdGHIsJK4V+E4xCY7Nc6L08cTbYQ7TJ20b25LjRbIxa9vclUWyEBLCOXjftjRElx7NzktfMhYk/CPpWnA1VN76efpBKZrgHAl8
+mp9jfZnwVL1od0Vp9cXFWVT6T3NMyWH0HxVLKPJvDjwAhaqccSz4WLrfpzVhLZITdmhJC/0EzYNPhUYTEaiIYzSarEJuUTRbpUVxSwwHYHga28NPcWqoITgS
+bG2ReJ+OZk239tB4sGGXbkYVv3Cw49RZH7vjXg91OhJI5I4PDySGYDNhja/3ZJuy1+6UjAemuBE12Fpdttkzw5c7ZzYYVk+GsGxxwNzublO2oexTpp0G3aY1
+aXf5yrPa9gdTwWAecTkFRTGNKfA7pQvyb8UxxGH12jxK+UeVN6Gb0sLved/H7wlqVwEvKRuVxPmxT9LzodmzKAfw03nM5IHLPIrmdihJTXQU0cNXeY0BOu2F
+AqkG6DEnOBk0UotqftnqQW0Kk6xifoXueiAi6nP4mEUtH1wctTFFR4ow1dobXnhywiY70uGI5G0pY4y/fn9zoX96PeYvIGek+UUExQ49P5M7vWwdfxzm3Dk/
+6eDLeOT6WQiXw1jeOgyhxYTjxNEbljpTT4pCV0l8KvAplhpvqfZuEB3Pwpep/AdhjLtR7bhzBI79PnJrAeUKLu3rA1sj44I3wmdr29zntYigF0Y0RikdR2Wc
+ci5s8nv6QcVnU3cThOrzIPQxVsJwE3Ojtw+/ahh6wX0V6ZXc7UPg80QA1fo7HwmXUlPfEtt0mxIqMeDSaiiFigkuEYkPcUL/WUWfpeOMo/4d9g94JSwHPaWn
+dV67q6OoNFdzy3uZ4zwqXCoN3wYBsdYY+UeFDcRDG35KuxQsQ1vLR2T75K0vunrHqHexG8OY+QnM/NeppBYqwvEr9tE0GdcMaAQ00t+x1oVikCSI0BjPjLzJ
+6zyUW+HZTE3x4WKo5sMX5quUtBe7hpXgOOzuodzCM9blusVC1RXK2Hz96v987NXvOMttd60nN3IPecOEVEclPOzO1GZdEqdv/NyobWOlQE/7yDagq90cpxz/
+WC3xuSwYIplMd1QLFoIpIaN4t09pb5Ra0O2MJTmdtbL1gqj427VtZIMmt3P2NF2338xJcXaq3E2/EjxkJCapSLtOqWtK1nSb6anDQ9gY0blME7vKKy5y9Wow
+tlH4kypiyY7G4BlMEdwMkE/lciZFnQkqm50ac+6Pz5+1lncX0Zy3+y127iM4E53BFyljlgl+KLdhwZa1NNk2OhqZTB2oqPCnQ6ZzF8k6IyY5nEQcc8MMeUcN
+1jbM1hhwFdjCo7wWHGgiRs5ZlMl2a+qi/EWRqNNYEQ0aXUofsSC8M5ukL/xsT30jfWXlEQLVguwxnRkfJZOy9jwI8GNTlK3FtIPPY8LFV9tW1rSp8jsuyqDN
+a3tsui6U8mWEaURnGqZdeZKD/jyO9cEz0M9SGCUVjxLVwuKscDk912ds8rJmxgypHsJGb0wD1yX6w0e2UWAhwKzX+cYc6yjr1qzzlaHq/GTYetMVWOuSuumB
+Weyu3rCxhlggxOD0b+55AbpPp5K39Hnb9EeMG5r52xK6WVMRTrVn65+3tb+k11Lh4KjER/kmda/icG3DJnryTA4XOhHU3yE7fFw8N/Dksf6Sm1KNfD8iAgqv
+poDp5MbW5YrJbil889jAN+pUO0G5HuWqxf6VbbTQcYTd06ekSp4LPQ3t8By+Velal8WXgr/1HeVCiHmndG5nNFzOZBrS72hSqf4H7ndvicTDmiVdUet0PBHF
+WbrVznnoarnB84tX6Ec8iaB7CTIoGjFZWVF9WEWhCFP4IwMsCV0YV97XkjSXCoID5l3u9JVN0GPkKMQJ8Gi/+bmSXfPEUvTDA9vt8SHlCILJilR4CeMAjUze
+EEq/SH7WK44qln2QsSOuRy8CFJHHsfExzKsxXMKr/NH4NMkGPAtMp1xvTbM1LSq+RuwaKe+Gqu1L8p5T3o1Ml01jPtsVa/c/yXWimeukf7V9KddJIDfpuB1G
+JUdiUBaJ33LojiSaZD/+xFHxmwbf30vq9x/8DgGApzrxoSBiPVEdvpOY9SR7gvaEd3lE4MVvUojKFB1pKY94knSpV+Mmh9STtEtlk+zAI3cciJbu9h2CFDX8
+BR+lwKXSWrZ00gbJZ3MXLr8xoFphyjwK82kQpn4GhKl+BQgzNNg23WPoqXMlUQb1zqBeyfdt46GvaZ18+2Bq9RQwlpfvgMLAWmYzmOrqS0GYOgJhvhknxJ22
+HutPHLJLlFTiSBglvXVsHwmd8IBhJiFRqu1+WqlKrtiDRnaawceWGtMe1Ashdj/82M61FCcMRUE9iYgB2437Qk6J6hw/oRbcv4Kc/mAqgu0PHiMFBJoGkfCb
+sja1eDckOeOBwPAkigAXXqXR8YtW0YuI5DgTvyb2mjliPTAhPGZhLjPFKAa6TzKvtB5k1egWj6L30mxP6kzvVvLucIgFM4XOKvkmNoTrMLdcI81JqG3etIko
+SNQBHoXCSx/AT2Od1tHV9XJ2Nh1RXhNGTudUSC30cYkqovLA9C8ZqrQoPw9q7WFlmMFxCsKbsQwVNRUb2Rso9YUDpQ8MFKUhWlR9bBUe3R2hBo2aIO57aVIu
+7QkjFzMa8igenpjoRExWYqP7JHeyh1WOQf9UjSd5qm2y0EA2V6LYxt2+8z0lvV9T9QYcQJ9FFwsrnV8S+E/Gt7bNBp0vQvUQhpflZH3GIZopLeCjSDU31o/D
+qxlPS5qzIEKhvJkjx2teFPB3Yn0cWjfc8+6wqKFtjDf0PQqV2oZF/PKiMHWx24hBlcww711FG3WAgDvSCRpc1BibSMiRVe64w/0I8GCngxmLCU9R2MDfp442
+DCl8nduposZhkqabSv812YUBdfF+C9CVW3tG7SGrN3fKx1v8eOKTviCWn15AYhiqPqtQ1J+Aee5EUL9GW1pwa+jpxCSR+raujHO4hEDItVyV4Dnh4+JYMwDs
+OjbQcLyiG6PQcYziE+Zgoy3gfcU784xgUD8KxTaD6ghZDZR3DHP6Mwq8ti3q6RA5HKsJu93WNM7QDgobN6QJceqFA7YvZb+3O+VTwuYXs9r5ElLf8cbc56QA
+2bOERRtQ7l3HdOTc95DcRttQb7sYSmqrV0B1LIeKj8oSLVSvRQGrkT6c8vXDsRu0WYXXjGUWffkLzhUT9ZBwU1nrwrhVU96lMTXdiaQp2QvyMT5xdf/AJcm/
+c2a7ItHVj1T5vfcLWVqIga+kWBYPQ5ghjAKFQEcWpoS3qgstPIKe9fxK/7kuTQ3Um9Q6rzUCPX9lfbai+mwm8Bc6LtsMsHH51U+BLR/Q38fsAL0Spy8W9YGX
+RhXaarBCm5zpxvzGumy4UQlghEupV5gdQq1Ylc06D0NF2XCuJlXZUW5pwF0ijeoLP26kAc2loaJ9TModVCrNki9UhdA+GNuE9JxCI6xpY5+zNvdVeW/qlTnm
+ZGEOWcA0ebfrLVfVWa5Hwh5WmKq8QzMHG3QPDm21969pkWvl+PDyZmLTTpHKnUGuHSrrhKmMSdBiyt96z37sM0RojL0bIELjA1MF7WAhq4m4FroHPI7Xzp8D
+XgyOJ0pFJx3t1CdTY9KSdVQlf29tAXUmGVLuaqy9QcnFFSs5+2o/zC1WaybxzkRNI81JB9ih8v1umMjnOMMVpcs+c20IauYFKYJwj7fWCfOGLx0MJBPfjfUE
+iaWgw3IKwgsn4SqMVjUxRR3YZmrIy/UZCjHXAo8iRrM4f4ghMp0jyn6NJHDYKmQssGuRM8rkXEICL+R6afdhS0SsT1F1oWKWei7JgaVVOi/hm+7pUKpI4rI8
+MDho0SOzYHyuWrLQbR2MUA7OQ8fkxwPkomgzaFuriF9wiFwk4wdBWI3iWLg/dWsDUVTGhCgEbHmw1cBt85A3m8rTICakr2W92jVNSDAw52vunGnQV6PgWNZf
+dXd7vr+h+YheDKPm7VqZNBUlt2KOLhx26M+ECJSCmQ8/xKfi+lJLKxxLHSxS9+OUYGXpvdRLvbNlZZptlbfiE2fBFF6XpioQzFZZZqG6g6yMAUb6/+1/H4ny
+JV8Ze5lxYYSDky7y4/RRYesXKU+QPO9YlK5+aYXm7M6ENzPzXnTb0clQt9rt6zb/xVMrkqgnvnKsP7JMbWPo0+x+5fgpRVPrHFpo5DCgcbWVC0zSRjHToK2D
+Av1IFNXhhBsJ514vuQ5NM7krTSMjI3ky79R7x1nlzeoB8m3juPznf9vv9/v/Xf9vIuLYSRz+7yroewWDf2hFkGwq/2t0/INHD4oKFSZecAzZPkUGsZQuJXY8
+/UHGTJQp5PVCAuutUg9tu/3+q68eHx/HgPEyG6qUsM39VzToX7HFP94+bJX6dZanTq7mAckI1cNF6NLFH2AT80tIgNQhEqCDRuZYL0yiPC7LVbEO00pXeX2/
+y+8NV4x20DzM6SzWlevDPP4nKqT5p63/qcq7039sCegz9Z+vvz097dT/gADUH/U/v9/8+zMvLsIcqJQk1PRaSzpUgs5YhcOnKefa4rxIUEypi9hnS8F1lBCh
+w14KRFFByerC5JXygeRO/UekQp+wVPfc4kAj4NRwhSnWz2RCfJAlodRO8vsrBo+rNO218IjqnFRA8TxseYgc5TztJvksJt52TY1cC0rUnLODuvtpJmvZr1fV
+z9er+lhbBJ5ToXS12x18FDE5pO9wCbeExwx4ERtTRFj3hAaZ6DM4wRovEu8FIYlouVa1TeA3vVXVp3aWZoz1JQFBQ32H6n+KAUkp7sv5fEXnbd6aSJNGtknk
+1bGaLvne5zLXzm5MwndN8E+30UcBeqR83h++kuvatI+cgEQ45rHOnX404Mg7AbrmdSSkshP7WnEnbI0miXEtRw+7s4iBQCY8fO5J4WHSDlgCrjV5IVOT8Tex
+ZcnsMwff0CTWYon0llimL/N6l1cuY6k6QYFfAoIJ1e1luTlxyNOjxQfePRWQxDk5C0ezHrWHhWF6YdaEH9Y3nqUX6p+9WhjV6sFOkncrwTT7bx251iu02kYX
++zrf0D+PCc8VIB1RhY88b6zUDQpMacdm2tn1DUj0vby5mMyuhCm/ysv6panv4UDDuH4eq9iUjgdZyQanaP4XlK737MxPHXJJIgAE05ML0yPh8QGRbSy+frJ0
+PZNIpDrEFYnF7VfXV3FM84DEuJ7cLj9czxeqX+yOhfiZjurYqaZ8mMfPF6WrpCgd+ftipr4s0QPP9FOF6OngX8/jwnRqy/l0cjG7er8gTsXpby5Nn9Vr+/Lf
+Zjf/SAvwGfvvm5OTN1377/Wr13/Yf7/HH5n/AyQgJ9999wroO77T8sGEgyhiETqIAg5WSSTxk+mRPHCky1CwRNEIFUwdZ1h+SKCiYPLAua8nRQVBvr/Yhxq0
+Ch4y/de8qfR5/hkuoA95k1cAC69/xk+ZvH55Wa4eTKXPd3e2dPyzyu626n1eVkDC9AEBYfq9zdvK7DM9LfR72xRgV87yGv6+yetMnz00pdMfTHNnm/Yh0+dl
+87P+kOcA73vfmHt4eftY3md6bu9M0+oPJofP/cXWOTITfdgVDh56k+8q/dfS1GX7Hxm2vNB/LRu3erjLd5tMQd/qvb4wJtPXdW3157zWpKxWFwZadW8buByL
+BrpW/2wzvWjNZ6NvxvqyrHCAFqa5L626tLVxZab/Cresvn40kL1+byzEbG9M29jPmcbGz62p7NrBcJb69tHoOUShKnnyIq9Kd7dr9pk6h5TmYlO2D/zLWl+O
+9WL18GiKnIepLfNaL7alwbacWbcpa71sdm2e6Und2rJGwdkHU/67qTOFI/LZ1vqteWigh/Ny9aA/5hVaAeXPBsLXrRHWqtgE9Hf8KHe6dNnIewCPPiZO4dmf
+y7rIooCH8gGPOONDFrLfHwwwX8Wk1HdGP5iqkOzJ2jYHciRxaoTzJYdTJJwRUd2UxzrJeoT8bmK1dBy4CDHIgo9DX/PhZN68cdIn5DIklBUQefjYCrZMSbs7
+KeYvWy4F9p6M0KeRu1KE9K1QiIV9Hzl1Diu1Uzgia2sEp+BogCLiOIbEhRerrp+UJTV9oVlZcKCq0rUUw/RIgqFGlbUGEGzDlQ1HvgyLEv+wSiQqxuYhtVEF
+UGV70J37wmaqpJnQpNQWZt/Viro6UmG6jonc9W/GKkompc8jE5Ll0ihsHHWWAMvEpk8dr43yldTb2DrHo5/ZKnN4pomAEdg9/nzpye/Cm144BRd5u9vqO5QX
+odC8sz4ozJgIvpN4fOBZnaJ8u1a5RnhI3hT6tv63crt49zeZ1yi3uHj3t4/lfxzHWMJcO1OtX5pfMNGLOVOKjP/gq3soHiAZa9jjASMjV2YVFcFJBTxci4RL
+09gc7CT6ygS4wlKIjZWqMspTUJsJygSjVOCqncCWNYWny3z5MkIT9DOmmYa8HXo2tXkUOvD6Xvn6F/MLUWDyB3EFwUfvm3z7gMWNoXQr86+lzwkelhNL0HxO
+ruaUYcMUB7lGGRWpFpjgIufJk6L4p9a2VTgCcnS/fCkhB3SGQuVLztS38Ab8CI2k3pTO1xERfu3O9MrBxCEmvVEcaRQd8Fvez0LnK2O1wJd2JoFyPoeboX0z
+pF8iMJzMnxqavyq/MxVLgeD50n233/ME+wm2mTrim+Fz3pQ+iEbZ4GcWTYDfrfJt2eYVV9K740yNbiwk0Gh3ZSM9+lj+2wgmdXSZr/6t3Ia7m3Y5odHiMBbK
+sbNFemhAG+MZureNfSjvylbmJR5bSnXTFYt1LP9Wbl++3d1jLMEPtnkJFWGwRMFqoIw+KeLwB27nF8iYzFgNuOuIp8V/Apks+bCTdLhPAXabjzs1mmq4wzpE
+qAJ66EwajCcPK4+vGskxxmMN/+lOAf+b/gVbws/Fmm0f+1hLHAh+z6eLrMI/cjn/jH9mdWuqfzAD6DP+/5tXp9/28j+nf/B//n7zr6+3xtfWHAwEfAOBgFea
+vhFpARJlez8ikBrHnuEtyC5GhwgqRT5dBJQp1pNm86nP2jmUIKHaWwNaqf+f/8f/8zkvYtBfGDDEEXDQ9wkG/Jhgpo+HW9BxGbgFX+ANqAOOyZONEMM5MeHV
+b3QJsD9XHLWXi8jTGHWXia5tE11Xdt3zpxEnfWco79NaoVRjWPLGtkbC9i5hUBhI0AAa3KMUtk0ZFZMHIwKD3LPFcJT7LSq4YEwdlVD0h+uL8+l8gSHmRJuG
+4+EKfgHh4xD4HmBkzWJppX4QHOWe1K8mcj0onqSgW16t5/xQLHx2tZxeMBI5dCyJjKtnVXb0r1HZUUfPjMPN/PrslrScSU3m7WI5W94up/r99fU5ju5iOv9p
+djZd/KB6DLJZRCH7A/z97e1ihiMFXZ3Pb28g1n48IN+jfq18jz4o36N+vXyPHpLvIfZZJcv0NwjwgJjs324gI3Ex+bj4Hp+nL2Zn0yvImZyfL2BRzKfUE4QI
+cT4m+hY88tP17Vz95XY+W5zTB8d61kbi/mZQoLwrw8EEY42531WC7wwoqbKOn/bvu6Z0BQWCRG/u7HY+h2VxdJnvgab61bG+HS/G/FwVPbefKDZVee9x6dwQ
+76nhUxDLmtf6zihhJyUkV0KUQF8l+oTG+H/5InLNSlitxacqs7nLm3trEK7XetEWlmtgbOHZ7i7P9KzJ/xtUItzt80xfoeT5X21j6Dd1phb7pswzvdgVEIye
+rO8xhdzmtSdWEBTyrm5JPigQymAXH3KnfYMUgJ/p1uBykD9M+f8/s/9fTs5uZv84J+Bp+//k9bevevb/12/+yP/9Ln9g5vXLgPMR6z/ob6jDvwP75nq+nFwt
+9Us9n07O9dvpu+v5VJAIYB4slpMLyFQDDgDu4PfArWU9dBfrIvJiCCdW72OsWDBLE9njTHXwYnpXt2WFEGEsuVvljVnvKqxKyruG8VB54Vi93WObuCCJKpNS
+bNc+RvA/IReHtBXwYS78fSzdA3zFWfp6Jr8oa9di/UjjozqLkNM5GUd2KJfBLezGsPCwfy/ChF5Gntv/+O/Cefnq64xMcgUm+aEkrtb6dCyGAdDinDBgqGRe
+Y4ncc4BVTPwxQ3sFQNLTxlH0roC8xrlJCgniEl1/I7MozEPeBhwNjGeyXCDo+pnExrGRnQBZ3tJjXjzTNujv6Zh7RUQxzyEejwSVDgsvgj+qGE8eO5hUUM6C
+/dgJhK2DyPhnDP+d2QKeldcxuUymhnhq/JjR2GO7X0T5dp8lAeo5en2MLZewNPCx7rnXR6Nr/qmfXGxOawUoCZzDhS8QcglEUkVaY1iTjyNlm7iSOe6nUIAQ
+h4d3MrHxzEAmXcSgKvWK6R5Ox6/TuZLjyTCJqyfwwSbBTwRXN8DXo47S1+vk9ccZjzEBspC0QrTB19wIeh5xMygCtKX8OXpgaLPAE4RoVGIDSimCFDN+2abt
+jyCOtp8Oz7VHDCtcjZnKS6oheUlfMjkUb0gon8qQIecCadqm8WAKCTzvlYQLz3Zr+JloeWBwUBVKisJ8ISC1KUptU46dIHQdFS01qKL1gx6CzOaBtT5iWJaX
+wMSrcv0lYSat9etxVNIL7FYnXTod6DbH23Dhzb3W1DvOWHj2ZKHO6D5CdWJXUoq+uwNfABEFT+29zkQgeVEkeSWJk7C95WUJ30lYL/7SUVc+SCa/84X6Scgq
+sEhnAzGrUNyNG2BKrtpZqOrxQqgd0U5/DMTEO1UlvVfYe+8T+U/7QBST8hAulKuufLzMl6YTGbsKL2Oyd+LiF93Szk71zEs8V/SUsW+ESsa2ZHQ0NqAXtEuf
+wQjoOz5zG1Pg9raNb+G4MzDyljxiHor4NiSmmqyR0sfdsmTbCdAFoB54dQ/tZtgHp8/tg9p+0VZQ/af89q2gOlshFBb8ht2ghlawfmYF/yeisilH14GNkO9R
+HtDze/r+RDdsGEIevSdGjO6ktOtot/FVY0R6ORWIobXhm9VaJE2o5N+li/LHT8gsms2dLcrA3evlbf2TA6AEXyHv5ysMhqK1zzxclXXnooof5EUvcFW/HlrV
+Uw9N6f22rCPgSszNpX7j4T2QNVCdU1nLqfzUSTu8TtXT6/S3AorU6/EbseAkvZ2LV5Lptmwrb3O0pjG42AR11renlD9kvh73kxL0mprBDNRUT4TCcizCzSeM
+8Yq+FFhpuKY3Lz6bpi2FsCLia7Q+T0HVthXZddo2il5ZmBxwGGiZD6cyMJyYyuEmMy/JjTSnITubC6m8c0Yu3ZtEsB/SBhzWPbuGRANInAHj38mY8wCXk79O
+MSI8BOxnLTrUnANhOMmd+MQJCMWPVfK76/ns/exqEhTffL7hfDqf/TQ9j386lI1RlGPJWC8PP/dxdnGBWQP+EDXn9gZ6lunJYjFbLKFrPgiB+v2ZWs4nsysK
+SDCWXvII46eefHtzPllC/mZ69QEeC4mJhbqe6+nfltOrBVh68n3Mg7ybnU0uLj7Fwn5Xn75Moi9TnRKGL0r1IGvj6VCGh1r1YfIT9cQnKiDIz74+jNLSpwSm
+2DH4LIvpfZjNz/GVUIPBv7q4Xiy9Sh/9izIvUTKG6o4XS/z3r8joYApFXuSTTYoTTAeTSj6Z4jMos4WevJ9Pmdzm9uocN8AnhfkeXNasJzgfTvjgcpvPp/Dm
+5ewn/EpIAi2nF+rDZAF5GFgSEpp5Nh2zGMMHFlNKeE0o44JzpSY3Nxef4EFQBgILGHcaPO7dZHZxO/dPny4W3Hmef9mknERTMNLnn3BVvI6Mv0DQTkkLXwB+
+yKDzwumlUz5AZxtmuI4YUD3LRk+ha102ro3jI6ifyzXykW9HhyTyJXzOqzgvoxegnAJhHFHIOCNcMh6u4UzPgWwHu1Akn+6rD7/HinWSjp4xmhS9Wj9SND4u
+cGD4foWGJY/Es/tl9DU18LUcLnxT3tdxIijrzo+p3U7qyrpXpyKdNXjLV/59IdgUcl0DLAjwbGC5zeLsGxQvFqaJ5QNi+LUfP0gdsXUwKSCc4FpO7c/Dw8Zh
+AEWKHjpR8yor23ALEwIAbJ6yKKlYDiFy3b7h/LZm9UBCGUXe5silBx5OFkUBbSMZrIPuifKidpQbS9UW0um89yvEs/zQ6uL4ivIqarloLIVYCQ1jeERe+XWN
+Da1M2xJvGZBaNTBDmTq0X2K+58w/JzzmP5GvQ0leCBC+PBm/+gelgJ7O/3z97Tcn3fr/05NvT/7I//wefxAO8XaymOqb27cXs7MQ/P+JgKj6ZPxqWLsKFszJ
+WI/OQoXIrTMi75D6Q7GZvAkl2UlMJhRmwyYlfhmK3OPLTsb6xYuIt+jFC34TsiQx3zeJQDVGzuNOVAc14OG37Fhdxh4evuW08xbNw+DfRmQ5Mdd56oxQWBfN
+c5U8nvwHVLLpMUPBE9IPSzjHpy/K1a7Km1hbAdv7mtobPISkoUmrYDwuu0Ilne6ooe5QrXVXSonGfZU7E1XOs9May6+cgJv34sU0SGvEwnD6UkrgfcPzqCye
+WH2Rihc5qcgxTy66wnw2ld3iOc2yF+3ew6ojRQ9fVm/XeIdg277GtoUyEmnEoTyF+I4PuQdMsuN5Mv4GnjWDDZJX+pyaZZpkQlLJC160wq8U6h97D+nweWOr
+uHQ/Jhua/oIodz3B9nwL7bmAvBWRb0UjjJlCuvho9o3rRRhkLhXPJVkQK341320hvZGmQAPDosY/J+M/YWvox9GYpCp08DE4Uy68YIGcJw+5FzzxGRqMzmc+
+wZH/AgkOyj60ikjzKuMJGjXbUm0ZUIolDzQ+CQ2IWDUkX9HQZirh+FuHVjiSzdqbglljsQ/fQVeTDROGPkqIcD6kMJUJfjuubQoDrTA06dpmt2qRe3Cto7hG
+b29z5PdzaXeuc7Lpjw+mVumadoLcL4h70ZmGiT+QiCXTefIQXUId3mSsJ1/SAyHLpqIoeGDMtCBNV36pDTdbqbf0vto8Shg895wNrkd43huSg889GZ+8gklK
+vuEnKd5ldh1IIP2Zg5sAtw/FxJHdNkQkv3SXwsCnF4cigvJy9ZB1l2vZ+hkbUn7gMry8akxe7NObNd2qye7EgYAdx7IrZ5jWjDWaYiURLHCp7WOImMGqz9ct
+ZGlkszzJwZMxR0pkwOO+2iKB8s7Re1ymyjp++0UiYIK7lS/AE7QLohFPztuONOBQLDXIBao04Oule6U3qGRki11lnC7DMsz0ttq5Dl5F+Yq3qGpTdhZRxzkP
+dBZBKaod81FVQYX4mzmvo7Ay+TtR1sMXXJU5PSpvSuQcEl2UcHiogcODLjbklQHGljqLDLL0apBBDKaYU6sHW0KOctlZ/oSk1Eyyt8GSa1o5VBmZV5z+9/CO
+DkGwLox8jy3Jwryk76YSrOA8FonmKlJT15axG7RaTsd69MnuXrxAHT74azM69qu9cz/nzPRrupqVDBmhfSjyiRtmvPGOtl0nF2PWI7XN9XoHJ7vioq/u7alR
+VEzoEVnJVX8D+/WdbeLGYekhdUwF5YuggyNXPa4zMN/cgDpq6QbFUZVQK9NbnxREHfF3/CY8ymNNVBVkcP4+mqjqbu95lQc0Ue86kqiofYq224A4qti+fVFU
+bZsvVEMlAFW8AbqGEGOqICXRtfFQAmus1PBvUwUrkq9CLNBLEq1KpakSBIzysYOEUsz7VwdyYXQUf68UTCKtjOEP8m44imxjPrdtlGY5jk5xdbcf6KKQskWa
+ZcLMFlTJ+mJkqiNE1DcFjiJzVlyT4161UeriSP41Ke+OrGnGIR3dydjcMAKIrtA+/ocumSxQnTtTSVVuYhFlB9XD9BYWOga4CCoEPSe4EJx1lHGidqtI1K90
+IiT4hUMzVgqKvqLcbYDCCHBNjqPT8QmsD2gJjEXeSP63/GyYeFYh6KE/3xTnCjPXsYYGRNlVx3w5Ko57ig5xw6BBIu5wGN7zvT45xssC79EgpGIq00bR3qRx
+P+jT46D70PuMos/YRr8+FmkYL/Hl6MCDlfG9ZtHoJJ9r1/2HwbNK/vBzAQhe2pTjj2L2hWF8vz+MTsfxRe6PoMWvPycOKh6q33JkeWDeP+jwSUIwv/HcwQOg
+c+6kARaKRBWDonuDG0+xjYYGoN7VXi3sLnelL5DEj3QCMrnr4Y3gFPiVp9eXHV5Z5/RKmqKS0FXcY+4bqQZS+zhH4Rcz9o94Jv33fCQyGTG5eqNvHz8Fp/Tn
+o4rO1Bi60Dkq4Ux4KiYXN3BwKnGE4ZAY2LCHn5uIpw6Nhv7i0XjmEOdj0sEhIAc4/PXJMzxuCJ3eMNxOpzHgIT/r2cP69Nce1pTHKUx/oT2QVIZpYxqOgTGE
+M1z1z/DBTz5/jKv4pOyBXIem8OCJrr5s4Q0e80dBoycWZxt4/3HI9/HFgBfWm2eOBDo+0/AoYT2dYa2HL2v+WMCySUD4Omg0+HsKEbSTQD9FQi1iBCx75y65
+O6iZiCcwXgU2/rG0grTbJECinoplPkMuHK1kNPBV7AVEDl4ydFzxnFK6V/uu5aPs+qDzqA85jz68l7iP6pvxCdmNXjMyQEFD2UL0HFxk5rNp9tHvvbwjdiOV
+nQtVHrVt+fglWnnLIpg8vhTiicbJO8K4VJAShdPqlI52Ih8kxaKH+m4bjCMEKc8XYifA6YwDEgmFSHP9QNRxaYqEp0m612sYhQ/wk5MwoKwGgKOFVXw61hOK
+TrDEybqTRIBg52WCRf0VK1lojmC/RTTHZZKpoEBY37JOxy+YIUj+m28McQ8zQ3CIQoUZYLri2mdq9IGUj/Ipn5SWDnmxSdqhMAfe4ntF9ku57jI/QxueTTVl
+ApjcIMQ7iReJPqZuH0312eijk9NjvbF1++A0BjoDirtsJX8ANTRmBaPkH5ap+GGu/EUffdN5UB5lG9KVnOT8kgWhPDt2p+Ot5SIZv+SDLFdXjwvRJRDG8viS
+obOKhsdF7xDhIvjG85OLtNyliJxi4jPN6cqugBsg1TDphuu7OroBzq9TOH9nR/SA/OoAkJ+PgF+B4VcdDP/AafoksP7yPwesx/Z4iC1fW+xhq+BhUxQ55jVP
+OToG0owSsYvO98x79xyV56RGWR/YpoJi7XL8oxiZnyg5MBNNwCYNrw2YkbJsBD0sHuGNeISXeQsXh3xSa+zTEtfeDVpnZNKMFZRndg1HoSvnBZB3yuySRdwp
+KVRdp7SMtGC6ZVb8EbHIB1xElVzcYKifwPicjk+zpNWdZRe0ej3yOr6rE2AGoqwLPbqYvp9cvHjBkyMTw2gHGCy/9rHfKsJweP10t1tDgBRWeWHavKxkAP2R
+RPAuGGA67GV7rqhQNu4TgZ+YoC9MSTiBO7nJznGo8oP3cWfwCG8HW3XbVmy3s34VjAmdGrDQgzZCMvbo/kTHsE+I4YjR01uomkRDW7nWbF0Q9KstS04lKY9N
+XqJrXZWOGO1r8+juG7vbuuMgH7BXq7yCG6IVNSG82qk88fEBBaQMnCm9TSSQvMcwsuFeoZGXcmDeP3fHSa8nN7P+7nnRSbMm5lRku28be9/kmw1VwXKWDGO4
+h7eiXffE8Gmp0vUmIxKqGoV+Lzp1JzezgX2DtIuhgKd0PI55GwoeVFgKyaisjvU8YhGkuyp+g+cYJBRkrJ+KnEwoE7bdNW6XQytttEzfHOXHit3hVKyxKs1n
+gVU+NfwwNMnvfcmvFgzUkTuWGEh37KPdHOqg8LDCEemiH/qZZTmmv4b6Fz4Dr1gzJLrRix2tDKYy9BdLSJIL1AiPtMT54BthtkZTDHeTFtgHNHe7Y9Xq8Ng8
+NqwSsxitg52UJQXYBRYy1v37vffgyvICx92tWNCyMp9hzOget83+2FPi7hzkW0U1rip/5mLdytqfKVyFhwS/A7sZ/IBC25rrgJr0MIQ5jXW1sSSexOJcij0T
+OmbuRHJeBiRR6DlulXi+wnxLur6j6MMgrHicqffJ9d/30lRiBnieglAXk5gET+pSqlYSwJE0ZaRB6WUpvRgljOqwCmVrk1EP7e769QO+JWr6iM6k6uhMhsI0
+Kz86bKNx6CsJpPg5gvtIly0EY2y1a2FJrSqTc2C8J+c41H91oP+6dDSutN3hlRjKDdEETlH2tS3VcE/gexRYSOKKT2lbqoNj0guwdxRl+11WB6acXGLoEHY3
+Osu+6cSqkjo/Cau5SNg+yg50Q2bTbgkgq4NS3AIPTAwygqMRroWTl6/HX1NakLxAIxSyHWeFZoXVRvnMUnKYEHlyfR/ckQPBqj5lQ2TsqGeiCAl2pitT+WAf
+NYGdTGNwvLBT6121LhGUAI+NNp9OhoFCbNIbCXysbO225Wpndw7K9YOy1Zc4Ldmwy6Jto1a2gt80SGQ95MD0T7BDVXwqPbE62aMhV2rdA2b6kzGBoUSeisVZ
+bzSBcjJuMZ6Q7A3TfAWKZnAuVTp3Kb+mhBIOKGsOzr/K62KgCNg2h/oaVJba1my2aBFhhBVjKZWY/n60gW0uYToZWsU+rM9Xi6Md0z74TLbYLJpv2OcnBQdc
+5dEASu++6CBuoyAooQjpQRGbJbcJx3z40EWW8P3wHaEG7oinT2h98IRWv/KE1k+e0OqJE9rfTocP4G/HcRIzPmk5aJMkOfXdnhMrsBF7dbSeJ49gmuqLEM16
+AGwSvxL6pCBJWnm2UiwwY2NuBSoxnVXCpV7psS/vVfFKCKej7KJ+bATDIpFIyBmxL56TaQu1TTsKI4eirbEKNnS58RY0vANaOkDh2IG2pdsePwUxR0YQuC5V
+VdpszVa3o6Zl+t93BQOtGoDXKXSkpK2pNf49hnbi1h2euNaqPkzc+ws/+BhXEpaK5WslBAILBh611zkmSccaCejjC05upPgOSmMKwdxWSXjiTZAjiL/vQYU9
+/uKeOzQlH5PvHelmIMC/28tQ62Rkmbo+6oaSZgRnsNpzcIfXYBzdQYWagsiM3c9lVUEb7iAxWBkU3IRTEzO/umxpsX7dS+t1vMhEhQ+jCMLPEod9h48ccGNJ
+P5zMCTXkXTKhgNz9/f30zdgbdh2FaH8uISLzyjzGFuBb21TwbM+eF1MhH7144QvvXrw4xgOMRUc1QNYRlE9+eW0e1efh9/N1UW5wJOC/Yz0FT5k/T+E2L7WZ
+49op6/td6R4g+i8fq3ebu+iU/QasqymubHhhp1/X9aqzfX3kiHvgM46Jr90xKsXMCCr81WO+J1RqWe+8TlHZDueqcp8x8caUQv80+IA74Q1LWjvwNOGKSTMx
+qjPYoXdMl4azRyRVtYnrgvwvcWh8tATBKSG8SK+Pcpkdt9Y7+n2wfzRVmDvx/F9yKSahAiEVzL3WysG06RFtJ9FXR9arwmqMyxcE0CQBzLL1m5C+Mlx+oJPy
+A9UJEh1HFyEc443BMMWnmGjQ2WBBbh+a3BmHtTX/UVZV/uJFpl68uLz+t9nFxeTm4sWLTOM/5a9XpnWrfGvgX6PLm4sR/hD/O/KThP9YnI0yNZq9ffFCe2RJ
+vUawU7XXrtyUsIapAcLZSFx+MDgJNSLjL+DAq62kkZJPkLHn+tZeQFEm1ZJGlW1sPOIAdfaTPNvXxkTWpY6sS0Wxcxw+VlNNLBsZss7vxvroXUl4L77JYKrU
+oRhF1i/Fie0+eUQn7qSSgzkQFdBbNg7ysQ7hEsZsKOZ9Z4bo5ILVND4OW+UNNypmqKdt2umrILDl11v6dQRFukNcBRkjh0bzJ30yPlHeJolY4yjlyNLN0VOT
+E+ap+2Osr+uq/xEVfQQLL37FaTNOW8NlG/7A2Dm+7w9v+ch6VrGK1Vhfdo4d1120YmbQAYATm3+2ZSGbUOjpZHH+LyzNIxMkA/C/+JfqHkFVEoBQdi3TEPzD
+J9YDLaFlJ33qpy6JVlPSbHToaaPkcViehKgp6HNNTm5Nh/ng4/1ly2Jf/mmTjakL8hxmmZ7h/2aZ/oniQD/NopgRQ+P8V/3Ts0PbP7yWv6vUBPNHubxVH81m
+x+FjqOSVydb2hZuSByCtQML0mEKFBIOUgXjcLq6Vp/YCduLbhOHo+p3w6wD9ydn1T9P5FDQbzlOFB2KDSajwkfufBR1evNBvJ4vZwuscqwM6xzNkg2HVh+l5
+pPuQiD3wUyLOl0xFNEDLD5MlK06k7X03nyK3y/n03fQMqHYCW9DFFCWRSZ5hSA75eg5qyC+ZSwjIl/EVQBwzn+r5bPFXPfFc//96OwGuGtSxuJnO313PL5HV
+5vrdYLugPyAIMNaLD9e3F6R9kXwIRnqqqN3AncMSB8ymk8GX9RHwLJEExYyofKY/TS+ub2AaYwqiSJjiWE0Wi9vLKbdqsZT5uJqeTReLyfwT8wjhsM+nN5PZ
+nCiD5vMpqxbgrIclo6IlA69iYiLgbIqJdibzQCvES2asr65FpSEaACWjRCrTs39jYixac6wNEC3A0BTalX8a6+V0fomkWddXY6X+5KmY49t6AEfg8W1k/cO5
+X9YYGdm1diN644oDw2tM06f+PV0VVM6Mb/Ef2jVsUd81mJGDj5e1ev1KF2C727W+g2I9jPdSyQEdlPRxIp0O8Hk3RHCmQlqXwBTV3neNLAK3az4Tq+/e927Q
+d7wR2jonFbUU1ykbXeeU2YthYGUtvIV3Zm95cKMX9DCYSXNwik59CJFwYa3RVdlK+uQO60NNg1HhXPLZMaCYsRRH5hcOm6vCrKoc0oYQFEEFfZ0TGuTYl3UO
+Z4jSWoNhUoGOWSYPRGwGro6y4gmnlxKehQpqkUnHQd0wuHjlNq9b4K2uKnMviQWuHMMHRB97MYxnH8Y9+fFxKpQDc0o2Lso/gKaJXqtbi52i2G0CqmFX+3R8
+qgcnOdO7LRRe8jpnuxUNh+gFKuy1bWPd1lPU611dGed0ueYd459EQBHEaWzRzqVHo1NMEMzv9VF5zPFayD00pSQVtvk+6V6uN7t2R1wZ8HG08jw0wigutpGo
+XqO3uSPaY8YRs8jwMHa7O5oYlTsC9Dp0qWjyR/Hb/HqnxdwNBh7AoitZfN0X4ZbqDJsfqEznURelnkgRl/Oetgtk/Xh7walS27YzUAXNbjS6HP/F6IXBZDSZ
+e52usQEsA1BnB3BdyRqJFqE+tAiTozo+wr0eZtkkdC00MLIwt6YpbaFZ/wrMLYCRjKnaB4MdnjLrIW8KT55FRQBZHMp4eu9KZU727ObVg5s3HSoZm6G92x+v
+o7teyQrw5YOuqy9dUbl3NRCwS2caVa1wSWWRaWerIuaxJ3nZh7zgTz1R3RSvU7gAXvsLgE76J495WfHJJubzk5C5f/+Tk+EX+GC/iBvjbAU4MQ9Lu9vHtHzO
+tC0BqY6Zd9Tz8+Itx7fvUE/DnuFpJzfAH0qf82rX9aeePsUPYiKVJ2oFuB3sZ7ihVsj0hirYhjaSB/Bv8DeAW5dG0DjtCezv4zU4s29SlkBscmR8dFr1J2rV
+n8antPcyTaR+hULQj3dSRSfERXd+WIm2ceIpmaoyjTtmzxtcOYV+1ue8KovIROIUIcewoidFdmGYwsi4SW2r6Ddkj343jvyWhCNzrBTZsVfX+mw2P7u9JNpV
+Esrzv6LEB3FsBh01UEnTQSVNBZW04yxSWIsV0zImNf10fZsNOw4ZMnz23IZMHIrz2UJ+Bj2JXRb/GeCRvZihL4lUtsLfiT4W8JMGbbzlNX7jZjpfXF/1SUuD
+Kl4klacOMpiKG/FhAl1HltGnPEj5noL3CvEq0KkCh22mP17P/6oXy+ubm8n7aYZ8v7fwUM8lOteXk4t3t1dn9DRqPCkZXlx41+sSXM6klfQyGAjRovPD84kn
+CPhm1dsp/PYKfMnp+RcSo84WUQ+TtaboyeAyEk/q8jr8Eof+fDpZfkDtP5yOCZDf/uV2jk7n7UUgIIZ3Kmzti0Wszedl75ZIuwuL4Axn+WLyEfzZD7O3wJOL
+TQ6NHKvF9eVUx8J4C31+TQ29uLj+yA89u7hdMNts2sOwNA6ujEwvrmlwwnNgnqIHXU4+JWOjwDsn7r5XY6LvfA+L/QopeKewPRfT+YJDfz2Yj37xYhXKOsvW
+bLIXL4ihLG/xiNDC/kEJzjd/0mfjd+P5GISDXp3oo2vIjZ58993Xxxlic1gl3q7TR/f4leA1dZF8SPU+lMJ0uGkRfCBvAmW3b5k+OR2fnpyqo4XZStuwAh7a
+Rgj19qH3cWxN+OHp6bfjb09fnb480e1DY3f3D8r/6I0++suuNtJrOFRp4AOZ6LQuoEgWDneiTOo4wNCCSGiGjaQAW/FEYzCxJ2N9OVucTS8uJlfT69tFN2Wa
+IJAN+fmmja4emJiVaZhnlKr+N1hAoYXCb8Y3o/jTAzQ1+sFUEpDf1QYQ1CtDLEU4Jf674ZZuDMDepOAxzVYn4jSCpImeSqEQlXridynR1VlelWvb1GUOjLeh
+Bc5XyCbvjCUoq/wRVf3zep8FMQGfkznOdLitWcR2XZWr9qVdv6zyx0CYD8xrHf8HKsyR6sIjyXyRmK1F8Aq336psy/8wYONndKELmQ/AWUksnvL3paN/wdOt
+voOsFbqbdZ/UFhyGycY05SrPGMLjnZoUsdYLdNwZvz5o4FSszSk23DtTIE7uzO6a1lvfqGJpmprRk5RYDhNEzLf6s6l3RhOJUlnrRV63OZTqNBDM2CF0M/pO
+gHtUFhlVqDKlW+u2sq51z3GSraC19FG2uLyFmretbWqzdy/02hj6tflli4YqgRDzLoDBj/kVO9FngJKvyWGryYTPV63zIB7MFZC5lVd6kRPO/T3qcpYOXtcY
+56o9LztTICuegmWdYmNoQfkVG7B3eX2/y6mOIg+ESTKxCg7nttmZwjsl8K2iofhEyMRR8rezPvgkAm2X6eLm+moxiy5k4r6HylbIDLSPxtQHgMCdwIDwifgN
+EU0scbVQWTp8tcg3OTqZTUn0FIdq6GDemUpv15YVCybApkiQlD2816dY74+4M2Hx9TrSrWJxvOO9aAxVaCYCuUT1AZkRwhgi08cYVJQApCFx2JIqzeqCdr3f
+kiEVinNYIqyHSCZZ5Rp654F6PFevx/oSzKGbi+lLDmSTAQzYgd7sIFDVuPKeYlsR50Rfj8zp0eWuasttZV7yCBajsRr4oWfp4zXafy9pvaPyIHSQZsyoJxtA
+ExihX/0RdHMhfLdRkk2YsVw470PY5CCWkU/WPowr5l/VWk//hvainuiXtMffDmYXR8sOY2YQBsyb7ql7ME3pI2kn41cY8BUWVTM6/sFDOkR3M7yCL8NhYK/P
+o3vEMpWHJVwBASOTt/qhbbfff/XV4+PjOIBkVnbz1ezmYvzQbqpIUDSmPQi4nMiqSHkRYJ+w2Drz4ShxiYZTc1zFzucncxBUJZyfC2OSl62ZMcCLxvsjk2wK
+2Ip8RsAej2GBvZbT5C/7pEx5wggUVkSS19SEj0NbljS8ANFzI4s+egCnRb+a1dumZIRoEPw5OjvufgIzMIoFtuaJ7Gd0aB254+/1//2L/mg42C+nV+ekhqJm
+46hXOE723nbyVh7kTcVkURxwMP+rRhFSLtOjv+Sf8xFMJf5tgRn9ka9MxwMWv8do9QR+qZ7mw6aRmM3Geimv17cuR+rI2QwpSCLpn0tROsJRTVSBggjShq59
++OHaYNIJw8m7wJGzSuhAdq4VyqkOaiRf+WpIeOj3eoQmMEOMtSd97PJiP4EmGesRdgzgfnVhG4dPdmMPdHB65KczGyFMKhvhvI5mb0fUWoShk6elWqsNPYjI
+d0kLaSBkiXsxBnJ7q4xIW0vbKNE2wlvAid8RzhRs+uuxvuERuIIGj4ffBk1OXidH4Z3REGQ3hQo9pdUV8GEoWLHhCXm0TaHjzwYUmKQWN8apXnf0s935r+H/
+/8vi+uofp/z9JfoPr05PXp909B9efX3y5g/9h99r/v3RHF0eq2N9+urVqcb1YZt7pW7Cwi1dwkYKGZOndZIj6R+0G3wuPVX8DmrfnTrSsjKObZqg9I0vKUxe
+KV/909EdilRsnvYA4/ogIQNkwWPmAdwY7BZD9BLZ4wjS4BnlIGCvmC7ArpPWZQI5J/OWh8h5BpykJ2Ck7ZoaUYGK/Htns65JOKRIy/DCrswtpwjJ4SpdfBzx
+r7xrEV2eKmI/YJL8jkxhr5scVZR/dmT0wIED/5bKmKafS5CqgyDpkPqbGH2ZftrcYwgWiI71AVj67S1qp4s+loR6n1ZiE9U1dUh1DWOwHbm2IQ02eBVhgRYU
+4hU59w/XF+fT+SJKKEgKAV3nLI65Uzw+yJOpIEUGSCXRMnsXpU4ww5LmT0QkDQLhGUulQZtmV/C9K4JI4VDrZEZII41l3bgt59MJiPsv4Mvxh8fqv+5I++PP
+rzn/c3djmpen/zDxp+fv/69Pv/62c/+fvHnz6o/7//eb/24MQZ+OX/WtgZOXp69efaMvy9VDbip9nn8uCz0p8o1TKv3wyXfffQcffqVnEJnTN8j+rmf1anz4
+o0sKnCJInLjx3jZlWzoo4Kl2m7syV2oSoF5N8F//M6YJWRWQjxkdBztFdbgQ/1N2imI7Rf+n7RQV7BT96+2UyDbhy1z9vWwT3Vr1q2wTJsEfNk/cU/YJ1yiJ
+w6tYHlEkjO9MZR+PB60Y/bwVo/pWzOk4Af7//9j7n+Y2kiRfFH3r+BRxaTaP5BwIJVIqVVdVvzaDSEjCNEVyALLUOteunQoAATK6EhnojExSmNVdvtm+8wVq
+90Zzze7qba6dXdPOF7mf5Jm7x9/MBEhK9ae7OnXsTBfJzMgIDw8P//NzdxHN9lpn88Q/7tSbVkOYtXf8beUI74baZDyiupQAh/mnY5hZwDDXdbBPwC9vVONe
+vreQ7brus1G/Yxv0O/4p+h3bot/xx+h3oC9FLXE5LmaySeu7R9VjkarnwCe2za3DkPDNmAD7hv2ZvXszuJicDb8bjuuAh6gpbw/79MKrvntvmx7JEj0ywkh4
+5Q90y9HZ5cS+0Ku3363rlMzrlFaHbGQZjCZBgwRaAtB/bNFEcY/pl+9ZO2l9FoUbhh+fYS/rC+52EP98PkCAh1sKw16+J8Oji0s4I2OAEfmNdUYGLRp24OJ9
+nw8HR28aEwjdnlmj07Jd9zgKi/GX47NLePvl+8YXIPkEIDOnrMZ6fPtcI1W/D8kdA9iA4xHS/+KMD/8EUJ6Jayps33oNRyQ+4j1aH84XfgkTxKQL2KCTIatF
++i7O+GQIzZ2jZWyd5ulweIzHF+FV/YbYgQ17Nbg8uXhycXYyhNlRK2a3lRfDU+ADICXw0ugUUBCAiXlyNB5dQOttNnk/uRi+nfQIrANpLm/gPF8CVa21AnPy
+GJzTy6OT4QCwUUewKER5DUbjo/Hg1QU7HXw3ej1wraIBEXV5OjqiX/gPQZ7JxXjw6tXoiE7O2Un4oz3KJ6NXQ2a7lPO3g6M3o1N7jt8NB+fYB9q9MTrl796M
+jt60dX92tAK7jV7gR5iEczIcHNtvET4KEVG9Oh6qR922AfnGz9+8nyDFoJn56Xej8RkChLyY4Xs7b0av39h0IUjlQers7Pc3yLe4/zlzJwI5oynBE1M+FsZt
+n+ysyp/838ng5Al0Pf05XcDb7b+DL589fVH3/7540fX//UX+oeE3k3xQlPxETQvJ2P+Kll8mFyUflKUqq7nk/1vcD/iQsfPi7qNYTqtMcugKeSNnfCbLUvLG
+eD2e7QqIxihDRpI0JZ/rPL/7KPlckj5eQKoopqlXRhZMlvAj9WsFjFjBMxiKEOXScC2rm0IaPhe54Zn08Ko5/K7QClVs+q6soLDFiVY5n++qq1wXMFrrcz27
+hMwuAZ4q5Eznubj7P0su8dugxJd3/wEVn4dZJjlm4sIkgRRY8kflM7WS3Grrsiwxylm5LPK55AuhCsmrnFUQXOQzoGWpFnweL21XFJBTMMh0YfhfKpgmPb4Q
+quTzihaA88dK06tC3X0s7z7yTJXl3ccCvyFLLopSmVLBCGCAVapkdz+SxSlVPi9w/bPZ3X8YPveTvPuRZ7s0lR6M39hVLvhKVwWfViWb48IX4gb2GOuInOwi
+ViVs966ubgpVhE/BDju2gA3ZRXCH5ZA5Et5Qb0PD5rtVLi1h+EoUSOasojSBOc/1clrAduSYNF7wP+tKGYMwBpyjzPmS0CdKFgx39e5jeMj0cAewCQbPdXUj
+M3gmWKvwe7tHOqchBcAIFm44KiGkMlXefUyf7vPjFhYldjNcwLHJhGMyOx/g/kzw+d3HBcXLgbxVYfd7qQsBvvIhZSuWPT8KoefmFc+r5d3HAnYczp5y0DNi
+dYVQM4nsDHPJ9JWaKZkZhkfMQLIaUUFU4FSQ6erxLVfXym0vNu+s+nyU0Y+VMYplu5ZIM7epfCb5XyrFBSdGlR9WME841XcfcWNpK4n3lSlpF6ASnC6Ewvj8
+ieSGdpmoFGZmdF7WOAe/NtfqhkpSL2VVOFFicB/Z3X+WcErymS4kv4HZIAZriS9U0afmMvlanx/twlLvfkSoGR4nCfW64T9n17KYoecjnwvq/U8LzyU3sIcr
+LP9byFnpkmRAFt79yOH9CE0IsLxZcfcfpezzI2LRGX52fvdx5k+UyoF7qp79G+03Cjngc5lxcUPZj1XJjK5mChYDC8mUWWLPCZUbBayip3+2S4UN4DmOB5MF
+fCvIDstr7kF8aqFyhRS6+wj/yRCyzUsJTEpEAg4XyiCzGOBFOGDED603Bv+GPCaVIz6K3I9XsmCwv6tCoDgzkWgzsKt3H2G9BpnAyIoO+n9cZdJKeSBarpcK
+v72EFqJ3H/uMHd/9+yvMITk7nTD2f//v/52fWNEHV5vKuNkVVyBpYzFEjcZpW6k9aT73ItPWssdlAt8qkN8MNgAmark61PBaaQMCWwF7Gr6XSWMHENUHeNTc
+fcTCRMZeQdaFtd9ndAOZEhnT8hAwBGVl3cj4dvOTwjnDaUtvO7t/JasJPyMzncNn/1KRYGwuAI8eXEtY2TmhYESJbxhDDn1y9+MTPDSeXG72KNvd9FHkxQ9Z
+is81KQEuunoDP9M5pmolyAMwFKwzgyNXXcPc3NSiSzYirjQtE0Ru0ytt/EUQhDftPJEBDjGQXvBQOXwumZ+7zIE8BtPX8A5PFRXXdo78v14RCvI2LnHDUBcA
+6sCpvPuPAiWT3UW7Rg9z2iNJyHUVxGJM1X1YtPwgl6sM9YW5AFEcPYDTMcJzh5uhsbW94BccK6ECF/jS7pJm6VhvVdx9hJNPu4jSZ3H3keqe4H1qz4bALgpW
+DUDQdikLeNlC7Xo+pF4lRDO8ysIRskQ4gkdhfTh48jyeZe6roYuKG2nv+fhM2IEGVYMZW9ga1TB0l+fumiO+Dm+RVkWvWnEiiqkupLFH0H6f2ec9P/chAzKd
+Wy9Qd373kfL1JK8rLiI3LDq6M1SqoXwvN6RIelrZ8wzjeZIly/G8bjk93LwRYbwOECYHy2QJ2QTdTKbKUDWmqzVmcXqJxNwGsjNd3ftiKq/DSbcY6D4/e/kv
+wwvGjhI5aBVbfxdazi/4fXLxRleGrUBz+jdUQWOjxXOWJZNrFHyye/fv4Mq6HPJjyDHj/3J2OZpMwOPp5uVEiClBki0R5+mVf2+5yIjkDdnOYGqIg4dT6eaL
+UvtG49UpC1RdceWZ8DZZJrxNZvUtorYsvrErOIBJn4xeDscXd/8Oazg6O4e0yb2zS/hpPDwfnx1fovd3n7HvYB4CCJQJ/9lgBCY0oslUuZWYjhezHv6BwcTF
+UhnA9JP4AMlchAdhwrDUv1RW68oEL+XsOkcigMTTazhadiGH9YUcj169Avdnjx/vgr9xfD6GvUqWdvfvEFYZxKuzDODJlhquVnwhO/hrqBdfXLriuc57ID8z
+HuYuQ532xl8yJasenMpSAQF0DqK1+gBDXRWiBIvPKGRPZ4XIf+OpNuKZulKgLGJxMpALf9ZkIpImssIu46naQBrHXOYlGRy6coxGYn+mzN1HSqi++7+4kbws
+gELRndXDHYCv+ReBwaUpVS5KQRcxmAaJNjOPtaQHDAFfVxnyTiGsoWhtUVicH4qxPfpvmRkuyy90FcsOsw9nVsQXgrtEaOQeN7sqi9WOHp2+YOve/RhxR3jf
++jIMX9795zLdFbrxHfc4jn1W59i3Z8ejV6PheMs5c8c3ZcaIkjxZfbp0YErFV7K6kV7lohIZWRY4t9UpEy0GmOKmkoYh58Drs0ziAZQ5n2Hbnkj/2bM3PekP
+1NfUGWMw/eiOZI/dGN7cGEcf9ukb8ww4ZHR6dDY+P7PBBRDtu2fDy+/GQ8Yu4KPw0t3HjE6GqV8Pm2xWZ+LBiv5SaQXGSS5pGc5Uowgx+LrAqr5y3q4HbTDD
+pVa5E6V2Ns50lYWwxqu7iO5+bChMVID6u7MJPx6fQar08e7g8mJ4Oa7fs/muoAknt20OzAmy3WnHbk9NBb8vC+kVESpOMMwxz8eg84QuWvt32lEw+250VgUf
+RKCz4wDqV/hvDI1Guq939WJRgIioPhAxEhawk2ufExp7MxDAQDj8Apoo6PMQs5ku5njXiqKNeF/24VgfX47v/t3pBBilDwqBfZyTzYlOID4HOUZTodV4zcxI
+Y5saE8/2+Qlpfqzd99qz2nyQFLuRClWqspLEIfgZ+EgpZsopVa2q1LwCuxyVCfqvuWzuL9VpBsJEvkdoRG5JmMvo8loJ2g5Qe2VTb7f7upLFXP6bL6bzlwr3
+lkXO379Uu6jD4+POnKKPko56pZaSQ5orqU6iwssXH7/7z1K6k+BPIXl8ycETJlyw+yebS6c6wMs0GEhKkcFNNZeRmsbctKMZU53qk+EE9Za7fx8PTwH68d1w
+PKFiBFs5iS7FmhGN668yBV9YYt0emfObSjpbTyzvPmbKVmHsMeBnE9nH6McQM/phDr7E6gbH4X/9P/7648xFGISNMPz1x7/+j30gp3FmtGSJP9bXDiX3JniE
+UOSGu67U1Z81fA1Ic63VBy5zWJHb3ZL8YvPaibB/giuJRL1w33LTIY053DkkNeyJJr+E+ksFJKPuDJC7StZAuoPJV8k8mccrqxmxVNfyBEPul5MndvMm4AQ1
+dmq0gYY8izkxnr3QvBsEwwPW/u7zi4Zlx9yFyKd3H3OwdmZK1liO11kObwPruIxdmXSpIwuwdsvN1zcEfjwbxTU2BpcWcNIwylqtn0Uh8rv/r4Bk2S6O+3nx
+32e/Zvz3q6+eftWI/37VxX9/nfgvP+g/43sngxP4j/040vvXH12oty0i+HPEef/nf/+8OO/MP7chitka4uU+xMtaQry71MR1Vo/wgv2tq5JXoJCKWZXHkV5y
+YrAk0hu7L3EV0qwKRU4L4wx8okRV8CuZUzwXf7L3467NbCY86EThFU0xD36FwrwQGe1MiCpFsWNLOBcY5mlgmPloLWjBfi/CVHsbGEHYHsyw+F4cG+63RIYf
+FgS21yVN4q8/fmtDtuwTQrb8npAtO2njFNppmG89xjyPGBU5QOaOp/CRROO0HGZLQIhkBsg1fXfE0POIGbwrH4/cGNl9QFgXzRc9Fy1LtvYJmEl3H//64zc8
+28yezKBpBBOiIrpZFm5+wWeqmPl2Mtb8okLrMDPgI3/aXc71kLyRYNCATo2hpBtd+DgsDWwDls7N6whuo0J0XoiCbCUr+BRWy5WFdWruYhSUYi+wTeb+8GQb
+c//1x83BydmWQ+29xR4ggkqxU4OR6N5PQ0CPnrfQ0phQL5GZPiyPHOCK8cx3QzthiBlh3RhDZYqPQyjHoEg/BVUSbFIQmzkpjaCju8P21/8BRxqFXGYJ7/re
+y14ip5N4Ws/GgOwbdqd4SWf1L5V3rcOL6olYFXf/QRM6qb0D1BnV4rD2iVr8tTY7ir7iPH6y6Ctz0Vf+qOirnVJ78JVZSpT8c4OvJ3USAO2OamFXR92tYVd6
+iNXDrvwTwq4nm1ikZXKN9dVjritbCxRM+WbMtclvj4m9sntir/ye2OtZ8LK5eyuOvJp4gvu4+GswLnl7BNbugGfm1BUt/WQCfbydl5cbIq3soZFW/sBIK0ZZ
+YSkXnxFl5ZwfPKFwXP+Xisc5+j4kFucnRa98VjCO5vWTBuN8I4/HxeP6Dw3I2VXjhGD2Pe6ib7XgG3N+hZ5dGH9Q9G17AI7vxeG3XhR4g59cMdfh2K/nMSG4
+//nff6EQXO/xMTi8Cw/6LUG4rTG4TwjB/fXHbzHP7QEBuBlJrNYoHF/TXawAdMBv7v5/QAu3bOQyR2V/+vDLz/oPjNupckvkju+RpKw+sPa4XXSRJPOmwXVe
+UozIxLcXFLr/WeJ320N4/c+L4TlCbIjwbA7ONXhvRuLJBe34g4J2rfG5Bo/9pdpV7Uqdf4dL2BPle3SiECSzA/FVKfrD8ZLfnUR6JVenw8ziXvlzpC3AkSSa
+/S0WnhUlfB4/8PzTOakWcGRRwJF/VsDRhtow0+5Pw4lVucSsBNYAQxRBprJC3m0Jvugq/vWNVkbl6FS2gUhmQaAU/0FUhobcWWACWHa4tFAvsjjMtgjXURLA
+7OEzpP1YU6KuiLgpWF3T3rrEiII19q/Kw49h46BHIlGT7GaMjDZURmYRuFhbby6d8woh0TSaIsvWfUjk5bUGS1taWpEDHp0+zBMIZ5o6DPwnyRpKjKFNgUHg
+u13IfLv799dxXPl//n8grtzHwHIpeX1xyRnABXKZG7mcokjPLUHtJMHrh8sQxkDcmUXerjQecN8+T1RYo8URZzbUSkcZ5MDdx5UEiHRQydzUehRGqW0WsyNZ
+aQAS5O7HcDxsloRfHl7OjfWlTBKdbh9/hQzCu//3eDgh/ent+eCC8hzv/p2euczTyEQYwwsW+JJRXJY8BJWNclIVN51CwbLuNo1dpZG65wXF3B9/FjFSdPe6
+Q76AM5xVswKNUYNqqQHRYuPZUdEBY2PW1jLfxR7QsiVtAd6bFaoE04d0KLt08mM5GY/ruxKFyEtS/VailEWuaqaNVZTDXX4T6rUFIyNeJv9LJfI5cJtTAWKG
+wM+7TuE0ici5a3fl7uOVyFzla79ve6gnrQo9g0nuO0mPY1hqONmb9pskyxuhAH6S1m8bySrWdtfYtAMrddwfiruPK0gcyMldtoXabKWNQxLQ4kLD4oC/CGm4
+wxO8GfDJnwZ+wXIVPw2klh+kwVycx6MyWGq519AZ/MHojPg4GbYZpME9w2+EabRLt6P6R0DEgb/77v/MpY3Cov/fGIE08EZnRCy46LJMzkpw9/toL2ycza0e
+WJljNy1WAcmcrd0juCQL/wkswfeaZl2PBbSHkyv7VBoVx6DiuRjDyETdV+1AJehelIbBATBxULcNu9L/1cArkfZXB6+IFL7yk2FXnF+hg648FLry9YOhK/1f
+GbvSyIz9m8OszKXXPx6PXmEReoV/Nnrl4CntawJf6Qf8ypO/PfwKeA+CadfwJoemGydDxKpAoxjs+3I8+tfLJndKUwIrztUVfEjmiT+2CWTh0oW7oo4Bc8lf
+ygKFSEZxhYbL8TMAMCevz09+3upv9+E/Xjxt5v8fPH36osN//BL/Xp9eggdqDF1zXw9Ph2Mo1nL58mR05KpDhcz/wx53vW0OaoXG8VevChmVsnqlq3xuI8FU
++O1LeETkP2Qq55Oyx1+pRXnNX2VaFz3+UpsSHn074E8PDw6ePjl49vSgxy8nA8aGN7JY65yapGNlstL1PFhRC9Cox8KNLKaiVEsel18N7ciZK9nW49OqpC7g
+1MclNDTP9C3WlftfsXC57ehNjQMLmUlhQi/z0LtuWohizV+fn/T5iMaqllNZyDk/5FM5E5XBPv5XWlK1az/CoRtDF3OVu0H+N4DeSAGWbNKanbqGLDWGICyp
+BfrDIH5LZCnFD5KLW7Hma10VWABvrpfwF3MtColdKmxUXpV9/nJt8RqGmjNyYIrXMpeFyGpNBlxZdduJotTsqkI7T8r2b/HoW/A3P+knT1xrH1MVEj7LTFxZ
+Dp6FlYoso34dRmIh/ItoL3u26r4lfeuMe5RRTd2GjV7aEv/YBNk3tZhv5N2oV6YtjSfyte2QSXsOQ99eayPh4rjWeHHMFKIMYNJIYWieMBO5/RnXtdZVNEKP
+l1r3GXt3LXN+i3MUPwBfJDTrwZ+o0+VCFoXt1WNJTqV0V4WayT4/qwq2bQdjbok3QZQwMeoVj0xPY7O0jUk4Wcn0+J7d7OJK2t42ynAjixs1k1wtYGh2q8z1
+fi98Cpvf3khOIVI+s4X5gVpXssRjiS/yW5HDj+FVBs9Y1iqvo2nYAvsrJWc0Sxgkx371OF+bpWq+pS29FiWDD0B9ffwSjDvXMCY1ysivkPE0vFjKWUl7h8LP
+4JbkMqJk4lfBuS50MVVzYBydSzgzc5nj0bSfoJE4NWUU5gf6k4Y9ATPJNpegp7AYoUm/whANkokSBuczWZRC5WmbGWXlBoxs6dm2nywSZdh0yj5L1SU55ou+
+0uCyFeSy3TIWT8a6vZZ4ZtCVh2td6IIJvpCyR5+oTMmvlOW7Qs7USiHsAgRAWD9R9FbyK2DRta5sZ5LKlKzGyOW1XOO56nkmixirvE54Dhu3wjwylf/AhOOR
+qMmUW4mfrG2zFHqqaSp8SVVAbY+saCFGh3nhNAoJH4Of275DvZeW4gc45sTmWJw8esj2qiLXNF1i0BlqHuZorvUtfcByM7bGw5nINUOOp7YFlrsYO6sKvpTl
+tZ7DHlqOh7EjpufXwvDyVnNTypX5hu8d7EdVMeNV4FWzd7jP9WIhC8vzQYDbhlWw7dTmJ5NXIouLftpLPi5kymwdU8uU0eewXo7RPWR17B7l39LFrvGrAT3j
+VpJEccfWHRvcImkVDoa9XUwp8nnoVEQXVK75LfaWti21cVkoXtxkgKOiyTHlfIe2s61eSp1LLjPbK2UljME+N2FyqjTxUSg1CilqDnzreQmltVVeSI+wkaQb
+157ZsZ5t4wfQOvRAIVZDztnUXmmG3ypbyrSQiwy4WefpmPaS2+WFXFWlb5zyCv4ILbbgE7GUJfWpkKKUcN+gI0bkZbYmGliRTb2DoV2exBsCJeGNVnPbvDm/
+QikorNMWQWN+b7G7SnIR4SpUPlc3ao6dvpltW0Tf4aHrU3mNqdGu9b/H1bkuwX4VealtmSVZwm66b4FJylcFdQUGJsDdwy3BVtGq5LNMiiLaAJoDns+p1+vm
+2NTMMd6u5aWKKsjChrjnuEAVtc/YW21K1NWCfhCKB6OqE+kXyvhWM9N1Td08vWSblCc6oLfCBJUB5olI3XLtr1Lb48fpZcxpkY/QzdwBjJQyP/1+ovbBWqBd
+Gjn+JIJRfSldvyqdy2/51F0ImhdSzLkqmcr5osoy0ufmOt+lcNdS+h3CK5+r3NoEMKoRS8mF76noPsJCi+gL39ja77/gRq5EgU3TaN1Z6DZV2yD6kD3RbJpV
+dOnPsWXqjJr7ATsgN5PEcu30SA7ClHXBxXxu1UJ/h6EMNuDeXfPKuGviROV4s9SuOhFd2bYWsTeR4D7zf1VIDFyEwTUlX0hvsnwOj4tcZPpKV7jbRZXbOts1
+VsJFRGh1++s+f6Nv4XQAj3PBS/mhrESGY+OlwUywCOBaldCmUc4qaqoHnwfRMcVetdDBr8cF1WEmn4peJGKOJZNPT0srK/OykKI0HIOn2Iaqz9hLMvu8CQrb
+Cgcw2tVeRLB7PoECIjDMXM1RLvhu99nat1wyUdeNAjsiWguUDEfXGqowzI3iGm+FI8ffobvMVs+2apf4QRYxIGGJN779LLNfA84sEZbm96zKncKKx5pYTy94
+rvMniU7Ob3WVzflcrgqnC6LlR4W5tYme1AtONb5zuVCRBMDxTCnKqqaGoqC5lksjsxsvU7ZLqKTvYqiLHggYL4L5qVnTL1IHUAbfXissEYiV671O5UxmYbjA
+xYIi7rrm+vEiQycd1oot6nami5UuUHaSpFr22d47K45gm42UOUedUHMxu1byxrYCFIYX8koUc2O/hMf7Woq5LEij7bFpVQbZRi/PLcO3vAszJZgpX1T5LG4S
+wyzR+/tgzfBrvZKR/FNWC8mkQJovhCEfONJ8abvvp0SwAnhVyJnyai5ao4FXqRvsam07hJoQDIQHkxpLVLQeyu6s+SzTRnJRurQVq4K7q2cWBLHgOyBZEEAy
+t4qTEyTUNs09gSutDPGjE5Q7aNtxmwk10zlch4YsYlsxnnkmjwwr4Cn8FcyxoHbKt9jlrNRXZHXVrYs+oP5Le+PRLedRFUAmfwvYls4bNQc6NKx2aAqBXy2v
+RU6vKOO8LlyjF/1iOH47wZLOvjo1lf2FasLY7N536XeNzgkbRzWXGXta6yo48P2sI5UCtC0vCt2KSKPx5BXM9hVYZWIWltgo8q8L6/UhBVj9m5zb3rRGrOlS
+ZUuxBmq1t7Ukngyts7eJHbYnMqNtazq+E/ejhbLLQzG7droE3W3zeSHRehCG76x1tQOmEN/xzEe9VgWfaYw5W8elp004oNa2motSwGlaiULOmdEwrmMFiEwo
+iSq8vWhJc2he2sY1a6iMRFUhSPHki/i5fTzrulhG97Y71zuWXDs96u/QIw9Y2GSQlo2d1oXt1YtzuBaGTeGYtm6PExp9Pmg5xfwkJSQ1FmXRX6hD9zpWKXAU
++kDgpkzcfmOPHc7eCNAxGD5redKpA/HIrkEFebN8Z1Pv69b2jCfgFGcoe/fQnJuyEDCPhS5uRTHP1mTYiNx6NG3T0z7fe4N9h9EJ0fMjgGzEG9FqBS2d1Kzk
+B2LynXg6O/19xnYmweWyY0UNrt01A0YZvpCoJiEz2BscH1po7xBJF1pq1GlfxaKrFxwzsW/RngTrVIr/Yr3NbKnnWDxSlV5K9Cx8LV/HzV8Qq7gQCI5zKVX2
+rsTnkT8Mdig1vi8IDFnoLMETooKcm1JkWdJGPHJrgIZHbjwdZOvDbjPUDXJdOinOnEi2EuVb8iLAU7oqjUKj1XAz0ytJV5Kghu1WZ48si8rUmdW6IYK2Rxq0
+rspVZXU0PKwi9g+4ieHVpRaupz71Q477WvONJxNAz3MJeELSD5jVHEOHYjs/NB60zvjC9lUh2b3f5++si9KfzgIBAzCmYdhcXZTJWHNtO9X4v7g1pfd7/Hwf
+AYCumfKD41d+kF1TZ2s2s423Y286yAhYar7mSzlX1bLn3JXz4HoHyq7UrNKVyXAaLGQNojVBTYSA0uhMs7Pl0VPtnQVDOx4Q+M5N9i3/QcoVnBrgJ3cC6TWr
+/OHRr/eXt4EX3FExNQSfw2bd0dA1ItbaN/mRMm37KsWyu8+Y3xAKXqBf2rfnX12vjZqJzJ0DchLZAIyw/klBblfUAYQNEeiVPc2wKjfXyAsJWyQ/uOgYCq+F
+tD2H3IyslxPHozUV7ZzhbqD0pmDo4HIerS0HqGfV5SZDUk/lRN7qIr7dEu1mYpd2QL2d2vgOdZullMgEtuOSkWl/KI7/BBkI3m1KV0Vl0DMqswWfSi4aF3+f
+sem+DwtwMnxdw/bICVtqPhNFQWazQuie40ZTipJEmw0O0SbNo2GcY2AuSs+P9FSfsVnr92+vdSaTG410qti1l2vHhKVG+pTXqpjbQmtmiz7pHFHzfXA8C74Q
+M3Ks2BvZL9vxS6RCeXWMlA3ymegFQzWQ5gjJT5l1XYu8TdurST33/R7dWAxvLGHw5eIKQ/DO5X17LfPkHTK7b/QPcH+U8Fcf0QDXFxP8Sus5QgGvwQGii5J6
+bvnoT88tmxyztRn7HuS4qjVZ2zENkAK9dE6mVFnGweoXJVzx6LOXBZwtg1cAuDoAS1bS2TN8VRUIHS7kEm0N0D1UfrWowHu7lwTSoi3AKyqyv0De4zn8S4Wx
+X61tBEa4DzB3Zcm8VAWI7luZZU9QK0E9Jb4ZcVERMfCKxwIXsscn1dTQAWaHc17Iv1Sq8C6GfB2/98RzRINwpOw4NcP9mTmPN4lFkX3DVWM29a2xEd9k9bUR
+aV/aqAQapwuX4kqo3J7AgUudHgs8j0hVPKYYvqGkuoWCRTFLGHvmRGnfsMpVo6dbKlfBEnYOYjHN1gwuXzWXRW1/4GHvNSYrXuWR18oeh/jMU5BNlYTTND0+
+1zijaJnaSO6nf+vOUyTj4QNcGJZ+us9fVuWm58kn7kcViNZDBrMUZLZRn9l+7Tg3t9dfnXyEMVyUhOl8s9ADJwh6Bn3YkJw9DqWBD0nD5YdS5nMXQ6WzQp9x
+bl7yTaOyA7/AaAwtixxcmTT49dtrzW8LXUqGIfGL68r0IgQRDE91TvxULZ3wPINqFAX9UdM1pDKw0NyRTFfb+M8f7CmqAt9aF0sv/hQZlJSwgzLBxsxjq6ON
+2LEXvPAeghvHfrhrLN01aL6fY6yBfNhLWUgurq6ASm5YZ1PiOoAqbdvf0MWgPqWLRGxkmn34WXDIIHBNGU2pCyixo4t0faT9BqkyLby3PcwuXKpo9LhNY+FS
+fRYUMr1CitrTlfJkGlTb5NxRuSmlmDe0UxgVY+F5pL0GmvMLTbAUZQIKgYmslMUjtOleikOInpHsAZPvBdhaj8cL0TU9ZA+VkFzeysK/girAFtjbZpLhdbda
+SVEkGgGIVWqVuaY1ucHdzBx2SJnr/j4/RsFIOJEA43JIuNzqoZaGgIXIZ9Y97p4xNqibJzvlzr4qIA5cBD+qjViv1j1m9EMXm3gwswz7lEpMXHWJX6igp44m
+OzF7+9ivOrictUMUeiEWVRZEuou1w9NefpfXDoeVMCAjV1Gq7jnMZKR7P99u39YPe+TbKtKIHIO7n86msyoO94H2FmjjsGJRnA9dRk0dmU3cNXWA0zkk46TN
+NpkRwGDNVRnc5t7knumCcFUQZWVLMbtWuXxSSDEX08xZ5DgxFzp3Ss92p/CmCaKcszJsVplSL0WhMMpqA/EBM5mXsvD2x2jREPMx0RwfT9dknKJtiLmZnhmQ
+k0QUjWfoILeHz78FetWNyFB1SQdouNecYgR6Aw3GjSiVWdi4XKyk8RRwWBurh1o9L691dXVdM5CCs2u5kug+b5lQ8APgbyPSAEwZfMAJk/tgQa5TDq0b3Ili
+A72xlEmglnSnu8/aJ2EXppI6JINPUM65LhLXOtjxyrhwQHsQ6cQHkSbkX6Mgt4Jjr11FLjqtTDTc1PW5W+OWzAK+EFlmvHMwvidZzfj0Ad/Mwwu2TbZJixki
+hQwXOYuOtRM1bSF7L0tciDvy2zb6OjsFopfEqB4SlguzYcpEtElCYxEtvMh6gc4Em0VFiml8NGFmaH6mUQ8EAt9DPPzNUpSyUCJzBzYK2eJ7TJlEsCd6dywT
+nLcLv2gjWQ1GqdExPoeNA0/MFvtVlfF+VWWY9EBsDicE3Ut56WxCnIY1m6KzoKsyXQaCVJl/QxnnGgpXEu5feV1IA7G8gNYjx4adjkVPY/wYoeFkO0/XPBO3
+JFFxm0Qe4zxpD9BvnVdLWaCbEEyopSxlAbaYKAU3ZVHNyqqQPBNrOEZ4g5O8xHwr+NEs4bJfilmhTfQLlWcqj+Nle6XMgSISjYdMGsNUzjOZX5UErLa+lMj5
+HU9YmQSM0UstG+ZwuqTQILImWzf5oM/3hoFf08iVMvaD5JnGyIk7h/VDi4F+MuBByNRu+xdgwJ/BfG6VIbRxvNFbpIFVkMU6xo1u5fgtrswXAKpdxweUJQv2
+roDM6NZlBPizxfL5gAtxNqMUtGzdlIfEzH3GYBLAF+AwdpXXSaX0qoN1uLqV43TsleJuFCdS2EPlMaEe59XMR1+ipW+Qrr2myudIZKnsKExe7WutZpGjmDnr
+xD5nMTdJSKseFYQfSD+SAPTVt5hrwQgfDSq55BJAbJK0lgWqmtPqCnFtTQe3iwh4THrdQ0yEClGReD5lLU7ElI3+EaLQ5RzEj3DnwYFDK7bcKmFa1j3WHujw
+2GOc0LzCRRP/oo9NmVUm1obVozeRYemCvDU0hF2+o7mf/1LnV3DAlj0uDLof4X8F2ZfU3Qp53FnfiHMqtR+96cgmJLefzlxznbtj7vMyvmFM7POBV9r9mu9R
+3fkG1Z0lgiEEDR2q1rl5PdDpVhbS767/OsEe2Gbdv13d38c4VlPOJbpQrBgmh6655MYit5193LhYPFr0QKJ3R74D3ELMwYmA+DE/403kchxiMeJdrtGqglRh
+bcGKPt+jpDoLyNd6nk4EXXJuU4gIFD9mehGF540N3qi8eQ3luuS5hDuZbKyp5Di1UvscC7pDYne1Rfolc44+BxfYdDODCu/gQ4Oqx29EpkhDESXPpDAlKi6S
+r6UoTI+VOuTIoP+VztG19HqgRVrhRCDP0jv0+QvRc1cE5d7YOFOu+VJT2CS3pDOoLtrghr/VY7WVYlwtZmbMuJ9sY+IL99iWuJRosYEAMCzzFMIhKS72nSy8
+p8gzDrqWMjgiaxc+nydiVZqYurpgaZqce9e4BAAaVjgPDIJS8ojXe7Gxm6Jctqr76O5zUhmxRqIUeNLqaHxMSKOhfUVOJ3gjOwbJr8oIWo0i2yH1vKLRa6j2
+sUCDbzE4O9HUUDhHDqJiiWpk/Nqeyh2KyY6sCz4lVD2QZD/Is6X4MzqmlysNV7Dhe/Y0Fj3+gyywKRwqzJpDeyin9GKgDgSKWZtSLrnOrUsmJQMvqhxrfKEK
+7HNM8FPelpj5tBOWvg2WQYnq1jX4J/MIxBq7Myi/dq5mpbMtLVIyThbUC0YeySjPpI7ztbEdT1KRSBa/Zk5r7jPrDAjfJ6e4yI1zoDr091QTuZc+sh10QIKR
+MkSU8LpdnoaG+ox9FRyAePh8MNOGUhPQ8jZMj1Fz+WS6fgL/ix/ngDfKJM9qMyNeibNyk49F4Cc+XbPUHd6GdohyBTyaLPUV4omwwTK2UQa2rgo+aB/aNGOm
+LM4L7J6Q8m5jzg2nZUhWvdVbFKP6mtwdFGuwKDIbc2deyahyPwq9na83rsJCdFu0INYKY6ibMoTjeL1Z/25dlB1qIWYlUch6P5gq741FAnnlh1Vm7ZtbTPYr
+NV8oeyT8cQO5EtEiFuKehH3GfheOAjEhBAtIX+pxg25/4kLUkmBR6NFod5czkshcQCgTGz9k68ANjqSxFQBmqyhLuVyVET+Vmn3eRLjCKpHEjwyVJ1eqaIZS
+ifrRilImWaNtM/RXj/XfMtDj8F71d7FrPKlDzjXeXGtdtQzpdTnmkthVEWon+InhbYFeYJuu4yZgwRrcpomRMq0ElTX8mrbT+Zj9HY7ZFLg3tVCbymcyqArw
+DjmDWXLt5pouTEwFvSpEXpJ0TvNgabeau8IiABhVNU8jQy5lXLizVeB5ulZTVXoPl0vPd9iB5moSnMh0naadJbmYMcvuWVTa5lgu2Xgqn6uZZxj6vLBIv3gW
+WIlAc6PpsLrqEI9J+KAZ++nXUdxR1BumigH+g6cWdF+qpbRAy20hre0LZkmGe+3oWLY3XIRswZDbaJNw6S+gijbTo6MjzNMsaX+tVmTllTpGvrOUeqngsjUQ
+YWGLqkB5n6oueS1tf5c5IIITi1YIIHvLOb9GfHn9PNmSBTZ8KvOFLkBzZeEUWpdIFPFphJ4ZFGgaLaw6O9M5hU9nFiow01VR8j9X8yvKJcLoeQRcsPm5Kl+A
+zUKV16heQXTb2VTPPcrMXSpbkMa9a0wlzX4v5kZYJJER0RTARHvOLThd21npYo56uM9mcTAWENz7IRpFuhye/ugTDVwM+hntkZYfZpUh5vUMlbzLGh6kSEOM
+NSSXDULRuzU3aom9vCUBiRHHoqeZurLVEVuENJ5dT8yVLEq63aPXrCtbGJbu4Tpiyw1n0GZI83qpCsHS/G+bbAgjWSdjodciK9eUkxid8WZ8D1D0hLrQBlFE
+7uT6mtzOp6sLlG72p/K6wGjJWlfBWU/Id1uwB1ghm3vqol7u/YbxRYePTa2DYlEIFXBCZNttmT5FNBrhywippAy/ltmcq5ycErrgVU4nUhLID7dV5GvEPKpZ
+lYmCGgAtDcpuXB+fiiwIchkPHydWIpyGkGzxUwGP1/oCV7kDcUTfNWSURWAsB8RsQWOpHL1StuSKO/RRoj6inYNdJotyTbKMgFyubotDcSGxVLl20WHULejJ
+b1ny8Wthc8t1lswwqrnh0F1XhR3RpX+GWknJHltL1wPvICUb5Qjd87Us9FUhZhbq8hbXK/Uqi2sXXMlcFq4SofuIcW7bWzWXvEBcTJRLxmIj3zE7yi07OUVO
+QeKJHI+2MqXP3Isd/KK0tvu3Nh5VrdzHKQvvi7nOify25pJacLwnublGjgFlEAmpWSLBLPHc/IIospNE+RRS7KwQJPFN/h+KWjiEzQauRqQRTBS+kq1tJYhb
+6xeZyoxyaCkQKVjtqqIb1ZQtIO+DQ+/ir+f+fGHLH9UTX0yUlAObYOstsJmuYHOly2rjU8/5XNuryXr/45wn00tVEv8QswmTIBLR/E+TYFouAwxWzefkWwAW
+UCW/kuDKWl2DVpTa11Gumfzg/PIkhf1SgqM6eVUZ5m1pCtoqQrxD9CK8bcVGZewH5LzPR7k9yYLu1YAOjNOunXPHz1AYYEnn5LVe56mer9v29BlFqjeWIlsK
+e4TNNS/kjcKMAdrwXN6GSpw2++mezHZSAtQS7w/4X4scSYaC88OmWNxBZaKAFZiVKpRTHimZPS9DqZlpVdq4K/pwsaBHKVQGL9g0VQaf8GVoCAE7kwWG3VDL
+toNxZSzITtiSCVeVMmgpuSeoxp4/C27JzitsmKg9Wqe7lZUR5M5etTsgEzJRBvjiTo8ltdF8dg+ndHEnsFttEXu2rFhzQ+LVsuCNT7ltDvnIm5jCLZ0lSWlo
+aFpcZLAjUkqEQPHsWjvl3s0LY1wPnwRjB8+9xugwhdG5QF3dNHPxSm3FblIDghGcOzm6dUU6j4q3O18QIsELRWXQwiXBbFW1hS4ii5q0w1AxyF2ZsbBrWzaL
+61omX9tEnW/5LWU/wyEzdCd437phDo6CRfvwHsNNgpPHp5JfVWoeplLean6lMQ6xiAtJBL3A170QWRa5AvBXrtpEWLb1RVIBDzeQLeNho+f2LvGvXJE4ydZU
+DPf0jL8bjMeD04v3jB182ecvh0eDy8mQX7wZ+sqio4mrJnrMX42HQ372ih+9GYxfD3vw3HgIT0QjQSkAFg3Q4xdnOCDU2z294OfD8dvRxcXwmL98H3eQPhm8
+6/Phn46G5xf83ZvhKT+D0d+NJkM2uRjA86NT/m48uhidvsbxoNzAePT6zQV/c3ZyPBxjTYIvzsb0Ij8fjC9Gwwk/H599NzpO1sR2BhM+muzwd6OLN2eXF2Hu
+Z6/44PQ9/+Po9LjHhyMcaPin8/FwAss/G/PR2/OT0fC4x0enRyeXx1DugL28vOCnZxf8ZPR2BPO8OEPKuGfd6DCZs1f87XB89GZwekE14t/DpPmr0cXpcDJh
+UEVhQDM/ujwZjPn55fj8bDLsEwFPL0bjIR+PJn/kg4kj679eDvw458Pxq7PxW+h7xs5e1bcRVsvfn132+eTN2eXJcfJ3IBNUfn81PLoYfTfswYN8MJlcvh0y
+ovbkAslzcsJPh0fDyQTemgzH342OgArQ22swGnOsAzEeD7FPGQiXF33YuNMzPvwOtv/y9GQ4gUL5/3o5GrcxAYwweD0eIiGjPWfvRicnuDv1je/hK6fvo41/
+z9+9OeNvB++p9MR7xxrjoa9NMYyZFOgZGHPw8gwo8BL+jNO6OENywPYcD94OXg8nEQPAp5mtv9vjk/Ph0Qj+Y3R6NDoenl4MTogmp5Phv17CFg5O3CB8MB5N
+YATgQbtfl5MhAz47dfxxccbrR3IvfLvJe/zkbIKMdjy4GDCc8cWAvxzC0+Ph6fFwjEdpcHR0OR5cDGFy8AbU+r6cXAxGp7QpsN6zMb94Mxofu7PEkD1fDUYn
+l+MhrzHYxRk/Ox/ikMhoYUMmZ68u3g3Gw/0e8gAfveKTy6M3jHaPJyf2PX8zmPCXw+EpHxx/N8JTR985P5tMRpYmZzSCo2OfDU/puZbSJAhs5aXmA7Q6yZ96
+gfd8qcGFVfBTeWsvNlA7mL0LbdEayoRIK2JFNU2tAWCvxyuCwIa+Jqwy/pIhEw5rzCEoYSnzuauJoMqadE9qGfKZyFnitgyleUOFWvStoglAijKMW/ODoAbP
+4nqSe7rocUxEyQVVourxDRGeDTWunDK9T4VWfXKN+0TPNlmgi86rWz7dJSmL6QowG7GQpsTr37+8dM+a0oJiEcRlQ9CEmtVUJSsurIVVT9Y29ou9dEnzSxPQ
+cSgcw1yjc4YAAgHJIfmO1y92GAI7ydJcaTSs4Ns26HQtKf5AS4TlLECj6DOmsVtMjhP1YJDMlwfIxdKOmnM1lwKnaev82AoEae3utRQFvaUXTmVy9fa87Zgw
+1rc++SbhJ1U6c8TXiw3MkrLB/bUBMaGwTQFlrSpWKIiSJhd5V6gu+F6al7/PGnp3v7nw2J1h7TesV+VKJzk9jTJryP6xKgED8eXUgm89fNlipiV1XJFzz0Qw
+5/rNrosHXOwTKR9KVSoeDm4atMoc0jHm13YQzAMGZ0laRSDjt1wtgI8fqC9Tufjep5aLt0Vg0aOg8tAcWPvCZ4g1ozLOwFgyk7Oy0Lma2TKsK6y7q7KUNgin
+vZKWhaiFqSz4nksx8+nD1oxZQlMdV+jbgI2V9ZhCY68Hs4BAIBeRQIjKZux4CKK/MtTCg9PWfQ7VeZiCrxl0b39L4hdfgaMM8f/3eq3n61xaemLEZbr2X6Ei
+OOHrKIAkAj9ZXAeaf/+q0NNdvheS0nFyUAwQZPAPuZ6afefjYOAX/xeYAR+LfK6X/I2Y/YDFAAnkVRUoZy7W/EjDBh7wwapQGXQQeMqY//V5IY1yGevfqRkW
+wRflri/dQ1V40V7/X9j/o/v3mf0//svP2ADkU/p/vDjo+n90/T+6/h9d/4+u/0fX/6Pr/9H1/+j6f3T9P7r+H13/j67/R9f/o+v/0fX/6Pp/dP0/uv4fXf+P
+rv9H1/+j6//R9f/o+n90/T+6/h9d/4+u/0fX/6Pr/9H1/+j6f3T9P7r+H13/j67/R9f/o+v/0fX/6Pp/dP0/uv4fXf+Prv9H1/+j6//R9f/o+n90/T+6/h9d
+/4+u/0fX/6Pr/9H1/+j6f3T9P7r+H13/j67/R9f/o+v/0fX/6Pp/dP0/uv4fXf+Prv9H1/+j6//R9f/o+n90/T+6/h9d/4+u/0fX/6Pr/9H1/+j6f3T9P7r+
+H13/j67/R9f/o+v/0fX/6Pp/dP0/uv4fXf+Prv9H1/+j6//R9f/o+n90/T/+bvp/HPyM7T/u6/9x+PzFV43+H4dd/49frv8HWKbj+9t/9A96/JWcFhXIgIOv
+v/6asWYXkB7+5ZG9QAopHyTg/27agZxIY2Thu4HYVIwqLw2nqA+UHKH0n7b7dUvO9bWkpGTvzmPWsQmHuGsa8uCmIXaDPqdnSFS9fPaDuJLmyZNyvbI4Do/b
+fPLEbvCmI4GUjWtLYj+R+7uIYGcDW5PVVFdX0lCpIuLM8hpQIDNRgFqarbmY6qr0eUjxEeC6aDXNWLMULjxGxX25KQtRyqu1n2BuY6YhFIyhkqR8BGLrcgsL
+wEqOn9fzBJ6u4Cuh9Qk2MPgbbn3y7ae3Pvn2/tYnMItP6X1CBxSU5aRA3M/TCCVqyoClGz+lHYr55Rui/AP3Q7EYCz2XP2FDlN7jOqL8Wg1R3sk2dnfY6fJW
+PzGlXNmeKdQL5Vbe1w7lVm7oiNJ7eEsUlrryNrZEic5rvSdKsw2KwtjkOu5ZEbU8YbWWJ7LW8YRy9lTC67y97wlr63tSY3Vredtd2dTzhG3vedLSvWTXRN1L
+QvLXVDKBPj+aqI9Q4zBU6X4qo7Yp3LdNiRug1HuacEJO+s4nthuKPQ9cfkD0S6hpGVeSxyL8Lp5aO86CO2y/RafE/konDfHIoRaEwyd3AE5/6nJ5hX8HBEMC
+PRV2KbYUdp9FQOBbIIhRpmz0N3FD0CdsNpKoKcquPLpPEojgQF4AoB80vfHTJDeEY4RWHD9/X5Rau5Ogv2/VKtkDu534jhn3NjnZOL130qYlhlki/d1n/bcI
+AYM+Wt/dwNXuIFBN/Gipmx0bQrGKqKJvUhi8eeuZ0uOdEa1EDIi6/zy03gjpHUnSd3mr49oEqDa6Sqxpgw+2ucFHWhzi3uYejuEtfsimDDhCubrFUVm5eN4L
+eAH+/6xQpSyUcOrjXC/xMG3nG+6+iVmamfgQxlnoIkwCJsla72i6xWakOUQcgQEJ+vbOpo9HVVXmWhqcKivDleLyKHeNP6I+kfQesoJpzNA0tpqDidEgAeEY
+mm2c2Pp7IudifiPyUlxJrjEfWy9XErP9mgxqNcS5Mv4lMgXKpGePy+O953DZKuT5Ou6V4lM5ItsywCxpcSz6esDf+XTLOoI10St1zguYsZ7NhPHo6EKGQi1u
+GKeCy3ymq0KQgcBu1TyOKDqAoKgJhHW4NlUJW6+XkupsPFmIWak5TG8uinkfo3RRA5FeUzB4mW49KHGudNAYKBqxKFyFM2FCLxCRqBfEfz7D6s96iqUG1Fy6
+glhuCizamRFdDx64Z3WZTJUlxfquYPnTNaH2YiyN+y4rdY0j4bwjpSKu2XqEqV6i1aIFNh5LFToYRcTGcxbqlTcIy2ROZVeEjRUXEbjNgnj9mBmapA5ymKwD
+IVDM81hzRu5aO+InW6bD7XQYHgoUUukkQjVPGKyeo5oUjFCl4TeiUCIvfROxL05UXn1oyW1lgywqPbRdhiorPBy4OtwIqB3tMt9F1Qm6qJiwf47OTL0AXq0i
+g4fiOYC3Hdrn1WKEF54WmW2FRUO5cd196LRmVncuBtX+H7XnjSykMHHXm6A/xtmpiRmSM1R0LMl/3SY0cUoB7Dmrd6Xhn9yVhtW60vDHdKVJzlDdAfgZTWlY
+15Sma0rTNaXpmtJ0TWm6pjRdU5quKU3XlKZrStM1pema0nRNabqmNF1Tmq4pTdeUpmtK0zWl6ZrSdE1puqY0XVOarilN15Sma0rTNaXpmtJ0TWl+m01ppirv
+mtJ0TWm6pjRdU5quKc2v05Tm0kguuKkULZbQ197Hv5QwcWWWCci4vsF9PmB+hPCKMngukBiQEIP7KkqCuqmlrJWWsN9krpWKKyqo8xhebJ3phccNxtqyRR34
+oYJqgg6CtGlHj/s8nCh6Ysu7Zr4vwib8XVyTwW+1hUrgwpFrXXH5eBdYVNnQAzWetJSyi71TZSRAhLWR4TmK6P0mWwvN/75aC8mutdBntRYKJCDrJ+4UAt/j
+P017IUbthfhP0V6I1eHIn9FeiEXthXjXXqhrL9S1F+raC3Xthbr2Ql17oa69UNde6FduLxTKGXYNhroGQ12Doa7BUNdgqGsw1DUY6hoM/cM3GNqacd/1F+r6
+C3X9hbr+Ql1/oa6/UNdfqOsv1PUX6voLdf2F/g76C/2ef26HIf6HemeA31OXoT/w39c6DfE/WAP3Z+41tNVU+bxWQ/2Df9xmQ9stwM/rNbR9yyC4xn6iVkP8
+ywP2id0oeFuvIdb1GvqVew39nrd1G+J/4F3Dofb+P//lZ2wAdH//n8NG/58XX3X9f7r+P13/n67/T9f/p+v/0/X/6fr/dP1/uv4/Xf+frv9P1/+n6//T9f/p
++v90/X+6/j9d/5+u/0/X/6fr/9P1/+n6/3T9f7r+P13/n67/T9f/p+v/0/X/6fr/dP1/uv4/Xf+frv9P1/+n6//T9f/p+v90/X+6/j9d/5+u/0/X/6fr/9P1
+/+n6/3T9f7r+P13/n67/T9f/p+v/0/X/6fr/dP1/uv4/Xf+frv9P1/+n6//T9f/p+v90/X+6/j9d/5+u/0/X/6fr/9P1/+n6/3T9f7r+P13/n67/T9f/p+v/
+0/X/6fr/dP1/uv4/Xf+frv9P1/+n6//T9f/p+v90/X+6/j9d/5+u/0/X/6fr/9P1/+n6//z99v/53PY/ze4/aymKP/Dfp61/us4/Xeefv+POP4y1tf7hXeuf
+rvXP31v/n2f9pz9j+597+v8cvHj+9KtG/5+Dw67/z99Y/59nPX74Nf+XKpf88OnTr+qXPPzuHnHLf39dlivzzRdfLMyir4urL/7w63XzwRu4huy9//Zp+GLb
+gtswnBv5WTz2pvR1zJuwcRRm1RBXyCAp/g8rNeg6ss1TOOdP+3wQnj2OcN74Z845YwOb7UFh8V6temNUdad12lvLQvq8TL6DSzw/uXe8dt08mu7ORYxODaMJ
+j7PDe/AKfsibGSm9qB4dEzkfxHGfggt+5IBV7+x16jPrAlmJbjnfid7eocyHdZRTA+424+sp5yF+EaBT03XsQbU9e5w/MNcxdqoN0dG3e0o10qvpLBMGMwgE
+x//kUVpgLSAzl3KJKtbSZs7b0rJt02Tp6zER+E5CsJ0I6LaKeiEQXM0CYHyubUJ+1kwguLgm962N77bXuiUfbMDXuvmwdwksXhnSn2xSMtU3p9QUK8V2onVh
+Ec+3KldLkfGjJBOHKkK6YpApu4SqkMk7bGJtPKtSJS/1olASFYRNSzzWUhFZ+kWqHZUU54kTqEVRY5yI4BTxRiZzTBWTo06NlArROPwoKo65iR5xhmUzUcdT
+Jp5elMbUhjtnD8Gdp9Px2JPkM5D3HkfzJJ9QINb7VBzHJaMRfQ76fBgnOLocylSonZ/ENfvQoK8LLHJa+PJEz3Cpz5sxIzLhGCXgT+EWhfNlNn+Wc37Y50f4
+UXjnrUv7sfts+t5lZK1m0VbOpWdTvXIyJ5L0w15Uvo1tKtO2uTZbzEtJFgJzg/I9HWW/P7omGwHf8hB1CjsQ1unToayYIRxzM2TcViGQKrRsK/LG2ou8DbZU
+EiM/TErAXkqWB5V441tKvLFQ4q3HdYGw5+A+s0xkU/XykM+4SQOpQyYtqsJmpcV1Xjjnz/r8DKUCChDQAq36hFyalAlwov4NFQt4pUKp5VqC9NLetTFhYcvj
+iGB7CQLmSxDw9hIEcZkY5B8Mysffj7KF2aZsYSuxbX5YNK2QS+TufovtY6V+VLI+ryXr6yLJ1e+xRoo+vABQ7QxVV0zY1wVfYC2kDDP344x9i/uf6vLaJVJ7
+tygdmtePSEGO6fezZiKzaT0rIf50S0KC5f4AfnPBbGdJEBs/76f3gmkT9ckTW7LKLXeU4geZ+xyTHov9wRZS6ds4teWZbypfYLNW5ZxZQZTO61PTz9Gr5Cul
+zm1Fus/njaaq8wtyR/rxR/AHi/hjtm+LfLcsxWW1N7LVTSMD3ulClM/7sOz2tDrYpjR3tjXNPdJ9HngaOOdzLFwWXRYxBzzdt6oI/mWbhn1f6dgA2NyumVLx
+M7wafDryAqNG+TyR2JSyg26GXkIMjHa5qhShD2HypXvyglPVekN6ec0soCPKliLPZRGlnEbq3ov67lDigVP12sgKMe1fLsFb3JvgffJpCd6YpT3dkKXNarux
+yXJ0l/3WfGvWMIvkPj+n25yP4ir4o+DrJ0ve1Ycn7zy48CNzPiTj2p5ZOCAK1zhokNgFjQ0nQuB3bE1ELBpq888agykTXPjwgs1Mt9lnIGzkFiZOZVhs3rsT
+Yg18OiNOpjRs/G0bk5Ca71nDBH0oZGM9nz8lJXgT6SnrL81O3SpnRD5n20VIn7fN46Clv+nWibnC1o840OwBB3ofBe+XkRriDddIFfn8fFg2pf/ln5EP6z7G
+cl3GZLZKa9r4oem7s75Ur3yHbFnfLXJbxR61iJXXDQrKr5u52rPri6susk2l0z8hRzXOS+VxXir7CfJSo5WyTXmpnEN9qrGFEH8XcMMP87Jbq+8nwCkjZR8Q
+r/4NY5WpCQfz8XZVBviy7yRruwDSEE2RfW8QgO/Y5LUU0Zzinnu8Hd/MHo9vDqANP1P4I/sJkM5BWUm6l8x9Y7gU/NwG/35Q1GQzOvrec+IzAx4No27lDb6V
+N1aF/kDduW0KjM0pZIsKHRI3jz3d3FyjOoIJWfgVht/YNS5/CGtTLm1APk3WjclkA4Yit2mu2ADNNbMrXMaVpW9SiblmS/V/Y0H9T4j//5efEQDwKfH/F192
+8f8u/t/F/7v4fxf/7+L/Xfy/i/938f8u/t/F/7v4fxf/7+L/Xfy/i/938f8u/t/F/7v4fxf/7+L/Xfy/i/938f8u/t/F/7v4fxf/7+L/Xfz/MfH/k/HPmf1/
+X/z/2fPDr57V4v9Pv/ryWRf//yX+bT+p4NY4oaumVDM+lhTOMYydF1IsoXYU3tS+AQIVfjGlDeQUMuk0XKIH3VahplKBc7h4NdmmLBSMwt5BL9e2FLkpe2mA
+pFYF96oShchLKZNxmRs3KkSFf8PJPXniyueC554K3bo540ML7TvYqwJKZBXGoQbi8ucPqITTRsEei65Go5eSh8azoWkVz8KrhXuVP3nCy/XKFvTP5Ac10zlB
+ELAgjKCJtpQZw+JwUJkOi+H5KnOjs1N8EMvQjY4G8AvGntr+Km4lA18yPpo5iOOW5dlIret4zITTWdFA8RdV8HHZkr6+ELaT5pLq8K+5EWsCcjDbBXh763LL
+LvdtDWvbGr4Xx5BTpMS+bdTgCzcr4zQ5OQeNYWetK4gxD/hOy97t2GCp4DOdZdYc1AvLeVNdlTwT+VUlrlCbXAlwHPhy+FPb1JHsi8h8dUFSqzfvtKxqp0fY
+hl6MpnDVw6lrHG7ZtTB8KmXe3k7HlUuDZuQ7bYZk87t2xaS/sQ1Pcas91juA04cDm2Ti9hvn0MHjLcB6abSA3faVuBw9qKR2Yh5YpK11m/i+nXVRFiI3GZ5M
+UxYC5rTQxa0o5pktNytyZGDmtrHP994g5kYsSln0/Ai+ljfVf/ZVu6LSztY1CATnO/F0dsAbsHMir1wni6ULzLewXBSeX+HW1xvDuQdRZ7KV/tLFo/IOPA3R
+CTLxdaPzYq9RJzwZpW72s5rZ/21oP+xauqvSUEt3woiIGaqDRZVbKIzlekaYlk17rkwSSXFdrnRVrqqSDD/rZXDjKeMm6X17MBXXuxP+w5SqJDfatkPA/CT2
+VD6XK7ivch9wTEqot8w754KXWmcop24LVZL82497l+NBoGbhNL6hNnQ2QGJXVIu3t30N6/YxdhCHPx+Ivmudfs14wpMGK8rB7zxX1bItwA6EXalZRV0+4Nti
+Rb3qRAktJ5zJr/MoiiTy+Klm2MQuwVY8S2oqsh+kXHFFVdrsVR9KPsKs8MCQeV/rLeIqQE8NBlisZZKWa4wo194RmWrZxT73BuvEcUbqqSn4QoZo0Op6bTBk
+bI8Hyhc45XRGqHuPLQeKV2danNC2w3Tz9q0hSPzID05rQumykDCdw8AjFkqC49H6ivtZw8l6K4eZlcNYDt/1BN1+rvxQzldInMrqbfZSKaaL+E5p7al+YJuY
+siZvokqwlJIYxTfvDB6Kb1xkTOyjuPLeb/woNa/Gbo98KtslNfn6fC/tmXA9fKk7sB+w1HwmimLd8AgaNJxda0wsJk/7N4+GcfG0uSi9QU1PUSSz5fvUHiXp
+Jo7KiFWC5lzADBx/lhpIxNImSveE+UhxMTJuamlclVadIndCBUScF7ptsHqfWijsPerhVc5ZRG/Y+wdVDDknyb+dr0TOptI2YhJTqtIaEHhBoMPDrlkjfsy1
+cl4amd3YRhZ5S19I31qs5wL+0ZK1kQEpduuQTYHHsbE5ECL9dJ+/rMq254OT1o8qCAKD0svusselPvgI1luEOLgIjee6M9oR2oO8nAryxyCjhTMFmFO1oVlG
+KYPopU5E9BmHQa5QWcHLAX6BpYlxibyQV6ClSULO3l5rflvoUpJic3FdmV4EEnddcfKyraEPXiWu3xh5bEtpSKwy++tSW6XaNuZwbZPA8lnr6lvbu7oXf4pK
+TLq+Zsw3NXO1RAudNYiNxkNQmnXhDQvXno92kG3eQfAK5h7r1eNLWUgurq6KqGOZ1WppTUChezWeljuNB3XIKdkP1J/24W+C3+issgWEuSl1AVZS0hxQ51a7
+CI7aaWGVQxatIEgj1C9bpNEzsHAS9cnZsjzXCcVbely1roGiAcpY657keGjXvY1UIucynxXrVVkLubS8sE+RWjG3aQ+64IQitT2N5w6bLecUp0XNzDhrV2zo
+E92062yEhHawx1JEsj1HomHQbZ64O8K2ByRbUDN1awts2yvf5zKEpx++jK3KV4P6/F7qz6i4Ohd8JWY/iCvbzEmZlBibh+B7U0lXr4qYzmG92JZvpw3x7yMB
+i3bSRgXsjB8x2Z5XK2v3jBtqi7L1nPrHkpAKxrtF6Nj3Ue0CVrAjhZcZgwEM9XSKsNnNPr8h1IKjOZhPTCD2aB75nBOaAIPcSgMFHrLZjb7SCdVawuOsFaIa
+7YztT9gKNLTDWpuDzSpT6iVCdvRtTrC7TwQVOvMGtISrR4AG3ZQiLGDz9G6FDjY3cgOMsO6p6AcV2WK42427xrEivB/zeL8t2MAIdOLa3rdhAlmIY7Usx+ED
+l5tggTyBBbIaLLBlQVDwPJraPAYAGrmlNbgjQQxZWGUS7fUY7LIUs2uVyydwgaEun0VOru3eEpfa4QHxZNTAwgvymzo4rJvMHum6Lf3DU2FjwC7M5/yQxMk+
+GveIho1H86L6MfcFge5ZQpE6DR58lcE2sxju3EzBCW3rPPRxJvK0iW/zHNmL2a/LdhRpATe2CDNEN7q/t6uf0wZUMcUmhnt9myB+AFKxFUfIN8DVtkpftQgU
+tPg6/A4eNdf3OzZbWRS03opEdJ8P2+QPj81yQ72NjPUtp0x42wNdPD3qG4r0jBqGFFLytRSF6bG42QeaiSQPrkMqhYngbCrnk2rqrKLnoufuWvBWCucLyDW2
+gSCNhLicuk3a/BVikLj9BcEU5vsgPNu6y0Y6CtACtF1cH4xE6RA+dRgsfCbiaBpGn3r0AgdPw43I0IBPXyTHnr6R0Xo9DZh3HCCRcEiCi34nCzpF8fG6FoY7
+1Gt7ywsTE1gXzLucqLmLfdcQ3pOutMKOEAAdnix0WzxWCsEh9d3m46cfrEbHdxVry+TjD8nk85oG0BhMc6/b481lQ6RB4evV+JP8UrEkh49hu347Ny7yNd5T
+3nue62JJYdfotT2VO8hR8FpOsbMQ6nf74bwuxZ9JRq10jm6rPWtqFT3+gyxymZGyZjTX+b4jLEkh0AdsQ1j027v0VtIRUJIVVW4AXZghh5Ilaj/lHIoOnaek
+Yenb1KcYVN9rsVpJL1SUiT1tUcNv11+U3NNpD/YFIze7LEWxtlhDjxOy/itPzBSa61drod19NnHITvdlMt5FbuKm4KCKIYqTfF35fBMDMg9NVXmkVPuzFPa2
+z9iXafN58pHTHQip+NPMuaV8p/te4meQG29KYkwuwFe1KqSBOIZXuJtZhmQBiRLSsqIs8NB2/zMmFUijDL/Rysa98AoUVamXorQIAtD/VS5Ki56wHqyW2YbT
+6HvjX+taNx9y/+PUaKAeHWYEVvshWch0pRZUHhWoigAm8RPDw+NuVTQi3AQow5GpnJpZhT7+fcZe1Lv/W/lWaospqzkhjXLd33Ey8A55apgq+9yvPdckP2Rm
+JL8qBBx5eCl4L6nDF+xcc4fYhoiIKk3dWWL6thGcmIXGpKtCX6upsnUiMnEb4Z1hxi0rw2HIq9KD+5KmZlH84Y5F03ubibt+mEeYkgeZyudg5FmmSkF88ex4
+qakpHPEnmPreLV4DgVqcfCPcTCsJy9oSeKq5RNH7+5XFdCD+l4KW95+qdpqwzTQpMdQ+Uytl0YrRCbSnx0S9dFF5Sfpf019cL3iQDmGWkVQIpNgopUxFRgLZ
+eqYdcZvKSGpMjgteVAX65dPLIU8XaHa9I9vjDEiu4InxlUjwKywcUbAEjbP4uMwXupg57yUdbJsvEMWWQiuw4BP8HVjfVmuoNT2HX1RFyf9cza8IY4o4r8jb
+Tc3Jfat+eIjpglRnj+qnsBDfi3J40Siy7xpTSbPfa/QVRjoi7wGn7VksLVXPgFnpYo7qjgdbuTgIXAz7wRVNlya5Y5K6M2UdoOaTSeWHGdyn8F2vwybvsob7
+IrqKk+72FpBkRKnMYs2NWlZZKXJJkXv0N+lppq5s6LV5l9BZ98RcyaIkr0/0Wo+MTmFYuofriC8fcFAFhmypHbP8gG3MqEGa2yt35Ch5C0a1vrBCr0VWrp8g
+HLDWpHKzox/2ksAEcGLghrTH24XGyeuS4eWgcv9TeY1N/GF5tpIBHv8cLFRCTAKLZHNPdVSMvGMrLh+Bj00lpTUuCrghfQAKt377UpLQUEt8IwqHKcOvZTbn
+KifzUhe8yunYSlRfaetFvmZRjZeZKmbV0uB9QJJxKrJwOch4+BjnSZBxnxLqngrB4NYXuMotm8XfNaQbRxE/VzWhJeSncvR0WES5EwxEGWQiw9w5tX6Fcm2D
+d7YJnpPbFCpEYilqxehRePTktyz5+LX1IxidJTN0QA173cGirwo7om/W7TFgyX5bg8NHeqE/pK09hY1ZCSXpTsWqAPUD6fUW1yv1KpOkIqEFjv26dUU+fPcR
+3zL1Vs0lLxBE4nCOMbLQMT1lMtLEVI65hcQPOQXdTemBp7Fv2r/0LaPobbVKe7N/Mdc5kd4mL6gFx0uVm2vkFlBAkYg6Dq0HrK2bYJBVdpYowAIO1EpJe0+S
+mEaPu8MMb2BpRCDDTOErADHIpChsO1XDpzJT8oaenEouWO0us/i9siUq9rX3P9dxeeATvA98ZiLAHFO5TxCa6Qo2WfqEnKk/AVzbayz0t/eOLpLlXpdhDeAv
+iEl0pWx0MbRdIkuxZmI+pxgQsIYq+ZUEN8PqGkFRydIjaGVcCUgbGZbVY85BmryalKYjUCBVEkMfu3/bipLK2A9AnblRbk+3oPs4mn6jllw0Q2GAVZ0Lz0JB
+p3q+btnqg6eEkPwJ8uXYpyPaW5LoWHsSHf+UJDq2JYmO/4xJdE17OmRItWd/xTcxytgkCQ4Fx47PU3N+4Z1NyXH8wclxzInEJCWOf05KHNtMhrZcOHdnpFQJ
+QeKWVLdHZ7AdHHjN9Bb4udTxOUKjwGyPFJXaIotQr/NuScIgJce+rrznLf56I23HYZZcPMid5gcq7uY9A5Qae3rm2zUzdnDY5y+HR4PQP//09eVocjE64uPh
+5OxyfDTko4mrxnnMX42H2Nb+6M1g/HrYg3fG+MTpGXOjYp7HhsF6/OIM/zb808Xw9IKfD8dvRxcX1FB/cH5+MjoavDwZspPBuz4f/uloeH7B370ZnlL/+3ej
+yZBPLgYX2J+fvxuPLqBnP4wHeSXj0es3F5x65mPyyRdnYxYa54+GE34+PvtudLx5rTuDCR9Ndlxza08pfvYKG13/cXR63OPDEQ46/NP5GIqXHvOzMR+9PT8Z
+DY97fHR6dHJ5jDkuLy8v+OnZBT8ZvR3BnC/OkGLMPutGh4mdvWp0xYakGNsWm29si82QmKcXo/GQj0eTP/LBxJH4Xy8Hfpzz4fjV2fjt4PQIt2/D8tlogivn
+788u+3zy5uzy5HjTs0jKIT8evhoeXYy+G/bgJT6YTC7fInnZ0dnkAr41ODnhp8Oj4WQyGL/nk+H4u9ERUmc8PB+MxhyTgsZjGOXsFI7Ysz5s7ukZH34HLHJ5
+ChVi+Xj4r5ejcZ1R+MngHYwweD0eIoEjvmDvRicnHHatzhw9fOX0PQ/M8Z6/e3PG3w7eUx7Se8s+fDz0iUpDtokUg0nEyIOXZ0CNl/AoTvHiDEkDW3g8eDt4
+PZz0mGcSnIatfdvjk/Ph0Qj+Y3R6NDoenl4MTog+p5Phv17CNg9O3CB8MB5NYJnAp3ZP4RgDL546Hro449uO9l6Yx8vLC5byKj85myBjHg8uBhxnfzHgL4fw
+9Hh4ejwc4zEcHB1djgcX+GF4Yzjhk8vJxWB0OjxmL9/j2lEgjMbH/hwiO78ajE4ux9sYEmZxdj6E4RkyZti0ydmri3eD8XC/h3zCR6/45PLojd1h+EC8uW8G
+E/5yODzlg+PvRpPhMbPfPD+bTEaWVmd2BEtfuL1Pj3FyLcls/7g5uz/lv5PzkycHP2/773vyfw+/Ojg4rOf/vvjqeZf/+0v8O6lmMi/rOr4rYXXQhz75b4Z8
+cHR09vZ8cAqZo3DvvB4P3oLSYW/zY34J0ggPNJ1VPN2jSb2Y+N4O3hNvh6cXkEsJwuRyMsSbaHx2fIk3EAq6ODfVSQr7WZDFF6OLy4vhhI2HR6Pz0fD0YncC
+cxyeX4QLdjTh/mO+juvx8NXo1AoQ+NXOUeS1sMmKIZWjby0vtN64XvDfn41fD05H/xVzZP/A93b478/enQ7Hf+A71sd/5vz156RYYljDDThtDIjINj8HXfTc
+o5xz1fcgJqtbpmMq1fe47foj39oqLmR72lGszbfpHWbt89IG4F21nkAjOd8hFd3Wl7H+vGj+mPoa05Qr0xxAxK+QQb2n9inTDnEuYj6nklnR9OBFXE78rg2B
+k2te5xQsy6+4zhvP7ho2ldciW9jCYkrt24pXYTjnRrDJHjKHAKbKMZxdzNElhYq/hYaxZ0c9qjEhCjErpas/4QyQjYQXJiGS6bPkR+ey96CFDTtGBuY3SDzY
+KZcKAoirKqM0LOPMqBjfgK6c/M+u8KwHM7hxPW5XFUzf5t6y83GJiIZJZk0UvnREsGP2GduJSG3Pmj8/aUBA5qUqLaNdx8RC9ExMqxqTwFeOlal/RfCxj72l
+7h1p4td7KTyV1c+dy10TBYwjC6kX8MUTlwl1Tr4w+qwLMFgXMNEQveKNI2AdBYVkrnSbytbe0eztYnTbFdwIysdSZcpGmE+IT0D+D0sLUtWIVJdTO3Edb/e3
+WkEaz0pxPSjyV0fVAQwvJODLaNpuf+NK3wkiMUIsomfflZp0qQTowYAZt0y0vgjkovQktewYYzueGzyDkPSIwjWm9UDES42WxCDiE22o8TW5X4N9CRcSmj8T
+xgRkTsQB2FpSVDR8/XLAoOl0bVEHLLC04LnOn6DT0ShA4d3qIpuD772XRrGiVPoQfncoLEx8BAki285yz0YGsrUDLke/sXi+OCaNmxmAK6motUxVF9Fuuy1i
+Cv4aZsJwJiiOIwbCJ+v1oQFU+muSuRZZJOZxQoJZIeHc/z041j1uZJY5bGKp7Y8QTyjIZxiqSrocX9sp4KEkfRDdqBtDMnsXsW8ILVvHKTM6TdUkuSPiZMD6
+LFEGx2O5mJ47bwpuaZufgC7l+lKVVRKcaEyWjERwlyZvmQPDFBsLJormawMtSW1Cyevi3TWtgB/YA8mUZHfife0vu+j7htmwXIT6j+fd4zachTdvTdIt7Hip
+HDrV/FoUc5TbK1lwKp/jc3eB15E/AVHcj65J/KUpRT53pcCy8hpjYY3zQgclhg7izVm/ngw3sgQ+K699I5pcc2FMVWBI1iGcfNsUoFH0IeaxtY5NvBPax2TL
+wMA+JKvyUmJWZCUyH591qZrWRR7rHRYQFC/RlRDAm4JnSkwJ1VrqQDLCJ9FTUwzolW4R8egBQRUjTPA+j+bJ0jiySYAglPkU/NNR4qgLFNi3wFBwe5Jgb3B7
+rewLm24lH2zJUhqKOntUDq6YoWyaVYWMFtZKYDcFwvv6m7wFiBHwPOu68AlI1bnNLNe30XzTsG2iqlFA2D+6a2oLweFmODTxt/vklBIQE3xZqjzNWxikkDZy
+ZU+L5f8fcn2byTlWtEJN1lSLhZopgt3ay9ixosobZyaI71LT/tUygdys/dGiNJf4hvOdGKwHFwziCWODPo805bgWYULVVCxTDQ+4K2I45yCGK9HvYSEbLIck
+Be2byOA96FPS45LiZV5tbQ16NS7yb7E2hVqkEzZ1uye+A72EKEUeEo16GGhfrZufgBcimu1uWGJc2Ye1J7B5Mx0t+Wc472YG0gO+ZS+7zDXjcL//BjXNpKeA
+F2E652QJo/Craa74C1sQRJHtGkHMeg7ZDL9H6IjCsx3yr8KbFIiN90uVmbQNifInsfTrxcNtHWMpC7CqSyuAcbSFKnOYEpUpiRwTFjDzLaqDMS1sfP4hpAiy
+foH9WZbiSmJ/DbdiQnD1PJar5/IUkCxYmkJkjnsJvVEqkYWhSFsxPNNUZHqhSmMZY9anYpjuCs4tntwG01FXsIFxi+lLbbFCkkbp0hjrWhfai65DVHJXoTTu
+M/bSyrpEVNQTE0OMWuVcxIV8eKZnrpxVLJ/9sU+bCP7+/XAw/kOv7mfz+i9UHssyPiZ5OZZGFjfYze+oz5MqBg0dheZMdULWPnMisXdLqiG7WQQjjzpcs60J
+Zew11OICwpEinw85gbxPyNQ8GeRocpXDbf0hV3uE4c0H18fUslC42ghZ4JbWWFBDieyzs3wm/eSVVwzw0nTQSjSWsWoZlbHHJkuhG8rZW4iWYjQs8tVCU8gl
+HFEVo2/gKMWeKPiEBao7YFFyNzvhz+C31oKT+RxTnwwgQozC8w48mssiFLXJ1A8S6mNRk5+g5nJlWIy6sqW4Renyav2cI2CU1yTgh4j9GTgJfKJV/XaMByOz
+ugwZauYaoZmId4cD4XfbnmSnzVIVAb7SpZUVqRBKFPwI3U/aVHxSP2madKWweJy9nWhfY//avlcZQegYQtotZD53h0Uuc7VYMyrFEh/IvZ2R/Su1xcijMcWV
+ULkheZdpY6TpOXFpW20aSrRytU6yNd85wQfh7YLUYJSIdOX1IFXCVKo0QZZAcjEVzsLjGWvsiUbqJgMk3DBl5ye0fRnQuPTWo5hZ7T3uoGXN9DaaMnLO5jLy
+zcLE20C792wpc1tKFmuMyXZaosMl1gsQAekDdpVoywqZ2QJT9IAgjd8h6C3kr2kJxFc9CmqEuQPV/lKJDFOcRL6JuCi6v+FiH8ZbrsA3n2sv6gINWcr43NXM
+c9TG1ZAQn+5bSyIdIdmFUHWnZy9vlzns9dL2N7myeYl4EAwpPEAtpJ6ccyPLkhC3PJdXulS2FgLu0UYiiLXVbBSYSMxq4mFlXJRe6ZYfVhYbGBtbNdmwxFs3
+vsYfyk09fk6/4X+CSYsyXb6xwP0N9AH8FrOqSNvf6W3qq2r9ihh4cScZLzWsYRr0ROZoW+owt55FdzYHoVS4SMd1kbJ2bmrajqg49fll8Arbc9TbxlIEzCeM
+n5eTbkaRlEl00GhljeWw1uVYRSWkulgL2tT9KZSwglofs+LVlYgwVVZuW4vtiCLWdlL29b5rU5Jg3CyMbDBxgK0TgCFdACDn4g3ghdJgbS8J98ZR5rNTNjj1
+ELGXg8lo0qsjxSy4Jap6DBCoDcgxFnBjMWzMDYlQHNRBezjElk+wi9HFybDHT89On4xOX41Hp6/tYuqosrPxA0Blw9Qvo8gXk60DI9ocqbmkNEnnpYiKYaKO
+pBfMNf2d800+Dfyjc/qA0VMo8wPwgtEzhdznbiEWp3U1U0XbYiOI4K2lSWHCOX3DX+eU1YDTkUWBXQ2jtK+46DGsHe5zpxSArNYFagouy6AXgJ2acv1Xwc6s
+cnEjVOZUKk0OrKKoVs5nbROWfRPtF31Qc49OBqO3AG16hcgy3M5P4O5Ty4MevMBPCQ3Hjs5OSZU+G0/45A0A994MvhvC38IXiWtO3/PjEWD2AKoW/suB1nrM
+I9mGfxq+PT8ZjN/3NiPZIhhak/fZCQAJz8dnr0YXk/0ef3P2bvjdcMwRtHqMsCysIP4ezu3Z+H1Cnx6gR3G5o1OO6xscXfQYmAxHF/FjgFA7G1/EUzkdvj4Z
+vR4iomMcAKj7DngHQwLZ3g3e8yYGbxuGxOJjh38CG2YydCLCxugwZjc85gCtRWBLQLhZ9Bp/EHqNc/5V32ELQy6VM+Bb/EvUznZrKpU/AiwTt2ixRQEGdHFQ
+GRGfYuTyKKXnd5c4shQKx7S4/40BMkocpwLVNv3Tqs1Ox3W5mNeykKW2Lo2wUJohmpFwdXkZwEFwLaulU5yTvmW2OP/smoWBiDQwn4gylKYWCUtXn9kEb3Jp
+Fd9gWqTxd1Vec2ttgjTxyYFpc1tvwe4F6Sb4rNDGPCElDP1VVV7KAn9GNc3ZHvsuozGvu7lNHD1qeGlKTWSoxWbqvENEDtn8wiMwsNardRM6QjBlsCLsvOY4
+UY+nZL5mNrLxULLwhCxkPPi6tYkmSn6avZCwEwfL6lZQ1JbM7RTThQ9/7Xs7xAb+4uAArW/PuE1qPECXHXMbldajO4Q2hZ+6AYyhT6vxrYdtM4GmFkJh/ROG
+V+Y66kG2SGqkuENebHWlUzll742onHoMX4EfcPu8cwpVUqXnOA42f7Reo5mmas60EdYMy3UelWngI3K7PnT1ft29OC7vfQ8zKYz0Fem2WczCcKMp0Srystls
+R5QqoeRDPLkNec0J6fBItB3tMOXYko6nRbs703mp8kpaGERxo25AyjksTZzPlWRXRecP87zgl/st20vVBZJ+Z6hwRoM5eoWXXOpWewYVq2dQXUSriuaVBIT3
+bVnGW7E2tco9LK1SsHlatAk2bE7oQVeNJBVtjNhSbF+lMiEdqdewwGVGU2Ib4mPRQhvR77jpejQFjNDrXMY9FdxGu+xfXwfYV3ZI4nt86OrOsFB3BuMGVKjL
+1bE7hFatWMoOxJUt2BXzpAU+5dpJOx2XY9E+1bjp4bFR9JherWYBcxUP/ESxJglGfmYW8iVNqVcrmdVD3llmp+XyIR3VqbqBW3gqoVsiIkUIG1w01K8rgH7k
+wXsHl5Tjkklpi6b/HlOg/uD9zu2B+/jVyxxNnwkFc/SCD6jXe5+fautndG0G/HQYnThbyj/2VravLZRa0zmVdbOSGGhFNWX1wo0gCm3csafv3wrcfODbUMVa
+8D9XxZqXeHeQz4mRdwBmFW6zfpeO8fec/3H4cyaA3JP/8eKrg4NG/7cXh13+x99E/sfhP2YCSDP1w1LqQs6uc53pKyUNH+WzPt/bObk8wvVsyABhP1sGSHhw
+WyoIY4/PBaGyuYSwTP/CPjsNwyIu4t7+n5yBwSkDg/0EGRit6Qm0tV12wm80O4G29yG5CdYk7nITutyELjehy03ochO63IQuN6HLTehyE7rchC43octN6HIT
+flO5CWlWwuHTp896mx1Av2p2wrakAb4taYA9MmnAdn9qnTod7dD6ZHPSQyGT8qfYC7clN0E0aojMgo+lS1Po0hS6NIUuTaFLU+jSFLo0hS5NoUtT6NIUujSF
+Lk2hS1P4nDSF4Z/OYV249rMT1gKvhbsw+i3a58o0jnr9NMAhSuFv8gNFjuYgEExJLMwLeVVRA3nD95ypZB+1Kg4eJ1RUwsOpk9z3Ytinhf2uy7/o8i+6/Isu
+/6LLv+jyLz43/8KCwbr0i994+oXd5y774m8z++JU3vL30Ha1S7/47PSLk/OfvwHEPfj/g8Onz2v4/4OnB087/P8v8e9EXMg/gfxEYZ+mATAG3BEnA/CDr7/+
++snTZ0+eHkSF2+CXHAd65kZibAiRFGshht55tndxqh3g7TIVpVq6FqnuynTIAYe7td3gIhC0a+CJfnIUKueFFEswzxAvuG19fA/Wt289wTVEZGjGD5AoRuPU
+mwJGFybCnubSzAo1BfklwW/vWtVWJg70zSRzvYS9QeM71bumZknHetvEfyojAAFcMpXCNv+2B3jQumFuMF/nlN1zYXxhF+Ia5IMCvI9EVf4TGBSqT7DHJagd
+pOwqUL3s2KzU8CmQ5rq2TtuAGD+Ce4pzTGhoroOvOeriGDWIzHWpZqj1gMTDEXKxlF72g5ztcd/+n/RuXsiFLJDSpU5mBMo+L9crbA9pIw6+YS5j//RPfKWu
++qZc438HHj98+vSAv+3z931+KpbwJMfby7kCZyJP+ob7BovErNGFaO18GMG1qdvGo8fRkBgpPLoYnMLLophd042RW5iHLtZ8KcDs+wK25sMXwLlfZKtVBtL9
+W9cmkP2TV78O/BTcfkHEkzixsD0I95sdBPuMvUYtlNjK7ZHK/Xb3mt2laRvcSbY0tx19Yde+Yez7SGndpT0sIh3dc/f3do92kQvwrbBTb7Cj6C5rvL6ShdE5
+/z7s4i5xrZGSzKksxKCMlH4xdPcDDa1UmIkC230maDdmqbDQhRcbSLYPCcs7tcdDc3EPySqMJY8/NBFN3AmN9XSggOMxULjn6kbNQTmkAeANPF22a+XMO5Bm
+OjdlIVReGq7zRKj2GPnCafXxGa03fU8tvKBBU7TFfhMAPiAO3dKVIaSEqZzgEzEIA0VgoycvhfpWmSxBQrsVeyxcTKVcui6ts2s5+6HOii6kTHyKN5GWBraP
+3iy1fY/AAPAYvBN/YYHhyrIQCUFcY0o2136kqBV/Qivv6zN6aSVkzwW3kXOdPsq8KFW5KaVI7s2LRhaIsJCryLnlO/mzykjvLfSqcSk/lPDfeNdjWG2p5zLD
+NZIYCNA+un/ht25ajjuoGbttZR4tQNK4sS2BLI9/Uku0GjBPCtjgYjM4MkhPGK9//+XO2P/rnn+MXeAmDCgvx7HHK0SurNYYQD6O9wwefBsdk/s/ce8MQvAx
+mhUm66Fe46KlhKe5SNA+CZQjaFjTdc1F7e1TyLqKdiFYqBaB0ONtgtQhYg2Ld+d7Acd413ul/TytJfqDyuc9e+E441CiT93BMgNIsdcSees5/mhgLhsoS/SV
+3YeyJH8INcHHqCEX3qRGbAeNkwSt7flYBYaE1QL39/mEuD4mCdCJwBQI9aF+vhSsZCSBqE83xgq8nx1sYTVDKhRyJVRBrsGiIK8B6H45zzVqXyWv8gxW6VHn
+yWaDwQtvozduTo1x3d6i94i17a/PGrTLVeEGoU62BvWtubvdbRPkBj9OJaK/yE8PS48RqVGUFT51RZ2lAxiVRWBUXbRjUT1eSldEx3ztYHAXSQ8z94DKo0QI
+eHKDS6zJfRiiZa5fPa6jFHwqybEHkgjpIWazCpE2NqiLbm1TCmUdGEAFbIrOAk5LpdgFXIh366ZLgYkkWB4w/i0Alp4AZ7lTy1EVs93UnbS1noVrYfhUypyL
+OfX+pq1mK208UsR5hwNAIsVLpmJrLOuu3SoniOfc6h3KOJMshdTDb+0j8CPDIFO1al6QNVrUGpihazE65nhTutzL2k1yTUd9Wu+AFnsKk8ROrhZppid6NeFr
+7pD4FNSGZrd9FXAK0bksVM4FAsVUjh5hVDPJowskYsFuSvK9EkOGtA3nOCSNB9UJmqVxI6DZAH/QubRgBaHy/6V9um4bo6bwHv1qt9ZmDziJcBF8gT1vlhXS
+nk3K34MZJOIC9tMxSLjbPVabxXrVN4z9Mx+VMU/hWm06BXnmmjZIwKkG7+RCF1M1Z6rs84mU8EdJpnrPzmIn0gR0HktDXPxOH+byHqmOZqj/GGXmWIv/B0pA
+inL3Vdlz0tu/ElLdmRFr49DbucCojXVsOFnf5wOPo0tOGiKcakaB0wVDfqso7drbAJraeBXUgQDxR0pwlXNm8fty2Ys2C9ZveAwh9cfCMUrjWLP0QHhq0pLw
+y99bULpz8MDL+dVuXQUvNZ9lEt0griR/SMEjnZ0IGJ9bTBkUxZybtSklfX6UHlEPRRY5/x4BPnAxE1xhDly0y40mguJfjTXKGD1F98fGi9YGR51d5MYEyuPh
+tj8zt6luqy2M0jsT4tOZnCs3N5oNKkSMdgNosRQqD/dTsmUrb2LaRwpjhS6oIgEOFf89qbFg+dFvqZiF/LKgOBCDkWJxrVYNgWoX5mIMfsEwdhg6M40ssGAT
+1oNL8Cphf0tZ5IJw2MzeSnHOm8pR9yOu0yGbAfD83n2VzIjPRM6mMopgwqH29JpK7jQiPSWZn/ul9uIpWzM21rHTZETvSiTeWXPBL8cnFCIwqowciDQBCl4s
+CimztbuBw2Ksl6zNeN6zSnsU9GM6t06JiALB/Yopo8jdK1lci5XhInUX7beeM1NPBEFJAjPDsdzAXOc9MvQIGGC52YOb/ZXhZGop8zgDKTg/RIb1FGwmEA7J
+Jt69mT4XL7HATqpB7lmh+g1jT/irMBn/Zf59X+VmF1RMU4rMopRw2vvfJBezy2GZRoxuVaSppIhReS3XXmOo33Yu86qQfJWJGcoJ1BxIwS6jM7lxqov5Lt9D
+YvCFzks+lwuV2wuwbc6ikMzdwrRvfurOGER54KCbcEuQhYd3Njp+CE6F+gGMxS3MEfFjuc19KDVfFWT1iLKUy5UNiM3AcgrQSPdOQAukMsP49rTppGuJl0g+
+rhe1K2wb4WaLq13I7cgX6qoqNm/yTOQoyTFXZs5il3AghhRmzdOx3JVFNxWenUQpxfyLxdVVpeayD15GyyBwanhLuMLFJgw5AlRpsesGvSXBVaULzz4WdGIp
+ATPAqHXqBXT0jU/zqtDXaqosdO1a5jOZZG4wZ+FXq7kg34NN8FvK8lrPe9YuQvejlcSqJESLNDZtbSYyXmpGYeJSmjIV/KJsc931P9tpE5lBp2dQgoo5Xw6q
+kkrehEQ4I53+tsTsa/R8gZBGyQVmul7SzxZuV6vvA8MAV+jCgqtur0UJXM5LObvOkQTOsUvv+BhSD+RfZsKdT8VwJI8xxT3ctavCuT8KGTK4apqa9W3abWRG
+Sn6tb+lIXuOi8XK40iJzXxbzaxk54OvEAGnOCynmrIx4+1E8vdAFN9XVlTQOBP1S+kg7vAhKUOQngtxaVFb874B65Aulg2YwOc9eOMHJ6AObfC5XhZwJV5mp
+rkznOn/itcxIS0NcmM5x4XpBg1KKiPOGZOtIDkbZjr4CCirgIEkJ3Rl/2Van8E7AcFk1D6wzUx29l3r+CBlSEs7t2uk8htmZ2Ysp2WF6XM5pFn0+tL6Kmh6Q
+xBLDVc9FcUVTVHk6S9SDSpVlJE1sRZ5rG8UjnrL2mOBtauFmRzMk6261AO+TH0Rd1RaHmSIkxOKhRBbHJEhXMRXEqKTV1CxfsFkyn2Q/QvDH6ipWU5DzJMqB
+hxcTZZmwtTGCZO/d63vZ5LVAVczVpzAlpWSQUxoczrRIf3ukMVc8BZEqh+AZz6Y3srEsTynYZdC5gxMp0Y9cOKMeQSbD5KIWlWzur0tZJw9DHjvZ9YL/czyt
+fyYBHdwSGz7xzzFx/5lTuF9yI5Z0rh3ZiFT84royXLC9Qu7HUTG9iEJgvcjhtjEEtpSStC62JQL2rQ3oU/grgn+H2Nm1FIRSp4gYc9dqM6gV3kqDevBHmI1p
+uQe6yrG/5j+L/zr49fBfz54dHHzZxH992eG/fol/DwijPtn+/xoosQOLEvvqycHTx6DEIgPt4dAwr8cQZiG5NfQikm0RQGw8HLx9eTKsxVx/QagYY+9bgWFG
+etyVc8d5tw76JyCaV8rcJgSZ6xQK3ecJsuRBiLEounkh//TEKWIBMMYTwNi+RwD46avSx6DqIzZAYnhHuVT+713GHWTmvTl7xy/OQjKcz4Gb+MrCo4mrIrzb
+Y9Zpf2URUKYsqpkvAkQaRs+aNJghns+tfYQEtoHN22uN/oyZzo2ak61Szz1VRUjRjGC/URQm38CQvAXOZFxJAkelpte+NnwLWCi6yWMG3A4UqtVKSt68z63c
+MlyfNaBGre8IMNBWK6ojAJqFhcU5VRes0lRbqh9hsHqMzG6krYmRt+qrbgksXkJ4ldj2n3P9z9Yd/JC5snSeNa2ubRYib3HqwpOM1PDBo3BObR7iONpGKj2a
+ObmUc5NqbclsnS4b8RJVVsLK0ExmhIwE1Zvq2kRYrrrFEVCNYZNqoVoEU20IXH5LPk1RSK6rEs4dRmDNTK9kLayLpgkTWPOFF1WOvtua7UYxMrIbULS+s+DD
+9KrpNXwzMYFQmrR6Z5jzzNQQy/cemYbvhj3cd4MOFBl7bnjDc8O856b3ONcNb7puWOq6kair26+amFC94LThidPm+9hrs8se7baJaxScpinRcDu8PTsevRod
+DZpYhHt9dO6mfdQJC6Q7rgUv8Si5kGILKwY1w30YfmtdqzaKdyvWzrnZxjQgiIzPOCHrlVHwFsVQU0BaV2d/w2rTkFV93ouelasYUe/V4vASdbfoqGBhLAkl
+/A4ssLAyJZmasLZ298ZUF7l0IUeaRXtsuN1d498STYi9gyVBiTOrVwz8HGq+nOjKetVyZQEc+ND5SnwM2WlAHn3s8c05XzfXH7B37aTYdHNsXTjzcZ5HLZ+n
+y09DXkQCWPOzaCMJx5lEVlP2CYE3lREEohbCZZYVn6MwbwzQC0SrR4ijsDBeTmFwloRlAsoEH9mjKqT7vAbYs1ht8g0ZrqDW6JfRUiPMQSvkIMGSlDp0/re4
+gwA3SClUwx2kwYcX7RPwOAD30XRI52OlqD5Vi2IWfhDPkipKRV+MnMs1yHUbQIBnOr+SxTaYQBz+dxmpEUQAPU0tEIFkOSDwv4rosJXfHgxlYVNdXrd+j+Tb
+oolrcXUpa8+ze3nciVsqMBEWoh3kdW+wj6kUL/e/YQx+qF0/LXF360vsbbueavCZ/VreS2Ml33qrrCUVmzXYdinmKKV9IUAbbXVZWy2MCQQUbC6Nusox4IyR
+VCse/Tjg7rsRGQZz0yFjElBatPOM4kDea6gis8eCHm3F7xq20kYjgYQyy8j0bHxJAKNvohljey/3A2bC1bOK2i+0HfoIO2J3MoKWMB++iaAgbWzwrU0tqIE+
+3GQEJLLCh9hYWjzNiZ4JrFN4OT7Zx7fbASF0WiMkiI0oitYUCtB+LRqwuJJtoBDa+g2wEKb8GbsPGHKq3VytILOJ0d/HHLsbxYMJ5bkUPzi1GH/rQ2ew31SM
+NYSUBVWtC5WPTQ8phQCNfGZzK0SWJUPqnOHroCTnsMMu3elWrHkDakZ8rWz/FCwBGhlRqGEbmS36/Ps4haC5MOscmMm5F9W2AwaQL+o4EUvHvIEDf/KERwIF
+n7U3oVtjIsuY5ZurHKeWlxg59U40D+X33jhXsyUJWFvrkGETiMymF6DDgyiRifyqElcgOY/qGJGIEHTW0SMTVYlt8Qs4MdzAsbbEOoJ7BuWDq243lUQ5+hBF
++GxfnxtJqGl/0Fu6EHmGjT4JOeUgu68dIMUVlKG/Q60lRKYmNSyRtepot1ooiT70t463aKg8Az5OfHBc50kaDX9ncUOJrcWefMo/TJXFTA0jeU7Wtke2tNpc
+9jJJsp0oU1BkDJufOFgTog4jy9juR8NCWuii4UWsARlt7V8U7/g6bSZRsckKVtXC9bDUDwc7V0rL4/jUtVitJBaGEjNKqgBh4G6ShS6uJHWZYt6t7D/mztS3
+qEmGIssaMh1cYgfCh4RD3ZGsZQGa1bwb/U1Ekc/KpORRVLPlRmkqycI2pI76dDba4spUqNeqnDZuKg3RsYD/KDX/QcoVeTbabrjA0/4gU8cgjiUz30ZOWuOu
+TYaFUkLFVXxrKq/FjXJFs0Xp3or4piQtSJV+EXPmFOGN1to9xuonHhA8I4NG2HujQ7TdiLV86nylrObUlZtSLu3qm06HpClOinnwLY2oI9xMFvnGu4DrhqbL
+tlvW/YdAAja6XcnVEvACcRkzl14GqXAWe+RQBImqlCLweQ1SEIq/1cd9oAfcj7yN18jDkXoGHslgVF6v3cW0GeprvxyMH7XgqmSb3C6uOFgK/G3l0R7ppixw
+Kb+PS63mmfSLwvXQrY+iiTqneLvQA0IRDdzi8nM2J622+Z4DCxuVz6T1t4IDvYkdrutv9wGB/fRBoZFrD+rsxzO9z6MdIXRa576Y77J29LDFwkZQNA+Lq8t2
+6XKPWfBuWcSYm2cd8Z/eka0TqAHFVRE0Xspcqbd62TvYx8nYzPGk7WYts8UltsZI5sgEIRSzLh6FYAa783Cf7smW/IbWtAGazR6sXuWrquQrUV4bl5WApyy6
+rSzITJYBgdi6aWTOlHS9Vob6c1zIP+3/pKwD+GnWip/evGf9xiWFhUKtKoA5D/s+QwIdZrCLBAdbLqN2YVH2GWHaQiphMiPnzIxgpfdBSpUxFfnxUgFG6dUU
+lWIPRJO2EjZBkrJPRpJSSLQBJWUzQVgtfo8aBvBn3sxff3AK+8Pz0tnWvHTekpfe25SYzu5PTOcPT0xn9yam88ckprOHJaa3XK/1xHT2wMR0/pDEdEaJ6b2H
+ZKZvzD3n23LPGXn+00TzNlCBeyFJbwbHXCqQWBylrnn+4mpUFkSyNXWd3ZO6zh+Rus62pq7zR6eu91gbg6b1xbenrvdC7jpLctddG+daqnq+fmCyOot2qZms
+3nqgsXWwctmB/kcchG3NZOfbM9k/GWf0GbkiFrjjgnIhCoVNU0VePga0xFpAS/xzQEsXpGfaEQV1+raiUBj+/VpXu/XWK+i009oQBkMZD43TBR9YB58HDT7K
+aljEfZB1DUaGHgdB7e1cUR04ETVQdbiomO2gHTce00bWbVBrSbSV3AkN6/I1dU5XeTqpaUX7VyvHkzzTqMfD6vV4uKvH4zDr8cfvLcXDXCke3lKK51Mr8NQR
+fPeW4AkIkDhDY/e+RBJIfcfzQN6iEp3aEMOT18rGdu/xALkRemnE5PZ6nZwGqx+Fub8+veSvSYg3MJ6vAeJ5KxL3s8qjVhV9NrwP6sjTHJa5MrPKuJTmlEgs
+5LFg1komb4Qr9+7OJhnOTZRYeshZiAN49ikkl/lMV4W4olkhagej4G8I/XNpZHKSNx7bDbX9KEKncuoJFzlVTOMUY2x2a4G/cM221/jDH6ifM6mIS9ctnzU0
+5w0gtThc46ufhRh3JEJqsgJIZhVakTte8xeNr38HM/RT+8YXE5yXH+4tJtioJ9gO4GwvKAgvt9jUW+DMcTzHobZ9QcKw7gdXA/wnvNHbogJJczuucnj2uixX
+33zxxe3tbR9e+fBkRZPs6+LKlyuEB9EtHGaIugp+WYVyLCLLaqlwzrhNCh1+/fXXXzx98cXTMEi/jex48E1pYnYxbhdJEVdX4LFpr4CYcABZlq3VEKmGt+Uy
+VkcOJ7URSbe/pzJieetiV9/budrSiHa2u70tlRL5hkqJrFYpceRVl3FNPWFb7vdjdC7kV/ydjYL5jgaJM/vC3VO+CZS9xms7G70UUtLi/CnWAD+WFrVvfbZB
+A/NxiRBev6UQSQzyx4iya18kk+qMM7+a9JMXBFeosnl6O4FTxkIuUeqEAjBx7Z+N1g/PpSjai07qBQlh5wTHEDSOxzOYNSS1bmX2UJYJZkJW2FLkaiFNCcex
+z0goipLEvgd3iamR1ihF9DNCPfQMm0EaKiuLzDYlylOInNVpzGs0Jh94dA3bclrozVquCpXaRIQjgPUOo9RD68YetXKNieBAEOnY6EhuFsfURbP0ZYuvpldz
+wPO9eoFMFmj9/aN887vOEU75Ld5ckPNmIcWN5abogMHxlKaRthk50hl6uei+oDzP1hxPjL3Y0XSOXIhJnTNRyitdkI+EtaWGvmpzbzudOCC/fE6Kz13ct02E
+S+lvZTz/6a42Uks3723fUrvpAzR8b7srk+bSzG51swD3CNs8i8djQtGK28yytegJ3TgBQRBPdC5nGU6SCbdb6+YC4p1r/WqXyPn3m/95+Gvmfx4+O+jyP387
++Z+HNv8TGgV0+Z9d/meX/9nlf3b5n13+Z5f/2eV/dvmfXf5nl//Z5X92+Z9d/meX/9nlf3b5n13+Z5f/2eV/JvmfcZxMGItJVQQLjnXImc5vZI5fds2aSoHp
+bfhnW9B3v8sn7fJJc96lk3bppF066Ubbl3fZpF02aZdN2mWTdtmkXTZpl03aZZN22aRdNmmXTfqYbFJ74XfZpF02aStqiXfJpF0yaZdM2iWTdsmkXTLpbzuZ
+9PBvPpn08HOTSQ8Ou2TSLpm0Syb9h0om5Z+SS7oF397jj84lZRtySfnjc0nZPbmk/MG5pOyhuaT8vlxS9vBcUt7lkna5pN2/nyT/85n4GRNAt+d/Pj/86uBZ
+Pf/z4MXzLv/z7zT/85kAm+T5k4OnT54e1BNAD58+PXzy9PlvJRF0VailKNZtCaFoSQbb6QdZ5DLzVmjky7CpleQ6e2Sa6K0ufoDleRwmv9bZPFXr4JssPGDz
+Rvmn5o0SBgY/nCaN8scljaJf1yxFltVAhEk2qftO4hKBVFLaqgfmkr47G/+xPZHU1oBkP0kiaexGZS1uVFjMhizSh6d5CqJJu03vb/keE4bfyizjYuNIrfFA
+1OQ2fOHXza4L/NDIreMut479JLl1POTWsZbcOk+i+zPreC2zjn1mZh1Z3qnj79HJdsfDV6NTyrarRVo35TLXIDJgWpG4gnj+N4x9/04XP+zygZNIFCFq8/cG
+B2Gff39s0zDSt8mY2IhsVi3pGzBAn7EatHqwFUodf7z1a8ziqBOXsE0wmpOQiGCukckh8hThwYUxeqYwEd6jA/Gz21DX1hlELp8m/JpthF/zB8CviVLrXX6h
+LUJJfD6xYEcT0P5bAunHF7ZdeIRWgT3UufMT8VIzO98eRn2vtc9rghspzcj0eH6+N638DR/Cl/vM5gnAZGUmZ2WhczVLQQ/RrBIMP24d0nJBccdSz3RmGG2M
+4a8uzmFiby7of6drbq5FIecJ+py7pydVvmv4qSzxgICZyif4CNs7fTUBMMz3R3q5Ulk4D3VL7Z2XfT5yiTtmjN9pjskhNs5UUtClkLMSoTdIb53bBKOqlIWd
+Zd+7cnD9YOeKtaMtI99PCiETM5CGKsY9Tdd+kj1LNpcR46jM6I+gmVAO24aN6PkAExc+JZ1g8LT+OuQRtvcxE4ycbaYFPxkEylFVFDIv+Vuf0gb7YlmVElsM
+rC/XS0X5T8JuORxPlUeDUVqpB7LgQ85n596n7CHvi4GXv2+4WNkm0QgTfimM5KO8lMWqkKWdbkAE2C32uNActijL1hgNBdbVhUu0Z3jo7EB4hqybnJxt9mBG
+5OIDXv86ISnkSuZzhoG6UhZk6Ptth3PrgYo2dcs76pxfHr5RH5sliDvyWDY/4JI1fYqnNFyVkImDSCGE9BNEtLKnSBbIczJb9/kl4VAi16rVKuQcXyfZ6TGU
+1mQqtSdKL2AAo71qkEkZLvgOXt5PXuGp2flZk+IP+vzTaz0EcfnzFnrw32lUebC1K5x7f4mMJBBBPZcO1o0+qNwmMxq6UVdwv3qfvD3kh33++CIBgQikWtrM
+wDlT9SuqXjQgXlbE6i1UtuLSLRZlQNkErtZuZ6u2EyTLKl5ki6LW5szmWSYqI6Ps7/ryk9uodu049K9VwSJasXto1dQJj3Ai/NADpg2mIXNhXKJTPI8ajGBD
+Ul4hZ2qlkC2Wwl8NSIV0OJK3yl2EoOfhZSkMmqgYOguZW3Yc2OUFT2DQ8wbB/L1bV1Kfe6uB8lckb14y6WVoDecAQ/SuX6hGaWFpscSpDJ8VUlipHXPHZgw0
+8Fv8ZOvHosB6slpT4wikdPrd+KfGHiJHLkUOS5+uY6KwiCixhR3A/6s5frZW98dR+8uE2rkuH0NxB/kDI47VuXk7odEc89WKwkGeEdkYrjh+oTlcyk/JeAlp
+sajAZywyPvUpC0Q3bGz+NauBkI0ELrxw88aqlAN0WsO9fjdjlNtXMmigOeAtRhqR/ADId3emRJQo1+dnIGU3LDzxKjIxn5PY8OF1G0+CwVEH01xE80xZSiC1
+G3+v7SefiRzlsJUEvJAY64SvWfxuO6luKSUlIZRTT7z1WNcgyH0AiCVZkB+EosYtK2jRhKzCUhl0Dcm8RRdyhqso27+dLG87ZZjbdgTb52I5VVeVrkyrkuaA
+ay2hK2VY+NADlsA3L6HP2LTPhykDO6Klu+oiviEA6pJm5rIUKnMZh7koLa4VfkoSQOKtJQxyNBriJzAIHix3FvIea/efMIlekUxVuAxQP+dwT6Nby8F2eaav
+ahPtMzYDgyupa6Cawsdi1+3JRh8CWUTRVcH2mrji/bjgS6O6Rovd2/NJk5TdjJpc9BG+8SOhZgqs+Vrk8ywpoNIjOJtXGNpI6cWXNs5RYUgvoPwCFlkITglZ
+87l2k7bSpqZ/1qQ4x39zuqJjoVzyTApTUiR8URPGXiTEo1F1psHDVNjNRULCyWOJLHpUnZDw5mcXC3Ez9joVw0ohuuBGLyU3aqkwGeJTKoewRuWQ5mc3lQ3x
+S/yWCkhtrQVSKwGyeYNYdOd89dPd8Kla0dDkU50kKOMtR9+wWBSVGq7YlpOUfNACUtNhI7CkXjiD4EXPWwRWybB5q1eimDt5XztCv2sk4P4ENQ5SwE5LnYM8
+vjS2lTvAtPa8rrL6kEL7dU2mSE3uT9dsAwMkRtVzS8M+Y183zdLEDZ2va7ubw1Qx2TzHgiOcmLpnvYvxyzj75O29FtetC5rmzrGMmYDeg4JH2PuntC/0BN+k
+AhfkBMjEBxg0r7LMpcTaKKCtApLC3lESr2SBB85yDaWgIINGDhxRus+TMD54SsreoNW+bhqyETrfTyDxUXlsptVtrnWui/oJiHBc7iC4c6DywP0sNkNQmqky
+UMlVI8EvuCV7tmqm0rMQiJxa7bZ21DcFc6IEiBoPMCyJFS5AL/9cUIkEZKTBBG0UdXVy6Xl5wvSi+ZHmIq2patoIGHmGnEJWt84PDmrRcLxMDHmbotF1bqO5
+PgM0iVAH7w3MDfelbIyKvFsfVVxdwSajwkKOZPhMLW6UVDZi7OAQnePXvmhdHSjtpR6qm5aDbUJvz+cVe+UQ/0NhdiB8KcvcJUkiGH6Rep7Np+Vvero/PHmz
+3Jq86Ql0X+Ym1lHosZ8oc5OHzE32E2Rucpu5ye7P3IydifW0zfBHoI9kn56zyR+Rs2l9spT2lzAJvL0xo5Nty+jkbRmdm0NH7P6cTp7mdMaXbT2hk21N6OSP
+SuhkWxM6eTOhM75l69mcrNQ+6ctduls59t5UTtaayskfn8rpxF89j5NvzeMs2/M42cY8Tv7oPE4W8jjfDkanF8PTwenRkJ+94hdvCCa0MZKCcowIfW31VpBP
+leHf09yehLKZuxuXEwxFNMG93R+8YB6X77BlLdlNaTjY6wLoc8Jgw1S2FPFszZEFEbZ2ZgprWUp/y8KbK7aJTW2a6e21ZjCAq6M6bywkBIxAJNgcEldzEa31
+pGxpYPwEojEl/wCpdYLfiEzNuYQlZS4zdd9lcpBKSRLL5xG0zL3USbVajYFpXq3IH2tqs4tJligabXQrNf++yjdRMtesOZ2eC8LaQLS9J8khvtkoRHDNTOSw
+aMwRFLNreO26sOawjPYGdQtX66LKXWTK3l+yUHoOd51RH/hS5+W18VV5C2dv2UMa41vgv3FUcsoRk8ocrjMLdyS3KRhODzBxUSDBlUKZTk7CyA+UAMPad7IU
+P0iub5wKW2gsyjFKb9N4S+jCfOjMrOSLqBq8NaaUK0PembcwCwG7YDRVfrF1XXCKhZht/MyeJXQ40Mzdl2rhU7jIGNlPJuI3VeTk/sxlaaUAek6MFMXsOiqD
+rYz9Hfkw0B2zqDLrspE5nhxQ23x5UEc/SkUNNPSOczJm6eKJSYwjCvPDliNIhjNF7FjKl3ibWcyFziXxpDdnUGb5dVR5WAmsPtfIjuT3hzttKVv4kymDnJPX
+IlTJuaRF5LmuXElHK+PAAiUHr513uSYgLY7vPh44Mz4YfK/Gmw5fe2vtP/SEg8YHdqnOZS2NebqGq7C08hT0JgAOlvB/+vuMPROeRu2RExQUxKH5nM4bWugr
+YUxCofhkkq7kK2xZ4hkuF6CFcrVcyrkSpczWJEYd0eAkJ/vWPicS3PG8Wm84miwFkqOZpjJE4krk/BFzZm1zDpFdi2nl34fdjZ/dTQvZq5wfTvvOVQUkXpQY
+wimkZWTDa7yiDFwsWSbzKznnubVjNjImy3WxUQuwf7MaldSrtLy9gBuZKh8lly7dNnS9zbnRttQMJjSRfYGf28vl7X7LlHxsllQdeaMoBFTlYVubb3mfWnhI
+A+dhXpY/bhHd0ItNk/T2bVx9wIMink2xYvfzfbQxreO2ZQLo1aBZcLzxl8Ie8RayI5eAfiGDK2ROWg5+JBZofKtAY06gDdxqNn7Vu4F6wanSI08e3UZiVtb0
+xq3FGeJeR8TeD76f6eK0pSTiI29p0WM1mFwviYXVAoRRNrSDg1uFSpgWvAAFUuzHKdnfCWZ8N5e37n1raFPdcSepmZfUHLVWfjjd9+7UT8s3eBRM62+pAMzm
+zIW/g+ovMPnPLP0S6bAB0vPgQjCs1nipVgiGIPNV2XNlYHqNOjC3HnfNWtzMn14EhsVFYPhnFoFhaREYHorA3N4rZLoKMBtynX6x8i/REWcPrf3ySaW4H1kK
+9oG1uFuDZ59djfueOtwt8fEHF+N2SRuhFnctrNRjn1yLmzdqcbM0wvjoityh1iiL1+1jmI8syt0S9k2jFjmn4tg0aw/DpDK16HaPkl56wLywm1hWE4Dh00wu
+jY8x+Iu8WcBja9lvZst+86Tsdw1Itb3mN52qRxX8vo3Rl77aN0GZ06LfwQX+M9dhSn3t0aVFlZg8/IU/vhKTc0yhoCRJJeeu5GImTIm9rUqBHvNs7TkPYBYw
+tGyv49TsLrYRzV5H2m2t5sRVzn7mak7PNlRz2pL2+TOWcnr2N1/K6dlnlHICcm8r5XQbe71R64m9CNZ62Il97TvJ+5vMIze0MtFm8+anH1FC6p/8ufLpkSqT
++HdTrnn/Fy4yhblLlMjWrDCFFnqtxlSvUWDKTn0XCrpLkgXkNqyBlvwQNlXNj0OVbXhLUlQyJeDcNPmG0n5RujXzr2hybZlhD619lSQN3wqbX/s25izp80uR
+60odCsnjqUPtxqIY+E7gvx2ruKi81HynEc3Z6fM3to0Mv5XBMvQFG/FGSMczG2eHIns+JxeWLuZk7MRd5vxcraMEcczgdrUECiY2Kmq3zus0EznPNav1oyNu
+tShKRaNjU6Wfvp6Yu+QfXkwszi1sVhJL8Hy/UBmx8L1NNcT4VLLH1hDz6/z0AmI2J/abzbLu77CCmOcYVEhDYtJMWLdAGP3WOetlXqrSQk3RZxxFg2Y6h8uv
+KqRh5FpNt7n22U+smOTq/8x+vfo/z58+P2zW/3nW1f/5e63/MwO16ndPnn4JhX7a6v/An/9RKgB11X/Ir0jkvy1UWZIiCY+zKG/TXfu2PFBXBairAtRVAeqq
+AHVVgLoqQF0VoK4KUFcF6O+jChAEBbeUAeKfUAaIbSsDxB9VBohtLQPEH18GiG0sA8QfXQaIGlV+H3sid63Qg9dngnICXCllcI5Fvkb7Hh23XSb8hkO0DN27
+boLfg3bgroHdrtBQV2ioKzTUFRrqCg11hYa6QkNdoaGu0FBXaKgrNNQVGuoKDXWFhrpCQ12hoa7QUFdo6NctNNSy3rTeEGVc/yT1hpgXK/yz6w2x4CDiXb2h
+rt5QV2+oqzfU1Rvq6g119Yac5spU62q6kkNdyaGu5FBXcqgrOdSVHOpKDnUlh7qSQ13Joa7kUFdyqCs51JUc6koOdSWHupJDXcmhruRQV3KoKzn0E5Uc+rIr
+OfRLlhz68mcrOSS7ikN/LxWH0jyfv7OKQ0k4Iao41BbMeUjFoXi83t9JyaE00eACvj0oJAAj+DhglM2DdU/GJkAmkdmUmSA+YT8o48xiMCysDC74JC3dni36
+yQeXEKscKSQX1hlk9RGLUoHfLI3MbhzswuecoeOmCdSJMW4O3gG7w/fgbhMlZtAiKiv8vB/Dt1kdjvvOZdOjuLIuVz+NPdm/6mO8QfCFvOWZyqUNFwFatwBS
+4CQC6D2T6PoJ1mspzA/71mJ2VH4xRbq9mHMD/k3mgXldWamurFRXVur/0f37Df87Aa33UP6M1b/uq//19ODZ00b9r8PnL7r6X7/Ev6Br7h3tg23yVQ/+7+/w
+/34N//fgKf+jKDL+UhYFXInpGwdf/+53PSjt9Rzf+IpPSrmC2/W1yiBC1vICPHrw9ddf4v99wS90YUqZ87eiKFVuJBhx5yAirdvR8KtC5GUkzWplgjaVBVuK
+vBIZS3ILG84CsmCUheHRJ92fCowSAiwREyIZ3B70jW1ThE88qJJRPMng3a25/+LFrRFpl9Y7KCMUJ6LjsOiU1dS2+8B9HFK0LD5JJcCZgoPpkct2JWhaVozm
+AqvXpOlF02v1hjYI2d1Pn/fvRK5KnauZ+NXqPz49eBb+5uT/ixed/P915P8BDyzBB1lG5SAMd4LQVakzelHeCnJDNpLsJb/WK+nzk25tjIgMcQr6gYkZY/gB
+Ezs4fc//ODo9BnS/jhC4fnwEQc7kiuaz0rnH25JTRecy8sBb6POMUPJkKpTx3BHUCM87NJkqEYUujY/opqh5rLiF5r7AMpI9h0JHK5YbDOiv4QMR3tzm+9rS
+lk5kBpHLrPTs+cgSBtCTaBlOuipmEo1dsrbQHi1mSlhEWf4k/IbZCZvINxYggXEOyDeYgrR3sB+Z4l5WR98kFIrFcS6VKSTejbCWb2mIw/2We85iVziGOsGX
+LoofQn2kb2GtjO0926fv2cvHhYgwrLjUN5QogXEJnz+Yr93somuB2V/FVnR3Q2z7d6JO1L8+OX9y0D/42W6A7fL/4PlXz57V5f/zw8NO/v8y+z9Dx8aJmhaS
+zyv+r9Xdx6mc8f/7f//v3Cl7N1D0lhhln7FQ6feA0hf7/Ly4+yiW0yqTjB3JsrROiZnkZheTcv5SSX73Iy91VfJMX6mZkiFWcveRz3Ve8kzyUpVVJhRNZV5o
+VbL5rqhKWRV8Vdx9nCkD+Um7KuMS43R/liUX1QdUZrE0As8E37QotmlRfG+mnohVcfcfBlx/Mrv7iAP99f/46492JX/98a//Y5/yNQ/7/PjuI7j4rCvwWOQG
+HocZokh0y+/Bopda5YYDBWyBSChPxvNdlc+RLlXOjcwNeinvPhZYwFjnXFIMfyWKbxiDicx0Prv7OBd5CXP564/ftBOMe4KZqoBHHL17HF1LVemiBLlk87v/
+gdEUAXerMrDslSj4DEM6uZIFLEDMZuRpb1vit35y9raqigdOrzGbaBasOQujq6UsywIe44EWvMol918Hec8vcx5PhyFzGZE8xSnoPtPFShewbFEFxoS/kVfu
+7mNx95FuWtin8FkmfY7sXNYHr9NE6dzRJD0BzvONW0M5j8jC9b/DvbZUhsuSz6UpVX73EUhy959lIaNV8DkyYhiAbmaYS3wjRvuzELAjks/vPmbqppCFJedK
+4Y5F44SjoO4+xquJ9u8vlcIkqLv/mMv2gWAF8JT8IIuZZJk0xBXGkfbuI8ZTgdVSBnMjuE/D8P/zv1c3aKTr8u7jlWPeTNY4rcdLmWUoN6rcJYItJZvv6mKO
+BRurAiZmRJor3OMrRKaJv1T4/nZ+ZsJJqLuP8Cl8xQupUjbF1KazFATk3cdC3aTkbjIHB+Gb4Wdh+VXO/T714EgVklnhE16t8DH3I6lP8DjuYKHnlSo5Fptz
+P2SCl7oUmSrvPjJ8XTqG9VGGVpapfyNlnQQaUZ89kgm2bC4NXygIKBTGq3hVshwJh0AShXJd3UhRuVecnghrc2cFyMFScrSuR0ZCiIT/s76/XuZ1RmNsoivD
+cVuLG4nzTnbcXwuZdAwv8tIJWJRBfvFVLpl9nucIOJxlFV5bsuQZ3WySF3IOsLuZNDyDvYOvlTB5Pt+lQwYHwjB7ygxUPMnxP5K74a8/fuPqwhz89UeAaswx
+zSbigUImTLCRaFCa5K8/8uGHu48zrC5rx7C8XjxsFC5zi2z61k3sGUwMfvXQMb5l7Plff+SwJ08sXYkbDD6PR8NtCVG0xxEqWd2ALNEVn8miRK7F0Vkm+KtC
+Sj5xtu8rXXkYYsWz3bOVzPmE2G2Uq1JhQQtiHPw/qXIkjdt7+IJB4Y35s8A1hYLLUGSSRFP42xz+vFyBE/KE9ljNfKYBsayXqkFJuvsRiBZfA05YRpfavCJb
+r3nGgGVBDPK5VqW9eDwlIUNcpry3ZgJj5rLAU5DgD/v8JP4qX8mq5KIyRvn5yYQ7GRBpuRJXcO/NdyssT7SgA5C+sXH2SMBFIRRUbl6tsruPKOZVgYh14ARS
+0Way+sDhMZ1jvMxwsVA5n/vlFfYg39BDNZL17Q7b6QVaYUooDJJ7pWVeoeIRfYwY5Xm/hl09kZFYQGJFi55LY53S6YKBTE0JD6Lb3So92sBIWqFTRc5Kkhmx
+79XKDWn4N4GZrbA4CRsVr7i+Z1T1zl6eQOgyufhAZkxUcksJfvcRzne4nrKYEvgtmYMOQ5uypEylHp8DIPPuI2nTwAmw+bNrMavdJX5kYw0Q4aqp0WbjNqHu
+wOzY3zL2DJdsYG6gvpdAFF25jxuYjoEtvvtYClTX3FkM6gieCFFAjg6bS+fIkfSHK2FLO8CoVlEQJVxB5d1HB9YQeZmQKkdmu5F5yVbC2B1wq6OhgO0LtYQf
+a2bJbMtqcsmMVoTUCuUVDAzQxl884i+gaX73MZJ+X9J/H0V6sWncmXi80fgp6XSrfFbKnlUYEh2etGLpFIdE6U+NhBXlsuENiplOqZ7MjSyEHy5V1JBR3U1M
+C3gBSFvcPh5tH2Mn/obn+W641GcVFbZ3gRPnV1R3/1FYpdnKFtTGd6tSZcrIgmWBT+IP8Xm8Niu+7E5mmSREiakJZF/xEK8xrNBByKpIatDqvurz144HGZuI
+auHYgSgMx62uwXiBlFIVyGzX79i6x4C8hTK4KlnyFbBLRhrSrpj9pYJTWxWW9RsWRA/egbMCgzNhTFXIgtcV3FWljJHAVSudzwtp+XUqjVbRBpB2Qg+DmUh1
+rZAZqwyOL1x5M53/pYKdn9AlLIv64pFlw6MIKYG97fFCyZznu3K5uvvP2bVM1FuwPxaLQuEcQFubYTmLpgyoHACN9ud3fT4m5zcBve4+1q4IaUrnHofDyudW
+LwaZ+OdqrmbSrzAvie5ejZgHXdFpJnWDrI+fi9afS25EhcxLgqeUeVWfwVwvqfiIqabKON0jEIMMYFx9CbLZGl9AVeRsrKyE/yW5sZI/A+qKGzmLZsdlGZuV
+cLrAU5wHTctW3fq6z8d3H43KlLDXbItFRipaVeqlQPkIBwGJpzLUCufgMSLmSz/J0k8ijeA3IJ2R2PBL27yGdJFY7ESzNqVaVRnN+gJE4EIr0+MGr8r53ceF
+qErrsCgKdeWcAHDT3X3MhALaP3vK/6yrwlhXBSJ86OLJc6GMQTlgN9kb8zJ3w/fIagenm9kVV6oEllwVcglHwT3DMpESzeu1c+ksMogfa0z6qEo/d8Da333E
+GjVOquAWIE2gUhKQOJF4OH5+9xELB6lCMlTRcBfCHJBHNkyCaq75lmWJbYbMfSTzktQUKkokkWFLcYWqgvcn9riRhos1WlTEoU0XGxEOmEBd5ZDtICu4p9Hd
+h8hMYHkfLynuPt6gB4yu4XCDN24ifnQNjgn3KhYtuSkE7HxeLe8+FppXOfBsH/Sq+LIG8s3vPv757kfnV4pvPBRmblCzAo/GAobp8dkuvGlkRe0EaD7uUQY8
+C8JR8MySS5GesEVyRJr/7Forowo+9w/IIt3PCn2sbmZiVqJXhnmKu79UWQlXiqzQnqvQapkJkyqP+GX3TRybnDzMjRJ9OqtUytKWhQ5AUYfngwd4KeE+4vru
+/6rdEfB+lfOlypUp6W6ocq6LK5ErswxZPGjKMn8Il7oQWeRpoMd6fFbcfQRelDksu6zstmVakTwfGCOXU3RjU0MRkUkWM27ttBZ3H69UzX0W1D0u/JtcouSd
+Cau55qU0zlsG+45aQA78FL4GhwhFmawyghjefSxtClGr0MXNoaskxAisgRwZJYkeZfo8iEcW87A/RLF1koxLdizP9TJywtvxeGRIgFL5YNEgrTgDdfzj7Pru
+I+pqXmCQvOixaE6FLBWYhE2NboMmx2uanGTxWXtE/G/8K8b/Dg+evmjG/w46/MevH/8bg/BfFXqGpqeNlo3//kOAG9bVRQG7KGAXBeyigF0UsIsCdlHA30oU
+sIu+ddG331j0rQu8dYG3hwbenvcP+qnBA5XQ0HMIrhIfOQm72xbKqd9VdMJExVHXWSk4WOCJtWr4zJbjqt0v3MhM5+Qn2qoCgYxiNYazsZL6Fx44Ilt5x3OT
+dtZF6xbe/xQKbdSmWGTA4EKoEH7Bs109zdSVrb24K/PN0rRHy2IusITru6lwB8pCK6Pu/mMpucjAJhA2lFaUapbJv/74zDHCYZ8f2Ux8Fzdq91uGpRpdXQtV
+JoK+lf1mejmFj7MafwpDiq8ixg23b6gJ0ONLoawgUHiiIBqrMFbCXC41ESiVkLTjrazZQ80hqxr8wALv9B7CS4m0jCbdZ+wyVlL/Yn3EwTSwmtrifpM8EIlt
+JBLPrVsUpIyxDCAyrYowS1x5n48yWLw0JYi45d1/gnLgmN+hnryx1mL6k5UdQ6ZIVWGft7YaA7CElsPU1pbe2G6lBa0kQW5FwC3WAG7xTwRu9ZhzSuUKbYm5
+5EUsSq2PYLkSBRWGRWlvow+w4JnMKtXgQW8AGKrlYd3W/vZQ+RxYONclWKjg6Unu/SO9XOqcH8sbmenV0pUtjdWWUBHw6Pj45MlB/+m+G+EwjFCrHhi8ek/5
+3tF5+tozfC0vC0Eh42D9+K2mjTiSR6OTk16od9Q/4HtHQ/jlk8P+gR/w+eMGfHLkRznyY3wJJsYsUysj64t5wm9oIcPaQl7AO1WhV1Lk/DJXDTr0okJPB3xv
+eHl+wm/68IMf46u//khPP8xzutBFKdNRrc/xvyTD/u6vP26r7uj255Dv4VPnJzeH/t2vH/bus/DuM//uwVP78ok0Rhb3fh8WgI/jFKIFHBw8aqBn0TDRbIBD
+3+p/U1kmNk/hKd97ew4cZXf2y36HK+pwRR2u6B8LV1TFHwdk0d8VsCgGEkm+yqTKnTq1CVeEX2QdrqjDFXW4og5X1OGKOlxRHVeERP7VcUXdv7+Pf9YOBVfZ
+z4YBuwf/9fTwoF7/7fDZwZcd/utvDP9FXgzvufitwcDqy/s7QIPFisBf/wf/G4KCJT6TB83tN44DSz+HBPl1QGApCMHtzGMQYJG269fxi8C/wgD43d8s9os3
+oF/bGOZvGPjV/ETMMR3q628D9RViS5+L+LIBpp8D9fUpmK8O7tXBvX4rcK+fCuz1E0K9eAz1Yp8P9eIR1KsDenVAr58B6BXT7qcAffU26FG6YvEfghD4RCwY
+a2LB+CdgwfgvAgWLiMwcLIz/BLAwFmBh/HNhYazOPZ8DC2MdLKyDhW2DhW0CgnmbgD0QCBbpA58A4Tr8aQFczx4NvXr+2cCrLz8ZH/Xi09BRHayogxV1sKIO
+VtTBijpYUQcr6mBFHayogxV15Yo6WNFv5d+Jmq7yq5+1/eN9/V+ePn3+Zb3+08GLgw7/80v8sy18V2vXgzdDhrCdmAy2t3cNBxe6oO7aM53fyFzRvT5C8SoZ
+tsZdg6SaFXIl8tmaT2V5KyV1cA8NAuErfvjcdwHmwIfX1Gwau0vPsmoeGorZecUxNdv+VpSlLKh3M6h5N0KBZXh0dv5+PHr95oKfnl2MjoY9fjyaHJ0MRm+H
+Y+r2fjI6Gp5Oht/49ui2/Zb9Ev5GrLnKjSxKLuYkx0UWJr9cyrkS2GLaN9diuFi8IEiFsBSeS6BlITMpTNKG0X7OdvHtM2Z/4XWsg/5h/0WPD6qrypT84Ets
+tPkcFl/o6uqaH/Sf97/s8WM5k8upLLjt2tnjopBx883Zvn3x8OnTF0/gEf46k3nOxwImY56cy2u4x4k6Irp1Sjm32gQukPqoG7HEJ2aZUEtZ4Et2ERzUF1zF
+E5j8l209yFQ+VzdqDs0vqZ+7HRb7KetF5HXJr/gAW8EZ6CVqlirnF0VVijZKPe1/1eP/UmVrfoDrfBqTCWbyhJ/NSg1keoYPHBKZmnR6+gT++otQ6Gn/xXYK
+mceQaKKWOn9yrmQBvjwxV7L6wNiwUDM+6fOxWC819Fx7rbJMGv6dzjIIRkN76bZJOLY37uNhPd8Ab0vqAJhrfisK8AKsubgSKkc8VSkL7Ls+kzQ0Cg+Z/1mv
+USEPEqcQxZrpInp1Uaj8CvX2Pm/9CsgJjuMtFrooDddFMhr2HFxU2QL+FwSTXtD3m1398N1cyrmxLc3tGLHwY7gA7B8uqqw0PS/KXPdVZX6AbxhRKrMQs1IX
+a/6XSmSqXIMVVYDpLNDZK2azqhCzNY1B84dvefKDndUiCJ72vwbuFnkFkzv4+uvfxdz9FITEW1HMrvnhU8f8LbxN7x18/fXXvwBzP+1//aL3kzE3cJxe8hOR
+S8ZaJ8/YO+DrJb8ROZ/MroX6oY2Ov/sapEQusQNxICLN9q1A4n61iXovkHpf8QF46oThx+rqShaMHbeRiz+SXL/7HZGLbSQXfwy5/kVf5/ylvs1ggn+UNyrn
+Lwsxk2twDy75y8pcyyxj7K24yivD3+hseVXInLHXhbziYy0zvTBE9QsB/sY2an7pafZlTMvf/S5h1heb6OkaQb+u1pzE1OxaZLkoe/x1oasVf37Y46N81mfs
+lT3k4eAugnJBg0aE7fGdNrLscAXW4ELl1IgypbSRSM+I4N8wFrY6o60WN9I2rJ6Dqcoac2fsXFQZ/LhU85KxC7Xk7+QVUvDiWvLz09d8LJ10PAkCBwEzSs75
+zmDCR5MdFH+t2wtLZSmFPIehpLKyUkEwFDxT0hjqpKmW+ImeVbKwrTQwna5KhrgnEdSrMAiQBRz61yIvhW27CtTWC75QZS4NtWjGzqm0P322be613bWxcJ5r
+nik3/gK7vxZyVvaYyu1/wbTRqgS0LER+FfyHBb4Ua+zq6ru/lkpkfC6w9nyP316r2TUDtY66ZVMzUStz3YXUujc9Lm9kztWCi/mNQkLi07ZCCU0X5H81u7bf
+a7TLhntsuo67ZoNNnTR/7TV6aIc+rIx6v+qCg3cdDx+MqBe9OuH9dvKFhNhDNf2znJVOZgRdtdkI9oD47ZP6wPYZO4SewdSm9YEtYHG9m8dkwvCppM69MmBe
+LQCMsWf2yj6qN3Z/bA9Z5v7U2kL2UYyMLAkAvCxbUyCtTDcElS2Zz3RViCuZsl+N2sJwgT4oncscd9AGVyxJGDArmk+oX5Rc5XFjYIRwzkrT59bGge80PqJy
+LtyjoJ78kMONMb9CLY3a8lKUDuiDzZt1lc35lMLrcP5o9wd8Z5Vf/bcrWf43L5B3+KLKkcNgJAHWmUB0x0JHhmSJE1M53xFTXZU7bKo/SON1rEz9IL9hbFWo
+vFzs7fyT2ek1PrR3enlysr//LWODzOieP8mZvtJ8T+X4AxGpB6QG6hu5n8jcyBw1uJapKPqr/GoHd8z94s+rK773u999eHawz93vc32LD/K9r/EP4Cuki1IZ
+fjYZ8SOCHsg5j0EFDnnQ3/KMMkw45IJFS8MRctJqY8+xDQrSFfy6WHGBNC9MnzhhoYsr2c9lyVjdkuxcc7/df29HFz+v8+/+/v+HB4f1/v9Pn33V1X//pfbf
+o2BSzfz3aymKP/DfB9X6WmcgSv6wXa3p8UUh8TKbXYviCqEwpJtglIjraSlUjr4F1HxYuPZIFpL9aYymeyXNyLGyeQ8k346Tnjv7+JG5FBmzItz9yd+7kaoT
+6b3+z0HtxddxwYa1a2hLicvC4Ja57kXaGqpa3voQ+fwLXXADFpZFjetFMrse3XDaagmWROhrub3Wy+RZoPSiKnJlruUcZjbX3OjNyl0UeiJPDRdTfSP5rK4p
+0SWrTIz5sX/aC4SCsXP5AdDPhbgqxOp63/pcpzJx1sKv3GoLmJ0pBWnhXmu1mrO//Bi7eDPkk7NXF+8G4yEfTfj5+Oy70fHw2FlBPf5udPHm7PKCvxuMx4PT
+i/f87BUfnL7nfxydHvf48E/n4+Fkws/GbPT2/GQ0PO7x0enRyeXx6PQ1f3mJXmB+Mno7uhge84szDh+0Q42GExjs7XB89GZwejF4OToZXbzv8Veji9PhZMJe
+nY35gJ8Pxhejo8uTwZifX47PzyZDPjg95qdnp6PTV+PR6evh2+HpRZ+PTvnpGR9+Nzy94JM3g5MT/NTg8uLN2XjCzsY8OKbfnJ0cD8cT/nLIT0aDlydDjp86
+fc/RT93jx4O3g9cwuzE/u3gzHONjNDv27s0QfzU65YNTPji6GJ2dwjKOzk4vxoOjix6/OBtf+FffjSbDHh+MRxMgyKvx2dseB3KevYI5jU7hvdMhjQKk5smO
+nI3x58vJMMzleDg4GZ2+nsDL8cNdJO/v7P5/cvT28mfVAe69/198VY//PT38qrv/f4l/v69fB3/gL9fhju7xyuD/2LsMnqbboHZhf6ELhii8Dfd2D61PcVVI
+Sa57+PEa3GiFFPMexcNMqfUcv0Xue0yzW7e5j0tZLMk8TG65SC95jHMlLIOna2A13SP1snAXM7F2fZsu5OMHuOhyyy28WklRmPQGdVEGUfKpLm1w9DEXOI0K
+g0Zug3RjmP8EXvFi6f1gdc3P+VMqY2/6OYB5FDAIOqVQ9VHlGvBATscrdZoGaYc2dfXM+Ux6/LZQZSnzaDFWP6hfnD6gy+GWjS5zvL/Gw9eDsb3qRxN/O/VY
+UAvgNastbNcF8Ka36kByw7Nwwzdm17zVJ+fDo9HgBDST49F4eIS389HZ6WT4r5fD04vR4IRFNz684X5892ZwMTkbfjcc8/Fwcnly4S5x/PTJ2QRnfQlX/PHg
+YgBq0Pn47NXoYtLj9ysKp8PXJ6PXw9Oj6HYH5WF0djmxL/SYUx1IaeCblQarJJwPx6/Oxm8HOOqrdBM6FeFv6v6PzvGvUP/n6eGzFy/q9X++OnzW3f+/xL/I
+5j8KuIff8SNRmFLm/I1Q5b9lsujx11IvFvyNKApldI6Xzo0olK5MyGQBAEJjxOc04h8hAqYluhA6D8I/igehzUXAYhdBfTmYx53sF4yOMSNUXv6fQc/gS1HK
+QonMkBJTC1z4T18pjNzRvtS0Uj8EfsYxEFuJ2Q/YrdoqRspE/CAMqkD/QG6LT/FQtCoe7FM8FO3KBus8FD/R/b+Q17+u/X940MD/Puvu/1/kX3cPd/dwy3K8
+L6R5FbfdsmzrLZtcrfyeq5V1V2t3tXb/fsH7//TV4NfM/3l6+NVX9f7vT7961tX/7e7/7v7/5e7/raF6HoXq43J5+HcYRVNeRf1xzAHJLVzdRQ6ma9oYSfXE
+SiiLAb9ZW1ghQRQlr3LcJiXnEZVnOndMl5Q5JG0iWkK2JkRnz8I/ETc7V1TgwUEShUPtplupq9KouY+DOLaVczbT+UJdVYWF4UkAQyuzjJLkpuvaYJ7yfjUA
+ew3xoDOHaSVE6a7h0+qKF9JFa+RSqIyL+Rzx2+SnqIrMYIqGVOW1LFghV5mYySiDB+k0w0wahDxGsReX624rXmDppIUF1sN6ACjNdOFhs5Tgkq07mMZvEKbx
+lko0/Yr2//NnB0/r9v/TF191+b+/yL+3Z/91dHIy4OeXL09GRy4nNi7t/9TlBRxLX96+zxg0i4jya3Sxw5dS5IZLMbtGmeGS9GaFFCVdMaHklc8nxL8qnYPy
+8DYpXAvfOEy/4WqguG/hAFhiMJFwXqQeYcLEqlC6YMng/iYSPBo+JPWlDy/FXJJgF2WUP8iiV3G2z3C2N5hGAJ+Op5nMCWiRfkIX9cWwtsXgBJM3QQUims+E
+kZE+5K/wkpJEcIbP+3xnmMlZWehczdKWoW/dbeamLcIFx6+ocFq25mI2kyu4C61+5pW9edSPFFIQqtxl8MBjMny1LERuFrKA7ZqLUvR9Vbv+lzC/D3JWlXBP
+u4nENIXlgg4K1xrXsDbYFo+Ah0dwpS/6fIfg8JnrlCqLeENChhcQ3zKsL1bhMsNYYwzu9VL/QadkhRyJNR9+uFZTVfIBzuarPt85AR264O908UOg8K0ufqCs
+JLv3srbcONvH7iTlos7sh/kVPJ4H1YOwMU4XP/F55Y7Gv4O50G8DPVRw9eCEv+7znYTP/JTztU8K5qVGrUpmEn/0yVRWD5tJ0CNMWVQzrPirF1ZhaT8RaFoU
+8gZjiqks4O+uZc5SPoiy6TFLxsjCKuZoWfS4SAbhCjP5+nzwkBWApALdFIYTOGCserqpM79F7dNm7CV9L5e3NAhJRBqJiBnrzQ2SbBz3oA8loXaSF9wWxZyJ
+iS7LFRZj9ycVWYeYDlMhzaxQ05D+8lDOBrKnopayyGHgHrfqbakIUKRKv1+++EFgT5dlJLJCivnaHQGib8rgCU8f9KGs0U404/iIrwq5kEVBpSuWjsgJF4F4
+WoofIBmuZk9orsrYvgQtfqnnVSYNV2EPe9RmHI9FwI1R7rvAKjfu1nRsCdRelfYKKjVdiprQbioLHlOVm1Jkmb8MRM6DaERzRvj03zibC2oCycJmPlIVUoW2
+qM2uZ9vPIMnUW5llHBy9eS8kbdUk04KVcVYc2i6za61mkpIIYz6aiZysT5vOFpJRIWNd3YiMwR4FwBxOD6u3rgqgKZ9L9x7e9HBon9C7sDsmsq1v1VyC+een
+DZuca+vFIK4BxeK9roJUq90HgmfySmTuXqCCg4h0I4cC8TAxPMEUnQ+C7OFFIop7KbPj+IsKZaIr+4TUTA6EqXydkImkDLoXoHRBEnQ0OYQJ0lqsbW8YcKOd
+ubtbkMdAWzDuhwxPFDKSsuvBe9sVztQZroe91xV9tJF1HXi7x3fsO46ie2KfzqC+BSOcUnfhOghpvKWmqlL4HP3Sbu1S5IKqP/iUWloOZPBKNHmna5qlmJXw
+BnLtrTJ0MPam+1zf5rIw12pFN8KiRL/VDMbc+/LpP+3jd3QhnZ4FRn8pcjzr5loUpLFOZS4XCnMpkwGjOfkGUDG/16/dw77NrG3qE6/B22ZTTJt/jV1yhr/X
+FSkN2fwJsHmPF3otsnL9BFx1PZ7r/IlvFsK8/yrxLKkCO0iBtpNj4f1ZWVGq6ErCbzGBHO5K3ELrN7PdQGYyOM/myqwyEYpbxC6zGtCWNWXNXotis08HSBfe
+mVdTdSFN1/gbU/BIpfoWPskY7Dyx8krQBZ7rWxgRviAWpSxwH+feGnHnoEn6His13A2yR0BlsAIAD02LA18g39u5LFWm/k3u7PPtS2RuiT1MoDU6k9na2UDQ
+/CsvSTfApGRgLpo/qTnCaOhZAXoEVpEs1jA3maN0A54oNbcz4Q8lNSwi1yUvNYqLK7TSimQukEM9leGj8WcWVQFDpTvEdGHVWK+oOPY/7Mc3hWf6IVgu8R9+
+O/yeUMZawchppqqtuX2DrG8PtYHYGToVRhlbVEU396DHRdOEsKeG/eSnJrGeS81iLmwx3NuXuvlMsMecCb7tTLBHzCYcDb7haLBHHw3eejSepe1n+JnvAhHO
+zjO4OgZU3dJ5OcL1ctHgNLrxgQLEc/DlUse/dpSQ6EN26jXbZj/eE2lxCgqe8wvM7Qt3YVBxUr60NIzLDOk8W0e10XAqrDaVDepT+kyt0JqdH3vRP6DtBTpg
+1QlalrQhrMY4eMrkjSzW0d+dakvmCQwV1tCnoW31Cb1APweWXKGMkbWjL7kxIjr5Wq7E81kpC+QaF+8yTiV2ZU43rV0XLA2w7DqlFTgcCdLnb/QtLKvnp+sJ
+kce17pxTgOFKXCJo9IAdOTEiHTc8638ZcTHUBiGV3BdLSZ02YCgnFvtjONlVGYFbOlL9VeIZIjuwxl8N+ln7CHdCMqzchIX+0M0QG2FhBwp+A3/PvXeMb/Cy
+Me9ls9JF59IHCank4Fxu+IpfFUlttbAPu1/jHO717vWIVAXElaIxGSY3lRyOTcnLW5ndSL53cLjPlzovrw2nawBVddgKBUdHUaSNT+UMqOQH67F4MKM+8L0X
+tYEEOomoRE/KyYmPNWEIdo3VYGReXziWRZldRyxPx1AUshHmkrmpCirUaF0UbbKKyBMVLPHlf+CN+zdXgddYoYvAOZojTSQ6FXADSHIJWArUXT1eVJGxBOZl
+Ikb9iVinJ8K6FnCX0ffgs9ncMbbRPisCaCh70pA4yWecaxx23xX7pAH6bdJ0Veilwpo1phS2PLqneLKpaEgW6kbOnZmYrXlkKGbrHvnlUq22HnG1+jsLphM5
+UZIE9ii7rdWx66zWSL7TfQEakvU8W5eYyjccUxTZWRPwwFQs0ZzAjOoacU1qljcy696qiG2eQ+HVSJs9d9rsWyyHatyTnOOaLpD3zlELPkJ9t49lT9+7LEyH
+6bM7L6zGTLoxuUfadGeU/lzlLDqxteB6QV4pULVUaTh2C/k3ku9NP+B+j62bvATdkZGDQ3w7dXhFcq5UZQbV006GrwcnO4wI7ohtI0ZUIc3yMy2UfIDRnzFl
+cgGmv8SuE6VQmdVGg5jhmKYKtLMoD+0C777gEoFkSDwFIqMMpJMtfkAVIpV0kdwRGy/XQ7q8CYEJ520FJ4fMFVwJ0oAIlxZm8B8Onym9qo8+LRyUl/AM2Rim
+lCvD93AdAp21aoE+r9hHB1AFhcVsDVbkZLm8NVeFrlZmP1bZZyKbVXRESm0xCry81qBwXWugrwR5EB8A0rVwA8CZHkjp7wSiNIJHA+9P9xMzZHA+Cpxf1OMD
+eIFHirZrFEwVGJ1H16mP+hZOLHO+Mmc5EcfRzdNuo0D5vUggDs5HmB3NkOtFZrRnfTwaMYRD5bWNjUTCl9BoxbrpT6lEcXx7zCtamIwKMMN4wZ3vAokwbqro
+Rt791ElJErGZJV1/Fdx2hUwEH2vRT1Fu2rThRPBxK6ucFa1z6fx3ySbumf0ecxqtmM+p5CuKfYwRxbxQ6pgSyRmzNIFo0WiBqg5yfGg7V2q+wmYgs+v4PogV
+l0TtxNu3wvewFa8LiWGZspw3ZF5j4ExbnsQTyHAxYOPcUDujQlLBWfjQviW2wPpeoVYbFFEj+zrT+gfcJhrLfihYLrNrDUex1GS/eGwbOdC5wGT3BVj8rhxv
+z+WXY5lGucyt6A+VHEN/Q4ytxBsYGKFuIvZZw0xBrBzZiTqnUwwHcSqvRbYIprt2v6pd95F6YCOOcRA/KDIoHVXJxdTorMIS37NMChtsdg4J1lw/v3f9XBmi
+K+ku8EmR6dyeL/jR+sBstQTthlysNygu8B7ZqNFimKtWEOag8llVFA2ViSc0aXinhGHClcvUC/7wJZN1haISlhuJqhc1t4dexIqU9c546SXWkX3dAANEL1KU
+D1jD6uk2bLlElKFeRHfnwZNn/S9J9SGDQpZWcNX0XmIpReLHHk9/ZFC3Jb1hiy3RFneMayGyewzSJArJ56m1cK1vqQ4FMA3z+pytfG3v0PjwJWQgb41bjbOh
+Zzo3KzWrdGWyNUuKO92v//batV+6KiGciQ342nXhe66EujrQ5y38gfZZi1Zecz4RzYWrFQzcgcKEApi2UixJQ2tE0d746KrDCsT7lFb7cBYoleRcZUr4Sugb
+95on1Tjc5NyV2rKuuZY2dF6WcrkqUb6rpSp9udMUbbtrmKVsS7TfDephEPZRQ6ejvKaX4hC8vZjv3wBH8EBAuzovdNk2oVtGvjOr1ONAzW0AmrNWAUsFTLbI
+vrb7gP0k0hjIx34aacydNHZWOD6zWdh+1Y89/rFUtbZ+EhHg07X1U8OhS45MFHFw4CP2IPBRs+yOTD4Ja2IQXM+kK0ML5i0tTCCwrVe7mrFvVV3Eu++yiBMi
+SehOUdOkRmvaX1saeyNna35MGtukFGVF3sexvKoIkUFWBKmGaukVQ/iGDT7E1YtsA4KNFCJIl+1jDC8bvZR0grPW+8MqkyZMrfBTY6hTOnp9gw6Aeiml9n2y
+MnYpPqhltXQxGLe4b70nJHFehDBAKNg7I2NNrrlYLCRs5wTUpfjucpdNvdFLZLRusHufw2dY430PvpjXMxRqYq7Phx/AS2uXy2iZwHmo9dsgXRtle8SSbcsI
+zoJszchdYFku9hfoBce+AGAJmh9UltnECOfFtGWwKPuy7zrQ1oI/NQzURXLS4FFC184S52C7hAHjWZSlmF2TpsBa7EKv/rt7vXl+XvjuhJ7edQAEQldO5W2s
+3Z3K0szESmIz4yr3EawjXay0zbHY23FP7eyj0LLJOhyQcYj9o+JjubzlN+1zsFeEWhKDqyVwABi69nny4rgUViZsG9+rSplrFVod8LyCqsjRig77fIjsDR+s
+re0sn9WOrHdU2BX44FRiNtaURqdaBKM2uxVrwvConIQAls4u28MawjvXvbLE0MUQbDx43V4zDf0oGY3umrrTntWIHVY3XXO3d31+am2+gNh1f0PCeGUDQRfB
+hUUfj4JeNc+A9wg0fYnRRqGTvVA3WBrbX4PWQxdiS+heD3k6m+Jre8HdDjsCBgeba44OXJyExgmvuSr9OXRoyzaUI09QjqyGctzvBfcASPJCojsDlVWnQRkd
+dMbVdSGMNADf/TeVZWKnx3Ysyv/8ZKfH4Qf6D3+w4L/PT3acBjTT+QLL/mVrbtRSYascHBWWiQugsnIiX5OrQeV8Hc/H3RMeleXt6aDPMXyjxu5uAI+Q3azw
+SW4XaJt5s1jZ8LyVNvru871XKsvIn3d/RKDHGvjIWBVzQ2x2IPGBdaSCqkRfWRqIrBkMfEu59KlxKfq0LuX3Xavr0MQMsohclhX2O/tuOB4e86Oz4zQ16/IU
+qtFh/TWb3cHPMBnJ5mzxl4PJaNLM3GJp5tYIs4lsAtfwGBPHGylcYRTM4Rpg6bgogYtdvBlc2HJ56XRfjYdYJ+54+Gp4BGXrQp7XyRCTvHg9wYu5BK+zMeR3
+PbEJXqPT1338BJTUGw/5eDT5Ix9MXDbZv14OfEm/qEYdO3vVOi9YD39/dtnnkzdnlyfHlPcVPwSEHtp5j74b8tEpg2fGQyj3d9GDl/kepLXB8KPTEdT548fD
+74YnZ+ewi5RKRslamBU2enl5cTbe54PJ5PLt0JY+nFy4TLrT4dFwMhmM3/PJcPzd6AjJPh6eD0Zjqic4HlOCWJ82PXAMizgGPjW5GF1cXgwnwAywqViCEAns
+K/ZZjulDshymlb2KCcAclShvbvRfh8f8zXA8JJYb/uloeH4R81+Yim8NfjEcvx2dIqM0VJl8Hq4F47umeAwDXdsgIFSOZkxVanCTY98NZj02C6GyujJOMgXG
+UYS09g9Vhb0KpwV6wuFxlbNnT/kcLl294FM50xgPEIg2tpKDHofeJ1kECjM1nwWhPEJ8gKJoWWgIQ7LCVMUNXFPO3k2Sm+Lr7Ry8DSZgfnrWCFMFzwV5l+NQ
+v8q5JG1lKtfaEjf6QANnk0zHNyEPJxu4wac69hmjnT4940ej8dHl28kFHKwJnjT/J9LuL94Mz8ZRHQvMgdzzYoSFipT7vShVMs2SDGmbjVPVC2eKRWeq5+tq
+jibud5SMGc6zf2ZyeQ7ibex4/uwVm1wevSEBNJz0onzQizM85clRPh+OJ2enPlXUlfzs+SqgUIh0dDw8vYCKoPVCoCGn1B64NwMgASaSbpO1voAofNfVBX19
+dnb8bnRy0uPvzsZ/5JOLs/PzweshUPbt+SUM+mowOrmkBNK3g5NXl6e26KedPOwg0NoJqbcgnJNZ0seAKpBSy0evuKfVe9oo9mbw3ZC/HMJfT0HqDo9Jygz5
++dlkMiIu4mf2TTty34mhiOeY5zkaGXOGB+fnJ+9hI8IfgQTHw8HFG1uOdHJ2Ojjho9N/uRy/D2VUGZZRDbPdncT1UO2lMfzTxfCUPjI6wi0/GbwDyf9m9HJ0
+MaHXwyT7bHL2dsj/5XI8mhyPkJYTfnxGEz05OXtnBz06uZzgmsa1UxVYg23ijB6fwNwGF9E4sE/RQG8H7xPaMLjHfDv5y/6kz18D559CSjQfwjGdDMcT3+2o
+5q7mO1FjIVXKZW+HkuREiWKEu4wAUoGe/44f9V/1x3122D94esD3zmZlH/u97WMavyEji+tFMnAjW2mHeuyER1jjkVpRvx3fW8paD0WoWexnxQ8O+4cHh2xv
+IlduXghRhnlRaL+8bjyOcwm/PDz8qv/V4dPDJweu7x3zv3rO91yLQVgxiFIiOer5MFs+zOf80sjCcDFDL1btpoAZYDiDQuMNZyxdYaG9+9vR5Gh4cjI4HZ5d
+TurXqW+q5dNmV5ksJfk1bdJmPpMF+v0cgHpJzXVdBuloYZP07MXTMI2whkjmyz3kEmLXM0kZSwThde8yXxihkBC4cejPFBOfBM6dERGNSu2/WHplTdOcsSOR
+qYUuciV4Jm7DDAzfk7EjyH6TRdZmJm572PMtX3vvvglmzT60nXOhGVWiWb7I1Kx8ohdPMnHLwrf6/F3NxQd4csyE9rEQj5jTucOl4MGbqVL9m4QLmtK+cpfc
+AwFZ6lpGbipl6CcYXfMpGHHSwAeoHdZljt4t8GdKjIcNlrJQM0EuwirPpIkWR5yBp+a2UCWVgc+yMG8XmqGWoLGBTGRFNSlfuxeSUM4WOJHZD6wRAfkXaIfB
++ZsqytIRxVSV5CaKeoxmGtO0iHwrARgVW+jE2HTO8Nq3aKXCHKNf1kJOyvCBPyK+2hlyucr5ROSl4EeZKAQ/0hXGRQO/9SI3iqggpkbZU/8yeDv5YpjPj4ky
+32Lkd29GU8lUqa4eNpMaicCB9+eqUGauZnE+/Cs5x6Dbka6K0nvHTjXC1HMbiiUvVjR3kj83Mq8kp/S2B6y3dRtYHYOJe7EdUt7DBm0lPYrb7uE0komy1EUu
+14YvpC2JIj+sUOemgKaoe0w98596H2N+I3PSe3My68WsND5IMMpLWZBiLDI+EQSJea313HBlXD/NbG0FgJxjoi8DAZO4jO3R9rIjxPZEflWJKxxWhFw2t68M
+rsiyqOTcd0WGt+YFwWe9W8G6mmpH0N4Jh320Q89OJ6NIKUIbDO4H6wZX1MHdONyITQFNsnKEQStJzuvGlEPWP+s/723E3Nr+m1wUitpJbMJ56qr0kdgYKagX
+Sd5lLew6RaS/LfhEXlpKfF4pknOJLhNQ1qEZIUWSkNcNPuAhaOhtoqmAJCGYMU6F/OJEKw/Foc7PDn7sQoaU5Y/xCbTA5NxV4WFxg4SIcBY0gjF2iHBRLBVT
+f/p8+CfUO7G2wM5FLVsdSYPhE1HUpUPNZeYFtvVbs4P+U1uQy/5lZ/9b7+fMdRnaR+IHZOCfZnzb3ckhSG+hkCLJpPDWfsmvy3L1zRdf3N7e9pc0z74urr54
+e37yBfPNopJ0kSBdIwUkfoAI6DxdlDnFttcosuh/e8Cjdrl9PpEy+djCZlq45hXhTJP6EaULU1viEChrzNxq3anDURn+3+Af35Q72lo3QBnm3jp3yU1R+hn9
+TRRJw7ejff8lcGKMadZjaWRxA5DKSBjsmf1v3MM7Xb3Hv+9/VP/p4Fes//Tlly8Om/Ufn3b9H3+Rf9svBH7QP9hU/umpLQDlLffLUE8mbflTpHEgh7NPgN1J
+Lk89V6arNdXVmupqTf1ma039rn/gH4u34lrcOFlBWkqpKVTSuweo5KuE1IsQ0V4QkXEkV/eUIA3IcUTWHoL14ARECCyry2HX8rWcB//bb7he1i9ZLKurlPWz
+VsqiG/ucaihQFmDMpba4Aqa/QR4N1YBwZR+i9DB3RO7x3yxlea2pBSL4cOnCEyvoH1xWhr5jIF8k/nqQAtxVAbEX3j9yoa/HlPeKRAb7vPJevFHei316eS+e
+lvdin1veq5a5vqm8F6Sdwn8VO/uWXx5d6ovSM3r8pyr1xZpBk67U109X6gtTylBNayn69XMX+7q3zBd/YJUv9uCqR/yeqkfsnqpHom8ZYltIhu9F6q8V1brg
+ZSHmEnqz7UeCm7UV0/rUwkqsJXXg8wuJMVvQ/55iYlNHmnOb20uXJlf5olD5VVCD6WKxzZK57RSA/7lI1Z8e31BTDCSjmJWYegQvW+cyAR5hQJH5PgQsmNNA
+Om1ku1bUSpk+Y7N+jLUMyCqX2xOK+Rzg2XZoTpC/hCACtrPlUbAYSHO7F6owZbRxNf2nBV7MagrLHDDDJeyfP6nJxKb71AYBjoNjySzoSHZR3/CDfbZwRQh8
+WhbqsDJosMnkvuWH+9xIVFRanmH0jC74s33cG8cOlIOCcg444xuuSC7G+kZ9p7xSrOzD97kY4iwcfyvidXljM7431Frjk8cXResx+TPUZ/uZZE6tFNkn13Fj
+NXHzmXXcWCgn9Jl13Ozpf5zQepjM6tWEVjITlvik4gXbpWF2nZ2ejQZ5HsblqdLE77HW+mvuoo3e3o/kJW4nicaaWGSRKLWTaJOQIAq2OdviCbbuJBIYZEPL
+Od08blJ4ro0a/MHU2C67rXQ0UITNy238762yO54JSW2gt+GpZ3dDOZqtQvrw0UKasg/msslp18JYoT0PErmFiCC7WVN2tz55v/hmsaBsIPTb9nCjJGcP47xW
+8e5gSlEVxw2L2vcKsrsQ8KJ6vr9dJpD4TB2fJfYZMjKfye0MzlPX9L1VDbfVM+SPK2fItpQz5I8sZ8i2lDPkn1bOkLWVM+SfU86QNYzETy1nyJrlDPmnlzNk
+zXKG/BPLGbaVe/z0coasvZwh/4RyhozKGd5byJA/sI4hu7eOIX9gHUP2gDqG/GF1DNkD6xjyh9QxZA+pY8gfVceQ3VfHkD+4jiF7QB1D/qg6huyBdQz5I+oY
+svvqGPLH1TFkD6hjyB9Rx/D+Cob8oQUM69K8UcCQP7yAIdtewJA/ooAhu6eAIX90AUO2rYAhf2QBQ7algCH/lAKGbFMBQ/7YAobs5yxgiPm7dRWxUcbQKZsu
+0zpi3l2TmJ+sbn5iwMwGc0rt/MKyLSetxRhMb2qDPgtdgEbRS2Z9f6nDuNbwllKH/HGlDtn2Uof8EaUO6xtBOD/TWvOw9fSkUpBtrXgYf4hAsNsrH7K08mFC
+erRz2kog8rYSiOyTSiDy9hKI7BNKIPINJRDZo0sg1s/Obi1smhSDvq8gIrpoNx9EvagZfveVSGQbSiQ2Ts1DSiWyllKJQJTZPh+7hBhvmcRfSNJlRNnjwQab
+KzPLtJFzCJOYSsAsdVKfZE/sM2v3xmNOZabkjQOBb6M/oTOjvzPtnIAOxLRn9p23o0786DRbQeWQE0iSOoyhGSxuLybJH19Lkm2oJfnpdRVZva4i31JX8f32
+uoqsXleRb6ireG9JRZaUVOShpOLD61aG8hyfW7aSD8JWUbGPeL8+oXxnVFxuW6m25Pa/r6In+ymKTYbKg+wnKDbpjUj2ExWbDKoc+wmKTYb1s5+g2KRzG/xt
+lzf7nGKTDy4zye+pMskeUWUyKq9ooMxkj7SWZ/1n8H+e4135GZUneb3yJPusypP3tdp4eOVJ3qw8yT638qRb9Jx9buVJHipPss+tPMl95Un2aZUnW8Qkq1Wj
+5J9RjZI1qlHyT65GydqqUfJPqUbJNlWj5I+uRsk2V6Pkj6tGybZVo+SPqkbJtlWj5I+vRrnxwvgEcc1axfWn1gZmG8Q1316NslmHkn9aGUrWUoaSf2oZSlYv
+Q8l/gjKUDQ/2P0oZyh7/czW3+KkC22OmqbCfUZeSNeHe/BPrUrJGXUr+qXUp2f+fvX9bbiNJ10TBfe1P4Uab2SLbQiiSOmUq29oGIiEJVSTABsDMUpeVrXQi
+HGSUAuHoiACZWGPzNnMx2/oR+m7tFxv7D36KCICkpDzUKtKWrUqRER5+/P0/fl8bl1J+BS6lGHTAEXwZLqXowqWUX4RLKf6guJS/Dizlf2JUyidQyj86KKXj
+5PnjY1J++vUxKc8vzh4ATSmocRlDUzYRKQOkl8JYfqvoCVLgKtHS4A4eBGdJg48PivgKOEvZBWcpvg7OMs4kFF8FZykjOEvxTeEsn9Asn9As/znRLAM0RfEd
+1QI8IVr+cREtvwNlaWg9bCAuax3iL11hrZcuCcrK0Q8HCYMcQt13MGAi1fNcIRnTP9bpNT6jyB944Eq0uskn4lzi7oLghsy2DWJIFndHlvOC00cpik3FcaI2
+UlVQAQiKXbZSRb13AJaJvrZ5JFQPgu8HTz3rzlftznVw00O1STRnHI8Ja2q3hNCDz8ra4JjIVxNF0jnwBTmmnWucyPUK8uN4m/Odhldt8AHhj9qqNGj0Zrca
+0jUYCy1b8IFxLVF4GKOzK7wDqWnUhCnf6q3czw7YP+Oh0zCypTbR8JRcriHzIGd3DqqSAcoVp9JbY76UK1XVdLwpW3Bd7UjNbM4mGuP7WUa1MWmp7qy+5rY7
+7eWmD2BLqqmwe6/5ITxRjWlzE5VIFQzRVgugn3GlNnRawOXPpwuESmHqxkSltLrB7LLbx4IqsYelNTTWde0EMCxeeydGeyTYhHLbJowkdSjBa7bkV1kZIS3Q
+xNiNudJlZlKLI6RTSpruYTI/GjicCQxVQ2VK/+VqPpLQftl9dG3ifXLv2ZWdZzeeKTs1XUe3PV37Vy4j3Sakl/rWfNapT0wXylnJmKVFEo1y0rlQKk1kZfI0
+8d4U+BUGvlN+akfxQrhNQfy/cOKf5PxOIW83fHSGWXpSHObbC06OrmDDbg+XujL5rU590Ppq40MHpax0XVP6xIFAQBJ7jPmO47u3a6T+yPCyk3PTyaRbla91
+A61/txDfmgflUR4hx6aQWVEbqeZzs8ZOyVTTOXJZukv8iyml6wTN04YIyp0phyuLvlR8T9+yhytUPRq9+o569V3vmI4eIV3qIhUY8Ldz67BSq+DG9zvRlBap
+sNJ5rsvqgE09CIwJdLPcqjxLAwWJIwJs3gYtBVqhX8JAtYk1q+Av9wF1/0443Z/Gl8kOsG7RMhm+FKxbEFi33AHWDU/ci88dgDAn4lvhc9v3xDfB5+bRi2+B
+zy0Bn1t8C3zuYKt9A3xu6fC5xT8jPvdw+lX43NceLFoXKWYfVU/w3P+s8NxZNdd5rgpt1tUTOvcTOrdF594Jzp3cCwrdmt5/aUjoZ0+Y0PdiQscowzj9XFPR
+r+SVru+0Lrak/jW8AVVCgIDuPATrKnzD+OpjUKAtEFYAAi12gUC7KsgOzOXWQJoZ65WMUZi5BusBUMxiZGqMxrLvNaOakiKlQ+9OpI+N4BJmGMInaLhlVtmI
+pcvG4aV60ZPn67zOVrl+ziZISikMHZ5BTEbTVXZN/qygjryVtQFOQNcwT2C61xMdv3QgW7xD299dgelRE2p2bXjBtNjZAVq/IMPNPnV+cWbrPVXOh80DR1Re
+2ntXydZ8JZar7YyNIE3a/ad8viX+9u0hto+eILb/gBDb9/5Ifv3BINxyV0tbgblbP9uQuhmqW7Sguruxuh80vr4/cyCH6637nmvdUW3vTPHQDEHu4u+45eXf
+/u3f/u3v0u38xGtXiChLWH5OATQLfAHeiSidAxUQLkGtUvoolqSj51Qyt+ddVt3I2oB6b+7YX95BPGmHtb1eX1NP/h7dN4WphWsc1c7K5q5s/4Zv/vziLMF7
+Dysz4A0IHuHYYTsQzmZx3ZwVHCGrP6tczXHall7N4oiEJycI3g3RKe2g/h6lz8KscSoFdgBbF83PJ1H2GOyGhwyazr1oCPq4D3tCjMazwVtU2bAa0jbkZTXl
+XWP2RJXD9s83Po3Cv2OzxjqTi6FbVefRJWla3WCRD0ljLbb0pFTe9e4+vjDrwqUNRk1HtZwurNNALP3N8d+Pe4e/I/77i1fHh0dN/PfXb14+4b//AfDfj3uH
+Hfjv9yGyx/iaEbpmiNKehBjtooHRLtmcNncBKLfVUL4Cr929YW8YkttinzTLg260dukKIYKsyLiAM2w4QMB+EfYy6F5zSGTydbfu4NSbL3XhGL/HQuIwvdYi
+XgctimaCrewkfm8UT7xncF20j4JvivcIo9bCi+96VtKz9wDJCw8kH8K1DwsEvK3Ra/AT0w+ZIlXlxm5dC2ksBEEhqHrbHMhtc9CV+ibeNVNf7BoAwJIQ+1fB
+x5qPyDvVLHLv0jJuAxPBlFKrMs+8eunZjtY1Z2xWpqsZ5SdFhPjPryO8e1yHEP45RE5G27kb8B7fewDOPB30Jsy8nxFXxCGWqtZlpvKESn09eBdqZKVFU8YG
+OYDfIQ8ehTj//TfBm3cFCIn4Urz5RLZh5h9Qn20Hi8jmW2HnuQMLA/ppVly/pTOBi521MYVIcMAsU0FPRTpVAKAka5M4+HdMlE2orraNbxmaDdtPjAoB37d2
+JwKB77wKjjqAzfEkRJgB25HOnQASXw5kLrcBmYsuqEgcmKsjd0BsdLqtv5G3TCPpvhu/kcEbE49s5WFjeWNTdkS2pDpaMKwDcgy2ySNsQssWsgWw0ENtt6Sw
+Uwc8fcGH0aX8QMweDW0jsQ2K495h4h4+01Wly/vegYQC/04fBm/sO2LbOy/gO7YETtW6bBRpmMonN9AoX8Tg85H0pEPWkJ4RJD0vVpwVTQ2//OZI5bGweACG
+uAwwxN2BQwhx8WgIcbkVQlw8GkJcboUQF4+EEJdbIcTFoyHE5VYIcfFICHFeIsLnJf/ViSlSq2OTkEdEcXpCiMGvgMbLt8K3x+Nty7wOTF71OVCKdmD0BqC8
+Ae8iLCG4EXOT1W3Rlcgt0LsihN5tgO7ejwq+f2VnK7px3Co3hrwdvVY46awbsjkeoBPTO2S02C6j7T5ydWHZrZanqtbkz9wBJQubr5UOGlUlZwh9MTdLLTy6
+7AKV1/lN/JgpfF5fM6W0hRYu4pmMB/ICjo7385qCzoeczs1qx6BibHOpuHIXfX6NFFAvR72LfWQ6cCJ90lnl6vXYl+09UmHdp09T4+SKlmbcJcO3YOwKm1Z5
+P8Ruy6LlY99G3VUtG6nUS+OoULcrczuxdCEnGp1dqDsVG2ddhHBs0fUoOiYnkTaHucO6bx+LwMjYhqXbejHA0uWhzQ/uB9RurWIbQDerKxF1r1ki67ATcMnC
+fFtuzEnaCkRJeYvAGfRPU4rcXBtXERlVEvgRs9s+SttoVmCz+zgCNYnLnA+Cs/gSceVt9aW1wYUYmS6oNX9++MZq4AeEEcEoJCs6zWpbqdqu/2zXR1Zau2Nz
+dNg7RqTk3dazfzlbcJizbsc7RARr8iKcHATRCkHGOm7wBs5YSzQ6zLDW9ha4z1AIdUCDodr+ADQw/p2oTfsL95ugx+BVeK+yEhKlGvuZbXUXD7dQIOHOdqgx
+vJQusIPwxPiQTM28LrOCqcrgW3iZ4n+lWuUWvp4kCmzbW5UTdqrr5ZtepFptQ+ghdJ6Xkqi/7PNb052zQgQ3pcO+jtIrMl150MujNiARGxVgT0D8LW9wZy46
+RUuXP83h9DTQhyvh4F/aANoN9OHEoRdsAa1uRJzhYYYuDHC1OiGJ3reJuXzAGe6ph8F2wwrdmDuJGA5zZSEPRTfedYxeHWDSEBRNgEbdhJgW22Fp2AFm1/R4
+J8gUrSs92wan6Vrbxsvw+YIv66gg3r3jsRDvhY3GvbIDX7ONJt5cW15B30n0/XYtSZCE0OkDhoUOKoHIaYuv1dlS5xu5VEWBeHC1VBYNrjCBcUY6QIU3R3Rq
+OO/NdTtQ3DugvrB3zVm/B7Qd7caANsQhHXg8IXyvCcLUAaMkmp/eiqEkuzGUHGKV2LJZd8EQEHxzY/9GVo8QTZyeGEUneth+ys5ZBGIVcL+xQMJd21RAmnBq
+Yb+b4DlOhGDsGv4QdobSM2MtsdsTTU5rEQqhEDSwHWVIgmS45hnG1RMPiVU0yOJsKhUj93h1CcAcGht2q90Q7Fg6d82vgsdThOgMEQxMcw6XYIXTg1kpzYr8
+N4t1SSQTD9LQok45l2AM8R/3Vfi+xpjRjEXqN2VhajZQ4uPhKFWtSmyPnc0J2PdOX69r8B8Ta0i5fyPmq8qWuqxkhEuI9pwzRqMsvgPruWYQoG3HM74VvbEj
+bP6zPS4EtUL4xO0BxanZIXQ1HKZ0I5DvUS4UeXeyQs3n61LNM10Fc9yG9el7rX2GCu8fC7rTS4BvAt/ZBF0Tj8CDi1E6O4aaiC+F6dyO+/ZodLdfD3OzTb7h
+t86WA9TK2gsPkM/6qw3WRIaZ7F8Mmfa7Iab5/MotqGmCUNPkt0FNE7uDlr8OahompKUUyfVY9hEWmtiKhfYgX1iEkSa+BiNNdmGkiS/CSJNbMdIiSJBXlh50
+q78xYOrZDgUis4XYBgXCGzarGWzIi0ULJUv+WpdPXCfCF/re2y0KCW/JU5GqBK0b+YzhfdiqLnNQ5QGwAkzSuqizvC2NwJ2fzTNYCnhqkRVxTX1Fghz7WHlu
+C/Lxm+LaIB4AOfiztktewKRVjAOVLbj8dsGur+J5kGYNOwlmqmmluIJcEaNCOGcGzu+Vmn+mmmbfZE+em1IbS1RkR4GzKnbNqgxmtWOknQPF8WW6+oIRsl6a
+cWwzK6taYE6DG6LjJ+CcGbNots3Cqbl3mhCi/oZr7ks3zfJFc5odAodw44Je9OCE7UZ0sXUzQcjzfpAX0QR5kQjyUnbDvGCZUlHr8rnNDIARzktTVfybAxGB
+rkjV5X5+FETD1iPsIRrCRJOo9mWbSdUgpMbC/U48HXc0cf5fPKrw/lUPc51e/Y6F97ifoqY65B7uRRHX4Mt7avAJfpy1HpiHn1jpEYLnOjIanaeg4/NcZKHg
+TO6FzKFmXQunS7H6+jkr0maRBdL1U2CKuD7xijTlJnAdJp3ZL9x6pqsdCW4ZyDCNYiDVoCJUiVjqcn6jCmaxX2Q136iBlOMcAegQSA/e2llxTbckHNBSyzKr
+PgvlDJz/uVao7SFmDIWHrZ23rW8+M2GKad2iK5MIF0Bz/2FBOaGHlTuU2HIfHTOxjXAgVFWtlzpySKkioDThcA3MsSz1SmWk5M1NyWkMDBTmleRQEQ9qxmCb
+S1hOov+3EaxmtNAiAwXDjJytal3fmDL79+7NxmZn8Bffr55F7vMRWOJvZB1ciEt8rTBynpXz9ZKMcHvj858oH6W+0aYMkjRqU9ahWV7o6zy71sVcHyQufSNp
+UMDTGWysiE3uMQWy6YiQh7q17BAJdMEdFkLI7WEVO1S1qEFhs1F8XgraKJS9OM9SXdQq58UtOCgFK2VrHz3vGAxGl8Kfvs6KV/sefD+HrbUqzSIDxSc3FTZ3
+bUwKymIiMO+oqs1qpa514kv1QeNZE0v0UuWLdTGntmlIPvuQIbVdIb/rM34a3VWWPI6KxbHmkxbA8xmQz1in9jySvUGbw/qkuGXe9Xm0lQLrr13P6v6ItaWp
+VsTyvtJlhVZmVvxjXW7YmMUkOKtzuCCz31UNGyIuLHcmBWMV+E4C9/9SR7ZoxSUzgkqByA2DeTaUoRSP0G+UrfuEHXRZFbQTW8o+qmHnBuSbBSI8cxqPEP0H
+lo9zcPgKgRJqZriwXvZ1SbjmKho3oxPVNyQ2i1QVteMLpJDlqoThrlROpiGM31a+o37Pk+u614kRkKs7Dx0cdsBfgphuZ1e1o9hfhsX+tmq3mRWCHxerkjQX
+5fZM4GC4ghsq0uZoEUONz0HwfDPEh/rG15kS6oPgBHn59agPvrpLfhvUB9py36TqXTApyEOq3m1Boq2yLte6wWbBr6s2ZTFkUm/BCnfp1odtrHBbPvPerIvU
+efLCKE9V6ztVps5voQJFL4i9HR32XiSiaOFRdzQVQ1NLD01tSotzLQt9J+KU1siFshVmXG6DGRcNmPGjQ59P1sYZ74iyPTQ8AP/wSRwiWAd+2setbd5Dvumm
+y4uyhvBl8QDo8BgrvGspgzmACn0Lze2Hz2WUHC9zqUdtoopmZBveumPkS3pbKFjLKGaIEtMjAm7a0bluzPAWGmpG3WTw7nCkZEstDWO2OtlqdXAR4kw3t2cn
+1DZ22vXofsxtSI4+7L0MY5PFdTt0YotCHhJs8+DqLmwR7M8vadpGqaqt4eItwPmd1UYhNMG7lhS3vkpbsBRjFzR7b3vG8TK+elpjzKomekF0DruLAxNx24PC
+QLp6IqQBqOq9I2rMCCPA+4LAHUyWVJC8YYoQnKAJTHDcO/xTSHMSEQaiFlhlpQrYA+NytsjgxM8L71H3sYrWW13MgBZy3NarKLGLKzDgTm0RBsothIFedqo0
+DRPmKFhX+3CfWUgft3S55j0h/B56/tCKuXt3ycPaSaRyGGO2TGXLHpK8h8T/8fTze/5w/ffzwjyH3ZTrRf2c5DdYOt+mKvzx9d+vjo5fPdV/P9V/P9V/P9V/
+P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/
+P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V/P9V//2v9nE+fX5z9quzf
+99V/H746OnrV4v8+PHyq//5N1j8DU8Ys6sYBlfvn1fOLswOWCfbCJvWlsv4lOOBqjpKh2GAhTJiOA8oFF+y4PyQCfqvmnNjgm3ZvkCXtH3EKRmL/xG26nO5e
+Z4n6zN1me77gZM//A83HPbmX6jK7VejwAwdKleyh+rMXRij3BPo3cCBqSUESGC4Jenv9X/amvUAm5+quJ/tc6mQbkhnWNvuc3SpUUtEdk7q001LObxS6+vl2
+9tMbtmvKPZFxOAAdIaS7hE4vNohdP0LnnlsAWzebCooqVHsS/WNyHhW6h9WTlS0YVWkQryha33M1Tx9sSeOEUtbEfv9Anrg5wz8/l9OtSonvb5jzGuwSEeTt
+NnMLskJWNtcx4fpzPzQbjMMNGtdKoW8tT9s1VeFy8xGpjS9was0DJMHplSq1aG47G7SNn27k2jUfkNYr2WwMV8XbID0h9t8d2AKGf7pJziMDECaBf5PyZqx8
+oZWvphJdNVdcERsUWv0p9Cdj4ZmpdNdq2PRKZ8+h7tleRVv+bN+TjfdcnvhJPIlBTRMdi5GRM1vyYeXycxnJ40bNCJpzLscfzcBiI4Lpr55JMN3Ae3xtkqh8
+r+JNwmKY/FwqOu1R9DRcVLgV/FLcqBqlPL9T6rhWJroO4Ftls2zJO22Dj4iGDJS6SKs4LwJGcOJGEOaoFhsLLWFXqGFAr6talprylvPcH+uEZWHiJ4rrv2u/
+wC4DD4O5GElCz17Hyu+fPrJ/eJ5II8caLayGhPdFI8OtLdFhsv3xVd7FGKXK52Ei0yaKhaKz+9G9hc9kuU6FKaUhERP3HM0b7DN135bxOAnK0BEQraTYnYgu
+KiH2BwdyFn02EAl7qnqeVb09TipQnC6dVZ+ht1guL7OaIozh0RDgAZOFsSHTIPKZyOu1gv/WurLBHT66PukNJY+32ODYQVSwtCeShokzDLZlTl5tC9MSLNtc
+FXCk6e7vyZkRgfO1WYfUaC+JxY8pOYLABi6HgIUfGtY7u0gturkxWAsO+u0BW0qddBFbTcgaT+bUP6P9N/m97b83xy/a9t+bJ/vvt7X/JuCtKlGQhDbg5MkG
+/EobUIgOI1B+kREoGtpEwwqUX24FCn+5OjNQfq0ZKJ7MwCcz8MkM/EozMBDMuGTVc8TXcTBeDe08hswizRvSi6M+7zesClPKK8psx3S6+iAwizhcHZTfcFv+
+9ZqrybA/ULhybcvqukyNhJVeb3nkOd8qvhmvV/NjtDr4t8DSohTBfAPdLYW5K3AB6MDQTcFT4WciXj02PgpbzbJxAUI+Lb+DFX7yT2+Fn/7BrfDBkxX+Dazw
+909W+D+7FX4+O/u1zb/77L/Xx4cvm/bfq+On+N9v8uNSscIC+/PZWaCMAwDS8eHhGzyqs3Jd1Zo27LBIM1UoeVlkmOeC0HbHh4ffydNSV6kugr/A8zM9vylM
+bq43rrLwAc0dHcpptlyPzK2Slx/k/o1a1OviurrS1fym/L//v8Vn0Fbu7u56VbZcF+ZW9eZm2SMwFT70cBGUtxpSt/qY+l69lRe6BnRJU9fV/CYn5JRU9ou0
+1HfybL2sUpUVNieCEj6qIOFey3NVl9kvcqaXq1zVMINXJSQFiXtH//y5rG5MWcvZ5Sn8Az7cHr18/lzQY8NLeMqZn05VDrQML7WpkgSM2yAryWHABnbeRIdX
+jEsc8zcd/CbQCi1gnildpnoI2JUgHpEXjG3gC9cJGdoNpZZLXb8FMEyJS6ZbtaHhzRvqCISwZW5D+4u0AMzxkXnGtRi05lYRCMxu125ihT+9ERs2glHcbCkP
+PBVgyvGU/iDEcfcQsiKcRzsEaxzuHsXWTskHdcqO2cIfU054aO9Ii78cJKa6wuJwHD8I8aInIbU3aiwu7pWqY/yMJeMvQL8d1RxQCXKd0u31Voi9WbiF+a3K
+KxmpvtW5WenUgi3Hx2xk6hLgDZe8By50eavwsAQH8ExdVfB6+9CRWtk+wSI6wYRwWsO/QeflmgeaDrQ35nVLktjuug6JB3WIPqXSZVbAnJKRScY2LfD/XOuK
+rEX7ZfhKP71VxVyncqIrrcr5Db4TfHFoS4rgs0dHh4fyJ9gHd2oj3+W3aU9wV1Ymz6rEdezl6+PD40Subkyh5YujN8+P37x8/ur7w1cAyPVL+Jvj3h7I4FqX
+BXYaqn9xZzaWnJLdViutyqamLrO60ozUIDCrDiwcNEkQOPA5VaD4BqlCsIC1yHPbak+Il6RnYtbq3vnsbK87a1wXqSmpHG1VmqWptd2HFZn4Og1SVasQQcas
+bb3qXZnVtS5IElaY/YnvDC+h3dnlKa0oPyb8Y4lc5VpV2u2gjiuhvaz/Z7Cowi0qVmLKi0bnZUfnbRnJlZZgvemUJiiBWcQ/4j9FtEBZyfbrvSPvvNbF7lHI
+cBRwQ+L1eOn8EIXBeuVqXVJlmbtfwmvCiTJrmwurJOOjHr7WY1HuxL41C/+kYBxfGXTOCuIG7gbjpfpM0IUprcuUC23E1cY37WqSVUWV3qFqT7VD2zspQhdW
+TwjOVh3Iy9HpYDKd9UenUzn72J/J6fj97Kf+ZCCHU3kxGf84PB2cyr3+VA6ne/L9eCJ/+jg8+ShHY/lTfzLpj2bDwVT0p3I2lif9i/674dkQfiXHE9k/Obmc
+9E8+SWjuvH866MnTyWB6OhjJy9Hwx8FkOpx9kuP3cjY4+Tgan40/fBL90akcjk6H/VE/fOjD8MdB/E0JT573/4K/ngwuJoPpYDTrz4bjEQ5EhAN5PxkM4EPD
+0fvJcPRhcD4YzfDDH4eTU3nRn8w+yYv+bDCaJfJkfPFpMvzwcZbAGMazj4OJuJiMLybDwaw/+STxb9P7hyK7hyJsn4OhtCYe+/t+Mj6Xe+8uP0z3Ern343By
+OR3gf84m4z/3R/LjeIK/EHuzSf9Cno7HE/zzT+PJ+XTP915+7E/O31+eyZMxLIFb+v50enmOXx/IwWg2nAzEZDj9i6TFhN9eDCbvx5Pz/ugEZ8/1sD86/ROs
+73Q6Phn2ZwNYiNlgMuyfTRP4o5yNRfN9+PWP/bPhKU/UcIR/wvX6MBgNJtjO5XQ4+uA+9BQ2/j1/ztVnPSxS/cuv6AS4x/5/8fplM/579Pr46Mn+/03XPwYB
+tAUBR0d/gv/7/rvvQ4fAyYHEXwHw8Y0u5P8pP6qyzCpTyGHBCh8WLUw3Va2XqD7Oe0K0mvgOmhjn2a2u68xrAycaWul4/g08P9HXIRRlrP6fqDxbmLLIlDX1
+3wpxoW8gJIF93V/dzP9fpS5MVvaudPlZ53rT0+n6QIhHjeVC5Ub289ok0Tcvp334nlOAssoidwWIHIR6H8f0PIivWWUBa8KqNNelWnaY001rkY3CrAoVMPun
+UouV9YLgg+32vJLCRTaq+kz9vVMZwesRDCV0y9UeBraetn1XlcRC6KDMraNLRdpAhPQF+7aej+AOPD6mZ9kgExFnJgA5V/M51BXXRiq5VPObrNDPS63Sxsu6
+6cuPGyTAUVPhxIprDJ1yOWYAlBPlEEiovVlhFT+M60YVaU6OjqqueiFcKvSiao2hWSxl1m2V1lWAcbJFRkU2CotdV9l8bdZVHFTonnSF4B+kUdLi6zyvRLwF
+ENDVWCjXRld6zS0e7G0/klYBImnROVa9h8gVbo97f1Xo6kDXiPNIhBj8HijU7WLBmCHu2xgOtFM358pQnLb27GBVPhr0XBsvWs+gA4SKx5trxh403By2eH5u
+in8w3gKFdiqz1Px6yybjaYDwqKnQKl2sc+fsz9DPh/EHDOqoVK3IgklTOVcrZeGmbed9e/QqV1JnKU6Brw8VTcz09nKEM43r0ZMXpqxb+xhOHtkxFUlLlCMM
+I2idU7FXiXJLCAfWda7ZtYr8au0NVd3YyrpS8zcQd4ujlOT4s0D7gcBorV1hZBNGpzJljWAqYYyVUpIqUYYY3xuHoeTAHLhCj+Jc0awyPqMFPclqgVLZwjk1
+gzy/snp8npXj6e+b/3f06vXrZvznzcun/L/f5AfcYrgHKACEdZ9Wrfjbp0F/8nf5t1H/fPB3+bfBeX949nchLpqaQ2WzhlilsR5pCyzTgtdlRDuCxhWmdHcp
+I0cWcUKhdTcncrX11tHohSV/OYp8e4tagHe8pX0cfl2gLqPTOFMjoer/eb4RlnlIhsxDlEZkWYqWugSgHYxYqztFaMAWFXyurdy5Yyxqp3JZTwtM0E/D2cfx
+5cx6Cj7FIF4sx9b10lS1aAWfrzYN1JpEFjHuF7rxON78g4/bqDybZ2ZdCWRnwGvkujRViI6DMG6FYRQ3dNKyzxlB+1CychDeJYdcaYIaYeykRUTgZf+XLggC
+uiEYo4C+IauqNW4Q5DUTDMEFXmnot1lYxgd7lcDcelgild5m1UOghyQCTWZVbdlZFON+JRb9ChZLcz5S5ikRzRoxByuVpZxm+ayS2XKp0ww5K9U6r0G2F070
+a85IqhwTxh/J43FualOZ2vyaV8Bu+f/yzdGbVy37/yn/+zda//FsPB3PxnJ8MRjJ6fhycjJwMAfPHRblYe/73hGLM7tl5HilHb2ESxmHDe8IWA9IPJFOundi
+lss1okiD8qhyMQXFp9xjuJEc0U2rAI2Pgheqknc6z+F/QRasV6mqdYXRCTxREAVAHAbl2AZVHaQ67rkO23jI3oFPEgs1wauNG1zvxCzFu96PPbnPozHl3gGF
+P5vtBWmo67JaM35LhDyUVVJJn1jNL/b2ZPNXcEfJrK44sRECknRz1HpOXRSn63p+E1yqWGccM++gHYPYrz6lN0TP4fQvSo2FlpLYFcE3XKt3bNxWhPRWra/4
+HuLMdN4QPPFDgl+Fy3F/PB0y19gKBKvKITil1RIsYtpV9p8w3JAyyMKj4MSscpUVclBc51l1wygqql6XZNhWyP/WQjty+Md18zvo5IhMUV+JMLvRgmkzHchR
+E1Yr1SvoZ8FgaeuVKYIJb6BIWbT5qBeNhYvS7WQwpXjGfGmDiNaB5pT2b/i8X4CeX5Vwv1tMpDYIHGRY6hSO3N54OpQnuqzJ8grbt21CoHcWg6UGEF+U2WHT
+81wyNqocVnEKJyo4CgiBLy1CPUyOKXSBFzDoiNfXpb6OEJYiw5ITf5FWnGwvZjSuYDsgJD1ztQjybSAwnE059yrJQuNiW0KFHqZ5vDN1TGkDKxJ4qm67scSi
+c25xLmPOdGTwudpIQKEDFS0C603IXHb8PgvDuYdr2LP7sGm53qX1wb0GUzT8ImyaS16CMe1hRg/juViFyx6rA8wGgWUlA57cfda5GQ+pNuGr4QIT5pWYq8Li
+V536VPqfMJUelyyraXR4tORe86E9Oo3YUdHu6MuefLdh090qZc3OBN4OjxTYpv3BggXiYLCsD/FYSbR+1tsGbVN3ipDRBaawSYC1vReomXtPAXXKU1Q0OUbM
+grZI3E+/h6zPpqKkgeCkdg/Askuwg4Ioq/2TRBAMnlMSAzDhOGvFxrtY7m5UXRkSywW9EbXg1yqKp9u1JZvEea5bXUyNropntWVihig54WgjIyUuEkIAM1yo
+KR0iqRCvXbJ1wO/U+RVTtnZrAMeGHChioRm81Q3dlNYhllhfkB/tFqKNgBRF1IaWc76uarOEZbNwWpyuXF57mqRgH/SEeOOGRoDnLTL0KkJYdKsRQ4b6mRDu
+IMMS2ooFrPXxBRVN6DPsvDe6u9rrAiWOCRBCyPF4wwavxN7ojaV/aa9ZxtiUYq54rbcfPsJLdKCr2GqzRfmPdVULQmnE9Hoep4vxtBTBVqbUpo2g2P0pX79C
+t9V4OnxuFQIR3tau+MbxXio8GmWma1UGZTYsLoi1y+4YwWUsjWocxqgMJCREDuhcO6kEF3FWEOfJYp0vsjwX9xJ97ccVZ8GChLuRsRrhFm1s9wM+A6bStkyi
+cmNrzaItmqqyynEK7LiymRYh11WoZZw32N0BE3MbCKdVtDzYJMUBsiXdHNkyqICieiRRm2gqrYB2MgqPm83/7EYelHZOhKuEKnwvA12ZYF9DIdBCHaXKCPid
+OIu6YDe+OyRds9nQfEuNeq8pIEw5xxI8X8iLSznXJcWiiNVkhivLHwzUlDsmQtjAbwURS68R/t7lzAYNkyNypdH0dMoU0p4WGfqGskIq+QG8VJDbzReOLtLG
+xEK6Ff2XJcmicmRX6Gr/+h7m3U6qe6tF5b55DJW7O9siQnN01MpbssYsPUVtUEaFeblvhVA94HSN2dq3sbOHV2Ujrt2S7ab057EmEOPwsDe0FAqfZwVh/fIi
+HySgCFw1VXA2FKKIMWv+vVixZR4XEix6ocsSMjtNucQ9sFSfoSfLh+mwvuuKQlTrXHMuLm7ImgYA2Z1r8mCoqjLzTFFArNblQs3DXUmCJZHEU1SJANO4Lk3O
+lV3KJe0jMUyeO3jxzglAimjlUsmd6ZMpalBhykN8/zeUyK6buifEVU+ebFHd95XNztQEI2kPKd0/DUeGyNXdQXBU3ByjmztNWeRjuW2uPbp7g+2vlFVdruf1
+utTOU9tx3857kpgK5o6935ZOFgaRq2HdaNuZO+i09XUbzJK92rhTnIidNP+yRfO/o9xYdGmZzfNCKndlct3C5o646Ji3JqtiyG5NCQwbYjt7UMfFvR2XD+k4
+bMKOuxf/1sYGCPS4WCq8JyL6EMI7tm3tAY+hFMLNA//u3D3C7R6JgulAKjyQ4UXfFgKWlp5YP+7wDRFXYtvwbtdc9eTHQMplTZWqZrO+bdXbRHZbXAH/7RwH
+DSOcsQAE59bQ8Jt0h2gox/yPDfXLg5aLl0DwdaVzc8cV/gw8YVwe892NBbJOo1gRSTRo2XKWC7No4hzAnR0NQu6ryGexd9B1a4rH3pryAbem+G1vTSgYj5fP
+zmIXgWOniIhvSHHfDekIg/BWkevCJVsQwxi9ChMDUrV5iOke+FqBGozpm8rUeCb/WQTqvb2OHRCiQ5q+QNoB4g+pQtVTk5QFI6VBhRFTe11pmWq9pOOKhyxk
+76+Nr8evEk+j6/hzKy7Yl5Wel7quHlJw4cSjKQU/HtMDWhoFjnDnxPeoU9YU0YnbgDLIKmGTBSq9UiWGaq30b0tzNDdMqp3MpEsjANLovAHoNcc2vdX3y2WU
+WEoSoojcqDK9Q+AkcEPDRDayHKAxP+HhTKF215ypkKuH3iBsAC7Xy4rO3jl2FLF1S6DWuio1d8sy5fje4NzS5FOyBcQZGtTosgX6z4AWdt792NzpIBx1PD/K
+G6J5yGn+skeEbmPvMoOMYVWi/hgmOfdQWjfIjmcNB9Knlue0J/ssQnH7+VRFETrjt/v9Ny0y305Hsmhg90iiQvTxO3+X0r3p/xImrIAR3PQsd7LFynuIqOyd
+f2xjoF26xf19tX6P4JYXEXDGtu6ZUiq5WKNi1kxYDJwIWoF1EjMXvuk5+lyvKMWZst65V99IIlTmvwsCsW+6+H3/qXFaSozvMRu2pMQbw/saRyUM5bM0rNZ2
+3Gir6DBl1zYhUnD01FK2E74cpAZtmzFTxqnf1TPBsh22KE5j4BTaNCgTVBGm49o0LRq+dckFD3DLjvAiUCXly33k/LjqyT4FJtjxvYhc2DIm43YxjKRj+4iW
+Dr3LoI3CMzsOY3i2vMIUgMKlmZKq6gJr8assfGwxyEnvvFNuoTm51OBsy6qlvNaFLqm4FYMGPt7VLJBmCimbcmEjAhou3NIU2VzWpSqqBVFTpqpWYh84Jgf+
+gVBaynPbB0iDmDVm08aEAqqZe7eu7JhUWxu/RAggu7woWVUt4XTXsr7TOeQUHB0fyKUp6htLDAvDS1WtZVYz9yvM05Wew8r4vSLCxqrsF7n/utGQ6qIF2uZL
+EK1xucA8hnLdh3201CUPcwRXF9WaD4xqu15sB2hWwsidveHhjS3LJtyyyaxy1Gw2BT6wdsglchqwYzdV0OD0YRitfUy8jflVdxb7unALCLS9rWixrndn0ycO
+HDGy7ElQETCZ64pH4OLgrKkakj3wESPRtOOImt90fFKEn+y8YqCIPCtgEzUrLpp3CkfasSIg2cL8m2xXWdkPiN8VNVe6N/RPUzrZFmqJ28LUWQHOj2ZUhei2
+wdPBmZmWxIhsW7uvttxizgfcOPQ4TJcB1kSXCHdJwFQvKJomDZmTyH6zTZT6UEph6oAujdR6Qfx5GhNCqWqnmbC8TVe2VGBpTw5Da+bCWjPnSNYH9nGGEY8y
+lRdw3OQJMQXagCxasg4XgXXCEEwyOqzPKHF3SyF4mK1CNSO6xJBKBz/01aZxT27a29jz+Lu0pHBLhEk3os5qxBA4G3zon+3ROOyqMdEhl8mnXIgP3WaPu/9z
+VghPyi+Jkt9OSkCrBKFYmDTwMy2d3JjXblqZYApPsJ9dkvIuU6Mtx7yIVbG+Iry+csUYiWQVwVFfwZHlzYW7BebAc0XlrrbPfln479TeOQJTQ43W0Duup6n1
+qvJUVMRdj0GHFQVxVQ0+hQxzo8HLjxKx0HfVdWnWq+rAl65t5FzlkDnC5huBl7A8DIrvUtGd/gFCCEaAywHOTj+x7uajecdkqSzrRQKnfzF02755c4aKZWCX
+sYtqSUapjZjs7/UvhnsHNomoeYSc/8meogAZ0M+FiBw5kCgfiOn+xTA4EFik5FFssopnzsopEa85Dj3ryYnl+QxvUUf+iR9KAq8G1k+YRjKpeLmfHqAvmuiJ
+MdFC55m+3ZmH1MgwIohMV2fKjlcLJqRqP4vB2fOuHnL/eBkiMEy6aXMZ9oTQMG6WQFRLHN6Q6ZrWVjctUBxHDFUEfbLIHwGyaIc63z0H/vr/Ak3EYc5El0+H
+tRTmJGy5fJoDd2xzLlQYEgD2OZOaJm8P71t0saM4MYttE4BWK2hg4fW67VKMeCrThrPdmXtCpSkd1gIV6SrmT7UaxIjrI7t5+oiaT/gHW9R8kTmBSt/acfm6
+mAzacQUOMLqkUC6O7mHva3P14YcOhCXsW1e6fARXXyM/A84pmr60ozgTS2H+6MKUjkl/k/gEsIfle3EW7NKU4ebbEkhqGusenFSYwmdBXekbxdBKuFLG/qql
+NbadsD0R2+FZLec5QXwGzvKHj1f48cqsIv8BiRUYBJz6gB819p0Z2yTduSLSdcmRE+5Va6f6qc6K+bosvXMs9D23Ai28jaAUSJrFIwbosYVxcD0hFr3YvDaL
+oErcccn6PdaRUuEs0G0xnRama5zbRcuFG5jNLXJSeUdt20XratjgNvL3haqzCoNFzbwu8ASwzlRJjBfWN4h7hB4f9kqEMPUoefYzbj1017kid+FpOilQ0/Se
+JVtsCTyWBlIlMP/bAd+Jqmbpvcv+3uYxygJ9UdzjxozSRWQaW9s35o7vIl1q4SaXU+Z4cgPRIP3csj66n9mJC1CUrVkjHmzWJGzm3R54JTk648Knd/LqUYir
+7dHHMHrXcUbMsc2uUA/t/Z3HXe4+7mL3cQ8l3D1HXcQA4fYQX7cPcSsy6m+JbVkyunfdS0QzclSZJXm98/wBgSSMmxw0wsh43ba+pypfW9XMHrWCAg0bdBUm
+FvrZJgGYEDFkRwKnKsOt65IxRZiM2dm/MBdzlx+8HSOHIejakFX+CkzyAIftBGprNvKUtIpprWp2PUwcQ33IMpwtne7CcR2yL7ERhntwvMedZ50AH8DXx7kF
+D1lRVnoq6l4i/7FOs3mmcmHKFPMyQ0Z9rw3RZQwyOezh9q6xzrZUv2TL9dKhWvOIWQDPM1ZUKz9Z/uO0Tjb2V6G8opSS9Abd/w3XkhUq0HLox4lYI9gtIDgK
+qDdSYfVVT06xeDcQmBbToxXP9FZ3RK3tJWV6QH1pteAATFsItP5OEBSIGDiO8xAVnCeD0zS6Zi3hKmQ/EGG74Y2ufMMuDz44oc8Dw/4poclWnzEXnWDa17Uu
+RWApM+SHLfHAk17VMO6M/Vavd0Y/e42irqCS03I+kNEUitFQgt6oyrGVNxR/Z+VYKgg0puemXJkyCOU7w9IR0FPH3/ScgtTRa+X+SnGKnfWqWQscU8s/q6sr
+XeLjIn6cYEisy5k8Cc6PElfi263sLBGBCL7rknMmITnca3luDuGi4Jzwdka4LDXVt3PaSKHvxJZCM3LnD1zpYvy5cTHvkD3Oe+OT0ilyH9lp2yjtrb2h8ju1
+qVqZ6lntyg0joaRqn33uqM8RDsYaVfg6npqW4tuhbZH10RW9CXobjDDQA3pixLaWr204y+ZyS/574OmjrwcboDbtRE5rZreLW3pCpL32Rdgu+1o0guwYhWnB
+4oiW0hlV/crUOHwivFXs5t3IjO8pjiSiqmfrZPNSq3Qj1fbKuU43cqnRa4C+J6tdWB3C+wnmplggYAJk82TLDDZao4Y6kNnoO42NTiJgcGW0nHP5ABUVtARR
+GfBtcKVaKfNoFBw1sBEvl4DTyL9pG8lCSvkdaogWEcUs5E9sM3p81lOAYD29PJlFaKyI2QrYpVNXjj8eyf7IgYe860+H08TihwiHHzJ+L/ujT/Ivw9FpIgdD
+BAcd/BWgUxGvdXh+cTYcnCZyODo5uzwdjj64NuTZ8HxI6KqJaCKXAtRnV4ct6urp4P3gZDZN5PlgcvKxP5r1350NEvl+OBMAKNtHANbhyeVZfyIvLicXYxjQ
+RI7Go+cM2DocfegFOKWygVP63y/7ZwCtChijDczSbX2DcclP48uenH4cX56dth4CzNcfB9x3QJ8djnDyJoPpxeBklsDLch++OBqHUwCoqxN8ksBXT8aj2WT4
+7nI2nhwIglzFp0/G05ldkNHgZDCdArjsdDD5cXiCMz8ZXPSH2NjJeDKBToxHPVr10+H05Kw/PB9MxPi9h4c5GY+ms+HscobouHIwBUTcYf8M55fhbt2W6QEG
+7eUUJ8mOXQQT1L+cfRxPhv9jcCo/DiYD2nODv54MLmbhBvRdoU39fQ+LXNha4TwqW58X/klerkwh32FJYC/mTLLXYxyPEi7thII7rs6vUf/HxYYLDAuZbqVX
+sBIBX3IPrku+S7hOEV6hPLey3sj9F4cHMoXryyzklZ4blAkKhKGtCrviwYAi4PN3t9aHhGxEXIZqh0oxnmpd3hKku69qDDN1HCDBhU/BppjB1cZhcaNrVIS5
+E1lhcQuu9MbwXHe176Rr1B3SUVor2ceyUX6daeSGIfmMvaIo/aLWMs9qdlcgViG9H1JaRQDXFPzbJ66cDEHy5rkqFfpq/7EmgHepyJ10IGyxSiSFIx10f5ty
+al/FwCG4k9FgIP9uwV+QVvPkOKrdT5Xcm2AGR6qLGgJQea6vnfuoFezujua7gVdBFS4bdPALC57FsRYfr6VO+g5wFaqMct4qeQSfO24uM62y8MdqVRq0T7Hs
+lBAsquwXOAqv7VFgbxvenMFnw/gEwEFmJt07kOsiR9Ime6xUbdV+egS7yolT5NOBAH+Z4abAhAMjV2oTfkjJ5bpeU84TvMHomy6aaQEbXPrlSlW1BO8PZQuu
+t9SnWKCFxnz6Yg4YRFqqO6u82J3M27Rp3rc+YPdY4wM9cDLwBGFGUTxBKgIZ5bEhWKra0PYHBYIODKaNYEpDNEMpLWQwsxZTQJXENlWy5R0Pyep6duDkYGin
+DPgDt23vAWHD8baabcuEoX9ZZaWTRu2pIBtm4mfjR5Wvdcvia9a0b5csTmCEu6vz8MqHHl7hDy/5bWm1XZ1AqSuTg3Xp4lBXQQ11KStd1xRyPhDEosAXCUtQ
+npyuIfnVY7GBa+VmS9za2QqKhKNVxBkPpqJrFeUxi4wrjekIsKtqg/h8a+yQTDUtrMvNWuJfIHfRdoDmiHavKQMKohRT3Se6Lo1CGRRYrKGGIYch7gMMyf+R
+zUrX7e8hyGBK+f3+lYuXoxuc+I28lYBHhXyXwY1jJ0qE6AGmrBikCio6tCwrRpBCBz3azLcqz9J8E84veoqZmdW35DNrpV/wYDixWlBHepaU8uiwF1CEkmBj
+L2dPCFLaRmN5MpycXJ4D58MJ0yi4P5GDbPZxMJ58SuRPHweows7Gk5ncd0aBGA0+nA0/DEYng4OE9Nv+ScCW8NNwOkjk9GP/7CzSixPUdQN9OBGsKZ8Op/Z3
+oTpqVfHEatTTywuwUCZWbwZOgsuTj2Q/DKaJeAdfA+sCLAN44mIwmY5HyFsB/wQ6hglq76DED/tnaOsMT4E54iwhXXs0Hfz3S1KcxWn/vP9hMLXfO/nYh6EO
+JvdYSNK+B989G4N19V58GI9PfxqenSXyp/HkL3I6G19c9D8MYAbPLy6h0ff94dnlBG2f8/7Z+8vRCbXGnYeVgjnFSRYn43Mwp0C9d72kjw2miRz8OBjJYTA9
+n3hBPvZ/HMh3g8GICRgGp4KtpIvxdDpE+g4/sdxyz1oOdoS0Sn1+mloejWeif3Fx9gnm3v8RpuB00J99hO7RcvTP5HD058sJGlOXZzOgfECyC9/bJ+KH3//n
+fJ3X2bz6VRGA7+N/ePPmqIn/+OLN6yf8x99w/Z198DGralOCxibfqflnSB4sUo4E6DSzdw4mMPOrAZWUJZJTRTP0HsDfCvprYf3m56qq1PxmXem6rgJespgz
+8WojP0Amo3x3eXYWgKfhPz+O5DDIxWOqBmRqsNhxARF3bVzARFZzgvlweYM9Giv2jztOafx5ZeBNxKzTMBBGjiXY7+XSFNFXsATlQUNL5Dud50ABZ9DSRabb
+D1TnwWn92TwRH02hNwiEuW2oifTP4LTQL3HaNP0Gph7+Q+yaMdZAwzoSa0aCC4NSJQjgvWd3gQifvlOV1V8ZVfNBS3xRGijDFuf9E7l/9P3rF8+Pvn9zeJCw
+D5l8GOfDmZ8qMvpO7GJOeTFhlH1ELMTQKWVmE6qxjRIus1RC61VYOpRrlfpE8ovSLHRVmVK+12WhitSIP5tKyxNTXqnaYKF3WTnYelII7aEI6DVAQawCQFKg
+OMlqTQgVhDdSqGvKO+eB2EpQ7zRCJ5YrFQsxDXvyPI55iOYiNWJrvotZMc9Wua7cKuLKhaV5WQGz9ErWBihgD4NTuEbUATlYQw8TLkAgQpbetAcP4iPxPhPx
+dlUVWEtzmmR0WtW6hLm62lh0oaygrEPCIzJ+hwtsnk54kcr7T4fsyedf/hMxXliscEJOtfX9jRJ+Aka3q2T9nVnZyG9toOnhc7b+kpEnYwKZpIO6BTEYRCch
+zI0X6FdOoAd8i0FaussDBtwAsZViZkuLW8lnAu5ATpMLQd3ZgHXfjqpXzoezRH6EMAdKL1M6cR8yN8Io0ltd1ogZjpXy6JjN6g30xqJ2hAadi5IFK2RnHRw6
+IDq2shr2Aoz8o+/fHIsHS7h7d6poEiUdHx6+xsN0zzXX+Q6ckGl/ir7qSYZOnAlzAAl3/69+bfrv+/S/49evX7T4v4+e9L/f5Cem+T76/vtXz48Pj45h+/TL
+z59LJQdU7J9VmllY2pzaf0Am6cm3YpHezb/sOxLFyI7bHfj1OKC7+vANaZ97jvY5KIF0dY/oLbcJMU2YS0JIdORLEK3PAcM1hYVmwd1F9MFOvxhukTyblafa
+oY8mws5DUPNoK5moBvRGR9sLivGC4KpAt8dORlR0yow+dYTRA4LPMKIu3l3OMGSM3pQBcGUm6H9pvwZeFh8yJ3cKfO/9cDYaTKfbg+fQWTeM0x6Erkdj9AnN
+Ar8cRXin8t1AsNPsvfPHkZPMu8tCL5lznQ3+Oji/OOtPPu3wnHm3YSLbI4f5PLmcOD7W6eU7jmBLcJfBdAoKiQ+mP1hPGkStwcU26+N3Lybj98PZ9Af473fA
+HwrLMBzNBpPJ5QU4qw7kx/FPgx/BZ9a/BLcizOGYovnk6ozcWd7vORwF3k3wUJ7MgsfEuOUZld4zGnlDD2R/MkRmU04i+Kn/SYLPkFxvgsPw0W7zPrz+6Y9D
+6Pa9frpvTB4y6k/7z496L34//o8XL45fHbX4n968eLr/f4sfWP+I+6P/YTKgo4pcxuORPOq9YCHZ/dz+nvvvvQPIpYHTibuYWJTdaZ4M2NePCUYuHgD/kufj
+0+H74Qk5nuHsTgbhA9DGyWAy6w9H3pVuT5EYT4YfhqP+2Rk4m88GfThI7/DcAyczSKHprA8JMx/GPw4mI+x1f+oJpd3jInwAjvgneTac4t8HZ+Of5P5e6wFE
+w9jxoUS0v9RqJMFknBFKtBHcPMhZ/Zx8+u8Go8H74cmwP/kEbnyQ7CBCSQzLcJbwZoonbmolyvTy3Z8HJ7OAdrk/+jQeDeRPHzFFaOrXB/7btTGY2sUZNJsf
+TMOWhRdq/SlvA0orGvrwBowIXpoMxu9h0AlNfH8m+7QtgE98cAHRAjEcyfeXfI1BQtZ4xCLREoOP350NP/RplCDD+/jF4YhkrNuUPSE+IAIS+qb64AbavJX/
+Fv90PCPHVo9x5BmnusquyTH4hS3MsjrXHe+CLwkqEUDxwvaxflZXtU578gJhjeSPWZXV8qauV2//9KcHfP3CZOS6O6GqeFSdWl3q6IyUEv8fLuIQJ1iIfi/G
+XmSIzdZnE8Z/tv5DSnuEX9hPC/vpxCFSMFwkF6R91lWjGLUnxDv8PvF3UuoRw3w2EOwpgIv5AohFEZWqcjKWq/rOgli+KwFcc2heUaVtVsdQmp6WBLCmuW4n
+1J6nDCdphwnGeU/unRJGRgg+XN2Q4o4EZB6Qqt2EAxuyGQjClNBdasog6G9GhGoRxF6qbzPMmj+lDjit3vaCargtcm6ICNTdjRKh1CDHB/wn2AYCtqgCP9gT
+YtCTe2dQ+lpiGrP/kPW4h9nFjJenq45PmVK0cRqZRJWbaIP5NZuhr2RYMyh3A6H1bT5AT4j3vZgtJQKUzWvt0laigj62jxpAswi1nuyAKYYe8PKGx8SPwZR2
+XI2jQ9/WXMNAueN3xGmwDXqZDUNn94upLTU6eiMvpyfy6PAo4kzSUlFChh9oa5IDZFAlg8VHIg701XEeJh0nnaMha31kraP+oSf3WrMQnpv2ZiKgdwsAF6Sk
+C7es6LxuCUncUO1fp17St+QjDAQFVq7T5h9luCECj0MiDc3Z3KLohJavaHhDM8yZ6QnxsQe5hlz7E2xCy5Ot5liBV3XvfT8LfnMnwvcPfL8hBRDUv+EH0w5J
+0Sx07Pyixc8KF1REeFg9If6M3/Ds2eHKMhIMC8Q1+p4UFlZb2sZATIoOSfsXgHpXebRd9C8cTdjWbYzCgF8WPgBFjbcqh0gKZjBBpj402ngp/EBrsyZNYCmW
+ypwuCHCtMKwQrvW8J/cuq6jZCIi5lHq5ys3Gx+O2DATyjRx5sLvLP4C/AZRBUsrxPie83pEpntOFyk7it67d2gRykqqnnfupJTYTgRnVIYhvK0cyqytEH2jG
+YzsvGxGDO9eGEDDceZAqBnROtvIliABISRfX6trFAb3zDBPQiEA7Dhp09e2tBX4ilofLSge/OI6rgoO/vOjJcOMHf3nZi8+M/8urpi8x+NvrnmStAvUjWs9/
+zrUkWdVQ8HausAhXWH7lCovfYoVBLsUrO3bQ0PS3EypZbGTcrnldS3VdqtWNfNejdISIYCWoH8eC9XDxnjU1WHaZNgctM0AEIR87FR7e6KjGWmYVqDlehYp2
+SAjNJy3hbrStmX+r2V/LTu/Y+anpaC/05LD2+gQOXdQm0HWjAnpS+xx6REPBOO2c5qzwc1zJPgWWca5zc4dd8tu1NkH1hyCPMyKAUptcr+wfcV5wn22Aj3cd
+R9GlkVo5Hhq9IM0HJ8OL4WA0Q4Ee39FlQ3BsvTVs39BdHpBCuu9bfCq8Xhyfgl0vTmZ12/PFxx7GYH660QVokVIFE+c6RGnopQ5/0S06Gli9vlfdBdwoWHYZ
+Uz+ABodBmuHia3pmAasgoCOCmtFI7fPtexAxR9MXPClBiuUbDyVCgQ18yeJfPWx4ESyZKeSNuYODQpBsrc/CGMKKg6UqCgK1NaXDalEIbLteMukc2s3ES7Mw
+gRJu9Syy1gexgMeRINKp7g7kNVMNMGugEg5EkypWt9zGf+vS7DFFBYsCGnDIrY9ZdC2XEy6iwJRPyI+xAagan3U/Nw2UKb40t4RvJq5KNf+MYOm5Kq7X6lr3
+/i7E32a7x4/ddykOi5AAgvPZ1Lw2pYOJgzVuT4J9kDpF8s6C85HVjO2SN0FVYPSQwLl/PjdaEe5KVlddZpOfBkGFc+1ZkHH4+f/9adCf/H/kZYEQCmCY6sjB
+pCrhgPNoJprWV68r16H3VZM9z26zXEJLCvGMinzTk99kdoTbJN9idoSq5L2zMzLB2LOKfGbeK0BtC26b5Dp6LOXRmwQTuwj9AiZ5jAKvPdUnfPJDvxuBBt8o
+2Im6zP5d46xEjpTOgy1U0xHoi8CZ5HpjHQrsdeTjCF/t8N0JFHcs41AKBYVkeM9XIXrAxIOt1cZ/Mf6OTSSKdAw5LCz6TWCwVTFmTtKaJOFVFixIJGwgOPBk
+wObmGv9w0IWs6ueTclZFiGZspzh4KAmJwhvYR/E0ghoYvcgKS2WnJljbaEHDLrXWMhGU0agAdRPrWUNwrHsgkwPkjiTyirYAk9v+NJfw0OURInQMwaMLGDgS
+mdUODSDAa0VEyFvzGXf/aU/241VVmDgRWEsN0bPFYuqJMaaytl/wvN9pqtNt7yeh1iVst1niZLVLvOFCPKSyCFL3eP3CkaC/CT4pPHgl+Xv74TXPX3LIumWE
+f7rtCpemFKxOwYVviDIgaSb2xakrHsAOrYcCPKrIWi5UIXWRmrLa4fnjO6/YcKLfJMTY4Y5YgHeQbtk83jnu+USa0nel0vqz9PoWpIbrEvKQYSyqqNW1Fmzh
+LNiz2urbM2LKaXbsWcVALNkqAJ1tu66HMJFSLxamrJmkZ/5ZriuwilF+Mgw8+CDX6D8v9dyUadWQw4G3ObbWEyowRaDgVb09WsBA1BjGErWRJQa4dLnN63q1
+kVDj7nDe/Y19p6+qrO4IVvWi6SGE0iJlJjKVR8qwI7DxqivsygorVK3PrJJ0xfPxC3d2Oy4VqOuMZhKM14ot3wR1pmPg/OSdvpIwTL/PMClM3ID9iVWXcExb
+l40QfwvogwNLbMEYMUiKqnzzC1NaXzltCFNktUEKAjTbaR2dQou6DWfccgovHQFqA9anNaS/i2+1CwNL8Ot3IfMVweSKjtDl1Ubq50sGi4DWHhBVzbOKU+Xn
+OfIivOq97yWN/Rtsw7cdellzD4sv28OyYw+Lr9jDsr2HxbY9/EWTJ2jyZHPy7t3wHzq0THfT2NAX/L9W9DarLHR2Sn4nETvKWgDZXM3EjqfbzOTKMbowYYAv
+JU6Ex3qrEosBRw6FNdK6QmuLdWndBXULM83jwInt4NuyBb4d3gEf23eyh9MKMIpZc7sPplh4VFtOIe2EKpYNqGK6rCchYLHoVHVanUXM4oBKHHazhLVjJYqQ
+ikUndnHnRe+9hO5D7KKLtKQQ0VhdVSZf1+CTeTS4Mc2S6JqlBsAxNuoHj6kFPfme9kdioSNsB3GfVTEMasdl4rBQG0Nuwx+LBvzx8lEQx83NIIKBxT2nyGJr
+T6KV3QgZX23Yk9sZaUYwUhf2dzfU/dF9d0S7MFLD7+MEIBSZthogalQ4oLmyXFINxxZCkqFvqyuRoZOeswFbGXQhqxpE3vHp/jNS9MFMIJ4jCiBSVy1AkGef
+tuTTvru+oAgA2V2VDu1E/Qv01CrA18akKLpqKGHBCyZVtfLZFpEvAo0MNm8J69SyW3Gr1hXuX4dKMb9/e/K9ynKoivPqs6dniBupJH0N9yncHmW2RKntzxw5
+svEbubqrupw2BaddNG8Oj5hN1y3pPsKRqhSs8GOk3v4Wb093PWdVtdapI1N0QQZaEME6SOUFSjy+wOMfZPV7IDCbA2dTlYeQO/1Tf/jjYIK/H45OB+cjl1KJ
+0YGRcehzbztzAjuT3y30AaU0EwqZ2AEyh9hyFmRuPMGMSAB9+JTI7WniAhLkwy940LlWJwFfAfL9ANkA0ssxVd0OdJpAXuPD8uwTm2QvtyXZY/43INydjs8J
+vYCx6jCx8FE9BtiKwWTC7bmEyPjt0/HJJbTMCBPD9245kmjYYjbu/FKvkfooT8eDKcxzYpPSz/uj0WCSBDByAlDkRqfjyZRe6cpPdembk+F44mNOFjKDEB6m
+ifBQD6eD6fDDaJrIj/3JKWWFuungLOBpjKEHsBJ2ET1mhMBZd7nzXUN+fzmBBpKObtujM4WsWbH96MjJ4EN/Ajszyrz1Ga24EpjBS/mlPuc4eAgaDZNjhzM+
+RL09DIj8pLJb5vcZ2jv0bTCbuGyId4gHOcIDscP40B+OpjOxM+NYDmdTV9QAxR/QyPTyXfArzNH9aXB2BtnJHSvbgxG7fz2b7loBu/yCt1gwr4k8HZz3R6dT
+h5YCBSUXAPwSIJm4wgmHDyIwX9yJC6pXsXAr8JDbQu8w2ZsBVGKkkSQcgNgxgOBBrpyx0pPm/+P47BT28fkZyAqs5fgWUy9p6kVj6hMLeDn46wx6dDGYnA9n
+nDl+1v+pF63LdHwGC3A+OEUUFkEIOicf5Xl/hjnyOJ53A64+Oh+cDvuzQQKb56w/G0wACQg+MHKQL63kaZdBNBi55yl/KIBoeitnkZqyBd9RbsN3FC18x9Bs
+BfjGqgPoseoOoFPo1L/kQB8LU2ALGeZgPgr7sf1+jzARA4SmyOcqA2V9ibygYEuIOTpd1k3K9x1BkG1Yk62UlRbEpNWECbMShuN7F8FMCaeFdEFEhrrnu56c
+gm3BStZbOVzEymf7LQL3RnwsxCwuNHgQ5jrgy4ihswNFClM9CBIMBoKNMLmKa8UyxaImT/CXqU9h3p7je+Lgz+G7Z+qutYedMhdo43H4baFTXapcAOA3GqkL
+xve3jpgk5FW+WqMTnxDv0f2QNOHThB9iKycLxwPZgZwhmdVVqPTHJy4RoWXOsVidZp4WksEAKWgxKOqs1JRBZq2K1nz4nF3Oq6SX1uFLePS8sRV/K+LJsg7I
+rbmkReriFuR41GWlU1C0HeI1zPZSF2BHcYrK1cZ5V2zlvg/qp+vc8vD60+HnYdCT7zIcheyv6xtT4g5/Z7l2ydCC2mZerF0ZtyJYt0gqLBYZ7EgMgNqPoCMq
+4/WLkowsWuf9KXshhELkUwgZVBDWTrHgbbXJBPoYQ2h66t5iCbBvlZkWt3nys4qdaxSM83EqGwPXaRQZusUkcvKXVh0e0iessF/nZ/Tu4uz5Ue/w96v/PDp+
+8ea4if91eHj8VP/5W/xAXsxI1/KdMblWhbxANcPBgTFlhDxK5PGx7K+v1xVgnnz/XQSB8v13CTQibCPDAG4IXE/pnTGpPMnAjXii8mxhyiJTibyc9jvzdeTI
+1PqtiPDCu4g69volRT5sf/dkjF/kFEVA70FWXseOcmbTbOSwtkQBSMLcJD8z/opy37PkDmDYXUwG/fN3ZwMASdMyK+oQL8tx4pM8xJwGzn51UpfVYaRoUPJC
+zT9DiNhFtVcZTCIpZzYa4yf/o8lTXbpYWkWOt0ovr3KqM1oIZScJc8FMLkFYdyN8wWWIn0fKiFwD2pm969aVLisRPxYTYrSUWvo7j4gyDSEw8NyUz7GCzGYT
+buRCVTeoQK/ydZNmA/zxYYJiE8pBLzA1zxTVWyH2+Gt7hNxd+exoJEtmBZWAKcKNcrXpnFiyJHx1lSct6WrQZo3ZtEkgTl6rPOpyT4i9KehKqkzt8Qo7yxST
+btIWMiNmFlKDdOGUH6yQ87U1/FsLqlGmzsah0HJW3XjdrzlO6FXzd3syqyDpA1N4M4pLOne1z0oxpf8HonOIYINAu5/MGpvamHXCwP3PMAc0K7DWR12ZdY1t
+cJKHN4pw62WVvPCtBXDQ9pWF1txXVTu21rkq5D/WFeZrGerzlaow+ILJrErMTVUnjpIVFpJiYVVCue9mIVfarJC9+RZBnClohmyaPbkPbDU2Mi6udES5bb+c
+ubqL1s5Ca8DCmvmiNiLqWy7XFGGpZU7xuQrz1srPmvU7zn1WdJIXWvcOhNh7T0nEfRvR9dVEqpaFsYhiNNDUYalktV5yHh1YGbB5BUYqbHLNQuvKTYPMCnmj
+ijR3qbGIBDi02c3+i56s1AoO/BK57gMxkdXNvPhAPiINmOWkJvKqI88VxHlGKQEzqju1kbcaAPqypcU1M4sw69kGJeBX9iCKH0OaIL/hXJZUqaGvczLyYzwi
+pAZ2vL6gr3PjPpDc4l1EG6mqzJwQElPHEFMReJAdHBV4XK2v5SL7BTYmhAisxYu/wqY4KyoUjPayFO6y5Jv91MBdIU3pr9GWMJB9NwGBWBEsmmCK2XtQc9qs
+xUDUaTStjocLIYTsoLC3d1mlLYMSUZKGKf7hlVFs4It+1oWbdc62hTQpThFvpnNT1mQqidyBST4Dmk2il7ZPYcPwKJ3zjmU2eCC1qmoJ0Am80C7L460Q6kCu
+cjUnwqPGkmRF90IEE8KFAUtJB1n0fWpGgB+/MpR2gADu8TfAQ1HpQjNDYljMSCn8FI7M1dwe3vh9Q/mx/zAl4VTeasoYsl9frwtd99brXqHrhIvQFQ+/WzWp
+PWn69jnpPog6EPwSf64OUMvw/dapfYZkKfv0eEuREsrFMKa8VgXnpwK1wIHlpoItBmVmld22nlG2Iq4qCy6VGsdWlWfW+LWvieA1S7nlaz6ufFJXtL2k8lXs
+S1WAurAC/Q9TY9X8ZlvPWAshTC2nZ1a4uzNLd1XhIRdbziRNaXpA244BAyIgN880UXlNokN3eNnJUxyhK3Yc7bAi2pTB2GDwy+SLD2DQBdUat30nXGNYjjy7
+KhUzgiOkwbWGCSESz6ygYn3csvsWQtavVlw2fCBNwWQQtZHXuu7c4kCxc+CrwFu4a37Cl+i81c9LrVJcer7J4jNCPW0fMdrq/js7d7v9ZoanpyRuCEzEaB7Q
+aLMHZsLWpqM/4HEi5bq5hW1D1hBjnuJgtiu5H8/3zuXatRa/ymF4FRLDc3JV2am1unycdhggVHqbrUHJmdak5VJWF3HTr2zuRvS66wyoqc1sr8aZRA2wi0M+
+PNT+BczYvr4u9bWqdUhGvM9Mspsg/fnAywKGi6Aqf9RvS9H9ikvwiWaoUzBgqICzxbWIxoXK88oyBy8cCX5PiNdUCUpsrB2SAKc1zzCrXGbFal0zugc1R783
+6xpyaZxCtVXo2W5Gsa4FqFJe/XXaomi8TRYDQP2S/xyoq2CZ5DVihZPhqZfMk0h6e2XyNJjQfJOI4K9u7ZyjJLK13vTkCYQ+SgNmSTgXlsobZ6v4jOphbaL3
+Q3ZJoZdrTJoMG4OXb1WZkXSwNDXslXFwJVebqFUR5uOEaqfdT+0JIxAYHcgLiWAmsGKqiop+5evo0tFV3GN7+1MpTtTdrBCsqNKevDNrmHjFzKe1YQY6jFBd
+l5pxpHVVe0BL21hPiO9oVxLy9WKby2cToSDXxpY88PZcmloLx/TdcJ5lVZje/VDgYyG+5xSTi/7JX/oftsNlhllDu2EzxT00K/fDZg49bKa4L6OHJnZQpE8B
+BfwZnUz7vzYA9H38H8cvWviPL98cPfn/f4ufyyJD1mAKOA/zPCtMVv0JtkUnX3ZckvpfP2lV/jf5X8d3hS7FODCw5Egt9X/bBhh9ytBwIN/fSiH+64gl3Wng
+kUaigf8W/NGiqmemgF9fTs5Qdrbe+VP8YADc3wGmj+AhZsHKFWOZbbj2grNQqSARLmZ3J0fF3qErJ2I9IRWCmBIdbhCqqzLVKhceMq4piyOHky+LtH/2nPlB
+yF908xIsNQ6MKa+TQJlLgqySxGZzA8OZiD1nMehYbehKqHmSKquMxCPJKgjEF8iyDT3jnP4qhqPpQvJ+K8R/+UYA3hSKfxB4duyE+y+/C4B30Idvi+D9X+Qo
+QJhzSNnbz10iu06dTERhGm1kVIziifJYudyulFhVvBJtpWT6JUoJJvXtyGH2isUWlmynoIg2PXYXojV88GGpxTvwu5FVehQmFG8D8Q7o9KYCUbgvPiGMF6Ym
+DhDeWzbgvTFhNAlp4yjNNsC2DiCw+yMGYIWxeDxshL6OKf/CXM2E8a2hT4SjPSIKa5xvGS0LAGlD0urUo2YDZdzZcPRh2n78Kd/jN/oZfbg4+331v6Ojo8NX
+Lf6Pw5dP+t9v8TMazD72T/7iknsvLt+dDU8sO6fcD9M8vvtenvfktNarG11UpjgQYv8dwm2yGvJuCDyc10xdRiQ80ukX87Cp7+QkA5UrpSZVni8VNDiAujVT
+oPrgGUBqgi1pZhd0Bvp8zkgirtZsrmMJbi2Z3xgDFjol1xgEitFDT4EFiCObMuUcRRuQ4Ry8hbt7HfsWpX10cMpCnNmEvF/kgIV+ftZ6RQ6UmnM6yzlnsJoq
+eLAHuYeE4AOharMut0wupdjWlAjJJHVC26mM0imqG+jLSNcf1fxzT854jB6WCfpl/aSsV95peacK+lNtkPQ2kXdaFpo+xykaTmPl6O/ClFdZauFKUectNhhx
+YygUbF0SBa6qPtv2K6hIdP4wC6j2EenbwvUVKsjmnbKegE4uaXtcdw4PBhDPig8e01YCPZ/nKPHvcRA6UkRNKXUOi6YKnLas5hwH6kBWJz5gCc+w94gbl1yg
+v8r03KpTEsve7sgysfsMVW/fEtAVuiZTwxOKlWa4JVvDdpuBKoznNzZNGKaK5sPYBeNlSPWq5NxweBFH6WFseFXehwguPPDghPqDaWcT1x+V5+vMroKvEMbU
+VLfo8YqxJ9vV6FZYKcgpURswqkzilsiUbkUaiGfAZUNjxKZqTZ/EKFlW2oEJ0c/BXMETT95iWItas0l2p4OezAlNsjHTi6xI0TfsOklWUWFcVS02787i0M0S
+PObim1BMa5bargFBA6iK5K7f6lldhTNZG9ok5I60s0RLbWneb1QNZ9uvV0rirNQLAyq4lvvn2WcdiPwg3eAG3ZDh2gZ5QBRGEbF1QzKUAqOV2lCv3ELALsYw
+KUvniK9NC0cz5OZLCNCDQRe9GJ8NT4aDaZQY8sALw/Y9PNMKk5WE3UsZFj2jW8DG0NsRCJTpGeVEe3wcCqjnuSBPACfFLCgs7qcrcXW+1s8SZo8XLnlaXVUg
+BQXX59pt9EOQAOMK3cuw7h7ftkNlmdJ0abQ+jdXo196m5PfiFJV2DkfZMbuW5JDKoEnOkUjrWiaUT3GegI+PuIIO77Y/YpiUfe8oaeeQtYZ30LWMqAmkRnSE
+lcmbH6Ue8KoaOVdluWnloVQu58RfAZRrQu1nua5kE6QL5omewmXFKLH/9N2Nyd1jAPXumw4m0JTe3YN/zwp6kwCyKFrCReJ4AWeVVCGIfMfSqbL21eu1EVdO
+60klJZdxcNFWGNxkZeoKP7hQqmLIsblikBBTacEdscmFnVtxn8s73GhjTAuCx1iaUgv9S62LCgbhpKwX3PAsCFNpynYnEfqWYoMrePgAI+etWG4UivRxVC1X
+N5sKR8awTpZNAXF1FHvllL181KbxOYKF6Ox1VngMc8iwxPhtnFXVdY7sEu4TmJQ9fGVjpUFHaUIMHx/szNAgH9iuQ1mJI+zOMZ3N7UeNIEM6Ezl80gIDlrk8
+yVzX2/MiCHjVJ+DAVg3uuN3dllG3f5CmTBqJGrYvC6LA9dA8CkUBQ9cEcKtbOyxi/FezlKqQagVlfGWmah2lYPXkPibEqwDpKavsbSlc9RnVKFrMscgPeCDE
+e9qrlQ4q1Fz/IgcrZnEGTkMcLj8Rtio8WiBEsx0FA2aZ+1y09SpFB3nsWSYRyMdehM06/yGDhMDTW7djnIJEKVqrTezejsWjPZvujLB8UZVgNLx84/dsQKTQ
+Ek3MjVjXermqg1y+2ohHdyKr5K3JUiskSqcHG2EvAHt5d3RJtQsX40wDV22b9oTL8bAS+u6GLDufattm2XCJDbhZ0cjJkCq4U2Y72DBrb2Wlh+/wfZGVkaho
+qIpSTH2xIGZIZgXtvaDyVhBEaSpXucqKfPMW1z5mDa2N1U2cIRflWqLZpco6tvXaYqpRchw68Nk3bWHafhB8tWbzda7KJEzG0ml4odpF5JsP6byhK8G9KzjA
+gFPQxIbGDBrb062XJsLWGEqLMmUKeVpGqhutaINFngDKlUhN8ayWdbmhYhWzkqHxh7mE8BZ7UxaqJM6fJzfqP+3P6Gx8+vvW/x0fvnndUf/36sn/+1v8jEx5
+p68zVZD4oPoIivwHRb2nqlZyH/bKgRAXpV4oSj/N6R0RlOrNteX4YEefd7LRddhRJRYoUYlwcg3eV3NwYuQ6vXZlcz7QSNXLsXTu5FOo6myFCWheWHJf0cNK
+9dxBJ4gIwUvboKqb30u4d2yTdH9XVhqdoJiR2vhsvxLwV7NG22zKev4bqvHHpypTungwE48VKRotHuKKLQ/xP9cqd5gB0XxypYOqW3/IKgch2WssoE+yy5ag
+IzJmnE05qGSUdkC1JFqnZukg/via14Gu7Xfae3qYgsu+Q/15HUP15fo6qwhKUeCesqnBDSe44i1mFg4w0ti/VXpeo80b7DCL1kvWBuky+hdQ32hwZTTW5uYJ
+RhJw7vfndQDb56bg4ZNpKYr0s8rOJkOn2WzBUs/NdZH9O7kEc3VHWctHPRmUQArxH/8LTuuVqvR//G/+OOjyUsmUfw8jrLJllqvSGplOx7U78eULu5R+3eLR
+wpeC5Ys/BpWPFZN2VQkDu4JzOsFewMmonDpGlHViW1eOnP+quycJUTFi+SzpqNQM27QhQkaa4mSCU4toAckZx0V7qpL/8b8KnV3fXJk1Kjm0wf/jf8usECc3
+alXrUr66pzsy9ELxjJNkWN2Y2pCVeUB5uL7XXfZpIC9wpf/jf8GH4okuA7KhkI8El4c1QtgI8LX/+F8bs45fL1S9hpNk8LSp3KX0tGSi6OyV/YYpG+t/o7e1
+HZJ8RoLKitttX3LkNs2NDaKR4053nN7VEHSxPA8p8CInc+IsskRyDeZc1WS2Qed1goydCYyESlQqneeJyHWRMsRKQQfjsnn6QlxU29lS5/pWFe5upC2Uqzsu
+JUWzwOW5+PRyc1d4fBv3Iu2R4569yKPApG4lXoUSqCUNw7lPwKZ25mc4sbZ6gT0JzEiJVxTc0yQCrjbEpPVfXOFD1w3VcNfGD9SG5ESVQCITGXVbGvqTKQP0
+0OYDQYVCdCGgmzXqY6tPhP7JhYLuIzoNG8I8K7xIsw7mMhDquHNWGovC8fybMk/vslR7xWAW91mE2VRREAIboOcw0gPzlCoHykqihXlN5mapBT1kWdHW9brk
+z1kxEVxV1frqOW+dyFHRFkovenLg7k78zSy4/GK+LkquTGXtQkzu7+TAVRGYAOYBRuuHY3J+a4ePjVdKgz3MS+cLfkygFgsSel3kDCtNETmFqkadLVVtq8St
+Txc1MINx1AC4Ge6qOd8kFhEh7Eyz46F0zwrGkOZAiGKQaFNGMMHoQsSEWfCPL8htjlpes3HcY6nNomO9J+i3JQiCJgldy0m/cKOlWi91KitdVBk6F7tUtxE+
+q3K4nNeIXNSf180ORZLG+tc3VlukRYzUXJuWQXBIFeWLBoR+oUbZ/JjXGlDs1xphGbBUDNHQ/IcDhi5fYwOL2nHld6EoPEQBEKiP2GLZulSphqJ9qNy2jIZF
+yhhILgJ/ta4tWgifBg07PBdI6rzSabZkNCgsJ4kOhC51MD0IYJ6ba+NJQuACUUSfJWB6rktWZXlew+Y4E6MOCzjJtdthWYgtQBPo/8o1FirdRYBftmESlcNF
+vAluVPOejvpGTt3oiFvrk3oRsHVSxCHPqjqJ9g7zEBHtQZ7jdHYMzG98dwXCqulSdVyCqpKVof+9Ic36RrPErYQiapMF/R5rgtAXyohpxgQI+w2VJR6vCHpD
+cvdlTw4QGI/wChHhzw4mb2kAc5RzLRXAWRwNVMTtpnSUYgX31ZDuE30bMfW4GQc1KoNtH3Ux0C7IeYz3FO36diPPquha6LjW8ZITzMITwi26NGIW3Ja2Bro9
+Z/A5Oq3cvySyxWjDhO3hrd3ZpqwhFWSpFQhdDgqvK8qBsBTz9hzrIu3Jd9qHdXeOz55l4X3mX7KviV2lrNc6stLthnrVk/06oDQNtUfWDGLJ4E6uCj3hV5vG
+H4s0ILVCcwu+6RIevOJjuC67hklalWpuwxZcGaoba0N98mX0UIkIzV4ZW8rIbbsQe1w3HWtuXeIINSE3MsSNqIOKABdlAbEVr4irUyyXOAQP/ORDnGjPsSqD
+GkzXhWuPcpdXLvVeuSaW0d9s6aAdz9//439vGaQfYKcoooFgdPFKt4w0ZV+fy9sGhIqTnfNFz9vyh42di80LDAXbOcoq39OuY2DV8M4zwMXkWZGi6YZjEvRC
+RbLXMZHHW1XzMXjdkxeoOMAp6jwFhak7j2mLWo4ZLOUyq3KtEByzwOAPgh52vw/rSPQ66LXCIvx5zc32hAjLOII7v3C+lNBD+sxWaZSBKmKRg4QNsDpih1Jj
+4LjAbzusoWD81t6zNaWeGKsSHhQzPldSyjdITMuFLbQpLWGEmLVvPpdV8h//S1Uyq/7jf7esIs54wMMjdVmyN1gYtpWrnpyF+9yh7fvcuyzCZo30FJAy0LgF
+1WQ7fZvkmG332MaeWt9RmWq6uoPKMY6ktkzfGhEgfsmW66UTji7I6RyBUSeiIuk8swkbTJMnA5I8mZsK3VOe9QOu+C0XCL4I817wcYY+h8hd9kaM8gM6J01K
++V1PflgrWAuNwd5rVTqnmbQebZgscupmfssEot2pzKvSqiDBRKBFlFXVmkz41SrHK1iVG08vWAn/af3LimwJHCnnU+/uj2V69s25a4HatdABKJAwaYPdbnQv
+4Sox6Cy71X0tu73tTEkbulEf5sIGIR24DZcTJsT8Jvi08L3sSZe57+BnVIw07GYRDpu9Zq/diokOqL8G83BnUKQLYFFsj5TgVvneebUoOb52myG60QjcLHLp
+SVVIlaoVL6pz22Dq3JWdglD7j4yMhhJBqWPOZ+1Flb0N80Yvc+T6syS8bBmGp8NWy7muNDKA7msQLleagIqwITgq0O94UwZvNp21PkuX36us284iWkcMZk1V
+xK7OvtUCjnqHB+iuI0eKsckWXWKFPAIeW4kxpr33/K08KTXt4BOzXMIuClRV/3GMCwWqyFHvMJHH9P9e4QKuCyav40fEi97hAf7FfkHaL4zUbQEptXml5Yve
+Iehk1/pxQ+oeC84d+KK2D6ZqzKQN0HmU9Zbc45AZLx6drStNhbVuA/hsHX7Q7zsL+oPXHkeWDntypO/sXFXbzUvUv6KEzC4FadvJIqIeuC+Ljq+1dGF5jy4s
+iHrHqncEYoQGhFxmBZy9jdxH1ivkFIdnzu3vG/xhKb/AfuKJhsHQdrlZl/Mb2V8sVFZWB8SwSIl1bgCqxCIA9vUaZHVTecLuRxHJWHx/qT53+rU7AtE+vBjU
+vSAaaFUrx9xXmRyM1mArNdXzsFqXV/3IMiWhNFAElkcRnFtdrBvR/sidSGgmS0uGgFERRA9aIWa8Qrgn0o5K5HQVHfqEKRvaWPix8HLlqOzVJojIgjZEup/v
+sH+poTWj1wiUgeDhWHME93tC3aLbnODsGl7GMtOoU1iHnj8G6HvVUtGwhaqdlr5cadTlgk9X1ncfHkpYgcoHe9YFbFP0+7GyuMPjqUq0ecG1wGwJaTNZa3T2
+e9d/Hh6+PHzTqv88eqr//E1+RmOqa28UfgqH83aYyD+vCy2PD4+O5Ycsz3Ulz/rnw0n/TIgzJd9lea6ySogXr159dyjfqXUO3qP3JRpMW5ufdamGC8YSRrKM
+UEtKomoQtkOJVw3uZxJch8hwhnFNSXgT7iGPboVqG/mDvKn5L5zBOBpPf+fz/+rN8avj1vl/8XT+f5Of0WA2/B+DkRxfDEZyOr6cnAzaMuCod9iRaoQ4zIe9
+o57cO/EFB5eVA52OUQ0bOLceLxaVQnFiotBPbaQKo4f4MfqU82/Z72BcQRc1EqkgKiqWDFRk8PHj2kPBM68vOCbOG0iZR73j+Bseot3CWms2IlXI2TS2WM8n
+WHuCWCXiPK5gq0hXUSHhb+J80/HD3j+p6iClPKQqxt6+wN7iDOKnw25GfZKmbHzClM3BiK7BYAejNxMPr6wqHah/TZJT7OHLntwbgIJSmgJQj8M9ca7nN6rI
+qqXttpJL+yub5Jdb9h2i2CY3vgWFDFBrPGa6Dd1r/1WXv2AWaCk4vM+j3ivonysksR0J5zRMqwqDyIyMBY/gSF/35N4QzobKLZ6OLsMFyYo0u83SNeVE8Yal
+wP6CESbB5dNqw4Ek+w/amJPDnL/ayMEvN9lVVss+9uZNT+4FnLJ+hvEGtPYprL1uDLeDrlawoy99CNPuWchginP8HfTFMnTY+QjYMbDD3/WO3GPhUtwohy3r
+y/VLhV6qLu+o8OGiuxsEhbUwCxbVn9aCJhlbkugqu6qA9hxtQjVnSlcR5t2ahe9FQMDtWJOOet/35F50Vty0QzMp6TkMIJrqXOM/XV4XdKFWWN9bSkKwXVP4
+OHD6t041eeT1bWbWVUOeyZ9udCGixc0qX6FFTMe65HosRj5WTbr0t8j113/ICNh9gM0pbDAscbFdF26bdXcbWeb67A7ARkiq22CZK0ftlFe72j3qgU9jL3rB
+LlF4usyio/gJtz8dHKhV09W8zK58VvBDTydMe3xd+FTQpLlVs9qtV1fFl8MzKbVKN/FdGh/S6FziNMBpu8CkFHmCNnm4VylbhazW/eogkYW5w7xD9KLCjleL
+WpfCHZSdGHWJXOr6xqRYXgW+c/YNrNDxvK7oO1UisiL8upcF0iL28bUHPoq9YMJDKbtyKb0h00N0CBamZBVEtNDzszocC/pITbrGKkW/BZmnBk+1i+Bgek2J
+pRGp05LsqbIAw66qkbl4YJtluU+EzIqqVnnu7mNVSH87YaZzWKTpfOmZoqZUmWH6rrqGbtaB4BAdgoM92TrPKYMwCRSw+Eqwk+i0j2eVmN+YzGYshpt/rgp5
+Rbcm9qnUhFVhGQUUxiaWEaODjpzeqbbvuXrl5/QuxnEChEHInQwL4wRVwHKxNu0V0OiQgwbKoOG/yr0Dt9Ubl7JifwxfzvoXXc7RUWWhcPAQJkHpiTMvgxvC
+3odJfFqxfUq8dP7nxqXJtOGNDPjXcFjfmzLsHMYvaVy0XTEis7E996mSpclBZavsP3IUCQlX/dOH5uiItk+Qm/iTWdNHbaGwJ7VyuzuRe/yOPYH76oBOobmD
+eaIoowgijonLjOH8ypIHiWeyUNeON5JY0XE47ioPgx+RSYHj2b86oOTo6iZbEQAT4d/AMVzUiOo5RzSBV4f/zwPH07KuHY8+1kSibnylC00O27jJoFcu4zrc
+/V7KHveO6HC0FboPpSrqbX8MEUorKGwVihKFn8NuT2RpNiqvN88poTjKMvZgW905mKLbXUji960QsHq0I3b6FfcDLZhldZhicBBIbliw9hAtUKnDz/RopeAs
+ztUmzLF39dNdIcdYrO13KK8Hzq1sb6bYnLGgmhEqfaA6MwzH/pWdmwtO5aRr0yV3OXWYrpaEaygYUhX/cxHrQInFp0ooNYXyiHBmOP9Jc4mBjSDokuK6KneA
+rcKb1TB3AV3dA6amJwTg+AYhVE/VbCOxVgod945gf0BPYC5UqaXGDETYeFzElKq6a0svsrKqg5VraEAdiAyiobLspwdyZGpYQXdYw45dHVDWJxwIuycDcDQe
+01t5dCDIy5iyVgmF46jIaq/GRp37AbAwXIy89YygZ0wpXxzg2oS5fhVJOoI5zg5afDvNlXKaccYP3+dqCEsa3L2IFyZm5Tgb7Bj8GqFbgySQENNOOSF3yYmE
+jP+wNRJZwoss+WiR9WsJn8jZ8oVyBwVAQ+7EzhTL/HfFNNrh5HQePMGaGUX814VH+1JVViXhujacL6phrrMUeKT0epjwShrSK+qKiJxU4Yh5bCo3he0fJQ75
+zYzjy+oqfM/5G6MZs3du8PZBIDhxPUlGNuSjCGRqiJvcEJUgE3Z538IOdi7lDwQY03lgt7cbuvI6Z0M+eDbuEeIsJisQAlaAw3/ulOFhR0h6U8Ve7Ontsq7u
+FdbHjxXWiIjlBHYkd1TFwjsN6exacwgyXLRleOeT94txEUrKBn3aonMJt0p08bCN1ynm9x1kDXZFtI00+/0DX2rLFwNeWC/vEQkkPmNHaIBCt3t/y9hVTZVi
+kfd37PnpEcrqyPHx29Njr39UlONPkX2D+K0oerl2BX8t8Nf28xp3+YNclh1eDOG9GOEWblu9QfpzNGecXxHkZxNUU1vluddYbD1jPXmiZS6SwujwMn0CfkSz
+aNvB3YW4lcL/PR7epwhgLsZaIrlryrDAHQclDPlzuuYJ9whiWaG5ZdFjyYejaB9c5dsNZXbye5TDZ1ZBALGMExIQWdnu8kQIVThnpso9SzOOxHqbgwe45cjn
+x7MtXvRe4fY97lna1cxiMYQRAnRohnt4yxYWwa/9Fuac7XaBVDS3MSib6N5YXvFwZKtY3oLu4NDb5LddKW7h74WLxMh7IzrMo8HAv8gOQah3NPkdX3Gjovs0
+WzQHC33Y8l3hvpvIEDfJv4z3iKUOrO90fqvl/tHxgVyaor6pJLoz/fWX1TZCgHUPc5gl5bk4w8aq7Be5/7rRkAriCSI6vlE8L94QnstZNWOheOf7vU6ccKqM
+s9sWGFWq1qVDnKy3CCmansp/A8CiC5hzeOP+xcWS+syiNmJQM47VvoDI5Kkmr2eXLhSA+ZIzSOV5LDi3SXN2nuLyUnSgyRxoyz0+8YbzKHKCb6ZYfd4Cv9nr
+kp8O4tPnUvupbgROLOeWdYPlmzD1HmCqUROJjT8Hf8MXVduYJjexvafqBk8YPy+i6KH1ygV7IXGGPDvdPXVu5/kUNi2vRXKThaLMisiodrSMXGiiQ2MkK/UF
+RIuHofF3YY2/c1XDVWGflBLHNMNNd4GKGGkvVDrU1BFDECFV2zxtF1OJdu+zKrI/RdP+xAiaZ/pmF7EOQ4NW+e6wBuOrGnTyI2lKUCmSqNeNbQdgCrTZA8og
+WEvaNFGmRZ3VuU7l3tngQ/9sj9fGrgtnMMBcua3PiiwFRPyfs0JWa0xaxQRb7YszlRdFVHwJ80tC3p5OAIhpLAQhYjL6uF8RJ3lbxycWg0J1X8DyRXPuXGHg
+clXnrKHTYcIpwXkUDqgC4SijqUdDJxC/LtxlYfPynIo9CVuvqvWqkvu26BvOEeFThCGNpcrQiM6zigqnCn1XXZdmvaoOPDvoRsxVPue81tpwFjAnm9/dGI/N
+2DxDtDCFvvMzK9x9QjNvE3z5+FwdRKPuXwzbh+dZI4Tqd2RhdTRbhA94hkvCFOAoGHprt59Es2iYflUAh+NnRBYaIoaq3AiYkCWX8NB4+xfDjmODxTC2p6j7
+NMrs4Jr3WyGalfmBnGiu0HO2SfiF0v6VYFMSDxxqMRd0GkEu+G36cl8dCDZ8wyavdJ7pWwsPvmv6YWqivwtPMs9ZTfvVgfV2NOc+OM0OV5RkFc5IM6+hHTe2
+UvpVT06sCBwR0nVPCHdbejZ8D4QusyIIgdsEIpRobWsDRYen67AJHZRQXZMA8c2qUKGK1GE45+laU1i38ikVWN9UtK/3VsO54Q2Op1twlRyj9tA1bsrNAeMw
+KLmusDJonadUefdZE+hJbsxnX5Cl+Bs4TK//pxFIULjwsKbWglEpQ8PipY89Cq8XTonhQUTy0ucH+ZHjUQnXy6+3DcY3GO3Y7grnmUYf3f5ts0xEWoCjBPR5
+/pFGEKBu21R6tM2YBsPIJpFx4nCzE1/QmhWpXmJOmCmDWkyPsoKUeeGsx6D5cZdaxiQy2lFptClg++MI5ZW+UfkicdV9xv6qoaIFKh07uSKXSUx2kdVSXVUm
+XyMKAZY5kwsc3sNdtWv8Ysv4ZVbRvNJx/4So3KbQ3n3AwUgsDaQbCZtcbET3SOA99CTEHkQeoO9DVmAFjsO26JyTlis9KFOFyWwPWWxZcjKFYUA43ECWvW54
+pcwiVH7ZgebEW8yF3XSODRr46Lg12KhinZFKRM0iuBaOnr/ovQpr06kWUrZslcQaxtAV58mwZz2iGqi3e6damkNWdWCvPcBDpmQaG3gIkFCkXJ3yyRLtLNb5
+IsO0A8LwdodPRtPAPjUejXV4zE1RrbL52qwrBLBhqKOH2SzJFovFlGJucvhLqfIt9ktbgjVrxKN0q57s2B9oTHf4UxathEsnGe0tHBWUkqMdBQwl3Th0+6AM
+ntYrrq4V8drF4NbWhZAVO+BfGusvyGaIAVrcpdAxVg8AxgDpNZdoCCwrtaq/m22L9VJ1pdXZRp0Dn6+WdvWw8xzzDXv/onARYjCBdnQPEsS193qy24IaCvD0
+uE+SIIG6hC7Wgm8x+0XHHbFbQm93H4hHSmi5U0KLHRLa3U7bBfCbXhiuDCUtu0WjcCbVMFuAv+gYBTESm6ksHuT2lx1pJeEnYUwCwqE5WJCnlQXAQBQh1ujm
+Cg5Ym6CqJfubuQ1UJepEpD1Kbf8IukbcfQb6EiIfn5J+O0UMOZQlE6qMdLA2pEhnS6dGwzc+EcdXCJ8Ma2UW26eJksDB4cgJAyGRStfFwqo3wdvpRP5jnXJC
+VQlJdORRsn2NVfK36N7pBndurl5tRDsL3BkNPzg/V+SaCuEvrR8Edo1AliyFMVGGdghvOXsthRdR7Fhwn8FyhcD4w8+03nepgy2245ZNNGAGnAZOkkGtnr1N
+fhf4mbWoA34YwnbDW4T5hj08vAdDF49Z+ErZ6nOW55aoCxSG2lg0MxhfVnswqTiW1zAlZ9ERgEcZMyt0/W6ROzdwIOtazW9IpxBdJiazZlkFoH2eXvecdmen
+u2npYt4lVMAHauBI19m/60Je1Bt5Vqdyf8/+Zu+AkDKIeEmW+jbDLHsyxjtK24UdP90R2RJHDv/bkwM1v7HPW4AzZPiyIEFZcb3OKqxu5MdEsV5eBaL1de/Y
+YsQRr0Q0jnExbxxXDxlIIwhgLQIDu6FJWt3CCW2V36kNJZtmxdphAWR1d8RT1bZFp0ERGlQMJcCWQNDbjtbosrFhlzhy6HoajO5qI3ntsHIU7UCfW8R/wmlx
+uobwrCL+00G0smHHOsu+nbsfLBOGShwhk70FI9+A5YlUnk5kW1B4n46O5ZgCG0SkRqIfPqXcSwaKqd2Bs1UNXdUEMqomEA2v0EFw6YHILjX6JVBXtQpUZbzK
+uLopEQ/bnpq9ROxBDeqedIkexQJzj/KNBynHlyxCCMFqwYA24Vc4HQJWqDC1Z/v0T5BGVrVVMp/UGJUoapHVoYaHg2rsf9u2K04JVEAZqICCCfhwSyF4Bcv2
+UAf5a/ffenL/fUY5WOy87Aj4ePmYtAtiQg2NmmDTSXR7iAIEKfrKsoKIaYWZDIQTS1dAlP3SjNED3xTDfw2nyEc+mAC/uCVhRwbHHweTwak8GZ/GzO2Xo9MB
+MIYPp46LeIw05UzpLt/1p8Npm9hdxMTuQ+QZZ373wSlQj7cZ3n0rWKveBwbzJOB3F7OP/RkTscfdfT8ZDGBEp4P3g5PZNAlo4M8GyAEvm/zvwvK/jydA//6c
++d+How89/MRgNBtOBnIynP5F9qeWbP6/X/aBsx1J4y8Gk/fjyXl/dDKA0Xb1C8YjP40ve3L6cXx5dkqM8OFDMNED7vfwx4EcjgQ8MxlMLwbA/P5pfCn3gfUe
+mh+OhrNh/0yeDn4cnI0vYBWJZJ5o3AN6+gPZn04vzweCejWdWaL90eBkMJ32J5/kdDD5cXiC0z4ZXPSH2NjJeDIh6vgeLbrfMSLYMfCp6Ww4u5wNprAZYFFH
+2DWYYDl+H+2YHsAPIOH8+3AChJ2l/uXs43gy/B+DU/lxMBnQlhv89WRwMQv3n+9KT4g3vaMmUmUDjI1cYC4o0MEmFkDMlaBTWaM15PzM8wCozhUycmMJxkEy
+iCw77GgRV0M4XwV7SoDamL7rSgOWGFJ194f7uqBUqviXvhw3oaxBBp3GGsNSh1wd3LlKZoVoNMICnqsITUB26PwKYUPq1mRpxYRZKJoqEdA5cIURV4NQjzjj
+PgaJV7EqyqQpLnJLv/KWL13WzmxN/H96JJlQCorYGghHHHiN6bYIs4MAjy7T3vYmBFphFl0fRIMFVpwvUscZ6dpJOOcmdGiLFrGi9UfSx5x+XBt5bUxavWWD
+dZWruavEgV/hXwVNHUHp4SDAsr1VOe4QeOIH+77KSpesGvxlpTbcKkWaTQX/zR90/C7wPAwBGV0sFUL7U6YM2xQOJIfaDAqHqUHqlU5dglFrHtAEcZCWfqQB
+zrv9K5XYNfsgO/sgohcrWq+UWnBYiLPB5Hw4wsunJ8R3VDzUcFJ05Bi4bDeyEhxEcEz9J9hrvMAYfmz3k8ZCVcz4FffQumTNmycJHs8K8eJQpmpDuNN6bjDq
+65Cu/Zz2ZD/Pgyz6quE3Re1ERMQtK13mGzc02vvVury1zMZ2dJ025YWXDFxMS06frGS8xTg3LCs49Vle6Y3hyQ0+0Mo4jLqDS3Ts/IuULFbDMa1tbOUKi0N1
+iWJY2WB3BAVNiRb7TnSJVM9zBeIcnCXX+AyzhRy4ms7u8FFcctCNI9DQBG2DmLiBuyPLecHpoyFBjiCCnL0LNAWzlSrqvQO4p/S1jTpwARk2EDz2rDutvTsn
+ys0PVTPSpHG8NqzF35JpE3xW1gYHRY7dKOGGTfLj3rHsXORErleQR8v7nN0LaDYEH/Bw3LB10SuW3ep8YwHeZLbgE+NaoiwSTOJYoXxgLRz6SXmZb+V+dsDO
+3KyQd2VmIw4rtYmGp+RyXa8JIAMeR/vT5U1owTU31ttXypWqaiaxgNNgQV67U7ibs4neun1IYochpaW6szae2++0mZtOwi0p6cJuvuaH8Eg1ps1NVCJVMERb
+VsSM+CSAUaHg46VKtHMaE5XS6gazy85h5h21l1xraJY2nyegSLbkfEV7JNiEctsm3MLSaq96/csqKyOEFpoYuzFXusxM2mQj7VHRDzpFuGQgkTeqTOm/XJFY
+Ejo9dp9dW6CT3Ht4ZefhjafKzk3X2W3P1/5Vq3LF4v27ChakIl74dE6SaVS8wpWVoDKbPA2ZeFOcjBuV8lM7ipzCfQoXwAt3AZCk3ynm7Y6PDjHLT0pM/faS
+k3MzsGG3iUtdmfxWpz5nDSwXn1df6bqmLKsDgUhG0nGF4C3Ht2/XSP2Z4WUnbdAJpVuVr3XDd7Jbim/Nl/Q4y5CLVxCjiZojRKLMCplqOkjOCFjiX0wpXSdo
+njbW6HFocOI7SkQNmC2gy4Hy0ejVd9Sr73rHtrBJIZ9FKjAjKG8SNFfBne93ogUHL3Wl81wTrGg2v8EwukDX7K3KszRQkTh+yAZE0FKgF/olDJSbWLcK/uIB
+l50vBOzns2H/3RBcED0hyDYejeXJcHJyeT6dgStiir4J9ycKiMw+DsaTT4n86eMAPQWz8WQm953jRYwGH86GHwajk8FBQm6EPjgfxhPyLPw0nA4SOf3YPzsD
+h0TS7YxIwLgXLVdEYp0Up8Op/R2MJHSDuGemlxfgFYIH0A8yfi+nlycfyW8zmCby3QCn4GwAHhl44mIwmY5H5N4ZfZLD0elwgp4TcKAM+2foXxqeDkaz/lki
+0MUxmg7++yX7K0775/0Pg6lzTXzsw9AHk/u8UvyegO+ejafYwIfx+PSn4dlZIn8aT/4ip7PxxUX/wwBm9PziEhp93x+eXU7Q53TeP3t/OTqh1qjzAlYO5tjO
+4Tm4saJe0sdgIgY/DkZyGEzPJ16gj/0fB+LdAP46Av/U4JT8MQN5MZ5Oh7R73MRyyz3rsOnca4JaBjdU/+Li7BPMvfsjTv3poD/7CN2j5eifyeHoz5cTdGRd
+ns2Gow/y/WR8jt8U2NtnU+l3nXWvDf46G4zoI8MTXOWz/k/gI/s4fDecTanLvpM9MR2fD+SfLyfD6ekQ53IqT8fU0bOz8U/c6MnZ5RTHNGmM0G+NrTsjkdMx
+TY5vB9YpaOi8/ymaGwEeP4fcfNmb9uQH2OyjcxjcAI7ndDCZMltAKwcIsDxccWdW62WyR5BkqiZfhIX+IEfHy+/kSe99b9IDoIvDI7k/ntc9efT9968OEkzb
+oSCVNIuo4Rau0h7KvOAR0XokTt+hbgVZBar0/HiuV/LouHd8dCz2p3pl+4Ul8NAvSlyvb1qPY1/8L4+P3/TeHB8ePz+S9U0JSLrC/eql3EdgVB4xiFOaco8f
+PShSqJItK/ZiNE1f6AHmiFGCN6tHPpvFIYsxLPP5cHoyODvrjwbjy2kziBolJhPse65DVH9YlDnDOjd8gBavb7iwBB9kScs2PI280bn1/q8LhhWmqihcEvdu
+yIMA2XA65bFG3lOX3W0rumVWy6BVO0rvQ3TNhrkdDCQLGb46lS1CMHDLsscJEiXw8v8xm9emzBSlnbHuL7MStMo5Zp5X6yvGkqUOW8SCf6zLrEqzeaiRn5g1
+Q8HbdrFP/XVVlyrnf1H+Dj54o4lGH4NZecXc+hTlhAd6sjHsrAp8jg0im6zwo6HDrZrBdy0vC8wSGLGhd2IKUGzwgYLUTDWvPfHhsKh1WTDdn5gqStT+gI60
+LHRre08wVDjiTEd5HaTCWAYW4ZPHVHG9VlQIoJq8BFc6XE5WnOGttCQbGh8TEbNkO7oK0E8Q0RiPpsPg0kBvPpyffiWvdH0H89idydowXi30BWNkVGH1HcGK
+WOqXVKYKuY8t0Pn2GjCzri3S27rO8uzfnaUXpQK2EpbQ6rBJXwTqmNU37YE0yzCaBBlcWsgDYe90IVWBDldKkkNQip4YGYJTZl9hVnmSFFN2+cYDAgRCQLRc
+tiEJEK/Vi548hyv74mzwnAM4pKRBLLy1OphpiTSK6H8J4BFaaUjgtTpf53W2yvVznsF0ryc6fulg5HiPtr9L0R4mtze8Ylrs7AAtYJC+aZ8aXZxZ/7HK+bjd
+OoYzCPOgPE7a9GpbMULbKUhB2v/gr6jPyL58visWLcTPP88aaI64/bBxBJWPiQR3tRVgCKNb0iOBHvzgkhQ8pZb9CgfQotxU4RLB7Cl3SbdU4RTVt2t/Wmp5
+U9ert3/6U0Ed7c3NsqfWf7IT/afR+OLsT0JMnb7Rwcatw0swfIAOi41LE36LaMalZRSX5hpsFqJcOp9nIESnWkcfW5iSwgeWZ83JzWtH0mDh/os0ymtr9Zwv
+hzg9IKvkvz3oh99ub71OYKIdzfbkBR8XEeDmdD/LJU+WHHv/5IAflFt7CVGHCc3IRCODbhrXcO1XB28fPua+P5kgruutByNkau7MddKCeu4yVvA4/O3f/u3f
+/i7doUh8Pvrc8o0GMU+zkH+jrv1dhBmkQQ4S3JVapfRNxGNAdyB4quC83WXVDUYKke6bnMBi00ptsaPaDlahqSd/j26lwtTCNU6s3TaHa/s3fPOQCpQ49j7q
+VqrnOHjYJAQ5W1w3pwWHyM4TDF3CA0ufOcp+dngAOxW+G6K02lH9Pcogh2nj5CPsALYump9PoqRJ2A0PGTWJAmEHb++DuBN7QvxtNJ4N3qKuisXAtikn3rn4
+ADOOqhz2f77xmfL+HZsu2ZlhDx2rOg80yVomsSVZrcWWnpTKe5TdxxdmXbh82ajpqJTZhStiuIC//+tSNPyqP6OLs+eA4v9rUkDcw/9w+PrFiyb/w5vD4yf+
+h9+I/2F60r8YbOV/2cH9cNSTP/8cliM/+yJCBmFLl+U2Qoaff+4Ig7iv/fEoGX7+OdT/o47+YUgZfv75XpiXZ89+e1oGJGT4+WdfoOU68YWUDD//3FJYowX5
+dUkZWpwMP/8cVBYFE/wYVgbBSym/mpXh55/518GUNHkZvofHor3mu/3EavDHYTX4+efoDbdIj+A1EFkDYuURG/yPQWuAV1LQ42fP/oWYARSxwJrFozgCxO5j
++AiOANHiCJBfzhEgYo4A+bUcASLGgmtzBPz88yezDkTbI5kBaB/Tpv8WzACtOtHHMQPY0fye3AA//8wvuVn9Y7EDdHACNPOmn7gBHDeAOmBHzhfBZwceU/Ft
+YPvlY0CvVwzZjzQ2AYGN57SJTkBHUZXYAtWPg6tAPO7//PMlhQOePTu4B4DfqnAHibxaO57eODTqUAUorsrZVtVWbCxdoHTjym7uyoM5EixaTW1QVFyjxVZG
+fWEHZxSytZ+xzP9x8pxjXHe6Cimf98LSD7oR5uWXIMyLeza+fAApxjfc+V8JHG8dhl8PHO8pL8Q3Pz8N1H0R7sZ7Mc7vPxviMWdD7job4hG98UdEbjki4tFH
+RHYekSeo7Ceo7Ceo7Ceo7Ceo7Ceo7MdAZW+eoLKfoLIdVPYWhGxbsoJ2TAsZe6W88ktekK1Y2DCc4IAu1sWcLgGGX5xba6OR28YleQ0YkU17B3WgXseerUCs
+Mer1zz9j5cGzZ4/EvfZ/Fl+Le20zBignSXTCXdN5+6zboe5AmDUAr0UEeE3R+PuBrmUMdG0/LB6Mby0fgm8tHohvLR+Iby0egG8tu/CtO2GteceXLanzYBhr
+mDZzVwSGjjOJdoNWyxC0WnjQ6k2EwHsPWLWMwKq78Zfl4+GXxRb4ZRsBDZ2P92MBM6Phg8CAdwD/0lKJB0AiJ98WE/mhkNPiPshpuQNyuinkxD2Q03IL5DR+
+KMadFg/Hnd6JrixrI74RujI09W3QleG3pXhCV/5nQVd+MK6yvAdWWTwCVlneB6ssHgyrLO+DVRaPglW+z4WRiIfCKsv7YZXFY2GV5VZYZfFIWGV5H6yyeDis
+snwgrLLY5k56NISy6IJQll8GoSx2o80+AkJZbIVQlo+HUBZbIJTlV0AoiwhCWX4dhLLYgp/3eAhl8aCL4OEQyuKrxPCWlh4ghndAKLfBk+WXYSeLDuxk+aXY
+yaKJnSwZO/lrYJNbTs1/FdjkuJpOfAVKsmyjJIsvREmWLZRk8aUoybKNkiy+AiVZRijJ4mtQkmUXSrL4IpRk+QdFSU6+Eia5DZAsR7qu5mqlkSl4Xbgo1Ikp
+V6Zk0+bnn+1jECoHzN+HoybLe1CTxeNQk6VDTd6FlywfA5cs7odLlg+ESxb3wyXLh8MliwfAJct74JJx1aAUtAMvGf8WAybLewGTxcMBk7uhkq2/7bFIyaID
+KVl+DVLy7gTF0MX5AKRkYZGSIfn237M8V8+eQUrV+fh/DM/O+hdn7p/2P/2Rwn/Br1njEQ8GVVbFhsyMJroyXw8iBkiWMUDy5pEAyQ2ODC15pPICMygiHcNt
+r/hvHhvZpqF/PTay2Okp+gNjI//8MxahPnvWQEcW3VWoX4GOLDw6svxKdGQRoiPLr0FHFg10ZPl16MgiREeW3wQdWRA6svzPio7chWT6BGL6RwYx/UNifW2H
++aJnxTeA+aLW3osdMF9wyqOjfC/mlwgwv+S3wvwS9r1vgvkluPPfAvNLAOaX/BaYX8LtOfkNML+Ew/yS/4yYX/3Zb4f59fPPHniLQL+ePftGqF9R062yI/hM
+kUYP3Qv8RV17Qv7610D+QgQsEV9cDdivE5VnC1MWmULgqZW/PJkNJv6mCAzPXN15fB2LTuUrRg6SgFAgI1rkRZ7N6+dm8TxXdwH6Q0/+1PDwQUo4Fji7GIhL
+gTOF9vBRSs4zBKQRUBGEtpqt0YEIbI0bhrxUWUX/gtaNvAJjDiGmiyQE90JwM7Q1+ktdZnNFHkLGo3aDszjIHgI5sd416rcNyYgGrJadVlSWio19IQrhdGfT
+0w4/8FsjyMVfoD0G5+8qo1IbVV5lNTmLEu/YzA3WW9H0rRTkoAhKbam4ONO/9oO04MfBLxuhpjasnLjStMuzQk5VUSt5kqtSyROzxkCo329JENJTa4ilURHU
+n/vn0z8NivSUZuYHzB7Zn1NXAkTp+3rSmCKwSkPYOaukvtcpBts8/Bz8dmQw07zgCCz5s4K+k/y51cVaS6pTe8B4O5dBNJMqcS06ksMDVzLcE+uypkcZs8UB
+BKu6NmWhN5VcaF0xl8oKNW8KZD4G2U7cj2wnH4psJ+5DtpMPRbYTD0C2k1+JbDfw8FmKmPUxUYSrOZvE8AvyaDdMKp+1/TKR25JoHwNzJ2wENswF3IVzl8gr
+LOnmBGzy1FIR8yojORdpNDZtOsw/pkAS7nVCbbMpZuR1oq6AJKG8YewKucVprlzuzULNa1NyiK0DeI+iITp1MPIxzN7DEPZE/3wwOgXdbSpE32fVz9p+U4u8
+1ukVw00ytO5s0v6qKE+fY91xtXdWbWtRPn8Od0qRUjSONmmwQb3vzvv9ERAxI/3Dpg+bVvay6HT1Doe90KVZyXelKlJ+pRsTAs+f676f+kpwMMFi93lYKv8Q
+774wW6kuVaqXqvy874C4ValFXOjkvne1rv1DeHzZY0CRonVnpTuvEwzWNQTTlJtr09TyXBoAehZCPH12+tc3QZ9jfzA6/v1v5Ai/cmPK7N8RSAW+Z8tE3EOI
+IXVjoGn4e4JI6OCDwZMCH6k4+SGO8sWwUTTCH3dGYa2MBo3Blzr1hLiw0OU0lzZPN/pCp9yIghF2IURY9De35f5rSm/cstV6DbavaJOJrLK8TE7ME9EEyhbO
+LCZ7pfnpRm6Q2NL/OEuv1FlR6+tSWdxObCorGlmUwl66Vxo64OAaOoA/uYFWbQyttswWwj9GCYZei+Q8oizFD7dBQrbt9x97YBu1uUcIqNQPo7MQtyfEjxB1
+HBbyA0HRkF6wjVfeCyk7p4l0toEIVtZjbDoWLyvvEtfcjz0yFH/svcCOHPfkGK+JC8ptqHrx0vHZaIHXcCpEFQTQRHj4GrswSjJmnJNQ+KRrV4pV3xmxf3wg
+N1qVlef94nsQ33UFHB0wSXbn4Kr760K4DjtnaaydbqsShMmCiXARLsgV8O0GE1EEeUPUGIeFjI8eBYvjdhQshQxAGvkvWXHdWAvbhWalj1m4555VIpz2UI+N
+t2pWzDmeTcF1OJj/jJOHx3HYk/3ASIKvnjlLBSiPTQ0jc0AIzcyQ4LgdHVksxAZAY6mvFUJyC9X4VGAUBakgR0f76iCRlvUFLKhGYJoMNne6O+3XlvLsIXB7
+Quw9FuS2UzGyhEbfAODW2VjiSwFu7+7uektSxHqmvP7T6F8a1/Y+lTTx0vRclfMb+eIoAa/ed4+Gun1A1ktPWNTbsOjfvdjGuYWObGtYhA3Lb4p4+4fAGkXN
+F/bj2mX3sNmsMjisukCeH7xTs0JW2XKd16rQlM+MDiW06uJjQvijq1KvEHy/A4hkYUq7J7oAT+VOwFPxSMBT+QcAPCX8z6PfEf/z9eujF69b+J9Hh0/4n7/F
+T+tWG9JZuXXwn0exU2J2/1UIL8n9vdHF2d6BjQm5gx6n+IiuV52n0Wuv3vUQ6mTuAD53FlkzO+g9CpkAzxEb32u/2RjLnuiCwnjAwHtPfpu9LVbM3pPX5h6v
+TeXnbg9VaLHX7bPZi1w2ex0+mz122sDf5hquyr1E7H3Q889mL5F7f1a3ag/ex/+aYs4z/H66VGUt35XmDiyoPesF2ObzEVnxAFjbJyfQv4oTaC9a971fzQUk
+8afpCBLiiz1BsssTJB7sCbL9afuDhPhSh5DsdgiJxzuE5BaHkPhyh5Bs+TTEl/s0utoVX+XTwMXY6hdqrMmDHENyi2NIPNox5L4dtvhNprHZMm2Vr3YO/YrO
+oK9y/sjQ+SPud/7ggJwD6Pl2HexbO4aOnhxDT46hb+sYen70/fff/w7eoSc+pN+WD+kioEPCN8S/Dh3SxXY2pC2FQj+GXotu0pJD0Bn3Tnxm6mXlDNM0AqwI
+8KNDkKio2CtCnmsiux3xp9xB2/sifhRp+VHENn6UvQ4Q070/LDvKXqj77v0RuVH27oX12/vtmVEsbccr6J8DX9j7OnaUvdZNtvebcaPIFjnKXoAcsPdF1Ch2
+JcVXU6M4ZW0rMcp3vSP3WLgUN+rWBdxtCSx6f5J7ivAdjn2TLoPWgiYZWyLcCFu6izuOpjVB8G44AQG6AGtLc1Pc6o1OfXI5krs0Tfb/HNQuvyWvy69I6rIX
+vbD3eEoXmVXikZQu8g/G6UI39gVhfBOGZbhLGfwbsRsRFQ4xyi0sucM2FO6I3JOcvNT1jUmxJmCuK84FVauVKlW9rug7FQA2hV/3UkBalHq+8KB6Yy+Y8L1/
+IUKax9DQBCJDfB0NjWzR0Igvp6GRMQ2N+FoaGt9t0U1Ds/fJrPcQNRX+q9w7+FJCGorPJPJbEdKIdkXQYwhpaFy/Jx3NHr+z94cko6Hg1Y0qumhpnuho2nQ0
+Pd4Qu+qN5H6g/rKoNqWPtx0Eglt0hRq+lPhDtGGxvgHljQ2J3kN7c2Wn5oIhaunSlFmxgKiAV4PpYgEaG9hQpkQeG/zPRaz+JHIL+w1IRjWvEU8PXubKCUL1
+gAZVTs7qP5kywA6BqTNVd8ihe2Z6Qsx7IaCIBw+wfmvPOXGEZ9siWqlSc5E8bDtTeOj69nIvsrKqwyj0ljCjF5yiobCkPdl0kUcduzqw7pHC2C2Zex2JB/VW
+Hh2IhcXQdliD7G5xGmzUuR/k8YGsNCoqHc8IesaU8sUBro3dDoSdiXIOdsZbmZFcDPWN5ko5pTjjh+9zMYS8NO5WxOvyFuGLhdjCBSSnjyftSYT+FfiDfiWZ
+06DK+WKeIdEQN1/JMyQ8+cVX8gzx6X+c0HqYzEoaQivqiYh8UuGAeWgIGcnd43iL28M4vKyuwvdEJz+QvWiDtw8CeYnLSaKxIRZFIEq5E10SEkTBLmdb2MHO
+lcQJBtnQcU63txsRI3XNhnzwbOyW3SwdK+AKcnIb/3un7A57QlIb5ruSsWe3y6a6T0gfP1pIIxGGE9TN6koS2qmXyB2TCLJbtGV355P3i+8oxyU2G82iq9Ht
+klw8bOd1indbgx+wjG0Z1IFTkO2FgBfVy4PdMoHEZ+z4hC+oq0oXc717g8d44E+sW0+sW0+sW0+sW0+sW0+sW49l3fr0xLr1xLrlWLek/emm30Js8aaK2GLh
+ssqmhdQPNu+zahcnV0UBM5/ry35h3QW72GEMxjd1hT4LU4JGkUS9vp+pK+TJiBIrmKlrD2ED9x7J0yX8n7+Wp6u5EJRJx/hiTcKurtMTS0Ghqm2o5o25ewht
+l4hpu6KpRzvnwfxd4iH8XfKB/F3igfxd8gH8XaKLv8ufng4ir+bZeVa1y7JpQz6U12v7QTSLhuF3H9OXCJm+pGf6ap2ahzB+iYjxy0/K/EBOLNqbs0zCL0RY
+cKp2Ge4Ks5vmual0CmGSaq2glybC3t9XB4Lt3rDNK51n+tYiHO2af8pVDP4ujHUC2iQmKMthb0dz8oPT7GtkUFbhlDTTGNrB4l+dGe3hLGGywRImHsMS1rrW
+44bFQ1nCYoIw2SYIE9sIwpjz5QEsbNZyEbtJ2OTDSNjsyAUeknC9HkdGZ1EzdKnFfVx00e1/Hz+duIc6TT6OOk3soE6Tj6VOE9up0+SXUaeJbYw5X0SdJh5O
+HXcvdZp4EGfPQ6jTxIM4ex5OnSY6qdPkPw11WgXcaQlpLS96L+D/vcS7skWnJr+cTk3stP4eS6cmdrjJHk2nJuodNWSPolMTDzNcHkSnJrqNmC+hUxM76dS2
++gk7xKR4NMWa3EqxJh5NsbaDcumRFGtyK8WaeDzFmtxCsSa+gmJNRhRr4uso1nYwcDySYu0RF8YXiGvxFRRrbXEtnijWnijWuinWEvmPdcr5UyXkzIkY5/Ur
+ONdEO91bfiHnmmhxrskv5VwTbc41+RWca2LQVUX9RZxrootzTX4R55r4g3KufSXlmsSfFvFaUNK8i3jNw0sg7Zrspl0TX0C7Jjtp18T9tGt2QFvJ14R4DPma
+vJ98TTyQfE3eT74mHk6+Jh9AviYeRL4mH0q+Ju4lX5MPJ1+z69RBwYbC/NMXULDJDgo28TUUbDKqJxBtCrZPj6Bgk5aCbY/LDQFBxfGvAVoKsq/tJRFqy945
+/+ribE+65JAW/5ro5F9r0q4FNAaFqXVAs2afIAWuEi0NzidA7uRs+9TmbBO7ONvkF3C2iW2cbfILONtEJ2eb/CLONrGTs00+krPtSyjbbBV9TNjmm/xWhG3S
+E7aJryRskyFhm/gawjbZIGwTX0fYJkPCNvFNCNskEbaJ/+SEbQFhmPiOagGeSNv+uKRt34GyNLQetqzGCF4ApXKFtV66JJ4WG8cKEwY5hLrvOG5Eque5qk25
+AWPoGp9R5A88cCVa3YzqcS7xVub18CnbIIZkcXdkOS84fZSi2FQch/gJFVQAlnU2z1aqqPcOpMpzfW3zSKgeBN8PnnrWna/anevgpodqk2jOOB4T1tRuCaEH
+n5W1wTGRryaKpHPgC3JMO9c4kesV5MfxNuc7Da/a4APCH7VVadDoZTAOJvrJFnxgXEsUHsbo7ArvQGoaNWHKt3or97MD9s94XiCMbKlNNDwll2tGOMPHUZUM
+KFw4ld4Dm65UVdPxpmzBdbUjNbM5m2iM72cZ1cakpbqz+prb7rSXmz6ALammwu695ofwRDWmzU0UgVnYIdpqAfQzrtSGTktZKnu6LJhgPFEprW4wu+z2sYwh
+7GFpDY11XTsBzPnU3onRHgk2ody2CSNJHUrwmi35VVZGSAs0MXZjrnSZmTRAzidkFUzmRwOHM4ETeaPKlP7L1Xwkof2y++jaxPvk3rMrO89uPFN2arqObnu6
+LAjVce/YJqSX+tZ81qlPTBfKWcmYpUUSjXLSuVAqTWRl8jTx3hT4FQa+U35qR/FCuE1B/L9w4p/k/E4hbzd8dIZZelIc5tsLTo6uYMNuD5e6MvmtTn3Q+soD
+mGEhR11T+sSBWFnQR499YO/erpH6I8PLTs5NJ5NuVb7WDUrq3UJ8ax6UpzCDHJuCgBfVHPGoZVbIVNM5clm6S/yLKaXrBM0Tig0KAFsb+zvKL8P39C17uELV
+o9Gr76hX3/WO6egRjZsuUoEBfzu3jgiwCm58vxNNaWm4Kp3nunTAqRAYE+hmuVV5lgYKEkcE2LwNWgq0Qr+EgWoTa1bBX+7jov2dqGg/jS+THXy0omUyfCkf
+rSA+WrmDjxaeuJeCNuAZTcS3oqC174lvQkHLoxffgoJWAgWt+BYUtMFW+wYUtNJR0Ip/Rgra4fSrKGivPROqLhA9HzJjuxlo95oEtHvfiH92bxf97B5KvL1H
+kM/u/ctyz2bVXOc5sSs8Uc8+Uc9a6tmdzLPJvYynren9l+Y7ffZEeHov4WlMoYnTzzUV/Upe6fpO62JL6l/DG1AlBAjozkOwrsI3jK8+huLUAmEFDKdiF8Op
+q4LsIBRtDaSZsV51Ybk/jGd0ZGqMxrLvNaOaEkSjdgUKUWwElzDDED5Bwy2zykYsXTYOL9WLnjxf53W2yvVzR6eAKQwdnkFMRtNVdk3+rKCOvJW1AU5A1zBP
+YLrXEx2/dCBbvEPb37W0EhtKN6EF02JnB2j9ggw3+9S5hypVHh7XxtEqL+29q2RrvhLL1XbGRpAm7f5TPt8Sf3s0ivVuRNU/Joj1+b80iPWDEZsfh1i9o6Eu
+nOruhztQq+kPQmxr/gmn+p8Yp/r8Xxin+nwHTvXvTqTX5rATOzns5CM57MQuDjvxfzz9/MF/Rhfj6dnzF73DX5EBcDf/3/HrN69eNfj/jo5evX7i//stfkam
+eH5RmkVWy/FKF9IpU/ZyeNE7ZJ/PvY/u74WPTIH9z0rQF0yOK7z6GObWYoK3lS5oAZmFVOv6xlC5Cd24Tv4gPviBuMNbEotSIt3UAGrqjaokXilpg0rQs4hx
+FFOl/1BzTSFT0hKs2sIPWDs6+v5bYe/xUGu4d46CCRHi6IBA7qjWxn7VXWF2NB2odR2gdSICrQvQ4cjnZKtr7GDSdRn5FNyoE1kbkZp41iDB4oCylhmYrj0j
+pO2vEO01QlhrIFaKuUHwguxW41r/IMQVto2g97mq4UpO1apOyJhK6A+EeWfR8ExpA+5RRwR0BJU9LDehomOIdfmMVfhkJff3mkmsewdgBdggfQRD/BP1cn7Q
+MLhNaVH8Ue2hwbcuYngbdRz/Reymy0rCLN954HEiFcVYLHHbatyiKTsadECRWzvpbPu8uXujIy66ty8vpa3QygpZWc6mN/vpwQ82TTjFqWKkwo7poCHnGwb/
+o5e0nV/AOdzxEoBFBifnIoTg/rWODYc0A6zxyoOMe7hiwcqo6wOuCMygXl6ZNNNtZcrW8CzWZeFyvb2VbMrtZ5a6U30J2iBW3ZT1vTuVi56EeBHMeBeIMCu5
+5fJ+tHMRop3HH/dw57ID7twlYXnQjbhkkv0l8PqNuZNx+nv0pWCboOutAunP+1oquVTzm6zQz0utUviO3AY71jUKAWKPobaJdiR4OR4vbg/XE39qK98/UZIx
+XMWMEpWqs2rB+e1B4fTVBq89yvxrDmMrehrVpoY4FKVemSrDhMAA7cLDfwg638uslllBHuuMbTlEaCgySiGESItNF1iQ+MDZUZUfoS3AaDo026smxMsDOaDT
+CtvifWmWTjgxYOwoIJ8o1JJkpz9MhQn+JCygUsOr2voyvecL0bIyZG2FQ6ZLyGoS+IskcirURuoiNWXFjA9maWrtCRQZhCmowYxuMxdRsH4nyqvAua/a5S2m
+BPegxfxwvn1Cg7LcHzAacgA3SlGtzKTYDf2qNq7pZ1Uw6kQ4naFKAlkED8C1UGoCV8BJwxXphM2FchaxFWPzIaItxKQ2pWDJRgLXVbc3qSJaTJveU01/5qBz
+WCt3DH3d0skGj2+w5WIS1TaHbueNAF/qXiG8wAVW6tW6XJWaO2Dr7fx3cUN5dZfv+Y4CbNEISWx2SilfTkKAIjj7ylcc+XSmV3BW0QsHjsdVbjDlKbwqOv4c
+Xhm45mH9YSIidaYLbbqtHElSjrga/05RtpeHILj/1fA0X20sbqDfQJBH69l7GA7J2IWO3M5h9+3OgcdXuqxMgTmgDXQ9gj0Mw4AuVmOpi8GnLJUsdH2He6cP
+rwgrMOamYCYcq0jwKTcLu1g+bSsokapLTRXjomOZXArYlulXzVnEcYhwMXlHOg1yfw5Qwa8PZL/2z5BHOEAdKXWtQIJ1OML4mG9Xi8lvnVhkLWvw2ITREKZb
+Woeb9caJ+659RL5GhhJVBbgPwTTjpVykmCfpil5v2dUW8GrVtiSiohhFMB0EatTbc/MhPEFFA2mzMReycy6I4qIsN1IJBx0o21/sVgICDLAAoAY+IRywhqsB
+7LrN3xzInxgrBVX50tzqAoNFqIZmFQpjilbYB0l+NEzPSjijpCPKGgd7sNYKP9CM91jDVbRjPEGMP+kM8sPwGPeF7TWwLMIs1UQsdTm/UUXtAG9KuchqTKfA
+BQuLV5nLZEsZlQjLqDjhbTwZfhhCMhrm40V1U+jA2VK15kO7uN10VTFLjyeuCrEqghuwoSgJXoowSzR810YFgwVK3QpD9itA49sJpRvUBpblJb5TGDnPyvl6
+SbRjFDxb2z8R5Ux9o025SYSVxlkha1PWct+tnyz0dZ5d62KuDxKXrpA0SFoYRy8wxMhqdmyPdAksXA4sUcZ48hiM/akcfjXHw61yvr4KLvuFSbbZBR7ZEzqj
+S5tu0MSqCCfUFgysq26JdN+Wtd+GaGVuKuzEtTEp1HMl5IerarNaqWud+BS6hcryNbE3LFW+WBdzao0nAgVcnjuoC5eD50ZaSvgY5bdkVdAjGSYTsKLTyAtx
+OVxxPpXTfjhFz7fZE+L7A9lHXGAnWIKCN4gUIZcZ9L3OloyV4ZNiFJ4HlHUNfn6y7fHPLvZVUeSICmzhU1lZ6ltDHVWuF6IL44PRKmAQnGJYpP7yrrpgYtB4
+epgHqutObuCCiKjIRS8WpgxL4eOjVxsbtudSERSYPB1mIcILYQeVeaPW0A+XJpKxcyyWA8JaQlsK/IdILYWQlTHi5lEgrINmApjd1rUYu/5aF3uRytQQo5c7
+STemMCVDkla6c8lkVjVwMLxzGXatwuBakJ0lUOfabNf+w0yd2jAcSNAqiVj4tmgmFgQCcG+hMtQcsUKd/pVqBSXZeweNFaFj6GuDsiWicSOwkMNwxyQQNOwx
+QYIhZ725vL0EJxodzj8umBUytaF5tvF7N7FutQXrjUeHB+G5RsHMrsH+nE56e1xbq1a/dGSye2RdFUIoGkkicdVjjFs2L01VPcfrkcyGNewU/Hfiqti8VVaG
+RruO6yM7rwZXtcP6L89PWG7ic3KbSXp07XG/3cdEV22Og0RSUWZV3J0O/ghhSlc3But7dCD/HKSEJvJHTOiElfrgkmrO1B0h2StH1VatszrMPo2tXTbtrjDP
+umaAvIK3m00yVTJKRr1j/TxSDkpdUR5v6TNB3B9h56KzJ6vRgbNU5cal0iZejRGUTnnnEUeiD+/MC5ZhXvDsRovHZI7K+zNHxUMzR7frI2ZdwyThKKu5WelO
+VgnMCrW3oN2K27KEIxRDrMfUhcqtCu4Fo3Hee5gqXuEQATmc6B5FPK1l2ihaiqu1OyCEALa/7xN732vQdYZcRzy3nLSccL6TYkNUWqM33CpPfh/DHqxNYl3r
+twzgTBmtbrZ0wXDbtYEb2dzy1PpkZJttfK+Bc0/WMrXo8NgwGFgUPIPQXljfHXyMGItXK61yxx6kQoH9qFUQwSq8OIhrF1CB+vJSAxGXGshvU2ogxNHLg4Cl
+HitXmCszurAsNSiXhMDqxOqo5Z3MCrlecdF9bu50yRBwvwOPaCcVqIipQGVABerIkx/OBCpCJlDQHb6ECZSoj0TEBCq/mgnUmaC7mUCzrEEF2kkAyjjAW4lA
+bVvZwQP5QI9eHZBzDcZ2GWbj2mG2ovuw3ncMTOBHISw1jguGkpLW4QAwOBdY/wLbyV2P6HmqdIXWXm0YpF2X2hFiXrWZRHA4a1hjckXCmF7HVGkw6tl2YyOr
+grSL//i/5PHh4SvQIEoNJtrEVLroyQsfaIk9/nC3hPXcSTPU3oIohDMbhjW3q/k2O53BEF0UM9YoKYMm7QxYMd9UK5LbphyixkXdTFIMTdPmWJtDRZ3devoc
+nwwl5Zzzr2WQ+kOU2g7XixS/KAOoilOARHztB/k83iggCIuQ+MllpAa+f/RObnyyou8YrC8lGnXlPpCpBGlN2H3OfRc+991UNt7YQLXq/NgPdPADtzZmK4kA
+y8oXC7iagLJCdh51XarVDafPNrJlXYa+Zd36r1lR6bIBG4Z9u9Gl/m97lm/WgzTbPps7psHK6GR2wJg1cN0b+5ghLrMsXhdBRo0HM2usPMy6gTVgAnFXkcn4
+JtGE2hvfYwKiVndL4oeXEpsZMowAMCuNp8MDZ74GmEQCTl3uqhtvM01W+lyXtRcrzA0PEufNQZj01V/qIvVBLo9rllVSLclsb6ASRptN+Fy68fQMssT2DmRW
+yb2H5Ont0UftZApuQeatCeKeqIrPERAqAyBeYMy4uk70fQA4UGlLvgi2FXfL84Upn6+oX6a8VoUtesIHKbIN1wVMJVprdzeqrgwIIJ/mnDbAztsJFV0xOaa3
+RKmLTTHUehCMb6vLPSEAxWPmDhOM0XIFWvfRG/m3ve6oxN7f4d88r56/BK+/z7og1SPqenVPnpVtLBGE8oVgAe8Hk6kcjQPMNvnTx/5sOh78iKhdQAMyDEVC
+1yi+k3/b2+JOp2GgutzRE46GV3gTBC5ia9O6SnhbQT84lYW5E06h2yP9aM++DD1OadJtZlvorIPRNO+cwtwR/lPlygH37ktO22ulsG8R5F3I+aJ1dz8kLsx7
+cFsKXeP8WfB9+jo7MsuIziU4bY4Oxsl7PKJ2ptz0venJsVcoKd8/gu3Vbb2kkdu4c2yNQpLmoMDd7z9q8WTJ/91Mta1kZSwDANT7VEZe0aUMpnquSidhk9hJ
+4/HZUSHI/l1bzWj7KOkqsV6VzkNnFyNkLQr4Cf211Bg0bGh98JCDnkfORtzReE732tsEDb72jNUmRNClHqK4u+v4qNjd667878nZr5j5/5D8/8PXL46OGvn/
+hy8Pj5/y/3+Ln9HkzJ2yk/HFp8nww8cZwHsMTwZQQ+cM/DjREonGrFyNE5myyv8lvNmFu+8vp3KkblWOZX6qhDtFXZmS0Af3R5MzgtlyvjuXEpuVFuoAcjtS
+W8pYhaWJ3F3XBexGnGGn5UjXf2LUjd7L52dZreU7XX7Wud74ctLTCPd8/9309CBwo6IWR/pBAAWhatcQSkbO7bGdi4YFg4I7caKvfVHi9rZ7oqtAElu4PPFf
+9Qatt7BDPI6sklcZOg4Exrqi/jUmj5yWkCuj0EGIUzc5k37mKcWDUnJ0GoufsFfzqFfOCIlr2vzaUkkb8qfM56ZMY86PzoarsOXWhgg3JQXzwt18p0vtBpXC
+IsIorzbyVpUZgJ6stFkRLIip/JN2+C7H1+5WdMl6GnaKv2z8FwR/AXqCLagKCuB1GtbAkg8+Wp7awGs9OSY3Ol7kl9N+go2BNuqn2RRMgtA4Ds1R0gaiMCJk
+ZsO805IzYYurYG6WxVh2AhMspEXvdxvCUnXxAaTsNJQ5hGkrBPyD09GClNYF2iCRYaAISAhrDsieg99cEfg/OGArxvlgc7bpbEnaoqG1EWxds6qxXzETzTa3
+A57qpa7fEvoBFTJH9bb3nwQ7cdQ+Fj4j4EWLe4GHjkDoQToc056b23b5EqtSVqEP+100a6OidJwX7Q5kRTjhznERVAR9yz5YuRCtkWD4SnIZLVWty0zlQU2K
+ExNh1yFxnBZGpbe6rMnB7d9eUhQMfrnQCNlbWRT7lvyAUYuwSsX3Xc0dgSS0WL21pS1cQkczVXvfd8e5vNrsvAYSEV4wNg7oktd7X/BFh5Hhs/9nen5TmNxc
+b+RpRvGOROy6tyHbt5167wB6Jmdx3r3Ncwm7HmbZikfnzLtx2bNvBRdDSd6VWV3rIhAygIzwcSCn4/ezn/qTgQdrf/cJOzyctn4FSGgB1t9U/vwz5hQ+e0Zg
+dqNPFpw9gGYPzPgIpf3dJSpazoaejRFpULRfg3Q9D8U+dNDp74ezEXyrCcouGZRdwLBc1t9pTw5H4FYALL0Zw+fBqMaTeFAedPC9wzJEkEHh4QYDlEEPPTj4
+6+D84qwP0ItbkQc9DGMiumbgYjI+uZwMzqGLiNr3jhHSEW8Q55Ug1wfTHxwU4eV0kIjT/qyPH76YjN8PZ9Mf4L/fXU6HOEfD0WwwmVxeAITdgfw4/gl8KfKk
+fwk4+jCZCHT3ibEjRYgH6IEkh6MALhLgHU9m4WPjFtRkAPonQnjJA9mfDKfwwJA++1P/k4RMVE7lZJh3BOKzG9SBIIr+6Y/D6UOADhn8A7yYLjXJ1c7wDe/d
+yzsuRnBN0lXPJ5o988w4jHUAyAsWlyWoyvs3CJWP4lJiZfJsHlaKciqBTqOUW3t/7hA8Ag2GJ6iBL/wZzS5+b/v/qNP+f/Fk//9G6+/r90ezi4MQtWd+IPfd
+Pz+aPNVlNVJLfSD3sfDu5fM0u87q5xutyoPn+zMjot8IEcROCUwm4UiiLeNuUds1DbWsrmJRJGzCNUf4ZVPnX2hO48AKcI7XJh26PKqqolXtT0w8lYwJ2B2j
+5JVh9bL5IpNOZpEdE7VJjPUYpEBFNxxWIkPOSoHK0/7MFqPolKadRayFHQ11Wcx5gNLljHIHQLRjcqrpjK9sVZcSryk16gx7ot0h4qIvTMONjdA6a5rmCqHn
+mBwwXN/mSvbksOaEWVqpPVXJrNprFUD6G8IR7T7J/y/8GSnYg7/uFXCP/H/x+uVhC//lzcsn+f9b/Iz6l+eDURP1bxbJ4axqXAr80n69pBj6SWSA9j1LSulx
+3CaP9ubInd4cAT2jNJlap4931Rx1eje+oXsluc+/cvwb+FeS39DB8iLIeQh3CI4ivLkeZtuLHbb9NpOe/AH0cXL5zELMhK2aBuVKMfdjU8GA4COmvwCjJeGF
+ho3CW1PKN6Da7v1qeQAtznw1OM6DWfievXK5qAzeRk5z1AuCMTR4k3j/cuJLAn0hwlSfmmj/yO266k5f2+lCsbXNjKAsHAGVidcWLgnftnsHSxZ8gVpxDQ52
+X5z5VojIUG15Tmgstvyy4SmJaOxCX4m431ciH+wrEff5SuQDfCU4DrDjAZz/YS4TudtlIh7pMpH3uEzEo1wmcqfLRDzOZSLvd5mIR7pM5C6XiXiMy0TudJmI
+pssklA1MKsDlBEUgrS1FD+/v1k0op9YFqergNWjU16xRMRtkoKkCM6vnpU6zmsAmf321dqTrk9P3v6/+9+pF0/4/Ojx6sv9/kx+v2B19//2L58eHRy/D0ENI
+Hg33Yr9emmp1o8ts7pxxf7osslTValfM9U5viXHAi/KiNNelWtpAhP++eMj3AeuXYIasUtn6PKXfING8bmhDHjoJ3xeeMjwrrivJ9aDrSpekTMB/RYngVhfw
+RTN4rWtOKavqZJvvg8DtdHHj6pK3KSgJOhdcJDUEsnNpkNZbss3DYPUa4RwnLhWz7R3JKiwUKPVOr4hoOBOSdvegV9t61JPv10jckMjLk/4ES7l0VfuQOU02
+CUR8xG41m89NHg8VBNqpUN7nmnRuB1O6Bjg8hu+6GFlz7nNY1+sb+r0lni6CreM13wr7aTn2uLuJvOGke2Ez00MvTrHZ7snp1pVxGa2+bKIqe66k4UxpF73v
+0JURN4zj9Dhf4QwHmx0pzv2x4ImOl4PmhCeEwcFoS/l1pBzzYmP3A/H1rPMaK87qkhxWBDEIN66ihFasD/ucFWx4lPpala7kAY+VWenSpyUx6CC/3XA5FaTp
+b+/begXKbpUQIz6ZUDEbfin3rtbXYpH9oiuAeN6p+tIsjYagaG1TgAP1VzwuVPgw9Vc8JFT4APU3HItoK7wBARlpvNsVWqsi0z+Fz21u8me1lNVAV41UzP5I
+9pEQS4zfe32zS5VEdXM4vpzyC4lTK1mXHFuddUQMzkgKgLPdPwHS54Q0zUlIXN1SPP9p/JEjfVflui5/TQ3wHv3v8M2blv736gn/+bfX/777/vnz48PDl6Ce
+fUSwAfnBqDrXm14UyonL4BgHhz0h8UXbdKWgiN4EVGubChjfyNFgwowv8sSUGrkbttZ+2cpDrP5Cn17Lc+lbdObWjVlpV8mC3OV0Gy/WeSKv1rWFhRIUmCeY
+pB98GOKW+UWbQQgkNW64HkxpRa/YJnrJFYh0Fb46yOfSrXKVQQo+hV0sCR3NWehaW2aVC8JAimElr7SlXHA5mS6z08qnkfmcqV85Arz7/L86fHXYYf8dPp3/
+3+IH1z+qkvPR4PFfxlP7zwNhSZjlUe9QQZrj6eA9kqKOR1NgG+ovFlmegfK0R5AZIRIB1Fl7ijnMUVOWZRYPaSezsC3+JvJAS9dFeOHuXV+uUaml7ijeDoug
+HVazPUeCmpSmxE7N7+9UB+qzIxDGxnpCvKdct0q7OvTEj94DB9Q23dSe1bhJVRgq6GcoEPyXbeRGVV2F4rJRKH5rmAwQB0vvYmG9hTHjOcrqCrKAVVZW1nip
+je2P43Y0lcUowDrJKwOquFlwI6ZE9RgAOW5VDl26MilMx96Jt08uoVAr2BRxcDpEF2Uc6JCFy5VJenTM2kgl65usTN3s7wVeuOhbmKgdQQ3gAlRuNTPEXrb3
+DP6VOc4axCbRNyxJVvStrCAeIHabh4/zbFpEHNEseZw6c5riHNG3PQhp2KaL84huVn0cbQAuGHZHFWnYW5IIWSFUyqihnZ2zlivB8nvcIrqgyyVsasAhXtcM
+c7HXXMRourq/0Ro8zZ4IZk9umz0cV/Q2dJOT9VWlfZ9FkKvPnKRib5DreV2aIptHhSDyXEMEJKuWUfeVXNrfy2td6JJY/xHvjdLgu7LwmSAVa9aY0BAe0/7T
+xHxA+JdolkPPftFzmtWoC83pDRcoxDoOUEKhNVzuqCH8TeT5s64lL+LhzTNVXuuSyDjiubhD0DQmw8Kl0lW7f6Z0lQ2CJ57scIy+YsAt4FvdDiAnPKlIvKMy
+nzrkH2rN2426tfvWxfRQvU2sHFiqX7LlemkhXVamQhAKj+/B3qo68xnPGfOtYUuEQnXFqI+4MYi7KokREyNAMURR3zjIbhhBtJvjOYdm3Hk18EHi3LIxUcrE
+uaoYfbCUVV2u5/WaboogbbvzEJKrTN9mZl01ReFPN7pr82HttUZUPUSwrHTJmEwYW0ykihqSWUUcI/2HjCQkclPYoM1mhaW0QxDRVtvSfXFF3wQnDzbkMGhU
+VhA4jcdB3TI9tm3RuiVaj7eEXgNVuM1CjacBz5KADW0ZIbvwjwsL1euZ0Ty/l6o6bhj0K2PjSXMXZ7Vbwi5IXesGzUut0o3dAH7K47MbH1eG5TtBoPXWPg5R
+2vergwSLwZ3WBaeBEMLcIRK72XeXur4xlAE4d2hragVl1fW6ou/Q3YBu1ZBNhChTOYvQQNJGTG7RWEvHcCFDhovW2fAkF6KD5CICAYTWTbrOdSUzvysTucrX
+tDdVVZl5puiSqXW5UHMdYB3Zw0ZY05XLv7CKHWy3LA+kfFHVKs+dXqIK6a8bhFoIM2QcirylWFdlVsFALDqhlytii1yhiwlRs6F0BvIgnWrXvjFwQkNKyGcV
+M7GSvzo8DHNVUDo60ooug/RyCBqBhkq6iwhiHzEiXKrte6ybpvo5vUtIoF7UAa8MgEa6ri8MgRXfwB3ZEwx5tQ/OY+DJ2zto2EW/HSQXtb9Yo+hnZ4Now6BV
+a1fIbpEGXveOOvG8ZIznJbyW0ASdAgKF6fhycjKQJ+PTgS0CpJql494Rqx7E4yHENHb7dN7+Cb8TpvtGlD/PkfNHRJw/MuL88SQ/kZ/JWRTdtBWEYcOXlgUk
+c7WosfigLtoooEvo8qFAriZLBAcvAhqijsBg1y20H2hTVo09aNVDNtRhNvRCci4ZaHVkaHuIbRLbFcttB22X5RsH+OlUNZJvwNpBMR0BzB34n4suQ8exfARU
+HjhZq1LNMcONqT9AV2rSf4QJa2g5wnSaDgzKztmyuidgyM4JpYRn3rs5bWG7JwI52lc0NQAnA01qLKGF7cTsYZg0RStPIC1+DTtu4m6q1ujaTAFpqIaldNBv
+YX+uDig5EHa33Mqn8lYeHRDBuUl1wBdG/KhOwWr17wd5fCArjZemf050PGdK+eIAVydEga0oRw32x1tJcHtRPqfYpV9l/MKDzD6YIBEjyZLsRkQgK2uOIxP4
+MRKHQ9r3SgphJYXTMND0DD97j9DaRVRml/Yh4ifyE2yXQramNZEPlEKxaR5wQqOvKRxop3Sy2gGqHnJduNRFIuMOIIFFU251WJP3yTIimrs6iGTZ40UZ6UAk
+y1p+odjdEk5AxIfIXc2KcEOTrM7qKnrP+lyjCbS3avD2QYMDTtxLlLRDaIKMiAcmtg6sc2VxskFgtA+teOCEPXg2RNds7BblLDUrEANWjMN/7pTkYT9InlPt
+S+za3Kbz3ye+jx8rvom0zIrwSKioisV5AHjSMYsg0UVLonc/eb9AF6EYjK0as+hqdLtcf9QWaQj6fe2oz6Arwi1H6/sHztlsrwa8ul52CwgnFMTVptPTRrn5
+lQVhe9gQepAzfzqcUh7xcDyS43dnww99G1ORUr7oHcl+jOPtNYJZSwiTzy2g9yE/fvBr+3UtQytd7PKwhRZp28AOdzHhjodWWAB22Jo2rveP6LEisDGxDRa7
+ZcG0nnHOp8iGEa97R4lnLVhXLukLjcTVptUObjJ9q8tNBwUkkZ/HlBy9CICUBDAVrBnmDeBBkbMjmCsHm4rbBLMD0eFtg9yVNVAt78m2sRvCAfMEHM+sT9Gx
+fHXAwLqJKJz/TeXOgUpKt3WUBg9wy5Ffyu6IF71Xdhcfyz4Zx0x8tGj4ocEFF27lR+1kGyqHcxYY4VnRpMRa3qdmBzoJ7D+MKCLdBXNxeWeIn/1S3sLfC+fu
+l1tiB8LFDuK8ibsbs2Q82VRv+YobFSky2aLJEgd9uDdmkdiSoqXKgjbpRqklHJla1nc6B4hUQLFfmqK+qRiS35WCZLX1b+dwgucwS66xRISNVdkvcv91oyEV
+eMPjXRxHqcIN4dE2GwOvDd3/frvTEVRlGzJaF9WaKVvY29klq2h6quAbzOCIb9y/uBlI/MyCK2CoLg5Q4ol4IU8tBRyNvuk8diIKL1r07LRE6LYTwS4+XGX0
+ATqiXHuEqY6n4uOfak+OY6+ptoLtdoCIi4G6pKkvOELmUfi0z+uNFjZzJV/Jlph7QupJ29ZzrjvrZBHkxAyDkRaHxsY1Jah8jaVPnCnPQTMu6c6KLccRxTJR
+4MVJ1lkouaxQRFlCOTjwZgTKvkVbxC3yUg4Dg1JeWIPyXNVwORBm7wy31gUqXqStYG1ZUyV0wER8vTjlkoAjoy36rOr2eVlhj8EdjjHUxrPi+OiVCCjg8HSG
+3Ymu5Aodf6YE9SGJHgs3lFCETQ5b2Rcghoc3yiNgzou9s8GH/tker4hdDfT4kFHuNjXrrT7jgP6MeROIWQLbN9W1ynI7f07eCEwhg/klSW7P3rxuLQTlWzNH
+mV8RJ15FIyTWQQW65aJtzB2RNsEZXNU5K+TMTA5TQvMYIxxEU4+mjf9u7aIuggBf4D3k4mL9u9YrKHyEYSkMDGUL9EmH7vQlM5TkVE1VikLfVdelWa+qg3vp
+JZlT9cZEYK3tsBMuDsTy/Oy6i8NmuzMIdDjg/sWwdWyeNWKFkYbkVXKxouqVJeH42xhMoxqscQbNomHjseou6NZycxHRh0C+YSBI+xfDjgOD2fr21FDBQoDr
+lRWNTcBo0pMYvqEXtRuAgcOHE+mNLKwlNqBnr9ZltVYFMgD7bflyXzm7NmzySueZvrWA4jsmHSck/rtLZrT5OfvVgXVnNGc8OL2eSY5i8l2h9nasEuXwKzmx
+4o7YUKPrOV3TXtANIHwferVpJ3jq2uzrPTFcMKh6YXx+ARYqrGsSFr7ZiCQ00m/xml9rCiNWPsKPwJ1F+5JuNZwbljx4kgUzT+b6FubL5nhtDoj/SiounUDw
+J6TG/KyJ/yY35rOn6LC0AjhMr9CDsaddulq46LCe1ipRaUpw/3iNY4+CNRYx+0AkG930B+oJHo5wvfxaO6jKVs1U86ah0Uf3e9vUEtE97+AQHAlZ+853Q57f
+GEPkqmhzUYy+tsFEqeRCozabuMTjxJfUZEWqlwVzy3ouzaDKDeYsnHnf9y4jvcNQTI2sDFrqwuCuwpHKK32jcq5DQ9PX/sorX+y3Cj0gbm2EJUdSV5XJ10gv
+SCjidN8WrEg8eMzCj1lmFc0lHXH4JHpivSuAPfEIk0u3Dja5CNVK8gJEfkBHumfn2dFfuaifPQXhrm1Quj58GclmJdrthS6tfHodm3tmEaqs7O9yIiuCve/0
+ZQUvUx4D49aTU8HTrYmAbeBF7+g5CEr0MJOZpms+Ph3WBM08w697HY/PcQRNsM1K25ZdkQWKi7jH3I8JF9PYHrsxRP2B593zWS/W+SLDFF1oNjhYMpqOY64J
+pBFZDwVUvK2y+dqsq3wjQuBsb3XIbVZHssXmQBJYSNrQpcq3WCBtCRVJoy5dqosmgSzgDnto0ZXfJ1oSkNbDGx9mQQKEkjhsziBKQTZfad1cpkmACuLWMC5e
+tbY/hVdWeRaDR0f7QIQxPbfbbOes8O8YryckqGu9XNWyNgJdoijjcustcbP+rHK2U7F1R7uoKj9a0Qmqb5q0Ol1MwN0dFXbC3QS60UXMv7sFL86ZYCsqo5hS
+cxkkJeh0CVksUNlEd4Bo3QH3SGC5VQKLL5DATVJte7uILQL2TRhKDCUpe0yiUKMnPIXD1ToWXUynD0527QrDhp9WlVASGZocoA6CuLMiRkSA8cpXSLPbEO3t
+A1KGks+ejLa28BIqR23x02wsT8bnF2ef5OnlQMzGcjrrIy7JGCo9P1yeYaSjJ7z+my2d9gvfYJKPgJ8pZN7tnCGKozKUPLxMMOhlmBnVWhPWmuHeQSK3f6xT
+wik1JSZaoRF0veZEuUibfov+pLCH27u2O5/4B+eECv1GEYWzdVdA5E8gpjwht/fkdD2/iS4we+PE5AyR/e/UZRG5El760rLwfZde1sIkb5kzYkAGYkyFGRNh
+81zHU5vQRg3GIWw/vCWXb9gTwxsxcMVAb0yZEoRW9Rn8MsTkicpAHZaxy6zuyVc92b+4OBue9Cn6xlW8Pi8togskuj0CVTJp4HzlFDQQKnWt5jesG7RMQdH3
+lY50kbcP0Oue/HEwmUIE0ILVRGlyryFNTt+Fah19HsSRpd/B4nWdWqs4LmCnBbODIkGeLWE4Av63Jwdgq/Lzrj4SwK8KqUIyGhBv9rFivbyy8vJ171gOcF+i
+6h93dlzMOw6g89HwCFzgLrJ2Y9VPOAXAGYs5slOC6pAVax1genXqgcoFH3rCN1GZwAKD1/nyaPS4o0W6RWxgQ8S99dSOboT2hgIaSDLJfJWG31JOIYiIIO1n
+g3Bgh1HpTG2fwO0IcuWbni/4B9YxV+raE+Jk/ONgMjjtxjW4HJ0OJtFJISQoB3Dwrj8dThNXRmvbhW9ASe1fhqPTRA6GWI7fhQIWYR9wK4R9gOc0CWAS5Oxj
+f4anpKvL7ycDrM0/HbwfnABkgC/PPRskUJm7tSoXujMaj54PR+8nw9GH4ehDDz8DUAaTgZwMp3+R/SlccfDb/37Zd1ALLVyA7r7BuOSn8WVPTj+OL88IEqL5
+oIBJH3D/hz8OLFz4ZABwC7MEGoBKTYCH+MuwnyAymWWSI6CF9++HZ8P+bDAVDLtAMAgBfNmB7E+nl+cD7up0ZhdqNADAg/7kE+OH4XpMBhf94UQguMNkQhgJ
+PdoN3dsJPsWAZFPYJbDahAcBsw7wW5HQlaOxxfLqmhGYuv7l7ON4Mvwfg1P5cTAZ0H4c/PVkcDETweb03enJ73pyNpicD0dW5fiudxSTwdq7tcHn70LmJAkt
+5TQioBvwqs4hCirYlF1ggCDWWOigMmU8fMU9tC5ZulyV6B+Ex7NCvDiUKcgxs4DgqkHHsgoS4Plx4rPwKXpVw5Dz1ZJ0S3HAbKXL3OEiN0i1rbK/ldr8wlHM
+26oRx8xUKPI1hhHmrOBsKnmlN4YnOPhAK/E86g6u0rG1dSjiXGuZZ7X161xhAYQmbCFlXephmhIHcvYdc75Adj2kmvrHOr3GZ4huvDpwdQveVImT3dzvI28O
+v4TBH9wEWa5DHnOKl3FlSG2kquTeBd5q2UoVNfDh5rm+tnFpSinH94OnnnWnwm0pUrZTUAlfUcNuYK44g2829/rVpvVZ6C+MiaKEUcyOlQvIpe1cxkSuV1A2
+wLuZtSHUN4IPCH+iVqWxBFAQ82UIo2zB58K1ROEyjAOtUMWgpvEOp/wNYgImWxL8IWVmHR0rtYmGp+RyDTHOnE1PvEkDpntOwbXWSClXqiLqHk5AYoCp7oSv
+5mxibgTy/sKQ0lLdUaPBlqb92jRitiSxub3X/BB6CBrT5iYqkSoYos0yRh/nSm3oRJSlsidIEQpYY6JSWt1gdtluRX1NowOczJfG0DjKYSegSLqk7tUm3iPB
+JpTbNmEkkENBXbMVssrKKNecJsZuzJUuM5MGpMsYtupxDcQmADm7UWVK/+XyypNQd9t9dC2OQnLv2ZWdZzeeKTs1XUe3PV37V61s11LfGsAVcVmv4J02C5fq
+wRKNEl65LCNNZGXyNCQET3EyblTKT+1IHw63KUj4F1bCkyjfKcftfo+OcCg8fwW5yQEfbNht4VJXJocotAt6A7G9T1CsdF1TsPaACYj4FPM1xrdr10j9ieFV
+J+eME0m3Kl87VaCV3/ywjcAJF8JDUajPGk5zbRCNdI2dkqmmY+Ty/pb4F1NK1wmapw3lCJYBAd93mL6Cr0H6FFXQBrpFo1PfUafgwud4MfRME6JjKfImkWEV
+3Ol+H1pKmlJDArwuK4vn4eISMH1ZGmhA7KvkUE7QUqD6+RUMBkA5FlZ1Cv7Sk9/3AnMlAsbtCdZQR2N5MpycXJ5PZ2ApTBEVzv2JvDQErOuBzhpIucLDmx0k
+AepZiJqbMHjbp/FlYm2ETiMgEQ64eGp/J8fvW/p3YtHbppfgOSFVH1tk5iE0pQC+2UPEzcb4xMVgMh2PHGCcR0YOoOM8RHITPU449Dg2DD72YbiI/7bLWHSo
+c/BdBpQTAIz80/DsLJE/jSd/kdPZ+OKi/2GQoL/yEhp93x+eXU7QDDzvn72/HDFaHHceMfzOzmgexcn4HCzLBsYdfAyA/CwGsZueT7woH/s/DuS7Afx1BCbj
+4FTcS+k07VnLu3N/ccuj8UyAZwt9sP6PMAWng/7sI+PXTcej/pkcjv58OfnUxN7zvX02DXaatXgHf50BNCC7z2CVz/o/gY/g4/AdGKH4uu8kFH6eD+SfLyfD
+6ekQ53IqTsfQUZjH8U/c6MnZ5RTHNGmM0G+NrbiCRCh23v/ELfpxz8aE0I3oiT+AEY3dO+nj+vR5mBWZzwDIPf40gPa2WNLPWvPtW4dvXfamPfn/eHUIjp36
+xgL7OEbMDotnVWr0OZPLl3yjpnSXvZOzZQ3OT4XVRxjDQAhN4BOQR4c9eT6cngzOzvqjwfhy2nRfRmk8hOeDRX9OpEJjc10WBLdEBW5LTDWUFhcl4HbIik5j
+E5U7ZmoJpGwbQYBTnSyPrZ2p7vnBZkhJ8CoQ++cgnGQjAh5yv5l0wIgGvjg5cVm9/Ge8Nn0LokltHEJBbCs/iwOztZFZ3VyGrGqFenJ1hx1+nxU5xrncxcYc
+iYs8m9fPzeJ5ru6oXp3cqegBgMItLCpVJRWJo4llM1RdgDerZN+tNN1dV5r1FM5S5sCRKq+yGtnVwL1ossIzMMsTXdSlyuXJjQKHL80FVkBp4UfArLLcDkwF
+gi+ka4fFjMmDq1yRf/yjzqus+JwltgGLmDMortGhnavieq2utaNN8Jgc0biySi7MukjlrclSLl3WkP8215SbbhH/MHZLrgn4DioFXJgA/75SuQeQ1f4DNkJN
+88deDnwZPxZ8ipmLefphHdEP1JNHwDwymF6MR07Cvx9PBHqq4Mz2wRNe32ldBJHPhv1ni0m56rRqJbpTySmadKlaqmu/O7bnWJt1bU/Jus7y7N+dlhqiDrTD
+kXgqbdgWoH/IevWdDzse03AIztznznOuEiHF/U/kjco1lYN6AcGOtIxylIuUMB3chk5hoSzGRVVnGGQieByLX2kWPl7ck4O/4o0l+1RPFULL4FDRm6NK7UQi
+K/IRPJ4MwPHkPrjsHSbRwQ9c20EVQQ4kE9vlTMotuQo+DXHaYlQPZKoOZUtcTQUzab3yVFYrrIrU7ZWP6RgDqq2enGodfWzBdUYOY9oeUpZvHq+CqY59HLPV
+cy5m60AZq4T4twf9QNTCgZn+x/8l/+tGq/K/BdsQLfSqF3JsTzw/UbBJ96uDt/KhH/0//rl/RuZOX/2u+J+Hx0cvX7fwP4+f+B9+u/WXWUgdj0DAx4eHhxSg
+BCh1OVHLSm/o7LS5vVwjq9LUSCRPOTDcoiX2w+RmDFc8Tw3enCFAQ3WjSu1BqYJ86LBRJX/+GRrO9aJ+9izQXSH7mDLyCu0wEkEQvy+19vLkPWgHihmrXL8D
+4J5Se9hiJihTSNOkc4S6hBtH3brMVbWJUItFQU1CDp7JLdMAKisuSw1ug4AonHK1PQdalLt/Mr74NBl++DijZH33TY4D09fgLU5ECon2K58ZJ+CbjsosWm3r
+Er5iukZOigp4CsCfqlP+Ftf/iuXGUQrAPUsQe7Z34Hvh57MFjvcuq24IPEj/osDuSOQmfFjJk9PnYHV6UEZ8v9moikbYTP3D2FGATwXriMnj/JKAl6L1RtSz
+woQFrZRmnQQlmnc3Rl6tN1VXWx5jMUgzR6IwZlrgJPJKZWnUN8pLQa5zTLex4BfbMlatY81/BevqKFUvJ9eqwA5ix9YVwZpmFdz7pAv43QIP8z/bu98sHMlX
+nDjP/Bz09tX6GixKU9ZYy+y/fKX596SFbcz6iZzzj/szWhdZ/Tvf/4ev37Tu/1dP+N+/yU+kMh8fHh4TA8Cfkcrmpx5kb33OQNKeZ/MbpXN50pOzO5PIfq5/
+0RlU1/xoSlPUlblNxMmNKvNMywtjct3Qx0GjoMYvbrI8W8GrJ6XKrpuEayEF8DNVPc+qZzG30k4e4CHIOY44UMZGwFZ/peUN3ON5RuLOOv1jE9knYnexB30j
+LoSg9EAE9D1BYWHleBEooTyrObSxkyRBPIgkwVICtEa3g1KA7Vj7d3QxCMseKe9Kw/nIbbIBcNrAM+1JoXo2SlQGvS9QjFCr2690g7DrwDoe+a1m/bUvTe4F
+hGRfv82F3+Yy2uaUDvKwjR7wPLAu8KV0DyJeG3kf3QPlsVPGk61J8SpeqZfmlsExuX94BijujR2NyV6fLs7/JD/jk5PZ84uzX1UD2H3/v3hx/KJt/x898f/8
+Jj/I/HHSn570Twdypuc3hcnN9aZBCO7JP173XieyvyqzXB4fHr0QYnwxGLkGGHWJHH4EIkAGf76J0QQiU4jTud2lsK1P9mYr9X1Q+q26P0LKdTTgTaNKCO+h
+wLvF8hXFz3Fd0nrONNDGJW4EqTpU+ote1Bg3g53y0lNr6KqOIg+uyJWe/EFEH+dkcFmZPOoh+0es8M+KWl+XQSwD5stPbijHHf+S4zxxEAPkP6HFcz2wYKxV
+T5zjgLVZ5dqjChL1gVlXDaJcXjgAtZSYVwZdsz0Soce6vimZaVHV3DuZFbCvyDVuCsvHC+utYmA0eEnQSz+w/2i9sh8nFfBPqWEKwFTPoTvZQt6w+4mRnAmm
+tjZBv/xus/2D0dMacycxo9JOFIJPw14i1C9SkhinWogLPCOy1CqNd9dclcBBlW88sLPGcrwrvTCwdOauyI1iZJusEl7BercJ/+qwKslNxFEfu+6QKksQpvRh
+Sp0KGS9xd0NoCKvk0bQnbCtcA/JcXGGYLapViw+ZaB4yqwWmhhIAObuUWoYl4EnjX1h9mTKfKrHtO81DCuDoOMH8HTsv8AVy6GFu11IVhY1mxWoSUBs5APVK
+iEvKQ60xCwyfXuogVq2bsXLjePXxjP+CgAtu0xLcMJgzlPg4V6usVnn27zrl4Vle0I4wOJ60SB0WS60g0oIMTL4WpBs3iKIee4g6XmHdWlee6ZCgvcQpSVYC
+Gt/EFbws6qTx/xlaLh5bsRsRl9sipx3mDNxtwfZn4P+rjeUFvS7Q1BJBT6XrqXduzSEE1VwZl4VhF8gxRRAIFPhAsdg9LlyLQFh2QHhbHzCxfbg7I/gOA0iw
+LRtm/fniv3BJbHZnGzjh8WsiOtdEfsGaiC1rYrtqEdmaJEPthRFdC4MZ0S3an6xoNdrEikkEZTESym4LYZdmNlqKFlkUnQyMrceQ/BEg/w7uKNHgjrLXetjR
+Jl1SaxtDt049kzLTY++95e4pLOhj2KGg1JjqMO9j17Glx17/ehTVTquzrluhJsjozRjkMVC4qSupanmUcM4H8AS8L//v/1+pYQ2L+trkiwwkzZvvjr87TOSH
+9UYVc7Mu60S+L+HaafPfvO2kvkkadDGmZG+2PdQRCw7+ImTCCeewJ5Hbpq2qdtLciIfS3EjCHN7Va9Hq9W7Gm+bK73POeqHvBD7rzn4ZAT1FjDN2Qzm8virm
+whFNLpxpqH7cKVQ4XXhnG3TWRqYa+JPNImEHXmlu8XM4DW1pGedvQW9N4eSc3eGqIg4RVeFTBdy6IWWzM0wChq/oM24XW3TAjlW/z0AR1u+C7psIuI1PVfvC
+aufUhI7Bjo4EJNWo0ayuIWOtauHh49zZOwXxB+kqxHaI9sV6eVCuhp+B13aNRTxkHESWw6xHdnZbXUzaSPJJFyZ0e1xNqVpJpzDaKiLZReyGZCWOiMXJ1Mdy
+sNCIRThiphaQfa8rY6oR//HdxqrlVn3YqaOHWkTzjAvrS6bx2eFRJbRPvnQq/KeGCh8CKIgt6nSY4oVjhcuVFPYa/baY3+YTliD/iv2ytkSw1VRUrPwJIoPs
+l5zOzYo+xYbt9tL7SNY5KGefXbWN2oBv6IjUAMRsVhLaYNRwIrfMsPDYd36eXbii4xQQoZlDifGA++J+TVK2Ncnw5rE5jgRAua38Cdc+UhTbEHcvY8A/t2U7
++TB275ekW9DhLkO9qg3JXkVEGPfyYGQl1CzRNiKwsQ5ejCCOfp9ZJCZW89XI7hpwuyYP0/hje1qLaKGiFIW3lM/gWmtgcDbVCxKENrbgktgkgWrqWpUbQd78
+yiLDoOtIl66tDhuJh6pWK63KTlOIBKzFsoDVobLVKfx7nWu5199zQpi6ZLthyrAXwjXSdXI/a72SGUKl4izYNhpdInR0mD4qo3X5zFHptmli8EPfbFzwB5r3
+LwV75z7HkEs/CGE9Gg86sfuq8mkevuqIMfQEg+Upzs5XlQXIPyCxTijQeFeH8M9Xbssz0SImHiWC8lrCZJY8DzB+kbjrIV7SAGaD4XGzIsTkiJl4m99oqlKi
+MaW8LjaGhw207b/E32SuLNEsSJzghETWlUg1mKT26QYMR7SYVMvooKrzAAWf4DTnqiw3jn46RFLt8pxEnaYDabEmxQOOstx2lBsnUUQn8d2eU5F3HES57SAS
+XtKmNefMYn2NVm4p53D/ZwhSSJ5Vf/sxpo4xAQAb7FwKTLOdLNr2bCX3w6h+ANdYG4uSQvPefvdANICwKgcPug39qoF9hVDwhBcsFKcJdCFgyd0IWA2WVNHQ
+F+h6fdWzZd58rwaAZ3TnEbh7ERcxhulnem6WsDM45b+N59K4MqNLMb5Bse3wErXdeviVafOnt3ChfzLrRHzJzbnl/D80nBPpNK97fnGC1P1wDRqf8cTs3d6Q
++75qkQfRiY3hBvLqmpD9gw4pNWjKsD2sHUecawGEsoyW3VbZ5J3FH4vn8U/b755EVArhPdIAuxkTFOu6xfP4RoZ7901P9n1yJFkMYhtULVYcNBQyZvPaAmIr
+wls6AEBFAgVdgHmRdOPYBj4NKk8JAkOugqJCu6Lznm/Ibn9NY/VGMOYZjHnvwD4Y4+XGfpAOrFzBWLmyjZVLtwD/GqN6rvgLZy6uiwmyMQnIvIUw6ZOhY8jc
+5lgkWpBfhocLjqqFLqjc6cbkRDrf7Yhv8t7fqHKJoZTtuLmCnMocKSVAAJ26sv9uhbbtHa9wiUWwxFsng3b5dz156i7LEJOX6lKmXblpHSeTC25UJbNqL+Sx
+g0vONcm66eesSHdTKzmE58wipTdMQGETdmFR5nWVSMgju1EF8/UuMo6jhRqbCx2XeFI5CoExJBirLuqs1LLMqs+ojNKu/59rhQuFJjrRA4aehGlEhlvfCGfc
+Qym8XWaIpaWoopDZvSRHrBW8cG08eIkFKr5O8cRodGmzrRVW8pew9kGRm8vzCxbAz38iuhbAvgKt5qbCd6+NScHkTtxvUlWrhNKfq9qsVupaJ8I5Vhcqy9ek
+IixVvlgXc4btjbB4SJYFqYCut6WA7+iKc/N3lngGHFkBrAUv4rpqKeAc8jzsyZmtfG24XhzULWsqrfrb/z97f5rcSJKtiYLvt65ChSLvBSlijuDg7jGVXCk4
+CbrjJp3gBciI9FdSUlcBKEjLMJjimhrIwJX+0YvoJXRLS22jaie9kpYz6GRm4ODhMWQm4kcmHYCp6Xj0DN/5Tpp4m9HfyNfOCYiU64EfyL2P/etxfy+Te4P+
+2QQqmVRKnqlakc8IvjjtT3rgWYW/Y0/rXib2zEqXM2Vnaq57M7MkIzT51FS3DYV4W99MlXZtzeWhfXRrW2wGJuyoF0B/JCNav+SECxsxLWVUmYTzPGpdaUu+
+NG/Apo7OtJgYofQaToH/9T8dno9ZvtCqt6gksG3F8e+OZQeSal0tOzKUA6YV2iAZApc/5GWqItyFS45AMHuOWQgHR0Goxr27YxwyZWvcMfUNtjwS3OET6rCj
+DwO0L1rKKbcIVgj1cjomN3XcIknOar7EEl81lPCG40spHesKd0+iw1LjyySWlwBqWsCKpnzklZ5rW1dm87jvhwOxHBIVKxQCrvwQa9xtErhmOw3+E08B50v0
+bCc3eT4v3DAUjcsCp8ubTH4LDR8dZbB0xzj6ozdtwrCMQD7KenKmiFAu7UqDQa7ZkrvucDsKDMMtahj2/tGbAwkpoXzjH73ueVrSBpnOtvMcM6y2KVXjPrQ5
+VZH2NDnbL+Y8FemMtXXwZ/CedkF0VFSDrfISPe3rC9lOo7lI6E4fk6hvevJjbme6KFSpzdoKcfSmdyTHmqiB43pZV+xcuk6V+qLwKWumhKguucQoFA+EGaVU
+t7qcQYVjVdVMTZ/Jv5kc69CV9brS6d1B9Q8WHAtS1poZkTcJl6NPnBTz7qEFhdr3zgdg48hupapNJh7udJx8DaD+6YZSHBzILSrY28MJOpbDcq5XupxDk2cR
+GHIboQb2JF+uVF51w30cVz3FetAjkgVwFeMtuZYw9sa6D/Hw5XUmELxeswvKc5UHJKepnH+G9VpWKclmU0TYbfNlXqhKOIUJ7IEsmQKMY+qaDLqs6TPs8Dhl
+4rFOeKPNDTE4LjrGQ/N/Iidg8Tk7Zrho4NbQ1bymmDn3tqzl39ZVbueUkyEXeTnn4LoLnbXlYwh2h2AfpxAmBBfk0Go0pNPFn2pHVDGXWyjJOcEF6MasYTNA
+B7oMPTOlWeYzOdWlXuSc4Y8Wu0cHeC+zOx2kLEDQtG2PF0WQXnkJfrZCYg/ZJIaGe3JweeYYdYHMFTmzxOno8mx4HfiyA5VsA5ycW6nkeaVLjHbP8v/9P+v/
+/T+hm47/E3bcqsgX+f/+nwhqdQQslb7NLWZFiDut5gxoaeJZ5MvwLJT1+Qg+Rr4UHzOsrYSMTpvXqMNhNScQgXUjIUjN55Q/lSrTQjwXDB6HgRLWjJQ0I7dN
+zozHX+BdmeCCkYFAo9cM8cp62ys9n4Z4jE9DMgSX/Sgqifa4Tqi6NUGUfqUwOb0Nz11bB8x1ldW61YhOpEb+JH5K/Mr9Ia9bUb4kEfx//c+kB3LSn2SQy3TU
+nfUv9667o4ZFIe3z2Eqav0D9WaR0JS3viUy8J1tvXvZgCRcCISssriCFsulx70vkm0RG00XkXkF5jx6W0ru0nvayoHsuYMBtm1JFJpQqnY7nJr2KeJJeZQ9q
+MpRzrFCUnN2Xn/h3e0Ls7Y78P8yRj5fniSMvGpX5a9MxqqgAGJO+bufOSZvDegVx3/7bJ6xCZOIyJP99W9eunwHQe6aAEo8KKPkSASWeElDyuQJKPC2g5EsE
+lPhzCagO+fRub5dm+jvkf16cvjruHf6WCaCP538ev3l72Mz/PPzmm9e7/M/fa/2RBk1Vszu+lP1pP+4dCkEhw/9LnobzPVrIG9AKPqoN3hfH7fz6nsSWR2WR
+l1qeulDIRT4FXwfSSMLdNSxnKNBFg5BNiKuLQX8ykONB/4yreYxObz4C5expfzw4v7m4+NST7z7Js9FPlxejPtD/AknrzQT+oEImZwOoRDOAoixfj8a+AaKU
+7Z8C1W//8hP9PliNch+eDqoI1Vjpvx8PsIQZfHk+An5ZfNCZoXK7GQr0fex/PmIgCI22ia+0L0FY0vSSJWoWwi8hKVLRnRyyW4j7nVFTznEXklcgb2BmisJV
+YBBeiepqjXoRZ89AjDwBtSDRVQOJIhCk4jAqrzw+rVKlXegqxqNwafkZgnZXlV5Ruq9z6iABE0xO1iyKt4+hFcKuO/cB/fMgI3bMiG+DAKThE3cvMgw9FJsm
+3HzplYHppnNevI6RIliSmXWUWjiDLrvnINonx40UxL5Mcl/2nOrFz6JrI00oodIEcSJMnEXiQtBxQWrXlovcg4cbv0+SQBrkq1rwYz67E2sKNpJeOooWureF
+tUGOMgyRJLuqt4da7LxR4DZuw1FnBAWukzoryboy+O8oMDTVhXno4VSfYuqBnmNJxT2OulqaxZBt5TxetxVFYGHNMfWuqm1jcuLEqxmG9foyeQkjPaFfaxCi
+BnGg7IJaQsx3VWgBB4ATX4jrIxSwdL+RIG4tORvnSXJ8tLtOemm94AufY3BN8B4pZb8n3+sSC8qO45K/rrSfsmlaZKVn5rbM/1MT/vMVwdQcfXWVfhoIaQQS
+vODcH2RcXW7bMjPXGn8K7mJYWotnERoQnh5lta6TGF70QitNGzjDyR3+KLDWGpeAykui5lWpDNyYdezRZzKcqlXOHPY1fuHkiWgUr8/ckZ5qiyuKv/YkgSAs
+I2TthooNlhtm4dkPW3u6pjlAdZvRZ51xV3zSHiQQAnipaPSdTPHGIB2Sf9MG0VFXtPXmsqBqjT5o1NogAcm1ZZf4wAvQ7rkioE4Itbog9+Oizs7Oh0eiGLML
+9XnM1AHbkE2hEtt/zgSjWyuuaTUzlk796m5juSSYu9Mql72DGYEtGJnjB//J2Y8BiPT1hbeZoG2Po/Gdi5HLQANeqI2bIoKxP+M8wUGCtASn5ISKelfj0ftx
+/2NS+68/eRWV9uskERb7aWm/qLDfQQ/1rjFoaxefMlJiPvb/MphA0YtQ1Q+rzI0HE6etYe269zd9+BqI+eXWN2SojLnKbxOs0YBc/1HjotG4bDT+RD0JmBp+
+W1yIcHQeVRckXm/oyvnw+hK66KpetCsNZlRH8Hux3z8g9ZKLTWBJQa5DyGUIB6gBj87d2lAxj4+js+E5FzHN4G4ZfXw3vITuQXELfLHsv38/HrzvXw+w4sUP
+8On+O3ofFX7whTWuxqN/HZxeUzGS6/5fBpekVYtoTzz+Xrn1vVAdWF6OBJTEuObaCaSs+0IkExkKh5z7miRUKCSUDIkqhQhfPmTwV6jd0IeSKWmBiKTyoavQ
+sR+tMxSOgJoZoYhDBkM9vRn7GZ/cvOMqhhKqh+CuobKIg8kPrrAIVC6EiiPXfayncjUenQ+vJzjb72ASYScML68H4/HNFczagfww+mnwI5Ri6d9AEUzYMiOq
+8UjVX6Baia82EUrBDC+jgi9QsOX0WkY/G7WKxchQwkPEBWIOZH88hOV1pSV/6n+SsOU50MalGJO1dwVN+mc/DifPLFoC8gWKNb6DN0AlS3gXH1IsS0I/FJEg
+kf33/eHl5DrdHT99GJ5+wCoftG+pWsmn0c24o6+ELXjXS5QY9oWdNWQj3SF4O2DCUUd2T1N8SgpauzrAiVyXM1WSNtywBHTvthfnxBxk2+le8cpyvBNgcoRr
+Qw5LETA3ZNUFFmFAJD6q3sedgmHGdDd4vfgC/EnskLBm3yNvgjP592cHRHoHNy+W8MKyVYjoBTNQq+rgUXeASNwBITdmFiN6t4ShGpz9wjvjEQRGmdLzBJFD
+/89olNSM9p6QDDHSUSjjUT+JI8c6ZvJ/2UH+Lz6X/N9HQlzoQriJ3RLCuKvr1fdff71aV0XPzIoZQCK/hj++rrj/X4/GVxdfh/wI8aurCjxR6/dlVQUSD7P4
+NVUFZMIs6jIE8JTdm2JdYtZVylXlohZLOCQhJRztBobtQzp6vB2I2HppEKsXMsRMme4aT0xktXaL9PDwENbIL08vLYbgAjePlyKQdBbambfbgk+PNOR5M139
+6K1hHKySE4mC0wP5RCe7yi/IRvWFZ5Z8kL09lO+nz5Lvl6Z8tfzyMh5LY6qfdSOaRWI+Il14iZgX4Q2wyT3vqstOTGWyJ+zA/q4oo1BRUhDu+VU+W5s1I0KY
+Bvb3lt/d4ntPpNDRJuGFM3R8HSYvHqOZTawcx+oSF/LDVhgP3HpF0x6juqbMdU76w1nn/ko9OPFuEyL+l+UiSeHHtquezJaNUc6FmwTi4Yjyett9/2yTNDZH
+tzin4jtAWSfToxPFkcTwiSBn13VHamXwRb2D5056pwTNxizpaA8f0NEQjlMqXmpA8vbhJrq9rfQtbHhy2OWEfm/4GUHwZxH3FnvuMMsmr++ET8e9xdxUuOWk
+rU0FVw/gw9fLnuxOjPCH19PRoL9Bpv2SoQo3PNTAxs+9uxdmxzuFRYxiT3kl4mzDhamm+Xyu2dVrNZEYxn0Q0IcoQy2enIR+q93xJqgjJJ3CobYGINpmXcyT
+NqdIn1apWR3IhBsNZ40E1sDx4YpvNHVX9Pl4b3baGh1Vt69e9/w6vQe3CmUzO98+HgtKf8QcS6s7PUlY1BUFX5BpfDC1Fb48KLkBk9BHbcJzuG8bD2P/t0dG
+EsKLJDgS05dsC5YIUAwzYnJmYqko+VbN1arOmF4qk5W+zyGmpMs7SrYM6FdmbhXsWcqeGWNxuahxSRKC4WLERvh6FB3zvY+2S+MJh3g96KCdERQ3o1Q+Op9+
+CzQzBx7PGhABtBv5yt8kCSdoInDsjqm0u7hH9rsSTGlj0EmIgWKR6nMgtpC4hJTJmIHOVcS3Dp5OXV6YbbSLSZnhzk669HqZFBZPqt37bIm0knFU777n1SrI
+fKPMhCWISL6841DmJmKn2Xe+a/wwSvU96Co9R4sblflOpo6Lx0MnZmtXvXlagZksuC56fZfDpOyfHB74AumYZ4+ik5L6F+6gBgutlXEjokrC8D6S86tK451D
+b473HlrglfaJOD7ZKi0o3Hwu2pRve3KE8oTjNRyTcaZTs7hPoMR36U/NgnTolvf5f2YRB1paabWGKwVR3lCjCpB7qtKFvge5Gz3ei9Ku4M214ZQ2llUYB5ha
+XC/oQBiHCxCImC2Ddw2Nsc3t6QKTAouWOhlqm3eK43lSVe3kTOABQI0rsc9x81CBV0jqr+qv9S/wf7LSt+uilZmeBJ7oxfjsGUSzayqaHSpoZjLisaMWOAmC
+VkeG2LVO28Y9J5Y4WaiOoGrkK5iT64C2aCgkiyqI0wQfmQ+R2B4qSlPAvzDQ4vYe3VgrXa00hpuIPcz1Vc/FY7F35+TyUSLqKMqTTPp6twhcYI4fzq7425oL
+RiSl15PY1jNEYpL6Q3w78A5O+4sQGXB8idNhLugiC1HySs1zTpN4DMOBxzv6wdwIqpw7g9ei8OR4c8MzFOuALGbZCo0oaOMV9QkcNocwMWVXFRtpVZ3bxaYl
+bTtr9aZ6TOh3Kku2mNFUmgvdJMITROaLUOnfXZwPqEZCS6SdJYpQXPkk94mCQeHcMP2QscSZUumZBkHK+ZVbKv4zozegD2gsM98HN0FTwzZno7eE7YwXlY11
+QeOY6iB4UdYtKpWXnGBfbGiBH4nQhXu00Koqab9H54JCuJ3SJtn5yjUIgySArioA1LxJbDr40lWjNssVTE6l5xQU9rNcG5c+gnsst3bNE4G4j6JgFQp1U1vr
+lWWafMrucmsDGwY7wos0F439gg0DeZ5jFJVkU8XujcabwovC/K+MZcJzCWdkXc74pJfNxiBzZrouCl3npZwaVTlQ8WN8hlVcbxarKd87Nd0Bf/PSMfwLU8XJ
+WkxowuWM3Ut4BaLyxKaKs/V8xnVUBjquSfxoGhU2yIxIU6qNCwfJVybyncAiSeuyUA+LdZHRPRBuRf8wKBRJ0ppw1xXdjbDli6g0gQdTdWaKOZ45ToRvp6Kx
+YCrUQ7NcbIy/arv6KUuW2HRbxxVepZZUR7iI89G8Vd/O0Xb71KXWwzwgRTY0VuoH31BucQdSnCK8mTQ+y1zjHnAScWqhS28uuNYx9JMdAZbLKdZ3sjS+/FXA
+2fCbPcyg+9bjXRfrZm0OgLRIfMJMEzyOPCOBeobzYkW8sFFd+Q6+fFa6KB/OlyRya0JBEsFuEqdthvele6gxz12ETo7vlBOE2yZlUpId/p7UTDg3usuNP9g3
+ZQ5LhF/iT/tLXeUzFXxM4JyoUDSsqryc5avCQbOooDv+A3fzH4j/PZtevDr6I/Hfbw/fvD1u1f852dX//Z3W/5SyZc5UrabKhkDcPmyNA3lVabUEXy5J3Md+
+mttQAiU6ob5MO8lS8yDWVlMleKYIxNK/kYcKbAYXKfUve7jLC028YJ71PLcCs8WhnblZyoXThWxPfqTKfvS0yy7yPldvW/pboMKULoofez7KIE7RzYRPWAic
+LnWSxG2JsQwJJOG3crAGWatKcVOi8YDKjs+aiWstYKdCR6k/llOrzqYXTt/SVsSdyGRtTE+eE0d3Fn6eW6rWqspkDdg2maGbgFfALBpzHNidme8RaN0t/LvS
+9bpicqXZTFuf+Cbcwz3h/7RypkoPIFBU+OdeVbmmu6ferLwkxEt+P0eqo0yq9TwHdQJuq6WqdZWrws3IupxTkhRPMa68mzcCdrOueNCaRIFXLd0CNr5y4RP8
+95lviMzSukW7H/9KhMhwsXGuZlNZ5wn3LBqwIrW5JWIHxi4mvmARvDb0KrSMkneTxu6ILa3GSy/eQusyB7W32AjcTDRLJm0FjcV2sx44bDWNcp4jMVxZC7fP
+WoObazur8ikcSVW7jtDc0kf+FW7kgpcszBoChqu5q+wB54mmBeoI0LkoVJUvcm2T1zjnBpxAUO6sbFdHCHvCxQL5A5nXVheLDLfn1MsDJJ2LmARJ++ZTR/Bf
+uU/+TQtX+b2qtT9OnlSKXGduO5VqSe/H38+8t+pr7IyMSJLijRjBB0RcQ6isbdjTHic/B8/93KOOg1FFXDCEDcfpd/KMKCfBmkwH7AtMoa0EWQo5Km6tQFUU
++hHiFf5HF4PbJBi/w2CWg2UfCNC4iDOy80v0ByrLAW37PdHB9A7jFAgMoXKlJEtxybnFILbLqvBHc0++kh+52EAs2/JSrkvPvwzzjMtJKGKhpEvQIDM8j1hV
+wiVCa7K0urj3jHzucOOSWKvhspyLvETDO2R9EC8zIP+RJs65i9iQImB5ayxoiYiI1Caf41qomNwkEsCQOHGvNzAFiFW/19U04woHN76KQCTqutqBbSvi30Xz
+tKWbDCX2Z1ejVQ49uEJSPlEbgsKZyln8DZPKN2Wq7l4hRuReAxmz8JFX55PEYBSHP0jIggSuQrm2UOWk1DWVjncRqhyZgeY8P+grma9nHPuG0ngag6zeg+zc
+jgF7FfVddPZdmtlsXVleIDzOsETXrQsm2q++KJ+D05P89PIhE/EW5Xr/3hXk7k63AdHQYZMwzGhcj188dt2xU2yq5UJRGgFKMUMl3610wpGvcRFd4zJc41AL
+DftIdzlWOYoObb9xBP0Y9l0YAuWgRJz8LZtuXH9P1flMmEoudX1n5vlMFbgZKUcn3NROd+GSLBreVpkyn0UzB4fFs6Nuq8Yf1Tjxc3SGLsX8PpJA/iP53duv
+v/t6cOom1qmHQGVV5AoDAaqcu69PwVOV4x1+dCQ/Iubw6Lvv3rrkFYr3RZcIc1CSBomViNh8htlez2Zaz/U86S2GMUNPAzAnZpjEvtypFRyc4XAo9/fsOqcq
+j7ndO2AVwSlk7ZmQ+1FXYHzK0jlC1znC7PRyqityKNiDTHRs+gHBCFzobKxfreu8yK2KXaYoXUl4TKjAE5JaxeWKTr2CFUVZQGw5pxWPJjDa52Uay4o1/gZp
+8tFh73WjbFf7QgqqiZxiEByhRm3t0w1fcFyutIVL+IfgvfubzgHsnSYnYRLIwNiail3msbA1i3TKZNeUsVvF9Qsr5QmulxoSjTK/VFS+xq+Uu3i6FwnfKBpv
+pMNd6ofkegtbIb7lNToJVckhnFqD81FVPHVOZBfF87YH4U+cMADcjwtJ0T1KGqvLnIFeObBvqni0DWFcWkvhhGAoB10epCic3bRMQHpiHTN8t20XemOquDua
+72G6nVG42XQntAWdaAo6aiLezcjQGNfHYwpenOepmW8YZ2MRSmoqWBaqj5iX/p/YdHznwhsUaUle61Yl3S2JhdhxtQi6Wg6agmz9nD3Y3Ali/z5XUkkGffsr
+4j/WutocOAmZ3NmPKVTRD5/UqHBSGH8D80Fbrza8jtbny6nS1RGhFcT0cuYGxSsOUXoCwcn46zeH/ycy3FZEqFg5ANrKPGgqjIvCmzk4I1vArYVwm82xJnHt
+LK+LsOmhKKduL5XXYTDuBNFVj5VFkloi2CmcAj674ukVc4NpBtVI10Q6xUqXNbI9g9FHcFa/sJRTahYkODDsgLiLvei9Yf/b8CHeFE5B+I+1IpCOqTxtNu63
+qKQZiDuQAw4cuSKYNVp4XqGJb75YnKpgpNiGMLOtGQGjUC3NOhBOPtWqEk9eB0Ls3bDS1jQz2IYsQdfiZNHcygAASJxvsKpN+eZYMPMyjRmxLI6vth/S27LN
+kJNUVMvwvfiHL0rOvJPph4x3w11CoRe9UgiUaCHTGhdqolr4wm7JT4SiNZkyISbjlZo1ZmVOaO7gj4H2cK+SCeBL2QVxzDJ+S6m6Zg0JI+8U3eBQbSJHTIC4
+z02haqYm6FR5O4snxJLONS1caNenfbhQXsxI4P0GtXEd1+1ue4zoXNtVXhMIhTotqct0UKUkz4B3EeblLYLsYuD8qsBLC1b2Pp9RgSDV4+J9h/In4tmPRkxO
+Nf7BUU9e4F3HdJhuepyLpRGBytmfIaVUYPxHt36UjZze+KXOb++mZl2FJfzBNTJtNJJc4niC8FC4n88whN7tB+5isHXL0RPiuHfMI+XlYEdQY4D4qY2skm6b
+IBO0BHl5+70QKmJPpwp5sUjomoBmey7xi58SvgaWNx3S/B2pi7iuT7xlp+s6YLlxOI1iTd6pFymn4bprGu6U0sR2gTjuvYYDKzkHPho3RP7IKW79UjRCCtAz
+1CR/1gSBx959ny46iAVUwezsTi9VVMw/KW6b2gtkbFa3qmzYUakhMlMlBRPi8+PfjEUmiNyrZntprn/R9gfvdJW6rCsyw826Xq1rae+0ru0PfsOd57qYMwG/
+WQg/w7Y2VcDyuVf+IMS019SIWx9QXB3xA/OOS69tRIpHjcgtFlHzpTMClBQboe916TMQqCZGabr2U9gynY0V1ghs0TfmX4/eRgJPzoMw9a04iKnV7PwBkZD6
+TbyvLmCuHBauyZXge86iZcb5WmBwsGFoU5PkMdESxZBEyyM19JGmTczQ76JRKaBuTRVX8IKgBqWysNGKgANtVoU67p24OY6c2z0USU9kdzQLJceV+rVTYU0l
+zIrN7eaZot3b/RbsCCMvaYXbUsdUjebcajzVZKiAETFpBKxDZHSAuEoY12uTmORtERyJ2LzWS099459qAHNjK4j1oCjCEEclYvLDKFxL85M14yiZi7RQ1AVV
+IdK9XWsOricemSmHRI/Mq5ZwqeJb9EDkSeXgbcPe5naV+YK2kSmFCu8yC/alNpyfWUMX82c32qXuSuSIUlgejrHS/UAeXQoXoalnHkppQdFVtc9kYJdyqQUH
+H4v41vesR4vOiCq9z5X4PXTzx2h3IU56R/KzC8x6aYLNoSSJ6sZ2ZdE0KuIxsh7kG+Yd4J1BNZ2dT++7gzi75sY2rj8XuJ2vq6TUf5Cc4lFPDp4fG4O/inyW
+x0UoI1aytbuS50YyTtgXVF/gHWoWUpdzre7NuurJa5pQB6Iz7PTOSxF7NBOFI0tADu5wOqV87krVYq0UvAjIEAkWW2kekLGo9LGVsPsXa1BCaDNch83Cm4H2
+UxLDJy3xV/h+E5Yyd4BiPfqUGZjgNx1em/DbWfrbDncN/Hae/ip4IU0V+SfjSjI2dSySLQsf4MyC+AtD46KrkUx0262r6zgZrlqk2NZnus11ygaW4a5bl3F9
+G8r+KnQ5p4rlDW+Nk7iuKJhIvTdbBimfM0ixfZDJGLeti5M+x5SzXFhTbfyhRoVZWxLI0GF1b/K5K2s2N+tpTbsQMscfVH7P2tD2hoZcwDFV5GmMkKDhxSRJ
+auwviykq8cbhypIQoOva0P5pvROmht7qwP8Ax1BgbjfkI6daWxYHvrZgoxcCvXuhKz7fzNnkrmBxIuvd8W07GEjX+ekLTBqe3y81afxWwQCQzhnDD5+aL5nO
+l/jV84UkcKjN/eiZKVpTdb2ln9unJ1SVSeKgpsqcZAZrpQ7VBX3zuZXKhec45F0wmFtYM0MsFz6l5su8xAIZVt43+h6m3GbyPlf0BD+eoeUifs209UCNOJHX
+8RzA5aaalzU9GpxpqGkQQMhgzb7EVYih5JZPOjGP85iE5tLU2k1hQ8EV0wCyeuW8FB7L8ymB7dxpObszTEVDIQsM3pVEeMpPW9Y8Up9DUKTikGQWlRYM4CYR
+gOZR7qJfebItPbO6lPJ17zDm/zXM//u6d9R1mbcLrmGT4aWYVhellGN5M8oR9UGFlOKiZfg55cllYlW1KuvoZ6KlNeLNs5CforRUah9etcxClSakmwjoA04a
+NQuZ15b2TQ+GfiwvKbsSkXXwtAvYMFKlFRvqvscy0bDrngwOobwqfSlruqPOjHSVZp8ALZALPQEhhlrAIg1rv+69Jkk+fLxy/j4IFBVX8cukek7rB3jv1xbg
+9BgNGmsm5xjOdVnni1xXcv9mPDxo26swcV0TGulIGGttwhO6HxORauL1Y+fVRV0ouD/+ovVK5mWtZnU7UzVV85Oi7u5vFBWVXuiKdKVEoM1xQ1GV9dJEyjuW
+Ra/jSuWhReh4nFWO1evnVAsur23sB0w2T+LZSxpzZDEU+ENMSTQvGCQ0EAYlgBQBin3eFbks4eWFMT9zXTA8Nid8bPAzknHsGtz3Vq7TpJlltxOZFUBgPBfR
+CGIJ9Lp3HLFe5JRP508qGHVKNCBfrblRrt0u70naK5+ThiTPsBZMwkq8yOUmicSvLd2L+sFm7BPT6PcgOBuFtPCWym1U9gxSjm0o3pX0gDPXsXmeUPmgrPAp
+wC1vYbYtZr1NKoktUokcLKrmvRtuzs57G607Su2j2SX1Jsh8iOmTRHaJmPQ72RQiJ98LNPBUjmpjYODCoQIZI9DAy8v+x0HkHcdLKPSRdjG0LOonEh0A6R43
+6nDRmO+JxVqi7VFiNbtFh39W3m1Wuiry8mdnMN2Mh82f9rgGbrMre+6lAWX/WHM4l03wGogZ/5D1ZDJO3uBBEPyaNICm8jJqMhJHN+PhVzYMni5/Xl7WJF7L
+yR28SoGMoH3Q32Lh+ap8yXl1dEhdd50wCx9vk1LmqW/0ByHyHANocLnLbSUrfRJjKe0qr/K6WV35B2mq+C3UKCgwqs4jUhZK4IUBxEX5u0ZKwzClbpQitRJu
+n9yUCCmW+3meH0QCKuXVSO57VUe9mD5m42ZPIgJiJ8tzUBgMfi71QwS9t1JtvXZbg4k0hB7euB1P0pWaCD9gGev6Kbzd7SFxYx3Swjz+YkpRT17g3VpouWxb
+zdwmO3ZOlzpt+z5se3y9CpXoQ1TpsWVyyy44PyGBrHVOUEvdkiquKxx2bKj18ByTC8HYzc1u4zQYRJ2s6ztT5ZYpI37ZUOFjcrzq6LgEw6arv3i6KHdlI+dG
+WkP2um9+Tq1/ZdnhJJLsVLhVV7WK+CKaHXfZuDC9EUSBcBjCZcG2Tvbr3huJ/NzEZxYW93HCMxgU+3DJZ88uWX/vkTr/1D5wwjomGHDjCZezaG6FRM6xNRRh
+8RrmawOLL2K8P82Lw2tTrDpp3dYuizp36ci5TXT3TnmQ+vRIrLR0DbJJbkL1tWcg8OQ2BF63OgM9psPekgEhbuS+75qjRYNvLDapvCFxw1CQbjESKLaYskiV
+adCeLYTaiBgtF+czhs4uVFFE1+Uj+xM391vZp5DqFtnStnpvrN4i4k3VmkPmwepcK3+9IfQA0aoJRaH1OOZur3D6JrZYYfbkUs3u8lJjRT/U+pAtItIZFB1e
+oujoap+ufkThgInl1K9GKCxArlnPbAS6nXJN6QoiICCbDzefiwGxqrg1VV7fLQ9ih3lcBya6WagwMFkh6xUzpWjvEJtFIJQUrUabaUu+0XX3N3Kf9pnqoXkf
+BkRzxt9Oewdel/N6uCB+y4BXVTHNR4idyJmxxHjl6C1TakgKUFUajxezZeaLlMfYByrxnOnaaajfhJKB2PBSK7uuWN+I5pY0zkeQA+hdgt1cG6YLkvtM+mzb
+/rrXvW8EzIkrtm8d1rHu7k07daBLDnlD7gXgFtYRmJNLOPDmdpeSzzjo1B5yyvoBHyfKKR2ITTwPiHuHPwQNfhQ2mgNIUFDMgF5IKu6VqlRR6HQnBP5sXgE/
+tVunVTwzSe4Z0yqSad1Xcm8ckLA+QeQgIAruSd1PJfI3r6XqUQoz39koGbnU0pZUtG3Xqz9pLfJYbqOjhy2bSjUsfAe9jU7HQjt7q/1zEsYE6MW9Fp0Z2nSy
+venE52462bXpxOdsOhlvOiCrlHs3MblZWFG+4nMav5bxzxJDQdWy0MrWKNRDdlpzccj/tkIzbUapDzBRym5bMjKbnjQn1IbLyz5f81Ml6t6wUanAuC7v88qU
+lIg01Xc5ejdWytoHU80JE8z6iy/qT0P1mQocy/Rst97yn5uXbosndsVvvClQd/o2KqllFp6RIi5pHPgjG+6egZrdEW0ReF9CLL4Zf0pkz1MCHTNQtsXRiW/X
+lFrqIsnlbcRFXdqQEanYUDFKJhVCEVFDJ7RHWbGd1LjSdmVKOg4IDEHmLIoGeVK8acwUmHMSQENMgGni5p4a0fEO8XsNtwVBwbr2fuidNYUuNq0OQpvLhM7W
+LLqb8lUGOHfHhWPYN0z9qdjLnlxiMDF0kYmnKpx1VzXzBwJeu1jk1dKHZdpoyTe9Q/nRVB7VLcSb3lHyiSuxoJ1WhmjwZfSLOBwTz7qByGjugjxzJ8rIrO+6
+z2rjSN9rI+tKK+TPxOVjwrfgIV9V+m/reT7TUZtfWXlnSphXKuq9Wtcd6ZJiritzqxBE4F8SbPIUo6Ccrxpj8djrdOjNYL0qChH/gjaf/5UPBTdDVnyygNBN
+25phXcJHhjjtqVAPTf5V+UTOKkf3FltG0HgCVl8102KTV4hG0CqaAkTzOoNVKmt1VfOZiV7YAkejCRKmj+vWYYg2mciuGZKNGRKfM0PeWG+vv9fy4z1QpVjo
+dLzYZ/HI5MJop7GHCzdFpV0tnrxKxk0oT4ePVpiV08pxCPW8Sw9UsC0Ko3DXgtni0I1bdrZwDtToQ+wpuX0gLy23HVB3z/aSvJqkzdveoTxXeSXnWhWINPNP
+kdVEWRGBiz2ma8GbHGClbxGU0GmP4W86Rb+pkjsQBhLleBQbgaeSrdpGJUH1kMT3PG994pZ6rI67gzq6IbbuUQokhwSaxGG/CPYFOqUR5xqIDYIHcOHYaGot
+Q5HbqKmGx0rkRbG2tbPc0WJC/8ktZZvkJLpnsgpFk8r5o8EEQa9AN5XVs3XlciNLjyKqSDvBozbPkRV4VZmZnq8rF8lItsgC/rWOrAEGNkAuUrFxdUmtjksF
+SYSZ8nQLog2NWIAXHczTjRWHHfu2d/zsjUZEf4t1IT33Gc8TShc/SzrN6ZSdOZ2Z1PeqWKPuzcmmigsLm0pQyil/kHnFxC/sw52qreFwuL+ZZz7g3qmv7Ef+
+iuTstbIUMmlD+pM87r0+QANIfJEcV5oP0ZXjekch/pfluj4jmEWi6RtIDKQCnTk7gkKZTiG+YSBUbNj5jDS+nP09uKeszO0eNuLtZtS4sf2Nw7z+nJfzZums
+zFF+0navKM1z6pCPOpOzta3NMkNebKuhLX9UjKMRX1t1q0O4xvPS4YmZ87i4pDRXs+bXiocwCaZqovTzumCgfXygENmwrtTMozILXesSRkNua5AfaJpWUk35
+z4XUVWUqm8lFXsNvaSPHEBfe0ZB4XM3uVFmzpYaAKq8KdpH1pTddBPTkLUdjlWGsmbAcvwg/dNq4z7z4ZNa0Wb7tHcq0cmvhKrcK8W2a9QBz7L91PlpPgsoo
+/zkxuxLPxnQDogQ1BBGDNeGRInde7YwZWx3kjluyBAgMb4RZLYy1MGdzBRkb4STB/rzTpb53bOjzyFacbvxt17YeAuo02DjTjXsUrtjMNR+41j07/UIhc1np
+iV1FUuTOoI5VGpd8lqxdGFlCViJTshJRG8Lj4c5XBUpCtEXgb88xX+NXq3WZ15gzgteGXq4KVW14tqxwHnmYRfJk3utyrSlBCd5S57N8haJvVZlF7lKRCmNr
+OV3bHHY3DUWEobi4GTQm88bw75SVU61LTwHH80PaLm00V5KDu9mDnXcsh/EMdW00t7tyzjz1RaSZ94lKuYNZKhZ5qUq8oHHodZJxQs+HdQXPjp7LUt8W+S0d
+8e3rS8fou95hs/bKp6haSHvXCfFd7wjBJITZbICJn5NclFYREVEBlnYOfL7EVJjau5Figc5QJRYLyHy/zQ/nKD0obz7iRN/m8RHPcuJ3wrpsC9clniR3w1Ad
+zGMXjUDOZOXOuZ/HFNRu9uaxY0+TviZz6ynKu4ociNidl4bqvTcveMi/bYOb+EsrjzJxnMlvMvltJr/DRTo6pI67Uisu4rKlOlAPNtaxi3c691Reum2W7K/H
+s9U8n5+fm449jUjJ73on8oaK2ETzmI77u95RIyEwD/4d2nrI9trKUgu67Jbseng/ZILmZQKmcD4fV3dCKy4e0zUT6UkTXRPDNaBwEzzCVcGo7Mp1Z04W05Vj
+nvdVqCItNaRcxSu7LuucIp9vD2sQjhtibhfIvuHmh0ZDrpEr1w5o12XHo7LjUVd+yGshMS60NOzxaiiGFFqepa/MF/IZj7qJ91wYXFqNSQAWeWVr71RuCBmW
+VGYRnm6Xx3JoJ/dCusI+uepKybu5MFBt5MkhFVaiecKlxteuvMT30EAnBJ9cQb8L/UHURX7rYiZTLZRMi2fyQCMh0hIeuN3fADAZxI+tFSa6BQRjtiWdqivl
+RGxNOXGvDwHIjswT2ZF5ksQjoRQlvKzJK/P06+BdK/BAdDEkJU15qQ7TMa/UQwvsH/lL/XvuVO01E4dZjuFJU521YGUNqemE/kHWKq5CnXqyuoU7eUFmwgVH
+UoMWk5SLo8PeoXyvSw3uOHF02DsCoZZWwWiegtxSYQwciyvsIdOqHsSkqWpCNkTOADwiXAlE+mhGXAcklPeYa3eixfOUFhg+iB1fHaQ9CtGqiUDdx2epL/Nt
+TlSXvsHaYQ8n7Fh2FYxg5EybCiKuBxFTCPkwdBqdQDh2B1HGsHYYa7JPtaqKXDP7mTu54J50HbAUNSQj01WKfozDiEZ3El/3z73qfQqR06pLNInngkSEv0C2
+puW4Unkq99nkQZVtaMjgm5anPl0M1iVdEajIY92x4Coi7iqN6mE4v7h93DEep2OGV9DuxJDYmmShmGq/nzznOC6MquCCzuuY8jCVB12cI9C1Th7Ex6IQEQtQ
+ACTQK1H72o5udUnwredwlFGGW3KmYLkqbMrIpdb1Y4yxf7L6D+e/efmPJ+p/HH7z+uh1o/7H4dtvDnf1P36P/ybDCzm6GlzK89HltbwYng4uJwMhfuTUCKBh
+fyWPIdfxnlKBjw8P3whxNR70P767GBAE8NaowosPTFc5N2UdZc2cXxzg+UGNB6rQYcUtx9ThSs66cogzwxQ691ouoKFVZUApxJoX0q5XWFuKQNMlo3Chs3qx
+MOwuVzM118t8hmEq8H6uc1vnM8dtgD49UjGMs02lKqVZYQlltdRIP+plHrzHCmbEwGoldHHmS/ZrMHOBo9XE+4VhH1w45fyC3I02Tu+YU8usUawxM9jW6zk6
+eD1XPWmxEXRRcN0U9DqBpmgJHe80YmsKJ9mZq97lVpWteLxochr66gjTdTkvoCOw8PM5/JX0gnMoirljAd0Iaxb1A9c2jYA8fqkcz1ebSBEeohpdc4oX0MSk
+5YBt5pyTWUR7wMr3PArPMX3PZgV3jQiY/bPB+fByeD0cXU6E2MNNOuEu71E+pi8/7RzfvKtjeL6U8hWNB8un8wdzVavkg6jIPH8yXefFXNpZla9q/1icYYp0
+qZR4TUfoUi2TniFlWtxtnFKprLRal7DmFF9Cvi8/ETTtypK7k/aUq1cWbtpQ1QspT92VzaKg2YuUqz3tE+gwpiSeMiviLcNqTCDB+2CKuQZ1Zu+j2+4dL4Sh
+RFsG597vNYelgSsbCx5mAmteu5hHqHVX3sr/3//z/+UOKzOOEIjq1au45m00AMZwNqcDuWRxw3L1U2TCITc3iCdnniUTI3xaVASZg7H3MdLfHrHNb0vY7Bpe
+o+Ev5h5bwt+ePxTLohFWlxacQWrgUZy5Gm9BvU/6BLb3YPxxOJkMR5fy/5Kno8szd0KuQjZMbpMC5yAMUnR15nrN76Z8U4oowhZzFn/y8oyzbEjqbYihFQM6
+t5rlTigLFQsfxh/ookjFZFThI60v0XhtUs9eC3+6IyvreyGODuQlx986Dp6zgokYICLaCrsnw0xk3jrCVLK5ybFuFN0qLLOpRA1QsR34J2XXk50jc6152Z3e
+Gy2J7U9R1hDZaHTAargMBxsldAbl3OVFO2PdiVpmZ+CbhLXxuQtmkkVezl+pwpSUnCpQcmbybr1U5Sufn3GnFdh1fFSxB1HNTk7nCD9f6lqBHBZIiWUd7LTG
+VNVKTvMSIjf4qvTyNFZLfob7rJXNiw3mZlOFIHg5CFegHDmQl6a1ItsXxFWDasv2fah50CGPMufHcKxgvvLhKjmTEX0H0Vw15CpZhHFh2Cghi6q1+vyPhHJT
+tA4MRbn8Vb7U5ZovH4/HwS+oOtSK0z1gSl1VB0U8AXNiGWc/Ve6grXShVmYZFKfXB6i2lDRRIcCRDtJFNEiIRj9MZW8orDoN3YBKt6YGWVPOTcXRhvm9ruqc
+PdvNVc5cRUqMiQHdWaHnt9rXanJOtcc67JJqfI8FQ6Q5PPLIoveEeEOz0lggL/fQH+X+lcnOzcVpN8nVvA7ZdEXMmRJFCZOfp8pWpGRdw3Vy2Yc7hIuK8ndU
+XV9bWYLnDtqGaBhm2Yar10mYpNY2vH+JCTpnw8npRX/4cTAW4vrDgMyWyej8+qf+eCCHE3k1Hv04PBucyb3+RA4ne5n8aXj9YXRzLX/qj8f9y+tPcnQu+5ef
+5F+Gl2eZHPz1ajyYTMRoLIcfry6Gg7NMDi9PL27Ohpfv5buba3k5AsPo4/B6cCavR/goNzUcTKCxj4Px6Yf+5XX/3fBieP0pE+fD68vBZCLPR2PZl1f98fXw
+9OaiP5ZXN+Or0WQg+5dn8nJ0Obw8Hw8v3w8+Di6voaHT0dWn8fD9h+tMXvWvB5fXmbge988GH/vjv2RyNJaj6w+DscSf9OTwUl6O5OBHeHjyoX9xIWFCfBvy
+w+jibDCW7wbyYtgHWw27c/lJ4gRm8qz/sf9+MAntXgzdCKIZgAfeDy4H4/5FJidXg9Nh/yITw8uz4Xhweo2/HJ4NLq/h69EY1IfJ4N9uBpfXw/6Fe0Umf/ow
+wFcML2X/UvZPYXPQiC+vx/1TGOhofO278tNwMshkfzycQBfOx6OPmYQlHJ3jGG8mA/jp8JL7C8sCn7kNIfyGGI3xaR7g2aB/Mbx8P4FutDZPT/z5rPPfy/9z
+9Af6f44OTw7b/p+3Rzv/z+/xX7ib9mcH8r/MVa3tv2TyvzTvrH+R+//lZnzx/9BLlRf/cpAJSrRoazTyv7Q/+xfWQ1LNKMLIiRAPQodU04WUyeCQOuo1bpXc
+kq7PJQUzV2eHqqAmKWpSyfP+v0lVfy/v6nr1/ddfszHes3nRM9Xt16PzC/GkT+wIfGJv5bmeVmtQKI8PD7/5zX1iRaGm5g/wipGdB59u8Y6xq2bnHWt5x742
+VTC3RGxuNawthZxjfGzYW8O9dQCqZkd6wvf513vJhFm4SW+xVyBE0C8ERRjjwEnRQD+LUDi/3Hj3lidOCZWYqE1S3vMqHsDzPXX1nS+IS1adH+YWTxMo2x2d
+xwBrAcHEDRZypXwkMHpYdFFGAJqxzrFHFqtInHq0FLFLr/ekTw+midY8cs9ZtrjabrpAb7JvscDSyFXI+T08dfzOFzjr2u45GbvnxLPcc/D5Nv8cSVieBPFr
+/HNy55/7J/TP+a3zYv+ce/JL+ueSO+OzXXQR8/yXcNEJ9KB9CRedcC46+atddCJx0cnf0EUnPscZJ2emogxhlHtekIqtrjkkeEgIk0BPyJc4IS58RjXIEcxC
+wBI36Oc7zPCrvzOfGXz1p3SbEeApYiJs+c8cEKzLgRYF6X6l/iXaBWue0L+a18zOb7fz2+38dv9k/43eX08uflPv35P+v+Ojtyct/9/rnf/vd/nv2nmn3ldm
+vZLX2tZygiBN56m6qrRaguaGDofA2JVUvZS5JUeWi+ZFFwHdVRwClFdq9rO61cLX/lrhfYvWrgcLtSJmcOmRiovkAVYvpwWnUQlV1c6NRWRCHjYc+dB8ihy9
+HnmgCy1v83t3HaIOJdKfpSj/NTsKwi2O3/OImNTKVPqVqV6BvigoFxfVN2XvqEhZsW7kDiBxQMRxlzDIwLWsLVqruZXaWkpK5Fx+s9JVMkiK1+Ea8ex46K19
+hSXnBVPoQcvAZzFdb3Rlv5dqrv9jDYs3M6Wr4yNrfnWh1Zy1DyJWhDfcVsoT8Vmuk+uKsVHi9r229dIXcydsL3M4eD8YPqfNqtDcIf8Njwt719Ups5CrQtUL
+4wDyy3w+L/QD4XS1AtYG6q/lHWlrgp5hu45c3u0s+DfW6XjlWo277JFtrpdr+A77NixfzfWqvvMdy0vQtay2nIutKyYwjwpZ/az1yorm0jknLk4pTNrsTs9+
+7sn+1TCZgtzKu/z2rtgwax0RJ5bz0C1yLUAat8zrwLLIWTIho96xgeBPe0L0ye+USZuXRJ7DNTamupzdgWcsIspbxJ3K5IOWC60LT6l4W3EeKkyMrI0pLPTc
+LHzBjhlVfPTQ6RX7QNMmXCqE/iWnCY6nYmnmOoCs8U3u7DpHNmYvROyhIDO82n+fWOu59U/Dbl83SlBj8/jGLNW9WV23QYJ1PAMrsIrqqnAS3YLo0qZr2Dkx
+Bbg/ufg4NTSjHM4VpRsVupZrizXXhDWwADNVwiKwqT9Vs5+JjDvuUsbYRmtkpZzJ5ikAmMTHrnXM4x5Z9FMzx3LwUa02EoOjyfCvGErI61pr9BOe6UVeenfN
+HkvKxx2UZPo/wxVJPqbIbeyJz7sadPaPKxcFDgdwBsXi9incI7lkg8BfyLz2VbQxITsYkKYSPk3bfYpycDYz1TzOdYU/7J2220xf6FXzsz2Z4xnH3PzcosU/
+D7TMEXuJ/4f1qaC8xX0F8dzCRnTFMb7CfL68pBy0qVnXrm46NJfkquEmuwqtjcMt5h5ZaM19VTV2FvNGFZQ6tnW+8CkxU2VJNmBOtQAJmMn52gtg9mLajNIY
+zYJvDbhmTHEflVQyZU/ux5k4YqqTPDT35tyn/rR2lvQVIf0WhdqvVImUYmUbqWpZQJ+IsRqjBlxHi+WtIi1koXXvAIIYFJbqu4jkHpfEw0dKA7/DWCYOdO4J
+mPJaL9nrCFIHNq+gusKsQi20tn4aZF7KO1XOC6fWwOOYNkWUoeGNbTplfBOxTYXjJ/M6ytZDKr9It8PAmk8lxcTlo0BWx5xIc1CztFQPagPydqrqfNlw/7qQ
+Cly7/JGHTf7YKNPgjp9LtY9caE0XKWw2t4k0kq+ZRSILZdNPyiS/oc7L3NO8WKyP6wdHbhYQ24v8FxTKcKc5Wo38F26KnDuJUkcyCwOVXIqFOLTlmUHnjqlC
+kZaWMJB9PwGRWGEWCqzqTE474uFCDbu0+RwL7HYBmbGCmh9UYNDDxjeUtJuUfIrVXccT6WZd+FnPS6J3g6+WealL74jOS/ZcE9ofxTMaDrBj78yD5x0RKCr4
+V9gw/JTOeccym0Cl2gTsV5qBihskp3EXq9S/6Nmaqphjq3DF4g0rrfHFWzxbDKgdRT6rCfnwdCOu1kygMp+GuC8OQ4Qgu6+8u1TlGhkdbpn1EuZqS6+T9wlW
+5CiI6Qwzi1Oa1wy4tGFndWyE12EjNGwcX/K6YxcwKePMzDUzpHD3uIzpy9YK7sflijIwnr1ckRM48bqL5iAfW63IFtz2WpG+NvkZ7pUsiSS7RXDNRmzrIi/j
+pbaYVA2X1L0qdFlDoWdzS+Q4OLi8pApejuCTqm7VRt7qWmxZzjdhOelSScnUoyvas4S108LjG77ZWrnBh+mcGsNFD5wV03w85jAVvkPu7emuoiBbqNu16dqW
+4QGYTXV7W+lbBNF4fIncZwLITVRL+SDs5oT7BC/zSnQ/EszSeIY6tzZVZ+F4iEjGxSTOaIHDS1G6mocS2eTQ8R/jCIp8WoVgGNt2cDnJvMRCcEaSG4CMcqp9
+v1rX4V7ZemxdN2O2m2aFiKiSePo0qUcAtsJ4PuihS1gmeYv59KRl62WAD7vwaZjQYpOJ6Fu/dvNAABMplt/0JutpZUABiydiitcTT1X5M/klTDrQJIIVrkIR
+GHOSN33b8wG0rejtCBDtQmJRJIyjY97P4i57J3hzG/aS02Cc8cXMGZ3hq+968voDxEn6p3/pv+8MmWB4wkVNwP/PMRIZYiRRBCQTPlgQYi0YL8EQUIae9vZj
+ceBkyG78/uXZk7ETmthBOd+56/9M/40uzvpXvzEC+Cn//8lJ0/9/dHT4euf//z39/7ANnBni6dQixGsmj9/I/voWdNmj7777NhKL8M9MJg2dm3U5J5eK7BcF
+UzaIcVIw+XvZhPE2RKWWe33n2/dlD1XiGwp1y650VcgrRl+BWnOhyts16h1DV7dXqiooYbV7jUHCXtH5vt5eE9e7C4D83gGQnRNx50TcORF3TsSdE3HnRPwd
+nYijy0EX+Yc64IJJOKh0SfKyeyGiCYHNDMaxpIMs/EEOKdaIUKdIp1X5vPGO2kChxVLXzJsffEaCCmrBrYFddIc3fd7gJa3+Bk9Xszs4UzavtX/7el3qurde
+90pdI5M2QPd5+N2qSW18ZsT2Oek+iDoS/BL/mx54KK7fmfwbkqWMF+YtRXVLsb2KS2P+p7uFZwfyOY7fx1y9MnH1iuixp5278nHnrnjSuft8f+42ByBN6fyA
+th0JkcR/hQTnt8yT5zWJDt3hj/AMbz+AURdUa9zumaanN/GmBfeqaLtX93nPRqslU+9s0wPbucWhWsWBDP7sFu2mn/AWjwXfZOkZoZ62jxht9Wf6zbd5ytsH
+NNnsz/CNd3nDxTO94fLZ3nDxpDdc/uaHYedX3/nV/0H86vJU2s91rUekl0Jz/nLcGDx8r6qcpAPLsoK9MnIOVr2zy6Mdt8VhL7sc9jRhigmog7wg+CGsGK4p
+Foa9rdTqTr5NLh1t0x672x/VxbS7eSlYUaU9SQXnsL4B24ALxczilb6tNNeQ15ZM5qSxXZxhF2f4s/n/j/9Q///blv//aMf/+mfy/x9n8khO9KomEtgXRwAE
+kzZ/gQiASCMA8jkRgL5NtE2QrIgmpgJUriSV74i9Q+mOLj8FUN1dCGAXAtiFAHYhgF0IYBcC2IUAdiGAXQhgFwLYhQB2IYBdCGAXAtiFAHYhgF0IYBcC2IUA
+diGAv1///8kf5/8/OXrzpu3/3/H//Jn8/yeZPPpG/ivoZ9UG/P3fNdz/r+Cz7TEAEbIA/sAYgM1vS1SayzrJxaRYgNzFAnaxgF0sYBcL2MUCdrGAXSxgFwvY
+xQJ2sYBdLGAXC9jFAnaxgF0sYBcL2MUCvlwsYIvv/0V8PU/6/uUjvn/xHN//VN+p+9xUDdEb6am4JcXnhAIS0iFfi+SRKW8tWTzlcp0ORvyq6U5CLeIqItR9
+0Y5uLZhwJTzii8KfrOkGhwrCEk9sErxI+71UG+GCIq0CcF1W3KOHhGMef85ozNHhLhyz+++3jv+8/gPjP8fftPmfjk528Z8/UfzndSaPvt3Ff3bxn138Zxf/
+2cV/dvGfXfxnF//ZxX928Z9d/GcX/9nFf3bxn138Zxf/2cV/dvGfXfxnF//ZGv/picGvj/u48Iv49XEfaY2E0wXuxKTY/rr0np60R7mN+OPrO1Um3jGWp7to
+0i6a9PcV/znuHf5h8Z/Dk7ffdOT/vNnFf/408Z/j3mEmv5H/ui51R+xne9gnk2M9fzBmLk7zepPJU1XkC1OVucrkzaTfk11RISHGOlXEyznebmbRkHtNrxob
+FPhqub/n7tS9g4zMBFN5URlbTBmqEFQmGNpJtc3E3ozclAIeWur6e/ROpl1GRZCNNrR20S1QaYjYRLcjRofIvoChsLOwJ5qNBacCRHQUxlUS95kbOTkTm4/n
+pZzmJd5r4ArlvvDti+NTU3OvW27LTHCNY6qbHYYuHYojzEvwaDoPT7IacFd/7TxfcqlqXeWqsGGqvX0Vd53ciP4i3XM7bI/G8OTlKZuXpwiX56R5eW67M939
+3RnVPO98xNdnxuWa1XLhH/mvZqXLYq5WPVPdkiPlavsN7zsZ6Qqgcel5PBmlQWVCRB+p1UortxR5xd6slw5VREP1H8rcon17m9uaHMCVmmusDP7YTIGVfLbW
+EDKb53UUVQU3fulCIkESVQZ9Rft3db36/uuvHx4eevHUfQ3VpVGPmYzOr3/qj1NF5t0n1DpGV4NL6sno5vIM1RHUbU5Hl9fj4bub69F4Iv/93/sTMZx89RV+
+Fak7g7MtCo+MFJ53N9fycsQKz+BMXo8y8bTC0w8Kj3xK4REwuLPh5PSiP/w4OOvJ4aW8HMnBj4PLazn50L+42DpW6P71JBmueDeQF8P+u4sBvfDykzwbjgen
+1zCq8Nfp8Gxwed2/yOTkanA6hD8Gfx18vLrojz9l0O7p6HIy+LebweX1sH8hzvof++8HE7n/+MzAAp3ejAcfoeujczm5eTe5Hl7fXA/k+9HoDBRMMRmMfxye
+DiY/yIvRBCftZjLI5Fn/uo8vvhqPzofXkx/g73c3kyHO3fDyejAe31zBqA/kh9FPgx8HY3Hav4FFhEnGhcdNMRp/gkYvhrwGmfzpw+D6w2AM84oz1YcpmFyP
+h6fX0c/EaCyvR+PraIzycvD+Yvh+cHk6gN6MoJWfhpPBgeyPhxP4wZBe+1P/kwSteHQOPRA3kwH9GW3fDBdUDs9l/+zHIe49/DGUVZ84/Rin7PSDpOnuiS+q
+//12FeCe1v+Omvrf8Zsd/+ufSv/DCnBH8kzPPAPsTg3cqYE7NfCfWg3c6X473W+n+/296n6x/vfH1f89fH385vWO///Prf+B9vedPNfTCgHgx4eHhw3t7xV8
+tl0FFKwCyp0KuFMBdyrgTgX89SogQzk63seA4/vcMn7FJXPQhALYG96YL3VPDtTsTuBv4cGcsgTy8nYN+HmMTSsAGOO35Rps34CwcdBEvzoYAxdUa9ws0nf7
+d5gqipX73wKewK6nVv/HWpe1e8r3bKfy7lTencq7++9L/+f0/+M/Uv9/+2aX//nn1v+x/tdHyBBA3X+nme80878XzfzvR7Peackv1ZLhO35ypyDvFOR/dgVZ
+fJZp/iI/nnzcj9fN83AVxF9t6FpMeQPmHTm8okWocFupsga/4E5t/w30/z8M//H65PC4hf94fbzT//9M+n/vaGcB7CyAnQWwswD+XBaA+7Uj4dhZAztrYGcN
+fFlrYGcM/DPo/8d/nP5/8k0b/73z//+59P/jTB5/K/91XWx2+j/q/y9X4oHdK595UpBm8yJqPpNbtPrsOWq9SCf5c9V6KeVJe4w4qGDixLK609Z5/Sc3Dt78
+/RgHb7+8cfDNzjjYGQc742BnHOyMg39q/f/kD8T/nJx04H92/v8/k/5/stP+d9r/Tvvfaf877X+n/e+0/532v9P+/7H0/9d/oP5/dNyu/3T4dqf//4n0/9eZ
+/DaQv+xMgJ0J8FkmAM2gv258qRa1ru9MRd+GmblDolzrjQVf8TEvPfM3lxAKJTVq460HKi4DtTTWVvtfyblWUMlG5GVDyW/y6GZBRUeFPSHSlVJ+FlZnB8jZ
+ad07rXunde+07t1/fyL9/80fmf/bxv8fHe74v/9M+v+bTB4B/B/d/0c73X+n++90/6d1fzc0Hql72c4E2JkA3WPNWgYAKbIfBrJ/c/1hNN6fHKDu+tPlgP4m
+rdNP6TPMBfFSc0E+Yi6Il5kL8jFzQbzIXJCPmAvixeaCfNRcEC8wF+Sj5oL4A8yFo525sPvvKf3/7R/p/z88auv/r3f6/59I/3+byaPXVABoZwDsDIB/dgPg
+zU5936nvO/V9p77v1PedCv0Pof9/80f6/0/a+v/R0U7//xPp/99A/c+JXtUeAPRPbwNk/+g2AL7oS9kBX0hdDsrp56jLsb7PejDowOLL6Mt0T34RfbkFAwGd
+WX4pnVmAtvdldGbBOrPc6cw7nfkL6MzBV/Ai54Dsdg6Iz3MOyG3OAfEM54C8zutCO92auttqz96pohCqlqooULpZWekliFJ4U3ugLWvi5fV3/3jDYmdX/In1
+/2//SP3/+Ju2/v/NTv//E+n/32by6BvZX9+CwD0+PDz5h9D+89Jp/7GyvtP+d9r/Tvvfaf877X+n/f/jav8nO+1/99//8X+MPl78hpr/s/T/k7b///Dkmx3+
+53fS/3Mrz5WtT98PnYKKqlmRTytQZFlBhsNspsgogp7yfZA1kfIOPxB5bZv6Pf7sLP4MfltFGjApbyCIoLzAz7rO5LCcif296KO9AwJcBJ2X1DHo8EbWBiXp
+Ii+0jU0Np9eKSWyLJJ2R67LQ1kr9C4w8r4tN0KbxPsnLeX6fz9eK22dZzP1ie8TKjVlDN9ZWZziyjGyXTRZJQFLdnZYJsi/tGExV2rmFqVDdXK2rlYGmU6NH
+/0IqcJhLweYDzjBZMnwtFoWTu6r0NlNu2d6QuZV5OSvW8yj8K+C5cpNYBbYnL42//tRtpdF2ydywMmkqWZmNKuqNXGhsuNL/sc4rIO/h4SSXe/6fGg1H25Mf
+I3PPEoY+vjZbiydAt5+2thIx7sSqQ6k1Wla8fyJUi99KYq7trMqnei7vdKV7criQyxf2R96pey1L/SAajWf4xlI/0D9JcYGOF1pVxQY32Qy3rEH7TS7yytZy
+pW41WCcaTB/YffIB+ga/2NDWh914NbiUH/vjvwyu5cf+XwYT1LzJPOgwDj7Jn4bXH+R4AIrwtbweJeq0073PRqc3oNU6Hd2riPAwqH6oBTuL5VNovG1AQJNP
+IW66DIZ4XF7JF9cj+Wl0I1nVv/4wHJ9he5+C+s+6u9NZz8ejj/D78eCifw0f4JCHE+HGLJ8Yc9Yx6AxfFSwMtiq2WxJgCQw/DmHM/FE8qxejybWzBGC+8N+U
+QuvSTOPp+NCfyHeDweUzck9dqm8vWWa0IZLxogXn7dM9NDf3evFbBbz1chRMiNTAAFsi25Zh6xeDrIaGZdw1/zsN8Hf6b3R18eqod/ib6oCP63+vvzlu8b8c
+frPDf/9e6z+4lFc37y6Gp/JieDq4nAyC3/eod4gewKOePNOLvCQXZA98qUc9uXdqSlIMTLUnl1qVlq4qXdZ5vSElY1ZpVWsrTSVn7ufaugQ5/Jahpsntj+84
+Tt8huVvuXdiAWYLDtY7J86r8Ni9VIU/NHFWm3FQiaZycBegxjJoPntX0x0s116RWKLiSqzqfrQtViehR7O0J9vYerXJ4ddzNpE8wF+krTNUcjOgaDHYweTKT
+eUlzPlNWswoHasfKVNQ0eDa0WWAPX/fk3qDQs7oyZT6TZ7H7/qOe3akyt0vXbSWX7iN5q0tdqaLYSDWb6VWt54LrR3kv/1zf68Ks0JCfmeVyXcIWWPDIdHhr
+XanSLnQFyzVXtSKkOW6yN9C/X/RsXYOP3HUknlPJKil618lrU9+pUk7ISIGf4Ejf9uTeELarKuQZ9UxX8YJEar2p3IbN5/DHItdzqfBnotWGq5sVvdCp0E7N
+hb0y+OUun+a17GNvvunJvQtV3epK/mSqn8MMP5jq50w+3OWzO1583RivqVpLKdhJRG+Wt/Dz0mu+MkWAX3hv9FHvW+gG/TtMReyAcft/ZqpK25UpcSup+VyX
+8/VSeg0ZFt/qGe6bt73XcqoL84Cv+K4n95IN6sdabqAhFCCyNjCuuS40/pPpNjV61WtVzrQwlbR1tZ7V6wojSjrnte44SmgggRferG1DiMif7nQp0g1kZaUL
+rSwtspJWV+SNIvsukyppROb2eyH6Pdl/zghAxGGkyCykwgZdlARm0nVd+KXt7rYQ7+h9YDFgIyRKqSWaTBBEnRLv0XaPekeHPbmXPOCWKN7SGHJarta1rsIR
+xy1HmzWP7aUXHgmY9lRGY+ALG84kx/Iw9mMWMq/9evlYTNjWkgxYqYpKq/nGHR2a3/RgNM7CEdxfUY9j2bCq9EJX0G0SMzTJyS5aIOPrz+BkbhmJeZ1FghgM
+76WZrwttZR7WMJOrYs3HwjssRF7WulqomZZzf926bQmzvaqtZ8vF29QUuE554QN9Mi9trYrC3yKqlEGmonmufFgxhj7O88VCVyAAFTWqqtzCkNQtdLgWj59B
+EsYPuijkz6V5gPDhvcoLeGlDolGYLLo9v7JydmfymSYfT7yPZqqUU5L62KdKWxg+vLGa3eX3qgCnwjJxjGCctTKrCuZUzrV7jiN6c/2KnoXV8Xs7t/Ihn+ti
+I0K3YZFLI2d3ILpp14BG8smsg1RrXCRKFvpWFe5C0b/oakZpShU5zHEP04aHfhUb+A4FOmwUs0hEeJZudmx/sUaZ6CKdzokeDoRd6zm9Rk68jD7qyXNTxZ3L
+YU/BWL76ynl+IAC9cV13lxJuMtAzrPtHgUcKd1LOA8Ib35TuFzgg8cms6a3svQqXUtjcmdzjZ776iud0Xx3QKTQPugL3WaVnNVwIeUl/Z7j7lUuAog95cZeq
+VLfokcLtvfbKKNyyGraomG6om2pWJ7ljOKD96YE0D6Wu7F2+ojthUW/AyzeDNvffHP6fB/geU2mnopl1DbcWnnZ7pypSdqe61It8BocpaTDqU6T5HJ34q1n2
+0XHlttjFuq5yK6/17K40hbnFdRuWM3r4uJcclyDhoJwvnqa2BvMeYizbvgQVY7qhOIyF+LlALaWYw+nInGfv1aLSOpOlKV/pX2bF2ub3OngA7Zr8xOQwq+Z4
+V4GTq9YF6IFrVQg4oBo+RVcrXLGw7rSZ4h9K/0M+QPuR1rdSNa50FaJgB7I2Ar2wHnaRuGJXhdpksJ4kNux6yt1uRqtiMUf3yX6HLnYgm5CVhnoOZ9b6y1qJ
+SA38gQAW+1M3bhoO3GgP8By8QS1qXeEGmnsLyp3A9uplojZwLemMPJFguVCMEwZnQTbv//u/G5Dzsjb0AXyTL2FcX3118NxRZ3K6rqU1hSbvOzymf6mpIr+q
+GSIxu+MhCVS6lDWlmhag1cy0tRBbqI3UJcraT+Q/v6nzIv9P3XHJxP0QfvYZMQOPwjtv0diskr6wjzh5qXvNYl2hTGhZY5ElRrm+x734xnJnCDEp8eet4yO3
+Hx/x+cdH/l0cn2RWBTkCcOPadWPauo8WKxyo18h1id3I9VxMlc1txicPf9I4dKptRMWHUMaHUPzKQ5g4EGrzRU5g1KZ3xfxx57DDAwO9ER29+bzjKBrHUT56
+HB0kLfFejKZFfuuP6wlcfv0olmkW/mrEey99Aak6MHLao3giDH0s8GM3Axrjas+yuGMbwOFHinyZc6TIqWYoWZpab1Du0n3McxeO2VyYsth0gdSeoTimv3FG
+sWipjrSsCKVb25qHpZvFzV07eCr1va42InyfDg+aCmMIKD3YOXQ0TIUnAo/NxqH0yPPTNU+014taV7hbKg1tz2rrjAEHuuTfi475gV9Wepavcjj9XzlxCTsb
+J6QnP5gHGFbmu8sTIVTp/QKqCM4UHAmsPnYi/IBbTsxnnm1x0nuD2/e4J/tkhuQFKONmkXi40DcQ7+EtW1hEH4ct7IKP6Nf01g47St3RxTtA/wLOPrCDyDLF
+8UV+PtgXK1CkoQw9jiNaHLSd2zszNVeCiCdM1lLLpZ7nCl0zseEa9m0l7+H7Mrgin3Rp8qBMqelcmyXG62kC4rcI9xY/LT+QeF40Zgv78OR7M8EAYMR2pYal
+qiUcuFrWD7q413L/6PhALk1Z31lJFw4aN7CWORw6VPWKjZjqGcxStG5xYzb/Re6/bTSkYrhqYjgmDu10R90pK6dal82B14ZulHBY8AALxLSh32dBUrFtW7Ao
+SF7jXI6F8aJaNOUFzLVzroHVRoaRt/gXxntlw7smDNUTDo7b0Z+HvCjgIMAGBk8NuPpzdM+Ezkb9QKMdDj6/GbfOli0QXOfOAx6PWtSmfQDx4MO9psnTw0K6
+4dD0YpgMYJjz5Irw99YmPfTsMMJ9SC5FJ6iciIIO32rL4o2aImFCGyV5jVs23J+MiaYGel03BQAv8xI0EI92D7D9hrfVVWFypn+xkZHxX2wy8ramVorfDNNt
+m49cY+5Grl1JKrOIfy8SP7/zRER7gO5C0BY5EOHgM2W3uBJ4HRUdiQ95LLTdZYCvMzgyaUjldI4D0fRBeu/BCcR1hrHSf+WU/o+qhkuRrINrNCau0Jg4RbMB
+QS7wftRVwW1X6Pkte5sV2x1kYZCrq8uA9xjXSJIs1uWMrju8vSqGjME61lauUd1z8CtkrYnuhINMbto7qNa/1AyBcckKqfMyHD5R5zUW6LoYvO9f7PE0uynm
+sCEMyu9iGij5c6Ov81LY9QKcOLBf57pWeeHmxos/kiIwd3SxuIM2q3F2YShmCudOoNgMk0yymU7bz6gUNSRwkLkqVReEU85OoCg3tEDCF07ZCs4LGWw4EpwD
+mrgUBeZeHPk762DsoLWCjdbQObKybK1XVu7jOJRlWY+SM/hbxVLlCKIGFzNqY6V+sLeVWa/sQWx8zFQxW9PBqI3MS1QX6jsDKuSdgfnVWPCtue1pASAwEqbS
+31U004AfRoturMHx646cTU34yn1psUmv7ShLCEBj9Rxcl3atyhokdpj113Cc1NTc6yxpcqqLXN9rarDh4U6NYVU1PeDGeTtcSHzfHrjEnfgdMNZoV5I2Cd1D
+nwPpsfgZbMN7vemMf4CeKccuOHPJnGZBcs/XZDuRQAoyLsRwOOwscGeZRbecTD3TDCZsARzDo4IeJZBbIhc7VHMUq3hgTSoXWah5h4MptXPZJquwbw+CMq/m
+czirVKiPAoOxFV6beCYS3T2EeuHFee1iUitjbc4a0wqsdDg21AACK2N5mejNeDmvNYWSbIiDIoiwbAlHETWMkxx0KX9UFZp397BB6Bo1kKGWF/qAJ1uBwl+J
+B8diXOQ/s0uhMOZnXCZqi18UjLbZnTGUXYEGDy+8ERQ1kQpBoAtwjjyoCrboBtxGK3AegMkw18uS74gi9+ZOsOahqXgBw0ZoWscdFtrcSGskmsimhE8rYR4g
+oHSnikXwVhj3UUMbiFVXCjMnkA9/WlB+57VUU2uKdQ0Th8DOhg/msfGLLeOXuaV5pWMMr1SFKXWwydnjiEhckqTY5GIjtutBaJ7Hg/FA49CHvJytK3qt2Don
+LUce7zW7LtDV1h6y2LLkZB7CgHC4KKPeNpw8ZhFrWOyKsmErRu7HeGOIPNHMKKYLeyJfsNmPcnDpNlWQ80evTnpvSDkiU0jXgiRWQx/OnJUIXQlKCx9KW7Og
+chpvJO1Sf0zacVj8cDs/4eTJBL0X72M5T42IO/MgKcbuJCsOarEuFjk6MqHZ6NSJZBrYQ8Wj8dhlU9pVPlubtS02ATze1ItFp16cbdGKUVWE4DXAjbp1ZPHE
+XdDSk7v2B/yow+xvOtxozpXH7JsF6WkUrnbIHRSDZFwx/6KPpUelZN06NTD8eKQrlNwYEM4B/0IB1K1rHUP4feDA36UdVsjcaAZK1LVermoU7PkyR+c/Os5S
+B9hX1s1sG9vhJ8uDXvinVqOrqL7zCW/e58pmxtML4CY8TKAb3fOlLa8Bq/3YUHsZcM67JSsmFG62wL86LoLHxfAW8/IzxPCWlp4hhv0V1JSycrgQm3RhdGv3
+2BjClEo9r4v4aJHoVhjfuY30osR04W09ar3Ga+GbXhzAieQ/uz6T+I6cbjh60Oy9iOJHLwPTdcS64rguzT6APorQcTksnQYFSM2ssZ/tutKty6jlp1ZVLLPd
+eW87BdAf4G9WUI8BaYL1KsCOqVW9JhfxWN+uC5ci6rXXfOl1V3gHh4QIrsJYlZDE0zlDNLeAIeRApjVLTbKm6LzpWN+1oWuV75pAtdfN1/folok7s32d+DZY
+ql/y5XrJYSjhBveD9+Wc5rWOu8bdsGk/2KxbVYb29MasqRx5APIs1kXoVWAU6Mn92UHq5AmhIJeQpcXMzF1uz2IBlQLlZD27S+5yd/nG123DzE9QmpHNiq9p
+Pe+hRy1avIbY74kB2cdpmNHNBgd2u9Yvo40fDUO4bgRDttiwg4U3duxhMQtpqjnRatifwd1C/lO8OWpDlwZCcGRe++osjfhfwwK+Ts4V/JRA6bPYibrFGAAT
+XNW1mt2R5iQ6DGRnB3k9p31K3/a8DuvmO/QPIVuX+iFSc1N8EHmiIf5umRZDz508Be+IE94ibXorswb/3jvFqY6Nilk2AO2YMmxEfiJczSlzs9RGmpUuyUMH
+xeZxTWe6qr31jWM87skBbnWYgHS0o3LWkBHey8Oj9jA3JSJTuqFPe63LG/rFg9rQlZaXJHXWFhUK54pMpInyEZNe1IQ1kd0Lj/MN3FQdxWPEId09jUbXBfHp
+iUtDHo2A4+jeoV4zgz5GHsGQOJvP3AlKeu78Jm24La4Zhiiq/F7V+b12VzB7OkPwEYMT0kE2tkWdM8FOt7z0MFjahPVdfB3OOyI7fsK2wdIzuU+neMPrhkbf
+3Eh0rs8JgUEJxHntzz4+IrpxxfJRXHHsuIY7qtLgSxJoMDgt1pqgt6/uKmW1lf/+75BQ/NVXGf91dcF/wx9O4ZyZcrFGnWIjbL7MYatTAzAi7OtqpRX+mKy8
+vJSb+NXusguMGOj+zetYfcYnGhvTNeDh51v0a+HYJxo0Uj25f54XgVmjM/ISgdjaMPlYtXVNPOaJkz4YyW9ZWoixWjGFJ/SSJNRU8/70QJPGLXHgIixve68j
+d2k/xPK370q+YYqAU1dyXUazGmWyPxj0BbJ+EUqJLcwaqbOED354ewwj5atCzbTc9x69g8x9TdvbLOSq0hojbzP9vRuNlPK/Hf132Y9674hNIvKi/3b839Hq
+jzMacHagH3J0dfE/jv7HYe/6r9dZ5N/QLlMoyq5emSKfbdw1ZEMJtGFJSUtEb7UocgrDAsrM2NzzabkATauqQRbEmWu9AMzwK7qbNAHn6EJjqY+X1EpXVs87
+dXwt7/JbAAQmTdB1/U0vMByNIb3W5TwDu8rox8F4AGUOzlKip5vLs8GYmHE4uY6oe1yGrXzXnwwnIcPYtSlG55hh/Jfh5VkmB0Mk9OnifHoyTzmQO4nrD/1r
+zLRtdvd8PEAGn7PB+eD0epJFKdwXgwyyt7czP43G8nJ0+Wp4eT4eXr4fXr6nTGNIfx4P5Hg4+YvsT1ya+b/d9D2n1NVgfD4af+wj29B5Z79gPJDu3ZOTD6Ob
+C2K+Sn4EEz3gfg9/HMjhpYDfcHZ7Bg/LfeC+guaHl0PKyR78OLgYXcEqUso45inHJFQHsj+Z3HwcCOrVBKmO4JeXg9PBZNIff2JeJ5z28eCqPxxT7vcY8sEh
+f5kWPewYEe0YeBUTRU1gM8CiUro4TLDnUuId05OXI8exFE2AcLNEPFnD/3twJj8MxgPacoO/ng6uruP9F7pC+/nbnrwejD8OLznfmqXDt70jea2rpUuqXK9M
+Kd9VGOxpaM3lPCgZnronQKbo5NXcFtI9mKWq8xniWNhZusCYampd0oGEdnICiPgfrStWtabYIfx5XoqTQzmH420WcgrpHugDfSAPD3aRft6TwFwUMKu2y12Y
+MWgKnqYYd7FxY8v4YrHr6h6UHwdOSxJQY3XpChwZ1tuOWWCnKBWFdGKAUF5KTdrwVG8Mz27UfssfkPSmJ8S36KoND8gbWLyLvGa/rnfEEZyohrvdfQddU9bq
+CoSwUA4cnJcLALERZIPC4vv6F+donutZoTCY9Lf1/BZ/o1D/sgc+bajbh5bCf7cGGOJfuQYxzI57Jy94O9BLKbePU7dqI5WVe1doGeQrVdbAO1MU+ta54hk0
+jQ1EP/vKdiJeu8Eofn4od4YmjQN18AHsXlijxhmZblqvhQ7DoMhuYLvdyuPekbPqjnvHsnMHZHRI3/IpYNUIDb3oBSKcxFVl0DOT32vA0zAFTr7g8+RbIiwA
+huJXqEpR0xj5IuTe93I/P2CvJ3jrq9y54VdqkwxPyeW6XlMOMfwcPYweD6CF54whjxMAsS0hDBm4ytyi3bnZzdnMBGyrPKf8jHmlHiTr4X6/02ZuOqq2AJ4F
+b77Wi/BINabNT1QmVTREh/5HHNlKbei4QICMjxeInNLUjYma0+pGs8t6Cxq92gp2A7aGxjaMm4Ay65LW0026R6JNKLdtwkSQxwK+Zs/QKq+SJHaaGLcxV7rK
+zdzpnHpOSAqGbKCNzDoiYOirOf2FiXv3OKeR5fv42XXY+ezJwys7D286VW5uus5ue75oLHOYL/wTQ9f35mc9Zxmf32uhvNqJODqSaRX6hDltAK4cU8xjFqk5
+TsadmvOvUmBJQlYQ71O4HU78BUCSXj4m5t2OTw4xy09CBH55yckBSmzYb+JKW1Pc63mAM0w3ISJXSavrusCeHwiPYaYcf7zl+GbuGmk4M7zs5HPwQuleFWvd
+sIMfl+IdJ4dOjfBuMkBUwXmuDUCYzRo7JeeaDpJHjC/xG1NJ3wmaJ5QbhBdwttq3hADE5/Q9u0xjxaTRq2+pV9/2junsZXhH6XIOqT+Rx8Cxe9nkznc70ZFr
+VRrSWHRlD9gnALFlgZ66e1Xk82ITzxgGn6qUV4zzbDgBxy9hpPmkelf0Demt3/Uimwf0ZE9S1BOCdODLkTwdjk9vPk6uweSYoA3ivyL/OdGpBgbVBj+qCOxG
+B1lEfRQzHWUR3W3L3siCtSEiayOTnrl24j6DQcSWjv/N5OYKDL+xswZG5wI5VNE0A1apQIibcmXha8HqmowuPVmWZ7DyvLgJWe52Ois2RT70YQqQbPYxK9Sx
+UOF7HQ8uUOT+NLy4yORPo/Ff5OR6dHXVfz+Amf14dQONnveHFzdEEPWxf3F+c3lKrXHnkQv54oJHdjr6CGZrg3QLXjaYBBotP1efaKHEh/6PAyLTGl6CPfoc
+ptpJzxlo0Z4LFLzUMlIu96+uLj7BQoQvYQrOBv3rD3LklqN/IYeX/3ozRsP15gJYygTSloXefjWJubXYnB789XpwSS8ZnuKSX/R/Apv4w/Ad0Crj46GTPTEZ
+fRzIf70ZDydnQ5zLiTwbUUcvLkY/caOnFzcTHNO4carC1hDbdkYmJ9C3/nXUDqxT1NDH/qdkbgRY+EQzdNiTN71JT76HnX+JtMoDOKaTwXjC9MAtgIz893+H
+7HNdYa51Xutl9tVXxN+iahQV0qWck5vw9bfytHfeG/cgSfrwSO6PINR29N13bw4yRLUwqaJZpE23CDHgNeU8+ZFo/SiNeHPXomC8qgJ3se+ZPDruHR8di32o
+uMV9Q/Qq9I1Ax/Vd6+fYm/Dh8fE3vW+OD49fHcn6rjLr2zvhP3ot97GWL48ahCtNPPq2obtyUM7ljdUg5Gfo+eyKnoNHnUC7LRAI2e+8sEc9+XE4OR1cXPQv
+B6MbWEysEcDe+gdlEV1ekl+12MhCL2o5LVT5c6/bDekeVZUW5CjNy2eR13h1E/oFFP6DydXocjKMjif6SaCPAx+dmKGrnsGSTHbRhOUuKHzZ8GaE3K7XmUf7
+UA9DjH2ulgr10ArZIrbnPkAaoUMhxTh6s0gYJhqQoynS3XCWFYfuzIKh4KKJegq5USHJiBSje12uNZGdOoA22ZnUFdAMKTkIu0IxUJorjz9dKPBVM2rDw2WA
+CIlj0agds+cVelebGGuBv2m7Cvx8MoAS0zHAYU9IFswY7onBX1EwIivTNfrUDc9UBGy28hnbKdtOhsQaVUhYeDI3BXZ4cGu72BPqTpz8uiq0G7tZc+oMtN0T
+fkzvto7p3d/bmHakjL/jf6PJ4PrV1cUrIAz5rTggn+B/fPv66HWz/s/J68Md/+Pvtf6NgC2ilY4Pj96gdj0evv9wPYFrcjD+cXAmfxyMUasD0zYOU4izwTlU
+r0Alkh9y1S7Gg6vx6OyG1Pdg5uC/Po7OhufDU0+reybHg/gXEDg5HYyv+8NLbxF56tj9/kTSe8/ku8HF6CcoFjJ8P7zsX1yANn0x6E98vRWiSp6MbsanAzm4
+oECNvB6cfrgcXYzef4rLsOyjLTC++CT3YDyjydmP0dd7Bz0BgabR5UD+9AGjM5MwSvjbj2AwcUOEv5CHOPouDnuJUDuDDRyMBoFxMxidZxDqwXH0r2WfZ7J/
+ClEersxxfsN2J4R1Jmhg9nFihpe0UP334wHWKumBJQSmoVP+4QswKtBgwMcpdAZTDDVPriBQdE1VT2Cwcbf9YqQiH3W6VaVXCtGrrIAISvj8z7woVGvX7X28
+utg7yDz+4Lh3SARSqixNnXB8OnzwDIP69YPWZVrDB5kyry6gDaBaQ/44q5lYBrZ8VIToQU+lzWstVC0fHh568H34GuBLXyMoZ/Yqqu2DoZlf6iZIYgr+MQpe
+fd9BnYokYtuJU1MysYRKLCZTzWIqVdGgUkXeMEoeDTQokxB2P+odfw6rqn/CQSVQr7NiP0fH1EE3p2pAqkcQrTTxLW44YsQ6iToZ9a45ICJ06W6cOE/lXvOZ
+LsLDc0w/jHF/nHIfNyiayD/ZifxrgPDPmUQQNePoneIcOXRanK5dv5X02yfIXkUge/WUqsCDimx+NRobP7FJYso5wCn57Dmazu+FUHCMVb1tCuS2KehC4oh3
+zQirW4IfpKmEmPYCGqr5C5QfqRXSlQLjMhyOer9IU0mtqiIP2fvBAFrXDOiypqsZFeZExDSRb2NCWlyEmNA0podEC6abkRafIyLYR3hg+YQ3WWCTIkQNpHxG
+iYQWhKyqOXUQsqzhD3tALbLq3SEJ3B759nFaWCJ2db+JmXnv1L1zV3ukIbp6s27otc+JzASz8TXpPuNdhy1ReoPDTIIFHIdV6QnxdMKr59k7fISftonMotOA
+C835022JATNMiRaWLOGIDUbWJvMcsQgVzyiPMbzfvTN2b2w9KypmhN3am4QltlP+Hx1BXBwXhHgA9ugIxMc8zArHTTAcgymzXu4Ix3kXwOyZXOr6zswxy2mm
+LfsA1Ap36NpSM0RbHTUetpboogfDcfncVBfBmdOxdmkQvF3Sgy/YPqXcfgip4f9BwAL/8CQ90AqVBONNTcE14uWCZRMxbzXT1uS1bd6LjQ/D9cr8oXKvJXzd
+FkRU7+J77O/7yxv5nqF0qZqUuQYFqjbuxxcaInpPPQNKe3imD4M37hmx7ZkTeI9LTALJExKDzIK9fy42RqM8kXvNvRlrFy2xmRDu8lql2Exq9zWTr4KIg7+q
+vYPPJWIVHejmrRSpe54hVUYMqf60IUGqeDFBqtxKkCq6CFLDFd3JkCo/hyFVdDGkyscYUilKnzCaYl423nmdZKmPkKSKZ5OkHof0h/fErQhi5TSUeWUJD0Yp
+/UCI55MzvnqS3NTRCNGV8CV4Fp8SeVxLKqZhVD8nLtmttIyiWXLKryCWuTJ53ZZc2bPYFh8nN5VtctOpm6zkvvFL3BgxESfiuEFCs3gOsllEnIpOMqfj80L6
+CQktuiW020THnJaS32t5pmpN9l4LAJDHPH5HLSxRkiiaI8vAzCy18AAQHJlOtin8zJQBE9LEIxE+JCywFelEpuM4kRdRnpkp6WzIycysHhkTVblyA5OKUxMx
+2NOADwUp6tVmeWk66O4CXsH6FKMc0p1dBKFZfjggHDgW11KIuyT4palhFbyoccEXh8ghP3Zp3JmM8PkRRiFRhejIL3wextzzIzXNokovjY+IbNXiFqbyKgyB
+K1BSw4sJTYexHdSayo23KDwP7Fc2vRlFx9Rk0qHfOoz59pEIloXwUcv9wIbjzvcWxeaARjbrPuleWSOu1MYScjxCTa0uZ47vXyS9a+bq+VR2XK8Yp8WNeRlr
+QYpU98hZQP80lSjMrfHJWAkGNQy4izG4mXDKSMiEOyLNtzyIzuFrOQl5X87mFpDKlejc6mdt47PDN1UjmztiIJBJcEx02tEupX5b5lk0t/tWa39kjg57xwfb
+SmC3XQgSHEGhOnvjoZRQ4iSemzcNmqaOi7tB1dQSip53qbW3kWgpr7fQK+Fd0M2oJDsYlURt2m942uo87r2V5yqvIJre2Mxsm2Poe06hY7Sl4m3t2Tp4IUPi
+XqgTPDezukK3gVnIBbwLL1H8i6saZ16Vw7jovSqQuzF08ptEnfJYsROwGJBo7KR3QtctJhNXOipKvxUiF5GVQYAplJOPwrUQ5PVUdkctyhe2JMCIEFh6eN74
+QZdU6XKdeXaUBnmqFZ6Io83/2yBPzXwG9RY6lnANRjSIaPBEzEUNIpjQzUZufCgPCvfT81iHYYHuqMTlRs6U40QT3XS9bULJcuNlTKsc50xV1UZ4Mkfpio0m
+BDc4YUgFOedSloW2Kckvs5DAeSLykYi0dxsTb152zljEgnj8GFsQbR/6aZuNpGsLNR6Gt5ekCyTJvv4RnET0L7aoPTt3pFDdJMB04hqUy80dxPsk9BGdyV0L
+H/E0dzqVYTtFGHW0McmtWOdLXWzkUpUl8nrV0BSxepUmsvpIx6CCMsnZJB9g4OsJNkEHIw92rjnnT3IekXfQa3EOfxvoY7j8asq508GaI5qvfilljt+pYstO
+7c6ndpv3pLV5E3NKiCbJSUpBkvzYvclNWUJZ5GYiCD1KZ2/oN01SrLjbTeYRL6YQXg5fxJ3JrVRNHbTbuU1+cBELupj6rR21CBX72gcYF088J/bRqLLjKjoz
+7UlQx4qNaO7XrTZJtGHp1DXfCp5UEWeDJ3QXzTlcgnlPP8wraVbkGXJ0+c/TAJNO+VpOKQt62lcR+hqTzTq+yLAnS1Oz8ZMeDl/PzSnc7tC5i2M/+JKDMsNf
+Zs5G8//2hbkrm5LLoano7VzQNT2B0oHzhzPXybbDmV68wZJyBAX+tBABBHGktgeUkqN49oLawPzo+UZgbSwEwa1VIfNSzWbrSs1ybaMpftMkL4lSzq9RnUYG
+z6OnSBjlc0gYxbNIGOXTJIwhxvAMIkb5JBHjb8q3mP0WhIsv5fN6jLZLdLInymeyJ7ZrFIQNtOUUcW36yFsUnaJAEFAbTNf527rK7Tyfsb8JduOxfynxEkSv
+jAwFzGXryENjt5NoUDfxQQqmeEoUlUHeKfuPKyisFtNG7bcKcETjwxboGl3oOcZMIGK1pisvauYgk3RvqUJYPVtXOUscgoSg7VZpW1NRBlzS7pE3ar636ye6
+9LiQM+zlR1qTAwm/+yXLoSTTrlNNYgoEZDpBlduTQG+RetE9F+fsTDeUtgPIkrrSqm3TMCi424ZBtxjEPD6Xo+wPoyjzQ9i2+wTvvgbv1ecSl4nHo+eBuOwp
+SjF0V4rPoxRzV1q6CUWyCX8dJZjo6sZnUoKJQAkmt1KCRanx7rKFW/Rc5QXy77ldSK7xra7u9FxtIThgNljRRXDAezOvmUsnoi6mpzhS4BlBOStV1M/qFh60
+baAoTj/AKqGQ1Qqb0oscVUT54DB567LOi/ZFBWGkfJbDEqEEzfHJkGFu6Y7HPtpQM4FiS6a8NZjGTIGlvCMWBJOG7jauJ0I3G2/L8lVElAo7DGaqacKGNMK3
+h68gK1sEvn30puH8TtXsZ8rEDE325EdTaeP0FzeK9qyK7bPaNdLOgeL4wAH28hGy2ZJzTB3jQmCyCz9En6LBN4tZNNsOTIXJ3ml2k+qVdu3LMM0nh68izgCc
+uIg4IAiUXjh5xy5WMIxzcilwgLJ+G1WFS02OgvExe0V3WrPoZq+ouvkrMomZubp65RArMAWzyljLn4gGnYRUXdGRZ6WeiyT1vOOQh9TzGP0Uvc56P8YW6y+O
+U3bTiPjDG63QSWx5nIZ9w0ZIM9rG4A5Nu74ZSXyTyUUH2MF9nzlKGOZ2idxhcv8Rp/BBFv30OP2paDsBk5+ftFtOPCkHeKVHGVxyn43fA14szzcTjsQ2vx5c
+Nd5JyGzdFIwkVA060046qTemXgSGo4yH9D43rBs1w5ZZXM+ktSkedU0KZZPK3amXoJSKdd9iE7XjEx9LXSOoB69swWQP2HOJ1wr7DCut5f7JgdxoVdloy71O
+TtEYrOZcB3HQdZ0+QdRrqCShL24iWkIsx2JippoHoRgvmirneE2F0IvfQ+JN74R3gm5HrSD8yFEpPNAEe4tqyIcIr/MdUoFEFN95KQv1QHKDctk2COyZVhTF
+YFElrdY/03n7G5bdQclf5HqRiSizcGGIkGmxLmTwtZiqS1TK/UdrF8ZNrsu/wZbWZZXP7pYoxDhJsoHaLdTDAQoAAcKGpSyMzG/hKIcxAvwFbEfg4XeMHE+4
+h6Nd9canswYaVc9ugFR0T3AnNCUZEybILYQJ4nmECVnEmCC3MCYItr7wSk3a6lD9usgTUpoo0SJPoDIL7BOA0f/ELgEhutyq3pXe8XpSe/aUlbndkxFyCHaQ
+9zSwc+fnHM4VeyL1L1zbPXOwEBS9ZD2YahMF8LJO3Cm3nmv7CKY8BzVOo/ica7CHbCaWuprdqZLL4y/ymo2NSH3mK0uiwla+4qOSl7eUm6zLOq+0rHL7M4hO
+tob+Y03Vr2DFGZnlXKHb+hZQgRMkgRRdEF5cAM39z+99YR02Zcnpto+Ri9SDdiCUteulTgI2qozrwBJeAuZYVnqlcjJpMV2UXD2U4hNcSLGbCnPn65yYs1Up
+YTlLRHI7BEkTq+M4naJhJiFPhUzH+X92bzb2zEbfhH55ysaAf6IisOxxEOIGHyuNnOXVbL0kP7UzevgrwoLWd9pUETyyNlUsIUWpb4v8VpczfZB54GTWQE7S
+GWysiIPVmhKLXkVulw78v7IRvIKBTFipx9m8eD1Sg8LhQAMiFD14qoCPZvlcl7UqeHFLhoXASjnRHkr6wWB0FYqLZLLr9MVXQgFba1WZRQ62X2EsNndrzBzu
+n0ygdmBrs1qpW50FxWHBZjgiYQpXQs7NUcD8M2O/p1zwfcZXY0AHBLm3t6i6Gy1AELQUU9Vzdx7JuUKbw2lX3LJPbIu3UuQb9WSz3sXmv8RyMXOtqEb8SlcW
+VWm4qqsNu3oRfu7NLgfyCrvKxVHZvRK0EFQPnLeFSSVCJ3tyYpY68dRaVobgCqZIsWSEK2GD0xGGjbJ1n3AIK7dRO6kfOcT83dx4D+C3vYiaUIg++sndvxsl
+XaJjz+isKTJa1FzJx0Wh1xWVTVDJuFklqe9IbJZzVda+1ifpZ6sKhrtSBXuvzUJO1zYvtbVo/PLk+u55Nqc4Wlmoh0AbHncgXIKIdHermtfWU9++MotXvJ7s
+/kU0412XJYUvF6uK9BXl90zkTiUlMbZXaRFjm9ZzJ0WsH2zZkd70Xe9InvqDJoe1XqYkLImwBqR6ysOy9zgNi3BEJU/RsOw9xsKyh6iCR3/S4GDZe5SCRaSc
+Kr+GgkW0KVjkr6JgEVuywp5Jw4JLegwX7wTbyW9LOVwu11iBDBcWe+ACIrehJ3gEavTs4XquSRt6BEIjOE72oDggkMN6uLfm/NYWZlqDWJ65/ARX+4VPhNim
+4H/XO5GnhIiEW15RtasfdbnWQnzXAxeCI76rtFTxyFBY4NDc+25K9F1Pakq1dar32mrxiO7mKkXCIFQSS/K5FDhWihBRHdyoH1mXS2barjWPFiFfT9gGRqjZ
+0nhcngS0hJpj4MJLesggQCz5VqHrINt2Pf1bxJTp8yNSccvVatshNhLQVKCQzlsYSI9W6jhZqVIquAM3nWvTsVH9cglWLb/EcgnfjeeskusUTP6C40nO50WO
+1MeWQD53CcRTS4Dfbp/4nxQW9KjB8XbWO+XZP2lgHNq2tbuD4Mh5qhnXx9j1lZafedM7jk4r4KFXlV6q2YYRsb5ZWJy8lEWu15jJTpcvc7bbLHKZnPPY+iAb
+iTBejKMQ0xlc9VZ3/U7Gv/O42FmhWK75Q0MoKTWfo3FqY8ERcInt+2Yb1AsIzj7mdqaLQpXarG0DD5wAnXVgyfGOBdidM115lkh3HpeqBi2d07/hCGF2pRtG
+yzGWW3mnC1cGYV2y2CUbGPd8eNbv8ko7lbkkVa4zQs95PDKvZdRqj/Q79dAMBJIDhJ0Kke1eqPJ2rW45wd8fSlJ9uPxgXa313EcD4Kl5hS6+LFLKp3y518Y/
+kwZb3fOqwR3AvGVbavT4nGIgdIhL19BFinUw2LGKReJhJq/17K40hbndxPQT+3tAOLF3IPdxeqtiIwklpKyoG02d5bd5rQr5o0EZEVo5cIEgW+sHVc3bvrBB
+SOhwHpzohB4dgSOzbNWWiaGS3LTYVl3GgSLsXVKBqNWVpOCQeLzgkEwLDvWiaXdJWrZZPCgA0xoFB5+DjIN/hPwIEa04/zrAwl1OQbEJobau12Q+i+JZ9YCS
+AkAd8x/PwQmDtPU8Gj1foIwU9UKpXd+uiRuHpx74UqSnhcKljMGydGP7oXXgUrtr/4iWDKJuUpmcZKDkJEU8IXm2HNeLM4XjAjKN2fGJPBQ95mQt7LTvkXuE
+YMJWtKpDAt7xCLhDQnSovHVnMIAGr5lf4Tko07AsHqsX7c7uVP7Hm3ZYKbsVJr2tFlYXbYdIqjU218qhMxzzR096Lj35qh1Aco9RsMxd8c0x5rapTyansIOZ
+KhP3PYhg7u+NgCqILroosQEL0yBzSGniLMl5FOle5AVtWxGB5E0JY/v+2fQ/3ZW+0ddj80pFZb/rOzcNsln1G3sSVTcMeL3WU6Gkt+7d9jIZCnu7sjLQGOH2
+26W+Q5XvgKB5qtS3aJf6VvN5jG0j6GodkEJmEUeWXD53oF18J189i41m7+l98zxSm0wq73JwTBBdu0rirvrnJB4cTS5eHfUOfzPuv6f5/46PTo7eNPj/Dr85
+PNrx//0e/0XKKusJTnjf9+RR75APYfdPsHCLJw86iAtpop3mki6JHGkhKKADUoEfdaXeiA8J5BTG2UB2JI0boPq4U5a8s1R1M1RQ47ssXwJYQCEgPHxJhlSa
+XOAzWNz7Bbz/eyHcWOIE1i2zw3frUe9wT/gLj92nRwecaY/wePdur/+6Mb2MkUIkjBRI97DS9RriOPBNyIOi2EAAUs9Ni9eIFEh1AF97iolkRjh5p2So8A9C
+TPHXTOkn56EGJKyulft7zbKQewfM++dB38l0/yDE7KChB6W45LQvqpy3Kk86xYEu6CwpGH2fu/ya0Graoqk6GuREjyTXx1nAxTN3xw9uhuc0ZxT+7RiTA9Jw
+ahw9pN2sAK3HIw/1hDiONtpVzKX0++6yjCck5oqyAo4xs/1GvDiRKuqonWDm9HJq5nmAHydDFsrKxboqE7PIt7EfTi3zWR10sIng/sH0COEIRdov+n3PZthF
+jQGIx7rv+VDadViFOIk2RKw4+V1xzWp2QtEUszN5MiYR8zSlO3AbTRMFtXyo1mOARFr2nY0OeBwzVrEQioMcR6VqtyyNiFNwLAkl9GhIJZdqdpeX+lWl1Zxx
+acE4iCeEPhINEVMYhjwxz2JsWSS/xH3r90oUuO8J/6kHgCXOEqvq3C7Y/I4Sj6aUOEP41eYwxLZh5CVxX8FaucApls80VZTXu5EzVYDRwXWMCEgg81L/stKl
+pVp2c6IyKHOCweKCMPBwQclJODvKhoVwVZRtQ4y35ov8C1NfsdpdzOxZJU+LqrcNBEb5glveFeTGJiOdpL2nhHh9IAd0bmH/nldmmTJNtaOwLe8/lZQVnjEi
+Im+oTcJH4nvosxttRj+QVs8qTUQ5AOAgF1w3xZRZcB8Qie+8eoygKijlQs995O/yMbabDkFjKpYztaEPSDQTrs2XIYoEfdNf2BBmcdA3wJChW3Qm0t6kFC7p
+WGNUB31NufqUuCK6bo5nLB9McrWqNHfAASrCOUb3UOAi6kwxly0fEs5TovA0hEZANpItjtgUFQRF8Da8gT1a64pKDq8KgzWgYlne8XUs05vh2ihj7GmNiPFl
+D4rT27yLPh1c16OM1SBhQrqApwkm2FO0dT6ZdeAFfV7XUBGNvDy4fwwlcVsIeYoGEYOyUpUOQoM70XOvLKhzEjykUoWgDuOZM+E6H903zC9kE7CXnOsiv9eV
+I/VkaygdrUCYXx+7wyc3JAumnJacikmbytfJykIBDeHzXju2gRPhDWklVZg4h9rxsRWv5nK24FM0DBRUiDHv1pEIAZW8hyC8PfDYUtLmO2GnPfZpjcauYO5E
+RqWLrz4hubwYUmEhLoXkWN+pltRwIkc/XXri99DgaByaco8I90jgZccH/UNUJawvf+xfDM/k6c14DIWJnOMNCzWlXYN3j3uuBm9/4io3X3ySk+v+taNkH8jh
+x4+Ds2H/enDxSV6NB6eDAZTQklANGOo8ZaJzaJ9bXVq4+sPNitIvqScNPYqrVEfFn5FbHhclFJ1uT7cfRlxkGupJdZWZllxm+jlFpalsl2i9J1SRbtRBls8p
+ySy2lmTmv6EbrXe+h0Y7CjKLbQWZvznYBlXtyRcXsBNbCtjJFxawcydAJDXlsDJBWkbOFZFrl5OTaTk58cxycv3xcAId7k9kn4uiNddA4FkeuMrYHVvs8Y0c
+laQTv7oknQwl6cTnlaSjCaW59zXpxK+sSRfKzokvUJNO+pp04u+xJt1w8uKadN8eyP4MFGxE7sOVFaUQB9VSF1YjF3XKpoeqPF35D1Ve17oUAcjhqlZ4dZAD
+sAexxwHtNGs5W4hDrEELEqQFbXGyRYYX4qcDjxHRccT4ebH1cu8ahnx8GAKHkccmSZoTjijD4JMt1APFJAMxnYPSCof2Xpd1lTvGn+mGlHZHzCBrmJkNKsQI
+UtFYaT0ifUaTavFIamWwTaw8osq9rLdk/D2itbBIbyVU2BRdKWdMIeDzu51xzVQQjdpYjfTLxMCFBzeeZQcNcF25gemY5ICGJdoZo0nvcJPAEDzavjbyzpSs
+bzqvaZiNhgb33YH8iNWp44OA6ip7H/txnsiWBNM0Ud7nFj93jPLxMbo0UmTJV7iR1nntrBgEwjUTd8FQmwzlqa5qAgiYlS6lRTdLoER1IfmGEzimAWKuIWoZ
+wpTtyRKtydpzwLOuctTOxA5+Up9VUxsEOeI3NuL3PzyQ/5pgzxEHi1uJsMXQvQv1kJTgc/PgposLEXKKHTqztuASvWHhAPV6nuLxnautjcjPy9SRW2mLIDBK
+eSSgjf8Szs96ViNcX6yqfKmqjcfoZ1HiDsNku5H48lG0rIjR92Bdq5SHKYKgXioSFqfoM6MflPKUcWpWOBNumAiqiSpQZLw3Zm5TEUkdA2gFIOXWdouL0qxr
+mCRs287Mqi2BTMXZw07+JHmAoo3iTbjeKF2tVEUdBWJQYIdAVn9WZ/LoGwEfn/bk//r/SgDw61pa/R+9baJcdoryRI6LIMejCp+N2s93Sf3njpzPo6MD2a9r
+U5V6Y+W51haLY+MdOHOZtwxNfCR32FTCZda6DKNwBmD31iZzDnPwL8DncarRlBIDayoJKio9M/e8KDNjeabJ/6ptnN7YmWAVFSVXbmxfyYXW7nKBFj25FIbt
+ytJVVw24c5YI/mWYYahWK60KDytXsQh/0SLEpXOOD1Kka+NKeAnSVbSRrvLzkK6iA+kqPxfpKrYhXcXRyUFU9AszWLiKRDIJPf6YU0NMQ4H0GYciL+V6tSI5
+XJgHOEUKvv9VtS8y3jiQR+8SvWPyI3cqsqeLZIjnFsmQzymSIZIiGZttRTLkI0UykEcdZOevL5ER16LIxHNKZBDzelLPoqMwhnBkelsLZLi28gP5vDoZsVYJ
+kxoE9v4pFHQ8PIZrn7LPBj05NlaXPQmUyqxYccBq3hNXwfDIbRIKdUjq1abJQZrU33MyLA4P9tIeuszAqeZf6bl/jM4buUWd0REZQ2YhSI4maLNd5djPxn8d
+/ZH4r+O3b09a+K+jHf7rz4D/Ovrnwn99BvzraAf32sG9dnCvHdxrB/d6NtzraYwXr4f4AhivVAztMF47jNcO47XDeO0wXl8W4/U4rktGuC7x63Fd5KWZa/El
+kF0MwxK/FoblgyjiERgWnK3meJ/AZgmPzZK/GpslAjZLtrBZ/dq/U45RgkXloSpdqxxdx13XLGyeVly6UVYrc8zzGybD7KiL6nMJ+ahp8ch97m4dKx9QYyLH
+Y5SNGM4zqNvwpWccv4f5+qWWyHBFvqea5oLakXvxbFA+Ym/PT4cIrsO4g67ew1Yzg6fCF9OSKiqn1X5j9+0OT3cXFQvcz96j1nUffvNcHJ4TY0zxF9EzhHs4
+L121kQ570kqyCpo6vDMF2iKGaPzi6gP+IVfJEDkyJYY6ogvP7Zioa+QVlIPt9yfv5ljfwMLlmvywuqx1OaMk3fbQnsGEGePwcJraULyUAFOaKhBgPhETgk4F
+2ksQ9k0wXhaB7AjpYyrA2V0C6G8r1k48F2snH8PawWwEiJuIsXYvJ4qM3But7eIUCy+Tn2KG/PYxrN1LaSF9SCAvm8yQ8gXMkCDq/EZPaB1RFcNrz4uX57E7
+imezO7pof7PSaAKr4LswBKZbvqPnMkSKFiek/HxOSNHFCSlbnJDP4XAU3RyO8iUcjm4Nv7KixeAovwCDo2A66z+ewfG7x7Fx7SKLjzj7YHO11KJMphWfVFwC
+QS8Wpqoj7Sc5r6I2jtkhjiRBJ+9N4QkMUO5Ah+JrNGWiEE1R9ELEXwhoiy+B+CN1QjyG+OPqpc/AT0WYN3Hk0W7Pwgs+Urvm5XhB+RheULwILyifhRcUz8EL
+yufiBaO5ayEF5QuRgqITKbgNR/ckUlB8NoqujRQUTyAFG/YEwNB2UME/OVTw6NlQwX5ATyFBTF7HiEDxMoZeuYWhNy9TPejZeED5CB5Q/Ao8oGzgAcVn4wFl
+Jx5QfAYeUHbiAcXn4gHbrJ7ic/GAkvGA//v/87/+34wIFL8SEShjRKD4bETg8Q4R+McjAk/+mRGBr3eIwB0i8HdHBB69OSBPLoztxkZcbm6YLfwHrPeD2hCz
+nR+FcOX5fYSfNNSuAlEVU+HTdvL3Nfo5rbZoZaMHs9bVQnsO84qOmV2ZMhT2xOGsYY3Jf75DOX5plCPg/47/WP63k+Pjwyb+7+033+zwf384/u/4n43/7cX4
+P5yh3xv/t8P+7bB/uvz9d1gn7o8ifOJL4P5kjPsTjTYy+TyUXOduEjvs3A47t8PO/TbYOY5gw9OlWuoE0JXJ0kRfOWzZLC5d3PVmei44dPMqQYxVjnpJMOou
+RiGhM2duKl/LY2lqvCbIX4jXmZ5HaK50kzvt3GnmVFOzUz9PBFQMQxOPoQjly1CE4lEUoXwZilA8giKUL0QRisdQhPI5KEJ3yYrZIyhC+WIUoXgCRShfhCIU
+T6EI5YtQhOJxFKF8IYpQ7FCEOxThDkW4QxH+oShCsEkqc69LDwZ5GaZQpFb6Y5hCV0GN170R6KXZFzFsUFV6OwoRvgzGjg8U+/MgOs9DqDvfVgci1WbfHtCn
+xkajoyB/0v3PxygKj1GUXwKjKCKMovwCGEWRYhTlr8UoihfzAT6CURQNjKL8fIyi6MYoyh1GcYdR3GEUdxjFF2AUnwFKlJ2gRPEZoMTteDzxUlDiozSE4jNA
+iXIrJlG8EJP42cDMl3MYis/FJHZwGIrPxiTKbkii+BxIYid5ofhsSGJzD4hfAUnsJC8Uj0IS/7xYROXFzpzRo3RZkORqA3Wkisu7R9Xd8d8ZggdZyXRoQTzx
+AIRwJU+DMt4ow63co+EegEPnAY4O0+AaivAdz2yqfTnvT9Hp5oY3M8spr9SWKBG8iZNo447dqWoOfx/sEI47hONvg3CM6Q7FlwE3esr8HbhxB27cgRt34MYd
+uHEHbmyDG0924Mbdf18e/3n0x+I/D1+36/8e7/Cffyj+E+GfO/rHp+CfRzv45w7+uYN/7uCfO/jnDv65g3/u4J87+OcO/rmDf+7gnzv45w7+uYN/7uCfO/jn
+Dv65g3/u4J87+OcO/rmDf+7gnzv45w7++TvAP1sQT5PwT+oGsWXXZckUk8IhOHl+YiRUE0wUhDApAtxv/zLRhUidbp4J9pQp2FNEYM8d1nOH9fxtsJ6N6tY7
+uOcO7rmDe+7gnju45w7u+TvAPV8dHx6+3mE+d//9lvjPkz+W//P1YUf978O3O/zn7/HfVu7Pk96h3OfdcfD3AAMNgTrGt6j53xReSazi/HYw0JOXsIC20XnP
+AOf5IFUKyHMjmK+rxIyLAqS1ER2w0BcAQn38RxUYlK8wGsxhECVmBoEnDh7q4aN1pUpbUMh2rlY1RG5rUFfwC9BAMwazka+8qlR5q9vrkZE9AjpJpclA+RxE
+agoY6EKkGlLR1iWVb3k+QFV0AVTlrwCoNkPf2zu5Ha/Kp1X8uQGrX/IsbAWnpjXJxRcAp3IoWQSY27aDGMBbLfxU1oCuZoKC7gomAL0Hy5Wp6mfio/+hEa0E
+ZRVfBMrKFea/AJTVfyp2UNYdZrSNGX0MK9oB+BAJHBNG013UmmVmXEyqNlFUNwaSimdXI38MSAoxRvESaGhLtMlItJlKsGT7XLyoTPGiooUX7e7k02XHxRdB
+jHKo/rMRo+SbCrhR+JH4EyNGswRpmIlER4l2/4sRpGJLaOpzEKSigSA11imPTZxo0v0O3Khg3Kj8HNzol4OLIuoAQwrPgIt2zmEbQwrjEPFi8o6M0Z5/JMwz
+2+E8dzjPfyacp579ncI8wWSLg/wQzqlmd6qsHWQNbr+8LuFNeBLQpZDDrq0ER0P+5DDPzqVwO7QZSmgBPsXfN+DTlEGCPQ7ybAH7xK8BecoE5Cl+DchTtkCe
+4vNBnrIL5Ck+C+SZQhlDcFY8G5X5DOhjJklxkHW+1KRRBJFEKEQK7adBW3KW4NceV2YhjFnJWaEVgefyqtL3hjoa4Gbis+BmbZAmWqPP89M9A7MpXoDZlE9g
+NsWzMZsJWiAMlyaSr4/aUD9q9TMpDwq8rBiTlkVum2jJSEhHzQTEpngRYpM8UHMDrVnjTxLC7Ri0YHXnkvF9FGElE5hkGxfjq3FvM6dyG9Tp2ki1WOhZHbVK
+ohXeLVJcsI0F395C5aiK70lT8b/mWhV5ebt30AX9E9thjZ8D/BOPwRo3W2GN8cT61RasiO8gjTtI4w7SuIM0JpDGIBgDAyxMFa+wWoFbrwJplkw0K8pfpMb2
+Vztg4p8GmPgyDKFMMYTiV2EI5Q5DuMMQ7jCEvy2G8OjtgfwYBWJh1NfbjY0UZThDlOEbDzAUjC5MgYQNBGHk79dZE5GQaICdcMKtej7FKgkNEMeFU5WS0EVb
+vIaUZ9aKjcsP5kHfe8+52nDjgo7xL3VnKlRzrM2hotLufKfCYYEIsPSRP5YRLKqcsyKZ44KT5pego0jx8vAokd77EZ9PsAq+x5Mc1PZglsfRFHS+Yn+bHYP1
+JRBWF8KJbKXR5GIvTufCLUsnxlgXwWX1Av4Bo+h82Q908qNAASK5cBkYk4UepEWEwljkla3hile3lVrdSTU195Fnlh9z8LM5iW75X/LS6qqmbjifHfYNdIh/
+wXGRPHctuD6bh5Jkck5HEzROUFpvwVWbL/NCVW49+MnGPkbADIulaF2I5IaaphelKw+zbmANLMUInEkg12WhrU0n1F35iIabal2SWndP8oeXEpsZwgVAZ2F/
+NBkeePuV7jWcBETlFj534T7XZKbPdFUHuYKsA9bu4Lr/UP/BXplMLv5A/O/R8fE3Tf7Xo+OTXf3/33P9/XWQKgdH3333LaQgfCsdTyz89qoycC1tSUIQY52E
+kNHQtygmWbTBJ+AbqdCnsrSZV3m6FIZMqMopB7Weh2CX9/J03YsoT5e6/l6Io55Mu4TXLPdlZuY6jlpjg3THNPHCmeBcCVuTnR3eVc4bHUmCO8ftDuRlPAP+
+NozQuF+yD+4uTaB44O/72qnmcqlqXeWqiIKJ/o6Nuw44Q1p4Nb+H+wHNpvD0knwr8OFCq3pdkXvIu0dyG242DI7HYNLQdzX7uTQPhZ7fQoPfyz1UYml+6mBH
++abm+l4XZhXgnY296nEYeZl8fW1M8XNe9+T+XV2vvv/664eHhx5cw9YWPVPdfn2wJ8RrwsOQnrPXeJQ0o73G+/ZoSTlRpoExE8/DmPnBuVOxjYqwJ847P8/k
+qtCKIgw1GmI0sFczU+n/Go0SgEA9efWMrkTZP+A01mHkewi/A+0wfIIuDrf78opn8KnheD8aNSOcuBHibedJViWfooc7VVsDin7rRG/dWeLZO0s+ubNEx856
+dGNdfxhO5GR0fv1TfzyAqPXVePTj8GxwJt99orC2e9d49K+D02v57/+OcfyvvpL9yzPZv/wkB3+9Gg8mk8GZGI3l8OPVxXBw5uLbw8Ekk8PL04ubs+Hl+0y+
+u7mWl6NreTH8OLwenMnrUYZvaT8mRuctXiZ44zZiJsnETBLG4WPtZz05vBSXIzn4cXB5LScf+hcXncOCrl9P5Ono8no8fHdzPRpP5LuBvBj2310M6GWXn+TZ
+cDw4vc7E8JL/grENzwaX1/2LTE6uBqdD+GPw18HHq4v++FMG7Z6OLieDf7sBEEH/Qp71P/bfDyZyP8yK6JqVq/Ho9GaMBFWAF5jcvJtcD69vrgfy/Wh0NoGG
+J4Pxj8PTweQHeTGaTOBXN5NBJs7613188dV4dD68nvwAf7+7mQxx3oaX14Px+Obqeji6PJAfRj8NfhyM5Wn/ZjI4wwkeXeJQrz8MRuNPsAoXQ57/TP70YXD9
+YTCWw0uaqT5MweR6PDy9jn82Gsvr0fg6GqO8HLy/GL4fXJ4OYJeMoJWfhpPBgeyPhxP4wZBe+1P/kwRMCIMqbiYD+jPapRkuphyei/7Zj0PoNv/4ajSZDHmr
+4JSdfuDpdomOrTM2qzar2qBZl88icccSYbqRgyqfgbVS3sp9rTb/FZ+AH/ZmZnnQE0+c3ail63wpP6znFi30v921WvJ+hMnkQqtNlz50ivrQm1egFD3RMyG6
+VSPqr5r9rG7R8lYlvA9xNtpfzC+bAN16WoXkS2uksuS3IQwY3umXurYztdIWXh7SUKcV6CO5lYtKk7spoquCSw5APdFHIPQirHiajdXQxNSdVhVegnSXdv/Q
+YR4g+jojjNy6nHsDO4GZwhWUk/43Pn2dyfGkn8niTtm7TJ6B4NP1rJehfvcDGvJ/Q8DkncYZh4+pJ/CvNEXBo35ZAWq8WaCL9l5X4UqwahmrauQYZsSP11Lv
+TDHX6MsNe1F07kURNl2llxD0jPbBVxw7Uwz7gNvvtKEl2hDOm2tUn9nJiC7FJfgJMDBQN7Yj6il5KZU7U1n0YmnvAEwMuObb/F6XUkUQRFp/9l2F5BeOJdZ3
+2u8veAezCM1UiavormhKOVHokQNP/RLCCLdaKtAVQE4sJRhr9XrFEcdUn903ZZFThhq3cNChzPJw/yHslJZ1IL11IHYWyu9loWhSJLdpkr/2lgM98Q7B6nP5
+VdLYV5JPUKEXtYT9mDM+w6zzMgJG+8M31RB9xGPupELaPYy16rn8/hXIodcoJTZm7UaD0uanvJybB+tctTPam0iyFqdm1sRicuDyFzD0bDmQZKqN3I+D9dDG
+Ab4Kd1p4X3ui977Urf+UBj4YD08BQ3r5frviLYPaLX612i3barf4TLVbttVu0b+5/jAas1b8DE1bPq5pixdq2vIJTVu8SNOWj2ra4mWatnxa0xYv1LTlY5q2
+eImmLR/VtEVT0+Y8Kb6/kiuOFBMH26VkXYB+RaksLoxlKhGdZScY8aTPVOlcAHeQsz3vybyne+1f2BxDDFNMasz1nLAqa4evdJSMSRdd3/9bgIXVd1q+v7yR
+V9hfCn3MdO+/7wIRX+C/q7Ozi1dHvy0ByOP+/5O3RydvWvwf37zZ+f9/L/+/PFO1kqeILbHyFR80eWbA9pBneu4u6f8rcH7AvjkQ4qrSagnwKOELyb2wsRkZ
+wl7z81hfQYageUCtoDZolhYbwn4E7gintqP0eXAZ6iTfEA8Dv/BIBPjcYTcCn6mXOjHU2GfulXKuagVoaQtikVyZM1NiNq3c34Nv9w58/kxtbgkCY6oIdoSc
+CB/h9aExVenYkvSadBerMuQr5wzUHKwrs3J8AV4L49wLX1GGcEXgDA1vZJ8tBvztOpe3utRVbveE+4nkDrwz9R1bblZLq8mOc5nEURYdiXCCOznwlPXprrWe
+1fGA4UJStcpQceX0tnU50xWYNJQUtKrUDBHJmAELCUH1RhA4E3M2XXkkQxuh0TotDt4vBNqCr5EVmcwmoQjfB5fR0qyZaplmjG8luWdh9hvOmz2KsKvZnfQJ
+tIjks9Ks68LVrUH/wizHzeTOwFVlajMzBe6ooXPWQOfwvPQpAR+OTU/0Q+YLG3MPKr/XFTNe0y5FhkkPClkXhba1A9+tjEW4EJweXeQM9DYV/i5kjMelh8JG
+wRf6reBWqyf66GNA5Eu9kZcGcW+Vh0NgBptP446QsL4pQ5tQzg26YtCiVGmSlTv/LvOAgNezmpKBPJYMDzfOmPCWoUeSwqs9E0fj3awbIWVCFGTwKRvuAxAh
+QrzTDvoWdY14h2zaPPSWkckkEPKSOXJmhSHIklsTtFd5k5DyJecoFpnjhm2yREBJhFkHvK9g7iNTNro/3aSdBYuev7MRxM0JyeDHKzaZwO/07K7Eg8cHF2Zp
+qZVdI9SO8Ow67BE3sTgLAcsuYmHJp52Lz1SazyfpjXRi1dz5B0wlf9Z6Bb9ZMjtBTwxrmRP4TsXzQLs2yrdxxCCLdYV7Ym11ZR3EBySrTz9yp8hU3oOF/QqA
+HBRMwY3VHKzAHUl+GfIh3hpVQDeTM5esIiJsp5vO94j4Pe7Q0dYt44IGOS4yTrLdspvndL2GvtNuPk2JyhVPzNLY2glw9RBlPU1VWVJG714nIcReJvCmvEOC
+gmIjPcdtoR74SnUUAeT7qgAdnNvaSgvxOZbuGkGHVuQlksEEVxLMAnVseOXkFPs6Kl3k5X+skYcnI+k4p7oCDADjA7bE1BC8l5KLNJqZgh2RsHRzbVd5DR6f
+2Z28U9XSlLlVrgDWMi/z5XopCwgGYhPhjNAWoJQmHXc9JUHA42UKc5trK+yKKC0s9xNcTKqCXdPVY7rY1ihItL/n/fUIDS7I++IEVZgkTmjHicoRdNmxnv5i
+Dwj1dC1S9cBmXjniLX1bmKkq3IghmcsJUMzRzK1zteE+7uwCU+jH4pagf9GC25gu3o3K0e7oQt+rso6/Jp6QnhziHizlrdFWUiYCbxdOZEMHPj8IQ2vsGEwg
+p3QmOCi4qRFFyuB2vxRw64b+Ssx/z+9906ZyXeLjyxpq8w50soSSEulhCh/g53gFsCjFA56XtWnfKpA5XOQ/e38ADQ2DO7hJO8CGmcCvYSjrykETKTsYfs13
+nG8wa/QNkmgdPECxErKHoPvC4UlDr2HvwKIp64x8XAX8qlk6z72QI365lVN/QesN85uArYBOBkxOro0HdT8wlhvwASB9GBKrN2J2ZzDPfJxOsavBADLnK9iB
+rwqHlq09Ej/KsvE3g1vnsln2r6O/jt8HnbcRKYs8M5jy7xI3rayrdbFhrQf1CHgfPDJd58VcVvnsLiYfcffqPeb+IsgfoidUSFRa1k6dNx5iNC4G6Pcfqueo
+ErJRYyo5DSbBI9oPwJ9RBTgIMiC2P0peLPmQz7W8V1WuiViCW5CvpA/WLRTKCGxOvmrsNYpGBeD+C0xPEUxPzunTZZ1Xjw2LcuQWErbPhtaN7iOnj22rQRLU
+MTcbyDXEQ6OVhJbA5qhz4LvC+XlcTD8yE5GCUxtBa4gr17lsSTceQEQ6ajcOl0Q8bnGtKh7UD444SeYos0DaFJsQ8KJmsXfOY0jmrphuuk8QDw2ic8kYYYpU
+YY1sK+UY3SYTafP4sXSsUvBjXFDlf0I6aqEVp1n5haegabDSBetLTqSayveEUhA32Nc5lyyadtoRSLOHGd5oiSPtacb5k6zm8Ll75U/eq8cPXsN0THdIqTl7
+A1L0HeUIikOSR4RzouNR3natNG0O/DH/UMscIFn/CgcVbxmfiku6Nft23fOcak2KZ+fuzkTntoar5IFMB1VLU6JQpuXzrNXuG/MAHp0rBL0JXdq1eyxSG/B4
+IWQeZyPwl9GA2xvfZ6nGp7kpMCHsq0pShlMKBNISSEchZhvfMKdz8qXt3Fas7cOfP+flPOOldvMRQwo5NSCMjuZO5NZV75+zPUwKxR0A8iBAeqWqWg6/l8Oy
+pjAaSEVUSeJjh8IccoGsKRG+EDLkgioj2XNAoztwXI3KcqzUfo/koUe9wyjjD+XjqVrltSpyGMlPpppbIfa8pbInX8mhC+0lDppUM6XPSp3f3k3Nugo9pIqn
+5KEoNj6wmbqyIrENip1ZpKpkrAbyqXB8C297r3tC7MGNA129jmUGN3PmZQudbB+prNFe8ESOWCVPr3Q512UtIpUrCXtLJtpFP1NtkjcQ3V77FvI271nYt3vu
+Ieh237XKNuQZmqBExctIELuxtYaOzqBPS13fmTl6CR4UUQbEPs5YSYHyVdB0Zcp8Fo0H7cJWhxsMTF0dpr0J3f6IbbidCPsPO94odAfTcHqnVjWYkcOh3AeX
+p3AuzwP3PNuL/i1nlOkICTPhz+/efv3d14NTt7TkgVUlHKQiV0sk0izn7utTsy5nOSaKHh3Jj6qa3QF07K0z6MnIi9wsZhHcJge+3tlijSbTejVHNbDBXcZ7
+29s7/qLOy+27WHbvYp5tmFtnt+c2smT8ANkbGQ5QMLPgTzFVs5/lehVCkK7iCbyGJElYQCVLRXaGz5jlMpJozU7NHDVD+gyuumplwEgW6Fn3/4Smb3g/Q6P3
+upq61F9iMOEkdJfXJaPszWmMnjKVSDeblTGVUuy7Wep5vl4m5Jk/MLcJnXJBAQpn2zh+PeZe8M62YpPmS6/JyMCj520IaIucuCgznNcilieBddbTnPn3wgTB
+/4eZ79bqz2Ll4gy9Xi+QK5jhTFoKXxlqBv666NKIpC3vD10Ex3GzRSnpWnBzD3Yi0IO5geOHqwI3EPT4Pp9R/FrRw8e9Q/kT3f6JbQMCw/IvjnryAjeeJlaZ
+VjfkdfxPmcN1pnqy7/Ulcie0jO/GaTlNLi030WIciTC3Wky4PYWXeH1qWwuyqwWYsLQkJ278pC7nwlTN8+28FZWbvmM3OU5/Ji24hzNwGqJV/abi3XEdu0XE
+/RgeFnDtohVofTHKRtxruk5sC9b2v093LByQAuSCnd3ppfJXLh0OW1frGcjSzF1uIdJgqlvlXH2tmxsvOG97xPvOv7kGmcs+OvgTL/NftP0hWEC6rCu6K826
+Xq1rae+0ru0P3gY/z3Ux5+wIdwvb2lQhpuReF08diBLs18yvPj5JugRMPV83yQWA79wQDQn8PGy3xp7qtTdZibQntQ6kjfoXDMu4hiv9al3nReQ5hR893JmC
+/O0gnWytmhx71PH2C2fMqroRaGc6G6TSFBOIN108AzRRnY0V1iBHzMY3hr+jSwGBsUF3SMQhknKw/gX/dkqSQEntcqwjY9fxAUnm+nDMbSHK606Z6yBlG0dH
+LBU9c6NtSg3n6ekYHRsAvOQAR0se4gsrXXXu7x9w3be8JhjVTBydTrI04W+ccEF2l7Rae/3ide9Ygt5deQy3fw/6IjlYM9e1ygvLW3H2jC4RhTUzNVtrZjlq
+9x4Q+kSvTl7Qq54QNxhM8neD3xfoIVwz8UPtzWk4mGhmOpObVV82TJgPEckNE2cCAnFP5DnyEUHj4Iyl8EJiltXeoPTxH3y58+ZEtoXIk32VqDvNab4jkgT6
+rX3qNoFBugg//LTj5bHMT95Mktf7p4hDMjzGzTa667U3zGaLA+6s45BRO/w+Bpl0Xc147k56h9HvOJDElC/PvXUBaHwUv+2pB2S3mx6Iq5IFnoIXgDTHaIEy
+73qJFqdzgC4GTqwoXsjyj0hQh6AKaATRLZ2MQDyqaDgx1diflUar3jqXDXmhbYjcVM0wnicHTtq5Uyjl3Xnc0sVOXUi4LvJW47PpaVrTKMjCWafWEcOwYGx0
+iLBBnissb6lQwRdZFJJrQOLFz6ZcWxdinfjREQWeI++tu9c2cM+AyRtYhpMRt604b7oJPudM9ZpXEv6HNBm7Rjve+EAzYf23NeVLPvkSLokphMYShrYFHXJY
+1gcJsG409SjOERg/cK4Qxn8sf3rE4HxyJ+Ad7zgT4axGSntehkuZk2F0+r17UuKTye37QhubGMuSrcSRS6Bubw/Mb9fkEX+tmIpNK51OmWhaAd+7uj2xvp4U
+9OlQ+4S4Tui8PHrlsTFnUUZI00cgopE9d6PIxzaKSDdK+5ySD9IlPSmLVDChg2HCRRxAdhZ0RFK3bMm7k96JDx6ZxQv2n8VtBL+CbZ3sPtHefZH1mG9poqEP
+0pyIz9+RjhgGJ08qK4L/loV8s2wT835ur9wkG5Wb+GIVJGTDTu2qntRQmx81oLF74X7Rv8BjeV1s3M6SadpigjFjHkfk5FugIWYWUpdzre7NuurJzzkNIrnv
+2OfjmFTnjlesvdudtyne9WK7eHwtP5rK2+cN7kAW78voFzHZarzlsVZJKG7QmcwX8WuZwEoFXQt4LLR+RKg+sqr039bzfKaj9r6yyG67rlgPWa0d2WJSiGau
+K3OrIE9I+JfQTjxv4TOUy8kKkicddVSNgKRuUYj4F43yKV7Qxvf0E3BLBsI8FUVon0SywYaLLZ1vPABrrh7de07jdz3LmmW0GmIRpjx5YSLxRISygS0bbt50
+/h6dHFcu7TMmx1uE7VX3vqx45Ymh15fZToeKXRaPzCsmfGbx5oetEBIu8yoZdrhvvVGPp2elZyEK5IwTMkNLU3NI0rYg3m0HHdVV6NzTFN4yyYfYW1vnSBk6
+tZiB6XroMDDt15If4nXvUI6RaNWUSJ7oGep4rOJ170heug8dHnetiijFs+MqdsHP3Dain44PO0bmt9sOZdPsIwUquhHKHpbc6SFwlgDOuH8n7jd/s4JYTlHK
+kXsafBvNGWNPyVYHBl6cfGkWAYIiYi/LduXPPACm6xm+CPFcX4R8zBcBbpLmACO/y0sGKVxwO3r+8YFKU3X/QHgsc+z4xFMmX+anEb9ibtjXMPxevtcl4lkD
+EzWepzcQfPAlUbIo1dn5GDorQAARz5G85hEltV2mm9ZcyD1lZW6Jgig+SFyMZSMSIMEjBV6c4gFzl9dwa8CTs9m6UrMNzQQkLtQaybudY5csXNKE1dT/qavK
+VBabiEu8MNoxLTbSE5MnBCEpX1xPENrkTkcVZzJpCW8jwg8dU5H3lhJd6pvesZwEWk0CrfDkB2AngywcxTfhi5euoAFDVRtOG5YlXCVlYXihW4ThlopbhbeC
+AKBSJBVzXntXHK7rnS7xH4gkmkf1V6jOGR6GqK5McBdFXLxwGomJlp/VhVOD3a+4eW50oQDOygGE2FOfjNlUMGaWBMkiheE54y4THMmhWaLZrE2Gr+ssHJNU
+jcnkCoQ72hGmEvoXvVwVEDSlKbOtTjhT00HUOt1LAAQSan6Parnb1ai2UOcdhbB7CWygEzmMx9e1X9wmcbA4N1jJ1xpl5lZ6VotFXqoSxk7laFIqbXo+rAxz
+fIYiPjJaIdGxQnS5v+0dOjkFJFpH20nBo0BnzAqel/eqyPEcNAjC6cg4rjOuzYEqET7BlZ78I35WSQkEb+VcOxtjOwbkbe+4FYileSLgZtD4XBDRTUrOhGNI
+pQzzzRP8EyXBkFf8ToMbYegJaZmgG7AdmrPJHPW0zcLL0JVfafbz0T2ZvEzGL4P5Cey2+EoY2MnWMA/NZTNTi09ydIxxB0YQomJDGFKXZZ3Y0YhfZ8CbiusW
+sIIWm4j7IJlSyYxlINpbxV/0BzIurJJJV3QlC2DMUK6lYfIhAgShWjNzW+KJjG/JKhR64aJ2vt6GaJY9oAbTYVuc7teN6aZYCkf/H/Xq+QoX8BP3PG9ZrC9H
+1TTowPCGn3vPIi9hyFdxpNq8Sr6rIqrVsK0jjkWeU6eCAQD7xr9CpK9oxBLIQ9Fuol33NLpTJCa3YWtGLrWuGctW+wS65Nzu0uv/9P9dfbh6dfLbpv8/kf9/
++Ob1yetW/v/h0S7///f4D0yNqw9XoaSGIwCBAGmLDPg7+QoKBryV7rH3lVmvkG1J/A5EwLn9ffm1djzAT7BsOVpcuXf14epRztvH6qqLz+G8lVs4b0WD8/YW
+duh/Xd2teqUmt83nEt3CEDPHckv/FF0Mt9mTnacXNnrmagX4+g2hVECYFVcme2bKv3EVSlofOIzTjbQKEKdi79wYqgYHy5KXttYK7RsYC9XSwgHIc2OoxsPq
+brUwZg/sm/RwY58QOWDvsDiAJQTU14jze3DyIuL5Q0HCrF/5ktIc8qXuyYGa3bnfU65g4BLEzQUAT85ZdT8r18spHJ5ROQtpOHhYnfnmOhd0qaiUrG8n7V0m
+uJSFVAVWd6Eo+lpHENzOjC5VuxZ97RhBGDzMJHSPo82Y9LajNbR711M2cV27ojGPYXTsAIrE7qWRaZXz9AfiTjVCm76miutGpCLXJu2xCztF6S+h0NTvyMR8
+9eEqc9wvIXcGT9B/ibiV+Rx9/S97vWcQK8McnQ1+HFyMrpDk93rQ//iPwq7cObYnKZbF84nfnkOxLF5A/PYkxbJ4PvHbkxTL4qXEb9splsXLid8eoVgWLyR+
+66JY9hcFuM6QbsEs5L0p1mUNOoYvWo4Hdqnm6ENcuhrOlMVjhSnlVN+pYuGkZhA5QqSXA2cr8mWr5/I+V3KwVHkhVfvuPXclvWK0n2lILa/BwCcrIln3TPZW
+665T/y9bOaahof9bl3M5KG/zUndIElUnLf6nLufADvkvPfFPZP8d/XH8b4dvXr9+27L/3u743/5w++9omwF4dLwzAHcG4M4A3BmAOwNwZwD+DgZgICh6gSXo
+ntmZhDuTcGcS/jOahPDznSX46H9Xhf5lbX/T8N9T9t/J67dvd/U//6D/goV3fHh4jEWurpHBZK7v1NqiZefSWcZPWnatAiqqnMfo28TukPt7rt703kEmHrX8
+5OfU1hGfa/oFYyuiNqYKWFheqSeajeHTnIyP2anMOtvmgfvHtgJnhbIW04Hsi61B0bbGJs+1Bqnqfdi3Yqt52KyJOTXT/zpzu91Ut/JJE3HyiImYjL80hFZO
+P/3sopgiHqDsNZrNif7tNrc1qvCItAagdXNqJFX7PFujhj8PzGLeFqtNQwa4GpbRC3vxnH19IJ6jYZ+OzgYf+jcT1GwTtbOlZguvZstfrWaLRwvsfJaaLToL
+7IQB7gpa/mMXtPwH0v+MrW8rPfm337AE/BP63/Hxm5Om/vfNm5Od/vf7rn/Il/moSkXAWjlB9j8hAAq8hJoanJaqrOQnbUbc2tEn3705kFemIkWkFUJ4++r4
+8OgwI6sxvP09UYifUbllfDn5sMT2pl5nlHCmb2PexZsyR1caAc1PVZEvTFXmSoircJ2Sny5DjSotJuPVG92hy+a1bahNjWozwfe70C7Bgj9Q/loPcHWE2Vd6
+unF44Ey0ddtu3Y9VPKzgWSks4NfW+kT9YML3NlI+MJvTrHJMaui+zm4uhz8OxhMWgKf9i+H5aHw57EdX2fUI5SzcmJ/QZdR2F/l7LL7ctl1k0b0OHp5rd+tk
+XrI/Ic7x0oG792x0egPXXR+upkw4/8mj4/rQh2t6cPmyimuPtYiDPx+e9i8uPnkFYsJ3U5cyI35VtcBYmRHblJketucnzGtpHwbjwc3lGVzIE7q35R6qZXvi
+XX8yhDW4PHt6Ai9HcvTuYvgeJ34CO4TfID/2QV247F+eDjIxubm6Go2vM3lzdda/hkkYXH6Ar2DRJrhDPo7OcO6woR2a/Df572pT35ny1fFvCQF//P4/Pj78
+5rB5/7853sX/f5f/rj5dfxhdBmlwPrq5PMMTJy+Gp4PLyUDiUR9dymN0o6BT2X3Vfz8ekFGR2yT1irZVMJXPzbqcO6/P1eQcHD7ushp6P7M0lRwhH+N/ut9y
+xEnvHThuYS5oElgmkCpLpHf1/h71YO8gQhuYKvGyuPt8m3+KXDVRsmgUNSvnsbPFOXm4s7Lv7vdMXk3O+YYXzJriRiSVLE0ZsaOk1CloZr9CihzP52qCUwim
+TxWb/9SZrLUFb7iu3KjAjeOKFhPnV7HJHCN4XKKXuKZF0Hiy1tT6pVSFodrayG26iZvhwGXmfUWZ4ARWYvaCSfiqY3bwZfQdqzRxHleGVUUzsZeqfceHh0cZ
+/O8x/u8J/u9r/N83GSUnbN98P4gOd+YeE5IFUrlnD1l4mvXpxi8s+cSGdBAg+7T2X7lFsFI118HT24EKPpeU8RaxHFvBvXL6pqpqV2OZ9UxHysNs9q2lTskX
+mPBMRS4+aI2rkZWhz6ygMntIHXhQaVoExrqpfTmtcg0ps8slHDNWyF25L4wu1Yanl/1sk3OZW0dW6SY+psj3/YAvSqeSwDTllp7/2P/LYAJhz/EAnEZO6UOv
+RqxltUs29+Q7dhKcy8Ff+x+vLgboThJeAyP1MbwG1BtQg1JdLn1x9N5PXYHd0Th1OF1+6tDS4FfXH/rXkaYrWFj/NLy4QB1xeHk+Hl6+H7CLZTg+Y018PHz/
+4XpCjj7oOun08EiiurMYx3dBE+hIgZeNUbnk13EfRadzaqtHajQmP1J/IvtyPJjcXKDejjrdJ9R0z4YT8o7Bv5IQqbxBVZ/en7nenQ3Gwx/718MfBzAn48Ho
+/Fm+G/4tYRauO8U0YVHUujZIdI9ZqCDoqZDbegU7LxDxTyutZncCK7LZzguhJ8Q3AM6gQjGOZLT9WnunCAMzhw+oRAcVxaQ6pYF7A96mIOMbBLmq6lJX8DFm
+0P7N5IgeKZFi0N3CsOzQKy+VkrGL0IkGb8eqaSNjS96bbOGN+C/y+GNJTf+ttO6iegz1ZSoJkjefEd2x62EmWLTVd3k1x2FC0dRve/Id8UtjRnFe2poxTKZq
+agEsQLLokvVSa6rFFC4CB6N52U3ek+8Go6vBZe909NGdGxHUHzgjfFyOe4dC0I/dR/j3ZHQzPh10qE5Ovzp6pn71TkOxI4hMy/09+geUn71T96QbSQPVyLRQ
+tTx6eygnqlK1uVWyf6/Ltc7kRJW1kqeFqlQmT/vyuzeHb44y+bQ6Jp6vjjVcJx06mHiWDib396BPIU74cqWMZshdLW0VTfAPYj9MU0t79QI1TSRqmvz1apor
+CfK4mhZP0yOai2grazJV1qCh7jnLbawlifiN2TOUJbldWeL3BUUgHc2z1QF37vxV/dkagWhoBLKpETTf9JRSICKlQP5apUAk7q/ImfMC1eC1E2mfqR3E7xWu
+y79aRxAYd0KNwKsK8LtYWUhe/Zh6IJ6BmgrqwZvfUT3Yrop4neAWsJilY+SZU4XRVaXrQCDJNCO2g31wUkPfEv93xvw0DJJYFDkVvICnAntVT/yxeguLgrbq
+Itr9eFJ14cY6tBdBYXeYyN9SfcGKNGVgLsmo4lsiX/dkYW6NTYBoIga04a8KNYWg+/Jr/HHvrl4WjlgUsRVqNjPVnEujw0vCbFjPBOKY4h/0VK7UrSZF9fnq
+FbQboLgNJUugkiV/lZJ1ejkeoroktqpL+whrp5vpqPd2enQAsns0vu5fXn8vry4G/clAjgd9clWfjy4uRj9BOCG0cNofD85vLi4+9YR490meXgxP/4JiBhze
+p6eDq+s9iE0DiOHyDPzPAGEYXIx+QlkDT4yu0ISRw8vJdf/igmSU6LZfoJMZbG8ljyKp9Wl0Q6gC6BS4+eWH/o88zjNxPcLeXw/GHz1c4mzIdxiHPFpT03uB
+g+6U/QqYjGAqeekqOINXBApWiWGZ1zle4LatXEpQLr/97o28qrStTSl/ustrLc8qVJHG+Fkmf+xDstB3R2J/DxYWtNQv5PQTsbqfzPBjOuc2v59IdE4skE2V
+aeFckUYUDiKChIau3LOFYZtS9te3a1vL15k4Pjw89P5H2qGfo7Z2+BLxcDzmTHz1d+NNxGl5jk9RbFNTYTK67qZUSU3el4nPV1L7BSy4qjUMPi9lkes1Xq/d
+3YiEI5KyQtmbvOZK8yKA8mr9Sy33zTKvaydf/2Ntam0Pvpd77d2dkYo8j3RiYZ+xqfJyW0dZXvh/C75TCjNT/sbQYb+HeyAMYl3m/wGG5Qqm0BJxnWOTrsS+
+RwwoeafKeaEPvkfZ0Ts+/vro8Oio2QXpCxtNtTRTXktMJ1JwC/+ywfsXi7hu69zN+OJ7vkLv5kWP3otZIfGb935jVy3t8i/lsI1LeHyO05ayygRv/eC1rRG9
+6aqzw79i6G3TbUuDIvsBBVLLe8tn+/lGGzbz60028JA+arKl7/nDDLagFkyPXujLxRH83Zlr8YC/nLn2O3tzfydz7ce8us3/bow13I9fxsuMTf3xbuYin/0c
+lc7z9sB0XdemZP5LJ0vnGZPqdphPYqt32h2ETh91h/kknm8+UW/l6U/DDgsA4Fl8FA973/UO5fWH8ejm/Qd51DtuY+uO5Cv4vzeZnNT57A7Vk4+qvsNawHZ2
+J051WVfrpewvba2ruVoSGO8S2YQLVc45eaRNC/A7wPAS4N1C6w6gndwCtBNtoF0HaC4UWeLq+qpuIfREQOiFEbfatOvVylQ4v00zJKpchf4KWPru1ZBuNUyF
+qx9nXUC/5/e6qnPLBQFJi8jrDXQM9Ct2GyQ5PSyZWgn5zGE7yxycUVDOQhhjT4jJ9fD0Awr/j/3rD4OP/evh5PSDPB1cXo9vPsb37sVFdL3Ln4bXH+R48L4/
+RpO4kQQZAODw2IsRcVkHzvGJjrZB++6a9Kj9R+9LvOn4nz996F9PRgiQp8sT3no+Hn1s57JGePsEJt+/lP1TCjGfR5j5Djg8QuaHo5sJP9ACUI4c7v5yQC3i
+1Hs1ZSyuBuPz0fhjH/NXGyjLHRjvH+W/f7u6eHX0h/K/Hh2evO3gfz3e4f9+j//gwP+bvLp5dzE89TqD84UcpRywp0QB9AqgVvK6MkVR69md7E8yeWmqBwVq
+1OBeV8hMnlD11FSrt/Nid/6oKEPyuk0qXYQYZG2ktrUiQhJwb83NEj60d049IMARXpv++qo0FA+vHY1G1GRg5sBikOw0xIrSLone/dTVFODKEXFuvbtGlbvh
+kUnea3JeNxBcfYCIWtiVUDtXfpiceTf/iIbVIieAU7Kj1QIX4+wu9Iu1nryUlV7oqqKlUDaJr/ZkPEbh64YFH0C6bpFyEMK/Ifu3mr9CndpRi9SEk+MKxd5l
+kj6OL4hSP8wCVzbSPpB6fW0hzBD3XYj3HNtwNVmPiJgFHnJhD3gicY86pdRqLPJT33n7ya20Uw6RJwYVdFr0OVVC2VA6LGwC+Iz0wtjdFjfWkz/dmUKDRQAz
+A1bn45NpIsafvNauImSRlz/jexuhd/booMPW5rdlvkAFdQYxJ8U1WZL+oDPa0Q11H8zQPjS/Lmk7aCpVl6rOIirEsFKzn9WtjqsIvALbCRXSSsMLZiwQXsVQ
+T2/aCU6xdj3ilGWbOuX5UOUYoijczvGFJMLciNzGZ4r8fW7k6HZLfV21SZ5vzguSMSVPZFQAHGfFuQUtOA5VrUMp8bBW6HYD952qZ3eaK2aFdB03RdgZX7om
+eSNVwfuYuuh8AQ5wU0tTcTVz3BdNs8TXn40O0RT2qC6fmo1KhzWIqgo4UqVmFELEQQg6czA//lia562irI14bBFgPFxgt0lG5ZvwOxYWQPifUQmSyFnpha51
+YjcvfZUEJ/ejclBW79sDR9nUGgm5Sd1+i8awVLO7vNSv9C96tq5dxaBlu9+m6vit8L/1x3LbwJtmLtJeceEGq2W83+Jqsdhl2FQO69t5VbuN1GAEmEbP69LC
+uuC7yQc2y1d5nK63dSpUpYlay3mRKz3TILzpPqV6VP7xSqs5/jAmdahN2j89F7HYdCIKOpae6qSa1uxOVbdaTvXGcPRyZmxtYdXnqka/VGkXrrIX3vvojcpL
+XbYOXSL5oVIp6Q2Yv9DDUodb5y49nGmRDr19m6QQqk6tIrkb3oQd64Blpspv85JitU9uOdL2lqsc6gPhnQXTUq3LWCOQXiNwZV/coUHpvvH1qgimTv5ef5Do
+p53tZbLSa4tDhU6Y0pN3YEv+YhVdF+vLB8ulZ6m1jCr+RtuNiEsaIboQOas0lnGGs8Ry/ZkH5wn54TpEa991hhxjy3POkojPErX7jOMhm8ejKRlClV6vXrfG
+ibKeV4r8xLiX9Kswx8KvF3y1dcGokbwkf13H/EHlx1h24HzS/Rz1Cbs+1X4+50Za0zEdVgQKPI962LQeT0YSlXbDHxOlIAmFYTwKWFW87eMYG3x964oRooXg
+39u+mETzzMJO1LBqMW8Nv49jixu3cuCxLDbSlNC3C1+iib3svpLhsJSl4agqBSE6u8KFFp2OQuaRxRi0L6WHBTK5BpuMJ/Y5amZhkE0H0c/o/azMIqf4ANca
+xkpsGbr16a+OcnSuHKQvSOf6k4mowhwXlERayueXlcsYICYa1d/Ke1Xlrn5VBq9Q5cbf6Dauoigujas3uSEDOtFhu6/vRDHCmCxJwctRkr8CTsy/DC/PYgcs
++A3i6OjZYDJ8f5l9XkKyEKd3hvO/LtRDw+gGjTEKrsEEXagH3G3sdpBnuV2ta21DsMvqui70HEJtI1sYeQrTfmrW1a4sVfLfv92ti+K3pX97kv/j9UmL/+Pw
+ZJf/+/utf9YO/52Al++EJInHBk5mORadBIlyrWd3pSnM7cZDBjEIpSsQ2PLULF1ld/z5j7ldq8IlkpiFfK/NUoOclpO6Ws/AerNyH17H32y4uYMGocfHvCy1
+NbUSAoy34nv5HzCG/4r/C+C8JvGlZ4DEsZJR3vGeHn+f27ict1AYttZzCg5GP0r9U6h7qE0CWbrPlQQQEr0SEIS+jz0x9D4/5qHEuOI88xpMxqpOt0uwi++O
+DLgjCru2rX5mlUODNyfyMw5pLvICaUCOe7Kf0tYhPg2+9ppL3B2A090GTxhHRs2CJkl4gzMexA/UsF8Ufsj/Fu/PMAekG+QarS1vM/ir20b2PUX9sSFunfrB
+IK8FKTBEgMzbftM0c7339ja/d1gyF3llULUFdybBI90vUqpC+GTOcI70G6eOVVpZwt1S8mz8K3IZ/JTYEfCeTsWWh7F9OkR7OqJR893vxuzj4F6jjvRh0dzd
+jYPhiMPJ61waX4M69O52reATrZuVoblfVO3We16tKeBccI3rnny3BoMJYuW4ARb5L9q6M2R1ieYVHrD/MV3fBnHwA8YBqJ4//Z4J3FGDntXsjF6yWxysS5uD
+U+yf4v4ff9Cnxr466h39Ufwfb46+eXPUvP9Pvtnxv/4u/431XH5QtYRd0Agsyfuj3pG7Us4G58NLSkCAEMtRT+6dOupoU+3JpVallQClw1CAK6ZOSDNXS59+
+rr1jGb9lfSBxaGMmw3H6DvkjCT73LvZcALI/jqKMnOw6NXP0fuamEknj0tc5l1HzQTp/bKNfpzycUFdARI9ib0+wt0SfD6+Ou5n0CeYifYWpmoMRXYPBDn5M
+3ZR5SXM+U1ZHxvDKUaYx3hh7+Lon9waFntWVKfMZ2EzBEflRQ8w0t0vXbSWX7iPnVSg2HFPSc8E+R3/fxLG7mVku1yVXtsef6fBW5wxy3qGeczof9d5A/7w/
+xnUkntMk0hUVPZjQNQU/wZG+7cm9IfsZzpyfIV6QPMk+4Q3rMetzvgpEq40QOfEvdJcnu/JwYw1+ucuneS372JtvenLvAnxCFVbYDjOM6GsKbNLa68ZwTdVa
+SaJJnvGLW4Zx6tMN5RrcHH8LfaFPw3yk9MRHve96ci/ZZ77L5SaJhZhKznWha1/RBPcE5DpA2FGYSlqn20OfdM5L1nEiqBy9vs/N2jZkASpDIt0HcYIOTKXV
+FaueqMtmUiWNyByU435P9p8zApBUzgGpsME4wO+6LvwSdXdbiHf0vlI/UCMMEsSWbMgJ6Dzrj7V71Ds67Mm95AG3RPHORDYdMMNizRC3Dm263Iq5trMqn4Yo
+wnN3Nkx7KmoR7YgNZy5pCqu/MLKa1ysOG0Z55RS7BL/zxh0Bmt90gyd7+qgHuSN7UY/jI77yiAeSFgtOe4t20QIVQYD7ilbcM687AkTrQluZhzUEMuk1H4uQ
+TIbI74WaaTnXC3R3mtJtS5jtVW09HTZeiqbgeEkwlxlH7C8DVcogGqluvmf9jp3W83yx0BU7KKFRVeUWhqRuocO1ePwMkkx90EVBPJ9Z5GJOJdNC0HT6S/Ar
+C/7qfEYRkWQfcUUIJkVfriptLRXBB69Bfq8KjFQloUrE2lRmVcGcyrl2z+WUbTPXr+hZWJ0Y7gIpbsVGhG7DIpeGvfK0a0Cx+GTWQao17gNFMSl3L+hfdDUj
+7CzDRnAPZyKgQXJvBheF22pOFGfpZsf202g1zWZyIOzaW/sTTVWe3vaOiNQ86lwOe4rG4gxqdJVzz93dgnvMZuTawH8UeKJwI+U8Hry3Tel+geOBmlT0UsZV
+h7sl7O1M7vEzbkb31QG7vB/ASU9edbgOgocd9r5yASj6kJd2GdhnnZuchoPRNdigYsp1S9B6i2D2OJ796YE0D5y7QDfCgpDOM2hz/83h/3kgueqI07PMuoY7
+C886wshQKZvqUi9yCASkDUZ9og0Fup9TpN9Vqpx7HRBnfM6i3/0kOEOsS7AkqJwVTMoVY7GdyPRHm3eWL/f0iArfE/zlVzbpGAN/UIrxJVTFezc+66Q9QPYq
+ZWQDyblnwBHHvSM68G1dCYFZ275M2VY+mbVQUZJqM301BW95uEkSV/XJHCiAC1A54TyDENFVDakdKl+CGoC7k/MOIqIWl3zAua4+BxZf4yOUCR5ItMXofofO
+dtCqatjQ4iHAZb0yoGSkLf4ArxQCNjVtg5Ui3aQ0D9AivEEtEPTzUJJQTY54e+ozwRmHGfva1JzxezA4C7J/f++mzov8PyEB+/EhCjfEDMNv7LJJw1gUwnYp
+h9R/0uDAC6amBahIkOUNrECY04OCGwLFtZHcE/ncqeYyHbI2KAlv0QCtkr6wz8i/NH7NYl1BU+kKCVOxhu51sGMwT2PrlHc7lriLP29tdPnIRhcv2ujy993o
+yZT46nBTXtd4zN0rQ6qHoBTQCFGIWaCu8md78hH51zSL+LiIL35cEo9AbUS8/TqcEd1D3X4YxEsOg3zsMIgX9CacCbnlTIgXnwnZPhNSypNelPo5uoyZoMEL
+D7GJCLMTsvLomkhfQNoLjJz2GryxNvSxwI/dDBAy8Vm2cKzVszQWhYcweGULj3dTjw3qWrof29htYcpisxVq9bgqmP7GmbmipQzSsn5qRzCSAI5rB08XYCY2
+InyfDg+aCmMIaCvYOWaxIFxpvuT0OsqptXScN53zxNilmjEeDpdhnXrvCk8mhS/T+akT9M1XTgGHnY0T0pMfHCtEKJ+KEyFUwG2qImAecCQucBP9gFtODGKe
+bXHSe4NBJIiPkWHhYRyx6wmt/XgPb9nCIvo4bGEXYEvpHbAb4R1kzPqNJbo3FgQeVem9dfJJrx/flog8ghNmlhQkwq7Epqdf29BBdJsaJmJAXUN4XQPFscwX
+zUFBB5/sVIZTIloQXUz1rCWci1rWD7q413L/6PhALk1Z31lJ8t1H4fLaYY6KjZjqmVpGPclk3JjNf5H7bxsNqY4arWSxJQ7hdOF9bdrGwGtDgj/s6R4pvwjB
+sitT2tyNERGAPkek3iKMaHoi9qgYjvSMlUf6hrzkoB56xZPEaJdL0exdaep8sXEHqa3gs4CJZ8UzfiO5SJL5gOPKFyi9KDRNlmQUD+x5Qwfy+8VUSzXDyKiK
+RlA1mnRheW4EN3P3lIjg73Zu62RFKTs7XU6SkeDecL3mLoqY1CNppnVuaB67JzFU3iIfDwJOZ5vbck1UYHpm7NcHIJng3tXkXOJ5bfhQ/T1BVjfYeskd5i/W
+TSqV6riiHXoxnSR1S+/YnT85geEJBuiIJK9xO8DFxxkwWd7qXtdV5lHUwhfiC6eh4eB1ldqcv6HYyMjjALQ96OBN3bduc8npliVgb5xTGZp4gJbEk3np3R/R
+caXLGtRSDmGwbzUvu+WrwPuy6CBEzeNbxd1WUdReGtJtnbdCdNvzqKu97slhbEJcORPio6rh1iYT4hrFwRVaHKdoWyCa4pMDa7gizg6szMYJmSHkXQvvEN5M
+4XSQMpahCy5pruh6rch5AlptXlu5Rn2UpqFdlPqgE84RACwe3536SyMJUOc1FvG7GLzvX+zxNLsp5oAjDMrvYhpoRFlKX+elsGugSQMBL+e6Vnnh5sYLfpRi
+OHd037qDNqs9VoWgFgQRCZNMtxKdtp9Ra6u2iphUnxFOezzpHZO+RHBJOGUrOC9RdXCcA5q4lPfAvThysdbBqkIXAjZaQ+cYlVLrlZX7LvUp3ByRi1cAlAs+
+K6hGMdaWt1gt2B7E1tFMFTNO5KwNVw2W9Z0BHffO49vnrW1PCwCxmDCV/pZ2oJYemY6xadW/Gvr9XrVkjupMalgSIwuHAYTT081DGRmc3jSlfUZ3bLcRmAM+
+f+nNx/7VMNrrCO9P0nTiUsp52VhOVGTfwGXKUZ1Lrmka5O98TSPSnDjpJFUI/nDYWUCDHaZEFjDH3qXNhGuJLHMXLT0q6FGiWEmkW4cFgMKRaSsS6cZLJZx/
+ggnfsMZ0vHr79iDYDGo+p1QDlO0YUYz2gKhN1M3wGsIrlaZmbDfpdysw/WGrRyI+Bg4k2jxeqGt8Lq9tCJc2IfdufdOGhQqqlD9eCm3GewWiB68+A8SHeaEP
+eGqVXFuI8riin0X+M4RMaiMLY37GRaG2+EVRqigmJEBn0YrKnA7DGSBKLjQqh5lw4K/M0Zsgul4vS5brRe5tqGmR34a4W7xcYdlFw+TuCdGy+ygTAw1vU9Jp
+NQ+ubHnwgbQqmbevcA5HxwiPcDhQ6Oa1VFNrinWNyM1Cqyp1c37WBMjc0sSSPgKvRMLCzEuQmNWNSd2gycVjygsa/cl2dlw5oQ95OVtX1WNqkNsUUTtSWQGb
+za4L9Fs/f8hktaJIXFBi0AlgNs4aSdGRWsQOLtuZTBnvDJEn6hTFfmFPsEEUZ1yh/e6vxKNXJ703UfrGUteCBFRDic2cUQtdaQFHbc1yKTXaRNOD1LqfcvuM
+LD3nxRJRbFrOU83/zjxIisU7QYqDWqyLRY5uTmg2OnYimQb2e/FonFNiZkq7ymdrs7bFJkk9TJRZ0anMZltUWbwLIciNOUudiq14QvS3lNuu/YGGsO+V2ObG
+ozlXPu/E8J1PYe2MexdFztj7EgJzoUa0W6dG9q0zpvOSAsc5puf7lP7OtU5ooHzn3NXZYTp4qjVV13q5qmVtJLo4KcjnnCN+Zr+ybmbbGBA/WR4cs5U5wXty
+2TZ4egHchIcJdKN7vrTlNWBdHRtqLwPOeadkxXmabragvbZdBM8Sw+IJMSyfLYbFU2JYxmLYBxfaUpbtimhldGv72BjrlIo9r434YJLoVhDfdVaYl09UmBfe
+QqPWa7wXvunF8Z3oAnAkhvHXxMW3nLZ7L6Lw0svQcx3cGCJ+JU4/oEOK0HE5LJ0ONVMgBtINzZm96W3Ucn+rKhba7sC3TXmw4i9dvt31SJ6OPl5BtdEbpAWd
+XPexzvcIWMfe31wQt6nw6mu+9MorvIIDTU2SkwamJekoTS2TW8LD1iz1I4ACp/DCTbkmnziT86ARfqdLP13foy8l7sz2ZeLbYKl+yZfrpYu3ucH9gM6XWc7S
+qPPdjPGtDO5ii/sdZYma32HMwBkCLKbIuw0JU4kfpogyYpXPiEbjTG+kWiw0bG0k6olvbnfVNvP6I0u8KzgBbhzUD1vPe0BS7OSwHeZaTw4w/VTWRkRxezcR
+HOTtmjHmM+kaRvCAFBtBPhDexbETxCwkVi0AQ9f+nBcF+1mdsxyvCITmyLwmVzmnIE/hQqMNECaoNt5JvMCO3uf6IQTK0OXC1Ih4E4UMeNqeswhkTA7odVnn
+RczfGPGX4N6IPL4KUrL8Qc1Lr+768/TYzqfD/KYn+1dXF1z1tsn1z6ltF206qlnsxN2iw9+BqKprNbsjJVB0mPbepnMqW0PeuOmNIm0tiLbPxfKUkSgKQ6zN
+/QDCMQadJZ0k5q3QrfPmOzWh0a7wLzZlCy7FSoGxHszrjlgCKo3H517TOC7Qry60lx+u2xLJl79uqKLzhZ89zNM0XIF7ls4odkU5snt+PTHVV8vIQqHd+rbn
+qpd5jmm/UxGveKkfItstnlykKLB3eEYRxk3KQakfmtQNvvALjS1fkqzPl5rhNvx7Ot5TzN3TJeqVFuyEdW6RIpl/Jsr1corq0FswdgYoj+FVaV9H5ayxs723
+kLvuEZpKRC7shonnF9S7mooHtSElKy9JHIAUymvv0k72hPIxx17UhDWRL2btTkLbmklbI82wI5YZ9zQa3XTjVgyKGQp0zYSkC/4Kp8UbBp6tKin/EkL9wVsn
++FQgacgDM2c5yRqBxNGjHDrNvSRCGIx6ecZ81g9ZyQ0Bd4x3tfInW1rMPonODU8yOg3mRmJEBXtjmJArr73AdTj6l+PX42gF6DiVRtcjGpxevJlA/7u6q5TV
+Vu4NTkeTvUzuAdoT/p+XAf/8MLi62HNWy8yUC6SdLjbS5sscNie1IuaGOkwcxKrcOFpruYnf70JWgcbamWPBBhP4RGMruQZ8rsN2Iy2Fr4oGfFXun+dUjIiV
+oY7YW4RsTAGCwlSJneSaoEsv0aWCZ5uCFwR5gZ8vLeALrEj406dN2rhmws0BSsdvep7oeDAG+ehJNcTp6MfBeHBGYNrhJBThpwL88Z2fluKXXIof2HqBybeb
+p2MwRA5grpUwOIuqJUQkHqGVuEpCoFMWvv5ss7vn48GAWD/OB6fXk5j2A4ovnA+vZZPsQ0QVES5Hl6+43sHw8n0PXwHcyeOBHA8nf5H9ieQCSP920/c0IhEh
+sRidd/YLxgOVlXpy8mF0c3GGU5L8CCZ6wP2G2gPDS0GlH4DX+ZrKMu1DbQVoHjMegdB58OPgYnQ1GDtKZ6JYRgJmQLqNxgeyP5ncfBwI6tUEKZbhl5eD08Fk
+0h9/kpPB+MfhKU77eHDVH46JOHo8Jv7lHi162DAi2jDwqsn1EAw5KFUhYVGJaxomuKkl9uTlyBWbiCZAuFnq31x/GI2H//fgTEI1Bdpyg78idXy0/0JX6LL/
+tof1qIaXbEJet51U3j3kBLkHatHlHMowJOUZBDtTFxgoTa1PEh3MYgpv8T9aV3zvUQkH/HleipNDOYcb1iyCzqWIS4/kBv2cWBIC4tV2uRNFiM1R3Lrw/PEs
+Key6us/vAyAuyUaNVa4rX7TBJUmR0yGvuPhK1iBokJo0k4hXK3pBy1uQdIcW7LtedLBhM1wM2U0gBK3z5UieDsenNx8n13CsqNSY/4oMq+sPg9H4U6AhB2Jx
+ue+FiAjk4wdZxEke13POmGy980xl4USJ6ESFQiEOTzoaE+t5OM3+N5MbsJlIyGJr52Jyc/qBxA9UjUkqpcAZTw7y1WA8CYWupWN2z2Ky91AoZTvfOx+3D32Y
+AmRsf0zSCvfcuSusMjqX70ejM6gJk8mfRuO/yMn16Oqq/36QoVfnBho97w8vbsYoRz/2L85vLonfXXDnYQVhrp2I+giiuaOKC9bS4Xorfq4+0UIJLEX3bgDf
+XoLM7a7FMuInueWeE0LRlhN+y0Vla/7/7P3pcuPIliaK1m9/Cr+yPp2iHQghKRRDRv7oy5AYEdytqUkpc8cpK9sHIp0SdoIACwClVL1Rv8btF7u2Bh8BkJQU
+OW7KrGpnkKDDZ1++1vq+Dy64X0myRn8JXXAy6F99kRd6OPqncnj+t+vRV8uWL4At36ntd2OH+l4fGYO/Xw3O6SXDYxzy0/5PsO9/GX4cXo3p57aSsRhfnA3k
+365Hw/HJEPtyLE8uqKKgHciFHp9ej1nb3V9UdmqIrpkRyfEFibnbcmCcnILO+l+9vhFwihH4fj+W1/E4lp9h5p+jlMfg/ISUfYigpxFHAkTWfK5KxC6ltZpH
+O4RpTuieKDWAi8yfo/fyOP4Uj2KA8+wfyN0L8GGC+kcvwtAPXaRkMfMKboBLd3CDdh4RjUcCjQusluOthieWVVAreXAYHx4cit2xWuh6IfhCq43hQRE+jnWx
+Hx4evovfHe4f7h3I+q4slrd3wnx0JHf/tsyVbjFspNTjaLxDbeUgn8rrSpWVTCbos23zLsOVge7ejSgJHWA8ogexPBuOjwenp/3zwcX1ODhLS7UoVYURSo+e
+MTFqOpMin6gyd90Jc0zZkhrvP5wxpJpPnYa7FKnBM23OLnOVz4pyoigplsAJ+rfCsKqVCgKqaspN9WA+XsaKvig4pQYqrq3CSVasNhA6krtKuyiddwrnZrmG
+Ia/nCiqldWVElfaK2V6WPAj7rlj+FDi0p5pezsQoTcpwkes0MFx3k7RO/0vB6UyZxbmGYk7ukrLG+UJOv7Sif0HphbyBi5qq4AXEH3Sdo98VZaEwMtCfqzKd
+JOQQX+aZqpzG0czARfNQpjUqEbHjl+qtQ6bkwHZmAXcr6Ws+6h94IdZ2kBFN8J6dGo5ba2bYOG9SwlQm5U1ak9poZP34WYGeJeq+BfLhC8o0Y/pQ52fkv4c6
+Oh8GoWBPRBArBtc2nOVp7srey+NiiYkKrjiyDbknS4h1E9b1b/2z8atBPmWGwR+EdvlT5K5ObzerSdBFcKX/57JMq2k6cTOhP6kpBsORqdD46s8LBODknCFB
+7iqn7rT9EMElgZE3aG/rMIgwzRvHogU040Q54IhYljU9yqRlnMemRFLXRZmrx0rOFLO6q18W5MLBRIPExwM5k/+cL9fHRX6vcjJ6c3nMwN/KONpJ/tFw1CWU
+kva5KKaYP65+QeB49sgbgJoiUEPABuOHf2hpm73DxtyT/HaZ3GKxiUUe63EVcELW5VJNNcoefzUtKSXSehQMn3uTnAMw6XAHvTgfDx2TiESShOD4TJpjOLHS
+iVyM1/fghkmFNyQ1DS9SFsxyFMmurH7NsZqUCHjvzqUulgi+Q3+Vm5dbzDyQfODV9tQfyA8rixmnloZJXg4ExcI1KGqKU50CdDrhkxxKVBXYSAhmgVVxdGht
+btwsmdRFyfFkE8knShYMnOHtSxEOIiV1aycKbDqOk7hy3Ov/c5lSMJ2VLXFoX8eyf0Lizf1TLelsrVM2W+FOgxbi8cU40GMRnSp/Zmtx1QbrwoTurIMfOsc4
+88VKRLl3q390HZ26NHvwYBKhwPyixHqAs0Y4BQYgK24LuecLKQaygPA+nj7s2MZrrxGH4OIiz3EI5UaEfuf/ZAAMceuhmqDDA6kDq8JzFrp6GM3YiC9XqNWQ
+nUrgsYBVoPeluct200h7as/c4as+Jfka1hU3rwJyONyw4jGt75Mle9ZpZ4KD+sJmFe7JqyYee6Sm4gs53XEnx+Cn+Xn2SDPGpLZbzPGkcBdkSx5+5IV5bJgC
+puhtmdTstGmUmeZhcEAfNTfK4UqfBuQIXYV5eXcEb/bmReMnOJWdqc1Zb+kUKhQSIonm7hbLwd/xiin7QuxcBTxC+CTGqFsI4VcwOIgfjbrPgdyFhw1/U+8H
+E6zIi5pXi36LsieGSTSzCTamynrSUwJ8QP5tdoFaMgwJOExLNb1LasQfCaOm0CFc5Fw23AdaVIAjEXq0pefR5tXEhzkjUbMUDvOxUt7LZgXFvbRQoT2/6arh
+ELngtuSkazRqzmJU3lYBrcGR2pOD+Y2aUjg2n6W3yxLXxMVClWQMjh8ruHxbFC/cYBUee6/3I7hvvu8iyGhlhUorE5ETlxrn7SDxzXaL99NAuOs9vvD7SKJC
+vdlKh/kkFuALJQElOdJCnTtC6Bn9UYhLh6rKXOaVbr8+mDgCv66LRLOLXHlXG2CP5Zpmiqc0U+62z+MeOoNF2AFCuKLJcnhODq7L0cXJ9TFM1DF8hh54E7/5
++NWc5OiJO/+qozDCxmA8QWvHSeiJVg9AATMin2njZ2K10qXsolqX0BDj2D+BzDERKGLCCylC0KJ+qR2j1kXqeEaFkZAe/H1wdnnaB7dxp7d0d3XDxeXo4vh6
+RNK16Gn8yBEQ9JGiH5NCKoPxD7KhoBk5Epo/QNd/BJlq6Jrh+dVgNLq+BHusJ79c/ISKnMf9a4iTQR+ic+4r+709t7lxgovhuePqBv/08ZX72EXDTe46Kl3X
+eE9oXc4hvRaFzK+vtL+Vwzie+mYk24WyRbdztpPNdnR5+uuSv67jfz3YP3h7+K6p/7jlf/1N/kZqki7KYpJkgeEROSqQB8Fhcri/fwD88IfEAp/Sr8tqmdYC
+9tlItp0n8nI06J99PNVZbJelSuZwXiJkrVb5lI07jswjuynqI8lBfpul1V3EOQAULsPf1ASOqSbFQjWCbpYcog5fiPdxh3/R+RWe+EyyZpKDQt/pVC2gwnlN
+rlBUlW9INfLzwvCvuLWIA69vGogjoo93gS8veZCAcEwOczDuSOFyhixlcKPTUckJCTqHl/AVSbP0n0jgZ2XzHtCLHbKD0D1vAn5eWReuF8Ga5rquSY20ueRN
+gRqq/4R7Cf8aesRe9EgbRtvjqXKU57ysuyTg16giEeb/0F3fgBuPrQQU9BWlu7OfokrmSu5OCnRupvcK81Rnmtt8AJcl9Af3LHbjRC2y4hHaRf8FBgy6QOzT
+7WlcHj6CbaVZUt2lPNj2QuU2mXMwddqxoWXnoqZy7DAfqjyDrHSbKU70dTiBFKuyxkJcr5kRfCMgNaoTH8PRfK/6JUHvUEJAA+OM0els9kWNgDVb9IjDkBrG
+YCS7aLhlcntbqlu8NDZuGmmRCwerYMQB8OZaQZOTzPFxEOzbJqUukkdNMphoFi4j2i9nimURr6uutoeS85MgINVMhxBBEhjxRNnSiY0RAaV62dAayx8tnb4j
+fyRy+oVXArG+aI++Cy7B50iPyfg2dbOcm1yhqvy7mlxhJuuhKIk5ojIVSvN/LstHaZ2GJFR1THl3zoKoC+9NtgOf02FNPJXh2XBXDjNd2VoIZ/l6dCzNvWct
+9s5wCTUg4RVlxmWPtA0hoQC+wekOykrFVQMzVbT2TSzENJbHAa7W01QwIEsNJTLj3o66FC7SlqEepZwsq7qYk8LehluDzIuatgeunw/jNgPQ2rBI6jfbLomE
+k1aayLyY4y4HjWbWMvBmqCnXuKh0dj/fNtsUigW5yJlsZ8WDMs3vi+xeycXdY4WWzFxN04QW/xmlsmqWaKVpqvfULxwSb8isRFp92vy7KE3QE9koNK6jZcwn
+sexXlSrJE8rscJpIw4ktmMXupzjiy5jHhdxZjGV4SB4N/RadN5bBCr53nWGO47W5ObTwy3mk7GvXjh59e/i37w1N/5qfMmZTyWipjHTMPPAU0dZ180juIiPE
+gpmvST5RkUcgK8yuYmLwbl8Ll3Sv0g2fJItkwqvN/J5MJd0txYwNSk0TDTP05lHep2W9VC187RstxWXF6/DECLqFBpKzQ3b1tAntCWtcawOWpnLEUVFSUAm+
+Chl97Hcx2be+85soK7D7SYmxhXccioS+teQthpoFHB6fB+Mdz50YujWnvvZdsNvwym47NfSJbh+WrccGVS6dg8morTjmXFtPuKV5Gj5dXcqilF+uri7ltHjI
+syKZ0hZwbimh7OSbuXZiG/2crIuwqcKpqDE3LaERNsRllEjkTZmqWQgoh2CJs1sbGVs4xVCPF4+5ZaU1q9KceCzgaVnkCEivC504I9qGm1kQlwuYRhUtaWRU
+dewa1ln3D2ufTNWjT/W5VYUv908WTHdmQxc/KCDQWjhVm1yqmmXVZphGIZIURsvrWGcnjdZtpSC9yQniGFIEIup9+ZmkOn7QNJs8U/67PDF03RXr/Xfe/DUI
+RmDIwYYbXLgZ5xYFalxgMlYwt5FBPqm0Pj5mdfDYEsU33t1h/9Wu8YpuZOnUK5YK3N3R63mnJ1ykhYbtBVaiA4JzbE1fYNQ0rCh3egJ2yIpVUzN3pTv3i4SZ
+cuQu5XJRdJ9vjD2DZxC6WI4/4B2RwLCk17YkZkvPBukYa6HHWvYrkxbXwCZrh8IH5CJv1eXB4ULpMkfv5OGuME51T59nqoGgWp9HagUCnqokzyN3aGPUL9HL
+YgzuHnfie81t2aVd888YpilldWRWXZARYpfYjiST13pJGS25JqFrpKcgbh2mzLYxKGYtNaOccqqRSdJCW+A2yU3Og1/9rgoLqrBdHZRN4WZW2E7jWdNVQ2uR
+0Z6k16TPDu0YILDo8lyxVhEMne83wUTM1inmpIrSNMXUSLOeII+G5IX+gOpCQh+2+oBlPHeuT98HdSOrtDa0U/j83CXH1JuCIM9VSp67g/iN3DHrwRPICcir
+mw4qnCIN/5TA/Nyi0lsAtXvWAVPVej5v2+V8Ovd2EiSSO42Z7rZAf6bzOKZyAc7dJK+byUstJpfDR1ZJ4pdjIRvbYXocfGIix/pGPxLW9r20O7Un/RVs6HyV
+GVsJRHorEjHzSSGaJ0VhTyDvwFh//B7E32+gk1QFMkPVJkpJu2lP021abce6KCGI7RxJzds1lLSbppSeaISHCvvrbg2izsK4tLAkOmRLtciSiaq8a5QmfXad
+liLgdpy1Weu1LJc5InvtFD/Ylzt08rrDzxarh3E2+RcsDiR33LNC/3pZqfa33zwGUjBE4I4kUDDyBWZX8zmaZKgtd49YJnTv53tOaryWS4FUQ6fI7yrR+fo0
+d99iLpVoRckCyBRUGUk1hyNXAVoI8wiACcrGInQKD3kGklvj35wU5aLQCbhdB5nYdVpQlEGbejZZCzz5cENJKu80ZnUdudOyvblLA651kbHzjCgS/MedmieR
+Q51oVWaqSFviWkspMDud/ADkenBYFak2IiQ3xq4BN/GtsuQT1RL3+dkyyx61DBOOcLnMW45fZzU2vYERU9aHxeCT9mAQhFwmKRm5o+0H3WVpfq8qk2iMUYyF
+KtMmjSJPOlnMhOXNsKy1uLmYjJcwSBVcmuGBRVGTkhRMWq7fkdxB+84x+qYqS+9V2V7Qq6a7T948CvoxT02GiOSqhpEMWQD19+S0dHWITDRHFLON307NeCN3
+2k4+U5fN7jZigzsNnenNS42dn65ugLmC3Dyamy/V+O2mSmdW1Uw2VM3s/WR9R3Xpn5ktl7Rf0rxVBY0X+aZaaGJDLTS+iTi0VdyzZqDExIb8XOW69Sv/Zplm
+U5nACs1dvojOddI1ud6xJFdR4n+UzxYaa80y7JYAs++z5pcRAxMvFgPDeC8bymIjyS+5geSX2EjyS24u+SVWS37JJ0t+iYbk12G8L/t4WcHlfjHzEyphbuRF
+7YXF6W4TZG+nYPsZ0XP4SZXeojFTR+A1gyCfzDn3W2WV0jJC8Bs/Jxlu3qjsETn7ifVCFSWfj3Jqjp0q0OR05rUIvKDO2Zr4dHhXd6oiJn54joHSDm0UQKtS
+pp1j1olmT2AxiC1TCIt25NUt+Zh9jSDizSlxFOMSTOxotNG30TMGkUOYt5timZNRkGV43vHFK586mvoxpFkBYFhDQj8D9QHyDOhkOc7oPweA/Tnl+Y9DSL7+
+OeannV2cDD99jeTxaNC/AjKC0fDHPrARjDFzzACeB17+IdZkeAUA/uEZZXpxYh5Uz/K+Pb1uV18G51jIcf98o1oKr5bSqyVw9u2z79Yey/JTWcztiSbHbSRI
+fs+HqoiR+fnTNLV8p7Bo09RyUmBW+YTJ22TcLGK9CJfWJLB3vo5IV9BSQgXC87OC/YYfUD9JXgeO52eIebVYsS1hEpnmFKBpqM9wroZ7Cgs+hUklR+cAhK4P
+uduFQe5yLPW6b2rTKaPtzN06v225Wkv3ai20j7kt9vo6fi0p7MZjV8yerB6mJ5KjsYcuUUdozyXBxLsyjFzRdSksShHGWzdW2sNmQC+vUNd7fh1XxoSxO4/s
+gi0pBzAgimIzuY3zy/YAkuM6FLsK7zFVxO51e9WCGzOGO9Ai0t6GKgo5zCLBHscgmFZqdtC7BKwMVaZVnU4gWpLWKBSk7QF3NxArY0jMWNIZeiX4FjF+iDT3
+Yl2vWPTCjTjHQhx1bq7esfzxsQ1Y6eCpHTWIt/JGZcVDREryv6Z2Ia49XzO4tf9E634rn7Hfik0yC7r226NvtN/CTdHZWClq0iGd2LKYomArFiu3Yn0hMpCZ
+dr1F+qnQzr8GjRq0/vDF+6HTsmduiU6/tciOvmAvtOKK4tl7oT+VbVVF+5b4Jt6XA1og8IVjEiktH7oW6EkkZNCXbYhJA6GpInuQVpEet4gekJWalIpUVmBu
+Yts61pXZZYo2OmwNM0scmDVJNU0144UcNB+R/Ihlsa3ka+LrAHONN2KqtNkLmtCviGQfHaqpNI8bdsw8edQ3MeFykGLp0rA/zVwDNcyrYm8NbpBQHr4TdmwU
+7SgynBXQp8jTeF7wGecwGmpD1wyQ27OirWc3ArG2WTLPBgub1m+EFm5hWddoYVx7ti83Rgu/iQ/wlLETJZk4wkf5lO5wRljyDmhcvFOzcYYJPrwcWkLtCUtL
+zfEB255Zr13uHhGSueBUTapqiYlk5vJr9MRWrBvRyL+VXv4tXpTTfFam+a1iqSmcrro7V2lqGa5PepK9Fqu7Cecv7JHCTxqmnCJigqBT4Aa5c2qKlvjvsPgF
+XXXULCpmXm2bmTbOFMFMh8TeAin5U7vFhJMhTI5sHtAG3Vzk8fRX1XKuaJ36uH3YRys1Icf4o1y1EfJrc6WmsPMQ0wx549QvCTD0RODo8N2M/iZDOr+OTwhM
+DW2JhwzzzcRZ4mun7EmvFU5yFS0N/b4bdKkIU7SOBbRdf97G+1T2hZMm3M+ndDJVCFvQeGhtVLKBxhYIbc9m6ERdRP6yc/ZCaHTTEGsRV9AyD8+Mqvv4DhfU
+YYPNTXVh+VR1YWFlrthlHrUSpWiT+4i0Ym11TOKoWF8bzbBu7Mg12UNP0hmWvs7wO+Jcdy8CrsPkGUl5wr00SD8pj2IlK9dfU0ftael4TS+ol6InWlL0ZEeK
+XmMMTa4tFEKjQ2z1fOLpnElOmKzg9KB7cDJNMaU5y9hkNXzHKNXlWyP28HDebVOB4idpQgvSJbHfq7BFoSQ0LUxOzA90oYXRhZYtutAGgOWRXnSdt5h+kNZV
+Y5WQhI0jKN2hJx22V2yiJ4285atVnn0tD4ebZg3iKWqDtHVHYAP8SoBBc693UAaC2RC5IJNw3LAox1lnQU6cm96N1Fid1SxMYldTLF3nUjuD0f4Kf4jDdnbJ
+YvMKQDb4YsYPcw78dJVwdCWzgkSDnZcE074pMC1WCEy7g4IbNiwpmdKLVBm3kS2JFwg8y3aBZ/E8gWdP5p2E/IQFHIWVaqP5SCtzXKSITcVbGnFDCaajQdBO
+MTPSkq1IHCcFb9VzohOx8zY+fKIMcgMvsfk5jF4jSgAgz1GaKbkKp0D0K20Gvy+obDLHIqOt7OWPQe3INxHAMrDnAkPJhWdUnjyUfY1oe43TV6Q3QB0mJ2VR
+VXuYcqDyiWJafJVofnVP35Kntav3jTePTSSfpZF8Fq2SzzpFchPR53Ywk4uE0OTywf1PmzjB5bwzlSLNMXevDeKAaXm+YB6bKTovse0kCHZybNwaZWixoTJ0
+x1XA3RDE/OUAOPixPoHX4d8k/r2NX6/WpY4R9fRkXerMgt69PfC7apUbP7y18XVUtdGd3zwGgbyvGylSt+6wLYrU2I7fSZD666aC1EHqQKcatSEkfBsfvFiN
+2r5GfAs1aslq1OIbqVF37Ba4N0ETNtSmvolXSFN7oVuebJ3q1MImZu3u9C+HOz0dAw7XjvH6O+6zO6aYsh0h2vWqpdWr/rqpXrVo6FVPHHine5BbjCa8JnKc
+0ejNKsLM9bfx692kJ5Kb4p6X5o3KUnVv3Qzddx/Jm3FpWRg0OqYSRqHT9J+z3Kx3nq/PdtuYFPm9emwCmJyd8Kgp1I2bHz8pr9QvdYd09zr1bbAj2F+JLAy3
+7RtSMWvrmaZ5JELzCM25e94aNtXybpzS63TJ2zPAmSqP88Z7ggXLZYdguW4kXoPDI3e2wnwI8//0Tsnd44KmfLVx+KFoVRu3OTH6nDBGJakjtINfW5EYZlCT
+FkynHSyMWriC5iIUNCcW4jY9c7mZnrmv6+jrmbsFb6ZnLtbrmctuPXPcSN1qu9d9Z77R0WAtQQaDFbmSuwd8G9TZoeYmmBKTX6YpYp6A3HWDxF0oXW39GoOV
+r2FWvEq7BUXDd9U6CbwH2sG7wvMvB0r/Nkl4NexX4z8F+FFZvW18h2OGjfN+zRakUcjS25Q3FEJLx3k/bRsk6Q5SMTPXD37/Y7EUyIGvOUHCgbe3iUaNvDGB
+1MWi0kTrrj0B5oMk+yHSxoVE4yJyYWiG2VkyhFDlt2mumMzBsTtuFKIAJsbwmKpfoIlzvAW/kX0b6LwCX9QaDf+GgL8VcxffRMC/NXPI4ed6roi/CG9rG2n3
+twj2P6vBnYL9+mwQq5Wi/ZDceoFoQYn2TKkOg4Vx/kqnyuh7oWZY11uxvfMz2Xwp9MXAF5x2u3GdzvTmHdYUon4bv5XHLMBQkdzCBRrnRt5P/kQLIX/UkVmj
+x+7uWDePwnJoEE9wI+sQz3ZOKWB60qI0kniTtJws55QsGIkZItWb8RRdQOa6cn2ZxsyE2THKfaPkXZEX0Lk6k9s3gXEyBg0KiZUDFPhwhQqzpyipxS0CzWS9
+Wry2GBLhhzL1eL54Bvp55qWqwPcm2H6gt+DJbenMkto4Ldsonoi3r4lxR0Vk521GzpMv1+wDCtItQn9NLMS7eN8IecLXV16quWmcq0LakBbVuqSiW5fUFola
+oU37qykYKrTk/YsFQ0WTYe3pgqFNm7Z16j9JNVS4yCV5zgeCRcjr79brhgpfN7TZvxqV0IKSgUlwEBjjG0uBRo6/d548iimfSF1SoAanXrOMqctR0ai2aEtA
+/Gql9bt1QI157mp7Cr2RBdDsyARC2o49r3wNBxZGBqAOVDoD+sKqANPTYB6zcMrCDUK71z1WZ/d84anlndRskGGpHnitIVxzowxvfV1QSNC4mO0tcLEskfrG
+AQ7CHphM/7msjL+drx/kUSBbtZjpRsEJ6yoD6CWIDnDBhrupFU/pbmoVOngWGYsHXJ7GQryP9yGqhicy4dh/4qM11hCNE4eFeqVYqNhILFSuEAt1pULFxlKh
+0kiFttYY9ELF0/RCZbdeqPh0PcIqw//D8s8vbNvO+icDo4LXZM4OGLDFioqfDQZXYyKwxmJYXxR5sAfj49HwUmvAedKkIwmyhtxF4/7wBH/fvxo6PN3j66Hh
+7f44APrnrxfXo0ACJmaGn4uRqfCYOaKprZFp34Uj9hrRYJDyp9FT/G4sUBmPxLqhafgECD+e9EcnJK3oVvNitKKVsXiyZmsgEG77WbQJtzaealFulaFyq9iF
+d2r1VtN560RbZadoq1gr2qqXoIZa+diqi5EEMUeokgCNSuAE96i8sZOGY6u5efHxdPgZ5w5053A8vh7I68uT/tWA+3c4FhYRZt7ypY+L4H+eX/x0Ojj5jJ09
+wElCsrcXI/njcHQ9NiT2uoxIAPf6ycVgjP3GM8su53AVSyvBOBiNLkZjU/RgDJPC042Vz5ewFVbCtnVHeY6G7ffxvjw12Vh0Hhmvz5MFUUWHIKp8oiCqHsNI
+BCu2SwG10R8NGVRhZFDlChlUeGKrfLpVPv0NlE+H4ycrnx7sx/vyS3p7J0dp9bPsQwonYrvibjMDivnUvz692ru6OB3gRgbQV/7mZDAefj6H8/Gsf379qX98
+dT2Cf4HBc341OAeLCkbjmowOs+qwzmN5cb53OjwfkEbxxakc/K/r4SWqUwzP5Zf+/9MfnVxcj+Xg/Mfh6AJlWsdyBA+B2YJzd2/c/zRwj8SIVIr7vC0PrOEA
+3Xt+fXw66MO0P4apggu4Pxwdj/qfruR5/0d9TpCBcnZ9PjzGD8Zi/HV8NTijx+XVqP/p0/BYcr0j1vCQp8NPA6kPpbP+8ReQx8De+GnQBytE6mJA7eKnL8Pj
+L1hHswo7jnN5TAf4oH/Cr6Ipj5M8EsEUj0jHAzSd5eWXr+Phcf8UPnK60cwmubvzZfj5C9sbYAVgr+z0HEMJJpezh45pI/sExZ5+NceBq8sim7osqKbvSqm0
+vRbmKFAujvxc7FlRmmyEPtz/6welfGhfQNkLgSlhNEQbAoxYFv5sU3E+USzRvZLW1cbafHzlNaBKCGBoTEAl2NeXqsolkPGhAqEfhtwui6RMbstkcQdtqwv0
+szPL3KTI/8koTQ5S5dMAPwYFOlejC3M1YrlAnXf2PuJ3m8P9YiZPuLtsqoH83kmIssUyszJeWaHL23Ydt5SDfUp3DF0Z6Oo15RLrZVvPKUoo0sPp3O3ZK4WX
+W52ySBnYLQqFnVOqTSEtwD206hW2euvanY2WNNgAuN5HunuhfzQIiYX7XYmPogzhbOyyrOq0XtaURJxMtRuwmNlJBmsOyDeurBB+7AsK6oT0IDHHJPiSV9GQ
+R4t0jsmDtcoChnwdI3Xcw+35KyDw+FYWJaI/PD1ig6yq3Gxl8oSjY4VIppKc+sRZeoKEO7XeqoOSi+SRhiS+xdDRAtUwGeFk5JZddm9hWIh0MSY+wcFYpw9g
++mo3B4zDsgP1TSNxIPualNsdE3m9KHL5sYSdrWV8Wnj+u8YnIP1OyaE3w/wlX97Uug15ysGbzINLnVh6g5XSUcL6LoVtd/f1fk9OwcuKUYRJQdQjxE1K8+CG
+G9PPMgcvsILA3CTJaAkFI9FCk79alvcpc4TXTtc15EIuLWib0lwwyUylpRHCYcchZjunuRatITFJ0y8d5UfCqw4N62FzMImWngu4pISgoYPyMpkPFP+ulSOO
+LAiDBb+HbtX5RC5IjI45lKPmaPJUTbKkTOqifJT/XE5v8SkmgOkJ3rL9uJ8XrdrtwHLq3Z6S3GBrxaQFCo/l/AY6iJlVqy70LpZUyM62KJBtD3Kmskzdmgzu
+Fi2N1gPatLxyaP7xBMs9ulomgbLJhVRLWwMfSWU3CXjdUWPPxoEWdmktykKjH7WCUZX+AsvhrV4OnGODm5XzWje5RlyqMi2mO0aV3CytpNauVXoEq6pVX/A4
+s3rlkDUr6kIukkf3RYmcL1mPFH+BO6sTBmcskWUNXqAmeyk0n3gXrwaiCZodSmxSkKgLrZiWyYNgD7eeyzxRHwKF+OYb9DQL3oDK/NxFSIfgd1FiW6dM62A+
+aLUaNCcYWKl5pvw+mtJQOn3LhpRGZ3Faf9gmwbEF3XJWom9kuNo11zX7knwqjrrkGnQYT/2ySK3LvtkVtBO9liPbHz8mGQV6/YAebzwJC2e0bS8c7tZj4s6w
+1hUs16xg0baCXfyC4RvgeOnU5jMBKZYTDVB1TbmSPbEoU5v+xfso909Lm5wB5L0Dh8t0l7jX3dUKwrx5pE53+qJtIOWRVdKHJNqcdHqTyaRYYoXkVNHYGvjC
+HL8pSmkqQH1EE7gohQV8HxzGR/K8kCNVl0WCW5EcGM01z8obhsaZ/dKlUOLa4/6p8imlhun+NgxglXvSuGe5MZuLktI6S1WpLFOlVgHD7E4M6t4nWTrNHt0O
+hWnIORtOQdb4dEbYqb1vDzjfGP3wfXmWVhOVZUmuiiWyDCIXFPAlfUZcKnbtIJ/K6wry453rSaASlsgdh+Q1BenVHdFFy5Tm8ui9PI4/xaNYHsYH+wdy92JS
+xyCw+qYX6fsCdGIxcwsWhkizMiyb0JvuuxuP+OmpETKSw6IRlhB7WYW1Amvl4FDugrAu1wuTpL17jHlc8OM4sW3LDt/F7w73D/cOZH2H8Hb70ZHc/dsyV7rF
+MFJBtwvT7SbVrdnzWA0MIpMlzrtqSClAI3soR4roLjVS45K27sCWRlM5L2otVV2XS9Kuo8zk/FaggprKJ4CETcqasR+R/GeRYig/JwOS56wPCmwRrGFfBjw+
+Lyj6a1nZopbcK3vRMHU1Sdta1Y5oHUuH69HQP0QbC/dQxwFsxJIhn1jW+G5iFaxXOl8kaenQwAmTlMBDGtkgN5PRI2xYU1JGyNChyH5wkfxqcpcXWXGLynKc
+fcSLjaHJFr3pJCUZ9iqZVH5DcdmompQJojYEOeXR6Upa4qK2ClK3HeGCgS67KvjWBv0lf0rSe1XG8lOSZkvCF3cZ1XWh756CpUHoztKCObZzli//iXzAF8Gz
+bLdxWZo+ApvmTVLzAmrBGzlGvT8dzLnyoe084zRxPKATauc0IKlNFAYXTB6HjFVzRBtJ5u8BO2N129JK3qlsSvSSYpmb23iGNFiTO+e3xgOCtJfQDbQ7eBxG
+HnGbzuJwSqXGv5XD3OHrOKZL8QnlKYzrpGbg2EjdLrMkSPZG3LaFpDIZhXuxtgycHb6g0BauijlijKGBnQhnzkysqHoRXPGYdrVE+g50mOj6OtnjkyRnsXuP
+3NRnzzBHCnXQOzn4ZVGUaPehC8bBN5K3Tftm1LTRGq0cAZPDW4i0M6AfVmHx+J9Yb/PvTuJgXCVoE5CXx4XAFKWzb8Ciu87RZwhDyW5EWgTW2wRcifSN7bQK
+WeCc6rMQmm0BIYvCagqLztbAZVwp+FzBveW7qGG2pLAp84zJsrV1w4F5L/vlTVprXvq/Lcu0mtL4yP8uf1T5UgVr2Swbh45DHBdZUSbTQvvctLtklwFB/qLy
+fXSaycUoS1bCdFAvktZKTOvKJDzuFbM9/11xyFsEPSZMhjEAPWFuukiXdm6mankzT2tOa7pJiZ84sb0kbIZeiXTcuDLACchJ5bzi+nNVppMkd3tY9u1hTl7l
+2bJ0ruME1pom80oeF0tI7ZWma6/HfUn6/gtVguWwJF8eYZrQTHNqCc9NlMLK/9Md1aTSS87Njg+I3QVdmjT9r+68NjLsvy2nfBvCSy88Dm67KV3w09yrFL9Z
+5TVmbEP1eTM1QomTYkmEeHzM5rVXf9KG1mUaRza9Uudlf1dhZjaHMyrmY3LqwehhZ9AYis/pb+wjwQ/lLM2nMq1D4jqsNk5Kd3JQRaBeSv1sPMra1ND0yHQT
+xUeh2XKS3qdZS9OF2/RIc817dbYTV+UMU6oL5xLqdIrwO4XuKC0klbzbnSdkAB0XOdip+EAuj9ngqoRmJxiSphODHcYJ4bs+F8W0kqnLOUdLWU1pqUI9DP2a
+fQgtkYBUL5EodFuXeGPKOexlnSfGeGjnRvUnbD9HTmz/fGODkDcgSweRJfntMrlFJ3Ri+c9NnztGvyMOOi0J/UqPYS4qJqvWhZ9wjVvw93KQ12mpZF9bQ8GO
+a+MyOsSAj1vjiW8FwnUxhYfoCqvKJGsKXFvsA0JGUk8s8okIPtGN4HPP4RDaiFG8unCxpJABfKNs0nBSEXFXO/GSJU46ALVQVzX/4Pvvv+9QzZerVPOJBvFS
+5cQr1f5jkAxF96v6BVZUiqLcE0aOOlxp04Do0DbBlCt0uXoK7O5cXZ7u9HROvYSod1HKW0yrLiNzi9NAH1I3TzOFHsrKp/ruzo3d3RkFrzmICIiik8+FycB3
+BuHG5NI6YS3NZwKzgfO+Ta1wKuEWK0gTnEgLfXYiYo3iWZDmDRuni7Vcx4sotVfXhSwth4cJ6Vyx1y9PTUxsdHnKrnXukmqHdQWbYwNjLpNa3tX14sOrVw8P
+D3GtH1rQM/GkADgYRqwa4une7NWv4xOeGAzJIiYgVpJDSrGAlGKMFD8/pRgTNK4Gx18gW+VUXF6PIBUWUoliTOT7+NXNdT09paQlm74b2dSy1swyJz12SIlp
+NtUYE64iL7GjLeM4kv/reji4EoPzv118hQSUKMhBHsCHsRwr5ffezInimr2bLVW4PnjMhTpToWoZh1j822/1N7o83TuI38T1L/Wv9o79/f39t0dH+L/7+/vB
+/7578+6d+Y4+P9h/93r/3+T+b9EBy6pOyn/b3/8WjXQb9yf5696OQeJwd3R5ehC/6QlhN+U3cFHLHuXBm0ge7u+/k/4pd7i/f7CHn3eccqLtlBPicjTon308
+HZAZsOKUGF2e9mRaWbJRPn5wpy5mBDotiwmmuZR4v0O5FIznRnKWpGWuKriBDnNZ3SFwEc8GHSW4LdWD5BQqhEynJYHqsqJSMiuKxV2RUUaQ1hgUxULlkk8T
+LqeKnGeZuI5OLG0lOeBo5/eicgkGiN9IztI8ydE/Qgo1NZ0LaW1oJu+Se75Yalp9TBIQ6Rx2dI56FKWn/2I8sFpSk2mO0XSfEp6YrMFiQkBRIvhL51A5zfo6
+Tafs2XXe61n20FC54zDu7MRC/HSXZooMLgpMTFSJp+SsVGoKjWNX7byYqsxCctAISmviFvgZb1KBNDmcowi9gw5Z5jDiuqnuONlmJ7XMCACdT7Vb2tB8BIWT
+elea0ygihC+WfaKbyTIgJL+Vs/QXVUVCBdD6uUrgHJgtSQkOwmPQ0d6QPCgO6tpcLJAgTI2QW1qimv9NMvmZQnHhCCpC6eNdNpvK2VJlku/5WA1QLEez7bYs
+HjjrTv2ySHIX1gdnFvrvnP4y8xJWnhnARyTuwVchvix7RD5LLNaq0LlXBw9Dq6pKoQKmHTow04olacDXD4WjHFZ9sJrDGE5qExqMhRip26TkLBLNC+jSM8Jb
+HotlqfnV3FGT7qipehKLDgbCzWbUw53KHVoG4Yry7u3pq74WJqY4MyyXHAWq0qnSvgHEmGJn10lZa5Ey4enkGikp8qyWeFVkhkHPKfpJzwe/T4hYSfckFkez
+32F5II1GR7XRNaKpx6BhJQ5BxlSAOrID8/I71vRLM3S7QGMlQhmVuEnzpNQGO960YPzvkozFqiZZqvL6FZ4Z/g6zt2eIIqHJcKJ8dTvjjlKyYDyKTMlFiry0
+NfRzWhWEmq9gYlLeLB0OmiGPhxqOhiLJqsj010NScQeR32OuFPP70LzETVdP7QuYKHxjtWrsMgnpGcNnh5wIACSUF+NhL6YT0hiaHdpJbtS/KSasOF8MgtL6
+xqbDr7+Jsvybfyll+Q5BeV3qH09Yfqslv9WS32rJP0FLns+kf01Jef1TKywvfcVuh/N0hWL3+z+VLv33L9elJzv/11CmB9H0rTT9ryVNvxWX34rLb8Xlt+Ly
+W3H5rbj87yAuD8nGez+mlIDVr61t/CSjS3ykAt9v1eq3avVbtfqtWv1WrX6rVr9Vq9+q1W/V6rdq9X91tXoRbLRbufqtXP1fXq6+JVi21anf6tRvdeq3OvVb
+nfqtTv1Wp36rU7/Vqf9z6tS3LcMNleqFK7T9QqV6EdJCPFup3nMxSaPBFWiL+bgqmMmyoWcvXqBnL0M9e/ECPfsusfPn6tnLpp69+N317JP27cIIpJOgvFib
+3bVeUN7sRKuF4rv04ILEbFwJLpGiLxufFxJzmTExjyTh5WpJeDEhMETBL2iVgZfrZeDrQqARpJttO3NjZfgOzf5nKMNbhThcDM4qe5FKvCSVeLGRSrz2isLL
+f2vNd47F6Expe73xJBEZByGYLfYJuu44eZvbomiVRZcvkEUXK1z8z5ZFF52uw60s+vNl0Y2B9lCmda1yN5TKtbXXQ9HhGfgX0Va/W86TfA+sFNq5jco67jK+
+tPqztdRlQ0tdPEFL/V9Fxbt9MEAqMks1zVJjVLZi399A7Bs3wzYpXqEv32wvd6mCy/Wq4GJTVXC5kSq42FAV3HUE3MQBfqa1Z9ZmniPjggjz1nvy+YrhYmXu
+41MUw0WoGC5XKoaHR2sygXS1JAekkPBH+fnS4NLwxTnS4H7v/QoS4W0vMFLhTRlwsZkM+OQpMuAeisQiR/iSuYG8t7tYVqhUi24p8bWi4OFYig0VvmW3wrdm
+pRLrVbrXdpTWVH22YPfXQH5bdMhvyyfIbz+om6y4rSJh4QyeErfJmrVoHV+dW4bq3KJVnRsBx1A/Y6pqgte6kP+5VGWqKobNdlLydacdT2PZlRb6nH1RfGQm
+mnW5pnajhNW3d89Psgmvp+BGWyEfGFOVpbnCzGdjRDv5DJFweFITp0KO/bFyU5XOpiq6N1X2GeuN130T9VukHcr/ieTrQjcdfmYBJVJlJlUU+nxNf2oepVhc
+FeQY0AISbg267DBcol1vEM7eWUvwota46Mw2aq6ybXTM3ibLevQrNlmzxW7F6LsC0XiNq62LpLBMjIQb13TLjwFdXXi120jfXpg+lN9A316Ya8Y30LcX9tUv
+1LcXRt9evljf/gkdttW33+rb/2n17V8uas9DmYgXi9pLLWovvoGofVNw/fmi9j5i6iWi9tITtRdbUfutqP1movZJzxWce+9kEdIUwyVDSCuPR7soiUgb9zqm
+UoaDpee4QGxZlOIugus2we2KUk568JD+4cfGD2WyzvxcoaYvN1XTF1s1/a2a/lZNf6umv1XT36rpb9X0t2r6WzX9rZr+Vk1/q6a/VdPfqulv1fS3avpbNf2t
+mv5WTX+rpr9V09+q6W/V9Ldq+ls1/a2a/lZNf6umv1XT36rpb9X0t2r6WzX9rZr+Vk1/q6a/VdPfqulv1fS3avp/WTX9II/zuar6ogXl+gxV/Q8vE6kPMQqi
+U3dePkd33pc49nTn5RN158Ua3Xm5se682FR3Xnq68xtovrPS6gq5d8h5Fd9C7l2nE4hvIPAufYF38QKBd+kJvItugXfoqjXa7rJd2114/R0LYVKnaX2uQwMy
+1fEDWSU0UlHbAhat6MvEcz4ZBuX4SO5Oex8E6b+PQQB+/9cUgF+j/374xn5Gnx8c7B++3eq//xZ/I5Vk56SNVOmNkQ+AQLRVHsT7QuyO1H0sT5JayYtJXdyo
+Uh6+RyH4wx7IyWid2BZZWF1eu9irL4bE+i9u7SKSj/c9F0zoiWc8ajdq6VBP0j0SriarJE1Wi+s2mDRYvVZ4hri1dNQOteTwgqRLkukUXk9CrNohoZsCVcYI
+54VmECFlJXgFXNN1kESQ46BqU5jQR9oGY3RvhHX35W7r2aX5nXuu8O46BRjhm1sGIdum8vK1VeVFksoLY1S6fQuaHM8SHmIXVqs1WwRptuhMhs01W6g+6GOA
+CrI7okFAamq3y7MOh9XQx7qP9CLpCr2IxzVCL8ThXhWRVZTtEnVxtagjggPr/KVuPxJIEGXpxLAXkQKQuFnWNXlFXF+G5+VZpwnct1d+Tl0Y4eFH4kofUFwp
+pc6ZJJXiQJ5dNWgPUhyeEFOaObtJ3I7rGhUncYoGLO7adIwE8bnfPBJNPMsShfyfu6kuiC9/qZM8gL9eVjwRHNYnb/0S/NkgvzHtbn6jQ08UlmADzzKs3yXl
+FP77B6rFTVvv6GUIH3f2jHh6z8iOnhH61mY6xXbEbnuUtmd6SNoegnh+yHlLgU3qqaJs7SSvU7UCNLhakxoNGH93cwXNsNAgCJulVU0LcvALbgziIza3lnd1
+vag+vHr18PAQ36ks/cXQkcRFefuKLw+vyMg2UWTvmlEJL4uT9/w0CN7b/AAMjkAjjV6XzkkyStgcotG33852R5ap3NEj938i/J8YtCYuYOzrY/4pzl+PJFOz
+uZoDUKR5Q0AnwZiaJ54BhbeT+aoVNSvKpkzt680VxrFhBHqtfInxqk1i3OeAZE1ptytckUNvOkahzCaHOfQMhlUYbApMhRj8jhML4Zs2/nart4DKz+36553C
+596oWiSyaGqel46EuKd+vTv67ye9TiV0ESqhy+cqoXNVSQdkIxF0vv5CW0b//UQS6vab65979XqO9PnbhvS5Hj5Hp84fKE5n8I0cviX6gQlLIIl+dUv9pLcU
++KWrEFuU9gER8Eq0VSSYrVzle1XeJHVKNmxa3wlf5pOHAbXRM9bGwD7jPZMvxnHIUoFofeEJPVLqNVvbsLr4c6faK6uMMVvaHfOidgIxKxnQve3nHQmuw+Q1
+JFvOCqQPmp3HM+Ih4V0J4PQ3ivmhKCV7WZnNOqAKwCg/fl0X+k6PxVWqvE8nClHhHT/TBbsVsBK5dFjkNacyUhkiLEOnBTRbVXmeorrg5Ar2QkYiIFYm9iPX
+5Wxy0mdUUVlgtRoivJHQDXG6QDffj8/rtjXa5e55QCWO+vGx3BlqRkwrcMwf+DJbzEOaKrKuqkq6atACSDkjeacSWJgsKfv5enhSRfLHvStov93gF2VRF5Mi
+Y9cgrsVcyUWq0OwXlYkAztJyri872irkADFYJZNEU9nWCqsH/+aoMTZX6DLl6jK1Pn2LArwnAC/rIjIExOhzNY1ibre6iISvUMffe5rwLWcoE+KSvr6/1Yjg
+QJWdB2pHAc7JKladrMCxk9MOgbmDrBwAExemW0qsxjy6iWfGyrSii0zQXS5js9Xvc53QpI0vnI3ZXWU/6Lrv3lDZrhA+TenSYz+FCRQKR1sK6GqFaL77WlL6
+3W94I3w13NDx3+GaSSpDi5o9hnTrrlHcxglizzC4BDZDDdJRvV4ubstkSp4i17/iCF37bxfr3m64Ww2LjQ1WeZa9dfJw2py+szlbwm7VM/E/qI2938GDBTl0
+YPYJEp3WYQ38QcjV+YQeFY1z7OBA7rgGnB5YTtj2tnk8lFxlZ+bwmBWluCPQFIZLuTjYKeEg15deV7TfRJkhEOcU+V0l2t6c5tJ5AQggTtL6kc+RAih0VRlJ
+NYdDV0FCiprfqDISqZOFpqN2jFG41cx6clKUi0KnPnQZlmLXqXxRBs3pWUkjoPRLZ1g3t1+psw87xdKR8VhaxmPO/etaSPquVmnc2Cp19bTu0kxPa73bVO1q
+6ZvqpMu6EM/TSd91ZnuPeun1Vlb8ry4rDmuD02uuK1X9II9tOPK/B+lhG0goN/VFuiWUI8ZlwbnPjlhDm9viupa792kSZm+RLyu8Y5hO1a5cuE6tceZCKjNJ
+Ca1XdHbudY6cp5NR5TBKfedcAyvyAXm2liBbq10O9DA+MDHpDSSHcDD4xuP2iUnlmvJ+xRkDuCHkE/AKw7R3uL5Ikief2rfCj2DHmhQLNrp8e49sKG6osCl5
+lNOk6qR8NLIJrAfCaGNPnpAJox5tbFS0WqZT+bNSC5kiIb/ktA4snA/5sHZJTfi1kLDrByF2b5y2N1SMWlI7fX2igFLdm4zY0gY3u6uXH6QdGPUi0aZe1DA7
+OsWHPC55EUgMyVBiKFrBaWpCztBTk14LEXE4+9PansKabQ5FNcgPRwMlUuPqlf2NcliyjFxOzYuEsNuxodDDs5G26rJ8dLRX9DxxI3jYKLouTbGz6NcGGc8U
+1ng7x6egL6a9gLW1q8IN/4SaBm6Qljhmh9BahxWuNyItQWlhFE7SwGudizCjdYb88UnlKig5HgWhd9MWqSRza9I9wzTnXMwcgYN4TsNSESm9SzHPLbGPygWu
+R0QtBgHFoCOxE3XP+ZEKqYxCEvbvropvY3NIUD0ToAeXVVqrHpr3Quyqng43tuyWMs1dyz8KU3swL90yIwq7Y4STLDJRIiy2rlQ2cx2eXXo3kfAmZ9AdbV6f
+znkjWjcwL7ma2OMpbq7ImcGabOF2E4su7RAlL6jHsEKc+eJNQm+to1xDclMs6x15U/xibWo3Bxaj9Vwn+rYhyEOhZHhuGgnftbpIJj8niGOcJ7Uq0ySr0OA5
+/MOovnpjaPpKvFjw1WRLCu8NT9d6DQURxQu0Xn1qFPENtF5lMVujTLux1qv0tV7Fi7VepdF6Fd9A61VarVfxZK1XR2K8UlkWIb6lRNQpmRowBlWSqcac3FAF
+FjPduSa7VQ8X2etN7gpyw7uC2OiuIDe7K4hN7gryaXcF4d8VFqpcKBjk4NoQjhrt1M49wSZlCC8po3UYg8HD+z2NbGMchdk4Wq1/aG07cUhLwOU1i55vpNTr
+rbd2gV7RKdDrXEo214L19V+doRBpWar7YkLQM8OS0NSFxdZ1pcfg7rDBaqaMjaL0HRN06TWDieMYLEIeYhrJDWVmHdUi5/7XNJX1uYPQympSLGjWaBBQ5U5F
+kzgRIODhQgo/OowPtS11s2qMUB1oeVOl0zQpW4do/TKiguzw2Uv3qhETdsTky0ZMrF9+G46Yc+9z5AcRQG/uLbuzovT68FVRNrqx52YVhDrCbZckNwAOj2sh
+Vvb+RhrmLebLrE4XmZJ1yjfzQNtNXwGt5K4m1HaNStauJAMcK0RPTZGzXj1oUZngvDEW3M0SbTyPSjt/lEl2W5RpfUfhg1TjYFiEl9FMnhZO8yUaJsNNx5ua
+peIhcihmTqmtHFykk68I3O9Lr9cFF6GhoTBe9HOhf97KzxPQjAtx1Ii6u0orrIQTPkI+RYjFOc4wm6phjOfnRdlxmQcXtLACCfuEa3Vb4nWGFkGN5wMeNtXS
+OXEi4V+cUSKHfBRoU8w9NIipR6nkbJnNUlycPIyiLTdDpx9wEDF0uod5RUfay+YLzIaNxDn2GLhvtDhD4V7zhVZK1uftw12R6UQ6mDI22leURC/hLJdwygrd
+qqSsbZMIeGrANnjc0muSWuaFVuAhc9A/J8Ua3wKHiQIJDsdDpM2JNBdWZf6QE5FRN6TVJ+LLMbfMI6dieEix1diIHbeRzlWSSCxeRxL1InFcD7sT88CfbbiP
+OAoT8Jk4QStUAsa0KzU1yVQOG1zD6eeloMu8cFJiWja+4JzWl7FwK4hwXzS0H0UpaNn76STGhmtbGo1JXfChIDKYL413Rvg69YuaL2ovWK6N+LaxwBUFVW1B
+8EMff/ATs6n7oyA3Sn9tQmxZmv+MR0Hk7jQU0ClKEzM3s8dJayHWCNgugpfq6+Zc1XfF1KRWwkLKHt2MfidntTM9smrmjZJDv1S3Beapw1JszeXkN4lV6eCQ
+/76kZmjMYTiauji9LfCCSsJUDQIzjU/J2nBrROqzSXPdGMEGbTp4q0Pa1VGFq8rQn7n1FVBfQrya/nG3f4MwceXQOxczi5kV5TTNKSec7sAt00zWBc4kO3Fw
+/vPsx4wEZhLxs8A9DZUA6tFZr1h493jjUCLB7/9KsywJkYz3kJ+Pta/MI5iAQBu+WfC4yt3gyiYVPQOgYzoTZ5ene+bo0Mk1HenG/Lvg+Ql2eF7Uwq1UUepV
+aogyjNkRGhtnl6c0/reMNiLNJeOwrOzJQky5UhGAum3Lpde5Cx6BOUnZtpaR1BDv9lBcsVC5BnNmeqW16PxjWq5L7aUjuo/avHVyulr6MTWwU+HcWxzNbTSR
+HJ6Oo/i1PFOILL4t1a0Ttrf5B0Qc42JZmt3SsJJ2OS9UJi1YGH8zKHKZyPsiAz8XvFpWdVEi/jrIxZ2rabqcWyfjTam3P6eyzvGO18+GvBYT1+mMwUZ7mFvD
+bGX6esTHARw9PPECuW3KQcsePR8qvGp35i5Q8BoZlj6BaUzYDEM+0mPamtWV8CDiSbjrrQaKWVfLm1gO6J4M8/mTRVMr8r50UzgFZMLCSFn7MH6jgFRFTow6
+0viUiB6AhVgq8qfi9WjFZTpQUuoWTjT+d4x0sIc1zaFJVlVee191P5nqrnmP0GcGDhX9guAxxHfQdi/s7ExhOhOaXC5KVZukV4pomJqgeWTT1Wm2k14VWj4Y
+j2kIV9ld0iFc9ByTdLihXZpYVTLDGRgYx6HR6GIYOLs/sAa8y4ZzY7TTvjP04PvfKE2B9cmNYKgIVet9YgCylD3FO5qsaU5+D19LD6Xbmv5vPVcqtUgw59bY
+qn4igN96ozboP9QCAgtSc9/EB7G8MhMyQMeaXbBt1elItZ3OgrLxpwpVbCuL9zKdYqGv8gx+seNiwnVk7biHof4iVDdzC8cIcH6r5ZVDmbPzQvovEuxT0Zne
+Kp8WJU0iCHYWtdJX/ZZLrNepbhK7l2UQynqaXpWXRZZOHluaKo5Rn/OporR18c10aXlNUZtMbo/mWBTmCuacc9bR7EaV0lzu7oTt2OlZ4VvRInzrb1xP07wV
+7JzHj1tCVy2auGu2WycqzHFjL93ku8qhRvH0bBvjJ4levEWsVq4VqwWvwEzlxEFyV2QOmU27cu1dUs6RyERfaOxIu5KzjuAEuvjUtJVqu6FEe/PIpD9WqrKl
+xagH6QmBevqdrTqgrPqp52CuHgy3SSPa39AMjeUA3HH8A6tfmsKRmaBNl+a3y7SCs1ALi8p8CWm8rCfq75XdWqKyqSXaTA9sUxJ0VURlt4qoWKci6ld0rYKo
+6FDd7JAQld0SouKJEqK+keuph4aJ4u9jT9rvYqQFa+gu5RWk+5VZ8EMnpFiUao8RFRBLqVVVI9y4xOVPUgz0oYPj73yFUGVZlAbNACYL+VJnRJaJBmRWVBRU
+SOqEhUDpgElzw0KJbi1d62QyWZbJBKleN07c4MtYePGJuv3DlUxqZ0uE8tjFUqbVzyiwJI4vQJPnhOTjXNFJrS0JPDkhyU4kry8/j/ong7EzVsJh3vHYdbx/
+fGc183bHPbl7fHF6Skp/p6CD9WkwGoFa44Xsj+WOfnIHGXNQnYuIclBJbDxgMav3+Ibve0YazFUGEkz+48kCnZ56UpHnJ69I2epkiCVGrYxBVuwMxfTOL5j/
+Z3Airy4irF6bDmVYuGxhBoK+G/evhmOQq7oYfdXiipG8+LQBaxD0/PHx9ah/TEU1WIRIL/HcZRFiKb7RCeuijYafv1yNY2/UxKB//MXtzaaCYP9zf3g+vkJp
+rdGnwQiFyrTM48jWQbCOlDvfIqs/qFXexvi2/vB8cKLFstxfoMyiQFVLFtIE4S1sz9gpzdPXai0DJPKuz7HSo+vLq8EJSJuh0uEeyB+iXBUWx1KmnZWBglgo
+cnCC29jFiITSfhoNr64G5/Lk4vgaKsg0UaQ3ZyS9+ic/Do8H8vMQlOo+frUygbJv/ls4KoijAUxMKu7HgVYEdIeIZOiOR4P+1UD2zZplfSFnfzH8c6FHh/Kp
+AsgkgmRu73DrkIkRTHIy1KKWACc5dqeqSm/zSBPZMdEgAQmZCnWe4BuTfIL5xvmS5JNnySTN6EUySctJmcxqmSf3hhHffGihilBi9VjVal4hoDNJ8bIAOB6D
+mJgnkzsE9qY539WQ86K+Mzt7W0KboBMgU8mU8K0JuFQ1IkmmOTAIEkUbmGZKLu4eq3RCcBKV36dlgVziSSZIFcoxhowKF6FC6ESA3yExtpoam56ggXXOVp5A
+q2AJjnqQvgy0+4wAYRyoB8Ia1pKBgxOcef2fYHbCFAbZxCtBEymUV3WnmtWZtMqSVkZSa0sauclO+UDZHw3HwKsGh8zFJ3jVaHDaB0VErYbK9cAZD0tfsHzf
+8JwbCA/CZ81NhqsGZxT0CqoJX3yKJAt7CtL97LPgJynkajVPe+J1y3/iGXg1Gh5fWTHIXgS9e3INW4f5MNAG1eKUbg8j55zTxQIUX1GnEjaK8UYylbzbX8Eu
+jLq/2I1fBsKR9HNkWbX+8ifW2T0bnHyN5RrNSEGagK1TzdeMlGs1I8UKlUgSCx7mMi9YE4I8V05WT13USdaehQlPavm1Xf+qbhihOcPw5jFgDO61mKro61NT
+T/9iJmuVy2mRZUlZyd3/BoKW+z1StpQXGqXUwBk1sjHbUKGNrFzCsFSObFAkkY+VoXC1KhXCPbRroMH+oSVxRHi3c7aip75FtNAR5dPud8ui7LiHW5eQ7wDc
+6ZFdjZW2zhyvcJdy3hOlEZ5r2g09ujfRSCZ1m2suci5hOjdpVTXaxpHaGzhN8RoriMogyO7xcC6u6weaZ+sBE2kuMac7YxERcOB5yiIHEHmDj7D/AkE9ure1
+EdzbPDWWezLCPlPhJksg9mIj7bdObT5OifSGzGQjuMpUpsO75eGEBhYxBLqRALJOKe71vhaIE75AnIE+0vMMsPoWsoKH8Zvdm94PoigpD+3JHYEzFxuezpWc
+LkstcNA2uBEaSooIE7QMGhJzt0gPacVMuz6dZLZHOSmLqtqjTHh0QcN1u9SiSLlMYB+tlmndY2QRknc/lGldq/ypLTJuBvENWmS5dDj33NeHahrFhHJx1IRc
+f7vjWhdtrvVeqxLcRp0pWjqTVvVhl4IS6vg58kKRk9lYF96UrepigUtGE8RjLqjO9qQfu3jvyKcu8lI0jSNMNfOG0Fau6rJ4DNCWjae1nKJIcwpBL4qqUqwQ
+avJYO/UZ/Tgx2vUQYRLky2OFRrMjbSDT1JRtFJ2yjfi+iF3/jm4jI8M6lBvFGuVGv0Kr00mFKyD6JmKt1oP9SB4cRKihRMKtr9HPRzNfO0tZiZZtBprMWvVB
+5RWPvxdF0KaVdjoWZa1JGzBzr1TVMvNVvHBnco8azFooyqklkjZskxzf6O4aoeuuN8lFqVBgxG8GOyzRsJmr6aOVKivrRz43D+Om9hakhXfLbjXSIBqKWzsO
+zTHM50/9EalreWpeZsOgxk7uEPWHvkR9gvqRXcqB1Si1ptjUZFnBAYIJQxYjUXB6MCyZoF5hOlZqy3iUTpsyO2ZBPdE043aI1MulbAwv9ALKcx3I3StTzElS
+J5SlpZW+jplbxpDs49eU6XwC8dBa46VO1Iw92uXkLqnAVXCCff3mMD48fLf3bv/gjfMuAe+Se3vy2DZtCM4Cer+RBHstd0em/51ndcVMpqUoyuaH8sTFPvZi
+2cd+SPNbgFm26IvB/BK+vlgz62UzabHD31pa7Nsoi/22wmINXbHD31hXDA4mqkGlP8TJnRqZMQ6GiValMfk8pTHRpTTGqYNr8i6ilVpn62TJmqpkIAxJ+mNI
+0mG8g51qZA1Y6O+mSNah8UFWhyfyIVyRD/kskQ9de+FpfLhcS4cNdTS4VQ1nJhLMgdtknfiNmKX5tFqtgUa5yWHAiZI5fUE0YTVPbEHKH5UbZQV6eFHPk1/S
++XKu86s1fTdkLFaFTChzkswoeFxNiryYpxNmiKkr46mwdzFNF2jvp2iQgY+nda6Y4G2aY9hQYg3x1/TmGJltguRoESRHv0AtHpa+R/HbpRZ/yE+TIvQKpXho
+KNNptojFt21s9q7gKPvSliw2FZVvvRI07wMCIUbmBiBX3wBwxr+VJywaNVJVkS1ruy6tcI/e7Bx1Lv2sEbUJpYLWKXgBkzRD+dk4G6ukrmG+/5RglkGNpw0f
+Xl7RnOwxYS1GbZnpIIK3FDXulIS4UlZnwuxC/HympphljEva1+Q+SWlmeSI9ouEC+73UmXD8tGLfqyGBfE+TB22hVI6YrZvlnGUsw+eAg13pOUvtFGrR6abh
+zZpIrmmvfiyWslQTBamsrXNV503z3SbUxVN5pT0USe3Q/QuqKFyo9+g/OZzThme2gsIlNJXPVb23ijSX92nhspxx7o3b4ghdBP55RKe9AfdkmbAKY8myvitK
+pqDjK8/7hlDUD2ykQgtPk4eNdKNEQzdKPlM36spJpW6VBWQFrYcqmLqOjCKuz5pIXZz1GQvxk8dxnxUTpqWj2sKhxUD5/7VUN2oSyeMkT6ZJ5HNayUkGOSGC
+0R0f5JXTVrvcZ2k5N/6lR7L/IP5AySj8hXO71XDwkE2lkjfaMKD8wfw2S6u7WJ6qyry2yGupfklv/8//lv+5VCKD5vyf/11ZqsJaKuj7ZSUzVTllT4o8V78o
+WIEpPF3+n/89hWIqqXKZ5LdZklaxkQvqx0LsHBsGE/BfgoTyHsiutIikuNDfjDfEirw5dNcSI1Wp8h43iKsuFS17hM+Q0cikQHOGon2sbICQMAfOER/RuKGn
+qc3swm92AGy003OZhzcm9i8XlXatA4cbTQYDRtFNc1Wx3Aoe6zKDOjpKYfuS63j8/DpOKqAYaATIoUiyD9AYjaWXsuZkS5owBYY7jKg+Wjlue2KPK8yXJjOC
+Y7JFcEzACEi4JqYzaboxIc12Xc/aV+sJktTsbBG6bbG8xPQxWbHMlvMLfiGVrU8/A6a2ubQi0NtyBLkcq8if3TTmOPF169PKQwB+gcGSJ+d9eWVuYbHfOhIx
+Mci1spELPtGnWvHA5KAOk5/e+TSpT6qVDaZutTZefwFzN2XGTZWws5E03b7DXLPv5DM03USo6TYa9E/PB1c/XYz+51g+UddNrNd1k0/RdRNdum4QFG/Iugnh
+XGx3q94H+Y8N/oSwc8Fg9ijj93+mtbxSVS3Hy7RGVuDTgs283XRmtxuzywiY1vq9O0I06a8+OHPwOEspmGlerycCSPO7R4J3EAhSyzLHBG3/PAFLu/07inTd
+eGezBtdv4GjrCntoZ2lVu5JfRLRX1WgLNk8IvZklRBNJEmPi8A1JjEU6Ttpg3sG29CeQ/p9O5KdS2d1a9BfJBJnh2NZ1vlhkqr0ZQvTLOq3qdGLC0cIV6Otr
+ei3TR0J8HJ/Yh4+JO9ZHaB4IMYDwdCY/FeVybl/2+fzaKKeF8pSfL097B/TIaXqDyjFFKXdOVVWpcqfzZ6f8u+HHs+A7IeAikcmLhcobzf5bcnOjyvbvzoZX
+toFnw6vRQB4XWZbcIPPyvZI/piVGs9GJtUjsb+Xu8Y8/6X/0hDgr6qIqDOMLFNcOacUj9uzytLfiiQP9xHnxc5q0Vx0//FwWy4WzVu3Xl4/1XZHbKfKpWOZT
+WsPmmVE6KXwGzbBbx8scfJvLqi4fwRTOp0k5rYK6yN3xcDw+7dHjYRHXeYorghLQhlmW5kVavTo/HvfbG/YjeMCSxuSW9zEJ9v30+tg+/Pf4XNX2n/9PsWg2
+4r+y9OZVlt4scPHyhwfnRa0+yBul2TKtuJ7lo5ioEv5DllZq1UYxyTpBPx2yp5Q18ajViiKXXZBwolF01OWt+ptJHQpxkL7Xlbe0zIU1EPcvlB3sz8KqsDVh
+uLr5jTR2DBjcNMradMcEV0pS18iTUBdk78m8yMkO0oPA0TobmWwK8+AW+hmA2iEwwHUPgD/hJp1OVd4KVm6/bZcWO92B7qexsgA2ukkrVwxH23VrgO94J4Hf
+VsH5kJLYl5wkOXT3DFapBE6Ipwt92ZPvWAh3kL5zUGQLRJHF/gMc1KuC26kDMZ0UCG9N7zlGu9MEqFU7H8SOW+pOJPHfl1nyqEr9r78tf1Y3xS/6n2PMt9X/
+6i+naaH/8WM6VcVORIVe5Er6BZ2paZrAP9Cw2PG97U7VfTih8CB/3mUi6JOdTgzejqTpVrnOypYOEW00wjeP7GJmfWL3ekIZWZi8UjUvIN8Jj2XJVGpZoXDv
+Mp2qDEeRrl3IkVmgiyCpJUyjUiVZrls7Keav0nxWvMLJlRW3RXxXz7NY/Nuf/G807u+dnfya6r/r9H/3D94cvQn0f/ffvtvq//4mf84N4hidSgd7h5EcjfuY
+KwBhi2WJyE70Kx0zvAye67pQOAKncOXF9WtsBtffbPODPIbJGr6zYiyC4XA7XXU6O3kjz8DVeqv2TtJbVdWyr6nedjTzvObxFXPyrzOtJ7JIq3xCB51bP61W
+qCO6TsOQjKMqXIoBpKvSDQ0lbIMGIucpfYEdYVqKJ0UDCL15s0V3s+WqZiuDvoZawcbe8UrSTc6LQKIInZkTdrs4h/1clZO7JK+dqFhLHyuWP8FnZOOZmUNg
+xnhQJt+LxbD2FOx3kkqm1Y4xi9aAO6DUn9OcXaCVMrzQmgiJ8rMtP5bNp3NllrC6HgO30BJZho7lD73/H1+e/rrb/5r9/83+mzdHjf3/6HC7//8Wf+svtZ78
+u5TyIPa03VHsJ27VccWM743kW6Ur3ypa5VsP/XfoOIErv9RCVBZof2AqaqACYKQamxlRRLXVBq3A5tg9SfhMnAfx61juDIy8AEeJ2XV0pmBbTKu5rjuKDFRp
+HSR4zfVzglmuELgMNrINnpl90hV2NXcf47azSgcCpTtnlJowTeqEtHGhulapwNW1+qxjgK70JeWeWaSPx/MPerax3PF+2Km225D11KdCoLjbjn1pMtWqRMdQ
+7D25VXL3bSx3Tom4zVVuTVp1Sf1OKMpGkYEY6RMkSGN9dYKed7vJJLehnRF1pewUlK8TCZ2Wx4n1mNPPHacVNrAkYpzQxAXZo06emxpVXwGmQzGztcAT/l49
+Wq4l3AlwM3hvGuDU3jkSSYsybhWjrDaTVzTikyIUnnSsjcas6haQJGFIEczs9QqRMlSIJEhN/3kSka52ryfopLOLWipOcJi+qxvZLQDZ2iudBYM+ZNwhEIn2
+92WW1LjuYVMZOnzNfYc6b0f7hGiJOn3aBGzB4hiNf7QKhoTXOEZ4rqcFTl8gRmMX+KtR716Tbreo3otVPrKICTsxbXKiKk5VSxZI/rSs6D2G91ozUNlFqhe1
+o9OfuFixUtUFKwXGcG35UbeFz9o0SydFLn9MskyxaQ2T6zjJ0llR5qknZogNEKSOWMmklofv3x/KMdT4Swrx/CKZRuzNPjh4E8kzlWcF5CH/HMnjvvz+aP/w
+zd67/cNDluWLO9ULSVOnVFOTWgyf+qvEKhSKJykUypUKheIJCoVyE4VCODGC8yxircG0qolsEfuAPJYaMZgmVGxSphU0SgOzVm8zTFKoskwC6j+PnDSA4ODQ
+/L3GWACFt7sinXCKmDMy2seZ5qygXBpx6aSc3KX3SSaIGd65XipPBGeq9O84QW+q9ui3MEDuVfwhnarsUfgky4YImmbOUcwKi8/UcaQdIBIk5OGlWDrnjT4p
+owY+M9HJw9YjHIBcqmrZVB4D47RbLVJrRAqrESlfrBGJgtT4Ui2Qai+KZnpHciP1SLGBeqTcSD1SbK4eKVerR4onq0fKhnqklPIw9ua7tYoOYcyQppLOTzgk
+4P81ZSA3l100wAAfpeyo/LdxUtJRwOc80UN5Mg1aRoMuNk3PD8zjhpSDsIg6GcgvNHeY3RZjs0erpiiFPt0CO5gk2rQdkEjHznWUN2j+eqeuQVAay5U2+4gD
+ZkWJEhb4n7MWGUqSuiBRimSqVS8WMOVQ0oz0L6DRoQYGJ2I7yu9ppSUWNuwVkuiK3e1Vk50OQmmpp8wk4c0k+eeZSf69asK+25vHJuHcblGK5izTOey5THK5
+zI3MCOcPOUyXgcB70nJj+lUmZBTMSK8ewrusu83lhiE9oKXg9fwHBiPp/M64Qrzpp7c259e9aAPZpUg468TlhAym/we5m/ZWuSHcGrYuDBYmT9Nem5uku2TX
+q9HaIXLjDqEd/3Xse0IubCYfykMdxO5lwsYJ2Trya0rHNCxdXjMEU8KPBX6sK0ryHxvdyVvC68IJr2urAveZ0GBzItX+7OfEBbs6pwITBtZJabQbPf4z+n4l
+GmaPIw/7JG1aYb/32+erz8atyrMyVJ4VBXmM2jrKCtAGIJ0gG7SDAFJ7ilaJ07YwnHJHIOTSMmpqdwW1RAdGnAe45FbJitfxG5y/kGRHJrSJZrieMby4u5O4
+Yw4L52M7h3VYAleotdRTz/tGVzebadg+sezGbkCMCC9iLZ7g5mT7vySS81yudW0yMrzIFa3JYo6Jw1T3XHS8wTaLtqx0Fra28X7R/v5Ii1EjGYB/r0lqCWum
+bhGnFeRIMBKBaa19Z5jfM4GeMoVF0i2sSn+Ru291QRILEonjafOXsOc+9ieFYUX12y40zb+d75um7wQr2ZCVUve4CrCuYsb6QU7hjE01pw760B1LCJcEbPmK
+rvBt503cEE/KsmD3NHv6o78e2BWA40uuML2G9eolueWKVz4VReuM4JL+e7oFm+O2XdTo9RILvWUoDi0vR6MpMtn50rnWAYAa7zm+F9ClTYfLD/k4XN1mJOUu
+Zvhtmpv7ozPSdNtGCh3ahpkNJs1XrHNZlA0wDHlVUnfH0jshvrPAqsMvvTtfw4FkHMevIeYwdE3lS20qnyE0iSyCI+RKxzl1idY1mYVG7QFNqIBrMWFD3PD8
+rZJ61YS2vBB1uD/JmHxjoq8baV3JZZ1mjONqoS3rRfKxOU9q9UtN09MSe3u+J7uyBLJ/TeXO6eBz/3SH+1r3M8e4WBB3apBej2wSO1+nuaiW4DJMVV7LqaqR
+jon6xuwehC2BvqP9Wa8nVF4jnAeBTIiHy3Yy7ZG0qH5ukQx0UTD+gWkUv17HhxHLecOCh7W0gFXhUB1jH1DHcTIDx9/1ix1vVe34gPOpCx5lIv5aLSq5i+1I
+MDkxnaH3ideVcL1m8yTNdOI62iW5eqhuIXO46jG6G3XHJ0k2WdIqqQsWMCSwr3i4KzTUcdpcAzQSuXpw+tTs+dTlagqLA09wIroymEekMPEjlV8RWMkZGfik
+K8mPhJdFpTlI3I6N5GM4dpSTgh8XBsrCcdmKmEGYiO5GkSMVnzUNQelp9zZ8l5CzlybmboNWRzgqjatuzj0PK9ysN/Gu+t3QnEfk7JOGLpqWhsnpyIxhyYoD
+DuzTcVdbgSJ9o22pD+1egTuifzk0e1fZOCUayjPFbZnMgRzNcZBro754cIHaWnfFyWZ0pqrFotaFgFwY57jqXw6dfSuQZk8rT5mzMYOwlW8AG8X8jgRfcY/1
+6ZJaROeEPX88TXeI2goco+a9Q4fiAzqj/NE/nEys2wlARawA7x1XLdcFd2r5x5UczoQ1z6esq4BeUG/4MCxlAHrTKc0IPKBhAXh39Lpwu8K7UJhOgRdzKlxe
+2CgvVHCxpBwyLgCWtUeX790G0CwiZnwkXdTBU4TW5o3zSjgFMxuagTLp3TPBO+d9ktdszRTlI76ox72dyGWlSpZVw6T4nxWRaWRF8TP5P7AsftFa5Q2xQnlD
+PkV5oy6EO4C+MEYw8BsoYwirjNGhfAHnS4vohfQlTNNaJDdIlgD9RIzMRKCq4efPaa5IK/lMPQxjbzaUL8RqwQtub8O/GPCUba6bQvdX3O+gKbjfvA28SM1Y
+nxHFsFPLcY3qgdYMX41fU/gTBppvQqG0rDVjDvZex28c8rs58UTJ5v0i0jdaqFHLNUKvOrhNEMFJ8+IWguUaGQzWJAp8S2KFnyuRU/+Gdlc8sA6p3jyhgVY/
+F89/d115XcKeMW6O9lpMirxapJMlKalx+9Em2fRaErVfSugQhLivKpOs44qyZs9vXlOIecWfN3gn7royhT4/rWOi0SZaGJqivRFX0lXZ0PBtzV7bEPuykV7h
+mYPtoO0V8rxmaunK6aOzq3UG0uOAlNBuw80t0y4TR7ZH+EjntjlsUmMaFGshWZ7Q0n6bjYXuetuV3E5Lp8PbrmzbdmvrvNQ3sJQc9+GACCKsbdtikQHrceX+
+7+6+YrPdV26y+4p1u685Vdo213exG5xpExB3vvbFw70FINrUwzfWDA+GWrjvxNZA8CdTnlQ42xSTxEgDrdYIFxtphLe5L9BzYU6oApFs2aM8ITtrXCf1kly5
+I8MPEwthDLp0bsw5eAlU1adX1krw9Z1q7SERErtUBdHQOXkUXrW1CVjZqlnqGoGWoO6wD+hGcivTPVCtGYlCN46jTjc931PksjVoNwYrGKpHmTDl1xhAEu6x
+pM+RUIfRud618j6/jo/wht/4vUk8cT0wVcv9A5U01UIz6AideGlhqDeP7T3Lep9tzbDuGVQHh1sozznXQ1PMtCzxo6x+Rjpv5H7TrBq402EKhkxrmptvHGlB
+eQ3oEK042J/eq7KmjJwzxoUwKUzifKUhI5WLGZkpJNlFtUGHWCMIc+F1j2LUATbS0UOBUj/IHSQc4uVrL+ZhOjPva515czvQtsNYDkhacE5O8as7rYa4gz+N
+duROaxk7OAM5RW9Z1YKJC1coFrZEP9FJ5G6aJnJIWXVpURo2bs2wRyYDZT++ASf5JXfFOdQ7bn8NVNZ7j46+gX2VoGVG7dyRORPaQw0einIq9RfEzMmrJy25
+n7jCslFh0VZhKeXbbuE5UFc8iOW5enDMcDiaNtShC8oTL5Ohk4EMHdQOJowh9fbriSJ1/rTuFqkTTZG6oPKOTyB7SB6rTqk60S1VJ9dJ1bUYnmKFVF1HVX2l
+OhhoFqlzxTdhGJ+qT+ebBZ5AXZgOL6V8h5c79PkRRuEnvivGQnwGvZnzNhU10pbxBWzOZf/cyKt97I+HY0N5IzahvAERmFZZMtlCXyMc6hqjj9WoL2hewStZ
+7SpyKG5OB8hvA9w2ok19LKCyGZ5/RmE5CWIvo4EcDcf/U/bHWn2Idc0EMPVcDkYohYUSOp/aK6aVxGI5/nJxfXqCneI9JaCvB1xzkMQanrOQDegPXUXwa7kL
+mjIwR1gLCFV4XJ2dnuyPx9dnA0HyQeMrPQDng+PBeNwffZXjwejH4TH282hw2R+OSNkGpb+GF+cxjbKmGhqMQPHMDCco4FwNr66vUJ7Hld/pj7QUm5kiKCN2
+bTV5dHuF7hVHDwwYjmiSDf5+PLi8cmecrQtN4fehOMh7DmI9W7sjEFrpFubgBcjisBsocQirxCGbShzo58fHO6UAAqPfYulDFYB1hP/BXrAB4b8b4Q/p/mVA
+9y9W0v3jGB2aoCKF/GvVKm3hUJ5qBmF9y/MzsfjTIOHb/AhjbjiMacYjw6IaiEThTPy6kEkldpBWfJIukrze6fkqGpxSiAU4j31XNVPV2zK32gPSok1Jg33G
+DBJyZIvMGN88NuqhdaK89KQKCPr12Q806q2jE5GQyVueoezuRnPAeYGzShZlUS0074ahikpnPNdNSRQpxADdAvmnqWisJ6XGUM4dOUZT0lJhV9EiefTensj5
+EuJVGd/kMVPDhF6UziTVN71SLpKKSFU5sWvZleNKc0e0p+eF3Uw53JDhB22dlskDvc2ZrXimijZO0Lb3tk4WPXfh7cJ5O66coJNNt0Z4pWl0CHTBInlECnA8
+4nl9JSX5CvxundJccMaCXQiGiJN6K2yvDqDoXmFq3+a89WYUTlmxesp6W7K7VTNV9i+LtHTETBR3jJ5rC1WmxVQy8bSayuSmwI0ILswk80FXocjo20QE4bjH
+nzuKEzBtuTfcGeHabc/cHWy+ivC2B7lmewi619YxnDgt2wK1fwp9jP+JcZr74mel+cDx+NC3BIHZOrSNluju4azzaSSrIptG9nrP8sR3yZSfCntArIH7uhMe
+DozX5sAgdW+ZcE/4BwZnbPDS8fYO3seF4YX4lXdw9ujjm8zyQJ7uezW1Eb4bNxIuK1XXFDDuCSvlY1Cl+vhefVby5CAfnN4LxH2SLUOF+5WnSdsxQutRGNJi
+yPvIZZqjLASyUMs0l1NFS9RkdrKuYClNJaifaEcqSl0fHOojnY7h6I451ktQq/dUq/fxIa1qEiJR+VRAYNT0rSFvrlzFKzNfi9KQbassU2XVa0gtIQGrY2Ox
+D9pwXFce3oFq2abGJHzjrPasVykl6J3aJGhMy9byB4J1Pc8v5PFwdHx9BlKYx6yOab4iLx0pfxpF0FDrU1itz17kiIP6cp4klzoa/xiFOqlGgvRkONafwe3A
+u8eYh0BO+3Q4GGnrX6t74tUL6Eqt6irpYwrvUnM5GI0vzo0gKymvWg1WT5bVarWGIp1Ca4lyJY6/9KHNg9Ga66bRIIX3n16AgvYn8fni4gS0kiMJrKxyfHVx
+edn/PICuPLu8hkKNMulInvVPtRi06RKt640tFMcXZ3A9dfVj+WWDsVVVNX32lUfmS//HAUmpkgQzaD6v1VKN9cWsXemUSj6/uBJGsNR+CV1wMuhffZEXelj6
+p3J4/rfrEV5Pr09R4PbT6OLMqe13Y2e6BaK98JLhMQ79af8no987pp/bSrbJtwpXvpULPT69HrMOtd/CTeRbBcq39q+cckjofY2Yq98kKEHAGDu1+WSVbD/C
+3X5IkuM/9k+HJ3Sf738GhwW+3VEsJnXw0fjH71zRXVrrWlIYxdppULEQZOOFq/PgRH4C9wFM6TE05OTi9LQ/Govd//Ym2gdJ13YPBdQMdMTPwd0DQw4X9+E5
+dNo5eQZwncA8FufXx6cDkPb+ccjL5aw/HsurUf98PERS4LPByfC4f6pH2qiEm+U9PIcb//nV6Vdx0j//PBhdXI+9p7FTjtFXQnMMKoPTMAonIeCwr/rjq9HF
+5ZfhMS95WoPjsTwZjK9G18eaBPkk7Phz9krBdfK8KQ4tnFUHX3nywPDBef8Klnx/LPu6pvwNzmb2f4BusdfnLNi2H7cJaLFAG/E0NpMQGhJtEWq0UdhZlXOZ
+GmE0meby6L08jj/Fo1igbJvcvYAIEBAX90jLnBy6sph5BU+0Fpi2lNmbbx8RjUf81AGqlhMKTUr2/bu1Yqk0sTtWC10vdNBDvSiPtr5rPI51sR8aubMDWd+V
+xfL2TpiPjuTu35a50i3uEC2TvmhZW7B1E9UyVq8NVfiufEwRZ1EaWh3KljQGi0v41S4oAdHO1UJRaSXvVDZtVTOiMTG/tbZdqSA3R025rQVftXAY3CRHE+B3
+St1E5MLhYGANIe2E2lVuEJDfKXzNIZIhyh8jq0tlAH29SFobLyVGlVmWTuq9YraXJQ/Cviv2hC2q5c08xfe2ategap6tNYQ6tcoo53XpjAbCV4CdSz9MSgr4
+kVno5Pxrun4jTWL7/wanbq2Dr59YF+eYdHHYkD8v8P251cYpZk4dI5qtpLVDJABpLsdJXieQ3V+CG22JuW72N9QlWYEVpsRirJO4aUJfJkVVr6HJjVicCx9l
+I9tcSpK6LspcPX5XyZliaRP1ywLvJnGoQwU9S5J1gUwer7hI6MhXpdTPMs3/iQADlCTJUjVzOz3RrtliFgyApiJZIyn2dJkh4coMyefLDAGyTWwk0iY3EGkT
+q0Xa6DFMR+pQaWOp0ZGeGZSpAc1j+IgQAyt4kVSq4puxJvPwoOJJhV50NQ2d7Rb+dxR1IrG0eisvt24IEASMdZaYCzQpZh6BRpASdoPkOozkoxgnTRCt9eUf
+zxaEZ1FsdCnH9Vi5Kk1MQkl1ATcFoc9YhQOWhU6S53TgWTKpUWjGU0ImPi3oL0ruUFO9t4m6cBN9Sn+4CHYOCTuU44Wo81gaSRwhdjqla1xJGr0y1zLdNVVd
++Jud3g/ccZtKpwiTOaMnpYnisnCLC8NVNi5EqjFMZF2mhBRCCuvR5akwIq2e3odN9XTOVvcB6kkdCiX0vlit/sEI0SaFZizHSnkvm7HnTGvo2dVtxVh4Aodq
+LY2aa0sEE4IekspBX26YESIvNQydI81i9eMJCsAGHLhv9g6+//57VzBJOoJJz5EP+cc/4p0/JA3oaJpWk9+V/3P/8ODgIOD/PNjff7Pl//zNxh+2u7TSgKMe
+rjxvvYFSxVk6KYsKCegdmTGXgRfziJe5Bk0pokP22Y/xRZm6VfRTk45XEMavThaMRSddtoqxLXqjNBc5rivJCxYZ5WglZR3zPYkyq2GHLQUnqFBTtTVImBLD
+8/mIv5iVihU29aGEeXUjCPF7CSe4l5LH4fwi0C3Su6j14aFLwz4kMAVkPPx8bjNAtGMMPABdOkfS6BzBF6PhGJym6NfqgytiNObkkv4p+g2vx+xnuBr1Twby
+EjyLw2O42VNPOCNH53NeaHgJpf9zT2E2qsn217YGj4romBoYuIYbMoxQSvRtYHaXzFnFjDfGUC2lyu/g6GTOyPH1uTwbHo8uxl/HV4Mzko+KXR/juesExJHg
+XBTt+nJVn6DLjy8uv46Gn79A4g11yXhwPBpcjbXr57IPvrKx/PhV0oibz0eY0DMaXHyCRN4ceooCAZin0dEFVu3fpG2T7hFdfYBypyxmrFDLQFU4Q5MsMoah
+JLa0nHO30iTTBmVksPrjZS5MkloypcQ6rcZbVNagYl4WLiAWHWMnxOGbN/vycwJOFNnH2oL6zpJwCj+m6sG9mwHF4NHrP70Qwr/o32h58/grH//rzv+j/XdN
+/u93R9vz/7f4A2pHczcxMga3GGR/SB4hWfQmqdO5w37vUBi4RKH6aDakdA54OgqsALhNWWCwA1uwoHNtkwtNy0/2gMFgT02WaIXEb7YRdNojANe9Y+n6afTw
+Q/LYUi0xLSyny8X5QP/a5LJ/ECLpsSg1vsPnQWXPGF8tTwrMTvOYvbCT6zs1R5W67FH07VXcicAvCvJ6V0k6lQ2u1etK5Yo18/GOfJ9k4KkD02k5x0SHG1T9
+xjpjlUiGmHCehD7srr6VLhBSypseX3mVNBxw+gnyxHLuD/e5Ja+Fhpe3Sc6+jFiISY8mF3DqVayQJoEmDMmWvU8xNZ1p5tKcoMtYSfQsqRJRU7eq9idO1bgi
+mzD2tEedb3TKzffSyUCqLOyFugxxUW1A0nBWFeRvmHCyxg0BN3ySVmHn/0YTLXid6Sm6SpMaIMmDBtM8knVxS4zg0B7h9eAuj/M8yZFrAX1xpTOReqaTZUsn
+i6BvIU9ngsS5OeUhud/b7pwnk7s0V3ulSqZoF/EuEtT8TztLjhqbkOXsUa7RHM4cIsviN+rPxS4jqR6lDWn1YvlxWRPgS6vCNntc59BpEMfNo1PRSFaFo4+t
+nzW+GK0kHAvxiR3CmjSZgkv0Xr43pbTJkppeTmxpVWQ0feFZyv5AoAs6qDWhc3MKw+0jYyWcNF8sa6ZTZ5ZM/LxY1otlLSw5vBnJAtvh5+XN4GwxTbOnSmO1
+iJtlLUntTDOWAfxSkvQCKYerecTiusjnB/llztBkj+ZbCEskt7elurV0IZ6sDcJQKDw+vvh09VN/5OMZtK8OboPaUUfRe4QmOMAEV2FXrEkawUtR42ctUrvw
+XrHuFhpvTf6/wN+4//e9y99X/+3o6KCh//Zuf6v/9pv8Wd834pYrIcb9v8u0QlfY/wedHRDWiWQKCakhF03xkLvaB4Uc9/8eySrNJ4p+QMA5MJYN/9SCTOMp
+msYxiPYajJcQH1lhlSth1CPqovlbjFwRf31eWGkvjmICeji9RROUP3FOXE2BpHNTvUQCX33SRPghvmmwwA93KndseqQAnHpQAe+0uSuyqSorj+XaRPjZNMQ2
+hxpmbYJlYXRGTYWNzziB70hzbEnLsYV9Fsqh8b0u1GtDkEJa56qqGJncIsKGJ7rK67RUskwrhOdzd/7nklj0ICeBGauRoaKY6eGFVoItHMvxHbIFwefQHagD
+QbneRE6VVNVyTlwAk4KMEQwCm5wPiM6kE2x1qRZJWhIviHY3Bm47hoaUmrbKG2jJgWwGHtSFO67g8BOsBucNbuSLN1GSwsNdEZiDrzBCbq16gSvGugqJ3MyN
+HHtULfkjWyRZ5DoLJ6gimGSiKNu9hSbbo1ga22dJ+d2pS2Wg154lahPNSSSzoqq0fpTEmtaJvFFQfqnA2MK1kEwmy5JJbeEXqhIV7LaaLPSRUpUtWxzjOBIM
+ty8tBA0qVBeyWKAlRtcZp6cpEgAEXNojitdoGhbrW7V8dIGjVKx3lNpd0oJTK45V4uXJItg1GSgvg6Q2wLRHeZLcp1N5pm5v07yCjQfmB/JkERc4VYynAb7G
+mWZ6FzN3GFZrhN5BbTY3fq17Dnc64HjDn//97FTujvt/70WG0MyTKtYU62p+QxyeWunh72eneyeDHz1uxIhSuJDExOzTeJfp2OWLXMGBUcF4fjABdUZBc+Ql
+zeVNUd85ZjUTa+V79iPhpLhUsMvl0wwL0ftJ2X6/0ysJny1ymcjjk73RxVkkoD0Nak6M0t8Uxc+RnKdlyd3vkw/RXR5ZT0HETVZprSLNkpDqxB2egeBPg2ZX
+SsHGGgvRmBDmP+WVmtzlRVbcwqo4rSHwfPD99+/39t/sHRzQqB/CqA/1GCQ3ST5ltm2f47Tikxl+IHfb50WPqGJ5IF2XnP6h0zURi+8YeXb4qdcxglmNNFGH
+m5FAWWt10XSUxfiySWEEnW1s7St06u0yQaSDIoES52jKH82J9NRePdzf34de3X/zr3ihGR8P+r+v///gzf5Bw/9/9Har//mbjb8c3yVwZPsy/HSoXFFidD6V
+x8a986FFB/SDEDtjk4hNKXpzlQQqlbzFNFncjEqR5bOHBX9BHtVP6EINqOCdbLiWRug7ya5N4orI94pZB1OMPJDh55yxDM502WIdqc1lyd8hD05S18nkjhW5
+tPdTm/7uw7EQRh3f65pxgbxgnKM+yGtVwp4JnSL7c1Wmk4RDybuM6N+Blu70hNjhniENt6KUO05XeS8xcp3YeGIBq5jLrEzySgumlfRPS/bqixe4VmiLRWjO
+A/KBC+0DR91I5nWpCz4H4R0wCDXYilPWlEPXsFTI6UYGu69TB23kD9w2ot2EBkeWpDk2GarYVEwVoWJqmk+XVV0+rmmZed49/4RvBdBXkbxTyZQ5qCG9O71d
+chzEzAMUHYOmQJqnN050y2mRVatY/w0Ty4uSkmrBNykcrQf8kMooVZf2Wkua7GGs/81SSVrmvKGRRCDeCA01zDAlTtYgybI224X1Bje5J6GRJaB6JxT28gSW
+IleIaaHKhQIgeITXSFeXyXU7YHKJllByVJXsPStiizR71OpJMOVdtqqxnRh63ylK6Uw5aURszgtKC4RXf0o5wYN0i+iiqX4h5QBjtGs7DJPxSxVCo4NOtaMl
+PCIhJ6Mf/onJJzBdZ2nmKqXXiKO3NjK6438WdptblEVtpeNqivpqK5XhAfiWOT5u/RzpHK7WxP9peP4t1SPR9fq9SeUkj45zHbdrqq2RkmOiQJNZWb2iaIXD
+yOaGJI8ciiym28ZSH1RG5uPE/RbqpkPV80AqbVGncw5PVpGsiyLD3d1f4ORLovFK5+ShKNABY5j4HQcHfFZNioURfDSdsetdt3a8Juz0Ynm5Sg9I6BTzGWdW
+O8RLY/eEpTDN3FdLYjZmHgOSKNTRqjviOpYPaXUHBU+LnGH1pd/NZvRm7GhIp4q2ONiMaCOtPPveDJvUwaTkvkin2hE0LZY3daRpcVGmGnVOVD5d8XqbbT8t
+SMpS3hcZ5CeVacbsJCUyghG6XnW0IkHiPBQmY93sBWkVdZ7LkSC5Vn/BRsEeJIvSyyF0TuGi1P+cJ3kOhGRUE0uQx7sEdwmlZNCI8Km8ol8C7k/8zsIlzBab
+ta3GZkfgjRBLNK+3hd0o3rxKXW6Rx1QLnfJXFRlewCcliiFHjCPSUHxlbD4UG63d1AQsl6bhNGgPTC1vVUUmCTTSah5aAtFK1j1i1ayjERptppt+q2FGbb5R
+Bm/UaBViBYfxqivkAycnKEcJ8Q0YQYdo9qRUU+LMZdcdjHT+c8ViSVo1ze6B2mYxpmZoqTo05QQ9M/PD3/8gnOzYs43mYCSYifRg//QC1d7eAg+Jmy4AQtvx
+3+B2kp8IoAZ1FisGmmPj6rZM6rZqS6SU8Bar8BcrGc8aVoQNC6LAcIg12pkXD444t2jrz5ynkN5WHRBDURomzpEKnB6uOSGE3n5df3CQZ2WHaB6K5FuJyEqw
+0l8oBvYp1Acmw+GDFuFJgNZipSwcbqX5Y2PisX+GDF9d3E0sh1OVg7hKUF2enA+qVEaJyW2eEJO2qtAC8/xt4UXLm5zzVvlH4PJhzsrmgDjXJqF5pl88Hlp5
+UfgvoFypXUxu0FlSemLDRRrJF2+LYgo+/1jFEk5BpAxxHZ49S9hrDEC8BSVyuiQvvruf+fUnd51njYvG2EbaEqydKR/aRIau0YvfQYpBa0bDKjpGJhKE1IbB
+OSUrjIFtoz2tPuRpfApLI5Thl3o1vALqRYdaEfPFozAlAnPunZSIVpLGmMD7I+iD08EpsSNenI+HQCVBPBVXg9HZ8FxjAvqXl6OLy9EQEs8HYwQGXI+x9mcX
+J8NPX+E5pFQwlCb6l6aTkbQDSRWpG4fj/zmGDy6OodQToakdob+AzoNAApdQjTHwQIxbyDMNj2EH68vTSV+QM0IPHNGFGH4Vwfn6F86XnHl/9WU4OuGPuqlW
+POKMSHQxrwz+Pji7PAWOSc17EaIGoHjN2QBEJxHMQno40oALS3TRwj5BnTi4ArIVw3thCG/EpwuY4gNoNBRwfe580Eqp8qU/Ji6V/smPQ2QkbVCpCE2l4lVc
+mooTn4ZdBOOAWmWAnKNX8HtBCUncIIJaGFIRKOXT9enpYHylSUX0JILm9K+QK4XHCKkkzrDrxWjw+fqUXm3YhD4bZOBp8qC9i6TbVMi/OZBmjrFZJHQrbJ/S
+7GpVLkrFcXg4KMqpp4gAAXy7kdcs8+cA0x2EPh6VN8UUjyGIBzs4bZJwEPSFRsJ6QGssHF/FbjyS/kzMlXtRFhOlyOVEkHpRF5qsoOUGaNtPGbzJ9J/LKSdP
+E9PTgmRu0ekjLNtTF3J/vEQCupKg+7r6BLyHf42THEnCi0i09JTmCPSba+D+VKZ2fTToAIRXFrM5cw10GJh9T0R+UMm0rlQ2s3kaWtXdY0KAcWBojVEgRl6C
+WaDEb2zthGHzw1xopdcItah0sJhq8ZDoQ92SHieAvH+UdYkcJ8yKOdGuVGd8O1kVLMOKjS6fs/8ZtQOmgciNq+DpRKQP9/ffrHQlE6aHj3vXWu/2/UdyFRha
+PA0MLdeBoUUXGPqDEAyHLlWlknJyF1cTlcSTYv4K/uMfFVb+H2SG/kMztt3V80yI6+fmeEQt0WMjsLwxzDowcVyrA7i1zk+GZjd+Luwa/i1Wwa4teftG2Ot/
+2/79y/2NPw/3Pu4dxPu/YhB4dfz39eHhwbsG/vvN/jb++xuNP9HSmwsF3wHkRyF23VPg4NXhm1eQLNFrCf9Cdkp8IHf6VjCdzzJL5L2DgS4m3HKU1R2WpaRi
+gQrt+To3wmA+F6rdvSDmBnvdyleDUMhBfCh3+pdDXY1utU1p1TZVBeQjRhYBeivF5JZ/slCwPdy8CmJ/vAYP/z1mw1EMk17cSrl8FroTmHnrxiMwD38Im/qZ
+H9BgNU2ktXEimwtNi1Frhi5xEB/JnS9MLmx75VEu7h4rwE9osmGKWmEclXEZEVk4VaX0B6Kqi1IbSRxkLmb6MTxVOfvRcmMhkgMr8kbuOMImpi7MHYOxTeoL
+VUm3S9GWDlpG3CtP05zCSrzVNg4YCLoOd8m9djUZ2w+Dd1G7/JFJj46E9m4z6RFKmPAoMq0JlUSKiVqeA0PWZLJEmmtdOOlYHG3DwPojawZoZjdclu+sqWYm
+XFoZ5w029b3c8aaNO/p6Zer2QdVqiteUVkCUqyMa8/FVUTaKQe9YhkEAyjxYgP5LsaxCzdyf7lTuD7CbBE7KX6pkfxYG1SOZhDq6H4To84UraIrLFcSa8dbP
+K9uaIp7dFPGR6pCrB3oTriJ+XeWpB7duCJ3lHsTfyx3a4dxhyzt2yqL0OjSSibWJhbk7t5jrweo42Jc7XslmO3dSJpmazOdcxG9oEaeV8FS6wpRLapZns1ud
+4CQgxKaMxeViirdP+G9Vll7Wd2VIiYikHdZKXVBzDuTOSAtt2X4Mky9kpm6TDBPc68euvAqqCDGDacI5N4NSbzm+45XKZxUAq8njScrLtKqW5s6mNc3eUzDV
+qVoKC8Ftj85HxvnLtdf7KApGV5i+y//IsKsxaJZym6A58yLXT2CbhHkBVcC9U9MeYwyCSO7wL3foxq0rQR29m/Q46/oB4kDMdeEQouHuaj+eJ3lyy8TlVBAJ
+Htz0bB6CwC1hVmOCx0Tltdx9s/9/9YzaLw9GsawNeR9eH/G0vVG5mqUYk/MKdN7obLEHh2AJ6FmjeaU+l8niLp0YFo2W9BqcpSOLiyfo+oH/EKjfeUk1joHU
+FZ/BJHpBXotVMuqRcctMl0aeoP0XwmaJwCF/R1KavGj9hQi9EaQLmakiEyefx0vgCfJ+dD4PDX0RgKFRkEPPTHZ9VMKe12yd4Y87EoBYwdLGadC8oFw4XMIh
+EMi6CyKTKQQdzrkebfZYVuRK6FOwMju8p5rJwoipaZCTtUOjJG27BLULf6LmN8U0tTtnMAhMexpRNMgoIYCtlkxQiUMAk7x2WM4oE09WSaaMcWZTfaDBRYc2
+SWyHV2i7cNPwLwwUNrtOftaQFrYlkllB2pxpkcNx7yyRQ3fVyCLnbCvrUQp5OZvrRrM1gsZEmvZg9ulez6xDhxOzPsiDnqzUIkHEiQEbe73wg8hBe6AnKfXF
+6h5UxLWKxxdMW5/pqKU/ETGAEyKw+luflo60qjlki1JqUx477LU8L0yXf3K+lUMP/MZssw3xhTR3O8ygxH1pNx9HB78xb+GKJ9ltUab13bwys1e0zV6s81Fw
+C3I3OYAqHBsbJQ6eNEQU3I6pL96CuiJpL1jeuLc3y5ktM4Lc47tqYRRZoQpJpdHfKUW6eTmuunw2b7Vh0ymr0Pf4wrzvw4c1LQjUKsSiXx2zs9RM/it7U/ZU
+lI3tFAnI93mIOuS4S8VYDZ3DxWnPntSTXzt04jt2JVkErVqqvj2fewYfkmMI56aPFbQilSSB7NjPSa5rKBNZq19qggrBopulKpv+AH2srDI0vsB1JWB72pMd
+gnez516skBvXFBl3yu6I31VtEuPcCaQ5TwqnbAfTNWHlBGJZtYlWRMIqVq2ziRuRVQXxZb98YOkmkzEl8L3jxb/0EkvsiY8Z9m0BANF6+Y6cn7YyrNjpwqeU
+HV/BhvNu1ZNBxflw3T3oeW/7Qe4e9hzQMq3jSZKJgEcYk8Fha8eNheJJmNRLC7pUDvoUOX7gbYTbK9PZo/AmRJEp+FWmc6uLWei30YjfqSqLW7P50+koxp+H
+Zlr9oHth97XbjmLmTUHUCUW3i3PDxe4XG0nMN0Ti6ccOm0laCSOyTAlR9K9KHkXybSTfRfJgP5IHh2RwHLzWovdUkKNdq7P0BDpCFqqs1FQHdnVWspk5OBA6
+0iOHTqsp267iXExBOuncOVYKq3PmrdKFF4ZFvZjJtK64Oqvl4WUgD+/YTCQST8jGmk1XgJlCKBJV4OtHx8bypOIr6WnFg23YrQpvrMcOBfjEWdb+OI8cEeoZ
+cWwd+bqWL1K0FD5NS+oOI7Ggs3AV/CLUs6w8QUvhCVpKLWip/Zy4u1rhSm0DeDsF44LEy0QrI5g2ylEkBOMp9Dpit9vqxO42D9VYrXMp1ulcNusUCl2+wYwt
+qxF+UcoLXB1EdBwM67RQxPpwy9dXB1L6QaBIoqNVJxO88Bhz2Xc85YXOR7DLmgsrSmEsUMpU1hTzzYmUlCndIjA0qhEOqqqLxUJlUtMr4CWmTe3QrdQPZHXj
+wEKPIJtUJGvdO7gjIbMAXKjQF53UVcEZtysUBqinOPSPjRRhFoKymuVWYJyHkIXRK7qsaiZ2p/aCulS/eJXI+Pjz8AdWh9RNTWum7yh9b0fLpZK2OB62mjh6
+07mqeEJyHihsX8iWzffyVsukfWCbyCRNo6/5tt/GjuVP7ztNHqof5HmR7w2de1fblllVy9Kk1GslC5fEHxvm5QVUkaNhwPk9jEtD2MdUlVUk0MLJc+VEgVyr
+iq7P9grsLwUPcSZCig74vfoF4hpQkz39n1B0Oof/Nl65Zm1Fa21NfhLoytxaXRlL4o9CeWWqKvdur89oxyJr7puwCVhKRUcactdROhBOWnuaPfbcs2LXiVKx
+kqRx8fBalJWalKoOVmbBgNE2dxeX7dCm9PQ/6GekpgJVY8+kvoJ7x4+RwjHGv2ZkifRcjgydSlGKqk5qaONjRCxiqFxsvTwNvRgNsbpRcqrUHCeAI/DH6LpY
+iHcxa1dAK/xJ79lAYK3kpt2WW4MdgDhkQueM8QRtWM5sYM44xkk0LoCwwLHE8oiU3ww2Cc7bt31XrRwX1/BCwwB2v0WdURhj9ggGr83igoqjDKMR6g9ShWI0
+cmC2soi9hOAJhoyoJRCEube/FV4iDW6t6ZxMgxT2f2NPyATnfprfLtMKZV64FJbaiMVFPgkWhWEYsYL6PAFc/px2Ef5IeFehdm+tY5STdZ/mS42ClKnD5Ode
+txKj+4+ec7wemB8x5qVxdzKWizBGow5TmgbYVrIZu9p7rS8Jgu4MR02DBa9bejwdiBs1xndAebdqTWnSinX9PnZ02qWjGR/LY0hWdaTmAha+eAdFEXW2OVDj
+tZDoQeazk9UFiRTmfZvlpa8vtEnUF8lx/2o4/tQ/voIka5b9j8R6BnkoOkx6p2VEyeSguIfJ5KI/1um/XDz+9nIwAnVHSPvWSck6gwRY2lEYz/0Qe3UgTgaf
+IL34R9Tuo7R/zL6OwlcfX4yvSDDR1XkcD0Y/Do+hG8VocNkfjkg3cTSinOU4FOR3BxoV/c/lYDxmhUXoFtDN9DLf4d2siUdKe/7c6F9ffbkYDf+fwYkEPnjK
+BQddw8srOb7++LfB8ZW4ugirARHH/bhL3jKWHdn0IPfXqpwqAuXU1kklHX1L6Lqr0fD4yr7VlVgVbRKrMCBaK/XzkDvoYuToonZm5IuNxE/lBuKnYkPxU9kp
+fqofOOlf9SPxLClU2SqFKp4hhSpbpVDFc6RQZbsUqthYChVdShtooIona6DKbg1U8SwNVNmqgSpWaaCOBsfDyyFsakIcHHT4VUx2fFVkKnu0ClQd2l1ihXaX
+9gxtKt0Vi8AEmqqZypG/T/t24IAEFjN5l5RzTGbuCDWjCZPkVi6NxSaqyPqEIssnR7J3hF+nO7dD90ZZ+I9zlrl2lPu1MB7p4vVCSjnX+whhR+GGvSwrg0nV
+D9PbG16ZouSixWpL3Ki02/QEIUD/rVu5VF6FL2vVLd1x5EfFBvKjDTFRR+WULt1H77X5ecz5MlpDcWQvceYSuVYGVLDmZ4viZ5q3ZAqUHYQdQdoJ9N/rUCRU
+PlsjFNuLgHBPKxRvLs8XCRW+SKhsioRWBbNRJpO7VN2jBz5XCTj4ksok6lEWfTJXnFCDF+hJkRfzdKKdbUnlU+/Ztxq/J7pDpqoM2U5ocdPX0rjvgt7sQjU5
+SojtmCbpYpp8OI6umcbvCFcmlA1pFp60QvQqr5m9sS6oBOx5znhQU6b6zB4Fu1udQm9U/aCU91GpKoyvVOSGzdJaqwg5sTFRt3ZFMFFtnogH+uGm8yoST1Ii
+lbtFaZy2/tz0XiLS3MUTRTzj3Te1gKR6TErvCp06DAxirehp5Iyyq3zaLngqnit4GuzrOO2s3ulTFUVlqCgqnq8oKhuKouKZiqKyoSgqnqIoagPpBkcl+3wR
+xcPV8AIHTMI6NbmhTQlHlnBmeJK3xmKSipy5dW2zfjBjv1QO9J2rJD6uhG1FjRTYdrlMOFJER+ZZC4TrVxfEbNg8TMGS1HX+QZ7inj0mH30VyYO3+/uyP1+k
+9Z1K6lLJy6T8GaVeQgGrPglX7R28fnMQEaGbxZsVVRVXtykizRZlAR1TvYJe/whUprXiWE3iSKiluVvzKOCU6oCKCavISas9cDVs4BVw3AzrBACe7WYQrW4G
++Uw3gxBBNtlVI1aQVh/kv2M0Bt2AuhujwP8mfdbSaVKr/4gC6cJWk7n1lQ6ocnfSk/8upxrGO0vLqmYGMd5QTVIPC1h7aBXRiH78R1dF7DvZib5oETD1OZLT
+igQyNImhyipFiSgMBugQLl2VYwLd3Q3MscrmKxA6jTY/FaWz8x9bCOLvhf87+P3wf0cHb9828X9b/Yc/FP7vQO4fvjo8/NMDAJ8HyDPk/i4iz0XSvf6jIOmO
+ViHpGIL0awPp3vw1gHRvNwDSWbDdlFPSzSzvyujXOFLDBaqDqFOdLMTCDBVfkxpTExrjTU9rhFLZZM7609UDfm6K/2ug3UJAoAgBge217QCzSRfvJ/4YeL91
+rV9d5hYNuBEaUBNxmyRo8WIwoHTAgGILBlwHBjTzb4sFfB4WEFGAtpefsvsnTgiK8nHcU0C++BTgrN82o8U5BQyY8bXc2QDNeBAfHOGDTz3nvNxr4x+ZCi7m
+aUBJjESvBEu2miVbnOQfDCdpwfUrDRkCVMpNAJUiAFQ6TeaZtgIhSbhIg4kUhInkZMsGNJKEElTZ2bDm2hRBw9wo9FMRlKITQSk7EJTo7QqwjujzFo6/OwyI
+ziLP4HYTmgMDDOwuNeWAnDGguEres4iLrHQwxdmVCnLS3zNWwk3QM4Z1azPS3Af5pb3Qmy88dV3nrTor2NHndSuLefL+20TqzFA+hu2G2IIDLWbeAzfhrIBK
+hlO+CCrZ2PsbpC/Q1sYE06hZPctesGsKN4109a7ZSMDmbY4hzI5sm3my8nY+udHOh32PhTUO4M1XuXRXufgWqzw8gX8VJG4+lTCyL0fkipV48o0umnIFePV1
+fLBFr27Rq787evV1fPhbwldRXh21FZKqG9Qp6GSwve2rpwRm41pErHDmzEsRscIgYuU3QMQKi4iVT0LEtsNcxcYwV7kRzFVsAHOVG8JcxYYwV7kBzFVsCnOV
+m8BcxYYwV7ke5io2h7nKdpirHOISU/PFXVKl/2XHwM/7SgwkKrOyUVMEOyXlFLMhfSwEbpZCzRdZ8RjWpDV5yh3Z+DBYxrD96SlnZrsGrzTcak2TTF+zkkqm
+NWJC9AZIyB59uhtSwdDH9jp+/W3AwEKDgeXLwcBiCwbegoG3YOAtGPjPAAa+QusWfn+flBg8MddMAs6KtTDf4Ha1EtS7MgHz98PzRuxK1Ts4BtjmKSpvpngS
+FzdZekuZwMWN4sNgQxx07AMWvwVamDXsPbRwknc5Hpo4Xw/mKwzMV74U5isszFe+GOYrGOYrtzDfLcx3C/Pdwny3MN8tzHcL893CfLcw3y3Mdwvz3cJ8tzDf
+Lcx3C/Pdwnz/NWG+eJH9BihfXPR/ZZTvwb82yvduC/P9V4X5ymfifEVayW+D820NbPz+OF8nGUe8HObLTRZbmO9fGf97+Dvqf+4fvnvdov/5Zov//ePgfw/j
+/UiO1aKO5cH7CCSn3/fcXfS4J/+9exM1e6II9sR2moJLG7FOKy+PWE0jOSsVIcvuAAAQSc7u1nG+G50ERpaGuabZrIR8KpOqKiYp7ql+eiRCDNmk0oc62lSF
+nKok0zu+/sqY4KVFqqxJBbCXeEFRGSI7jjgEEsm5wmZxvMVHZthUEYMBrlSWiUmxSJW9pHDtIn2zI+OSu6iiiGUx956Fnp4tyxxDPIKAJVURhbczgzNx4Akf
+QKNfyeSmuFcWc2IRiFZSX604aLGuJH9NF0Unb4GLQohcqTCpb6KgSmutuFfC3A28BFLkAKc+KzWeFXHEvi1v+ycWwgtzNONHOw2t76++trc29y5GOrDkxIvk
+x+sr9GyiHTc40W5aVza8JSzElpnossy0YeaHf4bnEIQAf/aVDgIMT4fHF+fy86h/+WV4PEYTM26JAaAJGjnucXadG+e0CV5Q5Ef20VcsLj7ZMITEsIYfi+iP
+hmPts44kdOLFJ3hkeA6/O6e4D3awPw4XI+wmDOXoupwM+qfD889j+LH7cCwE39+SSqeLaoM+rXiWkUGvLdIOW46wM5zWuax4Uk3vVVmzr9QDEhScz8PZu4g6
+YHQfPiVgb0nz28rUpbHB+Dk9ybK+K0rtfka3cPsOu97QGg/H49Nf8ez/tw34P/aPDoPzf//t0Zb/4zf5Gy9zOcyny6ouH8G9lU+TcgoTEFPM9QV9z3U5MPvH
+vjwZfBqe022S2T+OreP82tIm+CEAd2nMCcHWuFKI5D5JMx3+T9yUk9gQeZgUIV05l89jnYgeHo4ixHxVJshAHiibyOG8ThN+DDI1qcsiTyfyxG3hmZrcJXla
+zS37xlx/JG9VDh5MJKeAvciFvvOa52ste9fn8yXEs0yKiLJvNQilYgYna+KAeo/kzsBAAnQ9grs3XUMRoekkjvPIGxHzN3JnyCQbJ1QxVbod7WPkOcnKEcdj
+nzqXIUwZxtFl3yfzFYh/I9G9qU54k0Ph2/CbPFFn+8/MhILkEr8GUQgZek/nC+l7H4gu7o9nEHfoaoi1jBu/HpNHJ6mGu0aeI7G96RprsGoYdgyWW6XcRI/h
+yEH/71aoqvqAecSYJwkziZzvZgKuCWDMVX1XTA0rEt1hkgUKsS4rgzOlzUssXBVYDW/HFVCUhqPBab27cS3wKgFdQFtg23jNipLPKC/MTbCL2r3qwXKaF9Nl
+piqZ2vkQyUW25OViLp0izWtVzpKJclgyNL0NrNxJmS7qiizLujDZwTDuaWavS2le1UlGH1DqnrSbfsjwoI92twsq/WGgZqonxEfD+/C1WO5AHA3/q9zpPZcj
+hXRZI/mtOFJEM5TcwpHyNj7oYEn5Wiy/++5XUkv/WiyfwI3y3XeWD0U2+FBEyIeCSHp8jj5k04rIUXRWh8eRwmcHuJlN5Mw1xposKlAE0qWgYdDCpyKezKMi
+2zTVD+N9Ob64Hh0PKAGPPVDE+HFljQdrgDBJSPt3PvIcAlCJwMz/vScgzD3HRzv6XQTo9w9CwNjRhFiZrb7r2Fu8hRWlBZr0Av3vZhO168hh8ND+IybgiHQw
+3/UaCS8hR7VseLstdlKP1mdRmg3b58bbSO9ciN0b3TeaDoFOkybzDe24cEfmuzQ4ufA/Z/45vYnmuVyneS420zxv75pYCAgh1R3AfQ9kCPwUSY/E3296GI2l
+LBGYeOQaFNOkbpvS5C6zI1d1RmC6En12p72GRrpbsZseefDWy6ILSkbQgA5YX2hsdcqkg0R6t5S6oGeKUr5eqaT+QbI8+io1dWO9vVRLnfgavXQLAD/7Sn0N
+Ew6pEPYhVHs1Gn68ptzHj6fDz32+JAMnQt9Ps/BoEFxDzYEO+FUmAXLhB5qzx5ZJsAnFGD4jbL4Xc+81DlBaQhjOX1a1Q2PQRkZAVyuiP7Df++3DiWPa4Kca
+0EqFcZzDghQabFlJRtO1dRSBl7NalRWlk5BPnkwdCygQQdvd/qEUL01M8p3erw3eEGtZGjA6biV36UKft5Tw1/BLsO1A9xPo20WRU0GgNd+SP2E3DZoW3jxj
+ywS6i5macEACTUvBBj6tokrVcEjrDdb6eW6K6aNNV5NFruTdMp+WML8fVF4/yt2Dw/2eQBTtjZoVJQ0ctLqruZCFi9+pe81w1iDXqcS0wJGeK8VK/G6FIzq2
+IWEN3acM9+Fro0aawiIl49fsKrZli7Koi0mRMdIZVjA+FTCkFDMaCb9yeOlwYg2b/Mggy1rKSz2/CtN6cHQGzi8LwjZtwq+7aiBCglrpO8yyLEglsHsDpkBy
+6iq5LDTaFteWto4ozoYpKxpMDSXChHi93yNUte4GOp0my6ou5qoU7opo9gUzcoz0/ZPSA6rYbi3TJW2QZJ9YJkt7TU1zwm7BlUk0txbMOCVsY15Y9wnNJJ5t
+tlgXryW8UUozJaeEucK0Vu3BwBhB3twL/YJFIrOCGi138SsG3mfqPslrttuL8rHHPCwJWC6lfCiW2VTeKJmlPytMUxNZUfxMdgsWw+/AZtptYIqrVzMcun0O
+VCC8uwK/KQ0KhjiwRu4SZgcUN8JzJFhPnGm5QKYWd7zcmxjt1EGAlTdZt5+x9YLsCHpf2x7swpPbKGq00URuC26uA4DDI8UsOooew3mocDlGDuCzWi4IoJtq
+MAEsNyuujnhZ6wdwe93W20x+HwEoaH5+KR4INq7rieFWOsb12VA85PJG3SXZzFJoFPojnV7eMBWddbSK5SO3O45puGg2XHY0XKaVS/mBm7Ul+/haLIXLUuGT
+VLRWe42GfftvAiKLVSPoNYQBk1BrbASzbZwESAXrSzGAVGulOLeoIL3MdcIQYQzNdfIy5URwQYaGc0I7mEn5mmi+cJ+0IOE55fzTedqMZUCpUDlrmPEirmpc
+I5bppsPIrFuy85xjpQUq6mfPXtl9o52nK6RwcnZKr8+KUleRa1dFhj1hFZtVmpNptHYrcbeNRqvbhhiXWvuoOnDjtvVuIS6GWsGDCiWWu4d/P7kr8NqKTaE9
+jHyJrbRGop3WyF7NiFq2jc2qK8DBh0rgJljTet0aWzttI2+0HdXSWvgu21CTukkEbEPO1sMcQyu2xWBTEs/flIIXic5NyWyzbXvOkesmcTYXtus9L4q80US+
+De5y4VxinxbOkk2HkHDfiY0BL0ymNA0JWvdsh0wSmHT+CFfLUjW2t4abFkZxtsxmKXpTtVUQxh6klEfxvhyea+TjFSCGzwD7d3KNUMbxVf/q+gqTP0aDz9en
+eNEWwth/6dxYf/ASqGpd+Hcly57a2kUipI2pijndpa2/2qu3NhmJI0JF8p/LKXpBBVJmRD7UwTclP6D3161ed71Wxwp/MH4msxOSk0qHWixyCKYM7HCPMmHQ
+0phuIhSHgErqDd3dwuGnBFsmQ9mlcnd4tfA1jd8bj38r56IXg27HXJQFWqM0v7mr/Z6N9IXKNEPoalTLGTimKTQ6VXWS6lmY2PMCKlOU0zRPykdZ/YyMVYiS
+QidCXdCeh840mdY0W9/E+xpSq4GvLuwcMuXcRYDYLCKwQn8aU4l07EZIIlbXyeQON3gl2q5GbN+2MfXihU97ArxBOqDav4335Y+D0VjDAyB5ytT8bXwgz9WD
+YwpB6sjmZBwuakI0yDhiOYALHT9PYDrAyaX3Kl/PziHexodywC6MWVBNpO7wV2g3d4dYz91hdukke4BbcBc1hwiWrqXmiJ0iqqKNpKPFu+sX18HS4Vc1IO1Y
+EqVxkSvh5nssc+wPLx1hDR9Hyz79jvyeLYQMQlyMhp+HAFFvUG8QI4K7RCSCvnVOpfzYHw/HJrNSdGRWDjHvjxMsAX4/ks0Uy3ZKDifBEqHnMOcb9cUEaSAX
+QFqLceQkYp4OMAsTMfmtGZgXIxcZMzz/HOM7APo+GiD1hmxQb4gO6o1GxaBF8uvFteHigE7xnhJIyCG7CTm+XlzLXcg8hRdgOhcg8gc/Dk4vLgejHpN1wLeC
+yDqo588Hx4PxuD/66pB1yE3JOuw4AhHA1RCO8HbWDrmKtUM3VGxC2+FMNY+xQ0r5Pt6XV4PR2fCczYf3GHd8PqGh9AgNBd/MZkmahdYHra6A0tBjNJQeo6HQ
+jIbFTN6oSTHH3AOX35kep9x6G/mrWu86LKcKv34Z22EsQr7f1dSFch11YcNgDJkL38eHvpu5mLm/b4ikHOjwFhSj8in52kxc1EKjdwmbCv1qbJOi1GhaCCaq
+supRI53b+X2SpdPsUTi8nmjr0wXCKcmZNJTUWxdexb2GCucbmqvfx/u0f3l8IUJ0cMvARmK+aiWZkQHJjLBcIS53jGzjjvl6cR217xsIQhSaVuWcwmEXI0O8
+YkJkFyPK9Ha2LPPQ+BpMKdoxcL075CtD4Aux+elXF/gLIkMx2eqGqUa2MdWI5zDVyBVMNWIjppoNuWnEBtw0ciNuGrEBN43cnJtGrOOmkRtz01wffxFY2+/G
+m1DUyNUUNeJJFDVyBUWNWENRMxz7FDVyBUWNgOOZUqb3433m+gC6qXMAY8gBLNDxYDQWIqAB+SCHswANkILZijv+xGbvFaXnFsabQVAUPIO6Q+HnizKdK5MO
+VJScN2o/ELvaN5OqsseBj/pOOYV4HOYepgijaBM47pIc8rwCLnoDzUGnaNLGapJphnL8V1r59Bh0NT96L4/jT/EoloeH7+J3h/sHsr4ri+Xtnf7gcO9I7sLt
+7gSyEGodmzpRMyhc7p4UJz2BXcrcnpSkEZYeH+yT/MLBYXx4cEhFQvrQSXEivZ/zeEOO/NlwfDw4Pe2fDy6ux8H97ynkKiFxhZZxehG3ivS5VUSTWwWHxr96
+W453HbxMa+mUSpwnYiXniUPOAeQPC2s87KqW675oEGoyTNkqYOocnl4k7QGeUlbzLEsn9V4x28uSB2HfFcufAtcO5P9gdo12JcsEqTYrioxzClglEzlJ6/S/
+FJgprLKl0wYhsETcKuSMSCv6F5ReyJtlleaqMkvFo3IBm6c/V2U6SSKZdPKoyE14VMQK9hT5JPaUSDToTZ5LaSLaKU3kcylNhKE0+c5wmsjnc5qIkNNEPp/T
+RDQ4TXhCPZnURLSSmsjNSU3+jsel7Ms9AXd9myHBjwixc7URZQgvydZCtL9FtFCS/PBEFhGrt/FkFpF/rP4TsRDmdHLzp2xwy9k2W5k8pGXyEKvxngxmFTxD
+OJspS2GGjJXy3qYnm1aotJOCdk0ngRrmuOvFbVQ9JjxuI5T3j43+JP+86X7sCBF+wAlxlk7KonqsajXXIElAcgcUF5jGt1k9/iEw0uNhzJ/w23ZkuZPpsFs9
+pTi9iD7KPTv3qaP0P6XO67aYJ6vKJ8TFQuUX4HVWcVHeyr+fncpPsAA+FeU8qeWYB573PEwcgYJqjXH+ZZ7FxULlhSmjUaabWHhZFrdlMkf3wNCgDTrfYghx
+kkUavubftn9/4T/E/+4dxIe/n/7//v67o5D/4+Boy//xm/wJMb4G58DJ9fgKnLlX/fOT/uhkrAEZJv5jAcCH3QBgH//7K8J/27Jsnwj+hY/Fc8G/a7G/vyf0
+18nVeBHwt2kD/G6wXzcp4fcF/fJnKyC/Dhrxzwj4DZbEFu77K8J9/cefA/ZtSui/GOzrYX3/VFBft+n/okBfezN/BswXrtm7lCZV/lUgvk5O7O+C79U/2YJ7
+/+zgXqn/EOe7hfluYb5bmO8W5ruF+f7ZYb5rUL7Ibr9F+W5Rvn9WlO/vAfJ1SMhBOCndYKo82qnuzpoGUs7JYHfXkDOZRJhk6U2ZrsLc29AWobxFKG+KUH4e
+QHmLUN4ilLcI5S1CuXUr2QKUtwDlLUB5C1DeApS3AOUtQHkLUN4ClP9wAGXMBA7gybbEbwVPdvRfxEvhyZ5AjHgJPFkG8GTxfHiyDODJ4vnwZNkKTxbPgCfL
+DniyeB48WTbhyWILT/4rw5Plk9DJYg06WT4ZnSw60MnyyehksQqd7H7jAU1ku6qM8FVlngM86ZSqEQEQBfJUCYjCXxAMRYhn4FDMNNoRYmdTKMptmU5Vfpvm
+Kq6WeYWVjHNVv+JRje/qeQYFvgSQQqfQE+Ao0qJRhPgGcBQZwFFEE46yw+Pc8Nx8LtOpHFAX6Yc2h50I0Y47gZJacSftj8sGygTUDQ+aTwtdeBu0BD5/Orhk
+pxNYAsnVI9f5NsNUQpS2s8+RR6tc+xy6JNgC25uqhcqnKq9x6OAbg1qpP7hjYtPGYkjW2XdCOt47qRZJ+5dysSyrZZLjItb7HXoP3RdXHygd6DzBA8NC8iqM
+IPAN+LEZjFsVKBJWsx2bwTGRR+0MmNwl+a3SAtjgOaCIQ1WQLex0RSTsao5aVzY5OKt0nmYJRi5YI1G3A9vL284U+xNy2M1OQyky37CxFbWT9zpMYdLZZtmj
+dPLNIDqHzcUXhvHHsFAMcVOt63iLDPoL/I3PLi9/X/2//bcHR0db/b/f6W+s0JQ/U1WV3MKmdakzKnbHZ5eQonhTJmWqKvnv/ePTSB6fnUZy/On0P4TwolF0
+IhLwkrZEchmZcOrZpaWvMFGApJZ5gdhuy7iQF3KW5kmOHknXHViq26Q0uP5l6BxGI/SivE1yFsas5CTJ2c5TAipg1DQTzEmGrZYyIVgJlkwz+GqmNIIcf5fA
+12SAZI/C051HSLlPqxHLj/2BHJPhAK1W+R20B1851bE/injRC3SSMWfussHbKPaq+aEj3OsWaOWObQ9zqfcqnxJaxDWurZ6vjgNDMZd4Q7PuGj1uNq3CeRcc
+a9/Ls+QRLKhDIdZNLb9wQtN6hAhOM1+1Une8Oje4fHhXWj/K/q3KJ4+vCBYr0NdWI1DIPLELATlKx8we5U5LR+/0gjRjq0UsVstNG//w2aVeBTDiBXW1py59
+dimswvQTVKnPLuWJ+y3IUxclps6FaasRs054WOQVms46IuZpO/N9JxTeNusV1pNj/NmusjmgQfdGokX22voCqMfcZF03zAftH9sMTgHmSJHb+6P/RNTIafbR
+He5KxgQkdg3fPFINqvjX6QCnUYF2dzi8a1rg70VtLYj0FkC1Lkr4d6L/yWgmuBwI2gYqXGA645yYZyZ3XM0wgEsJWI2NQqSVLBXpK8dCfFoiw8i8gAFJ0bZd
+M6yY8NQ+omLzEXX7Q3eGdwcQfCmm5DybW3en9OrVYY8ka9EGd6oldi3MgL3f81ZoadsQ9ShXFOx5VdURwUGqWiVT14oHf3eSPxa50k9qUKHXeV7F75JaUJwR
+Jm/26DSya3IC/0OZ5JWexNor0xxl7EJL8CQf7pJa3avS7SjRqB+0qFRORjDTKLjPpJV/1MAw69wZWZTydpnAfyvT1+zDa+Ha2ECOn0vmVThXJdwMa52Jg17P
+tEbWHgrAOREYRv5wG8KjeZLkrBGODExZSh2vvW7JPLlVlXbM6OuY8C5nNjEeq7q0qAW3w2IhhjaZEDagutaIsoW3uUbuwitKiyO2UWbhDYUyiumW6QaqR8wR
+DBwlRGZdq/mirvjQMTu40LGySZFX6RTzIhJ5nxZZq68W91W8jLKHSXv/nAPThN/uC5gjzhRaoi/5TskBmlxgSpz3j4/lrsL/7cn+TUU21vgxr5Nf5HnBM+Ei
+V3K3Pz6PD3ri+P/+v9nwRUId/FSqHNcU2pwK/7Nik2LP5P4kdcKHPVmPgl7LJbjF4k36XjmZGoPzLxBMOeEaG4n7y+uPp8NjYYMMRW4mwefza4qhTSwuxeEB
+UrLr/bG4st8eI9xQlXoGuX5GB+3DoxA77rL/3/+WB99//24PDb4uY2zrJ/gD/43Ph/1f+fq/5v7/5vDwXUP//83B/vb+/1v8ja8uRv3PA3k+uAKeUuDmNGQg
+/fH44njIQUfahtr4QA6YD0SeGNgrA9zljk8IsvMURpBj3h43IwRx3e/6NRiYZLgvGboYDqgoKzM3IDt9BYZv2e3ZROu/9l6hw0w7T2IdoZCaV7bUx7uX5W4I
+SuRGBCUiJCg5kjtu97m1bDAUNOAtQVtEW1uwft4vI4N5mCSVcqyu8FbK5CI7aylUdn4XDhVgG9mxyaS6Em53bsqg8k7uNOJZO78Xh8p7ueMkTu6sZFHxGvtN
+SVSAYMQwA3ZyqBzs64fcIfgz0aiAZr63PHaexaQiitKCel7CpPLTncqFP4criwKlLFpV8hWICSoSrxD5V2NfOZQ73vM7z+JfER4C6UX8K4iw1+kpwUxNazNc
+JjfAJ/nNi1omWamS6aNev9S9/ur0FiQyhux4fC87fyLClyO543T2zlrKF2/+/w6ML9+E7oWIYxwnj0E0pAkVlZRpBQ3RdLF2zxAtewadXw8qy+TPefGQR46P
+yD8HGsjn7ypBYJAmqn2S5JROjY7xOXoOaNok5eQuvU8yhsdqP49g9HZZLMoUMaBK/46NxKnao9+iT9JxFT2kU8+1JYiXm12cNFXeSEjc2UF2B/ivcuevwm9D
+7fo9GW52+Dc7/4IcN0xx4819u7+uobiJ/3gcNzB2W2abLbPNltnmr8FsY1LNrOeCt57x+v1BBvtDJPB+7xbWslXJDbcqzUjxa206njvlmfsNLvxgv/H9JTbD
+lI4Ft3NaF5yOOVIC7zKn2aCmnMXrjmfgX0mCizmv/ifuWpttWlGwa3lVEZ4bym0xtw3xrlw/zqk2kxjbl9aV+zvjTPR6TJ+0zq97zoaJ40l7Y7AvCmcvdcO/
+wRYJe8Eq/5pbwdahxB6GzaFloXaX6zrrWntDbtwbazZvA9c/jA/1xg3/uXLvditCuzZ0dxVSO7dcqdZu0odP3aTBmLUbtbfvJBVv2lO7I7f0Iezdorl3tz65
+fvsW7k7p3xqLWesQdu7kYrOJ17q979qgLFRFdHNS9YyBrA8EPKiO1mwJtH36Dk94Q3JTqXyiVs/vgC0bydJ8D++FpSeJ15OlraHFIkoYS6tgHfs4yTfyTbYk
+CTiZh+4MXsnf5nUZ0be5sVTxUvo2GdK3iZfRt0lL3ya+AX2bpUgQL6dv67wdN+jbvmvhb2uw13BHiCR3c7W005laop3LzgNcsufjsyiGN8wU1SfPA1PQzHyi
+J3BfulP4STNY48FxfVm/TAvR2Dr2E2nNDsMPNlfTNEHnr8ducm/3rnv4Phc61rIB7T3lRxY5g8WLOacwTZX/FkPPZ1r1g+aICRoLdVj73ki48D374xnlu5GG
+V/2gsnsFXHc9OS/y+q6S5MBELwQMRVrreED2KG7UBHrJFBZJt7Aq/UXuvg0KStoxzyIUFPAmhAF7Bw2vC02Zp6c6Lj9E84RKWiqvljyBV3DoUPc4nDlC3atc
+s/2sH9wUdvo0p8Q+Clr6kVgkKXJoGRqRVLs1kf8nyTJ/2+xaCewuxdFlPgdevHrZEp6n4iVPRdEKE3ws+bazHXVOIqMC4rbdc1EW8zRXeS2qOqnJPdVKY0eM
+7GV6DyGjLrwNmiH+jc+ku3VTSeVTrpBuMKKVipn7vB8j1I44ZypE5vYeUhy1bwICt+g2ZqPU3ck8Cg/KlPf50DgTMDAX0TY4kkP31nepb31nKHQIFgK04wrn
+2SVaXg5aKrQJEyoEPNmZmuoEV0NiJDQNkDNtv6tWXzvTysZv6kL7g4lyKQBct10CvSMaTPEDWZRgSkTuYyKYcLX6hfLFnVR7Z0F7CRR1WmdqKneQ62SHsb96
+RDg1AXrMTHq2X22qBX2d5g7vCLOOCO4/swdR6h30L+3uel1O6sZ4UHJ8RV1iRkTYLTdcOMH+l7QfvPJ12HcESoY1uqgzNswfQ/oXmOFZxnnMXj3pfmP3xNpE
+tmK6PrsP9y+H3zWm3ndBgNEOZO4qEIqFo8plA0UwLGvncTEL7kuVg443koiPvjqngfPRMuhfDiO/5ZjAm1WF0BUmndV8hqpkvMz9juQ750hrl+qN3S3XEzZN
+6sjJIp2m1SQrKjU1yFBhkaGwG+wm5o7oFnmjslTda93EFX3PsnHu9xq6YzJ8dquedgyEHe+sAA736CyD1pB/M6b6On6zpQ5dTx06L6qa+UOhh78NfyiC+5Mp
+5w+/kD9UmNXxUv5QQV3QQfr33aYEom5U36FiewF/qFhLo7mGP9Q/z78FZ2iHNcPOINe1IJ9A3AezSmzMvrkJjajQ9+yn0ojC7/DK7R1hTSI/h+nD/yIpKRpN
+PNdtlosgd32DRtIdrV4XU6B0O100zJlvymj69tmMpoF3Sji/25TFFGjF9mC39ghMhSEwdV+xEX9pePcSbfylYQ5WJ31pw0flJNckHvddMZN3xQPh0cxGg42y
+BIZQrJ3VwuuFw2/IjMqtfRodqrczipadcdVEbp0ePiGo6PLRte24a2hPxWa0p3ID2lPxFNpTNt55gmU+UYtojoCcFoqTwgiXI+uCkrGIGlLb4Ka3jYx/m46Y
+LtQ40OnRFsV+47qlk1t0Doops5OZ1d/gxYuZWaXDzCo2Z2btPHto5xcv2/mbFK7iBTu/DHZ+8eydv3GRFU/hjX33bN5Yb31iEtI3oo3lV4rfgzY2cHscwbv0
+oKJhh4xrJ2SNjy1X6MhwhTpWv88YK57BGCs7GGPd3GZvHLoIY2UnYax4BmFsexa3eCZhrGwQxornEsb6zocjeI14AWGs9AhjxUsIY2UbYax4FmGsbCGMfROH
+kbmQUH1DslixnixWbkAW692AmBHW2IkBB1vcxghLgu1FmdwqTQxBCunMKtfnRF681hLfwvmwv9MTz6ORlU0aWfHr0sh6y7abRdb1CryQRTYMOT2FRdarbUtp
+zyCRxcUGmEZmkqXot8CkIf3dk+lk3XoK7YtopuHjAEEApEzvE0ys4OPP82LIosQeSKRJ/emI84pdWkCaBAAvNdNConMdX14w2jetzbIzwrBPBgT0nCMPNu1S
+oQfF08LS5Giwphd3ZVKpSu5suKKiHVpO0Y40uR35DNONskehudOoVE12liwWKimhxY9uNXQGRI1PKYfBjJ8QZARWTSvQ5i96qEOc0I5R6UolaC+cfrvBn3Rb
+nTzRfMKEWO5+SjGlStvYLSEcu0VGTTyLazimucux3e7IYr5OCtbD4/MKQqAVZiYoNaeQxo0KSEXCjb4nxLu4gyM3lscXPw5Gg5MNyZNF/1zuIG/lThd9styY
+PllsTJ8sDX1yWF1mTxZPYE+Wq9mTxZPYkzWReVgvaI8IyZO9hwLuZLmKO1k0uJOh8vD4BXbv8cU5iRle/LFJlcNespzK0nAqi004lV1K5fgPzamc2PgOA8Io
+hPYcgmUfA/hSfmVhNrZvwK8sVhA4+9XB4TrUpyolbNRKZmmtvbY3iMlSSLKG1g6a2G5mH8c87V1bTNUkS8oEghZwwbnFpxIK2/YMmKrdPeCn/bYbvMH2rQvE
+KCrOlTTj4aeXUuCZYGxIO1YBVA/MtnSRIElZkmXqVjseP8gCT20swXnuu9bcUtGem2C6yAUAckSIAbDw1mBRiJvHxmtlXWCrWsLfbDdDXnrrMEcC6ZDeMpU4
+H3B4sHovMEtvURbVQjO6RXKZZ6qqZDrTC0iXRMumVBOVLvBA5KKhnpQe9YFUJ9GjA97JMtVux0Xy6L5dJHK+BNdLxq5/XJzOOjUKiyynLhcJkxxybh9T+bQn
+Voe9GRnZSWjStEwetFlmpjzNZ/dmb50/jeE3sy98Edqq1G1mAIoZGxmRTNqaCI1aJI+0XiAcwCtMU976HTWl0XV6l612LWLJPpNG09ik1R2Q47QUzQyMJ09C
+f+d2dvSaBV0WaekBMKhj9OxZqDItpporW00peo171Gu9R9FmtHIn0iPiVl8vcMrC2Gxly3UrW9iVzfECLNh0cqmqIrtXUxvXvXm03vBSVqquKaugJ5DQQvtt
+eCPmjmprqR1T3lPIn6ZnlLhPsqU5yBoZ7W27TGd2DSeF3ChZJz+rXKZ5XSBX2RIrJaeKBtokfc7xm6KUphLUTzivhSEHpYE92pzdv5LvqVJwZLWw/Itns/zL
+gOVfPJvlX7ax/Is2ln8hvo8dCxusstMhi1/FbHCdX8jj4ej4+mx8BfbtGA1e8xU52K6+DC5GXyPx05cBmp9XF6MruWuseXk++Hw6/Dw4Px70IrJN+2DRXozI
+XP1pOB5Ecvylf3oKVm7UbuFG7fZtpC1fI+B9gQaqa1pGgp8ZX4NaElmwWNonOb4+/kKXgcE4kh8H2AOnqPYFT1wORuOLc7oznH8FNp0hmMWRAKt82D9FzZfh
+yeD8Cv4b7ebz8eB/XbMRfNI/638ejI29+6UPTR+MHKUY0XbV0b+D955ejLGAzxcXJz8NT08jCdw+cnx1cXnZ/zyIUKPs+mowEp/6w9PrEV5kzvqnn67Pj6k0
+rjyMHPSx7sMzuBt5taSXDcaRGPw4OJdDp3u+8gB96f84kB8H8O05XHoGJ2TkD+TlxXg8pMljOpZLjgXfAlqnGpcMdxtQs0LdNfsldMHJoH/1BapHw9E/FcPz
+v12P8HZ0fXoFc+zT6OLMqe13Y2fW6Tvb4O9Xg/MrLZkFo3za/0lcji6Qtn9MP7eVjOX44mwg/3Y9Go5PhtiXY3lyQRU9Pb34iQs9Pr0eY5tGwm+hnRqdMyOS
+4wu61NhyYJxsQeKs/9XvG7hGCnGwHzNHIcz08zNo2QDW5ngwGpNztBGeBnS3Af6ktZpHOzKp6ECCjUFqMDh5Ho7ey+P4UzyKYSveP5C7F5M6Bo64N72ICACJ
+trKYuQWLBsnGDu517rsbj/ihZawW5XmwB49srKBWB4fx4cGh3B2rha4Xpq5BvSi7sb6zjwt+HOritOzwXfzucP9w70DWd2WxvL2zHx3J3b8tc6VbDBt9wAop
+BvkUEFRlpXkzmjEv8vTVd0XVEmm1LDMHsTwbjo8Hp6f988HF9di/wHpJeIquoKp2zhkYjwmpeQiNFp9j0q1kpiatR7DQtzvZ5CogbkvyIi1zlc+KcoLWcETJ
+I/a35kQuFeRoqCk309PN8/IXtV/OKTX2FepMma5T8zjJ0llR5mkis+TB1qBy/YZO6MVx9mbJA1LUJvmjidpX1lPYi6Q9mlNKBJxl6aTeK2Z7WfIg7LtoPSVh
++MRQXBNbYuWIXcgiJ4MumdSV0LHDYV6rMjfUikmGtsnnoiA5BsvKSRVTU4KcQMO94BEnLpg22VwCre2CAFLDrGB6FlZtXS7VVJuomPk8Lek2ZR2MDu+kTzJz
+GKNL6uJ8PHQ2aHTHjGPZr+SNqh+UyjtC5sEVRqOQGa5cNaAQHAKHn2qKVQ6cd2bki2JZa5adZZ1mTCAPH3lZIY0Qs5Gcx2hXUf5MzFjtDXEbAc878WnGeXBD
+KA2AAMIQbKZ8CcQHQ1ijxtgQO5BSyl7Pp8SuYkbNendx/NJ6WStB5FMOs6/JLYBxeh3LMzgaL08He+x9I2MobmkQJtyoKr2lG7hPjex7mcBxcbbM6nSRqT3u
+velO3PahofDhudlMBCR2W5I3qQserUYF2iJLThZPF6gsyXitWSKNym4H9obXqa3Lbv1m+NjjYjuKZf8YPJRgHgdRVDhzNdqK7Xf2JRKhNuOeizJUf26QG+HG
+zfzEjjjXVUdsgjh0NxfjahbhSG/tC4qe8jc7vR88PZrnaG+JDYW3hHh4eIirPE3iorx9Vc3TV5oTvKxeTdL5KyFeIrmlYxfPE92SqzS3xPM0t5o158FsKG7x
+5xuLbMl/RzHjwdUADc7/gAKeLnMFf7EQI5VMKYc4uYH9FieAufzW8q6uFx9evVo1esVC5f8g7qtXW1bhb/I3vjzdO4j3f1UK4DX8v28OXr8L+X/fHbze8v/+
+Fn/j63PpU/t623gHs+8+sJF9A3JfSkhYQ+5Lr/p12X0P4z8Tve/r+A/M74vIiElRkjmLj/hJ4IZ21JzxDp0h0RfHf1h6YFwPb+JvRRH8Nv4mHMEt4cbncAS/
+i/8QJMHYx+/jDYiC38cH5rGXUAWbJFPDMvgCqmDhRkrXUwV/H2+pgv/gVMH7ccgVHMevnk4XLNIA0fznowumg/+ljMHCrBX5LRiDRZq7b1/BGAyVdymD7TD+
+vqzBvitbvpBEWIQkwvLZJMKiSSIsn0ciLDpJhOWTSYRFB4mwfB6JsGghEZYtJMKHMbPtblmEf0UWYbsm/5WIhOMtk/C/LpPwlkh4SyT8BCJhfUmivw1YhfVJ
+LcQ3pRWOt7zC/7q8wlta4S2t8G9JK5xvaYW3tMJPoxWOt7zCW17hPy+vcPxNiIWF8/HLiIVF+8R6DrGwIGJh+U2IhdspiF5ELCzMe33c2jOIhUWDWFi+kFjY
+R+x/G2JhwZxEf2Bi4XjLLLxlFn4CszCRC8ffkl24i1aY9kjxLWiFnWiheDmtsDfbxPNoheP4FWJ2vvtOBtTC4kXUwjKkFhYvpRZ2Nt1vRC3MSKhvRS0saycE
+lmuQNQDUtC1eq0VlgXewhGYYGHCDGvMkxftzllbIGipy9VDdlsVyUfVcKuBJksGhUNMEI1JfBhU83BWEg71X02Y+Dg4MxFFtz5qjhHpeTdupkb8xM/LuTv9y
+CBOw10qSvGVH/jOyI8ffhB5Z4Mr7y9IjEzOyrAvxbZiR4bIivhUzMrZcfAtmZGq9+EbMyHz4fwtmZMtiK74BM7K5N4pvxIxsrTfxbGbk3FI4mvaLb8CMrD0F
+4pswI2NevE+D+Sz6Spe4WDyFuLiV3/Jt/E2Yi0XqWcZb5uK1zMXVlrp4S128pS7+o1IXv3hrdpmFWynj38XfhFpYOAGQF1MLC/eVvy+1sJTyOezCQnTQC8tn
+0AuLDnph+Ux6YdGkF5bPoxcWzcxv+Ux6YdGgF5bPpRcWTXph+QJ6YeHRC8uX0AuLNnph+Sx6YdFCLyylXMMwLMTGFMNyPcWw2JxiWG5OMRwHPLzjZS7P0klZ
+VI9VreZVJIf5JJa7O+NlDvminUzC4mlMwrKbSVhsxCQcfxMqYeFEH15IJSyCtfsyKmG/tGdQCY+XuWYRFi6EZ5n/ugTC8V+BQfjDOgphHB5LIbwkeuBlHoDK
+iSx4Z3x5ionBTeJgycTB4tsQB7MJVolvQRys6RCeRhy8zP9CvMFSym7qYCGexh0sgTs4jl8hBP+77wL+YPF0/mC5mj9YPIE/WK7mDxZP4A+WK/mDxRP4g+V6
+/mCxGX+wfAJ/sFjHHyyfyh8suvmD5bP4g8Uq/mD5PP7g+I9KIPzaEHk+izM4uDi9kDPYnnXfgDPYfYHYgDM43pIG+6TBFP7+9syiazmD5TrOYPE0zmC5CWew
+2JgzWK7hDBbP4AyWaziDxbM4g2UnZ7B4Bmew7OYMFk/jDJarOIPFEzmD5WrOYPEkzmC5kjNYPJ0zWK7gDBZP4wzGVOb80STlApynnNJ/GfhF5DIKrF67OgU+
+ks8j/Pa7SvfNZky8uzeN3PBS3Rc/q6nNEReJueliwhTtaZQezgimaSSrIptG1vMBH2FAespPrYARuPMUKZnjLSfzX5STOf4mpMwCA/HfgpRZoMPkW5EyC9+6
+8kmZpZQreJmFeDIxs+wgZhbPIGaW7cTM4nnEzLJJzCxeRsws24iZxXOImeUKYmbxJGJmuZqYWTyBmFmuJGYWTyZmlquImcXTiZllNzGzeCIxs+wmZhZPJWaW
+XcTM4vnEzDIkZhZEzCylXM3NTLx9G5Izy7XkzOIp5MxyPTmzWEvODL475meW6/mZxYb8zHJDfmbxBH5muZqfWWzOzwyjGlI0hxGNp5A0y3aSZvEikmbpkzSL
+b0PSLK8cdaCXkTTzO8WvRNL8U3D5ARwnwspNhocBZhS5sqzDiZykdfpfCgx8wm/kmjED8stqnDAUXksr+heUXsgb8FvjXTN36aAhLqvQg9qfqzKdJBGH1s2N
+xs8kaePA9ilaxT+XZVpN04kL8v6kppi/clwsy9qY3ucwcVWZc1IThYTsAEU06e9VvlSSiErSXI6TvE4gW74ET8YSc6mc39hobFYgewF2nWiwNRdVXa2jAZpA
+belRNreMeZrUdVHm6rH6Ts6Uoq/VLwu0Up9OwS1cCm75Mgpu0aDgls+k4BYNCm75NApu2IlWsnAL8XQabtlJwy2eScMt22m4xdNpuGU7Dbd4Og23bKfhFi+g
+4ZYeDbcIaLhhrLqZuIV4IhW3DKm4/0eDdft/xKLlww4qbrmOils8jYpbP9VKvS3WUW/Lzam3gY/Oxrf2mlE2zkZ/MhF2s6AGDzZ/8S1YsPuttNdeOmbApbzM
+40kxf0XNajA8N5iaZReXUEATIlpKkLG81KPvEFjwlwQ9WDziWpa7xz37U0GxhxGt8pGqVHmPGJPnsU0DT3XfTijYWurOMWVANdqirZmsSlChJoYM3/+Pf//H
+P/7xH3oA/ofsRdZiQIJUIpQzRk0xk/9OlfsP4WZ0OWYNbOwqmdJLEfeMTkHwV8G0eUirO4zXZ1nxwK5g8diINutmdYPCFdXkP7wtNC9qYQpHU6rS+RXd77DF
+jy9PI9zKEQsAv4DQCLYdZgCxPua3Ya9gC/lIX2TJBLttbqc9O9vhAayT+1uXJVE36j+8JE7oNU4HwAooki0OXh95OUwwGzZpNPn+Bbddo8f9Ogjx7+cXV4MP
+uKAQeqcLshsRZf9iBkCVwfTPHm0qgP2NjtdXbbSRUK2qlcaH8maqO4SV0H6jREdNysQ6lc3LZ8UyN8lrXtFeqriJWPiY3P/YkrT/0f/GP53+qtzv6/nf948O
+Dg9C/vejg4Mt//tv8Yc3pGV9V5SVR7pF1h1aqIbkCiwPj9+K7WbEmJgkJ9xWjB+Ikj2rFiiYo08SoAyE+oU9URNjLujtj6JITBTgA28dmEGqQ8AirWwO7b0q
+b5Ka4Mie851cAecFhhdrlVv3S2RoEDELWQdolfLw27o52pbF/kz/S+GeW8VBwBbzkN0+YgvENNacaWlpxgbPaKXwoJazAo9pm1qGRythOYzpC6MZdCydqerB
+ccFh5lr2aM7uqSi0IV1WtVzwrRTvegQch3IlZT/DdROuJOcQPwA38xV7lsGZSpk16Il2/PnjFr98f8S3Ua2UuNo1362Z2B8Nx+BABhc8ebIFp/6gW3Z88enq
+p/5oEEnwEJ9cHF+Dm7XvKR6eDEbDH/uQDgUpX4PR4OITedDF8JPXLKtq2D/5cTjexHceuwWgC9/rGGzrp+Fx//T0q0k4wkrZNDQvY+3j9RV4k0lCcHAiry44
+3kLZbc7PoCI2LQ3rhplp54PxuKFuLzg7LULvtZOfNoDuiv2+9JL2OEPPU/fHdrod12g39uT5hbz4eDr8jKMBM4MLlWf94fnV4BwCVpGAoM/F6CqS15cn/SsM
+bpx/ga+gZmMcxLOLE+xEdPHHQjj+9Ovx4IO2C3FvQv8umaXuemygLtFFfGtcxBGafY7LmK77CFxDs3tnxOxDasp3mh0Z6hCQ0Zs16Z9l4jntXa9dHypcER20
+RW9UcvdTf1T1ZJqL4wzJT94cxuDyPvheAhp997AXd7VbdTdbnADBWa0pdE/UDDdC/0dWJi5LqspoErjaIMccHBBjL37wjD60PSPSXHJbD6mxe+/2D15Tcw96
+WF/olLhB9Yl7W1Gq2wJdUdBOvcdaIHsYEzAXkArzrMgBmtaV7jB7WAq+NLXQy5o+S5H4qCinbeA54+oQGpdmg9wfIWo5vuqfnsIuF+xpkK3ZP/6f5xc/nQ5O
+Pg8oYRU+xPU1GvRP6Bf9z6MBrpdImEcwCAxR4RM5vKJ1b77D52FFfhzIjxfX5ycSqnE1xgRIWs7HF+cnQ15wW5P6T/U3Tn5ZJPXd76j/tH+0f/QmsP8PXr/d
+2v+/yZ/vkDvc39/fO9zfP5QPqoQUnWl6m9ZJhv60zGKajX9upFwjGne9JfE/sLoAfHJDkDCIJxoiWsug7UIIIuQnIccybPxN65VMX7ob5MSnR4bsXEE260Es
+/SoRw6mjdMApxQR/vmMnW+OiEQneequaVD30u6x0k60JsrYk6RyBVYfNGqS52wW6BkwY/LJK2FdTF1GlMJhcKecXGjgSWBoOi62cJ7Uq0ySrbK+bo8ltD8Jw
+CXafzJXcGff/fpnUdzvULoQjK6NOofJpUVYssFLMi9qAYg2bmYO0MSeknhuUCKVvZfaUJT2B5ueRWJDoCRNH6bPz/1vxNleUt7EQR7G8DGohW2qhHfVg3SSI
+ujWNjWSO9E6PwnzkQI50EvtcRWubol+sBBcEVcMAw5lVMNhdzN0m9DAOZAglI/lA2GFV1XL3ZllrrydfUHsG5qTvwlxJGJ49yHcLLNDmBCgb6/yVgRLZDkvr
+SmUzJMOcGHImbABU5D7JVG7iJmb5fBBiB3MieGLoOlYNzS7r6w27atcNeNh+etWLd1ojAbZ6c+30hfSEMlncQUov8+1T8Pq2cHkNg+CK8y4hui9G/+//qyFL
+mLh1/rUVjuTe80Tzniefdc+DN4quq54BIkGFDcblJJbtV3ruduFc7fHGO7r42+D4yk3lc6/5n0xWH93p7e3ezbczN/3B3wdnl6f90dcVF/3d1b0DAv3H1yO0
+cukO/pERQ5h5hxUnCNJg/INJyruGK+9J/6qPL74cXXwaXo1/gHSvj9fjIfYfXEVHo+tLsHV78svFT4MfIf2ufw0D2T/nG/BXzqL0MuNMSiW4S2ziJNyDj6/c
+xy4aSZdu+pubaNkT2uHByK2f+l8d34ds933odEDfbSFWuC22Nv1f92+s8ikQCv6aF4A19v+7o3dHof3/5uDt1v7/Lf7Gg/OTs/7w1KBsrzzT1jj1yWGcTx2b
+kvy/GjslkoaRYHIYjY3pkPJrIkmyf/Q0JLKESLw9evdGHt+VaVWnSvYhSkscrZ+yoigjOZir8vE+zSCD8bgvvz96u/8+ktfjPubi3Tw6Qp4CysUsPm0L6ik/
+KeaxMNH4K2jiByGuqwBRgs0OLjq7NmFtGhCBQSBA4/JFURoDE/KSeituRtAp9uajacDQ8c7+uNa7T1p1X33+cwn5eI/okJuVSpHzDfD0FwtlqL6tW45C28h3
+MAvuODX3DsAFw9eQrTxV0MlGMs1FaToAsElBlxlz2yAhJpWlQC/ECKi2F4DHbL5I8lQTRHcxy9P4J7lIS4AbUW4FUcrXhTZtV/x+VpRyuSAztVRKPqqkrARf
+PxvVl171iQimDKrPRKIQspktMdNSYDzNu/myQzicTWHtaIhY9ZP4xIXhXedVWvl+Q7wrwTOu/lrwph0nmh+IHlNCMssLQl+S/Hz+M/7DvVrDTZvXlk3oFCyE
+6GlqHrZe0912htf0lkgg1vBR2GsXrpUwMSLiu7C3k7VdoF/ZjFMvJ89J7SZljATvCfKtvFFZ8UDX4adf+a3j5ZyBklhP8ZR6yg3qKbiekfyWLoC2KcUNdh/E
+Z3bCpu4QRFnjy519TSfCksSV80Pw6x98//37vcP9g/3gtJBtrqkdvOWfO7KVmjMj+HHOLbnOU0z3IaUFJzs9L0oBv9UZNqkjL16UlZc/9nKPB7v/JytcH9b1
+olfbjsCMdCNZ1WymEG+4n9r2ppA/yz1eUILDXLxtLwm/l4pcTlSJy1XNb9QU5s4E+Se1gKQ/BwJbAlOGQ/uhxIRVdmnx2RNOiffvI5gYr7FXRurW5vd1D2nc
+4cxsHj2/v0NzNw3r9K09muscmrtpSxW+uUtzZS1aty7xXO/lbgotatsagkmTF/brSiemu2tfPH3ty+61L9avfeCHkif2KDj1joJTfRR8WBGj//hVapsfnUyx
+juBZ140O4Ae+qg5PlZORIF7sqdJJCeIFnirTPKhZDFFOCH9+hgwB4xk5H/44GI3Z13HcPx1+uhidD/vscerwYom1Xiz5FC+WWOPFkk/xYonVXiz5FC+W2MCL
+JTfzYokNvFhyYy+WWOfFWg1c/W8jRZm/HwD+/Vb8t0ieJlUtl4sppqr/t5OkVh8kmBivDvZfHb6Rh68/HBx8OPhe/rdInvDmIw9ef//+6H188Jdwi43T+eXp
+3mG8/ys6gNbFf9+9bcR/D49eb/0/v9H4LzIVIlh2cVr05GWpkjmEPQi12v7wYbzPP8D/RK2Cu6Kse4STXGRJmluIm1HdMDetz/RDMmwfinJK9o8hVY4khLTg
+IL4tkgwKNRfePVkX8naZILcC4c7gurlE+C48BV6PKWnJoBw3mZ0ox2TOX8yNYm2xhwI5SkVyU/A74S7MoGj4J7YT72qPOq8e9UoWpaplWsuk0khqDVcWpn2r
++/ow3ieMDv7b5Tp1Y2zfVa4hWhAUaaKFpyYFWCr4Voe9BW3NIeVWYd6SJ3lrbg1VL/aRdKa9pJS+eGTwnramWM+V1X4SMnEIwoUgvGLmVT0WyJp0q6hjTTIt
+vE0TadLFbw+1QN3fhhnDP/BzZwA+Dt7LphrgdFL0j3HwNpE7J2yEAavmTk8XAneKMGErpaQveKX7I2JKfvTEC/2KQj29n0T4OFrIQM4qb5LJz/rmCxD6JUoO
+aFnnPYhpkiJYRnRBHIhs6okR8g7g1z8IsSdPVXIPDzNQRhWLDGZLw3Fi5S4cC5s9DVo6mRxJaS4RlIPFI1vb1CYNmvsHLM80m0piDUYsb1UnGUNezaekMsbK
+PsIq/+NrEsniREkNA6aSCmGsMFXwvqUqh2WVWmvynom5FNnJi1w7yHiZFoGrTJOFsqcM2XREUuktqGfD9RBbz2+1v87ISWrhD4dGSt837Gxy1QeSPC+W+cSI
+LBDJdlM7wfqnCYBYMtiwmMP8SvPbygbxcS7R5qNKPW300jq/cKxsSH/MzbT5Oc2n2HlDf2EagC5lCOCzD6Bc7dHGlmoCCZEM3tW/EdpVauh64EeLJKWseFqG
+NKr/pcpCpnr1UCY7UKQl+SO2sGelW/KiNu+D2rDWLL0y8tTqispmZ8DOSwS/QErBj8c7che2VdTXIxmHZV6Xj5zJDrUB7615LzlqGdSKvjbBBLFWgIQucNhH
+FaOtzc81BvuxWMIOY3d0TXuMbv6FIoIzo/7UZKLl+aXyqU83JtLZBxjFrxbeltykmvNKyZ1wm5Fmm9nRE999kaoE7smF/FmpBbu4+OUkRPJIL+jh5OnTSbko
+kWaJJg1eat2DwZ9fHbBK7BYnAqPx9qdawN1Ry9GTxK6Ch4QPWHtOIWbtB5kCDVfiKk3MdVqund22oFmaT+WdyhazZRbLq0Im90U61RzD4Jq/07KvNHsFGxC2
+QV6xKvdgvjBm/sByfReZEoEtUJROYzCTZ6ZURkdkXThpKy3MxSKt5M5NUmHwyHlHYG/s9Lx4/jhTavE4Serfzf4/OHhzeBja/+/297f2/2/xh9uTngNmHjYc
+7/t7B99//73z5NgYz9aXKv7AiaF738iLipu9eLoXde8v5UJtbU2Su025aYRsXeHEIodNVdaFoDwAG2h0x0QfCCcffdwMvEkXDpcS8y2RMi6Ru9j/Hd0uGwPO
+TuCmUofwA7H4iK/+nBek+2YA0zo27P1ykS0rkcgcbPokg+088gQ+YGtH48r8yjELvbi5HmYKviW5VFaQiAKdrX1oY7lhz4Ky6byYLjNVybQ25PSxHNZWgsno
+GwY/1j8sSnx5RT1fPy7IRrHDQ5fo5J9oeM0XRe5GaIqFKonUiMQypCbJEcT85DVQlksMHq/2bZ8OBpdfj/tX9onO5Eux1qH9hNRL8RSInTQQO58BnnE5G/i4
+m83sTLgUL3dVy901Tv5nJ1yKl7mq/YRL8RJXdXvCpXimq7qZcCmegBNtnr8U5cT/fxQJoK97UsRT/jngG9tT+tue0t8iyIkoh982yLlyh3fDeY3QZWOvFxsH
+L9ft9WLj4OXGafYdyHkTrBw9OaVefIsdvhVY/sKUevFtdnhg+/1r7vBvcG9/i7v6ZamqdIqsl/lUflKE6Spm8ktS3ifl1Fm/2519u7P/VXb2L/3Rj/0RrXoA
+mP+1dnbduotRs3Hbnf0vs7P/ybJCxguVT1S59/7tr+cAXpP/cXDwtoH/ebt/uPX//hZ/jXzat+Cq8++RV0VZ5HURy594S795lF9UXj5Knj3IsiK8I4KDElM3
++n9pTgKZWgmRutDJFxyj8U+XIPBPfLyPDuM8Oo4iq+PqB/HJvxaFrK32iHYju4SisZRoWj8oJK3mHGmKNU7ocGVtJq/ykcgLw9ZePMjkYbbMItQnsZG1MtXy
+rlM1U3DWYo4GoSSgLgXSLjYK9/D287QyVPJqGhm/JnBSA8FoWgs0RBgdU9gT+TVYkERQbrRntXMSU3eyRwnZIcTrAwFzx4HZ+naBWrE6CFho0kg7Ef5t+/eH
+2/+/P/r99v+3b/ab+/+W/+U33v8Pvv/+0PUyhlt8+z3zytuSeL90NlsSMeDoNl1RWOAgl1cqU4u7IsfLqIB/EbLqmCMHhSGd3hjY8ZucMchVrjPEggOn+6QR
+f6CTZpYlD7/pOSPHaT5RcqYeODlT3VN8a8pOgCqSE+jKWsMlEdXXSlvzuxxaT2iAcBogOxogpTxivViWBHTIZko1L+5JSCChVv56Z6bZ/7//3fb/wzdHLfv/
+m+3+/3vY/98Tnu77zXZ/VgfQFJGBXyip5AwC2VPEngKXJEr8HpfJI3L8J+XkjvD+8vr6fHAljilPTRPljlV5j1Bfema8zOVZOikL2o71x7Cux5hlmk4qeVyU
+i6Jkn2XOyY8ol0vMwc3ttVTVMqsrbzeGmP7PFeaSs/75c1yqcm+lT1XsSd+pupoVuQHdtC+GtwnjO00rqfI6LZuuS07CZZZ+u9Hh56S5LIuZsAwGFrE9/G4K
+W1qpJin8lrZKSDTMedvj/kwBejz5GTP9W7Y+KN5OEESGYrZGarL8KVcUkxo1fxX8On4WvdKfhVzpy+AcVNEvwWs1Ek+kTtrIGyg2pE7awBsoNqVOWu8NFE+j
+TlrjDRRPoE5a7Q0UT6FO+lN7A8d1kk+Tcnp2unf+t1/JBFh3/h8chfmfh1v812/0h6S/EJY4O4W5ez74Sf5tMBoPvsrji8uvo+HnL7hFDI8HkaEIYgZv2s9G
+TixTgA1xuL9/ADQNB3DWXyF7NcYv79IF8hKcnb46/5sQ5kfa8fg9EI/ij06XE5XX8kpN7vIiK25TVXkXu3Y9ggbj8loZAhHe++ApfVIzx7+riaCm4ZFsooSi
+edQa+79NouCmMFQLzSOaAow2buZ81YbdcV5VLRcov5Xf+i2NhCOOYAOGLR0dyY8qy+RpclNpjWJ+itUN86I2MUNo3PRelTXqusHzC8w1h+cWxAbBgoVttDYm
+aTPkvohs7BAjiV4EkWujm09GGvdKyuA3WapbCJNrJTxzS3aQI5D9CPnxqZq6Py9mEnjL75K81nAP6LpZWueqqlBiOi9YYZooy6lC0CeAEMmsNwGbk2SRkbgj
+8IkDUTHAG/4F/1M83CV1VfANE6xTqDC51YtK+wAiOU3qhIO1s7SGCP6dwus4WnTIVE4IS60oGMlc3WbpLdj0lpSrhhlTLCv+QaR1+rQcHxF9Too8J/FsYaLS
+Sw4WqxIze/NJ0zGx9fb+sf/Gy9ukPB6d7R3EB/HrX8cAWH3+vzl8exTq/xzuv363Pf9/i7/x9ef+6Hh0Ji+vP54Oj+0Zb+HHOEWkyxg3oIwXeW8UluX9USwu
+iXs5L2plzhrUOMbPKRsoL2RW5Leq1GcV+bnswQ1uBSugeBC/Zggdz9MurcXdnfHl6U5Pg6+Nq/is+K80yxL+mWhKNB5Ehq2PBZL1MWTafjw6c+Rs+8y6nGTi
+SoO/tIraR9eTmmTB202lD+IDCQ7wG8UCa0n9QTi0wnP6GdIKn12ewv/h+ryr5xkphMbyxCJoY3AmH8TAl2WSezSJG3Ki8dGNY0K6jBWfRdzpBtOK3/KpcRa4
+AA7iQ/8duhcDwjhwgbjnvCcbF9GZLrzCyZpAVj+neEt+5j88TzTWManRq5ROlllSulqRWNvXWFsrPupW06sT9IX/iqIMGyPaGoMV9H4ZGR46FIG05oZRRa21
+bLc4iI9iuTMwRJXyxDWSzhQYIWk119VO5Fx/JG9VrkqNs1CLWquFqAZnNmuIa5i5dnpZekxZl0lezUjgEywK8k7jJHsD9TMgDF0RX4bewf04pAIOCx+29G0s
+dxqSou6AgI10n06XSQadzxMWcyCNpgygcjp1X50XanPZFYg0ModYm3ex3DkFksqSmABMD5N2MBOWwdiroLlF2RhJMocm/GJP4zxAvIaazNjH76Eu9Kntj9Te
+VLDC7+MD85g7FHcE+TdodATNgykb6QU9T35J58u5VlFfFBV6P62tyFeCOrU5hCl3MpakMfRktOKMo26N0E+IcCzjJtUu4gkoaj+qqRXDP4i/j+WOt1ZMt0Mx
+vKlCtYuS5DpdcnwN458oAfWpy+WEvZXSSYRsrGrSxVf3aN76+5n86U7lwp/LlT6rcL4lslIl3wkQ4xTJxCtEphDH+4hq3yirBk/xLstgKqpBUtatmyEZ763V
+E6JP5a7tGVfVNqEq8NthavhyvavedxAf7Mdyx6ugHiJ3deF9QgdG9W6D058WTloJT3/5KasTut0/LvDiigVH4VRNazNebcLg2oucQZDuUS9j6gd/kXrrErsB
+VttlAt0KKlPp3JurC/oCr5+7VS+SefEgi4ecLBmY8ajLLsxCWaNuP1f1XUGuhYmqmCojWQCDZ72s6D2ghZ27b7d7gdTuCT72DqDyToe7u+wCiTdZsnDeqtA9
+M4wuYh7IFqKX3rtByxb8YISYR1rVVVVgvGAqDPWHtNQfelUxR4jJQ4aSyiLTnLMm2uISi8B5nOTSnk5E9uEklBv+oDShouBaCw3RGvp24xAtGwcdZg/gDAHQ
+fR458E//SJiF2vjfVYLEzckadCc/W30wllinUlUVR3rLyR1IZODIWD8TAiEh8FIsSuhJOVX6dwxMnao9+q0LhJUpeEKmAC011RaMWiV+ZporYNF9LZY7chdY
+oUHCd6dnpnpwKCcyU7dJpg9n9YsqJ+QoYPg3LsJIExEtskc9490TQp+Hkb9asfzZEjd1DumL4NCUaVUtjUj3mJwR8i0sVkDEOpVDPxa1S4uJ4GHFNdcHPM4y
+MNkq/Y8MtwScSmnFLwLjqcj1E9geIKagl7r8t3Rsm9kdyR3+jV6BwF+Kq7B4gH4ipxCcZ9pBhKf2JGGhZn6Ah3lu1WCKGaY0cHPMUU4sTeTpMd6dh7SipQHk
+prBJldoRa8HLs3RGDjv0rO2+2f+/etrcLZa1Uc5DAq2KsNC5mqUo7ucV6dSKLJzD2Jv9ofVzCDcK90LxGfaxWIixm0OTllM8Qh8lbCIZWK4wI2FJKPiUN0gy
+u93SyHcrcHOsiNoKLLxsugdLI9IMVHuzUinYxfM99cskW1bpvaGLJlJYmgjegSDTfAYSitbQo00zIu0Y2o1UljF1mHfYCu8G49aYt6Qk46SoV9r3Zi9VuJ7S
+unJ/Zy7Mu659qgfE+XUPZxhQRkXkQoQqRsyOjsRtSaYigUxhUMnI44eGuQQ3dcqRmn2QB71VVzO3grsthnPvByhdHvbaLo7d5br3vNbekBv3BpDBTuj1PN42
+ZSzN9RZTwTyFtYu1jQ9hRsAWq2aQrpuiYCVRBiS1dw6whi50dyUdQczrSrUdvVCbaa8hWMm1MG8mzfq80JaAo6nAdYeBMbENPAsbE+0OpTQzVVtIj2rrwx/k
+YU9UCm0RtebJ1z2cQnphzNEoxd0M1sgHmfaEu5h966KYtRWKG3HaNkHEZhOPJgid5eZs3GXKJaJJr0XzBNfv71kv+VRhLsoP8MnRmi0BduLwlkyhokqxk3yz
+6sdEek0va9/9+MblMvrxxChKy+3XcwxGqp3jZtHxNIN+80S+FxnQXLGPH9Mb9YyDUFVAg+c3i3xNuEPi2nN7uHVHELwBosEnl7nxzN0kVWogivhI4HZJgos6
+7VuCh5juRs6VH7cePIZex77f5eImS2/Npeg1nFB9sqAoGuTrFNAdza0LWxdw2lDzcRwK4Xyse0GZnEUcf2tfpnmoSDG3HFqi1aMgbc8Zdsy5mqYJ3mRdQ1mb
+V7IoxX2aUKSInEhyrTPKz6TFvCriupoq0f4W0yra7dNZ2FioQ8d7hXlvpPGZ8yTNAyKWpJZwCaxl/aCyeyV3Dw57cl7k9V0l8SZmN+e01s6NDIi7J9BLprBI
+uoVV6S9y921QUOK4QoTpx1noivQnxB2mlqo8bHhd0Koo1SRdpLBbEgFeW6KayqtlaejPwiu1rgl1jytX5yQAbzC4SGOX5izMg/5YZ8fG5QDLRdGFre2kJgZR
+HCuyY8Hy9xZmXTDDS7AU+N6XpLn2YmgPmPZwadbL/z97f7beRpJlicJ1bU9hze4uEdUuDw4aIhRV9SdEghIiSYIFkKFQ1ldftREwkJ5yuKPcHKSQV+dZzlX3
+zXmI6jfpJ/m/Pdjk7qCmCEVkJHiRGSJ9MDO3YQ9rr/WWJxzegktM8L4Zr/8NtJlBE9k5kEouLemmQJpAeK0f6kbMx9bCWgs+X8vAhodiIzwn48iLww2wtdgO
+ZJKH64kN47pevl5EgU/rUARzgdwvMBQ4XsDhFvxXx/oUZWVlyBtIvSzcymxEJwQNllVk/YsOe4bM/EMIdA/D0+vCnl5nCFk3ZGaHx0P/YmiQmDfyqxvni/t+
+BdHl0u/FsipvKrVYkE63jTvAuDRNIE+nV84b9pThaUqrkXmYIMwHMRqAeNal5zKmudK/GCbRK1j4x5S2pYTsCImw2DI4Hbzqn+K05yOf1K4u0FIi86I5Go0O
+YAs86SEeFyJav4/MwyYEhj85KFeX1r/XQVxXWOO460CP3HKwmfdlWYHV2jEmdjgUMjbScvdUjsHOFovJZDXSfOJg7fDstDPT4Pyj4gO7+NnQRMdM+j8jUGYO
+DiwiSXStstyOn9uMxX3GoR865uz+NK1bH4IozKjOIPgi/uxpbiCNg0AZ14vQ75CHzbFDwAlM78USdh0y0hrzp4E4ioYeHRH/3jqIVRYzerqsgVGZDeZaL5kk
+F9oIO8kcYzphPAo0ZjJUisOsayUKfW9uqnK1NL1w4UxVDmcjV+PQEmD61vvbEsZdw6YaekW0c+KHgei6H1l3oloROXbkxraewx5GYe9dsQcx6TriVWUITIQy
+N8tVZVaqqEVdBp/hya5yjlf4yGudZ/pOm5bd3typcBeJ/+5yxDblumt61tturvJgtvJCtHmezqRLO6h9CKm8sV3cpMATntWzFe2etNj9keED85zWFDjF2rpk
+uCYyB922aSZUXFvVtDL8Y1WYtm0KdsnZSlOw2fhEDzKuF+2TO36wUDIveZm5aaswSXAHg0UndFmte0z+q7CYR94jjzsiuN5pZO8VeVm+o4gIPobfgd30pv1M
+YoFBRbG08IvDx2T2WqFmMyJqxfMcWxR+YE7UcSeijcBnLV3PBR4n4fcKA6R05MfnuE35huOMvRfRwe6t0Ed2QkUHPP8ObQUG9cWHPXdXTm9LWNR1SWElVzXH
+soAKkZVz8DstljGxWBCEyulFgfJSIeNy6Z0yfHAw6r7dFEMImiRfM29vSCRsSqKzLnE2YQ/ltb5V+ZxaCtO3tL9qWF/eWhMcXQl9dT87YaOVWQ3+fpmvaphS
+01wrds/hPpxVrv+i3X+5of8yMzSutM7hlRgtpMa/LVeCEauOxZ8fOX/I7kT29Dh0Bc5zsQ7akBXTVVU9ZMHa1RI+hxcggBghzvLQJ4+7TF4u9A+7i5vYs4av
+Xs5Di5ZjNnZfU+sQDhxODJFFljClwlhvkyqCXNkJ6RK6g2D/8WH6lIQUyKPTtaD11fA/Euvsvg1E1+0uglTkHc6ciJy5ruRcJ3X8BoxBIui9VpshctqwRrSY
+8TYIjcROzVf5PMMsCDw2WHUiGgYuGLWVixzEmEbE9yGfauSHdMcJkg1eCMKUIOcHeJdun0R0bF3hNtXyS7rmB1zkWxX69k38R2tLpO+gwjJn3FkoB5hw5Ae3
+RnZw6Xu5BGVAqmS/XQNnzmEBYYlyM8TaOou58/vLCPKdxxzrov0FPOerqmu9WNaO/94VovLq4NF+ZKw10pHltw91IWO6NBCpzIqoufZoFRs/inumHXA/gLZ3
+0Q4sNuzANc5HGjP2ITJKgDQ/g0RBD9ptRbzbYqnuAxth5+HACakv35pltDWLL96abYQErhFdO+/zNIyeBlssxzij4Kq8XnO0HlZgtPMGAdxPQ0y1yzu0CF+J
+nYHMW+5IyxCsz+YboPGSxgFtVpVu7fetpLOqwm3RGlPtOAeGONzhVSKJQb6Wx2TMTmpVrygWPNY3q9zWgTurOVs4mxne8ZbkJ0JhTvg+jRx61FLBlQ8AX8W1
+i9ohrIjUdZiwnW2oaYn882rGOd0K8vgUGbJtje3vFxh6asqGdn+4uhRtIJrzEL538aooxOTxMZ5WDyaMICYDzLyx2nJ4stmjqEHmHbrHzsAWkaf9JGLldvc7
+9EKLu7DpAIkB63jQgWO7WZVownPMxM8CP7IJzdGgG8I2w/t9+ZrjFLZM2G/DVKIxo7pj8w6iFoRjxoOsLmm7xHQi0T1IKUEM1gfMOtCJl9ES8FjwaRjC3bDX
+gMeq6lpNb8mOEF3+pKNrokO/vZ6epc6gs8Ptm/cMTJABzgL447m+D6y/UTFtzHQXL8DyJHPrQCRKBEH7huHljmK71an8Xq2Nk3GxVCJZTQ8TjSWgavvENHiE
+KQMHCW7nbTlobcfTaG+2mYcNLQ16d712yPVUnLPDFKBz+W84MBF4NAgp0cs5rkq+fAyXtD5wG3uHXwgzBU6Yi4+NyH2WZYVDoDwCP+pZsLft0oSLBIlmpcQo
+NL69ZOGdrHbT1MIRPx0G2AuOCtjoKo2u+9tQxMWUzrgSy9sKCxx27MDuJBLrEaRLwqOWTHETSFDRTU7Bx/GdRFIxNlVdl0FZRXQF2S6mbbz0hN1ZPXjDOqaB
+LYSdakwo+2wL6RObjaWNlRmp3D3JCP/CmzCModjkUCdtZGxoq9hHNIIkItpTKICJDjy+ZWEgHwhLX860XrgCjxbyAIbNloakPdx+nqdBiStUxjIPwNtUiKPR
+j4PxAJinj2Megqvz48GYisZtHQ1WtTtF3Zf9yXCSyDfDy9dQbG6fCbz1UIT+x+H5cSIHQ6x1ZwqDwXFAYhCxmtqnYPV+H+rqk1CP4PJ1/xLL05vNPRkPsLj9
+eHAyOLqcJAGpwekANQtaVAbCUhnEcgXD81cpvgI4BsYDOR5O/ij7E3k5wt/+y1XfEaheDMYno/FZH2k5TzrbBf2Rb0dXqZy8Hl2dEpFDdBEM9IDbPfxxIIfn
+KMk7HgADwmUCN8tdIDSAxw/Ph0SDOvhxcDq6gK9IZApIAhDyr/ZkfzK5OhsIatUEWQDgyvPB0WAy6QM1A1Ie4LCPBxf94ZgIFsZAxjAcnaf00f2MEcGMgVcx
+hwIwlkv4qMTQCgPsaAZ4xqTyfGTJSIMBEHaU+leXr0fj4Z8Gx/L1YDygKTf46WhwcRnOP98UOk6/TeXlYHw2PMeJkgrxbXrgtmPKidew8GsbZ7pG+K6mYmJl
+k2MhuIezKbv6PYcZxExPcwXxVbAlb/Aaqik1PYe67faWYmhId6VHY0uwD8TszFvU8cr5OKWXUkKL8M6iLqUyAOqG4z5bqqLe6YFhp29sIIYF5vEBwWWPurFl
+3alfNz6EN6VB49h1WC3BznAznRa8VtYldoqMgSirxkmCg/SgZWvjDpjI1RKAsXtyBvYK75m4XwcvwD09KxSRPKPTkN0hVeaqyLVB6TewfjP/JEpoYaZmiXss
+PRqjqwQ/eSFBr578W4jVVJkNwoDQX9g9JRcrUsCjy9HC8Ho3wqmDkjNUyaUyRLjGIGFmQevGUTVHE50ZFLKHLs0qdW8PczffaTI3fagNuDBhJ1/zRbikGsPm
+BiqRKuiihZ/iMb1Ua1ouEB7l5UXlknVjoGb0dYPRZbcZLVltBHuora6xIWQHoEjCsEwwE6M5EkxCuWkSRuKE0k8rjjzp98usimroaGDsxFzqKitntupSzyjR
+lhIkAA1fV71/q6oZ/VdpAXlJaNc+vHYtSjb54OKVnYs3Hio7Nl1rtz1eu9ct+Gil70ogqHMwUqGcp4OoFdrTCEE6I8DuLJGmzGdJWC+Lg3GrZnzVA0jjcJ7C
+AXDoDgDa6R/c5u2MjxYx75+Ev/n5d04OT+OD3SSutClzyBS7DN91QDFZSaPrmlAZPUH8EbyO+ZRjO7erp37N8Gen6JDblO5UvtINI/nhXXwjKEKQsXqtMeEO
+6xn1HVGbVGaFnGlaSBa5wOKqZSVdI2iccN+gbJn1vb4lvA3eR0wV0GRelz6A6lr1LbXq2/SA1l6CZ5QuZgKzo3ZscfuhpIg/8/1MLCvDhJgAKteV6bHDAJkF
+ge73ncqzWb4ORwzDjMx+4Z+EkVJqpf+EvgM8eGZV3YFvGfyFjJ3v0sAojqikUiHISDofyaPh+OjqDAhwjgYkJeP+RPEioqLy7FMNbinhuaV6ScBLFfJMJUzw
+9XZ0lXRbpQlqGbRs0kQ66q+J/R30JLSH3TWTqwtwD+ACNIgtCRUa8KCF4BnFLkd4x8VgPBmdO34xzyvmuMQCgjGxUVrA2qiv+9B1JOh60D3h+wS813KHAa3Y
+m+HpaSLfjMZ/lJPL0cVF/9UARvTs4goeetIfnl6N0fk465+eXJ0f0dOo8QI5305PnV1/Bv5M1Ep62WDiGbzc8Lx16gw/DsTLAfz1HByVj2H3mqTWcu+ca4Ke
+DP5I/+Li9C2MvfsjDv3xoH/5GhnT8HP0T+Xw/IerMXo0V6eXQE92Mh6d4TsFtvbRJGQ0Yz9r8NPl4JxeMjzCr3zafwPO0uvhS5CawCb7RqZiMjobyB+uxsPJ
+8RDHciKPR9TQ09PRG37o0enVBPs0bvTQT42NMyORkxENjn8OfKfgQWf9t9HYCHD9iN1gL5VX6SSVr2CynyMV3QCW52QwnpA8djstCtVWrsIiA7reHSoaVzVu
+ENIWZ1FU+Mm38ig9ScepOEj39/bl7ggCyqDD00ssiQTX7oQPblW+7uCeF1wiWpc0qJh2LCWtjbBVnk3JtUruH6QH+wdid6KXtl0IZ4J2aSt83Lwc2+J/eXDw
+PH1+sHfw2NF0CPerJ3L3h1WhbY9hO6Uhx5AYtFYOihmUqsC2TpWsXakcCMQRiquV4HO13/BJ91N5NpwcDU5P++eD0dWkGWOO0FnaS1m6Qwc+ylRXeCBaimVL
+eMyMCkM+EyF12hVFJD6v3IaBVoUGFNpUE/gbP4m715/PlQaAgJ5xX6PwfoQGtcG14KlE8Ssi90xex4FHT18tc3XvW2DC4J9/pwgisrm6R01qVaxdxtj4cF8v
+kf6cZvGfeZ5N68fl/HGu7oV/VyrfNDwfqPxAZhCXc3fQ+LKw0EpceNOszv6iwbonBH1h6ywB8UMkzZTwyAz9C55eymsIiKKjWVhS71rPMFOmMQ3HPOEJJzud
+OxPn9pveL+VNQgpu8edVlZlZNg1JSU70DBEFR+Wq8mwx5yXK/RcMM6Hsgv9ACU36O12stKTy1qyQE1XUCkC6FYQxVohuCe7x+bG8xGpZHDrRRPhPS1ObD5WK
+T6G1dCnbWs42VXVdVoVem0dyrplqTr9foonK3LbNjI8b83N2n48AQViQq1aQ8a6mtXFZz2FR64oMLZXLiSIo4KuynCF6WL/HuuR8zdNOz7A8RsC0jpOJNKHc
+jPUoBVXcrBTBsZUvZrUfVsDGXFcrPXPuCNw1qygy4eO/ToS/TboBJc8QMBydT4bBUYzBMtiV+hAvru+1LjYk5BshAVt46hZE8GERG2Rp6oqZ5XezBGubCwiY
+eQ3W7KrO8uwvzn+OMCetBDn6chZdQGQmMPlaHRENMLyJOPq0rUvhjjAGDSsIIFVOaAwsyYLsEuny024vMwKZA+uzLCu/JH0AHr9hhnlQYv5gbj/onYM08Lc6
+TOUZGEIXp4PHHB8l0xdS562vg5AebbIbimoFlZ+t3DfEAs9WeZ0tc/2YR3C2k4qOXzr6BJ6j7fcSg7PB7FRd8hfT4sEG0AcMcEL2qsnFqaUfUjkvtzstrLfp
+93sfMNmI+uCdtZ33DoClm4i1kHtLPvaXkt0Vso3g9MOHq6q56X6IsEvQ83cd903ve5clhRXsRBrw+XwQdsOfXObGPYA1xRWyazazpKqWAdWWgWZOq0U6LRff
+TC5OxcRZbT7EEkDtAlMivIAWh03zULWiaKZ5ZJTm4YI93jRxByA6ylROtI5eBmBM+LdjJnP7JBkSnoMBd4EQPNFqOdvPcbYtMy/8BwuY3uja9sTqJLPJTECX
+BtzsQlzYJRBUgrr3ECbescAeIXXsk8YzvhcCqOfH1Luxp54Pdq9d03sh//2jflIh/vV8dDl4gSciFp/YiewmOkMDMctpcnhxvvbpTn+PBTaYLpYbmLamc5xo
+mppbxJzTNNdiQ0sq5YOb7uVEGZcV7UdHOFIXOY8L9P5NCDvCH1ryL4XwZHfyso0HeDD/izv40MI00gTnEqw//0jGQcZsQZnZ9Ej5uE2+RwdMcN537rNtM2A4
+DLY+XDTlTdl0ShwikmotgiCwk5HRvsY6zP8zja8OfwVvME6SBY9Yug0WQhOxFH1X0SSoS4Ww2HYi4fUcUXAzhcRpdoYo3iSsDIpPJWHr1AO8JQxLqw453PUA
+IJEQ9M7yCanaV+/yG1ylC4krWBtXy5Kp8jDK6Av1zLTSusBMEo7gRXlvAZjxYBJ0zPMDNJiUs6AUGpvHYJccHIxG+W4TGhd08nrd2JKaPFQhNEx4monlGoOw
+9tskUWdkuzMW9HWXke2IQkM5q82ogupTyiluo9wChIZel3VdLuRUwwBCezrGVNCYpi1yCUdgTc2bZQtdRJgr1+CXQYNF1GDnF+7vPZPv5cGhXGbvdc4Ua9yB
+aZ5N39nq9GAURGMUspqeS7YwXL2Q12r6TtZl69iel8BjVFY38UCLztkQ1o/zEMt4iDGfgV/V8AALHlsei855urmXbeHgT+kc2yS/NH3y5dHpLyf881H8v3tP
+DvcPG/y/e4fPt/pvX+Wnpd/mZq13LD5SfS0RTYWexCr3dMrzyD6mPTHgE/4Bz046wjinTfELr9npoYlru4bJ3vKEc947cJ1jXIMVKssDDn08dgO2M3waW8p0
+asVKACE5f7cagT9BsBTI+m8fI0vwAR0god9zbLq5wRjOKzN/wwbpgdqLjonMeMPjTlfXqqby6CgdR/HB89Kx8ruYbGJzgwQWt5ANraNactsdnj40ntlfNFq/
+Jm1AOBAuHsnPqTVVFzXnZVa5bwOdK7Sm6j2aJ/jtqXl+ynhTE75ml5pDoe+DuDziJPO100yaidK61JWp5ZJDVXjiUhE7PJfl9mB6QpyipfYD6RWCcWFuKsjw
+TToydf0xh6i8EPhDybrNOuBW8eaDMjeQMzoeHV1B4qUfptrk8WA8/LEP2LsJ3D8ejE4opyaGJ1G3IJ0mMZ32CVo5InwCi4z4kcHOngyP+qenbx28jbV7ulTf
+xRcpOyWh5LvoknVKsIkBGhI1k1K5WaYKc2CigQWFhzzYbxzK85EcvTwdvsLPAVODHyrO+iCsdA457ATzwKPxZSKvLo77l5jvPH8Nf4KWTfArno2OcRDhORDu
+DFJsV5PBCzmcYxgLNyfM+pCVGC7IVnUsJo5uXOKIDLAgkUQ+GhYYYg5lZ8xKmHrGjv2ObNJXU3QbiwNEvDuqKI8XxvL70GBDnpCvsjFy96Q/NkCIIo5yJKV5
+epBCImz/O9Se2T3opZv6rTd3WxwDLVptGRmP9Rx3wvgmF/+c5soYR2W9E7DBHXG+0EWedlyY+RPH0I+MyArJfT2gzj5+vrd/SN3d72F7YVC6HYJ5WembEgPU
+0E+7yXrCgWamENqLBzZpiFBaJKuNHTB/Wgp2nNvVXH7MMiSkKqtZV5Gji3oKGyxwuJe/2/58+s/lmar1L+wBfMj+f37Qsv+fP3u2tf+/jv2vJc6BSODDRdQv
+gxUW1oHBZhTmN/ARYvLj+R+zWubZdaWqdcJpPotjhfx1VQBI9HHAMkYXw2N3dXqTyleqWC/0TEwmr+2DeikFgWmX8AzPlmgYq+Jwg6VYrBWghMhAnd1UmUnN
+6toWY/1Z3anbXHBKYXJXHOUZwxwNW30cCmL3J/fsGj/0f+y/PrWpKDL5WupnT0D97IBHdeIEhLsVVD9ZVDR5UFM0EbGkaNvA9V7UtCwoYkK9XmiAwP+DHOtW
+rWM46BxHYa1Rjh61Yx1UU5NnpibCeP+qYtZoh1cyS7venxVh/+37manyoSYIPh4+qQmdqqUiZMGVC1XrKlO58aPrDqmw6Zt6o4qwKxBAm05R8ZxzeSE9WFkg
+VUNdCs5pOWBM+E1sft4dofi1wbuSvCShy/Be+yrALoiOyx98CrnhrclAiSzRUXYbTW3kS2pQNxZlQENNMTODPCHRncQnL4tygWHkudZJVK07rzQU+Af6uJ4f
+QwSAez8FiLxbFVIHNCBZrpPu8cUMsGhuP3Oure7gwE/lsPbZA0dW5G9GZIC9EZmCc4eDWC8ZSu8+FvNL/xlhIotlWQThGAEJOcLDUNRFOnwFgYaiDspqVZiO
+iRnNS7uxBAOKj2/03en3tWZbZjwDFFqr97e6sKCC+LU2HgPhGLdtQai/uX2SuFRiSdgaf5eIP0dGmT+Yu+JdVkPwFNbNjWZkeqQiuNlJe/lWXp71Lwf+rx+j
+Liy6fdAvUxdOPigtnDQK8tDRS5qCw50xiLiPnyg5LD5Gclh+pOSw+LDksPxYyWHxQclh+WmSw+JhyWH5CZLD4kHJYfkpksPiZ5McvhyN/+VqgOpiv5b+//Nn
+h8+b+v8Hz7f6f1/lh76/vDtIn/4Pv5vaBPgdKNXFSr0He/t7JO/bn6kl1vZTBAMsikFR62pZZUZz7L/T6kUC/NB3t+n0sgrPB40nLjfQGSXUUszYgx1mS9f5
+SAdTwB4EeEjR7VjIYhL5HyttyA5j/MW1lpASzPXMKeA5rbRyznf7BGFdVv+x0vjPP5gVKGbkK1PrCpOQgsDSU1UQzwMQNjCjSenzhyFfZguLFD/xG7h2oYpv
+cgz4z8tvgven4mhl6nIBr0RqAdtYYjiCBsBw8QjPuA9YMamu5XWlEKLnznDEt7S/KH1E6BKco/Zb2PHtvgdYxV8IMYDWv+Ahe2wPZ8WXT+3VcE4DUui2LPQL
+ufvt3n5PPt9//vjw+d6eEG/09QsJY9N5H/6FB6zSZJ8Y+APjODyPwR0Nk0PZBdM+0AUgwAb/E+qDiWtBmIzIKiy5JJqfCcF2SeZY8gRrmDeOJ4bfAHY8Uatm
+Nedk7DPNPlAshTM6mIBwL37SyDVFB4sd9StjY6bNBoRdfcCtFA+6lfIhtzIgGHIulWh7l6g4hHz541YjP93DFNaLU1NLDGvLBHz+C3Qrbiq1vJVPE/mAOyjY
+W7FO4KaHpEIcdHbhc53UT+zFk5+lF50+bqT0Ij7Bxz1Mv56Ta5cDe7MxkQd3wOJAkCSXbnjQcZUfdlzFxzmu8iMcV2EpAFpt9w13yDqXAn3Q0QVvycoMruWu
+ryCgU7yseoFHR0kD+nJP6HgOJdz9d19QIQD8cq5VvWLpp2APjZonWCmkEViJp/ULIXb4E2LlSDgG8FQIQV68nLD7eo/5DIKc4pw570/6UBVCOFAQPRNHiHpK
+5Km6r1Ba5RSwCfhpzm2dwqm6LiukpaCwwY+6ymaZKuxsCvGuoeGTyn05DKbppMxXDKPDU/HHzGT1xiPoG1r8tfmGfX3MbPinqWv4IMGExqqY+yIv1cxN25Oy
+Cm8SdFN0gtvX4DOMI9L+4CHtl1MijdabD9l0R4inm9hwHsh22jznQ2Q36QZ3Wn6cOy0+P6Xb7U6LrpQuutPcxiD1PDhOZduv7h/3LyA/Lqh6Fdy9AbihF+Ph
+hDuTAnHMxWjMqXXItvdPToanw/4lVjecXfTPseOjMSXpG6WQlsbmhG4FTxM6vMmFx2E99+ABjxT4UidehFGNL3TiReDEyy9y4kXbiZdf4sSLyImXX+DEi0/C
+QoQG7H3WrhskKOs9molXtbpNgl0eaVGnlkZd+AoTKMWqIOiImV8t919IvyH7zRY3DVVIQL9k3iQFI/JQ+I06tTc7gr1gv9brspjZK/FOOobD34hASsr9wYvK
+klimJflHlfRQJn15be6RVg6227E2p9n1BI+FMZKapsos3///3un1fVnNzD+VS10sr83fZ8VsZepq/U/9PP/7ZVUK2D7/HVzTbKrxl1NV65uyWv/TSaX1fz/Y
+swfdfz/YO+Yd2vw98s79+/X6n1CVIqVKWeHtbXYwmxyZ/FfXb0MSowxNtkPw9XLal6PJLw4A/VD+d2//aTP/+/RgG//5Kj+X1cqA7dKV/nXLfj/dU3L3cnw1
+uezJaSMctPdMnmXvtDjLivovuJDH5bWuajl4p4uZus23qc9t6vMXTn02MbXNVGdXriq664s8RNFMbUJPsZAACseUubWg3o/yGsWXpDtDfjGICNg3Osfv7vPT
+oMLriP8MaVDhbv7iNCjGPT4rDfpgGvBs+MeBPBueX/4J7czx6OVgfCkHfxycH/dfn8ZpQfFFfkwzLSi+1I/pTAuKRlqww3v5QJehY5eTkKZoIj4xc/hRTof4
+yMzhRzgd4mMzhx92OsSnZQ4/4HSIT8gcPux0iE/JHMqfLXO4/fk9/FxdnD7eT/d+URfgA/b/4ZOnbfzn3tb+/yo/cXL3X9daVf8m/9UblLdlPtOV+Td56Su/
+QMCTwTR32lO0X12c9hJH87if7jV0/APLI2CJutZ5eZ+EBVVZXGuFqVkihdSVKa3oH9ESe4KHwJhLwjqwriQHiAEruQvCQpb2VjZyfwmlksu50y0rnGhAQOTr
+Bkrg/wZUAK4p1hzlO5h3ki8v7wsiSmGjLo9UurGuKOcofsCJiFlAGAC2T22pdCCY7YFRRniVXRhKeFuoRmtWwUuQwcmVVIeSL3JXGWELL/Cj9VA5fKZJl+m6
+rG+xst6L0gZD4Nlcl5mmUFRosIOHYcld0W3xhnZe3VT3EOSpWShUZHNZFlqGtWuBdqjTeFco/LIT9GEnVq6w14rMyHCImmNieokQHRmUh8maQnbduuTqQJYr
+nnldBAxgyXLeJbXeUSQxCQYNCccSgcWHQLmZkF4PYfMUWPHZgiTW9Hv6fywfIZpYuB//CXyy8XBYHzEYuV3Tczp+gQB8VCzipnRhp2V9q40WznnEAo5mZBOW
+bbxJdHjpL7gSs9PZxXa5N2bG+y7BnsKXosC3UHKRFShGo+JYKda2XJx2ysiEzAkVtNnUqkBJ5ibPj8MsUkHbQ8mRthRAzBHjPQrRVgDosIjRwvs450Fsch5s
+WVtc1fZgFSPRQF68HQ9fvb6Ur0enx4NxV0ICPY8k5OVEGze0igPjuX8u+0eWc9Jb0mg0xxyr1jwGssyELWMxshb4OXH041DL6ItQgoWC9rYtx4P+6fD8FfgA
+0cW/U+v4qsjAEX98Obr6xWzAh+2//Sd7h03772Bv72Br/33F78/sPpBKMlqIEy4ouNEFVlYu4byaruWyzLPpOmCdUtOpNsYx7Zms1pROZqIAeLa44Lsv8G4K
+OjnWHLky6qZ903/+f8h2ClvraiHPUTm3mIlLd9sV3GYfiYZHP7U3e8gi/UVKuZ/6X8r//N/Ae7oPMMYn9p4H8IoI+jlioJe1JymWyjRPXIp4r69hBJzOo5K5
+vtHFzNaPO6r0naYiuAtbpzuy701dbwr7qnmX5xMYGfSiTSQj1tG8umTDQ7YMD2AELYE9+0bY88+N/wRqQlU1S8ITmuYJ0wfaqLJjej1MP7r10rb+LtP3CVEo
+uNh2Ihq2D0qwdXTNlDkGWWOchMotd4KRWUF8MKyyjdTvFjLBGAlb92pnT2fH4QLsvNjU+SepPFlVBO9jojYvMRTQswUmJH546814lKhlTgrEyYymQLGqFXcd
+gDWFTRzuuJl/qyo1rXUlj1WtrpXRO4LzqI43zPJ77aeI7BiApdxgQ2oOBWZ45x/onWj1rpxDd3jF32p5Dara2o7crn3L03SPrDhKN/ewgtxqL2Kr2Z5Gshxu
+IuZdkYKBgvdlkWcFx6SRedbIqaqqtbDNjZpGK50MUxzP0JrnRzWUaCnOPC0rHY8Azj7blWfpngcnJ1E5oaPiM1rYjTXcdlE1TZ6XoW3sPWC5s8iqqqx2ou9j
+dxwrZ87cG+SzzhDkE27R2j8FbkuFeB7zbzgBd5dGayuiZJ6vhEaRzGKnnGn/6BmtmFkkFeIlgAUbhevyFDdJ2jpqPb0tIPBPUz0oM/J6bhbTHGxZnjlYhszB
+GSy5G11MmQHlG9wnTF3Rd82zGv5gBfcyI/uObpocCVuk327WQ6TgwsMgkAnXUIhB1XSQ1toy8zbIDE76Y4l05OiGQ4X+RAT1+03O7pCNj9dY1EzeVa1YPOZI
+y4oyfiWqnzKMJCjlj/2xaDGrWL1XYPvkwZ5r31N56d5/jO8PaA5gUQxrvTBy97y8oz5wOjIzwo079cPHDjrIAGCU9g8eA9+5HSWJLTjYO0hENCrtEZAfPwB2
+ekWD0NYv9k1HW+MolW9I5JznnDx2GVZDEGh0gFGscRpBb+1iBsYbmyzzXxQ+73pZIoYXfiE0LGRS6cdtLCvUdLqqFE72FE4C0rKprMaOzafNZr7cIUQX0kn2
+PW1Rcsr3W+xRVkyZo4kma6Hv/VZuDyzfKRF3yttm0DuiSl9AGEq7Ix2uti/l4A+f1LumZx+3rGAEFrum18Eb+cCYWtXnbKHJnhuG/LWBQGg1vVWGGF0X6qbQ
+dTbFIV6iwJFc6FmmCB9lDUeBhiOlRZk10a16WemFnq0dB5JVmaIh1e+pv3b4ZtpKAdFrWEiqyAoNyN7v9npOUcqRKtoWk/XFQnD9INQwPJcT9oCP0oOE0kQX
+Vy9PmQUG/P1vRmPn6MrhRHwQxBmGKRqChYkXK4T022X/EhKFbz+Y/4yZfH6+cshUXp0PUT0PAhuQv6TyfYhbkO4fRDY6KMEH4zEzNI3OhpMJymQMzzeNnwij
+ChxKYA6liXzzenj0GnGk48HJYAwZPkwxo7TG+R9t7CZ+sLDhCW7+m8HLyfASsIHHsMdAKB6JXIhUHDkQi5IFf4guxi45OPVq4wOaSCWZKctQDhMTLRqVoxWU
+zfBwTBwlOZ13BcvPZni84EutDNG7rJjh2QL/sH+7v1W1KYmk9P5W4x6FIIjamy+wW8/uMlxwvIVg4TDpmttlgQ8MDLSkkxyfZSgQ+4ewdUvZ6yKKL0ReGmuV
+Ju4oX1blPCPyfehmwaLQLqiMF8dY9Uq063wyE+6mdtC9y2XogIBqBE8Z+/fyFGhh7cHgLbs3ZTWTZ+Dp2nis/cspUp9WEfNsObd/ZurlnfBZ3pNmuZLo2h3/
+MNRo7XjalUf9h12kILSFBAWWeuALW+o0Q6VUviBAW+ak6FWPTLB53pdVPrvPZi6Q7qUi4A7hxihpDVDSGragg3QCdI+QizXI7liD3N3xf6Bf7fQEU/j6A5LO
+UrAvfIQZHroOGfrVdTZzNogX47DzvvkeS6hiGfHw7GCwLhxseKbD/zsyrVzDPJ1W2s7S+LOiv47EKlk14/qRYFK5lrvuKFbMX1YAt6ktp19WCfYQ4KtBTqvi
+yX6SyrPMTHWeq0KXK57nP4SCHFSSUaws2wwGXSqZGYb02B4qVyrH/RNo8Dc4KDc6A6EB8g4RSV5zhgwWhxP0Uxr6q5bQ2wr1UedcAZMVbHPZJhnPIIbulqiD
+rmDzG9cnjm6sLQQSMdwLuCVHpDcPUN/TLzJ7rc+AoqlTzLytEQlPiMbm2L61CjDmHFtpQM3hv3FwRTTwAfj8BuIFMLtRiwfOk2WurXr0PaKimbqTHwtPUsy4
+DntCqNdCyxQrZ3HZMDqMCSpJoYZeaYnqqFozkO9huUDuTmaRd6j7YgmVu2VchO9fux1GZTP7GMykUdRL5VH76WvAHeBO3MOp7XnEccFWqjBz5ujnLtnR4/BE
+Wa0WtGVFrvr12k1p+/8BS1y3rHs4fURgE8PDliXRimZ1FM8Nem7FGZQBkQ1XdEahkfDRbjZwwx4Z1vGzFKJoTaDLBFUB6r02wWvshybVWGyieu9lU3g7iCPP
+fNyIuEQtPKxoC0uslgQBR0uDQiZk7/vGFroWsJ2js/AEBBnudGWlSeRGnakoeoBiyBXOLdQ9BKOhITlF3uWC43/+AOh4IH1Zuhicb1IN5QBeUWeVloEPHe8T
+geQKRQs13eC1tVjxRgQ6sls02N/Ez1Vha01+OQTYB/BfBwfPm/m//Wdb/o+v84M7BYRBKm0xTrqYrhbXGLJ2sddKI/3SjHRqfahHzkrYkYCgr1gzKmfO1m1M
+iI3Xm9vEkmUjbZ8DrlQyov2IwFyM7fB1IVMnRgEeJ0rQWRZAKpdIbLxFOArtIFyLPmjx2P+GfAWuokaQO8pLRec42x2VnpY3RfaXEIYCJkwSUFtjq/C/3Ebu
+qdZmmtyhbhQZqQtoU7f4cKNRFzzq8g1Zsxx3p0fbjARcfq0LPc/qODyHgg0W02aDnDNdV5n1yMpVJcGq56LiFR61ZWXwhSTv1XplXWKVfCHBfETdf3hSpfOs
+AFbeWzoWC7CSlrpeMcEKIuLICA/E3YXHTUVzQVr4WzD0f0Mom88B1MguQI34HECN7ATUiM8H1GCkv1kXn4gl7jTkfMIM+Eeo+DQvvvlm5Y4q4Mb556198nP8
+/DiaXI5HZ78m/vvp82f7zfN//3Bve/5/xe/fVNqal3FFaJMDbO+5tHe+LnPwvQ3lQRje2fojoWt2+fc9eRyGT3cdnW+Ucu+JzCBcNSitswpXSx2YA5axC20B
+PuS4oBuOdTvHUTMODib+BR6E1OE/3JWmrkqU3GmiSWTpoj1WTkJghu/GqeEDcXEQG6KAka6hcjNQXTVAZHzlBcn43czpE40Ih/gcnNsGM13ah/EaCLlwV/Ev
+TaCZkQHkJpCzYkNsmq8t4JifzqhjPROfXDi7bwMTYROaEooYTW+yN7fA+cRCFQB35za96TTXMLTV/YBdm2bICjdSgioM49b1pPLYilXri3yPwZZs7m5MmlWP
+SfzmxFYvV7JaFY8xoqJzmh7CU81ZOZFcXeuckObLXE1hYFUhMe0Cr6t8gk/uIqoLJzaErhORkRhj4vO+tbrpSVLAZVEwHLkCjfjvMcLC7GTcHfhP92lQwTYg
+8J+WCyLwh/BLaYym74CTm/BrOp6qwVjLcKxJcm1KCtY+6FBl5p1UrPOig1ewZxDMRYyBsukavvJ7jMkw1tvuSwtVqBsOvgRrd9fLCvjhhXfYBUTDlHTDynk+
+9MJwYqyuE5C1iY52Pk3dZuPy3ouG3k2rfxzirHQIM8/XwmSLLFeVC+hF661jnLhsmN/L44sOlyDfLBQpam4L0ZdgtcKw4UE6TizUO5Jlb14UbNzBKnMz14va
+WkijFyuUSAbpkBffIzgqfrhPuNDWBMvS0cknFgEQbxEBtsNHWMPThNvkdmBXLcFQEI6fo7gyXSO6lWJrC+3CYel6RTb3IkkYZVe16LgukderWs6wxsKUBB7A
+KCyGANuHR2I53KLBgo50bHV+yXZcSKPDEzgQUwpPv+8RQ1aUjQquALj28CwrK/9Lnm3iwyuEGmk4bOoOW5+5wSU0VUVR1uI6il/Y0aU9wrmURQRlic6Cbwnd
+Afsv/DVc0PAdrqElvCMEVGquY2CZeJgnzLzjDo5ID3OjpeAwhkkjdB7zWDfl2xrm0o4yMjM7XINlW26LwCcSXMywRr1dwk7OsxwPXvXHDqYgArEo5yhbJxsd
+ZYZGnJ5+HDcXEm99GN4BV7V9ZNst5x8L6x8HAlmeDKub34rvsP9887p/ORlh2fd4MAENcnaH22XkQRV5t7sd1a+IzUXfPXhSm52qodvVdMOFq2tht/tiMD4Z
+jc/6WEbeKAtvRfV/nPzq9b9P9vaft+p/97f+31f5+RFsfdXB/JxCBe+D+i9+2wmAD4IDlzv8YHQij8hD40hh33tE8pQUXnbaWTlbVSfiUz6EKrgGODg2wBud
+nYdKW9eayJYZMUy7vQHlLlW7E5ZyjxuGIippbqu97Nn7znWNNRUPFpL81jiP9tOfifTosxiHKKn9e2I9Yo47glHt/Dg66p/uJJ+6GOi0/rSbILRy1D/t7dB4
+hXZJXQpdzMqKjL1lVS7KWvsiGASoORhRZE/YKRcZICIwQOSJ/31gmCSSg7hWrOMOMCx/uMMOEVc6eHAXH9GEwMiC5a1nflSLEhEJgn8B25NW9ntmFfnL3X34
+WDEQC39tCH8MjgMDKbSfxNeU/rgcXp4O5EbG0lAApMtm+nF43Jfng8s3o/EfLTFpB8FPXMYrQhtpeI54YzKH/tt+sre3l8jz0dilJx5mABI/g3ZIyAAkvlA7
+JGQAEj+HdkjEACS+SDukwQAkvkA7pMEAJLYMQH/LPz9mi19Z/31/7+nhfov/c2+r//51vv/wjOshBkIMe2C9VJolGxu1nIWM5MACshnLizGXP2YLNuUdnHZN
+FokraIp0X/T7OpVvy5VXTXHvCOlsxBLrOfkFEOV7p+8zA5dUvpyQXiw/4sXCv9hJayiwDSliZW/xrIEcUALMLkZYXG1B0EZoGhPAJ0T9D4h3gENTjbvU79UC
+obAIeYarURcf0SbDnhwibDBoRjAUykd8d8sKpTSB/b0X1u3i0AhfxRq2nu3ZKIOBomyxWTyHBnvDWXhHgavoe7FHCMPYZkvxYwIGfg8tYvsbbKW9IwycZoUs
+C93SEZFzLARQa/NCCNWzKGsMpNtisbrEp8JoG53PCVqN77AJF1sq1sneSSyjzlZdl6tUvrFj45RKK6nMO/ykILGAweN7te4R0sjyUIm62VW4Pkq/ubZhF3CG
+cD/CCuSAIzNpZz+DRgXV9OR6BH9jvxPmLkNqwskdvtqOSzmfZ4iRakwrGBAHjrfPFxiJnpXYhvhxniwLioBkwBe0dsVvwahAnrfQN2WdUSkr1ubpKqjLK0p7
+geVWKho5VPwMWHUtuPEJr2sftQjbSKM1XVUIOQ9GLTPyZaUW8qwsc10oVYl/hH//4S5bgOvyz7500A1e7ToFAecVVkxkRVQ5AHk+bcTuojQ1bl/5WsITzTwt
+dJ2g/AK/wdVClotlChWWQEHFUzKrZcBwlK9FtqCCqVyHczj+UJS9DFIrdSmNLmbylleN7YmYgtdmUjkqprrxEPwWQBeRQZKYtsNb+kIsOTGLn+U/NvhvhEkv
+YYwI7HVDsMFrt6TxCvcoFS8jfB0KggRbhnIqJTbxAjuEk3H3G6klLvA3w0D6bSqJH6VqOeylItxqrDaLym3/OMfz3mYQVC+caRTDslNNiGlPXlDsANqF925c
+9XZ7hlUFuPN1wHKnxQO7C+O+OXM1Qzim3eGrhcQqC5gh0GiotkiFPXmntyW0N16slG5H3mJ9j42isZ3NeO0Ei52KgOytLgRhD2ebXMZjk7OFeAuejsE+Hm5C
+orkJCTHr0TJw86UxTZgigHY4v0A7Jsq0R1sx9D6eFnZH7eI5Dr6bzJqKV/VtpXV3mO0xDpjfBlUdTxb/VIrl0TkTmwDBVRa0iWdp51nQyJ66ZC4+u32rCM6F
+qI8PHwubX5hCn2F2vdN6GT0Sz8taQnyoljRkQDppGMGQw68jOxMm/bSsqEwKl0n0ybvParR1FhqWwP1t2TyF28d0XT5wvIfN55JsKjfHqvpExEd6fasX8ZF9
+my3ceHyMRSK7LJLLW6YpVFOoTfEIB46PQuclFZp86hBbXpNNQ5wwzjovIffrtX1TIXTPD/+r8yv5inlNGnCy3VcXp70NU96tQ9GY7FEbfF4dX3Nx6uYiskDA
+94r0ICEW25N9udAGbW9HOA/kB4kfHjvDV/Vy5XDSOy/4KTsILoNorCocI2VW1FUJtrvWRULZfRduRwhQOW+3Hyx7mg6CmK5UjZuNMu46nsnR5G/vXPKgp3t4
+HvGHsz3MDGktO0jBnL7aXOH/MTULc7HDQQ6qyy6Q7fbuleFbg08E4Voy4+2UjKqAjX+nbaIibo+D3qwXBaQrvSjv9Ew4qodZ0nTbLHvUbbYAax53aDL3YYYy
+ew94TENnOnQ7Sk3XyFkGuoCyPXVDxgHwLdkdMQ/gjy2DdsPqbthagQPWOEHo1FwisBnG6l6tSfSLvk1mAASiH4NsJoqlr8VqCVoz9lGOzEvZwlJoFd1gr7ka
+n1rzmQFgTGGLx0ZmpFmA/YGIxSQcrDP1TsMLesEDY2tBsrmP3RDcTjWbVVyG52AQRv6jHw9nNgsx/NH6uIhe8eYZTYo4MuAc7A49zYanbUMDWU1EuuUi7Jjd
+D3D3BdNo1RCfRQqEZaXvsnJlcBYwWMQgVZBWjpogOr/RvJ2qBWVJEqcVCkQhsP38lcR/3hwe/crxv72n+89b+j+HT7f8j1/r+/vU1/nocnhEKSUXE0S7A9Fu
+u3QGcpDH12c1AXaEVx0P+sdnA+NxqqLSOdVa13phejKzyfmQjLq+DfO6TD4eHP7e2nYSWVazUYiXa88NnjA6zPnSy7UtEMfe0GmwGyxr3aOCWMLhBR6pmiXU
+AlOX5YxMadwLm9pernGM/YMLA7EVIS48RK5VH9cie25q2iCZSAQ6/oBkr6V24Ro4bndssZdrlddrmRkRU64nDSgBDEfoIPiuQjr69DQIAcMGHTU8nh9l5aAd
+FOQB7mt/ZoZdMMz7PF+BywznkMXm8UQlik3LCgFEkvZQdmrXhNoMbf+yatJgUvUinAGP9fuMi+CLWiM7/ArpT5nuwsMGTMKoA5rh7oMHusd4EBdlAYHfzNQU
+hcH1ZkdncltWtTzH5wSK5HaNid3bNbwXep4Zbzkk0v3KIj963jHR8rqcrS0zz0N9R6NECH4/32AtnhYutC69FRJ+MWLoqb1zeq8rws2mcvcNhnSg0pK5T3ha
+yavx0D3SfUE8uz1BO7RPZA4ekPaECLjUmnl72LUs4xKhD9uZ/IRS+S2uanHW/yPTQEGC3z4B08cfgke2KgUjWcwHs/zwnCjPf/62I9NPPFD9yzjTG1fdxR1/
+Mzw9hZZICwrgVPVwfCzg+fCWSyCmSvxQTBJ5Oe4fD8764z8GlYT0t1SI1pj5t3yMBBAn/aHGsjvR30A5wlM+pq8pbRGFJe2JOZHaRwlYgNDmbtCu2ADatTP1
+AZCuQ5YgziTEl1wiUWldBq2xEYS2aNkm4Qo4cARrrUN5ibEuP7Sk3U+S9zR8fLMN/EIG4pn3h1hG6YmPvjnVNyr/Bqi3v3HPe2xb+Bh+v39wuM+PBPdvlTtK
+3TeHR49MR+WEvNZoIyumBirksZ5q9EsO9wU8M5VhE9kXMA1DgCiFbrOlfUXodQemPDPd4pfFgfHQLa6VIeU1OA5JeoNtjjeHR4ms9Bx2fMr1icH4aHhG3sq9
+VPKWpdrc1S7K6GiSgoJlnha4Mc5Eg1zX5SyIL9d3WC2us5sVuCF4DMP1Oyujd1I5gtZDxpNBbvaJGd1ocIwNczOxK2Ovgbdkxk1QU+KVJdVdV3fk/YLUqT+Y
+ToDKCz/uIwNzUhtja8Mh9IJ6bDWztOHzR5PhIyOnsI7siSG81RZWEh7rOZYw/RX5SL/nnzeHR4/3v/vu273nB78YCPwD/t+zvcO9Zv3vwbOt//e1vv9//n/+
+eG17gI3S3/3vvnuCZwEQFOYz+QaMuTf6OmDQS8TumTJGTW9XRte1kUPLPgT7B1IEl3l5s07cH7xW/0zLsZ7e6mqKFYPC6e//x0pLXUtdyP6qLvk3ifyjzkor
+S5XV6x7hrplXemxx1x9z7vG5BvY5ebthWq7p6gZurdzg1orPcms9Ij1yZsUXObMydmbFz+DMvmg6s8zqsvVoH/ZoEx9dgHDyC0f7+RV83INUfhU3V4ErW7k6
+2jaiB5LR3rUVH3RtvWfsDLvP8HRfyJ1IAeRf/xsYZ4/LubNx/+33tqftYEHCp/n3sAF2+fgi9PHll/n4IvbxZezjb936rVu/des3uPX//hE/X+qi91c3kLLd
+fwLW3rfCOYxM2N9w/7Clry5OG468LsyqsrhZ2FTsoAR15HycENi4pVOOvjq4m2x6MP14vo4fZ+FOMZYU7luq6TuiRp+wuBSOYjQwNtNNylaCPrOTOVG1TuUF
+1TIZrZEYzR8iJ/1/sYy9i7KQ/7HShoE/18BlQDZbwI+NNMCrilWyAvuSZ1unpQVvELYniBwEjoP7+/tE9hdqrcjO+iG7Meqe4wTNllBn6MvbEAkRu1P+Fdrz
+mAbgD3SUbF3zvyX//2Bv/+ne0/3DX8n/P3yy96Tl/z/Z1n98lR+f3v2yfKz8QD72i31X8WsmYqmhD1dif5JzKh90TsWnOaf0/R52Q0Uzsfp//5//92s5nvCq
+XyfBivJLbHB1ZVtFkG3lhn6ctwTvqsrVza1UwfowtapZ1oK4tB1wOuAhcgiJnZi8JbzE5Ras9SDwg3OFtB1k++9/JSk86OzVeBgkKdwD/y1tEBf869tBf/xv
+kqJ/u2fDy0RivoO8z0S+1Bn0vJfudOVcoWT4E9wwucENE5/jhsluN0x8rhsm226Y2OSaPOSByc0emPgr88C+rvOFm9fP5Hjxs/JcfoJP9Tdt6765PLk4/YUR
+gB+w//aePWvyv+89e7Llf/8qP8cjpLrCveDk6uiP8u3oSr7pn1/KyxGLkXksoCXCOUh8Gv9gb++JEEGS6AhpcZ7IiVrI1+VU36lK/qNRiz/c0j+g3u2fhRhA
+ZRMzxnsCG17VTSPMKohGBJPOBhIRctieeWTJ4QHOqiZBdW1QzeCo3VxFH5gCnzQul4Px2YTPvvPjIcUYT1gUH6krjocTYtRgwTh5NjoenlihNyGl3COE8p8h
+8vLQy3/m7erNT/dZMSvvza+o/3DYWv/7zw8Ot+v/a/yQYCTMSjL7MSHVN1JZRUAuz2CFvS5v0BMmEhPRTXangwObHCNfP7ky3nFBv4PV0gMdTwbge7wMPVgZ
+ih1OI57N+/dvaAY7KqRTuighmSlY/CwhYWOTh+m+1Vhz15aV3GUMvyQMf69dVSTju7AYinQt9ExsdJfLefjm5jOclrITpXMjTvAexzuwDrOseVa8s05rc7uk
+EkfHPoC+U2JJtUpS90W3JGSPJUF4J4ZETcQBJV4rrrWBt9PN6HtQUcoHGFAb1WEirA6boudjr7CfsKuObKqJlVgF9bAZTAm8JXFFTjxHbLkjTVs/pq4GikXI
+So81dWOtZn4y3qt1Ki9Lqe7KbCYXmck11eQoUjxRLscF7t/KUb76Sl1Op7kywZnOtY0s+HZxZB5HFe53JNfflJVQMzwYfJSkwejGN5NUclbc5Gui2OKPBsZ0
+k3i4nHt+CoqC+zWc8HHJNVAlBI4tt3bNVK91swN16YcUb4ze59oyKwWM/n1mCMHXNTD6lxyXv9v++J83qp6Wi1+WAvTh8//p3pODZ83z/+ne9vz/Kj+TNez4
+hNCkudCs3b3z7JdChJGDty/78K+Lwbl80788Gp35YMLxYHI0Hr4EkePB6eiN3N2xf9rpQeBocvXyh8HRpWAtnU77eTK4BBv68rUTjmm/sGGI+1vsa/kvO70U
+beiz/luMVEA3YtUgYnM765+fD8by6vwUOODgjv4R2EebW8mDwe+ht0Bw5qh/OYB/jPkJwMYrXr5tvggjM7sPcii/fItxs9Hx1RG6Eug5gFcB4TPvV5y/6jXk
+dYYn2JrjEXaZOwLB49HJxv6IuD+JvfvhAUuF+MR5tJ/aI/77ABFsFU5PG4SzWNdcl41QuMsAsya8edGSBgntG2rhI2Obd/TN0f/4H2g5nZRVXanCqohVwnFj
+7u7YUPJOz6rvIqtDB/0+5wdAGRNPvaJ2FTFwdiUsd2rqUKZjpgNlsnwdVBZfr7nFaI5zXE3UwfAgeGxf7jAbKNx0oWpd1IwV2kEtsxdyV/Wc6qlClg9daX64
+BZBjHqKswCom0tpE7mY9KyFbzuUSn2y8NEFR3suywjwG0QqoKdWAJ4SsB3MYC9BhQMiz51fCkO9mWY+eNQW9MEvLLxeqrnXVdAm0GFmFiaNyxoT44aRAPqxa
+FnqqjQH70SISPW+sVQMIZea0jJ7ropBZMa8yJrj/ntp73TWGzB8mghGUP8cICleb0BhBfOGHhy8r4Moqkos1iajVO11IlZcFScLjjAevwMudR+OBYs0HAJsr
+rFYIzSlDiS6q0y8rqYs6q9fcoEqT8jJ9RRprJ/eBf2VHKmodvusQ3nWHyoPwfvuy1odKGj1jC971BaZxdIMD4jTu40mhED7oBKybaTRs2xO5c6yXebl2reIp
+ZnCbs1oKfn7JsCtB+Qn6w/h5UOwPmlhpo1U1vSU/Tt/pvFzC3JO7478/7tlWXlh94SuuHREuTdQlhx8KDLo3rRqt5E/BTRVuFdgZdA0gFm3wc5bVjSqyv9D3
+C/i6x39/zMI8UTMFNdPIew3xeMOQE5p5/N9u7Fgw4cF2Xa9x/rNyR0gEzoQtVhJyoYoCyaX306dy5xSkDiuAdr5zs5cTBbif07zRpvG92tlUwfmDmUb30elk
+X6+b3q7fo7ERz+RONO+oGfSBOC4i6zIh98diJN3kpEMELsAVxJIwfkczdbWa1qvKhUsaS0UVa18T1NwR4nUjN66bDQ8IFpB4aAERs4nFejoxDpQ1MrriVDb7
+yUqEL5GZoSOsMVyUBQ0GjDeBgnZZJLuCBwo+S2CGhV/4e9v23Wt6NuRq4YYQ2R/qw0OgGSyEVa0rySaHz/haxRF6mQm1saPX4oR4LneiYbbzEroJvXCiazNK
+RLvXuabh/FVGWOWlfN1U/nD2Qyj64ijt4/Jdep9w7yvWcrWc2W18tbyBBCDueOiAW3tLltQQ0363eODdqrD0cZbdT7+HgTYxeb/rgFCG3ssH8K1W8FD4Vrum
+5xR2oCX+sCbGOx/psgqxwscRwlGG2z5vLPGDfit3wt3Zfk9WIo82FpSZxR3wLpsB9oF16WEnhXBWiRaBE5NH9Zc7xbL+sW6thZoYAPYHj3yEbxbNN2eFZPUl
+eMFULdXUiuYVRPGlq0TqBRxyWidyoUkZKytmeqkL+DK4mCo1rVnl9oaLApV0Gg1w9Gw8NXZ940VLhrfnQ3P/sQLhfWxbOK441t/JneDLhTbC7WqhCqxxwa/m
+Se86lw9F+zKYW1ltb7DkdC1ofFaHiwbO1EU5W+XayKy2W4ylfW0gfvHwnaupljPn6di9jhlgHVE/jm+Z83R1snjIRKNyD+ZVRUDvKneDmd7DQdrfkztvy9UO
+dBn+IzDcwrlHpJlQK2BtOP0ePgaex2TGio4pDx5TeBvKbTXf54wTGA56urAnLnbSJJIpzaoyJzk8DMNnxokyIa6Wr2CenbflKiHzW+zwX5rb57K8h3nbtjcS
+zC8qYgCyGFi0F6tQP87uKNRofBvOmuu1tPPfTSWsyZV8jLgyZYGn2ZzwDlO05J7u/feetIK3fFKXqxrOcgIB36qK9lsSbcYFHj0waBPlCi5c2vTKaPO9PPJx
+z7+X44C7OZ2wf1CXgakSo+JEw3JJ7JYXItUMDb+ez/mk50QBnBXknEyZ+zn04rFi6r7ISzXrNFBgOeazx/cZ/Isxco/nFexBsEPo99N8ZbI7x1GWNNy+0Lnf
+5Axzbzk1YwT6Tfb8azTIknIFwYYXMOD7IYdU4FsmXs2MFcw2JWbsi8SRp/gMAIUN4yo4GTb7CyL0Fzo9hbjuLSukVniYkhn5QghYN2/LVST1QqXp3EErduhR
+h9GIxWkv4cQkI2k2BvPZEbGIMv/xOBe/9joeotOanRGnZEZEdCrP3aPZPmi2DWXb50RyGU5MNBaE2L3+UO/jhJO9vYOgVTQMt+j4bRcOvo2o66hvPMMwRVLO
+50RliSS/pHzKXkbRNmGwoyqv6RbHvIo5slPvoBJmc5otM7BaH/Euj6scd13Hx6dCXAb+SU54u3yWCkQRglUPAwBnLky4a5itWhEdMWR1uHIvohbtGEoBQy6n
+eTZ99/i+UkvW5rMRN2YXNFLNgX2YsKW006AjdL2mexFsBh9P7gz57zsQKqopSmHlMxcaXKrMLDAl1fFepqiz7nVI2s2/kspb/jzwBe4KYdlw2ykXdk6RmRQa
+GU3vt2fPiIkrPra+GLFpxWNR+zwxGhmYJrYliiYcrDD77OMI5ETa/RBZLGEGMgcw/K5pSkJLyNG2TLhwl/DZ1SnNojz3yf72qdNoDp5rB5+3zVJ0JmpnIj5t
+7zOqzsx8TcjBW91oJ89+CQcBrn32ePk0ai7+5qB939hqZis8qerWcZbVQY46B3N2HQKcuY4nK+Tg/W12ndWy7zpG/uu804nM866gIEGqnUkU56zJXKqqNapG
+ZQW0zm62MP9pvqgaO8XYKeGpKq3sFloHEaD6e9o/3kbEuZsaTB9Wz+KWO9VQ0daY3RCMabq9NmzHpo3RNcTmanQ07cc+lNc6L+8Ty6ZqUWOw0njGtVZHWcn6
+Xud3WuzuH/TkoizqWxMwWvJwsJIzP4Yga7jh4Zaa0bt0hbgwKyJNhdK40TbYchuj98DICQ2w+6osWDCP5MudhcYEmvf6GgvDevCtZj1Y5G99n5u7QeCAJKFn
+Qj5Yka8T97GF30ObsypxMXZ8bI38r0EEMSsc20KDNyCajR9YiDC0G+eM6DzlG2rwt+U9lXdgFqAuucyldSZ/L8jC0O14hHVOW43rKGekJr5FdtH7wk1bLJKh
+aRNvc9iM1lU+NkUdLUqZa4OadrW15udhjgZ3lCD2b8mTNq0u6Gy8LVDksLzLKN1Fpzlwyeoq0HpzfgI6RnMM+HKsl3IVFPmoKXSytrYlRH7xvDjES30MSU3f
+FeV9rmc3dGtQTKRyOJJvbh/qpuDtINghLMInqzrTBF2f0WgMjte3SEORFeDKSGXMqlLIFAWdDQutuEGcjGiMeh0+n8J07oSwuSryfMnlcXGO7iIbNvnKufDD
+yX7lg9/fmu3YxDxTXABMeSlMa+CfjbzG0pjGB6M3eIBZmGSj7dC3VbTaGrjbjE90hzM0IAhboIHrfD7hPqFVpA4sXZg37N7Cp1lodLkk8aCbzPfP6Omq0sFw
+PTiwhdZoNWZ45FG0hLV/6JeUvEBRo7X9Zna9ZgGbds2qRDbv0djyY1OHEFu477c7wKlFmky+8HteVrrNtt+IVx+m9NRXuC5SOcSQjMlmurLxKDZOm58liRcR
+fwFBE6YzNhx8Eby6kTYmw8wmfjJthIpDBHH8wDOt+S10Y4Qg8Fsf/Lq7PpXH367HXHJlFUSzeAtL2qnozXZsqMgf2LSibbc51XAFk3ZaLul6tiCV8QERN/oE
+CmW7xqAVC7ccpAcEFQzyZMazZVM6V6rwzxQIg6xRM6mCp6WgAfq0RFm77FLzK8UbynVIJWF95066FGciblJmFRj1ScOsNKtKs9eLawCzNa1jHvbj+SqfZ/Dt
+HCtA06zDA422/SA5/DSVp87HQ+ecJ5aLlw6cS+2PKXQgZ6GteZDAoWyPN3yChRLwbRwMyEmzptJuU/MnCB03qYinCweGwm+Mn5fHJMRnx/sR2a02nUMnOP8D
+7qdFmhUyqw1tnnBIVJo1QJ6lsu+ffAkfPphaJDpTl3Tqc2KoBBehgqMbq2bnZZXIe1XBe9aJMKsljD8lJRYF7G5lFZxFXuTI9ZlzebR4ceMy0EHB1cLBHNjd
+aTZ2p4fNK7SL3PrQSTM/ncrX5T2Y7onr4ayUpiSgSskJdTDgrvWtyue0ofGO3XHukD2FcSB3izfQ2oZC6uc9m6NRqOeREd4UdNYZrpxmr0NLTIaWmAtRWW4t
+Nq1K+zlwC9NzDT0rZgiAjywKDJYFbZa3qlqAFeqqp/2nzAoU57IwGDYtlDG6ghmPAlKmDsYD+xIactdrDkxZ57Ozt6kQz1Or8d0KSISTnaH+EnLhmL6mCQaJ
+Ywvpau0rZOZmCxwl+P9UDmDL4huccBgUTRRS4RaYFTerzNxCOMteRloOLMwVBzhdFtVVIlhFKjokpysIevGD2lF+txrLos6KldN9yupud1rVXmLF3os6a345
+w+041HFDOx5HH+WamV1intRgEH3PAlTcOS9MfxrbHVCh+Jh0inccJPJvVt4EqMt4k6fzbhYYJ8LHpr5NoYqZi46xvnhydXExGl9S3UbLj2OPS2aFvL8tc4u6
+gs8ioBiegRBgntTaIA9ASYE3qMpfS/ol5dgffoXQVVVWDoQAoQIK6sxVlq84sZSXBkd9pmqmjWFnMyusopwsK+FbrabTVaWmmeZd+6N8rJUr3IkMyWTD+YlJ
+QFUH2yA8Txd1BrttZt6lADwVR6MfB+MBQFWPN0t0W/Ss/UKJvLp4BbXXk+BbCS6C/uPwnJScGVfs//PRhPGvo/HupCd3j0anp4Ojy+GPg1PA4p4MxmOsPxf9
+idyhW3aw3A/VlamsHJHBk8ERoYS/xad/18P/AwhupLDNde+nb4XV7MZrAo6x/vnxN6NxgNP9QLF8Ij5Bfbz18E49cuhO/3I4OekfXYLi9b9c9fEPcPGHtcph
+zI+Orsb9I3rUv1wNB5dycP7D6C1UnCdWxjxSMR+dRKX1XDEffC8x6B+9DsdSHo8GE+w390/2X/WH5xMo2L8cjE8GY5TWhnlC4GzXAgt8DudZ4okBTq7O+VPC
+2/rD88GxhaWHd2DpvjgbDC7p8ePBv1wNSZ18EjxtdDEYE7h7dNL9DCj9vzp3IuSDYwB7D8bj0fjxyXgwSBxrwfHgZHB0OdnYGHjQ0WgMXAGDY9y+RuP+KTIv
+jIeXlyAQfn4yGp9xc8YoFn40kK+GIB7+8i2PdiL7btyvLl+PxsM/DY4jPocfkdAOFlb4PUhs/mg8ADh83y1Mlt4NNpHuKEdmYxwkd4t2OR5OhYV6OxdUFivU
+VpNzNc1yxiSorJpWal6LQt2xXZggrGBV2LyLWZtaL4hkRGWVrCvIrEwd6mChprcID8RNPJveCgQA17dub+2M/9AeDKVi6LhrBdgFC+qRWfHnVbVORFlJA0aR
+lsvbtYF4LMFp77KqLDC8mcuZWqgbOH2+S8OygFBxPpVcSDH4CdgncAFcjEevhy9x7b98K0/7b2B7gO8PqvCXgj6MLalof7iHqCc6pPw9H0VMQuHoLiwbRbT5
+xswUI1gvp30oZaDCkOHEkRCPxrSgrui/h+fcc+i4rU2Il64l/BiNscQaGjEYnXht/qvz4wHsVl7A30n1B+fHZn3+XiKoKONy4j9EJNrvBfj9MKPYfjjOr/sT
++XIwOBcPivTLQKR/YjfLS9jGJpf9c2wZ7lL94enVeAB72WAy4U/geE5o5GE3On6bysnobCB/uBoPJ8dD3tpspcgp1M5QFYidbiKcbvAoPwvkaPwJHz0RE/dp
+7cNdWU7/4uIUP+nb0RV690UJPkNRS3MLoR8X1GDIaWDsPzKyLmG5NOOSlLjJ7TqK4zfKqqUKF3O7XofmYa7uex2BKsyR6xmT9pcrimCiVPXtqpjB/jUr81xV
+Ru7+t6d7e+neHuKy9lJ56chUGsUuLqJLPjVi0slpDbKwnooF9g78F5IXGLlDg7PDY2PtYne9bFwPaS1iRqhL697vTksMe2V3Ol/bJ8ozuH0HwUsWe22fGj/U
+P1PwhcEH2g3LCOwz0YoPXwMPbF1nKR4ZJCd1MSsr8vkgg1TWLiRkYmKkFhpE4OM7YfmePYwYZyz/DBJPhiAjBlGEkw9h7n7WwJfeT+XIgrdS2cBftWLSXaHQ
+Vl6CsCnIYeHiQp75CcFBmhQtOZDSKnggXOv1WjRdZefmfuo7OopoOkst7Jth8LrCGbs82eKwFRRdweMor8bTJHqs05e+1lIxiTJlNbkkpgn6CR16FO3rCFwl
+gS/LnwqbIDqb0PXtqJeU6cJ4C7qdPCdL40DpAedBANEJgmMMwggE1BdMbZQia8g+UAboasEAfph5ENgNf9UoqeOcPG8traQIjWjN91uERDy0FtjCxdWcGQZr
+iPhbPBmfRSwRTrsNC3T3rCoaYnld4bznGpTDPTlTa3Rar/UUksU3UmHNXzkPr2dsRbZY6Fmmati92ESk44MttGlWTVcLChMbJ8EbxWD3D9Onu9e970VZEUbh
+I7qO8wi7mi20nK0crhC67ngK3OQgqmws4EDSXWah5bhrlBYL0ESUXKlKYwQl4TDbAIGDCv/d64iINSOEODsO5ADhm0RcHsyTq2VZuC+PywCNdBfiCwbX1OUS
+s2FWWT3KcLTRTUlc6RPF+l1USrfxcmg6m7oq1w38YetqW1UnsoLIB0CkWBPFo8/LEJW6a4vXIm4/CzknKa6GCaDckTVKTxLsB4stFLOq7hCRVayjvzYmf0pq
+8EFaPOHsSFbJQtWrSicWPMfkZVnBsFt5rddlQTib+BXxRhI1KIS+XNP8JVwawc5dVugwkU8T+W0iv0vk/l4i9/cTiXMGvsY+kslzzZUNXOaZjaN5bBqTIi91
+YfjzR+F6a4nZAGBZ1RbrirO80maV06K1/UOt46BzWcGcDrCUGaNRG8t0glNn89AI23a7mpeV/vNqlk3jbnAAEcGUC+2FBizyALbfw1SeZWaq81wVulxhGSNw
+vLzCnBeu4UGB+OyqK4KXQXd3goqOrNaLHRgFQvEBNE+e9MeQrduDmmn/2IhjvAYBAnQfIbZnN3feAmOPmsE3VD0LZ5G9eroysNNVWb4O8CS0PAhr1WhXPKFJ
+n5yfsQ6qmn1mu2i2E8927gfJQPBrOz4vjALMxP19uXvpHnOsapaaxr8dyN0jLtESFjiJfyb81rGGb2cBB8d6ztHlanqrDMQLjnGsnx6kBwfPHz/f238avEvA
+u+Tjx/LId21Imi+YRz14nj4/2Dt4fCh3x278g2ttwxyik4zRxi8dXSnOXNB+8NQlCW6CV+kkbcwvgfPLJflbGdmOr+3wKS5hCBP3QI41FZmA3QqDdEF59oYV
+EZpdiDOtVlTOR/W9xY2As+1GF9M1CTSzJZzIP5dZARmEgioWQ1/FQqKosMQW0MDxcK3re61xI1eLsriBHT7ZfM55JLVrpyvlE47vp6iJ6cZWd7jgNm7FmGW1
+hxch0hVzFXkgCo7ZoRz6Ail57NH4sFnWt8R128SU5Tm8QWWV82lFux+PjM9h8LdNPKSBcf8JnlTUKGN/iTtwVicSnDRdt4vfZe0kS9iNIvcJjz5GJTiAgQjA
+0/NVwUeFMkk0GrTj11YPuwEzDXL5Jnnw7S55avvnYBPcmagj9A2eyDcK9FKwBoaxzGDSnHCE7mGAVSk14PumBPBxgLUOwL+f9TMNZ4JU8h5fDNfOVzin+Vkw
+A4TNmEXz3L2A9HhydU9nzI3VJKAsO2+GNCqCyCOKm5W60bbijkuR6KCPVyKbgnDXrCJ2Adt6EfBexWWMh+lTOYFwJEdQUqyoGs6d7hJnU+HdKypt5W9e1PLP
+qyozMyo4EvOsmBn54GgmQYm1zwWxjnzBgwimRUKFeo0H6firXLtxn3lN/vfZYrWwmBhLSHeda69owRYVXK6nZVEusimXX9XGebzef2DQUeK9KLTNAFfTOVdc
+TjUriGcbW4h305tTLBs7L2tYM7YUjBDUZaVvShSyzuaNiBSMBSK/TVjpYcgLQ1I2zB4yRZal1EX/hZwzW50gPRqIr5aHktXwtcVAuk+BcwI6ymx65ZyPFPuU
+pHOX816D8yodjIBqKoJLwAm3g2ZLKjY5B23PABqX1c4XkA/7Ajjjn8njzMARLMfalPmq9usyqzll4LY5OeNrK3et5LNJWGoOF0jJGQhbdpnlQMUhl7maulzG
+eYmeVAHtwe+J9btQBltWRab8mRa8hFEYNkRljTWbZYiWpAXp3WmakImjrGNXXM+QcQ+XtvEiW6p2LYqqHtqBFbBueaLWwTq9KtDLIDksAzsvzC28oKB9WE1r
+AoDA5UMqcmOExkRRQcOrspwZmYX4Kf3e8bbvH6bP5YBSxn0LcvmejST4CKfqvmHBhNUxNMvh7gAiw981XPddVR9N6hVLeHAZll25HboJhcvVvWmM06TGunu7
+w+A/wX0MpwJXaOHHsRJo/u/wVOjflDs/LYt5jhsEGFfqPhXiTUhfQyT/jmuHdlouFfqXlb7W00QeqULNVNJgYJrmgDEQTNP0Ql4GY+XnJtQpufze2goRokaN
+RaxH3pkFejah/kZe29MM2zoobgAVkspTbdxry6KGYpmb//O/QAVHIFrw//wvY/Hvlaqlhm+3MhKJK92zp2VR6PfaSFNmcHX1f/7XLPs//8tIXUhV3OQqM6kY
+/IQ5NdlPhdi5sPjvpkLmt4ekkEmrFCThpt0ablaoKcu1R+e3oqmwLbfAn42rjGDHzIVm7R5o4tDzR/N0if10j2Q5HvFfHvU8dq8oa85J2PbznCRSoWWeec8N
+guVuk3j5lknPMAd0MjxFljTZfzUeDCDzA1nJjyFaE/2gktAbA6ETaV/eHtEmDQorJCOMyVeGqFqCuJ4hvNG0XHzDJqmuvikhtoGFHkyW334HRl+sV9dNkBqC
+TyMVDfiG8hEiWx4BYj4zSQvcEmXV5GCIGbcOVIhoYFxa8BPIjL58KyPsCeYbQ20GlwIVbYq6BNvxoCReshEjIhxGpIEJgT6cj84fh5CQSIwrHEE+PZyx423l
+G3cI+KKAoCTTtD9HuvM3RBH6xqyypfplBQA+wP+9v3fQ1n/e2/L/f5UffwDdKyPVjMuRjnKt5EkqxxrPVGD0//ab/f1vDveCPKkXUcJomirEq5Uu0FCGRbYs
+MV/oVfHpNZrg5q68xXI1wl8hfnpV2MC01YqiavwmNxjmiay4LNwbKwkAWXjA8I/vZjiRLXJNO2phO15kL7fwVEPQezwt3PsCTsLMCiNBFBvab99daciSz9BS
+Fk2acLjgjULUb10WbPd5FVi8yVEUSbWqb0t2wbSYl0WN3f9BFbrQ8g0OPN2yrDIOyBJhwq5C+wE+KIpVw3eT9rttyZH/1n5+2t//ZTf/j9B/ePJ8v6n/crjV
+f/hq398ZUbGIy/533z2TPwUa2JFsXoeEHVbclXOu30lsLs3ShHolQNzYXcQsFsvdJJRLOzz6IxEnL4Erc8G+66Sp5Ft5GqQwB9rm5ghQEaJbz36hsVtcjJBE
+1C0+pew4EY3OWbbNdHN5eCJ9GiI8KO9vy0V0LYz0fFUVpDFRl1RUlDT9usApD0X6MSZzTaK99uMGEsD4AYJzvLDieByvsAwoMitEmICvLA0lEhg0C6Ft08E1
+ijij27D9D7o1zp8RHyUJhz7ix3kiYhNavQuLnobgWUY1w6t+QugjYEyvzkQbM4sOVeJxkBYY6aCUHo+KhNqyf2TRlh6NihjUGFhqkbMn49FZEuBnh+dw3zmV
+HeDQtrTsnMydA2kO+qfD81cOQm4vToXwZYttvJJlbHDySfzxwx2DJxIkHbq14eIEUl06PB+lnqBemhlrOf6qVZ4VN8aln1vrPQbvkZlk2QcdJjBsYyrET5IU
+ZOQEseCUlQ8wjvPoBorn/F4MpZ9OKq2/ffZ4P/3l7IAPnP9Pnu4fNP2/J4dPtuf/V/z+vgbW0/Tv99oWwROIbD5Dx8LeeVGVf0ZuRVwWou+Qo7Lycc6t5fDX
+Zjnsg/MfusLYFvbVsbY/JMyrN9oZiVXnM5wwtu/wWVTfAk8MSAyXzRZkhVWxQoACt8Dy9H1GIx5sg02IRPNJRGXlXt2/HXYOm55wNtmTNmDyDx5GXVFGWtoE
+bnriKXwiysSQjIjYOS4xfzV7jLRt8eR3BpxrVRzfsCQlSUzNFI+Ir9OCB79g4WIa9UCr2Ae7OUyOHBibtgq5e1vXyxfffAMx9vdzvCAtq5tvCE6V1cbrB5SV
+2UlEa/Rke/SQHOQxIRTjZgNJck6pRZ2veULEl1Ats2WfpJe5tYRkVEn0CenVwDFjVbJdOxCb/FBjkHLjU+2rTWMpnJ0lv9DOErGdJT/fzoIY14YjAlM/nV7B
+//yflPN4RJmK87fWARgcBymNMDEhfM2r/ISa17ZfAG8UHypklShuxImSwfFGrwBqNL99BgViP9iaOWz/5SROvXyMwHNQbydsvZ0c/DQ4uzjtj98m7fIr623s
+Pjw00Lqjq7ErtZ1cvZxcDi+vLgfy1Wh0TCXTgzFUg06+l6ejCY7aFYoC9S/7+OKL8ehkeDn5Hko/X0JeDwbPVa0OR+c9+Xr0ZvDjYCyP+lfwFeG7js5ZNttW
+3rXdITE8Dzwg0Dg6ugwvG43JLeoszYucpZ6wzhJLFr3pv7Uek5f8ltGc9JV7cVWe2FyV99fqEPw0eafqX1f/eX/vsKX/Bv+3tf+/wo89ypEfPzNkittT73tS
+/FRFaDVomdV4GWpMXhFViFNOvS+QtOF7gvTBEwPGjFSI8cb0ipfFivI4MbVhZJiI0I6rtFzo+gXB8glu4a3Av28HuSwFn/dRpJQHqerJUZFbntKAi41KdKbv
+1A2fpxj1Km7ozQqZ0qSEI1QcpNc9OayhH1iS7sme7m9LkgCjx88s3gZG36Zr3KfgdJFgYFRMBSMz5xugGfI+Xad/YWJ8+IcV9oqFa51eiHsPmFB/gYtVIVV1
+nSEoWprVfJ6932aDfv8/P+mqfP8r7//P91r633tPt/Gfr/LTxK599zThzA9MDHnkZV02Qdiu2EO12frO6MyygtIbhwvFKm3iLg9kp8UKKxDjmyvtDwMC5kYM
+8u5CyoZD2Mg/XMDDY+L2zTECjPM2O82QZmj7TaWWt8LGC5CXFnH1WU3Nap5rreY1O03o56hcNs9DqHeMCNXv4cRxvCQAHbUAQhNEfBrSRehPUfThp8F4BPmK
+8cWI2WesJzMBzJcI4V4Psgt1qZR2+1mi28/6CMKgDooLAdY75SzQYZwMR+QkEB0PwNiGjEVzngK+wTpE48Hk6hTpRSBvIpuZEfDOwBsYBoxMgbPnUzWBQ+F8
+FPEAUwh6Ui0XxroYrc8ihhP58WwgX3hI/1ToX9r8/2D+//BJK///7Nl2//8qPxhSSs81B2e6sADTHqCF9iDyvx9fq+ZqretaJ2Ghgria9LcB/y1UYAsViIOC
+xJs2ERivu3iLbHby9ej0eNAZCPwwdEB0QQfk50AHRCd0QH4JdADtEl9N066AYUkprqYxXRUxaPjEZVA4FT9YPFPOZX+hq2yqti7sB37eXpw+3k/3flET4OHz
+/2D/SSv/v/d879n2/P8aP2/VbVn+l0YNUGLpkCUWAb29OO3xiu68HM7azMgdV3q30yMMDVfyx7TTlnbHCE/Iy9RDWPfMrwALI5FKHuscGX9ChVGoIcO6+hpi
+l1kxzZagGYE5wXLu5UdVLZ/v7cuTrDK17GPlYyInq6JY32HOK9hXvnuy9+13cneH3r7TI44QDj56w6MuMSR6lykvmBPl1nYDkyOVL6GC1Zb0+iMd6QvaSpyF
+1cjYRS3NnufeCUmTKXPpxjoVokOQAe9YrKyAwSIzXFPILvYGDUjPCsGqR2H1I7VGGTYgjIu1ojAE0aFbw8HW8oEUqXwsP0J/0rnMfS9rw1OBrEeny1IS5xLN
+mVDlHKt/TWC+dHCiRR9CqIfkJumfdaUKQ5pLXjGqLhsGH8sLJ4K0j9hsa0k0BeZrrH6PGiE42Czp2/7QSaCfhdoakXY4zJ/rKpt5PZXwjcLSr0H9HpHOhO//
+fkfeWu56eAkWLMClatYaNKrGd2SFliw61Ltu2qRwXeON6Q5MjQP5WA7nrg41XhGK9OgitrhIZ9gtPGGFWpFDOigZnwTRlyYlHSn/ummJt9K2gouVM9pEI3md
+WRK6xuvpxa7AxS9K4neaUZICDeG6dLY711Y/8MDMDS6uat2gy8JchJ8osrUj7KeH8rGbUw8OQyxCKbMiwviwEKPwqnVe0mCT2lo0Y2delYA9BKGkYxuPQnnu
+Phwg+iJht1P5Jq6/Fp/XmYeaTNu+Y8jA5RIgPu6VpdHxRPJ0Ie1U7QL5wMWRw8LLT88MJmsKN/kjFZ4OhsHWbgA37iBnhGvibanNDnSKm5MVYT+dzwp3WpLR
+Gaz8zZtyNBI29sm7IPEttLVp0iahhWwQWrh+R80XzeYnbmxgLTSc2JDbIOAGiURu5PO2gmieWxKHiLnRzz1iIInYIxtvK+eOLKGsmEGBWRPKuSwsj4M3LnBB
+Pvn8BWm16+Fy0pAT0Xx2K4xK0thmCZRKKLg8nWpjWtt5o7i8e82g/Adzhnxw+aYYSmiHw+N+x1O8s5fYAsEtsBywdOq7k9jOwOltiXCpOF1sJ1BUlS4cFm7D
+JoAr1Z6uTTkhdY3UHzpfU3JXOC0V26Rga2ACENYF7O48W8XIFSq8Kp6fkZvUWEJdPLtiXOk/vNJqn/nT2qkCB5MDX9mSffcAOJgLNN8aUycUH6L3d6+osoqf
+KcL51UFTGz0XOruJqjM9xJX1VD6Wl/ErXYcxaEczxFFpBYuLVU4tdySRgORrL9WDCaTdLNWp3UpmJVPS25NbR6oWnGePTD27hUVTvhcYsDRjSES1LI22YAQ5
+IbkjIawlrKBbgcoRY/lwwXtyPVZJgpFfLWcYEqEt3FGSuQIKP2Zuj+R31aXURU1qaGU0we3xbHmhGDSoZ1kwBGFoT0p5mCKzi1NVu7Cqaiy+Jg7RUWBEpCWD
+yR3dtmV9iRmTQ1PfqVPvp/tgujv+Yn4IxiLnZXXNVG4mEtBi2l1VZcRUWDEPnmjZdh9DGKff36qV4f82dblc6lw0NAwDhuWA5TXPH1SfS4gNzM6oTePSsYNw
+CD7AuxyS/U22e0imEe5UbNpH6xNBQaiTE6i6dimnJ5F7FiCbies28UU2LCBYexvXonQCMicXYI5b2YbkRkLyzXg/EwE52DJBcHBYnPccNCRQlxcObVPOfdNZ
+Wuem5LaiVLO18RVpaaMjOldT3dwf8DMc2g0s76aChzGOqeAdDekjg+DgBBuQOE54bJfjCfLcqoUGGwAQPg2iZid3jGqAUsonqTz2kPNyLt+QBlumzYdD9Z1i
+PGG0PpVv+69Ho/8izvp/RMGWiGvExrU5op/4FDQmUvuXV6hAMx686o8xuN9SjghC0aRRcTI86p+evk34vW2dGKfN4vqFUi4vB6S6IgHRC++B38qLwRg0Uzjw
+Hum0yLP++flg7BQ5XqH0yeRSsDQHiisEmhzcnrCRcVr+47LqyQdT6nLXJpz5la/7x6Q8QQIwg2OXWeY7et2qPPBdIZV+MTi6lI3RTjBHfzY6xr4QcQ98ysHl
+yM2J5r0OgIu5j8Gr0fD8Fc3BSFcR5uCp1XYQIsyx4DfhXn0iotormHwMojqYwWGqvw2HEBYuzQDpJMZPd0Kl/TUErz4aTTA3g7Imva78zGj8gISKHd6Tq/H5
+cPIaRaF43vYZIx2MfJjm8TALUlySr2DeMcICZVN86ql/ejp4BbDuiezLl2PUYwoyUBanPRxdIfL9+IrHvwWSIJqi1hQlcZRPgkMIKeUzYsXHQyXgOAdByv22
+tdjiamRayFWBAtnwkFVRZwEt/kwCrDPTlViuKrNSlnwvM24nfYbyl3zCeiZ6Nq5mmp6lA9JTJEYjPns9a4ZSrAUIRoprRjvw8wwPE7AtNuseWwpC95QoJmyH
+qsMrCkbSsW5mRRiHDFVLyBJY6BnK9DpAkg+WqNpyn4LlSdTgXeYLdgvc58sOSu/GV8RQoyf1DMTHK32jkEdZNM56K+vrj8D9XhLeaUuvGPeLLgX8N3XjkRFe
+csY+xMgn2P+nvSRqtXvJM5RTiajD3R/F814ScsAar3Rh6eXrh0aClsDzBjM5J3D6HXLwjv4wSNXEjKdN5kPRwXzY/iIk3QFRTz3j2Aswi2bQ2UiEyyTCETTz
+L2gm2AiS8SKD8GSTOA5SjsJwIVLi6DgfaLw0Kps1Wk/g66pBfh08RPjlk/GaRc1yz/xs+XjLypU2ds5PsYkzW346Z7bYyJndxV+ZyuHc+ZuJD/NB9kC4qSbb
+rmFm5LxcUQIF1/ydyrMZfKNc3c9XOa3cgLPX6v8Q927SORTRMKCpvFBFoVnwflGaWk7z0uh8LWg/XilL1hmy8sZb1ISUoLF9zW7GvbRtIP21QDCIOH3he7Ca
+QKNa1K/JRNAqjc8PGiNqgUIpSzcsDoGzynNt6oiauK6pXjJ3VKXt8UI3YlnpmsRnG+MXE+aLTjSFbKMpHoJPJBb9JGj7JLeB6ESZTdQmYHPNAgdX6Xm6ieUV
+vCfxIZZXX8wYM1UHn7kfhHEF+Oy4N686pgRMhjK/8/u1Wz+ULARwUjbLVAVr3KFUHgo226qLD4eAI85W3ISIfJfighCxUEWt5FGuKiWPyhUoXIdhY3lugxhW
+AUsZk90UgKCLokBWxUzONGwECLHr2IGYuBM2IJvV9DHFpEul1rPj35X5qqjBcywruMNJLwqaBpHAyBoTJeUcS0vh/5UxujaMi6uYhNmUeTbjgImdZg/qb4VB
+TvSAIQVGYtA4LpQX4THAplWuo/YNNutGD3Uf/K6E1XotswJWOgG6PbM7eOzBUdyeZAw+dz45fTUnHZJFyQYRfJGVqdmKgv5yuPhaswWa3WnHYZ/IQt9AVIig
+5Dl88LXgVwYc9k27p7OxWd3VRhHIGCUbNB7KiuYZ3MJtDTnwcXR5FAS/oTskVcz8GzjIBJMmMN5wC0W7cI7zwk3OONfc7JsXcwkb48K/Aqm3veg0SzCl4m8Z
+/7X/q+K/Dp+38V/7W/z3bwP/tb/Ff23xX1v81xb/tcV/bfFfW/zXFv+1xX9t8V9b/NcW/7XFf23xX1v81xb/tcV/bfFfW/zXFv+1xX9t8V9b/NcH8V8YPS5z
+xTHBjaGTLryX2OK9tnivLd5ri/fa4r22eK8t3muL99rivbZ4ry3ea4v3+vyfP/3q+K+9p0+e7bfxX1v+r6/z/culbsG5/nRx2osgYDEbKN7TZIYWTdGvOKQJ
+mYtrDfu7rmri+1cGThWbbmxrA6DfbcIsPvwm0H8yfMqWfrdfBHHqRETk0Q8pCchOJYH9TgGq35sEVvK1NLBIrApMuVCZyN8NzYheIxdkUsJlc63AGzLEoq1n
+lCktA7hPyA9LUJXMLHO13ihmhSfICyE+Ws4KDJ3m3JdzbgPnNv8kR2RKXhD3K7xyUNxlVYnmSqR69ZdyqdNpufimB3CoKEzkpqht1rXGxvPA6ZlvJ6KmUNob
+2xYBhXbxQ/ipAENsVyQ/YNaD97ALOM0V9sXQET8jmapztdDGOZv28+Lbyqo9Ijj4oRYVGiuzsjKINrDSU9wz90Wlz32bh9WmPAstzYLWfiTEU6vtrpuK7sbS
+xOPyub9VtSnBJWytZS8z0tA/+w3NGFJ0r/TjXmcXy/k8m2YqpzZUOtcKs0uMBcB8L8wf8EurQuWisTgwEtdAakD/dAHOj7qBDq68VUvyKHomDZH9c9Jpqerp
+rUaq5M4GGUw1Ba1nhCG20gmo8CPJNqcneh/eyZp4wv9r7e7N1bXO6cBZFa4J0YilIu5llOufqqpaeyE2bDs7YK7LWeEmVSKUkYiXsYlIcKFhttWlxanCw2ix
+GdqvUyF8vu0hibSXb+WfRheDiMf/Adk00S2bJj9LNu1z6fw7ZNNEgyG51adPkksTDyd3PlUuTXQNyWfLpYmWXJr8fLk00SmXJr9ELk1EcmnyC+TSxEfnadg6
+dDsmIl0NhVOc7CNC0i1yubWNwlm2gDXnMcMmRgu3z4XJUk9hlYcJftrSwC7y2AKIrS2WqsDAzRQOkNogWCgVv0P/7+BX5X/ee3K497Tt/x1s/b/fhv93kO7J
+lspM2yEU4drcrRck43oUSLiGmKcPuYlio5sohzVehVhXuFTONAQDFcUF5auLU0S2qDqDCPz1GmNJIETqEUMnkNzglp5MTnpb3/Nvw/e8jEy41oR9wHGRHY6L
++ALHRW5yXJ5QK7vAqi1BKQaDZbUFYtqiEASQCQSQQfYY8Hp1+FC4a6Kru2zKCLZds8AE9aUHteGIlPPW2k7llSuwQZnCaUkJNUzpOTPdZ5Z3jdayy4c5g/f0
+yFnjRCXhcXEVsPeGxXL0Zabom9a3/o98fV2yiQ5fKCt0UTv0oKkp1YrLbl2uuH6K5xy9jWfgjNNlvspqa5NvbfKtTb61yX/PP2T//6r5nyd7B1v7/7ds/+/L
+focmGC9HrG2FLLcHr5O1RmduNtMFGvKGYl7uMbdlDoAvKxX18XmihxwA0eUAyL8VByDaIH9lP6CzLWyWJfKz2vKzuwOU17DR2ObM/PruQNQM4RfIb84ngBh8
+eyVvcgyu111ugfsuXT3+PXgEYGy1hf5+B25Bd8c+sfBCfK4f0DUG4rP9ANnyA8Tn+wGy0w8QX+IHROKNPfEFfkCjhkI84Ads7em/nZ8/6dkvLf/8Ift/78ne
+k6b9/+TZFv/1VX4gfP9DtpDH6i7TJpE/qGIFht3+d989FUT7org6uMVolJH/jWrO+ZpAtP+x0lWmLY/IYpmrrKiNNLflKp8BEnleVveqmpE59UO2SOnNf5iW
+i1xdp+X7VE3T1TugtZrTSY+sC+4INg4fDu9O5DICNUTt07LSZpXXRniqGgz//s+/6NnjqVmmpl4/+tve7v6ki9kvnQD8kP//7PBZY/3v7z/d8v99lR9wLGAO
+yEFxkxXaMwDe+fTfXiPdt//dd9+BGvwB3XkJvBNlXt5k2ojTetad5xPiF/CxM/Mzu9jl/OfMsf0WUmy/TobNyB2YGzv49p1ggu18pFstPh0e2MqudU/Ok04P
+3B0kWCY5rW1A6g9/0cUM0lXkh7eeKfGZcEay2L+EikdDBVswioW+t2vJxRr42cz2ky2oUCxb6FQO1PTWXu/qwMBjL6TC8c6KmxWjBO1lxWpxDfNpVEy187px
+/rpoGjfOEYRQHUk2XeWqcs+JW5eINZ/+Kr9XaxNVvHGMoZMCTtX2ialkA0JgsI7IbeztXJgTtLbjaXDuB7Ub/FwRtzTo3fV60yc6L2VZaJ7U9a0q8ELRvvCW
+UHs+MkIcj0G7oEwtm9oCP+4CUSQRLUpEw8I7KkU1xl+IRZVNLOoGKGodb+sJ22jS12WrOkrK8izfQcTnZox2FyL7IRC2+BAIG3shd5rHUGZaLRYbW/xwYnZw
+fiwvB0evz0eno1fDwUSeXh6nv4v0bHfPPiIQI36OhKwdBPGzJGQpECN+noQs0Gb8HhKyX8Ez+VO2uK7UY+BS+8U8gA/zfz9v2v9P9w639v/f/d3X+v4P8H8f
+UjqQ03Sdl/+8/N8/nhFJ9s9EAH74ZG9fvs7y/C7T944E/ELlpeznddkgAT/ceyJ3d6gJWxLw3wQJOH2MLQn4lgR8SwK+JQH/LZOA8071WyEB5+b8tZKAWztg
+SwK+JQHfkoB/DAm4XTG/Fgk4vX9LAv5LkYBbS/hrkIDzu7Yk4FsS8C0J+JYE/JchAf/xDG7++iTg9N7fDgk4t+drkoDzK39nJODcqy0J+O+BBNxN0b9dEnC2
+wn4PJODclb92EnDqxpYEfEsCviUB35KAb0nAf+sk4HzsbEnA/1pJwMOg5pYEfEsCviUB/2XwX09+PfzXwZODJv7ryfMt/uu3gf968rXxX/QKj/+61O/BXg7J
+unbomp2eDAFa4ksBWg1c0BcBtOTfDkCL58QWoLUFaG0BWluA1m8YoMU71W8FoMXN+WsFaNmD+q8RoHVuAVpoXWyxWVts1i+MzbKL5dfCZtH7t9isXwqbZY3g
+r4HN4ndtsVlbbNYXYrO20KwtNKsbmvWn4dnLcf9LoFlJhM0STUjKJmwWvfgjsFkCET+/ODaL2/M1sVn8yq+MzUp+aXAWd+v3A86C8QKAlkCw0y8B0Ep+2wgt
+N1H/dhFabIv9HhBa3JW/doQWdeNzEVrJFqL1KRCtZIvRshit5LcI0kr+VlBaye8DpuXILv6mQFp88sQgraWqMPxVVr8IXstGgAVGgLuhWscqz5VxKC28MgBo
+ySZAS3wBQEvGAC3xZQAt2QJoiQ8BtJLNCC3ZQmiJAKEVhjY/BqGFL2pCtMRnQrQ6UE/iMyFasgXREp8C0UpCjJZsY7TE52O0ZIDRwg1HfAlES4YQLfFFEC0Z
+QrTEF0G0ZAOiJbYQra/x86c8u/6lCcA/wP+7/7SF/9p79nRvi//6Gj9/ybNrh+OKaX7/ca1V9c/yH1tKH//MPksoCOrSeo+UeZyZR/7sIGMTw+C4w0EYXs/k
+PcUr1ykAo4qS/W7cejyyw8A+dqvzmQRnLtdO1GSmFuoGMwRkYVgTUXQxUaZCXHhu2Mz73mQKl4XjJI1uayqohIcpuEmQkFO5ZQMNBWxKqXJMzNTsbofp4pqZ
+LZNm+trTY9osIDySeIovnch5N8+mtSAWmXEem55976VSSEtdZQvhhE/uq5KjG04+3XgUBJGvtwcF3QHmG02EKhpcnjbaz1fEzMLyHljg8WxaLiHDqWqWkM9I
+moZTXDPiRu7DIOqZTXc7Fl2nNw/k8vlaQjSdPDY4WegbBIMi4kGRyIlrAQ/trttsGE5xFrsKbLRKL8o7OnAVtw8nH0wUbmhMi/zbXv/Xf8mWB4/307306S92
+DHxg/z98ttfif3zyZH+7/3+NHw/03Uufwt6yvyeP9VQDo7Q82Nt7HhwK//m/gfr92WP89Q8rAGvIiQY1Bz4QllV5U6lFInFWEYTLRj407OHXFfgqeXbNF9jU
+Y7RLkHTa9CNeu4lp/rem5fZ//5//92dimrdSe5/KNA8t+Ns7Q6DXv9YhYkccBUfKeWDUhKfJR+jKWQJ88QABvrEaqpsE5tKPECvrX12+Ho2jHHHAiy09K7b4
+YlZs2U6Qis9kxZZtdTLBPfncTGPAhC0+V5JsMxO2+GRJss1M2OLzJMm6mLDF50mSdTBhi8+UJOvKFwrLhC0u+pCen7yQl2QtX2vaAxdrafcOzQcPrl17yFg8
+mIdl1LiChcpvyiqrbxcmla8trn5oL79Vd05CCHeOQFQQvRp+kDRaVdNbhFRWGuCzciinqnBCi/DOm5VCj8NvBLi7Y0iSMkO/Afvv2a9m/x0867D/tvo/X+Un
+ttt2cDrsbLTcduyq2vlI2233qGeNt/09a7yN/8rMt9+CTtDfnvF2+OsFAJ6kf52m20ZRk63xtjXefk3jLfLaE/lng//xBzhK0rK6IbPtG2ey3QVBiWewAp/J
+iV7WGJQQcJJsk0I/1890VeW/cv7nYO/gsJn/eb6/zf98lR8v5A3bz8VgfDacTAC7ej66HB4N2sqPz+RjebC3/zSRx6rIdC4ntS6udXWTiH+c4W/+cKvev0+N
+/udUiG4DL8jGUOIlaRRqd0nNbkrLiKbtN9eYkeJ6Cs71JB1mX7eVJhxGw5/B/CeuWEATiAomALv1Ybx98iDYPglOaeGg9H73bh9QuOE+eCA7uLnYfBp3QMdp
+tx8f4/VvGVjMB7VoHNRsdEwIjP1hOXg89hN7BDs9rQ0nXP9c9glEHeCUEzrZwjMssWeYOBmPzpIAYt0BxW5CqN0hZ9tyPOifDs9fTQC8Hl6cCsGFT8pYgKat
+dHFZosQLDHMRfJw39fApYc3HrIh09iKOirp09iSVz+Q6kSuj3VVyplUOIExXKzbpFsa0NiVXy/8lop9otnILuPib+oGyVnCffkX95739J/st/beDp9vz/2v8
+WHYYLBlUhZyVqEOK/75XluUhq23B61BWem5jqQDdLguTMczeFoUiig9AXFNttrvJb/tndpctZ/PFL7n8P7j+Dw9a/F+Hz7fx36/y0+dQqZ7FMtCZIX4hC2F2
+xvgsoXhdLc2tDeYFfxVMQfEPxJegi/of0CRK5RFyIs24pN+G8IJb5T/U5Q3hmuEhIQMN1XYRq9LsH7qoKwxzBWzNl0/70UeleXyQ7v2K5//h3uHT5vrfP9ye
+/1/lB+LcMAdcbbvdBQ7SPUb14D6QGQfthsvJ1xksrvUMXOqjsphnNyuiXxtREUJxIydrU+tF6oMIgvNB3ybwv98lgDDaw//dx/89gESLfK1qov8TApvGYuAu
+AvC9s1aayErW3GfF9lCgXkQUS6/Or+QrXWBtSIPLUJlYTh4uP4G3O0KeEyi4IkY2W+3mBk2Wldwl86mS5RIu6hE1h6r9dUHHwv2PMye35VJTkCLz5UUro7Gc
+C/ZeG0ygkC0FFL4X1ucDIC0+p4m0hc43pcjLamPYXnCgIJUTrT80anMc9UrLma5VlkNQBEpo+YjAZHqlpxqSIewZf8yncJxGOFzfy2wuixLKX6qsdgVb0ceR
+/uNYBsmn+/KkUsW7PCvkpE7ESTavb+VJXpZVIl+WpoZLz/py72B/f+/x/uHevrya9CF2ZaSi9IzKmaoBH5vN2f+mkywrTK2KOsNSYb1Y5lSpRRR3CzWtSiOw
+difPCi3nq4JLUX1OCJ6D1SM4rYllyf+F0QzFO5oO9a1w9GTvDEcJiMsDfyWvldEzWRbhs91/euoKYESpjc7nYqooyQefyKxyXLn4JCojmZZ3ltno4e+VCoZR
+BLxEjqMqNCng6Dc1T2zkPPR1yFGxHBkThotzdw97H540KW9a7nv5HnPtoSUEolGstCK2v9t15wAKajIGSD5tMEQ/50q6O+3LvmEocO3b2rGqIrPoek2BJeJT
+jLdBVcvbul6++OYbGlWTVnp2q+p0Wi6+0dPS4P885pd8Ix9//I/4r//1v/7XwdFo8uri1EXxBufH8OutKfX7/9GvdJG9/2UzQA/bf/t7B4eHLfvv2db/+yo/
+g1eD8+FP6dHoTF5cvTwdHsnT4dHgHPIDr8YDzAoI4UHi++leTIWkwlL0YOfU8mJd35aFPDofD92J7okzhJL32Qxpj6awU8N9S108tudG7rZRik8Ni5pAFcgP
+gk3YaT11BxvB5fU8s6flIhDdn7zL8pzwNhNd3WUQoXq1uH4td3f89Tu9hElmpQL/co6pn1peKFOX1ePTUt8+ntRVKp98m8jjx0/2nj97Ik9hGy/mOp8l4pWu
+FqpYJw7XM4wopEfVjSpsGH7XdkPv9JgCkl4887kAYempGzgcHquyCtFQeGtWmxC/FYFwxO5OyLe103Nsdo4P+lOYoINxbthvwdcOLgrzcsZeq6Vq0jrHnM/3
+ZZXPHsOUEQG9cyQyqfL1X8DW0aZO5FJXdjDAI4CSdKjMRdN+CsVXy0pDhblAiA4d0mhRJYFJnsSfQVo7yZMHIs2jY2ELH8amvs87Jp692WUgN46diCgeCFnW
+plZL/Ktl+9UtqkPuMtoadtxduZPn+Horws+VganyrsmOLiPmGPcREa3nEOTXymQmFZOrlz8wmxR4LF67Mchgvnk9PHotj/qYd0ZSr58g/QmJUL9DeTay8QAS
+poD8IQqr0Th4WNKBekoBKfWmj3nXwU/9s4vTQSKixGqfGJ7826R7G2RKA8qv87eN90PeNkzsNp2s0Th2ss7fduVjMR/ZvxQNxq1IihKbazO2jBRqpWstP5zv
+VgQkEiLoI2Vzecw5YXs5smdAg2fqagKZ3Ua7hO1SJxhrM1HXmHBTARJLtFm6XLpYntgbRiceTuW4u7rpukTrBmJS40e7rO/F4OjqfNgfv8U39IglazyYXJ1e
+Eq4LW4ekaW9ZafR4CMCrl1fE4RUSrNkhOx6Mhz/2L4c/DohgbXTioFPiAegUX5vKyehsIH+4Gg8nx8MjmuXHI/xSyFCHN+IiAbQGZNPjz+2/xsZvkMgJIQn6
+L0c/bn7YWf8tvfbi4vRtODkcBVxE3YVHc+sEoDiGWtXlQtXZVOX5usl0peRC1boCZ/u60mp6K5gIpOv08fxht1k1kxcqZCrtYymmp8iMTr/N56bdqMO4Q7Tn
+UUwmOlg52m1gY4WedJpF/MuZCLfKTl52VbefwJWorSEVzrENyFhKoy3TrWnxvEIcLtPu9zUOHfIwCOefPsjkeRwVtjKbFfu/QjBzrUNFtCcBkUgYqpWtVb2q
+y8oRkxEi2qwWujKEUeYyimtNRBYzR/Pl3eVKTev0Y17tGEU8RQYxpHIi0/NLwbTrZHsqq4YoX4elCbM0OFqZ1clTG1mj6YEGeuqmuzKbJUyKK6iZEKyI+ZwS
+ptNwr3APcokdtuKY0chxjAo0oHAaZnVEhWRDiXmJdmeGzNBEFwVBMwzD0WtDnL3IjFyWxmTYqjZ/U/eUKGYhrZBjGRGOZSTiaaJEczlv0EF10Kq0XpVu3Jvc
+iN1AdKeg2UWU957TiTFfzFZmBEeAkCpvLp3Fr98zRUAHG5NtYyqHdQwGCl8csD6d06R8iLMp5msSEV/Tpp2jyV+7bMLxghktYh5d/Bcm9AyOiKezlcYa5Q8A
+4kVZScN+F9GF0lJx3GnBnoTfS9MqL3MAOslcFTcrdaMfWEaZkYPiBsL3WMJgXyBulQkj0Eg/lNsDAXkgKB5oX5HIrEY272tY4e5ogJVon/kIoWB3ush0MdWo
+tkGb4rdpsEuLl2snQoS6ChD8xv/AwDF1LAJ+dWgR+X7gsWA4NHuN1G/X6+AY2eCkdayGk7KS/7HSSILtaRg3XJ7IZa6V0S70/iJyEh50sCU42EJ0eM9CtL1n
+Yb3nbfTxa/zcTEb9i8f76eH1r6X/9uT584O9Fv7j8GAb//tq378ZfAgCfofXWApFl1H0xJmomStw8hSNZ+VfsjxvKsSJ3bOL0/10v0eFVcbSsaKZd68r4sir
+wwe5uiy6U9b6PWlAQFEIZIvT/XR32kt2Zz34x4H/x7fwj+teIvf3KICzv58GBOrfyntlpIK8NQmhODvJNUtVmp5/bZ8N/wF0/LsGs6pwOf7mqdzlljOno6kl
+VpkVU92jtx+mz6LbrB3iDH3gD9JO94VG2rkvSzV9h8eR00TxmS2WyDSlWk6XXPZ8b2b5wW2UfcvLqWIL5gau/cZUU7yW/gV3JGDbkIbIDZrzbGJ3PJoy/1fH
+x8PoJfSs1WyWEa0HtuyNvsbjXlfSKIDyRLfQr8w39/qaLkrlpafPd7Ae4yTNyjK3B9xiWRbIDumJmCCop4J/h9PUlhXS3aoAm6u85qAaTrn7+/v0RhfZXabg
+ROO4szwenAzPh+h+06/gtzABUdhkP5U7aI2BT1RWO3KhVWEkOK9OdAwdGfQ0DBMs0uX+i+Nfmds5lmhC9bX4HVaZ0b4LH1AuriPWYS1HdvUclTMU3cnKSjT0
+qgwbujJ4vA9ZxxdbZTrsDpLWTle5qkRwKwmaYWvJ7IdXh81stKklMVRWzd6I7t60hPnAlqJRnyqjA7UO5wqDcaXLOQk9pXJnkOtpXZVFNo2cWnmmAWSWmYVt
+uJIL+yteGnmQsxBOhIeX60zf6bxcMpEzUikHwFjt3+r4L8u5nKlaBbPrKbQPBQrBGbMNCUfVxnyJYxj6Bh+mkBNaXXAJ9vRZKneGRVbDajimlukq/CSxtCVP
+2WwG/4E7Iil1iNYzfGTAvdCWyLildr2Wg/e32XVWyz625nkqd04VakG/Kat3foQx6050vfTxdaO7ZdX6kgQNmPKLWw5UzNd+GiaTcIy/TV36yI9HZlyoCBv8
+bbrvLgs/BeeGnFabrEvypBK7pBfqfbZYLdjlFt4rtsStHP9HQk+e5BkPMj7Jst4SFybOOBrWRDS0NF0r2BtZM6d8VmAfvktjSUfjhr1YRxTxRF6q8Z/uEIYm
+1IjEgPbU1WqKgY9yboFPrdXppJotoXi8o8k3t7oQ8VyG7RmdC5xvCk4MjlIhwAZ29YYk5Ash+sQF+8EewH6LBwWW5MADbf0OfELbdOGmWXezhXhJ7yv0PT2E
+9nV6kmnpara3uo0P3k/391K5E91hv1G4vDA8tliual357QbnP62czIhIhOtTlieMe9xkLILDByfNuZrV7oOF5Lmn/rTFSE5eaTVb23VMAxyv0mhh4jDAcrsg
+WpUjoCqIJivzrSCHwa7pJbIo72V5X1BcEKa8mte6Em6lBOeAC2g5sZJELnR9W1JdIATEmNBiiYHclaH3mERkRfh2vxlIm8Pkkw+MzJ1gwMNtdllplt136kS3
+Ot7iEECHeTaxiE7FupRZ3VSgWpSzFWLP/BwED33Fy9rFp4UXNprpOe4wZWGXFUyWZW0ckQFHWhiBphz3Bscp3ImsCumPJ5zcoVXncN+ZokepKkOElbqBZtbB
+ziE6dg7Gtuk8R1qfIgkyjfGZYAfRGSCPjCCRQXIywsk/VYVVxcA2VdoYmjeqmt5mdyrHL+OTtaQuuwRxrwpGUs60vY81YGf6Md0L3yRkQrWwBtts1IEtSjm9
+hbOP5goYdSAeLXfLCv+r2um5qd4UnGYJAT6dW0KAAhdh4gzjfG1nfHhE2AMxiVcrPn++wl2d08aiGTPKjFk54TKvm7JPcshB4zKYVdQvq96FpxW33J7wOMvA
+ZjP2HzluCZTNNk45brEoC3sFZWNQIO+kS1bXz+5E7vA9dgXuqh6twvIexmmWVXpaEyST/huPbY+CpF/yZ16oQnm1Zox5Wx1jPstFkIqIAnnYn93rHm5SlZWu
+QJQsWmvzbF6v5VJXU3j67tO9/96zBm+5qp0sq7lVFfkO17rQ8wx9m+iRQasYyiEno6sxVG+Pjgc2X+esH/BscYW0zTrUCOfAa/uvMXIDlR8DXEbykEq3TTAl
+MWu/C/Zu0NSjTfiFEPANaV48JL4ndwNjmHfsUHKtF+zf8NnaXbTl6AGwxNakM4TEQUuwK9wt0aEjHm9uux02bK9FWxR7NYLBK8pj75UMLOjv0dEWu9d2bKjL
+hk7G4Ez0x2S04Nr9T0RdwjGkE8Jsg9uXsEs+kwZ25d0dlDqVdUn/hj9kC+jaTi/qtyDbJ+5SlylPoHYWwYtzVVa6VTBlfO0AMZAIU9f5OpbM0wUeFDA14UvW
+WZ4BJCiA6YjQWabhxyljXAS7w35r9wHaFD6po1tuvUkJ3Anhv2Y9vxYP0vAMcytw8unLJCEXOHxarMn/SSvWhk9+qbUXBR0+c9nh/G8su+hbceSFYlmrxuB0
+Lklh9YsQybQqXHAQwUxJOGUas0I1nFZavOIXW7yNARRfuGyDx7nwb+cIfcRylcFyFV+0XKNo0Mbl2hEf6277h9fkYQDtASTNy9Phq34c/zuE87PvWd+DRCYf
+nfEcJLvnrdNkxWVRhr+2zdekeMtekngolvGwd+PMNNxfLhEE6K3hAB8Yzdh2rScmNZtQlZaB2GFENpvrXfzIjAShP7JcnYY3dUtHVULBc3AOAK6gqYgL3SMv
+M9ZnT+nRzNtGi4BkCEqONPP4kp8XjJOwfaFpnde6YlUWYucn347p/2Eeb+o7Kyt5Se9Hds902mUBBaltrhsIL+WnPNuiwJ7YMFRwAT+5U5D7MH0azGJg2Ccn
+xaEq4gBiv6krHOymVN3FuAlPrVMy3LlhV4AXHTzb45RhvpsusDF/12bk7hAizMeaXFce62ZExU0lMumhjdE037T22AVGkWT0kYUdbDvMVIBs+BPRo3hN4xxp
+7v9400zVmtzmNT8g7ZrtAJXICoSjWFpYjxM+a8BsOO1mnZl8LQN3BhIhGP+KLVD3ka7X4UcS7iOxs293lbrBgNgZBLa+VbD+aD3DucahE448ZUUcOfDLpSL8
+VQunnhXBh7ITOkgOypIOxyqUBQy/QTBtnmySNz9DmUGYN9CXEE5IISiEkUQ2lTKecZj3BpdrIk0lFdpsj8zDdlOYnqpL69/rILArAr39lhUT7acGnDsYloP0
+IIkuC2ebUJRNhTnu84rh+owK7OusBpNj53Twqn+6w5/Efg6DWxsaoG7GY7dD1Dj9OSukWUExRYYYJCwUtePn9kaBQFEYX3l/Wy7copzWrQ9BCTzi/Ay+CBlN
+EF5qRI2bpYZq4y7ZGDvc50CfcLGEpcbVxbg9wZDQOEacYPHQq3faBO+tg1ilQ7+h+hXbNrVeGrmL3VJELjXHmE4Yj1qojCBRmUGtMFHoe3NTlaul6YX21lTl
+kKljtZuswJ2XcKL3t6WHRAVLh05R/DAQ7vYj6+BQNnWakv0adrZ/MWwtmUeNqHd0tHk7SjAN8YKQnDZwCWO0ef2Vc+tsOAgrTlDk/N1kd0IyPthh+xfDjsWi
+clO6FVOTJD2MnkMRxxMAxmLak+NYLzV27hwJLSEfnAC8YjXb0uiZtFLFoi6DKfkEdickrIubeq3zTN9ZHf4HBh0HJP67g1jYDPSu6dmCmeaIByuXNyWb9OrM
+QLUD/HYjfgpkyrzdnZN0f3hmz1Y0H2j/80eHz1XYVC+uunLe3LtSAN1mtc1D2NQbFkqD3wKryj9WBcns2ELB858kQ7Pa+OQXehxF+wRvPRhhF+iJwJ8EprbA
+Cr6DMaOTuqzWPQgjVloqcNcqz4+cZ+/Yv8rL8h2mB2hD4HdgN71PMZPsMmF4Mfzw8E2tSalmM2ImwHMdWxS6fWyAcSeivdFncn3PcYGE38t/b5s1ic5zlwYP
+x5l6Hx3wbTtZRAe945PMlVVmjA59193pbVkSJBWNZcal2Ei8VHKu0RRNHEUCBBeW4DUmqCC8KFj/2ktJB/Ke8Khw1H27m55Vh3U/K6Upyb0qC5j+2EN5rW9V
+PseWkr9if7XZCuOEa4zEsKvtWRrjG8p5aIGxr+wWILTLXq1biIPgRkpjQeMzC8XGJQ3fGv3DQLX7MaCkMCyBZ8dC1zwRVL3hu/k2oHHRZTLOWwCB1vwgw8iZ
+ZtAqHGbKEVklb5wnbPWT5+ESWG3cepQfUqQSz6WYmHnJnMLvZsedrSQ27G3j7NLo6Ksv86hrvViin4SevpMgk5F3+cgIa1m2s8D2oV5SmS419FXq22YJhdtn
+GmG2jobaAfcDyL1zWwbGqGRWS3VtynxVww43zbWqfOSIxoxtTHxQ+zMgDBCXtPdFMWaUWGaLj14pcjgX67h3utUzE0IGYh8vDO45TnzHue/CmRsOs5f2E8X7
+JB/BpCNui6ICiUU3wfiNwXp/noYxxnBh22qX8O9URbO47uhZEGuzIBvxUSAb2ZEKCV8Jp46A9GXu2y+HhT3dpgqWmTtjFiQKW+nWLmPfK4JJAeLd8wwDpHZB
+bXAHvwUrxIssBuNEKgSg8WdLd6CJDhTFkhKeNhX3hGWVaZRhpm+MB1aurnXONC8I6uTCTDk8t4WhlyN5NDqDsrrjK6y7pNpYLLscD15dnWLkMRXepMkWzqCB
+HnLklNK+nPN12Mnu7yNaovvlgkJ4PlkczQQ2gqheSycSRXwxB1lB3lmgXXuzYsBAZBy9wPhA2LrN06YuRRs55cy3711QIYoD+Iin19CA6QqPsprFrDw/C4JG
+lnyuWegWuHP2NQjxC8xwry0R3u+y7S2VkJZ1yjzCceB8WZW4I7CPT0Mto5HlOq+gG8I2w9vm+Zr9al4BgWMNjSmrGdU7mneos0oy0ZCQqUvavjHrLDPeUp5i
+0efwyFWVohSAzydH5UUBjnkahdm6N2NwKlRdq+kteZ+iy9ZnNVUbHmov5mfyx8F4QjXgVEzbzHYjUOFc34fGzivC7eB6Z7IvCfgso2d28wXP123/cTCSD6Rs
+QZM2W+hUDsAj4esdZxfoPxV4IBowVFeZwSJFe1mxAj2DoKEHqRzglIUXNpo8KqaNdemLkxxdmT2BA58m5gJwhozb7lR+r9Z0wGXFygnhhtRpjQJVfqIz1wT6
+Ad7Whts5ShW0tuNplCiyGMcYftKO/D6jyK8jObDnW+QGWdottZnV0n7EXZqcax4HMGbFrJQYVZxRoozqarPaTWmLtOtCuMkI4SYaDnAvONNgT6w0umBokFor
+yZTeLlzeVspouYMHx45gywVKCrE6LF9Lky0y+MB8JcuWeYL6dfjoXW13HVhmHNyBrtsLyNQybVur5zZeX6DGVpwITDfsSGOu2Wc7lORm206LrjqUVO6eZFQg
+xxv0g/HopA3nDKMY9hEPebdBKSq9ZWF0fqeNaGrrxxi9Js6YUonPvdjNGHYnSxGRCnE0+nEwHhwTPicUDLg6Px6Mo22WJGEcp8bL/mQ4aesJiEhPwPLoMw9G
+4kR8RmM2MUbjt5H6Twf1gvho9Z8Efvch9Z9EfEBsAKgbTk8HR5dX/VMYkIuB57RInKCR6zBwZSA3AfD/D4DeYzzoT/iYGvePgUSj/2qQyKOrySUqAwANwtWY
+mDWY6D+1HRfYcUvmcDIaD16NcGDejq5k/+iP56M3p4PjVwOk6JAP6T0Iq53prrQ6CUzfwD3oIPcYX53Lq3NHYTE4BiaGwXgM/CHjwSB1ChCOywMfAqwO44Ec
+Dyd/lP0JmJHEXTGxkh7IeXF+NBBNSpHhRPYnk6szEpJ6O7pKeQKej+TRcHx0dTa5hBsn1L6gM6Kt7+A4P7r1mZwsU4uPQjhOED+H4aLz/uUVDM3r/uVkBEJJ
+fmK/7CN9RiiEZCdGIjarH/WsXlIwgp1iEiwjIUbjQBpJjgen/UCAoy2JFH7t1/0fB/LlYHBuhZLE6HyTUBIzckBbhvFvjkZXp8fBs2iO91+evgXelcFkMDhP
+WMnjEqYyfC/sNLT5pD88hREEzpvJhAbb082csEAYsn78OBAgd3X81s3llNoRsoqcOzYWGtzBhNSzhpOmgJboom0B6SyYRwn85tKTt9i/g3QW7lBuXN+Mxn8U
+k8vRxQWt5NHZxdXlYOw7NpZn/dOTq3NWCkG6n/5wbLcX/LD4XhQxEU5trDmdG3Il8Jej0eQSm7VZCyyUErFyYOmmHaOh4AYDdjyYDF+dD47hnQIof4YwyI9P
+h+cDOfiXq+EFvnN4Ll/3/9QfH4+uJnJw/uNwPDqHP0zoA/UnErZKZ6KfXx2dDvpjcdI/gjmGfEj94fho3D+5lOf9HxnOwqvwcjwilp7T4cng8dF4eDk86p+G
+Zv8kFeFA8ZFy+tYdbjiN/HqivQemIAiydDEZCV5S2PxP6S+eAUdHg4tLGD//TnjgeAD7z6Wd1v0jRwzFtE1uy9jEr0StEp/cKrtzwsThxTWxvpK46I/7r8b9
+i9ckpnc5GJ/JnY1jvSPPBv3zCYrf+N/Cu5EfCz+Ene1MRBTMf/xNo1NnfftFWD7v9C1p49h/wdhdvH47waYMz3+4og3arsnXV2f9c5wcKc3hbpMGN3RaFRPR
+Pw+3m/74suU5wte78ntQ0xqiuTb80+BYABsSHUmDn+DDhwaSbwrTLuDwDs9t0ORbwjm3Qq8u6GnT6g6FQ56b5yWK+IpERp7GHNPWcdCFLFsqu8K3uItWFXtD
+xGmEl2eFONyTM/C7yrm81tMSU56K+IrIuqXLSSHVAx5dzWjodAiyqInhDQzh3KlesT1rVtWd1SKuPVlTuz7uwvOocPEPhfqyShaKsl8c2FyoDJOgmhzWa70u
+eXCDF7SQYlFznGsHBdvwuQ4xcYzRXGN0FYgsZ8UcsE6Us2WwAZeTXKCvmy1VAS5Zrm+ghAZ9Ehz24M+PTCdQsBtC415JJQzcDspW4YOpFQjeMGUOKXSXsb9e
+O98H+U7qmrLNPUF6TPwNqeDPfoiunuZZzckmzjrWt0FSGzlyVrrhbvlpfb1uDcFmtIhwYC5AIlhSlOm0XGGj5EzTd3WIswX+paykawSN05rQaVXA4PktoW/w
+Pn3HsahwHjZa9S216tv0wGa8oWm6mAnMkbYYsgz6twxbchG4sqKYYKUB8Kor02PXE3JRAiMnyDEUrBbOJbC3HTwp2CL8JwzmebzMgr/QHP9uExdfKsQGgxvO
+OvcnikuSKKg3ght2rojsXG8YxwYuEf69HV2xuuv5kCjhBj8OTsGOSPAQxBvoGYCEHY09rZ1Fx47GpMzmt253zeQKDi88IQT821q56BSi0RhyDcIVF4MxuG6e
+StB6EIFT4VyJzbKu9jx53Yeuo5DcQx6uvU90W6lgfcqPtj5t71EKF8bYjuEZeMsd1IcwENZxcMPzlj8QWPwCLX6iLHxQZ9U+ObWnbDfvo+d6DKj8+I849MeD
+/uVr1K7Fz+ENgoZNB+8U2NpHk8C7sn7R4CdQ6rcWDHzl0/4bMKNfD18OrdnqG5mKT6I6lA9QHYoPUB0OJ8Fz4Ds9QHMowKgjFog9eZVOUvkK5joafXJwfkxs
+mHzFvjwbTo4Gp6f988Hoyv72AK3S0flkGAwymaMkL2AJ5NrRcWupBAeWre/gQhDj1be4xpCqQPDWmVqoG2Tzw0rBzTjRclXbml6C37tzO8qitxJ8eEjD/oth
+fqzfz+rbdkdEA72FBkyQHcQDqiEjRqUXkOqjLCzTyFqOP7ayMuakm1FtRBe/X1mYOsNMChW7M8kZ9M4BOuhT4f8MfsIJimwFO5eN2vFAjqENWe4KXlr7Akgx
+5C5c5QgHet+7sHNR1l5iHR/PkdpuSIFwkVEbvycoXkNTw30pkm1j7QCgOJmadG5WqZ6tvpG6uNG5Lr4xpVraw/q2XuTCMwaHpQA+gh+Uese1Ai3yX8/p2i18
+ysU2+j1W1jIuP8/0zIuOBAojxHZj9dIdGRzF3H1BLAtm+Mxgq+VcptEoP54H33LiC3pfSFPPYIzS28T+50Hw3+k0Ee7X0+DXy2VwPf2L6XTS2+C/p/6//z3X
+79M8Ee7fazWdpsAqWFVlhbfxf8FN68V1meN/ZkVWuwfNyukBfkfCO7lfLWfzBKfCja7TW/+f2OScOpTPIBnD/51npub/vEOwWnoLPc0N3L+xSLSLAUFmRo7L
+a10hgOxOFXJAcy+VF1yiI4Lags5LJeEXnRrxUQ9lgx4f7O09ie8QfEciXxGhj8yKKXlSxBALqX8rRSxluD3tmt4L/N3Ov2/4SXeE+NfzstYvsIQWcdR2e/Bp
+BEIxYYbD5PDOnMla6ugem/TshOqQukxzOKcOKcXaOly1LDa0pFK+Ks+9fI68gVkRPxrfGhbSMMKgUemW/lt7y3xJI7lzEXBguJp4bVWiLLTIYSpMg+0KpKF5
+PoS1JnTR5u//XfeMScRHfP9mCAiNhIvx6PjqCDYtCHVSDMPF+F++lcCf/eOwD5aHS0xwYEx4zvGIkjywRNt6zmyLt24T7VwLvu9DuRYJnXHhkeM49tkUcO6Q
+avbx+45IvnA2uQvpP8Dzvftwx8XG6CqFbcPQ6vfORr8CTwbCxfhiDiR/D0PfSQTek69HbyCBII/6VxAFhDEcnTN/OjhVkaHsPCwxDBMMzcwBvntzriHyu3rC
+JhaCdAJLVUfxyCBK7L2DmC5cbPYA0p1PJs28KVbLvKx/Tf3Pg6fPWvq/T55s+R+/yo/fUfe/+xa0/fe/++4wYY0+PFsvb8uFMvINZOIVVJkflXlWyD9CUGX9
+gJb/gxr+VqMkLvhqKPvLL1P2F01l/075fo/HvS7rW8vrFN8o6Bo4unxvW89k6DgGocJupc1BCgqIQuIY1x+ory7KOiYYa4CinTSqZ6/0JDxpfGLj0Um4hdBe
+V0YsVT299VFlV6TrMD5xw/3dRJyfaXuYE+KFmINgABqtMlH/3Pdalyugw2nCRadlRS4h8Z9Qv2LEhccvN5psGfks0RKGkG21iCf2x2tsHxIhDlIsjrBqf/yw
+BurTDgFjuQhi4y71Zbq2kALkkGwDG6ivRIjD1A5EWJNRzOAx6A0pSx6VLVTl1OEcspWnmywRwVy1EE9k/rMWCCnISLjOPqdRzUQM0LyGXRZCBQScrXnc+GzN
+T+ENQTcxgy8gPuIrP/Qt/YRilUAYvoAH/kDWt1W5urmVT3DIWOkhAmhaCGO4Ct3k3FEwa3fc5tN2Ur2yJn1wYdGcMEo1G69BfXiu7n9z8sTZmap/Xf23vWfP
+Dp40z/+D/f3t+f81fpAp7eS0Q15EbNB5QwlMQ3tWsFXQRJIIQOmPj12eWp4OX47747did3Jy2kuomM7vCEl8VpLZEJRgMFcdBf2otNeS6cE2q4xEMbMLcil3
+OCxx4T3MwGWcovYwuox7tr1HZbUsKzqnXVCNELBNUqesZmlSLjqjmF2Z5+U9/KZNsS+Hc4nFJYi6pCF0p49hJKxj1ueig6A3dMrhQBtWUn65FlkxtW0ubqLL
+LeoT47Fl5dvevLAuCc1p4OSg3WyaIcuoDelSA+3Hx4YCKZFjJhjN/cA2hzzhtNuCaAnddfI///eD40/kOiLkt/bRPmeSGa6J9qj9gC6PJxYrwQM7sRVCGM3l
+FZAZvcXvAQ+QhaY4sT2Fu1Vrfc+CYh8YZuGqV1xxnyv8mXFloOWwxsi1aaEG/MNFa/a88GS18uKBFtrWWUpIB+1dEQMd2jZngNjFubF0U0tHLIXx1PaEBhZm
+zCfcZsHbxPI3X+gql/2qzkwd/xVNnv7MWtKEW6bndhSKhq19IXcuw8DRymiwXavaNHefk9NPmHDyH4PIeLbAg3BaLv4ZdhGOFOG0UTfa1wfZgQNDrZYFfFFT
+O2XAYCly9dCfkWDQ05InQk3VTC+yaSIXWZ5B2RAzxAK0XUMQV6KVpHCT9aU6QTA8kPG47Pp1UDRI+AwVSmJZMLhvL6Tnha8aSPD6oDSfZtUKASj+Lv8WUpKW
+c3CriAJjQ2vdMLIP1CTO5SeH7EbWg8DdODorhOtIt2RJjPCHdtJbI44YwLAj3GNGZczQotgKZZ/S1NxOzMIFOw19A4QmlPPgfj1zA4WrFd+HzBbOTw2ewmUF
+whbl28RUn8XkZ5mZVkhunLguVZrkWLBQyBLndwynLS0gR8FqNkw5Mg8DU14jO3HeeTujqML7vPiY/+bRGzumpEs6gn+7btTPCyWnlZ5l9QeH234qe6jZAZNq
+Vd+WWAJMvBp0C3dl6phpVECwg6TKdrdQ9jQ3L3w028jpxxsQO+G+EdZdt7aPhuvkpn1Z+bKY9qJAWaq1QFpTovAM2MAiV6m+5cEC6BqStzD07vKnyx0aeNjw
+F0t4nmNsm8X6br6OPq6X32hYJPhXOk/hT8p5SKIs2hcyQ0yL77g5IjYsRAWxrB/lHTV0XnPnoUcT11ZoU1WnZ9lR5p3EM9w7tO4t9tBW0zq7Q1bbFJdhUVLR
+F4IE+es7qmW7BboazFmj7D487N2+hSi1JTmTLqRBil66liartew8olIhjjODCX9dwWR7Y8e5af66UYLZXWkdMOfxlLktl1basHalayuj56s8FcO66RM/zsxO
+4pxi+33tzvcuK2bNtC4m6AX7zMHO7gNdVtSvLonPr+FfMxs8HKC3qqg5c4+LfJ7VhTakhBbVvXEIkVaJLuqs0rLKDNYf8yT5j5XKWWBOMLkkJtsbeydSOtd4
+jqRyQkm38O8wOEivrWG+6ASPvvkKA4wG13CllyqrElY+w54jm2NleYaxbtK95KuGCPLsus7m8181/g9i7039p+d7W///a/w03eNvv328/913z+VELeSpns9z
+XQnRvOi7fb4oy7NpWQBp7fI2m5pEDotp+kBOICB1jSS2kZK0M0Mg2p5BmCFoZQPCXEEjMyB2s57PDrSC/DYT8IEov88ciGaim9zeDiY7psTL+P0kn1jOo0GG
+K5rj6exW2pFn1q1QszsNDhZLB5IsF2xllhPHRqBd0+yohAgalgVCSb9aF2GvP6Jtrdx5XAb32JbBPQwAshWKIqhQDNCqPrHaBd8M6wE7yhLFaPzBTDkUEIPm
+eJQbn/TP5Ong5OQUIKxjORmeDo9G5xLrOIZHE7m59k2EFW82ey4/hFelobC5d/qb8GVvTfhlKwseJME9Ong0JjijLT7rRI/Sy5IPJsSDsjh4EpXFHY3OzwcU
+44Ov4xPZ47DosJXXTrfCjr+hn8Uym94e/Krx/4PnT541z//9J9v4/1f5ORpdvMUaa/IcfDg7M45rDhWM2URXDcbg2LeeOaeEi5I2Ma0sqzIvb1aRe878s3jC
+svOeU7bViOCyNLRHiEFQiP8BWIUD0E3G5CO17eg2m6qbMrJH2gZDB/f8Eix2VHNzdBMQUydFBomEJKEDb6PpHCfxh64yHJTAVCCoVlU3ZVFoFnZWuTxV1xA8
+KKu1fAXknfJNCv+xXL6Qu88O93ry4OnB4yeH+99+L0/6P4W/fPrdt8++F/oxEIG+kDdwzx8WU5OqIk9vyjsJ5eIr8y685fm3Tw+6nyP5OSJfmXfRY84AP4g1
+cIaZOFmDajIl2ePjjHXHm30Tvm+J++PwVD7be3L4nRABmp5jEwH4z+IYLfmVrLRZ5U4fFD6GldoLaE8IpY9oYMxgWaHsTUzTfrJztPsF/to/IUwyg+XJPj48
+jr44xgjACCvgb5aqDxzRbPZ4tURJBaeokMisqvRdiclggTIMoMJgX+7gkW2Zr7p8eHYKmp3YMPZk2SjkSDWLKLhfQvzAVU9yps+9loKVduRhEvMb0XlHJxvV
+DlThCsTKOX0TxPGXFaFBVME6+naRs6o5ZpFMMMzAz+Pl5KKrYAQpmNy5tPHPQdQmq6ReLPNyreFWJt4t1j4M1U7iU8zNmNWCryVBo4DxsWoWKHBiSKjpdFWp
+6TpxaJwChcSIhGa+ygt8FUVGArhF4mXGEs8aR5GzKdziyGAT2WaNpWINo5krtCh90aDNIeRrQaIRlDF7yOJamnmlbn7V83/v+eHzVv7/8Pn2/P8aP/z9Z/X7
+Brh6/7vvnsmjSmU38iWsnrpO5Fk2vVU6l0cp6dTQjnOs7rKZPFJVnhnkQQaktc3VIi+qR1vj+Yi57Gb1yIejkRQvtIQtofP3vQfp3HGVbAuiU86b/iGsLfYP
+xUb/UB6XxSPUcmXMHdNAotUgM0rwO0BfZgQBzTB4gfkxuBv2xv8ih2EVUWboroxRQsW6LDR2EFrPqQyIJZbG6w5wVVhW2QucfaXfuxw+ZjdmEirnP8LVWhqo
++zK/Zvzv8PD50xb+99nW/v8qPxcTeQWFfxhkvyCxccnFb1g9h0S4vhQFomN8FRjnMHt1Xt4jJeu0uYFgmPCp7Bc3KyN/SGHfOF7d3ChILrHRh9AEzKIuzNT/
+o6j9f5fmQAj3r1WRvQcanOOzAbRxnt2kjhUxXSyMEHpp5vOsTqfS/tdCFVK/R1uw0qb5z2UuxDx7P8tNtaQ/+n8tc/jHfOH/gP/NtyzU1P+B/kE3LM0sq/2f
+7D/5tqVZhn9butuIksz/zf2bb6yD+2p/2/383v+e/sE33Ac33Ac3hNfby290reYLSf+Hf2WnzQ5Z8E+4HqwrrCZCUGau79JbuTTXZfkundr/WKhCiKXBojX8
+Lf0XXLjQoIYJz7X/DQ9dmmK1xCvh/+mvlTbZX3Q69f/JjzWop41/4P+kGyDAmU7tf9zCpXW5NPgr/A+6DDa+dGr/41YIc1veQ64TXeG1lUPCYC8px2MMthmC
+3vVQhSjnx3AhQcLDZfV4WZXzrLYGX89Hp18Isd+Tl1Euu24lD1kdAaHUFrXAdn3kV8PDrXJgIwqNp2PWeBwc0e4o4vuInpAh4h4P4PmGDxrtDVFJHe21PaUU
+ZDm3KWXmJUTnyvNaxOMIWTTTI91PFcrYlvNN9wi8BxJulF5/Vaw2oJZkWQE7cbWWb1SePzIt4JLYbdMuI8LpiXyX1T3KL766OMX50bwZvsr5CIt9CIdDOhLs
+8H0viaUWxl9XgApYsQa2fq8WS7flammWWZUhqIgZKMEzFbb8ORXiED+HaXzVZto2ledlYBBV8mal4L+1du6TiBKqxTpMqdaQNA8+ZgqIOp8uj+Yn+0P5WijG
+r5T3BSZgQUtbGaLUSeRQztC+WmTFTN6W9xa5Fs+gBiJKTZ3+h/DWEhmjwLkql7pc5jpChxie9+ALkmbFYhuI/ru/+7v3WaHr2a/q/x0+f7rX8v+ePNvaf1/j
+ZzQevhoC1QfbYy9kqyICcr5hmdh3B+CxXIAwclbWmZGXJquyG/hPCiLTepS7nZf0rH7w8uMKx8RHFI61zmRV0HbdSgSLuESslQQGu1AR4xEZvTYoFx1JDNUj
+xDbHJO3lPs4NDap0dGOg/ZAFyDLHfW0i1aENT0mFOGtDpsJBags5OkzO9TpCbxHnui/W4geUrQtlo1QsOPnpnkR4EKAPrAbChAgiJ2Qd60/HJVHO2/fGSAjI
+U3TPPKtMLQ/xhCkLz87Retpulmr5j/SPf05b/9EjoTEmMlsV5GLDWXqdyj7Sf7tXuJYBCqAIdA4br6xLi02kkvwQ2CZzCFdzHxxILzPBWzIjV0v7aF5D5bz1
+JMi8k6hnfFEDR4dt5okkbzPzDXx9rDGjoiSNdVciq5tVcQHzedCxgP+kmLkBaVLQYKy1gsdBKPwuazYumGNptFdQqLYofbCTu6GuHWwB+WCCxFMw45uVo6kc
+1g8Wc8G1DxR0NZfYC8vm8gLV079Nnz+erirUOIl2xm8BGrrPHBbyZaVWhRATDTFx2eel0HzUd8tK7z9RwYPsMya1vtPyVbW6vubQWYZRd5AIXHJ1CPwTUjdy
+iFT/+i5EEjagscijhutS0LEPKwzmP6m5wSsXWu66pvesMDM+7Da7gSkUTw1YmUOYTvBymPdUisjPtPBQew9sJ8WNvFllM51nhcZaDoaj4G7Hpa08EKl8uarB
+RMwozA24V7TcoFm5MgjZtYYh2nazDFbwpExsZO4eo5TroK4HvvxBep7+g9w9l//8T/Kw12wezCVSs7XDxBcExRVc6wgWtaVTH0oFshWhfCao7/6DhJ5+L5C3
+na6kwD3KJzFtPRO7Qw3SuVrY2wvwrvlN8iA9TPfoVrFc1ZQTMjWnRfl2Xi7w3n1cqDTF/mGzqft+ufxljb8P239Pnj1t4v/2nj7b2n9f5ceG4Szt8eB9XemF
+/i+QmZYXqzy/UJXRVaOMDpPtsH1fVitTa/RUxbCYZapQQaaOSFecXptLA4zbVtGKvGWOI8BvuGAXDkunu+6pAMLDLkFtQ1912+YD8AbJ1JeEkeNdU/QFG6pb
+8i1hXIOZT0kqbKMFSfhfiI3C/TZOnxXxhhxVQHJQwHA9gm8gyNXd6gBVYWOu1iq1kQQMyHR1ISvCcexSpdrci42Nkh/VqE5tKxFqW8mHtK3CfnyPEY5+S1Cw
+rb/T7H8k0RjNBBFEEbjeLi4y47sCs9ojDpzEWHPGu/UD6AeKWc1XFXY2rLfnkk9ATtQhwl/T3WlGD0aeth1IqNW6KjDVD0ETHGXffGg9abk4kGpMLYGoBaID
+QALbO81ikqhS/JgIBePngS1WLSBGw09NhXhCMT+0IXfirmemXu/AC7r+EO0pO6ymG6Ja61LqYlZWFI4DnV04VPk7+Lojr03ShLWKjUBWvKdrYzrpvD4R/GUs
+ScIHvk0qxNOevPiIloZkf52DB8MqChYl6h7e8PNmZMz7SoxNQyCcXEp7ENq/swuRbXHARVRQFEGmj2gWoLpl6ysBLRSBhKCRDyEs34m0sEVTC7ucB1fqot7U
+Srv5MGLDYjWwIrxYB6JXAS/mNbJB1Jxtjd6BPBczRPEE9Mswcg8qd5eVF8XxGa0B8fZiIfyE2P83gaSRKBFOXqR3p1Cx4/4iKY+j/gWlq4fEHNs/Oroa94/e
+SmJ3Px6kiDPun/dBOgTFsC7fylfDHweT5vPOj+VZ/4/0+/EAcNcAVEb8bquVoDdC3Kodgi3E/Sov+sDzmkgHIfRcw6jfMh4OLkHvA/826WwntkdE7dzQFkQ+
+77y8ejXZSeTOj8Px1WSA/3k5Hv3QP5cgTgC/EDuX4/6FPB6NxvjnN6Px2WQnaNnr/vjs5OoUGYtT6b4Z6aBMAkEVEQiqwG8bmGbXwv758TfwYSaT0dEQ9UHO
++peD8bB/yrI1lyPRvB9+/WP/dHjMOGwi+aVv8WpwDnIOIAaEmOtfFjf9lzy7ftw4Cn9uh+CD9T9Pm/n/J3tPt/b/V/lp2/UQwnguj24Bz6PlRVnmWojuy57I
+H7CU5Q0q7L3LqncRRujyvkxkP9fvdSb7qfyxrMqiNuVdx+P24HEH8uI2y7MlXIzIo4foeR5hTutR8vEhnWEhi5IJ6EnowSNt5LWWtzqf4VnC4prwwAaRstcr
+c8wnQfvSJtI4gBgzwMfFU8JuNYJXQYmk8LwBYTk+Qz3hsWAZIjNJMQuNXw2/o1rjxMJeRQv2WgUSqC+QicsndVu9I1ua7bZFZlykTs++9+Xc8Hc8cIWLldxX
+Za27g8aOIqU9KCTObsGRiDWNDN5dm9x13ek1kuBNZ8G4CuCQZ/SjFgBpyXUuAfFZS6DzgbQIRGsRHIAfXaPeBxtfLiBl8V+IS8sBs1C9o8g5GPiJVyul7ybi
+7yZRQrJVSB/MZxTLpZQQ5yiCkjSb/7A61NbyJbIJbGict/i77c/2Z/uz/dn+/FZ+/v8DAH0UAmoAvC4A`
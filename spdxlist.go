@@ -0,0 +1,261 @@
+package diligent
+
+//go:generate go run spdxlist_generate.go -dir ../license-list-data/json
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// SPDXLicense is an entry of the licenses.json file published by SPDX
+type SPDXLicense struct {
+	ID          string   `json:"licenseId"`
+	Name        string   `json:"name"`
+	Reference   string   `json:"reference"`
+	Deprecated  bool     `json:"isDeprecatedLicenseId"`
+	OSIApproved bool     `json:"isOsiApproved"`
+	FSFLibre    bool     `json:"isFsfLibre"`
+	SeeAlso     []string `json:"seeAlso"`
+}
+
+// SPDXException is an entry of the exceptions.json file published by SPDX
+type SPDXException struct {
+	ID         string   `json:"licenseExceptionId"`
+	Name       string   `json:"name"`
+	Reference  string   `json:"reference"`
+	Deprecated bool     `json:"isDeprecatedLicenseId"`
+	SeeAlso    []string `json:"seeAlso"`
+}
+
+// SPDXList holds the content of the licenses.json or exceptions.json files published by SPDX at
+// https://github.com/spdx/license-list-data. A file may contain licenses, exceptions or both
+type SPDXList struct {
+	Version    string          `json:"licenseListVersion"`
+	Licenses   []SPDXLicense   `json:"licenses"`
+	Exceptions []SPDXException `json:"exceptions"`
+}
+
+// LicenseException is an exception which may be added to a license using an expression such as
+// 'GPL-2.0-only WITH Classpath-exception-2.0'
+type LicenseException struct {
+	Identifier string
+	Name       string
+	URL        string
+	Deprecated bool
+}
+
+var exceptions = map[string]LicenseException{}
+
+// replacements holds the current identifiers of deprecated identifiers which are not named after them
+var replacements = map[string]string{
+	"Nunit":         "zlib-acknowledgement",
+	"StandardML-NJ": "SMLNJ",
+}
+
+// gnuFamilies are the licenses whose deprecated identifiers gained an -only or -or-later suffix
+var gnuFamilies = []string{"GPL-", "LGPL-", "AGPL-", "GFDL-"}
+
+func isGNUIdentifier(identifier string) bool {
+	for _, f := range gnuFamilies {
+		if strings.HasPrefix(identifier, f) && !strings.Contains(identifier, "-with-") {
+			return true
+		}
+	}
+	return false
+}
+
+// replacementIdentifier returns the current identifier of a deprecated identifier, such as GPL-2.0-only for GPL-2.0
+// and GPL-2.0-or-later for GPL-2.0+. An empty string is returned if there is no direct replacement
+func replacementIdentifier(identifier string) string {
+	if r, ok := replacements[identifier]; ok {
+		return r
+	}
+	if !isGNUIdentifier(identifier) || strings.HasSuffix(identifier, "-only") || strings.HasSuffix(identifier, "-or-later") {
+		return ""
+	}
+	if strings.HasSuffix(identifier, "+") {
+		return strings.TrimSuffix(identifier, "+") + "-or-later"
+	}
+	return identifier + "-only"
+}
+
+// deprecatedIdentifier is the inverse of replacementIdentifier
+func deprecatedIdentifier(identifier string) string {
+	for d, r := range replacements {
+		if r == identifier {
+			return d
+		}
+	}
+	if !isGNUIdentifier(identifier) {
+		return ""
+	}
+	if strings.HasSuffix(identifier, "-only") {
+		return strings.TrimSuffix(identifier, "-only")
+	}
+	if strings.HasSuffix(identifier, "-or-later") {
+		return strings.TrimSuffix(identifier, "-or-later") + "+"
+	}
+	return ""
+}
+
+// EquivalentIdentifiers returns the identifier along with the deprecated or current identifiers of the same license,
+// for example GPL-2.0 and GPL-2.0-only
+func EquivalentIdentifiers(identifier string) []string {
+	out := []string{identifier}
+	for _, id := range []string{replacementIdentifier(identifier), deprecatedIdentifier(identifier)} {
+		if _, ok := lookup[id]; ok && id != "" {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// ReadSPDXList parses a licenses.json or exceptions.json file in the format published by SPDX
+func ReadSPDXList(r io.Reader) (SPDXList, error) {
+	var list SPDXList
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return list, &MalformedError{fmt.Sprintf("invalid SPDX license list: %v", err)}
+	}
+	for _, l := range list.Licenses {
+		if l.ID == "" {
+			return list, &MalformedError{"invalid SPDX license list: license without a licenseId"}
+		}
+	}
+	for _, e := range list.Exceptions {
+		if e.ID == "" {
+			return list, &MalformedError{"invalid SPDX license list: exception without a licenseExceptionId"}
+		}
+	}
+	return list, nil
+}
+
+// LoadSPDXList merges the licenses.json or exceptions.json file at path into the licenses known by diligent,
+// allowing a newer license list than the one bundled to be used
+func LoadSPDXList(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	list, err := ReadSPDXList(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	MergeSPDXList(list)
+	return nil
+}
+
+func (s SPDXLicense) url() string {
+	if len(s.SeeAlso) > 0 {
+		return s.SeeAlso[0]
+	}
+	return s.Reference
+}
+
+// MergeSPDXList merges a SPDX license list into the licenses known by diligent. Licenses already known keep their
+// category and owner, gaining the flags from the list. Licenses new to diligent take their category from the
+// deprecated or current identifier of the same license, if known, and otherwise belong to the Uncategorised category
+func MergeSPDXList(list SPDXList) {
+	for _, s := range list.Licenses {
+		l, ok := lookup[s.ID]
+		if !ok {
+			l = License{Identifier: s.ID, Name: s.Name, ShortName: s.ID, URL: s.url(), Category: Uncategorised}
+			if s.OSIApproved || s.FSFLibre {
+				l.Type = OpenSource
+			}
+			// the version of a GNU license determines its category, so GPL-2.0-or-later may take it from GPL-2.0
+			base := strings.TrimSuffix(strings.TrimSuffix(s.ID, "-or-later"), "-only")
+			for _, id := range []string{replacementIdentifier(s.ID), deprecatedIdentifier(s.ID), base} {
+				if e, ok := lookup[id]; ok && id != "" && id != s.ID && e.Category != "" && e.Category != Uncategorised {
+					l.Category, l.Type = e.Category, e.Type
+					l.Owner, l.OwnerURL, l.OwnerType = e.Owner, e.OwnerURL, e.OwnerType
+					break
+				}
+			}
+		}
+		l.Deprecated = s.Deprecated
+		l.OSIApproved = s.OSIApproved
+		l.FSFLibre = s.FSFLibre
+		l.ReplacedBy = ""
+		if s.Deprecated {
+			l.ReplacedBy = replacementIdentifier(s.ID)
+		}
+		lookup[s.ID] = l
+	}
+	for _, s := range list.Exceptions {
+		e := LicenseException{Identifier: s.ID, Name: s.Name, URL: s.Reference, Deprecated: s.Deprecated}
+		if len(s.SeeAlso) > 0 {
+			e.URL = s.SeeAlso[0]
+		}
+		exceptions[s.ID] = e
+	}
+}
+
+// GetLicenseException returns the license exception with the given SPDX identifier
+func GetLicenseException(identifier string) (LicenseException, bool) {
+	e, ok := exceptions[identifier]
+	return e, ok
+}
+
+// SplitException splits a license expression such as 'GPL-2.0-only WITH Classpath-exception-2.0' into the license
+// and exception identifiers. The exception is empty if the expression has none
+func SplitException(expression string) (license, exception string) {
+	parts := strings.SplitN(expression, " WITH ", 2)
+	if len(parts) != 2 {
+		return expression, ""
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+// licenseWithException returns the License for an expression such as 'GPL-2.0-only WITH Classpath-exception-2.0',
+// which takes its category from the license
+func licenseWithException(expression string) (License, bool) {
+	license, exception := SplitException(expression)
+	if exception == "" {
+		return License{}, false
+	}
+	l, ok := lookup[license]
+	if !ok {
+		return License{}, false
+	}
+	e, ok := exceptions[exception]
+	if !ok {
+		return License{}, false
+	}
+	l.Identifier = l.Identifier + " WITH " + e.Identifier
+	l.Name = l.Name + " with " + e.Name
+	l.ShortName = l.ShortName + " with " + e.Identifier
+	return l, true
+}
+
+// RegisterLicense adds a license which is not published by SPDX, such as a company license. The identifier must
+// start with LicenseRef-, as per the SPDX specification, and the license must belong to a category
+func RegisterLicense(l License) error {
+	if !strings.HasPrefix(l.Identifier, "LicenseRef-") || l.Identifier == "LicenseRef-" {
+		return fmt.Errorf("custom license identifier '%s' must start with LicenseRef-", l.Identifier)
+	}
+	if c := getCategoryFromString(string(l.Category)); c == nil || *c == All {
+		return fmt.Errorf("custom license '%s' has an unknown category '%s'", l.Identifier, l.Category)
+	}
+	if l.Name == "" {
+		l.Name = l.Identifier
+	}
+	if l.ShortName == "" {
+		l.ShortName = l.Name
+	}
+	lookup[l.Identifier] = l
+	return nil
+}
+
+func init() {
+	for _, data := range []string{bundledSPDXLicenses, bundledSPDXExceptions} {
+		list, err := ReadSPDXList(strings.NewReader(data))
+		if err != nil {
+			panic(err)
+		}
+		MergeSPDXList(list)
+	}
+}
@@ -0,0 +1,9 @@
+// Code generated by spdxlist_generate.go. DO NOT EDIT.
+
+package diligent
+
+// bundledSPDXLicenses is the licenses.json file published by SPDX
+const bundledSPDXLicenses = `{"licenseListVersion":"3.8","licenses":[{"reference":"https://spdx.org/licenses/0BSD.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/0BSD.json","referenceNumber":1,"name":"BSD Zero Clause License","licenseId":"0BSD","seeAlso":["https://landley.net/toybox/license.html"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/AAL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AAL.json","referenceNumber":2,"name":"Attribution Assurance License","licenseId":"AAL","seeAlso":["https://opensource.org/licenses/attribution"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Abstyles.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Abstyles.json","referenceNumber":3,"name":"Abstyles License","licenseId":"Abstyles","seeAlso":["https://fedoraproject.org/wiki/Licensing/Abstyles"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Adobe-2006.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Adobe-2006.json","referenceNumber":4,"name":"Adobe Systems Incorporated Source Code License Agreement","licenseId":"Adobe-2006","seeAlso":["https://fedoraproject.org/wiki/Licensing/AdobeLicense"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Adobe-Glyph.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Adobe-Glyph.json","referenceNumber":5,"name":"Adobe Glyph List License","licenseId":"Adobe-Glyph","seeAlso":["https://fedoraproject.org/wiki/Licensing/MIT#AdobeGlyph"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/ADSL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ADSL.json","referenceNumber":6,"name":"Amazon Digital Services License","licenseId":"ADSL","seeAlso":["https://fedoraproject.org/wiki/Licensing/AmazonDigitalServicesLicense"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/AFL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AFL-1.1.json","referenceNumber":7,"name":"Academic Free License v1.1","licenseId":"AFL-1.1","seeAlso":["https://opensource.linux-mirror.org/licenses/afl-1.1.txt","https://wayback.archive.org/web/20021004124254/http://www.opensource.org/licenses/academic.php"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/AFL-1.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AFL-1.2.json","referenceNumber":8,"name":"Academic Free License v1.2","licenseId":"AFL-1.2","seeAlso":["https://opensource.linux-mirror.org/licenses/afl-1.2.txt","https://wayback.archive.org/web/20021204204652/http://www.opensource.org/licenses/academic.php"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/AFL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AFL-2.0.json","referenceNumber":9,"name":"Academic Free License v2.0","licenseId":"AFL-2.0","seeAlso":["https://wayback.archive.org/web/20060924134533/http://www.opensource.org/licenses/afl-2.0.txt"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/AFL-2.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AFL-2.1.json","referenceNumber":10,"name":"Academic Free License v2.1","licenseId":"AFL-2.1","seeAlso":["https://opensource.linux-mirror.org/licenses/afl-2.1.txt"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/AFL-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AFL-3.0.json","referenceNumber":11,"name":"Academic Free License v3.0","licenseId":"AFL-3.0","seeAlso":["https://www.rosenlaw.com/AFL3.0.htm","https://opensource.org/licenses/afl-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Afmparse.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Afmparse.json","referenceNumber":12,"name":"Afmparse License","licenseId":"Afmparse","seeAlso":["https://fedoraproject.org/wiki/Licensing/Afmparse"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/AGPL-1.0.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/AGPL-1.0.json","referenceNumber":13,"name":"Affero General Public License v1.0","licenseId":"AGPL-1.0","seeAlso":["https://www.affero.org/oagpl.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/AGPL-1.0-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AGPL-1.0-only.json","referenceNumber":14,"name":"Affero General Public License v1.0 only","licenseId":"AGPL-1.0-only","seeAlso":["https://www.affero.org/oagpl.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/AGPL-1.0-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AGPL-1.0-or-later.json","referenceNumber":15,"name":"Affero General Public License v1.0 or later","licenseId":"AGPL-1.0-or-later","seeAlso":["https://www.affero.org/oagpl.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/AGPL-3.0.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/AGPL-3.0.json","referenceNumber":16,"name":"GNU Affero General Public License v3.0","licenseId":"AGPL-3.0","seeAlso":["https://www.gnu.org/licenses/agpl.txt","https://opensource.org/licenses/AGPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/AGPL-3.0-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AGPL-3.0-only.json","referenceNumber":17,"name":"GNU Affero General Public License v3.0 only","licenseId":"AGPL-3.0-only","seeAlso":["https://www.gnu.org/licenses/agpl.txt","https://opensource.org/licenses/AGPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/AGPL-3.0-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AGPL-3.0-or-later.json","referenceNumber":18,"name":"GNU Affero General Public License v3.0 or later","licenseId":"AGPL-3.0-or-later","seeAlso":["https://www.gnu.org/licenses/agpl.txt","https://opensource.org/licenses/AGPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Aladdin.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Aladdin.json","referenceNumber":19,"name":"Aladdin Free Public License","licenseId":"Aladdin","seeAlso":["https://pages.cs.wisc.edu/~ghost/doc/AFPL/6.01/Public.htm"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/AMDPLPA.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AMDPLPA.json","referenceNumber":20,"name":"AMD's plpa_map.c License","licenseId":"AMDPLPA","seeAlso":["https://fedoraproject.org/wiki/Licensing/AMD_plpa_map_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/AML.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AML.json","referenceNumber":21,"name":"Apple MIT License","licenseId":"AML","seeAlso":["https://fedoraproject.org/wiki/Licensing/Apple_MIT_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/AMPAS.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/AMPAS.json","referenceNumber":22,"name":"Academy of Motion Picture Arts and Sciences BSD","licenseId":"AMPAS","seeAlso":["https://fedoraproject.org/wiki/Licensing/BSD#AMPASBSD"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/ANTLR-PD.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ANTLR-PD.json","referenceNumber":23,"name":"ANTLR Software Rights Notice","licenseId":"ANTLR-PD","seeAlso":["https://www.antlr2.org/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Apache-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Apache-1.0.json","referenceNumber":24,"name":"Apache License 1.0","licenseId":"Apache-1.0","seeAlso":["https://www.apache.org/licenses/LICENSE-1.0"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Apache-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Apache-1.1.json","referenceNumber":25,"name":"Apache License 1.1","licenseId":"Apache-1.1","seeAlso":["https://apache.org/licenses/LICENSE-1.1","https://opensource.org/licenses/Apache-1.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Apache-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Apache-2.0.json","referenceNumber":26,"name":"Apache License 2.0","licenseId":"Apache-2.0","seeAlso":["https://www.apache.org/licenses/LICENSE-2.0","https://opensource.org/licenses/Apache-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/APAFML.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/APAFML.json","referenceNumber":27,"name":"Adobe Postscript AFM License","licenseId":"APAFML","seeAlso":["https://fedoraproject.org/wiki/Licensing/AdobePostscriptAFM"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/APL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/APL-1.0.json","referenceNumber":28,"name":"Adaptive Public License 1.0","licenseId":"APL-1.0","seeAlso":["https://opensource.org/licenses/APL-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/APSL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/APSL-1.0.json","referenceNumber":29,"name":"Apple Public Source License 1.0","licenseId":"APSL-1.0","seeAlso":["https://fedoraproject.org/wiki/Licensing/Apple_Public_Source_License_1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/APSL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/APSL-1.1.json","referenceNumber":30,"name":"Apple Public Source License 1.1","licenseId":"APSL-1.1","seeAlso":["https://www.opensource.apple.com/source/IOSerialFamily/IOSerialFamily-7/APPLE_LICENSE"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/APSL-1.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/APSL-1.2.json","referenceNumber":31,"name":"Apple Public Source License 1.2","licenseId":"APSL-1.2","seeAlso":["https://www.samurajdata.se/opensource/mirror/licenses/apsl.php"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/APSL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/APSL-2.0.json","referenceNumber":32,"name":"Apple Public Source License 2.0","licenseId":"APSL-2.0","seeAlso":["https://www.opensource.apple.com/license/apsl/"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Artistic-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Artistic-1.0.json","referenceNumber":33,"name":"Artistic License 1.0","licenseId":"Artistic-1.0","seeAlso":["https://opensource.org/licenses/Artistic-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Artistic-1.0-cl8.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Artistic-1.0-cl8.json","referenceNumber":34,"name":"Artistic License 1.0 w/clause 8","licenseId":"Artistic-1.0-cl8","seeAlso":["https://opensource.org/licenses/Artistic-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Artistic-1.0-Perl.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Artistic-1.0-Perl.json","referenceNumber":35,"name":"Artistic License 1.0 (Perl)","licenseId":"Artistic-1.0-Perl","seeAlso":["https://dev.perl.org/licenses/artistic.html"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Artistic-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Artistic-2.0.json","referenceNumber":36,"name":"Artistic License 2.0","licenseId":"Artistic-2.0","seeAlso":["https://www.perlfoundation.org/artistic_license_2_0","https://opensource.org/licenses/artistic-license-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Bahyph.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Bahyph.json","referenceNumber":37,"name":"Bahyph License","licenseId":"Bahyph","seeAlso":["https://fedoraproject.org/wiki/Licensing/Bahyph"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Barr.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Barr.json","referenceNumber":38,"name":"Barr License","licenseId":"Barr","seeAlso":["https://fedoraproject.org/wiki/Licensing/Barr"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Beerware.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Beerware.json","referenceNumber":39,"name":"Beerware License","licenseId":"Beerware","seeAlso":["https://fedoraproject.org/wiki/Licensing/Beerware","https://people.freebsd.org/~phk/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BitTorrent-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BitTorrent-1.0.json","referenceNumber":40,"name":"BitTorrent Open Source License v1.0","licenseId":"BitTorrent-1.0","seeAlso":["https://sources.gentoo.org/cgi-bin/viewvc.cgi/gentoo-x86/licenses/BitTorrent?r1=1.1&r2=1.1.1.1&diff_format=s"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BitTorrent-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BitTorrent-1.1.json","referenceNumber":41,"name":"BitTorrent Open Source License v1.1","licenseId":"BitTorrent-1.1","seeAlso":["https://directory.fsf.org/wiki/License:BitTorrentOSL1.1"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/blessing.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/blessing.json","referenceNumber":42,"name":"SQLite Blessing","licenseId":"blessing","seeAlso":["https://www.sqlite.org/src/artifact/e33a4df7e32d742a?ln=4-9","https://sqlite.org/src/artifact/df5091916dbb40e6"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BlueOak-1.0.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BlueOak-1.0.0.json","referenceNumber":43,"name":"Blue Oak Model License 1.0.0","licenseId":"BlueOak-1.0.0","seeAlso":["https://blueoakcouncil.org/license/1.0.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Borceux.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Borceux.json","referenceNumber":44,"name":"Borceux license","licenseId":"Borceux","seeAlso":["https://fedoraproject.org/wiki/Licensing/Borceux"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-1-Clause.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-1-Clause.json","referenceNumber":45,"name":"BSD 1-Clause License","licenseId":"BSD-1-Clause","seeAlso":["https://svnweb.freebsd.org/base/head/include/ifaddrs.h?revision=326823"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-2-Clause.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-2-Clause.json","referenceNumber":46,"name":"BSD 2-Clause \"Simplified\" License","licenseId":"BSD-2-Clause","seeAlso":["https://opensource.org/licenses/BSD-2-Clause"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/BSD-2-Clause-FreeBSD.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-2-Clause-FreeBSD.json","referenceNumber":47,"name":"BSD 2-Clause FreeBSD License","licenseId":"BSD-2-Clause-FreeBSD","seeAlso":["https://www.freebsd.org/copyright/freebsd-license.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/BSD-2-Clause-NetBSD.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-2-Clause-NetBSD.json","referenceNumber":48,"name":"BSD 2-Clause NetBSD License","licenseId":"BSD-2-Clause-NetBSD","seeAlso":["https://www.netbsd.org/about/redistribution.html#default"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-2-Clause-Patent.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-2-Clause-Patent.json","referenceNumber":49,"name":"BSD-2-Clause Plus Patent License","licenseId":"BSD-2-Clause-Patent","seeAlso":["https://opensource.org/licenses/BSDplusPatent"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/BSD-3-Clause.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-3-Clause.json","referenceNumber":50,"name":"BSD 3-Clause \"New\" or \"Revised\" License","licenseId":"BSD-3-Clause","seeAlso":["https://opensource.org/licenses/BSD-3-Clause"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/BSD-3-Clause-Attribution.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-3-Clause-Attribution.json","referenceNumber":51,"name":"BSD with attribution","licenseId":"BSD-3-Clause-Attribution","seeAlso":["https://fedoraproject.org/wiki/Licensing/BSD_with_Attribution"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-3-Clause-Clear.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-3-Clause-Clear.json","referenceNumber":52,"name":"BSD 3-Clause Clear License","licenseId":"BSD-3-Clause-Clear","seeAlso":["https://labs.metacarta.com/license-explanation.html#license"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/BSD-3-Clause-LBNL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-3-Clause-LBNL.json","referenceNumber":53,"name":"Lawrence Berkeley National Labs BSD variant license","licenseId":"BSD-3-Clause-LBNL","seeAlso":["https://fedoraproject.org/wiki/Licensing/LBNLBSD"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-License.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-License.json","referenceNumber":54,"name":"BSD 3-Clause No Nuclear License","licenseId":"BSD-3-Clause-No-Nuclear-License","seeAlso":["https://download.oracle.com/otn-pub/java/licenses/bsd.txt?AuthParam=1467140197_43d516ce1776bd08a58235a7785be1cc"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-License-2014.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-License-2014.json","referenceNumber":55,"name":"BSD 3-Clause No Nuclear License 2014","licenseId":"BSD-3-Clause-No-Nuclear-License-2014","seeAlso":["https://java.net/projects/javaeetutorial/pages/BerkeleyLicense"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-Warranty.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-3-Clause-No-Nuclear-Warranty.json","referenceNumber":56,"name":"BSD 3-Clause No Nuclear Warranty","licenseId":"BSD-3-Clause-No-Nuclear-Warranty","seeAlso":["https://jogamp.org/git/?p=gluegen.git;a=blob_plain;f=LICENSE.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-3-Clause-Open-MPI.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-3-Clause-Open-MPI.json","referenceNumber":57,"name":"BSD 3-Clause Open MPI variant","licenseId":"BSD-3-Clause-Open-MPI","seeAlso":["https://www.open-mpi.org/community/license.php","https://www.netlib.org/lapack/LICENSE.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-4-Clause.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-4-Clause.json","referenceNumber":58,"name":"BSD 4-Clause \"Original\" or \"Old\" License","licenseId":"BSD-4-Clause","seeAlso":["https://directory.fsf.org/wiki/License:BSD_4Clause"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/BSD-4-Clause-UC.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-4-Clause-UC.json","referenceNumber":59,"name":"BSD-4-Clause (University of California-Specific)","licenseId":"BSD-4-Clause-UC","seeAlso":["https://www.freebsd.org/copyright/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-Protection.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-Protection.json","referenceNumber":60,"name":"BSD Protection License","licenseId":"BSD-Protection","seeAlso":["https://fedoraproject.org/wiki/Licensing/BSD_Protection_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSD-Source-Code.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSD-Source-Code.json","referenceNumber":61,"name":"BSD Source Code Attribution","licenseId":"BSD-Source-Code","seeAlso":["https://github.com/robbiehanson/CocoaHTTPServer/blob/master/LICENSE.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/BSL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/BSL-1.0.json","referenceNumber":62,"name":"Boost Software License 1.0","licenseId":"BSL-1.0","seeAlso":["https://www.boost.org/LICENSE_1_0.txt","https://opensource.org/licenses/BSL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/bzip2-1.0.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/bzip2-1.0.5.json","referenceNumber":63,"name":"bzip2 and libbzip2 License v1.0.5","licenseId":"bzip2-1.0.5","seeAlso":["https://bzip.org/1.0.5/bzip2-manual-1.0.5.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/bzip2-1.0.6.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/bzip2-1.0.6.json","referenceNumber":64,"name":"bzip2 and libbzip2 License v1.0.6","licenseId":"bzip2-1.0.6","seeAlso":["https://github.com/asimonov-im/bzip2/blob/master/LICENSE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Caldera.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Caldera.json","referenceNumber":65,"name":"Caldera License","licenseId":"Caldera","seeAlso":["https://www.lemis.com/grog/UNIX/ancient-source-all.pdf"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CATOSL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CATOSL-1.1.json","referenceNumber":66,"name":"Computer Associates Trusted Open Source License 1.1","licenseId":"CATOSL-1.1","seeAlso":["https://opensource.org/licenses/CATOSL-1.1"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/CC-BY-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-1.0.json","referenceNumber":67,"name":"Creative Commons Attribution 1.0 Generic","licenseId":"CC-BY-1.0","seeAlso":["https://creativecommons.org/licenses/by/1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-2.0.json","referenceNumber":68,"name":"Creative Commons Attribution 2.0 Generic","licenseId":"CC-BY-2.0","seeAlso":["https://creativecommons.org/licenses/by/2.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-2.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-2.5.json","referenceNumber":69,"name":"Creative Commons Attribution 2.5 Generic","licenseId":"CC-BY-2.5","seeAlso":["https://creativecommons.org/licenses/by/2.5"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-3.0.json","referenceNumber":70,"name":"Creative Commons Attribution 3.0 Unported","licenseId":"CC-BY-3.0","seeAlso":["https://creativecommons.org/licenses/by/3.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-4.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-4.0.json","referenceNumber":71,"name":"Creative Commons Attribution 4.0 International","licenseId":"CC-BY-4.0","seeAlso":["https://creativecommons.org/licenses/by/4.0"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CC-BY-NC-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-1.0.json","referenceNumber":72,"name":"Creative Commons Attribution Non Commercial 1.0 Generic","licenseId":"CC-BY-NC-1.0","seeAlso":["https://creativecommons.org/licenses/by-nc/1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-2.0.json","referenceNumber":73,"name":"Creative Commons Attribution Non Commercial 2.0 Generic","licenseId":"CC-BY-NC-2.0","seeAlso":["https://creativecommons.org/licenses/by-nc/2.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-2.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-2.5.json","referenceNumber":74,"name":"Creative Commons Attribution Non Commercial 2.5 Generic","licenseId":"CC-BY-NC-2.5","seeAlso":["https://creativecommons.org/licenses/by-nc/2.5"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-3.0.json","referenceNumber":75,"name":"Creative Commons Attribution Non Commercial 3.0 Unported","licenseId":"CC-BY-NC-3.0","seeAlso":["https://creativecommons.org/licenses/by-nc/3.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-4.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-4.0.json","referenceNumber":76,"name":"Creative Commons Attribution Non Commercial 4.0 International","licenseId":"CC-BY-NC-4.0","seeAlso":["https://creativecommons.org/licenses/by-nc/4.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-ND-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-ND-1.0.json","referenceNumber":77,"name":"Creative Commons Attribution Non Commercial No Derivatives 1.0 Generic","licenseId":"CC-BY-NC-ND-1.0","seeAlso":["https://creativecommons.org/licenses/by-nd-nc/1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-ND-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-ND-2.0.json","referenceNumber":78,"name":"Creative Commons Attribution Non Commercial No Derivatives 2.0 Generic","licenseId":"CC-BY-NC-ND-2.0","seeAlso":["https://creativecommons.org/licenses/by-nc-nd/2.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-ND-2.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-ND-2.5.json","referenceNumber":79,"name":"Creative Commons Attribution Non Commercial No Derivatives 2.5 Generic","licenseId":"CC-BY-NC-ND-2.5","seeAlso":["https://creativecommons.org/licenses/by-nc-nd/2.5"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-ND-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-ND-3.0.json","referenceNumber":80,"name":"Creative Commons Attribution Non Commercial No Derivatives 3.0 Unported","licenseId":"CC-BY-NC-ND-3.0","seeAlso":["https://creativecommons.org/licenses/by-nc-nd/3.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-ND-4.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-ND-4.0.json","referenceNumber":81,"name":"Creative Commons Attribution Non Commercial No Derivatives 4.0 International","licenseId":"CC-BY-NC-ND-4.0","seeAlso":["https://creativecommons.org/licenses/by-nc-nd/4.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-SA-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-SA-1.0.json","referenceNumber":82,"name":"Creative Commons Attribution Non Commercial Share Alike 1.0 Generic","licenseId":"CC-BY-NC-SA-1.0","seeAlso":["https://creativecommons.org/licenses/by-nc-sa/1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-SA-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-SA-2.0.json","referenceNumber":83,"name":"Creative Commons Attribution Non Commercial Share Alike 2.0 Generic","licenseId":"CC-BY-NC-SA-2.0","seeAlso":["https://creativecommons.org/licenses/by-nc-sa/2.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-SA-2.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-SA-2.5.json","referenceNumber":84,"name":"Creative Commons Attribution Non Commercial Share Alike 2.5 Generic","licenseId":"CC-BY-NC-SA-2.5","seeAlso":["https://creativecommons.org/licenses/by-nc-sa/2.5"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-SA-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-SA-3.0.json","referenceNumber":85,"name":"Creative Commons Attribution Non Commercial Share Alike 3.0 Unported","licenseId":"CC-BY-NC-SA-3.0","seeAlso":["https://creativecommons.org/licenses/by-nc-sa/3.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-NC-SA-4.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-NC-SA-4.0.json","referenceNumber":86,"name":"Creative Commons Attribution Non Commercial Share Alike 4.0 International","licenseId":"CC-BY-NC-SA-4.0","seeAlso":["https://creativecommons.org/licenses/by-nc-sa/4.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-ND-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-ND-1.0.json","referenceNumber":87,"name":"Creative Commons Attribution No Derivatives 1.0 Generic","licenseId":"CC-BY-ND-1.0","seeAlso":["https://creativecommons.org/licenses/by-nd/1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-ND-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-ND-2.0.json","referenceNumber":88,"name":"Creative Commons Attribution No Derivatives 2.0 Generic","licenseId":"CC-BY-ND-2.0","seeAlso":["https://creativecommons.org/licenses/by-nd/2.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-ND-2.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-ND-2.5.json","referenceNumber":89,"name":"Creative Commons Attribution No Derivatives 2.5 Generic","licenseId":"CC-BY-ND-2.5","seeAlso":["https://creativecommons.org/licenses/by-nd/2.5"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-ND-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-ND-3.0.json","referenceNumber":90,"name":"Creative Commons Attribution No Derivatives 3.0 Unported","licenseId":"CC-BY-ND-3.0","seeAlso":["https://creativecommons.org/licenses/by-nd/3.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-ND-4.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-ND-4.0.json","referenceNumber":91,"name":"Creative Commons Attribution No Derivatives 4.0 International","licenseId":"CC-BY-ND-4.0","seeAlso":["https://creativecommons.org/licenses/by-nd/4.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-SA-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-SA-1.0.json","referenceNumber":92,"name":"Creative Commons Attribution Share Alike 1.0 Generic","licenseId":"CC-BY-SA-1.0","seeAlso":["https://creativecommons.org/licenses/by-sa/1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-SA-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-SA-2.0.json","referenceNumber":93,"name":"Creative Commons Attribution Share Alike 2.0 Generic","licenseId":"CC-BY-SA-2.0","seeAlso":["https://creativecommons.org/licenses/by-sa/2.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-SA-2.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-SA-2.5.json","referenceNumber":94,"name":"Creative Commons Attribution Share Alike 2.5 Generic","licenseId":"CC-BY-SA-2.5","seeAlso":["https://creativecommons.org/licenses/by-sa/2.5"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-SA-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-SA-3.0.json","referenceNumber":95,"name":"Creative Commons Attribution Share Alike 3.0 Unported","licenseId":"CC-BY-SA-3.0","seeAlso":["https://creativecommons.org/licenses/by-sa/3.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC-BY-SA-4.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-BY-SA-4.0.json","referenceNumber":96,"name":"Creative Commons Attribution Share Alike 4.0 International","licenseId":"CC-BY-SA-4.0","seeAlso":["https://creativecommons.org/licenses/by-sa/4.0"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CC-PDDC.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC-PDDC.json","referenceNumber":97,"name":"Creative Commons Public Domain Dedication and Certification","licenseId":"CC-PDDC","seeAlso":["https://creativecommons.org/licenses/publicdomain/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CC0-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CC0-1.0.json","referenceNumber":98,"name":"Creative Commons Zero v1.0 Universal","licenseId":"CC0-1.0","seeAlso":["https://creativecommons.org/publicdomain/zero/1.0"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CDDL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CDDL-1.0.json","referenceNumber":99,"name":"Common Development and Distribution License 1.0","licenseId":"CDDL-1.0","seeAlso":["https://opensource.org/licenses/cddl1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CDDL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CDDL-1.1.json","referenceNumber":100,"name":"Common Development and Distribution License 1.1","licenseId":"CDDL-1.1","seeAlso":["https://glassfish.java.net/public/CDDL+GPL_1_1.html","https://javaee.github.io/glassfish/LICENSE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CDLA-Permissive-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CDLA-Permissive-1.0.json","referenceNumber":101,"name":"Community Data License Agreement Permissive 1.0","licenseId":"CDLA-Permissive-1.0","seeAlso":["https://cdla.io/permissive-1-0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CDLA-Sharing-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CDLA-Sharing-1.0.json","referenceNumber":102,"name":"Community Data License Agreement Sharing 1.0","licenseId":"CDLA-Sharing-1.0","seeAlso":["https://cdla.io/sharing-1-0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CECILL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CECILL-1.0.json","referenceNumber":103,"name":"CeCILL Free Software License Agreement v1.0","licenseId":"CECILL-1.0","seeAlso":["https://www.cecill.info/licences/Licence_CeCILL_V1-fr.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CECILL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CECILL-1.1.json","referenceNumber":104,"name":"CeCILL Free Software License Agreement v1.1","licenseId":"CECILL-1.1","seeAlso":["https://www.cecill.info/licences/Licence_CeCILL_V1.1-US.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CECILL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CECILL-2.0.json","referenceNumber":105,"name":"CeCILL Free Software License Agreement v2.0","licenseId":"CECILL-2.0","seeAlso":["https://www.cecill.info/licences/Licence_CeCILL_V2-en.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CECILL-2.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CECILL-2.1.json","referenceNumber":106,"name":"CeCILL Free Software License Agreement v2.1","licenseId":"CECILL-2.1","seeAlso":["https://www.cecill.info/licences/Licence_CeCILL_V2.1-en.html"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/CECILL-B.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CECILL-B.json","referenceNumber":107,"name":"CeCILL-B Free Software License Agreement","licenseId":"CECILL-B","seeAlso":["https://www.cecill.info/licences/Licence_CeCILL-B_V1-en.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CECILL-C.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CECILL-C.json","referenceNumber":108,"name":"CeCILL-C Free Software License Agreement","licenseId":"CECILL-C","seeAlso":["https://www.cecill.info/licences/Licence_CeCILL-C_V1-en.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CERN-OHL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CERN-OHL-1.1.json","referenceNumber":109,"name":"CERN Open Hardware Licence v1.1","licenseId":"CERN-OHL-1.1","seeAlso":["https://www.ohwr.org/project/licenses/wikis/cern-ohl-v1.1"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CERN-OHL-1.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CERN-OHL-1.2.json","referenceNumber":110,"name":"CERN Open Hardware Licence v1.2","licenseId":"CERN-OHL-1.2","seeAlso":["https://www.ohwr.org/project/licenses/wikis/cern-ohl-v1.2"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/ClArtistic.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ClArtistic.json","referenceNumber":111,"name":"Clarified Artistic License","licenseId":"ClArtistic","seeAlso":["https://gianluca.dellavedova.org/2011/01/03/clarified-artistic-license/","https://www.ncftp.com/ncftp/doc/LICENSE.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CNRI-Jython.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CNRI-Jython.json","referenceNumber":112,"name":"CNRI Jython License","licenseId":"CNRI-Jython","seeAlso":["https://www.jython.org/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CNRI-Python.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CNRI-Python.json","referenceNumber":113,"name":"CNRI Python License","licenseId":"CNRI-Python","seeAlso":["https://opensource.org/licenses/CNRI-Python"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/CNRI-Python-GPL-Compatible.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CNRI-Python-GPL-Compatible.json","referenceNumber":114,"name":"CNRI Python Open Source GPL Compatible License Agreement","licenseId":"CNRI-Python-GPL-Compatible","seeAlso":["https://www.python.org/download/releases/1.6.1/download_win/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Condor-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Condor-1.1.json","referenceNumber":115,"name":"Condor Public License v1.1","licenseId":"Condor-1.1","seeAlso":["https://research.cs.wisc.edu/condor/license.html#condor","https://web.archive.org/web/20111123062036/http://research.cs.wisc.edu/condor/license.html#condor"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/copyleft-next-0.3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/copyleft-next-0.3.0.json","referenceNumber":116,"name":"copyleft-next 0.3.0","licenseId":"copyleft-next-0.3.0","seeAlso":["https://github.com/copyleft-next/copyleft-next/blob/master/Releases/copyleft-next-0.3.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/copyleft-next-0.3.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/copyleft-next-0.3.1.json","referenceNumber":117,"name":"copyleft-next 0.3.1","licenseId":"copyleft-next-0.3.1","seeAlso":["https://github.com/copyleft-next/copyleft-next/blob/master/Releases/copyleft-next-0.3.1"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CPAL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CPAL-1.0.json","referenceNumber":118,"name":"Common Public Attribution License 1.0","licenseId":"CPAL-1.0","seeAlso":["https://opensource.org/licenses/CPAL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CPL-1.0.json","referenceNumber":119,"name":"Common Public License 1.0","licenseId":"CPL-1.0","seeAlso":["https://opensource.org/licenses/CPL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/CPOL-1.02.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CPOL-1.02.json","referenceNumber":120,"name":"Code Project Open License 1.02","licenseId":"CPOL-1.02","seeAlso":["https://www.codeproject.com/info/cpol10.aspx"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Crossword.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Crossword.json","referenceNumber":121,"name":"Crossword License","licenseId":"Crossword","seeAlso":["https://fedoraproject.org/wiki/Licensing/Crossword"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CrystalStacker.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CrystalStacker.json","referenceNumber":122,"name":"CrystalStacker License","licenseId":"CrystalStacker","seeAlso":["https://fedoraproject.org/wiki/Licensing:CrystalStacker?rd=Licensing/CrystalStacker"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/CUA-OPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/CUA-OPL-1.0.json","referenceNumber":123,"name":"CUA Office Public License v1.0","licenseId":"CUA-OPL-1.0","seeAlso":["https://opensource.org/licenses/CUA-OPL-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Cube.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Cube.json","referenceNumber":124,"name":"Cube License","licenseId":"Cube","seeAlso":["https://fedoraproject.org/wiki/Licensing/Cube"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/curl.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/curl.json","referenceNumber":125,"name":"curl License","licenseId":"curl","seeAlso":["https://github.com/bagder/curl/blob/master/COPYING"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/D-FSL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/D-FSL-1.0.json","referenceNumber":126,"name":"Deutsche Freie Software Lizenz","licenseId":"D-FSL-1.0","seeAlso":["https://www.dipp.nrw.de/d-fsl/lizenzen/","https://www.dipp.nrw.de/d-fsl/index_html/lizenzen/de/D-FSL-1_0_de.txt","https://www.dipp.nrw.de/d-fsl/index_html/lizenzen/en/D-FSL-1_0_en.txt","https://www.hbz-nrw.de/produkte/open-access/lizenzen/dfsl","https://www.hbz-nrw.de/produkte/open-access/lizenzen/dfsl/deutsche-freie-software-lizenz","https://www.hbz-nrw.de/produkte/open-access/lizenzen/dfsl/german-free-software-license","https://www.hbz-nrw.de/produkte/open-access/lizenzen/dfsl/D-FSL-1_0_de.txt/at_download/file","https://www.hbz-nrw.de/produkte/open-access/lizenzen/dfsl/D-FSL-1_0_en.txt/at_download/file"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/diffmark.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/diffmark.json","referenceNumber":127,"name":"diffmark license","licenseId":"diffmark","seeAlso":["https://fedoraproject.org/wiki/Licensing/diffmark"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/DOC.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/DOC.json","referenceNumber":128,"name":"DOC License","licenseId":"DOC","seeAlso":["https://www.cs.wustl.edu/~schmidt/ACE-copying.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Dotseqn.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Dotseqn.json","referenceNumber":129,"name":"Dotseqn License","licenseId":"Dotseqn","seeAlso":["https://fedoraproject.org/wiki/Licensing/Dotseqn"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/DSDP.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/DSDP.json","referenceNumber":130,"name":"DSDP License","licenseId":"DSDP","seeAlso":["https://fedoraproject.org/wiki/Licensing/DSDP"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/dvipdfm.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/dvipdfm.json","referenceNumber":131,"name":"dvipdfm License","licenseId":"dvipdfm","seeAlso":["https://fedoraproject.org/wiki/Licensing/dvipdfm"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/ECL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ECL-1.0.json","referenceNumber":132,"name":"Educational Community License v1.0","licenseId":"ECL-1.0","seeAlso":["https://opensource.org/licenses/ECL-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/ECL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ECL-2.0.json","referenceNumber":133,"name":"Educational Community License v2.0","licenseId":"ECL-2.0","seeAlso":["https://opensource.org/licenses/ECL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/eCos-2.0.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/eCos-2.0.json","referenceNumber":134,"name":"eCos license version 2.0","licenseId":"eCos-2.0","seeAlso":["https://www.gnu.org/licenses/ecos-license.html"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/EFL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/EFL-1.0.json","referenceNumber":135,"name":"Eiffel Forum License v1.0","licenseId":"EFL-1.0","seeAlso":["https://www.eiffel-nice.org/license/forum.txt","https://opensource.org/licenses/EFL-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/EFL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/EFL-2.0.json","referenceNumber":136,"name":"Eiffel Forum License v2.0","licenseId":"EFL-2.0","seeAlso":["https://www.eiffel-nice.org/license/eiffel-forum-license-2.html","https://opensource.org/licenses/EFL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/eGenix.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/eGenix.json","referenceNumber":137,"name":"eGenix.com Public License 1.1.0","licenseId":"eGenix","seeAlso":["https://www.egenix.com/products/eGenix.com-Public-License-1.1.0.pdf","https://fedoraproject.org/wiki/Licensing/eGenix.com_Public_License_1.1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Entessa.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Entessa.json","referenceNumber":138,"name":"Entessa Public License v1.0","licenseId":"Entessa","seeAlso":["https://opensource.org/licenses/Entessa"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/EPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/EPL-1.0.json","referenceNumber":139,"name":"Eclipse Public License 1.0","licenseId":"EPL-1.0","seeAlso":["https://www.eclipse.org/legal/epl-v10.html","https://opensource.org/licenses/EPL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/EPL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/EPL-2.0.json","referenceNumber":140,"name":"Eclipse Public License 2.0","licenseId":"EPL-2.0","seeAlso":["https://www.eclipse.org/legal/epl-2.0","https://www.opensource.org/licenses/EPL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/ErlPL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ErlPL-1.1.json","referenceNumber":141,"name":"Erlang Public License v1.1","licenseId":"ErlPL-1.1","seeAlso":["https://www.erlang.org/EPLICENSE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/etalab-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/etalab-2.0.json","referenceNumber":142,"name":"Etalab Open License 2.0","licenseId":"etalab-2.0","seeAlso":["https://github.com/DISIC/politique-de-contribution-open-source/blob/master/LICENSE.pdf","https://raw.githubusercontent.com/DISIC/politique-de-contribution-open-source/master/LICENSE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/EUDatagrid.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/EUDatagrid.json","referenceNumber":143,"name":"EU DataGrid Software License","licenseId":"EUDatagrid","seeAlso":["https://eu-datagrid.web.cern.ch/eu-datagrid/license.html","https://opensource.org/licenses/EUDatagrid"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/EUPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/EUPL-1.0.json","referenceNumber":144,"name":"European Union Public License 1.0","licenseId":"EUPL-1.0","seeAlso":["https://ec.europa.eu/idabc/en/document/7330.html","https://ec.europa.eu/idabc/servlets/Doc027f.pdf?id=31096"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/EUPL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/EUPL-1.1.json","referenceNumber":145,"name":"European Union Public License 1.1","licenseId":"EUPL-1.1","seeAlso":["https://joinup.ec.europa.eu/software/page/eupl/licence-eupl","https://joinup.ec.europa.eu/sites/default/files/custom-page/attachment/eupl1.1.-licence-en_0.pdf","https://opensource.org/licenses/EUPL-1.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/EUPL-1.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/EUPL-1.2.json","referenceNumber":146,"name":"European Union Public License 1.2","licenseId":"EUPL-1.2","seeAlso":["https://joinup.ec.europa.eu/page/eupl-text-11-12","https://joinup.ec.europa.eu/sites/default/files/custom-page/attachment/eupl_v1.2_en.pdf","https://joinup.ec.europa.eu/sites/default/files/inline-files/EUPL%20v1_2%20EN(1).txt","https://eur-lex.europa.eu/legal-content/EN/TXT/HTML/?uri=CELEX:32017D0863","https://opensource.org/licenses/EUPL-1.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Eurosym.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Eurosym.json","referenceNumber":147,"name":"Eurosym License","licenseId":"Eurosym","seeAlso":["https://fedoraproject.org/wiki/Licensing/Eurosym"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Fair.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Fair.json","referenceNumber":148,"name":"Fair License","licenseId":"Fair","seeAlso":["https://fairlicense.org/","https://opensource.org/licenses/Fair"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Frameworx-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Frameworx-1.0.json","referenceNumber":149,"name":"Frameworx Open License 1.0","licenseId":"Frameworx-1.0","seeAlso":["https://opensource.org/licenses/Frameworx-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/FreeImage.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/FreeImage.json","referenceNumber":150,"name":"FreeImage Public License v1.0","licenseId":"FreeImage","seeAlso":["https://freeimage.sourceforge.net/freeimage-license.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/FSFAP.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/FSFAP.json","referenceNumber":151,"name":"FSF All Permissive License","licenseId":"FSFAP","seeAlso":["https://www.gnu.org/prep/maintain/html_node/License-Notices-for-Other-Files.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/FSFUL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/FSFUL.json","referenceNumber":152,"name":"FSF Unlimited License","licenseId":"FSFUL","seeAlso":["https://fedoraproject.org/wiki/Licensing/FSF_Unlimited_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/FSFULLR.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/FSFULLR.json","referenceNumber":153,"name":"FSF Unlimited License (with License Retention)","licenseId":"FSFULLR","seeAlso":["https://fedoraproject.org/wiki/Licensing/FSF_Unlimited_License#License_Retention_Variant"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/FTL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/FTL.json","referenceNumber":154,"name":"Freetype Project License","licenseId":"FTL","seeAlso":["https://freetype.fis.uniroma2.it/FTL.TXT","https://git.savannah.gnu.org/cgit/freetype/freetype2.git/tree/docs/FTL.TXT"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.1.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GFDL-1.1.json","referenceNumber":155,"name":"GNU Free Documentation License v1.1","licenseId":"GFDL-1.1","seeAlso":["https://www.gnu.org/licenses/old-licenses/fdl-1.1.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.1-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GFDL-1.1-only.json","referenceNumber":156,"name":"GNU Free Documentation License v1.1 only","licenseId":"GFDL-1.1-only","seeAlso":["https://www.gnu.org/licenses/old-licenses/fdl-1.1.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.1-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GFDL-1.1-or-later.json","referenceNumber":157,"name":"GNU Free Documentation License v1.1 or later","licenseId":"GFDL-1.1-or-later","seeAlso":["https://www.gnu.org/licenses/old-licenses/fdl-1.1.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.2.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GFDL-1.2.json","referenceNumber":158,"name":"GNU Free Documentation License v1.2","licenseId":"GFDL-1.2","seeAlso":["https://www.gnu.org/licenses/old-licenses/fdl-1.2.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.2-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GFDL-1.2-only.json","referenceNumber":159,"name":"GNU Free Documentation License v1.2 only","licenseId":"GFDL-1.2-only","seeAlso":["https://www.gnu.org/licenses/old-licenses/fdl-1.2.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.2-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GFDL-1.2-or-later.json","referenceNumber":160,"name":"GNU Free Documentation License v1.2 or later","licenseId":"GFDL-1.2-or-later","seeAlso":["https://www.gnu.org/licenses/old-licenses/fdl-1.2.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.3.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GFDL-1.3.json","referenceNumber":161,"name":"GNU Free Documentation License v1.3","licenseId":"GFDL-1.3","seeAlso":["https://www.gnu.org/licenses/fdl-1.3.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.3-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GFDL-1.3-only.json","referenceNumber":162,"name":"GNU Free Documentation License v1.3 only","licenseId":"GFDL-1.3-only","seeAlso":["https://www.gnu.org/licenses/fdl-1.3.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GFDL-1.3-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GFDL-1.3-or-later.json","referenceNumber":163,"name":"GNU Free Documentation License v1.3 or later","licenseId":"GFDL-1.3-or-later","seeAlso":["https://www.gnu.org/licenses/fdl-1.3.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Giftware.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Giftware.json","referenceNumber":164,"name":"Giftware License","licenseId":"Giftware","seeAlso":["https://liballeg.org/license.html#allegro-4-the-giftware-license"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GL2PS.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GL2PS.json","referenceNumber":165,"name":"GL2PS License","licenseId":"GL2PS","seeAlso":["https://www.geuz.org/gl2ps/COPYING.GL2PS"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Glide.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Glide.json","referenceNumber":166,"name":"3dfx Glide License","licenseId":"Glide","seeAlso":["https://www.users.on.net/~triforce/glidexp/COPYING.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Glulxe.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Glulxe.json","referenceNumber":167,"name":"Glulxe License","licenseId":"Glulxe","seeAlso":["https://fedoraproject.org/wiki/Licensing/Glulxe"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/gnuplot.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/gnuplot.json","referenceNumber":168,"name":"gnuplot License","licenseId":"gnuplot","seeAlso":["https://fedoraproject.org/wiki/Licensing/Gnuplot"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-1.0.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-1.0.json","referenceNumber":169,"name":"GNU General Public License v1.0 only","licenseId":"GPL-1.0","seeAlso":["https://www.gnu.org/licenses/old-licenses/gpl-1.0-standalone.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-1.0+.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-1.0+.json","referenceNumber":170,"name":"GNU General Public License v1.0 or later","licenseId":"GPL-1.0+","seeAlso":["https://www.gnu.org/licenses/old-licenses/gpl-1.0-standalone.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-1.0-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GPL-1.0-only.json","referenceNumber":171,"name":"GNU General Public License v1.0 only","licenseId":"GPL-1.0-only","seeAlso":["https://www.gnu.org/licenses/old-licenses/gpl-1.0-standalone.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-1.0-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GPL-1.0-or-later.json","referenceNumber":172,"name":"GNU General Public License v1.0 or later","licenseId":"GPL-1.0-or-later","seeAlso":["https://www.gnu.org/licenses/old-licenses/gpl-1.0-standalone.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-2.0.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-2.0.json","referenceNumber":173,"name":"GNU General Public License v2.0 only","licenseId":"GPL-2.0","seeAlso":["https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html","https://opensource.org/licenses/GPL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-2.0+.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-2.0+.json","referenceNumber":174,"name":"GNU General Public License v2.0 or later","licenseId":"GPL-2.0+","seeAlso":["https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html","https://opensource.org/licenses/GPL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-2.0-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GPL-2.0-only.json","referenceNumber":175,"name":"GNU General Public License v2.0 only","licenseId":"GPL-2.0-only","seeAlso":["https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html","https://opensource.org/licenses/GPL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-2.0-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GPL-2.0-or-later.json","referenceNumber":176,"name":"GNU General Public License v2.0 or later","licenseId":"GPL-2.0-or-later","seeAlso":["https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html","https://opensource.org/licenses/GPL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-2.0-with-autoconf-exception.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-2.0-with-autoconf-exception.json","referenceNumber":177,"name":"GNU General Public License v2.0 w/Autoconf exception","licenseId":"GPL-2.0-with-autoconf-exception","seeAlso":["https://ac-archive.sourceforge.net/doc/copyright.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-2.0-with-bison-exception.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-2.0-with-bison-exception.json","referenceNumber":178,"name":"GNU General Public License v2.0 w/Bison exception","licenseId":"GPL-2.0-with-bison-exception","seeAlso":["https://git.savannah.gnu.org/cgit/bison.git/tree/data/yacc.c?id=193d7c7054ba7197b0789e14965b739162319b5e#n141"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-2.0-with-classpath-exception.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-2.0-with-classpath-exception.json","referenceNumber":179,"name":"GNU General Public License v2.0 w/Classpath exception","licenseId":"GPL-2.0-with-classpath-exception","seeAlso":["https://www.gnu.org/software/classpath/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-2.0-with-font-exception.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-2.0-with-font-exception.json","referenceNumber":180,"name":"GNU General Public License v2.0 w/Font exception","licenseId":"GPL-2.0-with-font-exception","seeAlso":["https://www.gnu.org/licenses/gpl-faq.html#FontException"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-2.0-with-GCC-exception.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-2.0-with-GCC-exception.json","referenceNumber":181,"name":"GNU General Public License v2.0 w/GCC Runtime Library exception","licenseId":"GPL-2.0-with-GCC-exception","seeAlso":["https://gcc.gnu.org/git/?p=gcc.git;a=blob;f=gcc/libgcc1.c;h=762f5143fc6eed57b6797c82710f3538aa52b40b;hb=cb143a3ce4fb417c68f5fa2691a1b1b1053dfba9#l10"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-3.0.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-3.0.json","referenceNumber":182,"name":"GNU General Public License v3.0 only","licenseId":"GPL-3.0","seeAlso":["https://www.gnu.org/licenses/gpl-3.0-standalone.html","https://opensource.org/licenses/GPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-3.0+.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-3.0+.json","referenceNumber":183,"name":"GNU General Public License v3.0 or later","licenseId":"GPL-3.0+","seeAlso":["https://www.gnu.org/licenses/gpl-3.0-standalone.html","https://opensource.org/licenses/GPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-3.0-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GPL-3.0-only.json","referenceNumber":184,"name":"GNU General Public License v3.0 only","licenseId":"GPL-3.0-only","seeAlso":["https://www.gnu.org/licenses/gpl-3.0-standalone.html","https://opensource.org/licenses/GPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-3.0-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/GPL-3.0-or-later.json","referenceNumber":185,"name":"GNU General Public License v3.0 or later","licenseId":"GPL-3.0-or-later","seeAlso":["https://www.gnu.org/licenses/gpl-3.0-standalone.html","https://opensource.org/licenses/GPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/GPL-3.0-with-autoconf-exception.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-3.0-with-autoconf-exception.json","referenceNumber":186,"name":"GNU General Public License v3.0 w/Autoconf exception","licenseId":"GPL-3.0-with-autoconf-exception","seeAlso":["https://www.gnu.org/licenses/autoconf-exception-3.0.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/GPL-3.0-with-GCC-exception.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/GPL-3.0-with-GCC-exception.json","referenceNumber":187,"name":"GNU General Public License v3.0 w/GCC Runtime Library exception","licenseId":"GPL-3.0-with-GCC-exception","seeAlso":["https://www.gnu.org/licenses/gcc-exception-3.1.html"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/gSOAP-1.3b.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/gSOAP-1.3b.json","referenceNumber":188,"name":"gSOAP Public License v1.3b","licenseId":"gSOAP-1.3b","seeAlso":["https://www.cs.fsu.edu/~engelen/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/HaskellReport.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/HaskellReport.json","referenceNumber":189,"name":"Haskell Language Report License","licenseId":"HaskellReport","seeAlso":["https://fedoraproject.org/wiki/Licensing/Haskell_Language_Report_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/HPND.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/HPND.json","referenceNumber":190,"name":"Historical Permission Notice and Disclaimer","licenseId":"HPND","seeAlso":["https://opensource.org/licenses/HPND"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/HPND-sell-variant.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/HPND-sell-variant.json","referenceNumber":191,"name":"Historical Permission Notice and Disclaimer - sell variant","licenseId":"HPND-sell-variant","seeAlso":["https://git.kernel.org/pub/scm/linux/kernel/git/torvalds/linux.git/tree/net/sunrpc/auth_gss/gss_generic_token.c?h=v4.19"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/IBM-pibs.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/IBM-pibs.json","referenceNumber":192,"name":"IBM PowerPC Initialization and Boot Software","licenseId":"IBM-pibs","seeAlso":["https://git.denx.de/?p=u-boot.git;a=blob;f=arch/powerpc/cpu/ppc4xx/miiphy.c;h=297155fdafa064b955e53e9832de93bfb0cfb85b;hb=9fab4bf4cc077c21e43941866f3f2c196f28670d"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/ICU.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ICU.json","referenceNumber":193,"name":"ICU License","licenseId":"ICU","seeAlso":["https://source.icu-project.org/repos/icu/icu/trunk/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/IJG.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/IJG.json","referenceNumber":194,"name":"Independent JPEG Group License","licenseId":"IJG","seeAlso":["https://dev.w3.org/cvsweb/Amaya/libjpeg/Attic/README?rev=1.2"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/ImageMagick.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ImageMagick.json","referenceNumber":195,"name":"ImageMagick License","licenseId":"ImageMagick","seeAlso":["https://www.imagemagick.org/script/license.php"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/iMatix.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/iMatix.json","referenceNumber":196,"name":"iMatix Standard Function Library Agreement","licenseId":"iMatix","seeAlso":["https://legacy.imatix.com/html/sfl/sfl4.htm#license"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Imlib2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Imlib2.json","referenceNumber":197,"name":"Imlib2 License","licenseId":"Imlib2","seeAlso":["https://trac.enlightenment.org/e/browser/trunk/imlib2/COPYING","https://git.enlightenment.org/legacy/imlib2.git/tree/COPYING"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Info-ZIP.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Info-ZIP.json","referenceNumber":198,"name":"Info-ZIP License","licenseId":"Info-ZIP","seeAlso":["https://www.info-zip.org/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Intel.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Intel.json","referenceNumber":199,"name":"Intel Open Source License","licenseId":"Intel","seeAlso":["https://opensource.org/licenses/Intel"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Intel-ACPI.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Intel-ACPI.json","referenceNumber":200,"name":"Intel ACPI Software License Agreement","licenseId":"Intel-ACPI","seeAlso":["https://fedoraproject.org/wiki/Licensing/Intel_ACPI_Software_License_Agreement"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Interbase-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Interbase-1.0.json","referenceNumber":201,"name":"Interbase Public License v1.0","licenseId":"Interbase-1.0","seeAlso":["https://web.archive.org/web/20060319014854/http://info.borland.com/devsupport/interbase/opensource/IPL.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/IPA.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/IPA.json","referenceNumber":202,"name":"IPA Font License","licenseId":"IPA","seeAlso":["https://opensource.org/licenses/IPA"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/IPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/IPL-1.0.json","referenceNumber":203,"name":"IBM Public License v1.0","licenseId":"IPL-1.0","seeAlso":["https://opensource.org/licenses/IPL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/ISC.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ISC.json","referenceNumber":204,"name":"ISC License","licenseId":"ISC","seeAlso":["https://www.isc.org/downloads/software-support-policy/isc-license/","https://opensource.org/licenses/ISC"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/JasPer-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/JasPer-2.0.json","referenceNumber":205,"name":"JasPer License","licenseId":"JasPer-2.0","seeAlso":["https://www.ece.uvic.ca/~mdadams/jasper/LICENSE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/JPNIC.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/JPNIC.json","referenceNumber":206,"name":"Japan Network Information Center License","licenseId":"JPNIC","seeAlso":["https://gitlab.isc.org/isc-projects/bind9/blob/master/COPYRIGHT#L366"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/JSON.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/JSON.json","referenceNumber":207,"name":"JSON License","licenseId":"JSON","seeAlso":["https://www.json.org/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/LAL-1.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LAL-1.2.json","referenceNumber":208,"name":"Licence Art Libre 1.2","licenseId":"LAL-1.2","seeAlso":["https://artlibre.org/licence/lal/licence-art-libre-12/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/LAL-1.3.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LAL-1.3.json","referenceNumber":209,"name":"Licence Art Libre 1.3","licenseId":"LAL-1.3","seeAlso":["https://artlibre.org/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Latex2e.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Latex2e.json","referenceNumber":210,"name":"Latex2e License","licenseId":"Latex2e","seeAlso":["https://fedoraproject.org/wiki/Licensing/Latex2e"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Leptonica.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Leptonica.json","referenceNumber":211,"name":"Leptonica License","licenseId":"Leptonica","seeAlso":["https://fedoraproject.org/wiki/Licensing/Leptonica"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/LGPL-2.0.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/LGPL-2.0.json","referenceNumber":212,"name":"GNU Library General Public License v2 only","licenseId":"LGPL-2.0","seeAlso":["https://www.gnu.org/licenses/old-licenses/lgpl-2.0-standalone.html"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/LGPL-2.0+.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/LGPL-2.0+.json","referenceNumber":213,"name":"GNU Library General Public License v2 or later","licenseId":"LGPL-2.0+","seeAlso":["https://www.gnu.org/licenses/old-licenses/lgpl-2.0-standalone.html"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/LGPL-2.0-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LGPL-2.0-only.json","referenceNumber":214,"name":"GNU Library General Public License v2 only","licenseId":"LGPL-2.0-only","seeAlso":["https://www.gnu.org/licenses/old-licenses/lgpl-2.0-standalone.html"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/LGPL-2.0-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LGPL-2.0-or-later.json","referenceNumber":215,"name":"GNU Library General Public License v2 or later","licenseId":"LGPL-2.0-or-later","seeAlso":["https://www.gnu.org/licenses/old-licenses/lgpl-2.0-standalone.html"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/LGPL-2.1.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/LGPL-2.1.json","referenceNumber":216,"name":"GNU Lesser General Public License v2.1 only","licenseId":"LGPL-2.1","seeAlso":["https://www.gnu.org/licenses/old-licenses/lgpl-2.1-standalone.html","https://opensource.org/licenses/LGPL-2.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LGPL-2.1+.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/LGPL-2.1+.json","referenceNumber":217,"name":"GNU Library General Public License v2.1 or later","licenseId":"LGPL-2.1+","seeAlso":["https://www.gnu.org/licenses/old-licenses/lgpl-2.1-standalone.html","https://opensource.org/licenses/LGPL-2.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LGPL-2.1-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LGPL-2.1-only.json","referenceNumber":218,"name":"GNU Lesser General Public License v2.1 only","licenseId":"LGPL-2.1-only","seeAlso":["https://www.gnu.org/licenses/old-licenses/lgpl-2.1-standalone.html","https://opensource.org/licenses/LGPL-2.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LGPL-2.1-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LGPL-2.1-or-later.json","referenceNumber":219,"name":"GNU Lesser General Public License v2.1 or later","licenseId":"LGPL-2.1-or-later","seeAlso":["https://www.gnu.org/licenses/old-licenses/lgpl-2.1-standalone.html","https://opensource.org/licenses/LGPL-2.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LGPL-3.0.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/LGPL-3.0.json","referenceNumber":220,"name":"GNU Lesser General Public License v3.0 only","licenseId":"LGPL-3.0","seeAlso":["https://www.gnu.org/licenses/lgpl-3.0-standalone.html","https://opensource.org/licenses/LGPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LGPL-3.0+.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/LGPL-3.0+.json","referenceNumber":221,"name":"GNU Lesser General Public License v3.0 or later","licenseId":"LGPL-3.0+","seeAlso":["https://www.gnu.org/licenses/lgpl-3.0-standalone.html","https://opensource.org/licenses/LGPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LGPL-3.0-only.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LGPL-3.0-only.json","referenceNumber":222,"name":"GNU Lesser General Public License v3.0 only","licenseId":"LGPL-3.0-only","seeAlso":["https://www.gnu.org/licenses/lgpl-3.0-standalone.html","https://opensource.org/licenses/LGPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LGPL-3.0-or-later.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LGPL-3.0-or-later.json","referenceNumber":223,"name":"GNU Lesser General Public License v3.0 or later","licenseId":"LGPL-3.0-or-later","seeAlso":["https://www.gnu.org/licenses/lgpl-3.0-standalone.html","https://opensource.org/licenses/LGPL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LGPLLR.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LGPLLR.json","referenceNumber":224,"name":"Lesser General Public License For Linguistic Resources","licenseId":"LGPLLR","seeAlso":["https://www-igm.univ-mlv.fr/~unitex/lgpllr.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Libpng.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Libpng.json","referenceNumber":225,"name":"libpng License","licenseId":"Libpng","seeAlso":["https://www.libpng.org/pub/png/src/libpng-LICENSE.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/libpng-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/libpng-2.0.json","referenceNumber":226,"name":"PNG Reference Library version 2","licenseId":"libpng-2.0","seeAlso":["https://www.libpng.org/pub/png/src/libpng-LICENSE.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/libselinux-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/libselinux-1.0.json","referenceNumber":227,"name":"libselinux public domain notice","licenseId":"libselinux-1.0","seeAlso":["https://github.com/SELinuxProject/selinux/blob/master/libselinux/LICENSE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/libtiff.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/libtiff.json","referenceNumber":228,"name":"libtiff License","licenseId":"libtiff","seeAlso":["https://fedoraproject.org/wiki/Licensing/libtiff"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/LiLiQ-P-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LiLiQ-P-1.1.json","referenceNumber":229,"name":"Licence Libre du Qu\u00e9bec \u2013 Permissive version 1.1","licenseId":"LiLiQ-P-1.1","seeAlso":["https://forge.gouv.qc.ca/licence/fr/liliq-v1-1/","https://opensource.org/licenses/LiLiQ-P-1.1"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/LiLiQ-R-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LiLiQ-R-1.1.json","referenceNumber":230,"name":"Licence Libre du Qu\u00e9bec \u2013 R\u00e9ciprocit\u00e9 version 1.1","licenseId":"LiLiQ-R-1.1","seeAlso":["https://www.forge.gouv.qc.ca/participez/licence-logicielle/licence-libre-du-quebec-liliq-en-francais/licence-libre-du-quebec-reciprocite-liliq-r-v1-1/","https://opensource.org/licenses/LiLiQ-R-1.1"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/LiLiQ-Rplus-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LiLiQ-Rplus-1.1.json","referenceNumber":231,"name":"Licence Libre du Qu\u00e9bec \u2013 R\u00e9ciprocit\u00e9 forte version 1.1","licenseId":"LiLiQ-Rplus-1.1","seeAlso":["https://www.forge.gouv.qc.ca/participez/licence-logicielle/licence-libre-du-quebec-liliq-en-francais/licence-libre-du-quebec-reciprocite-forte-liliq-r-v1-1/","https://opensource.org/licenses/LiLiQ-Rplus-1.1"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Linux-OpenIB.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Linux-OpenIB.json","referenceNumber":232,"name":"Linux Kernel Variant of OpenIB.org license","licenseId":"Linux-OpenIB","seeAlso":["https://git.kernel.org/pub/scm/linux/kernel/git/torvalds/linux.git/tree/drivers/infiniband/core/sa.h"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/LPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LPL-1.0.json","referenceNumber":233,"name":"Lucent Public License Version 1.0","licenseId":"LPL-1.0","seeAlso":["https://opensource.org/licenses/LPL-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/LPL-1.02.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LPL-1.02.json","referenceNumber":234,"name":"Lucent Public License v1.02","licenseId":"LPL-1.02","seeAlso":["https://plan9.bell-labs.com/plan9/license.html","https://opensource.org/licenses/LPL-1.02"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LPPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LPPL-1.0.json","referenceNumber":235,"name":"LaTeX Project Public License v1.0","licenseId":"LPPL-1.0","seeAlso":["https://www.latex-project.org/lppl/lppl-1-0.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/LPPL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LPPL-1.1.json","referenceNumber":236,"name":"LaTeX Project Public License v1.1","licenseId":"LPPL-1.1","seeAlso":["https://www.latex-project.org/lppl/lppl-1-1.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/LPPL-1.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LPPL-1.2.json","referenceNumber":237,"name":"LaTeX Project Public License v1.2","licenseId":"LPPL-1.2","seeAlso":["https://www.latex-project.org/lppl/lppl-1-2.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LPPL-1.3a.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LPPL-1.3a.json","referenceNumber":238,"name":"LaTeX Project Public License v1.3a","licenseId":"LPPL-1.3a","seeAlso":["https://www.latex-project.org/lppl/lppl-1-3a.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/LPPL-1.3c.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/LPPL-1.3c.json","referenceNumber":239,"name":"LaTeX Project Public License v1.3c","licenseId":"LPPL-1.3c","seeAlso":["https://www.latex-project.org/lppl/lppl-1-3c.txt","https://opensource.org/licenses/LPPL-1.3c"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/MakeIndex.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MakeIndex.json","referenceNumber":240,"name":"MakeIndex License","licenseId":"MakeIndex","seeAlso":["https://fedoraproject.org/wiki/Licensing/MakeIndex"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/MirOS.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MirOS.json","referenceNumber":241,"name":"The MirOS Licence","licenseId":"MirOS","seeAlso":["https://opensource.org/licenses/MirOS"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/MIT.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MIT.json","referenceNumber":242,"name":"MIT License","licenseId":"MIT","seeAlso":["https://opensource.org/licenses/MIT",".mit-license.org"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/MIT-0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MIT-0.json","referenceNumber":243,"name":"MIT No Attribution","licenseId":"MIT-0","seeAlso":["https://github.com/aws/mit-0","https://romanrm.net/mit-zero","https://github.com/awsdocs/aws-cloud9-user-guide/blob/master/LICENSE-SAMPLECODE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/MIT-advertising.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MIT-advertising.json","referenceNumber":244,"name":"Enlightenment License (e16)","licenseId":"MIT-advertising","seeAlso":["https://fedoraproject.org/wiki/Licensing/MIT_With_Advertising"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/MIT-CMU.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MIT-CMU.json","referenceNumber":245,"name":"CMU License","licenseId":"MIT-CMU","seeAlso":["https://fedoraproject.org/wiki/Licensing:MIT?rd=Licensing/MIT#CMU_Style","https://github.com/python-pillow/Pillow/blob/fffb426092c8db24a5f4b6df243a8a3c01fb63cd/LICENSE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/MIT-enna.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MIT-enna.json","referenceNumber":246,"name":"enna License","licenseId":"MIT-enna","seeAlso":["https://fedoraproject.org/wiki/Licensing/MIT#enna"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/MIT-feh.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MIT-feh.json","referenceNumber":247,"name":"feh License","licenseId":"MIT-feh","seeAlso":["https://fedoraproject.org/wiki/Licensing/MIT#feh"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/MITNFA.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MITNFA.json","referenceNumber":248,"name":"MIT +no-false-attribs license","licenseId":"MITNFA","seeAlso":["https://fedoraproject.org/wiki/Licensing/MITNFA"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Motosoto.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Motosoto.json","referenceNumber":249,"name":"Motosoto License","licenseId":"Motosoto","seeAlso":["https://opensource.org/licenses/Motosoto"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/mpich2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/mpich2.json","referenceNumber":250,"name":"mpich2 License","licenseId":"mpich2","seeAlso":["https://fedoraproject.org/wiki/Licensing/MIT"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/MPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MPL-1.0.json","referenceNumber":251,"name":"Mozilla Public License 1.0","licenseId":"MPL-1.0","seeAlso":["https://www.mozilla.org/MPL/MPL-1.0.html","https://opensource.org/licenses/MPL-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/MPL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MPL-1.1.json","referenceNumber":252,"name":"Mozilla Public License 1.1","licenseId":"MPL-1.1","seeAlso":["https://www.mozilla.org/MPL/MPL-1.1.html","https://opensource.org/licenses/MPL-1.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/MPL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MPL-2.0.json","referenceNumber":253,"name":"Mozilla Public License 2.0","licenseId":"MPL-2.0","seeAlso":["https://www.mozilla.org/MPL/2.0/","https://opensource.org/licenses/MPL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/MPL-2.0-no-copyleft-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MPL-2.0-no-copyleft-exception.json","referenceNumber":254,"name":"Mozilla Public License 2.0 (no copyleft exception)","licenseId":"MPL-2.0-no-copyleft-exception","seeAlso":["https://www.mozilla.org/MPL/2.0/","https://opensource.org/licenses/MPL-2.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/MS-PL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MS-PL.json","referenceNumber":255,"name":"Microsoft Public License","licenseId":"MS-PL","seeAlso":["https://www.microsoft.com/opensource/licenses.mspx","https://opensource.org/licenses/MS-PL"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/MS-RL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MS-RL.json","referenceNumber":256,"name":"Microsoft Reciprocal License","licenseId":"MS-RL","seeAlso":["https://www.microsoft.com/opensource/licenses.mspx","https://opensource.org/licenses/MS-RL"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/MTLL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MTLL.json","referenceNumber":257,"name":"Matrix Template Library License","licenseId":"MTLL","seeAlso":["https://fedoraproject.org/wiki/Licensing/Matrix_Template_Library_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/MulanPSL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/MulanPSL-1.0.json","referenceNumber":258,"name":"Mulan Permissive Software License, Version 1","licenseId":"MulanPSL-1.0","seeAlso":["https://license.coscl.org.cn/MulanPSL/","https://github.com/yuwenlong/longphp/blob/25dfb70cc2a466dc4bb55ba30901cbce08d164b5/LICENSE"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Multics.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Multics.json","referenceNumber":259,"name":"Multics License","licenseId":"Multics","seeAlso":["https://opensource.org/licenses/Multics"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Mup.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Mup.json","referenceNumber":260,"name":"Mup License","licenseId":"Mup","seeAlso":["https://fedoraproject.org/wiki/Licensing/Mup"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/NASA-1.3.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NASA-1.3.json","referenceNumber":261,"name":"NASA Open Source Agreement 1.3","licenseId":"NASA-1.3","seeAlso":["https://ti.arc.nasa.gov/opensource/nosa/","https://opensource.org/licenses/NASA-1.3"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Naumen.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Naumen.json","referenceNumber":262,"name":"Naumen Public License","licenseId":"Naumen","seeAlso":["https://opensource.org/licenses/Naumen"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/NBPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NBPL-1.0.json","referenceNumber":263,"name":"Net Boolean Public License v1","licenseId":"NBPL-1.0","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=37b4b3f6cc4bf34e1d3dec61e69914b9819d8894"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/NCSA.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NCSA.json","referenceNumber":264,"name":"University of Illinois/NCSA Open Source License","licenseId":"NCSA","seeAlso":["https://otm.illinois.edu/uiuc_openSource","https://opensource.org/licenses/NCSA"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Net-SNMP.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Net-SNMP.json","referenceNumber":265,"name":"Net-SNMP License","licenseId":"Net-SNMP","seeAlso":["https://net-snmp.sourceforge.net/about/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/NetCDF.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NetCDF.json","referenceNumber":266,"name":"NetCDF license","licenseId":"NetCDF","seeAlso":["https://www.unidata.ucar.edu/software/netcdf/copyright.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Newsletr.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Newsletr.json","referenceNumber":267,"name":"Newsletr License","licenseId":"Newsletr","seeAlso":["https://fedoraproject.org/wiki/Licensing/Newsletr"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/NGPL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NGPL.json","referenceNumber":268,"name":"Nethack General Public License","licenseId":"NGPL","seeAlso":["https://opensource.org/licenses/NGPL"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/NLOD-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NLOD-1.0.json","referenceNumber":269,"name":"Norwegian Licence for Open Government Data","licenseId":"NLOD-1.0","seeAlso":["https://data.norge.no/nlod/en/1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/NLPL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NLPL.json","referenceNumber":270,"name":"No Limit Public License","licenseId":"NLPL","seeAlso":["https://fedoraproject.org/wiki/Licensing/NLPL"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Nokia.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Nokia.json","referenceNumber":271,"name":"Nokia Open Source License","licenseId":"Nokia","seeAlso":["https://opensource.org/licenses/nokia"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/NOSL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NOSL.json","referenceNumber":272,"name":"Netizen Open Source License","licenseId":"NOSL","seeAlso":["https://bits.netizen.com.au/licenses/NOSL/nosl.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Noweb.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Noweb.json","referenceNumber":273,"name":"Noweb License","licenseId":"Noweb","seeAlso":["https://fedoraproject.org/wiki/Licensing/Noweb"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/NPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NPL-1.0.json","referenceNumber":274,"name":"Netscape Public License v1.0","licenseId":"NPL-1.0","seeAlso":["https://www.mozilla.org/MPL/NPL/1.0/"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/NPL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NPL-1.1.json","referenceNumber":275,"name":"Netscape Public License v1.1","licenseId":"NPL-1.1","seeAlso":["https://www.mozilla.org/MPL/NPL/1.1/"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/NPOSL-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NPOSL-3.0.json","referenceNumber":276,"name":"Non-Profit Open Software License 3.0","licenseId":"NPOSL-3.0","seeAlso":["https://opensource.org/licenses/NOSL3.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/NRL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NRL.json","referenceNumber":277,"name":"NRL License","licenseId":"NRL","seeAlso":["https://web.mit.edu/network/isakmp/nrllicense.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/NTP.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NTP.json","referenceNumber":278,"name":"NTP License","licenseId":"NTP","seeAlso":["https://opensource.org/licenses/NTP"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/NTP-0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/NTP-0.json","referenceNumber":279,"name":"NTP No Attribution","licenseId":"NTP-0","seeAlso":["https://github.com/tytso/e2fsprogs/blob/master/lib/et/et_name.c"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Nunit.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/Nunit.json","referenceNumber":280,"name":"Nunit License","licenseId":"Nunit","seeAlso":["https://fedoraproject.org/wiki/Licensing/Nunit"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OCCT-PL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OCCT-PL.json","referenceNumber":281,"name":"Open CASCADE Technology Public License","licenseId":"OCCT-PL","seeAlso":["https://www.opencascade.com/content/occt-public-license"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OCLC-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OCLC-2.0.json","referenceNumber":282,"name":"OCLC Research Public License 2.0","licenseId":"OCLC-2.0","seeAlso":["https://www.oclc.org/research/activities/software/license/v2final.htm","https://opensource.org/licenses/OCLC-2.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/ODbL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ODbL-1.0.json","referenceNumber":283,"name":"ODC Open Database License v1.0","licenseId":"ODbL-1.0","seeAlso":["https://www.opendatacommons.org/licenses/odbl/1.0/"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/ODC-By-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ODC-By-1.0.json","referenceNumber":284,"name":"Open Data Commons Attribution License v1.0","licenseId":"ODC-By-1.0","seeAlso":["https://opendatacommons.org/licenses/by/1.0/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OFL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OFL-1.0.json","referenceNumber":285,"name":"SIL Open Font License 1.0","licenseId":"OFL-1.0","seeAlso":["https://scripts.sil.org/cms/scripts/page.php?item_id=OFL10_web"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OFL-1.0-no-RFN.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OFL-1.0-no-RFN.json","referenceNumber":286,"name":"SIL Open Font License 1.0 with no Reserved Font Name","licenseId":"OFL-1.0-no-RFN","seeAlso":["https://scripts.sil.org/cms/scripts/page.php?item_id=OFL10_web"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OFL-1.0-RFN.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OFL-1.0-RFN.json","referenceNumber":287,"name":"SIL Open Font License 1.0 with Reserved Font Name","licenseId":"OFL-1.0-RFN","seeAlso":["https://scripts.sil.org/cms/scripts/page.php?item_id=OFL10_web"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OFL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OFL-1.1.json","referenceNumber":288,"name":"SIL Open Font License 1.1","licenseId":"OFL-1.1","seeAlso":["https://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web","https://opensource.org/licenses/OFL-1.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OFL-1.1-no-RFN.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OFL-1.1-no-RFN.json","referenceNumber":289,"name":"SIL Open Font License 1.1 with no Reserved Font Name","licenseId":"OFL-1.1-no-RFN","seeAlso":["https://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web","https://opensource.org/licenses/OFL-1.1"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/OFL-1.1-RFN.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OFL-1.1-RFN.json","referenceNumber":290,"name":"SIL Open Font License 1.1 with Reserved Font Name","licenseId":"OFL-1.1-RFN","seeAlso":["https://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web","https://opensource.org/licenses/OFL-1.1"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/OGL-Canada-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OGL-Canada-2.0.json","referenceNumber":291,"name":"Open Government Licence - Canada","licenseId":"OGL-Canada-2.0","seeAlso":["https://open.canada.ca/en/open-government-licence-canada"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OGL-UK-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OGL-UK-1.0.json","referenceNumber":292,"name":"Open Government Licence v1.0","licenseId":"OGL-UK-1.0","seeAlso":["https://www.nationalarchives.gov.uk/doc/open-government-licence/version/1/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OGL-UK-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OGL-UK-2.0.json","referenceNumber":293,"name":"Open Government Licence v2.0","licenseId":"OGL-UK-2.0","seeAlso":["https://www.nationalarchives.gov.uk/doc/open-government-licence/version/2/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OGL-UK-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OGL-UK-3.0.json","referenceNumber":294,"name":"Open Government Licence v3.0","licenseId":"OGL-UK-3.0","seeAlso":["https://www.nationalarchives.gov.uk/doc/open-government-licence/version/3/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OGTSL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OGTSL.json","referenceNumber":295,"name":"Open Group Test Suite License","licenseId":"OGTSL","seeAlso":["https://www.opengroup.org/testing/downloads/The_Open_Group_TSL.txt","https://opensource.org/licenses/OGTSL"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/OLDAP-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-1.1.json","referenceNumber":296,"name":"Open LDAP Public License v1.1","licenseId":"OLDAP-1.1","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=806557a5ad59804ef3a44d5abfbe91d706b0791f"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-1.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-1.2.json","referenceNumber":297,"name":"Open LDAP Public License v1.2","licenseId":"OLDAP-1.2","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=42b0383c50c299977b5893ee695cf4e486fb0dc7"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-1.3.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-1.3.json","referenceNumber":298,"name":"Open LDAP Public License v1.3","licenseId":"OLDAP-1.3","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=e5f8117f0ce088d0bd7a8e18ddf37eaa40eb09b1"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-1.4.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-1.4.json","referenceNumber":299,"name":"Open LDAP Public License v1.4","licenseId":"OLDAP-1.4","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=c9f95c2f3f2ffb5e0ae55fe7388af75547660941"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.0.json","referenceNumber":300,"name":"Open LDAP Public License v2.0 (or possibly 2.0A and 2.0B)","licenseId":"OLDAP-2.0","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=cbf50f4e1185a21abd4c0a54d3f4341fe28f36ea"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.0.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.0.1.json","referenceNumber":301,"name":"Open LDAP Public License v2.0.1","licenseId":"OLDAP-2.0.1","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=b6d68acd14e51ca3aab4428bf26522aa74873f0e"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.1.json","referenceNumber":302,"name":"Open LDAP Public License v2.1","licenseId":"OLDAP-2.1","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=b0d176738e96a0d3b9f85cb51e140a86f21be715"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.2.json","referenceNumber":303,"name":"Open LDAP Public License v2.2","licenseId":"OLDAP-2.2","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=470b0c18ec67621c85881b2733057fecf4a1acc3"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.2.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.2.1.json","referenceNumber":304,"name":"Open LDAP Public License v2.2.1","licenseId":"OLDAP-2.2.1","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=4bc786f34b50aa301be6f5600f58a980070f481e"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.2.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.2.2.json","referenceNumber":305,"name":"Open LDAP Public License 2.2.2","licenseId":"OLDAP-2.2.2","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=df2cc1e21eb7c160695f5b7cffd6296c151ba188"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.3.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.3.json","referenceNumber":306,"name":"Open LDAP Public License v2.3","licenseId":"OLDAP-2.3","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=d32cf54a32d581ab475d23c810b0a7fbaf8d63c3"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OLDAP-2.4.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.4.json","referenceNumber":307,"name":"Open LDAP Public License v2.4","licenseId":"OLDAP-2.4","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=cd1284c4a91a8a380d904eee68d1583f989ed386"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.5.json","referenceNumber":308,"name":"Open LDAP Public License v2.5","licenseId":"OLDAP-2.5","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=6852b9d90022e8593c98205413380536b1b5a7cf"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.6.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.6.json","referenceNumber":309,"name":"Open LDAP Public License v2.6","licenseId":"OLDAP-2.6","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=1cae062821881f41b73012ba816434897abf4205"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OLDAP-2.7.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.7.json","referenceNumber":310,"name":"Open LDAP Public License v2.7","licenseId":"OLDAP-2.7","seeAlso":["https://www.openldap.org/devel/gitweb.cgi?p=openldap.git;a=blob;f=LICENSE;hb=47c2415c1df81556eeb39be6cad458ef87c534a2"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OLDAP-2.8.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OLDAP-2.8.json","referenceNumber":311,"name":"Open LDAP Public License v2.8","licenseId":"OLDAP-2.8","seeAlso":["https://www.openldap.org/software/release/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OML.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OML.json","referenceNumber":312,"name":"Open Market License","licenseId":"OML","seeAlso":["https://fedoraproject.org/wiki/Licensing/Open_Market_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OpenSSL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OpenSSL.json","referenceNumber":313,"name":"OpenSSL License","licenseId":"OpenSSL","seeAlso":["https://www.openssl.org/source/license.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OPL-1.0.json","referenceNumber":314,"name":"Open Public License v1.0","licenseId":"OPL-1.0","seeAlso":["https://old.koalateam.com/jackaroo/OPL_1_0.TXT","https://fedoraproject.org/wiki/Licensing/Open_Public_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/OSET-PL-2.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OSET-PL-2.1.json","referenceNumber":315,"name":"OSET Public License version 2.1","licenseId":"OSET-PL-2.1","seeAlso":["https://www.osetfoundation.org/public-license","https://opensource.org/licenses/OPL-2.1"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/OSL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OSL-1.0.json","referenceNumber":316,"name":"Open Software License 1.0","licenseId":"OSL-1.0","seeAlso":["https://opensource.org/licenses/OSL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OSL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OSL-1.1.json","referenceNumber":317,"name":"Open Software License 1.1","licenseId":"OSL-1.1","seeAlso":["https://fedoraproject.org/wiki/Licensing/OSL1.1"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OSL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OSL-2.0.json","referenceNumber":318,"name":"Open Software License 2.0","licenseId":"OSL-2.0","seeAlso":["https://web.archive.org/web/20041020171434/http://www.rosenlaw.com/osl2.0.html"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OSL-2.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OSL-2.1.json","referenceNumber":319,"name":"Open Software License 2.1","licenseId":"OSL-2.1","seeAlso":["https://web.archive.org/web/20050212003940/http://www.rosenlaw.com/osl21.htm","https://opensource.org/licenses/OSL-2.1"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/OSL-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/OSL-3.0.json","referenceNumber":320,"name":"Open Software License 3.0","licenseId":"OSL-3.0","seeAlso":["https://web.archive.org/web/20120101081418/http://rosenlaw.com:80/OSL3.0.htm","https://opensource.org/licenses/OSL-3.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Parity-6.0.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Parity-6.0.0.json","referenceNumber":321,"name":"The Parity Public License 6.0.0","licenseId":"Parity-6.0.0","seeAlso":["https://paritylicense.com/versions/6.0.0.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/PDDL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/PDDL-1.0.json","referenceNumber":322,"name":"ODC Public Domain Dedication & License 1.0","licenseId":"PDDL-1.0","seeAlso":["https://opendatacommons.org/licenses/pddl/1.0/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/PHP-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/PHP-3.0.json","referenceNumber":323,"name":"PHP License v3.0","licenseId":"PHP-3.0","seeAlso":["https://www.php.net/license/3_0.txt","https://opensource.org/licenses/PHP-3.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/PHP-3.01.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/PHP-3.01.json","referenceNumber":324,"name":"PHP License v3.01","licenseId":"PHP-3.01","seeAlso":["https://www.php.net/license/3_01.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Plexus.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Plexus.json","referenceNumber":325,"name":"Plexus Classworlds License","licenseId":"Plexus","seeAlso":["https://fedoraproject.org/wiki/Licensing/Plexus_Classworlds_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/PostgreSQL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/PostgreSQL.json","referenceNumber":326,"name":"PostgreSQL License","licenseId":"PostgreSQL","seeAlso":["https://www.postgresql.org/about/licence","https://opensource.org/licenses/PostgreSQL"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/PSF-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/PSF-2.0.json","referenceNumber":327,"name":"Python Software Foundation License 2.0","licenseId":"PSF-2.0","seeAlso":["https://opensource.org/licenses/Python-2.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/psfrag.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/psfrag.json","referenceNumber":328,"name":"psfrag License","licenseId":"psfrag","seeAlso":["https://fedoraproject.org/wiki/Licensing/psfrag"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/psutils.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/psutils.json","referenceNumber":329,"name":"psutils License","licenseId":"psutils","seeAlso":["https://fedoraproject.org/wiki/Licensing/psutils"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Python-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Python-2.0.json","referenceNumber":330,"name":"Python License 2.0","licenseId":"Python-2.0","seeAlso":["https://opensource.org/licenses/Python-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Qhull.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Qhull.json","referenceNumber":331,"name":"Qhull License","licenseId":"Qhull","seeAlso":["https://fedoraproject.org/wiki/Licensing/Qhull"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/QPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/QPL-1.0.json","referenceNumber":332,"name":"Q Public License 1.0","licenseId":"QPL-1.0","seeAlso":["https://doc.qt.nokia.com/3.3/license.html","https://opensource.org/licenses/QPL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Rdisc.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Rdisc.json","referenceNumber":333,"name":"Rdisc License","licenseId":"Rdisc","seeAlso":["https://fedoraproject.org/wiki/Licensing/Rdisc_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/RHeCos-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/RHeCos-1.1.json","referenceNumber":334,"name":"Red Hat eCos Public License v1.1","licenseId":"RHeCos-1.1","seeAlso":["https://ecos.sourceware.org/old-license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/RPL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/RPL-1.1.json","referenceNumber":335,"name":"Reciprocal Public License 1.1","licenseId":"RPL-1.1","seeAlso":["https://opensource.org/licenses/RPL-1.1"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/RPL-1.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/RPL-1.5.json","referenceNumber":336,"name":"Reciprocal Public License 1.5","licenseId":"RPL-1.5","seeAlso":["https://opensource.org/licenses/RPL-1.5"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/RPSL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/RPSL-1.0.json","referenceNumber":337,"name":"RealNetworks Public Source License v1.0","licenseId":"RPSL-1.0","seeAlso":["https://helixcommunity.org/content/rpsl","https://opensource.org/licenses/RPSL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/RSA-MD.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/RSA-MD.json","referenceNumber":338,"name":"RSA Message-Digest License ","licenseId":"RSA-MD","seeAlso":["https://www.faqs.org/rfcs/rfc1321.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/RSCPL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/RSCPL.json","referenceNumber":339,"name":"Ricoh Source Code Public License","licenseId":"RSCPL","seeAlso":["https://wayback.archive.org/web/20060715140826/http://www.risource.org/RPL/RPL-1.0A.shtml","https://opensource.org/licenses/RSCPL"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Ruby.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Ruby.json","referenceNumber":340,"name":"Ruby License","licenseId":"Ruby","seeAlso":["https://www.ruby-lang.org/en/LICENSE.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/SAX-PD.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SAX-PD.json","referenceNumber":341,"name":"Sax Public Domain Notice","licenseId":"SAX-PD","seeAlso":["https://www.saxproject.org/copying.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Saxpath.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Saxpath.json","referenceNumber":342,"name":"Saxpath License","licenseId":"Saxpath","seeAlso":["https://fedoraproject.org/wiki/Licensing/Saxpath_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SCEA.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SCEA.json","referenceNumber":343,"name":"SCEA Shared Source License","licenseId":"SCEA","seeAlso":["https://research.scea.com/scea_shared_source_license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Sendmail.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Sendmail.json","referenceNumber":344,"name":"Sendmail License","licenseId":"Sendmail","seeAlso":["https://www.sendmail.com/pdfs/open_source/sendmail_license.pdf","https://web.archive.org/web/20160322142305/https://www.sendmail.com/pdfs/open_source/sendmail_license.pdf"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Sendmail-8.23.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Sendmail-8.23.json","referenceNumber":345,"name":"Sendmail License 8.23","licenseId":"Sendmail-8.23","seeAlso":["https://www.proofpoint.com/sites/default/files/sendmail-license.pdf","https://web.archive.org/web/20181003101040/https://www.proofpoint.com/sites/default/files/sendmail-license.pdf"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SGI-B-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SGI-B-1.0.json","referenceNumber":346,"name":"SGI Free Software License B v1.0","licenseId":"SGI-B-1.0","seeAlso":["https://oss.sgi.com/projects/FreeB/SGIFreeSWLicB.1.0.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SGI-B-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SGI-B-1.1.json","referenceNumber":347,"name":"SGI Free Software License B v1.1","licenseId":"SGI-B-1.1","seeAlso":["https://oss.sgi.com/projects/FreeB/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SGI-B-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SGI-B-2.0.json","referenceNumber":348,"name":"SGI Free Software License B v2.0","licenseId":"SGI-B-2.0","seeAlso":["https://oss.sgi.com/projects/FreeB/SGIFreeSWLicB.2.0.pdf"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/SHL-0.5.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SHL-0.5.json","referenceNumber":349,"name":"Solderpad Hardware License v0.5","licenseId":"SHL-0.5","seeAlso":["https://solderpad.org/licenses/SHL-0.5/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SHL-0.51.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SHL-0.51.json","referenceNumber":350,"name":"Solderpad Hardware License, Version 0.51","licenseId":"SHL-0.51","seeAlso":["https://solderpad.org/licenses/SHL-0.51/"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SimPL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SimPL-2.0.json","referenceNumber":351,"name":"Simple Public License 2.0","licenseId":"SimPL-2.0","seeAlso":["https://opensource.org/licenses/SimPL-2.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/SISSL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SISSL.json","referenceNumber":352,"name":"Sun Industry Standards Source License v1.1","licenseId":"SISSL","seeAlso":["https://www.openoffice.org/licenses/sissl_license.html","https://opensource.org/licenses/SISSL"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/SISSL-1.2.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SISSL-1.2.json","referenceNumber":353,"name":"Sun Industry Standards Source License v1.2","licenseId":"SISSL-1.2","seeAlso":["https://gridscheduler.sourceforge.net/Gridengine_SISSL_license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Sleepycat.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Sleepycat.json","referenceNumber":354,"name":"Sleepycat License","licenseId":"Sleepycat","seeAlso":["https://opensource.org/licenses/Sleepycat"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/SMLNJ.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SMLNJ.json","referenceNumber":355,"name":"Standard ML of New Jersey License","licenseId":"SMLNJ","seeAlso":["https://www.smlnj.org/license.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/SMPPL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SMPPL.json","referenceNumber":356,"name":"Secure Messaging Protocol Public License","licenseId":"SMPPL","seeAlso":["https://github.com/dcblake/SMP/blob/master/Documentation/License.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SNIA.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SNIA.json","referenceNumber":357,"name":"SNIA Public License 1.1","licenseId":"SNIA","seeAlso":["https://fedoraproject.org/wiki/Licensing/SNIA_Public_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Spencer-86.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Spencer-86.json","referenceNumber":358,"name":"Spencer License 86","licenseId":"Spencer-86","seeAlso":["https://fedoraproject.org/wiki/Licensing/Henry_Spencer_Reg-Ex_Library_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Spencer-94.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Spencer-94.json","referenceNumber":359,"name":"Spencer License 94","licenseId":"Spencer-94","seeAlso":["https://fedoraproject.org/wiki/Licensing/Henry_Spencer_Reg-Ex_Library_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Spencer-99.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Spencer-99.json","referenceNumber":360,"name":"Spencer License 99","licenseId":"Spencer-99","seeAlso":["https://www.opensource.apple.com/source/tcl/tcl-5/tcl/generic/regfronts.c"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SPL-1.0.json","referenceNumber":361,"name":"Sun Public License v1.0","licenseId":"SPL-1.0","seeAlso":["https://opensource.org/licenses/SPL-1.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/SSH-OpenSSH.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SSH-OpenSSH.json","referenceNumber":362,"name":"SSH OpenSSH license","licenseId":"SSH-OpenSSH","seeAlso":["https://github.com/openssh/openssh-portable/blob/1b11ea7c58cd5c59838b5fa574cd456d6047b2d4/LICENCE#L10"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SSH-short.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SSH-short.json","referenceNumber":363,"name":"SSH short notice","licenseId":"SSH-short","seeAlso":["https://github.com/openssh/openssh-portable/blob/1b11ea7c58cd5c59838b5fa574cd456d6047b2d4/pathnames.h","https://web.mit.edu/kolya/.f/root/athena.mit.edu/sipb.mit.edu/project/openssh/OldFiles/src/openssh-2.9.9p2/ssh-add.1","https://joinup.ec.europa.eu/svn/lesoll/trunk/italc/lib/src/dsa_key.cpp"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SSPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SSPL-1.0.json","referenceNumber":364,"name":"Server Side Public License, v 1","licenseId":"SSPL-1.0","seeAlso":["https://www.mongodb.com/licensing/server-side-public-license"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/StandardML-NJ.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/StandardML-NJ.json","referenceNumber":365,"name":"Standard ML of New Jersey License","licenseId":"StandardML-NJ","seeAlso":["https://www.smlnj.org//license.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/SugarCRM-1.1.3.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SugarCRM-1.1.3.json","referenceNumber":366,"name":"SugarCRM Public License v1.1.3","licenseId":"SugarCRM-1.1.3","seeAlso":["https://www.sugarcrm.com/crm/SPL"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/SWL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/SWL.json","referenceNumber":367,"name":"Scheme Widget Library (SWL) Software License Agreement","licenseId":"SWL","seeAlso":["https://fedoraproject.org/wiki/Licensing/SWL"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/TAPR-OHL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/TAPR-OHL-1.0.json","referenceNumber":368,"name":"TAPR Open Hardware License v1.0","licenseId":"TAPR-OHL-1.0","seeAlso":["https://www.tapr.org/OHL"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/TCL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/TCL.json","referenceNumber":369,"name":"TCL/TK License","licenseId":"TCL","seeAlso":["https://www.tcl.tk/software/tcltk/license.html","https://fedoraproject.org/wiki/Licensing/TCL"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/TCP-wrappers.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/TCP-wrappers.json","referenceNumber":370,"name":"TCP Wrappers License","licenseId":"TCP-wrappers","seeAlso":["https://rc.quest.com/topics/openssh/license.php#tcpwrappers"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/TMate.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/TMate.json","referenceNumber":371,"name":"TMate Open Source License","licenseId":"TMate","seeAlso":["https://svnkit.com/license.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/TORQUE-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/TORQUE-1.1.json","referenceNumber":372,"name":"TORQUE v2.5+ Software License v1.1","licenseId":"TORQUE-1.1","seeAlso":["https://fedoraproject.org/wiki/Licensing/TORQUEv1.1"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/TOSL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/TOSL.json","referenceNumber":373,"name":"Trusster Open Source License","licenseId":"TOSL","seeAlso":["https://fedoraproject.org/wiki/Licensing/TOSL"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/TU-Berlin-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/TU-Berlin-1.0.json","referenceNumber":374,"name":"Technische Universitaet Berlin License 1.0","licenseId":"TU-Berlin-1.0","seeAlso":["https://github.com/swh/ladspa/blob/7bf6f3799fdba70fda297c2d8fd9f526803d9680/gsm/COPYRIGHT"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/TU-Berlin-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/TU-Berlin-2.0.json","referenceNumber":375,"name":"Technische Universitaet Berlin License 2.0","licenseId":"TU-Berlin-2.0","seeAlso":["https://github.com/CorsixTH/deps/blob/fd339a9f526d1d9c9f01ccf39e438a015da50035/licences/libgsm.txt"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/UCL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/UCL-1.0.json","referenceNumber":376,"name":"Upstream Compatibility License v1.0","licenseId":"UCL-1.0","seeAlso":["https://opensource.org/licenses/UCL-1.0"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Unicode-DFS-2015.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Unicode-DFS-2015.json","referenceNumber":377,"name":"Unicode License Agreement - Data Files and Software (2015)","licenseId":"Unicode-DFS-2015","seeAlso":["https://web.archive.org/web/20151224134844/http://unicode.org/copyright.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Unicode-DFS-2016.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Unicode-DFS-2016.json","referenceNumber":378,"name":"Unicode License Agreement - Data Files and Software (2016)","licenseId":"Unicode-DFS-2016","seeAlso":["https://www.unicode.org/copyright.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Unicode-TOU.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Unicode-TOU.json","referenceNumber":379,"name":"Unicode Terms of Use","licenseId":"Unicode-TOU","seeAlso":["https://www.unicode.org/copyright.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Unlicense.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Unlicense.json","referenceNumber":380,"name":"The Unlicense","licenseId":"Unlicense","seeAlso":["https://unlicense.org/"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/UPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/UPL-1.0.json","referenceNumber":381,"name":"Universal Permissive License v1.0","licenseId":"UPL-1.0","seeAlso":["https://opensource.org/licenses/UPL"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Vim.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Vim.json","referenceNumber":382,"name":"Vim License","licenseId":"Vim","seeAlso":["https://vimdoc.sourceforge.net/htmldoc/uganda.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/VOSTROM.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/VOSTROM.json","referenceNumber":383,"name":"VOSTROM Public License for Open Source","licenseId":"VOSTROM","seeAlso":["https://fedoraproject.org/wiki/Licensing/VOSTROM"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/VSL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/VSL-1.0.json","referenceNumber":384,"name":"Vovida Software License v1.0","licenseId":"VSL-1.0","seeAlso":["https://opensource.org/licenses/VSL-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/W3C.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/W3C.json","referenceNumber":385,"name":"W3C Software Notice and License (2002-12-31)","licenseId":"W3C","seeAlso":["https://www.w3.org/Consortium/Legal/2002/copyright-software-20021231.html","https://opensource.org/licenses/W3C"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/W3C-19980720.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/W3C-19980720.json","referenceNumber":386,"name":"W3C Software Notice and License (1998-07-20)","licenseId":"W3C-19980720","seeAlso":["https://www.w3.org/Consortium/Legal/copyright-software-19980720.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/W3C-20150513.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/W3C-20150513.json","referenceNumber":387,"name":"W3C Software Notice and Document License (2015-05-13)","licenseId":"W3C-20150513","seeAlso":["https://www.w3.org/Consortium/Legal/2015/copyright-software-and-document"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Watcom-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Watcom-1.0.json","referenceNumber":388,"name":"Sybase Open Watcom Public License 1.0","licenseId":"Watcom-1.0","seeAlso":["https://opensource.org/licenses/Watcom-1.0"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/Wsuipa.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Wsuipa.json","referenceNumber":389,"name":"Wsuipa License","licenseId":"Wsuipa","seeAlso":["https://fedoraproject.org/wiki/Licensing/Wsuipa"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/WTFPL.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/WTFPL.json","referenceNumber":390,"name":"Do What The F*ck You Want To Public License","licenseId":"WTFPL","seeAlso":["https://sam.zoy.org/wtfpl/COPYING"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/wxWindows.html","isDeprecatedLicenseId":true,"detailsUrl":"https://spdx.org/licenses/wxWindows.json","referenceNumber":391,"name":"wxWindows Library License","licenseId":"wxWindows","seeAlso":["https://opensource.org/licenses/WXwindows"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/X11.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/X11.json","referenceNumber":392,"name":"X11 License","licenseId":"X11","seeAlso":["https://www.xfree86.org/3.3.6/COPYRIGHT2.html#3"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Xerox.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Xerox.json","referenceNumber":393,"name":"Xerox License","licenseId":"Xerox","seeAlso":["https://fedoraproject.org/wiki/Licensing/Xerox"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/XFree86-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/XFree86-1.1.json","referenceNumber":394,"name":"XFree86 License 1.1","licenseId":"XFree86-1.1","seeAlso":["https://www.xfree86.org/current/LICENSE4.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/xinetd.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/xinetd.json","referenceNumber":395,"name":"xinetd License","licenseId":"xinetd","seeAlso":["https://fedoraproject.org/wiki/Licensing/Xinetd_License"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Xnet.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Xnet.json","referenceNumber":396,"name":"X.Net License","licenseId":"Xnet","seeAlso":["https://opensource.org/licenses/Xnet"],"isOsiApproved":true},{"reference":"https://spdx.org/licenses/xpp.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/xpp.json","referenceNumber":397,"name":"XPP License","licenseId":"xpp","seeAlso":["https://fedoraproject.org/wiki/Licensing/xpp"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/XSkat.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/XSkat.json","referenceNumber":398,"name":"XSkat License","licenseId":"XSkat","seeAlso":["https://fedoraproject.org/wiki/Licensing/XSkat_License"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/YPL-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/YPL-1.0.json","referenceNumber":399,"name":"Yahoo! Public License v1.0","licenseId":"YPL-1.0","seeAlso":["https://www.zimbra.com/license/yahoo_public_license_1.0.html"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/YPL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/YPL-1.1.json","referenceNumber":400,"name":"Yahoo! Public License v1.1","licenseId":"YPL-1.1","seeAlso":["https://www.zimbra.com/license/yahoo_public_license_1.1.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Zed.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Zed.json","referenceNumber":401,"name":"Zed License","licenseId":"Zed","seeAlso":["https://fedoraproject.org/wiki/Licensing/Zed"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Zend-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Zend-2.0.json","referenceNumber":402,"name":"Zend License v2.0","licenseId":"Zend-2.0","seeAlso":["https://web.archive.org/web/20130517195954/http://www.zend.com/license/2_00.txt"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Zimbra-1.3.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Zimbra-1.3.json","referenceNumber":403,"name":"Zimbra Public License v1.3","licenseId":"Zimbra-1.3","seeAlso":["https://web.archive.org/web/20100302225219/http://www.zimbra.com/license/zimbra-public-license-1-3.html"],"isOsiApproved":false,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/Zimbra-1.4.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Zimbra-1.4.json","referenceNumber":404,"name":"Zimbra Public License v1.4","licenseId":"Zimbra-1.4","seeAlso":["https://www.zimbra.com/legal/zimbra-public-license-1-4"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/Zlib.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/Zlib.json","referenceNumber":405,"name":"zlib License","licenseId":"Zlib","seeAlso":["https://www.zlib.net/zlib_license.html","https://opensource.org/licenses/Zlib"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/zlib-acknowledgement.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/zlib-acknowledgement.json","referenceNumber":406,"name":"zlib/libpng License with Acknowledgement","licenseId":"zlib-acknowledgement","seeAlso":["https://fedoraproject.org/wiki/Licensing/ZlibWithAcknowledgement"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/ZPL-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ZPL-1.1.json","referenceNumber":407,"name":"Zope Public License 1.1","licenseId":"ZPL-1.1","seeAlso":["https://old.zope.org/Resources/License/ZPL-1.1"],"isOsiApproved":false},{"reference":"https://spdx.org/licenses/ZPL-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ZPL-2.0.json","referenceNumber":408,"name":"Zope Public License 2.0","licenseId":"ZPL-2.0","seeAlso":["https://old.zope.org/Resources/License/ZPL-2.0","https://opensource.org/licenses/ZPL-2.0"],"isOsiApproved":true,"isFsfLibre":true},{"reference":"https://spdx.org/licenses/ZPL-2.1.html","isDeprecatedLicenseId":false,"detailsUrl":"https://spdx.org/licenses/ZPL-2.1.json","referenceNumber":409,"name":"Zope Public License 2.1","licenseId":"ZPL-2.1","seeAlso":["https://old.zope.org/Resources/ZPL/"],"isOsiApproved":false,"isFsfLibre":true}],"releaseDate":"2020-02-09"}`
+
+// bundledSPDXExceptions is the exceptions.json file published by SPDX
+const bundledSPDXExceptions = `{"licenseListVersion":"3.8","exceptions":[{"reference":"./389-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./389-exception.json","referenceNumber":"1","name":"389 Directory Server Exception","licenseExceptionId":"389-exception","seeAlso":[]},{"reference":"./Autoconf-exception-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./Autoconf-exception-2.0.json","referenceNumber":"2","name":"Autoconf exception 2.0","licenseExceptionId":"Autoconf-exception-2.0","seeAlso":[]},{"reference":"./Autoconf-exception-3.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./Autoconf-exception-3.0.json","referenceNumber":"3","name":"Autoconf exception 3.0","licenseExceptionId":"Autoconf-exception-3.0","seeAlso":[]},{"reference":"./Bison-exception-2.2.html","isDeprecatedLicenseId":false,"detailsUrl":"./Bison-exception-2.2.json","referenceNumber":"4","name":"Bison exception 2.2","licenseExceptionId":"Bison-exception-2.2","seeAlso":[]},{"reference":"./Bootloader-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./Bootloader-exception.json","referenceNumber":"5","name":"Bootloader Distribution Exception","licenseExceptionId":"Bootloader-exception","seeAlso":[]},{"reference":"./Classpath-exception-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./Classpath-exception-2.0.json","referenceNumber":"6","name":"Classpath exception 2.0","licenseExceptionId":"Classpath-exception-2.0","seeAlso":[]},{"reference":"./CLISP-exception-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./CLISP-exception-2.0.json","referenceNumber":"7","name":"CLISP exception 2.0","licenseExceptionId":"CLISP-exception-2.0","seeAlso":[]},{"reference":"./DigiRule-FOSS-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./DigiRule-FOSS-exception.json","referenceNumber":"8","name":"DigiRule FOSS License Exception","licenseExceptionId":"DigiRule-FOSS-exception","seeAlso":[]},{"reference":"./eCos-exception-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./eCos-exception-2.0.json","referenceNumber":"9","name":"eCos exception 2.0","licenseExceptionId":"eCos-exception-2.0","seeAlso":[]},{"reference":"./Fawkes-Runtime-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./Fawkes-Runtime-exception.json","referenceNumber":"10","name":"Fawkes Runtime Exception","licenseExceptionId":"Fawkes-Runtime-exception","seeAlso":[]},{"reference":"./FLTK-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./FLTK-exception.json","referenceNumber":"11","name":"FLTK exception","licenseExceptionId":"FLTK-exception","seeAlso":[]},{"reference":"./Font-exception-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./Font-exception-2.0.json","referenceNumber":"12","name":"Font exception 2.0","licenseExceptionId":"Font-exception-2.0","seeAlso":[]},{"reference":"./freertos-exception-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./freertos-exception-2.0.json","referenceNumber":"13","name":"FreeRTOS Exception 2.0","licenseExceptionId":"freertos-exception-2.0","seeAlso":[]},{"reference":"./GCC-exception-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./GCC-exception-2.0.json","referenceNumber":"14","name":"GCC Runtime Library exception 2.0","licenseExceptionId":"GCC-exception-2.0","seeAlso":[]},{"reference":"./GCC-exception-3.1.html","isDeprecatedLicenseId":false,"detailsUrl":"./GCC-exception-3.1.json","referenceNumber":"15","name":"GCC Runtime Library exception 3.1","licenseExceptionId":"GCC-exception-3.1","seeAlso":[]},{"reference":"./gnu-javamail-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./gnu-javamail-exception.json","referenceNumber":"16","name":"GNU JavaMail exception","licenseExceptionId":"gnu-javamail-exception","seeAlso":[]},{"reference":"./i2p-gpl-java-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./i2p-gpl-java-exception.json","referenceNumber":"17","name":"i2p GPL+Java Exception","licenseExceptionId":"i2p-gpl-java-exception","seeAlso":[]},{"reference":"./Libtool-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./Libtool-exception.json","referenceNumber":"18","name":"Libtool Exception","licenseExceptionId":"Libtool-exception","seeAlso":[]},{"reference":"./Linux-syscall-note.html","isDeprecatedLicenseId":false,"detailsUrl":"./Linux-syscall-note.json","referenceNumber":"19","name":"Linux Syscall Note","licenseExceptionId":"Linux-syscall-note","seeAlso":[]},{"reference":"./LLVM-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./LLVM-exception.json","referenceNumber":"20","name":"LLVM Exception","licenseExceptionId":"LLVM-exception","seeAlso":[]},{"reference":"./LZMA-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./LZMA-exception.json","referenceNumber":"21","name":"LZMA exception","licenseExceptionId":"LZMA-exception","seeAlso":[]},{"reference":"./mif-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./mif-exception.json","referenceNumber":"22","name":"Macros and Inline Functions Exception","licenseExceptionId":"mif-exception","seeAlso":[]},{"reference":"./Nokia-Qt-exception-1.1.html","isDeprecatedLicenseId":true,"detailsUrl":"./Nokia-Qt-exception-1.1.json","referenceNumber":"23","name":"Nokia Qt LGPL exception 1.1","licenseExceptionId":"Nokia-Qt-exception-1.1","seeAlso":[]},{"reference":"./OCaml-LGPL-linking-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./OCaml-LGPL-linking-exception.json","referenceNumber":"24","name":"OCaml LGPL Linking Exception","licenseExceptionId":"OCaml-LGPL-linking-exception","seeAlso":[]},{"reference":"./OCCT-exception-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./OCCT-exception-1.0.json","referenceNumber":"25","name":"Open CASCADE Exception 1.0","licenseExceptionId":"OCCT-exception-1.0","seeAlso":[]},{"reference":"./OpenJDK-assembly-exception-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./OpenJDK-assembly-exception-1.0.json","referenceNumber":"26","name":"OpenJDK Assembly exception 1.0","licenseExceptionId":"OpenJDK-assembly-exception-1.0","seeAlso":[]},{"reference":"./openvpn-openssl-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./openvpn-openssl-exception.json","referenceNumber":"27","name":"OpenVPN OpenSSL Exception","licenseExceptionId":"openvpn-openssl-exception","seeAlso":[]},{"reference":"./PS-or-PDF-font-exception-20170817.html","isDeprecatedLicenseId":false,"detailsUrl":"./PS-or-PDF-font-exception-20170817.json","referenceNumber":"28","name":"PS/PDF font exception (2017-08-17)","licenseExceptionId":"PS-or-PDF-font-exception-20170817","seeAlso":[]},{"reference":"./Qt-GPL-exception-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./Qt-GPL-exception-1.0.json","referenceNumber":"29","name":"Qt GPL exception 1.0","licenseExceptionId":"Qt-GPL-exception-1.0","seeAlso":[]},{"reference":"./Qt-LGPL-exception-1.1.html","isDeprecatedLicenseId":false,"detailsUrl":"./Qt-LGPL-exception-1.1.json","referenceNumber":"30","name":"Qt LGPL exception 1.1","licenseExceptionId":"Qt-LGPL-exception-1.1","seeAlso":[]},{"reference":"./Qwt-exception-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./Qwt-exception-1.0.json","referenceNumber":"31","name":"Qwt exception 1.0","licenseExceptionId":"Qwt-exception-1.0","seeAlso":[]},{"reference":"./Swift-exception.html","isDeprecatedLicenseId":false,"detailsUrl":"./Swift-exception.json","referenceNumber":"32","name":"Swift Exception","licenseExceptionId":"Swift-exception","seeAlso":[]},{"reference":"./u-boot-exception-2.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./u-boot-exception-2.0.json","referenceNumber":"33","name":"U-Boot exception 2.0","licenseExceptionId":"u-boot-exception-2.0","seeAlso":[]},{"reference":"./Universal-FOSS-exception-1.0.html","isDeprecatedLicenseId":false,"detailsUrl":"./Universal-FOSS-exception-1.0.json","referenceNumber":"34","name":"Universal FOSS Exception, Version 1.0","licenseExceptionId":"Universal-FOSS-exception-1.0","seeAlso":[]},{"reference":"./WxWindows-exception-3.1.html","isDeprecatedLicenseId":false,"detailsUrl":"./WxWindows-exception-3.1.json","referenceNumber":"35","name":"WxWindows Library Exception 3.1","licenseExceptionId":"WxWindows-exception-3.1","seeAlso":[]}],"releaseDate":"2020-02-09"}`
@@ -0,0 +1,56 @@
+//go:build ignore
+// +build ignore
+
+// spdxlist_generate bundles the licenses.json and exceptions.json files from a checkout of
+// https://github.com/spdx/license-list-data, writing spdxlist_data.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func compact(path string) string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	if strings.Contains(buf.String(), "`") {
+		log.Fatalf("%s contains a backtick, which cannot be written within a raw string", path)
+	}
+	return buf.String()
+}
+
+func main() {
+	dir := flag.String("dir", "", "json directory of the SPDX license-list-data repository")
+	flag.Parse()
+
+	licenses := compact(filepath.Join(*dir, "licenses.json"))
+	exceptions := compact(filepath.Join(*dir, "exceptions.json"))
+
+	out, err := os.Create("spdxlist_data.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+	fmt.Fprintln(out, "// Code generated by spdxlist_generate.go. DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "package diligent")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// bundledSPDXLicenses is the licenses.json file published by SPDX")
+	fmt.Fprintln(out, "const bundledSPDXLicenses = `"+licenses+"`")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "// bundledSPDXExceptions is the exceptions.json file published by SPDX")
+	fmt.Fprintln(out, "const bundledSPDXExceptions = `"+exceptions+"`")
+}
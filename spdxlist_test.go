@@ -0,0 +1,113 @@
+package diligent_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+)
+
+func TestBundledSPDXList(t *testing.T) {
+	cases := []struct {
+		d                    string
+		in                   string
+		category             diligent.Category
+		osi, fsf, deprecated bool
+		replacedBy           string
+	}{
+		{"known license gains flags", "MIT", diligent.Permissive, true, true, false, ""},
+		{"deprecated identifier", "GPL-2.0", diligent.CopyLeft, true, true, true, "GPL-2.0-only"},
+		{"deprecated or later identifier", "LGPL-2.1+", diligent.CopyLeftLimited, true, true, true, "LGPL-2.1-or-later"},
+		{"current identifier shares category", "GPL-2.0-only", diligent.CopyLeft, true, true, false, ""},
+		{"current or later identifier shares category", "AGPL-3.0-or-later", diligent.CopyLeft, true, true, false, ""},
+		{"renamed deprecated identifier", "StandardML-NJ", diligent.Permissive, false, true, true, "SMLNJ"},
+		{"license unknown to diligent is uncategorised", "CERN-OHL-1.1", diligent.Uncategorised, false, false, false, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			l, err := diligent.GetLicenseFromIdentifier(c.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if l.Category != c.category || l.OSIApproved != c.osi || l.FSFLibre != c.fsf || l.Deprecated != c.deprecated || l.ReplacedBy != c.replacedBy {
+				t.Errorf("unexpected license %+v", l)
+			}
+		})
+	}
+}
+
+func TestGetLicenseFromIdentifierWithException(t *testing.T) {
+	l, err := diligent.GetLicenseFromIdentifier("GPL-2.0-only WITH Classpath-exception-2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Identifier != "GPL-2.0-only WITH Classpath-exception-2.0" || l.Category != diligent.CopyLeft {
+		t.Errorf("unexpected license %+v", l)
+	}
+	if _, err := diligent.GetLicenseFromIdentifier("GPL-2.0-only WITH woowoo"); err == nil {
+		t.Error("expected unknown exception to fail")
+	}
+}
+
+func TestLoadSPDXList(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spdxlist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "licenses.json")
+	list := `{"licenseListVersion": "99.0", "licenses": [
+		{"licenseId": "Test-License-1.0", "name": "Test License 1.0", "reference": "https://spdx.org/licenses/Test-License-1.0.html", "isOsiApproved": true, "seeAlso": ["https://example.com/test"]},
+		{"licenseId": "MIT", "name": "MIT License", "isOsiApproved": true, "isFsfLibre": true}
+	]}`
+	if err := ioutil.WriteFile(path, []byte(list), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := diligent.LoadSPDXList(path); err != nil {
+		t.Fatal(err)
+	}
+	l, err := diligent.GetLicenseFromIdentifier("Test-License-1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Name != "Test License 1.0" || !l.OSIApproved || l.URL != "https://example.com/test" || l.Type != diligent.OpenSource {
+		t.Errorf("unexpected license %+v", l)
+	}
+	if l, _ := diligent.GetLicenseFromIdentifier("MIT"); l.Category != diligent.Permissive {
+		t.Errorf("expected MIT to keep its category, got %+v", l)
+	}
+	if _, err := diligent.ReadSPDXList(strings.NewReader(`{"licenses": [{"name": "no id"}]}`)); err == nil {
+		t.Error("expected license without an identifier to fail")
+	}
+}
+
+func TestRegisterLicense(t *testing.T) {
+	cases := []struct {
+		d          string
+		in         diligent.License
+		expFailure bool
+	}{
+		{"custom license", diligent.License{Identifier: "LicenseRef-Acme-Internal", Category: diligent.ProprietaryFree}, false},
+		{"missing LicenseRef prefix", diligent.License{Identifier: "Acme-Internal", Category: diligent.ProprietaryFree}, true},
+		{"unknown category", diligent.License{Identifier: "LicenseRef-Acme", Category: "woowoo"}, true},
+		{"all category", diligent.License{Identifier: "LicenseRef-Acme", Category: diligent.All}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.d, func(t *testing.T) {
+			err := diligent.RegisterLicense(c.in)
+			if (err != nil) != c.expFailure {
+				t.Errorf("expecting error: %t, got %v", c.expFailure, err)
+			}
+		})
+	}
+	l, err := diligent.GetLicenseFromIdentifier("LicenseRef-Acme-Internal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Name != "LicenseRef-Acme-Internal" || l.Category != diligent.ProprietaryFree {
+		t.Errorf("unexpected license %+v", l)
+	}
+}
@@ -0,0 +1,161 @@
+package swiftpm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/warning"
+)
+
+// WebLicenseGetter retrieves license information from an online source
+type WebLicenseGetter interface {
+	IsCompatibleURL(s string) bool
+	GetLicenseFromURL(s string) (diligent.License, error)
+}
+
+type pinState struct {
+	Branch   *string `json:"branch"`
+	Revision string  `json:"revision"`
+	Version  *string `json:"version"`
+}
+
+// pin supports both the v1 (package, repositoryURL) and v2 (identity, kind, location) schemas
+type pin struct {
+	Package       string   `json:"package"`
+	RepositoryURL string   `json:"repositoryURL"`
+	Identity      string   `json:"identity"`
+	Kind          string   `json:"kind"`
+	Location      string   `json:"location"`
+	State         pinState `json:"state"`
+}
+
+func (p pin) name() string {
+	if p.Identity != "" {
+		return p.Identity
+	}
+	return p.Package
+}
+
+func (p pin) version() string {
+	if p.State.Version != nil {
+		return *p.State.Version
+	}
+	return p.State.Revision
+}
+
+func (p pin) location() string {
+	if p.Location != "" {
+		return p.Location
+	}
+	return p.RepositoryURL
+}
+
+type resolved struct {
+	Version int   `json:"version"`
+	Pins    []pin `json:"pins"`
+	Object  struct {
+		Pins []pin `json:"pins"`
+	} `json:"object"`
+}
+
+type swiftpm struct {
+	config Config
+	webLG  WebLicenseGetter
+}
+
+// Config allows default options to be altered
+type Config struct {
+	// Overrides declare the licenses of packages, which are used rather than determining the licenses
+	Overrides diligent.Overrides
+}
+
+// New returns a Deper capable of handling Swift Package Manager Package.resolved files
+func New(webLG WebLicenseGetter) diligent.Deper {
+	return NewWithOptions(webLG, Config{})
+}
+
+// NewWithOptions is identical to New but allows the default options to be overridden
+func NewWithOptions(webLG WebLicenseGetter, c Config) diligent.Deper {
+	return &swiftpm{c, webLG}
+}
+
+// Name returns "swiftpm"
+func (s *swiftpm) Name() string {
+	return "swiftpm"
+}
+
+// Dependencies returns the licenses of the packages pinned within Package.resolved
+func (s *swiftpm) Dependencies(file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	return s.DependenciesContext(context.Background(), file)
+}
+
+// DependenciesContext is identical to Dependencies but cancels the lookups once ctx is done
+func (s *swiftpm) DependenciesContext(ctx context.Context, file []byte) ([]diligent.Dep, []diligent.Warning, error) {
+	var r resolved
+	err := json.Unmarshal(file, &r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pins []pin
+	switch r.Version {
+	case 1:
+		pins = r.Object.Pins
+	case 2, 3:
+		pins = r.Pins
+	default:
+		return nil, nil, fmt.Errorf("unsupported Package.resolved version %d", r.Version)
+	}
+
+	deps := make([]diligent.Dep, 0, len(pins))
+	warns := make([]diligent.Warning, 0, len(pins))
+	for _, p := range pins {
+		if d, ok := s.config.Overrides.Dep(s.Name(), p.name(), p.version()); ok {
+			deps = append(deps, d)
+			continue
+		}
+		var t diligent.Trace
+		l, err := s.getLicense(ctx, p, &t)
+		if err != nil {
+			warns = append(warns, warning.FromError(s.Name(), p.name(), p.version(), err, t))
+		} else {
+			deps = append(deps, diligent.Dep{
+				Name:    p.name(),
+				Version: p.version(),
+				License: l,
+				Trace:   t,
+			})
+		}
+	}
+	return deps, warns, nil
+}
+
+// IsCompatible returns true if the filename is Package.resolved
+func (s *swiftpm) IsCompatible(filename string) bool {
+	return filename == "Package.resolved"
+}
+
+func (s *swiftpm) getLicense(ctx context.Context, p pin, t *diligent.Trace) (diligent.License, error) {
+	location := p.location()
+	if p.Kind == "fileSystem" || p.Kind == "localSourceControl" {
+		return diligent.TraceLicenseForDirectory(location, t)
+	}
+
+	repoURL := strings.TrimSuffix(location, ".git")
+	if s.webLG != nil && s.webLG.IsCompatibleURL(repoURL) {
+		if p.State.Revision != "" {
+			l, err := diligent.GetLicenseFromURL(ctx, s.webLG, repoURL+"/tree/"+p.State.Revision, t)
+			if err == nil {
+				return l, nil
+			}
+		}
+		l, err := diligent.GetLicenseFromURL(ctx, s.webLG, repoURL, t)
+		if err == nil {
+			return l, nil
+		}
+	}
+	return diligent.TraceLicenseForGit(ctx, location, t)
+}
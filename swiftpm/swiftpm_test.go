@@ -0,0 +1,186 @@
+package swiftpm_test
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/senseyeio/diligent"
+	"github.com/senseyeio/diligent/swiftpm"
+)
+
+type mockWebLicenseGetter map[string]string
+
+func (m mockWebLicenseGetter) IsCompatibleURL(s string) bool {
+	return true
+}
+
+func (m mockWebLicenseGetter) GetLicenseFromURL(s string) (diligent.License, error) {
+	id, ok := m[s]
+	if !ok {
+		return diligent.License{}, errors.New("not mocked")
+	}
+	return diligent.GetLicenseFromIdentifier(id)
+}
+
+func TestName(t *testing.T) {
+	target := swiftpm.New(nil)
+	if target.Name() != "swiftpm" {
+		t.Error("expected 'swiftpm'")
+	}
+}
+
+func TestIsCompatible(t *testing.T) {
+	var cases = []struct {
+		in  string
+		out bool
+	}{
+		{"Package.resolved", true},
+		{"Package.swift", false},
+		{"package.resolved", false},
+		{"Podfile.lock", false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			target := swiftpm.New(nil)
+			compatible := target.IsCompatible(tt.in)
+			if compatible != tt.out {
+				t.Errorf("got %v, want %v", compatible, tt.out)
+			}
+		})
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	webLG := mockWebLicenseGetter{
+		"https://github.com/Alamofire/Alamofire/tree/f96b619": "MIT",
+		"https://github.com/apple/swift-log":                  "Apache-2.0",
+	}
+	cases := []struct {
+		description string
+		in          []byte
+		depsOut     map[string]string
+		warnsOut    []diligent.WarningDetails
+		errOut      bool
+	}{{
+		"should handle version 1 files",
+		[]byte(`
+			{
+				"object": {
+					"pins": [
+						{
+							"package": "Alamofire",
+							"repositoryURL": "https://github.com/Alamofire/Alamofire.git",
+							"state": {"branch": null, "revision": "f96b619", "version": "5.4.0"}
+						},
+						{
+							"package": "swift-log",
+							"repositoryURL": "https://github.com/apple/swift-log.git",
+							"state": {"branch": null, "revision": "unknown", "version": "1.4.0"}
+						}
+					]
+				},
+				"version": 1
+			}
+		`),
+		map[string]string{
+			"Alamofire@5.4.0": "MIT",
+			"swift-log@1.4.0": "Apache-2.0",
+		},
+		[]diligent.WarningDetails{},
+		false,
+	}, {
+		"should handle version 2 files",
+		[]byte(`
+			{
+				"pins": [
+					{
+						"identity": "alamofire",
+						"kind": "remoteSourceControl",
+						"location": "https://github.com/Alamofire/Alamofire.git",
+						"state": {"revision": "f96b619", "version": "5.6.1"}
+					},
+					{
+						"identity": "local",
+						"kind": "fileSystem",
+						"location": "/does/not/exist",
+						"state": {}
+					}
+				],
+				"version": 2
+			}
+		`),
+		map[string]string{
+			"alamofire@5.6.1": "MIT",
+		},
+		[]diligent.WarningDetails{
+			{Kind: diligent.NotFound, Ecosystem: "swiftpm", Package: "local", Err: os.ErrNotExist},
+		},
+		false,
+	}, {
+		"should fail on unknown versions",
+		[]byte(`{"pins": [], "version": 99}`),
+		map[string]string{},
+		[]diligent.WarningDetails{},
+		true,
+	}, {
+		"parse failure",
+		[]byte(`{{`),
+		map[string]string{},
+		[]diligent.WarningDetails{},
+		true,
+	}}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			target := swiftpm.New(webLG)
+			d, w, e := target.Dependencies(tt.in)
+			for i := range d {
+				d[i].Trace = nil
+			}
+			expectedDeps := make([]diligent.Dep, 0, len(tt.depsOut))
+			for depID, lID := range tt.depsOut {
+				l, _ := diligent.GetLicenseFromIdentifier(lID)
+				at := strings.LastIndex(depID, "@")
+				expectedDeps = append(expectedDeps, diligent.Dep{Name: depID[:at], Version: depID[at+1:], License: l})
+			}
+			if len(d) > 0 || len(expectedDeps) > 0 {
+				sort.Sort(diligent.DepsByName(d))
+				sort.Sort(diligent.DepsByName(expectedDeps))
+				if reflect.DeepEqual(d, expectedDeps) == false {
+					t.Errorf("deps: got %+v, want %+v", d, expectedDeps)
+				}
+			}
+			// errors are compared using errors.Is, as those from the file system cannot be constructed
+			got := warningDetails(w)
+			if len(got) != len(tt.warnsOut) {
+				t.Fatalf("warnings: got %+v, want %+v", got, tt.warnsOut)
+			}
+			for i, g := range got {
+				want := tt.warnsOut[i]
+				if g.Kind != want.Kind || g.Ecosystem != want.Ecosystem || g.Package != want.Package || g.Version != want.Version || !errors.Is(g.Err, want.Err) {
+					t.Errorf("warning: got %+v, want %+v", g, want)
+				}
+			}
+			isErr := e != nil
+			if tt.errOut != isErr {
+				t.Errorf("error: got %v, want %v", isErr, tt.errOut)
+			}
+		})
+	}
+}
+
+// warningDetails returns the details of the warnings without the recorded resolution steps, sorted by package
+func warningDetails(ww []diligent.Warning) []diligent.WarningDetails {
+	details := make([]diligent.WarningDetails, len(ww))
+	for i, w := range ww {
+		details[i] = diligent.GetWarningDetails(w)
+		details[i].Trace = nil
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].Package < details[j].Package })
+	return details
+}
@@ -0,0 +1,141 @@
+package diligent
+
+import "context"
+
+// Step is an attempt to determine the license of a package
+type Step struct {
+	// Source describes how the license was looked up, for example "npm license field" or "git clone"
+	Source string
+	// Location is the URL or path which was tried
+	Location string
+	// License is the identifier of the license found by the step, empty if the step failed
+	License string
+	// Err describes why the step failed
+	Err string
+}
+
+// Trace records the steps taken to determine the license of a package, in the order they were attempted
+type Trace []Step
+
+// Record appends a step to the trace. It is safe to call on a nil Trace, in which case nothing is recorded
+func (t *Trace) Record(source, location string, l License, err error) {
+	if t == nil {
+		return
+	}
+	s := Step{Source: source, Location: location}
+	if err != nil {
+		s.Err = err.Error()
+	} else {
+		s.License = l.Identifier
+	}
+	*t = append(*t, s)
+}
+
+// Winner returns the step which determined the license, which is the last successful step
+func (t Trace) Winner() (Step, bool) {
+	for i := len(t) - 1; i >= 0; i-- {
+		if t[i].Err == "" {
+			return t[i], true
+		}
+	}
+	return Step{}, false
+}
+
+// Offline returns true if any of the steps failed because network requests were not allowed, in which case the
+// license may be determinable once online
+func (t Trace) Offline() bool {
+	for _, s := range t {
+		if s.Err == ErrOffline.Error() {
+			return true
+		}
+	}
+	return false
+}
+
+// URLLicenseGetter retrieves license information from a URL, such as a GitHub repository
+type URLLicenseGetter interface {
+	GetLicenseFromURL(s string) (License, error)
+}
+
+// TracedURLLicenseGetter is implemented by URLLicenseGetters which record each of the steps they take
+type TracedURLLicenseGetter interface {
+	GetLicenseFromURLTraced(s string, t *Trace) (License, error)
+}
+
+// GetLicenseFromURL uses the getter to retrieve the license associated with the URL, recording the steps taken. The
+// getter's requests are cancelled once ctx is done if it implements ContextURLLicenseGetter. Other getters are
+// assumed to make network requests, so are not called if ctx is offline
+func GetLicenseFromURL(ctx context.Context, g URLLicenseGetter, s string, t *Trace) (License, error) {
+	if cg, ok := g.(ContextURLLicenseGetter); ok {
+		return cg.GetLicenseFromURLContext(ctx, s, t)
+	}
+	if err := ctx.Err(); err != nil {
+		t.Record("web", s, License{}, err)
+		return License{}, err
+	}
+	if IsOffline(ctx) {
+		t.Record("web", s, License{}, ErrOffline)
+		return License{}, ErrOffline
+	}
+	if tg, ok := g.(TracedURLLicenseGetter); ok {
+		return tg.GetLicenseFromURLTraced(s, t)
+	}
+	l, err := g.GetLicenseFromURL(s)
+	t.Record("web", s, l, err)
+	return l, err
+}
+
+// PackageLicenseGetter retrieves the license associated with a package path, such as a go package
+type PackageLicenseGetter interface {
+	GetLicense(packagePath string) (License, error)
+}
+
+// TracedPackageLicenseGetter is implemented by PackageLicenseGetters which record each of the steps they take
+type TracedPackageLicenseGetter interface {
+	GetLicenseTraced(packagePath string, t *Trace) (License, error)
+}
+
+// GetPackageLicense uses the getter to retrieve the license associated with the package, recording the steps taken.
+// The getter's requests are cancelled once ctx is done if it implements ContextPackageLicenseGetter. Other getters
+// are assumed to make network requests, so are not called if ctx is offline
+func GetPackageLicense(ctx context.Context, g PackageLicenseGetter, packagePath string, t *Trace) (License, error) {
+	if cg, ok := g.(ContextPackageLicenseGetter); ok {
+		return cg.GetLicenseContext(ctx, packagePath, t)
+	}
+	if err := ctx.Err(); err != nil {
+		t.Record("package", packagePath, License{}, err)
+		return License{}, err
+	}
+	if IsOffline(ctx) {
+		t.Record("package", packagePath, License{}, ErrOffline)
+		return License{}, ErrOffline
+	}
+	if tg, ok := g.(TracedPackageLicenseGetter); ok {
+		return tg.GetLicenseTraced(packagePath, t)
+	}
+	l, err := g.GetLicense(packagePath)
+	t.Record("package", packagePath, l, err)
+	return l, err
+}
+
+// TraceLicenseForDirectory is identical to GetLicenseForDirectory but records the step within the trace
+func TraceLicenseForDirectory(directory string, t *Trace) (License, error) {
+	l, err := GetLicenseForDirectory(directory)
+	t.Record("license file", directory, l, err)
+	return l, err
+}
+
+// TraceLicenseForGit is identical to GetLicenseForGitContext but records the step within the trace
+func TraceLicenseForGit(ctx context.Context, url string, t *Trace) (License, error) {
+	l, err := GetLicenseForGitContext(ctx, url)
+	t.Record("git clone", url, l, err)
+	return l, err
+}
+
+// TraceLicenseFromIdentifier is identical to GetLicenseFromIdentifier but records the step within the trace. The
+// source describes where the identifier was found and the location is the URL or path it was read from
+func TraceLicenseFromIdentifier(identifier, source, location string, t *Trace) (License, error) {
+	l, err := GetLicenseFromIdentifier(identifier)
+	t.Record(source, location, l, err)
+	return l, err
+}
@@ -0,0 +1,139 @@
+package diligent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type comparator struct {
+	op      string
+	version string
+}
+
+var comparatorOps = []string{">=", "<=", "==", ">", "<", "="}
+
+// parseVersionRange parses a range made up of space or comma separated comparators such as ">=1.0.0 <2.0.0".
+// A version without an operator must match exactly, and "*" matches any version
+func parseVersionRange(r string) ([]comparator, error) {
+	fields := strings.FieldsFunc(r, func(c rune) bool { return c == ' ' || c == ',' })
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("version range '%s' is empty", r)
+	}
+	cc := make([]comparator, 0, len(fields))
+	for _, f := range fields {
+		if f == "*" {
+			continue
+		}
+		c := comparator{op: "=", version: f}
+		for _, op := range comparatorOps {
+			if strings.HasPrefix(f, op) {
+				c = comparator{op: op, version: strings.TrimPrefix(f, op)}
+				break
+			}
+		}
+		if !isVersion(c.version) {
+			return nil, fmt.Errorf("version range '%s' contains an invalid version '%s'", r, c.version)
+		}
+		cc = append(cc, c)
+	}
+	return cc, nil
+}
+
+func isVersion(v string) bool {
+	v = strings.TrimPrefix(v, "v")
+	return v != "" && v[0] >= '0' && v[0] <= '9'
+}
+
+// baseVersion extracts the lowest version permitted by a version as written within a manifest, so that
+// "^1.2.0", "~1.2.0" and "[1.2.0, 2.0.0)" are all treated as 1.2.0
+func baseVersion(v string) string {
+	v = strings.TrimLeft(v, "^~=>[( ")
+	if idx := strings.IndexAny(v, ", )]"); idx >= 0 {
+		v = v[:idx]
+	}
+	return v
+}
+
+// compareVersions compares dot separated versions numerically, ignoring any leading "v" and build metadata.
+// Pre-release versions, such as 1.0.0-beta, are lower than their release
+func compareVersions(a, b string) int {
+	splitPre := func(v string) (string, string) {
+		v = strings.TrimPrefix(v, "v")
+		if idx := strings.Index(v, "+"); idx >= 0 {
+			v = v[:idx]
+		}
+		if idx := strings.Index(v, "-"); idx >= 0 {
+			return v[:idx], v[idx+1:]
+		}
+		return v, ""
+	}
+	aRel, aPre := splitPre(a)
+	bRel, bPre := splitPre(b)
+	aParts, bParts := strings.Split(aRel, "."), strings.Split(bRel, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var ai, bi int
+		if i < len(aParts) {
+			ai, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bi, _ = strconv.Atoi(bParts[i])
+		}
+		if ai != bi {
+			if ai < bi {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	case aPre < bPre:
+		return -1
+	}
+	return 1
+}
+
+// ValidateVersionRange returns an error if the version range cannot be parsed
+func ValidateVersionRange(r string) error {
+	_, err := parseVersionRange(r)
+	return err
+}
+
+// VersionInRange returns true if the version falls within the range, see ValidateVersionRange.
+// Versions which are themselves ranges, as found in some manifests, are compared using their lower bound
+func VersionInRange(version, r string) bool {
+	cc, err := parseVersionRange(r)
+	if err != nil {
+		return false
+	}
+	v := baseVersion(version)
+	if !isVersion(v) {
+		return len(cc) == 0
+	}
+	for _, c := range cc {
+		cmp := compareVersions(v, c.version)
+		var ok bool
+		switch c.op {
+		case ">=":
+			ok = cmp >= 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case "<":
+			ok = cmp < 0
+		default:
+			ok = cmp == 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -1,6 +1,10 @@
 package warning
 
-import "github.com/senseyeio/diligent"
+import (
+	"errors"
+
+	"github.com/senseyeio/diligent"
+)
 
 // New returns a Warning. It includes the name of the dependency and a message describing the problem.
 func New(dependency string, message string) diligent.Warning {
@@ -10,12 +14,62 @@ func New(dependency string, message string) diligent.Warning {
 	}
 }
 
+// FromError returns a Warning describing err, the reason the license of the version of the package could not be
+// determined. The ecosystem is the name of the Deper which found the package and the warning is categorised using
+// diligent.WarningKindOf. If a step failed because network requests were not allowed, err is replaced by
+// diligent.ErrOffline so the warning makes clear the license may be determinable online
+func FromError(ecosystem, dependency, version string, err error, t diligent.Trace) diligent.Warning {
+	return FromScopedError(ecosystem, dependency, version, "", err, t)
+}
+
+// FromScopedError is identical to FromError but also records the scope of the dependency, for Depers which can
+// distinguish scopes
+func FromScopedError(ecosystem, dependency, version string, scope diligent.Scope, err error, t diligent.Trace) diligent.Warning {
+	if t.Offline() {
+		err = diligent.ErrOffline
+	}
+	return &Warn{
+		Msg:       err.Error(),
+		Dep:       dependency,
+		Trace:     t,
+		Kind:      diligent.WarningKindOf(err),
+		Ecosystem: ecosystem,
+		Version:   version,
+		Scope:     scope,
+		Err:       err,
+	}
+}
+
 type Warn struct {
-	Msg string
-	Dep string
+	Msg   string
+	Dep   string
+	Trace diligent.Trace
+	// Kind, Ecosystem, Version, Scope and Err are only set by FromError and FromScopedError
+	Kind      diligent.WarningKind
+	Ecosystem string
+	Version   string
+	Scope     diligent.Scope
+	Err       error
 }
 
 // Warning implements diligent.Warning
 func (w *Warn) Warning() string {
 	return "Failed to determine license for " + w.Dep + ": " + w.Msg
 }
+
+// Details implements diligent.DetailedWarning
+func (w *Warn) Details() diligent.WarningDetails {
+	err := w.Err
+	if err == nil {
+		err = errors.New(w.Msg)
+	}
+	return diligent.WarningDetails{
+		Kind:      w.Kind,
+		Ecosystem: w.Ecosystem,
+		Package:   w.Dep,
+		Version:   w.Version,
+		Scope:     w.Scope,
+		Err:       err,
+		Trace:     w.Trace,
+	}
+}
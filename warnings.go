@@ -0,0 +1,175 @@
+package diligent
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+)
+
+// WarningKind categorises why the license of a dependency could not be determined
+type WarningKind string
+
+const (
+	// NetworkFailure warnings are caused by a request which failed, timed out or was not allowed whilst offline
+	NetworkFailure WarningKind = "network-failure"
+	// NotFound warnings are caused by a package or repository which does not exist
+	NotFound WarningKind = "not-found"
+	// NoLicenseMetadata warnings are caused by a package which does not declare a license or include a license file
+	NoLicenseMetadata WarningKind = "no-license-metadata"
+	// UnknownIdentifier warnings are caused by a license identifier which is not known to diligent
+	UnknownIdentifier WarningKind = "unknown-identifier"
+	// LowConfidence warnings are caused by a license file whose license could not be identified with confidence
+	LowConfidence WarningKind = "low-confidence"
+	// ParseError warnings are caused by a response or file describing the package which could not be parsed
+	ParseError WarningKind = "parse-error"
+)
+
+var warningKinds = []WarningKind{NetworkFailure, NotFound, NoLicenseMetadata, UnknownIdentifier, LowConfidence, ParseError}
+
+// WarningKinds returns all the kinds of warning
+func WarningKinds() []WarningKind {
+	return append([]WarningKind(nil), warningKinds...)
+}
+
+// IsWarningKind returns true if s is a kind of warning
+func IsWarningKind(s string) bool {
+	for _, k := range warningKinds {
+		if string(k) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// WarningDetails describes a package whose license could not be determined and the reason why
+type WarningDetails struct {
+	Kind WarningKind
+	// Ecosystem is the name of the Deper which found the package, for example "npm"
+	Ecosystem string
+	Package   string
+	Version   string
+	// Scope is empty when the Deper cannot distinguish scopes
+	Scope Scope
+	// Err is the reason the license could not be determined
+	Err error
+	// Trace records the steps which were taken to determine the license
+	Trace Trace
+}
+
+// EffectiveScope returns the scope of the package. As with Dep, an empty Scope means the package is assumed to be a
+// Production dependency
+func (wd WarningDetails) EffectiveScope() Scope {
+	if wd.Scope == "" {
+		return Production
+	}
+	return wd.Scope
+}
+
+// DetailedWarning is an optional interface implemented by Warnings which describe the package affected and the reason
+// its license could not be determined
+type DetailedWarning interface {
+	Warning
+	Details() WarningDetails
+}
+
+// GetWarningDetails returns the details of the warning if it implements DetailedWarning. Otherwise only the message
+// is known, so the details hold no package and an empty Kind. Err is never nil, falling back to the message of the
+// warning when the details have no error
+func GetWarningDetails(w Warning) WarningDetails {
+	if dw, ok := w.(DetailedWarning); ok {
+		details := dw.Details()
+		if details.Err == nil {
+			details.Err = errors.New(w.Warning())
+		}
+		return details
+	}
+	return WarningDetails{Err: errors.New(w.Warning())}
+}
+
+// StatusError is returned when a registry or API responds with an unsuccessful status code
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("requested failed with status %v", e.StatusCode)
+}
+
+// MalformedError is returned when a response or file describing a package cannot be parsed
+type MalformedError struct {
+	Msg string
+}
+
+func (e *MalformedError) Error() string {
+	return e.Msg
+}
+
+// UnknownIdentifierError is returned when a license identifier is not known to diligent
+type UnknownIdentifierError struct {
+	Identifier string
+}
+
+func (e *UnknownIdentifierError) Error() string {
+	return fmt.Sprintf("license identifier %s is not known to diligent", e.Identifier)
+}
+
+// CloneError is returned when a git repository cannot be cloned
+type CloneError struct {
+	URL string
+	Err error
+}
+
+func (e *CloneError) Error() string {
+	return fmt.Sprintf("could not clone repo from %s: %v", e.URL, e.Err)
+}
+
+func (e *CloneError) Unwrap() error {
+	return e.Err
+}
+
+// ErrLowConfidence is returned when license files are found but their license cannot be identified
+var ErrLowConfidence = errors.New("license file found but its license could not be identified")
+
+// WarningKindOf categorises err, the reason the license of a package could not be determined. Unsuccessful responses
+// are network failures, such as 403 and 429 when rate limited, other than 404 which means the package does not exist.
+// Errors which are not recognised are assumed to be caused by a lack of license metadata
+func WarningKindOf(err error) WarningKind {
+	var statusErr *StatusError
+	var netErr net.Error
+	var pathErr *os.PathError
+	var cloneErr *CloneError
+	var malformedErr *MalformedError
+	var unknownErr *UnknownIdentifierError
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var xmlErr *xml.SyntaxError
+	switch {
+	case errors.As(err, &statusErr):
+		if statusErr.StatusCode == http.StatusNotFound {
+			return NotFound
+		}
+		return NetworkFailure
+	case errors.Is(err, transport.ErrRepositoryNotFound), errors.Is(err, os.ErrNotExist):
+		return NotFound
+	case errors.As(err, &pathErr):
+		// the errno within a file system error also implements net.Error
+		return NoLicenseMetadata
+	case errors.Is(err, ErrOffline), errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled),
+		errors.As(err, &netErr), errors.As(err, &cloneErr):
+		return NetworkFailure
+	case errors.As(err, &unknownErr):
+		return UnknownIdentifier
+	case errors.Is(err, ErrLowConfidence):
+		return LowConfidence
+	case errors.As(err, &malformedErr), errors.As(err, &syntaxErr), errors.As(err, &typeErr), errors.As(err, &xmlErr):
+		return ParseError
+	}
+	return NoLicenseMetadata
+}
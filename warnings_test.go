@@ -0,0 +1,71 @@
+package diligent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/senseyeio/diligent"
+)
+
+func TestWarningKindOf(t *testing.T) {
+	_, unknownErr := diligent.GetLicenseFromIdentifier("Unknown-1.0")
+	_, missingErr := diligent.GetLicenseForDirectory("/does/not/exist")
+	_, unreadableErr := os.Open("/dev/null/file")
+	var syntaxErr error = json.Unmarshal([]byte("{"), &struct{}{})
+	cases := []struct {
+		err  error
+		kind diligent.WarningKind
+	}{
+		{&diligent.StatusError{StatusCode: 404}, diligent.NotFound},
+		{&diligent.StatusError{StatusCode: 403}, diligent.NetworkFailure},
+		{&diligent.StatusError{StatusCode: 429}, diligent.NetworkFailure},
+		{&diligent.StatusError{StatusCode: 503}, diligent.NetworkFailure},
+		{&url.Error{Op: "Get", URL: "https://example.com", Err: errors.New("connection refused")}, diligent.NetworkFailure},
+		{diligent.ErrOffline, diligent.NetworkFailure},
+		{fmt.Errorf("request: %w", context.DeadlineExceeded), diligent.NetworkFailure},
+		{&diligent.CloneError{URL: "https://example.com", Err: errors.New("connection refused")}, diligent.NetworkFailure},
+		{&diligent.CloneError{URL: "https://example.com", Err: transport.ErrRepositoryNotFound}, diligent.NotFound},
+		{missingErr, diligent.NotFound},
+		{unreadableErr, diligent.NoLicenseMetadata},
+		{unknownErr, diligent.UnknownIdentifier},
+		{diligent.ErrLowConfidence, diligent.LowConfidence},
+		{&diligent.MalformedError{Msg: "invalid JSON"}, diligent.ParseError},
+		{syntaxErr, diligent.ParseError},
+		{errors.New("no license field"), diligent.NoLicenseMetadata},
+	}
+	for _, tc := range cases {
+		if kind := diligent.WarningKindOf(tc.err); kind != tc.kind {
+			t.Errorf("%v: expected %s, got %s", tc.err, tc.kind, kind)
+		}
+	}
+}
+
+type plainWarning string
+
+func (w plainWarning) Warning() string { return string(w) }
+
+// detailedWarning implements DetailedWarning without an error
+type detailedWarning string
+
+func (w detailedWarning) Warning() string { return string(w) }
+
+func (w detailedWarning) Details() diligent.WarningDetails {
+	return diligent.WarningDetails{Ecosystem: "mock", Package: "pkg"}
+}
+
+func TestGetWarningDetails(t *testing.T) {
+	details := diligent.GetWarningDetails(plainWarning("something failed"))
+	if details.Kind != "" || details.Package != "" || details.Err.Error() != "something failed" {
+		t.Errorf("unexpected details %+v", details)
+	}
+	details = diligent.GetWarningDetails(detailedWarning("pkg failed"))
+	if details.Package != "pkg" || details.Err == nil || details.Err.Error() != "pkg failed" {
+		t.Errorf("unexpected details %+v", details)
+	}
+}